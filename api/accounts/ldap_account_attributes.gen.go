@@ -8,11 +8,12 @@ import (
 )
 
 type LdapAccountAttributes struct {
-	LoginName      string   `json:"login_name,omitempty"`
-	FullName       string   `json:"full_name,omitempty"`
-	Email          string   `json:"email,omitempty"`
-	Dn             string   `json:"dn,omitempty"`
-	MemberOfGroups []string `json:"member_of_groups,omitempty"`
+	LoginName       string                 `json:"login_name,omitempty"`
+	FullName        string                 `json:"full_name,omitempty"`
+	Email           string                 `json:"email,omitempty"`
+	Dn              string                 `json:"dn,omitempty"`
+	MemberOfGroups  []string               `json:"member_of_groups,omitempty"`
+	EntryAttributes map[string]interface{} `json:"entry_attributes,omitempty"`
 }
 
 func AttributesMapToLdapAccountAttributes(in map[string]interface{}) (*LdapAccountAttributes, error) {
@@ -15,6 +15,7 @@ var (
 	ErrInvalidArgument  = &Error{Kind: codes.InvalidArgument.String(), response: &Response{resp: &http.Response{StatusCode: http.StatusBadRequest}}}
 	ErrPermissionDenied = &Error{Kind: codes.PermissionDenied.String(), response: &Response{resp: &http.Response{StatusCode: http.StatusForbidden}}}
 	ErrUnauthorized     = &Error{Kind: codes.Unauthenticated.String(), response: &Response{resp: &http.Response{StatusCode: http.StatusUnauthorized}}}
+	ErrRequestTooLarge  = &Error{Kind: codes.ResourceExhausted.String(), response: &Response{resp: &http.Response{StatusCode: http.StatusRequestEntityTooLarge}}}
 )
 
 // AsServerError returns an api *Error from the provided error.  If the provided error
@@ -24,6 +24,7 @@ type AuthMethod struct {
 	Type                        string                 `json:"type,omitempty"`
 	Attributes                  map[string]interface{} `json:"attributes,omitempty"`
 	IsPrimary                   bool                   `json:"is_primary,omitempty"`
+	DeletionProtected           bool                   `json:"deletion_protected,omitempty"`
 	AuthorizedActions           []string               `json:"authorized_actions,omitempty"`
 	AuthorizedCollectionActions map[string][]string    `json:"authorized_collection_actions,omitempty"`
 
@@ -31,6 +31,11 @@ type LdapAuthMethodAttributes struct {
 	BindPasswordHmac         string   `json:"bind_password_hmac,omitempty"`
 	UseTokenGroups           bool     `json:"use_token_groups,omitempty"`
 	AccountAttributeMaps     []string `json:"account_attribute_maps,omitempty"`
+	AlternateUserFilters     []string `json:"alternate_user_filters,omitempty"`
+	NestedGroupResolution    string   `json:"nested_group_resolution,omitempty"`
+	MaxNestedGroupDepth      uint32   `json:"max_nested_group_depth,omitempty"`
+	GroupSearchPageSize      uint32   `json:"group_search_page_size,omitempty"`
+	GroupSearchSizeLimit     uint32   `json:"group_search_size_limit,omitempty"`
 }
 
 func AttributesMapToLdapAuthMethodAttributes(in map[string]interface{}) (*LdapAuthMethodAttributes, error) {
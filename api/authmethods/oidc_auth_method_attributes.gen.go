@@ -23,6 +23,9 @@ type OidcAuthMethodAttributes struct {
 	AccountClaimMaps                  []string `json:"account_claim_maps,omitempty"`
 	DisableDiscoveredConfigValidation bool     `json:"disable_discovered_config_validation,omitempty"`
 	DryRun                            bool     `json:"dry_run,omitempty"`
+	JwtValidationPubKeys              []string `json:"jwt_validation_pub_keys,omitempty"`
+	BoundClaims                       []string `json:"bound_claims,omitempty"`
+	JwtLoginEnabled                   bool     `json:"jwt_login_enabled,omitempty"`
 }
 
 func AttributesMapToOidcAuthMethodAttributes(in map[string]interface{}) (*OidcAuthMethodAttributes, error) {
@@ -162,6 +162,30 @@ func DefaultOidcAuthMethodAllowedAudiences() Option {
 	}
 }
 
+func WithLdapAuthMethodAlternateUserFilters(inAlternateUserFilters []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["alternate_user_filters"] = inAlternateUserFilters
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodAlternateUserFilters() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["alternate_user_filters"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithLdapAuthMethodAnonGroupSearch(inAnonGroupSearch bool) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -270,6 +294,30 @@ func DefaultLdapAuthMethodBindPassword() Option {
 	}
 }
 
+func WithOidcAuthMethodBoundClaims(inBoundClaims []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["bound_claims"] = inBoundClaims
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultOidcAuthMethodBoundClaims() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["bound_claims"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithLdapAuthMethodCertificates(inCertificates []string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -414,6 +462,18 @@ func DefaultOidcAuthMethodClientSecret() Option {
 	}
 }
 
+func WithDeletionProtected(inDeletionProtected bool) Option {
+	return func(o *options) {
+		o.postMap["deletion_protected"] = inDeletionProtected
+	}
+}
+
+func DefaultDeletionProtected() Option {
+	return func(o *options) {
+		o.postMap["deletion_protected"] = nil
+	}
+}
+
 func WithDescription(inDescription string) Option {
 	return func(o *options) {
 		o.postMap["description"] = inDescription
@@ -594,6 +654,54 @@ func DefaultLdapAuthMethodGroupFilter() Option {
 	}
 }
 
+func WithLdapAuthMethodGroupSearchPageSize(inGroupSearchPageSize uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["group_search_page_size"] = inGroupSearchPageSize
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodGroupSearchPageSize() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["group_search_page_size"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithLdapAuthMethodGroupSearchSizeLimit(inGroupSearchSizeLimit uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["group_search_size_limit"] = inGroupSearchSizeLimit
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodGroupSearchSizeLimit() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["group_search_size_limit"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithOidcAuthMethodIdpCaCerts(inIdpCaCerts []string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -666,6 +774,54 @@ func DefaultOidcAuthMethodIssuer() Option {
 	}
 }
 
+func WithOidcAuthMethodJwtLoginEnabled(inJwtLoginEnabled bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["jwt_login_enabled"] = inJwtLoginEnabled
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultOidcAuthMethodJwtLoginEnabled() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["jwt_login_enabled"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithOidcAuthMethodJwtValidationPubKeys(inJwtValidationPubKeys []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["jwt_validation_pub_keys"] = inJwtValidationPubKeys
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultOidcAuthMethodJwtValidationPubKeys() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["jwt_validation_pub_keys"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithOidcAuthMethodMaxAge(inMaxAge uint32) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -690,6 +846,30 @@ func DefaultOidcAuthMethodMaxAge() Option {
 	}
 }
 
+func WithLdapAuthMethodMaxNestedGroupDepth(inMaxNestedGroupDepth uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["max_nested_group_depth"] = inMaxNestedGroupDepth
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodMaxNestedGroupDepth() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["max_nested_group_depth"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithPasswordAuthMethodMinLoginNameLength(inMinLoginNameLength uint32) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -750,6 +930,30 @@ func DefaultName() Option {
 	}
 }
 
+func WithLdapAuthMethodNestedGroupResolution(inNestedGroupResolution string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["nested_group_resolution"] = inNestedGroupResolution
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodNestedGroupResolution() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["nested_group_resolution"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithOidcAuthMethodSigningAlgorithms(inSigningAlgorithms []string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
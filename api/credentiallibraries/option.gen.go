@@ -293,6 +293,102 @@ func DefaultVaultSSHCertificateCredentialLibraryKeyType() Option {
 	}
 }
 
+func WithVaultCredentialLibraryKvSecretVersion(inKvSecretVersion uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["kv_secret_version"] = inKvSecretVersion
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultVaultCredentialLibraryKvSecretVersion() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["kv_secret_version"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithVaultCredentialLibraryMappingExpression(inMappingExpression string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["mapping_expression"] = inMappingExpression
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultVaultCredentialLibraryMappingExpression() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["mapping_expression"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithVaultCredentialLibraryMaxRequestsPerMinute(inMaxRequestsPerMinute uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["max_requests_per_minute"] = inMaxRequestsPerMinute
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultVaultCredentialLibraryMaxRequestsPerMinute() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["max_requests_per_minute"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithVaultCredentialLibraryNamespace(inNamespace string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["namespace"] = inNamespace
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultVaultCredentialLibraryNamespace() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["namespace"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithName(inName string) Option {
 	return func(o *options) {
 		o.postMap["name"] = inName
@@ -329,6 +425,42 @@ func WithVaultSSHCertificateCredentialLibraryPath(inPath string) Option {
 	}
 }
 
+func WithAzureSecretCredentialLibrarySecretName(inSecretName string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["secret_name"] = inSecretName
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithAzureSecretCredentialLibrarySecretVersion(inSecretVersion string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["secret_version"] = inSecretVersion
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultAzureSecretCredentialLibrarySecretVersion() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["secret_version"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithVaultSSHCertificateCredentialLibraryTtl(inTtl string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -8,9 +8,13 @@ import (
 )
 
 type VaultCredentialLibraryAttributes struct {
-	Path            string `json:"path,omitempty"`
-	HttpMethod      string `json:"http_method,omitempty"`
-	HttpRequestBody string `json:"http_request_body,omitempty"`
+	Path                 string `json:"path,omitempty"`
+	HttpMethod           string `json:"http_method,omitempty"`
+	HttpRequestBody      string `json:"http_request_body,omitempty"`
+	MappingExpression    string `json:"mapping_expression,omitempty"`
+	KvSecretVersion      uint32 `json:"kv_secret_version,omitempty"`
+	MaxRequestsPerMinute uint32 `json:"max_requests_per_minute,omitempty"`
+	Namespace            string `json:"namespace,omitempty"`
 }
 
 func AttributesMapToVaultCredentialLibraryAttributes(in map[string]interface{}) (*VaultCredentialLibraryAttributes, error) {
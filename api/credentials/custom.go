@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentials
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+type CredentialBatchCreateResult struct {
+	Items    []*Credential
+	response *api.Response
+}
+
+func (n CredentialBatchCreateResult) GetItems() []*Credential {
+	return n.Items
+}
+
+func (n CredentialBatchCreateResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// BatchCreate creates the credentials in the credential store in a single
+// transaction. Only the type, name, description and attributes of the items
+// are used. Either all of the credentials are created or none of them are.
+func (c *Client) BatchCreate(ctx context.Context, credentialStoreId string, items []*Credential, opt ...Option) (*CredentialBatchCreateResult, error) {
+	if credentialStoreId == "" {
+		return nil, fmt.Errorf("empty credentialStoreId value passed into BatchCreate request")
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("empty items value passed into BatchCreate request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	reqItems := make([]map[string]any, 0, len(items))
+	for i, item := range items {
+		if item == nil {
+			return nil, fmt.Errorf("nil item %d passed into BatchCreate request", i)
+		}
+		reqItem := map[string]any{
+			"type": item.Type,
+		}
+		if item.Name != "" {
+			reqItem["name"] = item.Name
+		}
+		if item.Description != "" {
+			reqItem["description"] = item.Description
+		}
+		if len(item.Attributes) > 0 {
+			reqItem["attributes"] = item.Attributes
+		}
+		reqItems = append(reqItems, reqItem)
+	}
+	opts.postMap["credential_store_id"] = credentialStoreId
+	opts.postMap["items"] = reqItems
+
+	req, err := c.client.NewRequest(ctx, "POST", "credentials:batch-create", opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating BatchCreate request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during BatchCreate call: %w", err)
+	}
+
+	target := new(CredentialBatchCreateResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding BatchCreate response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
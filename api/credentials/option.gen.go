@@ -4,6 +4,7 @@
 package credentials
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/hashicorp/boundary/api"
@@ -89,6 +90,18 @@ func DefaultAttributes() Option {
 	}
 }
 
+func WithX509CertificateCredentialCertificate(inCertificate string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["certificate"] = inCertificate
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithDescription(inDescription string) Option {
 	return func(o *options) {
 		o.postMap["description"] = inDescription
@@ -101,6 +114,12 @@ func DefaultDescription() Option {
 	}
 }
 
+func WithIncludeSecret(inIncludeSecret bool) Option {
+	return func(o *options) {
+		o.queryMap["include_secret"] = fmt.Sprintf("%v", inIncludeSecret)
+	}
+}
+
 func WithName(inName string) Option {
 	return func(o *options) {
 		o.postMap["name"] = inName
@@ -149,6 +168,18 @@ func WithSshPrivateKeyCredentialPrivateKey(inPrivateKey string) Option {
 	}
 }
 
+func WithX509CertificateCredentialPrivateKey(inPrivateKey string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["private_key"] = inPrivateKey
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithSshPrivateKeyCredentialPrivateKeyPassphrase(inPrivateKeyPassphrase string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -198,6 +198,30 @@ func DefaultDescription() Option {
 	}
 }
 
+func WithAzureCredentialStoreManagedIdentityClientId(inManagedIdentityClientId string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["managed_identity_client_id"] = inManagedIdentityClientId
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultAzureCredentialStoreManagedIdentityClientId() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["managed_identity_client_id"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithName(inName string) Option {
 	return func(o *options) {
 		o.postMap["name"] = inName
@@ -294,6 +318,18 @@ func WithVaultCredentialStoreToken(inToken string) Option {
 	}
 }
 
+func WithAzureCredentialStoreVaultUri(inVaultUri string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["vault_uri"] = inVaultUri
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithVaultCredentialStoreWorkerFilter(inWorkerFilter string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
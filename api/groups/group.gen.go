@@ -133,6 +133,10 @@ func (c *Client) Create(ctx context.Context, scopeId string, opt ...Option) (*Gr
 }
 
 func (c *Client) Read(ctx context.Context, id string, opt ...Option) (*GroupReadResult, error) {
+	opts, apiOpts := getOpts(opt...)
+	if id == "" {
+		id = opts.withLookupName
+	}
 	if id == "" {
 		return nil, fmt.Errorf("empty id value passed into Read request")
 	}
@@ -140,8 +144,6 @@ func (c *Client) Read(ctx context.Context, id string, opt ...Option) (*GroupRead
 		return nil, fmt.Errorf("nil client")
 	}
 
-	opts, apiOpts := getOpts(opt...)
-
 	req, err := c.client.NewRequest(ctx, "GET", fmt.Sprintf("groups/%s", url.PathEscape(id)), nil, apiOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("error creating Read request: %w", err)
@@ -26,6 +26,7 @@ type options struct {
 	withSkipCurlOutput      bool
 	withFilter              string
 	withRecursive           bool
+	withLookupName          string
 }
 
 func getDefaultOptions() options {
@@ -52,6 +53,9 @@ func getOpts(opt ...Option) (options, []api.Option) {
 	if opts.withRecursive {
 		opts.queryMap["recursive"] = strconv.FormatBool(opts.withRecursive)
 	}
+	if opts.withLookupName != "" {
+		opts.queryMap["name"] = opts.withLookupName
+	}
 	return opts, apiOpts
 }
 
@@ -90,6 +94,16 @@ func WithRecursive(recurse bool) Option {
 	}
 }
 
+// WithLookupByName tells the API to read the resource with the given name in
+// the scope with the given ID instead of by ID. The ID passed to Read must be
+// empty or the name.
+func WithLookupByName(name, scopeId string) Option {
+	return func(o *options) {
+		o.withLookupName = name
+		o.queryMap["scope_id"] = scopeId
+	}
+}
+
 func WithDescription(inDescription string) Option {
 	return func(o *options) {
 		o.postMap["description"] = inDescription
@@ -13,18 +13,21 @@ import (
 )
 
 type ManagedGroup struct {
-	Id                string                 `json:"id,omitempty"`
-	Scope             *scopes.ScopeInfo      `json:"scope,omitempty"`
-	Name              string                 `json:"name,omitempty"`
-	Description       string                 `json:"description,omitempty"`
-	CreatedTime       time.Time              `json:"created_time,omitempty"`
-	UpdatedTime       time.Time              `json:"updated_time,omitempty"`
-	Version           uint32                 `json:"version,omitempty"`
-	Type              string                 `json:"type,omitempty"`
-	AuthMethodId      string                 `json:"auth_method_id,omitempty"`
-	Attributes        map[string]interface{} `json:"attributes,omitempty"`
-	MemberIds         []string               `json:"member_ids,omitempty"`
-	AuthorizedActions []string               `json:"authorized_actions,omitempty"`
+	Id                   string                 `json:"id,omitempty"`
+	Scope                *scopes.ScopeInfo      `json:"scope,omitempty"`
+	Name                 string                 `json:"name,omitempty"`
+	Description          string                 `json:"description,omitempty"`
+	CreatedTime          time.Time              `json:"created_time,omitempty"`
+	UpdatedTime          time.Time              `json:"updated_time,omitempty"`
+	Version              uint32                 `json:"version,omitempty"`
+	Type                 string                 `json:"type,omitempty"`
+	AuthMethodId         string                 `json:"auth_method_id,omitempty"`
+	Attributes           map[string]interface{} `json:"attributes,omitempty"`
+	MemberIds            []string               `json:"member_ids,omitempty"`
+	UnionGroupIds        []string               `json:"union_group_ids,omitempty"`
+	IntersectionGroupIds []string               `json:"intersection_group_ids,omitempty"`
+	DifferenceGroupIds   []string               `json:"difference_group_ids,omitempty"`
+	AuthorizedActions    []string               `json:"authorized_actions,omitempty"`
 
 	response *api.Response
 }
@@ -101,6 +101,18 @@ func DefaultDescription() Option {
 	}
 }
 
+func WithDifferenceGroupIds(inDifferenceGroupIds []string) Option {
+	return func(o *options) {
+		o.postMap["difference_group_ids"] = inDifferenceGroupIds
+	}
+}
+
+func DefaultDifferenceGroupIds() Option {
+	return func(o *options) {
+		o.postMap["difference_group_ids"] = nil
+	}
+}
+
 func WithOidcManagedGroupFilter(inFilter string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -137,6 +149,18 @@ func DefaultLdapManagedGroupGroupNames() Option {
 	}
 }
 
+func WithIntersectionGroupIds(inIntersectionGroupIds []string) Option {
+	return func(o *options) {
+		o.postMap["intersection_group_ids"] = inIntersectionGroupIds
+	}
+}
+
+func DefaultIntersectionGroupIds() Option {
+	return func(o *options) {
+		o.postMap["intersection_group_ids"] = nil
+	}
+}
+
 func WithName(inName string) Option {
 	return func(o *options) {
 		o.postMap["name"] = inName
@@ -148,3 +172,15 @@ func DefaultName() Option {
 		o.postMap["name"] = nil
 	}
 }
+
+func WithUnionGroupIds(inUnionGroupIds []string) Option {
+	return func(o *options) {
+		o.postMap["union_group_ids"] = inUnionGroupIds
+	}
+}
+
+func DefaultUnionGroupIds() Option {
+	return func(o *options) {
+		o.postMap["union_group_ids"] = nil
+	}
+}
@@ -30,6 +30,16 @@ func (r *Response) StatusCode() int {
 	return r.resp.StatusCode
 }
 
+// Deprecations returns the ids of the deprecated fields and behaviors used by
+// the request, as reported by the controller. The Warning headers of the
+// underlying HTTP response describe their replacements.
+func (r *Response) Deprecations() []string {
+	if r == nil || r.resp == nil {
+		return nil
+	}
+	return r.resp.Header.Values("X-Boundary-Deprecation")
+}
+
 func (r *Response) Decode(inStruct any) (*Error, error) {
 	if r == nil || r.resp == nil {
 		return nil, fmt.Errorf("nil response, cannot decode")
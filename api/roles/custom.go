@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package roles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+type SimulateResult struct {
+	Items    []*SimulatedPermission
+	response *api.Response
+}
+
+func (n SimulateResult) GetItems() []*SimulatedPermission {
+	return n.Items
+}
+
+func (n SimulateResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// Simulate returns the permissions that the given grant strings would newly
+// allow the users of the role, without changing the role.
+func (c *Client) Simulate(ctx context.Context, id string, grantStrings []string, opt ...Option) (*SimulateResult, error) {
+	if id == "" {
+		return nil, fmt.Errorf("empty id value passed into Simulate request")
+	}
+	if len(grantStrings) == 0 {
+		return nil, errors.New("empty grantStrings passed into Simulate request")
+	}
+
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	opts.postMap["grant_strings"] = grantStrings
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("roles/%s:simulate", url.PathEscape(id)), opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Simulate request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during Simulate call: %w", err)
+	}
+
+	target := new(SimulateResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding Simulate response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
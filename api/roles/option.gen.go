@@ -26,6 +26,7 @@ type options struct {
 	withSkipCurlOutput      bool
 	withFilter              string
 	withRecursive           bool
+	withLookupName          string
 }
 
 func getDefaultOptions() options {
@@ -52,6 +53,9 @@ func getOpts(opt ...Option) (options, []api.Option) {
 	if opts.withRecursive {
 		opts.queryMap["recursive"] = strconv.FormatBool(opts.withRecursive)
 	}
+	if opts.withLookupName != "" {
+		opts.queryMap["name"] = opts.withLookupName
+	}
 	return opts, apiOpts
 }
 
@@ -90,6 +94,16 @@ func WithRecursive(recurse bool) Option {
 	}
 }
 
+// WithLookupByName tells the API to read the resource with the given name in
+// the scope with the given ID instead of by ID. The ID passed to Read must be
+// empty or the name.
+func WithLookupByName(name, scopeId string) Option {
+	return func(o *options) {
+		o.withLookupName = name
+		o.queryMap["scope_id"] = scopeId
+	}
+}
+
 func WithDescription(inDescription string) Option {
 	return func(o *options) {
 		o.postMap["description"] = inDescription
@@ -102,6 +116,18 @@ func DefaultDescription() Option {
 	}
 }
 
+func WithGrantBoundary(inGrantBoundary bool) Option {
+	return func(o *options) {
+		o.postMap["grant_boundary"] = inGrantBoundary
+	}
+}
+
+func DefaultGrantBoundary() Option {
+	return func(o *options) {
+		o.postMap["grant_boundary"] = nil
+	}
+}
+
 func WithGrantScopeId(inGrantScopeId string) Option {
 	return func(o *options) {
 		o.postMap["grant_scope_id"] = inGrantScopeId
@@ -125,3 +151,27 @@ func DefaultName() Option {
 		o.postMap["name"] = nil
 	}
 }
+
+func WithProjectTemplate(inProjectTemplate bool) Option {
+	return func(o *options) {
+		o.postMap["project_template"] = inProjectTemplate
+	}
+}
+
+func DefaultProjectTemplate() Option {
+	return func(o *options) {
+		o.postMap["project_template"] = nil
+	}
+}
+
+func WithProjectTemplateSync(inProjectTemplateSync bool) Option {
+	return func(o *options) {
+		o.postMap["project_template_sync"] = inProjectTemplateSync
+	}
+}
+
+func DefaultProjectTemplateSync() Option {
+	return func(o *options) {
+		o.postMap["project_template_sync"] = nil
+	}
+}
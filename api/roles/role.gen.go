@@ -13,20 +13,24 @@ import (
 )
 
 type Role struct {
-	Id                string            `json:"id,omitempty"`
-	ScopeId           string            `json:"scope_id,omitempty"`
-	Scope             *scopes.ScopeInfo `json:"scope,omitempty"`
-	Name              string            `json:"name,omitempty"`
-	Description       string            `json:"description,omitempty"`
-	CreatedTime       time.Time         `json:"created_time,omitempty"`
-	UpdatedTime       time.Time         `json:"updated_time,omitempty"`
-	Version           uint32            `json:"version,omitempty"`
-	GrantScopeId      string            `json:"grant_scope_id,omitempty"`
-	PrincipalIds      []string          `json:"principal_ids,omitempty"`
-	Principals        []*Principal      `json:"principals,omitempty"`
-	GrantStrings      []string          `json:"grant_strings,omitempty"`
-	Grants            []*Grant          `json:"grants,omitempty"`
-	AuthorizedActions []string          `json:"authorized_actions,omitempty"`
+	Id                  string            `json:"id,omitempty"`
+	ScopeId             string            `json:"scope_id,omitempty"`
+	Scope               *scopes.ScopeInfo `json:"scope,omitempty"`
+	Name                string            `json:"name,omitempty"`
+	Description         string            `json:"description,omitempty"`
+	CreatedTime         time.Time         `json:"created_time,omitempty"`
+	UpdatedTime         time.Time         `json:"updated_time,omitempty"`
+	Version             uint32            `json:"version,omitempty"`
+	GrantScopeId        string            `json:"grant_scope_id,omitempty"`
+	PrincipalIds        []string          `json:"principal_ids,omitempty"`
+	Principals          []*Principal      `json:"principals,omitempty"`
+	GrantStrings        []string          `json:"grant_strings,omitempty"`
+	Grants              []*Grant          `json:"grants,omitempty"`
+	GrantBoundary       bool              `json:"grant_boundary,omitempty"`
+	ProjectTemplate     bool              `json:"project_template,omitempty"`
+	ProjectTemplateSync bool              `json:"project_template_sync,omitempty"`
+	TemplateRoleId      string            `json:"template_role_id,omitempty"`
+	AuthorizedActions   []string          `json:"authorized_actions,omitempty"`
 
 	response *api.Response
 }
@@ -136,6 +140,10 @@ func (c *Client) Create(ctx context.Context, scopeId string, opt ...Option) (*Ro
 }
 
 func (c *Client) Read(ctx context.Context, id string, opt ...Option) (*RoleReadResult, error) {
+	opts, apiOpts := getOpts(opt...)
+	if id == "" {
+		id = opts.withLookupName
+	}
 	if id == "" {
 		return nil, fmt.Errorf("empty id value passed into Read request")
 	}
@@ -143,8 +151,6 @@ func (c *Client) Read(ctx context.Context, id string, opt ...Option) (*RoleReadR
 		return nil, fmt.Errorf("nil client")
 	}
 
-	opts, apiOpts := getOpts(opt...)
-
 	req, err := c.client.NewRequest(ctx, "GET", fmt.Sprintf("roles/%s", url.PathEscape(id)), nil, apiOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("error creating Read request: %w", err)
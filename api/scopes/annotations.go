@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scopes
+
+// setAnnotation sets the annotation with the given name in the post map. A nil
+// value clears the annotation.
+func setAnnotation(o *options, name string, value interface{}) {
+	raw, ok := o.postMap["annotations"]
+	if !ok {
+		raw = interface{}(map[string]interface{}{})
+	}
+	val := raw.(map[string]interface{})
+	val[name] = value
+	o.postMap["annotations"] = val
+}
+
+// WithAnnotationOwner sets the person or team which owns the scope.
+func WithAnnotationOwner(inOwner string) Option {
+	return func(o *options) {
+		setAnnotation(o, "owner", inOwner)
+	}
+}
+
+// DefaultAnnotationOwner clears the owner of the scope.
+func DefaultAnnotationOwner() Option {
+	return func(o *options) {
+		setAnnotation(o, "owner", nil)
+	}
+}
+
+// WithAnnotationCostCenter sets the cost center the scope is charged to.
+func WithAnnotationCostCenter(inCostCenter string) Option {
+	return func(o *options) {
+		setAnnotation(o, "cost_center", inCostCenter)
+	}
+}
+
+// DefaultAnnotationCostCenter clears the cost center of the scope.
+func DefaultAnnotationCostCenter() Option {
+	return func(o *options) {
+		setAnnotation(o, "cost_center", nil)
+	}
+}
+
+// WithAnnotationTicketUrl sets the link to the ticket which tracks the scope.
+func WithAnnotationTicketUrl(inTicketUrl string) Option {
+	return func(o *options) {
+		setAnnotation(o, "ticket_url", inTicketUrl)
+	}
+}
+
+// DefaultAnnotationTicketUrl clears the ticket link of the scope.
+func DefaultAnnotationTicketUrl() Option {
+	return func(o *options) {
+		setAnnotation(o, "ticket_url", nil)
+	}
+}
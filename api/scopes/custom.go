@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"strings"
 
 	"github.com/hashicorp/boundary/api"
 )
@@ -54,6 +55,96 @@ func (n KeyVersionDestructionResult) GetResponse() *api.Response {
 	return n.response
 }
 
+type AuthorizedActionsResult struct {
+	AuthorizedActions map[string][]string `json:"authorized_actions,omitempty"`
+	response          *api.Response
+}
+
+func (n AuthorizedActionsResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// Allowed returns whether the result allows the action, or one of its
+// subactions, on resources of the resource type, either explicitly or through
+// wildcards.
+func (n AuthorizedActionsResult) Allowed(resourceType, action string) bool {
+	for _, typ := range []string{resourceType, "*"} {
+		for _, a := range n.AuthorizedActions[typ] {
+			if a == action || a == "*" || strings.HasPrefix(a, action+":") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type LoginMetadataReadResult struct {
+	Item     *LoginMetadata
+	response *api.Response
+}
+
+func (n LoginMetadataReadResult) GetItem() *LoginMetadata {
+	return n.Item
+}
+
+func (n LoginMetadataReadResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// DiscoveredDefaults is the scope and auth method suggested to clients which
+// authenticate without specifying them.
+type DiscoveredDefaults struct {
+	ScopeId      string `json:"scope_id,omitempty"`
+	AuthMethodId string `json:"auth_method_id,omitempty"`
+}
+
+type DiscoverDefaultsResult struct {
+	Item     *DiscoveredDefaults
+	response *api.Response
+}
+
+func (n DiscoverDefaultsResult) GetItem() *DiscoveredDefaults {
+	return n.Item
+}
+
+func (n DiscoverDefaultsResult) GetResponse() *api.Response {
+	return n.response
+}
+
+type EventSubscriptionAddResult struct {
+	Item     *EventSubscription
+	response *api.Response
+}
+
+func (n EventSubscriptionAddResult) GetItem() *EventSubscription {
+	return n.Item
+}
+
+func (n EventSubscriptionAddResult) GetResponse() *api.Response {
+	return n.response
+}
+
+type EventSubscriptionListResult struct {
+	Items    []*EventSubscription
+	response *api.Response
+}
+
+func (n EventSubscriptionListResult) GetItems() []*EventSubscription {
+	return n.Items
+}
+
+func (n EventSubscriptionListResult) GetResponse() *api.Response {
+	return n.response
+}
+
+type EventSubscriptionRemoveResult struct {
+	response *api.Response
+}
+
+func (n EventSubscriptionRemoveResult) GetResponse() *api.Response {
+	return n.response
+}
+
 func (c *Client) ListKeys(ctx context.Context, scopeId string, opt ...Option) (*KeyListResult, error) {
 	if scopeId == "" {
 		return nil, fmt.Errorf("empty scopeId value passed into ListKeys request")
@@ -94,6 +185,110 @@ func (c *Client) ListKeys(ctx context.Context, scopeId string, opt ...Option) (*
 	return target, nil
 }
 
+// ListAuthorizedActions returns the actions the caller is allowed to perform
+// on each resource type in the scope.
+func (c *Client) ListAuthorizedActions(ctx context.Context, scopeId string, opt ...Option) (*AuthorizedActionsResult, error) {
+	if scopeId == "" {
+		return nil, fmt.Errorf("empty scopeId value passed into ListAuthorizedActions request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "GET", "scopes/"+url.PathEscape(scopeId)+":list-authorized-actions", nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ListAuthorizedActions request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ListAuthorizedActions call: %w", err)
+	}
+
+	target := new(AuthorizedActionsResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ListAuthorizedActions response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
+// ReadLoginMetadata returns the information shown to users before they log
+// in to the scope. It is granted to the anonymous user by default, so the
+// client does not need a token.
+func (c *Client) ReadLoginMetadata(ctx context.Context, scopeId string, opt ...Option) (*LoginMetadataReadResult, error) {
+	if scopeId == "" {
+		return nil, fmt.Errorf("empty scopeId value passed into ReadLoginMetadata request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "GET", "scopes/"+url.PathEscape(scopeId)+":read-login-metadata", nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ReadLoginMetadata request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ReadLoginMetadata call: %w", err)
+	}
+
+	target := new(LoginMetadataReadResult)
+	target.Item = new(LoginMetadata)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ReadLoginMetadata response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
+// DiscoverDefaults returns the scope and auth method the controller suggests
+// to clients which authenticate without specifying them. Like
+// ReadLoginMetadata it is granted to the anonymous user by default, so the
+// client does not need a token.
+func (c *Client) DiscoverDefaults(ctx context.Context, opt ...Option) (*DiscoverDefaultsResult, error) {
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "GET", "scopes:discover-defaults", nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DiscoverDefaults request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during DiscoverDefaults call: %w", err)
+	}
+
+	target := new(DiscoverDefaultsResult)
+	target.Item = new(DiscoveredDefaults)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding DiscoverDefaults response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
 func (c *Client) RotateKeys(ctx context.Context, scopeId string, rewrapKeys bool, opt ...Option) (*KeysRotateResult, error) {
 	if scopeId == "" {
 		return nil, fmt.Errorf("empty scopeId value passed into RotateKeys request")
@@ -215,3 +410,121 @@ func (c *Client) DestroyKeyVersion(ctx context.Context, scopeId string, keyVersi
 	target.response = resp
 	return target, nil
 }
+
+// AddEventSubscription subscribes the caller to the changes of the resource
+// with the given id, or of all the resources of the given type in the scope.
+// Exactly one of resourceId and resourceType must be set. If webhookUrl is
+// set, notifications are also posted to it.
+func (c *Client) AddEventSubscription(ctx context.Context, scopeId, resourceId, resourceType, webhookUrl string, opt ...Option) (*EventSubscriptionAddResult, error) {
+	if scopeId == "" {
+		return nil, fmt.Errorf("empty scopeId value passed into AddEventSubscription request")
+	}
+	if resourceId == "" && resourceType == "" {
+		return nil, fmt.Errorf("empty resourceId and resourceType values passed into AddEventSubscription request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+	if resourceId != "" {
+		opts.postMap["resource_id"] = resourceId
+	}
+	if resourceType != "" {
+		opts.postMap["type"] = resourceType
+	}
+	if webhookUrl != "" {
+		opts.postMap["webhook_url"] = webhookUrl
+	}
+
+	req, err := c.client.NewRequest(ctx, "POST", "scopes/"+url.PathEscape(scopeId)+":add-event-subscription", opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating AddEventSubscription request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during AddEventSubscription call: %w", err)
+	}
+
+	target := new(EventSubscriptionAddResult)
+	target.Item = new(EventSubscription)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding AddEventSubscription response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
+// ListEventSubscriptions lists the caller's event subscriptions in the scope.
+func (c *Client) ListEventSubscriptions(ctx context.Context, scopeId string, opt ...Option) (*EventSubscriptionListResult, error) {
+	if scopeId == "" {
+		return nil, fmt.Errorf("empty scopeId value passed into ListEventSubscriptions request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "GET", "scopes/"+url.PathEscape(scopeId)+":list-event-subscriptions", nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ListEventSubscriptions request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ListEventSubscriptions call: %w", err)
+	}
+
+	target := new(EventSubscriptionListResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ListEventSubscriptions response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
+// RemoveEventSubscription removes one of the caller's event subscriptions in
+// the scope.
+func (c *Client) RemoveEventSubscription(ctx context.Context, scopeId, subscriptionId string, opt ...Option) (*EventSubscriptionRemoveResult, error) {
+	if scopeId == "" {
+		return nil, fmt.Errorf("empty scopeId value passed into RemoveEventSubscription request")
+	}
+	if subscriptionId == "" {
+		return nil, fmt.Errorf("empty subscriptionId value passed into RemoveEventSubscription request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+	opts.postMap["subscription_id"] = subscriptionId
+
+	req, err := c.client.NewRequest(ctx, "POST", "scopes/"+url.PathEscape(scopeId)+":remove-event-subscription", opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating RemoveEventSubscription request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during RemoveEventSubscription call: %w", err)
+	}
+
+	apiErr, err := resp.Decode(nil)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding RemoveEventSubscription response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	return &EventSubscriptionRemoveResult{response: resp}, nil
+}
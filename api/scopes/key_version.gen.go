@@ -6,7 +6,8 @@ import (
 )
 
 type KeyVersion struct {
-	Id          string    `json:"id,omitempty"`
-	Version     uint32    `json:"version,omitempty"`
-	CreatedTime time.Time `json:"created_time,omitempty"`
+	Id                            string    `json:"id,omitempty"`
+	Version                       uint32    `json:"version,omitempty"`
+	CreatedTime                   time.Time `json:"created_time,omitempty"`
+	SessionAuthorizationPublicKey []byte    `json:"session_authorization_public_key,omitempty"`
 }
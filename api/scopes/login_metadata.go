@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scopes
+
+// setLoginMetadata sets the login metadata field with the given name in the
+// post map. A nil value clears the field.
+func setLoginMetadata(o *options, name string, value interface{}) {
+	raw, ok := o.postMap["login_metadata"]
+	if !ok {
+		raw = interface{}(map[string]interface{}{})
+	}
+	val := raw.(map[string]interface{})
+	val[name] = value
+	o.postMap["login_metadata"] = val
+}
+
+// WithLoginDisplayName sets the name of the scope shown to users logging in
+// to it.
+func WithLoginDisplayName(inDisplayName string) Option {
+	return func(o *options) {
+		setLoginMetadata(o, "display_name", inDisplayName)
+	}
+}
+
+// DefaultLoginDisplayName clears the login display name of the scope.
+func DefaultLoginDisplayName() Option {
+	return func(o *options) {
+		setLoginMetadata(o, "display_name", nil)
+	}
+}
+
+// WithLoginSupportContact sets the contact for users having trouble logging
+// in to the scope.
+func WithLoginSupportContact(inSupportContact string) Option {
+	return func(o *options) {
+		setLoginMetadata(o, "support_contact", inSupportContact)
+	}
+}
+
+// DefaultLoginSupportContact clears the login support contact of the scope.
+func DefaultLoginSupportContact() Option {
+	return func(o *options) {
+		setLoginMetadata(o, "support_contact", nil)
+	}
+}
+
+// WithLoginMessageOfTheDay sets the message shown to users logging in to the
+// scope.
+func WithLoginMessageOfTheDay(inMessageOfTheDay string) Option {
+	return func(o *options) {
+		setLoginMetadata(o, "message_of_the_day", inMessageOfTheDay)
+	}
+}
+
+// DefaultLoginMessageOfTheDay clears the login message of the day of the
+// scope.
+func DefaultLoginMessageOfTheDay() Option {
+	return func(o *options) {
+		setLoginMetadata(o, "message_of_the_day", nil)
+	}
+}
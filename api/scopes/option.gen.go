@@ -27,6 +27,7 @@ type options struct {
 	withSkipCurlOutput      bool
 	withFilter              string
 	withRecursive           bool
+	withLookupName          string
 }
 
 func getDefaultOptions() options {
@@ -53,6 +54,9 @@ func getOpts(opt ...Option) (options, []api.Option) {
 	if opts.withRecursive {
 		opts.queryMap["recursive"] = strconv.FormatBool(opts.withRecursive)
 	}
+	if opts.withLookupName != "" {
+		opts.queryMap["name"] = opts.withLookupName
+	}
 	return opts, apiOpts
 }
 
@@ -91,6 +95,52 @@ func WithRecursive(recurse bool) Option {
 	}
 }
 
+// WithLookupByName tells the API to read the resource with the given name in
+// the scope with the given ID instead of by ID. The ID passed to Read must be
+// empty or the name.
+func WithLookupByName(name, scopeId string) Option {
+	return func(o *options) {
+		o.withLookupName = name
+		o.queryMap["scope_id"] = scopeId
+	}
+}
+
+func WithAuthTokenTimeToLiveSeconds(inAuthTokenTimeToLiveSeconds uint32) Option {
+	return func(o *options) {
+		o.postMap["auth_token_time_to_live_seconds"] = inAuthTokenTimeToLiveSeconds
+	}
+}
+
+func DefaultAuthTokenTimeToLiveSeconds() Option {
+	return func(o *options) {
+		o.postMap["auth_token_time_to_live_seconds"] = nil
+	}
+}
+
+func WithAuthTokenTimeToStaleSeconds(inAuthTokenTimeToStaleSeconds uint32) Option {
+	return func(o *options) {
+		o.postMap["auth_token_time_to_stale_seconds"] = inAuthTokenTimeToStaleSeconds
+	}
+}
+
+func DefaultAuthTokenTimeToStaleSeconds() Option {
+	return func(o *options) {
+		o.postMap["auth_token_time_to_stale_seconds"] = nil
+	}
+}
+
+func WithDeletionProtected(inDeletionProtected bool) Option {
+	return func(o *options) {
+		o.postMap["deletion_protected"] = inDeletionProtected
+	}
+}
+
+func DefaultDeletionProtected() Option {
+	return func(o *options) {
+		o.postMap["deletion_protected"] = nil
+	}
+}
+
 func WithDescription(inDescription string) Option {
 	return func(o *options) {
 		o.postMap["description"] = inDescription
@@ -103,6 +153,18 @@ func DefaultDescription() Option {
 	}
 }
 
+func WithIsolated(inIsolated bool) Option {
+	return func(o *options) {
+		o.postMap["isolated"] = inIsolated
+	}
+}
+
+func DefaultIsolated() Option {
+	return func(o *options) {
+		o.postMap["isolated"] = nil
+	}
+}
+
 func WithName(inName string) Option {
 	return func(o *options) {
 		o.postMap["name"] = inName
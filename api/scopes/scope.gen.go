@@ -22,6 +22,12 @@ type Scope struct {
 	Version                     uint32              `json:"version,omitempty"`
 	Type                        string              `json:"type,omitempty"`
 	PrimaryAuthMethodId         string              `json:"primary_auth_method_id,omitempty"`
+	AuthTokenTimeToLiveSeconds  uint32              `json:"auth_token_time_to_live_seconds,omitempty"`
+	AuthTokenTimeToStaleSeconds uint32              `json:"auth_token_time_to_stale_seconds,omitempty"`
+	Annotations                 *Annotations        `json:"annotations,omitempty"`
+	LoginMetadata               *LoginMetadata      `json:"login_metadata,omitempty"`
+	Isolated                    bool                `json:"isolated,omitempty"`
+	DeletionProtected           bool                `json:"deletion_protected,omitempty"`
 	AuthorizedActions           []string            `json:"authorized_actions,omitempty"`
 	AuthorizedCollectionActions map[string][]string `json:"authorized_collection_actions,omitempty"`
 
@@ -133,6 +139,10 @@ func (c *Client) Create(ctx context.Context, scopeId string, opt ...Option) (*Sc
 }
 
 func (c *Client) Read(ctx context.Context, id string, opt ...Option) (*ScopeReadResult, error) {
+	opts, apiOpts := getOpts(opt...)
+	if id == "" {
+		id = opts.withLookupName
+	}
 	if id == "" {
 		return nil, fmt.Errorf("empty id value passed into Read request")
 	}
@@ -140,8 +150,6 @@ func (c *Client) Read(ctx context.Context, id string, opt ...Option) (*ScopeRead
 		return nil, fmt.Errorf("nil client")
 	}
 
-	opts, apiOpts := getOpts(opt...)
-
 	req, err := c.client.NewRequest(ctx, "GET", fmt.Sprintf("scopes/%s", url.PathEscape(id)), nil, apiOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("error creating Read request: %w", err)
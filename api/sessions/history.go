@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sessions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/scopes"
+)
+
+// ConnectionHistory is the history of a connection of a terminated session.
+type ConnectionHistory struct {
+	Id              string    `json:"id,omitempty"`
+	ConnectedTime   time.Time `json:"connected_time,omitempty"`
+	ClosedTime      time.Time `json:"closed_time,omitempty"`
+	DurationSeconds uint32    `json:"duration_seconds,omitempty"`
+	BytesUp         int64     `json:"bytes_up,omitempty"`
+	BytesDown       int64     `json:"bytes_down,omitempty"`
+	ClosedReason    string    `json:"closed_reason,omitempty"`
+}
+
+// SessionHistory is the history of a terminated session of the requesting
+// user.
+type SessionHistory struct {
+	Id                string               `json:"id,omitempty"`
+	TargetId          string               `json:"target_id,omitempty"`
+	Scope             *scopes.ScopeInfo    `json:"scope,omitempty"`
+	ScopeId           string               `json:"scope_id,omitempty"`
+	CreatedTime       time.Time            `json:"created_time,omitempty"`
+	TerminatedTime    time.Time            `json:"terminated_time,omitempty"`
+	DurationSeconds   uint32               `json:"duration_seconds,omitempty"`
+	TerminationReason string               `json:"termination_reason,omitempty"`
+	ConnectionCount   uint32               `json:"connection_count,omitempty"`
+	BytesUp           int64                `json:"bytes_up,omitempty"`
+	BytesDown         int64                `json:"bytes_down,omitempty"`
+	Connections       []*ConnectionHistory `json:"connections,omitempty"`
+}
+
+type SessionHistoryListResult struct {
+	Items    []*SessionHistory
+	response *api.Response
+}
+
+func (n SessionHistoryListResult) GetItems() []*SessionHistory {
+	return n.Items
+}
+
+func (n SessionHistoryListResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// ListMySessions returns the history of the terminated sessions of the
+// requesting user in the scope, most recently terminated first. The history
+// is kept after the sessions are deleted. Supports the WithRecursive and
+// WithFilter options.
+func (c *Client) ListMySessions(ctx context.Context, scopeId string, opt ...Option) (*SessionHistoryListResult, error) {
+	if scopeId == "" {
+		return nil, fmt.Errorf("empty scopeId value passed into ListMySessions request")
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+	opts.queryMap["scope_id"] = scopeId
+
+	req, err := c.client.NewRequest(ctx, "GET", "sessions:my-sessions", nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ListMySessions request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ListMySessions call: %w", err)
+	}
+
+	target := new(SessionHistoryListResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ListMySessions response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
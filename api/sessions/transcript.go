@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sessions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+// Transcript is the terminal transcript captured by the client of a session.
+type Transcript struct {
+	Transcript   []byte    `json:"transcript,omitempty"`
+	Truncated    bool      `json:"truncated,omitempty"`
+	UploadedTime time.Time `json:"uploaded_time,omitempty"`
+}
+
+type TranscriptReadResult struct {
+	Item     *Transcript
+	response *api.Response
+}
+
+func (n TranscriptReadResult) GetItem() *Transcript {
+	return n.Item
+}
+
+func (n TranscriptReadResult) GetResponse() *api.Response {
+	return n.response
+}
+
+type TranscriptUploadResult struct {
+	response *api.Response
+}
+
+func (n TranscriptUploadResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// UploadTranscript uploads the terminal transcript captured by the client of
+// the session. Only the user of the session can upload its transcript, and
+// only once. Truncated indicates that the client stopped capturing because the
+// transcript reached the maximum size.
+func (c *Client) UploadTranscript(ctx context.Context, sessionId string, transcript []byte, truncated bool, opt ...Option) (*TranscriptUploadResult, error) {
+	if sessionId == "" {
+		return nil, fmt.Errorf("empty sessionId value passed into UploadTranscript request")
+	}
+	if len(transcript) == 0 {
+		return nil, fmt.Errorf("empty transcript value passed into UploadTranscript request")
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	opts.postMap["transcript"] = transcript
+	if truncated {
+		opts.postMap["truncated"] = truncated
+	}
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("sessions/%s:upload-transcript", url.PathEscape(sessionId)), opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating UploadTranscript request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during UploadTranscript call: %w", err)
+	}
+
+	apiErr, err := resp.Decode(nil)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding UploadTranscript response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	return &TranscriptUploadResult{response: resp}, nil
+}
+
+// ReadTranscript returns the terminal transcript uploaded by the client of
+// the session. Transcripts can be read after the session is deleted.
+func (c *Client) ReadTranscript(ctx context.Context, sessionId string, opt ...Option) (*TranscriptReadResult, error) {
+	if sessionId == "" {
+		return nil, fmt.Errorf("empty sessionId value passed into ReadTranscript request")
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "GET", fmt.Sprintf("sessions/%s:read-transcript", url.PathEscape(sessionId)), nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ReadTranscript request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ReadTranscript call: %w", err)
+	}
+
+	target := new(TranscriptReadResult)
+	target.Item = new(Transcript)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ReadTranscript response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
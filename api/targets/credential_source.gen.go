@@ -8,4 +8,6 @@ type CredentialSource struct {
 	CredentialStoreId string `json:"credential_store_id,omitempty"`
 	Type              string `json:"type,omitempty"`
 	CredentialType    string `json:"credential_type,omitempty"`
+	Exclusive         bool   `json:"exclusive,omitempty"`
+	Priority          uint32 `json:"priority,omitempty"`
 }
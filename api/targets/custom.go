@@ -78,3 +78,286 @@ func (c *Client) AuthorizeSession(ctx context.Context, targetId string, opt ...O
 	target.response = resp
 	return target, nil
 }
+
+type AvailableHostsResult struct {
+	Items    []*AvailableHost
+	response *api.Response
+}
+
+func (n AvailableHostsResult) GetItems() []*AvailableHost {
+	return n.Items
+}
+
+func (n AvailableHostsResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// ListAvailableHosts returns the hosts a session authorized against the target
+// can connect to. Any of their IDs can be passed to AuthorizeSession using
+// WithHostId.
+func (c *Client) ListAvailableHosts(ctx context.Context, targetId string, opt ...Option) (*AvailableHostsResult, error) {
+	opts, apiOpts := getOpts(opt...)
+
+	if targetId == "" {
+		if opts.postMap["name"] == nil {
+			return nil, fmt.Errorf("empty target name provided to ListAvailableHosts request")
+		}
+		scopeIdEmpty := opts.postMap["scope_id"] == nil
+		scopeNameEmpty := opts.postMap["scope_name"] == nil
+		switch {
+		case scopeIdEmpty && scopeNameEmpty:
+			return nil, fmt.Errorf("empty targetId value and no combination of target name and scope ID/name passed into ListAvailableHosts request")
+		case !scopeIdEmpty && !scopeNameEmpty:
+			return nil, fmt.Errorf("both scope ID and scope name cannot be provided in ListAvailableHosts request")
+		default:
+			// Name is not empty and only one of scope ID or name set. As this
+			// is a GET request, the lookup parameters are sent in the query.
+			targetId = opts.postMap["name"].(string)
+			for _, k := range []string{"name", "scope_id", "scope_name"} {
+				if v, ok := opts.postMap[k].(string); ok {
+					opts.queryMap[k] = v
+				}
+			}
+		}
+	}
+
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	req, err := c.client.NewRequest(ctx, "GET", fmt.Sprintf("targets/%s:list-available-hosts", url.PathEscape(targetId)), nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ListAvailableHosts request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ListAvailableHosts call: %w", err)
+	}
+
+	target := new(AvailableHostsResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ListAvailableHosts response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
+type FavoriteResult struct {
+	response *api.Response
+}
+
+func (n FavoriteResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// AddFavorite adds the target to the favorite targets of the user of the auth
+// token. Favorite targets can be listed by passing WithFavorites to List.
+func (c *Client) AddFavorite(ctx context.Context, targetId string, opt ...Option) (*FavoriteResult, error) {
+	return c.favorite(ctx, "AddFavorite", "add-favorite", targetId, opt...)
+}
+
+// RemoveFavorite removes the target from the favorite targets of the user of
+// the auth token.
+func (c *Client) RemoveFavorite(ctx context.Context, targetId string, opt ...Option) (*FavoriteResult, error) {
+	return c.favorite(ctx, "RemoveFavorite", "remove-favorite", targetId, opt...)
+}
+
+func (c *Client) favorite(ctx context.Context, name, customAction, targetId string, opt ...Option) (*FavoriteResult, error) {
+	if targetId == "" {
+		return nil, fmt.Errorf("empty targetId value passed into %s request", name)
+	}
+
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("targets/%s:%s", url.PathEscape(targetId), customAction), map[string]any{}, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating %s request: %w", name, err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during %s call: %w", name, err)
+	}
+
+	apiErr, err := resp.Decode(nil)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding %s response: %w", name, err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	return &FavoriteResult{response: resp}, nil
+}
+
+type SshCertificateAuthorityResult struct {
+	Item     *SshCertificateAuthority
+	response *api.Response
+}
+
+func (n SshCertificateAuthorityResult) GetItem() *SshCertificateAuthority {
+	return n.Item
+}
+
+func (n SshCertificateAuthorityResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// EnableSshCertificateAuthority enables the SSH certificate authority of the
+// target, which issues a client certificate for username for each session
+// authorized against the target. Enabling the certificate authority of a
+// target which already has one updates its username and keeps its key.
+func (c *Client) EnableSshCertificateAuthority(ctx context.Context, targetId, username string, opt ...Option) (*SshCertificateAuthorityResult, error) {
+	if targetId == "" {
+		return nil, fmt.Errorf("empty targetId value passed into EnableSshCertificateAuthority request")
+	}
+	if username == "" {
+		return nil, fmt.Errorf("empty username value passed into EnableSshCertificateAuthority request")
+	}
+
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+	opts.postMap["username"] = username
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("targets/%s:enable-ssh-certificate-authority", url.PathEscape(targetId)), opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating EnableSshCertificateAuthority request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during EnableSshCertificateAuthority call: %w", err)
+	}
+
+	target := new(SshCertificateAuthorityResult)
+	target.Item = new(SshCertificateAuthority)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding EnableSshCertificateAuthority response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
+type DisableSshCertificateAuthorityResult struct {
+	response *api.Response
+}
+
+func (n DisableSshCertificateAuthorityResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// DisableSshCertificateAuthority deletes the SSH certificate authority of the
+// target. Disabling the certificate authority of a target which does not have
+// one is not an error.
+func (c *Client) DisableSshCertificateAuthority(ctx context.Context, targetId string, opt ...Option) (*DisableSshCertificateAuthorityResult, error) {
+	if targetId == "" {
+		return nil, fmt.Errorf("empty targetId value passed into DisableSshCertificateAuthority request")
+	}
+
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("targets/%s:disable-ssh-certificate-authority", url.PathEscape(targetId)), map[string]any{}, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DisableSshCertificateAuthority request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during DisableSshCertificateAuthority call: %w", err)
+	}
+
+	apiErr, err := resp.Decode(nil)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding DisableSshCertificateAuthority response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	return &DisableSshCertificateAuthorityResult{response: resp}, nil
+}
+
+type SshHostCertificateResult struct {
+	Item     *SshHostCertificate
+	response *api.Response
+}
+
+func (n SshHostCertificateResult) GetItem() *SshHostCertificate {
+	return n.Item
+}
+
+func (n SshHostCertificateResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// SignSshHostKey signs publicKey, a host key in the authorized_keys format,
+// with the SSH certificate authority of the target for the host names and
+// addresses in principals. The certificate does not expire unless
+// WithValidSeconds is used.
+func (c *Client) SignSshHostKey(ctx context.Context, targetId, publicKey string, principals []string, opt ...Option) (*SshHostCertificateResult, error) {
+	if targetId == "" {
+		return nil, fmt.Errorf("empty targetId value passed into SignSshHostKey request")
+	}
+	if publicKey == "" {
+		return nil, fmt.Errorf("empty publicKey value passed into SignSshHostKey request")
+	}
+	if len(principals) == 0 {
+		return nil, fmt.Errorf("empty principals value passed into SignSshHostKey request")
+	}
+
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+	opts.postMap["public_key"] = publicKey
+	opts.postMap["principals"] = principals
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("targets/%s:sign-ssh-host-key", url.PathEscape(targetId)), opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SignSshHostKey request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during SignSshHostKey call: %w", err)
+	}
+
+	target := new(SshHostCertificateResult)
+	target.Item = new(SshHostCertificate)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding SignSshHostKey response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
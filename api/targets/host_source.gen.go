@@ -4,4 +4,5 @@ package targets
 type HostSource struct {
 	Id            string `json:"id,omitempty"`
 	HostCatalogId string `json:"host_catalog_id,omitempty"`
+	Priority      uint32 `json:"priority,omitempty"`
 }
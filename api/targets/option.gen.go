@@ -4,6 +4,7 @@
 package targets
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -26,6 +27,7 @@ type options struct {
 	withSkipCurlOutput      bool
 	withFilter              string
 	withRecursive           bool
+	withLookupName          string
 }
 
 func getDefaultOptions() options {
@@ -52,6 +54,9 @@ func getOpts(opt ...Option) (options, []api.Option) {
 	if opts.withRecursive {
 		opts.queryMap["recursive"] = strconv.FormatBool(opts.withRecursive)
 	}
+	if opts.withLookupName != "" {
+		opts.queryMap["name"] = opts.withLookupName
+	}
 	return opts, apiOpts
 }
 
@@ -90,6 +95,16 @@ func WithRecursive(recurse bool) Option {
 	}
 }
 
+// WithLookupByName tells the API to read the resource with the given name in
+// the scope with the given ID instead of by ID. The ID passed to Read must be
+// empty or the name.
+func WithLookupByName(name, scopeId string) Option {
+	return func(o *options) {
+		o.withLookupName = name
+		o.queryMap["scope_id"] = scopeId
+	}
+}
+
 func WithAddress(inAddress string) Option {
 	return func(o *options) {
 		o.postMap["address"] = inAddress
@@ -126,6 +141,18 @@ func DefaultAttributes() Option {
 	}
 }
 
+func WithBanner(inBanner string) Option {
+	return func(o *options) {
+		o.postMap["banner"] = inBanner
+	}
+}
+
+func DefaultBanner() Option {
+	return func(o *options) {
+		o.postMap["banner"] = nil
+	}
+}
+
 func WithBrokeredCredentialSourceIds(inBrokeredCredentialSourceIds []string) Option {
 	return func(o *options) {
 		o.postMap["brokered_credential_source_ids"] = inBrokeredCredentialSourceIds
@@ -186,6 +213,18 @@ func DefaultTcpTargetDefaultPort() Option {
 	}
 }
 
+func WithDeletionProtected(inDeletionProtected bool) Option {
+	return func(o *options) {
+		o.postMap["deletion_protected"] = inDeletionProtected
+	}
+}
+
+func DefaultDeletionProtected() Option {
+	return func(o *options) {
+		o.postMap["deletion_protected"] = nil
+	}
+}
+
 func WithDescription(inDescription string) Option {
 	return func(o *options) {
 		o.postMap["description"] = inDescription
@@ -210,6 +249,48 @@ func DefaultEgressWorkerFilter() Option {
 	}
 }
 
+func WithExclusiveCredentialSourceIds(inExclusiveCredentialSourceIds []string) Option {
+	return func(o *options) {
+		o.postMap["exclusive_credential_source_ids"] = inExclusiveCredentialSourceIds
+	}
+}
+
+func DefaultExclusiveCredentialSourceIds() Option {
+	return func(o *options) {
+		o.postMap["exclusive_credential_source_ids"] = nil
+	}
+}
+
+func WithFallbackCredentialSourceIds(inFallbackCredentialSourceIds []string) Option {
+	return func(o *options) {
+		o.postMap["fallback_credential_source_ids"] = inFallbackCredentialSourceIds
+	}
+}
+
+func DefaultFallbackCredentialSourceIds() Option {
+	return func(o *options) {
+		o.postMap["fallback_credential_source_ids"] = nil
+	}
+}
+
+func WithFallbackHostSourceIds(inFallbackHostSourceIds []string) Option {
+	return func(o *options) {
+		o.postMap["fallback_host_source_ids"] = inFallbackHostSourceIds
+	}
+}
+
+func DefaultFallbackHostSourceIds() Option {
+	return func(o *options) {
+		o.postMap["fallback_host_source_ids"] = nil
+	}
+}
+
+func WithFavorites(inFavorites bool) Option {
+	return func(o *options) {
+		o.queryMap["favorites"] = fmt.Sprintf("%v", inFavorites)
+	}
+}
+
 func WithHostId(inHostId string) Option {
 	return func(o *options) {
 		o.postMap["host_id"] = inHostId
@@ -240,6 +321,18 @@ func DefaultInjectedApplicationCredentialSourceIds() Option {
 	}
 }
 
+func WithMaxAuthAgeSeconds(inMaxAuthAgeSeconds uint32) Option {
+	return func(o *options) {
+		o.postMap["max_auth_age_seconds"] = inMaxAuthAgeSeconds
+	}
+}
+
+func DefaultMaxAuthAgeSeconds() Option {
+	return func(o *options) {
+		o.postMap["max_auth_age_seconds"] = nil
+	}
+}
+
 func WithName(inName string) Option {
 	return func(o *options) {
 		o.postMap["name"] = inName
@@ -252,6 +345,12 @@ func DefaultName() Option {
 	}
 }
 
+func WithRecent(inRecent bool) Option {
+	return func(o *options) {
+		o.queryMap["recent"] = fmt.Sprintf("%v", inRecent)
+	}
+}
+
 func WithScopeId(inScopeId string) Option {
 	return func(o *options) {
 		o.postMap["scope_id"] = inScopeId
@@ -288,6 +387,12 @@ func DefaultSessionMaxSeconds() Option {
 	}
 }
 
+func WithValidSeconds(inValidSeconds uint32) Option {
+	return func(o *options) {
+		o.postMap["valid_seconds"] = inValidSeconds
+	}
+}
+
 func WithWorkerFilter(inWorkerFilter string) Option {
 	return func(o *options) {
 		o.postMap["worker_filter"] = inWorkerFilter
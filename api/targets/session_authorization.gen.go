@@ -19,4 +19,5 @@ type SessionAuthorization struct {
 	AuthorizationToken string               `json:"authorization_token,omitempty"`
 	Endpoint           string               `json:"endpoint,omitempty"`
 	Credentials        []*SessionCredential `json:"credentials,omitempty"`
+	Banner             string               `json:"banner,omitempty"`
 }
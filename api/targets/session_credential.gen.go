@@ -5,4 +5,5 @@ type SessionCredential struct {
 	CredentialSource *CredentialSource      `json:"credential_source,omitempty"`
 	Secret           *SessionSecret         `json:"secret,omitempty"`
 	Credential       map[string]interface{} `json:"credential,omitempty"`
+	SecretMetadata   map[string]interface{} `json:"secret_metadata,omitempty"`
 }
@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package targets
+
+// WithTags sets the tags of the target, replacing any existing tags. Grants can
+// select targets by their tags.
+func WithTags(inTags map[string][]string) Option {
+	return func(o *options) {
+		o.postMap["tags"] = inTags
+	}
+}
+
+// DefaultTags clears the tags of the target.
+func DefaultTags() Option {
+	return func(o *options) {
+		o.postMap["tags"] = nil
+	}
+}
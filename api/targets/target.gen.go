@@ -38,6 +38,11 @@ type Target struct {
 	Attributes                             map[string]interface{} `json:"attributes,omitempty"`
 	AuthorizedActions                      []string               `json:"authorized_actions,omitempty"`
 	Address                                string                 `json:"address,omitempty"`
+	MaxAuthAgeSeconds                      uint32                 `json:"max_auth_age_seconds,omitempty"`
+	Banner                                 string                 `json:"banner,omitempty"`
+	Annotations                            *scopes.Annotations    `json:"annotations,omitempty"`
+	DeletionProtected                      bool                   `json:"deletion_protected,omitempty"`
+	Tags                                   map[string][]string    `json:"tags,omitempty"`
 
 	response *api.Response
 }
@@ -152,6 +157,10 @@ func (c *Client) Create(ctx context.Context, resourceType string, scopeId string
 }
 
 func (c *Client) Read(ctx context.Context, id string, opt ...Option) (*TargetReadResult, error) {
+	opts, apiOpts := getOpts(opt...)
+	if id == "" {
+		id = opts.withLookupName
+	}
 	if id == "" {
 		return nil, fmt.Errorf("empty id value passed into Read request")
 	}
@@ -159,8 +168,6 @@ func (c *Client) Read(ctx context.Context, id string, opt ...Option) (*TargetRea
 		return nil, fmt.Errorf("nil client")
 	}
 
-	opts, apiOpts := getOpts(opt...)
-
 	req, err := c.client.NewRequest(ctx, "GET", fmt.Sprintf("targets/%s", url.PathEscape(id)), nil, apiOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("error creating Read request: %w", err)
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package targets
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/mr-tron/base58"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Field numbers of the session authorization data encoded in authorization
+// tokens. They must match the SessionAuthorizationData message of the
+// controller API.
+const (
+	sadSessionIdField    protowire.Number = 10
+	sadTargetIdField     protowire.Number = 20
+	sadTypeField         protowire.Number = 80
+	sadHostIdField       protowire.Number = 140
+	sadEndpointField     protowire.Number = 141
+	sadSigningKeyIdField protowire.Number = 170
+	sadSignatureField    protowire.Number = 180
+)
+
+// VerifiedSessionAuthorization contains the information read from an
+// authorization token whose signature has been verified.
+type VerifiedSessionAuthorization struct {
+	SessionId    string
+	TargetId     string
+	Type         string
+	HostId       string
+	Endpoint     string
+	SigningKeyId string
+}
+
+// SessionAuthorizationPublicKeys returns the public keys verifying
+// authorization tokens, indexed by key version id, from the keys of a project
+// as returned by the ListKeys call of the scopes client.
+func SessionAuthorizationPublicKeys(keys []*scopes.Key) map[string]ed25519.PublicKey {
+	ret := make(map[string]ed25519.PublicKey)
+	for _, k := range keys {
+		if k == nil || k.Purpose != "sessions" {
+			continue
+		}
+		for _, v := range k.Versions {
+			if v == nil || len(v.SessionAuthorizationPublicKey) != ed25519.PublicKeySize {
+				continue
+			}
+			ret[v.Id] = ed25519.PublicKey(v.SessionAuthorizationPublicKey)
+		}
+	}
+	return ret
+}
+
+// VerifySessionAuthorizationToken verifies the signature of an authorization
+// token returned by AuthorizeSession, without contacting the controller. The
+// token must have been signed with the key derived from one of the given
+// sessions key versions, indexed by key version id; see
+// SessionAuthorizationPublicKeys. The keys must be retrieved from a trusted
+// controller, and refreshed when the sessions key of the project is rotated.
+//
+// Verifying the signature only proves the token was issued by a controller; it
+// does not tell whether the session has since been canceled or expired.
+func VerifySessionAuthorizationToken(token string, publicKeys map[string]ed25519.PublicKey) (*VerifiedSessionAuthorization, error) {
+	if token == "" {
+		return nil, errors.New("empty authorization token")
+	}
+	marshaled, err := base58.FastBase58Decoding(token)
+	if err != nil {
+		return nil, fmt.Errorf("error base58-decoding authorization token: %w", err)
+	}
+	if len(marshaled) == 0 {
+		return nil, errors.New("length zero after base58-decoding authorization token")
+	}
+
+	ret := new(VerifiedSessionAuthorization)
+	var signature []byte
+	// The controller signs the marshaled data and then appends the key id and
+	// signature fields, so the signed bytes are everything else in order.
+	signed := make([]byte, 0, len(marshaled))
+	for b := marshaled; len(b) > 0; {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return nil, fmt.Errorf("error parsing authorization token: %w", protowire.ParseError(n))
+		}
+		m := protowire.ConsumeFieldValue(num, typ, b[n:])
+		if m < 0 {
+			return nil, fmt.Errorf("error parsing authorization token: %w", protowire.ParseError(m))
+		}
+		field, value := b[:n+m], b[n:n+m]
+		b = b[n+m:]
+
+		var str *string
+		switch num {
+		case sadSigningKeyIdField:
+			str = &ret.SigningKeyId
+		case sadSignatureField:
+			if typ != protowire.BytesType {
+				return nil, errors.New("invalid signature in authorization token")
+			}
+			signature, _ = protowire.ConsumeBytes(value)
+			continue
+		case sadSessionIdField:
+			str = &ret.SessionId
+		case sadTargetIdField:
+			str = &ret.TargetId
+		case sadTypeField:
+			str = &ret.Type
+		case sadHostIdField:
+			str = &ret.HostId
+		case sadEndpointField:
+			str = &ret.Endpoint
+		}
+		if str != nil {
+			if typ != protowire.BytesType {
+				return nil, fmt.Errorf("invalid type for field %d in authorization token", num)
+			}
+			v, _ := protowire.ConsumeString(value)
+			*str = v
+		}
+		if num != sadSigningKeyIdField {
+			signed = append(signed, field...)
+		}
+	}
+
+	switch {
+	case ret.SigningKeyId == "" || len(signature) == 0:
+		return nil, errors.New("authorization token is not signed")
+	case publicKeys[ret.SigningKeyId] == nil:
+		return nil, fmt.Errorf("unknown signing key %q for authorization token", ret.SigningKeyId)
+	case !ed25519.Verify(publicKeys[ret.SigningKeyId], signed, signature):
+		return nil, errors.New("invalid authorization token signature")
+	}
+	return ret, nil
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package targets
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// testSignedToken signs the data the way the controller does, appending the
+// key id and signature fields to the signed bytes.
+func testSignedToken(t *testing.T, key ed25519.PrivateKey, keyId string, data []byte) string {
+	t.Helper()
+	sig := ed25519.Sign(key, data)
+	signed := append([]byte{}, data...)
+	signed = protowire.AppendTag(signed, sadSigningKeyIdField, protowire.BytesType)
+	signed = protowire.AppendString(signed, keyId)
+	signed = protowire.AppendTag(signed, sadSignatureField, protowire.BytesType)
+	signed = protowire.AppendBytes(signed, sig)
+	return base58.FastBase58Encoding(signed)
+}
+
+func TestVerifySessionAuthorizationToken(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	otherPub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	var data []byte
+	data = protowire.AppendTag(data, sadSessionIdField, protowire.BytesType)
+	data = protowire.AppendString(data, "s_1234567890")
+	data = protowire.AppendTag(data, sadTargetIdField, protowire.BytesType)
+	data = protowire.AppendString(data, "ttcp_1234567890")
+	data = protowire.AppendTag(data, 90, protowire.VarintType)
+	data = protowire.AppendVarint(data, 5)
+	data = protowire.AppendTag(data, sadTypeField, protowire.BytesType)
+	data = protowire.AppendString(data, "tcp")
+	data = protowire.AppendTag(data, sadHostIdField, protowire.BytesType)
+	data = protowire.AppendString(data, "hst_1234567890")
+	data = protowire.AppendTag(data, sadEndpointField, protowire.BytesType)
+	data = protowire.AppendString(data, "tcp://127.0.0.1:22")
+	token := testSignedToken(t, priv, "kdkv_1234567890", data)
+
+	keys := SessionAuthorizationPublicKeys([]*scopes.Key{
+		{
+			Purpose:  "database",
+			Versions: []*scopes.KeyVersion{{Id: "krkv_1234567890"}},
+		},
+		{
+			Purpose:  "sessions",
+			Versions: []*scopes.KeyVersion{{Id: "kdkv_1234567890", SessionAuthorizationPublicKey: pub}},
+		},
+	})
+	require.Len(t, keys, 1)
+
+	tampered := append([]byte{}, data...)
+	tampered[len(tampered)-1] = '3'
+
+	tests := []struct {
+		name          string
+		token         string
+		keys          map[string]ed25519.PublicKey
+		want          *VerifiedSessionAuthorization
+		wantErrSubstr string
+	}{
+		{
+			name:  "valid",
+			token: token,
+			keys:  keys,
+			want: &VerifiedSessionAuthorization{
+				SessionId:    "s_1234567890",
+				TargetId:     "ttcp_1234567890",
+				Type:         "tcp",
+				HostId:       "hst_1234567890",
+				Endpoint:     "tcp://127.0.0.1:22",
+				SigningKeyId: "kdkv_1234567890",
+			},
+		},
+		{
+			name:          "empty",
+			keys:          keys,
+			wantErrSubstr: "empty authorization token",
+		},
+		{
+			name:          "not-base58",
+			token:         "0OIl",
+			keys:          keys,
+			wantErrSubstr: "error base58-decoding",
+		},
+		{
+			name:          "unsigned",
+			token:         base58.FastBase58Encoding(data),
+			keys:          keys,
+			wantErrSubstr: "is not signed",
+		},
+		{
+			name:          "unknown-key",
+			token:         testSignedToken(t, priv, "kdkv_unknown", data),
+			keys:          keys,
+			wantErrSubstr: "unknown signing key",
+		},
+		{
+			name:          "wrong-key",
+			token:         token,
+			keys:          map[string]ed25519.PublicKey{"kdkv_1234567890": otherPub},
+			wantErrSubstr: "invalid authorization token signature",
+		},
+		{
+			name: "tampered",
+			token: func() string {
+				b, err := base58.FastBase58Decoding(token)
+				require.NoError(t, err)
+				return base58.FastBase58Encoding(append(tampered, b[len(data):]...))
+			}(),
+			keys:          keys,
+			wantErrSubstr: "invalid authorization token signature",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := VerifySessionAuthorizationToken(tt.token, tt.keys)
+			if tt.wantErrSubstr != "" {
+				require.Error(err)
+				assert.Contains(err.Error(), tt.wantErrSubstr)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, got)
+		})
+	}
+}
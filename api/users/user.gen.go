@@ -137,6 +137,10 @@ func (c *Client) Create(ctx context.Context, scopeId string, opt ...Option) (*Us
 }
 
 func (c *Client) Read(ctx context.Context, id string, opt ...Option) (*UserReadResult, error) {
+	opts, apiOpts := getOpts(opt...)
+	if id == "" {
+		id = opts.withLookupName
+	}
 	if id == "" {
 		return nil, fmt.Errorf("empty id value passed into Read request")
 	}
@@ -144,8 +148,6 @@ func (c *Client) Read(ctx context.Context, id string, opt ...Option) (*UserReadR
 		return nil, fmt.Errorf("nil client")
 	}
 
-	opts, apiOpts := getOpts(opt...)
-
 	req, err := c.client.NewRequest(ctx, "GET", fmt.Sprintf("users/%s", url.PathEscape(id)), nil, apiOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("error creating Read request: %w", err)
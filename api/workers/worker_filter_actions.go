@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workers
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+type WorkerFilterTestResult = WorkerListResult
+
+// TestWorkerFilter returns the workers currently connected to the controllers
+// which match the given worker filter, which uses the same format as the
+// worker filters of a target.
+func (c *Client) TestWorkerFilter(ctx context.Context, scopeId, filter string, opt ...Option) (*WorkerFilterTestResult, error) {
+	if scopeId == "" {
+		return nil, fmt.Errorf("empty scopeId value passed into TestWorkerFilter request")
+	}
+	if filter == "" {
+		return nil, fmt.Errorf("empty filter value passed into TestWorkerFilter request")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	reqBody := map[string]any{
+		"scope_id": scopeId,
+		"filter":   filter,
+	}
+
+	req, err := c.client.NewRequest(ctx, "POST", "workers:test-worker-filter", reqBody, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating TestWorkerFilter request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during TestWorkerFilter call: %w", err)
+	}
+
+	target := new(WorkerFilterTestResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding TestWorkerFilter response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
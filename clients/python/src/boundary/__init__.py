@@ -0,0 +1,27 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+"""Python client for the Boundary controller API.
+
+The messages generated from the controller protos live in the _pb directory.
+The generated modules import each other by their proto package, such as
+``controller.api.resources.targets.v1``, so the directory is added to the
+import path.
+"""
+
+import os
+import sys
+
+__version__ = "0.1.0"
+
+_PB_DIR = os.path.join(os.path.dirname(os.path.abspath(__file__)), "_pb")
+if not os.path.isdir(_PB_DIR):
+    raise ImportError(
+        "the generated messages are missing; run `make sdk-python` first"
+    )
+if _PB_DIR not in sys.path:
+    sys.path.append(_PB_DIR)
+
+from boundary.client import ApiError, Client  # noqa: E402
+
+__all__ = ["ApiError", "Client"]
@@ -0,0 +1,188 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+"""A thin shim sending the generated messages to the controller's JSON API."""
+
+import importlib
+import json
+import os
+import pkgutil
+import re
+import ssl
+import urllib.error
+import urllib.parse
+import urllib.request
+from typing import Any, Dict, List, Optional, Tuple
+
+from google.api import annotations_pb2
+from google.protobuf import descriptor, descriptor_pool, json_format, message
+from google.protobuf import message_factory
+
+_DEFAULT_ADDR = "http://127.0.0.1:9200"
+_SERVICES_PACKAGE = "controller.api.services.v1"
+_PATH_PARAM = re.compile(r"{([^}=]+)(=[^}]*)?}")
+
+
+class ApiError(Exception):
+    """An error returned by the controller.
+
+    The kind, message and details are those of the error the controller
+    returned, as documented for the api package of the Go client.
+    """
+
+    def __init__(self, status: int, kind: str, message: str, details: Any = None):
+        super().__init__(f"{status} {kind}: {message}" if kind else f"{status}: {message}")
+        self.status = status
+        self.kind = kind
+        self.message = message
+        self.details = details
+
+
+class Client:
+    """A client of the controller API.
+
+    Any service method of the controller can be called with call, passing the
+    full name of the method and its generated request message.
+    """
+
+    def __init__(
+        self,
+        addr: Optional[str] = None,
+        token: Optional[str] = None,
+        timeout: float = 60,
+        ssl_context: Optional[ssl.SSLContext] = None,
+    ):
+        self.addr = (addr or os.environ.get("BOUNDARY_ADDR") or _DEFAULT_ADDR).rstrip("/")
+        self.token = token if token is not None else os.environ.get("BOUNDARY_TOKEN")
+        self.timeout = timeout
+        self.ssl_context = ssl_context
+
+    def authenticate_password(self, auth_method_id: str, login_name: str, password: str) -> str:
+        """Authenticates to a password auth method and sets the token of the
+        client. Returns the token."""
+        from controller.api.services.v1 import auth_method_service_pb2
+
+        req = auth_method_service_pb2.AuthenticateRequest(
+            auth_method_id=auth_method_id, command="login"
+        )
+        req.attributes.update({"login_name": login_name, "password": password})
+        resp = self.call("controller.api.services.v1.AuthMethodService.Authenticate", req)
+        token = resp.attributes.fields.get("token")
+        if token is None or not token.string_value:
+            raise ApiError(0, "", "no token in authentication response")
+        self.token = token.string_value
+        return self.token
+
+    def call(self, method: str, request: message.Message) -> message.Message:
+        """Calls a service method, given its full name such as
+        "controller.api.services.v1.TargetService.GetTarget", and returns its
+        response message."""
+        md = _find_method(method)
+        if request.DESCRIPTOR is not md.input_type:
+            raise TypeError(
+                f"{method} takes a {md.input_type.full_name}, not a {request.DESCRIPTOR.full_name}"
+            )
+        rule = md.GetOptions().Extensions[annotations_pb2.http]
+        verb, path, body, query = _bind(rule, request)
+
+        url = self.addr + path
+        if query:
+            url += "?" + urllib.parse.urlencode(query)
+        req = urllib.request.Request(url, data=body, method=verb)
+        req.add_header("Accept", "application/json")
+        if body is not None:
+            req.add_header("Content-Type", "application/json")
+        if self.token:
+            req.add_header("Authorization", f"Bearer {self.token}")
+        try:
+            with urllib.request.urlopen(req, timeout=self.timeout, context=self.ssl_context) as r:
+                data = r.read()
+        except urllib.error.HTTPError as e:
+            raise _api_error(e) from None
+
+        resp = message_factory.GetMessageClass(md.output_type)()
+        target = resp
+        if rule.response_body:
+            target = getattr(resp, rule.response_body)
+        if data:
+            json_format.Parse(data, target, ignore_unknown_fields=True)
+        return resp
+
+
+def _find_method(name: str) -> descriptor.MethodDescriptor:
+    _load_services()
+    try:
+        return descriptor_pool.Default().FindMethodByName(name)
+    except KeyError:
+        raise ValueError(f"unknown service method {name}") from None
+
+
+_services_loaded = False
+
+
+def _load_services() -> None:
+    """Imports the generated services, registering their descriptors."""
+    global _services_loaded
+    if _services_loaded:
+        return
+    pkg = importlib.import_module(_SERVICES_PACKAGE)
+    for m in pkgutil.iter_modules(pkg.__path__):
+        if m.name.endswith("_pb2"):
+            importlib.import_module(f"{_SERVICES_PACKAGE}.{m.name}")
+    _services_loaded = True
+
+
+def _bind(rule, request: message.Message) -> Tuple[str, str, Optional[bytes], List[Tuple[str, str]]]:
+    """Returns the verb, path, body and query parameters of the request,
+    according to the HTTP binding of its method."""
+    verb = rule.WhichOneof("pattern")
+    if verb is None or verb == "custom":
+        raise ValueError("unsupported http binding")
+    template = getattr(rule, verb)
+    fields = json_format.MessageToDict(request, preserving_proto_field_name=True)
+
+    bound = set()
+
+    def param(m: "re.Match[str]") -> str:
+        name = m.group(1)
+        bound.add(name.split(".")[0])
+        value: Any = request
+        for part in name.split("."):
+            value = getattr(value, part)
+        return urllib.parse.quote(str(value), safe="")
+
+    path = _PATH_PARAM.sub(param, template)
+
+    body = None
+    if rule.body == "*":
+        body = {k: v for k, v in fields.items() if k not in bound}
+        fields = {}
+    elif rule.body:
+        body = fields.pop(rule.body, {})
+    if body is not None:
+        body = json.dumps(body).encode()
+
+    query = []
+    for k, v in fields.items():
+        if k in bound:
+            continue
+        for item in v if isinstance(v, list) else [v]:
+            if isinstance(item, bool):
+                item = "true" if item else "false"
+            elif isinstance(item, (dict, list)):
+                item = json.dumps(item)
+            query.append((k, str(item)))
+    return verb.upper(), path, body, query
+
+
+def _api_error(e: urllib.error.HTTPError) -> ApiError:
+    try:
+        data: Dict[str, Any] = json.loads(e.read() or b"{}")
+    except ValueError:
+        data = {}
+    return ApiError(
+        e.code,
+        data.get("kind", ""),
+        data.get("message", e.reason),
+        data.get("details"),
+    )
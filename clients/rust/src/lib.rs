@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//! A Rust client for the Boundary controller API.
+//!
+//! The messages generated from the controller protos are in [`pb`], by proto
+//! package. [`Client`] sends them to the controller's JSON API; since the
+//! Rust plugins don't keep the HTTP bindings of the service methods, requests
+//! are made by path, as documented in the controller's OpenAPI description.
+
+#[allow(clippy::all)]
+#[path = "gen/mod.rs"]
+pub mod pb;
+
+use reqwest::{Method, StatusCode};
+use serde::{de::DeserializeOwned, Deserialize, Serialize};
+
+const DEFAULT_ADDR: &str = "http://127.0.0.1:9200";
+
+/// An error of a request to the controller.
+#[derive(Debug, thiserror::Error)]
+pub enum Error {
+    /// An error returned by the controller.
+    #[error("{status} {kind}: {message}")]
+    Api {
+        status: StatusCode,
+        kind: String,
+        message: String,
+        details: Option<serde_json::Value>,
+    },
+    /// An error sending the request or reading the response.
+    #[error(transparent)]
+    Http(#[from] reqwest::Error),
+    #[error("{0}")]
+    Other(String),
+}
+
+// The body of an error returned by the controller
+#[derive(Deserialize, Default)]
+struct ApiErrorBody {
+    #[serde(default)]
+    kind: String,
+    #[serde(default)]
+    message: String,
+    details: Option<serde_json::Value>,
+}
+
+/// A client of the controller API.
+#[derive(Clone, Debug)]
+pub struct Client {
+    addr: String,
+    token: Option<String>,
+    http: reqwest::Client,
+}
+
+impl Client {
+    /// Returns a client of the controller at addr.
+    pub fn new(addr: &str) -> Result<Self, Error> {
+        Ok(Client {
+            addr: addr.trim_end_matches('/').to_string(),
+            token: None,
+            http: reqwest::Client::builder().build()?,
+        })
+    }
+
+    /// Returns a client configured by the `BOUNDARY_ADDR` and `BOUNDARY_TOKEN`
+    /// environment variables.
+    pub fn from_env() -> Result<Self, Error> {
+        let addr = std::env::var("BOUNDARY_ADDR").unwrap_or_else(|_| DEFAULT_ADDR.to_string());
+        let mut client = Client::new(&addr)?;
+        client.token = std::env::var("BOUNDARY_TOKEN").ok().filter(|t| !t.is_empty());
+        Ok(client)
+    }
+
+    /// Sets the token requests are authenticated with.
+    pub fn set_token(&mut self, token: impl Into<String>) {
+        self.token = Some(token.into());
+    }
+
+    /// Returns the token requests are authenticated with, if any.
+    pub fn token(&self) -> Option<&str> {
+        self.token.as_deref()
+    }
+
+    /// Authenticates to a password auth method and sets the token of the
+    /// client. Returns the token.
+    pub async fn authenticate_password(
+        &mut self,
+        auth_method_id: &str,
+        login_name: &str,
+        password: &str,
+    ) -> Result<String, Error> {
+        let body = serde_json::json!({
+            "command": "login",
+            "attributes": {"login_name": login_name, "password": password},
+        });
+        let resp: serde_json::Value = self
+            .post(&format!("/v1/auth-methods/{auth_method_id}:authenticate"), &body)
+            .await?;
+        let token = resp["attributes"]["token"]
+            .as_str()
+            .ok_or_else(|| Error::Other("no token in authentication response".to_string()))?
+            .to_string();
+        self.token = Some(token.clone());
+        Ok(token)
+    }
+
+    /// Sends a GET request to path, with the query parameters.
+    pub async fn get<R: DeserializeOwned>(&self, path: &str, query: &[(&str, &str)]) -> Result<R, Error> {
+        self.request(Method::GET, path, query, None::<&()>).await
+    }
+
+    /// Sends a POST request to path, with the body.
+    pub async fn post<B: Serialize, R: DeserializeOwned>(&self, path: &str, body: &B) -> Result<R, Error> {
+        self.request(Method::POST, path, &[], Some(body)).await
+    }
+
+    /// Sends a PATCH request to path, with the body.
+    pub async fn patch<B: Serialize, R: DeserializeOwned>(&self, path: &str, body: &B) -> Result<R, Error> {
+        self.request(Method::PATCH, path, &[], Some(body)).await
+    }
+
+    /// Sends a DELETE request to path.
+    pub async fn delete(&self, path: &str) -> Result<(), Error> {
+        self.request::<(), serde_json::Value>(Method::DELETE, path, &[], None)
+            .await
+            .map(|_| ())
+    }
+
+    /// Sends a request to path, returning the decoded response.
+    pub async fn request<B: Serialize, R: DeserializeOwned>(
+        &self,
+        method: Method,
+        path: &str,
+        query: &[(&str, &str)],
+        body: Option<&B>,
+    ) -> Result<R, Error> {
+        let mut req = self
+            .http
+            .request(method, format!("{}{}", self.addr, path))
+            .header(reqwest::header::ACCEPT, "application/json");
+        if !query.is_empty() {
+            req = req.query(query);
+        }
+        if let Some(token) = &self.token {
+            req = req.bearer_auth(token);
+        }
+        if let Some(body) = body {
+            req = req.json(body);
+        }
+        let resp = req.send().await?;
+        let status = resp.status();
+        let bytes = resp.bytes().await?;
+        if !status.is_success() {
+            let body: ApiErrorBody = serde_json::from_slice(&bytes).unwrap_or_default();
+            return Err(Error::Api {
+                status,
+                kind: body.kind,
+                message: body.message,
+                details: body.details,
+            });
+        }
+        // Deletes return no content
+        let bytes: &[u8] = if bytes.is_empty() { b"{}" } else { &bytes };
+        serde_json::from_slice(bytes).map_err(|e| Error::Other(format!("error decoding response: {e}")))
+    }
+}
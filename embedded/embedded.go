@@ -0,0 +1,309 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package embedded
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/daemon/controller"
+	"github.com/hashicorp/boundary/internal/daemon/worker"
+	"github.com/hashicorp/boundary/internal/db/schema"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-multierror"
+
+	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/targets/tcp"
+)
+
+// Server is a Boundary controller and worker running in the calling process
+type Server struct {
+	b          *base.Server
+	conf       *config.Config
+	controller *controller.Controller
+	worker     *worker.Worker
+
+	shutdownOnce sync.Once
+	shutdownErr  error
+}
+
+// New starts a Server, and returns once its controller and worker are
+// started. The Server runs until Shutdown is called; canceling ctx only
+// stops its startup.
+func New(ctx context.Context, opt ...Option) (*Server, error) {
+	opts, err := getOpts(opt...)
+	if err != nil {
+		return nil, err
+	}
+
+	conf, err := newConfig(opts)
+	if err != nil {
+		return nil, err
+	}
+	if conf.Controller == nil && conf.Worker == nil {
+		return nil, fmt.Errorf("Config contains neither a controller nor a worker.")
+	}
+
+	baseCtx, cancel := context.WithCancel(context.Background())
+	s := &Server{
+		b: base.NewServer(&base.Command{
+			Context:       baseCtx,
+			ContextCancel: cancel,
+			ShutdownCh:    make(chan struct{}),
+		}),
+		conf: conf,
+	}
+	if err := s.start(ctx, opts); err != nil {
+		if shutdownErr := s.Shutdown(); shutdownErr != nil {
+			err = multierror.Append(err, shutdownErr)
+		}
+		return nil, err
+	}
+	return s, nil
+}
+
+func newConfig(opts *options) (*config.Config, error) {
+	if opts.withConfig != "" {
+		conf, err := config.Parse(opts.withConfig)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing config: %w", err)
+		}
+		return conf, nil
+	}
+	conf, err := config.DevCombined()
+	if err != nil {
+		return nil, fmt.Errorf("Error creating dev config: %w", err)
+	}
+	// The dev controller settings, such as skipping authorization, must not
+	// apply to an embedded controller
+	conf.DevController = false
+	for _, l := range conf.Listeners {
+		l.RandomPort = true
+	}
+	return conf, nil
+}
+
+func (s *Server) start(ctx context.Context, opts *options) error {
+	b, conf := s.b, s.conf
+
+	b.Logger = opts.withLogger
+	if b.Logger == nil {
+		b.Logger = hclog.New(&hclog.LoggerOptions{
+			Name:  "boundary",
+			Level: hclog.Info,
+			Mutex: b.StderrLock,
+		})
+	}
+	b.PrometheusRegisterer = opts.withPrometheusRegisterer
+
+	if err := b.SetupEventing(b.Logger, b.StderrLock, "embedded", base.WithEventerConfig(conf.Eventing)); err != nil {
+		return err
+	}
+	if err := b.SetupKMSes(ctx, nil, conf); err != nil {
+		return err
+	}
+	if conf.Controller != nil && b.RootKms == nil {
+		return fmt.Errorf("Root KMS not found after parsing KMS blocks")
+	}
+	if opts.withConfig == "" {
+		// As in dev mode, the worker authenticates with the worker auth KMS,
+		// which must not be combined with a worker auth storage KMS
+		b.WorkerAuthStorageKms = nil
+	}
+
+	if err := b.SetupListeners(nil, conf.SharedConfig, []string{"api", "cluster", "proxy", "ops"}); err != nil {
+		return err
+	}
+	if conf.Controller != nil {
+		if err := conf.SetupControllerPublicClusterAddress(""); err != nil {
+			return err
+		}
+	}
+	if conf.Worker != nil {
+		if err := b.SetupWorkerPublicAddress(conf, ""); err != nil {
+			return err
+		}
+		if conf.Controller != nil {
+			if opts.withConfig == "" {
+				// The worker connects to the cluster listener of the
+				// controller, whose random port is only known once listening
+				conf.Worker.InitialUpstreams = nil
+			}
+			if err := conf.SetupWorkerInitialUpstreams(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if conf.Controller != nil {
+		if err := s.setupDatabase(ctx, opts); err != nil {
+			return err
+		}
+		b.EnabledPlugins = append(b.EnabledPlugins, base.EnabledPluginHostAws, base.EnabledPluginHostAzure)
+
+		var err error
+		s.controller, err = controller.New(b.Context, &controller.Config{
+			RawConfig: conf,
+			Server:    b,
+		})
+		if err != nil {
+			return fmt.Errorf("Error initializing controller: %w", err)
+		}
+		if err := s.controller.Start(); err != nil {
+			return fmt.Errorf("Error starting controller: %w", err)
+		}
+	}
+
+	if conf.Worker != nil {
+		var err error
+		s.worker, err = worker.New(&worker.Config{
+			RawConfig: conf,
+			Server:    b,
+		})
+		if err != nil {
+			return fmt.Errorf("Error initializing worker: %w", err)
+		}
+		if err := s.worker.Start(); err != nil {
+			return fmt.Errorf("Error starting worker: %w", err)
+		}
+	}
+	return nil
+}
+
+// setupDatabase connects to the database of the controller, initializing it
+// if requested, or otherwise checking it was initialized and migrated.
+func (s *Server) setupDatabase(ctx context.Context, opts *options) error {
+	b, conf := s.b, s.conf
+	switch {
+	case opts.withDatabaseUrl != "":
+		b.DatabaseUrl = opts.withDatabaseUrl
+	case conf.Controller.Database != nil && conf.Controller.Database.Url != "":
+		var err error
+		b.DatabaseUrl, err = config.ParseSecretPath(conf.Controller.Database.Url)
+		if err != nil {
+			return fmt.Errorf("Error parsing database url: %w", err)
+		}
+		b.DatabaseMaxOpenConnections = conf.Controller.Database.MaxOpenConnections
+		b.DatabaseMaxIdleConnections = conf.Controller.Database.MaxIdleConnections
+		b.DatabaseConnMaxIdleTimeDuration = conf.Controller.Database.ConnMaxIdleTimeDuration
+	default:
+		return fmt.Errorf("No database url provided for the controller")
+	}
+
+	if opts.withDatabaseInitialization {
+		if err := b.CreateDevDatabase(ctx, base.WithSkipOidcAuthMethodCreation()); err != nil {
+			return fmt.Errorf("Error initializing database: %w", err)
+		}
+		return nil
+	}
+
+	if err := b.OpenAndSetServerDatabase(ctx, "postgres"); err != nil {
+		return fmt.Errorf("Error connecting to database: %w", err)
+	}
+	sqlDb, err := b.Database.SqlDB(ctx)
+	if err != nil {
+		return fmt.Errorf("Error obtaining sql db: %w", err)
+	}
+	man, err := schema.NewManager(ctx, "postgres", sqlDb)
+	if err != nil {
+		return fmt.Errorf("Error creating schema manager: %w", err)
+	}
+	state, err := man.CurrentState(ctx)
+	if err != nil {
+		return fmt.Errorf("Error getting current schema state: %w", err)
+	}
+	if !state.Initialized || !state.MigrationsApplied() {
+		return fmt.Errorf("The database is not initialized or its schema is not up to date; " +
+			"run 'boundary database init' or 'boundary database migrate', or use WithDatabaseInitialization.")
+	}
+	return nil
+}
+
+// ApiAddrs returns the urls of the api listeners of the controller
+func (s *Server) ApiAddrs() []string {
+	var addrs []string
+	for _, l := range s.b.Listeners {
+		if l.ApiListener == nil {
+			continue
+		}
+		scheme := "https://"
+		if l.Config.TLSDisable {
+			scheme = "http://"
+		}
+		addrs = append(addrs, scheme+l.ApiListener.Addr().String())
+	}
+	return addrs
+}
+
+// ClusterAddrs returns the addresses of the cluster listeners of the
+// controller, which workers connect to.
+func (s *Server) ClusterAddrs() []string {
+	var addrs []string
+	for _, l := range s.b.Listeners {
+		if l.ClusterListener != nil {
+			addrs = append(addrs, l.ClusterListener.Addr().String())
+		}
+	}
+	return addrs
+}
+
+// ProxyAddrs returns the addresses of the proxy listeners of the worker,
+// which clients connect to for sessions.
+func (s *Server) ProxyAddrs() []string {
+	var addrs []string
+	for _, l := range s.b.Listeners {
+		if l.ProxyListener != nil {
+			addrs = append(addrs, l.ProxyListener.Addr().String())
+		}
+	}
+	return addrs
+}
+
+// Client returns an unauthenticated api client for the controller
+func (s *Server) Client() (*api.Client, error) {
+	addrs := s.ApiAddrs()
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("No api listener found")
+	}
+	conf, err := api.DefaultConfig()
+	if err != nil {
+		return nil, err
+	}
+	conf.Addr = addrs[0]
+	return api.NewClient(conf)
+}
+
+// InitialLogin returns the password auth method id, login name and password
+// of the admin user created when the database is initialized with
+// WithDatabaseInitialization. They are empty otherwise.
+func (s *Server) InitialLogin() (authMethodId, loginName, password string) {
+	return s.b.DevPasswordAuthMethodId, s.b.DevLoginName, s.b.DevPassword
+}
+
+// Shutdown stops the worker and the controller of the Server and releases
+// its resources. It is safe to call more than once.
+func (s *Server) Shutdown() error {
+	s.shutdownOnce.Do(func() {
+		var result *multierror.Error
+		if s.worker != nil {
+			if err := s.worker.Shutdown(); err != nil {
+				result = multierror.Append(result, fmt.Errorf("Error shutting down worker: %w", err))
+			}
+		}
+		if s.controller != nil {
+			if err := s.controller.Shutdown(); err != nil {
+				result = multierror.Append(result, fmt.Errorf("Error shutting down controller: %w", err))
+			}
+		}
+		s.b.ContextCancel()
+		if err := s.b.RunShutdownFuncs(); err != nil {
+			result = multierror.Append(result, err)
+		}
+		s.shutdownErr = result.ErrorOrNil()
+	})
+	return s.shutdownErr
+}
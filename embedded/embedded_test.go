@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package embedded
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/api/authmethods"
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/boundary/testing/dbtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer(t *testing.T) {
+	ctx := context.Background()
+	c, url, _, err := dbtest.StartUsingTemplate(dbtest.Postgres)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, c()) })
+
+	srv, err := New(ctx, WithDatabaseUrl(url), WithDatabaseInitialization())
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, srv.Shutdown()) })
+	assert.Len(t, srv.ApiAddrs(), 1)
+	assert.Len(t, srv.ClusterAddrs(), 1)
+	assert.Len(t, srv.ProxyAddrs(), 1)
+
+	client, err := srv.Client()
+	require.NoError(t, err)
+	authMethodId, loginName, password := srv.InitialLogin()
+	require.NotEmpty(t, authMethodId)
+	result, err := authmethods.NewClient(client).Authenticate(ctx, authMethodId, "login", map[string]any{
+		"login_name": loginName,
+		"password":   password,
+	})
+	require.NoError(t, err)
+	token, ok := result.Attributes["token"].(string)
+	require.True(t, ok)
+	client.SetToken(token)
+
+	l, err := targets.NewClient(client).List(ctx, "global", targets.WithRecursive(true))
+	require.NoError(t, err)
+	assert.NotEmpty(t, l.Items)
+
+	// Shutdown is idempotent
+	require.NoError(t, srv.Shutdown())
+	assert.NoError(t, srv.Shutdown())
+}
+
+func TestServer_UninitializedDatabase(t *testing.T) {
+	c, url, _, err := dbtest.StartUsingTemplate(dbtest.Postgres, dbtest.WithTemplate(dbtest.Template1))
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, c()) })
+
+	_, err = New(context.Background(), WithDatabaseUrl(url))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "The database is not initialized")
+}
+
+func TestServer_Options(t *testing.T) {
+	ctx := context.Background()
+	_, err := New(ctx, WithConfig(""))
+	assert.EqualError(t, err, "Config cannot be empty.")
+	_, err = New(ctx, WithDatabaseUrl(""))
+	assert.EqualError(t, err, "Database url cannot be empty.")
+	_, err = New(ctx, WithConfig("disable_mlock = true"))
+	assert.EqualError(t, err, "Config contains neither a controller nor a worker.")
+	_, err = New(ctx)
+	assert.EqualError(t, err, "No database url provided for the controller")
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package embedded
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type options struct {
+	withConfig                 string
+	withDatabaseUrl            string
+	withDatabaseInitialization bool
+	withLogger                 hclog.Logger
+	withPrometheusRegisterer   prometheus.Registerer
+}
+
+// Option configures the embedded Server
+type Option func(*options) error
+
+func getOpts(opt ...Option) (*options, error) {
+	opts := &options{}
+	for _, o := range opt {
+		if err := o(opts); err != nil {
+			return nil, err
+		}
+	}
+	return opts, nil
+}
+
+// WithConfig provides the configuration of the Server, in the HCL format of
+// the boundary server command. When not provided, a combined controller and
+// worker dev configuration listening on random loopback ports is used.
+func WithConfig(hcl string) Option {
+	return func(o *options) error {
+		if hcl == "" {
+			return fmt.Errorf("Config cannot be empty.")
+		}
+		o.withConfig = hcl
+		return nil
+	}
+}
+
+// WithDatabaseUrl sets the url of the database of the controller. It
+// overrides the url of the controller database block of the configuration.
+func WithDatabaseUrl(url string) Option {
+	return func(o *options) error {
+		if url == "" {
+			return fmt.Errorf("Database url cannot be empty.")
+		}
+		o.withDatabaseUrl = url
+		return nil
+	}
+}
+
+// WithDatabaseInitialization migrates the database of the controller when
+// the Server starts, and creates the initial resources of a new Boundary
+// deployment, including a password auth method and an admin login returned by
+// InitialLogin. Without it, the database must have been initialized with
+// `boundary database init`.
+func WithDatabaseInitialization() Option {
+	return func(o *options) error {
+		o.withDatabaseInitialization = true
+		return nil
+	}
+}
+
+// WithLogger sets the logger of the Server. It defaults to a logger writing
+// to stderr at the info level.
+func WithLogger(logger hclog.Logger) Option {
+	return func(o *options) error {
+		o.withLogger = logger
+		return nil
+	}
+}
+
+// WithPrometheusRegisterer sets the registerer the metrics of the Server are
+// registered with. Metrics are not registered by default, so that they don't
+// collide with the metrics of the embedding process.
+func WithPrometheusRegisterer(r prometheus.Registerer) Option {
+	return func(o *options) error {
+		o.withPrometheusRegisterer = r
+		return nil
+	}
+}
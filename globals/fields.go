@@ -24,6 +24,9 @@ const (
 	ApproximateLastUsedTimeField                = "approximate_last_used_time"
 	MembersField                                = "members"
 	MemberIdsField                              = "member_ids"
+	UnionGroupIdsField                          = "union_group_ids"
+	IntersectionGroupIdsField                   = "intersection_group_ids"
+	DifferenceGroupIdsField                     = "difference_group_ids"
 	HostCatalogIdField                          = "host_catalog_id"
 	HostSetIdsField                             = "host_set_ids"
 	HostSourceIdsField                          = "host_source_ids"
@@ -31,9 +34,15 @@ const (
 	PrincipalIdsField                           = "principal_ids"
 	PrincipalsField                             = "principals"
 	GrantScopeIdField                           = "grant_scope_id"
+	GrantBoundaryField                          = "grant_boundary"
+	ProjectTemplateField                        = "project_template"
+	ProjectTemplateSyncField                    = "project_template_sync"
+	TemplateRoleIdField                         = "template_role_id"
 	GrantsField                                 = "grants"
 	GrantStringsField                           = "grant_strings"
 	PrimaryAuthMethodIdField                    = "primary_auth_method_id"
+	AuthTokenTimeToLiveSecondsField             = "auth_token_time_to_live_seconds"
+	AuthTokenTimeToStaleSecondsField            = "auth_token_time_to_stale_seconds"
 	TargetIdField                               = "target_id"
 	HostIdField                                 = "host_id"
 	HostSetIdField                              = "host_set_id"
@@ -50,6 +59,13 @@ const (
 	WorkerFilterField                           = "worker_filter"
 	EgressWorkerFilterField                     = "egress_worker_filter"
 	IngressWorkerFilterField                    = "ingress_worker_filter"
+	MaxAuthAgeSecondsField                      = "max_auth_age_seconds"
+	BannerField                                 = "banner"
+	BannerAcknowledgedTimeField                 = "banner_acknowledged_time"
+	AnnotationsField                            = "annotations"
+	LoginMetadataField                          = "login_metadata"
+	IsolatedField                               = "isolated"
+	DeletionProtectedField                      = "deletion_protected"
 	AccountIdsField                             = "account_ids"
 	AccountsField                               = "accounts"
 	LoginNameField                              = "login_name"
@@ -63,6 +79,9 @@ const (
 	ApplicationCredentialSourcesField           = "application_credential_sources"
 	BrokeredCredentialSourceIdsField            = "brokered_credential_source_ids"
 	BrokeredCredentialSourcesField              = "brokered_credential_sources"
+	ExclusiveCredentialSourceIdsField           = "exclusive_credential_source_ids"
+	FallbackCredentialSourceIdsField            = "fallback_credential_source_ids"
+	FallbackHostSourceIdsField                  = "fallback_host_source_ids"
 	PreferredEndpointsField                     = "preferred_endpoints"
 	SyncIntervalSecondsField                    = "sync_interval_seconds"
 	PluginIdField                               = "plugin_id"
@@ -98,4 +117,8 @@ const (
 	TotalCountField                             = "total_count"
 	DirectlyConnectedDownstreamWorkersField     = "directly_connected_downstream_workers"
 	AttributesAddressField                      = "attributes.address"
+	HealthField                                 = "health"
+	UsernameField                               = "username"
+	PublicKeyField                              = "public_key"
+	ValidSecondsField                           = "valid_seconds"
 )
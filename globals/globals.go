@@ -26,6 +26,10 @@ var (
 	// DefaultMaxRequestSize is the maximum size of a request we allow by default
 	DefaultMaxRequestSize = int64(1024 * 1024)
 
+	// DefaultMaxAttributeDepth is the maximum nesting depth of the attributes
+	// of a request we allow by default
+	DefaultMaxAttributeDepth = 32
+
 	// ContextMaxRequestSizeTypeKey is a value to keep linters from complaining
 	// about clashing string identifiers
 	ContextMaxRequestSizeTypeKey ContextMaxRequestSizeType
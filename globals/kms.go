@@ -10,4 +10,5 @@ const (
 	KmsPurposeWorkerAuthStorage = "worker-auth-storage"
 	KmsPurposeRecovery          = "recovery"
 	KmsPurposeConfig            = "config"
+	KmsPurposeAuditSink         = "audit-sink"
 )
@@ -69,6 +69,19 @@ const (
 	// certificate credential libraries
 	VaultSshCertificateCredentialLibraryPrefix = "clvsclt"
 
+	// AzureCredentialStorePrefix is the prefix for Azure Key Vault credential
+	// stores
+	AzureCredentialStorePrefix = "csazr"
+	// AzureCredentialLibraryPrefix is the prefix for Azure Key Vault secret
+	// credential libraries
+	AzureCredentialLibraryPrefix = "clazr"
+
+	// PluginCredentialStorePrefix is the prefix for plugin credential stores
+	PluginCredentialStorePrefix = "csplg"
+	// PluginCredentialLibraryPrefix is the prefix for plugin credential
+	// libraries
+	PluginCredentialLibraryPrefix = "clplg"
+
 	// UsernamePasswordCredentialPrefix is the prefix for username/password
 	// creds
 	UsernamePasswordCredentialPrefix = "credup"
@@ -79,6 +92,9 @@ const (
 	SshPrivateKeyCredentialPrefix = "credspk"
 	// JsonCredentialPrefix is the prefix for generic JSON creds
 	JsonCredentialPrefix = "credjson"
+	// X509CertificateCredentialPrefix is the prefix for X.509 client
+	// certificate creds
+	X509CertificateCredentialPrefix = "credx509"
 
 	// StaticHostCatalogPrefix is the prefix for static host catalogs
 	StaticHostCatalogPrefix = "hcst"
@@ -132,10 +148,15 @@ var prefixToResourceType = map[string]resource.Type{
 	VaultCredentialStorePrefix:                 resource.CredentialStore,
 	VaultCredentialLibraryPrefix:               resource.CredentialLibrary,
 	VaultSshCertificateCredentialLibraryPrefix: resource.CredentialLibrary,
+	AzureCredentialStorePrefix:                 resource.CredentialStore,
+	AzureCredentialLibraryPrefix:               resource.CredentialLibrary,
+	PluginCredentialStorePrefix:                resource.CredentialStore,
+	PluginCredentialLibraryPrefix:              resource.CredentialLibrary,
 	UsernamePasswordCredentialPrefix:           resource.Credential,
 	UsernamePasswordCredentialPreviousPrefix:   resource.Credential,
 	SshPrivateKeyCredentialPrefix:              resource.Credential,
 	JsonCredentialPrefix:                       resource.Credential,
+	X509CertificateCredentialPrefix:            resource.Credential,
 	StaticHostCatalogPrefix:                    resource.HostCatalog,
 	StaticHostSetPrefix:                        resource.HostSet,
 	StaticHostPrefix:                           resource.Host,
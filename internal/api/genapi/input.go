@@ -111,6 +111,10 @@ type structInfo struct {
 	// listing
 	recursiveListing bool
 
+	// nameLookup indicates that the resource can be read by its name and the
+	// id of the scope containing it instead of by its id
+	nameLookup bool
+
 	// extraFields allows specifying extra options that will be created for a
 	// given type, e.g. arguments only valid for one call or purpose and not
 	// conveyed within the item itself
@@ -155,6 +159,21 @@ var inputStructs = []*structInfo{
 		outFile:     "scopes/scope_info.gen.go",
 		skipOptions: true,
 	},
+	{
+		inProto:     &scopes.Annotations{},
+		outFile:     "scopes/annotations.gen.go",
+		skipOptions: true,
+	},
+	{
+		inProto:     &scopes.LoginMetadata{},
+		outFile:     "scopes/login_metadata.gen.go",
+		skipOptions: true,
+	},
+	{
+		inProto:     &scopes.EventSubscription{},
+		outFile:     "scopes/event_subscription.gen.go",
+		skipOptions: true,
+	},
 	{
 		inProto:     &plugins.PluginInfo{},
 		outFile:     "plugins/plugin_info.gen.go",
@@ -211,6 +230,7 @@ var inputStructs = []*structInfo{
 		versionEnabled:      true,
 		createResponseTypes: []string{CreateResponseType, ReadResponseType, UpdateResponseType, DeleteResponseType, ListResponseType},
 		recursiveListing:    true,
+		nameLookup:          true,
 	},
 	// User related resources
 	{
@@ -239,6 +259,7 @@ var inputStructs = []*structInfo{
 		versionEnabled:      true,
 		createResponseTypes: []string{CreateResponseType, ReadResponseType, UpdateResponseType, DeleteResponseType, ListResponseType},
 		recursiveListing:    true,
+		nameLookup:          true,
 	},
 	// Group related resources
 	{
@@ -267,6 +288,7 @@ var inputStructs = []*structInfo{
 		versionEnabled:      true,
 		createResponseTypes: []string{CreateResponseType, ReadResponseType, UpdateResponseType, DeleteResponseType, ListResponseType},
 		recursiveListing:    true,
+		nameLookup:          true,
 	},
 	// Role related resources
 	{
@@ -284,6 +306,11 @@ var inputStructs = []*structInfo{
 		outFile:     "roles/grant_json.gen.go",
 		skipOptions: true,
 	},
+	{
+		inProto:     &roles.SimulatedPermission{},
+		outFile:     "roles/simulated_permission.gen.go",
+		skipOptions: true,
+	},
 	{
 		inProto: &roles.Role{},
 		outFile: "roles/role.gen.go",
@@ -309,6 +336,7 @@ var inputStructs = []*structInfo{
 		versionEnabled:      true,
 		createResponseTypes: []string{CreateResponseType, ReadResponseType, UpdateResponseType, DeleteResponseType, ListResponseType},
 		recursiveListing:    true,
+		nameLookup:          true,
 	},
 	// Auth Methods related resources
 	{
@@ -493,6 +521,20 @@ var inputStructs = []*structInfo{
 			mapstructureConversionTemplate,
 		},
 	},
+	{
+		inProto:        &credentialstores.AzureCredentialStoreAttributes{},
+		outFile:        "credentialstores/azure_credential_store_attributes.gen.go",
+		subtypeName:    "AzureCredentialStore",
+		parentTypeName: "CredentialStore",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
+	{
+		inProto:     &credentialstores.CredentialStoreHealth{},
+		outFile:     "credentialstores/credential_store_health.gen.go",
+		skipOptions: true,
+	},
 	{
 		inProto: &credentialstores.CredentialStore{},
 		outFile: "credentialstores/credential_store.gen.go",
@@ -537,6 +579,10 @@ var inputStructs = []*structInfo{
 				Name:        "Token",
 				SkipDefault: true,
 			},
+			{
+				Name:        "VaultUri",
+				SkipDefault: true,
+			},
 		},
 	},
 	{
@@ -581,6 +627,21 @@ var inputStructs = []*structInfo{
 			mapstructureConversionTemplate,
 		},
 	},
+	{
+		inProto:     &credentiallibraries.AzureSecretCredentialLibraryAttributes{},
+		outFile:     "credentiallibraries/azure_secret_credential_library_attributes.gen.go",
+		subtypeName: "AzureSecretCredentialLibrary",
+		fieldOverrides: []fieldInfo{
+			{
+				Name:        "SecretName",
+				SkipDefault: true,
+			},
+		},
+		parentTypeName: "CredentialLibrary",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
 	{
 		inProto: &credentiallibraries.CredentialLibrary{},
 		outFile: "credentiallibraries/credential_library.gen.go",
@@ -654,6 +715,25 @@ var inputStructs = []*structInfo{
 			mapstructureConversionTemplate,
 		},
 	},
+	{
+		inProto:     &credentials.X509CertificateAttributes{},
+		outFile:     "credentials/x509_certificate_attributes.gen.go",
+		subtypeName: "X509CertificateCredential",
+		fieldOverrides: []fieldInfo{
+			{
+				Name:        "Certificate",
+				SkipDefault: true,
+			},
+			{
+				Name:        "PrivateKey",
+				SkipDefault: true,
+			},
+		},
+		parentTypeName: "Credential",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
 	{
 		inProto:     &credentials.JsonAttributes{},
 		outFile:     "credentials/json_attributes.gen.go",
@@ -699,6 +779,15 @@ var inputStructs = []*structInfo{
 			deleteTemplate,
 			listTemplate,
 		},
+		extraFields: []fieldInfo{
+			{
+				Name:        "IncludeSecret",
+				ProtoName:   "include_secret",
+				FieldType:   "bool",
+				SkipDefault: true,
+				Query:       true,
+			},
+		},
 		pluralResourceName:  "credentials",
 		parentTypeName:      "credential-store",
 		versionEnabled:      true,
@@ -830,6 +919,21 @@ var inputStructs = []*structInfo{
 		outFile:     "targets/session_authorization.gen.go",
 		subtypeName: "SessionAuthorization",
 	},
+	{
+		inProto:     &targets.AvailableHost{},
+		outFile:     "targets/available_host.gen.go",
+		skipOptions: true,
+	},
+	{
+		inProto:     &targets.SshCertificateAuthority{},
+		outFile:     "targets/ssh_certificate_authority.gen.go",
+		skipOptions: true,
+	},
+	{
+		inProto:     &targets.SshHostCertificate{},
+		outFile:     "targets/ssh_host_certificate.gen.go",
+		skipOptions: true,
+	},
 	{
 		inProto:     &targets.WorkerInfo{},
 		outFile:     "targets/worker_info.gen.go",
@@ -925,10 +1029,46 @@ var inputStructs = []*structInfo{
 				ProtoName: "injected_application_credential_source_ids",
 				FieldType: "[]string",
 			},
+			{
+				Name:      "ExclusiveCredentialSourceIds",
+				ProtoName: "exclusive_credential_source_ids",
+				FieldType: "[]string",
+			},
+			{
+				Name:      "FallbackCredentialSourceIds",
+				ProtoName: "fallback_credential_source_ids",
+				FieldType: "[]string",
+			},
+			{
+				Name:      "FallbackHostSourceIds",
+				ProtoName: "fallback_host_source_ids",
+				FieldType: "[]string",
+			},
+			{
+				Name:        "ValidSeconds",
+				ProtoName:   "valid_seconds",
+				FieldType:   "uint32",
+				SkipDefault: true,
+			},
+			{
+				Name:        "Favorites",
+				ProtoName:   "favorites",
+				FieldType:   "bool",
+				Query:       true,
+				SkipDefault: true,
+			},
+			{
+				Name:        "Recent",
+				ProtoName:   "recent",
+				FieldType:   "bool",
+				Query:       true,
+				SkipDefault: true,
+			},
 		},
 		versionEnabled:      true,
 		createResponseTypes: []string{CreateResponseType, ReadResponseType, UpdateResponseType, DeleteResponseType, ListResponseType},
 		recursiveListing:    true,
+		nameLookup:          true,
 	},
 	{
 		inProto: &sessions.SessionState{},
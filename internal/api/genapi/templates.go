@@ -52,6 +52,7 @@ type templateInput struct {
 	VersionEnabled        bool
 	CreateResponseTypes   []string
 	RecursiveListing      bool
+	NameLookup            bool
 }
 
 func fillTemplates() {
@@ -70,6 +71,7 @@ func fillTemplates() {
 			VersionEnabled:      in.versionEnabled,
 			CreateResponseTypes: in.createResponseTypes,
 			RecursiveListing:    in.recursiveListing,
+			NameLookup:          in.nameLookup,
 		}
 		if in.packageOverride != "" {
 			input.Package = in.packageOverride
@@ -194,6 +196,7 @@ func fillTemplates() {
 			Package:          pkg,
 			Fields:           fields,
 			RecursiveListing: inputMap[pkg].recursiveListing,
+			NameLookup:       inputMap[pkg].nameLookup,
 		}
 
 		if err := optionTemplate.Execute(outBuf, input); err != nil {
@@ -266,14 +269,22 @@ func (c *Client) List(ctx context.Context, {{ .CollectionFunctionArg }} string,
 
 var readTemplate = template.Must(template.New("").Parse(`
 func (c *Client) Read(ctx context.Context, id string, opt... Option) (*{{ .Name }}ReadResult, error) {
+	{{- if .NameLookup }}
+	opts, apiOpts := getOpts(opt...)
+	if id == "" {
+		id = opts.withLookupName
+	}
+	{{- end }}
 	if id == "" {
 		return nil, fmt.Errorf("empty id value passed into Read request")
 	}
 	if c.client == nil {
 		return nil, fmt.Errorf("nil client")
 	}
+	{{- if not .NameLookup }}
 
 	opts, apiOpts := getOpts(opt...)
+	{{- end }}
 
 	req, err := c.client.NewRequest(ctx, "GET", {{ .ResourcePath }}, nil, apiOpts...)
 	if err != nil {
@@ -707,6 +718,7 @@ type options struct {
 	withSkipCurlOutput bool
 	withFilter string
 	{{ if .RecursiveListing }} withRecursive bool {{ end }}
+	{{ if .NameLookup }} withLookupName string {{ end }}
 }
 
 func getDefaultOptions() options {
@@ -732,6 +744,9 @@ func getOpts(opt ...Option) (options, []api.Option) {
 	}{{ if .RecursiveListing }}
 	if opts.withRecursive {
 		opts.queryMap["recursive"] = strconv.FormatBool(opts.withRecursive)
+	} {{ end }}{{ if .NameLookup }}
+	if opts.withLookupName != "" {
+		opts.queryMap["name"] = opts.withLookupName
 	} {{ end }}
 	return opts, apiOpts
 }
@@ -771,6 +786,17 @@ func WithRecursive(recurse bool) Option {
 	}
 }
 {{ end }}
+{{ if .NameLookup }}
+// WithLookupByName tells the API to read the resource with the given name in
+// the scope with the given ID instead of by ID. The ID passed to Read must be
+// empty or the name.
+func WithLookupByName(name, scopeId string) Option {
+	return func(o *options) {
+		o.withLookupName = name
+		o.queryMap["scope_id"] = scopeId
+	}
+}
+{{ end }}
 {{ range $fieldIndex, $field := .Fields }}
 {{ $subtypes := (removeDups $field.SubtypeNames ) }}
 {{ if ( eq ( len ( $subtypes ) ) 0 )}}
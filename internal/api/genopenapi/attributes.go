@@ -0,0 +1,294 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/boundary/sdk/pbs/controller/protooptions"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	_ "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/accounts"
+	_ "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authmethods"
+	_ "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentiallibraries"
+	_ "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentials"
+	_ "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentialstores"
+	_ "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostcatalogs"
+	_ "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hosts"
+	_ "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostsets"
+	_ "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/managedgroups"
+	_ "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+	_ "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/workers"
+)
+
+const (
+	// attributesField is the JSON name every option of an attributes oneof
+	// is marshaled as
+	attributesField = "attributes"
+	// discriminatorField is the field holding the subtype of a resource
+	discriminatorField = "type"
+	defaultSubtype     = "default"
+)
+
+// addAttributeUnions replaces the schema of every resource with typed
+// subtype attributes by a union of one schema per subtype, discriminated by
+// the type of the resource. The v2 description models the attributes as a
+// free-form object, since the typed attributes are internal fields of the
+// protos.
+//
+// For a resource named R, the schema R.Base holds the fields common to all
+// subtypes, the schema R.<subtype> extends it with the attributes of the
+// subtype, and R.default with free-form attributes for subtypes without a
+// typed schema, such as plugin based subtypes.
+func addAttributeUnions(v3 map[string]any) error {
+	schemas := v3["components"].(map[string]any)["schemas"].(map[string]any)
+	for _, name := range sortedKeys(schemas) {
+		d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name))
+		if err != nil {
+			continue
+		}
+		md, ok := d.(protoreflect.MessageDescriptor)
+		if !ok {
+			continue
+		}
+		subtypes, err := attributeSubtypes(md)
+		if err != nil {
+			return fmt.Errorf("error reading attribute subtypes of %s: %w", name, err)
+		}
+		if len(subtypes) == 0 {
+			continue
+		}
+		schema, ok := schemas[name].(map[string]any)
+		if !ok {
+			return fmt.Errorf("schema %s is not an object", name)
+		}
+		if err := addUnion(schemas, name, schema, subtypes); err != nil {
+			return fmt.Errorf("error adding union of %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// attributeSubtypes returns the attribute message of each subtype of the
+// attrs oneof of the message, if any
+func attributeSubtypes(md protoreflect.MessageDescriptor) (map[string]protoreflect.MessageDescriptor, error) {
+	subtypes := make(map[string]protoreflect.MessageDescriptor)
+	for i := 0; i < md.Oneofs().Len(); i++ {
+		od := md.Oneofs().Get(i)
+		if od.IsSynthetic() {
+			continue
+		}
+		for j := 0; j < od.Fields().Len(); j++ {
+			fd := od.Fields().Get(j)
+			subtype, _ := proto.GetExtension(fd.Options(), protooptions.E_Subtype).(string)
+			if subtype == "" || subtype == defaultSubtype || fd.Message() == nil {
+				continue
+			}
+			if _, ok := subtypes[subtype]; ok {
+				return nil, fmt.Errorf("subtype %q used by more than one field", subtype)
+			}
+			subtypes[subtype] = fd.Message()
+		}
+	}
+	return subtypes, nil
+}
+
+func addUnion(schemas map[string]any, name string, schema map[string]any, subtypes map[string]protoreflect.MessageDescriptor) error {
+	props, ok := schema["properties"].(map[string]any)
+	if !ok {
+		return fmt.Errorf("no properties")
+	}
+	defaultAttrs, ok := props[attributesField]
+	if !ok {
+		return fmt.Errorf("no %s property", attributesField)
+	}
+
+	baseName := name + ".Base"
+	baseProps := make(map[string]any, len(props))
+	for k, v := range props {
+		if k != attributesField {
+			baseProps[k] = v
+		}
+	}
+	base := make(map[string]any, len(schema))
+	for k, v := range schema {
+		base[k] = v
+	}
+	base["properties"] = baseProps
+	schemas[baseName] = base
+
+	var oneOf, typed []any
+	mapping := make(map[string]any, len(subtypes))
+	for _, st := range sortedKeys(subtypes) {
+		attrsRef, err := messageRef(schemas, subtypes[st])
+		if err != nil {
+			return err
+		}
+		variantName := name + "." + st
+		schemas[variantName] = map[string]any{
+			"description": fmt.Sprintf("A %s of type %q.", shortName(name), st),
+			"allOf": []any{
+				map[string]any{"$ref": v3RefPrefix + baseName},
+				map[string]any{
+					"type":     "object",
+					"required": []any{discriminatorField},
+					"properties": map[string]any{
+						discriminatorField: map[string]any{"const": st},
+						attributesField:    attrsRef,
+					},
+				},
+			},
+		}
+		oneOf = append(oneOf, map[string]any{"$ref": v3RefPrefix + variantName})
+		mapping[st] = v3RefPrefix + variantName
+		typed = append(typed, st)
+	}
+
+	defaultName := name + "." + defaultSubtype
+	schemas[defaultName] = map[string]any{
+		"description": fmt.Sprintf("A %s of a type without typed attributes.", shortName(name)),
+		"allOf": []any{
+			map[string]any{"$ref": v3RefPrefix + baseName},
+			map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					discriminatorField: map[string]any{"not": map[string]any{"enum": typed}},
+					attributesField:    defaultAttrs,
+				},
+			},
+		},
+	}
+	oneOf = append(oneOf, map[string]any{"$ref": v3RefPrefix + defaultName})
+
+	union := map[string]any{
+		"oneOf": oneOf,
+		"discriminator": map[string]any{
+			"propertyName": discriminatorField,
+			"mapping":      mapping,
+		},
+	}
+	for _, k := range []string{"title", "description"} {
+		if v, ok := schema[k]; ok {
+			union[k] = v
+		}
+	}
+	schemas[name] = union
+	return nil
+}
+
+// shortName returns the unqualified name of a schema
+func shortName(name string) string {
+	return string(protoreflect.FullName(name).Name())
+}
+
+// messageRef returns a reference to the schema of the message, generating
+// the schema if it isn't in schemas yet
+func messageRef(schemas map[string]any, md protoreflect.MessageDescriptor) (map[string]any, error) {
+	name := string(md.FullName())
+	ref := map[string]any{"$ref": v3RefPrefix + name}
+	if _, ok := schemas[name]; ok {
+		return ref, nil
+	}
+	// Reserve the name so that recursive messages terminate
+	schemas[name] = nil
+
+	props := make(map[string]any, md.Fields().Len())
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		s, err := fieldSchema(schemas, fd)
+		if err != nil {
+			return nil, fmt.Errorf("error generating schema of field %s: %w", fd.FullName(), err)
+		}
+		props[string(fd.Name())] = s
+	}
+	schemas[name] = map[string]any{
+		"type":       "object",
+		"properties": props,
+	}
+	return ref, nil
+}
+
+func fieldSchema(schemas map[string]any, fd protoreflect.FieldDescriptor) (map[string]any, error) {
+	switch {
+	case fd.IsMap():
+		v, err := valueSchema(schemas, fd.MapValue())
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"type": "object", "additionalProperties": v}, nil
+	case fd.IsList():
+		v, err := valueSchema(schemas, fd)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"type": "array", "items": v}, nil
+	default:
+		return valueSchema(schemas, fd)
+	}
+}
+
+// valueSchema returns the schema of a single value of the field, matching the
+// schemas protoc-gen-openapiv2 generates for the other fields
+func valueSchema(schemas map[string]any, fd protoreflect.FieldDescriptor) (map[string]any, error) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return map[string]any{"type": "boolean"}, nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return map[string]any{"type": "integer", "format": "int32"}, nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return map[string]any{"type": "integer", "format": "int64"}, nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return map[string]any{"type": "string", "format": "int64"}, nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return map[string]any{"type": "string", "format": "uint64"}, nil
+	case protoreflect.FloatKind:
+		return map[string]any{"type": "number", "format": "float"}, nil
+	case protoreflect.DoubleKind:
+		return map[string]any{"type": "number", "format": "double"}, nil
+	case protoreflect.StringKind:
+		return map[string]any{"type": "string"}, nil
+	case protoreflect.BytesKind:
+		return map[string]any{"type": "string", "format": "byte"}, nil
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		enum := make([]any, 0, values.Len())
+		for i := 0; i < values.Len(); i++ {
+			enum = append(enum, string(values.Get(i).Name()))
+		}
+		return map[string]any{"type": "string", "enum": enum}, nil
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if s, ok := wellKnownSchemas[fd.Message().FullName()]; ok {
+			// Copy, so that the schemas don't share maps
+			c := make(map[string]any, len(s))
+			for k, v := range s {
+				c[k] = v
+			}
+			return c, nil
+		}
+		return messageRef(schemas, fd.Message())
+	}
+	return nil, fmt.Errorf("unsupported kind %s", fd.Kind())
+}
+
+// wellKnownSchemas are the schemas of the well known types, according to
+// their JSON mapping
+var wellKnownSchemas = map[protoreflect.FullName]map[string]any{
+	"google.protobuf.Timestamp":   {"type": "string", "format": "date-time"},
+	"google.protobuf.Duration":    {"type": "string"},
+	"google.protobuf.FieldMask":   {"type": "string"},
+	"google.protobuf.Struct":      {"type": "object"},
+	"google.protobuf.Value":       {},
+	"google.protobuf.ListValue":   {"type": "array", "items": map[string]any{}},
+	"google.protobuf.StringValue": {"type": "string"},
+	"google.protobuf.BytesValue":  {"type": "string", "format": "byte"},
+	"google.protobuf.BoolValue":   {"type": "boolean"},
+	"google.protobuf.Int32Value":  {"type": "integer", "format": "int32"},
+	"google.protobuf.UInt32Value": {"type": "integer", "format": "int64"},
+	"google.protobuf.Int64Value":  {"type": "string", "format": "int64"},
+	"google.protobuf.UInt64Value": {"type": "string", "format": "uint64"},
+	"google.protobuf.FloatValue":  {"type": "number", "format": "float"},
+	"google.protobuf.DoubleValue": {"type": "number", "format": "double"},
+}
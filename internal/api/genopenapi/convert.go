@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	openApiVersion = "3.1.0"
+	v2RefPrefix    = "#/definitions/"
+	v3RefPrefix    = "#/components/schemas/"
+	jsonMediaType  = "application/json"
+)
+
+// convert converts an OpenAPI v2 description, as generated by
+// protoc-gen-openapiv2, to OpenAPI 3.1. Only the constructs emitted by the
+// generator for the controller API are supported.
+func convert(v2 map[string]any) (map[string]any, error) {
+	if v := v2["swagger"]; v != "2.0" {
+		return nil, fmt.Errorf("unsupported swagger version %v", v)
+	}
+	v3 := map[string]any{
+		"openapi": openApiVersion,
+	}
+	for _, k := range []string{"info", "tags"} {
+		if v, ok := v2[k]; ok {
+			v3[k] = v
+		}
+	}
+	v3["servers"] = convertServers(v2)
+
+	schemas := make(map[string]any)
+	definitions, _ := v2["definitions"].(map[string]any)
+	for name, def := range definitions {
+		schemas[name] = convertSchema(def)
+	}
+	v3["components"] = map[string]any{
+		"schemas": schemas,
+	}
+
+	paths := make(map[string]any)
+	v2Paths, _ := v2["paths"].(map[string]any)
+	for path, item := range v2Paths {
+		ops, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("path %q is not an object", path)
+		}
+		v3Ops := make(map[string]any, len(ops))
+		for method, op := range ops {
+			v2Op, ok := op.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("operation %s %q is not an object", method, path)
+			}
+			v3Op, err := convertOperation(v2Op)
+			if err != nil {
+				return nil, fmt.Errorf("error converting operation %s %q: %w", method, path, err)
+			}
+			v3Ops[method] = v3Op
+		}
+		paths[path] = v3Ops
+	}
+	v3["paths"] = paths
+	return v3, nil
+}
+
+// convertOperation moves the body parameter of an operation to its request
+// body, and the schemas of its parameters and responses to their v3
+// locations.
+func convertOperation(v2Op map[string]any) (map[string]any, error) {
+	v3Op := make(map[string]any, len(v2Op))
+	for k, v := range v2Op {
+		switch k {
+		case "parameters", "responses", "consumes", "produces":
+		default:
+			v3Op[k] = v
+		}
+	}
+
+	params, _ := v2Op["parameters"].([]any)
+	var v3Params []any
+	for _, p := range params {
+		param, ok := p.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("parameter is not an object")
+		}
+		if param["in"] == "body" {
+			body := map[string]any{
+				"content": map[string]any{
+					jsonMediaType: map[string]any{
+						"schema": convertSchema(param["schema"]),
+					},
+				},
+			}
+			if required, ok := param["required"].(bool); ok {
+				body["required"] = required
+			}
+			v3Op["requestBody"] = body
+			continue
+		}
+
+		v3Param := make(map[string]any)
+		schema := make(map[string]any)
+		for k, v := range param {
+			switch k {
+			case "name", "in", "required", "description":
+				v3Param[k] = v
+			case "collectionFormat":
+				if v == "multi" {
+					v3Param["explode"] = true
+				}
+			default:
+				schema[k] = convertSchema(v)
+			}
+		}
+		v3Param["schema"] = schema
+		v3Params = append(v3Params, v3Param)
+	}
+	if len(v3Params) > 0 {
+		v3Op["parameters"] = v3Params
+	}
+
+	responses, _ := v2Op["responses"].(map[string]any)
+	v3Responses := make(map[string]any, len(responses))
+	for code, r := range responses {
+		resp, ok := r.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("response %s is not an object", code)
+		}
+		v3Resp := map[string]any{
+			// A description is required in v3
+			"description": resp["description"],
+		}
+		if v3Resp["description"] == nil {
+			v3Resp["description"] = ""
+		}
+		if schema, ok := resp["schema"]; ok {
+			v3Resp["content"] = map[string]any{
+				jsonMediaType: map[string]any{
+					"schema": convertSchema(schema),
+				},
+			}
+		}
+		v3Responses[code] = v3Resp
+	}
+	v3Op["responses"] = v3Responses
+	return v3Op, nil
+}
+
+// convertServers returns the v3 servers of the host, base path and schemes of
+// the v2 description. Without a host the API is served by the host serving
+// the description, so the server url is relative.
+func convertServers(v2 map[string]any) []any {
+	host, _ := v2["host"].(string)
+	basePath, _ := v2["basePath"].(string)
+	if host == "" {
+		if basePath == "" {
+			basePath = "/"
+		}
+		return []any{map[string]any{"url": basePath}}
+	}
+	schemes, _ := v2["schemes"].([]any)
+	if len(schemes) == 0 {
+		schemes = []any{"https"}
+	}
+	servers := make([]any, 0, len(schemes))
+	for _, scheme := range schemes {
+		servers = append(servers, map[string]any{
+			"url": fmt.Sprintf("%v://%s%s", scheme, host, basePath),
+		})
+	}
+	return servers
+}
+
+// convertSchema rewrites the references of a schema to point to the v3
+// components, and the x-nullable extension of v2 to the null type of JSON
+// schema. The schemas emitted by the generator are otherwise valid JSON
+// schemas, as used by OpenAPI 3.1.
+func convertSchema(s any) any {
+	switch v := s.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k, e := range v {
+			switch {
+			case k == "$ref":
+				if ref, ok := e.(string); ok {
+					out[k] = v3RefPrefix + strings.TrimPrefix(ref, v2RefPrefix)
+					continue
+				}
+			case k == "x-nullable":
+				continue
+			}
+			out[k] = convertSchema(e)
+		}
+		if nullable, _ := v["x-nullable"].(bool); nullable {
+			return nullableSchema(out)
+		}
+		return out
+	case []any:
+		out := make([]any, 0, len(v))
+		for _, e := range v {
+			out = append(out, convertSchema(e))
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// nullableSchema returns the schema allowing null values as well. A reference
+// can't be combined with a type, so a nullable reference becomes a union of
+// the reference and null.
+func nullableSchema(s map[string]any) map[string]any {
+	switch t := s["type"].(type) {
+	case string:
+		s["type"] = []any{t, "null"}
+	case []any:
+		for _, e := range t {
+			if e == "null" {
+				return s
+			}
+		}
+		s["type"] = append(t, "null")
+	default:
+		if ref, ok := s["$ref"]; ok {
+			delete(s, "$ref")
+			s["anyOf"] = []any{
+				map[string]any{"$ref": ref},
+				map[string]any{"type": "null"},
+			}
+		}
+	}
+	return s
+}
+
+// sortedKeys returns the keys of m in order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvert(t *testing.T) {
+	const v2 = `{
+  "swagger": "2.0",
+  "info": {"title": "Boundary Controller HTTP API", "version": "version not set"},
+  "tags": [{"name": "controller.api.services.v1.ScopeService"}],
+  "schemes": ["https", "http"],
+  "consumes": ["application/json"],
+  "produces": ["application/json"],
+  "paths": {
+    "/v1/scopes": {
+      "post": {
+        "operationId": "ScopeService_CreateScope",
+        "responses": {
+          "200": {"description": "A successful response.", "schema": {"$ref": "#/definitions/controller.api.resources.scopes.v1.Scope"}},
+          "default": {"schema": {"$ref": "#/definitions/google.rpc.Status"}}
+        },
+        "parameters": [
+          {"name": "item", "in": "body", "required": true, "schema": {"$ref": "#/definitions/controller.api.resources.scopes.v1.Scope"}},
+          {"name": "skip_admin_role_creation", "in": "query", "required": false, "type": "boolean"},
+          {"name": "filter", "in": "query", "required": false, "type": "array", "items": {"type": "string"}, "collectionFormat": "multi"}
+        ],
+        "tags": ["controller.api.services.v1.ScopeService"]
+      }
+    }
+  },
+  "definitions": {
+    "controller.api.resources.scopes.v1.Scope": {
+      "type": "object",
+      "properties": {
+        "id": {"type": "string", "readOnly": true},
+        "name": {"type": "string", "x-nullable": true},
+        "aliases": {"type": ["array"], "items": {"type": "string"}, "x-nullable": true},
+        "scope": {"$ref": "#/definitions/controller.api.resources.scopes.v1.ScopeInfo", "x-nullable": true},
+        "children": {"type": "array", "items": {"$ref": "#/definitions/controller.api.resources.scopes.v1.ScopeInfo"}}
+      }
+    },
+    "controller.api.resources.scopes.v1.ScopeInfo": {
+      "type": "object",
+      "properties": {"id": {"type": "string"}}
+    },
+    "google.rpc.Status": {
+      "type": "object",
+      "properties": {"code": {"type": "integer", "format": "int32", "x-nullable": false}}
+    }
+  }
+}`
+	const want = `{
+  "openapi": "3.1.0",
+  "info": {"title": "Boundary Controller HTTP API", "version": "version not set"},
+  "tags": [{"name": "controller.api.services.v1.ScopeService"}],
+  "servers": [{"url": "/"}],
+  "paths": {
+    "/v1/scopes": {
+      "post": {
+        "operationId": "ScopeService_CreateScope",
+        "requestBody": {
+          "required": true,
+          "content": {"application/json": {"schema": {"$ref": "#/components/schemas/controller.api.resources.scopes.v1.Scope"}}}
+        },
+        "parameters": [
+          {"name": "skip_admin_role_creation", "in": "query", "required": false, "schema": {"type": "boolean"}},
+          {"name": "filter", "in": "query", "required": false, "explode": true, "schema": {"type": "array", "items": {"type": "string"}}}
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "content": {"application/json": {"schema": {"$ref": "#/components/schemas/controller.api.resources.scopes.v1.Scope"}}}
+          },
+          "default": {
+            "description": "",
+            "content": {"application/json": {"schema": {"$ref": "#/components/schemas/google.rpc.Status"}}}
+          }
+        },
+        "tags": ["controller.api.services.v1.ScopeService"]
+      }
+    }
+  },
+  "components": {
+    "schemas": {
+      "controller.api.resources.scopes.v1.Scope": {
+        "type": "object",
+        "properties": {
+          "id": {"type": "string", "readOnly": true},
+          "name": {"type": ["string", "null"]},
+          "aliases": {"type": ["array", "null"], "items": {"type": "string"}},
+          "scope": {"anyOf": [{"$ref": "#/components/schemas/controller.api.resources.scopes.v1.ScopeInfo"}, {"type": "null"}]},
+          "children": {"type": "array", "items": {"$ref": "#/components/schemas/controller.api.resources.scopes.v1.ScopeInfo"}}
+        }
+      },
+      "controller.api.resources.scopes.v1.ScopeInfo": {
+        "type": "object",
+        "properties": {"id": {"type": "string"}}
+      },
+      "google.rpc.Status": {
+        "type": "object",
+        "properties": {"code": {"type": "integer", "format": "int32"}}
+      }
+    }
+  }
+}`
+
+	var in map[string]any
+	require.NoError(t, json.Unmarshal([]byte(v2), &in))
+	got, err := convert(in)
+	require.NoError(t, err)
+
+	// compare the json encodings, since the expected numbers and arrays are
+	// decoded to different types than the ones built by convert
+	gotJson, err := json.Marshal(got)
+	require.NoError(t, err)
+	assert.JSONEq(t, want, string(gotJson))
+}
+
+func TestConvert_unsupportedVersion(t *testing.T) {
+	_, err := convert(map[string]any{"openapi": "3.0.0"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported swagger version")
+}
+
+func TestConvertServers(t *testing.T) {
+	tests := []struct {
+		name string
+		v2   map[string]any
+		want []any
+	}{
+		{
+			name: "no-host",
+			v2:   map[string]any{"schemes": []any{"https", "http"}},
+			want: []any{map[string]any{"url": "/"}},
+		},
+		{
+			name: "no-host-base-path",
+			v2:   map[string]any{"basePath": "/api"},
+			want: []any{map[string]any{"url": "/api"}},
+		},
+		{
+			name: "host",
+			v2:   map[string]any{"host": "boundary.example.com:9200", "basePath": "/api", "schemes": []any{"https", "http"}},
+			want: []any{
+				map[string]any{"url": "https://boundary.example.com:9200/api"},
+				map[string]any{"url": "http://boundary.example.com:9200/api"},
+			},
+		},
+		{
+			name: "host-without-schemes",
+			v2:   map[string]any{"host": "boundary.example.com"},
+			want: []any{map[string]any{"url": "https://boundary.example.com"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, convertServers(tt.v2))
+		})
+	}
+}
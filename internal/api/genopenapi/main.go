@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// genopenapi generates the OpenAPI 3.1 description of the controller API
+// from the OpenAPI v2 description generated from the service protos. Unlike
+// the v2 description, the attributes of resources with subtypes are modeled
+// as unions discriminated by the type of the resource.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const (
+	inFile  = "controller.swagger.json"
+	outFile = "controller.openapi.json"
+)
+
+func main() {
+	basePath := os.Getenv("OPENAPI_GEN_BASEPATH")
+	in, err := os.ReadFile(filepath.Join(basePath, inFile))
+	if err != nil {
+		fmt.Printf("error reading %q: %v\n", inFile, err)
+		os.Exit(1)
+	}
+	var v2 map[string]any
+	if err := json.Unmarshal(in, &v2); err != nil {
+		fmt.Printf("error parsing %q: %v\n", inFile, err)
+		os.Exit(1)
+	}
+
+	v3, err := convert(v2)
+	if err != nil {
+		fmt.Printf("error converting %q: %v\n", inFile, err)
+		os.Exit(1)
+	}
+	if err := addAttributeUnions(v3); err != nil {
+		fmt.Printf("error adding attribute schemas: %v\n", err)
+		os.Exit(1)
+	}
+
+	var out bytes.Buffer
+	enc := json.NewEncoder(&out)
+	// Descriptions are not embedded in HTML, so they are kept readable
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v3); err != nil {
+		fmt.Printf("error encoding %q: %v\n", outFile, err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(filepath.Join(basePath, outFile), out.Bytes(), 0o644); err != nil {
+		fmt.Printf("error writing %q: %v\n", outFile, err)
+		os.Exit(1)
+	}
+}
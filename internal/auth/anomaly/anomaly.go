@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package anomaly provides hooks for evaluating authentication attempts for
+// suspicious behavior.  Every evaluated attempt results in a Decision which is
+// emitted as an observation event, enriched with the location of the client
+// and the outcome of the heuristics that were checked.
+//
+// The heuristics currently supported are:
+//
+//   - Impossible travel: a successful authentication from a location that the
+//     user could not have reached since their previous successful
+//     authentication at the configured maximum travel speed.  This requires a
+//     Resolver to be configured, such as a NetworkResolver.
+//
+//   - New device: a successful authentication from a device which has not
+//     previously been seen for the user.  Devices are identified by the
+//     client's user agent and ip.  New devices are only reported; since
+//     devices are only remembered once allowed, denying them would lock users
+//     out of every device but their first.
+//
+// When impossible travel is detected, the configured Action for it is applied.
+package anomaly
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// Action is what is done with an authentication attempt once it has been
+// evaluated.
+type Action string
+
+const (
+	// AllowAction allows the authentication attempt to proceed.
+	AllowAction Action = "allow"
+
+	// DenyAction rejects the authentication attempt.
+	DenyAction Action = "deny"
+)
+
+// ParseAction returns the Action for the provided string.  An empty string is
+// treated as AllowAction.
+func ParseAction(s string) (Action, error) {
+	const op = "anomaly.ParseAction"
+	switch a := Action(strings.ToLower(strings.TrimSpace(s))); a {
+	case "":
+		return AllowAction, nil
+	case AllowAction, DenyAction:
+		return a, nil
+	default:
+		return "", errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("unknown action %q", s))
+	}
+}
+
+// Location is the approximate location and network of a client ip.
+type Location struct {
+	CountryCode     string  `json:"country_code,omitempty" class:"public"`
+	City            string  `json:"city,omitempty" class:"public"`
+	Latitude        float64 `json:"latitude,omitempty" class:"public"`
+	Longitude       float64 `json:"longitude,omitempty" class:"public"`
+	Asn             uint32  `json:"asn,omitempty" class:"public"`
+	AsnOrganization string  `json:"asn_organization,omitempty" class:"public"`
+}
+
+func (l *Location) hasCoordinates() bool {
+	return l != nil && (l.Latitude != 0 || l.Longitude != 0)
+}
+
+// Resolver resolves the Location of a client ip.  Boundary does not include a
+// geo ip database, so deployments that want location enrichment and impossible
+// travel detection must provide an implementation.  Resolve should return nil,
+// nil when the ip's location is unknown.
+type Resolver interface {
+	Resolve(ctx context.Context, ip string) (*Location, error)
+}
+
+// Attempt describes an authentication attempt to be evaluated.
+type Attempt struct {
+	AuthMethodId string
+	LoginName    string
+	AccountId    string
+	UserId       string
+	ClientIp     string
+	UserAgent    string
+	Success      bool
+}
+
+// Decision is the result of evaluating an Attempt.  It is emitted as the
+// "auth-decision" header of an observation event.
+type Decision struct {
+	AuthMethodId     string    `json:"auth_method_id,omitempty" class:"public"`
+	LoginName        string    `json:"login_name,omitempty" class:"sensitive"`
+	AccountId        string    `json:"account_id,omitempty" class:"public"`
+	UserId           string    `json:"user_id,omitempty" class:"public"`
+	ClientIp         string    `json:"client_ip,omitempty" class:"public"`
+	Success          bool      `json:"success" class:"public"`
+	Location         *Location `json:"location,omitempty" class:"public"`
+	ImpossibleTravel bool      `json:"impossible_travel,omitempty" class:"public"`
+	TravelSpeedKmh   float64   `json:"travel_speed_kmh,omitempty" class:"public"`
+	NewDevice        bool      `json:"new_device,omitempty" class:"public"`
+	Action           Action    `json:"action" class:"public"`
+}
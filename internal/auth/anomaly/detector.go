@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package anomaly
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/observability/event"
+)
+
+const (
+	// maxDevicesPerUser bounds the number of devices remembered for a user.
+	maxDevicesPerUser = 20
+
+	// minTravelDistanceKm is the distance below which travel is never
+	// considered impossible, to allow for inaccuracy in geo ip data.
+	minTravelDistanceKm = 100
+
+	earthRadiusKm = 6371
+)
+
+// Detector evaluates authentication attempts using heuristics based on each
+// user's recent authentication history.  History is kept in memory, per
+// controller, for a bounded number of users.
+type Detector struct {
+	resolver               Resolver
+	maxTravelSpeedKmh      float64
+	impossibleTravelAction Action
+	historySize            int
+
+	// now is used to get the current time and exists for testing
+	now func() time.Time
+
+	mu      sync.Mutex
+	users   map[string]*list.Element
+	lruList *list.List
+}
+
+type userHistory struct {
+	userId       string
+	lastLocation *Location
+	lastTime     time.Time
+	devices      map[string]time.Time
+}
+
+// NewDetector creates a new Detector.  Supported options are WithResolver,
+// WithMaxTravelSpeedKmh, WithImpossibleTravelAction and WithHistorySize.
+func NewDetector(opt ...Option) *Detector {
+	opts := getOpts(opt...)
+	return &Detector{
+		resolver:               opts.withResolver,
+		maxTravelSpeedKmh:      opts.withMaxTravelSpeedKmh,
+		impossibleTravelAction: opts.withImpossibleTravelAction,
+		historySize:            opts.withHistorySize,
+		now:                    time.Now,
+		users:                  make(map[string]*list.Element),
+		lruList:                list.New(),
+	}
+}
+
+// Evaluate evaluates the authentication attempt and emits the resulting
+// Decision as an observation event.  Heuristics are only checked for
+// successful attempts with a user id; failed attempts are enriched and
+// emitted but always allowed, as they are already being rejected.  The user's
+// history is only updated when the attempt is allowed.
+func (d *Detector) Evaluate(ctx context.Context, a Attempt) *Decision {
+	const op = "anomaly.(Detector).Evaluate"
+	dec := &Decision{
+		AuthMethodId: a.AuthMethodId,
+		LoginName:    a.LoginName,
+		AccountId:    a.AccountId,
+		UserId:       a.UserId,
+		ClientIp:     a.ClientIp,
+		Success:      a.Success,
+		Action:       AllowAction,
+	}
+	if d.resolver != nil && a.ClientIp != "" {
+		loc, err := d.resolver.Resolve(ctx, a.ClientIp)
+		if err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to resolve client location", "client_ip", a.ClientIp))
+		}
+		dec.Location = loc
+	}
+
+	if a.Success && a.UserId != "" {
+		d.check(a, dec)
+	}
+
+	if err := event.WriteObservation(ctx, op, event.WithHeader("auth-decision", dec)); err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("unable to write auth decision event"))
+	}
+	return dec
+}
+
+// check applies the heuristics to the attempt, sets the outcome on the
+// decision and records the attempt in the user's history if it is allowed.
+func (d *Detector) check(a Attempt, dec *Decision) {
+	now := d.now()
+	device := deviceId(a.UserAgent, a.ClientIp)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var h *userHistory
+	if e, ok := d.users[a.UserId]; ok {
+		h = e.Value.(*userHistory)
+		d.lruList.MoveToFront(e)
+
+		if h.lastLocation.hasCoordinates() && dec.Location.hasCoordinates() {
+			dist := distanceKm(h.lastLocation, dec.Location)
+			hours := now.Sub(h.lastTime).Hours()
+			if dist > minTravelDistanceKm {
+				speed := math.Inf(1)
+				if hours > 0 {
+					speed = dist / hours
+				}
+				if speed > d.maxTravelSpeedKmh {
+					dec.ImpossibleTravel = true
+					if !math.IsInf(speed, 1) {
+						dec.TravelSpeedKmh = math.Round(speed)
+					}
+				}
+			}
+		}
+		if len(h.devices) > 0 {
+			if _, ok := h.devices[device]; !ok {
+				dec.NewDevice = true
+			}
+		}
+	}
+
+	if dec.ImpossibleTravel && d.impossibleTravelAction == DenyAction {
+		dec.Action = DenyAction
+	}
+	if dec.Action == DenyAction {
+		return
+	}
+
+	if h == nil {
+		h = &userHistory{userId: a.UserId, devices: make(map[string]time.Time)}
+		d.users[a.UserId] = d.lruList.PushFront(h)
+		for d.lruList.Len() > d.historySize {
+			oldest := d.lruList.Back()
+			d.lruList.Remove(oldest)
+			delete(d.users, oldest.Value.(*userHistory).userId)
+		}
+	}
+	if dec.Location.hasCoordinates() {
+		h.lastLocation = dec.Location
+		h.lastTime = now
+	}
+	h.devices[device] = now
+	if len(h.devices) > maxDevicesPerUser {
+		var oldestId string
+		var oldestTime time.Time
+		for id, t := range h.devices {
+			if oldestId == "" || t.Before(oldestTime) {
+				oldestId, oldestTime = id, t
+			}
+		}
+		delete(h.devices, oldestId)
+	}
+}
+
+// deviceId returns an identifier for the device described by the user agent
+// and client ip.
+func deviceId(userAgent, clientIp string) string {
+	sum := sha256.Sum256([]byte(userAgent + "|" + clientIp))
+	return hex.EncodeToString(sum[:])
+}
+
+// distanceKm returns the great-circle distance between two locations.
+func distanceKm(from, to *Location) float64 {
+	rad := func(deg float64) float64 { return deg * math.Pi / 180 }
+	dLat := rad(to.Latitude - from.Latitude)
+	dLon := rad(to.Longitude - from.Longitude)
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(rad(from.Latitude))*math.Cos(rad(to.Latitude))*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(h))
+}
@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package anomaly
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testResolver map[string]*Location
+
+func (r testResolver) Resolve(_ context.Context, ip string) (*Location, error) {
+	return r[ip], nil
+}
+
+var (
+	newYork = &Location{CountryCode: "US", City: "New York", Latitude: 40.71, Longitude: -74.01}
+	boston  = &Location{CountryCode: "US", City: "Boston", Latitude: 42.36, Longitude: -71.06}
+	london  = &Location{CountryCode: "GB", City: "London", Latitude: 51.51, Longitude: -0.13}
+)
+
+func TestDetector_Evaluate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	resolver := testResolver{
+		"10.0.0.1": newYork,
+		"10.0.0.2": boston,
+		"10.0.0.3": london,
+	}
+
+	type step struct {
+		after   time.Duration
+		attempt Attempt
+		want    Decision
+	}
+	tests := []struct {
+		name  string
+		opts  []Option
+		steps []step
+	}{
+		{
+			name: "first-login",
+			opts: []Option{WithResolver(resolver)},
+			steps: []step{
+				{
+					attempt: Attempt{UserId: "u_1", ClientIp: "10.0.0.1", UserAgent: "cli", Success: true},
+					want:    Decision{UserId: "u_1", ClientIp: "10.0.0.1", Success: true, Location: newYork, Action: AllowAction},
+				},
+			},
+		},
+		{
+			name: "plausible-travel",
+			opts: []Option{WithResolver(resolver), WithImpossibleTravelAction(DenyAction)},
+			steps: []step{
+				{
+					attempt: Attempt{UserId: "u_1", ClientIp: "10.0.0.1", UserAgent: "cli", Success: true},
+					want:    Decision{UserId: "u_1", ClientIp: "10.0.0.1", Success: true, Location: newYork, Action: AllowAction},
+				},
+				{
+					after:   time.Hour,
+					attempt: Attempt{UserId: "u_1", ClientIp: "10.0.0.2", UserAgent: "cli", Success: true},
+					want:    Decision{UserId: "u_1", ClientIp: "10.0.0.2", Success: true, Location: boston, NewDevice: true, Action: AllowAction},
+				},
+			},
+		},
+		{
+			name: "impossible-travel-allowed",
+			opts: []Option{WithResolver(resolver)},
+			steps: []step{
+				{
+					attempt: Attempt{UserId: "u_1", ClientIp: "10.0.0.1", UserAgent: "cli", Success: true},
+					want:    Decision{UserId: "u_1", ClientIp: "10.0.0.1", Success: true, Location: newYork, Action: AllowAction},
+				},
+				{
+					after:   time.Hour,
+					attempt: Attempt{UserId: "u_1", ClientIp: "10.0.0.3", UserAgent: "cli", Success: true},
+					want:    Decision{UserId: "u_1", ClientIp: "10.0.0.3", Success: true, Location: london, ImpossibleTravel: true, TravelSpeedKmh: 5570, NewDevice: true, Action: AllowAction},
+				},
+			},
+		},
+		{
+			name: "impossible-travel-denied",
+			opts: []Option{WithResolver(resolver), WithImpossibleTravelAction(DenyAction)},
+			steps: []step{
+				{
+					attempt: Attempt{UserId: "u_1", ClientIp: "10.0.0.1", UserAgent: "cli", Success: true},
+					want:    Decision{UserId: "u_1", ClientIp: "10.0.0.1", Success: true, Location: newYork, Action: AllowAction},
+				},
+				{
+					after:   time.Hour,
+					attempt: Attempt{UserId: "u_1", ClientIp: "10.0.0.3", UserAgent: "cli", Success: true},
+					want:    Decision{UserId: "u_1", ClientIp: "10.0.0.3", Success: true, Location: london, ImpossibleTravel: true, TravelSpeedKmh: 5570, NewDevice: true, Action: DenyAction},
+				},
+				{
+					// the denied attempt was not recorded, so travel is
+					// still measured from new york
+					after:   time.Hour,
+					attempt: Attempt{UserId: "u_1", ClientIp: "10.0.0.3", UserAgent: "cli", Success: true},
+					want:    Decision{UserId: "u_1", ClientIp: "10.0.0.3", Success: true, Location: london, ImpossibleTravel: true, TravelSpeedKmh: 2785, NewDevice: true, Action: DenyAction},
+				},
+			},
+		},
+		{
+			name: "new-device-allowed",
+			opts: []Option{WithImpossibleTravelAction(DenyAction)},
+			steps: []step{
+				{
+					attempt: Attempt{UserId: "u_1", ClientIp: "10.0.0.1", UserAgent: "cli", Success: true},
+					want:    Decision{UserId: "u_1", ClientIp: "10.0.0.1", Success: true, Action: AllowAction},
+				},
+				{
+					attempt: Attempt{UserId: "u_1", ClientIp: "10.0.0.1", UserAgent: "cli", Success: true},
+					want:    Decision{UserId: "u_1", ClientIp: "10.0.0.1", Success: true, Action: AllowAction},
+				},
+				{
+					attempt: Attempt{UserId: "u_1", ClientIp: "10.0.0.1", UserAgent: "desktop", Success: true},
+					want:    Decision{UserId: "u_1", ClientIp: "10.0.0.1", Success: true, NewDevice: true, Action: AllowAction},
+				},
+				{
+					// the new device was remembered
+					attempt: Attempt{UserId: "u_1", ClientIp: "10.0.0.1", UserAgent: "desktop", Success: true},
+					want:    Decision{UserId: "u_1", ClientIp: "10.0.0.1", Success: true, Action: AllowAction},
+				},
+			},
+		},
+		{
+			name: "failed-attempts-not-checked",
+			opts: []Option{WithResolver(resolver), WithImpossibleTravelAction(DenyAction)},
+			steps: []step{
+				{
+					attempt: Attempt{UserId: "u_1", ClientIp: "10.0.0.1", UserAgent: "cli", Success: true},
+					want:    Decision{UserId: "u_1", ClientIp: "10.0.0.1", Success: true, Location: newYork, Action: AllowAction},
+				},
+				{
+					attempt: Attempt{LoginName: "user", ClientIp: "10.0.0.3", UserAgent: "desktop"},
+					want:    Decision{LoginName: "user", ClientIp: "10.0.0.3", Location: london, Action: AllowAction},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			d := NewDetector(tt.opts...)
+			now := time.Now()
+			d.now = func() time.Time { return now }
+			for _, s := range tt.steps {
+				now = now.Add(s.after)
+				got := d.Evaluate(ctx, s.attempt)
+				assert.Equal(&s.want, got)
+			}
+		})
+	}
+}
+
+func TestDetector_historySize(t *testing.T) {
+	t.Parallel()
+	d := NewDetector(WithHistorySize(2))
+	for _, u := range []string{"u_1", "u_2", "u_3"} {
+		d.Evaluate(context.Background(), Attempt{UserId: u, Success: true})
+	}
+	assert.Len(t, d.users, 2)
+	assert.NotContains(t, d.users, "u_1")
+}
+
+func TestParseAction(t *testing.T) {
+	t.Parallel()
+	a, err := ParseAction("")
+	require.NoError(t, err)
+	assert.Equal(t, AllowAction, a)
+
+	a, err = ParseAction(" Deny")
+	require.NoError(t, err)
+	assert.Equal(t, DenyAction, a)
+
+	_, err = ParseAction("require_mfa")
+	assert.Error(t, err)
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package anomaly
+
+const (
+	defaultMaxTravelSpeedKmh = 1000
+	defaultHistorySize       = 10000
+)
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withResolver               Resolver
+	withMaxTravelSpeedKmh      float64
+	withImpossibleTravelAction Action
+	withHistorySize            int
+}
+
+func getDefaultOptions() options {
+	return options{
+		withMaxTravelSpeedKmh:      defaultMaxTravelSpeedKmh,
+		withImpossibleTravelAction: AllowAction,
+		withHistorySize:            defaultHistorySize,
+	}
+}
+
+// WithResolver provides an optional Resolver used to look up the location of
+// client ips.
+func WithResolver(r Resolver) Option {
+	return func(o *options) {
+		o.withResolver = r
+	}
+}
+
+// WithMaxTravelSpeedKmh provides an optional maximum speed, in kilometers per
+// hour, a user can plausibly travel between authentications.
+func WithMaxTravelSpeedKmh(s float64) Option {
+	return func(o *options) {
+		if s > 0 {
+			o.withMaxTravelSpeedKmh = s
+		}
+	}
+}
+
+// WithImpossibleTravelAction provides an optional Action to apply when
+// impossible travel is detected.
+func WithImpossibleTravelAction(a Action) Option {
+	return func(o *options) {
+		if a != "" {
+			o.withImpossibleTravelAction = a
+		}
+	}
+}
+
+// WithHistorySize provides an optional maximum number of users for which
+// authentication history is kept.
+func WithHistorySize(n int) Option {
+	return func(o *options) {
+		if n > 0 {
+			o.withHistorySize = n
+		}
+	}
+}
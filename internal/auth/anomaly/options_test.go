@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package anomaly
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_GetOpts provides unit tests for GetOpts and all the options
+func Test_GetOpts(t *testing.T) {
+	t.Parallel()
+	t.Run("WithResolver", func(t *testing.T) {
+		assert := assert.New(t)
+		r := testResolver{}
+		opts := getOpts(WithResolver(r))
+		testOpts := getDefaultOptions()
+		testOpts.withResolver = r
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithMaxTravelSpeedKmh", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithMaxTravelSpeedKmh(500))
+		testOpts := getDefaultOptions()
+		testOpts.withMaxTravelSpeedKmh = 500
+		assert.Equal(opts, testOpts)
+
+		opts = getOpts(WithMaxTravelSpeedKmh(-1))
+		assert.Equal(opts, getDefaultOptions())
+	})
+	t.Run("WithImpossibleTravelAction", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithImpossibleTravelAction(DenyAction))
+		testOpts := getDefaultOptions()
+		testOpts.withImpossibleTravelAction = DenyAction
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithHistorySize", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithHistorySize(5))
+		testOpts := getDefaultOptions()
+		testOpts.withHistorySize = 5
+		assert.Equal(opts, testOpts)
+	})
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package anomaly
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"sort"
+
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// Network is the Location of the clients in a range of addresses.
+type Network struct {
+	Prefix   netip.Prefix
+	Location Location
+}
+
+// NetworkResolver resolves client ips to the Location of the most specific
+// Network containing them.  It allows deployments without a geo ip database to
+// describe the locations of their own networks, such as offices and VPN
+// egress ranges.
+type NetworkResolver struct {
+	// networks is sorted from the most to the least specific prefix
+	networks []Network
+}
+
+var _ Resolver = (*NetworkResolver)(nil)
+
+// NewNetworkResolver creates a NetworkResolver for the networks.
+func NewNetworkResolver(ctx context.Context, networks []Network) (*NetworkResolver, error) {
+	const op = "anomaly.NewNetworkResolver"
+	r := &NetworkResolver{networks: make([]Network, 0, len(networks))}
+	for _, n := range networks {
+		if !n.Prefix.IsValid() {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "invalid network prefix")
+		}
+		n.Prefix = n.Prefix.Masked()
+		r.networks = append(r.networks, n)
+	}
+	sort.SliceStable(r.networks, func(i, j int) bool {
+		return r.networks[i].Prefix.Bits() > r.networks[j].Prefix.Bits()
+	})
+	return r, nil
+}
+
+// Resolve returns the Location of the most specific network containing the ip,
+// or nil if no network contains it.
+func (r *NetworkResolver) Resolve(ctx context.Context, ip string) (*Location, error) {
+	const op = "anomaly.(NetworkResolver).Resolve"
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter), errors.WithMsg(fmt.Sprintf("invalid ip %q", ip)))
+	}
+	addr = addr.Unmap()
+	for _, n := range r.networks {
+		if n.Prefix.Contains(addr) {
+			loc := n.Location
+			return &loc, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package anomaly
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkResolver(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	_, err := NewNetworkResolver(ctx, []Network{{}})
+	require.Error(t, err)
+
+	r, err := NewNetworkResolver(ctx, []Network{
+		{Prefix: netip.MustParsePrefix("10.0.0.0/8"), Location: *newYork},
+		{Prefix: netip.MustParsePrefix("10.1.2.3/16"), Location: *london},
+		{Prefix: netip.MustParsePrefix("2001:db8::/32"), Location: *boston},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		ip      string
+		want    *Location
+		wantErr bool
+	}{
+		{ip: "10.0.0.1", want: newYork},
+		{ip: "10.1.0.1", want: london},
+		{ip: "::ffff:10.1.0.1", want: london},
+		{ip: "2001:db8::1", want: boston},
+		{ip: "192.168.0.1"},
+		{ip: "not-an-ip", wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.ip, func(t *testing.T) {
+			got, err := r.Resolve(ctx, tt.ip)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
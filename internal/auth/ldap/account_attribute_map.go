@@ -6,6 +6,7 @@ package ldap
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"sort"
 	"strings"
 
@@ -29,9 +30,16 @@ const (
 	ToFullNameAttribute AccountToAttribute = "fullName"
 )
 
+// customToAttributeRegexp defines the valid names for custom to attributes,
+// which are stored in an account's entry attributes.
+var customToAttributeRegexp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]{0,127}$`)
+
 // ConvertToAccountToAttribute will convert a string to an AccountToAttribute.
 // Useful within the ldap package and service packages which wish to
-// convert/validate a string into an AccountToAttribute
+// convert/validate a string into an AccountToAttribute. Any string which isn't
+// a standard account attribute (email, fullName) but is a valid custom
+// attribute name (a letter followed by up to 127 letters, digits or
+// underscores) is returned as a custom AccountToAttribute.
 func ConvertToAccountToAttribute(ctx context.Context, s string) (AccountToAttribute, error) {
 	const op = "ldap.ConvertToAccountToAttribute"
 	switch {
@@ -39,8 +47,10 @@ func ConvertToAccountToAttribute(ctx context.Context, s string) (AccountToAttrib
 		return ToEmailAttribute, nil
 	case strings.EqualFold(s, string(ToFullNameAttribute)):
 		return ToFullNameAttribute, nil
+	case customToAttributeRegexp.MatchString(s):
+		return AccountToAttribute(s), nil
 	default:
-		return "", errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%q is not a valid ToAccountAttribute value (%q, %q)", s, ToEmailAttribute, ToFullNameAttribute))
+		return "", errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%q is not a valid ToAccountAttribute value (%q, %q or a custom attribute name)", s, ToEmailAttribute, ToFullNameAttribute))
 	}
 }
 
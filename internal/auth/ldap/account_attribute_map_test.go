@@ -6,6 +6,7 @@ package ldap
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/boundary/internal/auth/ldap/store"
@@ -42,6 +43,20 @@ func TestNewAccountAttributeMap(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:         "success-custom-to",
+			ctx:          testCtx,
+			authMethodId: "test-auth-method-id",
+			from:         "employeeID",
+			to:           "employee_id",
+			want: &AccountAttributeMap{
+				AccountAttributeMap: &store.AccountAttributeMap{
+					LdapMethodId:  "test-auth-method-id",
+					FromAttribute: "employeeID",
+					ToAttribute:   "employee_id",
+				},
+			},
+		},
 		{
 			name:            "missing-auth-method-id",
 			ctx:             testCtx,
@@ -206,6 +221,16 @@ func TestParseAccountAttributeMaps(t *testing.T) {
 				{To: "email", From: "from"},
 			},
 		},
+		{
+			name:     "custom",
+			ctx:      testCtx,
+			attrMaps: []string{"mail=email", "department=department", "employeeID=employee_id"},
+			want: []AttributeMap{
+				{To: "department", From: "department"},
+				{To: "employee_id", From: "employeeID"},
+				{To: "email", From: "mail"},
+			},
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -248,3 +273,35 @@ func FuzzParseAccountAttributeMaps(f *testing.F) {
 		}
 	})
 }
+
+func TestConvertToAccountToAttribute(t *testing.T) {
+	t.Parallel()
+	testCtx := context.Background()
+	tests := []struct {
+		name            string
+		s               string
+		want            AccountToAttribute
+		wantErrContains string
+	}{
+		{name: "email", s: "EMAIL", want: ToEmailAttribute},
+		{name: "full-name", s: "fullname", want: ToFullNameAttribute},
+		{name: "custom", s: "employee_id", want: "employee_id"},
+		{name: "custom-leading-digit", s: "1employee", wantErrContains: "\"1employee\" is not a valid ToAccountAttribute value"},
+		{name: "custom-too-long", s: strings.Repeat("a", 129), wantErrContains: "is not a valid ToAccountAttribute value"},
+		{name: "empty", s: "", wantErrContains: "\"\" is not a valid ToAccountAttribute value"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := ConvertToAccountToAttribute(testCtx, tc.s)
+			if tc.wantErrContains != "" {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+				assert.Contains(err.Error(), tc.wantErrContains)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tc.want, got)
+		})
+	}
+}
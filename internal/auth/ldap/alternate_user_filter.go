@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ldap
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/auth/ldap/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+const alternateUserFilterTableName = "auth_ldap_alternate_user_filter"
+
+// AlternateUserFilter represents an optional one to many auth method user
+// search filters, which are tried in priority order when the auth method's
+// UserFilter doesn't find the user's entry.  It is assigned to an LDAP
+// AuthMethod and updates/deletes to that AuthMethod are cascaded to its
+// AlternateUserFilters.  AlternateUserFilters are value objects of an
+// AuthMethod, therefore there's no need for oplog metadata, since only the
+// AuthMethod will have metadata because it's the root aggregate.
+type AlternateUserFilter struct {
+	*store.AlternateUserFilter
+	tableName string
+}
+
+// NewAlternateUserFilter creates a new in memory AlternateUserFilter.
+// filterPriority cannot be less than one. No options are currently supported.
+func NewAlternateUserFilter(ctx context.Context, authMethodId string, filterPriority int, userFilter string, _ ...Option) (*AlternateUserFilter, error) {
+	const op = "ldap.NewAlternateUserFilter"
+	switch {
+	case authMethodId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	case filterPriority < 1:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "filter priority cannot be less than one")
+	case strings.TrimSpace(userFilter) == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing user filter")
+	}
+	return &AlternateUserFilter{
+		AlternateUserFilter: &store.AlternateUserFilter{
+			LdapMethodId:   authMethodId,
+			FilterPriority: uint32(filterPriority),
+			UserFilter:     userFilter,
+		},
+	}, nil
+}
+
+// allocAlternateUserFilter makes an empty one in memory
+func allocAlternateUserFilter() *AlternateUserFilter {
+	return &AlternateUserFilter{
+		AlternateUserFilter: &store.AlternateUserFilter{},
+	}
+}
+
+// clone an AlternateUserFilter
+func (f *AlternateUserFilter) clone() *AlternateUserFilter {
+	cp := proto.Clone(f.AlternateUserFilter)
+	return &AlternateUserFilter{
+		AlternateUserFilter: cp.(*store.AlternateUserFilter),
+	}
+}
+
+// TableName returns the table name
+func (f *AlternateUserFilter) TableName() string {
+	if f.tableName != "" {
+		return f.tableName
+	}
+	return alternateUserFilterTableName
+}
+
+// SetTableName sets the table name.
+func (f *AlternateUserFilter) SetTableName(n string) {
+	f.tableName = n
+}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ldap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/auth/ldap/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestNewAlternateUserFilter(t *testing.T) {
+	t.Parallel()
+	testCtx := context.Background()
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		authMethodId    string
+		priority        int
+		userFilter      string
+		want            *AlternateUserFilter
+		wantErr         bool
+		wantErrCode     errors.Code
+		wantErrContains string
+	}{
+		{
+			name:         "valid",
+			ctx:          testCtx,
+			authMethodId: "test-id",
+			priority:     2,
+			userFilter:   "(userPrincipalName={{.Username}})",
+			want: &AlternateUserFilter{
+				AlternateUserFilter: &store.AlternateUserFilter{
+					LdapMethodId:   "test-id",
+					FilterPriority: 2,
+					UserFilter:     "(userPrincipalName={{.Username}})",
+				},
+			},
+		},
+		{
+			name:            "missing-auth-method-id",
+			ctx:             testCtx,
+			priority:        1,
+			userFilter:      "(userPrincipalName={{.Username}})",
+			wantErr:         true,
+			wantErrCode:     errors.InvalidParameter,
+			wantErrContains: "missing auth method id",
+		},
+		{
+			name:            "invalid-priority",
+			ctx:             testCtx,
+			authMethodId:    "test-id",
+			userFilter:      "(userPrincipalName={{.Username}})",
+			wantErr:         true,
+			wantErrCode:     errors.InvalidParameter,
+			wantErrContains: "filter priority cannot be less than one",
+		},
+		{
+			name:            "missing-user-filter",
+			ctx:             testCtx,
+			authMethodId:    "test-id",
+			priority:        1,
+			userFilter:      " ",
+			wantErr:         true,
+			wantErrCode:     errors.InvalidParameter,
+			wantErrContains: "missing user filter",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewAlternateUserFilter(tc.ctx, tc.authMethodId, tc.priority, tc.userFilter)
+			if tc.wantErr {
+				require.Error(err)
+				assert.Nil(got)
+				if tc.wantErrCode != errors.Unknown {
+					assert.True(errors.Match(errors.T(tc.wantErrCode), err))
+				}
+				if tc.wantErrContains != "" {
+					assert.Contains(err.Error(), tc.wantErrContains)
+				}
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tc.want, got)
+		})
+	}
+}
+
+func TestAlternateUserFilter_SetTableName(t *testing.T) {
+	t.Parallel()
+	defaultTableName := alternateUserFilterTableName
+	tests := []struct {
+		name      string
+		setNameTo string
+		want      string
+	}{
+		{
+			name:      "new-name",
+			setNameTo: "new-name",
+			want:      "new-name",
+		},
+		{
+			name:      "reset to default",
+			setNameTo: "",
+			want:      defaultTableName,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			def := allocAlternateUserFilter()
+			require.Equal(defaultTableName, def.TableName())
+			m := allocAlternateUserFilter()
+			m.SetTableName(tt.setNameTo)
+			assert.Equal(tt.want, m.TableName())
+		})
+	}
+}
+
+func TestAlternateUserFilter_clone(t *testing.T) {
+	t.Parallel()
+	testCtx := context.Background()
+	const priorityOfOne = 1
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		f, err := NewAlternateUserFilter(testCtx, "test-id", priorityOfOne, "(mail={{.Username}})")
+		require.NoError(err)
+		cp := f.clone()
+		assert.True(proto.Equal(cp.AlternateUserFilter, f.AlternateUserFilter))
+	})
+	t.Run("not-equal", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		f, err := NewAlternateUserFilter(testCtx, "test-id", priorityOfOne, "(mail={{.Username}})")
+		require.NoError(err)
+
+		f2, err := NewAlternateUserFilter(testCtx, "test-id", priorityOfOne, "(userPrincipalName={{.Username}})")
+		require.NoError(err)
+
+		cp := f.clone()
+		assert.True(!proto.Equal(cp.AlternateUserFilter, f2.AlternateUserFilter))
+	})
+}
@@ -31,8 +31,11 @@ type AuthMethod struct {
 //
 // Supports the options: WithUrls, WithName, WithDescription, WithStartTLS,
 // WithInsecureTLS, WithDiscoverDN, WithAnonGroupSearch, WithUpnDomain,
-// WithUserSearchConf, WithGroupSearchConf, WithCertificates, WithBindCredential
-// are the only valid options and all other options are ignored.
+// WithUserSearchConf, WithAlternateUserFilters, WithGroupSearchConf,
+// WithCertificates, WithBindCredential, WithDeletionProtected,
+// WithNestedGroupResolution, WithMaxNestedGroupDepth, WithGroupSearchPageSize,
+// WithGroupSearchSizeLimit are the only valid options and all other options
+// are ignored.
 func NewAuthMethod(ctx context.Context, scopeId string, opt ...Option) (*AuthMethod, error) {
 	const op = "ldap.NewAuthMethod"
 	switch {
@@ -46,29 +49,35 @@ func NewAuthMethod(ctx context.Context, scopeId string, opt ...Option) (*AuthMet
 
 	a := &AuthMethod{
 		AuthMethod: &store.AuthMethod{
-			ScopeId:              scopeId,
-			Name:                 opts.withName,
-			Description:          opts.withDescription,
-			OperationalState:     string(opts.withOperationalState), // if no option is specified, a new auth method is initially inactive
-			Urls:                 opts.withUrls,
-			StartTls:             opts.withStartTls,
-			InsecureTls:          opts.withInsecureTls,
-			DiscoverDn:           opts.withDiscoverDn,
-			AnonGroupSearch:      opts.withAnonGroupSearch,
-			UpnDomain:            opts.withUpnDomain,
-			UserDn:               opts.withUserDn,
-			UserAttr:             opts.withUserAttr,
-			UserFilter:           opts.withUserFilter,
-			EnableGroups:         opts.withEnableGroups,
-			UseTokenGroups:       opts.withUseTokenGroups,
-			GroupDn:              opts.withGroupDn,
-			GroupAttr:            opts.withGroupAttr,
-			GroupFilter:          opts.withGroupFilter,
-			BindDn:               opts.withBindDn,
-			BindPassword:         opts.withBindPassword,
-			Certificates:         opts.withCertificates,
-			ClientCertificate:    opts.withClientCertificate,
-			ClientCertificateKey: opts.withClientCertificateKey,
+			ScopeId:               scopeId,
+			Name:                  opts.withName,
+			Description:           opts.withDescription,
+			OperationalState:      string(opts.withOperationalState), // if no option is specified, a new auth method is initially inactive
+			Urls:                  opts.withUrls,
+			StartTls:              opts.withStartTls,
+			InsecureTls:           opts.withInsecureTls,
+			DiscoverDn:            opts.withDiscoverDn,
+			AnonGroupSearch:       opts.withAnonGroupSearch,
+			UpnDomain:             opts.withUpnDomain,
+			UserDn:                opts.withUserDn,
+			UserAttr:              opts.withUserAttr,
+			UserFilter:            opts.withUserFilter,
+			AlternateUserFilters:  opts.withAlternateUserFilters,
+			EnableGroups:          opts.withEnableGroups,
+			UseTokenGroups:        opts.withUseTokenGroups,
+			GroupDn:               opts.withGroupDn,
+			GroupAttr:             opts.withGroupAttr,
+			GroupFilter:           opts.withGroupFilter,
+			BindDn:                opts.withBindDn,
+			BindPassword:          opts.withBindPassword,
+			Certificates:          opts.withCertificates,
+			ClientCertificate:     opts.withClientCertificate,
+			ClientCertificateKey:  opts.withClientCertificateKey,
+			DeletionProtected:     opts.withDeletionProtected,
+			NestedGroupResolution: string(opts.withNestedGroupResolution),
+			MaxNestedGroupDepth:   opts.withMaxNestedGroupDepth,
+			GroupSearchPageSize:   opts.withGroupSearchPageSize,
+			GroupSearchSizeLimit:  opts.withGroupSearchSizeLimit,
 		},
 	}
 	if len(opts.withAccountAttributeMap) > 0 {
@@ -139,6 +148,7 @@ type convertedValues struct {
 	ClientCertificate    any
 	BindCredential       any
 	AccountAttributeMaps []any
+	AlternateUserFilters []any
 }
 
 // convertValueObjects converts the embedded value objects. It will return an
@@ -162,7 +172,7 @@ func (am *AuthMethod) convertValueObjects(ctx context.Context) (*convertedValues
 			return nil, errors.Wrap(ctx, err, op)
 		}
 	}
-	if am.GroupDn != "" || am.GroupAttr != "" || am.GroupFilter != "" {
+	if am.GroupDn != "" || am.GroupAttr != "" || am.GroupFilter != "" || am.GroupSearchPageSize != 0 || am.GroupSearchSizeLimit != 0 {
 		if converted.GroupEntrySearchConf, err = am.convertGroupEntrySearchConf(ctx); err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
@@ -180,6 +190,9 @@ func (am *AuthMethod) convertValueObjects(ctx context.Context) (*convertedValues
 	if converted.AccountAttributeMaps, err = am.convertAccountAttributeMaps(ctx); err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
+	if converted.AlternateUserFilters, err = am.convertAlternateUserFilters(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
 
 	return converted, nil
 }
@@ -249,7 +262,13 @@ func (am *AuthMethod) convertGroupEntrySearchConf(ctx context.Context) (any, err
 	if am.PublicId == "" {
 		return nil, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
 	}
-	c, err := NewGroupEntrySearchConf(ctx, am.PublicId, WithGroupDn(ctx, am.GroupDn), WithGroupAttr(ctx, am.GroupAttr), WithGroupFilter(ctx, am.GroupFilter))
+	c, err := NewGroupEntrySearchConf(ctx, am.PublicId,
+		WithGroupDn(ctx, am.GroupDn),
+		WithGroupAttr(ctx, am.GroupAttr),
+		WithGroupFilter(ctx, am.GroupFilter),
+		WithGroupSearchPageSize(ctx, am.GroupSearchPageSize),
+		WithGroupSearchSizeLimit(ctx, am.GroupSearchSizeLimit),
+	)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
@@ -317,3 +336,22 @@ func (am *AuthMethod) convertAccountAttributeMaps(ctx context.Context) ([]any, e
 	}
 	return newInterfaces, nil
 }
+
+// convertAlternateUserFilters converts any embedded alternate user filters from
+// []string to []any where each slice element is an *AlternateUserFilter. It
+// will return an error if the AuthMethod's public id is not set.
+func (am *AuthMethod) convertAlternateUserFilters(ctx context.Context) ([]any, error) {
+	const op = "ldap.(AuthMethod).convertAlternateUserFilters"
+	if am.PublicId == "" {
+		return nil, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	newValObjs := make([]any, 0, len(am.AlternateUserFilters))
+	for priority, f := range am.AlternateUserFilters {
+		obj, err := NewAlternateUserFilter(ctx, am.PublicId, priority+1, f)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		newValObjs = append(newValObjs, obj)
+	}
+	return newValObjs, nil
+}
@@ -413,6 +413,7 @@ func Test_convertValueObjects(t *testing.T) {
 				ClientCertificate:    testClientCertificate,
 				BindCredential:       testBindCredential,
 				AccountAttributeMaps: testAccountAttributeMaps,
+				AlternateUserFilters: []any{},
 			},
 		},
 		{
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ldap
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// fetchCertificatesTimeout is the timeout used when connecting to an ldap
+// server to fetch its certificate chain.
+const fetchCertificatesTimeout = 10 * time.Second
+
+// FetchServerCertificates connects to the ldap server at the url and returns
+// the certificate chain it presents, leaf first.  The chain is not verified,
+// as its purpose is to allow an administrator to review and pin certificates
+// that are not yet trusted.  For ldap:// urls a StartTLS command is issued
+// after connecting.  The context is only used for errors.
+func FetchServerCertificates(ctx context.Context, u string) ([]*x509.Certificate, error) {
+	const op = "ldap.FetchServerCertificates"
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unable to parse url %q", u), errors.WithWrap(err))
+	}
+	switch parsed.Scheme {
+	case "ldap", "ldaps":
+	default:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("scheme in url %q is neither ldap nor ldaps", u))
+	}
+
+	// The certificates are returned for review, so they are deliberately not
+	// verified here.
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: true, //nolint:gosec
+		MinVersion:         tls.VersionTLS12,
+	}
+	conn, err := ldap.DialURL(u,
+		ldap.DialWithDialer(&net.Dialer{Timeout: fetchCertificatesTimeout}),
+		ldap.DialWithTLSConfig(tlsConfig))
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, fmt.Sprintf("unable to connect to %q", u), errors.WithWrap(err))
+	}
+	defer conn.Close()
+	if parsed.Scheme == "ldap" {
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			return nil, errors.New(ctx, errors.Unknown, op, fmt.Sprintf("unable to start tls with %q", u), errors.WithWrap(err))
+		}
+	}
+	state, ok := conn.TLSConnectionState()
+	if !ok || len(state.PeerCertificates) == 0 {
+		return nil, errors.New(ctx, errors.Unknown, op, fmt.Sprintf("no certificates presented by %q", u))
+	}
+	return state.PeerCertificates, nil
+}
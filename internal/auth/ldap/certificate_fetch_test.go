@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ldap
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/go-hclog"
+	"github.com/jimlambrt/gldap/testdirectory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchServerCertificates(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	logger := hclog.New(&hclog.LoggerOptions{
+		Name:  "test-logger",
+		Level: hclog.Error,
+	})
+	td := testdirectory.Start(t, testdirectory.WithLogger(t, logger))
+	tdCerts, err := ParseCertificates(ctx, td.Cert())
+	require.NoError(t, err)
+
+	t.Run("ldaps", func(t *testing.T) {
+		got, err := FetchServerCertificates(ctx, fmt.Sprintf("ldaps://127.0.0.1:%d", td.Port()))
+		require.NoError(t, err)
+		require.NotEmpty(t, got)
+		assert.Equal(t, tdCerts[0].Raw, got[len(got)-1].Raw)
+	})
+	t.Run("invalid-scheme", func(t *testing.T) {
+		_, err := FetchServerCertificates(ctx, "https://127.0.0.1")
+		require.Error(t, err)
+		assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+	t.Run("start-tls", func(t *testing.T) {
+		plain := testdirectory.Start(t, testdirectory.WithLogger(t, logger), testdirectory.WithNoTLS(t))
+		plainCerts, err := ParseCertificates(ctx, plain.Cert())
+		require.NoError(t, err)
+		got, err := FetchServerCertificates(ctx, fmt.Sprintf("ldap://127.0.0.1:%d", plain.Port()))
+		require.NoError(t, err)
+		require.NotEmpty(t, got)
+		assert.Equal(t, plainCerts[0].Raw, got[len(got)-1].Raw)
+	})
+	t.Run("unreachable", func(t *testing.T) {
+		_, err := FetchServerCertificates(ctx, "ldaps://127.0.0.1:1")
+		require.Error(t, err)
+	})
+}
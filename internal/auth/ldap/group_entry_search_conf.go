@@ -25,8 +25,9 @@ type GroupEntrySearchConf struct {
 }
 
 // NewGroupEntrySearchConf creates a new in memory NewGroupEntrySearchConf.
-// Supported options are: WithGroupDn, WithGroupAttr, WithGroupFilter and all
-// other options are ignored.
+// Supported options are: WithGroupDn, WithGroupAttr, WithGroupFilter,
+// WithGroupSearchPageSize, WithGroupSearchSizeLimit and all other options are
+// ignored.
 func NewGroupEntrySearchConf(ctx context.Context, authMethodId string, opt ...Option) (*GroupEntrySearchConf, error) {
 	const op = "ldap.NewGroupEntrySearchConf"
 	opts, err := getOpts(opt...)
@@ -36,15 +37,18 @@ func NewGroupEntrySearchConf(ctx context.Context, authMethodId string, opt ...Op
 	switch {
 	case authMethodId == "":
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
-	case opts.withGroupDn == "" && opts.withGroupAttr == "" && opts.withGroupFilter == "":
-		return nil, errors.New(ctx, errors.InvalidParameter, op, "you must supply either dn, attr, or filter")
+	case opts.withGroupDn == "" && opts.withGroupAttr == "" && opts.withGroupFilter == "" &&
+		opts.withGroupSearchPageSize == 0 && opts.withGroupSearchSizeLimit == 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "you must supply either dn, attr, filter, page size, or size limit")
 	}
 	return &GroupEntrySearchConf{
 		GroupEntrySearchConf: &store.GroupEntrySearchConf{
-			LdapMethodId: authMethodId,
-			GroupDn:      opts.withGroupDn,
-			GroupAttr:    opts.withGroupAttr,
-			GroupFilter:  opts.withGroupFilter,
+			LdapMethodId:         authMethodId,
+			GroupDn:              opts.withGroupDn,
+			GroupAttr:            opts.withGroupAttr,
+			GroupFilter:          opts.withGroupFilter,
+			GroupSearchPageSize:  opts.withGroupSearchPageSize,
+			GroupSearchSizeLimit: opts.withGroupSearchSizeLimit,
 		},
 	}, nil
 }
@@ -35,13 +35,17 @@ func TestNewGroupEntrySearchConf(t *testing.T) {
 				WithGroupDn(testCtx, "dn"),
 				WithGroupAttr(testCtx, "attr"),
 				WithGroupFilter(testCtx, "filter"),
+				WithGroupSearchPageSize(testCtx, 100),
+				WithGroupSearchSizeLimit(testCtx, 1000),
 			},
 			want: &GroupEntrySearchConf{
 				GroupEntrySearchConf: &store.GroupEntrySearchConf{
-					LdapMethodId: "test-id",
-					GroupDn:      "dn",
-					GroupAttr:    "attr",
-					GroupFilter:  "filter",
+					LdapMethodId:         "test-id",
+					GroupDn:              "dn",
+					GroupAttr:            "attr",
+					GroupFilter:          "filter",
+					GroupSearchPageSize:  100,
+					GroupSearchSizeLimit: 1000,
 				},
 			},
 		},
@@ -87,6 +91,34 @@ func TestNewGroupEntrySearchConf(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:         "just-page-size",
+			ctx:          testCtx,
+			authMethodId: "test-id",
+			opts: []Option{
+				WithGroupSearchPageSize(testCtx, 100),
+			},
+			want: &GroupEntrySearchConf{
+				GroupEntrySearchConf: &store.GroupEntrySearchConf{
+					LdapMethodId:        "test-id",
+					GroupSearchPageSize: 100,
+				},
+			},
+		},
+		{
+			name:         "just-size-limit",
+			ctx:          testCtx,
+			authMethodId: "test-id",
+			opts: []Option{
+				WithGroupSearchSizeLimit(testCtx, 1000),
+			},
+			want: &GroupEntrySearchConf{
+				GroupEntrySearchConf: &store.GroupEntrySearchConf{
+					LdapMethodId:         "test-id",
+					GroupSearchSizeLimit: 1000,
+				},
+			},
+		},
 		{
 			name:            "missing-auth-method-id",
 			ctx:             testCtx,
@@ -101,7 +133,7 @@ func TestNewGroupEntrySearchConf(t *testing.T) {
 			authMethodId:    "test-id",
 			wantErr:         true,
 			wantErrCode:     errors.InvalidParameter,
-			wantErrContains: "you must supply either dn, attr, or filter",
+			wantErrContains: "you must supply either dn, attr, filter, page size, or size limit",
 		},
 	}
 	for _, tc := range tests {
@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ldap
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/hashicorp/boundary/internal/errors"
+	capldap "github.com/hashicorp/cap/ldap"
+)
+
+// pagedGroupSearch returns true if the auth method's group search is paged or
+// limited, in which case boundary searches for the groups of an authenticated
+// user itself rather than the cap ldap client, since the client doesn't
+// support paging.  Searches for a user's token groups are a single entry
+// search of the user's entry, so they're never paged.
+func pagedGroupSearch(am *AuthMethod) bool {
+	return am.EnableGroups && !am.UseTokenGroups &&
+		(am.GroupSearchPageSize > 0 || am.GroupSearchSizeLimit > 0)
+}
+
+// searchUserGroups returns the names of the groups the authenticated user at
+// userDn is a member of, which are found the same way the cap ldap client
+// finds them, but the search is paged and limited by the auth method's
+// GroupSearchPageSize and GroupSearchSizeLimit.
+func searchUserGroups(ctx context.Context, am *AuthMethod, userDn, loginName, password string) ([]string, error) {
+	const op = "ldap.searchUserGroups"
+	switch {
+	case am == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	case userDn == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing user dn")
+	}
+	if am.GroupDn == "" {
+		// there's no base dn to search for groups under
+		return nil, nil
+	}
+	groupFilter := am.GroupFilter
+	if groupFilter == "" {
+		groupFilter = capldap.DefaultGroupFilter
+	}
+	if NestedGroupResolution(am.NestedGroupResolution) == InChainNestedGroupResolution {
+		groupFilter = inChainGroupFilter(groupFilter)
+	}
+	groupAttr := am.GroupAttr
+	if groupAttr == "" {
+		groupAttr = capldap.DefaultGroupAttr
+	}
+	filter, err := renderGroupFilter(ctx, groupFilter, userDn, loginName)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	conn, err := bindGroupSearch(ctx, am, userDn, password)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer conn.Close()
+	entries, err := searchGroups(ctx, conn, am, filter, groupAttr)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	seen := map[string]bool{}
+	var groups []string
+	for _, e := range entries {
+		if dn, err := ldap.ParseDN(e.DN); err != nil || len(dn.RDNs) == 0 {
+			continue
+		}
+		names := e.GetAttributeValues(groupAttr)
+		if len(names) == 0 {
+			// the group attr didn't resolve, so use the group entry itself
+			names = []string{e.DN}
+		}
+		for _, n := range names {
+			n = groupName(n)
+			if !seen[n] {
+				seen[n] = true
+				groups = append(groups, n)
+			}
+		}
+	}
+	return groups, nil
+}
+
+// bindGroupSearch returns a connection to one of the auth method's urls which
+// is bound the same way the cap ldap client binds for its group search.
+func bindGroupSearch(ctx context.Context, am *AuthMethod, userDn, password string) (*ldap.Conn, error) {
+	const op = "ldap.bindGroupSearch"
+	conn, err := dialGroupSearch(ctx, am)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	switch {
+	case am.AnonGroupSearch:
+		err = conn.UnauthenticatedBind(userDn)
+	case am.BindDn != "" && am.BindPassword != "":
+		err = conn.Bind(am.BindDn, am.BindPassword)
+	default:
+		err = conn.Bind(userDn, password)
+	}
+	if err != nil {
+		conn.Close()
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to bind for group search", errors.WithWrap(err))
+	}
+	return conn, nil
+}
+
+// renderGroupFilter renders the groupFilter template with the user's escaped
+// dn and login name.
+func renderGroupFilter(ctx context.Context, groupFilter, userDn, loginName string) (string, error) {
+	const op = "ldap.renderGroupFilter"
+	tmpl, err := template.New("groupFilter").Parse(groupFilter)
+	if err != nil {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "unable to parse group filter", errors.WithWrap(err))
+	}
+	var filter strings.Builder
+	if err := tmpl.Execute(&filter, struct {
+		UserDN   string
+		Username string
+	}{
+		UserDN:   ldap.EscapeFilter(userDn),
+		Username: ldap.EscapeFilter(loginName),
+	}); err != nil {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "unable to render group filter", errors.WithWrap(err))
+	}
+	return filter.String(), nil
+}
+
+// searchGroups returns the entries under the auth method's GroupDn which match
+// the filter.  No entries are returned if the GroupDn doesn't exist.
+//
+// When the auth method has a GroupSearchPageSize the entries are requested a
+// page at a time using the RFC 2696 paged results control, and when it has a
+// GroupSearchSizeLimit at most that many entries are returned: the search is
+// abandoned once the limit is reached, and a server's size limit exceeded
+// result is treated as the end of the search.  Servers which don't support
+// the paged results control return all the entries in a single page.
+func searchGroups(ctx context.Context, conn *ldap.Conn, am *AuthMethod, filter string, attrs ...string) ([]*ldap.Entry, error) {
+	const op = "ldap.searchGroups"
+	sizeLimit := int(am.GroupSearchSizeLimit)
+	req := &ldap.SearchRequest{
+		BaseDN:     am.GroupDn,
+		Scope:      ldap.ScopeWholeSubtree,
+		Filter:     filter,
+		Attributes: attrs,
+		SizeLimit:  sizeLimit,
+		TimeLimit:  DefaultRequestTimeout,
+	}
+	var paging *ldap.ControlPaging
+	if am.GroupSearchPageSize > 0 {
+		paging = ldap.NewControlPaging(am.GroupSearchPageSize)
+		req.Controls = append(req.Controls, paging)
+	}
+
+	var entries []*ldap.Entry
+	for {
+		result, err := conn.Search(req)
+		switch {
+		case ldap.IsErrorWithCode(err, ldap.LDAPResultNoSuchObject):
+			return nil, nil
+		case ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded) && sizeLimit > 0 && result != nil:
+			entries = append(entries, result.Entries...)
+			return limitEntries(entries, sizeLimit), nil
+		case err != nil:
+			return nil, errors.New(ctx, errors.Unknown, op, fmt.Sprintf("group search failed (base dn: %q / filter: %q)", am.GroupDn, filter), errors.WithWrap(err))
+		}
+		entries = append(entries, result.Entries...)
+		if paging == nil {
+			return limitEntries(entries, sizeLimit), nil
+		}
+		var cookie []byte
+		if c, ok := ldap.FindControl(result.Controls, ldap.ControlTypePaging).(*ldap.ControlPaging); ok {
+			cookie = c.Cookie
+		}
+		if len(cookie) == 0 {
+			// this was the last page
+			return limitEntries(entries, sizeLimit), nil
+		}
+		paging.SetCookie(cookie)
+		if sizeLimit > 0 && len(entries) >= sizeLimit {
+			// abandon the rest of the pages by requesting a page size of 0
+			paging.PagingSize = 0
+			_, _ = conn.Search(req)
+			return limitEntries(entries, sizeLimit), nil
+		}
+	}
+}
+
+// limitEntries returns at most sizeLimit of the entries.  All the entries are
+// returned when sizeLimit is 0.
+func limitEntries(entries []*ldap.Entry, sizeLimit int) []*ldap.Entry {
+	if sizeLimit > 0 && len(entries) > sizeLimit {
+		return entries[:sizeLimit]
+	}
+	return entries
+}
@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ldap
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/go-hclog"
+	"github.com/jimlambrt/gldap"
+	"github.com/jimlambrt/gldap/testdirectory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_pagedGroupSearch(t *testing.T) {
+	t.Parallel()
+	testCtx := context.Background()
+	tests := []struct {
+		name string
+		opts []Option
+		want bool
+	}{
+		{
+			name: "groups-disabled",
+			opts: []Option{WithGroupSearchPageSize(testCtx, 10)},
+		},
+		{
+			name: "not-paged-or-limited",
+			opts: []Option{WithEnableGroups(testCtx)},
+		},
+		{
+			name: "token-groups",
+			opts: []Option{WithEnableGroups(testCtx), WithUseTokenGroups(testCtx), WithGroupSearchPageSize(testCtx, 10)},
+		},
+		{
+			name: "page-size",
+			opts: []Option{WithEnableGroups(testCtx), WithGroupSearchPageSize(testCtx, 10)},
+			want: true,
+		},
+		{
+			name: "size-limit",
+			opts: []Option{WithEnableGroups(testCtx), WithGroupSearchSizeLimit(testCtx, 10)},
+			want: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			am, err := NewAuthMethod(testCtx, "o_1234567890", tc.opts...)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, pagedGroupSearch(am))
+		})
+	}
+}
+
+func Test_limitEntries(t *testing.T) {
+	t.Parallel()
+	entries := []*ldap.Entry{{DN: "cn=one"}, {DN: "cn=two"}, {DN: "cn=three"}}
+	assert.Equal(t, entries, limitEntries(entries, 0))
+	assert.Equal(t, entries, limitEntries(entries, 3))
+	assert.Equal(t, entries[:2], limitEntries(entries, 2))
+}
+
+func Test_searchUserGroups(t *testing.T) {
+	t.Parallel()
+	testCtx := context.Background()
+	logger := hclog.New(&hclog.LoggerOptions{
+		Name:  "test-logger",
+		Level: hclog.Error,
+	})
+	td := testdirectory.Start(t,
+		testdirectory.WithDefaults(t, &testdirectory.Defaults{AllowAnonymousBind: true}),
+		testdirectory.WithLogger(t, logger),
+	)
+	tdCerts, err := ParseCertificates(testCtx, td.Cert())
+	require.NoError(t, err)
+
+	groupNames := []string{"admin", "dev", "ops", "eng", "sales"}
+	td.SetUsers(testdirectory.NewUsers(t, []string{"alice"}, testdirectory.WithMembersOf(t, groupNames...))...)
+	groups := make([]*gldap.Entry, 0, len(groupNames))
+	for _, n := range groupNames {
+		groups = append(groups, testdirectory.NewGroup(t, n, []string{"alice"}))
+	}
+	td.SetGroups(groups...)
+	// the test directory's group dns have a lower case cn attribute type, so
+	// the groups are named by their dns, the same as the cap ldap client.
+	groupDns := make([]string, 0, len(groupNames))
+	for _, n := range groupNames {
+		groupDns = append(groupDns, fmt.Sprintf("cn=%s,%s", n, testdirectory.DefaultGroupDN))
+	}
+	userDn := fmt.Sprintf("cn=alice,%s", testdirectory.DefaultUserDN)
+
+	newAuthMethod := func(opt ...Option) *AuthMethod {
+		opts := append([]Option{
+			WithUrls(testCtx, TestConvertToUrls(t, fmt.Sprintf("ldaps://127.0.0.1:%d", td.Port()))...),
+			WithCertificates(testCtx, tdCerts...),
+			WithEnableGroups(testCtx),
+			WithGroupDn(testCtx, testdirectory.DefaultGroupDN),
+		}, opt...)
+		am, err := NewAuthMethod(testCtx, "o_1234567890", opts...)
+		require.NoError(t, err)
+		return am
+	}
+
+	tests := []struct {
+		name            string
+		am              *AuthMethod
+		userDn          string
+		want            []string
+		wantLen         int
+		wantErrMatch    *errors.Template
+		wantErrContains string
+	}{
+		{
+			name:   "page-size",
+			am:     newAuthMethod(WithGroupSearchPageSize(testCtx, 2)),
+			userDn: userDn,
+			want:   groupDns,
+		},
+		{
+			name:    "size-limit",
+			am:      newAuthMethod(WithGroupSearchSizeLimit(testCtx, 3)),
+			userDn:  userDn,
+			wantLen: 3,
+		},
+		{
+			name:    "page-size-and-size-limit",
+			am:      newAuthMethod(WithGroupSearchPageSize(testCtx, 2), WithGroupSearchSizeLimit(testCtx, 3)),
+			userDn:  userDn,
+			wantLen: 3,
+		},
+		{
+			name:   "size-limit-larger-than-results",
+			am:     newAuthMethod(WithGroupSearchSizeLimit(testCtx, 100)),
+			userDn: userDn,
+			want:   groupDns,
+		},
+		{
+			name:   "anon-group-search",
+			am:     newAuthMethod(WithAnonGroupSearch(testCtx), WithGroupSearchPageSize(testCtx, 2)),
+			userDn: userDn,
+			want:   groupDns,
+		},
+		{
+			name:   "no-group-dn",
+			am:     newAuthMethod(WithGroupDn(testCtx, ""), WithGroupSearchPageSize(testCtx, 2)),
+			userDn: userDn,
+		},
+		{
+			name:            "missing-user-dn",
+			am:              newAuthMethod(WithGroupSearchPageSize(testCtx, 2)),
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "missing user dn",
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			got, err := searchUserGroups(testCtx, tc.am, tc.userDn, "alice", "password")
+			if tc.wantErrMatch != nil {
+				require.Error(err)
+				assert.Truef(errors.Match(tc.wantErrMatch, err), "want err code: %q got: %q", tc.wantErrMatch.Code, err)
+				assert.Contains(err.Error(), tc.wantErrContains)
+				return
+			}
+			require.NoError(err)
+			if tc.wantLen > 0 {
+				assert.Len(got, tc.wantLen)
+				assert.Subset(groupDns, got)
+				return
+			}
+			assert.ElementsMatch(tc.want, got)
+		})
+	}
+}
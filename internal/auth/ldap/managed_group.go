@@ -24,7 +24,8 @@ type ManagedGroup struct {
 }
 
 // NewManagedGroup creates a new in memory ManagedGroup assigned to LDAP
-// AuthMethod. Supported options are WithName and WithDescription.
+// AuthMethod. Supported options are WithName, WithDescription,
+// WithUnionGroupIds, WithIntersectionGroupIds and WithDifferenceGroupIds.
 func NewManagedGroup(ctx context.Context, authMethodId string, groupNames []string, opt ...Option) (*ManagedGroup, error) {
 	const op = "ldap.NewManagedGroup"
 	switch {
@@ -47,6 +48,10 @@ func NewManagedGroup(ctx context.Context, authMethodId string, groupNames []stri
 			Name:         opts.withName,
 			Description:  opts.withDescription,
 			GroupNames:   string(n),
+
+			UnionGroupIds:        opts.withUnionGroupIds,
+			IntersectionGroupIds: opts.withIntersectionGroupIds,
+			DifferenceGroupIds:   opts.withDifferenceGroupIds,
 		},
 	}
 	return mg, nil
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ldap
+
+import (
+	"github.com/hashicorp/boundary/globals"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	connectionSubsystem = "controller_ldap_connection"
+
+	labelConnectionResult = "result"
+
+	connectionSuccess = "success"
+	connectionFailure = "failure"
+)
+
+// connectionAttemptsTotal keeps a count of attempts to connect to an ldap
+// url, labeled by whether the connection succeeded.
+var connectionAttemptsTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: globals.MetricNamespace,
+		Subsystem: connectionSubsystem,
+		Name:      "attempts_total",
+		Help:      "Count of attempts to connect to an LDAP URL.",
+	},
+	[]string{labelConnectionResult},
+)
+
+// connectionFailoversTotal keeps a count of the times a connection to an ldap
+// url failed and the next url of the auth method was tried.
+var connectionFailoversTotal = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Namespace: globals.MetricNamespace,
+		Subsystem: connectionSubsystem,
+		Name:      "failovers_total",
+		Help:      "Count of failovers from an LDAP URL which could not be connected to.",
+	},
+)
+
+// openCircuits is the number of ldap urls whose circuit is currently open.
+var openCircuits = prometheus.NewGauge(
+	prometheus.GaugeOpts{
+		Namespace: globals.MetricNamespace,
+		Subsystem: connectionSubsystem,
+		Name:      "open_circuits",
+		Help:      "Number of LDAP URLs which are skipped until their circuit breaker cooldown ends.",
+	},
+)
+
+// InitializeConnectionCollectors registers the ldap connection metrics to the
+// provided prometheus register and initializes them to 0 for all possible
+// label combinations.
+func InitializeConnectionCollectors(r prometheus.Registerer) {
+	if r == nil {
+		return
+	}
+	r.MustRegister(connectionAttemptsTotal, connectionFailoversTotal, openCircuits)
+	for _, l := range []string{connectionSuccess, connectionFailure} {
+		connectionAttemptsTotal.WithLabelValues(l)
+	}
+}
@@ -0,0 +1,251 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ldap
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/hashicorp/boundary/internal/errors"
+	capldap "github.com/hashicorp/cap/ldap"
+)
+
+// NestedGroupResolution defines how an ldap auth method resolves the nested
+// (transitive) group memberships of an authenticated user.
+type NestedGroupResolution string
+
+const (
+	// InChainNestedGroupResolution resolves nested groups by adding the Active
+	// Directory LDAP_MATCHING_RULE_IN_CHAIN to the group filter.
+	InChainNestedGroupResolution NestedGroupResolution = "in-chain"
+
+	// RecursiveNestedGroupResolution resolves nested groups by searching for
+	// the groups of the user's groups, up to a maximum depth.
+	RecursiveNestedGroupResolution NestedGroupResolution = "recursive"
+)
+
+// DefaultMaxNestedGroupDepth is the maximum depth of nested groups searched
+// when the auth method doesn't specify one.
+const DefaultMaxNestedGroupDepth = 5
+
+// matchingRuleInChainOid is the OID of the Active Directory
+// LDAP_MATCHING_RULE_IN_CHAIN, which walks the chain of ancestry of an entry.
+const matchingRuleInChainOid = "1.2.840.113556.1.4.1941"
+
+func validNestedGroupResolution(s string) bool {
+	switch NestedGroupResolution(s) {
+	case "", InChainNestedGroupResolution, RecursiveNestedGroupResolution:
+		return true
+	default:
+		return false
+	}
+}
+
+func (r NestedGroupResolution) String() string {
+	return string(r)
+}
+
+// inChainGroupFilter returns the groupFilter extended to also match the groups
+// the user is a transitive member of.  The default group filter is extended
+// when groupFilter is empty.
+func inChainGroupFilter(groupFilter string) string {
+	if groupFilter == "" {
+		groupFilter = capldap.DefaultGroupFilter
+	}
+	return fmt.Sprintf("(|%s(member:%s:={{.UserDN}}))", groupFilter, matchingRuleInChainOid)
+}
+
+// resolveNestedGroups returns the names of the groups which the groups of the
+// authenticated user at userDn are members of, searching at most the auth
+// method's MaxNestedGroupDepth levels of nesting.  The groups the user is a
+// direct member of are not returned.  Group searches are bound the same way
+// the cap ldap client binds for its group search, and are paged and limited
+// the same way as the user's group search.
+func resolveNestedGroups(ctx context.Context, am *AuthMethod, userDn, loginName, password string) ([]string, error) {
+	const op = "ldap.resolveNestedGroups"
+	switch {
+	case am == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	case userDn == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing user dn")
+	}
+	if am.GroupDn == "" {
+		// there's no base dn to search for groups under
+		return nil, nil
+	}
+	maxDepth := int(am.MaxNestedGroupDepth)
+	if maxDepth == 0 {
+		maxDepth = DefaultMaxNestedGroupDepth
+	}
+	groupFilter := am.GroupFilter
+	if groupFilter == "" {
+		groupFilter = capldap.DefaultGroupFilter
+	}
+	groupAttr := am.GroupAttr
+	if groupAttr == "" {
+		groupAttr = capldap.DefaultGroupAttr
+	}
+
+	conn, err := bindGroupSearch(ctx, am, userDn, password)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer conn.Close()
+
+	// find the dns of the groups the user is a direct member of, which are
+	// either the entries found by the group filter or, when the filter finds
+	// the user's entry (e.g. with a group attr of memberOf), the dns in its
+	// group attr.
+	filter, err := renderGroupFilter(ctx, groupFilter, userDn, loginName)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	entries, err := searchGroups(ctx, conn, am, filter, groupAttr)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	seen := map[string]bool{}
+	var current []string
+	for _, e := range entries {
+		dns := make([]string, 0, 1)
+		for _, v := range e.GetAttributeValues(groupAttr) {
+			if dn, err := ldap.ParseDN(v); err == nil && len(dn.RDNs) > 0 {
+				dns = append(dns, v)
+			}
+		}
+		if len(dns) == 0 && !strings.EqualFold(e.DN, userDn) {
+			dns = append(dns, e.DN)
+		}
+		for _, dn := range dns {
+			if !seen[strings.ToLower(dn)] {
+				seen[strings.ToLower(dn)] = true
+				current = append(current, dn)
+			}
+		}
+	}
+
+	// chase the groups of the groups, one level of nesting at a time.
+	var groups []string
+	for depth := 0; depth < maxDepth && len(current) > 0; depth++ {
+		var next []string
+		for _, groupDn := range current {
+			escaped := ldap.EscapeFilter(groupDn)
+			entries, err := searchGroups(ctx, conn, am, fmt.Sprintf("(|(member=%s)(uniqueMember=%s))", escaped, escaped), "1.1")
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			for _, e := range entries {
+				if seen[strings.ToLower(e.DN)] {
+					continue
+				}
+				seen[strings.ToLower(e.DN)] = true
+				next = append(next, e.DN)
+				groups = append(groups, groupName(e.DN))
+			}
+		}
+		current = next
+	}
+	return groups, nil
+}
+
+// dialGroupSearch connects to the first of the auth method's urls which it can
+// connect to, the same way the cap ldap client does.  The urls are tried
+// healthiest first.
+func dialGroupSearch(ctx context.Context, am *AuthMethod) (*ldap.Conn, error) {
+	const op = "ldap.dialGroupSearch"
+	timeout := DefaultRequestTimeout * time.Second
+	var lastErr error
+	for i, u := range connectionHealth.order(am.Urls) {
+		if i > 0 {
+			connectionFailoversTotal.Inc()
+		}
+		parsed, err := url.Parse(u)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		host, _, err := net.SplitHostPort(parsed.Host)
+		if err != nil {
+			host = parsed.Host
+		}
+		tlsConfig, err := groupSearchTlsConfig(host, am)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		var conn *ldap.Conn
+		switch parsed.Scheme {
+		case "ldap":
+			conn, err = ldap.DialURL(u, ldap.DialWithDialer(&net.Dialer{Timeout: timeout}))
+			if err == nil && am.StartTls {
+				if err = conn.StartTLS(tlsConfig); err != nil {
+					conn.Close()
+				}
+			}
+		case "ldaps":
+			conn, err = ldap.DialURL(u, ldap.DialWithTLSDialer(tlsConfig, &net.Dialer{Timeout: timeout}))
+		default:
+			err = fmt.Errorf("invalid scheme in url %q", u)
+		}
+		if err != nil {
+			connectionHealth.failure(u)
+			lastErr = err
+			continue
+		}
+		connectionHealth.success(u)
+		conn.SetTimeout(timeout)
+		return conn, nil
+	}
+	return nil, errors.New(ctx, errors.Unknown, op, "unable to connect to any of the urls", errors.WithWrap(lastErr))
+}
+
+// groupSearchTlsConfig returns the tls config for connecting to the host using
+// the auth method's certificates and client certificate.
+func groupSearchTlsConfig(host string, am *AuthMethod) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		ServerName:         host,
+		InsecureSkipVerify: am.InsecureTls, //nolint:gosec
+	}
+	if len(am.Certificates) > 0 {
+		pool := x509.NewCertPool()
+		for _, c := range am.Certificates {
+			if !pool.AppendCertsFromPEM([]byte(c)) {
+				return nil, fmt.Errorf("unable to append certificate")
+			}
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if am.ClientCertificate != "" && len(am.ClientCertificateKey) > 0 {
+		cert, err := tls.X509KeyPair([]byte(am.ClientCertificate), am.ClientCertificateKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+// groupName returns the name of the group at dn the same way the cap ldap
+// client names the groups it finds: the value of the dn's CN attribute, or the
+// dn itself when it has none.
+func groupName(dn string) string {
+	parsed, err := ldap.ParseDN(dn)
+	if err != nil {
+		return dn
+	}
+	for _, rdn := range parsed.RDNs {
+		for _, attr := range rdn.Attributes {
+			if attr.Type == "CN" {
+				return attr.Value
+			}
+		}
+	}
+	return dn
+}
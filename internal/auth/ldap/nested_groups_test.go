@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ldap
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/go-hclog"
+	"github.com/jimlambrt/gldap"
+	"github.com/jimlambrt/gldap/testdirectory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_validNestedGroupResolution(t *testing.T) {
+	t.Parallel()
+	assert.True(t, validNestedGroupResolution(""))
+	assert.True(t, validNestedGroupResolution(InChainNestedGroupResolution.String()))
+	assert.True(t, validNestedGroupResolution(RecursiveNestedGroupResolution.String()))
+	assert.False(t, validNestedGroupResolution("memberOf"))
+}
+
+func Test_inChainGroupFilter(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t,
+		"(|(&(objectClass=group)(member={{.UserDN}}))(member:1.2.840.113556.1.4.1941:={{.UserDN}}))",
+		inChainGroupFilter("(&(objectClass=group)(member={{.UserDN}}))"),
+	)
+	assert.Equal(t,
+		"(|(|(memberUid={{.Username}})(member={{.UserDN}})(uniqueMember={{.UserDN}}))(member:1.2.840.113556.1.4.1941:={{.UserDN}}))",
+		inChainGroupFilter(""),
+	)
+}
+
+func Test_groupName(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "admin", groupName("CN=admin,OU=groups,DC=example,DC=org"))
+	assert.Equal(t, "ou=groups,dc=example,dc=org", groupName("ou=groups,dc=example,dc=org"))
+	assert.Equal(t, "admin", groupName("admin"))
+}
+
+func Test_resolveNestedGroups(t *testing.T) {
+	t.Parallel()
+	testCtx := context.Background()
+	logger := hclog.New(&hclog.LoggerOptions{
+		Name:  "test-logger",
+		Level: hclog.Error,
+	})
+	td := testdirectory.Start(t,
+		testdirectory.WithDefaults(t, &testdirectory.Defaults{AllowAnonymousBind: true}),
+		testdirectory.WithLogger(t, logger),
+	)
+	tdCerts, err := ParseCertificates(testCtx, td.Cert())
+	require.NoError(t, err)
+
+	groupDn := func(name string) string {
+		return fmt.Sprintf("cn=%s,%s", name, testdirectory.DefaultGroupDN)
+	}
+	// alice is a member of admin, which is a member of ops, which is a member
+	// of eng, which is a member of admin.
+	nestedGroup := func(name string, memberGroups ...string) *gldap.Entry {
+		members := make([]string, 0, len(memberGroups))
+		for _, g := range memberGroups {
+			members = append(members, groupDn(g))
+		}
+		return gldap.NewEntry(groupDn(name), map[string][]string{"member": members})
+	}
+	td.SetUsers(testdirectory.NewUsers(t, []string{"alice"}, testdirectory.WithMembersOf(t, "admin"))...)
+	td.SetGroups(
+		testdirectory.NewGroup(t, "admin", []string{"alice"}),
+		nestedGroup("ops", "admin"),
+		nestedGroup("eng", "ops"),
+		nestedGroup("admin", "eng"),
+	)
+	userDn := fmt.Sprintf("cn=alice,%s", testdirectory.DefaultUserDN)
+
+	newAuthMethod := func(opt ...Option) *AuthMethod {
+		opts := append([]Option{
+			WithUrls(testCtx, TestConvertToUrls(t, fmt.Sprintf("ldaps://127.0.0.1:%d", td.Port()))...),
+			WithCertificates(testCtx, tdCerts...),
+			WithEnableGroups(testCtx),
+			WithGroupDn(testCtx, testdirectory.DefaultGroupDN),
+			WithNestedGroupResolution(testCtx, RecursiveNestedGroupResolution),
+		}, opt...)
+		am, err := NewAuthMethod(testCtx, "o_1234567890", opts...)
+		require.NoError(t, err)
+		return am
+	}
+
+	tests := []struct {
+		name            string
+		am              *AuthMethod
+		userDn          string
+		want            []string
+		wantErrMatch    *errors.Template
+		wantErrContains string
+	}{
+		{
+			name:   "default-depth",
+			am:     newAuthMethod(),
+			userDn: userDn,
+			want:   []string{groupDn("ops"), groupDn("eng")},
+		},
+		{
+			name:   "max-depth",
+			am:     newAuthMethod(WithMaxNestedGroupDepth(testCtx, 1)),
+			userDn: userDn,
+			want:   []string{groupDn("ops")},
+		},
+		{
+			name:   "anon-group-search",
+			am:     newAuthMethod(WithAnonGroupSearch(testCtx)),
+			userDn: userDn,
+			want:   []string{groupDn("ops"), groupDn("eng")},
+		},
+		{
+			name:   "no-group-dn",
+			am:     newAuthMethod(WithGroupDn(testCtx, "")),
+			userDn: userDn,
+		},
+		{
+			name:            "missing-user-dn",
+			am:              newAuthMethod(),
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "missing user dn",
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			got, err := resolveNestedGroups(testCtx, tc.am, tc.userDn, "alice", "password")
+			if tc.wantErrMatch != nil {
+				require.Error(err)
+				assert.Truef(errors.Match(tc.wantErrMatch, err), "want err code: %q got: %q", tc.wantErrMatch.Code, err)
+				assert.Contains(err.Error(), tc.wantErrContains)
+				return
+			}
+			require.NoError(err)
+			assert.ElementsMatch(tc.want, got)
+		})
+	}
+}
@@ -10,41 +10,51 @@ import (
 	"fmt"
 	"net/url"
 
+	"github.com/hashicorp/boundary/internal/auth"
 	"github.com/hashicorp/boundary/internal/errors"
 )
 
 type options struct {
-	withName                 string
-	withDescription          string
-	withFullName             string
-	withEmail                string
-	withDn                   string
-	withStartTls             bool
-	withInsecureTls          bool
-	withDiscoverDn           bool
-	withAnonGroupSearch      bool
-	withEnableGroups         bool
-	withUseTokenGroups       bool
-	withUpnDomain            string
-	withUserDn               string
-	withUserAttr             string
-	withUserFilter           string
-	withGroupDn              string
-	withGroupAttr            string
-	withGroupFilter          string
-	withCertificates         []string
-	withBindDn               string
-	withBindPassword         string
-	withClientCertificate    string
-	withClientCertificateKey []byte
-	withLimit                int
-	withUnauthenticatedUser  bool
-	withOrderByCreateTime    bool
-	ascending                bool
-	withOperationalState     AuthMethodState
-	withAccountAttributeMap  map[string]AccountToAttribute
-	withMemberOfGroups       string
-	withUrls                 []string
+	withName                  string
+	withDescription           string
+	withFullName              string
+	withEmail                 string
+	withDn                    string
+	withStartTls              bool
+	withInsecureTls           bool
+	withDiscoverDn            bool
+	withAnonGroupSearch       bool
+	withEnableGroups          bool
+	withUseTokenGroups        bool
+	withUpnDomain             string
+	withUserDn                string
+	withUserAttr              string
+	withUserFilter            string
+	withAlternateUserFilters  []string
+	withGroupDn               string
+	withGroupAttr             string
+	withGroupFilter           string
+	withCertificates          []string
+	withBindDn                string
+	withBindPassword          string
+	withClientCertificate     string
+	withClientCertificateKey  []byte
+	withLimit                 int
+	withUnauthenticatedUser   bool
+	withOrderByCreateTime     bool
+	ascending                 bool
+	withOperationalState      AuthMethodState
+	withAccountAttributeMap   map[string]AccountToAttribute
+	withMemberOfGroups        string
+	withUrls                  []string
+	withUnionGroupIds         string
+	withIntersectionGroupIds  string
+	withDifferenceGroupIds    string
+	withDeletionProtected     bool
+	withNestedGroupResolution NestedGroupResolution
+	withMaxNestedGroupDepth   uint32
+	withGroupSearchPageSize   uint32
+	withGroupSearchSizeLimit  uint32
 }
 
 // Option - how options are passed as args
@@ -153,6 +163,37 @@ func WithUseTokenGroups(_ context.Context) Option {
 	}
 }
 
+// WithDeletionProtected optionally protects the auth method from being
+// deleted.
+func WithDeletionProtected(_ context.Context) Option {
+	return func(o *options) error {
+		o.withDeletionProtected = true
+		return nil
+	}
+}
+
+// WithNestedGroupResolution optionally specifies how an authenticated user's
+// nested group memberships are resolved.
+func WithNestedGroupResolution(ctx context.Context, r NestedGroupResolution) Option {
+	const op = "ldap.WithNestedGroupResolution"
+	return func(o *options) error {
+		if !validNestedGroupResolution(string(r)) {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid nested group resolution: %q", r))
+		}
+		o.withNestedGroupResolution = r
+		return nil
+	}
+}
+
+// WithMaxNestedGroupDepth optionally specifies the maximum depth of nested
+// groups searched when they're resolved recursively.
+func WithMaxNestedGroupDepth(_ context.Context, depth uint32) Option {
+	return func(o *options) error {
+		o.withMaxNestedGroupDepth = depth
+		return nil
+	}
+}
+
 // WithInsecureTLS optional specifies to skip LDAP server SSL certificate
 // validation - insecure and use with caution
 func WithInsecureTLS(_ context.Context) Option {
@@ -215,6 +256,15 @@ func WithUserFilter(_ context.Context, filter string) Option {
 	}
 }
 
+// WithAlternateUserFilters optionally specifies an ordered list of user filters
+// which are tried when the user filter doesn't find the user's entry.
+func WithAlternateUserFilters(_ context.Context, filter ...string) Option {
+	return func(o *options) error {
+		o.withAlternateUserFilters = filter
+		return nil
+	}
+}
+
 // WithGroupDn optionally specifies a group dn used to search for group entries.
 func WithGroupDn(_ context.Context, dn string) Option {
 	return func(o *options) error {
@@ -239,6 +289,24 @@ func WithGroupFilter(_ context.Context, filter string) Option {
 	}
 }
 
+// WithGroupSearchPageSize optionally specifies the number of entries requested
+// per page of the group search, using the RFC 2696 paged results control.
+func WithGroupSearchPageSize(_ context.Context, size uint32) Option {
+	return func(o *options) error {
+		o.withGroupSearchPageSize = size
+		return nil
+	}
+}
+
+// WithGroupSearchSizeLimit optionally specifies the maximum number of entries
+// returned by the group search.
+func WithGroupSearchSizeLimit(_ context.Context, limit uint32) Option {
+	return func(o *options) error {
+		o.withGroupSearchSizeLimit = limit
+		return nil
+	}
+}
+
 // WithBindCredential optionally specifies a set of optional configuration
 // parameters which allow Boundary to bind (aka authenticate) using the
 // credentials provided when searching for the user entry used to authenticate
@@ -363,3 +431,43 @@ func WithMemberOfGroups(ctx context.Context, groupName ...string) Option {
 		return nil
 	}
 }
+
+// WithUnionGroupIds provides an option for specifying managed group and static
+// group ids whose members are added to a managed group.
+func WithUnionGroupIds(ctx context.Context, ids ...string) Option {
+	const op = "ldap.WithUnionGroupIds"
+	return func(o *options) error {
+		var err error
+		if o.withUnionGroupIds, err = auth.MarshalSetOperandIds(ctx, ids); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		return nil
+	}
+}
+
+// WithIntersectionGroupIds provides an option for specifying managed group and
+// static group ids which the members of a managed group must also be members
+// of.
+func WithIntersectionGroupIds(ctx context.Context, ids ...string) Option {
+	const op = "ldap.WithIntersectionGroupIds"
+	return func(o *options) error {
+		var err error
+		if o.withIntersectionGroupIds, err = auth.MarshalSetOperandIds(ctx, ids); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		return nil
+	}
+}
+
+// WithDifferenceGroupIds provides an option for specifying managed group and
+// static group ids whose members are removed from a managed group.
+func WithDifferenceGroupIds(ctx context.Context, ids ...string) Option {
+	const op = "ldap.WithDifferenceGroupIds"
+	return func(o *options) error {
+		var err error
+		if o.withDifferenceGroupIds, err = auth.MarshalSetOperandIds(ctx, ids); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		return nil
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"crypto/x509"
 	"testing"
 
+	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -111,6 +112,55 @@ func Test_getOpts(t *testing.T) {
 		testOpts.withUseTokenGroups = true
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithDeletionProtected", func(t *testing.T) {
+		assert := assert.New(t)
+		opts, err := getOpts(WithDeletionProtected(testCtx))
+		require.NoError(t, err)
+		testOpts := getDefaultOptions()
+		assert.NotEqual(opts, testOpts)
+		testOpts.withDeletionProtected = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithNestedGroupResolution", func(t *testing.T) {
+		assert := assert.New(t)
+		opts, err := getOpts(WithNestedGroupResolution(testCtx, RecursiveNestedGroupResolution))
+		require.NoError(t, err)
+		testOpts := getDefaultOptions()
+		assert.NotEqual(opts, testOpts)
+		testOpts.withNestedGroupResolution = RecursiveNestedGroupResolution
+		assert.Equal(opts, testOpts)
+
+		_, err = getOpts(WithNestedGroupResolution(testCtx, "memberOf"))
+		require.Error(t, err)
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+	t.Run("WithMaxNestedGroupDepth", func(t *testing.T) {
+		assert := assert.New(t)
+		opts, err := getOpts(WithMaxNestedGroupDepth(testCtx, 3))
+		require.NoError(t, err)
+		testOpts := getDefaultOptions()
+		assert.NotEqual(opts, testOpts)
+		testOpts.withMaxNestedGroupDepth = 3
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithGroupSearchPageSize", func(t *testing.T) {
+		assert := assert.New(t)
+		opts, err := getOpts(WithGroupSearchPageSize(testCtx, 100))
+		require.NoError(t, err)
+		testOpts := getDefaultOptions()
+		assert.NotEqual(opts, testOpts)
+		testOpts.withGroupSearchPageSize = 100
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithGroupSearchSizeLimit", func(t *testing.T) {
+		assert := assert.New(t)
+		opts, err := getOpts(WithGroupSearchSizeLimit(testCtx, 1000))
+		require.NoError(t, err)
+		testOpts := getDefaultOptions()
+		assert.NotEqual(opts, testOpts)
+		testOpts.withGroupSearchSizeLimit = 1000
+		assert.Equal(opts, testOpts)
+	})
 	t.Run("WithUpnDomain", func(t *testing.T) {
 		assert := assert.New(t)
 		opts, err := getOpts(WithUpnDomain(testCtx, "domain.com"))
@@ -147,6 +197,15 @@ func Test_getOpts(t *testing.T) {
 		testOpts.withUserFilter = "filter"
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithAlternateUserFilters", func(t *testing.T) {
+		assert := assert.New(t)
+		opts, err := getOpts(WithAlternateUserFilters(testCtx, "filter-1", "filter-2"))
+		require.NoError(t, err)
+		testOpts := getDefaultOptions()
+		assert.NotEqual(opts, testOpts)
+		testOpts.withAlternateUserFilters = []string{"filter-1", "filter-2"}
+		assert.Equal(opts, testOpts)
+	})
 	t.Run("WitGroupDn", func(t *testing.T) {
 		assert := assert.New(t)
 		opts, err := getOpts(WithGroupDn(testCtx, "dn"))
@@ -315,4 +374,18 @@ func Test_getOpts(t *testing.T) {
 		testOpts.withMemberOfGroups = "[\"test\"]"
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithSetOperandIds", func(t *testing.T) {
+		assert := assert.New(t)
+		opts, err := getOpts(
+			WithUnionGroupIds(testCtx, "g_1234567890"),
+			WithIntersectionGroupIds(testCtx, "mgldap_1234567890"),
+			WithDifferenceGroupIds(testCtx),
+		)
+		require.NoError(t, err)
+		testOpts := getDefaultOptions()
+		assert.NotEqual(opts, testOpts)
+		testOpts.withUnionGroupIds = "[\"g_1234567890\"]"
+		testOpts.withIntersectionGroupIds = "[\"mgldap_1234567890\"]"
+		assert.Equal(opts, testOpts)
+	})
 }
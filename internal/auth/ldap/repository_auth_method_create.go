@@ -34,6 +34,8 @@ func (r *Repository) CreateAuthMethod(ctx context.Context, am *AuthMethod, _ ...
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
 	case !validState(am.OperationalState):
 		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid state: %q", am.OperationalState))
+	case !validNestedGroupResolution(am.NestedGroupResolution):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid nested group resolution: %q", am.NestedGroupResolution))
 	case len(am.Urls) == 0:
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing urls (there must be at least one)")
 	}
@@ -146,6 +148,13 @@ func (r *Repository) CreateAuthMethod(ctx context.Context, am *AuthMethod, _ ...
 				}
 				msgs = append(msgs, attrMapsOplogMsgs...)
 			}
+			if len(cv.AlternateUserFilters) > 0 {
+				userFiltersOplogMsgs := make([]*oplog.Message, 0, len(cv.AlternateUserFilters))
+				if err := w.CreateItems(ctx, cv.AlternateUserFilters, db.NewOplogMsgs(&userFiltersOplogMsgs)); err != nil {
+					return err
+				}
+				msgs = append(msgs, userFiltersOplogMsgs...)
+			}
 			md, err := am.oplog(ctx, oplog.OpType_OP_TYPE_CREATE)
 			if err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to generate oplog metadata"))
@@ -5,6 +5,7 @@ package ldap
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -178,6 +179,9 @@ func (r *Repository) getAuthMethods(ctx context.Context, authMethodId string, sc
 		am.EnableGroups = agg.EnableGroups
 		am.UseTokenGroups = agg.UseTokenGroups
 		am.UpnDomain = agg.UpnDomain
+		am.DeletionProtected = agg.DeletionProtected
+		am.NestedGroupResolution = agg.NestedGroupResolution
+		am.MaxNestedGroupDepth = agg.MaxNestedGroupDepth
 		if agg.Urls != "" {
 			am.Urls = strings.Split(agg.Urls, aggregateDelimiter)
 		}
@@ -190,6 +194,8 @@ func (r *Repository) getAuthMethods(ctx context.Context, authMethodId string, sc
 		am.GroupDn = agg.GroupDn
 		am.GroupAttr = agg.GroupAttr
 		am.GroupFilter = agg.GroupFilter
+		am.GroupSearchPageSize = agg.GroupSearchPageSize
+		am.GroupSearchSizeLimit = agg.GroupSearchSizeLimit
 		am.ClientCertificateKey = ccKey.Pt
 		am.ClientCertificateKeyHmac = agg.ClientCertificateKeyHmac
 		am.ClientCertificate = string(agg.ClientCertificateCert)
@@ -199,6 +205,11 @@ func (r *Repository) getAuthMethods(ctx context.Context, authMethodId string, sc
 		if agg.AccountAttributeMap != "" {
 			am.AccountAttributeMaps = strings.Split(agg.AccountAttributeMap, aggregateDelimiter)
 		}
+		if agg.AlternateUserFilters != "" {
+			if err := json.Unmarshal([]byte(agg.AlternateUserFilters), &am.AlternateUserFilters); err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("failed to decode alternate user filters"))
+			}
+		}
 
 		authMethods = append(authMethods, &am)
 	}
@@ -207,7 +218,9 @@ func (r *Repository) getAuthMethods(ctx context.Context, authMethodId string, sc
 
 // authMethodAgg is a view that aggregates the auth method's value objects. If
 // the value object can have multiple values like Urls and Certs, then the
-// string field is delimited with the aggregateDelimiter of "|"
+// string field is delimited with the aggregateDelimiter of "|". The exception
+// is AlternateUserFilters, which is an ordered json array since filters may
+// contain the aggregateDelimiter.
 type authMethodAgg struct {
 	PublicId                 string `gorm:"primary_key"`
 	ScopeId                  string
@@ -233,6 +246,8 @@ type authMethodAgg struct {
 	GroupDn                  string
 	GroupAttr                string
 	GroupFilter              string
+	GroupSearchPageSize      uint32
+	GroupSearchSizeLimit     uint32
 	ClientCertificateKey     []byte
 	ClientCertificateKeyHmac []byte
 	ClientCertificateKeyId   string
@@ -242,6 +257,10 @@ type authMethodAgg struct {
 	BindPasswordHmac         []byte
 	BindKeyId                string
 	AccountAttributeMap      string
+	AlternateUserFilters     string
+	DeletionProtected        bool
+	NestedGroupResolution    string
+	MaxNestedGroupDepth      uint32
 }
 
 // TableName returns the table name for gorm
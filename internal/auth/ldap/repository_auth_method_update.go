@@ -19,36 +19,45 @@ import (
 )
 
 const (
-	OperationalStateField     = "OperationalState"
-	VersionField              = "Version"
-	IsPrimaryAuthMethodField  = "IsPrimaryAuthMethod"
-	NameField                 = "Name"
-	DescriptionField          = "Description"
-	StartTlsField             = "StartTls"
-	InsecureTlsField          = "InsecureTls"
-	DiscoverDnField           = "DiscoverDn"
-	AnonGroupSearchField      = "AnonGroupSearch"
-	UpnDomainField            = "UpnDomain"
-	UrlsField                 = "Urls"
-	UserDnField               = "UserDn"
-	UserAttrField             = "UserAttr"
-	UserFilterField           = "UserFilter"
-	EnableGroupsField         = "EnableGroups"
-	UseTokenGroupsField       = "UseTokenGroups"
-	GroupDnField              = "GroupDn"
-	GroupAttrField            = "GroupAttr"
-	GroupFilterField          = "GroupFilter"
-	CertificatesField         = "Certificates"
-	ClientCertificateField    = "ClientCertificate"
-	ClientCertificateKeyField = "ClientCertificateKey"
-	BindDnField               = "BindDn"
-	BindPasswordField         = "BindPassword"
-	AccountAttributeMapsField = "AccountAttributeMaps"
-	GroupNamesField           = "GroupNames"
+	OperationalStateField      = "OperationalState"
+	VersionField               = "Version"
+	IsPrimaryAuthMethodField   = "IsPrimaryAuthMethod"
+	NameField                  = "Name"
+	DescriptionField           = "Description"
+	StartTlsField              = "StartTls"
+	InsecureTlsField           = "InsecureTls"
+	DiscoverDnField            = "DiscoverDn"
+	AnonGroupSearchField       = "AnonGroupSearch"
+	UpnDomainField             = "UpnDomain"
+	UrlsField                  = "Urls"
+	UserDnField                = "UserDn"
+	UserAttrField              = "UserAttr"
+	UserFilterField            = "UserFilter"
+	EnableGroupsField          = "EnableGroups"
+	UseTokenGroupsField        = "UseTokenGroups"
+	GroupDnField               = "GroupDn"
+	GroupAttrField             = "GroupAttr"
+	GroupFilterField           = "GroupFilter"
+	CertificatesField          = "Certificates"
+	ClientCertificateField     = "ClientCertificate"
+	ClientCertificateKeyField  = "ClientCertificateKey"
+	BindDnField                = "BindDn"
+	BindPasswordField          = "BindPassword"
+	AccountAttributeMapsField  = "AccountAttributeMaps"
+	AlternateUserFiltersField  = "AlternateUserFilters"
+	GroupNamesField            = "GroupNames"
+	UnionGroupIdsField         = "UnionGroupIds"
+	IntersectionGroupIdsField  = "IntersectionGroupIds"
+	DifferenceGroupIdsField    = "DifferenceGroupIds"
+	DeletionProtectedField     = "DeletionProtected"
+	NestedGroupResolutionField = "NestedGroupResolution"
+	MaxNestedGroupDepthField   = "MaxNestedGroupDepth"
+	GroupSearchPageSizeField   = "GroupSearchPageSize"
+	GroupSearchSizeLimitField  = "GroupSearchSizeLimit"
 )
 
 // isEmpty returns true if all the args are empty.  Only supports checking
-// strings and pointers, all other types are assumed to be empty.
+// strings, unsigned ints and pointers, all other types are assumed to be empty.
 func isEmpty(args ...any) bool {
 	for _, i := range args {
 		switch v := reflect.ValueOf(i); v.Kind() {
@@ -60,6 +69,10 @@ func isEmpty(args ...any) bool {
 			if v.String() != "" {
 				return false
 			}
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			if v.Uint() != 0 {
+				return false
+			}
 		}
 	}
 	return true
@@ -73,9 +86,12 @@ func isEmpty(args ...any) bool {
 // zero value and included in fieldMask. Name, Description, StartTLs,
 // DiscoverDn, AnonGroupSearch, UpnDomain, UserDn, UserAttr, UserFilter,
 // GroupDn, GroupAttr, GroupFilter, ClientCertificateKey, ClientCertificate,
-// BindDn and BindPassword are all updatable fields. The AuthMethod's Value
-// Objects of Urls and Certificates are also updatable. If no updatable fields
-// are included in the fieldMaskPaths, then an error is returned.
+// BindDn, BindPassword, DeletionProtected, NestedGroupResolution,
+// MaxNestedGroupDepth, GroupSearchPageSize and GroupSearchSizeLimit are all
+// updatable fields. The
+// AuthMethod's Value Objects of Urls, Certificates, AccountAttributeMaps and
+// AlternateUserFilters are also updatable. If no updatable fields are included
+// in the fieldMaskPaths, then an error is returned.
 //
 // No Options are currently supported.
 func (r *Repository) UpdateAuthMethod(ctx context.Context, am *AuthMethod, version uint32, fieldMaskPaths []string, _ ...Option) (*AuthMethod, int, error) {
@@ -93,29 +109,35 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, am *AuthMethod, versi
 	}
 	dbMask, nullFields := dbw.BuildUpdatePaths(
 		map[string]any{
-			OperationalStateField:     am.OperationalState,
-			NameField:                 am.Name,
-			DescriptionField:          am.Description,
-			StartTlsField:             am.StartTls,
-			InsecureTlsField:          am.InsecureTls,
-			DiscoverDnField:           am.DiscoverDn,
-			AnonGroupSearchField:      am.AnonGroupSearch,
-			UpnDomainField:            am.UpnDomain,
-			UserDnField:               am.UserDn,
-			UserAttrField:             am.UserAttr,
-			UserFilterField:           am.UserFilter,
-			EnableGroupsField:         am.EnableGroups,
-			UseTokenGroupsField:       am.UseTokenGroups,
-			GroupDnField:              am.GroupDn,
-			GroupAttrField:            am.GroupAttr,
-			GroupFilterField:          am.GroupFilter,
-			CertificatesField:         am.Certificates,
-			ClientCertificateField:    am.ClientCertificate,
-			ClientCertificateKeyField: am.ClientCertificateKey,
-			BindDnField:               am.BindDn,
-			BindPasswordField:         am.BindPassword,
-			UrlsField:                 am.Urls,
-			AccountAttributeMapsField: am.AccountAttributeMaps,
+			OperationalStateField:      am.OperationalState,
+			NameField:                  am.Name,
+			DescriptionField:           am.Description,
+			StartTlsField:              am.StartTls,
+			InsecureTlsField:           am.InsecureTls,
+			DiscoverDnField:            am.DiscoverDn,
+			AnonGroupSearchField:       am.AnonGroupSearch,
+			UpnDomainField:             am.UpnDomain,
+			UserDnField:                am.UserDn,
+			UserAttrField:              am.UserAttr,
+			UserFilterField:            am.UserFilter,
+			EnableGroupsField:          am.EnableGroups,
+			UseTokenGroupsField:        am.UseTokenGroups,
+			GroupDnField:               am.GroupDn,
+			GroupAttrField:             am.GroupAttr,
+			GroupFilterField:           am.GroupFilter,
+			CertificatesField:          am.Certificates,
+			ClientCertificateField:     am.ClientCertificate,
+			ClientCertificateKeyField:  am.ClientCertificateKey,
+			BindDnField:                am.BindDn,
+			BindPasswordField:          am.BindPassword,
+			UrlsField:                  am.Urls,
+			AccountAttributeMapsField:  am.AccountAttributeMaps,
+			AlternateUserFiltersField:  am.AlternateUserFilters,
+			DeletionProtectedField:     am.DeletionProtected,
+			NestedGroupResolutionField: am.NestedGroupResolution,
+			MaxNestedGroupDepthField:   am.MaxNestedGroupDepth,
+			GroupSearchPageSizeField:   am.GroupSearchPageSize,
+			GroupSearchSizeLimitField:  am.GroupSearchSizeLimit,
 		},
 		fieldMaskPaths,
 		[]string{
@@ -125,6 +147,7 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, am *AuthMethod, versi
 			AnonGroupSearchField,
 			EnableGroupsField,
 			UseTokenGroupsField,
+			DeletionProtectedField,
 		},
 	)
 	if len(dbMask) == 0 && len(nullFields) == 0 {
@@ -133,6 +156,9 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, am *AuthMethod, versi
 	if strutil.StrListContains(nullFields, UrlsField) {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing urls (you cannot delete all of them; there must be at least one)")
 	}
+	if strutil.StrListContains(dbMask, NestedGroupResolutionField) && !validNestedGroupResolution(am.NestedGroupResolution) {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid nested group resolution: %q", am.NestedGroupResolution))
+	}
 
 	origAm, err := r.LookupAuthMethod(ctx, am.PublicId)
 	if err != nil {
@@ -165,6 +191,31 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, am *AuthMethod, versi
 
 	combinedMasks := append(dbMask, nullFields...)
 
+	// alternate user filters are ordered, so any change to them is a delete of
+	// all the original filters and an insert of all the new ones.
+	var addUserFilters, deleteUserFilters []any
+	if strutil.StrListContains(combinedMasks, AlternateUserFiltersField) {
+		for i, f := range origAm.AlternateUserFilters {
+			obj, err := NewAlternateUserFilter(ctx, origAm.PublicId, i+1, f)
+			if err != nil {
+				return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update alternate user filters"))
+			}
+			deleteUserFilters = append(deleteUserFilters, obj)
+		}
+		if strutil.StrListContains(dbMask, AlternateUserFiltersField) {
+			if len(strutil.RemoveDuplicates(am.AlternateUserFilters, false)) != len(am.AlternateUserFilters) {
+				return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "duplicate new alternate user filters")
+			}
+			for i, f := range am.AlternateUserFilters {
+				obj, err := NewAlternateUserFilter(ctx, origAm.PublicId, i+1, f)
+				if err != nil {
+					return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update alternate user filters"))
+				}
+				addUserFilters = append(addUserFilters, obj)
+			}
+		}
+	}
+
 	var addUserSearchConf, deleteUserSearchConf any
 	if strListContainsOneOf(combinedMasks, UserDnField, UserAttrField, UserFilterField) {
 		if !isEmpty(origAm.UserDn, origAm.UserAttr, origAm.UserFilter) {
@@ -202,8 +253,8 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, am *AuthMethod, versi
 	}
 
 	var addGroupSearchConf, deleteGroupSearchConf any
-	if strListContainsOneOf(combinedMasks, GroupDnField, GroupAttrField, GroupFilterField) {
-		if !isEmpty(origAm.GroupDn, origAm.GroupAttr, origAm.GroupFilter) {
+	if strListContainsOneOf(combinedMasks, GroupDnField, GroupAttrField, GroupFilterField, GroupSearchPageSizeField, GroupSearchSizeLimitField) {
+		if !isEmpty(origAm.GroupDn, origAm.GroupAttr, origAm.GroupFilter, origAm.GroupSearchPageSize, origAm.GroupSearchSizeLimit) {
 			gsc := allocGroupEntrySearchConf()
 			gsc.LdapMethodId = am.PublicId
 			deleteGroupSearchConf = gsc
@@ -229,8 +280,28 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, am *AuthMethod, versi
 		case strutil.StrListContains(nullFields, GroupFilterField):
 			groupFilter = ""
 		}
-		if !isEmpty(groupDn, groupAttr, groupFilter) {
-			addGroupSearchConf, err = NewGroupEntrySearchConf(ctx, am.PublicId, WithGroupDn(ctx, groupDn), WithGroupAttr(ctx, groupAttr), WithGroupFilter(ctx, groupFilter))
+		groupSearchPageSize := origAm.GroupSearchPageSize
+		switch {
+		case strutil.StrListContains(dbMask, GroupSearchPageSizeField):
+			groupSearchPageSize = am.GroupSearchPageSize
+		case strutil.StrListContains(nullFields, GroupSearchPageSizeField):
+			groupSearchPageSize = 0
+		}
+		groupSearchSizeLimit := origAm.GroupSearchSizeLimit
+		switch {
+		case strutil.StrListContains(dbMask, GroupSearchSizeLimitField):
+			groupSearchSizeLimit = am.GroupSearchSizeLimit
+		case strutil.StrListContains(nullFields, GroupSearchSizeLimitField):
+			groupSearchSizeLimit = 0
+		}
+		if !isEmpty(groupDn, groupAttr, groupFilter, groupSearchPageSize, groupSearchSizeLimit) {
+			addGroupSearchConf, err = NewGroupEntrySearchConf(ctx, am.PublicId,
+				WithGroupDn(ctx, groupDn),
+				WithGroupAttr(ctx, groupAttr),
+				WithGroupFilter(ctx, groupFilter),
+				WithGroupSearchPageSize(ctx, groupSearchPageSize),
+				WithGroupSearchSizeLimit(ctx, groupSearchSizeLimit),
+			)
 			if err != nil {
 				return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update group search configuration"))
 			}
@@ -310,8 +381,9 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, am *AuthMethod, versi
 			UrlsField,
 			CertificatesField,
 			AccountAttributeMapsField,
+			AlternateUserFiltersField,
 			UserDnField, UserAttrField, UserFilterField,
-			GroupDnField, GroupAttrField, GroupFilterField,
+			GroupDnField, GroupAttrField, GroupFilterField, GroupSearchPageSizeField, GroupSearchSizeLimitField,
 			ClientCertificateField, ClientCertificateKeyField,
 			BindDnField, BindPasswordField:
 			continue
@@ -322,12 +394,13 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, am *AuthMethod, versi
 	for _, f := range nullFields {
 		switch f {
 		case
-			StartTlsField, InsecureTlsField, DiscoverDnField, AnonGroupSearchField, EnableGroupsField, UseTokenGroupsField,
+			StartTlsField, InsecureTlsField, DiscoverDnField, AnonGroupSearchField, EnableGroupsField, UseTokenGroupsField, DeletionProtectedField,
 			UrlsField,
 			CertificatesField,
 			AccountAttributeMapsField,
+			AlternateUserFiltersField,
 			UserDnField, UserAttrField, UserFilterField,
-			GroupDnField, GroupAttrField, GroupFilterField,
+			GroupDnField, GroupAttrField, GroupFilterField, GroupSearchPageSizeField, GroupSearchSizeLimitField,
 			ClientCertificateField, ClientCertificateKeyField,
 			BindDnField, BindPasswordField:
 			continue
@@ -352,7 +425,9 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, am *AuthMethod, versi
 		addBindCred == nil &&
 		deleteBindCred == nil &&
 		len(addMaps) == 0 &&
-		len(deleteMaps) == 0 {
+		len(deleteMaps) == 0 &&
+		len(addUserFilters) == 0 &&
+		len(deleteUserFilters) == 0 {
 		return origAm, db.NoRowsAffected, nil
 	}
 
@@ -529,6 +604,24 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, am *AuthMethod, versi
 				}
 				msgs = append(msgs, addMapsOplogMsgs...)
 			}
+			if len(deleteUserFilters) > 0 {
+				deleteUserFiltersOplogMsgs := make([]*oplog.Message, 0, len(deleteUserFilters))
+				rowsDeleted, err := w.DeleteItems(ctx, deleteUserFilters, db.NewOplogMsgs(&deleteUserFiltersOplogMsgs))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete alternate user filters"))
+				}
+				if rowsDeleted != len(deleteUserFilters) {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("alternate user filters deleted %d did not match request for %d", rowsDeleted, len(deleteUserFilters)))
+				}
+				msgs = append(msgs, deleteUserFiltersOplogMsgs...)
+			}
+			if len(addUserFilters) > 0 {
+				addUserFiltersOplogMsgs := make([]*oplog.Message, 0, len(addUserFilters))
+				if err := w.CreateItems(ctx, addUserFilters, db.NewOplogMsgs(&addUserFiltersOplogMsgs)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to add alternate user filters"))
+				}
+				msgs = append(msgs, addUserFiltersOplogMsgs...)
+			}
 
 			metadata, err := updatedAm.oplog(ctx, oplog.OpType_OP_TYPE_UPDATE)
 			if err != nil {
@@ -589,6 +682,12 @@ func validateFieldMask(ctx context.Context, fieldMaskPaths []string) error {
 		case strings.EqualFold(BindPasswordField, f):
 		case strings.EqualFold(UrlsField, f):
 		case strings.EqualFold(AccountAttributeMapsField, f):
+		case strings.EqualFold(AlternateUserFiltersField, f):
+		case strings.EqualFold(DeletionProtectedField, f):
+		case strings.EqualFold(NestedGroupResolutionField, f):
+		case strings.EqualFold(MaxNestedGroupDepthField, f):
+		case strings.EqualFold(GroupSearchPageSizeField, f):
+		case strings.EqualFold(GroupSearchSizeLimitField, f):
 		default:
 			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid field mask: %q", f))
 		}
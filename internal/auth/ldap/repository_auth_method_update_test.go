@@ -751,6 +751,116 @@ func TestRepository_UpdateAuthMethod(t *testing.T) {
 				return am
 			},
 		},
+		{
+			name:       "nested-group-resolution-update",
+			ctx:        testCtx,
+			repo:       testRepo,
+			version:    1,
+			fieldMasks: []string{"NestedGroupResolution", "MaxNestedGroupDepth"},
+			setup: func() *AuthMethod {
+				am := TestAuthMethod(t, testConn, databaseWrapper, org.PublicId, []string{"ldaps://ldap1"}, WithNestedGroupResolution(testCtx, InChainNestedGroupResolution))
+				return am
+			},
+			updateWith: func(orig *AuthMethod) *AuthMethod {
+				am := orig.clone()
+				am.NestedGroupResolution = string(RecursiveNestedGroupResolution)
+				am.MaxNestedGroupDepth = 3
+				return am
+			},
+			want: func(orig, updateWith *AuthMethod) *AuthMethod {
+				am := orig.clone()
+				am.NestedGroupResolution = string(RecursiveNestedGroupResolution)
+				am.MaxNestedGroupDepth = 3
+				return am
+			},
+		},
+		{
+			name:       "nested-group-resolution-delete",
+			ctx:        testCtx,
+			repo:       testRepo,
+			version:    1,
+			fieldMasks: []string{"NestedGroupResolution", "MaxNestedGroupDepth"},
+			setup: func() *AuthMethod {
+				am := TestAuthMethod(t, testConn, databaseWrapper, org.PublicId, []string{"ldaps://ldap1"}, WithNestedGroupResolution(testCtx, RecursiveNestedGroupResolution), WithMaxNestedGroupDepth(testCtx, 3))
+				return am
+			},
+			updateWith: func(orig *AuthMethod) *AuthMethod {
+				am := orig.clone()
+				am.NestedGroupResolution = ""
+				am.MaxNestedGroupDepth = 0
+				return am
+			},
+			want: func(orig, updateWith *AuthMethod) *AuthMethod {
+				am := orig.clone()
+				am.NestedGroupResolution = ""
+				am.MaxNestedGroupDepth = 0
+				return am
+			},
+		},
+		{
+			name:       "group-search-paging-update",
+			ctx:        testCtx,
+			repo:       testRepo,
+			version:    1,
+			fieldMasks: []string{"GroupSearchPageSize", "GroupSearchSizeLimit"},
+			setup: func() *AuthMethod {
+				am := TestAuthMethod(t, testConn, databaseWrapper, org.PublicId, []string{"ldaps://ldap1"}, WithGroupDn(testCtx, "group-dn"), WithGroupSearchPageSize(testCtx, 100))
+				return am
+			},
+			updateWith: func(orig *AuthMethod) *AuthMethod {
+				am := orig.clone()
+				am.GroupSearchPageSize = 500
+				am.GroupSearchSizeLimit = 1000
+				return am
+			},
+			want: func(orig, updateWith *AuthMethod) *AuthMethod {
+				am := orig.clone()
+				am.GroupSearchPageSize = 500
+				am.GroupSearchSizeLimit = 1000
+				return am
+			},
+		},
+		{
+			name:       "group-search-paging-delete",
+			ctx:        testCtx,
+			repo:       testRepo,
+			version:    1,
+			fieldMasks: []string{"GroupSearchPageSize", "GroupSearchSizeLimit"},
+			setup: func() *AuthMethod {
+				am := TestAuthMethod(t, testConn, databaseWrapper, org.PublicId, []string{"ldaps://ldap1"}, WithGroupDn(testCtx, "group-dn"), WithGroupSearchPageSize(testCtx, 100), WithGroupSearchSizeLimit(testCtx, 1000))
+				return am
+			},
+			updateWith: func(orig *AuthMethod) *AuthMethod {
+				am := orig.clone()
+				am.GroupSearchPageSize = 0
+				am.GroupSearchSizeLimit = 0
+				return am
+			},
+			want: func(orig, updateWith *AuthMethod) *AuthMethod {
+				am := orig.clone()
+				am.GroupSearchPageSize = 0
+				am.GroupSearchSizeLimit = 0
+				return am
+			},
+		},
+		{
+			name:       "invalid-nested-group-resolution",
+			ctx:        testCtx,
+			repo:       testRepo,
+			version:    1,
+			fieldMasks: []string{"NestedGroupResolution"},
+			setup: func() *AuthMethod {
+				am := TestAuthMethod(t, testConn, databaseWrapper, org.PublicId, []string{"ldaps://ldap1"})
+				return am
+			},
+			updateWith: func(orig *AuthMethod) *AuthMethod {
+				am := orig.clone()
+				am.NestedGroupResolution = "memberOf"
+				return am
+			},
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "invalid nested group resolution",
+		},
 		{
 			name:       "start-tls-false",
 			ctx:        testCtx,
@@ -836,6 +946,48 @@ func TestRepository_UpdateAuthMethod(t *testing.T) {
 				return am
 			},
 		},
+		{
+			name:       "alternate-user-filters-update",
+			ctx:        testCtx,
+			repo:       testRepo,
+			version:    1,
+			fieldMasks: []string{"AlternateUserFilters"},
+			setup: func() *AuthMethod {
+				am := TestAuthMethod(t, testConn, databaseWrapper, org.PublicId, []string{"ldaps://ldap1"}, WithAlternateUserFilters(testCtx, "(mail={{.Username}})", "(userPrincipalName={{.Username}})"))
+				return am
+			},
+			updateWith: func(orig *AuthMethod) *AuthMethod {
+				am := orig.clone()
+				am.AlternateUserFilters = []string{"(userPrincipalName={{.Username}})", "(|(mail={{.Username}})(proxyAddresses=smtp:{{.Username}}))"}
+				return am
+			},
+			want: func(orig, updateWith *AuthMethod) *AuthMethod {
+				am := orig.clone()
+				am.AlternateUserFilters = []string{"(userPrincipalName={{.Username}})", "(|(mail={{.Username}})(proxyAddresses=smtp:{{.Username}}))"}
+				return am
+			},
+		},
+		{
+			name:       "alternate-user-filters-delete",
+			ctx:        testCtx,
+			repo:       testRepo,
+			version:    1,
+			fieldMasks: []string{"AlternateUserFilters"},
+			setup: func() *AuthMethod {
+				am := TestAuthMethod(t, testConn, databaseWrapper, org.PublicId, []string{"ldaps://ldap1"}, WithAlternateUserFilters(testCtx, "(mail={{.Username}})"))
+				return am
+			},
+			updateWith: func(orig *AuthMethod) *AuthMethod {
+				am := orig.clone()
+				am.AlternateUserFilters = nil
+				return am
+			},
+			want: func(orig, updateWith *AuthMethod) *AuthMethod {
+				am := orig.clone()
+				am.AlternateUserFilters = nil
+				return am
+			},
+		},
 		{
 			name:       "enable-groups-err",
 			ctx:        testCtx,
@@ -1060,6 +1212,11 @@ func Test_validateFieldMask(t *testing.T) {
 				BindDnField,
 				BindPasswordField,
 				AccountAttributeMapsField,
+				AlternateUserFiltersField,
+				NestedGroupResolutionField,
+				MaxNestedGroupDepthField,
+				GroupSearchPageSizeField,
+				GroupSearchSizeLimitField,
 			},
 		},
 		{
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/oplog"
 	"github.com/hashicorp/cap/ldap"
+	"github.com/hashicorp/go-secure-stdlib/strutil"
 )
 
 const (
@@ -29,6 +30,19 @@ const (
 // If the AuthMethod.EnableGroups is true, then the authenticated user's groups
 // will be returned in account.
 //
+// The auth method's account attribute maps determine which of the user's entry
+// attributes are used for the account's email and full name; maps to any other
+// (custom) attribute are returned in the account's EntryAttributes. A custom
+// attribute with a single value is stored as a string, otherwise as a list of
+// strings.
+//
+// If the AuthMethod has a NestedGroupResolution, the groups returned in the
+// account include the groups which the user is a transitive member of.
+//
+// If the AuthMethod has AlternateUserFilters, they're tried in order when the
+// UserFilter doesn't find the user's entry, and the returned account's login
+// name is the value of the UserAttr attribute of the user's entry.
+//
 // Authenticate will update the stored values for the authenticated user's
 // Account: FullName, Email, Dn, EntryAttributes, and MemberOfGroups.
 //
@@ -54,40 +68,34 @@ func (r *Repository) Authenticate(ctx context.Context, authMethodId, loginName,
 		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("auth method id %q not found", authMethodId))
 	}
 
-	// config cap ldap provider
-	client, err := ldap.NewClient(ctx, &ldap.ClientConfig{
-		IncludeUserAttributes: true,
-		StartTLS:              am.StartTls,
-		InsecureTLS:           am.InsecureTls,
-		DiscoverDN:            am.DiscoverDn,
-		AnonymousGroupSearch:  am.AnonGroupSearch,
-		UPNDomain:             am.UpnDomain,
-		URLs:                  am.Urls,
-		UserDN:                am.UserDn,
-		UserFilter:            am.UserFilter,
-		UserAttr:              am.UserAttr,
-		IncludeUserGroups:     am.EnableGroups,
-		UseTokenGroups:        am.UseTokenGroups,
-		GroupDN:               am.GroupDn,
-		GroupAttr:             am.GroupAttr,
-		GroupFilter:           am.GroupFilter,
-		Certificates:          am.Certificates,
-		ClientTLSKey:          string(am.ClientCertificateKey),
-		ClientTLSCert:         am.ClientCertificate,
-		BindDN:                am.BindDn,
-		BindPassword:          am.BindPassword,
-		RequestTimeout:        DefaultRequestTimeout,
-	})
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to initialize ldap client with auth method retrieved from database"))
+	// the user filter is tried first, followed by any alternate user filters in
+	// the order specified, until one of them finds the user's entry.
+	userFilters := append([]string{am.UserFilter}, am.AlternateUserFilters...)
+	var authResult *ldap.AuthResult
+	for i, userFilter := range userFilters {
+		authResult, err = authenticateWithUserFilter(ctx, am, userFilter, loginName, password)
+		if err == nil {
+			break
+		}
+		if i < len(userFilters)-1 && errors.IsNotFoundError(err) {
+			continue
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("authenticate failed"))
 	}
-	defer client.Close(ctx)
 
-	// authen user
-	authResult, err := client.Authenticate(ctx, loginName, password)
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("authenticate failed"))
+	// with alternate user filters, the user may have authenticated with any of
+	// several login name formats; the account's login name is the entry's user
+	// attribute, so they all resolve to the same account.
+	if len(am.AlternateUserFilters) > 0 {
+		userAttr := am.UserAttr
+		if userAttr == "" {
+			userAttr = ldap.DefaultUserAttr
+		}
+		if found, v := caseInsensitiveAttributeSearch(userAttr, authResult.UserAttributes); found && len(v) > 0 && v[0] != "" {
+			loginName = strings.ToLower(v[0])
+		}
 	}
+
 	acct, err := NewAccount(ctx, am.ScopeId, am.PublicId, loginName)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
@@ -100,14 +108,47 @@ func (r *Repository) Authenticate(ctx context.Context, authMethodId, loginName,
 	acct.Dn = authResult.UserDN
 
 	if authResult.UserAttributes != nil {
-		found, email := caseInsensitiveAttributeSearch(DefaultEmailAttribute, authResult.UserAttributes)
-		if found {
+		attrMaps, err := ParseAccountAttributeMaps(ctx, am.AccountAttributeMaps...)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		emailAttr, fullNameAttr := DefaultEmailAttribute, DefaultFullNameAttribute
+		entryAttrs := map[string]any{}
+		for _, m := range attrMaps {
+			toAttr, err := ConvertToAccountToAttribute(ctx, m.To)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			switch toAttr {
+			case ToEmailAttribute:
+				emailAttr = m.From
+			case ToFullNameAttribute:
+				fullNameAttr = m.From
+			default:
+				found, values := caseInsensitiveAttributeSearch(m.From, authResult.UserAttributes)
+				switch {
+				case found && len(values) == 1:
+					entryAttrs[m.To] = values[0]
+				case found && len(values) > 1:
+					entryAttrs[m.To] = values
+				}
+			}
+		}
+		found, email := caseInsensitiveAttributeSearch(emailAttr, authResult.UserAttributes)
+		if found && len(email) > 0 {
 			acct.Email = email[0]
 		}
-		found, fullName := caseInsensitiveAttributeSearch(DefaultFullNameAttribute, authResult.UserAttributes)
-		if found {
+		found, fullName := caseInsensitiveAttributeSearch(fullNameAttr, authResult.UserAttributes)
+		if found && len(fullName) > 0 {
 			acct.FullName = fullName[0]
 		}
+		if len(entryAttrs) > 0 {
+			encodedAttrs, err := json.Marshal(entryAttrs)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to encode user entry attributes"))
+			}
+			acct.EntryAttributes = string(encodedAttrs)
+		}
 	}
 	if len(authResult.Groups) > 0 {
 		encodedGroups, err := json.Marshal(authResult.Groups)
@@ -132,7 +173,7 @@ func (r *Repository) Authenticate(ctx context.Context, authMethodId, loginName,
 		acct,
 		db.WithOnConflict(&db.OnConflict{
 			Target: db.Columns{"public_id"}, // id is predictable and uses both auth method id and login name for inputs
-			Action: db.SetColumns([]string{"full_name", "email", "dn", "member_of_groups"}),
+			Action: db.SetColumns([]string{"full_name", "email", "dn", "member_of_groups", "entry_attributes"}),
 		}),
 		db.WithOplog(databaseWrapper, md),
 	); err != nil {
@@ -143,6 +184,98 @@ func (r *Repository) Authenticate(ctx context.Context, authMethodId, loginName,
 	return acct, nil
 }
 
+// userNotFoundErrMsg is the error message returned by the ldap client when the
+// user filter doesn't find the user's entry. authenticateWithUserFilter returns
+// it as an errors.RecordNotFound error.
+const userNotFoundErrMsg = "discovery of user bind DN failed"
+
+// authenticateWithUserFilter authenticates loginName and password via the auth
+// method's configured LDAP service using the userFilter.
+func authenticateWithUserFilter(ctx context.Context, am *AuthMethod, userFilter, loginName, password string) (*ldap.AuthResult, error) {
+	const op = "ldap.authenticateWithUserFilter"
+	if len(am.Urls) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing urls")
+	}
+	groupFilter := am.GroupFilter
+	if NestedGroupResolution(am.NestedGroupResolution) == InChainNestedGroupResolution {
+		groupFilter = inChainGroupFilter(groupFilter)
+	}
+	// the cap ldap client can't page its group search, so a paged or limited
+	// group search is done after the user is authenticated.
+	pagedGroups := pagedGroupSearch(am)
+	// config cap ldap provider
+	conf := &ldap.ClientConfig{
+		IncludeUserAttributes: true,
+		StartTLS:              am.StartTls,
+		InsecureTLS:           am.InsecureTls,
+		DiscoverDN:            am.DiscoverDn,
+		AnonymousGroupSearch:  am.AnonGroupSearch,
+		UPNDomain:             am.UpnDomain,
+		UserDN:                am.UserDn,
+		UserFilter:            userFilter,
+		UserAttr:              am.UserAttr,
+		IncludeUserGroups:     am.EnableGroups && !pagedGroups,
+		UseTokenGroups:        am.UseTokenGroups,
+		GroupDN:               am.GroupDn,
+		GroupAttr:             am.GroupAttr,
+		GroupFilter:           groupFilter,
+		Certificates:          am.Certificates,
+		ClientTLSKey:          string(am.ClientCertificateKey),
+		ClientTLSCert:         am.ClientCertificate,
+		BindDN:                am.BindDn,
+		BindPassword:          am.BindPassword,
+		RequestTimeout:        DefaultRequestTimeout,
+	}
+
+	// the urls are tried one at a time, healthiest first, so the health of
+	// each url can be tracked and the urls which are down are skipped until
+	// their circuit breaker cooldown ends.
+	var authResult *ldap.AuthResult
+	var authErr error
+	for i, u := range connectionHealth.order(am.Urls) {
+		if i > 0 {
+			connectionFailoversTotal.Inc()
+		}
+		conf.URLs = []string{u}
+		client, err := ldap.NewClient(ctx, conf)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to initialize ldap client with auth method retrieved from database"))
+		}
+		// authen user
+		authResult, authErr = client.Authenticate(ctx, loginName, password)
+		client.Close(ctx)
+		if authErr != nil && strings.Contains(authErr.Error(), connectFailedErrMsg) {
+			connectionHealth.failure(u)
+			continue
+		}
+		connectionHealth.success(u)
+		break
+	}
+	switch {
+	case authErr != nil && strings.Contains(authErr.Error(), userNotFoundErrMsg):
+		return nil, errors.New(ctx, errors.RecordNotFound, op, "user entry not found", errors.WithWrap(authErr))
+	case authErr != nil:
+		return nil, errors.Wrap(ctx, authErr, op)
+	}
+	if pagedGroups {
+		groups, err := searchUserGroups(ctx, am, authResult.UserDN, loginName, password)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get user groups"))
+		}
+		authResult.Groups = groups
+	}
+	if am.EnableGroups && !am.UseTokenGroups && NestedGroupResolution(am.NestedGroupResolution) == RecursiveNestedGroupResolution {
+		nestedGroups, err := resolveNestedGroups(ctx, am, authResult.UserDN, loginName, password)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to resolve nested groups"))
+		}
+		for _, g := range nestedGroups {
+			authResult.Groups = strutil.AppendIfMissing(authResult.Groups, g)
+		}
+	}
+	return authResult, nil
+}
+
 func caseInsensitiveAttributeSearch(attrName string, attributes map[string][]string) (bool, []string) {
 	for k, v := range attributes {
 		if strings.EqualFold(k, attrName) {
@@ -72,6 +72,10 @@ func TestRepository_authenticate(t *testing.T) {
 	groups := []*gldap.Entry{
 		testdirectory.NewGroup(t, "admin", []string{"alice"}),
 		testdirectory.NewGroup(t, "admin", []string{"eve"}, testdirectory.WithDefaults(t, &testdirectory.Defaults{UPNDomain: "example.com"})),
+		// admin-ops is a nested group, which admin is a member of
+		gldap.NewEntry("cn=admin-ops,ou=groups,dc=example,dc=org", map[string][]string{
+			"member": {"cn=admin,ou=groups,dc=example,dc=org"},
+		}),
 	}
 	tokenGroups := map[string][]*gldap.Entry{
 		"S-1-1": {
@@ -97,6 +101,9 @@ func TestRepository_authenticate(t *testing.T) {
 			gldap.NewEntryAttribute(ldap.DefaultADUserPasswordAttribute, []string{"password"}),
 			gldap.NewEntryAttribute(ldap.DefaultOpenLDAPUserPasswordAttribute, []string{"password"}),
 			gldap.NewEntryAttribute("fullName", []string{"test-full-name"}),
+			gldap.NewEntryAttribute("displayName", []string{"test-display-name"}),
+			gldap.NewEntryAttribute("employeeID", []string{"1234"}),
+			gldap.NewEntryAttribute("mailAlias", []string{"a@example.com", "b@example.com"}),
 		)
 	}
 	td.SetUsers(users...)
@@ -266,6 +273,99 @@ func TestRepository_authenticate(t *testing.T) {
 		w.CreateTime = got.CreateTime
 		assert.Empty(cmp.Diff(w, got, protocmp.Transform()))
 	})
+	t.Run("recursive-nested-groups", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		amWithNestedGroups := TestAuthMethod(t, testConn, orgDbWrapper, org.PublicId,
+			[]string{fmt.Sprintf("ldaps://127.0.0.1:%d", td.Port())},
+			WithCertificates(testCtx, tdCerts...),
+			WithDiscoverDn(testCtx),
+			WithEnableGroups(testCtx),
+			WithUserDn(testCtx, testdirectory.DefaultUserDN),
+			WithGroupDn(testCtx, testdirectory.DefaultGroupDN),
+			WithNestedGroupResolution(testCtx, RecursiveNestedGroupResolution),
+		)
+
+		got, err := testRepo.Authenticate(testCtx, amWithNestedGroups.PublicId, testLoginName, testPassword)
+		require.NoError(err)
+		assert.NotNil(got)
+		assert.Equal("[\"cn=admin,ou=groups,dc=example,dc=org\",\"cn=admin-ops,ou=groups,dc=example,dc=org\"]", got.MemberOfGroups)
+	})
+	t.Run("paged-group-search", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		amWithPagedGroups := TestAuthMethod(t, testConn, orgDbWrapper, org.PublicId,
+			[]string{fmt.Sprintf("ldaps://127.0.0.1:%d", td.Port())},
+			WithCertificates(testCtx, tdCerts...),
+			WithDiscoverDn(testCtx),
+			WithEnableGroups(testCtx),
+			WithUserDn(testCtx, testdirectory.DefaultUserDN),
+			WithGroupDn(testCtx, testdirectory.DefaultGroupDN),
+			WithGroupSearchPageSize(testCtx, 1),
+			WithGroupSearchSizeLimit(testCtx, 10),
+		)
+
+		got, err := testRepo.Authenticate(testCtx, amWithPagedGroups.PublicId, testLoginName, testPassword)
+		require.NoError(err)
+		assert.NotNil(got)
+		assert.Equal("[\"cn=admin,ou=groups,dc=example,dc=org\"]", got.MemberOfGroups)
+	})
+	t.Run("account-attribute-maps", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		amWithAttrMaps := TestAuthMethod(t, testConn, orgDbWrapper, org.PublicId,
+			[]string{fmt.Sprintf("ldaps://127.0.0.1:%d", td.Port())},
+			WithCertificates(testCtx, tdCerts...),
+			WithDiscoverDn(testCtx),
+			WithUserDn(testCtx, testdirectory.DefaultUserDN),
+			WithAccountAttributeMap(testCtx, map[string]AccountToAttribute{
+				"displayName": ToFullNameAttribute,
+				"employeeID":  "employee_id",
+				"mailAlias":   "mail_aliases",
+				"missing":     "missing",
+			}),
+		)
+
+		got, err := testRepo.Authenticate(testCtx, amWithAttrMaps.PublicId, testLoginName, testPassword)
+		require.NoError(err)
+		assert.NotNil(got)
+		w := &Account{Account: &store.Account{
+			AuthMethodId:    amWithAttrMaps.PublicId,
+			ScopeId:         amWithAttrMaps.ScopeId,
+			PublicId:        got.PublicId,
+			Version:         got.Version,
+			Dn:              "cn=alice,ou=people,dc=example,dc=org",
+			Email:           "alice@example.com",
+			FullName:        "test-display-name",
+			LoginName:       "alice",
+			EntryAttributes: `{"employee_id":"1234","mail_aliases":["a@example.com","b@example.com"]}`,
+		}}
+		w.UpdateTime = got.UpdateTime
+		w.CreateTime = got.CreateTime
+		assert.Empty(cmp.Diff(w, got, protocmp.Transform()))
+	})
+	t.Run("alternate-user-filters", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		amWithUserFilters := TestAuthMethod(t, testConn, orgDbWrapper, org.PublicId,
+			[]string{fmt.Sprintf("ldaps://127.0.0.1:%d", td.Port())},
+			WithCertificates(testCtx, tdCerts...),
+			WithDiscoverDn(testCtx),
+			WithUserDn(testCtx, testdirectory.DefaultUserDN),
+			WithAlternateUserFilters(testCtx, "(email={{.Username}})"),
+		)
+
+		got, err := testRepo.Authenticate(testCtx, amWithUserFilters.PublicId, "alice@example.com", testPassword)
+		require.NoError(err)
+		require.NotNil(got)
+		assert.Equal(testLoginName, got.LoginName)
+
+		// the primary user filter resolves to the same account
+		got2, err := testRepo.Authenticate(testCtx, amWithUserFilters.PublicId, testLoginName, testPassword)
+		require.NoError(err)
+		require.NotNil(got2)
+		assert.Equal(got.PublicId, got2.PublicId)
+
+		_, err = testRepo.Authenticate(testCtx, amWithUserFilters.PublicId, "unknown@example.com", testPassword)
+		require.Error(err)
+		assert.Contains(err.Error(), "authenticate failed")
+	})
 	t.Run("authenticate-err", func(t *testing.T) {
 		assert, require := assert.New(t), require.New(t)
 
@@ -296,3 +396,37 @@ func Test_caseInsensitiveAttributeSearch(t *testing.T) {
 	assert.False(found)
 	assert.Empty(values)
 }
+
+func Test_authenticateWithUserFilter_notFound(t *testing.T) {
+	t.Parallel()
+	testCtx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	logger := hclog.New(&hclog.LoggerOptions{
+		Name:  "test-logger",
+		Level: hclog.Error,
+	})
+	td := testdirectory.Start(t,
+		testdirectory.WithDefaults(t, &testdirectory.Defaults{AllowAnonymousBind: true}),
+		testdirectory.WithLogger(t, logger),
+	)
+	tdCerts, err := ParseCertificates(testCtx, td.Cert())
+	require.NoError(err)
+	td.SetUsers(testdirectory.NewUsers(t, []string{"alice"})...)
+
+	am, err := NewAuthMethod(testCtx, "o_1234567890",
+		WithUrls(testCtx, TestConvertToUrls(t, fmt.Sprintf("ldaps://127.0.0.1:%d", td.Port()))...),
+		WithCertificates(testCtx, tdCerts...),
+		WithUserDn(testCtx, testdirectory.DefaultUserDN),
+		WithUserAttr(testCtx, testdirectory.DefaultUserAttr),
+		WithDiscoverDn(testCtx),
+	)
+	require.NoError(err)
+
+	_, err = authenticateWithUserFilter(testCtx, am, "", "bob", "password")
+	require.Error(err)
+	assert.Truef(errors.IsNotFoundError(err), "want not found err, got: %q", err)
+
+	_, err = authenticateWithUserFilter(testCtx, am, "", "alice", "bad-password")
+	require.Error(err)
+	assert.False(errors.IsNotFoundError(err))
+}
@@ -8,11 +8,13 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/boundary/internal/auth"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/oplog"
 	"github.com/hashicorp/go-dbw"
+	"github.com/hashicorp/go-secure-stdlib/strutil"
 )
 
 // CreateManagedGroup inserts an ManagedGroup, mg, into the repository and
@@ -38,6 +40,9 @@ func (r *Repository) CreateManagedGroup(ctx context.Context, scopeId string, mg
 	case scopeId == "":
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
 	}
+	if err := auth.ValidateSetOperandIds(ctx, r.reader, mg.AuthMethodId, "", mg.UnionGroupIds, mg.IntersectionGroupIds, mg.DifferenceGroupIds); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
 
 	mg = mg.clone()
 
@@ -176,8 +181,8 @@ func (r *Repository) DeleteManagedGroup(ctx context.Context, scopeId, withPublic
 // ManagedGroup containing the updated values and a count of the number of
 // records updated. mg is not changed.
 //
-// mg must contain a valid PublicId. Only mg.Name, mg.Description, and mg.GroupNames
-// can be updated. If mg.Name is set to a non-empty string, it must be unique
+// mg must contain a valid PublicId. Only mg.Name, mg.Description, mg.GroupNames
+// and the set operand ids can be updated. If mg.Name is set to a non-empty string, it must be unique
 // within mg.AuthMethodId.
 //
 // An attribute of a will be set to NULL in the database if the attribute in a
@@ -202,6 +207,9 @@ func (r *Repository) UpdateManagedGroup(ctx context.Context, scopeId string, mg
 		case strings.EqualFold(NameField, f):
 		case strings.EqualFold(DescriptionField, f):
 		case strings.EqualFold(GroupNamesField, f):
+		case strings.EqualFold(UnionGroupIdsField, f):
+		case strings.EqualFold(IntersectionGroupIdsField, f):
+		case strings.EqualFold(DifferenceGroupIdsField, f):
 		default:
 			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, f)
 		}
@@ -209,9 +217,12 @@ func (r *Repository) UpdateManagedGroup(ctx context.Context, scopeId string, mg
 	var dbMask, nullFields []string
 	dbMask, nullFields = dbw.BuildUpdatePaths(
 		map[string]any{
-			NameField:        mg.Name,
-			DescriptionField: mg.Description,
-			GroupNamesField:  mg.GroupNames,
+			NameField:                 mg.Name,
+			DescriptionField:          mg.Description,
+			GroupNamesField:           mg.GroupNames,
+			UnionGroupIdsField:        mg.UnionGroupIds,
+			IntersectionGroupIdsField: mg.IntersectionGroupIds,
+			DifferenceGroupIdsField:   mg.DifferenceGroupIds,
 		},
 		fieldMaskPaths,
 		nil,
@@ -231,6 +242,13 @@ func (r *Repository) UpdateManagedGroup(ctx context.Context, scopeId string, mg
 	if err := r.reader.LookupById(ctx, foundMg); err != nil {
 		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("managed group not found"))
 	}
+	if strutil.StrListContainsCaseInsensitive(fieldMaskPaths, UnionGroupIdsField) ||
+		strutil.StrListContainsCaseInsensitive(fieldMaskPaths, IntersectionGroupIdsField) ||
+		strutil.StrListContainsCaseInsensitive(fieldMaskPaths, DifferenceGroupIdsField) {
+		if err := auth.ValidateSetOperandIds(ctx, r.reader, foundMg.AuthMethodId, mg.PublicId, mg.UnionGroupIds, mg.IntersectionGroupIds, mg.DifferenceGroupIds); err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+	}
 	metadata, err := foundMg.oplog(ctx, oplog.OpType_OP_TYPE_UPDATE, scopeId)
 	if err != nil {
 		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to generate oplog metadata"))
@@ -32,12 +32,15 @@ type (
 //   - Authenticate the user against the auth method's configured ldap server.
 //   - Use iam.(Repository).LookupUserWithLogin(...) look up the iam.User matching the Account.
 //   - Use the authtoken.(Repository).CreateAuthToken(...) to create a pending auth token for the authenticated user.
+//
+// The provided authtoken options are passed to CreateAuthToken.
 func Authenticate(
 	ctx context.Context,
 	authenticatorFn AuthenticatorFactory,
 	lookupUserFn LookupUserFactory,
 	tokenCreatorFn AuthTokenCreatorFactory,
 	authMethodId, loginName, password string,
+	opt ...authtoken.Option,
 ) (*authtoken.AuthToken, error) {
 	const op = "ldap.Authenticate"
 	switch {
@@ -78,7 +81,7 @@ func Authenticate(
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
-	token, err := at.CreateAuthToken(ctx, user, acct.PublicId)
+	token, err := at.CreateAuthToken(ctx, user, acct.PublicId, opt...)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
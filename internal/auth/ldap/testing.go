@@ -96,6 +96,15 @@ func TestAuthMethod(t testing.TB,
 				}
 			}
 		}
+		for priority, f := range opts.withAlternateUserFilters {
+			uf, err := NewAlternateUserFilter(testCtx, am.PublicId, priority+1, f)
+			if err != nil {
+				return err
+			}
+			if err := w.Create(testCtx, uf); err != nil {
+				return err
+			}
+		}
 		if opts.withUserDn != "" || opts.withUserAttr != "" || opts.withUserFilter != "" {
 			uc, err := NewUserEntrySearchConf(testCtx, am.PublicId, opt...)
 			if err != nil {
@@ -105,7 +114,8 @@ func TestAuthMethod(t testing.TB,
 				return err
 			}
 		}
-		if opts.withGroupDn != "" || opts.withGroupAttr != "" || opts.withGroupFilter != "" {
+		if opts.withGroupDn != "" || opts.withGroupAttr != "" || opts.withGroupFilter != "" ||
+			opts.withGroupSearchPageSize != 0 || opts.withGroupSearchSizeLimit != 0 {
 			uc, err := NewGroupEntrySearchConf(testCtx, am.PublicId, opt...)
 			if err != nil {
 				return err
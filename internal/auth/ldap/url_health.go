@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ldap
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// CircuitBreakerThreshold is the number of consecutive connection failures
+	// to an ldap url after which its circuit is opened.
+	CircuitBreakerThreshold = 3
+
+	// CircuitBreakerCooldown is how long the circuit of an ldap url stays open
+	// before the url is tried again.
+	CircuitBreakerCooldown = 30 * time.Second
+)
+
+// connectFailedErrMsg is the error message returned by the ldap client when it
+// can't connect to any of its urls.
+const connectFailedErrMsg = "failed to connect"
+
+// connectionHealth tracks the health of the ldap urls connected to by all the
+// ldap auth methods, since auth methods which share a url share its server.
+var connectionHealth = newUrlHealth()
+
+// urlHealth is a per url circuit breaker for ldap connections.  Once a url has
+// CircuitBreakerThreshold consecutive connection failures its circuit is
+// opened, and the url is tried after all the urls with closed circuits until
+// the CircuitBreakerCooldown has passed.  After the cooldown the url is
+// probed by the next connection made to it: a success closes the circuit and a
+// failure opens it again.
+type urlHealth struct {
+	mu     sync.Mutex
+	states map[string]*urlState
+	now    func() time.Time
+}
+
+type urlState struct {
+	failures  int
+	openUntil time.Time
+}
+
+func newUrlHealth() *urlHealth {
+	return &urlHealth{
+		states: map[string]*urlState{},
+		now:    time.Now,
+	}
+}
+
+// order returns the urls in the order they should be connected to: the urls
+// with closed circuits (or whose cooldown has passed) in their configured
+// order, followed by the urls with open circuits in the order their cooldowns
+// end.  No url is ever dropped, so a connection is still attempted when every
+// circuit is open.
+func (h *urlHealth) order(urls []string) []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	now := h.now()
+	available := make([]string, 0, len(urls))
+	var open []string
+	for _, u := range urls {
+		if s, ok := h.states[u]; ok && s.openUntil.After(now) {
+			open = append(open, u)
+			continue
+		}
+		available = append(available, u)
+	}
+	sort.SliceStable(open, func(i, j int) bool {
+		return h.states[open[i]].openUntil.Before(h.states[open[j]].openUntil)
+	})
+	h.updateOpenCircuits(now)
+	return append(available, open...)
+}
+
+// success records a successful connection to the url, closing its circuit.
+func (h *urlHealth) success(u string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.states, u)
+	connectionAttemptsTotal.WithLabelValues(connectionSuccess).Inc()
+	h.updateOpenCircuits(h.now())
+}
+
+// failure records a failed connection to the url, opening its circuit once it
+// has failed CircuitBreakerThreshold consecutive times.
+func (h *urlHealth) failure(u string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	s, ok := h.states[u]
+	if !ok {
+		s = &urlState{}
+		h.states[u] = s
+	}
+	s.failures++
+	now := h.now()
+	if s.failures >= CircuitBreakerThreshold {
+		s.openUntil = now.Add(CircuitBreakerCooldown)
+	}
+	connectionAttemptsTotal.WithLabelValues(connectionFailure).Inc()
+	h.updateOpenCircuits(now)
+}
+
+// updateOpenCircuits sets the open circuits gauge.  The caller must hold the
+// lock.
+func (h *urlHealth) updateOpenCircuits(now time.Time) {
+	var open int
+	for _, s := range h.states {
+		if s.openUntil.After(now) {
+			open++
+		}
+	}
+	openCircuits.Set(float64(open))
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ldap
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/jimlambrt/gldap/testdirectory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_urlHealth(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	newHealth := func() *urlHealth {
+		h := newUrlHealth()
+		h.now = func() time.Time { return now }
+		return h
+	}
+	urls := []string{"ldaps://one", "ldaps://two", "ldaps://three"}
+
+	t.Run("no-failures", func(t *testing.T) {
+		h := newHealth()
+		assert.Equal(t, urls, h.order(urls))
+	})
+	t.Run("below-threshold", func(t *testing.T) {
+		h := newHealth()
+		for i := 0; i < CircuitBreakerThreshold-1; i++ {
+			h.failure("ldaps://one")
+		}
+		assert.Equal(t, urls, h.order(urls))
+	})
+	t.Run("open-circuit", func(t *testing.T) {
+		h := newHealth()
+		for i := 0; i < CircuitBreakerThreshold; i++ {
+			h.failure("ldaps://one")
+		}
+		assert.Equal(t, []string{"ldaps://two", "ldaps://three", "ldaps://one"}, h.order(urls))
+	})
+	t.Run("open-circuits-ordered-by-cooldown", func(t *testing.T) {
+		h := newHealth()
+		for i := 0; i < CircuitBreakerThreshold; i++ {
+			h.failure("ldaps://two")
+		}
+		h.now = func() time.Time { return now.Add(time.Second) }
+		for i := 0; i < CircuitBreakerThreshold; i++ {
+			h.failure("ldaps://one")
+		}
+		assert.Equal(t, []string{"ldaps://three", "ldaps://two", "ldaps://one"}, h.order(urls))
+	})
+	t.Run("success-closes-circuit", func(t *testing.T) {
+		h := newHealth()
+		for i := 0; i < CircuitBreakerThreshold; i++ {
+			h.failure("ldaps://one")
+		}
+		h.success("ldaps://one")
+		assert.Equal(t, urls, h.order(urls))
+		// the consecutive failures start over
+		h.failure("ldaps://one")
+		assert.Equal(t, urls, h.order(urls))
+	})
+	t.Run("cooldown", func(t *testing.T) {
+		h := newHealth()
+		for i := 0; i < CircuitBreakerThreshold; i++ {
+			h.failure("ldaps://one")
+		}
+		h.now = func() time.Time { return now.Add(CircuitBreakerCooldown) }
+		assert.Equal(t, urls, h.order(urls))
+		// the probe after the cooldown failed, so the circuit opens again
+		h.failure("ldaps://one")
+		assert.Equal(t, []string{"ldaps://two", "ldaps://three", "ldaps://one"}, h.order(urls))
+	})
+}
+
+func Test_authenticateWithUserFilter_failover(t *testing.T) {
+	t.Parallel()
+	testCtx := context.Background()
+	logger := hclog.New(&hclog.LoggerOptions{
+		Name:  "test-logger",
+		Level: hclog.Error,
+	})
+	td := testdirectory.Start(t,
+		testdirectory.WithDefaults(t, &testdirectory.Defaults{AllowAnonymousBind: true}),
+		testdirectory.WithLogger(t, logger),
+	)
+	tdCerts, err := ParseCertificates(testCtx, td.Cert())
+	require.NoError(t, err)
+	td.SetUsers(testdirectory.NewUsers(t, []string{"alice"})...)
+
+	// find a port which nothing is listening on
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	downUrl := fmt.Sprintf("ldaps://%s", l.Addr().String())
+	require.NoError(t, l.Close())
+	upUrl := fmt.Sprintf("ldaps://127.0.0.1:%d", td.Port())
+
+	am, err := NewAuthMethod(testCtx, "o_1234567890",
+		WithUrls(testCtx, TestConvertToUrls(t, downUrl, upUrl)...),
+		WithCertificates(testCtx, tdCerts...),
+		WithUserDn(testCtx, testdirectory.DefaultUserDN),
+		WithUserAttr(testCtx, testdirectory.DefaultUserAttr),
+		WithDiscoverDn(testCtx),
+	)
+	require.NoError(t, err)
+
+	assert, require := assert.New(t), require.New(t)
+	for i := 0; i < CircuitBreakerThreshold; i++ {
+		got, err := authenticateWithUserFilter(testCtx, am, "", "alice", "password")
+		require.NoError(err)
+		assert.Equal(fmt.Sprintf("cn=alice,%s", testdirectory.DefaultUserDN), got.UserDN)
+	}
+	// the down url's circuit is open, so it's tried last
+	assert.Equal([]string{upUrl, downUrl}, connectionHealth.order(am.Urls))
+
+	_, err = authenticateWithUserFilter(testCtx, am, "", "alice", "bad-password")
+	require.Error(err)
+	assert.NotContains(err.Error(), connectFailedErrMsg)
+}
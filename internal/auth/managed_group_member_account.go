@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package auth
+
+import (
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+)
+
+// ManagedGroupMemberAccount represents an entry from
+// auth_managed_group_member_account.  These are used to determine the account
+// ids where are a member of managed groups.  See: oidc and ldap managed groups
+// as well as iam role grants.
+type ManagedGroupMemberAccount struct {
+	CreateTime     *timestamp.Timestamp
+	MemberId       string
+	ManagedGroupId string
+	tableName      string
+}
+
+// SetTableName sets the table name.
+func (a *ManagedGroupMemberAccount) SetTableName(n string) {
+	a.tableName = n
+}
+
+// TableName returns the table name.
+func (a *ManagedGroupMemberAccount) TableName() string {
+	if a.tableName != "" {
+		return a.tableName
+	}
+	return "auth_managed_group_member_account"
+}
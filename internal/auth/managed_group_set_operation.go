@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// MarshalSetOperandIds marshals the ids of a managed group's union,
+// intersection or difference operands for storage. An empty list is
+// marshalled to an empty string so the column is stored as null.
+func MarshalSetOperandIds(ctx context.Context, ids []string) (string, error) {
+	const op = "auth.MarshalSetOperandIds"
+	if len(ids) == 0 {
+		return "", nil
+	}
+	b, err := json.Marshal(ids)
+	if err != nil {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "unable to marshal set operand ids", errors.WithWrap(err))
+	}
+	return string(b), nil
+}
+
+// UnmarshalSetOperandIds is the inverse of MarshalSetOperandIds.
+func UnmarshalSetOperandIds(ctx context.Context, ids string) ([]string, error) {
+	const op = "auth.UnmarshalSetOperandIds"
+	if ids == "" {
+		return nil, nil
+	}
+	var out []string
+	if err := json.Unmarshal([]byte(ids), &out); err != nil {
+		return nil, errors.New(ctx, errors.Internal, op, "unable to unmarshal set operand ids", errors.WithWrap(err))
+	}
+	return out, nil
+}
+
+// ValidateSetOperandIds verifies that each of the operand ids is either an
+// existing static group or an existing managed group of the auth method which
+// isn't the managed group itself. The operand ids are the marshalled union,
+// intersection and difference operands of a managed group.
+func ValidateSetOperandIds(ctx context.Context, r db.Reader, authMethodId, managedGroupId string, operandIds ...string) error {
+	const op = "auth.ValidateSetOperandIds"
+	switch {
+	case r == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing reader")
+	case authMethodId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+	var groupIds, managedGroupIds []string
+	for _, marshalled := range operandIds {
+		ids, err := UnmarshalSetOperandIds(ctx, marshalled)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		for _, id := range ids {
+			switch {
+			case managedGroupId != "" && id == managedGroupId:
+				return errors.New(ctx, errors.InvalidParameter, op, "a managed group cannot be a set operand of itself")
+			case strings.HasPrefix(id, globals.GroupPrefix+"_"):
+				groupIds = append(groupIds, id)
+			case strings.HasPrefix(id, globals.OidcManagedGroupPrefix+"_"), strings.HasPrefix(id, globals.LdapManagedGroupPrefix+"_"):
+				managedGroupIds = append(managedGroupIds, id)
+			default:
+				return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("set operand %q is not a group or managed group id", id))
+			}
+		}
+	}
+	if len(managedGroupIds) > 0 {
+		if err := verifyAllExist(ctx, r, setOperandManagedGroupsQuery, []any{authMethodId, managedGroupIds}, managedGroupIds); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("operand managed groups must belong to auth method %s", authMethodId)))
+		}
+	}
+	if len(groupIds) > 0 {
+		if err := verifyAllExist(ctx, r, setOperandGroupsQuery, []any{groupIds}, groupIds); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	}
+	return nil
+}
+
+func verifyAllExist(ctx context.Context, r db.Reader, query string, args []any, ids []string) error {
+	const op = "auth.verifyAllExist"
+	rows, err := r.Query(ctx, query, args)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	found := make(map[string]bool, len(ids))
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		found[id] = true
+	}
+	if err := rows.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	for _, id := range ids {
+		if !found[id] {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("set operand %q not found", id))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetOperandIds(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	t.Run("empty", func(t *testing.T) {
+		got, err := MarshalSetOperandIds(ctx, nil)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+		ids, err := UnmarshalSetOperandIds(ctx, got)
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+	})
+	t.Run("round-trip", func(t *testing.T) {
+		want := []string{"mgoidc_1234567890", "g_1234567890"}
+		got, err := MarshalSetOperandIds(ctx, want)
+		require.NoError(t, err)
+		ids, err := UnmarshalSetOperandIds(ctx, got)
+		require.NoError(t, err)
+		assert.Equal(t, want, ids)
+	})
+	t.Run("bad-json", func(t *testing.T) {
+		_, err := UnmarshalSetOperandIds(ctx, "not json")
+		require.Error(t, err)
+		assert.True(t, errors.Match(errors.T(errors.Internal), err))
+	})
+	t.Run("missing-reader", func(t *testing.T) {
+		err := ValidateSetOperandIds(ctx, nil, "amoidc_1234567890", "")
+		require.Error(t, err)
+		assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+}
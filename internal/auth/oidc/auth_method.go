@@ -61,7 +61,9 @@ type AuthMethod struct {
 // See: https://openid.net/specs/openid-connect-core-1_0.html
 //
 // Supports the options of WithMaxAge, WithSigningAlgs, WithAudClaims,
-// WithApiUrl and WithCertificates and all other options are ignored.
+// WithApiUrl, WithCertificates, WithJwtValidationPubKeys, WithBoundClaims,
+// WithJwtLoginEnabled and WithDeletionProtected and all other options are
+// ignored.
 func NewAuthMethod(ctx context.Context, scopeId string, clientId string, clientSecret ClientSecret, opt ...Option) (*AuthMethod, error) {
 	const op = "oidc.NewAuthMethod"
 	opts := getOpts(opt...)
@@ -74,15 +76,17 @@ func NewAuthMethod(ctx context.Context, scopeId string, clientId string, clientS
 
 	a := &AuthMethod{
 		AuthMethod: &store.AuthMethod{
-			ScopeId:          scopeId,
-			Name:             opts.withName,
-			Description:      opts.withDescription,
-			OperationalState: string(opts.withOperationalState),
-			Issuer:           u,
-			ClientId:         clientId,
-			ClientSecret:     string(clientSecret),
-			MaxAge:           int32(opts.withMaxAge),
-			ClaimsScopes:     opts.withClaimsScopes,
+			ScopeId:           scopeId,
+			Name:              opts.withName,
+			Description:       opts.withDescription,
+			OperationalState:  string(opts.withOperationalState),
+			Issuer:            u,
+			ClientId:          clientId,
+			ClientSecret:      string(clientSecret),
+			MaxAge:            int32(opts.withMaxAge),
+			ClaimsScopes:      opts.withClaimsScopes,
+			DeletionProtected: opts.withDeletionProtected,
+			JwtLoginEnabled:   opts.withJwtLoginEnabled,
 		},
 	}
 	if opts.withApiUrl != nil {
@@ -113,6 +117,14 @@ func NewAuthMethod(ctx context.Context, scopeId string, clientId string, clientS
 			a.AccountClaimMaps = append(a.AccountClaimMaps, fmt.Sprintf("%s=%s", k, v))
 		}
 	}
+	if len(opts.withJwtValidationPubKeys) > 0 {
+		a.JwtValidationPubKeys = make([]string, 0, len(opts.withJwtValidationPubKeys))
+		a.JwtValidationPubKeys = append(a.JwtValidationPubKeys, opts.withJwtValidationPubKeys...)
+	}
+	if len(opts.withBoundClaims) > 0 {
+		a.BoundClaims = make([]string, 0, len(opts.withBoundClaims))
+		a.BoundClaims = append(a.BoundClaims, opts.withBoundClaims...)
+	}
 	if a.OperationalState != string(InactiveState) {
 		if err := a.isComplete(ctx); err != nil {
 			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("new auth method being created with incomplete data but non-inactive state"))
@@ -277,11 +289,13 @@ func (am *AuthMethod) isComplete(ctx context.Context) error {
 }
 
 type convertedValues struct {
-	Algs             []any
-	Auds             []any
-	Certs            []any
-	ClaimsScopes     []any
-	AccountClaimMaps []any
+	Algs                 []any
+	Auds                 []any
+	Certs                []any
+	ClaimsScopes         []any
+	AccountClaimMaps     []any
+	JwtValidationPubKeys []any
+	BoundClaims          []any
 }
 
 // convertValueObjects converts the embedded value objects. It will return an
@@ -292,7 +306,7 @@ func (am *AuthMethod) convertValueObjects(ctx context.Context) (*convertedValues
 		return nil, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
 	}
 	var err error
-	var addAlgs, addAuds, addCerts, addScopes, addAccountClaimMaps []any
+	var addAlgs, addAuds, addCerts, addScopes, addAccountClaimMaps, addPubKeys, addBoundClaims []any
 	if addAlgs, err = am.convertSigningAlgs(ctx); err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
@@ -308,12 +322,20 @@ func (am *AuthMethod) convertValueObjects(ctx context.Context) (*convertedValues
 	if addAccountClaimMaps, err = am.convertAccountClaimMaps(ctx); err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
+	if addPubKeys, err = am.convertJwtValidationPubKeys(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if addBoundClaims, err = am.convertBoundClaims(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
 	return &convertedValues{
-		Algs:             addAlgs,
-		Auds:             addAuds,
-		Certs:            addCerts,
-		ClaimsScopes:     addScopes,
-		AccountClaimMaps: addAccountClaimMaps,
+		Algs:                 addAlgs,
+		Auds:                 addAuds,
+		Certs:                addCerts,
+		ClaimsScopes:         addScopes,
+		AccountClaimMaps:     addAccountClaimMaps,
+		JwtValidationPubKeys: addPubKeys,
+		BoundClaims:          addBoundClaims,
 	}, nil
 }
 
@@ -425,6 +447,57 @@ func (am *AuthMethod) convertAccountClaimMaps(ctx context.Context) ([]any, error
 	return newInterfaces, nil
 }
 
+// convertJwtValidationPubKeys converts the embedded jwt validation public keys
+// from []string to []interface{} where each slice element is a
+// *JwtValidationPubKey. It will return an error if the AuthMethod's public id
+// is not set.
+func (am *AuthMethod) convertJwtValidationPubKeys(ctx context.Context) ([]any, error) {
+	const op = "oidc.(AuthMethod).convertJwtValidationPubKeys"
+	if am.PublicId == "" {
+		return nil, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	newInterfaces := make([]any, 0, len(am.JwtValidationPubKeys))
+	for _, k := range am.JwtValidationPubKeys {
+		obj, err := NewJwtValidationPubKey(ctx, am.PublicId, k)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		newInterfaces = append(newInterfaces, obj)
+	}
+	return newInterfaces, nil
+}
+
+// convertBoundClaims converts the embedded bound claims from []string to
+// []interface{} where each slice element is a *BoundClaim. It will return an
+// error if the AuthMethod's public id is not set or it can't parse the bound
+// claims.
+func (am *AuthMethod) convertBoundClaims(ctx context.Context) ([]any, error) {
+	const op = "oidc.(AuthMethod).convertBoundClaims"
+	if am.PublicId == "" {
+		return nil, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	bound, err := ParseBoundClaims(ctx, am.BoundClaims...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	claims := make([]string, 0, len(bound))
+	for c := range bound {
+		claims = append(claims, c)
+	}
+	sort.Strings(claims)
+	newInterfaces := make([]any, 0, len(am.BoundClaims))
+	for _, c := range claims {
+		for _, v := range bound[c] {
+			obj, err := NewBoundClaim(ctx, am.PublicId, c, v)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			newInterfaces = append(newInterfaces, obj)
+		}
+	}
+	return newInterfaces, nil
+}
+
 // ClaimMap defines the To and From of an oidc claim map
 type ClaimMap struct {
 	To   string
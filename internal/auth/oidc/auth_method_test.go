@@ -612,11 +612,13 @@ func Test_convertValueObjects(t *testing.T) {
 			scopes:       testScopes,
 			maps:         testClaimMaps,
 			wantValues: &convertedValues{
-				Algs:             testSigningAlgs,
-				Auds:             testAudiences,
-				Certs:            testCertificates,
-				ClaimsScopes:     testClaimsScopes,
-				AccountClaimMaps: testAccountClaimMaps,
+				Algs:                 testSigningAlgs,
+				Auds:                 testAudiences,
+				Certs:                testCertificates,
+				ClaimsScopes:         testClaimsScopes,
+				AccountClaimMaps:     testAccountClaimMaps,
+				JwtValidationPubKeys: []any{},
+				BoundClaims:          []any{},
 			},
 		},
 		{
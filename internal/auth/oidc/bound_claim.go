@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/auth/oidc/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultBoundClaimTableName defines the default table name for a BoundClaim
+const defaultBoundClaimTableName = "auth_oidc_bound_claim"
+
+// BoundClaim defines a claim value a token must have to authenticate with an
+// OIDC auth method.  When a claim has more than one BoundClaim, the token must
+// match one of its values.
+type BoundClaim struct {
+	*store.BoundClaim
+	tableName string
+}
+
+// NewBoundClaim creates a new in memory bound claim assigned to an OIDC auth
+// method.
+func NewBoundClaim(ctx context.Context, authMethodId, claim, value string) (*BoundClaim, error) {
+	const op = "oidc.NewBoundClaim"
+	bc := &BoundClaim{
+		BoundClaim: &store.BoundClaim{
+			OidcMethodId: authMethodId,
+			Claim:        claim,
+			Value:        value,
+		},
+	}
+	if err := bc.validate(ctx, op); err != nil {
+		return nil, err // intentionally not wrapped
+	}
+	return bc, nil
+}
+
+// validate the BoundClaim.  On success, it will return nil.
+func (bc *BoundClaim) validate(ctx context.Context, caller errors.Op) error {
+	if bc.OidcMethodId == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing oidc auth method id")
+	}
+	if strings.TrimSpace(bc.Claim) == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing claim")
+	}
+	if strings.TrimSpace(bc.Value) == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing value")
+	}
+	// bound claims are aggregated using the "|" delimiter when read from the
+	// database.
+	if strings.Contains(bc.Claim, "|") || strings.Contains(bc.Value, "|") {
+		return errors.New(ctx, errors.InvalidParameter, caller, `bound claim cannot contain "|"`)
+	}
+	return nil
+}
+
+// ParseBoundClaims will parse the inbound bound claims, which are represented
+// as claim=value, into a map of claims to their allowed values.
+func ParseBoundClaims(ctx context.Context, m ...string) (map[string][]string, error) {
+	const op = "oidc.ParseBoundClaims"
+	bound := make(map[string][]string, len(m))
+	for _, bc := range m {
+		claim, value, ok := strings.Cut(bc, "=")
+		claim, value = strings.TrimSpace(claim), strings.TrimSpace(value)
+		if !ok || claim == "" || value == "" {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("bound claim %q is not in the format claim=value", bc))
+		}
+		bound[claim] = append(bound[claim], value)
+	}
+	for _, values := range bound {
+		sort.Strings(values)
+	}
+	return bound, nil
+}
+
+// AllocBoundClaim makes an empty one in memory
+func AllocBoundClaim() BoundClaim {
+	return BoundClaim{
+		BoundClaim: &store.BoundClaim{},
+	}
+}
+
+// Clone a BoundClaim
+func (bc *BoundClaim) Clone() *BoundClaim {
+	cp := proto.Clone(bc.BoundClaim)
+	return &BoundClaim{
+		BoundClaim: cp.(*store.BoundClaim),
+	}
+}
+
+// TableName returns the table name.
+func (bc *BoundClaim) TableName() string {
+	if bc.tableName != "" {
+		return bc.tableName
+	}
+	return defaultBoundClaimTableName
+}
+
+// SetTableName sets the table name.
+func (bc *BoundClaim) SetTableName(n string) {
+	bc.tableName = n
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBoundClaim(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tests := []struct {
+		name         string
+		authMethodId string
+		claim        string
+		value        string
+		wantErr      bool
+	}{
+		{
+			name:         "valid",
+			authMethodId: "amoidc_1234567890",
+			claim:        "repository",
+			value:        "hashicorp/boundary",
+		},
+		{
+			name:    "missing-auth-method-id",
+			claim:   "repository",
+			value:   "hashicorp/boundary",
+			wantErr: true,
+		},
+		{
+			name:         "missing-claim",
+			authMethodId: "amoidc_1234567890",
+			value:        "hashicorp/boundary",
+			wantErr:      true,
+		},
+		{
+			name:         "missing-value",
+			authMethodId: "amoidc_1234567890",
+			claim:        "repository",
+			value:        " ",
+			wantErr:      true,
+		},
+		{
+			name:         "delimiter",
+			authMethodId: "amoidc_1234567890",
+			claim:        "repository",
+			value:        "hashicorp/boundary|hashicorp/vault",
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewBoundClaim(ctx, tt.authMethodId, tt.claim, tt.value)
+			if tt.wantErr {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.authMethodId, got.OidcMethodId)
+			assert.Equal(tt.claim, got.Claim)
+			assert.Equal(tt.value, got.Value)
+			assert.Equal(defaultBoundClaimTableName, got.TableName())
+			cp := got.Clone()
+			assert.Equal(got.BoundClaim, cp.BoundClaim)
+		})
+	}
+}
+
+func TestParseBoundClaims(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tests := []struct {
+		name    string
+		claims  []string
+		want    map[string][]string
+		wantErr bool
+	}{
+		{
+			name:   "empty",
+			claims: nil,
+			want:   map[string][]string{},
+		},
+		{
+			name:   "multiple-values",
+			claims: []string{"ref=refs/heads/main", "repository=hashicorp/boundary", "ref = refs/heads/release"},
+			want: map[string][]string{
+				"ref":        {"refs/heads/main", "refs/heads/release"},
+				"repository": {"hashicorp/boundary"},
+			},
+		},
+		{
+			name:   "value-with-equals",
+			claims: []string{"sub=repo:hashicorp/boundary:ref=main"},
+			want: map[string][]string{
+				"sub": {"repo:hashicorp/boundary:ref=main"},
+			},
+		},
+		{
+			name:    "missing-equals",
+			claims:  []string{"repository"},
+			wantErr: true,
+		},
+		{
+			name:    "missing-claim",
+			claims:  []string{"=hashicorp/boundary"},
+			wantErr: true,
+		},
+		{
+			name:    "missing-value",
+			claims:  []string{"repository="},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			got, err := ParseBoundClaims(ctx, tt.claims...)
+			if tt.wantErr {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, got)
+		})
+	}
+}
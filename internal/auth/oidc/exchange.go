@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gooidc "github.com/coreos/go-oidc/v3/oidc"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/cap/oidc"
+	"github.com/hashicorp/go-secure-stdlib/strutil"
+	"golang.org/x/oauth2"
+)
+
+// idTokenLeeway is the allowed clock skew when validating ID token times.
+const idTokenLeeway = 1 * time.Minute
+
+// exchange will exchange the authorization code for tokens with the IdP and
+// verify the returned ID token.  Unlike oidc.(Provider).Exchange, the ID
+// token's signature is verified with the auth method's cached keySet, so it
+// doesn't depend on the IdP's JWKS endpoint being available and tolerates
+// recent signing key rollovers.
+//
+// It verifies the same things as oidc.(Provider).Exchange:
+//   - signature, iss, exp, nbf, supported signing algorithms
+//   - nonce, iat, aud, azp and auth_time (when a max age was requested)
+//   - the access_token when present
+//   - c_hash when present
+func exchange(ctx context.Context, am *AuthMethod, p *oidc.Provider, oidcRequest oidc.Request, authorizationCode string) (*oidc.Tk, error) {
+	const op = "oidc.exchange"
+	switch {
+	case am == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	case p == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing provider")
+	case oidcRequest == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing oidc request")
+	case authorizationCode == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing authorization code")
+	case oidcRequest.RedirectURL() == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing redirect url")
+	case oidcRequest.IsExpired():
+		return nil, errors.New(ctx, errors.AuthAttemptExpired, op, "oidc request is expired")
+	}
+	ks, err := keySetCache().get(ctx, am.PublicId, p)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	oidcCtx, err := p.HTTPClientContext(ctx)
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to create http client", errors.WithWrap(err))
+	}
+	oauth2Config := oauth2.Config{
+		ClientID:     am.ClientId,
+		ClientSecret: am.ClientSecret,
+		RedirectURL:  oidcRequest.RedirectURL(),
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  ks.discoveryInfo.AuthURL,
+			TokenURL: ks.discoveryInfo.TokenURL,
+		},
+		Scopes: append([]string{gooidc.ScopeOpenID}, oidcRequest.Scopes()...),
+	}
+	oauth2Token, err := oauth2Config.Exchange(oidcCtx, authorizationCode)
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to exchange auth code with provider", errors.WithWrap(err))
+	}
+	rawIdToken, ok := oauth2Token.Extra("id_token").(string)
+	if !ok {
+		return nil, errors.New(ctx, errors.Unknown, op, "id_token is missing from auth code exchange")
+	}
+	tk, err := oidc.NewToken(oidc.IDToken(rawIdToken), oauth2Token)
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to create new id_token", errors.WithWrap(err))
+	}
+	claims, err := verifyIdToken(ctx, am, ks, tk.IDToken(), oidcRequest)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if tk.AccessToken() != "" {
+		if _, err := tk.IDToken().VerifyAccessToken(tk.AccessToken()); err != nil {
+			return nil, errors.New(ctx, errors.Unknown, op, "access_token failed verification", errors.WithWrap(err))
+		}
+	}
+	if cHash, ok := claims["c_hash"].(string); ok && cHash != "" {
+		if _, err := tk.IDToken().VerifyAuthorizationCode(authorizationCode); err != nil {
+			return nil, errors.New(ctx, errors.Unknown, op, "code hash failed verification", errors.WithWrap(err))
+		}
+	}
+	return tk, nil
+}
+
+// verifyIdToken verifies the ID token and returns its claims.  The signature
+// is verified using the keySet.
+func verifyIdToken(ctx context.Context, am *AuthMethod, ks *keySet, t oidc.IDToken, oidcRequest oidc.Request) (map[string]any, error) {
+	const op = "oidc.verifyIdToken"
+	switch {
+	case t == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing id_token")
+	case ks == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing key set")
+	case oidcRequest.Nonce() == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing nonce")
+	}
+	algs := append([]string{}, am.SigningAlgs...)
+	issuer := am.Issuer
+	if ks.discoveryInfo != nil && ks.discoveryInfo.Issuer != "" {
+		issuer = ks.discoveryInfo.Issuer
+	}
+	verifier := gooidc.NewVerifier(issuer, ks, &gooidc.Config{
+		SkipClientIDCheck:    true,
+		SupportedSigningAlgs: algs,
+		Now:                  ks.now,
+	})
+	now := ks.now()
+
+	// verifier.Verify will check the supported algs, signature, iss, exp, nbf.
+	idToken, err := verifier.Verify(ctx, string(t))
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "invalid id_token", errors.WithWrap(err))
+	}
+	if idToken.Nonce != oidcRequest.Nonce() {
+		return nil, errors.New(ctx, errors.Unknown, op, "invalid id_token nonce")
+	}
+	if now.Add(idTokenLeeway).Before(idToken.IssuedAt) {
+		return nil, errors.New(ctx, errors.Unknown, op, fmt.Sprintf("invalid id_token current time %v before the iat (issued at) time %v", now, idToken.IssuedAt))
+	}
+
+	audiences := oidcRequest.Audiences()
+	if len(audiences) == 0 {
+		audiences = am.AudClaims
+	}
+	if len(audiences) > 0 {
+		found := false
+		for _, a := range audiences {
+			if strutil.StrListContains(idToken.Audience, a) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, errors.New(ctx, errors.Unknown, op, "invalid id_token audiences")
+		}
+	}
+	if len(idToken.Audience) > 1 && !strutil.StrListContains(idToken.Audience, am.ClientId) {
+		return nil, errors.New(ctx, errors.Unknown, op, fmt.Sprintf("invalid id_token: multiple audiences (%s) and one of them is not equal client_id (%s)", idToken.Audience, am.ClientId))
+	}
+
+	var claims map[string]any
+	if err := t.Claims(&claims); err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to parse id_token claims", errors.WithWrap(err))
+	}
+
+	azp, foundAzp := claims["azp"]
+	switch {
+	case foundAzp && azp != am.ClientId:
+		return nil, errors.New(ctx, errors.Unknown, op, fmt.Sprintf("invalid id_token: authorized party (%s) is not equal client_id (%s)", azp, am.ClientId))
+	case len(idToken.Audience) > 1 && azp != am.ClientId:
+		return nil, errors.New(ctx, errors.Unknown, op, fmt.Sprintf("invalid id_token: multiple audiences and authorized party (%s) is not equal client_id (%s)", azp, am.ClientId))
+	case len(idToken.Audience) == 1 && idToken.Audience[0] != am.ClientId && azp != am.ClientId:
+		return nil, errors.New(ctx, errors.Unknown, op, fmt.Sprintf("invalid id_token: one audience (%s) which is not the client_id (%s) and authorized party (%s) is not equal client_id", idToken.Audience[0], am.ClientId, azp))
+	}
+
+	if secs, authAfter := oidcRequest.MaxAge(); !authAfter.IsZero() {
+		atClaim, ok := claims["auth_time"].(float64)
+		if !ok {
+			return nil, errors.New(ctx, errors.Unknown, op, "missing auth_time claim when max age was requested")
+		}
+		authTime := time.Unix(int64(atClaim), 0)
+		if !authTime.Add(idTokenLeeway).After(authAfter) {
+			return nil, errors.New(ctx, errors.Unknown, op, fmt.Sprintf("auth_time (%s) is beyond max age (%d)", authTime, secs))
+		}
+	}
+	return claims, nil
+}
@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/cap/oidc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_verifyIdToken(t *testing.T) {
+	ctx := context.Background()
+	srv := &testJwksServer{}
+	priv, pub := testSigningKey(t, "key-1")
+	srv.setKeys(pub)
+	ks, _ := testKeySet(t, srv)
+	ks.discoveryInfo.Issuer = "https://alice.com"
+	require.NoError(t, ks.refresh(ctx))
+
+	am := AllocAuthMethod()
+	am.Issuer = "https://alice.com"
+	am.ClientId = "alice-rp"
+	am.SigningAlgs = []string{string(oidc.ES256)}
+
+	const nonce = "test-nonce"
+	req, err := oidc.NewRequest(AttemptExpiration, "https://alice.com/callback", oidc.WithNonce(nonce))
+	require.NoError(t, err)
+
+	validClaims := func() map[string]any {
+		now := ks.now()
+		return map[string]any{
+			"iss":   "https://alice.com",
+			"sub":   "alice",
+			"aud":   []string{"alice-rp"},
+			"nonce": nonce,
+			"iat":   now.Unix(),
+			"exp":   now.Add(time.Minute).Unix(),
+		}
+	}
+	tests := []struct {
+		name    string
+		claims  func() map[string]any
+		keyId   string
+		wantErr bool
+	}{
+		{
+			name:   "valid",
+			claims: validClaims,
+			keyId:  "key-1",
+		},
+		{
+			name: "wrong-nonce",
+			claims: func() map[string]any {
+				c := validClaims()
+				c["nonce"] = "bad-nonce"
+				return c
+			},
+			keyId:   "key-1",
+			wantErr: true,
+		},
+		{
+			name: "wrong-issuer",
+			claims: func() map[string]any {
+				c := validClaims()
+				c["iss"] = "https://eve.com"
+				return c
+			},
+			keyId:   "key-1",
+			wantErr: true,
+		},
+		{
+			name: "wrong-audience",
+			claims: func() map[string]any {
+				c := validClaims()
+				c["aud"] = []string{"eve-rp"}
+				return c
+			},
+			keyId:   "key-1",
+			wantErr: true,
+		},
+		{
+			name: "expired",
+			claims: func() map[string]any {
+				c := validClaims()
+				c["exp"] = ks.now().Add(-time.Minute).Unix()
+				return c
+			},
+			keyId:   "key-1",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			tk := oidc.IDToken(testSignedJwt(t, priv, tt.keyId, tt.claims()))
+			claims, err := verifyIdToken(ctx, &am, ks, tk, req)
+			if tt.wantErr {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal("alice", claims["sub"])
+		})
+	}
+	t.Run("unknown-signing-key", func(t *testing.T) {
+		otherPriv, _ := testSigningKey(t, "key-1")
+		tk := oidc.IDToken(testSignedJwt(t, otherPriv, "key-1", validClaims()))
+		_, err := verifyIdToken(ctx, &am, ks, tk, req)
+		require.Error(t, err)
+	})
+}
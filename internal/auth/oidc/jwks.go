@@ -0,0 +1,344 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/cap/oidc"
+	"gopkg.in/square/go-jose.v2"
+)
+
+const (
+	// DefaultJwksRefreshInterval is how often a cached JWKS is refreshed in
+	// the background.
+	DefaultJwksRefreshInterval = 5 * time.Minute
+
+	// DefaultJwksGracePeriod is how long a key that's been removed from an
+	// IdP's JWKS is still accepted for verifying ID tokens.  This allows
+	// tokens signed just before a key rollover to be verified.
+	DefaultJwksGracePeriod = 1 * time.Hour
+
+	// minJwksOnDemandRefresh limits how often a token signed by an unknown key
+	// can force a refresh of the JWKS.
+	minJwksOnDemandRefresh = 10 * time.Second
+)
+
+var (
+	// cachedKeySets provides a cache of keySets.  Like cachedProviders, this
+	// cache can't be done within the Repository, since a new Repository is
+	// created for every request.
+	cachedKeySets     *keySets
+	initCachedKeySets sync.Once
+)
+
+// keySetCache returns the cache of key sets
+func keySetCache() *keySets {
+	initCachedKeySets.Do(func() {
+		cachedKeySets = newKeySetCache()
+	})
+	return cachedKeySets
+}
+
+// keySets is a cache of keySet types used to verify the signatures of ID
+// tokens.
+type keySets struct {
+	cache map[string]*keySet
+	mu    *sync.RWMutex
+}
+
+// newKeySetCache make a new cache
+func newKeySetCache() *keySets {
+	return &keySets{
+		cache: map[string]*keySet{},
+		mu:    &sync.RWMutex{},
+	}
+}
+
+// get returns the cached keySet for the auth method.  A new keySet is created
+// (and its background refresh started) when one isn't cached or the cached
+// keySet was created for a different provider configuration.
+func (c *keySets) get(ctx context.Context, authMethodId string, p *oidc.Provider) (*keySet, error) {
+	const op = "oidc.(keySets).get"
+	switch {
+	case authMethodId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	case p == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing provider")
+	}
+	configHash, err := p.ConfigHash()
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to get provider config hash", errors.WithWrap(err))
+	}
+	c.mu.RLock()
+	ks, ok := c.cache[authMethodId]
+	c.mu.RUnlock()
+	if ok && ks.configHash == configHash {
+		return ks, nil
+	}
+
+	info, err := p.DiscoveryInfo(ctx)
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to get provider discovery info", errors.WithWrap(err))
+	}
+	if info.JWKSURL == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "provider discovery info is missing a jwks url")
+	}
+	client, err := p.HTTPClient()
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to get provider http client", errors.WithWrap(err))
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ks, ok := c.cache[authMethodId]; ok {
+		if ks.configHash == configHash {
+			return ks, nil
+		}
+		ks.stop()
+		delete(c.cache, authMethodId)
+	}
+	ks = newKeySet(info, client, configHash)
+	ks.start()
+	c.cache[authMethodId] = ks
+	return ks, nil
+}
+
+// delete will delete an entry in the cache and stop its background refresh.
+func (c *keySets) delete(ctx context.Context, authMethodId string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ks, ok := c.cache[authMethodId]; ok {
+		ks.stop()
+		delete(c.cache, authMethodId)
+	}
+}
+
+// retiredKey is a key which was removed from the IdP's JWKS.
+type retiredKey struct {
+	key       jose.JSONWebKey
+	retiredAt time.Time
+}
+
+// keySet is a cached JWKS for an IdP which implements the go-oidc KeySet
+// interface.  The JWKS is refreshed in the background and keys removed from
+// the JWKS are retained for a grace period, so ID tokens can still be verified
+// when the IdP is briefly unavailable or has just rotated its signing keys.
+// The IdP's discovery info is cached along with the JWKS.
+type keySet struct {
+	discoveryInfo *oidc.DiscoveryInfo
+	jwksUrl       string
+	client        *http.Client
+	configHash    uint64
+
+	refreshInterval time.Duration
+	gracePeriod     time.Duration
+	now             func() time.Time
+
+	mu          sync.RWMutex
+	current     []jose.JSONWebKey
+	retired     []retiredKey
+	lastRefresh time.Time
+
+	// refreshMu ensures only one refresh of the JWKS is in flight.
+	refreshMu sync.Mutex
+	cancel    context.CancelFunc
+}
+
+func newKeySet(info *oidc.DiscoveryInfo, client *http.Client, configHash uint64) *keySet {
+	return &keySet{
+		discoveryInfo:   info,
+		jwksUrl:         info.JWKSURL,
+		client:          client,
+		configHash:      configHash,
+		refreshInterval: DefaultJwksRefreshInterval,
+		gracePeriod:     DefaultJwksGracePeriod,
+		now:             time.Now,
+	}
+}
+
+// start begins refreshing the JWKS in the background.
+func (k *keySet) start() {
+	const op = "oidc.(keySet).start"
+	ctx, cancel := context.WithCancel(context.Background())
+	k.cancel = cancel
+	go func() {
+		timer := time.NewTimer(0)
+		defer timer.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-timer.C:
+				// cached keys continue to be used until the next refresh
+				// succeeds.
+				if err := k.refresh(ctx); err != nil && ctx.Err() == nil {
+					event.WriteError(ctx, op, err, event.WithInfoMsg("unable to refresh jwks", "jwks_url", k.jwksUrl))
+				}
+				timer.Reset(k.refreshInterval)
+			}
+		}
+	}()
+}
+
+// stop ends the background refresh of the JWKS.
+func (k *keySet) stop() {
+	if k.cancel != nil {
+		k.cancel()
+	}
+}
+
+// VerifySignature parses the JWT, verifies its signature with the cached keys
+// and returns the raw payload.  When no cached key verifies the signature, the
+// JWKS is refreshed (at most once every minJwksOnDemandRefresh) and the
+// verification is retried.  Claims are not validated.
+func (k *keySet) VerifySignature(ctx context.Context, jwt string) ([]byte, error) {
+	const op = "oidc.(keySet).VerifySignature"
+	jws, err := jose.ParseSigned(jwt)
+	if err != nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "malformed jwt", errors.WithWrap(err))
+	}
+	if len(jws.Signatures) != 1 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("jwt must have exactly one signature and has %d", len(jws.Signatures)))
+	}
+	keyId := jws.Signatures[0].Header.KeyID
+
+	if payload, ok := k.verify(jws, keyId); ok {
+		return payload, nil
+	}
+
+	k.mu.RLock()
+	lastRefresh := k.lastRefresh
+	k.mu.RUnlock()
+	if k.now().Sub(lastRefresh) >= minJwksOnDemandRefresh {
+		if err := k.refresh(ctx); err != nil {
+			jwksLookupsTotal.WithLabelValues(jwksLookupMiss).Inc()
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("no cached key verifies the jwt"))
+		}
+		if payload, ok := k.verify(jws, keyId); ok {
+			return payload, nil
+		}
+	}
+	jwksLookupsTotal.WithLabelValues(jwksLookupMiss).Inc()
+	return nil, errors.New(ctx, errors.InvalidParameter, op, "failed to verify jwt signature")
+}
+
+// verify attempts to verify the jws with the current keys and then with keys
+// retired within the grace period.
+func (k *keySet) verify(jws *jose.JSONWebSignature, keyId string) ([]byte, bool) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	for _, key := range k.current {
+		if keyId != "" && key.KeyID != keyId {
+			continue
+		}
+		if payload, err := jws.Verify(&key); err == nil {
+			jwksLookupsTotal.WithLabelValues(jwksLookupHit).Inc()
+			return payload, true
+		}
+	}
+	now := k.now()
+	for _, rk := range k.retired {
+		if keyId != "" && rk.key.KeyID != keyId {
+			continue
+		}
+		if now.Sub(rk.retiredAt) > k.gracePeriod {
+			continue
+		}
+		if payload, err := jws.Verify(&rk.key); err == nil {
+			jwksLookupsTotal.WithLabelValues(jwksLookupGraceHit).Inc()
+			return payload, true
+		}
+	}
+	return nil, false
+}
+
+// refresh fetches the JWKS from the IdP and replaces the current keys.  Keys
+// no longer in the JWKS are retired and kept until the grace period expires.
+// When the fetch fails, the cached keys are left untouched.
+func (k *keySet) refresh(ctx context.Context) error {
+	const op = "oidc.(keySet).refresh"
+	k.refreshMu.Lock()
+	defer k.refreshMu.Unlock()
+
+	keys, err := k.fetch(ctx)
+	if err != nil {
+		jwksRefreshesTotal.WithLabelValues(jwksRefreshFailure).Inc()
+		return errors.Wrap(ctx, err, op)
+	}
+	jwksRefreshesTotal.WithLabelValues(jwksRefreshSuccess).Inc()
+
+	now := k.now()
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	retired := make([]retiredKey, 0, len(k.retired)+len(k.current))
+	for _, rk := range k.retired {
+		if now.Sub(rk.retiredAt) <= k.gracePeriod && !containsKey(keys, rk.key) {
+			retired = append(retired, rk)
+		}
+	}
+	for _, key := range k.current {
+		if !containsKey(keys, key) {
+			retired = append(retired, retiredKey{key: key, retiredAt: now})
+		}
+	}
+	k.current = keys
+	k.retired = retired
+	k.lastRefresh = now
+	return nil
+}
+
+// fetch retrieves the JWKS from the IdP.
+func (k *keySet) fetch(ctx context.Context) ([]jose.JSONWebKey, error) {
+	const op = "oidc.(keySet).fetch"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, k.jwksUrl, nil)
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to create jwks request", errors.WithWrap(err))
+	}
+	resp, err := k.client.Do(req)
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to fetch jwks", errors.WithWrap(err))
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to read jwks response", errors.WithWrap(err))
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.New(ctx, errors.Unknown, op, fmt.Sprintf("unexpected jwks response status %s: %s", resp.Status, body))
+	}
+	var jwks jose.JSONWebKeySet
+	if err := json.Unmarshal(body, &jwks); err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to decode jwks", errors.WithWrap(err))
+	}
+	return jwks.Keys, nil
+}
+
+// containsKey determines if the key is in keys by comparing thumbprints.
+func containsKey(keys []jose.JSONWebKey, key jose.JSONWebKey) bool {
+	want, err := key.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return false
+	}
+	for _, k := range keys {
+		got, err := k.Thumbprint(crypto.SHA256)
+		if err != nil {
+			continue
+		}
+		if k.KeyID == key.KeyID && bytes.Equal(got, want) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/cap/oidc"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+// testJwksServer serves a JWKS which can be changed or made unavailable
+// during a test.
+type testJwksServer struct {
+	mu          sync.Mutex
+	keys        []jose.JSONWebKey
+	unavailable bool
+	requests    int
+}
+
+func (s *testJwksServer) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests++
+	if s.unavailable {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: s.keys})
+}
+
+func (s *testJwksServer) setKeys(keys ...jose.JSONWebKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = keys
+}
+
+func (s *testJwksServer) setUnavailable(u bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.unavailable = u
+}
+
+func testSigningKey(t *testing.T, keyId string) (*ecdsa.PrivateKey, jose.JSONWebKey) {
+	t.Helper()
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return priv, jose.JSONWebKey{Key: &priv.PublicKey, KeyID: keyId, Algorithm: string(oidc.ES256), Use: "sig"}
+}
+
+func testSignedJwt(t *testing.T, priv *ecdsa.PrivateKey, keyId string, claims any) string {
+	t.Helper()
+	sig, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.ES256, Key: jose.JSONWebKey{Key: priv, KeyID: keyId}},
+		(&jose.SignerOptions{}).WithType("JWT"),
+	)
+	require.NoError(t, err)
+	raw, err := jwt.Signed(sig).Claims(claims).CompactSerialize()
+	require.NoError(t, err)
+	return raw
+}
+
+func testKeySet(t *testing.T, srv *testJwksServer) (*keySet, func(d time.Duration)) {
+	t.Helper()
+	ts := httptest.NewServer(srv)
+	t.Cleanup(ts.Close)
+	ks := newKeySet(&oidc.DiscoveryInfo{JWKSURL: ts.URL}, ts.Client(), 0)
+	var mu sync.Mutex
+	now := time.Now()
+	ks.now = func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return now
+	}
+	advance := func(d time.Duration) {
+		mu.Lock()
+		defer mu.Unlock()
+		now = now.Add(d)
+	}
+	return ks, advance
+}
+
+func Test_keySetVerifySignature(t *testing.T) {
+	ctx := context.Background()
+	claims := map[string]any{"sub": "alice"}
+
+	t.Run("cached-key", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		srv := &testJwksServer{}
+		priv, pub := testSigningKey(t, "key-1")
+		srv.setKeys(pub)
+		ks, _ := testKeySet(t, srv)
+		require.NoError(ks.refresh(ctx))
+
+		hits := testutil.ToFloat64(jwksLookupsTotal.WithLabelValues(jwksLookupHit))
+		payload, err := ks.VerifySignature(ctx, testSignedJwt(t, priv, "key-1", claims))
+		require.NoError(err)
+		assert.Contains(string(payload), "alice")
+		assert.Equal(hits+1, testutil.ToFloat64(jwksLookupsTotal.WithLabelValues(jwksLookupHit)))
+
+		// the idp being unavailable doesn't matter for cached keys
+		srv.setUnavailable(true)
+		_, err = ks.VerifySignature(ctx, testSignedJwt(t, priv, "key-1", claims))
+		require.NoError(err)
+		assert.Equal(1, srv.requests)
+	})
+	t.Run("unknown-key-refreshes", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		srv := &testJwksServer{}
+		_, pub1 := testSigningKey(t, "key-1")
+		priv2, pub2 := testSigningKey(t, "key-2")
+		srv.setKeys(pub1)
+		ks, advance := testKeySet(t, srv)
+		require.NoError(ks.refresh(ctx))
+
+		// refreshes are rate limited
+		srv.setKeys(pub1, pub2)
+		_, err := ks.VerifySignature(ctx, testSignedJwt(t, priv2, "key-2", claims))
+		require.Error(err)
+		assert.Equal(1, srv.requests)
+
+		advance(minJwksOnDemandRefresh)
+		_, err = ks.VerifySignature(ctx, testSignedJwt(t, priv2, "key-2", claims))
+		require.NoError(err)
+		assert.Equal(2, srv.requests)
+	})
+	t.Run("rotated-key-within-grace-period", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		srv := &testJwksServer{}
+		priv1, pub1 := testSigningKey(t, "key-1")
+		_, pub2 := testSigningKey(t, "key-2")
+		srv.setKeys(pub1)
+		ks, advance := testKeySet(t, srv)
+		require.NoError(ks.refresh(ctx))
+
+		srv.setKeys(pub2)
+		require.NoError(ks.refresh(ctx))
+
+		graceHits := testutil.ToFloat64(jwksLookupsTotal.WithLabelValues(jwksLookupGraceHit))
+		_, err := ks.VerifySignature(ctx, testSignedJwt(t, priv1, "key-1", claims))
+		require.NoError(err)
+		assert.Equal(graceHits+1, testutil.ToFloat64(jwksLookupsTotal.WithLabelValues(jwksLookupGraceHit)))
+
+		advance(ks.gracePeriod + time.Second)
+		misses := testutil.ToFloat64(jwksLookupsTotal.WithLabelValues(jwksLookupMiss))
+		_, err = ks.VerifySignature(ctx, testSignedJwt(t, priv1, "key-1", claims))
+		require.Error(err)
+		assert.Equal(misses+1, testutil.ToFloat64(jwksLookupsTotal.WithLabelValues(jwksLookupMiss)))
+
+		// expired retired keys are pruned on refresh
+		require.NoError(ks.refresh(ctx))
+		assert.Empty(ks.retired)
+	})
+	t.Run("failed-refresh-keeps-keys", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		srv := &testJwksServer{}
+		priv, pub := testSigningKey(t, "key-1")
+		srv.setKeys(pub)
+		ks, advance := testKeySet(t, srv)
+		require.NoError(ks.refresh(ctx))
+
+		srv.setUnavailable(true)
+		failures := testutil.ToFloat64(jwksRefreshesTotal.WithLabelValues(jwksRefreshFailure))
+		require.Error(ks.refresh(ctx))
+		assert.Equal(failures+1, testutil.ToFloat64(jwksRefreshesTotal.WithLabelValues(jwksRefreshFailure)))
+
+		advance(ks.gracePeriod + time.Second)
+		_, err := ks.VerifySignature(ctx, testSignedJwt(t, priv, "key-1", claims))
+		require.NoError(err)
+	})
+	t.Run("malformed-jwt", func(t *testing.T) {
+		srv := &testJwksServer{}
+		ks, _ := testKeySet(t, srv)
+		_, err := ks.VerifySignature(ctx, "not-a-jwt")
+		require.Error(t, err)
+	})
+}
+
+func Test_keySetCache(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	tp := oidc.StartTestProvider(t)
+	authMethodId, err := newAuthMethodId(ctx)
+	require.NoError(err)
+	p := testProvider(t, authMethodId, authMethodId, fmt.Sprintf(CallbackEndpoint, "https://alice.com"), tp)
+
+	c := newKeySetCache()
+	ks1, err := c.get(ctx, authMethodId, p)
+	require.NoError(err)
+	assert.NotEmpty(ks1.jwksUrl)
+	ks2, err := c.get(ctx, authMethodId, p)
+	require.NoError(err)
+	assert.Same(ks1, ks2)
+
+	c.delete(ctx, authMethodId)
+	ks3, err := c.get(ctx, authMethodId, p)
+	require.NoError(err)
+	assert.NotSame(ks1, ks3)
+	c.delete(ctx, authMethodId)
+
+	_, err = c.get(ctx, "", p)
+	require.Error(err)
+	_, err = c.get(ctx, authMethodId, nil)
+	require.Error(err)
+}
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/auth/oidc/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/square/go-jose.v2"
+)
+
+// defaultJwtValidationPubKeyTableName defines the default table name for a
+// JwtValidationPubKey
+const defaultJwtValidationPubKeyTableName = "auth_oidc_jwt_validation_pub_key"
+
+// JwtValidationPubKey defines a PEM encoded public key used to verify JWTs
+// presented to the auth method's jwt login command. It is assigned to an OIDC
+// AuthMethod and updates/deletes to that AuthMethod are cascaded to its
+// JwtValidationPubKeys. JwtValidationPubKeys are value objects of an
+// AuthMethod, therefore there's no need for oplog metadata, since only the
+// AuthMethod will have metadata because it's the root aggregate.
+type JwtValidationPubKey struct {
+	*store.JwtValidationPubKey
+	tableName string
+}
+
+// NewJwtValidationPubKey creates a new in memory public key assigned to an
+// OIDC auth method.
+func NewJwtValidationPubKey(ctx context.Context, authMethodId string, publicKeyPem string) (*JwtValidationPubKey, error) {
+	const op = "oidc.NewJwtValidationPubKey"
+	k := &JwtValidationPubKey{
+		JwtValidationPubKey: &store.JwtValidationPubKey{
+			OidcMethodId: authMethodId,
+			PublicKey:    publicKeyPem,
+		},
+	}
+	if err := k.validate(ctx, op); err != nil {
+		return nil, err // intentionally not wrapped
+	}
+	return k, nil
+}
+
+// validate the JwtValidationPubKey and on success return nil
+func (k *JwtValidationPubKey) validate(ctx context.Context, caller errors.Op) error {
+	if k.OidcMethodId == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing oidc auth method id")
+	}
+	if k.PublicKey == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "empty public key")
+	}
+	if _, err := ParseJwtValidationPubKey(ctx, k.PublicKey); err != nil {
+		return errors.Wrap(ctx, err, caller)
+	}
+	return nil
+}
+
+// ParseJwtValidationPubKey parses a PEM encoded PKIX public key.
+func ParseJwtValidationPubKey(ctx context.Context, publicKeyPem string) (crypto.PublicKey, error) {
+	const op = "oidc.ParseJwtValidationPubKey"
+	block, _ := pem.Decode([]byte(publicKeyPem))
+	if block == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "failed to parse public key PEM")
+	}
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "failed to parse public key", errors.WithWrap(err))
+	}
+	return pub, nil
+}
+
+// AllocJwtValidationPubKey makes an empty one in memory
+func AllocJwtValidationPubKey() JwtValidationPubKey {
+	return JwtValidationPubKey{
+		JwtValidationPubKey: &store.JwtValidationPubKey{},
+	}
+}
+
+// Clone a JwtValidationPubKey
+func (k *JwtValidationPubKey) Clone() *JwtValidationPubKey {
+	cp := proto.Clone(k.JwtValidationPubKey)
+	return &JwtValidationPubKey{
+		JwtValidationPubKey: cp.(*store.JwtValidationPubKey),
+	}
+}
+
+// TableName returns the table name.
+func (k *JwtValidationPubKey) TableName() string {
+	if k.tableName != "" {
+		return k.tableName
+	}
+	return defaultJwtValidationPubKeyTableName
+}
+
+// SetTableName sets the table name.
+func (k *JwtValidationPubKey) SetTableName(n string) {
+	k.tableName = n
+}
+
+// staticKeySet verifies JWT signatures with an auth method's
+// JwtValidationPubKeys, so the provider's JWKS is never fetched.
+type staticKeySet struct {
+	keys []crypto.PublicKey
+}
+
+// newStaticKeySet parses the PEM encoded public keys into a staticKeySet.
+func newStaticKeySet(ctx context.Context, publicKeyPems ...string) (*staticKeySet, error) {
+	const op = "oidc.newStaticKeySet"
+	if len(publicKeyPems) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing public keys")
+	}
+	ks := &staticKeySet{keys: make([]crypto.PublicKey, 0, len(publicKeyPems))}
+	for _, p := range publicKeyPems {
+		pub, err := ParseJwtValidationPubKey(ctx, p)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		ks.keys = append(ks.keys, pub)
+	}
+	return ks, nil
+}
+
+// VerifySignature parses the JWT, verifies its signature with one of the
+// static keys and returns the raw payload.  Claims are not validated.
+func (ks *staticKeySet) VerifySignature(ctx context.Context, jwt string) ([]byte, error) {
+	const op = "oidc.(staticKeySet).VerifySignature"
+	jws, err := jose.ParseSigned(jwt)
+	if err != nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "malformed jwt", errors.WithWrap(err))
+	}
+	if len(jws.Signatures) != 1 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("jwt must have exactly one signature and has %d", len(jws.Signatures)))
+	}
+	for _, k := range ks.keys {
+		if payload, err := jws.Verify(k); err == nil {
+			return payload, nil
+		}
+	}
+	return nil, errors.New(ctx, errors.InvalidParameter, op, "failed to verify jwt signature")
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testPubKeyPem(t *testing.T, pub *ecdsa.PublicKey) string {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func TestNewJwtValidationPubKey(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	priv, _ := testSigningKey(t, "key-1")
+	validPem := testPubKeyPem(t, &priv.PublicKey)
+
+	tests := []struct {
+		name         string
+		authMethodId string
+		pem          string
+		wantErr      bool
+	}{
+		{
+			name:         "valid",
+			authMethodId: "amoidc_1234567890",
+			pem:          validPem,
+		},
+		{
+			name:    "missing-auth-method-id",
+			pem:     validPem,
+			wantErr: true,
+		},
+		{
+			name:         "missing-pem",
+			authMethodId: "amoidc_1234567890",
+			wantErr:      true,
+		},
+		{
+			name:         "invalid-pem",
+			authMethodId: "amoidc_1234567890",
+			pem:          "not-a-pem",
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewJwtValidationPubKey(ctx, tt.authMethodId, tt.pem)
+			if tt.wantErr {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.authMethodId, got.OidcMethodId)
+			assert.Equal(tt.pem, got.PublicKey)
+			assert.Equal(defaultJwtValidationPubKeyTableName, got.TableName())
+			cp := got.Clone()
+			assert.Equal(got.JwtValidationPubKey, cp.JwtValidationPubKey)
+		})
+	}
+}
+
+func Test_staticKeySetVerifySignature(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	claims := map[string]any{"sub": "alice"}
+	priv1, _ := testSigningKey(t, "key-1")
+	priv2, _ := testSigningKey(t, "key-2")
+	otherPriv, _ := testSigningKey(t, "key-3")
+
+	_, err := newStaticKeySet(ctx)
+	require.Error(t, err)
+	_, err = newStaticKeySet(ctx, "not-a-pem")
+	require.Error(t, err)
+
+	ks, err := newStaticKeySet(ctx, testPubKeyPem(t, &priv1.PublicKey), testPubKeyPem(t, &priv2.PublicKey))
+	require.NoError(t, err)
+
+	for _, priv := range []*ecdsa.PrivateKey{priv1, priv2} {
+		payload, err := ks.VerifySignature(ctx, testSignedJwt(t, priv, "", claims))
+		require.NoError(t, err)
+		assert.Contains(t, string(payload), "alice")
+	}
+	_, err = ks.VerifySignature(ctx, testSignedJwt(t, otherPriv, "", claims))
+	require.Error(t, err)
+	_, err = ks.VerifySignature(ctx, "not-a-jwt")
+	require.Error(t, err)
+}
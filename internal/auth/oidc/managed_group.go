@@ -6,6 +6,7 @@ package oidc
 import (
 	"context"
 
+	"github.com/hashicorp/boundary/internal/auth"
 	"github.com/hashicorp/boundary/internal/auth/oidc/store"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/oplog"
@@ -24,7 +25,8 @@ type ManagedGroup struct {
 }
 
 // NewManagedGroup creates a new in memory ManagedGroup assigned to OIDC
-// AuthMethod. Supported options are withName and withDescription.
+// AuthMethod. Supported options are withName, withDescription,
+// WithUnionGroupIds, WithIntersectionGroupIds and WithDifferenceGroupIds.
 func NewManagedGroup(ctx context.Context, authMethodId string, filter string, opt ...Option) (*ManagedGroup, error) {
 	const op = "oidc.NewManagedGroup"
 	opts := getOpts(opt...)
@@ -36,6 +38,16 @@ func NewManagedGroup(ctx context.Context, authMethodId string, filter string, op
 			Filter:       filter,
 		},
 	}
+	var err error
+	if mg.UnionGroupIds, err = auth.MarshalSetOperandIds(ctx, opts.withUnionGroupIds); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if mg.IntersectionGroupIds, err = auth.MarshalSetOperandIds(ctx, opts.withIntersectionGroupIds); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if mg.DifferenceGroupIds, err = auth.MarshalSetOperandIds(ctx, opts.withDifferenceGroupIds); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
 	if err := mg.validate(ctx, op); err != nil {
 		return nil, err // intentionally not wrapped.
 	}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"github.com/hashicorp/boundary/globals"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	jwksSubsystem = "controller_oidc_jwks"
+
+	labelJwksResult = "result"
+
+	jwksLookupHit      = "hit"
+	jwksLookupGraceHit = "grace_hit"
+	jwksLookupMiss     = "miss"
+
+	jwksRefreshSuccess = "success"
+	jwksRefreshFailure = "failure"
+)
+
+// jwksLookupsTotal keeps a count of ID token signature verifications against
+// the cached JWKS, labeled by whether a current key (hit), a key retired
+// within the grace period (grace_hit) or no key (miss) verified the token.
+var jwksLookupsTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: globals.MetricNamespace,
+		Subsystem: jwksSubsystem,
+		Name:      "lookups_total",
+		Help:      "Count of ID token signature verifications against the cached JWKS.",
+	},
+	[]string{labelJwksResult},
+)
+
+// jwksRefreshesTotal keeps a count of attempts to refresh a cached JWKS from
+// an IdP, labeled by whether the refresh succeeded.
+var jwksRefreshesTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: globals.MetricNamespace,
+		Subsystem: jwksSubsystem,
+		Name:      "refreshes_total",
+		Help:      "Count of attempts to refresh a cached JWKS from an IdP.",
+	},
+	[]string{labelJwksResult},
+)
+
+// InitializeJwksCollectors registers the JWKS cache metrics to the provided
+// prometheus register and initializes them to 0 for all possible label
+// combinations.
+func InitializeJwksCollectors(r prometheus.Registerer) {
+	if r == nil {
+		return
+	}
+	r.MustRegister(jwksLookupsTotal, jwksRefreshesTotal)
+	for _, l := range []string{jwksLookupHit, jwksLookupGraceHit, jwksLookupMiss} {
+		jwksLookupsTotal.WithLabelValues(l)
+	}
+	for _, l := range []string{jwksRefreshSuccess, jwksRefreshFailure} {
+		jwksRefreshesTotal.WithLabelValues(l)
+	}
+}
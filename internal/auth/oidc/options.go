@@ -24,30 +24,37 @@ type Option func(*options)
 
 // options = how options are represented
 type options struct {
-	withName                string
-	withDescription         string
-	withLimit               int
-	withMaxAge              int
-	withApiUrl              *url.URL
-	withCertificates        []*x509.Certificate
-	withAudClaims           []string
-	withSigningAlgs         []Alg
-	withClaimsScopes        []string
-	withEmail               string
-	withFullName            string
-	withOrderByCreateTime   bool
-	ascending               bool
-	withUnauthenticatedUser bool
-	withForce               bool
-	withDryRun              bool
-	withAuthMethod          *AuthMethod
-	withPublicId            string
-	withRoundtripPayload    string
-	withKeyId               string
-	withIssuer              *url.URL
-	withOperationalState    AuthMethodState
-	withAccountClaimMap     map[string]AccountToClaim
-	withReader              db.Reader
+	withName                 string
+	withDescription          string
+	withLimit                int
+	withMaxAge               int
+	withApiUrl               *url.URL
+	withCertificates         []*x509.Certificate
+	withAudClaims            []string
+	withSigningAlgs          []Alg
+	withClaimsScopes         []string
+	withEmail                string
+	withFullName             string
+	withOrderByCreateTime    bool
+	ascending                bool
+	withUnauthenticatedUser  bool
+	withForce                bool
+	withDryRun               bool
+	withAuthMethod           *AuthMethod
+	withPublicId             string
+	withRoundtripPayload     string
+	withKeyId                string
+	withIssuer               *url.URL
+	withOperationalState     AuthMethodState
+	withAccountClaimMap      map[string]AccountToClaim
+	withReader               db.Reader
+	withJwtValidationPubKeys []string
+	withBoundClaims          []string
+	withUnionGroupIds        []string
+	withIntersectionGroupIds []string
+	withDifferenceGroupIds   []string
+	withDeletionProtected    bool
+	withJwtLoginEnabled      bool
 }
 
 func getDefaultOptions() options {
@@ -232,3 +239,59 @@ func WithReader(reader db.Reader) Option {
 		o.withReader = reader
 	}
 }
+
+// WithJwtValidationPubKeys provides optional PEM encoded public keys used to
+// verify JWTs presented to the auth method.
+func WithJwtValidationPubKeys(keys ...string) Option {
+	return func(o *options) {
+		o.withJwtValidationPubKeys = keys
+	}
+}
+
+// WithBoundClaims provides optional bound claims, represented as claim=value,
+// which a token must match to authenticate with the auth method.
+func WithBoundClaims(claims ...string) Option {
+	return func(o *options) {
+		o.withBoundClaims = claims
+	}
+}
+
+// WithJwtLoginEnabled provides an option to allow workloads to authenticate
+// with the auth method using a JWT issued by its issuer.
+func WithJwtLoginEnabled(enabled bool) Option {
+	return func(o *options) {
+		o.withJwtLoginEnabled = enabled
+	}
+}
+
+// WithDeletionProtected provides an option to protect the auth method from
+// being deleted.
+func WithDeletionProtected(protected bool) Option {
+	return func(o *options) {
+		o.withDeletionProtected = protected
+	}
+}
+
+// WithUnionGroupIds provides optional managed group and static group ids
+// whose members are added to a managed group.
+func WithUnionGroupIds(ids ...string) Option {
+	return func(o *options) {
+		o.withUnionGroupIds = ids
+	}
+}
+
+// WithIntersectionGroupIds provides optional managed group and static group
+// ids which the members of a managed group must also be members of.
+func WithIntersectionGroupIds(ids ...string) Option {
+	return func(o *options) {
+		o.withIntersectionGroupIds = ids
+	}
+}
+
+// WithDifferenceGroupIds provides optional managed group and static group ids
+// whose members are removed from a managed group.
+func WithDifferenceGroupIds(ids ...string) Option {
+	return func(o *options) {
+		o.withDifferenceGroupIds = ids
+	}
+}
@@ -206,4 +206,27 @@ func Test_GetOpts(t *testing.T) {
 		opts := getOpts(WithReader(r))
 		assert.Equal(r, opts.withReader)
 	})
+	t.Run("WithJwtLoginEnabled", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithJwtLoginEnabled(true))
+		testOpts := getDefaultOptions()
+		testOpts.withJwtLoginEnabled = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithDeletionProtected", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithDeletionProtected(true))
+		testOpts := getDefaultOptions()
+		testOpts.withDeletionProtected = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithSetOperandIds", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithUnionGroupIds("g_1234567890"), WithIntersectionGroupIds("mgoidc_1234567890"), WithDifferenceGroupIds("g_0987654321"))
+		testOpts := getDefaultOptions()
+		testOpts.withUnionGroupIds = []string{"g_1234567890"}
+		testOpts.withIntersectionGroupIds = []string{"mgoidc_1234567890"}
+		testOpts.withDifferenceGroupIds = []string{"g_0987654321"}
+		assert.Equal(opts, testOpts)
+	})
 }
@@ -93,11 +93,13 @@ func (c *providers) set(ctx context.Context, authMethodId string, p *oidc.Provid
 	c.cache[authMethodId] = p
 }
 
-// delete will delete an entry in the cache.
+// delete will delete an entry in the cache, along with the auth method's
+// cached key set.
 func (c *providers) delete(ctx context.Context, authMethodId string) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	delete(c.cache, authMethodId)
+	keySetCache().delete(ctx, authMethodId)
 }
 
 func convertToProvider(ctx context.Context, am *AuthMethod) (*oidc.Provider, error) {
@@ -123,6 +123,20 @@ func (r *Repository) CreateAuthMethod(ctx context.Context, am *AuthMethod, opt .
 				}
 				msgs = append(msgs, accountClaimMapsOplogMsgs...)
 			}
+			if len(vo.JwtValidationPubKeys) > 0 {
+				pubKeysOplogMsgs := make([]*oplog.Message, 0, len(vo.JwtValidationPubKeys))
+				if err := w.CreateItems(ctx, vo.JwtValidationPubKeys, db.NewOplogMsgs(&pubKeysOplogMsgs)); err != nil {
+					return err
+				}
+				msgs = append(msgs, pubKeysOplogMsgs...)
+			}
+			if len(vo.BoundClaims) > 0 {
+				boundClaimsOplogMsgs := make([]*oplog.Message, 0, len(vo.BoundClaims))
+				if err := w.CreateItems(ctx, vo.BoundClaims, db.NewOplogMsgs(&boundClaimsOplogMsgs)); err != nil {
+					return err
+				}
+				msgs = append(msgs, boundClaimsOplogMsgs...)
+			}
 			metadata := am.oplog(oplog.OpType_OP_TYPE_CREATE)
 			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, metadata, msgs); err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
@@ -152,6 +152,8 @@ func (r *Repository) getAuthMethods(ctx context.Context, authMethodId string, sc
 		am.KeyId = agg.KeyId
 		am.MaxAge = int32(agg.MaxAge)
 		am.ApiUrl = agg.ApiUrl
+		am.DeletionProtected = agg.DeletionProtected
+		am.JwtLoginEnabled = agg.JwtLoginEnabled
 		if agg.Algs != "" {
 			am.SigningAlgs = strings.Split(agg.Algs, aggregateDelimiter)
 		}
@@ -167,6 +169,12 @@ func (r *Repository) getAuthMethods(ctx context.Context, authMethodId string, sc
 		if agg.AccountClaimMaps != "" {
 			am.AccountClaimMaps = strings.Split(agg.AccountClaimMaps, aggregateDelimiter)
 		}
+		if agg.JwtValidationPubKeys != "" {
+			am.JwtValidationPubKeys = strings.Split(agg.JwtValidationPubKeys, aggregateDelimiter)
+		}
+		if agg.BoundClaims != "" {
+			am.BoundClaims = strings.Split(agg.BoundClaims, aggregateDelimiter)
+		}
 		authMethods = append(authMethods, &am)
 	}
 	return authMethods, nil
@@ -198,6 +206,10 @@ type authMethodAgg struct {
 	Certs                             string
 	ClaimsScopes                      string
 	AccountClaimMaps                  string
+	JwtValidationPubKeys              string
+	BoundClaims                       string
+	DeletionProtected                 bool
+	JwtLoginEnabled                   bool
 }
 
 // TableName returns the table name for gorm
@@ -25,6 +25,9 @@ const (
 	NameField                              = "Name"
 	DescriptionField                       = "Description"
 	FilterField                            = "Filter"
+	UnionGroupIdsField                     = "UnionGroupIds"
+	IntersectionGroupIdsField              = "IntersectionGroupIds"
+	DifferenceGroupIdsField                = "DifferenceGroupIds"
 	IssuerField                            = "Issuer"
 	ClientIdField                          = "ClientId"
 	ClientSecretField                      = "ClientSecret"
@@ -37,6 +40,10 @@ const (
 	CertificatesField                      = "Certificates"
 	ClaimsScopesField                      = "ClaimsScopes"
 	AccountClaimMapsField                  = "AccountClaimMaps"
+	JwtValidationPubKeysField              = "JwtValidationPubKeys"
+	BoundClaimsField                       = "BoundClaims"
+	DeletionProtectedField                 = "DeletionProtected"
+	JwtLoginEnabledField                   = "JwtLoginEnabled"
 	TokenClaimsField                       = "TokenClaims"
 	UserinfoClaimsField                    = "UserinfoClaims"
 	KeyIdField                             = "KeyId"
@@ -58,10 +65,11 @@ const (
 // fieldMaskPaths provides field_mask.proto paths for fields that should
 // be updated.  Fields will be set to NULL if the field is a
 // zero value and included in fieldMask. Name, Description, Issuer,
-// ClientId, ClientSecret, MaxAge are all updatable fields.  The AuthMethod's
-// Value Objects of SigningAlgs, CallbackUrls, AudClaims and Certificates are
-// also updatable. if no updatable fields are included in the fieldMaskPaths,
-// then an error is returned.
+// ClientId, ClientSecret, MaxAge, DeletionProtected and JwtLoginEnabled are
+// all updatable fields.  The AuthMethod's Value Objects of SigningAlgs, CallbackUrls,
+// AudClaims, Certificates, JwtValidationPubKeys and BoundClaims are also
+// updatable. if no updatable fields are included in the fieldMaskPaths, then
+// an error is returned.
 //
 // Options supported:
 //
@@ -97,21 +105,25 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, am *AuthMethod, versi
 
 	dbMask, nullFields := dbw.BuildUpdatePaths(
 		map[string]any{
-			NameField:             am.Name,
-			DescriptionField:      am.Description,
-			IssuerField:           am.Issuer,
-			ClientIdField:         am.ClientId,
-			ClientSecretField:     am.ClientSecret,
-			MaxAgeField:           am.MaxAge,
-			SigningAlgsField:      am.SigningAlgs,
-			ApiUrlField:           am.ApiUrl,
-			AudClaimsField:        am.AudClaims,
-			CertificatesField:     am.Certificates,
-			ClaimsScopesField:     am.ClaimsScopes,
-			AccountClaimMapsField: am.AccountClaimMaps,
+			NameField:                 am.Name,
+			DescriptionField:          am.Description,
+			IssuerField:               am.Issuer,
+			ClientIdField:             am.ClientId,
+			ClientSecretField:         am.ClientSecret,
+			MaxAgeField:               am.MaxAge,
+			SigningAlgsField:          am.SigningAlgs,
+			ApiUrlField:               am.ApiUrl,
+			AudClaimsField:            am.AudClaims,
+			CertificatesField:         am.Certificates,
+			ClaimsScopesField:         am.ClaimsScopes,
+			AccountClaimMapsField:     am.AccountClaimMaps,
+			JwtValidationPubKeysField: am.JwtValidationPubKeys,
+			BoundClaimsField:          am.BoundClaims,
+			DeletionProtectedField:    am.DeletionProtected,
+			JwtLoginEnabledField:      am.JwtLoginEnabled,
 		},
 		fieldMaskPaths,
-		nil,
+		[]string{DeletionProtectedField, JwtLoginEnabledField},
 	)
 	if len(dbMask) == 0 && len(nullFields) == 0 {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
@@ -193,10 +205,20 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, am *AuthMethod, versi
 		}
 	}
 
+	addPubKeys, deletePubKeys, err := valueObjectChanges(ctx, origAm.PublicId, JwtValidationPubKeysVO, am.JwtValidationPubKeys, origAm.JwtValidationPubKeys, dbMask, nullFields)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+
+	addBoundClaims, deleteBoundClaims, err := valueObjectChanges(ctx, origAm.PublicId, BoundClaimsVO, am.BoundClaims, origAm.BoundClaims, dbMask, nullFields)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+
 	var filteredDbMask, filteredNullFields []string
 	for _, f := range dbMask {
 		switch f {
-		case SigningAlgsField, AudClaimsField, CertificatesField, ClaimsScopesField, AccountClaimMapsField:
+		case SigningAlgsField, AudClaimsField, CertificatesField, ClaimsScopesField, AccountClaimMapsField, JwtValidationPubKeysField, BoundClaimsField:
 			continue
 		default:
 			filteredDbMask = append(filteredDbMask, f)
@@ -204,7 +226,7 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, am *AuthMethod, versi
 	}
 	for _, f := range nullFields {
 		switch f {
-		case SigningAlgsField, AudClaimsField, CertificatesField, ClaimsScopesField, AccountClaimMapsField:
+		case SigningAlgsField, AudClaimsField, CertificatesField, ClaimsScopesField, AccountClaimMapsField, JwtValidationPubKeysField, BoundClaimsField:
 			continue
 		default:
 			filteredNullFields = append(filteredNullFields, f)
@@ -223,7 +245,11 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, am *AuthMethod, versi
 		len(addScopes) == 0 &&
 		len(deleteScopes) == 0 &&
 		len(addMaps) == 0 &&
-		len(deleteMaps) == 0 {
+		len(deleteMaps) == 0 &&
+		len(addPubKeys) == 0 &&
+		len(deletePubKeys) == 0 &&
+		len(addBoundClaims) == 0 &&
+		len(deleteBoundClaims) == 0 {
 		return origAm, db.NoRowsAffected, nil
 	}
 
@@ -386,6 +412,44 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, am *AuthMethod, versi
 				msgs = append(msgs, addMapsOplogMsgs...)
 			}
 
+			if len(deletePubKeys) > 0 {
+				deletePubKeysOplogMsgs := make([]*oplog.Message, 0, len(deletePubKeys))
+				rowsDeleted, err := w.DeleteItems(ctx, deletePubKeys, db.NewOplogMsgs(&deletePubKeysOplogMsgs))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete jwt validation public keys"))
+				}
+				if rowsDeleted != len(deletePubKeys) {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("jwt validation public keys deleted %d did not match request for %d", rowsDeleted, len(deletePubKeys)))
+				}
+				msgs = append(msgs, deletePubKeysOplogMsgs...)
+			}
+			if len(addPubKeys) > 0 {
+				addPubKeysOplogMsgs := make([]*oplog.Message, 0, len(addPubKeys))
+				if err := w.CreateItems(ctx, addPubKeys, db.NewOplogMsgs(&addPubKeysOplogMsgs)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to add jwt validation public keys"))
+				}
+				msgs = append(msgs, addPubKeysOplogMsgs...)
+			}
+
+			if len(deleteBoundClaims) > 0 {
+				deleteBoundClaimsOplogMsgs := make([]*oplog.Message, 0, len(deleteBoundClaims))
+				rowsDeleted, err := w.DeleteItems(ctx, deleteBoundClaims, db.NewOplogMsgs(&deleteBoundClaimsOplogMsgs))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete bound claims"))
+				}
+				if rowsDeleted != len(deleteBoundClaims) {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("bound claims deleted %d did not match request for %d", rowsDeleted, len(deleteBoundClaims)))
+				}
+				msgs = append(msgs, deleteBoundClaimsOplogMsgs...)
+			}
+			if len(addBoundClaims) > 0 {
+				addBoundClaimsOplogMsgs := make([]*oplog.Message, 0, len(addBoundClaims))
+				if err := w.CreateItems(ctx, addBoundClaims, db.NewOplogMsgs(&addBoundClaimsOplogMsgs)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to add bound claims"))
+				}
+				msgs = append(msgs, addBoundClaimsOplogMsgs...)
+			}
+
 			metadata := updatedAm.oplog(oplog.OpType_OP_TYPE_UPDATE)
 			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, metadata, msgs); err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
@@ -421,17 +485,19 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, am *AuthMethod, versi
 type voName string
 
 const (
-	SigningAlgVO       voName = "SigningAlgs"
-	CertificateVO      voName = "Certificates"
-	AudClaimVO         voName = "AudClaims"
-	ClaimsScopesVO     voName = "ClaimsScopes"
-	AccountClaimMapsVO voName = "AccountClaimMaps"
+	SigningAlgVO           voName = "SigningAlgs"
+	CertificateVO          voName = "Certificates"
+	AudClaimVO             voName = "AudClaims"
+	ClaimsScopesVO         voName = "ClaimsScopes"
+	AccountClaimMapsVO     voName = "AccountClaimMaps"
+	JwtValidationPubKeysVO voName = "JwtValidationPubKeys"
+	BoundClaimsVO          voName = "BoundClaims"
 )
 
 // validVoName decides if the name is valid
 func validVoName(name voName) bool {
 	switch name {
-	case SigningAlgVO, CertificateVO, AudClaimVO, ClaimsScopesVO, AccountClaimMapsVO:
+	case SigningAlgVO, CertificateVO, AudClaimVO, ClaimsScopesVO, AccountClaimMapsVO, JwtValidationPubKeysVO, BoundClaimsVO:
 		return true
 	default:
 		return false
@@ -478,6 +544,22 @@ var supportedFactories = map[voName]factoryFunc{
 		}
 		return NewAccountClaimMap(ctx, publicId, m.From, to)
 	},
+	JwtValidationPubKeysVO: func(ctx context.Context, publicId string, i any) (any, error) {
+		str := fmt.Sprintf("%s", i)
+		return NewJwtValidationPubKey(ctx, publicId, str)
+	},
+	BoundClaimsVO: func(ctx context.Context, publicId string, i any) (any, error) {
+		const op = "oidc.BoundClaimsFactory"
+		str := fmt.Sprintf("%s", i)
+		bound, err := ParseBoundClaims(ctx, str)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		for claim, values := range bound {
+			return NewBoundClaim(ctx, publicId, claim, values[0])
+		}
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unable to parse bound claim %s", str))
+	},
 }
 
 // valueObjectChanges takes the new and old list of VOs (value objects) and
@@ -580,6 +662,10 @@ func validateFieldMask(ctx context.Context, fieldMaskPaths []string) error {
 		case strings.EqualFold(CertificatesField, f):
 		case strings.EqualFold(ClaimsScopesField, f):
 		case strings.EqualFold(AccountClaimMapsField, f):
+		case strings.EqualFold(JwtValidationPubKeysField, f):
+		case strings.EqualFold(BoundClaimsField, f):
+		case strings.EqualFold(DeletionProtectedField, f):
+		case strings.EqualFold(JwtLoginEnabledField, f):
 		default:
 			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid field mask: %s", f))
 		}
@@ -646,6 +732,26 @@ func applyUpdate(new, orig *AuthMethod, fieldMaskPaths []string) *AuthMethod {
 				cp.AccountClaimMaps = make([]string, 0, len(new.AccountClaimMaps))
 				cp.AccountClaimMaps = append(cp.AccountClaimMaps, new.AccountClaimMaps...)
 			}
+		case JwtValidationPubKeysField:
+			switch {
+			case len(new.JwtValidationPubKeys) == 0:
+				cp.JwtValidationPubKeys = nil
+			default:
+				cp.JwtValidationPubKeys = make([]string, 0, len(new.JwtValidationPubKeys))
+				cp.JwtValidationPubKeys = append(cp.JwtValidationPubKeys, new.JwtValidationPubKeys...)
+			}
+		case BoundClaimsField:
+			switch {
+			case len(new.BoundClaims) == 0:
+				cp.BoundClaims = nil
+			default:
+				cp.BoundClaims = make([]string, 0, len(new.BoundClaims))
+				cp.BoundClaims = append(cp.BoundClaims, new.BoundClaims...)
+			}
+		case DeletionProtectedField:
+			cp.DeletionProtected = new.DeletionProtected
+		case JwtLoginEnabledField:
+			cp.JwtLoginEnabled = new.JwtLoginEnabled
 		}
 	}
 	return cp
@@ -8,11 +8,13 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/boundary/internal/auth"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/oplog"
 	"github.com/hashicorp/go-dbw"
+	"github.com/hashicorp/go-secure-stdlib/strutil"
 )
 
 // CreateManagedGroup inserts an ManagedGroup, mg, into the repository and
@@ -42,6 +44,9 @@ func (r *Repository) CreateManagedGroup(ctx context.Context, scopeId string, mg
 	if scopeId == "" {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
 	}
+	if err := auth.ValidateSetOperandIds(ctx, r.reader, mg.AuthMethodId, "", mg.UnionGroupIds, mg.IntersectionGroupIds, mg.DifferenceGroupIds); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
 
 	mg = mg.Clone()
 
@@ -166,8 +171,8 @@ func (r *Repository) DeleteManagedGroup(ctx context.Context, scopeId, withPublic
 // ManagedGroup containing the updated values and a count of the number of
 // records updated. mg is not changed.
 //
-// mg must contain a valid PublicId. Only mg.Name, mg.Description, and mg.Filter
-// can be updated. If mg.Name is set to a non-empty string, it must be unique
+// mg must contain a valid PublicId. Only mg.Name, mg.Description, mg.Filter
+// and the set operand ids can be updated. If mg.Name is set to a non-empty string, it must be unique
 // within mg.AuthMethodId.
 //
 // An attribute of a will be set to NULL in the database if the attribute in a
@@ -195,16 +200,36 @@ func (r *Repository) UpdateManagedGroup(ctx context.Context, scopeId string, mg
 		case strings.EqualFold(NameField, f):
 		case strings.EqualFold(DescriptionField, f):
 		case strings.EqualFold(FilterField, f):
+		case strings.EqualFold(UnionGroupIdsField, f):
+		case strings.EqualFold(IntersectionGroupIdsField, f):
+		case strings.EqualFold(DifferenceGroupIdsField, f):
 		default:
 			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, f)
 		}
 	}
+	if strutil.StrListContainsCaseInsensitive(fieldMaskPaths, UnionGroupIdsField) ||
+		strutil.StrListContainsCaseInsensitive(fieldMaskPaths, IntersectionGroupIdsField) ||
+		strutil.StrListContainsCaseInsensitive(fieldMaskPaths, DifferenceGroupIdsField) {
+		current, err := r.LookupManagedGroup(ctx, mg.PublicId)
+		if err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+		if current == nil {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("managed group %s not found", mg.PublicId))
+		}
+		if err := auth.ValidateSetOperandIds(ctx, r.reader, current.AuthMethodId, mg.PublicId, mg.UnionGroupIds, mg.IntersectionGroupIds, mg.DifferenceGroupIds); err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+	}
 	var dbMask, nullFields []string
 	dbMask, nullFields = dbw.BuildUpdatePaths(
 		map[string]any{
-			NameField:        mg.Name,
-			DescriptionField: mg.Description,
-			FilterField:      mg.Filter,
+			NameField:                 mg.Name,
+			DescriptionField:          mg.Description,
+			FilterField:               mg.Filter,
+			UnionGroupIdsField:        mg.UnionGroupIds,
+			IntersectionGroupIdsField: mg.IntersectionGroupIds,
+			DifferenceGroupIdsField:   mg.DifferenceGroupIds,
 		},
 		fieldMaskPaths,
 		nil,
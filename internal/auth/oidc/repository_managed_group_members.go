@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/oidc/store"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/kms"
@@ -219,7 +221,8 @@ func (r *Repository) ListManagedGroupMembershipsByMember(ctx context.Context, wi
 }
 
 // ListManagedGroupMembershipsByGroup lists managed group memberships via the
-// group ID and supports WithLimit option.
+// group ID and supports WithLimit option. The memberships include the results
+// of the managed group's set operations.
 func (r *Repository) ListManagedGroupMembershipsByGroup(ctx context.Context, withGroupId string, opt ...Option) ([]*ManagedGroupMemberAccount, error) {
 	const op = "oidc.(Repository).ListManagedGroupMembershipsByGroup"
 	if withGroupId == "" {
@@ -235,10 +238,20 @@ func (r *Repository) ListManagedGroupMembershipsByGroup(ctx context.Context, wit
 	if opts.withReader != nil {
 		reader = opts.withReader
 	}
-	var mgs []*ManagedGroupMemberAccount
-	err := reader.SearchWhere(ctx, &mgs, "managed_group_id = ?", []any{withGroupId}, db.WithLimit(limit))
+	var members []*auth.ManagedGroupMemberAccount
+	err := reader.SearchWhere(ctx, &members, "managed_group_id = ?", []any{withGroupId}, db.WithLimit(limit))
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
+	mgs := make([]*ManagedGroupMemberAccount, 0, len(members))
+	for _, m := range members {
+		mgs = append(mgs, &ManagedGroupMemberAccount{
+			ManagedGroupMemberAccount: &store.ManagedGroupMemberAccount{
+				CreateTime:     m.CreateTime,
+				ManagedGroupId: m.ManagedGroupId,
+				MemberId:       m.MemberId,
+			},
+		})
+	}
 	return mgs, nil
 }
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_ManagedGroupSetOperations(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	ctx := context.Background()
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	authMethod := TestAuthMethod(
+		t, conn, databaseWrapper, org.GetPublicId(), ActivePrivateState,
+		"alice-rp", "fido",
+		WithSigningAlgs(RS256),
+		WithIssuer(TestConvertToUrls(t, "https://www.alice.com")[0]),
+		WithApiUrl(TestConvertToUrls(t, "https://www.alice.com/callback")[0]),
+	)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	alice := TestAccount(t, conn, authMethod, "alice")
+	bob := TestAccount(t, conn, authMethod, "bob")
+	carol := TestAccount(t, conn, authMethod, "carol")
+
+	engineers := TestManagedGroup(t, conn, authMethod, TestFakeManagedGroupFilter)
+	TestManagedGroupMember(t, conn, engineers.PublicId, alice.PublicId)
+	TestManagedGroupMember(t, conn, engineers.PublicId, bob.PublicId)
+	contractors := TestManagedGroup(t, conn, authMethod, TestFakeManagedGroupFilter)
+	TestManagedGroupMember(t, conn, contractors.PublicId, bob.PublicId)
+
+	carolUser := iam.TestUser(t, iamRepo, org.PublicId, iam.WithAccountIds(carol.PublicId))
+	oncall := iam.TestGroup(t, conn, org.PublicId)
+	iam.TestGroupMember(t, conn, oncall.PublicId, carolUser.PublicId)
+
+	members := func(t *testing.T, mgId string) []string {
+		t.Helper()
+		got, err := repo.ListManagedGroupMembershipsByGroup(ctx, mgId)
+		require.NoError(t, err)
+		var ids []string
+		for _, m := range got {
+			ids = append(ids, m.MemberId)
+		}
+		return ids
+	}
+
+	t.Run("union", func(t *testing.T) {
+		mg, err := NewManagedGroup(ctx, authMethod.PublicId, TestFakeManagedGroupFilter, WithUnionGroupIds(engineers.PublicId, oncall.PublicId))
+		require.NoError(t, err)
+		mg, err = repo.CreateManagedGroup(ctx, org.PublicId, mg)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{alice.PublicId, bob.PublicId, carol.PublicId}, members(t, mg.PublicId))
+	})
+	t.Run("difference", func(t *testing.T) {
+		mg, err := NewManagedGroup(ctx, authMethod.PublicId, TestFakeManagedGroupFilter, WithUnionGroupIds(engineers.PublicId), WithDifferenceGroupIds(contractors.PublicId))
+		require.NoError(t, err)
+		mg, err = repo.CreateManagedGroup(ctx, org.PublicId, mg)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{alice.PublicId}, members(t, mg.PublicId))
+	})
+	t.Run("intersection", func(t *testing.T) {
+		mg, err := NewManagedGroup(ctx, authMethod.PublicId, TestFakeManagedGroupFilter, WithUnionGroupIds(engineers.PublicId), WithIntersectionGroupIds(contractors.PublicId))
+		require.NoError(t, err)
+		mg, err = repo.CreateManagedGroup(ctx, org.PublicId, mg)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{bob.PublicId}, members(t, mg.PublicId))
+	})
+	t.Run("unknown-operand", func(t *testing.T) {
+		mg, err := NewManagedGroup(ctx, authMethod.PublicId, TestFakeManagedGroupFilter, WithUnionGroupIds("g_1234567890"))
+		require.NoError(t, err)
+		_, err = repo.CreateManagedGroup(ctx, org.PublicId, mg)
+		require.Error(t, err)
+	})
+}
@@ -37,6 +37,9 @@ import (
 // * Exchange the callbackCodeParameter for provider tokens and validate the
 // tokens.  Call UserInfo endpoint using access token.
 //
+// * Verify the ID Token's claims match the auth method's bound claims (if
+// any).
+//
 // * Use oidc.(Repository).upsertAccount to create/update account using ID
 // Tokens claims. The "sub" claim as external ID and setting email and full name
 // for the account.
@@ -161,7 +164,7 @@ func Callback(
 	if err != nil {
 		return "", errors.New(ctx, errors.Unknown, op, "unable to create oidc request for token exchange", errors.WithWrap(err))
 	}
-	tk, err := provider.Exchange(ctx, oidcRequest, state, code)
+	tk, err := exchange(ctx, am, provider, oidcRequest, code)
 	if err != nil {
 		return "", errors.New(ctx, errors.Unknown, op, "unable to complete exchange with oidc provider", errors.WithWrap(err))
 	}
@@ -174,6 +177,9 @@ func Callback(
 	if err := tk.IDToken().Claims(&idTkClaims); err != nil {
 		return "", errors.New(ctx, errors.Unknown, op, "unable to parse ID Token claims", errors.WithWrap(err))
 	}
+	if err := verifyBoundClaims(ctx, am, idTkClaims); err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
 
 	userInfoTokenSource := tk.StaticTokenSource()
 	if userInfoTokenSource != nil {
@@ -191,39 +197,9 @@ func Callback(
 		return "", errors.Wrap(ctx, err, op)
 	}
 
-	// Get the set of all managed groups so we can filter
-	mgs, err := r.ListManagedGroups(ctx, am.GetPublicId())
-	if err != nil {
+	if err := setManagedGroupMemberships(ctx, r, am, acct, idTkClaims, userInfoClaims); err != nil {
 		return "", errors.Wrap(ctx, err, op)
 	}
-	if len(mgs) > 0 {
-		matchedMgs := make([]*ManagedGroup, 0, len(mgs))
-		evalData := map[string]any{
-			"token":    idTkClaims,
-			"userinfo": userInfoClaims,
-		}
-		// Iterate through and check claims against filters
-		for _, mg := range mgs {
-			eval, err := bexpr.CreateEvaluator(mg.Filter)
-			if err != nil {
-				// We check all filters on ingress so this should never happen,
-				// but we validate anyways
-				return "", errors.Wrap(ctx, err, op)
-			}
-			match, err := eval.Evaluate(evalData)
-			if err != nil && !errors.Is(err, pointerstructure.ErrNotFound) {
-				return "", errors.Wrap(ctx, err, op)
-			}
-			if match {
-				matchedMgs = append(matchedMgs, mg)
-			}
-		}
-		// We always pass it in, even if none match, because in that case we
-		// need to remove any mappings that exist
-		if _, _, err := r.SetManagedGroupMemberships(ctx, am, acct, matchedMgs); err != nil {
-			return "", errors.Wrap(ctx, err, op)
-		}
-	}
 
 	// before searching for the iam.User associated with the account,
 	// we need to see if this particular auth method is allowed to
@@ -261,3 +237,45 @@ func Callback(
 	// tada!  we can return a final redirect URL for the successful authentication.
 	return reqState.FinalRedirectUrl, nil
 }
+
+// setManagedGroupMemberships evaluates the filters of the auth method's managed
+// groups against the token and userinfo claims and sets the account's
+// memberships to the matching groups.
+func setManagedGroupMemberships(ctx context.Context, r *Repository, am *AuthMethod, acct *Account, tokenClaims, userInfoClaims map[string]any) error {
+	const op = "oidc.setManagedGroupMemberships"
+	// Get the set of all managed groups so we can filter
+	mgs, err := r.ListManagedGroups(ctx, am.GetPublicId())
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if len(mgs) == 0 {
+		return nil
+	}
+	matchedMgs := make([]*ManagedGroup, 0, len(mgs))
+	evalData := map[string]any{
+		"token":    tokenClaims,
+		"userinfo": userInfoClaims,
+	}
+	// Iterate through and check claims against filters
+	for _, mg := range mgs {
+		eval, err := bexpr.CreateEvaluator(mg.Filter)
+		if err != nil {
+			// We check all filters on ingress so this should never happen,
+			// but we validate anyways
+			return errors.Wrap(ctx, err, op)
+		}
+		match, err := eval.Evaluate(evalData)
+		if err != nil && !errors.Is(err, pointerstructure.ErrNotFound) {
+			return errors.Wrap(ctx, err, op)
+		}
+		if match {
+			matchedMgs = append(matchedMgs, mg)
+		}
+	}
+	// We always pass it in, even if none match, because in that case we
+	// need to remove any mappings that exist
+	if _, _, err := r.SetManagedGroupMemberships(ctx, am, acct, matchedMgs); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	gooidc "github.com/coreos/go-oidc/v3/oidc"
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/go-secure-stdlib/strutil"
+)
+
+// JwtLogin is an oidc domain service function for authenticating with a JWT
+// that was issued to a workload by the auth method's issuer (for example: a
+// CI job's OIDC identity token), rather than by completing the interactive
+// OIDC authorization code flow. On success, it returns an auth token for the
+// account's user.
+//
+// The service operation includes:
+//
+// * Verify the auth method has JWT login enabled and at least one AudClaims.
+//
+// * Verify the JWT's signature using the auth method's JwtValidationPubKeys.
+// When the auth method has no JwtValidationPubKeys, the JWT's signature is
+// verified using the provider's (cached) JWKS.
+//
+// * Verify the JWT's iss, exp, nbf and iat claims and the signing algorithm.
+// The aud claim must contain one of the auth method's AudClaims.
+//
+// * Verify the JWT's claims match the auth method's bound claims (if any).
+//
+// * Use oidc.(Repository).upsertAccount to create/update account using the
+// JWT's claims.
+//
+// * Use iam.(Repository).LookupUserWithLogin(...) look up the iam.User matching
+// the Account.
+//
+// * Use the authtoken.(Repository).CreateAuthToken(...) to create an auth
+// token for the authenticated user.
+func JwtLogin(
+	ctx context.Context,
+	oidcRepoFn OidcRepoFactory,
+	iamRepoFn IamRepoFactory,
+	atRepoFn AuthTokenRepoFactory,
+	authMethodId, token string,
+	opt ...authtoken.Option,
+) (*authtoken.AuthToken, error) {
+	const op = "oidc.JwtLogin"
+	switch {
+	case oidcRepoFn == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing oidc repository function")
+	case iamRepoFn == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing iam repository function")
+	case atRepoFn == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth token repository function")
+	case authMethodId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	case token == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing token")
+	}
+
+	r, err := oidcRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	am, err := r.lookupAuthMethod(ctx, authMethodId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if am == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("auth method %s not found", authMethodId))
+	}
+	if am.OperationalState == string(InactiveState) {
+		return nil, errors.New(ctx, errors.AuthMethodInactive, op, "not allowed to authenticate")
+	}
+	if !am.JwtLoginEnabled {
+		return nil, errors.New(ctx, errors.Forbidden, op, "jwt login is not enabled for the auth method")
+	}
+	if len(am.AudClaims) == 0 {
+		// without an audience, a token issued to any other client of the
+		// issuer would be accepted.
+		return nil, errors.New(ctx, errors.Forbidden, op, "jwt login requires the auth method to have allowed audiences")
+	}
+
+	var ks gooidc.KeySet
+	switch {
+	case len(am.JwtValidationPubKeys) > 0:
+		if ks, err = newStaticKeySet(ctx, am.JwtValidationPubKeys...); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	default:
+		// get the provider from the cache (if possible).  FYI: "get" just does
+		// the right thing about comparing the cache with the auth method from
+		// the db.
+		provider, err := providerCache().get(ctx, am)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if ks, err = keySetCache().get(ctx, am.PublicId, provider); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+
+	claims, err := verifyJwt(ctx, am, ks, time.Now, token)
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to verify jwt", errors.WithWrap(err))
+	}
+
+	acct, err := r.upsertAccount(ctx, am, claims, map[string]any{})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if err := setManagedGroupMemberships(ctx, r, am, acct, claims, map[string]any{}); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	iamRepo, err := iamRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if _, err := iamRepo.LookupScope(ctx, am.ScopeId); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to lookup account scope: "+am.ScopeId))
+	}
+	user, err := iamRepo.LookupUserWithLogin(ctx, acct.PublicId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	tokenRepo, err := atRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	tk, err := tokenRepo.CreateAuthToken(ctx, user, acct.PublicId, opt...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return tk, nil
+}
+
+// verifyJwt verifies the JWT and returns its claims.  The signature is
+// verified using the key set.
+func verifyJwt(ctx context.Context, am *AuthMethod, ks gooidc.KeySet, now func() time.Time, token string) (map[string]any, error) {
+	const op = "oidc.verifyJwt"
+	switch {
+	case am == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	case ks == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing key set")
+	case now == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing now func")
+	case token == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing token")
+	}
+	verifier := gooidc.NewVerifier(am.Issuer, ks, &gooidc.Config{
+		SkipClientIDCheck:    true,
+		SupportedSigningAlgs: append([]string{}, am.SigningAlgs...),
+		Now:                  now,
+	})
+
+	// verifier.Verify will check the supported algs, signature, iss, exp, nbf.
+	tk, err := verifier.Verify(ctx, token)
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "invalid jwt", errors.WithWrap(err))
+	}
+	if n := now(); n.Add(idTokenLeeway).Before(tk.IssuedAt) {
+		return nil, errors.New(ctx, errors.Unknown, op, fmt.Sprintf("invalid jwt current time %v before the iat (issued at) time %v", n, tk.IssuedAt))
+	}
+
+	if len(am.AudClaims) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing allowed audiences")
+	}
+	found := false
+	for _, a := range am.AudClaims {
+		if strutil.StrListContains(tk.Audience, a) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, errors.New(ctx, errors.Unknown, op, "invalid jwt audiences")
+	}
+
+	var claims map[string]any
+	if err := tk.Claims(&claims); err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to parse jwt claims", errors.WithWrap(err))
+	}
+	if err := verifyBoundClaims(ctx, am, claims); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return claims, nil
+}
+
+// verifyBoundClaims verifies the claims match the auth method's bound claims.
+// For every bound claim, the claim must be present and match one of the bound
+// claim's values.  When the claim is a list, one of its elements must match.
+func verifyBoundClaims(ctx context.Context, am *AuthMethod, claims map[string]any) error {
+	const op = "oidc.verifyBoundClaims"
+	if len(am.BoundClaims) == 0 {
+		return nil
+	}
+	bound, err := ParseBoundClaims(ctx, am.BoundClaims...)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	for claim, values := range bound {
+		var got []string
+		switch v := claims[claim].(type) {
+		case nil:
+		case []any:
+			for _, e := range v {
+				got = append(got, claimValueString(e))
+			}
+		default:
+			got = append(got, claimValueString(v))
+		}
+		matched := false
+		for _, g := range got {
+			if i := sort.SearchStrings(values, g); i < len(values) && values[i] == g {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return errors.New(ctx, errors.Forbidden, op, fmt.Sprintf("claim %q does not match the bound claim values", claim))
+		}
+	}
+	return nil
+}
+
+// claimValueString returns the string a claim value is compared to bound
+// claim values with.  JSON numbers are decoded as float64, which fmt formats
+// with an exponent (12345678 as "1.2345678e+07"), so they're formatted
+// without one.
+func claimValueString(v any) string {
+	switch n := v.(type) {
+	case float64:
+		return strconv.FormatFloat(n, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,350 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oidc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/cap/oidc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_verifyJwt(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	priv, _ := testSigningKey(t, "key-1")
+	ks, err := newStaticKeySet(ctx, testPubKeyPem(t, &priv.PublicKey))
+	require.NoError(t, err)
+	now := time.Now()
+	nowFn := func() time.Time { return now }
+
+	testAm := func(opt ...func(*AuthMethod)) *AuthMethod {
+		am := AllocAuthMethod()
+		am.Issuer = "https://token.actions.githubusercontent.com"
+		am.ClientId = "https://github.com/hashicorp"
+		am.AudClaims = []string{"https://github.com/hashicorp"}
+		am.SigningAlgs = []string{string(oidc.ES256)}
+		for _, o := range opt {
+			o(&am)
+		}
+		return &am
+	}
+	validClaims := func() map[string]any {
+		return map[string]any{
+			"iss":        "https://token.actions.githubusercontent.com",
+			"sub":        "repo:hashicorp/boundary:ref:refs/heads/main",
+			"aud":        "https://github.com/hashicorp",
+			"repository": "hashicorp/boundary",
+			"ref":        "refs/heads/main",
+			"iat":        now.Unix(),
+			"exp":        now.Add(time.Minute).Unix(),
+		}
+	}
+	tests := []struct {
+		name    string
+		am      *AuthMethod
+		claims  func() map[string]any
+		wantErr bool
+	}{
+		{
+			name:   "valid",
+			am:     testAm(),
+			claims: validClaims,
+		},
+		{
+			name: "valid-aud-claims",
+			am: testAm(func(am *AuthMethod) {
+				am.AudClaims = []string{"boundary"}
+			}),
+			claims: func() map[string]any {
+				c := validClaims()
+				c["aud"] = []string{"vault", "boundary"}
+				return c
+			},
+		},
+		{
+			name: "valid-bound-claims",
+			am: testAm(func(am *AuthMethod) {
+				am.BoundClaims = []string{"repository=hashicorp/boundary", "ref=refs/heads/main", "ref=refs/heads/release"}
+			}),
+			claims: validClaims,
+		},
+		{
+			name: "bound-claim-mismatch",
+			am: testAm(func(am *AuthMethod) {
+				am.BoundClaims = []string{"repository=hashicorp/boundary", "ref=refs/heads/release"}
+			}),
+			claims:  validClaims,
+			wantErr: true,
+		},
+		{
+			name: "bound-claim-missing",
+			am: testAm(func(am *AuthMethod) {
+				am.BoundClaims = []string{"environment=production"}
+			}),
+			claims:  validClaims,
+			wantErr: true,
+		},
+		{
+			name: "no-aud-claims",
+			am: testAm(func(am *AuthMethod) {
+				am.AudClaims = nil
+			}),
+			claims:  validClaims,
+			wantErr: true,
+		},
+		{
+			name: "wrong-issuer",
+			am:   testAm(),
+			claims: func() map[string]any {
+				c := validClaims()
+				c["iss"] = "https://eve.com"
+				return c
+			},
+			wantErr: true,
+		},
+		{
+			name: "wrong-audience",
+			am:   testAm(),
+			claims: func() map[string]any {
+				c := validClaims()
+				c["aud"] = "https://github.com/eve"
+				return c
+			},
+			wantErr: true,
+		},
+		{
+			name: "expired",
+			am:   testAm(),
+			claims: func() map[string]any {
+				c := validClaims()
+				c["exp"] = now.Add(-time.Minute).Unix()
+				return c
+			},
+			wantErr: true,
+		},
+		{
+			name: "issued-in-the-future",
+			am:   testAm(),
+			claims: func() map[string]any {
+				c := validClaims()
+				c["iat"] = now.Add(time.Hour).Unix()
+				c["exp"] = now.Add(2 * time.Hour).Unix()
+				return c
+			},
+			wantErr: true,
+		},
+		{
+			name: "unsupported-alg",
+			am: testAm(func(am *AuthMethod) {
+				am.SigningAlgs = []string{string(oidc.RS256)}
+			}),
+			claims:  validClaims,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			claims, err := verifyJwt(ctx, tt.am, ks, nowFn, testSignedJwt(t, priv, "key-1", tt.claims()))
+			if tt.wantErr {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal("repo:hashicorp/boundary:ref:refs/heads/main", claims["sub"])
+		})
+	}
+	t.Run("unknown-signing-key", func(t *testing.T) {
+		otherPriv, _ := testSigningKey(t, "key-1")
+		_, err := verifyJwt(ctx, testAm(), ks, nowFn, testSignedJwt(t, otherPriv, "key-1", validClaims()))
+		require.Error(t, err)
+	})
+}
+
+func Test_verifyBoundClaims(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	am := AllocAuthMethod()
+	am.BoundClaims = []string{"groups=admins", "groups=ops", "run_number=42", "protected=true", "repository_id=12345678"}
+
+	tests := []struct {
+		name    string
+		claims  map[string]any
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			claims: map[string]any{
+				"groups":        []any{"devs", "ops"},
+				"run_number":    float64(42),
+				"protected":     true,
+				"repository_id": float64(12345678),
+			},
+		},
+		{
+			name: "no-matching-list-element",
+			claims: map[string]any{
+				"groups":        []any{"devs"},
+				"run_number":    float64(42),
+				"protected":     true,
+				"repository_id": float64(12345678),
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing-claim",
+			claims: map[string]any{
+				"groups":        "admins",
+				"run_number":    float64(42),
+				"repository_id": float64(12345678),
+			},
+			wantErr: true,
+		},
+		{
+			name: "fractional-number",
+			claims: map[string]any{
+				"groups":        "admins",
+				"run_number":    float64(42.5),
+				"protected":     true,
+				"repository_id": float64(12345678),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			err := verifyBoundClaims(ctx, &am, tt.claims)
+			if tt.wantErr {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(errors.Forbidden), err))
+				return
+			}
+			require.NoError(err)
+		})
+	}
+	t.Run("no-bound-claims", func(t *testing.T) {
+		am := AllocAuthMethod()
+		require.NoError(t, verifyBoundClaims(ctx, &am, map[string]any{}))
+	})
+}
+
+func Test_claimValueString(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		in   any
+		want string
+	}{
+		{in: "main", want: "main"},
+		{in: true, want: "true"},
+		{in: float64(42), want: "42"},
+		{in: float64(12345678), want: "12345678"},
+		{in: float64(1e21), want: "1000000000000000000000"},
+		{in: float64(-1.5), want: "-1.5"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, claimValueString(tt.in))
+	}
+}
+
+func Test_JwtLogin(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	rootWrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, rootWrapper)
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.NewRepository(rw, rw, kmsCache)
+	}
+	repoFn := func() (*Repository, error) {
+		return NewRepository(ctx, rw, rw, kmsCache)
+	}
+	atRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(rw, rw, kmsCache)
+	}
+
+	iamRepo := iam.TestRepo(t, conn, rootWrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	priv, _ := testSigningKey(t, "key-1")
+	issuer := "https://token.actions.githubusercontent.com"
+	testAm := func(clientId string, opt ...Option) *AuthMethod {
+		opt = append([]Option{
+			WithIssuer(TestConvertToUrls(t, issuer)[0]),
+			WithSigningAlgs(Alg(oidc.ES256)),
+			WithJwtValidationPubKeys(testPubKeyPem(t, &priv.PublicKey)),
+		}, opt...)
+		return TestAuthMethod(t, conn, databaseWrapper, org.PublicId, ActivePublicState, clientId, "fido", opt...)
+	}
+	enabledAm := testAm("enabled", WithJwtLoginEnabled(true), WithAudClaims("boundary"))
+	iam.TestSetPrimaryAuthMethod(t, iamRepo, org, enabledAm.PublicId)
+	disabledAm := testAm("disabled", WithAudClaims("boundary"))
+	noAudsAm := testAm("no-auds", WithJwtLoginEnabled(true))
+
+	now := time.Now()
+	token := testSignedJwt(t, priv, "key-1", map[string]any{
+		"iss": issuer,
+		"sub": "repo:hashicorp/boundary:ref:refs/heads/main",
+		"aud": []string{"boundary", "disabled", "no-auds"},
+		"iat": now.Unix(),
+		"exp": now.Add(time.Minute).Unix(),
+	})
+
+	tests := []struct {
+		name            string
+		authMethodId    string
+		wantErrMatch    *errors.Template
+		wantErrContains string
+	}{
+		{
+			name:         "valid",
+			authMethodId: enabledAm.PublicId,
+		},
+		{
+			name:            "not-enabled",
+			authMethodId:    disabledAm.PublicId,
+			wantErrMatch:    errors.T(errors.Forbidden),
+			wantErrContains: "jwt login is not enabled",
+		},
+		{
+			name:            "no-allowed-audiences",
+			authMethodId:    noAudsAm.PublicId,
+			wantErrMatch:    errors.T(errors.Forbidden),
+			wantErrContains: "requires the auth method to have allowed audiences",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			tk, err := JwtLogin(ctx, repoFn, iamRepoFn, atRepoFn, tt.authMethodId, token)
+			if tt.wantErrMatch != nil {
+				require.Error(err)
+				assert.Nil(tk)
+				assert.Truef(errors.Match(tt.wantErrMatch, err), "want err code: %q got: %q", tt.wantErrMatch.Code, err)
+				assert.Contains(err.Error(), tt.wantErrContains)
+				return
+			}
+			require.NoError(err)
+			assert.NotEmpty(tk.GetToken())
+		})
+	}
+}
@@ -24,8 +24,9 @@ import (
 //
 // * Use the authtoken.(Repository).IssueAuthToken to issue the request id's
 // token and mark it as issued in the repo.  If the token is already issue, an
-// error is returned.
-func TokenRequest(ctx context.Context, kms *kms.Kms, atRepoFn AuthTokenRepoFactory, authMethodId, tokenRequestId string) (*authtoken.AuthToken, error) {
+// error is returned.  The provided authtoken options are passed to
+// IssueAuthToken.
+func TokenRequest(ctx context.Context, kms *kms.Kms, atRepoFn AuthTokenRepoFactory, authMethodId, tokenRequestId string, opt ...authtoken.Option) (*authtoken.AuthToken, error) {
 	const op = "oidc.TokenRequest"
 	if kms == nil {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing kms")
@@ -82,7 +83,7 @@ func TokenRequest(ctx context.Context, kms *kms.Kms, atRepoFn AuthTokenRepoFacto
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
-	authTk, err := tokenRepo.IssueAuthToken(ctx, reqTk.RequestId)
+	authTk, err := tokenRepo.IssueAuthToken(ctx, reqTk.RequestId, opt...)
 	if err != nil {
 		if errors.Match(errors.T(errors.RecordNotFound), err) {
 			// We don't have it -- at least not yet. So don't mark it as an
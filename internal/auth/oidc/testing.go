@@ -123,6 +123,30 @@ func TestAuthMethod(
 		require.NoError(rw.CreateItems(ctx, newAccountClaimMaps))
 		require.Equal(len(opts.withAccountClaimMap), len(authMethod.AccountClaimMaps))
 	}
+	if len(opts.withJwtValidationPubKeys) > 0 {
+		newPubKeys := make([]any, 0, len(opts.withJwtValidationPubKeys))
+		for _, k := range opts.withJwtValidationPubKeys {
+			pk, err := NewJwtValidationPubKey(ctx, authMethod.PublicId, k)
+			require.NoError(err)
+			newPubKeys = append(newPubKeys, pk)
+		}
+		require.NoError(rw.CreateItems(ctx, newPubKeys))
+		require.Equal(len(opts.withJwtValidationPubKeys), len(authMethod.JwtValidationPubKeys))
+	}
+	if len(opts.withBoundClaims) > 0 {
+		bound, err := ParseBoundClaims(ctx, opts.withBoundClaims...)
+		require.NoError(err)
+		newBoundClaims := make([]any, 0, len(opts.withBoundClaims))
+		for c, values := range bound {
+			for _, v := range values {
+				bc, err := NewBoundClaim(ctx, authMethod.PublicId, c, v)
+				require.NoError(err)
+				newBoundClaims = append(newBoundClaims, bc)
+			}
+		}
+		require.NoError(rw.CreateItems(ctx, newBoundClaims))
+		require.Equal(len(opts.withBoundClaims), len(authMethod.BoundClaims))
+	}
 	authMethod.OperationalState = string(state)
 	rowsUpdated, err := rw.Update(ctx, authMethod, []string{OperationalStateField}, nil)
 	require.NoError(err)
@@ -157,6 +181,12 @@ func TestSortAuthMethods(t testing.TB, methods []*AuthMethod) {
 		sort.Slice(am.AccountClaimMaps, func(a, b int) bool {
 			return am.AccountClaimMaps[a] < am.AccountClaimMaps[b]
 		})
+		sort.Slice(am.JwtValidationPubKeys, func(a, b int) bool {
+			return am.JwtValidationPubKeys[a] < am.JwtValidationPubKeys[b]
+		})
+		sort.Slice(am.BoundClaims, func(a, b int) bool {
+			return am.BoundClaims[a] < am.BoundClaims[b]
+		})
 	}
 }
 
@@ -24,8 +24,8 @@ func allocAuthMethod() AuthMethod {
 }
 
 // NewAuthMethod creates a new in memory AuthMethod assigned to scopeId.
-// Name and description are the only valid options. All other options are
-// ignored.  MinLoginNameLength and MinPasswordLength are pre-set to the
+// Name, description and deletion protected are the only valid options. All
+// other options are ignored.  MinLoginNameLength and MinPasswordLength are pre-set to the
 // default values of 5 and 8 respectively.
 func NewAuthMethod(scopeId string, opt ...Option) (*AuthMethod, error) {
 	const op = "password.NewAuthMethod"
@@ -41,6 +41,7 @@ func NewAuthMethod(scopeId string, opt ...Option) (*AuthMethod, error) {
 			Description:        opts.withDescription,
 			MinLoginNameLength: 3,
 			MinPasswordLength:  8,
+			DeletionProtected:  opts.withDeletionProtected,
 		},
 	}
 	return a, nil
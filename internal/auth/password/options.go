@@ -27,6 +27,7 @@ type options struct {
 	withPassword          bool
 	withOrderByCreateTime bool
 	ascending             bool
+	withDeletionProtected bool
 }
 
 func getDefaultOptions() options {
@@ -95,3 +96,11 @@ func WithOrderByCreateTime(ascending bool) Option {
 		o.ascending = ascending
 	}
 }
+
+// WithDeletionProtected provides an option to protect the auth method from
+// being deleted.
+func WithDeletionProtected(protected bool) Option {
+	return func(o *options) {
+		o.withDeletionProtected = protected
+	}
+}
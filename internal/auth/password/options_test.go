@@ -68,4 +68,11 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.ascending = true
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithDeletionProtected", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithDeletionProtected(true))
+		testOpts := getDefaultOptions()
+		testOpts.withDeletionProtected = true
+		assert.Equal(opts, testOpts)
+	})
 }
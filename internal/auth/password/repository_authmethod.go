@@ -178,8 +178,9 @@ func (r *Repository) DeleteAuthMethod(ctx context.Context, scopeId, publicId str
 // NewAuthMethod.  fieldMaskPaths provides field_mask.proto paths for fields
 // that should be updated.  Fields will be set to NULL if the field is a zero
 // value and included in fieldMask. Name, Description, MinPasswordLength,
-// and MinLoginNameLength are the only updatable fields, If no updatable fields
-// are included in the fieldMaskPaths, then an error is returned.
+// MinLoginNameLength and DeletionProtected are the only updatable fields, If
+// no updatable fields are included in the fieldMaskPaths, then an error is
+// returned.
 func (r *Repository) UpdateAuthMethod(ctx context.Context, authMethod *AuthMethod, version uint32, fieldMaskPaths []string, opt ...Option) (*AuthMethod, int, error) {
 	const op = "password.(Repository).UpdateAuthMethod"
 	if authMethod == nil {
@@ -197,6 +198,7 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, authMethod *AuthMetho
 		case strings.EqualFold("Description", f):
 		case strings.EqualFold("MinLoginNameLength", f):
 		case strings.EqualFold("MinPasswordLength", f):
+		case strings.EqualFold("DeletionProtected", f):
 		default:
 			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, f)
 		}
@@ -208,9 +210,10 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, authMethod *AuthMetho
 			"Description":        authMethod.Description,
 			"MinPasswordLength":  authMethod.MinPasswordLength,
 			"MinLoginNameLength": authMethod.MinLoginNameLength,
+			"DeletionProtected":  authMethod.DeletionProtected,
 		},
 		fieldMaskPaths,
-		nil,
+		[]string{"DeletionProtected"},
 	)
 	if len(dbMask) == 0 && len(nullFields) == 0 {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "field mask must not be empty")
@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package auth
+
+const (
+	setOperandManagedGroupsQuery = `
+select public_id
+  from auth_managed_group
+ where auth_method_id = ?
+   and public_id in (?);
+`
+	setOperandGroupsQuery = `
+select public_id
+  from iam_group
+ where public_id in (?);
+`
+)
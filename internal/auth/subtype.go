@@ -15,6 +15,7 @@ type AuthMethod interface {
 	boundary.Resource
 	GetScopeId() string
 	GetIsPrimaryAuthMethod() bool
+	GetDeletionProtected() bool
 }
 
 type Account interface {
@@ -9,34 +9,9 @@ import (
 	"testing"
 
 	"github.com/hashicorp/boundary/internal/db"
-	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/stretchr/testify/require"
 )
 
-// ManagedGroupMemberAccount represents an entry from
-// auth_managed_group_member_account.  These are used to determine the account
-// ids where are a member of managed groups.  See: oidc and ldap managed groups
-// as well as iam role grants.
-type ManagedGroupMemberAccount struct {
-	CreateTime     *timestamp.Timestamp
-	MemberId       string
-	ManagedGroupId string
-	tableName      string
-}
-
-// SetTableName sets the table name.
-func (a *ManagedGroupMemberAccount) SetTableName(n string) {
-	a.tableName = n
-}
-
-// TableName returns the table name.
-func (a *ManagedGroupMemberAccount) TableName() string {
-	if a.tableName != "" {
-		return a.tableName
-	}
-	return "auth_managed_group_member_account"
-}
-
 // TestSortManagedGroupMemberAccounts simply sorts them by public id to make
 // comparisons a bit easier.
 func TestSortManagedGroupMemberAccounts(t testing.TB, m []*ManagedGroupMemberAccount) {
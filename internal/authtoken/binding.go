@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package authtoken
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// BindingMode determines what, if anything, newly issued auth tokens are bound
+// to.  A bound auth token is rejected when presented by any other client.
+type BindingMode string
+
+const (
+	// UnboundMode issues auth tokens that can be used from any client.
+	UnboundMode BindingMode = ""
+
+	// ClientIpBindingMode binds auth tokens to a range of ip addresses around
+	// the ip of the client the token was issued to.
+	ClientIpBindingMode BindingMode = "client_ip"
+
+	// ClientCertificateBindingMode binds auth tokens to the tls client
+	// certificate presented by the client the token was issued to.
+	ClientCertificateBindingMode BindingMode = "client_certificate"
+)
+
+const (
+	defaultBindingIpv4PrefixLength = 32
+	defaultBindingIpv6PrefixLength = 64
+)
+
+// ParseBindingMode returns the BindingMode for the provided string.
+func ParseBindingMode(s string) (BindingMode, error) {
+	const op = "authtoken.ParseBindingMode"
+	switch m := BindingMode(strings.ToLower(strings.TrimSpace(s))); m {
+	case UnboundMode, ClientIpBindingMode, ClientCertificateBindingMode:
+		return m, nil
+	default:
+		return UnboundMode, errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("unknown auth token binding mode %q", s))
+	}
+}
+
+// CertificateFingerprint returns the hex encoded SHA-256 fingerprint of the
+// certificate, in the form used to bind auth tokens to client certificates.
+func CertificateFingerprint(cert *x509.Certificate) string {
+	if cert == nil {
+		return ""
+	}
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
+
+// bind sets the client binding of the auth token according to the
+// repository's binding mode.  Tokens cannot be bound without the relevant
+// client info, so an error is returned rather than issuing an unbound token.
+func (r *Repository) bind(ctx context.Context, at *AuthToken, clientIp, clientCertFingerprint string) error {
+	const op = "authtoken.(Repository).bind"
+	switch r.bindingMode {
+	case UnboundMode:
+		return nil
+	case ClientIpBindingMode:
+		ip := net.ParseIP(clientIp)
+		if ip == nil {
+			return errors.New(ctx, errors.InvalidParameter, op, "missing or invalid client ip for auth token binding")
+		}
+		bits, prefix := 32, r.bindingIpv4PrefixLength
+		if ip.To4() == nil {
+			bits, prefix = 128, r.bindingIpv6PrefixLength
+		}
+		ipNet := net.IPNet{IP: ip.Mask(net.CIDRMask(prefix, bits)), Mask: net.CIDRMask(prefix, bits)}
+		at.ClientIpRange = ipNet.String()
+	case ClientCertificateBindingMode:
+		if clientCertFingerprint == "" {
+			return errors.New(ctx, errors.InvalidParameter, op, "missing client certificate for auth token binding")
+		}
+		at.ClientCertificateFingerprint = clientCertFingerprint
+	default:
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unknown auth token binding mode %q", r.bindingMode))
+	}
+	return nil
+}
+
+// boundTo reports whether the client described by clientIp and
+// clientCertFingerprint satisfies the auth token's client binding.  Tokens
+// are checked against the binding they were issued with, regardless of the
+// repository's current binding mode.
+func (at *AuthToken) boundTo(clientIp, clientCertFingerprint string) bool {
+	if r := at.GetClientIpRange(); r != "" {
+		_, ipNet, err := net.ParseCIDR(r)
+		if err != nil {
+			return false
+		}
+		ip := net.ParseIP(clientIp)
+		if ip == nil || !ipNet.Contains(ip) {
+			return false
+		}
+	}
+	if fp := at.GetClientCertificateFingerprint(); fp != "" {
+		if subtle.ConstantTimeCompare([]byte(fp), []byte(clientCertFingerprint)) != 1 {
+			return false
+		}
+	}
+	return true
+}
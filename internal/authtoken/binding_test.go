@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package authtoken
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBindingMode(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		in      string
+		want    BindingMode
+		wantErr bool
+	}{
+		{in: "", want: UnboundMode},
+		{in: "client_ip", want: ClientIpBindingMode},
+		{in: " Client_Certificate ", want: ClientCertificateBindingMode},
+		{in: "session", wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseBindingMode(tt.in)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRepository_bind(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tests := []struct {
+		name      string
+		opts      []Option
+		clientIp  string
+		clientFp  string
+		wantRange string
+		wantFp    string
+		wantErr   bool
+	}{
+		{
+			name:     "unbound",
+			clientIp: "10.0.0.1",
+		},
+		{
+			name:      "ipv4-default",
+			opts:      []Option{WithBindingMode(ClientIpBindingMode)},
+			clientIp:  "10.0.0.1",
+			wantRange: "10.0.0.1/32",
+		},
+		{
+			name:      "ipv4-prefix",
+			opts:      []Option{WithBindingMode(ClientIpBindingMode), WithBindingIpv4PrefixLength(24)},
+			clientIp:  "10.0.0.1",
+			wantRange: "10.0.0.0/24",
+		},
+		{
+			name:      "ipv6-default",
+			opts:      []Option{WithBindingMode(ClientIpBindingMode)},
+			clientIp:  "2001:db8:1:2:3::4",
+			wantRange: "2001:db8:1:2::/64",
+		},
+		{
+			name:    "ip-missing",
+			opts:    []Option{WithBindingMode(ClientIpBindingMode)},
+			wantErr: true,
+		},
+		{
+			name:     "certificate",
+			opts:     []Option{WithBindingMode(ClientCertificateBindingMode)},
+			clientIp: "10.0.0.1",
+			clientFp: "abc123",
+			wantFp:   "abc123",
+		},
+		{
+			name:     "certificate-missing",
+			opts:     []Option{WithBindingMode(ClientCertificateBindingMode)},
+			clientIp: "10.0.0.1",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			opts := getOpts(tt.opts...)
+			r := &Repository{
+				bindingMode:             opts.withBindingMode,
+				bindingIpv4PrefixLength: opts.withBindingIpv4PrefixLength,
+				bindingIpv6PrefixLength: opts.withBindingIpv6PrefixLength,
+			}
+			at := allocAuthToken()
+			err := r.bind(ctx, at, tt.clientIp, tt.clientFp)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantRange, at.GetClientIpRange())
+			assert.Equal(t, tt.wantFp, at.GetClientCertificateFingerprint())
+		})
+	}
+}
+
+func TestAuthToken_boundTo(t *testing.T) {
+	t.Parallel()
+	unbound := allocAuthToken()
+	ipBound := allocAuthToken()
+	ipBound.ClientIpRange = "10.0.0.0/24"
+	certBound := allocAuthToken()
+	certBound.ClientCertificateFingerprint = "abc123"
+
+	assert.True(t, unbound.boundTo("", ""))
+	assert.True(t, unbound.boundTo("192.168.0.1", "other"))
+
+	assert.True(t, ipBound.boundTo("10.0.0.200", ""))
+	assert.False(t, ipBound.boundTo("10.0.1.1", ""))
+	assert.False(t, ipBound.boundTo("", ""))
+
+	assert.True(t, certBound.boundTo("10.0.0.1", "abc123"))
+	assert.False(t, certBound.boundTo("10.0.0.1", "abc124"))
+	assert.False(t, certBound.boundTo("10.0.0.1", ""))
+}
@@ -38,6 +38,11 @@ type options struct {
 	withPublicId                 string
 	withPasswordOptions          []password.Option
 	withIamOptions               []iam.Option
+	withBindingMode              BindingMode
+	withBindingIpv4PrefixLength  int
+	withBindingIpv6PrefixLength  int
+	withClientIp                 string
+	withClientCertFingerprint    string
 }
 
 func getDefaultOptions() options {
@@ -45,6 +50,8 @@ func getDefaultOptions() options {
 		withLimit:                    db.DefaultLimit,
 		withTokenTimeToLiveDuration:  defaultTokenTimeToLiveDuration,
 		withTokenTimeToStaleDuration: defaultTokenTimeToStaleDuration,
+		withBindingIpv4PrefixLength:  defaultBindingIpv4PrefixLength,
+		withBindingIpv6PrefixLength:  defaultBindingIpv6PrefixLength,
 	}
 }
 
@@ -116,3 +123,49 @@ func WithIamOptions(with ...iam.Option) Option {
 		o.withIamOptions = with
 	}
 }
+
+// WithBindingMode allows setting what newly issued auth tokens are bound to.
+func WithBindingMode(mode BindingMode) Option {
+	return func(o *options) {
+		o.withBindingMode = mode
+	}
+}
+
+// WithBindingIpv4PrefixLength allows setting the prefix length of the ip
+// range an auth token issued to an IPv4 client is bound to when using
+// ClientIpBindingMode.
+func WithBindingIpv4PrefixLength(l int) Option {
+	return func(o *options) {
+		if l > 0 && l <= 32 {
+			o.withBindingIpv4PrefixLength = l
+		}
+	}
+}
+
+// WithBindingIpv6PrefixLength allows setting the prefix length of the ip
+// range an auth token issued to an IPv6 client is bound to when using
+// ClientIpBindingMode.
+func WithBindingIpv6PrefixLength(l int) Option {
+	return func(o *options) {
+		if l > 0 && l <= 128 {
+			o.withBindingIpv6PrefixLength = l
+		}
+	}
+}
+
+// WithClientIp allows specifying the ip of the client an auth token is being
+// issued to or validated for.
+func WithClientIp(ip string) Option {
+	return func(o *options) {
+		o.withClientIp = ip
+	}
+}
+
+// WithClientCertificateFingerprint allows specifying the fingerprint of the
+// tls client certificate presented by the client an auth token is being
+// issued to or validated for.
+func WithClientCertificateFingerprint(fp string) Option {
+	return func(o *options) {
+		o.withClientCertFingerprint = fp
+	}
+}
@@ -71,4 +71,35 @@ func Test_GetOpts(t *testing.T) {
 		opts = getOpts(WithIamOptions(iam.WithName("foobar")))
 		assert.NotEmpty(opts.withIamOptions)
 	})
+
+	t.Run("WithBindingMode", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithBindingMode(ClientIpBindingMode))
+		testOpts := getDefaultOptions()
+		testOpts.withBindingMode = ClientIpBindingMode
+		assert.Equal(opts, testOpts)
+	})
+
+	t.Run("WithBindingPrefixLengths", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithBindingIpv4PrefixLength(24), WithBindingIpv6PrefixLength(48))
+		testOpts := getDefaultOptions()
+		testOpts.withBindingIpv4PrefixLength = 24
+		testOpts.withBindingIpv6PrefixLength = 48
+		assert.Equal(opts, testOpts)
+
+		// out of range values are ignored
+		opts = getOpts(WithBindingIpv4PrefixLength(33), WithBindingIpv6PrefixLength(0))
+		assert.Equal(opts, getDefaultOptions())
+	})
+
+	t.Run("WithClient", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithClientIp("127.0.0.1"), WithClientCertificateFingerprint("fp"))
+		testOpts := getDefaultOptions()
+		testOpts.withClientIp = "127.0.0.1"
+		testOpts.withClientCertFingerprint = "fp"
+		assert.Equal(opts, testOpts)
+	})
+
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/types/scope"
 )
 
 var (
@@ -30,6 +31,10 @@ type Repository struct {
 	limit               int
 	timeToLiveDuration  time.Duration
 	timeToStaleDuration time.Duration
+
+	bindingMode             BindingMode
+	bindingIpv4PrefixLength int
+	bindingIpv6PrefixLength int
 }
 
 // NewRepository creates a new Repository. The returned repository is not safe for concurrent go
@@ -54,6 +59,10 @@ func NewRepository(r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*Repo
 		limit:               opts.withLimit,
 		timeToLiveDuration:  opts.withTokenTimeToLiveDuration,
 		timeToStaleDuration: opts.withTokenTimeToStaleDuration,
+
+		bindingMode:             opts.withBindingMode,
+		bindingIpv4PrefixLength: opts.withBindingIpv4PrefixLength,
+		bindingIpv6PrefixLength: opts.withBindingIpv6PrefixLength,
 	}, nil
 }
 
@@ -61,8 +70,10 @@ func NewRepository(r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*Repo
 // Auth Token.  The returned auth token contains the auth token value. The
 // provided IAM User ID must be associated to the provided auth account id or an
 // error will be returned.  The Auth Token will have a Status of "issued".
-// The WithStatus and WithPublicId options are supported and all other options
-// are ignored.
+// The WithStatus, WithPublicId, WithClientIp and
+// WithClientCertificateFingerprint options are supported and all other options
+// are ignored.  Issued tokens are bound to the client according to the
+// repository's binding mode; pending tokens are bound when they are issued.
 func (r *Repository) CreateAuthToken(ctx context.Context, withIamUser *iam.User, withAuthAccountId string, opt ...Option) (*AuthToken, error) {
 	const op = "authtoken.(Repository).CreateAuthToken"
 	if withIamUser == nil || withIamUser.User == nil {
@@ -94,6 +105,9 @@ func (r *Repository) CreateAuthToken(ctx context.Context, withIamUser *iam.User,
 		at.Status = string(PendingStatus)
 	default:
 		at.Status = string(IssuedStatus)
+		if err := r.bind(ctx, at, opts.withClientIp, opts.withClientCertFingerprint); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
 	}
 
 	databaseWrapper, err := r.kms.GetWrapper(ctx, withIamUser.GetScopeId(), kms.KeyPurposeDatabase)
@@ -101,14 +115,6 @@ func (r *Repository) CreateAuthToken(ctx context.Context, withIamUser *iam.User,
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
 	}
 
-	// We truncate the expiration time to the nearest second to make testing in different platforms with
-	// different time resolutions easier.
-	expiration, err := ptypes.TimestampProto(time.Now().Add(r.timeToLiveDuration).Truncate(time.Second))
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidTimeStamp))
-	}
-	at.ExpirationTime = &timestamp.Timestamp{Timestamp: expiration}
-
 	var newAuthToken *AuthToken
 	_, err = r.writer.DoTx(
 		ctx,
@@ -128,6 +134,18 @@ func (r *Repository) CreateAuthToken(ctx context.Context, withIamUser *iam.User,
 			at.AuthMethodId = acct.GetAuthMethodId()
 			at.IamUserId = acct.GetIamUserId()
 
+			timeToLive, _, err := r.lifetimes(ctx, read, at.ScopeId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			// We truncate the expiration time to the nearest second to make testing in different platforms with
+			// different time resolutions easier.
+			expiration, err := ptypes.TimestampProto(time.Now().Add(timeToLive).Truncate(time.Second))
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidTimeStamp))
+			}
+			at.ExpirationTime = &timestamp.Timestamp{Timestamp: expiration}
+
 			newAuthToken = at.clone()
 			if err := newAuthToken.encrypt(ctx, databaseWrapper); err != nil {
 				return errors.Wrap(ctx, err, op)
@@ -189,7 +207,8 @@ func (r *Repository) LookupAuthToken(ctx context.Context, id string, opt ...Opti
 // approximate last accessed time may be updated depending on how long it has been since the last time the token
 // was validated.  If a token is returned it is guaranteed to be valid. For security reasons, the actual token
 // value is not included in the returned AuthToken. If no valid auth token is found nil, nil is returned.
-// All options are ignored.
+// Bound auth tokens are only returned when the client provided with the WithClientIp and
+// WithClientCertificateFingerprint options satisfies the binding. All other options are ignored.
 //
 // NOTE: Do not log or add the token string to any errors to avoid leaking it as it is a secret.
 func (r *Repository) ValidateToken(ctx context.Context, id, token string, opt ...Option) (*AuthToken, error) {
@@ -201,6 +220,8 @@ func (r *Repository) ValidateToken(ctx context.Context, id, token string, opt ..
 		return nil, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
 	}
 
+	opts := getOpts(opt...)
+
 	retAT, err := r.LookupAuthToken(ctx, id, withTokenValue())
 	if err != nil {
 		retAT = nil
@@ -223,11 +244,21 @@ func (r *Repository) ValidateToken(ctx context.Context, id, token string, opt ..
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("last accessed time"), errors.WithCode(errors.InvalidTimeStamp))
 	}
 
+	// The token's scope may have shortened its lifetimes since the token was
+	// issued, so the stored expiration time is not the only bound. The scope's
+	// lifetimes are read with the token, so they don't need to be looked up.
+	timeToLive, timeToStale := r.scopeLifetimes(retAT.GetScopeAuthTokenTimeToLiveSeconds(), retAT.GetScopeAuthTokenTimeToStaleSeconds())
+	if created := retAT.GetCreateTime().GetTimestamp(); created != nil {
+		if scopeExp := created.AsTime().Add(timeToLive); scopeExp.Before(exp) {
+			exp = scopeExp
+		}
+	}
+
 	now := time.Now()
 	sinceLastAccessed := now.Sub(lastAccessed) + timeSkew
 	// TODO (jimlambrt 9/2020) - investigate the need for the timeSkew and see
 	// if it can be eliminated.
-	if now.After(exp.Add(-timeSkew)) || sinceLastAccessed >= r.timeToStaleDuration {
+	if now.After(exp.Add(-timeSkew)) || sinceLastAccessed >= timeToStale {
 		// If the token has expired or has become too stale, delete it from the DB.
 		_, err = r.writer.DoTx(
 			ctx,
@@ -251,6 +282,11 @@ func (r *Repository) ValidateToken(ctx context.Context, id, token string, opt ..
 	if retAT.GetToken() != token {
 		return nil, nil
 	}
+	if !retAT.boundTo(opts.withClientIp, opts.withClientCertFingerprint) {
+		// The token is valid but is being presented by a client other than
+		// the one it was issued to.
+		return nil, nil
+	}
 	// retAT.Token set to empty string so the value is not returned as described in the methods' doc.
 	retAT.Token = ""
 
@@ -290,6 +326,42 @@ func (r *Repository) ValidateToken(ctx context.Context, id, token string, opt ..
 	return retAT, nil
 }
 
+// lifetimes returns the time to live and time to stale durations for auth
+// tokens in the provided scope.  A scope may override the repository's
+// configured durations, but only to shorten them.
+func (r *Repository) lifetimes(ctx context.Context, reader db.Reader, scopeId string) (time.Duration, time.Duration, error) {
+	const op = "authtoken.(Repository).lifetimes"
+	if scopeId == "" || scopeId == scope.Global.String() {
+		// Only org scopes can override the configured durations.
+		return r.timeToLiveDuration, r.timeToStaleDuration, nil
+	}
+	s := iam.AllocScope()
+	s.PublicId = scopeId
+	if err := reader.LookupByPublicId(ctx, &s); err != nil {
+		return 0, 0, errors.Wrap(ctx, err, op, errors.WithMsg("scope lookup"))
+	}
+	timeToLive, timeToStale := r.scopeLifetimes(s.GetAuthTokenTimeToLiveSeconds(), s.GetAuthTokenTimeToStaleSeconds())
+	return timeToLive, timeToStale, nil
+}
+
+// scopeLifetimes returns the time to live and time to stale durations for auth
+// tokens in a scope with the provided overrides, in seconds.  An override of 0
+// means the scope doesn't override the repository's configured duration.
+func (r *Repository) scopeLifetimes(timeToLiveSeconds, timeToStaleSeconds uint32) (time.Duration, time.Duration) {
+	timeToLive, timeToStale := r.timeToLiveDuration, r.timeToStaleDuration
+	if timeToLiveSeconds > 0 {
+		if d := time.Duration(timeToLiveSeconds) * time.Second; d < timeToLive {
+			timeToLive = d
+		}
+	}
+	if timeToStaleSeconds > 0 {
+		if d := time.Duration(timeToStaleSeconds) * time.Second; d < timeToStale {
+			timeToStale = d
+		}
+	}
+	return timeToLive, timeToStale
+}
+
 // ListAuthTokens lists auth tokens in the given scopes and supports the
 // WithLimit option.
 func (r *Repository) ListAuthTokens(ctx context.Context, withScopeIds []string, opt ...Option) ([]*AuthToken, error) {
@@ -363,14 +435,28 @@ func (r *Repository) DeleteAuthToken(ctx context.Context, id string, opt ...Opti
 // IssueAuthToken will retrieve the "pending" token and update it's status to
 // "issued".  If the token has already been issued, an error is returned with a
 // nil token.  If no token is found for the tokenRequestId an error is returned
-// with a nil token.
+// with a nil token.  The token is bound to the client provided with the
+// WithClientIp and WithClientCertificateFingerprint options according to the
+// repository's binding mode.  All other options are ignored.
 //
 // Note: no oplog entries are created for auth token operations (this is intentional).
-func (r *Repository) IssueAuthToken(ctx context.Context, tokenRequestId string) (*AuthToken, error) {
+func (r *Repository) IssueAuthToken(ctx context.Context, tokenRequestId string, opt ...Option) (*AuthToken, error) {
 	const op = "authtoken.(Repository).IssueAuthToken"
 	if tokenRequestId == "" {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing token request id")
 	}
+	opts := getOpts(opt...)
+	binding := allocAuthToken()
+	if err := r.bind(ctx, binding, opts.withClientIp, opts.withClientCertFingerprint); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	fieldMask := []string{"Status"}
+	if binding.ClientIpRange != "" {
+		fieldMask = append(fieldMask, "ClientIpRange")
+	}
+	if binding.ClientCertificateFingerprint != "" {
+		fieldMask = append(fieldMask, "ClientCertificateFingerprint")
+	}
 
 	var at *AuthToken
 	_, err := r.writer.DoTx(
@@ -381,10 +467,12 @@ func (r *Repository) IssueAuthToken(ctx context.Context, tokenRequestId string)
 			at = allocAuthToken()
 			at.PublicId = tokenRequestId
 			at.Status = string(IssuedStatus)
+			at.ClientIpRange = binding.ClientIpRange
+			at.ClientCertificateFingerprint = binding.ClientCertificateFingerprint
 			// note: no oplog operations are created for auth token operations (this is intentional).
 			// Setting the ApproximateLastAccessTime to null through using the null mask allows a defined db's
 			// trigger to set ApproximateLastAccessTime to the commit timestamp.
-			rowsUpdated, err := w.Update(ctx, at, []string{"Status"}, []string{"ApproximateLastAccessTime"}, db.WithWhere("status = ?", PendingStatus))
+			rowsUpdated, err := w.Update(ctx, at, fieldMask, []string{"ApproximateLastAccessTime"}, db.WithWhere("status = ?", PendingStatus))
 			if err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithoutEvent())
 			}
@@ -55,12 +55,14 @@ func TestRepository_New(t *testing.T) {
 				opts: []Option{},
 			},
 			want: &Repository{
-				reader:              rw,
-				writer:              rw,
-				kms:                 kmsCache,
-				limit:               db.DefaultLimit,
-				timeToLiveDuration:  defaultTokenTimeToLiveDuration,
-				timeToStaleDuration: defaultTokenTimeToStaleDuration,
+				reader:                  rw,
+				writer:                  rw,
+				kms:                     kmsCache,
+				limit:                   db.DefaultLimit,
+				timeToLiveDuration:      defaultTokenTimeToLiveDuration,
+				timeToStaleDuration:     defaultTokenTimeToStaleDuration,
+				bindingIpv4PrefixLength: defaultBindingIpv4PrefixLength,
+				bindingIpv6PrefixLength: defaultBindingIpv6PrefixLength,
 			},
 		},
 		{
@@ -74,12 +76,14 @@ func TestRepository_New(t *testing.T) {
 				},
 			},
 			want: &Repository{
-				reader:              rw,
-				writer:              rw,
-				kms:                 kmsCache,
-				limit:               5,
-				timeToLiveDuration:  defaultTokenTimeToLiveDuration,
-				timeToStaleDuration: defaultTokenTimeToStaleDuration,
+				reader:                  rw,
+				writer:                  rw,
+				kms:                     kmsCache,
+				limit:                   5,
+				timeToLiveDuration:      defaultTokenTimeToLiveDuration,
+				timeToStaleDuration:     defaultTokenTimeToStaleDuration,
+				bindingIpv4PrefixLength: defaultBindingIpv4PrefixLength,
+				bindingIpv6PrefixLength: defaultBindingIpv6PrefixLength,
 			},
 		},
 		{
@@ -93,12 +97,14 @@ func TestRepository_New(t *testing.T) {
 				},
 			},
 			want: &Repository{
-				reader:              rw,
-				writer:              rw,
-				kms:                 kmsCache,
-				limit:               db.DefaultLimit,
-				timeToLiveDuration:  1 * time.Hour,
-				timeToStaleDuration: defaultTokenTimeToStaleDuration,
+				reader:                  rw,
+				writer:                  rw,
+				kms:                     kmsCache,
+				limit:                   db.DefaultLimit,
+				timeToLiveDuration:      1 * time.Hour,
+				timeToStaleDuration:     defaultTokenTimeToStaleDuration,
+				bindingIpv4PrefixLength: defaultBindingIpv4PrefixLength,
+				bindingIpv6PrefixLength: defaultBindingIpv6PrefixLength,
 			},
 		},
 		{
@@ -112,12 +118,14 @@ func TestRepository_New(t *testing.T) {
 				},
 			},
 			want: &Repository{
-				reader:              rw,
-				writer:              rw,
-				kms:                 kmsCache,
-				limit:               db.DefaultLimit,
-				timeToStaleDuration: 1 * time.Hour,
-				timeToLiveDuration:  defaultTokenTimeToLiveDuration,
+				reader:                  rw,
+				writer:                  rw,
+				kms:                     kmsCache,
+				limit:                   db.DefaultLimit,
+				timeToStaleDuration:     1 * time.Hour,
+				timeToLiveDuration:      defaultTokenTimeToLiveDuration,
+				bindingIpv4PrefixLength: defaultBindingIpv4PrefixLength,
+				bindingIpv6PrefixLength: defaultBindingIpv6PrefixLength,
 			},
 		},
 
@@ -570,6 +578,208 @@ func TestRepository_ValidateToken_expired(t *testing.T) {
 	}
 }
 
+func TestRepository_ValidateToken_scopeLifetimes(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+
+	tests := []struct {
+		name         string
+		timeToLive   uint32
+		timeToStale  uint32
+		wantReturned bool
+	}{
+		{
+			name:         "no-overrides",
+			wantReturned: true,
+		},
+		{
+			name:         "longer-than-configured",
+			timeToLive:   uint32((2 * defaultTokenTimeToLiveDuration).Seconds()),
+			timeToStale:  uint32((2 * defaultTokenTimeToStaleDuration).Seconds()),
+			wantReturned: true,
+		},
+		{
+			name:         "stale",
+			timeToStale:  1,
+			wantReturned: false,
+		},
+		{
+			name:         "expired",
+			timeToLive:   1,
+			wantReturned: false,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			ctx := context.Background()
+
+			timeSkew = 2 * time.Second
+			t.Cleanup(func() { timeSkew = 0 })
+
+			org, _ := iam.TestScopes(t, iamRepo)
+			var mask []string
+			if tt.timeToLive > 0 {
+				org.AuthTokenTimeToLiveSeconds = tt.timeToLive
+				mask = append(mask, "AuthTokenTimeToLiveSeconds")
+			}
+			if tt.timeToStale > 0 {
+				org.AuthTokenTimeToStaleSeconds = tt.timeToStale
+				mask = append(mask, "AuthTokenTimeToStaleSeconds")
+			}
+			if len(mask) > 0 {
+				_, _, err := iamRepo.UpdateScope(ctx, org, org.GetVersion(), mask)
+				require.NoError(err)
+			}
+
+			baseAT := TestAuthToken(t, conn, kms, org.GetPublicId())
+			aAcct := allocAuthAccount()
+			aAcct.PublicId = baseAT.GetAuthAccountId()
+			require.NoError(rw.LookupByPublicId(ctx, aAcct))
+			iamUser, _, err := iamRepo.LookupUser(ctx, aAcct.GetIamUserId())
+			require.NoError(err)
+
+			repo, err := NewRepository(rw, rw, kms)
+			require.NoError(err)
+			at, err := repo.CreateAuthToken(ctx, iamUser, baseAT.GetAuthAccountId())
+			require.NoError(err)
+
+			got, err := repo.ValidateToken(ctx, at.GetPublicId(), at.GetToken())
+			require.NoError(err)
+			if tt.wantReturned {
+				assert.NotNil(got)
+			} else {
+				assert.Nil(got)
+			}
+		})
+	}
+}
+
+func TestRepository_scopeLifetimes(t *testing.T) {
+	t.Parallel()
+	r := &Repository{
+		timeToLiveDuration:  time.Hour,
+		timeToStaleDuration: 10 * time.Minute,
+	}
+	tests := []struct {
+		name               string
+		timeToLiveSeconds  uint32
+		timeToStaleSeconds uint32
+		wantTimeToLive     time.Duration
+		wantTimeToStale    time.Duration
+	}{
+		{
+			name:            "no-overrides",
+			wantTimeToLive:  time.Hour,
+			wantTimeToStale: 10 * time.Minute,
+		},
+		{
+			name:               "shorter",
+			timeToLiveSeconds:  60,
+			timeToStaleSeconds: 30,
+			wantTimeToLive:     time.Minute,
+			wantTimeToStale:    30 * time.Second,
+		},
+		{
+			name:               "longer",
+			timeToLiveSeconds:  7200,
+			timeToStaleSeconds: 1200,
+			wantTimeToLive:     time.Hour,
+			wantTimeToStale:    10 * time.Minute,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			timeToLive, timeToStale := r.scopeLifetimes(tt.timeToLiveSeconds, tt.timeToStaleSeconds)
+			assert.Equal(t, tt.wantTimeToLive, timeToLive)
+			assert.Equal(t, tt.wantTimeToStale, timeToStale)
+		})
+	}
+}
+
+func TestRepository_ValidateToken_binding(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	tests := []struct {
+		name         string
+		mode         BindingMode
+		issueOpts    []Option
+		validateOpts []Option
+		wantReturned bool
+	}{
+		{
+			name:         "unbound",
+			validateOpts: []Option{WithClientIp("10.0.0.2")},
+			wantReturned: true,
+		},
+		{
+			name:         "ip-match",
+			mode:         ClientIpBindingMode,
+			issueOpts:    []Option{WithClientIp("10.0.0.1")},
+			validateOpts: []Option{WithClientIp("10.0.0.1")},
+			wantReturned: true,
+		},
+		{
+			name:         "ip-mismatch",
+			mode:         ClientIpBindingMode,
+			issueOpts:    []Option{WithClientIp("10.0.0.1")},
+			validateOpts: []Option{WithClientIp("10.0.0.2")},
+			wantReturned: false,
+		},
+		{
+			name:         "certificate-match",
+			mode:         ClientCertificateBindingMode,
+			issueOpts:    []Option{WithClientCertificateFingerprint("abc123")},
+			validateOpts: []Option{WithClientCertificateFingerprint("abc123")},
+			wantReturned: true,
+		},
+		{
+			name:         "certificate-missing",
+			mode:         ClientCertificateBindingMode,
+			issueOpts:    []Option{WithClientCertificateFingerprint("abc123")},
+			wantReturned: false,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			ctx := context.Background()
+
+			baseAT := TestAuthToken(t, conn, kms, org.GetPublicId())
+			aAcct := allocAuthAccount()
+			aAcct.PublicId = baseAT.GetAuthAccountId()
+			require.NoError(rw.LookupByPublicId(ctx, aAcct))
+			iamUser, _, err := iamRepo.LookupUser(ctx, aAcct.GetIamUserId())
+			require.NoError(err)
+
+			repo, err := NewRepository(rw, rw, kms, WithBindingMode(tt.mode))
+			require.NoError(err)
+			at, err := repo.CreateAuthToken(ctx, iamUser, baseAT.GetAuthAccountId(), tt.issueOpts...)
+			require.NoError(err)
+
+			got, err := repo.ValidateToken(ctx, at.GetPublicId(), at.GetToken(), tt.validateOpts...)
+			require.NoError(err)
+			if tt.wantReturned {
+				assert.NotNil(got)
+			} else {
+				assert.Nil(got)
+			}
+		})
+	}
+}
+
 func TestRepository_DeleteAuthToken(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
 	rw := db.New(conn)
@@ -75,6 +75,24 @@ type AuthToken struct {
 	// database.
 	// @inject_tag: `gorm:"default:null"`
 	Status string `protobuf:"bytes,15,opt,name=status,proto3" json:"status,omitempty" gorm:"default:null"`
+	// client_ip_range is the range of client ip addresses, in CIDR notation, the
+	// auth token is bound to. It is empty if the auth token is not bound to a
+	// client ip range.
+	// @inject_tag: `gorm:"default:null"`
+	ClientIpRange string `protobuf:"bytes,16,opt,name=client_ip_range,json=clientIpRange,proto3" json:"client_ip_range,omitempty" gorm:"default:null"`
+	// client_certificate_fingerprint is the hex encoded SHA-256 fingerprint of
+	// the tls client certificate the auth token is bound to. It is empty if the
+	// auth token is not bound to a client certificate.
+	// @inject_tag: `gorm:"default:null"`
+	ClientCertificateFingerprint string `protobuf:"bytes,17,opt,name=client_certificate_fingerprint,json=clientCertificateFingerprint,proto3" json:"client_certificate_fingerprint,omitempty" gorm:"default:null"`
+	// scope_auth_token_time_to_live_seconds is not stored in the backing DB but
+	// it derived from the scope of the linked to auth account.
+	// @inject_tag: gorm:"->"
+	ScopeAuthTokenTimeToLiveSeconds uint32 `protobuf:"varint,18,opt,name=scope_auth_token_time_to_live_seconds,json=scopeAuthTokenTimeToLiveSeconds,proto3" json:"scope_auth_token_time_to_live_seconds,omitempty" gorm:"->"`
+	// scope_auth_token_time_to_stale_seconds is not stored in the backing DB but
+	// it derived from the scope of the linked to auth account.
+	// @inject_tag: gorm:"->"
+	ScopeAuthTokenTimeToStaleSeconds uint32 `protobuf:"varint,19,opt,name=scope_auth_token_time_to_stale_seconds,json=scopeAuthTokenTimeToStaleSeconds,proto3" json:"scope_auth_token_time_to_stale_seconds,omitempty" gorm:"->"`
 }
 
 func (x *AuthToken) Reset() {
@@ -200,6 +218,34 @@ func (x *AuthToken) GetStatus() string {
 	return ""
 }
 
+func (x *AuthToken) GetClientIpRange() string {
+	if x != nil {
+		return x.ClientIpRange
+	}
+	return ""
+}
+
+func (x *AuthToken) GetClientCertificateFingerprint() string {
+	if x != nil {
+		return x.ClientCertificateFingerprint
+	}
+	return ""
+}
+
+func (x *AuthToken) GetScopeAuthTokenTimeToLiveSeconds() uint32 {
+	if x != nil {
+		return x.ScopeAuthTokenTimeToLiveSeconds
+	}
+	return 0
+}
+
+func (x *AuthToken) GetScopeAuthTokenTimeToStaleSeconds() uint32 {
+	if x != nil {
+		return x.ScopeAuthTokenTimeToStaleSeconds
+	}
+	return 0
+}
+
 var File_controller_storage_authtoken_store_v1_authtoken_proto protoreflect.FileDescriptor
 
 var file_controller_storage_authtoken_store_v1_authtoken_proto_rawDesc = []byte{
@@ -212,7 +258,7 @@ var file_controller_storage_authtoken_store_v1_authtoken_proto_rawDesc = []byte{
 	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x61,
 	0x67, 0x65, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2f, 0x76, 0x31, 0x2f,
 	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0xed, 0x04, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a,
+	0xfd, 0x06, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a,
 	0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x12, 0x4b, 0x0a, 0x0b, 0x63, 0x72,
 	0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
@@ -250,7 +296,24 @@ var file_controller_storage_authtoken_store_v1_authtoken_proto_rawDesc = []byte{
 	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
 	0x12, 0x15, 0x0a, 0x06, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42,
+	0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x70, 0x5f, 0x72, 0x61, 0x6e,
+	0x67, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x70, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x44, 0x0a, 0x1e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x69,
+	0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x1c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x4e, 0x0a,
+	0x25, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x6c, 0x69, 0x76, 0x65, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1f, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x69, 0x6d, 0x65,
+	0x54, 0x6f, 0x4c, 0x69, 0x76, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x50, 0x0a,
+	0x26, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x20, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x69, 0x6d,
+	0x65, 0x54, 0x6f, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x42,
 	0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61,
 	0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79,
 	0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x74, 0x6f,
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package base
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"golang.org/x/crypto/acme"
+	"golang.org/x/crypto/acme/autocert"
+)
+
+// SetupAcme creates the ACME manager which provisions and renews the
+// certificates of the API listeners with TLS enabled and no certificate file.
+// It must be called before SetupListeners. Certificates are requested when
+// the first TLS handshake for one of the configured domains happens, and
+// renewed before they expire. If an HTTP challenge address is configured, a
+// listener answering HTTP-01 challenges is started on it; requests for any
+// other path are redirected to HTTPS.
+func (b *Server) SetupAcme(conf *config.Acme) error {
+	const op = "base.(Server).SetupAcme"
+	if conf == nil {
+		return fmt.Errorf("%s: missing acme config", op)
+	}
+	m := &autocert.Manager{
+		Prompt:     autocert.AcceptTOS,
+		Cache:      autocert.DirCache(conf.CacheDir),
+		HostPolicy: autocert.HostWhitelist(conf.Domains...),
+		Email:      conf.Email,
+	}
+	if conf.DirectoryUrl != "" {
+		m.Client = &acme.Client{DirectoryURL: conf.DirectoryUrl}
+	}
+	b.AcmeManager = m
+
+	b.InfoKeys = append(b.InfoKeys, "acme domains")
+	b.Info["acme domains"] = fmt.Sprintf("%v", conf.Domains)
+
+	if conf.HttpChallengeAddress == "" {
+		return nil
+	}
+	ln, err := net.Listen("tcp", conf.HttpChallengeAddress)
+	if err != nil {
+		return fmt.Errorf("Error starting ACME HTTP challenge listener: %w", err)
+	}
+	srv := &http.Server{
+		Handler:           m.HTTPHandler(nil),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		if err := srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			event.WriteError(b.Context, op, err, event.WithInfoMsg("acme http challenge listener stopped"))
+		}
+	}()
+	b.ShutdownFuncs = append(b.ShutdownFuncs, func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return srv.Shutdown(ctx)
+	})
+	b.InfoKeys = append(b.InfoKeys, "acme http challenge addr")
+	b.Info["acme http challenge addr"] = ln.Addr().String()
+	return nil
+}
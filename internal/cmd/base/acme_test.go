@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package base
+
+import (
+	"crypto/tls"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/go-secure-stdlib/listenerutil"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetupAcme(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	b := NewServer(NewCommand(cli.NewMockUi()))
+	t.Cleanup(func() { require.NoError(b.RunShutdownFuncs()) })
+
+	require.Error(b.SetupAcme(nil))
+	require.NoError(b.SetupAcme(&config.Acme{
+		Domains:              []string{"boundary.example.com"},
+		CacheDir:             t.TempDir(),
+		AcceptTermsOfService: true,
+		HttpChallengeAddress: "127.0.0.1:0",
+	}))
+	require.NotNil(b.AcmeManager)
+	assert.Equal("[boundary.example.com]", b.Info["acme domains"])
+
+	// requests other than challenges are redirected to https
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	resp, err := client.Get("http://" + b.Info["acme http challenge addr"] + "/v1/scopes")
+	require.NoError(err)
+	defer resp.Body.Close()
+	assert.Equal(http.StatusFound, resp.StatusCode)
+	assert.Contains(resp.Header.Get("Location"), "https://")
+
+	// API listeners without a certificate file use the ACME manager
+	lnConfig := func(purpose string) *listenerutil.ListenerConfig {
+		return &listenerutil.ListenerConfig{Type: "tcp", Purpose: []string{purpose}, Address: "127.0.0.1:0"}
+	}
+	_, _, _, err = NewListener(lnConfig("api"), nil)
+	require.Error(err)
+	_, _, _, err = NewListener(lnConfig("ops"), nil, WithAcmeManager(b.AcmeManager))
+	require.Error(err)
+
+	ln, props, reloadFunc, err := NewListener(lnConfig("api"), nil, WithAcmeManager(b.AcmeManager))
+	require.NoError(err)
+	t.Cleanup(func() { _ = ln.Close() })
+	assert.Equal("acme", props["tls"])
+	assert.Nil(reloadFunc)
+
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		_ = conn.(*tls.Conn).Handshake()
+		_ = conn.Close()
+	}()
+	// certificates are only requested for the configured domains
+	_, err = tls.Dial("tcp", ln.Addr().String(), &tls.Config{ServerName: "other.example.com"})
+	require.Error(err)
+}
@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package base
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/go-secure-stdlib/strutil"
+)
+
+// CheckAuthorizedAction asks the controller for the actions the caller is
+// allowed to perform in the scope scopeId and returns an error describing
+// them if act is not allowed on resources of type resourceType. If the
+// authorized actions cannot be listed, for instance because the caller is not
+// granted list-authorized-actions in the scope, nil is returned and the
+// request is left for the controller to authorize.
+func (c *Command) CheckAuthorizedAction(client *api.Client, scopeId, resourceType, act string) error {
+	if scopeId == "" || os.Getenv(EnvBoundaryCLISkipAuthorizedActionsCheck) != "" {
+		return nil
+	}
+	result, err := scopes.NewClient(client).ListAuthorizedActions(c.Context, scopeId)
+	if err != nil {
+		return nil
+	}
+	if result.Allowed(resourceType, act) {
+		return nil
+	}
+
+	allowed := strutil.RemoveDuplicates(append(result.AuthorizedActions[resourceType], result.AuthorizedActions["*"]...), false)
+	if len(allowed) == 0 {
+		return fmt.Errorf("You are not authorized to %s %s resources in scope %s, or to perform any other action on them there.", act, resourceType, scopeId)
+	}
+	return fmt.Errorf("You are not authorized to %s %s resources in scope %s. Your authorized actions on them there are: %s.", act, resourceType, scopeId, strings.Join(allowed, ", "))
+}
@@ -16,6 +16,7 @@ import (
 	"strings"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/globals"
@@ -326,6 +327,9 @@ func (c *Command) Client(opt ...Option) (*api.Client, error) {
 		authToken := c.ReadTokenFromKeyring(keyringType, tokenName)
 		if authToken != nil {
 			c.client.SetToken(authToken.Token)
+			if hint := storedTokenExpiryHint(authToken, time.Now()); hint != "" {
+				c.UI.Warn(hint)
+			}
 		}
 	}
 
@@ -30,4 +30,8 @@ const (
 const (
 	EnvBoundaryCLINoColor = `BOUNDARY_CLI_NO_COLOR`
 	EnvBoundaryCLIFormat  = `BOUNDARY_CLI_FORMAT`
+
+	// EnvBoundaryCLISkipAuthorizedActionsCheck disables checking the
+	// caller's authorized actions before performing a request.
+	EnvBoundaryCLISkipAuthorizedActionsCheck = `BOUNDARY_CLI_SKIP_AUTHORIZED_ACTIONS_CHECK`
 )
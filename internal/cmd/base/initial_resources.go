@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
+	credentialplugin "github.com/hashicorp/boundary/internal/plugin/credential"
 	hostplugin "github.com/hashicorp/boundary/internal/plugin/host"
 	"github.com/hashicorp/boundary/internal/target"
 	"github.com/hashicorp/boundary/internal/target/tcp"
@@ -53,7 +54,7 @@ func (b *Server) CreateInitialLoginRole(ctx context.Context) (*iam.Role, error)
 		return nil, fmt.Errorf("error creating role for default generated grants: %w", err)
 	}
 	if _, err := iamRepo.AddRoleGrants(ctx, role.PublicId, role.Version, []string{
-		"id=*;type=scope;actions=list,no-op",
+		"id=*;type=scope;actions=list,no-op,read-login-metadata",
 		"id=*;type=auth-method;actions=authenticate,list",
 		"id={{.Account.Id}};actions=read,change-password",
 		"id=*;type=auth-token;actions=list,read:self,delete:self",
@@ -598,6 +599,52 @@ func (b *Server) RegisterHostPlugin(ctx context.Context, name string, plg plgpb.
 	return plugin, nil
 }
 
+// RegisterCredentialPlugin creates the credential plugin name if it does
+// not exist yet and makes plg available to the credential stores using it.
+func (b *Server) RegisterCredentialPlugin(ctx context.Context, name string, plg plgpb.CredentialPluginServiceClient, opt ...credentialplugin.Option) (*credentialplugin.Plugin, error) {
+	if name == "" {
+		return nil, fmt.Errorf("no name provided when creating plugin.")
+	}
+	rw := db.New(b.Database)
+
+	kmsCache, err := kms.New(ctx, rw, rw)
+	if err != nil {
+		return nil, fmt.Errorf("error creating kms cache: %w", err)
+	}
+	if err := kmsCache.AddExternalWrappers(
+		ctx,
+		kms.WithRootWrapper(b.RootKms),
+	); err != nil {
+		return nil, fmt.Errorf("error adding config keys to kms: %w", err)
+	}
+
+	cpRepo, err := credentialplugin.NewRepository(ctx, rw, rw, kmsCache)
+	if err != nil {
+		return nil, fmt.Errorf("error creating credential plugin repository: %w", err)
+	}
+
+	plugin, err := cpRepo.LookupPluginByName(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("error looking up credential plugin by name: %w", err)
+	}
+
+	if plugin == nil {
+		opt = append(opt, credentialplugin.WithName(name))
+		plugin = credentialplugin.NewPlugin(opt...)
+		plugin, err = cpRepo.CreatePlugin(ctx, plugin, opt...)
+		if err != nil {
+			return nil, fmt.Errorf("error creating credential plugin: %w", err)
+		}
+	}
+
+	if b.CredentialPlugins == nil {
+		b.CredentialPlugins = make(map[string]plgpb.CredentialPluginServiceClient)
+	}
+	b.CredentialPlugins[plugin.GetPublicId()] = plg
+
+	return plugin, nil
+}
+
 // unprivilegedDevUserRoleSetup adds dev user to the role that grants
 // list/read:self/cancel:self on sessions and read:self/delete:self/list on
 // tokens. It also creates a role with an `authorize-session` grant for the
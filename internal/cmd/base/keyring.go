@@ -10,8 +10,10 @@ import (
 	"os"
 	"runtime"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/boundary/api/authtokens"
+	"github.com/hashicorp/boundary/globals"
 	nkeyring "github.com/jefferai/keyring"
 	"github.com/pkg/errors"
 	zkeyring "github.com/zalando/go-keyring"
@@ -178,3 +180,34 @@ func TokenIdFromToken(token string) (string, error) {
 	}
 	return strings.Join(split[0:2], "_"), nil
 }
+
+// tokenExpiryWarningPeriod is how long before its expiration a stored auth
+// token is reported as about to expire.
+const tokenExpiryWarningPeriod = 10 * time.Minute
+
+// storedTokenExpiryHint returns a hint to re-authenticate if the stored auth
+// token has expired or expires soon, or an empty string otherwise. Auth tokens
+// can't be refreshed, so re-authenticating is the only way to get a new one.
+func storedTokenExpiryHint(authToken *authtokens.AuthToken, now time.Time) string {
+	if authToken == nil || authToken.ExpirationTime.IsZero() {
+		return ""
+	}
+	authenticate := "boundary authenticate"
+	switch {
+	case strings.HasPrefix(authToken.AuthMethodId, globals.PasswordAuthMethodPrefix+"_"):
+		authenticate = fmt.Sprintf("boundary authenticate password -auth-method-id %s", authToken.AuthMethodId)
+	case strings.HasPrefix(authToken.AuthMethodId, globals.OidcAuthMethodPrefix+"_"):
+		authenticate = fmt.Sprintf("boundary authenticate oidc -auth-method-id %s", authToken.AuthMethodId)
+	case strings.HasPrefix(authToken.AuthMethodId, globals.LdapAuthMethodPrefix+"_"):
+		authenticate = fmt.Sprintf("boundary authenticate ldap -auth-method-id %s", authToken.AuthMethodId)
+	}
+	switch remaining := authToken.ExpirationTime.Sub(now); {
+	case remaining <= 0:
+		return fmt.Sprintf("The stored auth token expired at %s. Run %q to authenticate again.",
+			authToken.ExpirationTime.Local().Format(time.RFC3339), authenticate)
+	case remaining <= tokenExpiryWarningPeriod:
+		return fmt.Sprintf("The stored auth token expires in %s. Run %q to authenticate again.",
+			remaining.Round(time.Second), authenticate)
+	}
+	return ""
+}
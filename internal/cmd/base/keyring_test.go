@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package base
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/api/authtokens"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStoredTokenExpiryHint(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name      string
+		token     *authtokens.AuthToken
+		wantHints []string
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name:  "no-expiration",
+			token: &authtokens.AuthToken{AuthMethodId: "ampw_1234567890"},
+		},
+		{
+			name:  "not-expiring",
+			token: &authtokens.AuthToken{AuthMethodId: "ampw_1234567890", ExpirationTime: now.Add(time.Hour)},
+		},
+		{
+			name:      "expiring",
+			token:     &authtokens.AuthToken{AuthMethodId: "amoidc_1234567890", ExpirationTime: now.Add(5 * time.Minute)},
+			wantHints: []string{"expires in 5m0s", "boundary authenticate oidc -auth-method-id amoidc_1234567890"},
+		},
+		{
+			name:      "expired",
+			token:     &authtokens.AuthToken{AuthMethodId: "ampw_1234567890", ExpirationTime: now.Add(-time.Minute)},
+			wantHints: []string{"expired at", "boundary authenticate password -auth-method-id ampw_1234567890"},
+		},
+		{
+			name:      "unknown-auth-method",
+			token:     &authtokens.AuthToken{ExpirationTime: now.Add(-time.Minute)},
+			wantHints: []string{`"boundary authenticate"`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := storedTokenExpiryHint(tt.token, now)
+			if len(tt.wantHints) == 0 {
+				assert.Empty(t, got)
+				return
+			}
+			for _, h := range tt.wantHints {
+				assert.Contains(t, got, h)
+			}
+		})
+	}
+}
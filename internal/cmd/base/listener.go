@@ -8,7 +8,6 @@ import (
 	"fmt"
 	"net"
 	"net/http"
-	"strings"
 	"time"
 
 	// We must import sha512 so that it registers with the runtime so that
@@ -16,6 +15,8 @@ import (
 	_ "crypto/sha512"
 	"crypto/tls"
 
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/util"
 	"github.com/hashicorp/go-secure-stdlib/listenerutil"
 	"github.com/hashicorp/go-secure-stdlib/reloadutil"
 	"github.com/mitchellh/cli"
@@ -31,6 +32,10 @@ type ServerListener struct {
 	ClusterListener net.Listener
 	ProxyListener   net.Listener
 	OpsListener     net.Listener
+
+	// TlsReloadFunc reloads the listener's TLS certificate and key from disk.
+	// It is nil if TLS is disabled for the listener.
+	TlsReloadFunc reloadutil.ReloadFunc
 }
 
 type WorkerAuthInfo struct {
@@ -54,7 +59,9 @@ var BuiltinListeners = map[string]ListenerFactory{
 
 // New creates a new listener of the given type with the given
 // configuration. The type is looked up in the BuiltinListeners map.
-func NewListener(l *listenerutil.ListenerConfig, ui cli.Ui) (net.Listener, map[string]string, reloadutil.ReloadFunc, error) {
+func NewListener(l *listenerutil.ListenerConfig, ui cli.Ui, opt ...Option) (net.Listener, map[string]string, reloadutil.ReloadFunc, error) {
+	opts := getOpts(opt...)
+
 	f, ok := BuiltinListeners[l.Type]
 	if !ok {
 		return nil, nil, nil, fmt.Errorf("unknown listener type: %q", l.Type)
@@ -104,6 +111,20 @@ func NewListener(l *listenerutil.ListenerConfig, ui cli.Ui) (net.Listener, map[s
 		return ln, props, nil, nil
 	}
 
+	if l.TLSCertFile == "" && purpose == "api" && opts.withAcmeManager != nil {
+		tlsConfig := opts.withAcmeManager.TLSConfig()
+		tlsConfig.MinVersion = tls.VersionTLS12
+		if l.TLSMinVersion == "tls13" {
+			tlsConfig.MinVersion = tls.VersionTLS13
+		}
+		if l.TLSMaxVersion == "tls12" {
+			tlsConfig.MaxVersion = tls.VersionTLS12
+		}
+		tlsConfig.CipherSuites = l.TLSCipherSuites
+		props["tls"] = "acme"
+		return tls.NewListener(ln, tlsConfig), props, nil, nil
+	}
+
 	if l.TLSCertFile == "" {
 		return nil, nil, nil, fmt.Errorf("tls not disabled for listener at address %q with purpose %q but no certificate file supplied", finalAddr, purpose)
 	}
@@ -137,9 +158,14 @@ func tcpListenerFactory(purpose string, l *listenerutil.ListenerConfig, ui cli.U
 		}
 	}
 
-	host, port, err := net.SplitHostPort(l.Address)
+	family, err := config.ListenerAddressFamily(l)
+	if err != nil {
+		return "", nil, err
+	}
+
+	host, port, err := util.SplitHostPort(l.Address)
 	if err != nil {
-		if strings.Contains(err.Error(), "missing port") {
+		if errors.Is(err, util.ErrMissingPort) {
 			switch purpose {
 			case "api":
 				port = "9200"
@@ -152,7 +178,6 @@ func tcpListenerFactory(purpose string, l *listenerutil.ListenerConfig, ui cli.U
 			default:
 				return "", nil, errors.New("no purpose provided for listener and no port discoverable")
 			}
-			host = l.Address
 		} else {
 			return "", nil, fmt.Errorf("error splitting host/port: %w", err)
 		}
@@ -167,10 +192,31 @@ func tcpListenerFactory(purpose string, l *listenerutil.ListenerConfig, ui cli.U
 
 	bindProto := "tcp"
 
-	// If they've passed 0.0.0.0, we only want to bind on IPv4
-	// rather than golang's dual stack default
-	if strings.HasPrefix(l.Address, "0.0.0.0:") || l.Address == "0.0.0.0" {
+	switch family {
+	case "":
+		// If they've passed 0.0.0.0, we only want to bind on IPv4
+		// rather than golang's dual stack default
+		if host == "0.0.0.0" {
+			bindProto = "tcp4"
+		}
+	case config.AddressFamilyIpv4:
 		bindProto = "tcp4"
+		if ip := net.ParseIP(host); ip != nil && ip.IsUnspecified() {
+			host = "0.0.0.0"
+		}
+	case config.AddressFamilyIpv6:
+		// For an unspecified address golang sets IPV6_V6ONLY on tcp6 sockets,
+		// so IPv4 clients are not accepted
+		bindProto = "tcp6"
+		if ip := net.ParseIP(host); ip != nil && ip.IsUnspecified() {
+			host = "::"
+		}
+	case config.AddressFamilyDual:
+		// An unspecified IPv6 address on a tcp socket accepts both IPv4 and
+		// IPv6 clients
+		if ip := net.ParseIP(host); ip != nil && ip.IsUnspecified() {
+			host = "::"
+		}
 	}
 
 	if l.RandomPort {
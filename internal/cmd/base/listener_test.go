@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package base
+
+import (
+	"net"
+	"testing"
+
+	"github.com/hashicorp/go-secure-stdlib/listenerutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTcpListenerFactoryAddressFamily(t *testing.T) {
+	ln, err := net.Listen("tcp6", "[::1]:0")
+	if err != nil {
+		t.Skip("IPv6 is not available")
+	}
+	require.NoError(t, ln.Close())
+
+	tests := []struct {
+		name          string
+		address       string
+		family        string
+		wantHost      string
+		wantIpv4Dial  bool
+		wantIpv6Dial  bool
+		wantErrSubstr string
+	}{
+		{
+			name:         "default-ipv4-unspecified",
+			address:      "0.0.0.0",
+			wantHost:     "0.0.0.0",
+			wantIpv4Dial: true,
+		},
+		{
+			name:         "ipv4",
+			address:      "::",
+			family:       "ipv4",
+			wantHost:     "0.0.0.0",
+			wantIpv4Dial: true,
+		},
+		{
+			name:         "ipv6",
+			address:      "0.0.0.0",
+			family:       "ipv6",
+			wantHost:     "::",
+			wantIpv6Dial: true,
+		},
+		{
+			name:         "dual",
+			address:      "0.0.0.0",
+			family:       "dual",
+			wantHost:     "::",
+			wantIpv4Dial: true,
+			wantIpv6Dial: true,
+		},
+		{
+			name:         "bracketed-ipv6-without-port",
+			address:      "[::1]",
+			wantHost:     "::1",
+			wantIpv6Dial: true,
+		},
+		{
+			name:          "invalid-family",
+			address:       "127.0.0.1",
+			family:        "ipv5",
+			wantErrSubstr: "Invalid listener address_family",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			l := &listenerutil.ListenerConfig{
+				Type:       "tcp",
+				Address:    tt.address,
+				RandomPort: true,
+				RawConfig:  map[string]any{},
+			}
+			if tt.family != "" {
+				l.RawConfig["address_family"] = tt.family
+			}
+			addr, ln, err := tcpListenerFactory("api", l, nil)
+			if tt.wantErrSubstr != "" {
+				require.Error(err)
+				assert.Contains(err.Error(), tt.wantErrSubstr)
+				return
+			}
+			require.NoError(err)
+			defer ln.Close()
+
+			host, _, err := net.SplitHostPort(addr)
+			require.NoError(err)
+			assert.Equal(tt.wantHost, host)
+
+			_, port, err := net.SplitHostPort(ln.Addr().String())
+			require.NoError(err)
+			for _, dial := range []struct {
+				host string
+				want bool
+			}{
+				{host: "127.0.0.1", want: tt.wantIpv4Dial},
+				{host: "::1", want: tt.wantIpv6Dial},
+			} {
+				conn, err := net.Dial("tcp", net.JoinHostPort(dial.host, port))
+				if err == nil {
+					conn.Close()
+				}
+				assert.Equal(dial.want, err == nil, "dialing %s", dial.host)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package base
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/observability/event"
+)
+
+// tlsFileState is what is compared to find out whether a certificate or key
+// file changed.
+type tlsFileState struct {
+	modTime time.Time
+	size    int64
+}
+
+func statTlsFile(path string) (tlsFileState, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return tlsFileState{}, err
+	}
+	return tlsFileState{modTime: fi.ModTime(), size: fi.Size()}, nil
+}
+
+// listenerTlsWatcher reloads the certificates of listeners with TLS enabled
+// when their certificate or key files change, so that short-lived
+// certificates renewed on disk are picked up without a restart.
+type listenerTlsWatcher struct {
+	listeners []*ServerListener
+	// files holds the state of the certificate and key files of each listener
+	// as of its last successful load.
+	files map[*ServerListener][2]tlsFileState
+}
+
+func newListenerTlsWatcher(listeners []*ServerListener) *listenerTlsWatcher {
+	w := &listenerTlsWatcher{files: make(map[*ServerListener][2]tlsFileState)}
+	for _, ln := range listeners {
+		if ln.TlsReloadFunc == nil {
+			continue
+		}
+		w.listeners = append(w.listeners, ln)
+		// A file that cannot be read now is seen as changed once it can be.
+		cert, _ := statTlsFile(ln.Config.TLSCertFile)
+		key, _ := statTlsFile(ln.Config.TLSKeyFile)
+		w.files[ln] = [2]tlsFileState{cert, key}
+	}
+	return w
+}
+
+// check reloads the certificate of each listener whose certificate or key
+// file changed since it was last loaded. A failed reload, for instance
+// because only one of the files has been replaced yet, is retried on the
+// next check.
+func (w *listenerTlsWatcher) check(ctx context.Context) {
+	const op = "base.(listenerTlsWatcher).check"
+	for _, ln := range w.listeners {
+		cert, err := statTlsFile(ln.Config.TLSCertFile)
+		if err != nil {
+			continue
+		}
+		key, err := statTlsFile(ln.Config.TLSKeyFile)
+		if err != nil {
+			continue
+		}
+		current := [2]tlsFileState{cert, key}
+		if current == w.files[ln] {
+			continue
+		}
+		if err := ln.TlsReloadFunc(); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error reloading listener tls certificate", "address", ln.Config.Address, "cert_file", ln.Config.TLSCertFile))
+			continue
+		}
+		w.files[ln] = current
+
+		args := []any{"purpose", ln.Config.Purpose, "address", ln.Config.Address, "cert_file", ln.Config.TLSCertFile}
+		if notAfter, ok := certificateNotAfter(ln.Config.TLSCertFile); ok {
+			args = append(args, "not_after", notAfter.Format(time.RFC3339))
+		}
+		event.WriteSysEvent(ctx, op, "reloaded rotated listener tls certificate", args...)
+	}
+}
+
+// certificateNotAfter returns the expiration time of the first certificate in
+// the PEM file.
+func certificateNotAfter(path string) (time.Time, bool) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return time.Time{}, false
+	}
+	for {
+		var block *pem.Block
+		block, b = pem.Decode(b)
+		if block == nil {
+			return time.Time{}, false
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return time.Time{}, false
+		}
+		return cert.NotAfter, true
+	}
+}
+
+// StartListenerTlsWatcher checks the certificate and key files of the
+// listeners with TLS enabled at the given interval, reloading a listener's
+// certificate when its files change, until the server's context is done.
+func (b *Server) StartListenerTlsWatcher(interval time.Duration) {
+	w := newListenerTlsWatcher(b.Listeners)
+	if interval <= 0 || len(w.listeners) == 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-b.Context.Done():
+				return
+			case <-ticker.C:
+				w.check(b.Context)
+			}
+		}
+	}()
+}
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package base
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-secure-stdlib/listenerutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testWriteCertAndKey(t *testing.T, certFile, keyFile string, notAfter time.Time) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0o600))
+}
+
+func TestListenerTlsWatcher(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	testWriteCertAndKey(t, certFile, keyFile, time.Now().Add(time.Hour))
+
+	var reloads int
+	var reloadErr error
+	ln := &ServerListener{
+		Config: &listenerutil.ListenerConfig{
+			Purpose:     []string{"api"},
+			TLSCertFile: certFile,
+			TLSKeyFile:  keyFile,
+		},
+		TlsReloadFunc: func() error {
+			if reloadErr != nil {
+				return reloadErr
+			}
+			reloads++
+			return nil
+		},
+	}
+	tlsDisabled := &ServerListener{Config: &listenerutil.ListenerConfig{Purpose: []string{"cluster"}}}
+	w := newListenerTlsWatcher([]*ServerListener{ln, tlsDisabled})
+	require.Len(w.listeners, 1)
+
+	// nothing changed
+	w.check(ctx)
+	assert.Equal(0, reloads)
+
+	// rotated files are reloaded once
+	notAfter := time.Now().Add(2 * time.Hour)
+	testWriteCertAndKey(t, certFile, keyFile, notAfter)
+	later := time.Now().Add(time.Minute)
+	require.NoError(os.Chtimes(certFile, later, later))
+	w.check(ctx)
+	assert.Equal(1, reloads)
+	w.check(ctx)
+	assert.Equal(1, reloads)
+
+	got, ok := certificateNotAfter(certFile)
+	require.True(ok)
+	assert.Equal(notAfter.Truncate(time.Second).UTC(), got.UTC())
+
+	// a failed reload is retried until it succeeds
+	reloadErr = errors.New("key does not match certificate")
+	later = later.Add(time.Minute)
+	require.NoError(os.Chtimes(keyFile, later, later))
+	w.check(ctx)
+	assert.Equal(1, reloads)
+	reloadErr = nil
+	w.check(ctx)
+	assert.Equal(2, reloads)
+
+	// missing files are not reloaded
+	require.NoError(os.Remove(keyFile))
+	w.check(ctx)
+	assert.Equal(2, reloads)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/boundary/internal/observability/event"
 	"github.com/hashicorp/boundary/sdk/pbs/plugin"
 	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"golang.org/x/crypto/acme/autocert"
 )
 
 // getOpts - iterate the inbound Options and return a struct.
@@ -44,6 +45,7 @@ type Options struct {
 	withHostPlugin                     func() (string, plugin.HostPluginServiceClient)
 	withEventGating                    bool
 	withSkipWorkerAuthKmsInstantiation bool
+	withAcmeManager                    *autocert.Manager
 }
 
 func getDefaultOptions() Options {
@@ -200,3 +202,11 @@ func WithSkipWorkerAuthKmsInstantiation(with bool) Option {
 		o.withSkipWorkerAuthKmsInstantiation = with
 	}
 }
+
+// WithAcmeManager provides the ACME manager used for the certificates of API
+// listeners with TLS enabled and no certificate file
+func WithAcmeManager(m *autocert.Manager) Option {
+	return func(o *Options) {
+		o.withAcmeManager = m
+	}
+}
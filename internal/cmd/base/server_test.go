@@ -102,6 +102,11 @@ func TestServer_SetupKMSes_Purposes(t *testing.T) {
 			purposes:        []string{globals.KmsPurposeRecovery, globals.KmsPurposeRecovery},
 			wantErrContains: fmt.Sprintf("Duplicate KMS block for purpose '%s'", globals.KmsPurposeRecovery),
 		},
+		{
+			name:            "audit sink without key id",
+			purposes:        []string{globals.KmsPurposeAuditSink},
+			wantErrContains: fmt.Sprintf("KMS block for purpose '%s' must have a key ID", globals.KmsPurposeAuditSink),
+		},
 	}
 	logger := hclog.Default()
 	serLock := new(sync.Mutex)
@@ -143,6 +148,75 @@ func TestServer_SetupKMSes_Purposes(t *testing.T) {
 	}
 }
 
+func TestServer_SetupKMSes_AuditSink(t *testing.T) {
+	t.Parallel()
+	logger := hclog.Default()
+	auditSinkKms := func(keyId string) *configutil.KMS {
+		return &configutil.KMS{
+			Type:    "aead",
+			Purpose: []string{globals.KmsPurposeAuditSink},
+			Config: map[string]string{
+				"key_id": keyId,
+			},
+		}
+	}
+	eventerConfig := func() *event.EventerConfig {
+		return &event.EventerConfig{
+			AuditEnabled: true,
+			Sinks: []*event.SinkConfig{
+				{
+					Name:       "compliance",
+					EventTypes: []event.Type{event.AuditType},
+					Type:       event.StderrSink,
+					Format:     event.JSONSinkFormat,
+					AuditConfig: &event.AuditConfig{
+						KmsKeyId: "compliance",
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		kmses           []*configutil.KMS
+		wantErrContains string
+	}{
+		{
+			name:  "valid",
+			kmses: []*configutil.KMS{auditSinkKms("compliance"), auditSinkKms("other")},
+		},
+		{
+			name:            "missing-kms",
+			kmses:           []*configutil.KMS{auditSinkKms("other")},
+			wantErrContains: "Event sink audit config references KMS key ID 'compliance'",
+		},
+		{
+			name:            "duplicate-key-id",
+			kmses:           []*configutil.KMS{auditSinkKms("compliance"), auditSinkKms("compliance")},
+			wantErrContains: fmt.Sprintf("Duplicate KMS block for purpose '%s' and key ID 'compliance'", globals.KmsPurposeAuditSink),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			s := NewServer(&Command{Context: context.Background()})
+			require.NoError(s.SetupEventing(logger, new(sync.Mutex), "setup-kms-testing", WithEventerConfig(eventerConfig())))
+			err := s.SetupKMSes(s.Context, cli.NewMockUi(), &config.Config{SharedConfig: &configutil.SharedConfig{Seals: tt.kmses}})
+			if tt.wantErrContains != "" {
+				require.Error(err)
+				assert.Contains(err.Error(), tt.wantErrContains)
+				return
+			}
+			require.NoError(err)
+			assert.Len(s.AuditSinkKms, len(tt.kmses))
+			assert.NotNil(s.AuditSinkKms["compliance"])
+		})
+	}
+}
+
 func TestServer_SetupKMSes_RootMigration(t *testing.T) {
 	t.Parallel()
 	t.Run("correctly-pools-root-and-previous", func(t *testing.T) {
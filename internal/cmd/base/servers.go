@@ -48,6 +48,7 @@ import (
 	"github.com/hashicorp/go-secure-stdlib/strutil"
 	"github.com/mitchellh/cli"
 	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/crypto/acme/autocert"
 	"google.golang.org/grpc/grpclog"
 )
 
@@ -83,6 +84,14 @@ type Server struct {
 	Kms                  *kms.Kms
 	SecureRandomReader   io.Reader
 
+	// AuditSinkKms are the wrappers of the kms blocks with the audit-sink
+	// purpose, keyed by their key id
+	AuditSinkKms map[string]wrapping.Wrapper
+
+	// AcmeManager provisions the certificates of API listeners from an ACME
+	// certificate authority, if configured
+	AcmeManager *autocert.Manager
+
 	PrometheusRegisterer prometheus.Registerer
 
 	ReloadFuncsLock *sync.RWMutex
@@ -125,6 +134,10 @@ type Server struct {
 	EnabledPlugins []EnabledPlugin
 	HostPlugins    map[string]plgpb.HostPluginServiceClient
 
+	// CredentialPlugins is a map from credential plugin resource id to the
+	// client of the plugin.
+	CredentialPlugins map[string]plgpb.CredentialPluginServiceClient
+
 	DevOidcSetup oidcSetup
 
 	DatabaseUrl                     string
@@ -462,7 +475,11 @@ func (b *Server) SetupListeners(ui cli.Ui, config *configutil.SharedConfig, allo
 			}
 		}
 
-		ln, props, reloadFunc, err := NewListener(lnConfig, ui)
+		var lnOpts []Option
+		if b.AcmeManager != nil {
+			lnOpts = append(lnOpts, WithAcmeManager(b.AcmeManager))
+		}
+		ln, props, reloadFunc, err := NewListener(lnConfig, ui, lnOpts...)
 		if err != nil {
 			return fmt.Errorf("Error initializing listener of type %s: %w", lnConfig.Type, err)
 		}
@@ -500,8 +517,7 @@ func (b *Server) SetupListeners(ui cli.Ui, config *configutil.SharedConfig, allo
 		if lnConfig.MaxRequestSize == 0 {
 			lnConfig.MaxRequestSize = globals.DefaultMaxRequestSize
 		}
-		// TODO: We don't actually limit this yet.
-		// props["max_request_size"] = fmt.Sprintf("%d", lnConfig.MaxRequestSize)
+		props["max_request_size"] = fmt.Sprintf("%d", lnConfig.MaxRequestSize)
 
 		if lnConfig.MaxRequestDuration == 0 {
 			lnConfig.MaxRequestDuration = globals.DefaultMaxRequestDuration
@@ -509,7 +525,8 @@ func (b *Server) SetupListeners(ui cli.Ui, config *configutil.SharedConfig, allo
 		props["max_request_duration"] = lnConfig.MaxRequestDuration.String()
 
 		serverListener := &ServerListener{
-			Config: lnConfig,
+			Config:        lnConfig,
+			TlsReloadFunc: reloadFunc,
 		}
 
 		switch purpose {
@@ -545,10 +562,10 @@ func (b *Server) SetupListeners(ui cli.Ui, config *configutil.SharedConfig, allo
 
 // SetupKMSes takes in a parsed config, does some minor checking on purposes,
 // and sends each off to configutil to instantiate a wrapper.
-func (b *Server) SetupKMSes(ctx context.Context, ui cli.Ui, config *config.Config, opt ...Option) error {
+func (b *Server) SetupKMSes(ctx context.Context, ui cli.Ui, conf *config.Config, opt ...Option) error {
 	opts := getOpts(opt...)
 
-	sharedConfig := config.SharedConfig
+	sharedConfig := conf.SharedConfig
 	var pluginLogger hclog.Logger
 	var err error
 	var previousRootKms wrapping.Wrapper
@@ -565,15 +582,28 @@ func (b *Server) SetupKMSes(ctx context.Context, ui cli.Ui, config *config.Confi
 			case globals.KmsPurposeRoot,
 				globals.KmsPurposePreviousRoot,
 				globals.KmsPurposeConfig,
-				globals.KmsPurposeWorkerAuthStorage:
+				globals.KmsPurposeWorkerAuthStorage,
+				globals.KmsPurposeAuditSink:
 			case globals.KmsPurposeRecovery:
-				if config.Controller != nil && config.DevRecoveryKey != "" {
-					kms.Config["key"] = config.DevRecoveryKey
+				if conf.Controller != nil && conf.DevRecoveryKey != "" {
+					kms.Config["key"] = conf.DevRecoveryKey
 				}
 			default:
 				return fmt.Errorf("Unknown KMS purpose %q", kms.Purpose)
 			}
 
+			// Resolve references to secrets, such as credentials, so they
+			// do not need to be in plaintext in the config file
+			for k, v := range kms.Config {
+				resolved, err := config.ParseSecretPath(v)
+				switch {
+				case err == nil:
+					kms.Config[k] = resolved
+				case !errors.Is(err, parseutil.ErrNotAUrl):
+					return fmt.Errorf("Error resolving %q of KMS of purpose %q: %w", k, purpose, err)
+				}
+			}
+
 			if pluginLogger == nil {
 				pluginLogger, err = event.NewHclogLogger(b.Context, b.Eventer)
 				if err != nil {
@@ -593,7 +623,7 @@ func (b *Server) SetupKMSes(ctx context.Context, ui cli.Ui, config *config.Confi
 				configutil.WithPluginOptions(
 					pluginutil.WithPluginsMap(kms_plugin_assets.BuiltinKmsPlugins()),
 					pluginutil.WithPluginsFilesystem(kms_plugin_assets.KmsPluginPrefix, kms_plugin_assets.FileSystem()),
-					pluginutil.WithPluginExecutionDirectory(config.Plugins.ExecutionDir),
+					pluginutil.WithPluginExecutionDirectory(conf.Plugins.ExecutionDir),
 				),
 				configutil.WithLogger(pluginLogger.Named(kms.Type).With("purpose", purpose)),
 			)
@@ -651,6 +681,21 @@ func (b *Server) SetupKMSes(ctx context.Context, ui cli.Ui, config *config.Confi
 					return fmt.Errorf("Duplicate KMS block for purpose '%s'. You may need to remove all but the last KMS block for this purpose.", purpose)
 				}
 				b.RecoveryKms = wrapper
+			case globals.KmsPurposeAuditSink:
+				keyId, err := wrapper.KeyId(ctx)
+				if err != nil {
+					return fmt.Errorf("Error getting key id of KMS block for purpose '%s': %w", purpose, err)
+				}
+				if keyId == "" {
+					return fmt.Errorf("KMS block for purpose '%s' must have a key ID", purpose)
+				}
+				if b.AuditSinkKms == nil {
+					b.AuditSinkKms = map[string]wrapping.Wrapper{}
+				}
+				if _, ok := b.AuditSinkKms[keyId]; ok {
+					return fmt.Errorf("Duplicate KMS block for purpose '%s' and key ID '%s'.", purpose, keyId)
+				}
+				b.AuditSinkKms[keyId] = wrapper
 			case globals.KmsPurposeConfig:
 				// Do nothing, can be set in same file but not needed at runtime
 				continue
@@ -679,8 +724,22 @@ func (b *Server) SetupKMSes(ctx context.Context, ui cli.Ui, config *config.Confi
 		b.RootKms = mw
 	}
 
+	// event sinks which use their own kms key for audit events need it before
+	// they can encrypt or hmac their audit events
+	if b.Eventer != nil {
+		for _, keyId := range b.Eventer.AuditKmsKeyIds() {
+			w, ok := b.AuditSinkKms[keyId]
+			if !ok {
+				return fmt.Errorf("Event sink audit config references KMS key ID '%s', but there is no KMS block for purpose '%s' with that key ID", keyId, globals.KmsPurposeAuditSink)
+			}
+			if err := b.Eventer.RotateSinkAuditWrapper(ctx, keyId, w); err != nil {
+				return fmt.Errorf("Error rotating event sink audit wrapper: %w", err)
+			}
+		}
+	}
+
 	// prepare a secure random reader
-	b.SecureRandomReader, err = configutil.CreateSecureRandomReaderFunc(config.SharedConfig, b.RootKms)
+	b.SecureRandomReader, err = configutil.CreateSecureRandomReaderFunc(conf.SharedConfig, b.RootKms)
 	if err != nil {
 		return err
 	}
@@ -689,7 +748,7 @@ func (b *Server) SetupKMSes(ctx context.Context, ui cli.Ui, config *config.Confi
 	// here
 	b.Info["mlock"] = fmt.Sprintf(
 		"supported: %v, enabled: %v",
-		mlock.Supported(), !config.DisableMlock && mlock.Supported())
+		mlock.Supported(), !conf.DisableMlock && mlock.Supported())
 	b.InfoKeys = append(b.InfoKeys, "mlock")
 
 	return nil
@@ -235,6 +235,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}, nil
 		},
+		"auth-methods fetch-certificates ldap": func() (cli.Command, error) {
+			return &authmethodscmd.LdapCommand{
+				Command: base.NewCommand(ui),
+				Func:    "fetch-certificates",
+			}, nil
+		},
 		"auth-methods change-state oidc": func() (cli.Command, error) {
 			return &authmethodscmd.OidcCommand{
 				Command: base.NewCommand(ui),
@@ -408,6 +414,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "create",
 			}, nil
 		},
+		"credential-libraries create azure-secret": func() (cli.Command, error) {
+			return &credentiallibrariescmd.AzureSecretCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
 		"credential-libraries update": func() (cli.Command, error) {
 			return &credentiallibrariescmd.Command{
 				Command: base.NewCommand(ui),
@@ -432,6 +444,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}, nil
 		},
+		"credential-libraries update azure-secret": func() (cli.Command, error) {
+			return &credentiallibrariescmd.AzureSecretCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
 
 		"credential-stores": func() (cli.Command, error) {
 			return &credentialstorescmd.Command{
@@ -474,6 +492,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "create",
 			}, nil
 		},
+		"credential-stores create azure": func() (cli.Command, error) {
+			return &credentialstorescmd.AzureCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
 		"credential-stores update": func() (cli.Command, error) {
 			return &credentialstorescmd.Command{
 				Command: base.NewCommand(ui),
@@ -492,6 +516,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}, nil
 		},
+		"credential-stores update azure": func() (cli.Command, error) {
+			return &credentialstorescmd.AzureCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
 
 		"credentials": func() (cli.Command, error) {
 			return &credentialscmd.Command{
@@ -540,6 +570,22 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "create",
 			}, nil
 		},
+		"credentials create x509-certificate": func() (cli.Command, error) {
+			return &credentialscmd.X509CertificateCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
+		"credentials batch-create": func() (cli.Command, error) {
+			return &credentialscmd.BatchCreateCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
+		"credentials import": func() (cli.Command, error) {
+			return &credentialscmd.ImportCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
 		"credentials update": func() (cli.Command, error) {
 			return &credentialscmd.Command{
 				Command: base.NewCommand(ui),
@@ -564,6 +610,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}, nil
 		},
+		"credentials update x509-certificate": func() (cli.Command, error) {
+			return &credentialscmd.X509CertificateCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
 
 		"groups": func() (cli.Command, error) {
 			return &groupscmd.Command{
@@ -942,6 +994,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "remove-grants",
 			}, nil
 		},
+		"roles simulate": func() (cli.Command, error) {
+			return &rolescmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "simulate",
+			}, nil
+		},
 
 		"scopes": func() (cli.Command, error) {
 			return &scopescmd.Command{
@@ -983,6 +1041,16 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui),
 			}, nil
 		},
+		"scopes list-authorized-actions": func() (cli.Command, error) {
+			return &scopescmd.ListAuthorizedActionsCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
+		"scopes read-login-metadata": func() (cli.Command, error) {
+			return &scopescmd.ReadLoginMetadataCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
 		"scopes rotate-keys": func() (cli.Command, error) {
 			return &scopescmd.RotateKeysCommand{
 				Command: base.NewCommand(ui),
@@ -998,6 +1066,21 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui),
 			}, nil
 		},
+		"scopes add-event-subscription": func() (cli.Command, error) {
+			return &scopescmd.AddEventSubscriptionCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
+		"scopes list-event-subscriptions": func() (cli.Command, error) {
+			return &scopescmd.ListEventSubscriptionsCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
+		"scopes remove-event-subscription": func() (cli.Command, error) {
+			return &scopescmd.RemoveEventSubscriptionCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
 
 		"sessions": func() (cli.Command, error) {
 			return &sessionscmd.Command{
@@ -1022,6 +1105,18 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "cancel",
 			}, nil
 		},
+		"sessions read-transcript": func() (cli.Command, error) {
+			return &sessionscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "read-transcript",
+			}, nil
+		},
+		"sessions my-sessions": func() (cli.Command, error) {
+			return &sessionscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "my-sessions",
+			}, nil
+		},
 
 		"targets": func() (cli.Command, error) {
 			return &targetscmd.Command{
@@ -1034,6 +1129,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "authorize-session",
 			}, nil
 		},
+		"targets list-available-hosts": func() (cli.Command, error) {
+			return &targetscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "list-available-hosts",
+			}, nil
+		},
 		"targets read": func() (cli.Command, error) {
 			return &targetscmd.Command{
 				Command: base.NewCommand(ui),
@@ -1124,6 +1225,36 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "set-credential-sources",
 			}, nil
 		},
+		"targets add-favorite": func() (cli.Command, error) {
+			return &targetscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "add-favorite",
+			}, nil
+		},
+		"targets remove-favorite": func() (cli.Command, error) {
+			return &targetscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "remove-favorite",
+			}, nil
+		},
+		"targets enable-ssh-certificate-authority": func() (cli.Command, error) {
+			return &targetscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "enable-ssh-certificate-authority",
+			}, nil
+		},
+		"targets disable-ssh-certificate-authority": func() (cli.Command, error) {
+			return &targetscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "disable-ssh-certificate-authority",
+			}, nil
+		},
+		"targets sign-ssh-host-key": func() (cli.Command, error) {
+			return &targetscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "sign-ssh-host-key",
+			}, nil
+		},
 
 		"users": func() (cli.Command, error) {
 			return &userscmd.Command{
@@ -1244,6 +1375,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "remove-worker-tags",
 			}, nil
 		},
+		"workers test-worker-filter": func() (cli.Command, error) {
+			return &workerscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "test-worker-filter",
+			}, nil
+		},
 		"workers certificate-authority": func() (cli.Command, error) {
 			return &workerscmd.WorkerCACommand{
 				Command: base.NewCommand(ui),
@@ -4,7 +4,6 @@
 package authenticate
 
 import (
-	"errors"
 	"fmt"
 	"strings"
 
@@ -33,10 +32,14 @@ func (c *Command) Help() string {
 		"",
 		"  This command authenticates the Boundary commandline client using a specified auth method. Examples:",
 		"",
-		"    Authenticate with the primary auth method in the global scope:",
+		"    Authenticate with the auth method suggested by the controller, or else the primary auth method in the global scope:",
 		"",
 		"      $ boundary authenticate",
 		"",
+		"    Authenticate with the primary auth method in a specific scope:",
+		"",
+		"      $ boundary authenticate -scope-id o_1234567890",
+		"",
 		"    Authenticate using the primary auth method in a specific scope",
 		"",
 		"      $ boundary authenticate password -scope-id o_1234567890",
@@ -92,12 +95,24 @@ func (c *Command) Run(args []string) int {
 		return base.CommandUserError
 	}
 
-	// Lookup the primary auth method ID in the global scope
-	aClient := authmethods.NewClient(client)
-	pri, err := getPrimaryAuthMethodId(c.Context, aClient, scope.Global.String(), "")
-	if err != nil {
-		c.PrintCliError(errors.New("Error looking up primary auth method ID for the global scope. Try setting a primary auth method for the global scope, or use an auth method subcommand (see 'boundary authenticate -h' for available sub command usage)."))
-		return base.CommandUserError
+	// Without a scope, use the auth method suggested by the controller,
+	// falling back to the primary auth method of the global scope. With a
+	// scope, use its primary auth method.
+	var pri string
+	if c.FlagScopeId == "" {
+		pri = discoverAuthMethodId(c.Context, client, "")
+	}
+	if pri == "" {
+		scopeId := c.FlagScopeId
+		if scopeId == "" {
+			scopeId = scope.Global.String()
+		}
+		aClient := authmethods.NewClient(client)
+		pri, err = getPrimaryAuthMethodId(c.Context, aClient, scopeId, "")
+		if err != nil {
+			c.PrintCliError(fmt.Errorf("Error looking up primary auth method ID for scope %q. Try setting a primary auth method for the scope, or use an auth method subcommand (see 'boundary authenticate -h' for available sub command usage).", scopeId))
+			return base.CommandUserError
+		}
 	}
 
 	c.FlagAuthMethodId = pri
@@ -11,8 +11,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/authmethods"
 	"github.com/hashicorp/boundary/api/authtokens"
+	"github.com/hashicorp/boundary/api/scopes"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	nkeyring "github.com/jefferai/keyring"
 	zkeyring "github.com/zalando/go-keyring"
@@ -128,3 +130,20 @@ func getPrimaryAuthMethodId(ctx context.Context, client *authmethods.Client, sco
 	}
 	return "", fmt.Errorf("Primary auth method not found for scope ID: '%s'. Please set a primary auth method on this scope or pass one explicitly using an authenticate sub command (see 'boundary authenticate -h') along with the -auth-method-id flag.", scopeId)
 }
+
+// discoverAuthMethodId returns the auth method the controller suggests to
+// clients which authenticate without specifying one. It returns an empty string
+// if the suggestion can't be retrieved, for instance from an older controller,
+// or if it doesn't have the amType prefix, in which case the caller should fall
+// back to the primary auth method of the global scope.
+func discoverAuthMethodId(ctx context.Context, client *api.Client, amType string) string {
+	res, err := scopes.NewClient(client).DiscoverDefaults(ctx)
+	if err != nil {
+		return ""
+	}
+	id := res.GetItem().AuthMethodId
+	if !strings.HasPrefix(id, amType) {
+		return ""
+	}
+	return id
+}
@@ -163,6 +163,12 @@ func (c *LdapCommand) Run(args []string) int {
 
 	aClient := authmethods.NewClient(client)
 
+	// if neither auth method ID nor scope is passed on the CLI, try the auth
+	// method suggested by the controller
+	if c.FlagAuthMethodId == "" && c.FlagScopeId == "" {
+		c.FlagAuthMethodId = discoverAuthMethodId(c.Context, client, globals.LdapAuthMethodPrefix)
+	}
+
 	// if auth method ID isn't passed on the CLI, try looking up the primary auth method ID
 	if c.FlagAuthMethodId == "" {
 		// if flag for scope is empty try looking up global
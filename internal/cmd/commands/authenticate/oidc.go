@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/hashicorp/boundary/internal/cmd/common"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	"github.com/hashicorp/cap/util"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
 	"github.com/mitchellh/cli"
 	"github.com/mitchellh/go-wordwrap"
 	"github.com/posener/complete"
@@ -30,6 +32,8 @@ var (
 type OidcCommand struct {
 	*base.Command
 
+	flagJwt string
+
 	Opts       []common.Option
 	parsedOpts *common.Options
 }
@@ -46,6 +50,10 @@ func (c *OidcCommand) Help() string {
 		"",
 		`    $ boundary authenticate oidc -auth-method-id amoidc_1234567890`,
 		"",
+		"  Workloads with an OIDC identity token (for example: a CI job) can authenticate without the browser based flow. Example:",
+		"",
+		`    $ boundary authenticate oidc -auth-method-id amoidc_1234567890 -jwt env://CI_ID_TOKEN`,
+		"",
 		"",
 	}) + c.Flags().Help()
 }
@@ -61,6 +69,12 @@ func (c *OidcCommand) Flags() *base.FlagSets {
 		Usage:  "The auth-method resource to use for the operation",
 	})
 
+	f.StringVar(&base.StringVar{
+		Name:   "jwt",
+		Target: &c.flagJwt,
+		Usage:  "The JWT to authenticate with instead of the browser based flow. Must be used with env:// or file:// syntax.",
+	})
+
 	if c.parsedOpts == nil || !c.parsedOpts.WithSkipScopeIdFlag {
 		f.StringVar(&base.StringVar{
 			Name:   "scope-id",
@@ -96,6 +110,20 @@ func (c *OidcCommand) Run(args []string) int {
 		return base.CommandUserError
 	}
 
+	if c.flagJwt != "" {
+		jwt, err := parseutil.MustParsePath(c.flagJwt)
+		switch {
+		case err == nil:
+		case errors.Is(err, parseutil.ErrNotParsed):
+			c.UI.Error("JWT flag must be used with env:// or file:// syntax")
+			return base.CommandUserError
+		default:
+			c.UI.Error(fmt.Sprintf("Error parsing JWT flag: %v", err))
+			return base.CommandUserError
+		}
+		c.flagJwt = strings.TrimSpace(jwt)
+	}
+
 	client, err := c.Client(base.WithNoTokenScope(), base.WithNoTokenValue())
 	if c.WrapperCleanupFunc != nil {
 		defer func() {
@@ -111,6 +139,12 @@ func (c *OidcCommand) Run(args []string) int {
 
 	aClient := authmethods.NewClient(client)
 
+	// if neither auth method ID nor scope is passed on the CLI, try the auth
+	// method suggested by the controller
+	if c.FlagAuthMethodId == "" && c.FlagScopeId == "" {
+		c.FlagAuthMethodId = discoverAuthMethodId(c.Context, client, "amoidc")
+	}
+
 	// if auth method ID isn't passed on the CLI, try looking up the primary auth method ID
 	if c.FlagAuthMethodId == "" {
 		// if flag for scope is empty try looking up global
@@ -127,6 +161,21 @@ func (c *OidcCommand) Run(args []string) int {
 		c.FlagAuthMethodId = pri
 	}
 
+	if c.flagJwt != "" {
+		result, err := aClient.Authenticate(c.Context, c.FlagAuthMethodId, "jwt", map[string]any{
+			"token": c.flagJwt,
+		})
+		if err != nil {
+			if apiErr := api.AsServerError(err); apiErr != nil {
+				c.PrintApiError(apiErr, "Error from controller when performing authentication")
+				return base.CommandApiError
+			}
+			c.PrintCliError(fmt.Errorf("Error trying to perform authentication: %w", err))
+			return base.CommandCliError
+		}
+		return saveAndOrPrintToken(c.Command, result)
+	}
+
 	result, err := aClient.Authenticate(c.Context, c.FlagAuthMethodId, "start", nil)
 	if err != nil {
 		if apiErr := api.AsServerError(err); apiErr != nil {
@@ -169,6 +169,14 @@ func (c *Command) Run(args []string) int {
 	}
 	authmethodsClient := authmethods.NewClient(client)
 
+	switch {
+	case c.Func == "create", c.Func == "list" && !c.FlagRecursive:
+		if err := c.CheckAuthorizedAction(client, c.FlagScopeId, "auth-method", c.Func); err != nil {
+			c.PrintCliError(err)
+			return base.CommandUserError
+		}
+	}
+
 	switch c.FlagRecursive {
 	case true:
 		opts = append(opts, authmethods.WithRecursive(true))
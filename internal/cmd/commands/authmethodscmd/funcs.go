@@ -6,16 +6,20 @@ package authmethodscmd
 import (
 	"fmt"
 	"sort"
+	"strconv"
 	"time"
 
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/authmethods"
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
 )
 
 func init() {
 	extraOidcSynopsisFunc = extraSynopsisFuncImpl
+	extraLdapSynopsisFunc = extraLdapSynopsisFuncImpl
 }
 
 func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
@@ -71,6 +75,16 @@ func extraSynopsisFuncImpl(c *OidcCommand) string {
 	}
 }
 
+func extraLdapSynopsisFuncImpl(c *LdapCommand) string {
+	switch c.Func {
+	case "fetch-certificates":
+		return "Fetch and optionally pin the certificates presented by an auth method's LDAP servers"
+
+	default:
+		return ""
+	}
+}
+
 func (c *Command) printListTable(items []*authmethods.AuthMethod) string {
 	if len(items) == 0 {
 		return "No auth methods found"
@@ -164,6 +178,9 @@ func printItemTable(item *authmethods.AuthMethod, resp *api.Response) string {
 			nonAttributeMap["Is Primary For Scope"] = item.IsPrimary
 		}
 	}
+	if item.DeletionProtected {
+		nonAttributeMap["Deletion Protected"] = item.DeletionProtected
+	}
 
 	maxLength := base.MaxAttributesLength(nonAttributeMap, item.Attributes, keySubstMap)
 
@@ -222,3 +239,39 @@ var keySubstMap = map[string]string{
 	"min_login_name_length": "Minimum Login Name Length",
 	"min_password_length":   "Minimum Password Length",
 }
+
+const deletionProtectedFlagName = "deletion-protected"
+
+// deletionProtectedFlagVar holds the value of the flag which sets whether an
+// auth method is protected from deletion. It is shared by the auth method
+// subtypes.
+type deletionProtectedFlagVar struct {
+	flagDeletionProtected string
+}
+
+func (d *deletionProtectedFlagVar) addDeletionProtectedFlag(f *base.FlagSet) {
+	f.StringVar(&base.StringVar{
+		Name:       deletionProtectedFlagName,
+		Target:     &d.flagDeletionProtected,
+		Completion: complete.PredictSet("true", "false"),
+		Usage:      `Whether the auth method is protected from deletion. Clearing the protection requires the clear-deletion-protection action. Supported values are "true" and "false".`,
+	})
+}
+
+// deletionProtectedOption appends the option for the deletion protected flag
+// to opts if it was set. It returns false if the flag could not be parsed.
+func (d *deletionProtectedFlagVar) deletionProtectedOption(ui cli.Ui, opts *[]authmethods.Option) bool {
+	switch d.flagDeletionProtected {
+	case "":
+	case "null":
+		*opts = append(*opts, authmethods.DefaultDeletionProtected())
+	default:
+		protected, err := strconv.ParseBool(d.flagDeletionProtected)
+		if err != nil {
+			ui.Error(fmt.Sprintf("Error parsing %q: %s", d.flagDeletionProtected, err))
+			return false
+		}
+		*opts = append(*opts, authmethods.WithDeletionProtected(protected))
+	}
+	return true
+}
@@ -155,6 +155,14 @@ func (c *OidcCommand) Run(args []string) int {
 	}
 	authmethodsClient := authmethods.NewClient(client)
 
+	switch {
+	case c.Func == "create", c.Func == "list" && !c.FlagRecursive:
+		if err := c.CheckAuthorizedAction(client, c.FlagScopeId, "auth-method", c.Func); err != nil {
+			c.PrintCliError(err)
+			return base.CommandUserError
+		}
+	}
+
 	switch c.FlagName {
 	case "":
 	case "null":
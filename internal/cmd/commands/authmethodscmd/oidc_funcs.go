@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/authmethods"
 	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/posener/complete"
 )
 
 func init() {
@@ -31,8 +32,12 @@ type extraOidcCmdVars struct {
 	flagAllowedAudiences                  []string
 	flagClaimsScopes                      []string
 	flagAccountClaimMaps                  []string
+	flagJwtValidationPubKeys              []string
+	flagBoundClaims                       []string
+	flagJwtLoginEnabled                   string
 	flagDisableDiscoveredConfigValidation bool
 	flagDryRun                            bool
+	deletionProtectedFlagVar
 }
 
 const (
@@ -47,6 +52,9 @@ const (
 	allowedAudienceFlagName                   = "allowed-audience"
 	claimsScopes                              = "claims-scopes"
 	accountClaimMaps                          = "account-claim-maps"
+	jwtValidationPubKeyFlagName               = "jwt-validation-pub-key"
+	boundClaimFlagName                        = "bound-claim"
+	jwtLoginEnabledFlagName                   = "jwt-login-enabled"
 	stateFlagName                             = "state"
 	disableDiscoveredConfigValidationFlagName = "disable-discovered-config-validation"
 	dryRunFlagName                            = "dry-run"
@@ -65,6 +73,10 @@ func extraOidcActionsFlagsMapFuncImpl() map[string][]string {
 			allowedAudienceFlagName,
 			claimsScopes,
 			accountClaimMaps,
+			jwtValidationPubKeyFlagName,
+			boundClaimFlagName,
+			jwtLoginEnabledFlagName,
+			deletionProtectedFlagName,
 		},
 		"change-state": {
 			idFlagName,
@@ -141,6 +153,25 @@ func extraOidcFlagsFuncImpl(c *OidcCommand, set *base.FlagSets, _ *base.FlagSet)
 				Target: &c.flagAccountClaimMaps,
 				Usage:  `The optional account claim maps from custom claims to the standard claims of sub, name and email.  These maps are represented as key=value where the key equals the Provider from-claim and the value equals the Boundary to-claim.  For example "oid=sub". May be specified multiple times for different to-claims.`,
 			})
+		case jwtValidationPubKeyFlagName:
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   jwtValidationPubKeyFlagName,
+				Target: &c.flagJwtValidationPubKeys,
+				Usage:  "Optional PEM-encoded public key used to verify JWTs presented with the jwt authenticate command instead of the provider's JWKS. May be specified multiple times.",
+			})
+		case boundClaimFlagName:
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   boundClaimFlagName,
+				Target: &c.flagBoundClaims,
+				Usage:  `The optional claim values a token must have to authenticate.  Bound claims are represented as claim=value, for example "repository=hashicorp/boundary". May be specified multiple times; a token must match one value for every bound claim.`,
+			})
+		case jwtLoginEnabledFlagName:
+			f.StringVar(&base.StringVar{
+				Name:       jwtLoginEnabledFlagName,
+				Target:     &c.flagJwtLoginEnabled,
+				Completion: complete.PredictSet("true", "false"),
+				Usage:      `Whether workloads can authenticate with a JWT issued by the provider using the jwt authenticate command. JWT login also requires an allowed audience. Supported values are "true" and "false".`,
+			})
 		case stateFlagName:
 			f.StringVar(&base.StringVar{
 				Name:   stateFlagName,
@@ -159,6 +190,8 @@ func extraOidcFlagsFuncImpl(c *OidcCommand, set *base.FlagSets, _ *base.FlagSet)
 				Target: &c.flagDryRun,
 				Usage:  "Performs all completeness and validation checks with any newly-provided values without persisting the changes.",
 			})
+		case deletionProtectedFlagName:
+			c.addDeletionProtectedFlag(f)
 		}
 	}
 }
@@ -277,6 +310,32 @@ func extraOidcFlagHandlingFuncImpl(c *OidcCommand, f *base.FlagSets, opts *[]aut
 	default:
 		*opts = append(*opts, authmethods.WithOidcAuthMethodAccountClaimMaps(c.flagAccountClaimMaps))
 	}
+	switch {
+	case len(c.flagJwtValidationPubKeys) == 0:
+	case len(c.flagJwtValidationPubKeys) == 1 && c.flagJwtValidationPubKeys[0] == "null":
+		*opts = append(*opts, authmethods.DefaultOidcAuthMethodJwtValidationPubKeys())
+	default:
+		*opts = append(*opts, authmethods.WithOidcAuthMethodJwtValidationPubKeys(c.flagJwtValidationPubKeys))
+	}
+	switch {
+	case len(c.flagBoundClaims) == 0:
+	case len(c.flagBoundClaims) == 1 && c.flagBoundClaims[0] == "null":
+		*opts = append(*opts, authmethods.DefaultOidcAuthMethodBoundClaims())
+	default:
+		*opts = append(*opts, authmethods.WithOidcAuthMethodBoundClaims(c.flagBoundClaims))
+	}
+	switch c.flagJwtLoginEnabled {
+	case "":
+	case "null":
+		*opts = append(*opts, authmethods.DefaultOidcAuthMethodJwtLoginEnabled())
+	default:
+		enabled, err := strconv.ParseBool(c.flagJwtLoginEnabled)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagJwtLoginEnabled, err))
+			return false
+		}
+		*opts = append(*opts, authmethods.WithOidcAuthMethodJwtLoginEnabled(enabled))
+	}
 	if c.flagDisableDiscoveredConfigValidation {
 		*opts = append(*opts, authmethods.WithOidcAuthMethodDisableDiscoveredConfigValidation(c.flagDisableDiscoveredConfigValidation))
 	}
@@ -284,7 +343,7 @@ func extraOidcFlagHandlingFuncImpl(c *OidcCommand, f *base.FlagSets, opts *[]aut
 		*opts = append(*opts, authmethods.WithOidcAuthMethodDryRun(c.flagDryRun))
 	}
 
-	return true
+	return c.deletionProtectedOption(c.UI, opts)
 }
 
 func executeExtraOidcActionsImpl(c *OidcCommand, origResp *api.Response, origItem *authmethods.AuthMethod, origError error, amClient *authmethods.Client, version uint32, opts []authmethods.Option) (*api.Response, *authmethods.AuthMethod, error) {
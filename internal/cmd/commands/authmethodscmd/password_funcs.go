@@ -20,12 +20,13 @@ func init() {
 type extraPasswordCmdVars struct {
 	flagMinLoginNameLength string
 	flagMinPasswordLength  string
+	deletionProtectedFlagVar
 }
 
 func extraPasswordActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"create": {"min-login-name-length", "min-password-length"},
-		"update": {"min-login-name-length", "min-password-length"},
+		"create": {"min-login-name-length", "min-password-length", deletionProtectedFlagName},
+		"update": {"min-login-name-length", "min-password-length", deletionProtectedFlagName},
 	}
 }
 
@@ -74,6 +75,8 @@ func extraPasswordFlagsFuncImpl(c *PasswordCommand, set *base.FlagSets, f *base.
 				Target: &c.flagMinPasswordLength,
 				Usage:  "The minimum length of passwords",
 			})
+		case deletionProtectedFlagName:
+			c.addDeletionProtectedFlag(f)
 		}
 	}
 }
@@ -116,5 +119,5 @@ func extraPasswordFlagHandlingFuncImpl(c *PasswordCommand, _ *base.FlagSets, opt
 		*opts = append(*opts, authmethods.WithAttributes(attributes))
 	}
 
-	return true
+	return c.deletionProtectedOption(c.UI, opts)
 }
@@ -164,6 +164,14 @@ func (c *Command) Run(args []string) int {
 	}
 	authtokensClient := authtokens.NewClient(client)
 
+	switch {
+	case c.Func == "create", c.Func == "list" && !c.FlagRecursive:
+		if err := c.CheckAuthorizedAction(client, c.FlagScopeId, "auth-token", c.Func); err != nil {
+			c.PrintCliError(err)
+			return base.CommandUserError
+		}
+	}
+
 	switch c.FlagRecursive {
 	case true:
 		opts = append(opts, authtokens.WithRecursive(true))
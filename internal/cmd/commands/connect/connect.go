@@ -72,10 +72,13 @@ type Command struct {
 	flagTargetId   string
 	flagTargetName string
 	flagHostId     string
+	flagHost       string
 	flagExec       string
 	flagUsername   string
 	flagDbname     string
 
+	flagAcknowledgeBanner bool
+
 	// HTTP
 	httpFlags
 
@@ -96,6 +99,12 @@ type Command struct {
 	sessionAuthz     *targets.SessionAuthorization
 	sessionAuthzData *targetspb.SessionAuthorizationData
 
+	bannerAcknowledged bool
+
+	// transcript captures the output of the helper when recording a
+	// transcript is requested
+	transcript *transcript
+
 	connWg             *sync.WaitGroup
 	listenerCloseOnce  sync.Once
 	listener           *net.TCPListener
@@ -184,6 +193,13 @@ func (c *Command) Flags() *base.FlagSets {
 		Usage:  "The ID of a specific host to connect to out of the hosts from the target's host sets. If not specified, one is chosen at random.",
 	})
 
+	f.StringVar(&base.StringVar{
+		Name:       "host",
+		Target:     &c.flagHost,
+		Completion: complete.PredictFunc(c.predictHosts),
+		Usage:      "The name or address of a specific host to connect to out of the hosts from the target's host sets. Partial values are matched against the available hosts; if more than one host matches, the candidates are listed. Cannot be used with -host-id.",
+	})
+
 	f.StringVar(&base.StringVar{
 		Name:       "exec",
 		Target:     &c.flagExec,
@@ -214,6 +230,13 @@ func (c *Command) Flags() *base.FlagSets {
 		Usage:      "Target scope name, if authorizing the session via scope parameters and target name. Mutually exclusive with -scope-id.",
 	})
 
+	f.BoolVar(&base.BoolVar{
+		Name:   "acknowledge-banner",
+		Target: &c.flagAcknowledgeBanner,
+		EnvVar: "BOUNDARY_CONNECT_ACKNOWLEDGE_BANNER",
+		Usage:  "If set, the banner of the target, if any, is displayed and acknowledged without prompting. Required if the target has a banner and the command cannot prompt, e.g. because the authorization string is read from standard input.",
+	})
+
 	switch c.Func {
 	case "connect":
 		f.StringVar(&base.StringVar{
@@ -285,6 +308,9 @@ func (c *Command) Run(args []string) (retCode int) {
 		case c.flagTargetName != "":
 			c.PrintCliError(errors.New(`-target-name and -authz-token cannot both be specified`))
 			return base.CommandUserError
+		case c.flagHost != "":
+			c.PrintCliError(errors.New(`-host and -authz-token cannot both be specified`))
+			return base.CommandUserError
 		}
 	default:
 		if c.flagTargetId == "" &&
@@ -298,6 +324,10 @@ func (c *Command) Run(args []string) (retCode int) {
 			c.PrintCliError(errors.New("Cannot specify a target ID and also other lookup parameters"))
 			return base.CommandUserError
 		}
+		if c.flagHostId != "" && c.flagHost != "" {
+			c.PrintCliError(errors.New("-host-id and -host cannot both be specified"))
+			return base.CommandUserError
+		}
 	}
 
 	if c.flagExec == "" {
@@ -379,9 +409,6 @@ func (c *Command) Run(args []string) (retCode int) {
 		targetClient := targets.NewClient(client)
 
 		var opts []targets.Option
-		if len(c.flagHostId) != 0 {
-			opts = append(opts, targets.WithHostId(c.flagHostId))
-		}
 		if len(c.flagTargetName) > 0 {
 			opts = append(opts, targets.WithName(c.flagTargetName))
 		}
@@ -391,6 +418,26 @@ func (c *Command) Run(args []string) (retCode int) {
 		if len(c.FlagScopeName) > 0 {
 			opts = append(opts, targets.WithScopeName(c.FlagScopeName))
 		}
+		if len(c.flagHost) != 0 {
+			hosts, err := targetClient.ListAvailableHosts(c.Context, c.flagTargetId, opts...)
+			if err != nil {
+				if apiErr := api.AsServerError(err); apiErr != nil {
+					c.PrintApiError(apiErr, "Error from controller when listing the available hosts of the given target")
+					return base.CommandApiError
+				}
+				c.PrintCliError(fmt.Errorf("Error trying to list the available hosts of the target: %w", err))
+				return base.CommandCliError
+			}
+			h, err := resolveHost(hosts.GetItems(), c.flagHost)
+			if err != nil {
+				c.PrintCliError(err)
+				return base.CommandUserError
+			}
+			c.flagHostId = h.HostId
+		}
+		if len(c.flagHostId) != 0 {
+			opts = append(opts, targets.WithHostId(c.flagHostId))
+		}
 
 		sar, err := targetClient.AuthorizeSession(c.Context, c.flagTargetId, opts...)
 		if err != nil {
@@ -426,6 +473,13 @@ func (c *Command) Run(args []string) (retCode int) {
 		return base.CommandUserError
 	}
 
+	if banner := c.sessionAuthzData.GetBanner(); banner != "" {
+		if err := c.acknowledgeBanner(banner, c.flagAuthzToken == "-"); err != nil {
+			c.PrintCliError(err)
+			return base.CommandUserError
+		}
+	}
+
 	c.connectionsLeft.Store(c.sessionAuthzData.ConnectionLimit)
 	workerAddr := c.sessionAuthzData.GetWorkerInfo()[0].GetAddress()
 	workerHost, _, err := net.SplitHostPort(workerAddr)
@@ -630,6 +684,12 @@ func (c *Command) Run(args []string) (retCode int) {
 		cancel()
 	}
 
+	if c.transcript != nil {
+		if err := c.uploadTranscript(); err != nil {
+			c.PrintCliError(err)
+		}
+	}
+
 	for _, f := range c.cleanupFuncs {
 		if err := f(); err != nil {
 			c.PrintCliError(err)
@@ -653,6 +713,27 @@ func (c *Command) Run(args []string) (retCode int) {
 	return
 }
 
+// acknowledgeBanner displays the banner of the target and, unless
+// -acknowledge-banner is set, prompts the user to acknowledge it. Sessions to
+// a target with a banner cannot be activated without the acknowledgement.
+func (c *Command) acknowledgeBanner(banner string, stdinConsumed bool) error {
+	c.UI.Warn(banner)
+	if !c.flagAcknowledgeBanner {
+		if stdinConsumed {
+			return errors.New("The target has a banner which must be acknowledged; use -acknowledge-banner when reading the authorization string from standard input")
+		}
+		resp, err := c.UI.Ask(`Type "yes" to acknowledge the banner and connect:`)
+		if err != nil {
+			return fmt.Errorf("Error reading banner acknowledgement: %w", err)
+		}
+		if !strings.EqualFold(strings.TrimSpace(resp), "yes") {
+			return errors.New("The banner of the target was not acknowledged")
+		}
+	}
+	c.bannerAcknowledged = true
+	return nil
+}
+
 func (c *Command) printCredentials(creds []*targets.SessionCredential) error {
 	if len(creds) == 0 {
 		return nil
@@ -734,7 +815,10 @@ func (c *Command) runTcpProxyV1(
 	listeningConn *net.TCPConn,
 	tofuToken string,
 ) error {
-	handshake := proxy.ClientHandshake{TofuToken: tofuToken}
+	handshake := proxy.ClientHandshake{
+		TofuToken:          tofuToken,
+		BannerAcknowledged: c.bannerAcknowledged,
+	}
 	if err := wspb.Write(c.proxyCtx, wsConn, &handshake); err != nil {
 		return fmt.Errorf("error sending handshake to worker: %w", err)
 	}
@@ -860,6 +944,9 @@ func (c *Command) handleExec(passthroughArgs []string) {
 		args = append(args, sshArgs...)
 		envs = append(envs, sshEnvs...)
 		creds = sshCreds
+		if c.flagRecordTranscript {
+			c.transcript = new(transcript)
+		}
 
 	case "kube":
 		kubeArgs, err := c.kubeFlags.buildArgs(c, port, ip, addr)
@@ -918,6 +1005,10 @@ func (c *Command) handleExec(passthroughArgs []string) {
 	cmd.Stdin = os.Stdin
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
+	if c.transcript != nil {
+		cmd.Stdout = io.MultiWriter(os.Stdout, c.transcript)
+		cmd.Stderr = io.MultiWriter(os.Stderr, c.transcript)
+	}
 
 	if err := cmd.Run(); err != nil {
 		exitCode := 2
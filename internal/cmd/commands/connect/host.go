@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/posener/complete"
+)
+
+// hostMatch ranks how well a host matches the value given to -host. Lower
+// values are better matches.
+type hostMatch int
+
+const (
+	hostMatchExact hostMatch = iota
+	hostMatchPrefix
+	hostMatchSubstring
+	hostMatchFuzzy
+	hostMatchNone
+)
+
+// matchHost returns how well the query matches the ID, name or address of the
+// host. Names and addresses are compared case-insensitively.
+func matchHost(h *targets.AvailableHost, query string) hostMatch {
+	if h.HostId == query {
+		return hostMatchExact
+	}
+	q := strings.ToLower(query)
+	best := hostMatchNone
+	for _, v := range []string{h.Name, h.Address} {
+		v = strings.ToLower(v)
+		var m hostMatch
+		switch {
+		case v == "":
+			continue
+		case v == q:
+			m = hostMatchExact
+		case strings.HasPrefix(v, q):
+			m = hostMatchPrefix
+		case strings.Contains(v, q):
+			m = hostMatchSubstring
+		case isSubsequence(q, v):
+			m = hostMatchFuzzy
+		default:
+			continue
+		}
+		if m < best {
+			best = m
+		}
+	}
+	return best
+}
+
+// isSubsequence reports whether all of the characters of q appear in s in
+// order, e.g. "wb1" in "web-01".
+func isSubsequence(q, s string) bool {
+	qr := []rune(q)
+	i := 0
+	for _, r := range s {
+		if i < len(qr) && r == qr[i] {
+			i++
+		}
+	}
+	return i == len(qr)
+}
+
+// resolveHost returns the host among the available hosts of a target which
+// best matches the value given to -host. A host reachable through several
+// host sources is only considered once. An error is returned if no host
+// matches, or if several hosts match equally well, in which case the error
+// lists them so the user can pick one with -host-id.
+func resolveHost(hosts []*targets.AvailableHost, query string) (*targets.AvailableHost, error) {
+	if query == "" {
+		return nil, fmt.Errorf("No host given")
+	}
+	seen := make(map[string]bool, len(hosts))
+	best := hostMatchNone
+	var matches []*targets.AvailableHost
+	for _, h := range hosts {
+		if seen[h.HostId] {
+			continue
+		}
+		seen[h.HostId] = true
+		m := matchHost(h, query)
+		switch {
+		case m < best:
+			best = m
+			matches = []*targets.AvailableHost{h}
+		case m == best && m != hostMatchNone:
+			matches = append(matches, h)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("No available host of the target matches %q", query)
+	case 1:
+		return matches[0], nil
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].HostId < matches[j].HostId })
+	candidates := make([]string, 0, len(matches))
+	for _, h := range matches {
+		c := fmt.Sprintf("  %s (%s)", h.HostId, h.Address)
+		if h.Name != "" {
+			c = fmt.Sprintf("  %s %q (%s)", h.HostId, h.Name, h.Address)
+		}
+		candidates = append(candidates, c)
+	}
+	return nil, fmt.Errorf("%q matches more than one available host of the target, use -host-id with one of:\n%s", query, strings.Join(candidates, "\n"))
+}
+
+// hostLookupFlags holds the values of the target lookup flags found in the
+// arguments being completed.
+type hostLookupFlags struct {
+	targetId, targetName, scopeId, scopeName string
+}
+
+// parseHostLookupFlags finds the target lookup flags in the arguments of the
+// command line being completed. Both "-flag value" and "-flag=value" are
+// supported.
+func parseHostLookupFlags(args []string) hostLookupFlags {
+	var f hostLookupFlags
+	byName := map[string]*string{
+		"target-id":         &f.targetId,
+		"target-name":       &f.targetName,
+		"target-scope-id":   &f.scopeId,
+		"target-scope-name": &f.scopeName,
+	}
+	for i := 0; i < len(args); i++ {
+		name := strings.TrimLeft(args[i], "-")
+		if name == args[i] {
+			continue
+		}
+		var value string
+		var hasValue bool
+		if n, v, ok := strings.Cut(name, "="); ok {
+			name, value, hasValue = n, v, true
+		}
+		t, ok := byName[name]
+		if !ok {
+			continue
+		}
+		if !hasValue {
+			if i+1 >= len(args) {
+				break
+			}
+			i++
+			value = args[i]
+		}
+		*t = value
+	}
+	return f
+}
+
+// predictHosts completes the -host flag with the names and addresses of the
+// hosts available through the target given on the command line. Completion
+// is best effort: nothing is predicted if the target is not known yet or the
+// hosts cannot be listed.
+func (c *Command) predictHosts(args complete.Args) []string {
+	f := parseHostLookupFlags(args.All)
+	var opts []targets.Option
+	switch {
+	case f.targetId != "":
+	case f.targetName != "" && f.scopeId != "":
+		opts = append(opts, targets.WithName(f.targetName), targets.WithScopeId(f.scopeId))
+	case f.targetName != "" && f.scopeName != "":
+		opts = append(opts, targets.WithName(f.targetName), targets.WithScopeName(f.scopeName))
+	default:
+		return nil
+	}
+	client, err := c.Client()
+	if err != nil {
+		return nil
+	}
+	result, err := targets.NewClient(client).ListAvailableHosts(c.Context, f.targetId, opts...)
+	if err != nil {
+		return nil
+	}
+	var ret []string
+	for _, h := range result.GetItems() {
+		if h.Name != "" {
+			ret = append(ret, h.Name)
+		}
+		ret = append(ret, h.Address)
+	}
+	return ret
+}
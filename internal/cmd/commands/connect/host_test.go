@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveHost(t *testing.T) {
+	web1 := &targets.AvailableHost{HostId: "hst_1111111111", HostSourceId: "hsst_1111111111", Name: "web-01", Address: "10.0.0.1"}
+	web2 := &targets.AvailableHost{HostId: "hst_2222222222", HostSourceId: "hsst_1111111111", Name: "web-02", Address: "10.0.0.2"}
+	db := &targets.AvailableHost{HostId: "hst_3333333333", HostSourceId: "hsst_2222222222", Name: "DB", Address: "db.example.com"}
+	// web1 is also reachable through a second host source
+	web1Again := &targets.AvailableHost{HostId: "hst_1111111111", HostSourceId: "hsst_2222222222", Name: "web-01", Address: "10.0.0.1"}
+	hosts := []*targets.AvailableHost{web1, web2, db, web1Again}
+
+	tests := []struct {
+		name            string
+		query           string
+		want            *targets.AvailableHost
+		wantErrContains string
+	}{
+		{name: "host-id", query: "hst_2222222222", want: web2},
+		{name: "exact-name", query: "web-01", want: web1},
+		{name: "exact-name-case-insensitive", query: "db", want: db},
+		{name: "exact-address", query: "10.0.0.2", want: web2},
+		{name: "prefix", query: "db.ex", want: db},
+		{name: "substring", query: "example", want: db},
+		{name: "fuzzy", query: "wb2", want: web2},
+		{name: "exact-beats-prefix", query: "10.0.0.1", want: web1},
+		{name: "ambiguous", query: "web", wantErrContains: "hst_1111111111 \"web-01\" (10.0.0.1)\n  hst_2222222222 \"web-02\" (10.0.0.2)"},
+		{name: "no-match", query: "mail", wantErrContains: "No available host"},
+		{name: "empty", query: "", wantErrContains: "No host given"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveHost(hosts, tt.query)
+			if tt.wantErrContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErrContains)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseHostLookupFlags(t *testing.T) {
+	assert.Equal(t, hostLookupFlags{targetId: "ttcp_1234567890"},
+		parseHostLookupFlags([]string{"-target-id", "ttcp_1234567890", "-host", "we"}))
+	assert.Equal(t, hostLookupFlags{targetName: "prod", scopeName: "ops"},
+		parseHostLookupFlags([]string{"-target-name=prod", "--target-scope-name", "ops", "-host"}))
+	assert.Equal(t, hostLookupFlags{}, parseHostLookupFlags([]string{"-target-id"}))
+}
@@ -93,8 +93,11 @@ func (h *httpFlags) buildArgs(c *Command, port, ip, addr string) ([]string, erro
 		var uri string
 		if host != "" {
 			host = strings.TrimSuffix(host, "/")
+			// IPv6 addresses must be enclosed in brackets in the Host header,
+			// the URI and both parts of the --resolve value
+			host = bracketIpv6(strings.TrimSuffix(strings.TrimPrefix(host, "["), "]"))
 			args = append(args, "-H", fmt.Sprintf("Host: %s", host))
-			args = append(args, "--resolve", fmt.Sprintf("%s:%s:%s", host, port, ip))
+			args = append(args, "--resolve", fmt.Sprintf("%s:%s:%s", host, port, bracketIpv6(ip)))
 			uri = fmt.Sprintf("%s://%s:%s", h.flagHttpScheme, host, port)
 		} else {
 			uri = fmt.Sprintf("%s://%s", h.flagHttpScheme, addr)
@@ -106,3 +109,12 @@ func (h *httpFlags) buildArgs(c *Command, port, ip, addr string) ([]string, erro
 	}
 	return args, nil
 }
+
+// bracketIpv6 encloses an IPv6 address in square brackets, returning any
+// other host unchanged.
+func bracketIpv6(host string) string {
+	if strings.Contains(host, ":") {
+		return "[" + host + "]"
+	}
+	return host
+}
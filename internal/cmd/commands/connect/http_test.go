@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"testing"
+
+	targetspb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHttpBuildArgs(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+		host     string
+		ip       string
+		addr     string
+		want     []string
+	}{
+		{
+			name:     "ipv4",
+			endpoint: "tcp://example.com:443",
+			ip:       "127.0.0.1",
+			addr:     "127.0.0.1:50000",
+			want:     []string{"-H", "Host: example.com", "--resolve", "example.com:50000:127.0.0.1", "https://example.com:50000"},
+		},
+		{
+			name:     "ipv6-listener",
+			endpoint: "tcp://example.com:443",
+			ip:       "::1",
+			addr:     "[::1]:50000",
+			want:     []string{"-H", "Host: example.com", "--resolve", "example.com:50000:[::1]", "https://example.com:50000"},
+		},
+		{
+			name:     "ipv6-endpoint",
+			endpoint: "tcp://[2001:db8::1]:443",
+			ip:       "::1",
+			addr:     "[::1]:50000",
+			want:     []string{"-H", "Host: [2001:db8::1]", "--resolve", "[2001:db8::1]:50000:[::1]", "https://[2001:db8::1]:50000"},
+		},
+		{
+			name:     "bracketed-ipv6-host-flag",
+			endpoint: "tcp://example.com:443",
+			host:     "[2001:db8::1]",
+			ip:       "127.0.0.1",
+			addr:     "127.0.0.1:50000",
+			want:     []string{"-H", "Host: [2001:db8::1]", "--resolve", "[2001:db8::1]:50000:127.0.0.1", "https://[2001:db8::1]:50000"},
+		},
+		{
+			name: "no-host",
+			ip:   "::1",
+			addr: "[::1]:50000",
+			want: []string{"https://[::1]:50000"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Command{sessionAuthzData: &targetspb.SessionAuthorizationData{Endpoint: tt.endpoint}}
+			h := &httpFlags{flagHttpStyle: "curl", flagHttpHost: tt.host, flagHttpScheme: "https"}
+			got, err := h.buildArgs(c, "50000", tt.ip, tt.addr)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
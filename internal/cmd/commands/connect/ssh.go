@@ -37,10 +37,18 @@ func sshOptions(c *Command, set *base.FlagSets) {
 		Completion: complete.PredictNothing,
 		Usage:      `Specifies the username to pass through to the client`,
 	})
+
+	f.BoolVar(&base.BoolVar{
+		Name:   "record-transcript",
+		Target: &c.flagRecordTranscript,
+		EnvVar: "BOUNDARY_CONNECT_SSH_RECORD_TRANSCRIPT",
+		Usage:  `If set, the output of the SSH client is captured and uploaded to the controller when the client exits, where it is stored encrypted with a key of the org of the session. It can be read with "boundary sessions read-transcript". Only supported for tcp targets, which cannot be recorded by workers, and the "ssh" and "sshpass" styles.`,
+	})
 }
 
 type sshFlags struct {
-	flagSshStyle string
+	flagSshStyle         string
+	flagRecordTranscript bool
 }
 
 func (s *sshFlags) defaultExec() string {
@@ -55,12 +63,20 @@ func (s *sshFlags) buildArgs(c *Command, port, ip, _ string, creds credentials)
 	switch string(target.SubtypeFromId(c.sessionAuthzData.GetTargetId())) {
 	case "tcp":
 		tryConsume = true
+	default:
+		if s.flagRecordTranscript {
+			return nil, nil, credentials{}, errors.New("Transcripts can only be recorded for tcp targets")
+		}
 	}
 
 	switch strings.ToLower(s.flagSshStyle) {
 	case "ssh":
 		// Might want -t for ssh or -tt but seems fine without it for now...
 		args = append(args, "-p", port, ip)
+		if s.flagRecordTranscript {
+			// The output of the client isn't a terminal when it is captured
+			args = append(args, "-t")
+		}
 
 		switch c.sessionAuthzData.GetType() {
 		case "tcp":
@@ -103,12 +119,18 @@ func (s *sshFlags) buildArgs(c *Command, port, ip, _ string, creds credentials)
 		envs = append(envs, fmt.Sprintf("SSHPASS=%s", password))
 		args = append(args, "-e", "ssh")
 		args = append(args, "-p", port, ip)
+		if s.flagRecordTranscript {
+			args = append(args, "-t")
+		}
 
 		// sshpass cannot handle host key checking, disable localhost key verification
 		// to avoid error: 'SSHPASS detected host authentication prompt. Exiting.'
 		args = append(args, "-o", "NoHostAuthenticationForLocalhost=yes")
 
 	case "putty":
+		if s.flagRecordTranscript {
+			return nil, nil, credentials{}, errors.New("Transcripts cannot be recorded when using putty")
+		}
 		args = append(args, "-P", port, ip)
 	}
 
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/boundary/api/sessions"
+	"github.com/hashicorp/boundary/internal/session"
+)
+
+// transcript captures the output of the helper of a session so it can be
+// uploaded to the controller once the helper exits. It is only kept in
+// memory; it is encrypted by the controller with a key of the org of the
+// session. Output beyond session.MaxClientTranscriptSize is dropped.
+type transcript struct {
+	mu        sync.Mutex
+	buf       bytes.Buffer
+	truncated bool
+}
+
+// Write implements io.Writer. It never fails so that writing the output of
+// the helper to the terminal is never interrupted.
+func (t *transcript) Write(p []byte) (int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	remaining := session.MaxClientTranscriptSize - t.buf.Len()
+	if len(p) > remaining {
+		t.buf.Write(p[:remaining])
+		t.truncated = true
+		return len(p), nil
+	}
+	t.buf.Write(p)
+	return len(p), nil
+}
+
+// uploadTranscript uploads the captured transcript of the session, if any.
+func (c *Command) uploadTranscript() error {
+	c.transcript.mu.Lock()
+	data, truncated := c.transcript.buf.Bytes(), c.transcript.truncated
+	c.transcript.mu.Unlock()
+	if len(data) == 0 {
+		return nil
+	}
+
+	client, err := c.Client()
+	if err != nil {
+		return fmt.Errorf("error creating API client to upload the session transcript: %w", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), sessionCancelTimeout)
+	defer cancel()
+	if _, err := sessions.NewClient(client).UploadTranscript(ctx, c.sessionAuthzData.GetSessionId(), data, truncated); err != nil {
+		return fmt.Errorf("error uploading the session transcript: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranscript_Write(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+
+	tr := new(transcript)
+	n, err := tr.Write([]byte("hello"))
+	require.NoError(err)
+	assert.Equal(5, n)
+	assert.False(tr.truncated)
+
+	big := bytes.Repeat([]byte("a"), session.MaxClientTranscriptSize)
+	n, err = tr.Write(big)
+	require.NoError(err)
+	assert.Equal(len(big), n, "writes must not be reported as short")
+	assert.True(tr.truncated)
+	assert.Equal(session.MaxClientTranscriptSize, tr.buf.Len())
+	assert.Equal("hello", string(tr.buf.Bytes()[:5]))
+
+	n, err = tr.Write([]byte("more"))
+	require.NoError(err)
+	assert.Equal(4, n)
+	assert.Equal(session.MaxClientTranscriptSize, tr.buf.Len())
+}
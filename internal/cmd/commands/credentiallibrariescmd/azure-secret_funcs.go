@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentiallibrariescmd
+
+import (
+	"github.com/hashicorp/boundary/api/credentiallibraries"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+)
+
+func init() {
+	extraAzureSecretFlagsFunc = extraAzureSecretFlagsFuncImpl
+	extraAzureSecretActionsFlagsMapFunc = extraAzureSecretActionsFlagsMapFuncImpl
+	extraAzureSecretFlagsHandlingFunc = extraAzureSecretFlagHandlingFuncImpl
+}
+
+const (
+	secretNameFlagName    = "secret-name"
+	secretVersionFlagName = "secret-version"
+)
+
+type extraAzureSecretCmdVars struct {
+	flagSecretName     string
+	flagSecretVersion  string
+	flagCredentialType string
+}
+
+func extraAzureSecretActionsFlagsMapFuncImpl() map[string][]string {
+	flags := map[string][]string{
+		"create": {
+			secretNameFlagName,
+			secretVersionFlagName,
+			credentialTypeFlagName,
+		},
+		"update": {
+			secretNameFlagName,
+			secretVersionFlagName,
+		},
+	}
+	return flags
+}
+
+func extraAzureSecretFlagsFuncImpl(c *AzureSecretCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("Azure Credential Library Options")
+
+	for _, name := range flagsAzureSecretMap[c.Func] {
+		switch name {
+		case secretNameFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   secretNameFlagName,
+				Target: &c.flagSecretName,
+				Usage:  "The name of the secret in Azure Key Vault to request credentials from.",
+			})
+		case secretVersionFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   secretVersionFlagName,
+				Target: &c.flagSecretVersion,
+				Usage:  "The version of the secret to request, defaults to the current version.",
+			})
+		case credentialTypeFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   credentialTypeFlagName,
+				Target: &c.flagCredentialType,
+				Usage:  "The type of credential this library will issue, defaults to Unspecified.",
+			})
+		}
+	}
+}
+
+func extraAzureSecretFlagHandlingFuncImpl(c *AzureSecretCommand, _ *base.FlagSets, opts *[]credentiallibraries.Option) bool {
+	switch c.flagSecretName {
+	case "":
+	default:
+		*opts = append(*opts, credentiallibraries.WithAzureSecretCredentialLibrarySecretName(c.flagSecretName))
+	}
+	switch c.flagSecretVersion {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultAzureSecretCredentialLibrarySecretVersion())
+	default:
+		*opts = append(*opts, credentiallibraries.WithAzureSecretCredentialLibrarySecretVersion(c.flagSecretVersion))
+	}
+	switch c.flagCredentialType {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultCredentialType())
+	default:
+		*opts = append(*opts, credentiallibraries.WithCredentialType(c.flagCredentialType))
+	}
+
+	return true
+}
+
+func (c *AzureSecretCommand) extraAzureSecretHelpFunc(_ map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-libraries create azure-secret -credential-store-id [options] [args]",
+			"",
+			"  Create an azure-secret-type credential library. Example:",
+			"",
+			`    $ boundary credential-libraries create azure-secret -credential-store-id csazr_1234567890 -secret-name "db-creds"`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-libraries update azure-secret [options] [args]",
+			"",
+			"  Update an azure-secret-type credential library given its ID. Example:",
+			"",
+			`    $ boundary credential-libraries update azure-secret -id clazr_1234567890 -name devops -description "For DevOps usage"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
@@ -151,6 +151,8 @@ func printItemTable(item *credentiallibraries.CredentialLibrary, resp *api.Respo
 		keySubstMap = genericKeySubstMap
 	case "vault-ssh-certificate":
 		keySubstMap = sshCertKeySubstMap
+	case "azure-secret":
+		keySubstMap = azureSecretKeySubstMap
 	}
 
 	maxLength := base.MaxAttributesLength(nonAttributeMap, item.Attributes, keySubstMap)
@@ -203,9 +205,13 @@ func printItemTable(item *credentiallibraries.CredentialLibrary, resp *api.Respo
 }
 
 var genericKeySubstMap = map[string]string{
-	"path":              "Path",
-	"http_method":       "HTTP Method",
-	"http_request_body": "HTTP Request Body",
+	"path":                    "Path",
+	"http_method":             "HTTP Method",
+	"http_request_body":       "HTTP Request Body",
+	"mapping_expression":      "Mapping Expression",
+	"kv_secret_version":       "KV Secret Version",
+	"max_requests_per_minute": "Max Requests Per Minute",
+	"namespace":               "Namespace",
 }
 
 var sshCertKeySubstMap = map[string]string{
@@ -218,3 +224,8 @@ var sshCertKeySubstMap = map[string]string{
 	"critical_options": "Critical Options",
 	"extensions":       "Extensions",
 }
+
+var azureSecretKeySubstMap = map[string]string{
+	"secret_name":    "Secret Name",
+	"secret_version": "Secret Version",
+}
@@ -4,6 +4,9 @@
 package credentiallibrariescmd
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/hashicorp/boundary/api/credentiallibraries"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/go-secure-stdlib/parseutil"
@@ -44,6 +47,30 @@ func extraVaultFlagsFuncImpl(c *VaultCommand, set *base.FlagSets, _ *base.FlagSe
 				Target: &c.flagHttpRequestBody,
 				Usage:  "The http request body the library uses to communicate with vault. This can be the value itself, refer to a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read.",
 			})
+		case mappingExpressionFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   mappingExpressionFlagName,
+				Target: &c.flagMappingExpression,
+				Usage:  "The expression used to map the secret returned by vault to the attributes of the credential type, one 'attribute = template' assignment per line. This can be the value itself, refer to a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read.",
+			})
+		case kvSecretVersionFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   kvSecretVersionFlagName,
+				Target: &c.flagKvSecretVersion,
+				Usage:  "The version of the KV-v2 secret the library reads from vault, defaults to the latest version. Can only be used with the GET http method.",
+			})
+		case maxRequestsPerMinuteFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   maxRequestsPerMinuteFlagName,
+				Target: &c.flagMaxRequestsPerMinute,
+				Usage:  "The maximum number of requests per minute the library sends to vault. Requests are not limited if not set.",
+			})
+		case namespaceFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   namespaceFlagName,
+				Target: &c.flagNamespace,
+				Usage:  "The vault namespace the library reads credentials from, overriding the namespace of the credential store.",
+			})
 		case credentialTypeFlagName:
 			f.StringVar(&base.StringVar{
 				Name:   credentialTypeFlagName,
@@ -82,6 +109,45 @@ func extraVaultFlagHandlingFuncImpl(c *VaultCommand, _ *base.FlagSets, opts *[]c
 		rb, _ := parseutil.ParsePath(c.flagHttpRequestBody)
 		*opts = append(*opts, credentiallibraries.WithVaultCredentialLibraryHttpRequestBody(rb))
 	}
+	switch c.flagMappingExpression {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultVaultCredentialLibraryMappingExpression())
+	default:
+		me, _ := parseutil.ParsePath(c.flagMappingExpression)
+		*opts = append(*opts, credentiallibraries.WithVaultCredentialLibraryMappingExpression(me))
+	}
+	switch c.flagKvSecretVersion {
+	case "":
+	case "0", "null":
+		*opts = append(*opts, credentiallibraries.DefaultVaultCredentialLibraryKvSecretVersion())
+	default:
+		v, err := strconv.ParseUint(c.flagKvSecretVersion, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagKvSecretVersion, err))
+			return false
+		}
+		*opts = append(*opts, credentiallibraries.WithVaultCredentialLibraryKvSecretVersion(uint32(v)))
+	}
+	switch c.flagMaxRequestsPerMinute {
+	case "":
+	case "0", "null":
+		*opts = append(*opts, credentiallibraries.DefaultVaultCredentialLibraryMaxRequestsPerMinute())
+	default:
+		v, err := strconv.ParseUint(c.flagMaxRequestsPerMinute, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagMaxRequestsPerMinute, err))
+			return false
+		}
+		*opts = append(*opts, credentiallibraries.WithVaultCredentialLibraryMaxRequestsPerMinute(uint32(v)))
+	}
+	switch c.flagNamespace {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultVaultCredentialLibraryNamespace())
+	default:
+		*opts = append(*opts, credentiallibraries.WithVaultCredentialLibraryNamespace(c.flagNamespace))
+	}
 	switch c.flagCredentialType {
 	case "":
 	case "null":
@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentialscmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/credentials"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*BatchCreateCommand)(nil)
+	_ cli.CommandAutocomplete = (*BatchCreateCommand)(nil)
+)
+
+// BatchCreateCommand creates the credentials of a file in a static credential
+// store in a single request. Either all of the credentials are created or
+// none of them are.
+type BatchCreateCommand struct {
+	*base.Command
+
+	flagFile string
+}
+
+func (c *BatchCreateCommand) Synopsis() string {
+	return "Create multiple credentials in a single transaction"
+}
+
+func (c *BatchCreateCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary credentials batch-create [options] [args]",
+		"",
+		"  Create the credentials of a file in a static credential store in a single transaction. Example:",
+		"",
+		`    $ boundary credentials batch-create -credential-store-id csst_1234567890 -file credentials.json`,
+		"",
+		"  The file is a JSON array of credentials, each with a type, attributes, and an optional",
+		"  name and description, in the format of the API. Example:",
+		"",
+		`    [{"type": "username_password", "name": "db", "attributes": {"username": "admin", "password": "secret"}}]`,
+		"",
+		fmt.Sprintf("  Up to %d credentials can be created at once. If any credential cannot be created,", maxBatchCreateCredentials),
+		"  none of them are.",
+		"",
+	}) + c.Flags().Help()
+}
+
+// maxBatchCreateCredentials is the maximum number of credentials the
+// controller creates in a single request.
+const maxBatchCreateCredentials = 1000
+
+func (c *BatchCreateCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "credential-store-id",
+		Target: &c.FlagCredentialStoreId,
+		Usage:  "The credential store to create the credentials in.",
+	})
+	f.StringVar(&base.StringVar{
+		Name:       "file",
+		Target:     &c.flagFile,
+		Completion: complete.PredictFiles("*.json"),
+		Usage:      "The path of the file containing the credentials.",
+	})
+
+	return set
+}
+
+func (c *BatchCreateCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *BatchCreateCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *BatchCreateCommand) Run(args []string) int {
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	switch {
+	case c.FlagCredentialStoreId == "":
+		c.PrintCliError(errors.New("Credential store ID must be passed in via -credential-store-id"))
+		return base.CommandUserError
+	case c.flagFile == "":
+		c.PrintCliError(errors.New("Credentials file must be passed in via -file"))
+		return base.CommandUserError
+	}
+
+	b, err := os.ReadFile(c.flagFile)
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error reading credentials file: %w", err))
+		return base.CommandUserError
+	}
+	items, err := parseBatchCreateFile(b)
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error parsing credentials file: %w", err))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	result, err := credentials.NewClient(client).BatchCreate(c.Context, c.FlagCredentialStoreId, items)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when performing batch-create on credentials")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to batch-create credentials: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		if ok := c.PrintJsonItems(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+	default:
+		c.UI.Output(new(Command).printListTable(result.GetItems()))
+	}
+	return base.CommandSuccess
+}
+
+// parseBatchCreateFile parses a JSON array of credentials.
+func parseBatchCreateFile(b []byte) ([]*credentials.Credential, error) {
+	var items []*credentials.Credential
+	if err := json.Unmarshal(b, &items); err != nil {
+		return nil, err
+	}
+	switch {
+	case len(items) == 0:
+		return nil, errors.New("no credentials found")
+	case len(items) > maxBatchCreateCredentials:
+		return nil, fmt.Errorf("%d credentials found, at most %d can be created at once", len(items), maxBatchCreateCredentials)
+	}
+	for i, item := range items {
+		switch {
+		case item == nil:
+			return nil, fmt.Errorf("credential %d is empty", i)
+		case item.Type == "":
+			return nil, fmt.Errorf("credential %d has no type", i)
+		}
+	}
+	return items, nil
+}
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentialscmd
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBatchCreateFile(t *testing.T) {
+	file := `[
+		{"type": "username_password", "name": "db", "attributes": {"username": "admin", "password": "hunter2"}},
+		{"type": "json", "description": "api keys", "attributes": {"object": {"api_key": "k"}}}
+	]`
+	items, err := parseBatchCreateFile([]byte(file))
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, "username_password", items[0].Type)
+	assert.Equal(t, "db", items[0].Name)
+	assert.Equal(t, map[string]any{"username": "admin", "password": "hunter2"}, items[0].Attributes)
+	assert.Equal(t, "json", items[1].Type)
+	assert.Equal(t, "api keys", items[1].Description)
+
+	tooMany := "[" + strings.TrimSuffix(strings.Repeat(`{"type": "json"},`, maxBatchCreateCredentials+1), ",") + "]"
+	for _, bad := range []string{
+		`[]`,
+		`[null]`,
+		`[{"name": "no-type"}]`,
+		`{"type": "json"}`,
+		tooMany,
+	} {
+		_, err := parseBatchCreateFile([]byte(bad))
+		assert.Error(t, err, fmt.Sprintf("%.40s", bad))
+	}
+}
@@ -18,8 +18,26 @@ const (
 	privateKeyFlagName           = "private-key"
 	privateKeyPassphraseFlagName = "private-key-passphrase"
 	secretFlagName               = "secret"
+	includeSecretFlagName        = "include-secret"
+	certificateFlagName          = "certificate"
 )
 
+func init() {
+	extraActionsFlagsMapFunc = extraActionsFlagsMapFuncImpl
+	extraFlagsFunc = extraFlagsFuncImpl
+	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
+}
+
+type extraCmdVars struct {
+	flagIncludeSecret bool
+}
+
+func extraActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"read": {includeSecretFlagName},
+	}
+}
+
 func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 	var helpStr string
 	switch c.Func {
@@ -65,6 +83,26 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 	return helpStr + c.Flags().Help()
 }
 
+func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
+	for _, name := range flagsMap[c.Func] {
+		switch name {
+		case includeSecretFlagName:
+			f.BoolVar(&base.BoolVar{
+				Name:   includeSecretFlagName,
+				Target: &c.flagIncludeSecret,
+				Usage:  "If set, the credential's secret material is included in the output. This requires the read:secret action on the credential.",
+			})
+		}
+	}
+}
+
+func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]credentials.Option) bool {
+	if c.flagIncludeSecret {
+		*opts = append(*opts, credentials.WithIncludeSecret(true))
+	}
+	return true
+}
+
 func (c *Command) printListTable(items []*credentials.Credential) string {
 	if len(items) == 0 {
 		return "No credentials found"
@@ -183,7 +221,12 @@ func printItemTable(item *credentials.Credential, resp *api.Response) string {
 
 var keySubstMap = map[string]string{
 	"username":                    "Username",
+	"password":                    "Password",
 	"password_hmac":               "Password HMAC",
+	"private_key":                 "Private Key",
 	"private_key_hmac":            "Private Key HMAC",
+	"private_key_passphrase":      "Private Key Passphrase",
 	"private_key_passphrase_hmac": "Private Key Passphrase HMAC",
+	"object":                      "Object",
+	"certificate":                 "Certificate",
 }
@@ -0,0 +1,419 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentialscmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/credentiallibraries"
+	"github.com/hashicorp/boundary/api/credentials"
+	"github.com/hashicorp/boundary/api/credentialstores"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*ImportCommand)(nil)
+	_ cli.CommandAutocomplete = (*ImportCommand)(nil)
+)
+
+const (
+	usernamePasswordCredentialType = "username_password"
+	sshPrivateKeyCredentialType    = "ssh_private_key"
+	jsonCredentialType             = "json"
+)
+
+// ImportCommand imports the secrets of a Vault KV export into a credential
+// store. Secrets are created as static credentials in static credential stores
+// and as generic credential libraries reading the secrets in Vault credential
+// stores.
+type ImportCommand struct {
+	*base.Command
+
+	flagFile           string
+	flagVaultKvMount   string
+	flagVaultKvVersion int
+}
+
+func (c *ImportCommand) Synopsis() string {
+	return "Import the secrets of a Vault KV export into a credential store"
+}
+
+func (c *ImportCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary credentials import [options] [args]",
+		"",
+		"  Import the secrets of a Vault KV export into a credential store. Example:",
+		"",
+		`    $ boundary credentials import -credential-store-id csst_1234567890 -file kv-export.json`,
+		"",
+		"  The export is a JSON object mapping the path of each secret to its data, or to the",
+		`  output of "vault kv get -format=json" for the secret. Secrets with only "username" and`,
+		`  "password" fields are imported as username/password credentials, secrets with only`,
+		`  "username", "private_key" and optionally "private_key_passphrase" fields as SSH private`,
+		"  key credentials, and other secrets as JSON credentials. Each credential is named after",
+		"  the path of its secret.",
+		"",
+		"  If the credential store is a Vault credential store, a generic credential library",
+		"  reading each secret from the KV secrets engine is created instead of a credential.",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *ImportCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "credential-store-id",
+		Target: &c.FlagCredentialStoreId,
+		Usage:  "The credential store to import the secrets into.",
+	})
+	f.StringVar(&base.StringVar{
+		Name:       "file",
+		Target:     &c.flagFile,
+		Completion: complete.PredictFiles("*.json"),
+		Usage:      "The path of the Vault KV export.",
+	})
+	f.StringVar(&base.StringVar{
+		Name:    "vault-kv-mount",
+		Target:  &c.flagVaultKvMount,
+		Default: "secret",
+		Usage:   "The mount path of the KV secrets engine the secrets were exported from. Only used with Vault credential stores.",
+	})
+	f.IntVar(&base.IntVar{
+		Name:    "vault-kv-version",
+		Target:  &c.flagVaultKvVersion,
+		Default: 2,
+		Usage:   "The version of the KV secrets engine the secrets were exported from, 1 or 2. Only used with Vault credential stores.",
+	})
+
+	return set
+}
+
+func (c *ImportCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *ImportCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+// importResult is the outcome of the import of a single secret.
+type importResult struct {
+	Path  string `json:"path"`
+	Id    string `json:"id,omitempty"`
+	Type  string `json:"type,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+func (c *ImportCommand) Run(args []string) int {
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	switch {
+	case c.FlagCredentialStoreId == "":
+		c.PrintCliError(errors.New("Credential store ID must be passed in via -credential-store-id"))
+		return base.CommandUserError
+	case c.flagFile == "":
+		c.PrintCliError(errors.New("Export file must be passed in via -file"))
+		return base.CommandUserError
+	case c.flagVaultKvVersion != 1 && c.flagVaultKvVersion != 2:
+		c.PrintCliError(errors.New("Vault KV version must be 1 or 2"))
+		return base.CommandUserError
+	}
+
+	b, err := os.ReadFile(c.flagFile)
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error reading export file: %w", err))
+		return base.CommandUserError
+	}
+	secrets, err := parseVaultKvExport(b)
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error parsing export file: %w", err))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	csResult, err := credentialstores.NewClient(client).Read(c.Context, c.FlagCredentialStoreId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when performing read on credential store")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to read credential store: %w", err))
+		return base.CommandCliError
+	}
+
+	var importFn func(vaultKvSecret) (string, error)
+	switch csType := csResult.GetItem().Type; csType {
+	case "static":
+		credentialsClient := credentials.NewClient(client)
+		importFn = func(s vaultKvSecret) (string, error) {
+			res, err := credentialsClient.Create(c.Context, s.credentialType(), c.FlagCredentialStoreId, s.credentialOptions()...)
+			if err != nil {
+				return "", err
+			}
+			return res.GetItem().Id, nil
+		}
+	case "vault":
+		librariesClient := credentiallibraries.NewClient(client)
+		importFn = func(s vaultKvSecret) (string, error) {
+			opts := []credentiallibraries.Option{
+				credentiallibraries.WithName(s.path),
+				credentiallibraries.WithDescription(fmt.Sprintf("Imported from Vault KV path %s", s.path)),
+				credentiallibraries.WithVaultCredentialLibraryPath(s.vaultPath(c.flagVaultKvMount, c.flagVaultKvVersion)),
+			}
+			if t := s.credentialType(); t != jsonCredentialType {
+				opts = append(opts, credentiallibraries.WithCredentialType(t))
+			}
+			res, err := librariesClient.Create(c.Context, "vault-generic", c.FlagCredentialStoreId, opts...)
+			if err != nil {
+				return "", err
+			}
+			return res.GetItem().Id, nil
+		}
+	default:
+		c.PrintCliError(fmt.Errorf("Secrets cannot be imported into %s credential stores", csType))
+		return base.CommandUserError
+	}
+
+	results := make([]importResult, 0, len(secrets))
+	var failed bool
+	for _, s := range secrets {
+		r := importResult{Path: s.path, Type: s.credentialType()}
+		id, err := importFn(s)
+		if err != nil {
+			failed = true
+			if apiErr := api.AsServerError(err); apiErr != nil {
+				r.Error = apiErr.Message
+			} else {
+				r.Error = err.Error()
+			}
+		}
+		r.Id = id
+		results = append(results, r)
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		b, err := base.JsonFormatter{}.Format(struct {
+			Items []importResult `json:"items"`
+		}{Items: results})
+		if err != nil {
+			c.PrintCliError(fmt.Errorf("Error formatting as JSON: %w", err))
+			return base.CommandCliError
+		}
+		c.UI.Output(string(b))
+	default:
+		c.UI.Output(printImportTable(results))
+	}
+
+	if failed {
+		return base.CommandApiError
+	}
+	return base.CommandSuccess
+}
+
+func printImportTable(results []importResult) string {
+	if len(results) == 0 {
+		return "No secrets found"
+	}
+
+	output := []string{
+		"",
+		"Imported secrets:",
+	}
+	for i, r := range results {
+		if i > 0 {
+			output = append(output, "")
+		}
+		output = append(output,
+			fmt.Sprintf("  Path:                  %s", r.Path),
+		)
+		if r.Id != "" {
+			output = append(output,
+				fmt.Sprintf("    ID:                  %s", r.Id),
+			)
+		}
+		if r.Type != "" {
+			output = append(output,
+				fmt.Sprintf("    Type:                %s", r.Type),
+			)
+		}
+		if r.Error != "" {
+			output = append(output,
+				fmt.Sprintf("    Error:               %s", r.Error),
+			)
+		}
+	}
+
+	return base.WrapForHelpText(output)
+}
+
+// vaultKvSecret is a secret of a Vault KV export.
+type vaultKvSecret struct {
+	path string
+	data map[string]any
+}
+
+// vaultResponseFields are the fields of the responses of Vault, which wrap
+// the data of the secrets in the output of "vault kv get -format=json".
+var vaultResponseFields = map[string]bool{
+	"request_id":     true,
+	"lease_id":       true,
+	"lease_duration": true,
+	"renewable":      true,
+	"data":           true,
+	"metadata":       true,
+	"warnings":       true,
+	"wrap_info":      true,
+	"auth":           true,
+	"mount_type":     true,
+}
+
+// parseVaultKvExport parses a Vault KV export, which is a JSON object
+// mapping the path of each secret to its data. The data of a secret can be
+// wrapped in the output of "vault kv get -format=json" for the secret, using
+// version 1 or 2 of the KV secrets engine. The secrets are returned sorted by
+// path.
+func parseVaultKvExport(b []byte) ([]vaultKvSecret, error) {
+	var export map[string]map[string]any
+	if err := json.Unmarshal(b, &export); err != nil {
+		return nil, err
+	}
+	secrets := make([]vaultKvSecret, 0, len(export))
+	for p, data := range export {
+		p = strings.Trim(p, "/")
+		if p == "" {
+			return nil, errors.New("secret with empty path")
+		}
+		// The output of KV version 2 wraps the data twice, first in the
+		// response of Vault and then with the metadata of the secret.
+		for i := 0; i < 2 && isVaultResponse(data); i++ {
+			data = data["data"].(map[string]any)
+		}
+		if len(data) == 0 {
+			return nil, fmt.Errorf("secret %s has no data", p)
+		}
+		secrets = append(secrets, vaultKvSecret{path: p, data: data})
+	}
+	sort.Slice(secrets, func(i, j int) bool { return secrets[i].path < secrets[j].path })
+	return secrets, nil
+}
+
+func isVaultResponse(m map[string]any) bool {
+	if _, ok := m["data"].(map[string]any); !ok {
+		return false
+	}
+	for k := range m {
+		if !vaultResponseFields[k] {
+			return false
+		}
+	}
+	return true
+}
+
+// stringFields returns the values of the fields of s if s has exactly the
+// required fields and any of the optional fields, all of them strings.
+func (s vaultKvSecret) stringFields(required []string, optional ...string) (map[string]string, bool) {
+	if len(s.data) < len(required) || len(s.data) > len(required)+len(optional) {
+		return nil, false
+	}
+	fields := make(map[string]string, len(s.data))
+	for _, k := range required {
+		v, ok := s.data[k].(string)
+		if !ok || v == "" {
+			return nil, false
+		}
+		fields[k] = v
+	}
+	for _, k := range optional {
+		if raw, ok := s.data[k]; ok {
+			v, ok := raw.(string)
+			if !ok {
+				return nil, false
+			}
+			fields[k] = v
+		}
+	}
+	if len(fields) != len(s.data) {
+		return nil, false
+	}
+	return fields, true
+}
+
+// credentialType returns the type of credential the secret is imported as.
+func (s vaultKvSecret) credentialType() string {
+	if _, ok := s.stringFields([]string{"username", "password"}); ok {
+		return usernamePasswordCredentialType
+	}
+	if _, ok := s.stringFields([]string{"username", "private_key"}, "private_key_passphrase"); ok {
+		return sshPrivateKeyCredentialType
+	}
+	return jsonCredentialType
+}
+
+// credentialOptions returns the options creating the static credential of
+// the secret.
+func (s vaultKvSecret) credentialOptions() []credentials.Option {
+	opts := []credentials.Option{
+		credentials.WithName(s.path),
+		credentials.WithDescription(fmt.Sprintf("Imported from Vault KV path %s", s.path)),
+	}
+	switch s.credentialType() {
+	case usernamePasswordCredentialType:
+		fields, _ := s.stringFields([]string{"username", "password"})
+		opts = append(opts,
+			credentials.WithUsernamePasswordCredentialUsername(fields["username"]),
+			credentials.WithUsernamePasswordCredentialPassword(fields["password"]),
+		)
+	case sshPrivateKeyCredentialType:
+		fields, _ := s.stringFields([]string{"username", "private_key"}, "private_key_passphrase")
+		opts = append(opts,
+			credentials.WithSshPrivateKeyCredentialUsername(fields["username"]),
+			credentials.WithSshPrivateKeyCredentialPrivateKey(fields["private_key"]),
+		)
+		if fields["private_key_passphrase"] != "" {
+			opts = append(opts, credentials.WithSshPrivateKeyCredentialPrivateKeyPassphrase(fields["private_key_passphrase"]))
+		}
+	default:
+		opts = append(opts, credentials.WithJsonCredentialObject(s.data))
+	}
+	return opts
+}
+
+// vaultPath returns the path reading the secret from the KV secrets engine of
+// the given version mounted at mount.
+func (s vaultKvSecret) vaultPath(mount string, version int) string {
+	mount = strings.Trim(mount, "/")
+	if version == 2 {
+		return path.Join(mount, "data", s.path)
+	}
+	return path.Join(mount, s.path)
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentialscmd
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api/credentials"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+)
+
+func init() {
+	extraX509CertificateFlagsFunc = extraX509CertificateFlagsFuncImpl
+	extraX509CertificateActionsFlagsMapFunc = extraX509CertificateActionsFlagsMapFuncImpl
+	extraX509CertificateFlagsHandlingFunc = extraX509CertificateFlagHandlingFuncImpl
+}
+
+type extraX509CertificateCmdVars struct {
+	flagCertificate string
+	flagPrivateKey  string
+}
+
+func extraX509CertificateActionsFlagsMapFuncImpl() map[string][]string {
+	flags := map[string][]string{
+		"create": {
+			certificateFlagName,
+			privateKeyFlagName,
+		},
+	}
+	flags["update"] = flags["create"]
+	return flags
+}
+
+func extraX509CertificateFlagsFuncImpl(c *X509CertificateCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("X.509 Certificate Credential Options")
+
+	for _, name := range flagsX509CertificateMap[c.Func] {
+		switch name {
+		case certificateFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   certificateFlagName,
+				Target: &c.flagCertificate,
+				Usage:  "The PEM encoded X.509 client certificate associated with the credential, optionally followed by the intermediate certificates of its chain. This can refer to a file on disk (file://) from which the value will be read or an env var (env://) from which the value will be read.",
+			})
+		case privateKeyFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   privateKeyFlagName,
+				Target: &c.flagPrivateKey,
+				Usage:  "The PEM encoded private key of the certificate. This can refer to a file on disk (file://) from which the value will be read or an env var (env://) from which the value will be read.",
+			})
+		}
+	}
+}
+
+func extraX509CertificateFlagHandlingFuncImpl(c *X509CertificateCommand, _ *base.FlagSets, opts *[]credentials.Option) bool {
+	// If neither is set (e.g. just a name update) then there is nothing to
+	// check
+	if c.flagCertificate == "" && c.flagPrivateKey == "" {
+		return true
+	}
+	// The private key must match the certificate, so they are always set
+	// together
+	if c.flagCertificate == "" || c.flagPrivateKey == "" {
+		c.UI.Error("Certificate and private key flags must be used together")
+		return false
+	}
+
+	certificate, err := parseutil.MustParsePath(c.flagCertificate)
+	switch {
+	case err == nil:
+	case errors.Is(err, parseutil.ErrNotParsed):
+		c.UI.Error("Certificate flag must be used with env:// or file:// syntax")
+		return false
+	default:
+		c.UI.Error(fmt.Sprintf("Error parsing certificate flag: %v", err))
+		return false
+	}
+
+	privateKey, err := parseutil.MustParsePath(c.flagPrivateKey)
+	switch {
+	case err == nil:
+	case errors.Is(err, parseutil.ErrNotParsed):
+		c.UI.Error("Private key flag must be used with env:// or file:// syntax")
+		return false
+	default:
+		c.UI.Error(fmt.Sprintf("Error parsing private key flag: %v", err))
+		return false
+	}
+
+	if _, err := tls.X509KeyPair([]byte(certificate), []byte(privateKey)); err != nil {
+		c.UI.Error(fmt.Sprintf("Error parsing certificate and private key: %v", err))
+		return false
+	}
+
+	*opts = append(*opts,
+		credentials.WithX509CertificateCredentialCertificate(certificate),
+		credentials.WithX509CertificateCredentialPrivateKey(privateKey),
+	)
+
+	return true
+}
+
+func (c *X509CertificateCommand) extraX509CertificateHelpFunc(_ map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credentials create x509-certificate -credential-store-id [options] [args]",
+			"",
+			"  Create an X.509 certificate credential. Example:",
+			"",
+			`    $ boundary credentials create x509-certificate -credential-store-id csst_1234567890 -certificate file:///home/user/client.crt -private-key file:///home/user/client.key`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credentials update x509-certificate [options] [args]",
+			"",
+			"  Update an X.509 certificate credential given its ID. Example:",
+			"",
+			`    $ boundary credentials update x509-certificate -id credx509_1234567890 -certificate file:///home/user/client.crt -private-key file:///home/user/client.key`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentialstorescmd
+
+import (
+	"github.com/hashicorp/boundary/api/credentialstores"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+)
+
+func init() {
+	extraAzureFlagsFunc = extraAzureFlagsFuncImpl
+	extraAzureActionsFlagsMapFunc = extraAzureActionsFlagsMapFuncImpl
+	extraAzureFlagsHandlingFunc = extraAzureFlagHandlingFuncImpl
+}
+
+const (
+	vaultUriFlagName                = "vault-uri"
+	managedIdentityClientIdFlagName = "managed-identity-client-id"
+)
+
+type extraAzureCmdVars struct {
+	flagVaultUri                string
+	flagManagedIdentityClientId string
+}
+
+func extraAzureActionsFlagsMapFuncImpl() map[string][]string {
+	flags := map[string][]string{
+		"create": {
+			vaultUriFlagName,
+			managedIdentityClientIdFlagName,
+		},
+	}
+	flags["update"] = flags["create"]
+	return flags
+}
+
+func extraAzureFlagsFuncImpl(c *AzureCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("Azure Credential Store Options")
+
+	for _, name := range flagsAzureMap[c.Func] {
+		switch name {
+		case vaultUriFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   vaultUriFlagName,
+				Target: &c.flagVaultUri,
+				Usage:  "The URI of the Azure Key Vault. This should be a complete URL such as https://myvault.vault.azure.net",
+			})
+		case managedIdentityClientIdFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   managedIdentityClientIdFlagName,
+				Target: &c.flagManagedIdentityClientId,
+				Usage:  "The client ID of the user-assigned managed identity boundary uses to connect to Azure Key Vault. If not set, the system-assigned managed identity is used.",
+			})
+		}
+	}
+}
+
+func extraAzureFlagHandlingFuncImpl(c *AzureCommand, _ *base.FlagSets, opts *[]credentialstores.Option) bool {
+	switch c.flagVaultUri {
+	case "":
+	default:
+		*opts = append(*opts, credentialstores.WithAzureCredentialStoreVaultUri(c.flagVaultUri))
+	}
+	switch c.flagManagedIdentityClientId {
+	case "":
+	case "null":
+		*opts = append(*opts, credentialstores.DefaultAzureCredentialStoreManagedIdentityClientId())
+	default:
+		*opts = append(*opts, credentialstores.WithAzureCredentialStoreManagedIdentityClientId(c.flagManagedIdentityClientId))
+	}
+
+	return true
+}
+
+func (c *AzureCommand) extraAzureHelpFunc(_ map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-stores create azure [options] [args]",
+			"",
+			"  Create an azure-type credential store. Example:",
+			"",
+			`    $ boundary credential-stores create azure -vault-uri "https://myvault.vault.azure.net"`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-stores update azure [options] [args]",
+			"",
+			"  Update an azure-type credential store given its ID. Example:",
+			"",
+			`    $ boundary credential-stores update azure -id csazr_1234567890 -name devops -description "For DevOps usage"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
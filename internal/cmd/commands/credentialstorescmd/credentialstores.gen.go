@@ -169,6 +169,14 @@ func (c *Command) Run(args []string) int {
 	}
 	credentialstoresClient := credentialstores.NewClient(client)
 
+	switch {
+	case c.Func == "create", c.Func == "list" && !c.FlagRecursive:
+		if err := c.CheckAuthorizedAction(client, c.FlagScopeId, "credential-store", c.Func); err != nil {
+			c.PrintCliError(err)
+			return base.CommandUserError
+		}
+	}
+
 	switch c.FlagRecursive {
 	case true:
 		opts = append(opts, credentialstores.WithRecursive(true))
@@ -42,6 +42,10 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			`      $ boundary credential-stores create static -scope-id p_1234567890`,
 			"",
+			"    Create an azure-type credential store:",
+			"",
+			`      $ boundary credential-stores create azure -scope-id p_1234567890 -vault-uri "https://myvault.vault.azure.net"`,
+			"",
 			"  Please see the typed subcommand help for detailed usage information.",
 		})
 	case "update":
@@ -58,6 +62,10 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			`      $ boundary credential-stores update static -id cs_1234567890 -name devops -description "For DevOps usage"`,
 			"",
+			"    Update an azure-type credential store:",
+			"",
+			`      $ boundary credential-stores update azure -id csazr_1234567890 -name devops -description "For DevOps usage"`,
+			"",
 			"  Please see the typed subcommand help for detailed usage information.",
 		})
 	default:
@@ -114,6 +122,11 @@ func (c *Command) printListTable(items []*credentialstores.CredentialStore) stri
 				fmt.Sprintf("    Description:         %s", m.Description),
 			)
 		}
+		if m.Health != nil && m.Health.Status != "" {
+			output = append(output,
+				fmt.Sprintf("    Health:              %s", m.Health.Status),
+			)
+		}
 		if len(m.AuthorizedActions) > 0 {
 			output = append(output,
 				"    Authorized Actions:",
@@ -165,6 +178,29 @@ func printItemTable(item *credentialstores.CredentialStore, resp *api.Response)
 		)
 	}
 
+	if item.Health != nil {
+		healthMap := map[string]any{
+			"Status": item.Health.Status,
+		}
+		if item.Health.Message != "" {
+			healthMap["Message"] = item.Health.Message
+		}
+		if !item.Health.CheckTime.IsZero() {
+			healthMap["Check Time"] = item.Health.CheckTime.Local().Format(time.RFC1123)
+		}
+		if !item.Health.TokenExpirationTime.IsZero() {
+			healthMap["Token Expiration Time"] = item.Health.TokenExpirationTime.Local().Format(time.RFC1123)
+		}
+		if !item.Health.CertificateExpirationTime.IsZero() {
+			healthMap["Certificate Expiration Time"] = item.Health.CertificateExpirationTime.Local().Format(time.RFC1123)
+		}
+		ret = append(ret,
+			"",
+			"  Health:",
+			base.WrapMap(4, base.MaxAttributesLength(healthMap, nil, nil), healthMap),
+		)
+	}
+
 	if len(item.AuthorizedActions) > 0 {
 		ret = append(ret,
 			"",
@@ -212,4 +248,6 @@ var keySubstMap = map[string]string{
 	"client_certificate":          "Client Certificate",
 	"client_certificate_key_hmac": "Client Certificate Key HMAC",
 	"worker_filter":               "Worker Filter",
+	"vault_uri":                   "Vault URI",
+	"managed_identity_client_id":  "Managed Identity Client ID",
 }
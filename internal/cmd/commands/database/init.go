@@ -256,7 +256,7 @@ func (c *InitCommand) Run(args []string) (retCode int) {
 		return base.CommandUserError
 	}
 
-	migrationUrl, err := parseutil.ParsePath(migrationUrlToParse)
+	migrationUrl, err := config.ParseSecretPath(migrationUrlToParse)
 	if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
 		c.UI.Error(fmt.Errorf("Error parsing migration url: %w", err).Error())
 		return base.CommandUserError
@@ -277,7 +277,7 @@ func (c *InitCommand) Run(args []string) (retCode int) {
 		c.UI.Error(`"url" not specified in "database" config block`)
 		return base.CommandUserError
 	}
-	c.DatabaseUrl, err = parseutil.ParsePath(urlToParse)
+	c.DatabaseUrl, err = config.ParseSecretPath(urlToParse)
 	if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
 		c.UI.Error(fmt.Errorf("Error parsing database url: %w", err).Error())
 		return base.CommandUserError
@@ -258,7 +258,7 @@ plugins {
 		return base.CommandUserError
 	}
 
-	migrationUrl, err := parseutil.ParsePath(migrationUrlToParse)
+	migrationUrl, err := config.ParseSecretPath(migrationUrlToParse)
 	if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
 		c.UI.Error(fmt.Errorf("Error parsing migration url: %w", err).Error())
 		return base.CommandUserError
@@ -95,6 +95,7 @@ type Command struct {
 	flagWorkerAuthStorageDir         string
 	flagWorkerAuthStorageSkipCleanup bool
 	flagWorkerAuthRotationInterval   time.Duration
+	flagSeed                         string
 }
 
 func (c *Command) Synopsis() string {
@@ -365,6 +366,15 @@ func (c *Command) Flags() *base.FlagSets {
 		Usage:  "Prevents deletion of temp worker credential storage directory if set.",
 	})
 
+	f.StringVar(&base.StringVar{
+		Name:       "seed",
+		Target:     &c.flagSeed,
+		Default:    seedProfileNone,
+		EnvVar:     "BOUNDARY_DEV_SEED",
+		Completion: complete.PredictSet(seedProfiles...),
+		Usage:      `Populates the dev environment with demo data. Valid values are "none"; "basic" for orgs and projects with targets and static credentials; and "demo" for the basic data along with an LDAP auth method backed by a bundled directory, managed groups, and past sessions.`,
+	})
+
 	f.BoolVar(&base.BoolVar{
 		Name:   "create-loopback-host-plugin",
 		Target: &c.flagCreateLoopbackHostPlugin,
@@ -418,6 +428,11 @@ func (c *Command) Run(args []string) int {
 		return base.CommandUserError
 	}
 
+	if !strutil.StrListContains(seedProfiles, c.flagSeed) {
+		c.UI.Error(fmt.Sprintf("Invalid seed profile %q, must be one of %s", c.flagSeed, strings.Join(seedProfiles, ", ")))
+		return base.CommandUserError
+	}
+
 	if c.flagWorkerAuthKey != "" {
 		c.Config.DevWorkerAuthKey = c.flagWorkerAuthKey
 		for _, kms := range c.Config.Seals {
@@ -833,6 +848,19 @@ func (c *Command) Run(args []string) int {
 		}
 	}
 
+	if err := c.seed(c.Context, c.flagSeed); err != nil {
+		c.UI.Error(fmt.Errorf("Error seeding dev environment: %w", err).Error())
+		if c.worker != nil {
+			if err := c.worker.Shutdown(); err != nil {
+				c.UI.Error(fmt.Errorf("Error shutting down worker: %w", err).Error())
+			}
+		}
+		if err := c.controller.Shutdown(); err != nil {
+			c.UI.Error(fmt.Errorf("Error with controller shutdown: %w", err).Error())
+		}
+		return base.CommandCliError
+	}
+
 	c.PrintInfo(c.UI)
 	if err := c.ReleaseLogGate(); err != nil {
 		c.UI.Error(fmt.Errorf("Error releasing event gate: %w", err).Error())
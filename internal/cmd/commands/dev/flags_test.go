@@ -70,6 +70,7 @@ func TestCommand_Flags(t *testing.T) {
 	assert.Contains(completions, "-worker-auth-method")
 	assert.Contains(completions, "-worker-auth-storage-dir")
 	assert.Contains(completions, "-worker-auth-storage-skip-cleanup")
+	assert.Contains(completions, "-seed")
 
 	// keep adding assertions for other flags which should be set as a result of cmd.Flags()
 }
@@ -0,0 +1,418 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dev
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/accounts"
+	"github.com/hashicorp/boundary/api/authmethods"
+	"github.com/hashicorp/boundary/api/credentials"
+	"github.com/hashicorp/boundary/api/credentialstores"
+	"github.com/hashicorp/boundary/api/managedgroups"
+	"github.com/hashicorp/boundary/api/roles"
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/boundary/api/sessions"
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/jimlambrt/gldap"
+	"github.com/jimlambrt/gldap/testdirectory"
+)
+
+// The profiles of the -seed flag. Each profile creates the resources of the
+// previous one.
+const (
+	seedProfileNone  = "none"
+	seedProfileBasic = "basic"
+	seedProfileDemo  = "demo"
+)
+
+var seedProfiles = []string{seedProfileNone, seedProfileBasic, seedProfileDemo}
+
+// seedSessionCount is the number of past sessions the demo profile creates
+// for each target
+const seedSessionCount = 3
+
+// seedSessionAttempts is the number of times authorizing a seed session is
+// attempted, one second apart, while waiting for the dev worker
+const seedSessionAttempts = 30
+
+type seedProject struct {
+	name        string
+	description string
+}
+
+type seedOrg struct {
+	name        string
+	description string
+	projects    []seedProject
+}
+
+var seedOrgs = []seedOrg{
+	{
+		name:        "Engineering",
+		description: "Engineering organization",
+		projects: []seedProject{
+			{name: "Production", description: "Production infrastructure"},
+			{name: "Staging", description: "Staging infrastructure"},
+		},
+	},
+	{
+		name:        "Support",
+		description: "Customer support organization",
+		projects: []seedProject{
+			{name: "Tooling", description: "Internal support tooling"},
+		},
+	},
+}
+
+type seedTarget struct {
+	name        string
+	description string
+	port        uint32
+	// credential is the login of the username password credential brokered
+	// by the target, if any
+	credential string
+}
+
+var seedTargets = []seedTarget{
+	{name: "postgres", description: "Primary PostgreSQL database", port: 5432, credential: "postgres"},
+	{name: "ssh", description: "Bastion host", port: 22},
+	{name: "web", description: "Internal web dashboard", port: 8443, credential: "dashboard"},
+}
+
+// seedLdapUsers are the users of the bundled LDAP directory, by group. They
+// log in with the password of the directory, "password".
+var seedLdapUsers = map[string][]string{
+	"engineers": {"alice", "bob"},
+	"support":   {"carol"},
+}
+
+// seeder creates the resources of a seed profile through the api of the dev
+// controller
+type seeder struct {
+	c      *Command
+	client *api.Client
+	// targetIds holds the ids of the seeded targets, for creating sessions
+	targetIds []string
+}
+
+// seed creates the resources of the given profile. For the demo profile,
+// past sessions are created in the background once the dev worker can handle
+// sessions.
+func (c *Command) seed(ctx context.Context, profile string) error {
+	if profile == seedProfileNone {
+		return nil
+	}
+	client, err := c.seedClient(ctx)
+	if err != nil {
+		return fmt.Errorf("Error creating seed client: %w", err)
+	}
+	s := &seeder{c: c, client: client}
+
+	orgIds, err := s.seedOrgs(ctx)
+	if err != nil {
+		return err
+	}
+	if profile == seedProfileBasic {
+		return nil
+	}
+	if len(orgIds) > 0 {
+		if err := s.seedLdap(ctx, orgIds[0]); err != nil {
+			return err
+		}
+	}
+	if err := s.seedOidc(ctx); err != nil {
+		return err
+	}
+	if c.flagControllerOnly {
+		c.UI.Warn("Past sessions are not seeded in controller-only mode")
+		return nil
+	}
+	go s.seedSessions(ctx)
+	return nil
+}
+
+// seedClient returns an api client for the dev controller, authenticated as
+// the admin user
+func (c *Command) seedClient(ctx context.Context) (*api.Client, error) {
+	var addr string
+	for _, l := range c.Listeners {
+		if l.ApiListener == nil {
+			continue
+		}
+		switch l.Config.Type {
+		case "unix":
+			addr = "unix://" + l.ApiListener.Addr().String()
+		default:
+			addr = "http://" + l.ApiListener.Addr().String()
+		}
+		break
+	}
+	if addr == "" {
+		return nil, fmt.Errorf("no api listener found")
+	}
+	conf, err := api.DefaultConfig()
+	if err != nil {
+		return nil, err
+	}
+	conf.Addr = addr
+	conf.Token = ""
+	client, err := api.NewClient(conf)
+	if err != nil {
+		return nil, err
+	}
+	result, err := authmethods.NewClient(client).Authenticate(ctx, c.DevPasswordAuthMethodId, "login", map[string]any{
+		"login_name": c.DevLoginName,
+		"password":   c.DevPassword,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error authenticating as %q: %w", c.DevLoginName, err)
+	}
+	token, ok := result.Attributes["token"].(string)
+	if !ok {
+		return nil, fmt.Errorf("no token in authentication result")
+	}
+	client.SetToken(token)
+	return client, nil
+}
+
+// seedOrgs creates the seeded orgs and their projects, with targets and
+// credentials in every project. Returns the ids of the orgs.
+func (s *seeder) seedOrgs(ctx context.Context) ([]string, error) {
+	sClient := scopes.NewClient(s.client)
+	var orgIds []string
+	for _, o := range seedOrgs {
+		org, err := sClient.Create(ctx, "global",
+			scopes.WithName(o.name),
+			scopes.WithDescription(o.description),
+			scopes.WithSkipAdminRoleCreation(true),
+			scopes.WithSkipDefaultRoleCreation(true),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("Error creating seed org %q: %w", o.name, err)
+		}
+		orgIds = append(orgIds, org.Item.Id)
+		for _, p := range o.projects {
+			proj, err := sClient.Create(ctx, org.Item.Id,
+				scopes.WithName(p.name),
+				scopes.WithDescription(p.description),
+				scopes.WithSkipAdminRoleCreation(true),
+				scopes.WithSkipDefaultRoleCreation(true),
+			)
+			if err != nil {
+				return nil, fmt.Errorf("Error creating seed project %q: %w", p.name, err)
+			}
+			if err := s.seedProject(ctx, proj.Item.Id); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return orgIds, nil
+}
+
+// seedProject creates a static credential store and the seeded targets in a
+// project
+func (s *seeder) seedProject(ctx context.Context, projectId string) error {
+	cs, err := credentialstores.NewClient(s.client).Create(ctx, "static", projectId,
+		credentialstores.WithName("Static credentials"),
+	)
+	if err != nil {
+		return fmt.Errorf("Error creating seed credential store: %w", err)
+	}
+	tClient := targets.NewClient(s.client)
+	for _, st := range seedTargets {
+		t, err := tClient.Create(ctx, "tcp", projectId,
+			targets.WithName(st.name),
+			targets.WithDescription(st.description),
+			targets.WithAddress(s.c.DevHostAddress),
+			targets.WithTcpTargetDefaultPort(st.port),
+		)
+		if err != nil {
+			return fmt.Errorf("Error creating seed target %q: %w", st.name, err)
+		}
+		s.targetIds = append(s.targetIds, t.Item.Id)
+		if st.credential == "" {
+			continue
+		}
+		cred, err := credentials.NewClient(s.client).Create(ctx, "username_password", cs.Item.Id,
+			credentials.WithName(st.credential),
+			credentials.WithUsernamePasswordCredentialUsername(st.credential),
+			credentials.WithUsernamePasswordCredentialPassword(st.credential+"-password"),
+		)
+		if err != nil {
+			return fmt.Errorf("Error creating seed credential %q: %w", st.credential, err)
+		}
+		if _, err := tClient.AddCredentialSources(ctx, t.Item.Id, t.Item.Version,
+			targets.WithBrokeredCredentialSourceIds([]string{cred.Item.Id})); err != nil {
+			return fmt.Errorf("Error adding credential to seed target %q: %w", st.name, err)
+		}
+	}
+	return nil
+}
+
+// seedLdap starts a bundled LDAP directory and creates an LDAP auth method
+// in the org using it, with managed groups for the groups of the directory.
+// Members of the groups are granted access to the projects of the org.
+func (s *seeder) seedLdap(ctx context.Context, orgId string) error {
+	logger := s.c.Logger.Named("seed-ldap")
+	t, err := testdirectory.NewLogger(logger)
+	if err != nil {
+		return fmt.Errorf("Error creating seed LDAP directory logger: %w", err)
+	}
+	d := testdirectory.Start(t,
+		testdirectory.WithDefaults(t, &testdirectory.Defaults{AllowAnonymousBind: true}),
+		testdirectory.WithLogger(t, logger),
+	)
+	s.c.ShutdownFuncs = append(s.c.ShutdownFuncs, func() error {
+		d.Stop()
+		return nil
+	})
+
+	groupNames := make([]string, 0, len(seedLdapUsers))
+	for g := range seedLdapUsers {
+		groupNames = append(groupNames, g)
+	}
+	sort.Strings(groupNames)
+
+	var users, groups []*gldap.Entry
+	for _, g := range groupNames {
+		members := seedLdapUsers[g]
+		users = append(users, testdirectory.NewUsers(t, members, testdirectory.WithMembersOf(t, g))...)
+		groups = append(groups, testdirectory.NewGroup(t, g, members))
+	}
+	d.SetUsers(users...)
+	d.SetGroups(groups...)
+
+	am, err := authmethods.NewClient(s.client).Create(ctx, "ldap", orgId,
+		authmethods.WithName("Corporate directory"),
+		authmethods.WithDescription("LDAP auth method backed by the bundled dev directory"),
+		authmethods.WithLdapAuthMethodUrls([]string{fmt.Sprintf("ldaps://127.0.0.1:%d", d.Port())}),
+		authmethods.WithLdapAuthMethodCertificates([]string{d.Cert()}),
+		authmethods.WithLdapAuthMethodDiscoverDn(true),
+		authmethods.WithLdapAuthMethodEnableGroups(true),
+		authmethods.WithLdapAuthMethodUserDn(testdirectory.DefaultUserDN),
+		authmethods.WithLdapAuthMethodGroupDn(testdirectory.DefaultGroupDN),
+		authmethods.WithLdapAuthMethodState("active-public"),
+	)
+	if err != nil {
+		return fmt.Errorf("Error creating seed LDAP auth method: %w", err)
+	}
+	for _, g := range groupNames {
+		for _, m := range seedLdapUsers[g] {
+			if _, err := accounts.NewClient(s.client).Create(ctx, am.Item.Id,
+				accounts.WithName(m),
+				accounts.WithLdapAccountLoginName(m),
+			); err != nil {
+				return fmt.Errorf("Error creating seed LDAP account %q: %w", m, err)
+			}
+		}
+	}
+
+	projects, err := scopes.NewClient(s.client).List(ctx, orgId)
+	if err != nil {
+		return fmt.Errorf("Error listing seed projects: %w", err)
+	}
+	for _, g := range groupNames {
+		mg, err := managedgroups.NewClient(s.client).Create(ctx, am.Item.Id,
+			managedgroups.WithName(g),
+			managedgroups.WithLdapManagedGroupGroupNames([]string{g}),
+		)
+		if err != nil {
+			return fmt.Errorf("Error creating seed LDAP managed group %q: %w", g, err)
+		}
+		for _, p := range projects.Items {
+			if err := s.seedRole(ctx, orgId, p.Id, fmt.Sprintf("%s in %s", g, p.Name), mg.Item.Id); err != nil {
+				return err
+			}
+		}
+	}
+	s.c.InfoKeys = append(s.c.InfoKeys, "seed ldap auth method id")
+	s.c.Info["seed ldap auth method id"] = am.Item.Id
+	return nil
+}
+
+// seedOidc creates a managed group of the users of the dev OIDC auth method,
+// which is backed by a bundled OIDC provider
+func (s *seeder) seedOidc(ctx context.Context) error {
+	if s.c.DevOidcAuthMethodId == "" {
+		return nil
+	}
+	if _, err := managedgroups.NewClient(s.client).Create(ctx, s.c.DevOidcAuthMethodId,
+		managedgroups.WithName("OIDC users"),
+		managedgroups.WithDescription("Every user of the dev OIDC provider"),
+		managedgroups.WithOidcManagedGroupFilter(`"/token/sub" matches ".+"`),
+	); err != nil {
+		return fmt.Errorf("Error creating seed OIDC managed group: %w", err)
+	}
+	return nil
+}
+
+// seedRole creates a role in the org granting the principal access to the
+// targets and sessions of the project
+func (s *seeder) seedRole(ctx context.Context, orgId, projectId, name, principalId string) error {
+	rClient := roles.NewClient(s.client)
+	r, err := rClient.Create(ctx, orgId,
+		roles.WithName(name),
+		roles.WithGrantScopeId(projectId),
+	)
+	if err != nil {
+		return fmt.Errorf("Error creating seed role %q: %w", name, err)
+	}
+	r, err = rClient.AddGrants(ctx, r.Item.Id, r.Item.Version, []string{
+		"id=*;type=target;actions=list,read,authorize-session",
+		"id=*;type=session;actions=list,read:self,cancel:self",
+	})
+	if err != nil {
+		return fmt.Errorf("Error adding grants to seed role %q: %w", name, err)
+	}
+	if _, err := rClient.AddPrincipals(ctx, r.Item.Id, r.Item.Version, []string{principalId}); err != nil {
+		return fmt.Errorf("Error adding principal to seed role %q: %w", name, err)
+	}
+	return nil
+}
+
+// seedSessions authorizes sessions to the seeded targets and cancels them,
+// so that they show up as past sessions. Sessions can only be authorized once
+// the dev worker has connected to the controller, so it retries until then.
+func (s *seeder) seedSessions(ctx context.Context) {
+	tClient := targets.NewClient(s.client)
+	sClient := sessions.NewClient(s.client)
+	var count int
+	for _, id := range s.targetIds {
+		for i := 0; i < seedSessionCount; i++ {
+			var sa *targets.SessionAuthorizationResult
+			var err error
+			for attempt := 0; ; attempt++ {
+				sa, err = tClient.AuthorizeSession(ctx, id)
+				if err == nil || attempt >= seedSessionAttempts {
+					break
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(time.Second):
+				}
+			}
+			if err != nil {
+				s.c.UI.Error(fmt.Errorf("Error seeding past sessions: %w", err).Error())
+				return
+			}
+			sess, err := sClient.Read(ctx, sa.Item.SessionId)
+			if err != nil {
+				s.c.UI.Error(fmt.Errorf("Error seeding past sessions: %w", err).Error())
+				return
+			}
+			if _, err := sClient.Cancel(ctx, sa.Item.SessionId, sess.Item.Version); err != nil {
+				s.c.UI.Error(fmt.Errorf("Error seeding past sessions: %w", err).Error())
+				return
+			}
+			count++
+		}
+	}
+	s.c.UI.Output(fmt.Sprintf("==> Seeded %d past sessions", count))
+}
@@ -153,6 +153,14 @@ func (c *StaticCommand) Run(args []string) int {
 	}
 	hostcatalogsClient := hostcatalogs.NewClient(client)
 
+	switch {
+	case c.Func == "create", c.Func == "list" && !c.FlagRecursive:
+		if err := c.CheckAuthorizedAction(client, c.FlagScopeId, "host-catalog", c.Func); err != nil {
+			c.PrintCliError(err)
+			return base.CommandUserError
+		}
+	}
+
 	switch c.FlagName {
 	case "":
 	case "null":
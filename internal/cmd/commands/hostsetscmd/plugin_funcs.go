@@ -69,8 +69,8 @@ func extraPluginFlagsFuncImpl(c *PluginCommand, set *base.FlagSets, f *base.Flag
 			fs.StringSliceVar(&base.StringSliceVar{
 				Name:   "preferred-endpoint",
 				Target: &c.flagPreferredEndpoints,
-				Usage: `An endpoint preference, specified by "cidr:<valid IPv4/6 CIDR>" ` +
-					`or "dns:<globbed name>", specifying which IP address or DNS name out ` +
+				Usage: `An endpoint preference, specified by "cidr:<valid IPv4/6 CIDR>", ` +
+					`"dns:<globbed name>" or "family:<ipv4|ipv6>", specifying which IP address or DNS name out ` +
 					`of a host's available possibilities should be preferred. May be specified ` +
 					`multiple times, which will build up an in-order set of preferences. ` +
 					`If no preferences are specified, a value will be chosen from among all ` +
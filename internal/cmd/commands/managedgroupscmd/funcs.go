@@ -12,6 +12,67 @@ import (
 	"github.com/hashicorp/boundary/internal/cmd/base"
 )
 
+const (
+	unionGroupIdFlagName        = "union-group-id"
+	intersectionGroupIdFlagName = "intersection-group-id"
+	differenceGroupIdFlagName   = "difference-group-id"
+)
+
+// setOperandCmdVars holds the set operation flags shared by the oidc and ldap
+// managed group commands.
+type setOperandCmdVars struct {
+	flagUnionGroupIds        []string
+	flagIntersectionGroupIds []string
+	flagDifferenceGroupIds   []string
+}
+
+func addSetOperandFlag(f *base.FlagSet, name string, v *setOperandCmdVars) {
+	switch name {
+	case unionGroupIdFlagName:
+		f.StringSliceVar(&base.StringSliceVar{
+			Name:   unionGroupIdFlagName,
+			Target: &v.flagUnionGroupIds,
+			Usage:  `A managed group or group ID whose members are added to the members of this managed group. May be specified multiple times. Use "null" to clear.`,
+		})
+	case intersectionGroupIdFlagName:
+		f.StringSliceVar(&base.StringSliceVar{
+			Name:   intersectionGroupIdFlagName,
+			Target: &v.flagIntersectionGroupIds,
+			Usage:  `A managed group or group ID whose members are the only accounts allowed to be members of this managed group. May be specified multiple times. Use "null" to clear.`,
+		})
+	case differenceGroupIdFlagName:
+		f.StringSliceVar(&base.StringSliceVar{
+			Name:   differenceGroupIdFlagName,
+			Target: &v.flagDifferenceGroupIds,
+			Usage:  `A managed group or group ID whose members are removed from the members of this managed group. May be specified multiple times. Use "null" to clear.`,
+		})
+	}
+}
+
+func setOperandOptions(v *setOperandCmdVars, opts *[]managedgroups.Option) {
+	switch {
+	case len(v.flagUnionGroupIds) == 0:
+	case len(v.flagUnionGroupIds) == 1 && v.flagUnionGroupIds[0] == "null":
+		*opts = append(*opts, managedgroups.DefaultUnionGroupIds())
+	default:
+		*opts = append(*opts, managedgroups.WithUnionGroupIds(v.flagUnionGroupIds))
+	}
+	switch {
+	case len(v.flagIntersectionGroupIds) == 0:
+	case len(v.flagIntersectionGroupIds) == 1 && v.flagIntersectionGroupIds[0] == "null":
+		*opts = append(*opts, managedgroups.DefaultIntersectionGroupIds())
+	default:
+		*opts = append(*opts, managedgroups.WithIntersectionGroupIds(v.flagIntersectionGroupIds))
+	}
+	switch {
+	case len(v.flagDifferenceGroupIds) == 0:
+	case len(v.flagDifferenceGroupIds) == 1 && v.flagDifferenceGroupIds[0] == "null":
+		*opts = append(*opts, managedgroups.DefaultDifferenceGroupIds())
+	default:
+		*opts = append(*opts, managedgroups.WithDifferenceGroupIds(v.flagDifferenceGroupIds))
+	}
+}
+
 func (c *Command) printListTable(items []*managedgroups.ManagedGroup) string {
 	if len(items) == 0 {
 		return "No managed groups found"
@@ -124,6 +185,30 @@ func printItemTable(item *managedgroups.ManagedGroup, resp *api.Response) string
 		)
 	}
 
+	if len(item.UnionGroupIds) > 0 {
+		ret = append(ret,
+			"",
+			"  Union Group IDs:",
+			base.WrapSlice(4, item.UnionGroupIds),
+		)
+	}
+
+	if len(item.IntersectionGroupIds) > 0 {
+		ret = append(ret,
+			"",
+			"  Intersection Group IDs:",
+			base.WrapSlice(4, item.IntersectionGroupIds),
+		)
+	}
+
+	if len(item.DifferenceGroupIds) > 0 {
+		ret = append(ret,
+			"",
+			"  Difference Group IDs:",
+			base.WrapSlice(4, item.DifferenceGroupIds),
+		)
+	}
+
 	if len(item.Attributes) > 0 {
 		ret = append(ret,
 			"",
@@ -15,6 +15,7 @@ const (
 )
 
 type extraLdapCmdVars struct {
+	setOperandCmdVars
 	flagGroupNames []string
 }
 
@@ -26,8 +27,8 @@ func init() {
 
 func extraLdapActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"create": {groupNamesFlagName},
-		"update": {groupNamesFlagName},
+		"create": {groupNamesFlagName, unionGroupIdFlagName, intersectionGroupIdFlagName, differenceGroupIdFlagName},
+		"update": {groupNamesFlagName, unionGroupIdFlagName, intersectionGroupIdFlagName, differenceGroupIdFlagName},
 	}
 }
 
@@ -68,6 +69,8 @@ func extraLdapFlagsFuncImpl(c *LdapCommand, _ *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagGroupNames,
 				Usage:  "The LDAP group names against which an LDAP account's associated groups (discovered during login) will be evaluated to determine membership (required). May be specified multiple times",
 			})
+		default:
+			addSetOperandFlag(f, name, &c.setOperandCmdVars)
 		}
 	}
 }
@@ -81,6 +84,7 @@ func extraLdapFlagsHandlingFuncImpl(c *LdapCommand, _ *base.FlagSets, opts *[]ma
 	default:
 		*opts = append(*opts, managedgroups.WithLdapManagedGroupGroupNames(c.flagGroupNames))
 	}
+	setOperandOptions(&c.setOperandCmdVars, opts)
 
 	return true
 }
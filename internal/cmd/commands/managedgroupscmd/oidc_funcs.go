@@ -23,12 +23,13 @@ func init() {
 
 func extraOidcActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"create": {filterFlagName},
-		"update": {filterFlagName},
+		"create": {filterFlagName, unionGroupIdFlagName, intersectionGroupIdFlagName, differenceGroupIdFlagName},
+		"update": {filterFlagName, unionGroupIdFlagName, intersectionGroupIdFlagName, differenceGroupIdFlagName},
 	}
 }
 
 type extraOidcCmdVars struct {
+	setOperandCmdVars
 	flagFilter string
 }
 
@@ -69,6 +70,8 @@ func extraOidcFlagsFuncImpl(c *OidcCommand, _ *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagFilter,
 				Usage:  "The filter defining the criteria against which an accounts's OIDC token and/or userinfo will be evaluated to determined membership at login time.",
 			})
+		default:
+			addSetOperandFlag(f, name, &c.setOperandCmdVars)
 		}
 	}
 }
@@ -93,6 +96,7 @@ func extraOidcFlagsHandlingFuncImpl(c *OidcCommand, _ *base.FlagSets, opts *[]ma
 		}
 		*opts = append(*opts, managedgroups.WithAttributes(map[string]any{filterFlagName: c.flagFilter}))
 	}
+	setOperandOptions(&c.setOperandCmdVars, opts)
 
 	return true
 }
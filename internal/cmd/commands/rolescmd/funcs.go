@@ -5,6 +5,7 @@ package rolescmd
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -22,24 +23,30 @@ func init() {
 	extraFlagsFunc = extraFlagsFuncImpl
 	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
 	executeExtraActions = executeExtraActionsImpl
+	printCustomActionOutput = printCustomActionOutputImpl
 }
 
 type extraCmdVars struct {
-	flagGrantScopeId string
-	flagPrincipals   []string
-	flagGrants       []string
+	flagGrantScopeId        string
+	flagGrantBoundary       string
+	flagProjectTemplate     string
+	flagProjectTemplateSync string
+	flagPrincipals          []string
+	flagGrants              []string
+	simulateResult          *roles.SimulateResult
 }
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"create":            {"grant-scope-id"},
-		"update":            {"grant-scope-id"},
+		"create":            {"grant-scope-id", "grant-boundary", "project-template", "project-template-sync"},
+		"update":            {"grant-scope-id", "grant-boundary", "project-template", "project-template-sync"},
 		"add-principals":    {"id", "principal", "version"},
 		"set-principals":    {"id", "principal", "version"},
 		"remove-principals": {"id", "principal", "version"},
 		"add-grants":        {"id", "grant", "version"},
 		"set-grants":        {"id", "grant", "version"},
 		"remove-grants":     {"id", "grant", "version"},
+		"simulate":          {"id", "grant"},
 	}
 }
 
@@ -49,6 +56,8 @@ func extraSynopsisFuncImpl(c *Command) string {
 		return c.principalsGrantsSynopsisFunc(c.Func, true)
 	case "add-grants", "set-grants", "remove-grants":
 		return c.principalsGrantsSynopsisFunc(c.Func, false)
+	case "simulate":
+		return wordwrap.WrapString("Show the permissions grants would newly allow the users of a role", base.TermWidth)
 	}
 
 	return ""
@@ -140,6 +149,17 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 		})
 
+	case "simulate":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary roles simulate [options] [args]",
+			"",
+			`  Shows the permissions that grants would newly allow the users of a role given its ID, without changing the role. The users are the role's user principals and the members of its group and managed group principals. The "grant" flag can be specified multiple times. Example:`,
+			"",
+			`    $ boundary roles simulate -id r_1234567890 -grant "id=*;type=target;actions=read,authorize-session"`,
+			"",
+			"",
+		})
+
 	default:
 		helpStr = helpMap["base"]()
 	}
@@ -155,6 +175,24 @@ func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagGrantScopeId,
 				Usage:  "The scope ID for grants set on the role",
 			})
+		case "grant-boundary":
+			f.StringVar(&base.StringVar{
+				Name:   "grant-boundary",
+				Target: &c.flagGrantBoundary,
+				Usage:  `If "true", the role is a boundary role and its grants cap the grants that can be added to roles in child scopes.`,
+			})
+		case "project-template":
+			f.StringVar(&base.StringVar{
+				Name:   "project-template",
+				Target: &c.flagProjectTemplate,
+				Usage:  `If "true", the role is a project template and is copied, along with its grants and principals, into every new project created in the role's org.`,
+			})
+		case "project-template-sync":
+			f.StringVar(&base.StringVar{
+				Name:   "project-template-sync",
+				Target: &c.flagProjectTemplateSync,
+				Usage:  `If "true", changes to the grants of the project template role are applied to the roles copied from it.`,
+			})
 		case "principal":
 			f.StringSliceVar(&base.StringSliceVar{
 				Name:   "principal",
@@ -180,6 +218,45 @@ func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]roles.Opti
 		*opts = append(*opts, roles.WithGrantScopeId(c.flagGrantScopeId))
 	}
 
+	switch c.flagGrantBoundary {
+	case "":
+	case "null":
+		*opts = append(*opts, roles.DefaultGrantBoundary())
+	default:
+		grantBoundary, err := strconv.ParseBool(c.flagGrantBoundary)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q as a boolean: %s", c.flagGrantBoundary, err))
+			return false
+		}
+		*opts = append(*opts, roles.WithGrantBoundary(grantBoundary))
+	}
+
+	switch c.flagProjectTemplate {
+	case "":
+	case "null":
+		*opts = append(*opts, roles.DefaultProjectTemplate())
+	default:
+		projectTemplate, err := strconv.ParseBool(c.flagProjectTemplate)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q as a boolean: %s", c.flagProjectTemplate, err))
+			return false
+		}
+		*opts = append(*opts, roles.WithProjectTemplate(projectTemplate))
+	}
+
+	switch c.flagProjectTemplateSync {
+	case "":
+	case "null":
+		*opts = append(*opts, roles.DefaultProjectTemplateSync())
+	default:
+		projectTemplateSync, err := strconv.ParseBool(c.flagProjectTemplateSync)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q as a boolean: %s", c.flagProjectTemplateSync, err))
+			return false
+		}
+		*opts = append(*opts, roles.WithProjectTemplateSync(projectTemplateSync))
+	}
+
 	switch c.Func {
 	case "add-principals", "remove-principals":
 		if len(c.flagPrincipals) == 0 {
@@ -187,7 +264,7 @@ func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]roles.Opti
 			return false
 		}
 
-	case "add-grants", "remove-grants":
+	case "add-grants", "remove-grants", "simulate":
 		if len(c.flagGrants) == 0 {
 			c.UI.Error("No grants supplied via -grant")
 			return false
@@ -267,6 +344,10 @@ func executeExtraActionsImpl(c *Command, origResp *api.Response, origItem *roles
 			return nil, nil, nil, err
 		}
 		return result.GetResponse(), result.GetItem(), nil, err
+	case "simulate":
+		var err error
+		c.simulateResult, err = roleClient.Simulate(c.Context, c.FlagId, c.flagGrants, opts...)
+		return nil, nil, nil, err
 	}
 	return origResp, origItem, origItems, origError
 }
@@ -348,6 +429,18 @@ func printItemTable(item *roles.Role, resp *api.Response) string {
 	if item.GrantScopeId != "" {
 		nonAttributeMap["Grant Scope ID"] = item.GrantScopeId
 	}
+	if item.GrantBoundary {
+		nonAttributeMap["Grant Boundary"] = item.GrantBoundary
+	}
+	if item.ProjectTemplate {
+		nonAttributeMap["Project Template"] = item.ProjectTemplate
+	}
+	if item.ProjectTemplateSync {
+		nonAttributeMap["Project Template Sync"] = item.ProjectTemplateSync
+	}
+	if item.TemplateRoleId != "" {
+		nonAttributeMap["Template Role ID"] = item.TemplateRoleId
+	}
 
 	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
 
@@ -400,3 +493,49 @@ func printItemTable(item *roles.Role, resp *api.Response) string {
 
 	return base.WrapForHelpText(ret)
 }
+
+func printCustomActionOutputImpl(c *Command) (bool, error) {
+	switch c.Func {
+	case "simulate":
+		switch base.Format(c.UI) {
+		case "table":
+			items := c.simulateResult.GetItems()
+			if len(items) == 0 {
+				c.UI.Output("No permissions would be newly allowed")
+				return true, nil
+			}
+			ret := []string{
+				"",
+				"Newly allowed permissions:",
+			}
+			for i, item := range items {
+				if i > 0 {
+					ret = append(ret, "")
+				}
+				resourceId := item.ResourceId
+				if resourceId == "" {
+					resourceId = "(collection)"
+				}
+				ret = append(ret,
+					fmt.Sprintf("  User ID:               %s", item.UserId),
+					fmt.Sprintf("    Grant:               %s", item.Grant),
+					fmt.Sprintf("    Scope ID:            %s", item.ScopeId),
+					fmt.Sprintf("    Resource ID:         %s", resourceId),
+					fmt.Sprintf("    Resource Type:       %s", item.ResourceType),
+					"    Actions:",
+					base.WrapSlice(6, item.Actions),
+				)
+			}
+			c.UI.Output(base.WrapForHelpText(ret))
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItems(c.simulateResult.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
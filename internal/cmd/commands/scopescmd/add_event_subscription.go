@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scopescmd
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*AddEventSubscriptionCommand)(nil)
+	_ cli.CommandAutocomplete = (*AddEventSubscriptionCommand)(nil)
+)
+
+type AddEventSubscriptionCommand struct {
+	*base.Command
+	flagResourceId   string
+	flagResourceType string
+	flagWebhookUrl   string
+}
+
+func (c *AddEventSubscriptionCommand) Synopsis() string {
+	return wordwrap.WrapString("Subscribe to the changes of resources within a scope", base.TermWidth)
+}
+
+func (c *AddEventSubscriptionCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary scopes add-event-subscription [args]",
+		"",
+		"  Subscribe to the changes of a resource, or of all the resources of a type, within a scope. Notifications are written to the event stream, and posted to the webhook if one is given and webhooks are enabled on the controller. Notifications are only delivered for resources you are allowed to read. Example:",
+		"",
+		`    $ boundary scopes add-event-subscription -scope-id p_1234567890 -resource-type target`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *AddEventSubscriptionCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:    "scope-id",
+		Target:  &c.FlagScopeId,
+		Default: scope.Global.String(),
+		Usage:   "The id of the scope in which to subscribe",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "resource-id",
+		Target: &c.flagResourceId,
+		Usage:  "The id of the resource to subscribe to. Mutually exclusive with -resource-type.",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "resource-type",
+		Target: &c.flagResourceType,
+		Usage:  "The type of the resources in the scope to subscribe to, for example target. Mutually exclusive with -resource-id.",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "webhook-url",
+		Target: &c.flagWebhookUrl,
+		Usage:  "The URL notifications are posted to",
+	})
+
+	return set
+}
+
+func (c *AddEventSubscriptionCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *AddEventSubscriptionCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *AddEventSubscriptionCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	switch {
+	case c.flagResourceId == "" && c.flagResourceType == "":
+		c.PrintCliError(errors.New("One of -resource-id and -resource-type must be provided"))
+		return base.CommandUserError
+	case c.flagResourceId != "" && c.flagResourceType != "":
+		c.PrintCliError(errors.New("Only one of -resource-id and -resource-type can be provided"))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	sClient := scopes.NewClient(client)
+	result, err := sClient.AddEventSubscription(c.Context, c.FlagScopeId, c.flagResourceId, c.flagResourceType, c.flagWebhookUrl)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when adding event subscription")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to add event subscription: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		if ok := c.PrintJsonItem(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+
+	default:
+		c.UI.Output(printEventSubscriptionTable(result.GetItem()))
+	}
+
+	return base.CommandSuccess
+}
+
+func printEventSubscriptionTable(item *scopes.EventSubscription) string {
+	nonAttributeMap := map[string]any{
+		"ID":       item.Id,
+		"Scope ID": item.ScopeId,
+		"User ID":  item.UserId,
+	}
+	if item.ResourceId != "" {
+		nonAttributeMap["Resource ID"] = item.ResourceId
+	}
+	if item.Type != "" {
+		nonAttributeMap["Resource Type"] = item.Type
+	}
+	if item.WebhookUrl != "" {
+		nonAttributeMap["Webhook URL"] = item.WebhookUrl
+	}
+	if !item.CreatedTime.IsZero() {
+		nonAttributeMap["Created Time"] = item.CreatedTime.Local().Format(time.RFC1123)
+	}
+
+	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
+
+	ret := []string{
+		"",
+		"Event subscription information:",
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+	}
+
+	return base.WrapForHelpText(ret)
+}
@@ -5,16 +5,29 @@ package scopescmd
 
 import (
 	"fmt"
+	"math"
 	"sort"
+	"strconv"
 	"time"
 
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/scopes"
 	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/posener/complete"
 )
 
 const (
 	flagPrimaryAuthMethodIdName     = "primary-auth-method-id"
+	flagAuthTokenTimeToLiveName     = "auth-token-time-to-live"
+	flagAuthTokenTimeToStaleName    = "auth-token-time-to-stale"
+	flagOwnerName                   = "owner"
+	flagCostCenterName              = "cost-center"
+	flagTicketUrlName               = "ticket-url"
+	flagLoginDisplayNameName        = "login-display-name"
+	flagLoginSupportContactName     = "login-support-contact"
+	flagLoginMessageOfTheDayName    = "login-message-of-the-day"
+	flagIsolatedName                = "isolated"
+	flagDeletionProtectedName       = "deletion-protected"
 	flagSkipAdminRoleCreationName   = "skip-admin-role-creation"
 	flagSkipDefaultRoleCreationName = "skip-default-role-creation"
 )
@@ -27,8 +40,8 @@ func init() {
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"create": {flagSkipAdminRoleCreationName, flagSkipDefaultRoleCreationName},
-		"update": {flagPrimaryAuthMethodIdName},
+		"create": {flagSkipAdminRoleCreationName, flagSkipDefaultRoleCreationName, flagAuthTokenTimeToLiveName, flagAuthTokenTimeToStaleName, flagOwnerName, flagCostCenterName, flagTicketUrlName, flagLoginDisplayNameName, flagLoginSupportContactName, flagLoginMessageOfTheDayName, flagIsolatedName, flagDeletionProtectedName},
+		"update": {flagPrimaryAuthMethodIdName, flagAuthTokenTimeToLiveName, flagAuthTokenTimeToStaleName, flagOwnerName, flagCostCenterName, flagTicketUrlName, flagLoginDisplayNameName, flagLoginSupportContactName, flagLoginMessageOfTheDayName, flagIsolatedName, flagDeletionProtectedName},
 	}
 }
 
@@ -36,6 +49,16 @@ type extraCmdVars struct {
 	flagSkipAdminRoleCreation   bool
 	flagSkipDefaultRoleCreation bool
 	flagPrimaryAuthMethodId     string
+	flagAuthTokenTimeToLive     string
+	flagAuthTokenTimeToStale    string
+	flagOwner                   string
+	flagCostCenter              string
+	flagTicketUrl               string
+	flagLoginDisplayName        string
+	flagLoginSupportContact     string
+	flagLoginMessageOfTheDay    string
+	flagIsolated                string
+	flagDeletionProtected       string
 }
 
 func extraFlagsFuncImpl(c *Command, set *base.FlagSets, f *base.FlagSet) {
@@ -59,6 +82,68 @@ func extraFlagsFuncImpl(c *Command, set *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagPrimaryAuthMethodId,
 				Usage:  "If set, the primary auth method id for the scope.  A primary auth method is allowed to create users on first login and is also used as a source for account full name and email for a scope's users",
 			})
+		case flagAuthTokenTimeToLiveName:
+			f.StringVar(&base.StringVar{
+				Name:   flagAuthTokenTimeToLiveName,
+				Target: &c.flagAuthTokenTimeToLive,
+				Usage:  `The total lifetime of auth tokens issued to accounts in this org scope, if shorter than the controller's configured value. Can be specified as an integer number of seconds or a duration string.`,
+			})
+		case flagAuthTokenTimeToStaleName:
+			f.StringVar(&base.StringVar{
+				Name:   flagAuthTokenTimeToStaleName,
+				Target: &c.flagAuthTokenTimeToStale,
+				Usage:  `The time auth tokens issued to accounts in this org scope can go unused before becoming invalid, if shorter than the controller's configured value. Can be specified as an integer number of seconds or a duration string.`,
+			})
+		case flagOwnerName:
+			f.StringVar(&base.StringVar{
+				Name:   flagOwnerName,
+				Target: &c.flagOwner,
+				Usage:  "The person or team which owns the scope.",
+			})
+		case flagCostCenterName:
+			f.StringVar(&base.StringVar{
+				Name:   flagCostCenterName,
+				Target: &c.flagCostCenter,
+				Usage:  `The cost center the scope is charged to, such as "CC-1234".`,
+			})
+		case flagTicketUrlName:
+			f.StringVar(&base.StringVar{
+				Name:   flagTicketUrlName,
+				Target: &c.flagTicketUrl,
+				Usage:  "A link to the ticket which tracks the scope.",
+			})
+		case flagLoginDisplayNameName:
+			f.StringVar(&base.StringVar{
+				Name:   flagLoginDisplayNameName,
+				Target: &c.flagLoginDisplayName,
+				Usage:  "The name of the scope shown to users before they log in to it, such as the name of its business unit.",
+			})
+		case flagLoginSupportContactName:
+			f.StringVar(&base.StringVar{
+				Name:   flagLoginSupportContactName,
+				Target: &c.flagLoginSupportContact,
+				Usage:  "The contact, such as an email address or URL, shown to users before they log in to the scope.",
+			})
+		case flagLoginMessageOfTheDayName:
+			f.StringVar(&base.StringVar{
+				Name:   flagLoginMessageOfTheDayName,
+				Target: &c.flagLoginMessageOfTheDay,
+				Usage:  "A message shown to users before they log in to the scope.",
+			})
+		case flagIsolatedName:
+			f.StringVar(&base.StringVar{
+				Name:       flagIsolatedName,
+				Target:     &c.flagIsolated,
+				Completion: complete.PredictSet("true", "false"),
+				Usage:      `Whether the org scope is isolated from the roles of the global scope when the controller runs with tenant isolation. Supported values are "true" and "false".`,
+			})
+		case flagDeletionProtectedName:
+			f.StringVar(&base.StringVar{
+				Name:       flagDeletionProtectedName,
+				Target:     &c.flagDeletionProtected,
+				Completion: complete.PredictSet("true", "false"),
+				Usage:      `Whether the scope is protected from deletion. Clearing the protection requires the clear-deletion-protection action. Supported values are "true" and "false".`,
+			})
 		}
 	}
 }
@@ -74,9 +159,128 @@ func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]scopes.Opt
 		*opts = append(*opts, scopes.WithPrimaryAuthMethodId(c.flagPrimaryAuthMethodId))
 	}
 
+	switch c.flagAuthTokenTimeToLive {
+	case "":
+	case "null":
+		*opts = append(*opts, scopes.DefaultAuthTokenTimeToLiveSeconds())
+	default:
+		secs, err := parseSeconds(c.flagAuthTokenTimeToLive)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagAuthTokenTimeToLive, err))
+			return false
+		}
+		*opts = append(*opts, scopes.WithAuthTokenTimeToLiveSeconds(secs))
+	}
+
+	switch c.flagAuthTokenTimeToStale {
+	case "":
+	case "null":
+		*opts = append(*opts, scopes.DefaultAuthTokenTimeToStaleSeconds())
+	default:
+		secs, err := parseSeconds(c.flagAuthTokenTimeToStale)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagAuthTokenTimeToStale, err))
+			return false
+		}
+		*opts = append(*opts, scopes.WithAuthTokenTimeToStaleSeconds(secs))
+	}
+
+	switch c.flagOwner {
+	case "":
+	case "null":
+		*opts = append(*opts, scopes.DefaultAnnotationOwner())
+	default:
+		*opts = append(*opts, scopes.WithAnnotationOwner(c.flagOwner))
+	}
+
+	switch c.flagCostCenter {
+	case "":
+	case "null":
+		*opts = append(*opts, scopes.DefaultAnnotationCostCenter())
+	default:
+		*opts = append(*opts, scopes.WithAnnotationCostCenter(c.flagCostCenter))
+	}
+
+	switch c.flagTicketUrl {
+	case "":
+	case "null":
+		*opts = append(*opts, scopes.DefaultAnnotationTicketUrl())
+	default:
+		*opts = append(*opts, scopes.WithAnnotationTicketUrl(c.flagTicketUrl))
+	}
+
+	switch c.flagLoginDisplayName {
+	case "":
+	case "null":
+		*opts = append(*opts, scopes.DefaultLoginDisplayName())
+	default:
+		*opts = append(*opts, scopes.WithLoginDisplayName(c.flagLoginDisplayName))
+	}
+
+	switch c.flagLoginSupportContact {
+	case "":
+	case "null":
+		*opts = append(*opts, scopes.DefaultLoginSupportContact())
+	default:
+		*opts = append(*opts, scopes.WithLoginSupportContact(c.flagLoginSupportContact))
+	}
+
+	switch c.flagLoginMessageOfTheDay {
+	case "":
+	case "null":
+		*opts = append(*opts, scopes.DefaultLoginMessageOfTheDay())
+	default:
+		*opts = append(*opts, scopes.WithLoginMessageOfTheDay(c.flagLoginMessageOfTheDay))
+	}
+
+	switch c.flagIsolated {
+	case "":
+	case "null":
+		*opts = append(*opts, scopes.DefaultIsolated())
+	default:
+		isolated, err := strconv.ParseBool(c.flagIsolated)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagIsolated, err))
+			return false
+		}
+		*opts = append(*opts, scopes.WithIsolated(isolated))
+	}
+
+	switch c.flagDeletionProtected {
+	case "":
+	case "null":
+		*opts = append(*opts, scopes.DefaultDeletionProtected())
+	default:
+		protected, err := strconv.ParseBool(c.flagDeletionProtected)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagDeletionProtected, err))
+			return false
+		}
+		*opts = append(*opts, scopes.WithDeletionProtected(protected))
+	}
+
 	return true
 }
 
+// parseSeconds parses an integer number of seconds or a duration string. The
+// number of seconds must be greater than 0 and fit in a uint32.
+func parseSeconds(in string) (uint32, error) {
+	var secs float64
+	if u, err := strconv.ParseUint(in, 10, 64); err == nil {
+		secs = float64(u)
+	} else {
+		dur, err := time.ParseDuration(in)
+		if err != nil {
+			return 0, err
+		}
+		secs = dur.Truncate(time.Second).Seconds()
+	}
+	if secs <= 0 || secs > math.MaxUint32 {
+		return 0, fmt.Errorf("must be between 1 and %d seconds", uint32(math.MaxUint32))
+	}
+	return uint32(secs), nil
+}
+
 func (c *Command) printListTable(items []*scopes.Scope) string {
 	if len(items) == 0 {
 		return "No child scopes found"
@@ -154,6 +358,40 @@ func printItemTable(item *scopes.Scope, resp *api.Response) string {
 	if item.PrimaryAuthMethodId != "" {
 		nonAttributeMap["Primary Auth Method ID"] = item.PrimaryAuthMethodId
 	}
+	if item.AuthTokenTimeToLiveSeconds != 0 {
+		nonAttributeMap["Auth Token Time To Live Seconds"] = item.AuthTokenTimeToLiveSeconds
+	}
+	if item.AuthTokenTimeToStaleSeconds != 0 {
+		nonAttributeMap["Auth Token Time To Stale Seconds"] = item.AuthTokenTimeToStaleSeconds
+	}
+	if a := item.Annotations; a != nil {
+		if a.Owner != "" {
+			nonAttributeMap["Owner"] = a.Owner
+		}
+		if a.CostCenter != "" {
+			nonAttributeMap["Cost Center"] = a.CostCenter
+		}
+		if a.TicketUrl != "" {
+			nonAttributeMap["Ticket URL"] = a.TicketUrl
+		}
+	}
+	if m := item.LoginMetadata; m != nil {
+		if m.DisplayName != "" {
+			nonAttributeMap["Login Display Name"] = m.DisplayName
+		}
+		if m.SupportContact != "" {
+			nonAttributeMap["Login Support Contact"] = m.SupportContact
+		}
+		if m.MessageOfTheDay != "" {
+			nonAttributeMap["Login Message Of The Day"] = m.MessageOfTheDay
+		}
+	}
+	if item.Isolated {
+		nonAttributeMap["Isolated"] = item.Isolated
+	}
+	if item.DeletionProtected {
+		nonAttributeMap["Deletion Protected"] = item.DeletionProtected
+	}
 
 	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
 
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scopescmd
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*ListAuthorizedActionsCommand)(nil)
+	_ cli.CommandAutocomplete = (*ListAuthorizedActionsCommand)(nil)
+)
+
+type ListAuthorizedActionsCommand struct {
+	*base.Command
+}
+
+func (c *ListAuthorizedActionsCommand) Synopsis() string {
+	return wordwrap.WrapString("List your authorized actions on each resource type within a scope", base.TermWidth)
+}
+
+func (c *ListAuthorizedActionsCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary scopes list-authorized-actions [args]",
+		"",
+		"  List the actions you are authorized to perform on each resource type within a scope. Example:",
+		"",
+		`    $ boundary scopes list-authorized-actions -scope-id p_1234567890`,
+		"",
+		"  Before performing create and list requests, the CLI uses these actions to deny requests that are not authorized. Set " + base.EnvBoundaryCLISkipAuthorizedActionsCheck + " to disable this check.",
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *ListAuthorizedActionsCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "scope-id",
+		Target: &c.FlagScopeId,
+		Usage:  "The id of the scope in which to list the authorized actions",
+	})
+
+	return set
+}
+
+func (c *ListAuthorizedActionsCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *ListAuthorizedActionsCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ListAuthorizedActionsCommand) printListTable(result *scopes.AuthorizedActionsResult) string {
+	if len(result.AuthorizedActions) == 0 {
+		return "No authorized actions found"
+	}
+	types := make([]string, 0, len(result.AuthorizedActions))
+	for typ := range result.AuthorizedActions {
+		types = append(types, typ)
+	}
+	sort.Strings(types)
+
+	output := []string{
+		"",
+		fmt.Sprintf("Authorized actions in scope %s:", c.FlagScopeId),
+	}
+	for _, typ := range types {
+		output = append(output,
+			fmt.Sprintf("  %s: %s", typ, strings.Join(result.AuthorizedActions[typ], ", ")),
+		)
+	}
+
+	return base.WrapForHelpText(output)
+}
+
+func (c *ListAuthorizedActionsCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	switch {
+	case c.FlagScopeId == "":
+		c.PrintCliError(errors.New("Scope ID must be provided via -scope-id"))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	sClient := scopes.NewClient(client)
+	result, err := sClient.ListAuthorizedActions(c.Context, c.FlagScopeId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when listing authorized actions")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to list authorized actions: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		if ok := c.PrintJsonItem(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+
+	default:
+		c.UI.Output(c.printListTable(result))
+	}
+
+	return base.CommandSuccess
+}
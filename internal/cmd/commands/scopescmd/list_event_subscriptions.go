@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scopescmd
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*ListEventSubscriptionsCommand)(nil)
+	_ cli.CommandAutocomplete = (*ListEventSubscriptionsCommand)(nil)
+)
+
+type ListEventSubscriptionsCommand struct {
+	*base.Command
+}
+
+func (c *ListEventSubscriptionsCommand) Synopsis() string {
+	return wordwrap.WrapString("List your event subscriptions within a scope", base.TermWidth)
+}
+
+func (c *ListEventSubscriptionsCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary scopes list-event-subscriptions [args]",
+		"",
+		"  List your event subscriptions within a scope. Example:",
+		"",
+		`    $ boundary scopes list-event-subscriptions -scope-id p_1234567890`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *ListEventSubscriptionsCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:    "scope-id",
+		Target:  &c.FlagScopeId,
+		Default: scope.Global.String(),
+		Usage:   "The id of the scope in which to list event subscriptions",
+	})
+
+	return set
+}
+
+func (c *ListEventSubscriptionsCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *ListEventSubscriptionsCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ListEventSubscriptionsCommand) printListTable(items []*scopes.EventSubscription) string {
+	if len(items) == 0 {
+		return "No event subscriptions found"
+	}
+	output := []string{
+		"",
+		"Event subscription information:",
+	}
+	for i, item := range items {
+		if i > 0 {
+			output = append(output, "")
+		}
+		output = append(output,
+			fmt.Sprintf("  ID:                %s", item.Id),
+		)
+		if item.ResourceId != "" {
+			output = append(output,
+				fmt.Sprintf("    Resource ID:     %s", item.ResourceId),
+			)
+		}
+		if item.Type != "" {
+			output = append(output,
+				fmt.Sprintf("    Resource Type:   %s", item.Type),
+			)
+		}
+		if item.WebhookUrl != "" {
+			output = append(output,
+				fmt.Sprintf("    Webhook URL:     %s", item.WebhookUrl),
+			)
+		}
+	}
+
+	return base.WrapForHelpText(output)
+}
+
+func (c *ListEventSubscriptionsCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	sClient := scopes.NewClient(client)
+	result, err := sClient.ListEventSubscriptions(c.Context, c.FlagScopeId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when listing event subscriptions")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to list event subscriptions: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		if ok := c.PrintJsonItems(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+
+	default:
+		c.UI.Output(c.printListTable(result.GetItems()))
+	}
+
+	return base.CommandSuccess
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scopescmd
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*ReadLoginMetadataCommand)(nil)
+	_ cli.CommandAutocomplete = (*ReadLoginMetadataCommand)(nil)
+)
+
+type ReadLoginMetadataCommand struct {
+	*base.Command
+}
+
+func (c *ReadLoginMetadataCommand) Synopsis() string {
+	return wordwrap.WrapString("Read the information shown before logging in to a scope", base.TermWidth)
+}
+
+func (c *ReadLoginMetadataCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary scopes read-login-metadata [args]",
+		"",
+		"  Read the display name, support contact and message of the day shown to users before they log in to a scope. This does not require authenticating. Example:",
+		"",
+		`    $ boundary scopes read-login-metadata -scope-id o_1234567890`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *ReadLoginMetadataCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:    "scope-id",
+		Target:  &c.FlagScopeId,
+		Default: scope.Global.String(),
+		Usage:   "The id of the scope whose login metadata to read",
+	})
+
+	return set
+}
+
+func (c *ReadLoginMetadataCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *ReadLoginMetadataCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ReadLoginMetadataCommand) printItemTable(item *scopes.LoginMetadata) string {
+	nonAttributeMap := map[string]any{}
+	if item.DisplayName != "" {
+		nonAttributeMap["Display Name"] = item.DisplayName
+	}
+	if item.SupportContact != "" {
+		nonAttributeMap["Support Contact"] = item.SupportContact
+	}
+	if item.MessageOfTheDay != "" {
+		nonAttributeMap["Message Of The Day"] = item.MessageOfTheDay
+	}
+	if len(nonAttributeMap) == 0 {
+		return fmt.Sprintf("No login metadata set for scope %s", c.FlagScopeId)
+	}
+
+	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
+
+	ret := []string{
+		"",
+		fmt.Sprintf("Login metadata for scope %s:", c.FlagScopeId),
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+	}
+
+	return base.WrapForHelpText(ret)
+}
+
+func (c *ReadLoginMetadataCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	sClient := scopes.NewClient(client)
+	result, err := sClient.ReadLoginMetadata(c.Context, c.FlagScopeId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when reading login metadata")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to read login metadata: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		if ok := c.PrintJsonItem(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+
+	default:
+		c.UI.Output(c.printItemTable(result.GetItem()))
+	}
+
+	return base.CommandSuccess
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scopescmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*RemoveEventSubscriptionCommand)(nil)
+	_ cli.CommandAutocomplete = (*RemoveEventSubscriptionCommand)(nil)
+)
+
+type RemoveEventSubscriptionCommand struct {
+	*base.Command
+	flagSubscriptionId string
+}
+
+func (c *RemoveEventSubscriptionCommand) Synopsis() string {
+	return wordwrap.WrapString("Remove one of your event subscriptions within a scope", base.TermWidth)
+}
+
+func (c *RemoveEventSubscriptionCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary scopes remove-event-subscription [args]",
+		"",
+		"  Remove one of your event subscriptions within a scope. Example:",
+		"",
+		`    $ boundary scopes remove-event-subscription -scope-id p_1234567890 -subscription-id evsub_1234567890`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *RemoveEventSubscriptionCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:    "scope-id",
+		Target:  &c.FlagScopeId,
+		Default: scope.Global.String(),
+		Usage:   "The id of the scope of the event subscription",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "subscription-id",
+		Target: &c.flagSubscriptionId,
+		Usage:  "The id of the event subscription to remove",
+	})
+
+	return set
+}
+
+func (c *RemoveEventSubscriptionCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *RemoveEventSubscriptionCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *RemoveEventSubscriptionCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	if c.flagSubscriptionId == "" {
+		c.PrintCliError(errors.New("Subscription ID must be provided via -subscription-id"))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	sClient := scopes.NewClient(client)
+	result, err := sClient.RemoveEventSubscription(c.Context, c.FlagScopeId, c.flagSubscriptionId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when removing event subscription")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to remove event subscription: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		if ok := c.PrintJsonItem(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+	default:
+		c.UI.Output("The event subscription was successfully removed.")
+	}
+
+	return base.CommandSuccess
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/util"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/go-secure-stdlib/mlock"
@@ -62,6 +63,9 @@ type Command struct {
 	flagLogFormat   string
 	flagCombineLogs bool
 
+	flagValidateConfig     bool
+	flagValidateConfigRole string
+
 	reloadedCh   chan struct{}  // for tests
 	startedCh    chan struct{}  // for tests
 	presetConfig *atomic.String // for tests
@@ -79,6 +83,11 @@ Usage: boundary server [options]
 
       $ boundary server -config=/etc/boundary/controller.hcl
 
+  Validate a configuration file without starting the server, printing the
+  findings as JSON:
+
+      $ boundary server -config=/etc/boundary/controller.hcl -validate-config
+
   For a full list of examples, please see the documentation.
 
 ` + c.Flags().Help()
@@ -126,6 +135,19 @@ func (c *Command) Flags() *base.FlagSets {
 		Usage:      `Log format, mostly as a fallback for events. Supported values are "standard" and "json".`,
 	})
 
+	f.BoolVar(&base.BoolVar{
+		Name:   "validate-config",
+		Target: &c.flagValidateConfig,
+		Usage:  "Validate the configuration instead of starting the server: parse it, check the KMSes can be used, the listeners do not conflict and the plugin paths exist, then print the findings as JSON. Exits with an error if any finding is an error.",
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:       "validate-config-role",
+		Target:     &c.flagValidateConfigRole,
+		Completion: complete.PredictSet("controller", "worker"),
+		Usage:      `With -validate-config, only run the checks of the given role, which must be activated by the configuration. Supported values are "controller" and "worker".`,
+	})
+
 	return set
 }
 
@@ -150,6 +172,10 @@ func (c *Command) Run(args []string) int {
 		return result
 	}
 
+	if c.flagValidateConfig {
+		return c.validateConfig()
+	}
+
 	if err := c.SetupLogging(c.flagLogLevel, c.flagLogFormat, c.Config.LogLevel, c.Config.LogFormat); err != nil {
 		c.UI.Error(err.Error())
 		return base.CommandUserError
@@ -314,14 +340,10 @@ func (c *Command) Run(args []string) int {
 			}
 		}
 		for _, upstream := range c.Config.Worker.InitialUpstreams {
-			host, _, err := net.SplitHostPort(upstream)
-			if err != nil {
-				if strings.Contains(err.Error(), globals.MissingPortErrStr) {
-					host = upstream
-				} else {
-					c.UI.Error(fmt.Errorf("Invalid worker upstream address %q: %w", upstream, err).Error())
-					return base.CommandUserError
-				}
+			host, _, err := util.SplitHostPort(upstream)
+			if err != nil && !errors.Is(err, util.ErrMissingPort) {
+				c.UI.Error(fmt.Errorf("Invalid worker upstream address %q: %w", upstream, err).Error())
+				return base.CommandUserError
 			}
 			ip := net.ParseIP(host)
 			if ip != nil {
@@ -355,10 +377,17 @@ func (c *Command) Run(args []string) int {
 			}
 		}
 	}
+	if c.Config.Controller != nil && c.Config.Controller.Acme != nil {
+		if err := c.SetupAcme(c.Config.Controller.Acme); err != nil {
+			c.UI.Error(err.Error())
+			return base.CommandUserError
+		}
+	}
 	if err := c.SetupListeners(c.UI, c.Config.SharedConfig, []string{"api", "cluster", "proxy", "ops"}); err != nil {
 		c.UI.Error(err.Error())
 		return base.CommandUserError
 	}
+	c.StartListenerTlsWatcher(c.Config.ListenerTlsWatchIntervalDuration)
 
 	if c.Config.Controller != nil {
 		for _, ln := range c.Config.Listeners {
@@ -366,14 +395,10 @@ func (c *Command) Run(args []string) int {
 				if purpose != "cluster" {
 					continue
 				}
-				host, _, err := net.SplitHostPort(ln.Address)
-				if err != nil {
-					if strings.Contains(err.Error(), globals.MissingPortErrStr) {
-						host = ln.Address
-					} else {
-						c.UI.Error(fmt.Errorf("Invalid cluster listener address %q: %w", ln.Address, err).Error())
-						return base.CommandUserError
-					}
+				host, _, err := util.SplitHostPort(ln.Address)
+				if err != nil && !errors.Is(err, util.ErrMissingPort) {
+					c.UI.Error(fmt.Errorf("Invalid cluster listener address %q: %w", ln.Address, err).Error())
+					return base.CommandUserError
 				}
 				ip := net.ParseIP(host)
 				if ip != nil {
@@ -398,7 +423,7 @@ func (c *Command) Run(args []string) int {
 			return base.CommandUserError
 		}
 		var err error
-		c.DatabaseUrl, err = parseutil.ParsePath(c.Config.Controller.Database.Url)
+		c.DatabaseUrl, err = config.ParseSecretPath(c.Config.Controller.Database.Url)
 		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
 			c.UI.Error(fmt.Errorf("Error parsing database url: %w", err).Error())
 			return base.CommandUserError
@@ -533,6 +558,16 @@ func (c *Command) ParseFlagsAndConfig(args []string) int {
 		return base.CommandUserError
 	}
 
+	if c.flagValidateConfigRole != "" && !c.flagValidateConfig {
+		c.UI.Error("-validate-config-role can only be used with -validate-config")
+		return base.CommandUserError
+	}
+	if c.flagValidateConfig {
+		// The configuration is loaded by the validation so that parse
+		// errors are reported as findings.
+		return base.CommandSuccess
+	}
+
 	cfg, out := c.reloadConfig()
 	if out > 0 {
 		return out
@@ -851,7 +886,7 @@ func (c *Command) reloadControllerDatabase(newConfig *config.Config) error {
 	}
 
 	var err error
-	newConfig.Controller.Database.Url, err = parseutil.ParsePath(newConfig.Controller.Database.Url)
+	newConfig.Controller.Database.Url, err = config.ParseSecretPath(newConfig.Controller.Database.Url)
 	if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
 		return fmt.Errorf("failed to parse db url: %w", err)
 	}
@@ -0,0 +1,459 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/util"
+	external_protocol_plugins "github.com/hashicorp/boundary/sdk/plugins/protocol"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/hashicorp/go-secure-stdlib/listenerutil"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+	"github.com/hashicorp/go-uuid"
+)
+
+const (
+	findingSeverityError   = "error"
+	findingSeverityWarning = "warning"
+)
+
+// configFinding is a single problem found while validating a configuration.
+type configFinding struct {
+	Severity string `json:"severity"`
+	Check    string `json:"check"`
+	Message  string `json:"message"`
+}
+
+// configValidationResult is the machine-readable output of -validate-config.
+type configValidationResult struct {
+	Valid    bool            `json:"valid"`
+	Roles    []string        `json:"roles"`
+	Findings []configFinding `json:"findings"`
+}
+
+// configValidator collects the findings of the checks run against a
+// configuration.
+type configValidator struct {
+	c        *Command
+	cfg      *config.Config
+	findings []configFinding
+}
+
+func (v *configValidator) errorf(check, format string, a ...any) {
+	v.findings = append(v.findings, configFinding{Severity: findingSeverityError, Check: check, Message: fmt.Sprintf(format, a...)})
+}
+
+func (v *configValidator) warnf(check, format string, a ...any) {
+	v.findings = append(v.findings, configFinding{Severity: findingSeverityWarning, Check: check, Message: fmt.Sprintf(format, a...)})
+}
+
+// validateConfig loads the configuration given with -config and checks it the
+// way starting the server would, without connecting to the database or
+// starting any listener. The findings are written to stdout as JSON, and the
+// command fails if any of them is an error.
+func (c *Command) validateConfig() int {
+	switch c.flagValidateConfigRole {
+	case "", "controller", "worker":
+	default:
+		c.UI.Error(fmt.Sprintf("Unknown role %q given to -validate-config-role, must be %q or %q", c.flagValidateConfigRole, "controller", "worker"))
+		return base.CommandUserError
+	}
+
+	result := configValidationResult{Roles: []string{}, Findings: []configFinding{}}
+	cfg, err := c.loadConfigForValidation()
+	if err != nil {
+		result.Findings = append(result.Findings, configFinding{Severity: findingSeverityError, Check: "parse", Message: err.Error()})
+	} else {
+		result.Roles, result.Findings = c.configFindings(cfg, c.flagValidateConfigRole)
+	}
+	result.Valid = true
+	for _, f := range result.Findings {
+		if f.Severity == findingSeverityError {
+			result.Valid = false
+		}
+	}
+
+	b, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		c.UI.Error(fmt.Errorf("Error formatting validation result: %w", err).Error())
+		return base.CommandCliError
+	}
+	c.UI.Output(string(b))
+	if !result.Valid {
+		return base.CommandUserError
+	}
+	return base.CommandSuccess
+}
+
+// loadConfigForValidation loads and parses the configuration, returning the
+// parse error instead of writing it out.
+func (c *Command) loadConfigForValidation() (*config.Config, error) {
+	var cfg *config.Config
+	var err error
+	switch {
+	case c.presetConfig != nil:
+		cfg, err = config.Parse(c.presetConfig.Load())
+	default:
+		cfg, err = config.Load(c.Context, c.flagConfig, c.flagConfigKms)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if extraConfigValidationFunc != nil {
+		if err := extraConfigValidationFunc(cfg); err != nil {
+			return nil, err
+		}
+	}
+	return cfg, nil
+}
+
+// configFindings runs the validation checks against the parsed configuration
+// and returns the roles that were validated along with the findings. If role
+// is set only the checks of that role are run.
+func (c *Command) configFindings(cfg *config.Config, role string) ([]string, []configFinding) {
+	v := &configValidator{c: c, cfg: cfg}
+	controller := cfg.Controller != nil && (role == "" || role == "controller")
+	worker := cfg.Worker != nil && (role == "" || role == "worker")
+
+	roles := []string{}
+	if controller {
+		roles = append(roles, "controller")
+	}
+	if worker {
+		roles = append(roles, "worker")
+	}
+	switch {
+	case role != "" && len(roles) == 0:
+		v.errorf("role", "Config does not activate a %s", role)
+		return roles, v.findings
+	case len(roles) == 0:
+		v.errorf("role", "Config activates neither a controller nor a worker")
+		return roles, v.findings
+	}
+
+	// Loading the KMSes requires an eventer; the event gate is never released
+	// so nothing but the findings is written out.
+	if err := c.SetupLogging(c.flagLogLevel, c.flagLogFormat, cfg.LogLevel, cfg.LogFormat); err != nil {
+		v.errorf("logging", "%s", err)
+		return roles, v.findings
+	}
+	if err := c.SetupEventing(c.Logger,
+		c.StderrLock,
+		"validate-config",
+		base.WithEventerConfig(cfg.Eventing),
+		base.WithEventGating(true)); err != nil {
+		v.errorf("eventing", "%s", err)
+		return roles, v.findings
+	}
+
+	v.validateKms(controller, worker)
+	v.validateListeners(controller, worker)
+	v.validatePlugins(worker)
+	if controller {
+		v.validateController()
+	}
+	if worker {
+		v.validateWorker(controller)
+	}
+	return roles, v.findings
+}
+
+// validateKms loads the configured KMSes and checks each one needed by the
+// validated roles can encrypt and decrypt, which for external KMSes checks
+// they can be reached with the configured credentials.
+func (v *configValidator) validateKms(controller, worker bool) {
+	const check = "kms"
+	if err := v.c.SetupKMSes(v.c.Context, v.c.UI, v.cfg); err != nil {
+		v.errorf(check, "%s", err)
+		return
+	}
+	if controller && v.c.RootKms == nil {
+		v.errorf(check, "Root KMS not found after parsing KMS blocks")
+	}
+
+	type purposeWrapper struct {
+		purpose string
+		wrapper wrapping.Wrapper
+	}
+	var wrappers []purposeWrapper
+	if controller {
+		wrappers = append(wrappers,
+			purposeWrapper{globals.KmsPurposeRoot, v.c.RootKms},
+			purposeWrapper{globals.KmsPurposeRecovery, v.c.RecoveryKms})
+	}
+	wrappers = append(wrappers, purposeWrapper{globals.KmsPurposeWorkerAuth, v.c.WorkerAuthKms})
+	if worker {
+		wrappers = append(wrappers, purposeWrapper{globals.KmsPurposeWorkerAuthStorage, v.c.WorkerAuthStorageKms})
+	}
+	plaintext := []byte("boundary config validation")
+	for _, w := range wrappers {
+		if w.wrapper == nil {
+			continue
+		}
+		blob, err := w.wrapper.Encrypt(v.c.Context, plaintext)
+		if err != nil {
+			v.errorf(check, "Unable to encrypt with KMS of purpose %q: %s", w.purpose, err)
+			continue
+		}
+		pt, err := w.wrapper.Decrypt(v.c.Context, blob)
+		switch {
+		case err != nil:
+			v.errorf(check, "Unable to decrypt with KMS of purpose %q: %s", w.purpose, err)
+		case string(pt) != string(plaintext):
+			v.errorf(check, "KMS of purpose %q did not decrypt to the encrypted value", w.purpose)
+		}
+	}
+}
+
+// listenerAddr returns the network and the address the listener binds to,
+// filling in the default address and port of its purpose the way the
+// listener factories do.
+func listenerAddr(ln *listenerutil.ListenerConfig) (network, host, port string, err error) {
+	if ln.Type == "unix" {
+		return "unix", ln.Address, "", nil
+	}
+	defaultPorts := map[string]string{"api": "9200", "cluster": "9201", "proxy": "9202", "ops": "9203"}
+	var purpose string
+	if len(ln.Purpose) > 0 {
+		purpose = ln.Purpose[0]
+	}
+	address := ln.Address
+	if address == "" {
+		address = "127.0.0.1"
+	}
+	host, port, err = util.SplitHostPort(address)
+	if err != nil {
+		if !errors.Is(err, util.ErrMissingPort) {
+			return "", "", "", err
+		}
+		port = defaultPorts[purpose]
+	}
+	return "tcp", host, port, nil
+}
+
+// isUnspecifiedHost reports whether the host binds to all addresses.
+func isUnspecifiedHost(host string) bool {
+	if host == "" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsUnspecified()
+}
+
+// validateListeners checks the purposes of the listeners, that the listeners
+// the validated roles need exist, and that no two listeners bind the same
+// address. Addresses already in use on this host are reported as warnings
+// since the configuration may be validated on another host than it is used
+// on.
+func (v *configValidator) validateListeners(controller, worker bool) {
+	const check = "listener"
+	var foundApi, foundCluster, foundProxy bool
+	type bound struct {
+		purpose, network, host, port string
+	}
+	var bounds []bound
+	for _, ln := range v.cfg.Listeners {
+		switch len(ln.Purpose) {
+		case 0:
+			v.errorf(check, "Listener specified without a purpose")
+			continue
+		case 1:
+		default:
+			v.errorf(check, "Specifying a listener with more than one purpose is not supported")
+			continue
+		}
+		purpose := ln.Purpose[0]
+		switch purpose {
+		case "api":
+			foundApi = true
+		case "cluster":
+			foundCluster = true
+		case "proxy":
+			foundProxy = true
+		case "ops":
+		default:
+			v.errorf(check, "Unknown listener purpose %q", purpose)
+			continue
+		}
+
+		network, host, port, err := listenerAddr(ln)
+		if err != nil {
+			v.errorf(check, "Invalid %q listener address %q: %s", purpose, ln.Address, err)
+			continue
+		}
+		for _, b := range bounds {
+			// Port 0 binds a random port so never conflicts
+			conflict := b.network == network && b.port == port && port != "0" &&
+				(b.host == host || (network == "tcp" && (isUnspecifiedHost(b.host) || isUnspecifiedHost(host))))
+			if conflict {
+				v.errorf(check, "%q listener address %q conflicts with %q listener address %q", purpose, net.JoinHostPort(host, port), b.purpose, net.JoinHostPort(b.host, b.port))
+			}
+		}
+		bounds = append(bounds, bound{purpose: purpose, network: network, host: host, port: port})
+
+		if network != "tcp" || ln.RandomPort {
+			continue
+		}
+		addr := net.JoinHostPort(host, port)
+		l, err := net.Listen("tcp", addr)
+		if err != nil {
+			v.warnf(check, "Unable to bind %q listener address %q on this host: %s", purpose, addr, err)
+			continue
+		}
+		_ = l.Close()
+	}
+
+	if controller {
+		if !foundApi {
+			v.errorf(check, `Config activates controller but no listener with "api" purpose found`)
+		}
+		if !foundCluster {
+			v.errorf(check, `Config activates controller but no listener with "cluster" purpose found`)
+		}
+	}
+	if worker && !foundProxy {
+		v.errorf(check, `Config activates worker but no listener with "proxy" purpose found`)
+	}
+}
+
+// validatePlugins checks the plugin execution directory is a writable
+// directory and that the worker's protocol plugins can be found.
+func (v *configValidator) validatePlugins(worker bool) {
+	const check = "plugin"
+	if dir := v.cfg.Plugins.ExecutionDir; dir != "" {
+		switch fi, err := os.Stat(dir); {
+		case err != nil:
+			v.errorf(check, "Plugin execution_dir %q: %s", dir, err)
+		case !fi.IsDir():
+			v.errorf(check, "Plugin execution_dir %q is not a directory", dir)
+		default:
+			f, err := os.CreateTemp(dir, "boundary-validate-")
+			if err != nil {
+				v.errorf(check, "Plugin execution_dir %q is not writable: %s", dir, err)
+				break
+			}
+			_ = f.Close()
+			_ = os.Remove(f.Name())
+		}
+	}
+
+	if !worker || len(v.cfg.Worker.ProtocolPlugins) == 0 {
+		return
+	}
+	dir := v.cfg.Worker.ProtocolPluginsDir
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		v.errorf(check, "Worker protocol_plugins_dir %q: %s", dir, err)
+		return
+	}
+	found := make(map[string]bool, len(entries))
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasPrefix(e.Name(), external_protocol_plugins.ProtocolPluginPrefix) {
+			continue
+		}
+		name := strings.TrimPrefix(e.Name(), external_protocol_plugins.ProtocolPluginPrefix)
+		name = strings.TrimSuffix(strings.TrimSuffix(name, ".gz"), ".exe")
+		found[name] = true
+	}
+	for _, name := range v.cfg.Worker.ProtocolPlugins {
+		if !found[name] {
+			v.errorf(check, "Protocol plugin %q not found in %q", name, filepath.Join(dir, external_protocol_plugins.ProtocolPluginPrefix+name))
+		}
+	}
+}
+
+// validateController checks the controller's public cluster address and
+// database settings. The database is not connected to.
+func (v *configValidator) validateController() {
+	const check = "controller"
+	ctrl := v.cfg.Controller
+	if err := v.cfg.SetupControllerPublicClusterAddress(""); err != nil {
+		v.errorf(check, "%s", err)
+	}
+	for _, ln := range v.cfg.Listeners {
+		if len(ln.Purpose) != 1 || ln.Purpose[0] != "cluster" {
+			continue
+		}
+		if _, host, _, err := listenerAddr(ln); err == nil && isUnspecifiedHost(host) && ctrl.PublicClusterAddr == "" {
+			v.errorf(check, "When %q listener has an unspecified address, %q must be set", "cluster", "public_cluster_addr")
+		}
+	}
+	if ctrl.Database == nil || ctrl.Database.Url == "" {
+		v.errorf(check, `"url" not specified in "controller.database" config block`)
+		return
+	}
+	if _, err := config.ParseSecretPath(ctrl.Database.Url); err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+		v.errorf(check, "Error parsing database url: %s", err)
+	}
+}
+
+// validateWorker checks the worker's authentication settings and upstreams.
+func (v *configValidator) validateWorker(controller bool) {
+	const check = "worker"
+	w := v.cfg.Worker
+	switch v.c.WorkerAuthKms {
+	case nil:
+		if w.AuthStoragePath == "" {
+			v.errorf(check, "No worker auth KMS specified and no worker auth storage path specified.")
+		}
+		if w.Name != "" || w.Description != "" {
+			v.errorf(check, "Worker config cannot contain name or description when using PKI-based worker authentication; it must be set via the API.")
+		}
+	default:
+		if w.Name == "" {
+			v.errorf(check, "Worker is using KMS auth but has no name set. It must be the unique name of this instance.")
+		}
+		if w.ControllerGeneratedActivationToken != "" {
+			v.errorf(check, "Worker has KMS auth info but also has a controller-generated activation token set, which is incompatible.")
+		}
+	}
+	if v.cfg.Controller != nil && w.Name == v.cfg.Controller.Name {
+		v.errorf(check, "Controller and worker cannot be configured with the same name.")
+	}
+	if w.ControllersRaw != nil {
+		v.warnf(check, "The \"controllers\" field for worker config is deprecated. Please use \"initial_upstreams\" instead.")
+	}
+	if err := v.c.SetupWorkerPublicAddress(v.cfg, ""); err != nil {
+		v.errorf(check, "%s", err)
+	}
+	if controller {
+		if err := v.cfg.SetupWorkerInitialUpstreams(); err != nil {
+			v.errorf(check, "%s", err)
+		}
+	}
+	for _, upstream := range w.InitialUpstreams {
+		host, _, err := util.SplitHostPort(upstream)
+		if err != nil && !errors.Is(err, util.ErrMissingPort) {
+			v.errorf(check, "Invalid worker upstream address %q: %s", upstream, err)
+			continue
+		}
+		if ip := net.ParseIP(host); ip != nil {
+			switch {
+			case ip.IsUnspecified():
+				v.errorf(check, "Worker upstream address %q is invalid: cannot be an unspecified address", upstream)
+			case ip.IsMulticast():
+				v.errorf(check, "Worker upstream address %q is invalid: cannot be a multicast address", upstream)
+			}
+		}
+	}
+	if v.cfg.HcpbClusterId != "" {
+		if len(w.InitialUpstreams) > 0 {
+			v.errorf(check, "Initial upstreams and HCPB cluster ID are mutually exclusive fields")
+		}
+		clusterId := strings.TrimPrefix(v.cfg.HcpbClusterId, "int-")
+		if _, err := uuid.ParseUUID(clusterId); err != nil {
+			v.errorf(check, "Invalid HCP Boundary cluster ID %q: %s", clusterId, err)
+		}
+	}
+}
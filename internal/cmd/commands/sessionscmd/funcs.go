@@ -21,20 +21,37 @@ const (
 
 func init() {
 	extraActionsFlagsMapFunc = extraActionsFlagsMapFuncImpl
+	extraSynopsisFunc = extraSynopsisFuncImpl
 	extraFlagsFunc = extraFlagsFuncImpl
 	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
 	executeExtraActions = executeExtraActionsImpl
+	printCustomActionOutput = printCustomActionOutputImpl
 }
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"cancel": {"id"},
-		"list":   {flagIncludeTerminated},
+		"cancel":          {"id"},
+		"list":            {flagIncludeTerminated},
+		"read-transcript": {"id"},
+		"my-sessions":     {"scope-id", "filter", "recursive"},
 	}
 }
 
 type extraCmdVars struct {
 	flagIncludeTerminated bool
+	transcript            *sessions.TranscriptReadResult
+	history               *sessions.SessionHistoryListResult
+}
+
+func extraSynopsisFuncImpl(c *Command) string {
+	switch c.Func {
+	case "read-transcript":
+		return "Read the transcript uploaded by the client of a session"
+	case "my-sessions":
+		return "List the history of your terminated sessions"
+	default:
+		return ""
+	}
 }
 
 func extraFlagsFuncImpl(c *Command, set *base.FlagSets, f *base.FlagSet) {
@@ -84,6 +101,28 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 		})
 
+	case "read-transcript":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary sessions read-transcript [options] [args]",
+			"",
+			"  Read the terminal transcript uploaded by the client of the session specified by ID, as captured by \"boundary connect ssh -record-transcript\". The transcript is written to standard output as it was captured. Example:",
+			"",
+			`    $ boundary sessions read-transcript -id s_1234567890`,
+			"",
+			"",
+		})
+
+	case "my-sessions":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary sessions my-sessions [options] [args]",
+			"",
+			"  List the history of your terminated sessions, most recently terminated first, with their duration, the bytes transferred and why they and their connections were closed. The history is kept after the sessions are deleted. Example:",
+			"",
+			`    $ boundary sessions my-sessions -recursive`,
+			"",
+			"",
+		})
+
 	default:
 		helpStr = helpMap["base"]()
 	}
@@ -99,10 +138,122 @@ func executeExtraActionsImpl(c *Command, origResp *api.Response, origItem *sessi
 			return nil, nil, nil, err
 		}
 		return result.GetResponse(), result.GetItem(), nil, err
+	case "read-transcript":
+		var err error
+		c.plural = "session transcript"
+		c.transcript, err = sessionClient.ReadTranscript(c.Context, c.FlagId, opts...)
+		return nil, nil, nil, err
+	case "my-sessions":
+		var err error
+		c.plural = "session history"
+		c.history, err = sessionClient.ListMySessions(c.Context, c.FlagScopeId, opts...)
+		return nil, nil, nil, err
 	}
 	return origResp, origItem, origItems, origError
 }
 
+func printCustomActionOutputImpl(c *Command) (bool, error) {
+	switch c.Func {
+	case "read-transcript":
+		switch base.Format(c.UI) {
+		case "table":
+			item := c.transcript.GetItem()
+			if item.Truncated {
+				c.UI.Warn("The transcript was truncated by the client because it reached the maximum size.")
+			}
+			c.UI.Output(string(item.Transcript))
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItem(c.transcript.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+
+	case "my-sessions":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output(c.printHistoryTable(c.history.GetItems()))
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItems(c.history.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (c *Command) printHistoryTable(items []*sessions.SessionHistory) string {
+	if len(items) == 0 {
+		return "No session history found"
+	}
+	output := []string{
+		"",
+		"Session history:",
+	}
+	for i, item := range items {
+		if i > 0 {
+			output = append(output, "")
+		}
+		output = append(output,
+			fmt.Sprintf("  ID:                    %s", item.Id),
+		)
+		if c.FlagRecursive && item.ScopeId != "" {
+			output = append(output,
+				fmt.Sprintf("    Scope ID:            %s", item.ScopeId),
+			)
+		}
+		if item.TargetId != "" {
+			output = append(output,
+				fmt.Sprintf("    Target ID:           %s", item.TargetId),
+			)
+		}
+		if !item.CreatedTime.IsZero() {
+			output = append(output,
+				fmt.Sprintf("    Created Time:        %s", item.CreatedTime.Local().Format(time.RFC1123)),
+			)
+		}
+		if !item.TerminatedTime.IsZero() {
+			output = append(output,
+				fmt.Sprintf("    Terminated Time:     %s", item.TerminatedTime.Local().Format(time.RFC1123)),
+			)
+		}
+		output = append(output,
+			fmt.Sprintf("    Duration:            %s", time.Duration(item.DurationSeconds)*time.Second),
+		)
+		if item.TerminationReason != "" {
+			output = append(output,
+				fmt.Sprintf("    Termination Reason:  %s", item.TerminationReason),
+			)
+		}
+		output = append(output,
+			fmt.Sprintf("    Connections:         %d", item.ConnectionCount),
+			fmt.Sprintf("    Bytes Up:            %d", item.BytesUp),
+			fmt.Sprintf("    Bytes Down:          %d", item.BytesDown),
+		)
+		for _, conn := range item.Connections {
+			output = append(output,
+				"",
+				fmt.Sprintf("    Connection ID:       %s", conn.Id),
+				fmt.Sprintf("      Duration:          %s", time.Duration(conn.DurationSeconds)*time.Second),
+				fmt.Sprintf("      Bytes Up:          %d", conn.BytesUp),
+				fmt.Sprintf("      Bytes Down:        %d", conn.BytesDown),
+			)
+			if conn.ClosedReason != "" {
+				output = append(output,
+					fmt.Sprintf("      Closed Reason:     %s", conn.ClosedReason),
+				)
+			}
+		}
+	}
+
+	return base.WrapForHelpText(output)
+}
+
 func (c *Command) printListTable(items []*sessions.Session) string {
 	if len(items) == 0 {
 		return "No sessions found"
@@ -215,6 +366,9 @@ func printItemTable(item *sessions.Session, resp *api.Response) string {
 	if len(strings.TrimSpace(item.TerminationReason)) > 0 {
 		nonAttributeMap["Termination Reason"] = item.TerminationReason
 	}
+	if !item.BannerAcknowledgedTime.IsZero() {
+		nonAttributeMap["Banner Acknowledged Time"] = item.BannerAcknowledgedTime.Local().Format(time.RFC1123)
+	}
 
 	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
 
@@ -166,6 +166,14 @@ func (c *Command) Run(args []string) int {
 	}
 	sessionsClient := sessions.NewClient(client)
 
+	switch {
+	case c.Func == "create", c.Func == "list" && !c.FlagRecursive:
+		if err := c.CheckAuthorizedAction(client, c.FlagScopeId, "session", c.Func); err != nil {
+			c.PrintCliError(err)
+			return base.CommandUserError
+		}
+	}
+
 	switch c.FlagRecursive {
 	case true:
 		opts = append(opts, sessions.WithRecursive(true))
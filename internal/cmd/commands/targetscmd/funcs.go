@@ -8,6 +8,8 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"math"
+	"strconv"
 	"strings"
 	"time"
 
@@ -18,6 +20,7 @@ import (
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/boundary/internal/credential"
 	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
 	"github.com/hashicorp/go-secure-stdlib/strutil"
 	"github.com/mitchellh/go-wordwrap"
 	"github.com/posener/complete"
@@ -35,21 +38,42 @@ func init() {
 type extraCmdVars struct {
 	flagHostSets                             []string
 	flagHostSources                          []string
+	flagFallbackHostSources                  []string
 	flagBrokeredCredentialSources            []string
 	flagInjectedApplicationCredentialSources []string
+	flagExclusiveCredentialSources           []string
+	flagFallbackCredentialSources            []string
 	flagHostId                               string
+	flagFavorites                            bool
+	flagRecent                               bool
+	flagUsername                             string
+	flagPublicKey                            string
+	flagPrincipals                           []string
+	flagValidSeconds                         uint64
 	sar                                      *targets.SessionAuthorizationResult
+	availableHosts                           *targets.AvailableHostsResult
+	favoriteResult                           *targets.FavoriteResult
+	sshCaResult                              *targets.SshCertificateAuthorityResult
+	disableSshCaResult                       *targets.DisableSshCertificateAuthorityResult
+	sshHostCertResult                        *targets.SshHostCertificateResult
 }
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"authorize-session":         {"id", "host-id"},
-		"add-host-sources":          {"id", "host-source", "version"},
-		"remove-host-sources":       {"id", "host-source", "version"},
-		"set-host-sources":          {"id", "host-source", "version"},
-		"add-credential-sources":    {"id", "application-credential-source", "brokered-credential-source", "injected-application-credential-source", "version"},
-		"remove-credential-sources": {"id", "application-credential-source", "brokered-credential-source", "injected-application-credential-source", "version"},
-		"set-credential-sources":    {"id", "application-credential-source", "brokered-credential-source", "injected-application-credential-source", "version"},
+		"authorize-session":                 {"id", "host-id"},
+		"list-available-hosts":              {"id"},
+		"add-favorite":                      {"id"},
+		"remove-favorite":                   {"id"},
+		"list":                              {"favorites", "recent"},
+		"enable-ssh-certificate-authority":  {"id", "username"},
+		"disable-ssh-certificate-authority": {"id"},
+		"sign-ssh-host-key":                 {"id", "public-key", "principal", "valid-seconds"},
+		"add-host-sources":                  {"id", "host-source", "fallback-host-source", "version"},
+		"remove-host-sources":               {"id", "host-source", "version"},
+		"set-host-sources":                  {"id", "host-source", "fallback-host-source", "version"},
+		"add-credential-sources":            {"id", "application-credential-source", "brokered-credential-source", "injected-application-credential-source", "exclusive-credential-source", "fallback-credential-source", "version"},
+		"remove-credential-sources":         {"id", "application-credential-source", "brokered-credential-source", "injected-application-credential-source", "version"},
+		"set-credential-sources":            {"id", "application-credential-source", "brokered-credential-source", "injected-application-credential-source", "exclusive-credential-source", "fallback-credential-source", "version"},
 	}
 }
 
@@ -82,6 +106,24 @@ func extraSynopsisFuncImpl(c *Command) string {
 	case "authorize-session":
 		return "Request session authorization against the target"
 
+	case "list-available-hosts":
+		return "List the hosts a session can currently be authorized against through the target"
+
+	case "add-favorite":
+		return "Add the target to your favorite targets"
+
+	case "remove-favorite":
+		return "Remove the target from your favorite targets"
+
+	case "enable-ssh-certificate-authority":
+		return "Enable the SSH certificate authority of the target"
+
+	case "disable-ssh-certificate-authority":
+		return "Disable the SSH certificate authority of the target"
+
+	case "sign-ssh-host-key":
+		return "Sign an SSH host key with the certificate authority of the target"
+
 	default:
 		return ""
 	}
@@ -214,6 +256,78 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			"",
 		})
+	case "list-available-hosts":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary target list-available-hosts [options] [args]",
+			"",
+			"  This command lists the hosts a session can currently be authorized against through a target. Any of the listed host IDs can be passed to authorize-session using -host-id. Example:",
+			"",
+			"    List the available hosts of a target:",
+			"",
+			`      $ boundary targets list-available-hosts -id ttcp_1234567890`,
+			"",
+			"",
+		})
+	case "add-favorite":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary target add-favorite [options] [args]",
+			"",
+			"  This command adds a target to the favorite targets of the authenticated user. Favorite targets can be listed using the -favorites flag of the list command. Example:",
+			"",
+			"    Add a target to your favorite targets:",
+			"",
+			`      $ boundary targets add-favorite -id ttcp_1234567890`,
+			"",
+			"",
+		})
+	case "remove-favorite":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary target remove-favorite [options] [args]",
+			"",
+			"  This command removes a target from the favorite targets of the authenticated user. Example:",
+			"",
+			"    Remove a target from your favorite targets:",
+			"",
+			`      $ boundary targets remove-favorite -id ttcp_1234567890`,
+			"",
+			"",
+		})
+	case "enable-ssh-certificate-authority":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary targets enable-ssh-certificate-authority [options] [args]",
+			"",
+			"  This command enables the SSH certificate authority Boundary manages for a target. The certificate authority issues a client certificate for the given username for each session authorized against the target, which the worker injects. Endpoints must trust the returned public key to accept the client certificates. Enabling the certificate authority again updates the username and keeps its key. Example:",
+			"",
+			"    Enable the SSH certificate authority of a target:",
+			"",
+			`      $ boundary targets enable-ssh-certificate-authority -id tssh_1234567890 -username ubuntu`,
+			"",
+			"",
+		})
+	case "disable-ssh-certificate-authority":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary targets disable-ssh-certificate-authority [options] [args]",
+			"",
+			"  This command deletes the SSH certificate authority of a target. Sessions authorized afterwards are not issued client certificates, and enabling the certificate authority again generates a new key. Example:",
+			"",
+			"    Disable the SSH certificate authority of a target:",
+			"",
+			`      $ boundary targets disable-ssh-certificate-authority -id tssh_1234567890`,
+			"",
+			"",
+		})
+	case "sign-ssh-host-key":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary targets sign-ssh-host-key [options] [args]",
+			"",
+			"  This command signs the host key of an endpoint of a target with the SSH certificate authority of the target, so clients which trust the certificate authority can authenticate the endpoint. Example:",
+			"",
+			"    Sign a host key for an endpoint:",
+			"",
+			`      $ boundary targets sign-ssh-host-key -id tssh_1234567890 -public-key file:///etc/ssh/ssh_host_ed25519_key.pub -principal db.example.com`,
+			"",
+			"",
+		})
 	}
 	return helpStr + c.Flags().Help()
 }
@@ -233,6 +347,12 @@ func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagHostSources,
 				Usage:  "The host sources to add, remove, or set. May be specified multiple times.",
 			})
+		case "fallback-host-source":
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   "fallback-host-source",
+				Target: &c.flagFallbackHostSources,
+				Usage:  "A host source, also given with -host-source, that is preferred in turn with the other fallback host sources until one has a healthy host. May be specified multiple times; the order of the flags is the order in which the sources are preferred.",
+			})
 		case "host-id":
 			f.StringVar(&base.StringVar{
 				Name:   "host-id",
@@ -257,6 +377,54 @@ func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagInjectedApplicationCredentialSources,
 				Usage:  "The credential source to add, set, or remove that Boundary will inject when creating a connection. May be specified multiple times.",
 			})
+		case "exclusive-credential-source":
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   "exclusive-credential-source",
+				Target: &c.flagExclusiveCredentialSources,
+				Usage:  "A static credential source, also given as a brokered or injected application credential source, that only one session at a time can check out. May be specified multiple times.",
+			})
+		case "fallback-credential-source":
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   "fallback-credential-source",
+				Target: &c.flagFallbackCredentialSources,
+				Usage:  "A credential source, also given as a brokered or injected application credential source, that is tried in turn with the other fallback credential sources of the same purpose until one returns a credential. May be specified multiple times; the order of the flags is the order in which the sources are tried.",
+			})
+		case "favorites":
+			f.BoolVar(&base.BoolVar{
+				Name:   "favorites",
+				Target: &c.flagFavorites,
+				Usage:  "If set, only your favorite targets are listed. Implies -recursive.",
+			})
+		case "recent":
+			f.BoolVar(&base.BoolVar{
+				Name:   "recent",
+				Target: &c.flagRecent,
+				Usage:  "If set, only the targets you most recently authorized sessions against are listed, most recent first. Implies -recursive.",
+			})
+		case "username":
+			f.StringVar(&base.StringVar{
+				Name:   "username",
+				Target: &c.flagUsername,
+				Usage:  "The username of the client certificates issued for the sessions of the target.",
+			})
+		case "public-key":
+			f.StringVar(&base.StringVar{
+				Name:   "public-key",
+				Target: &c.flagPublicKey,
+				Usage:  "The host key to sign in the authorized_keys format. This can refer to a file on disk (file://) from which the value will be read or an env var (env://) from which the value will be read.",
+			})
+		case "principal":
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   "principal",
+				Target: &c.flagPrincipals,
+				Usage:  "A host name or address the endpoint is reached by. May be specified multiple times.",
+			})
+		case "valid-seconds":
+			f.Uint64Var(&base.Uint64Var{
+				Name:   "valid-seconds",
+				Target: &c.flagValidSeconds,
+				Usage:  "The number of seconds the host certificate is valid for. If not set, the host certificate does not expire.",
+			})
 		}
 	}
 
@@ -308,6 +476,9 @@ func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]targets.Op
 				c.UI.Error("Cannot specify a target ID and also other lookup parameters")
 				return false
 			}
+		case "read":
+			// Checked along with the name and scope ID when the flags are
+			// parsed.
 		default:
 			if c.FlagId == "" {
 				c.UI.Error("ID is required but not passed in via -id")
@@ -326,6 +497,9 @@ func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]targets.Op
 			c.UI.Error("No host sources supplied via -host-source")
 			return false
 		}
+		if len(c.flagFallbackHostSources) > 0 {
+			*opts = append(*opts, targets.WithFallbackHostSourceIds(c.flagFallbackHostSources))
+		}
 
 	case "set-host-sources":
 		switch len(c.flagHostSources) {
@@ -337,6 +511,9 @@ func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]targets.Op
 				c.flagHostSources = nil
 			}
 		}
+		if len(c.flagFallbackHostSources) > 0 {
+			*opts = append(*opts, targets.WithFallbackHostSourceIds(c.flagFallbackHostSources))
+		}
 
 	case "add-credential-sources", "remove-credential-sources":
 		if len(c.flagBrokeredCredentialSources)+len(c.flagInjectedApplicationCredentialSources) == 0 {
@@ -350,6 +527,12 @@ func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]targets.Op
 		if len(c.flagInjectedApplicationCredentialSources) > 0 {
 			*opts = append(*opts, targets.WithInjectedApplicationCredentialSourceIds(c.flagInjectedApplicationCredentialSources))
 		}
+		if len(c.flagExclusiveCredentialSources) > 0 {
+			*opts = append(*opts, targets.WithExclusiveCredentialSourceIds(c.flagExclusiveCredentialSources))
+		}
+		if len(c.flagFallbackCredentialSources) > 0 {
+			*opts = append(*opts, targets.WithFallbackCredentialSourceIds(c.flagFallbackCredentialSources))
+		}
 
 	case "set-credential-sources":
 		if len(c.flagBrokeredCredentialSources)+len(c.flagInjectedApplicationCredentialSources) == 0 {
@@ -381,11 +564,59 @@ func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]targets.Op
 		default:
 			*opts = append(*opts, targets.WithInjectedApplicationCredentialSourceIds(c.flagInjectedApplicationCredentialSources))
 		}
+		if len(c.flagExclusiveCredentialSources) > 0 {
+			*opts = append(*opts, targets.WithExclusiveCredentialSourceIds(c.flagExclusiveCredentialSources))
+		}
+		if len(c.flagFallbackCredentialSources) > 0 {
+			*opts = append(*opts, targets.WithFallbackCredentialSourceIds(c.flagFallbackCredentialSources))
+		}
 
 	case "authorize-session":
 		if len(c.flagHostId) != 0 {
 			*opts = append(*opts, targets.WithHostId(c.flagHostId))
 		}
+
+	case "list":
+		if c.flagFavorites && c.flagRecent {
+			c.UI.Error("Cannot specify both -favorites and -recent")
+			return false
+		}
+		// Favorite and recent targets are usually spread over many scopes
+		if c.flagFavorites {
+			*opts = append(*opts, targets.WithFavorites(true), targets.WithRecursive(true))
+		}
+		if c.flagRecent {
+			*opts = append(*opts, targets.WithRecent(true), targets.WithRecursive(true))
+		}
+
+	case "enable-ssh-certificate-authority":
+		if c.flagUsername == "" {
+			c.UI.Error("Username is required but not passed in via -username")
+			return false
+		}
+
+	case "sign-ssh-host-key":
+		if c.flagPublicKey == "" {
+			c.UI.Error("Public key is required but not passed in via -public-key")
+			return false
+		}
+		publicKey, err := parseutil.ParsePath(c.flagPublicKey)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing public key flag: %v", err))
+			return false
+		}
+		c.flagPublicKey = publicKey
+		if len(c.flagPrincipals) == 0 {
+			c.UI.Error("No principals supplied via -principal")
+			return false
+		}
+		if c.flagValidSeconds > math.MaxUint32 {
+			c.UI.Error(fmt.Sprintf("Valid seconds must not be greater than %d", math.MaxUint32))
+			return false
+		}
+		if c.flagValidSeconds > 0 {
+			*opts = append(*opts, targets.WithValidSeconds(uint32(c.flagValidSeconds)))
+		}
 	}
 
 	return true
@@ -434,6 +665,36 @@ func executeExtraActionsImpl(c *Command, origResp *api.Response, origItem *targe
 		c.plural = "a session against target"
 		c.sar, err = targetClient.AuthorizeSession(c.Context, c.FlagId, opts...)
 		return nil, nil, nil, err
+	case "list-available-hosts":
+		var err error
+		c.plural = "available hosts of target"
+		c.availableHosts, err = targetClient.ListAvailableHosts(c.Context, c.FlagId, opts...)
+		return nil, nil, nil, err
+	case "add-favorite":
+		var err error
+		c.plural = "favorite target"
+		c.favoriteResult, err = targetClient.AddFavorite(c.Context, c.FlagId, opts...)
+		return nil, nil, nil, err
+	case "remove-favorite":
+		var err error
+		c.plural = "favorite target"
+		c.favoriteResult, err = targetClient.RemoveFavorite(c.Context, c.FlagId, opts...)
+		return nil, nil, nil, err
+	case "enable-ssh-certificate-authority":
+		var err error
+		c.plural = "SSH certificate authority of target"
+		c.sshCaResult, err = targetClient.EnableSshCertificateAuthority(c.Context, c.FlagId, c.flagUsername, opts...)
+		return nil, nil, nil, err
+	case "disable-ssh-certificate-authority":
+		var err error
+		c.plural = "SSH certificate authority of target"
+		c.disableSshCaResult, err = targetClient.DisableSshCertificateAuthority(c.Context, c.FlagId, opts...)
+		return nil, nil, nil, err
+	case "sign-ssh-host-key":
+		var err error
+		c.plural = "SSH host key with certificate authority of target"
+		c.sshHostCertResult, err = targetClient.SignSshHostKey(c.Context, c.FlagId, c.flagPublicKey, c.flagPrincipals, opts...)
+		return nil, nil, nil, err
 	}
 	return origResp, origItem, origItems, origError
 }
@@ -536,6 +797,26 @@ func printItemTable(item *targets.Target, resp *api.Response) string {
 	if item.IngressWorkerFilter != "" {
 		nonAttributeMap["Ingress Worker Filter"] = item.IngressWorkerFilter
 	}
+	if item.MaxAuthAgeSeconds != 0 {
+		nonAttributeMap["Max Auth Age Seconds"] = item.MaxAuthAgeSeconds
+	}
+	if item.Banner != "" {
+		nonAttributeMap["Banner"] = item.Banner
+	}
+	if item.DeletionProtected {
+		nonAttributeMap["Deletion Protected"] = item.DeletionProtected
+	}
+	if a := item.Annotations; a != nil {
+		if a.Owner != "" {
+			nonAttributeMap["Owner"] = a.Owner
+		}
+		if a.CostCenter != "" {
+			nonAttributeMap["Cost Center"] = a.CostCenter
+		}
+		if a.TicketUrl != "" {
+			nonAttributeMap["Ticket URL"] = a.TicketUrl
+		}
+	}
 	if resp != nil && resp.Map != nil {
 		if resp.Map[globals.SessionConnectionLimitField] != nil {
 			nonAttributeMap["Session Connection Limit"] = item.SessionConnectionLimit
@@ -554,6 +835,9 @@ func printItemTable(item *targets.Target, resp *api.Response) string {
 				"ID":              set.Id,
 				"Host Catalog ID": set.HostCatalogId,
 			}
+			if set.Priority > 0 {
+				m["Priority"] = set.Priority
+			}
 			hostSourceMaps = append(hostSourceMaps, m)
 		}
 		if l := len("Host Catalog ID"); l > maxLength {
@@ -572,6 +856,12 @@ func printItemTable(item *targets.Target, resp *api.Response) string {
 				"ID":                  source.Id,
 				"Credential Store ID": source.CredentialStoreId,
 			}
+			if source.Exclusive {
+				m["Exclusive"] = source.Exclusive
+			}
+			if source.Priority > 0 {
+				m["Priority"] = source.Priority
+			}
 			brokeredCredentialSourceMaps = append(brokeredCredentialSourceMaps, m)
 		}
 		credentialSourceMaps[credential.BrokeredPurpose] = brokeredCredentialSourceMaps
@@ -589,6 +879,12 @@ func printItemTable(item *targets.Target, resp *api.Response) string {
 				"ID":                  source.Id,
 				"Credential Store ID": source.CredentialStoreId,
 			}
+			if source.Exclusive {
+				m["Exclusive"] = source.Exclusive
+			}
+			if source.Priority > 0 {
+				m["Priority"] = source.Priority
+			}
 			injectedApplicationCredentialSourceMaps = append(injectedApplicationCredentialSourceMaps, m)
 		}
 		credentialSourceMaps[credential.InjectedApplicationPurpose] = injectedApplicationCredentialSourceMaps
@@ -619,6 +915,18 @@ func printItemTable(item *targets.Target, resp *api.Response) string {
 		)
 	}
 
+	if len(item.Tags) > 0 {
+		tagMap := make(map[string]any, len(item.Tags))
+		for k, v := range item.Tags {
+			tagMap[k] = v
+		}
+		ret = append(ret,
+			"",
+			"  Tags:",
+			base.WrapMap(4, 2, tagMap),
+		)
+	}
+
 	ret = append(ret,
 		"",
 	)
@@ -770,6 +1078,16 @@ func printCustomActionOutputImpl(c *Command) (bool, error) {
 					}
 					ret = append(ret, "    Secret:")
 					ret = append(ret, secretStr...)
+					if len(cred.SecretMetadata) > 0 {
+						maxLength := 0
+						for k := range cred.SecretMetadata {
+							if len(k) > maxLength {
+								maxLength = len(k)
+							}
+						}
+						ret = append(ret, "    Secret Metadata:")
+						ret = append(ret, fmt.Sprintf("    %s", base.WrapMap(2, maxLength+2, cred.SecretMetadata)))
+					}
 					ret = append(ret, "")
 				}
 			}
@@ -783,11 +1101,124 @@ func printCustomActionOutputImpl(c *Command) (bool, error) {
 			}
 			return true, nil
 		}
+
+	case "list-available-hosts":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output(printAvailableHostsTable(c.availableHosts.GetItems()))
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItems(c.availableHosts.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+
+	case "add-favorite", "remove-favorite":
+		switch base.Format(c.UI) {
+		case "table":
+			if c.Func == "add-favorite" {
+				c.UI.Output("The target was added to your favorite targets.")
+			} else {
+				c.UI.Output("The target was removed from your favorite targets.")
+			}
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItem(c.favoriteResult.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+
+	case "enable-ssh-certificate-authority":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output(printSshCertificateAuthorityTable(c.sshCaResult.GetItem()))
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItem(c.sshCaResult.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+
+	case "disable-ssh-certificate-authority":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output("The SSH certificate authority of the target was disabled.")
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItem(c.disableSshCaResult.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+
+	case "sign-ssh-host-key":
+		switch base.Format(c.UI) {
+		case "table":
+			item := c.sshHostCertResult.GetItem()
+			c.UI.Output(base.WrapForHelpText([]string{
+				"",
+				"SSH host certificate information:",
+				fmt.Sprintf("  Certificate:                       %s", strings.TrimSpace(item.Certificate)),
+				fmt.Sprintf("  Certificate Authority Public Key:  %s", item.CertificateAuthorityPublicKey),
+			}))
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItem(c.sshHostCertResult.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
 	}
 
 	return false, nil
 }
 
+func printSshCertificateAuthorityTable(item *targets.SshCertificateAuthority) string {
+	nonAttributeMap := map[string]any{
+		"Target ID":    item.TargetId,
+		"Username":     item.Username,
+		"Public Key":   item.PublicKey,
+		"Created Time": item.CreatedTime.Local().Format(time.RFC1123),
+		"Updated Time": item.UpdatedTime.Local().Format(time.RFC1123),
+	}
+	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
+	ret := []string{
+		"",
+		"SSH certificate authority information:",
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+	}
+	return base.WrapForHelpText(ret)
+}
+
+func printAvailableHostsTable(items []*targets.AvailableHost) string {
+	if len(items) == 0 {
+		return "No available hosts found"
+	}
+	output := []string{
+		"",
+		"Available host information:",
+	}
+	for i, item := range items {
+		if i > 0 {
+			output = append(output, "")
+		}
+		output = append(output,
+			fmt.Sprintf("  Host ID:               %s", item.HostId),
+			fmt.Sprintf("    Host Source ID:      %s", item.HostSourceId),
+			fmt.Sprintf("    Address:             %s", item.Address),
+		)
+	}
+	return base.WrapForHelpText(output)
+}
+
 var keySubstMap = map[string]string{
 	"default_port": "Default Port",
 }
@@ -833,3 +1264,111 @@ func exampleOutput() string {
 	}
 	return printItemTable(item, nil)
 }
+
+// annotationFlagVars holds the values of the flags which set the annotations of
+// a target.
+type annotationFlagVars struct {
+	flagOwner      string
+	flagCostCenter string
+	flagTicketUrl  string
+}
+
+// addAnnotationFlag adds the annotation flag with the given name to fs. Other
+// names are ignored.
+func (a *annotationFlagVars) addAnnotationFlag(fs *base.FlagSet, name string) {
+	switch name {
+	case "owner":
+		fs.StringVar(&base.StringVar{
+			Name:   "owner",
+			Target: &a.flagOwner,
+			Usage:  "The person or team which owns the target.",
+		})
+	case "cost-center":
+		fs.StringVar(&base.StringVar{
+			Name:   "cost-center",
+			Target: &a.flagCostCenter,
+			Usage:  `The cost center the target is charged to, such as "CC-1234".`,
+		})
+	case "ticket-url":
+		fs.StringVar(&base.StringVar{
+			Name:   "ticket-url",
+			Target: &a.flagTicketUrl,
+			Usage:  "A link to the ticket which tracks the target.",
+		})
+	}
+}
+
+// annotationOptions returns the options for the annotation flags which were
+// set.
+func (a *annotationFlagVars) annotationOptions() []targets.Option {
+	var opts []targets.Option
+	switch a.flagOwner {
+	case "":
+	case "null":
+		opts = append(opts, targets.DefaultAnnotationOwner())
+	default:
+		opts = append(opts, targets.WithAnnotationOwner(a.flagOwner))
+	}
+	switch a.flagCostCenter {
+	case "":
+	case "null":
+		opts = append(opts, targets.DefaultAnnotationCostCenter())
+	default:
+		opts = append(opts, targets.WithAnnotationCostCenter(a.flagCostCenter))
+	}
+	switch a.flagTicketUrl {
+	case "":
+	case "null":
+		opts = append(opts, targets.DefaultAnnotationTicketUrl())
+	default:
+		opts = append(opts, targets.WithAnnotationTicketUrl(a.flagTicketUrl))
+	}
+	return opts
+}
+
+// tagFlagVars holds the value of the flag which sets the tags of a target.
+type tagFlagVars struct {
+	flagTags map[string][]string
+}
+
+// addTagFlag adds the tag flag to fs.
+func (t *tagFlagVars) addTagFlag(fs *base.FlagSet) {
+	fs.StringSliceMapVar(&base.StringSliceMapVar{
+		Name:      "tag",
+		Target:    &t.flagTags,
+		NullCheck: func() bool { return true },
+		Usage:     `A tag of the target, such as "env=prod", which grants can select the target by. Can be specified multiple times, and replaces all of the tags of the target. Use "null" to clear the tags.`,
+	})
+}
+
+// tagOptions returns the options for the tag flag, if it was set.
+func (t *tagFlagVars) tagOptions() []targets.Option {
+	switch len(t.flagTags) {
+	case 0:
+		return nil
+	case 1:
+		if v, found := t.flagTags["null"]; found && v == nil {
+			return []targets.Option{targets.DefaultTags()}
+		}
+	}
+	return []targets.Option{targets.WithTags(t.flagTags)}
+}
+
+// parseSeconds parses an integer number of seconds or a duration string. The
+// number of seconds must be greater than 0 and fit in a uint32.
+func parseSeconds(in string) (uint32, error) {
+	var secs float64
+	if u, err := strconv.ParseUint(in, 10, 64); err == nil {
+		secs = float64(u)
+	} else {
+		dur, err := time.ParseDuration(in)
+		if err != nil {
+			return 0, err
+		}
+		secs = dur.Truncate(time.Second).Seconds()
+	}
+	if secs <= 0 || secs > math.MaxUint32 {
+		return 0, fmt.Errorf("must be between 1 and %d seconds", uint32(math.MaxUint32))
+	}
+	return uint32(secs), nil
+}
@@ -106,6 +106,21 @@ func (c *Command) Flags() *base.FlagSets {
 	f := set.NewFlagSet("Command Options")
 	common.PopulateCommonFlags(c.Command, f, "target", flagsMap, c.Func)
 
+	if c.Func == "read" {
+		f.StringVar(&base.StringVar{
+			Name:   "name",
+			Target: &c.FlagName,
+			Usage:  "Name of the target, if reading it via its name and scope ID instead of its ID.",
+		})
+		f.StringVar(&base.StringVar{
+			Name:       "scope-id",
+			Target:     &c.FlagScopeId,
+			EnvVar:     "BOUNDARY_SCOPE_ID",
+			Completion: complete.PredictAnything,
+			Usage:      "ID of the scope containing the target, if reading it via its name.",
+		})
+	}
+
 	extraFlagsFunc(c, set, f)
 
 	return set
@@ -144,6 +159,17 @@ func (c *Command) Run(args []string) int {
 		return base.CommandUserError
 	}
 
+	if c.Func == "read" {
+		switch {
+		case c.FlagId == "" && (c.FlagName == "" || c.FlagScopeId == ""):
+			c.PrintCliError(errors.New("ID was not passed in, but no combination of name and scope ID was passed in either"))
+			return base.CommandUserError
+		case c.FlagId != "" && c.FlagName != "":
+			c.PrintCliError(errors.New("Cannot specify an ID and also a name"))
+			return base.CommandUserError
+		}
+	}
+
 	var opts []targets.Option
 
 	if strutil.StrListContains(flagsMap[c.Func], "scope-id") {
@@ -172,6 +198,14 @@ func (c *Command) Run(args []string) int {
 	}
 	targetsClient := targets.NewClient(client)
 
+	switch {
+	case c.Func == "create", c.Func == "list" && !c.FlagRecursive:
+		if err := c.CheckAuthorizedAction(client, c.FlagScopeId, "target", c.Func); err != nil {
+			c.PrintCliError(err)
+			return base.CommandUserError
+		}
+	}
+
 	switch c.FlagName {
 	case "":
 	case "null":
@@ -269,6 +303,9 @@ func (c *Command) Run(args []string) int {
 	switch c.Func {
 
 	case "read":
+		if c.FlagName != "" {
+			opts = append(opts, targets.WithLookupByName(c.FlagName, c.FlagScopeId))
+		}
 		readResult, err = targetsClient.Read(c.Context, c.FlagId, opts...)
 		if exitCode := c.checkFuncError(err); exitCode > 0 {
 			return exitCode
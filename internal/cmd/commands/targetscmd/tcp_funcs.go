@@ -4,13 +4,15 @@
 package targetscmd
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
-	"time"
 
 	"github.com/hashicorp/boundary/api/targets"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/go-bexpr"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+	"github.com/posener/complete"
 )
 
 func init() {
@@ -21,8 +23,8 @@ func init() {
 
 func extraTcpActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"create": {"address", "default-port", "session-max-seconds", "session-connection-limit", "egress-worker-filter", "ingress-worker-filter"},
-		"update": {"address", "default-port", "session-max-seconds", "session-connection-limit", "worker-filter", "egress-worker-filter", "ingress-worker-filter"},
+		"create": {"address", "default-port", "session-max-seconds", "session-connection-limit", "egress-worker-filter", "ingress-worker-filter", "max-auth-age-seconds", "banner", "owner", "cost-center", "ticket-url", "deletion-protected", "tag"},
+		"update": {"address", "default-port", "session-max-seconds", "session-connection-limit", "worker-filter", "egress-worker-filter", "ingress-worker-filter", "max-auth-age-seconds", "banner", "owner", "cost-center", "ticket-url", "deletion-protected", "tag"},
 	}
 }
 
@@ -33,7 +35,12 @@ type extraTcpCmdVars struct {
 	flagWorkerFilter           string
 	flagEgressWorkerFilter     string
 	flagIngressWorkerFilter    string
+	flagMaxAuthAgeSeconds      string
+	flagBanner                 string
+	flagDeletionProtected      string
 	flagAddress                string
+	annotationFlagVars
+	tagFlagVars
 }
 
 func (c *TcpCommand) extraTcpHelpFunc(helpMap map[string]func() string) string {
@@ -111,6 +118,29 @@ func extraTcpFlagsFuncImpl(c *TcpCommand, set *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagIngressWorkerFilter,
 				Usage:  "A boolean expression to filter which ingress workers can handle sessions for this target.",
 			})
+		case "max-auth-age-seconds":
+			fs.StringVar(&base.StringVar{
+				Name:   "max-auth-age-seconds",
+				Target: &c.flagMaxAuthAgeSeconds,
+				Usage:  `The maximum age of the user's authentication when authorizing a session; older tokens require the user to re-authenticate. Can be specified as an integer number of seconds or a duration string.`,
+			})
+		case "banner":
+			fs.StringVar(&base.StringVar{
+				Name:   "banner",
+				Target: &c.flagBanner,
+				Usage:  `A legal or usage banner that "boundary connect" displays, and the user must acknowledge, before a session to the target is activated. Can be a string, a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read.`,
+			})
+		case "deletion-protected":
+			fs.StringVar(&base.StringVar{
+				Name:       "deletion-protected",
+				Target:     &c.flagDeletionProtected,
+				Completion: complete.PredictSet("true", "false"),
+				Usage:      `Whether the target is protected from deletion. Clearing the protection requires the clear-deletion-protection action. Supported values are "true" and "false".`,
+			})
+		case "tag":
+			c.addTagFlag(fs)
+		default:
+			c.addAnnotationFlag(fs, name)
 		}
 	}
 }
@@ -134,17 +164,10 @@ func extraTcpFlagsHandlingFuncImpl(c *TcpCommand, _ *base.FlagSets, opts *[]targ
 	case "null":
 		*opts = append(*opts, targets.DefaultSessionMaxSeconds())
 	default:
-		var final uint32
-		dur, err := strconv.ParseUint(c.flagSessionMaxSeconds, 10, 32)
-		if err == nil {
-			final = uint32(dur)
-		} else {
-			dur, err := time.ParseDuration(c.flagSessionMaxSeconds)
-			if err != nil {
-				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagSessionMaxSeconds, err))
-				return false
-			}
-			final = uint32(dur.Seconds())
+		final, err := parseSeconds(c.flagSessionMaxSeconds)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagSessionMaxSeconds, err))
+			return false
 		}
 		*opts = append(*opts, targets.WithSessionMaxSeconds(final))
 	}
@@ -197,6 +220,48 @@ func extraTcpFlagsHandlingFuncImpl(c *TcpCommand, _ *base.FlagSets, opts *[]targ
 		*opts = append(*opts, targets.WithIngressWorkerFilter(c.flagIngressWorkerFilter))
 	}
 
+	switch c.flagMaxAuthAgeSeconds {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultMaxAuthAgeSeconds())
+	default:
+		final, err := parseSeconds(c.flagMaxAuthAgeSeconds)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagMaxAuthAgeSeconds, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithMaxAuthAgeSeconds(final))
+	}
+
+	switch c.flagBanner {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultBanner())
+	default:
+		banner, err := parseutil.ParsePath(c.flagBanner)
+		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+			c.UI.Error(fmt.Sprintf("Error parsing banner: %s", err))
+			return false
+		}
+		*opts = append(*opts, targets.WithBanner(banner))
+	}
+
+	*opts = append(*opts, c.annotationOptions()...)
+	*opts = append(*opts, c.tagOptions()...)
+
+	switch c.flagDeletionProtected {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultDeletionProtected())
+	default:
+		protected, err := strconv.ParseBool(c.flagDeletionProtected)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagDeletionProtected, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithDeletionProtected(protected))
+	}
+
 	switch c.flagAddress {
 	case "":
 	case "null":
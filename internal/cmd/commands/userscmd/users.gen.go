@@ -115,6 +115,21 @@ func (c *Command) Flags() *base.FlagSets {
 	f := set.NewFlagSet("Command Options")
 	common.PopulateCommonFlags(c.Command, f, "user", flagsMap, c.Func)
 
+	if c.Func == "read" {
+		f.StringVar(&base.StringVar{
+			Name:   "name",
+			Target: &c.FlagName,
+			Usage:  "Name of the user, if reading it via its name and scope ID instead of its ID.",
+		})
+		f.StringVar(&base.StringVar{
+			Name:       "scope-id",
+			Target:     &c.FlagScopeId,
+			EnvVar:     "BOUNDARY_SCOPE_ID",
+			Completion: complete.PredictAnything,
+			Usage:      "ID of the scope containing the user, if reading it via its name.",
+		})
+	}
+
 	extraFlagsFunc(c, set, f)
 
 	return set
@@ -142,7 +157,18 @@ func (c *Command) Run(args []string) int {
 		return base.CommandUserError
 	}
 
-	if strutil.StrListContains(flagsMap[c.Func], "id") && c.FlagId == "" {
+	if c.Func == "read" {
+		switch {
+		case c.FlagId == "" && (c.FlagName == "" || c.FlagScopeId == ""):
+			c.PrintCliError(errors.New("ID was not passed in, but no combination of name and scope ID was passed in either"))
+			return base.CommandUserError
+		case c.FlagId != "" && c.FlagName != "":
+			c.PrintCliError(errors.New("Cannot specify an ID and also a name"))
+			return base.CommandUserError
+		}
+	}
+
+	if strutil.StrListContains(flagsMap[c.Func], "id") && c.FlagId == "" && c.Func != "read" {
 		c.PrintCliError(errors.New("ID is required but not passed in via -id"))
 		return base.CommandUserError
 	}
@@ -181,6 +207,14 @@ func (c *Command) Run(args []string) int {
 	}
 	usersClient := users.NewClient(client)
 
+	switch {
+	case c.Func == "create", c.Func == "list" && !c.FlagRecursive:
+		if err := c.CheckAuthorizedAction(client, c.FlagScopeId, "user", c.Func); err != nil {
+			c.PrintCliError(err)
+			return base.CommandUserError
+		}
+	}
+
 	switch c.FlagName {
 	case "":
 	case "null":
@@ -274,6 +308,9 @@ func (c *Command) Run(args []string) int {
 		item = createResult.GetItem()
 
 	case "read":
+		if c.FlagName != "" {
+			opts = append(opts, users.WithLookupByName(c.FlagName, c.FlagScopeId))
+		}
 		readResult, err = usersClient.Read(c.Context, c.FlagId, opts...)
 		if exitCode := c.checkFuncError(err); exitCode > 0 {
 			return exitCode
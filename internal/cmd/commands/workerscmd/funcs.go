@@ -20,6 +20,12 @@ func init() {
 	extraFlagsFunc = extraFlagsFuncImpl
 	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
 	executeExtraActions = executeExtraActionsImpl
+	printCustomActionOutput = printCustomActionOutputImpl
+}
+
+type extraCmdVars struct {
+	flagWorkerFilter string
+	filterResult     *workers.WorkerFilterTestResult
 }
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
@@ -27,6 +33,7 @@ func extraActionsFlagsMapFuncImpl() map[string][]string {
 		"add-worker-tags":    {"id", "tag", "version"},
 		"set-worker-tags":    {"id", "tag", "version"},
 		"remove-worker-tags": {"id", "tag", "version"},
+		"test-worker-filter": {"scope-id", "worker-filter"},
 	}
 }
 
@@ -38,6 +45,8 @@ func extraSynopsisFuncImpl(c *Command) string {
 		return "Set api tags for the specified worker"
 	case "remove-worker-tags":
 		return "Remove api tags from the specified worker"
+	case "test-worker-filter":
+		return "List the connected workers matching a worker filter"
 	default:
 		return ""
 	}
@@ -94,6 +103,18 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			"",
 		})
+	case "test-worker-filter":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary workers test-worker-filter [options] [args]",
+			"",
+			"  This command lists the workers currently connected to the controllers which match a worker filter, so the filter can be checked before it is set on a target. Example:",
+			"",
+			"    List the connected workers tagged with a region:",
+			"",
+			`      $ boundary workers test-worker-filter -worker-filter '"us-east-1" in "/tags/region"'`,
+			"",
+			"",
+		})
 	default:
 		helpStr = helpMap[c.Func]()
 	}
@@ -116,6 +137,12 @@ func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
 				NullCheck: nullCheckFn,
 				Usage:     "The api tag resources to add, remove, or set.",
 			})
+		case "worker-filter":
+			f.StringVar(&base.StringVar{
+				Name:   "worker-filter",
+				Target: &c.flagWorkerFilter,
+				Usage:  "The worker filter to evaluate against the connected workers, in the same format as the egress and ingress worker filters of a target.",
+			})
 		}
 	}
 }
@@ -137,6 +164,11 @@ func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]workers.Op
 				c.FlagTags = nil
 			}
 		}
+	case "test-worker-filter":
+		if c.flagWorkerFilter == "" {
+			c.UI.Error("No worker filter supplied via -worker-filter")
+			return false
+		}
 	}
 	return true
 }
@@ -161,10 +193,41 @@ func executeExtraActionsImpl(c *Command, inResp *api.Response, inItem *workers.W
 			return nil, nil, nil, err
 		}
 		return result.GetResponse(), result.GetItem(), nil, err
+	case "test-worker-filter":
+		var err error
+		c.plural = "workers"
+		c.filterResult, err = workerClient.TestWorkerFilter(c.Context, c.FlagScopeId, c.flagWorkerFilter, opts...)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return c.filterResult.GetResponse(), nil, c.filterResult.GetItems(), err
 	}
 	return inResp, inItem, inItems, inErr
 }
 
+func printCustomActionOutputImpl(c *Command) (bool, error) {
+	switch c.Func {
+	case "test-worker-filter":
+		switch base.Format(c.UI) {
+		case "table":
+			if len(c.filterResult.GetItems()) == 0 {
+				c.UI.Output("No connected workers match the filter")
+				return true, nil
+			}
+			c.UI.Output(c.printListTable(c.filterResult.GetItems()))
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItems(c.filterResult.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func (c *Command) printListTable(items []*workers.Worker) string {
 	if len(items) == 0 {
 		return "No workers found"
@@ -35,6 +35,8 @@ type Command struct {
 	Func string
 
 	plural string
+
+	extraCmdVars
 }
 
 func (c *Command) AutocompleteArgs() complete.Predictor {
@@ -171,6 +173,14 @@ func (c *Command) Run(args []string) int {
 	}
 	workersClient := workers.NewClient(client)
 
+	switch {
+	case c.Func == "create", c.Func == "list" && !c.FlagRecursive:
+		if err := c.CheckAuthorizedAction(client, c.FlagScopeId, "worker", c.Func); err != nil {
+			c.PrintCliError(err)
+			return base.CommandUserError
+		}
+	}
+
 	switch c.FlagName {
 	case "":
 	case "null":
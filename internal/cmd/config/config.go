@@ -13,15 +13,20 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"net/netip"
 	"os"
+	"path"
 	"reflect"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/auth/anomaly"
+	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/types/scope"
 	"github.com/hashicorp/boundary/internal/util"
 	kms_plugin_assets "github.com/hashicorp/boundary/plugins/kms"
 	"github.com/hashicorp/boundary/sdk/wrapper"
@@ -42,6 +47,13 @@ var extraParsingFuncs []func(*Config) error
 const (
 	desktopCorsOrigin = "serve://boundary"
 
+	defaultSessionAuthorizationCheckInterval = 5 * time.Minute
+	defaultWorkerStatusInterval              = 2 * time.Second
+
+	defaultUpstreamProbeInterval = 30 * time.Second
+
+	defaultListenerTlsWatchInterval = time.Minute
+
 	devConfig = `
 disable_mlock = true
 
@@ -139,6 +151,12 @@ type Config struct {
 	// Plugin-related options
 	Plugins Plugins `hcl:"plugins"`
 
+	// ListenerTlsWatchInterval is how often the certificate and key files of
+	// listeners with TLS enabled are checked for changes. Changed files are
+	// reloaded without a restart. Set to 0 to disable.
+	ListenerTlsWatchInterval         any           `hcl:"listener_tls_watch_interval"`
+	ListenerTlsWatchIntervalDuration time.Duration `hcl:"-"`
+
 	// Internal field for use with HCP deployments. Used if controllers/ initial_upstreams is not set
 	HcpbClusterId string `hcl:"hcp_boundary_cluster_id"`
 }
@@ -150,6 +168,11 @@ type Controller struct {
 	PublicClusterAddr string     `hcl:"public_cluster_addr"`
 	Scheduler         *Scheduler `hcl:"scheduler"`
 
+	// Region is the region the controller runs in. Workers in the same region
+	// prefer the controller, and sessions authorized by the controller prefer
+	// workers in the same region.
+	Region string `hcl:"region"`
+
 	// AuthTokenTimeToLive is the total valid lifetime of a token denoted by time.Duration
 	AuthTokenTimeToLive         any           `hcl:"auth_token_time_to_live"`
 	AuthTokenTimeToLiveDuration time.Duration `hcl:"-"`
@@ -159,12 +182,74 @@ type Controller struct {
 	AuthTokenTimeToStale         any           `hcl:"auth_token_time_to_stale"`
 	AuthTokenTimeToStaleDuration time.Duration `hcl:"-"`
 
+	// AuthTokenBinding configures binding newly issued auth tokens to the
+	// client they were issued to
+	AuthTokenBinding *AuthTokenBinding `hcl:"auth_token_binding"`
+
+	// AuthAnomalyDetection enables evaluating authentication attempts for
+	// suspicious behavior
+	AuthAnomalyDetection *AuthAnomalyDetection `hcl:"auth_anomaly_detection"`
+
+	// PublicIds configures the format of newly generated public ids
+	PublicIds *PublicIds `hcl:"public_ids"`
+
+	// GrantsCache enables caching the grants resolved for users in memory
+	GrantsCache *GrantsCache `hcl:"grants_cache"`
+
+	// TenantIsolation prevents the roles of the global scope from granting
+	// access to orgs marked as isolated and to their projects
+	TenantIsolation bool `hcl:"tenant_isolation"`
+
+	// AuthorizeSessionCache enables caching session authorization decisions
+	// in memory for a short time
+	AuthorizeSessionCache *AuthorizeSessionCache `hcl:"authorize_session_cache"`
+
+	// CredentialCache enables caching the secrets read from Vault by
+	// credential libraries in memory
+	CredentialCache *CredentialCache `hcl:"credential_cache"`
+
+	// SessionAuthorizationCheck enables periodically re-evaluating whether
+	// the users of pending and active sessions are still authorized to
+	// connect to their targets
+	SessionAuthorizationCheck *SessionAuthorizationCheck `hcl:"session_authorization_check"`
+
+	// WorkerFailureDetection tunes how quickly the controller detects
+	// workers which stopped reporting status and closes their connections
+	WorkerFailureDetection *WorkerFailureDetection `hcl:"worker_failure_detection"`
+
+	// EventSubscriptions configures the delivery of the notifications of
+	// resource event subscriptions
+	EventSubscriptions *EventSubscriptions `hcl:"event_subscriptions"`
+
+	// Acme enables provisioning and renewing the certificates of the API
+	// listeners from an ACME certificate authority
+	Acme *Acme `hcl:"acme"`
+
+	// RequestLimits configures limits on the size and shape of API requests
+	RequestLimits *RequestLimits `hcl:"request_limits"`
+
+	// Discovery configures the defaults suggested to clients which do not
+	// specify a scope or an auth method when authenticating
+	Discovery *Discovery `hcl:"discovery"`
+
 	// GracefulShutdownWait is the amount of time that we'll wait before actually
 	// starting the Controller shutdown. This allows the health endpoint to
 	// return a status code to indicate that the instance is shutting down.
 	GracefulShutdownWait         any           `hcl:"graceful_shutdown_wait_duration"`
 	GracefulShutdownWaitDuration time.Duration `hcl:"-"`
 
+	// GracefulShutdownDrainTimeout is the amount of time the Controller gives
+	// in-flight API requests to complete once it stops accepting new ones
+	// during shutdown. Requests still running after it are canceled. Defaults
+	// to the max request duration of each API listener.
+	GracefulShutdownDrainTimeout         any           `hcl:"graceful_shutdown_drain_timeout"`
+	GracefulShutdownDrainTimeoutDuration time.Duration `hcl:"-"`
+
+	// CredentialPlugins are the names of the out-of-tree credential plugins
+	// the controller loads from CredentialPluginsDir at startup.
+	CredentialPlugins    []string `hcl:"credential_plugins"`
+	CredentialPluginsDir string   `hcl:"credential_plugins_dir"`
+
 	// WorkerStatusGracePeriod represents the period of time (as a duration)
 	// that the controller will wait before deciding a worker is disconnected
 	// and marking connections from it as canceling
@@ -217,6 +302,11 @@ type Worker struct {
 	Description string `hcl:"description"`
 	PublicAddr  string `hcl:"public_addr"`
 
+	// Region is the region the worker runs in. It is added to the worker's
+	// tags under the "region" key, and the worker prefers upstream controllers
+	// in the same region.
+	Region string `hcl:"region"`
+
 	// We use a raw interface here so that we can take in a string
 	// value pointing to an env var or file. We then resolve that
 	// and get the actual upstream controller or worker addresses.
@@ -227,6 +317,17 @@ type Worker struct {
 	// TODO: remove this field when support is discontinued.
 	ControllersRaw any `hcl:"controllers"`
 
+	// UpstreamSelection, if set, makes the worker rank its upstreams and stay
+	// connected to the best ranked one, failing over to the next one when it
+	// becomes unreachable, instead of spreading requests across all of them.
+	UpstreamSelection *UpstreamSelection `hcl:"upstream_selection"`
+
+	// UpstreamCompression is the compression the worker negotiates for the
+	// RPCs it makes to its upstreams, such as status reports and session
+	// lookups. It's either "none", the default, or "zstd". If an upstream
+	// can't decompress the RPCs, the worker stops compressing them.
+	UpstreamCompression string `hcl:"upstream_compression"`
+
 	// We use a raw interface for parsing so that people can use JSON-like
 	// syntax that maps directly to the filter input or possibly more familiar
 	// key=value syntax, as well as accepting a string denoting an env or file
@@ -234,6 +335,13 @@ type Worker struct {
 	Tags    map[string][]string `hcl:"-"`
 	TagsRaw any                 `hcl:"tags"`
 
+	// StatusInterval represents the base period of time (as a duration)
+	// between two status reports of the worker to a controller. Each report
+	// is randomly moved up to a quarter of the interval earlier or later.
+	// Defaults to 2 seconds.
+	StatusInterval         any           `hcl:"status_interval"`
+	StatusIntervalDuration time.Duration `hcl:"-"`
+
 	// StatusCallTimeout represents the period of time (as a duration) that
 	// the worker will allow a status RPC call to attempt to finish before
 	// canceling it to try again.
@@ -258,6 +366,29 @@ type Worker struct {
 	// token used to register this worker to the cluster. It can be a path, env
 	// var, or direct value.
 	ControllerGeneratedActivationToken string `hcl:"controller_generated_activation_token"`
+
+	// ConnectionLog, if set, writes a structured entry for each proxied
+	// connection to a rotating local file, independent of the event sinks.
+	ConnectionLog *event.FileSinkTypeConfig `hcl:"connection_log"`
+
+	// ProtocolPlugins are the names of the out-of-tree protocol plugins the
+	// worker loads from ProtocolPluginsDir at startup.
+	ProtocolPlugins    []string `hcl:"protocol_plugins"`
+	ProtocolPluginsDir string   `hcl:"protocol_plugins_dir"`
+}
+
+// UpstreamSelection is the configuration block that specifies how a worker
+// ranks its upstreams
+type UpstreamSelection struct {
+	// Weights are the weights of upstreams, keyed by their addresses.
+	// Upstreams are ranked by their probed latency divided by their weight;
+	// upstreams without a weight have a weight of 1.
+	Weights map[string]int `hcl:"weights"`
+
+	// ProbeInterval is the time between latency probes of the upstreams.
+	// Defaults to 30 seconds.
+	ProbeInterval         any           `hcl:"probe_interval"`
+	ProbeIntervalDuration time.Duration `hcl:"-"`
 }
 
 type Database struct {
@@ -292,6 +423,202 @@ type Scheduler struct {
 	MonitorIntervalDuration time.Duration
 }
 
+type AuthTokenBinding struct {
+	// Mode is what newly issued auth tokens are bound to; one of "client_ip"
+	// or "client_certificate". When unset tokens are not bound.
+	Mode        string                `hcl:"mode"`
+	BindingMode authtoken.BindingMode `hcl:"-"`
+
+	// Ipv4PrefixLength and Ipv6PrefixLength are the prefix lengths of the
+	// range of addresses around the client's ip that a token is bound to when
+	// using the "client_ip" mode.
+	Ipv4PrefixLength int `hcl:"ipv4_prefix_length"`
+	Ipv6PrefixLength int `hcl:"ipv6_prefix_length"`
+}
+
+type AuthAnomalyDetection struct {
+	// MaxTravelSpeedKmh is the maximum speed, in kilometers per hour, a user
+	// can plausibly travel between authentications
+	MaxTravelSpeedKmh float64 `hcl:"max_travel_speed_kmh"`
+
+	// ImpossibleTravelAction is the action taken when impossible travel is
+	// detected; one of "allow" or "deny". Defaults to "allow", in which case
+	// the event is only emitted.
+	ImpossibleTravelAction string         `hcl:"impossible_travel_action"`
+	ImpossibleTravel       anomaly.Action `hcl:"-"`
+
+	// HistorySize is the maximum number of users for which authentication
+	// history is kept in memory
+	HistorySize int `hcl:"history_size"`
+
+	// Networks are the locations of known client networks, used to resolve
+	// the location of clients when no other resolver is provided
+	Networks []*AuthAnomalyNetwork `hcl:"network"`
+}
+
+type AuthAnomalyNetwork struct {
+	// Cidr, the label of the network block, is the range of client addresses
+	// in the network; for example "203.0.113.0/24".
+	Cidr   string       `hcl:",key"`
+	Prefix netip.Prefix `hcl:"-"`
+
+	CountryCode string  `hcl:"country_code"`
+	City        string  `hcl:"city"`
+	Latitude    float64 `hcl:"latitude"`
+	Longitude   float64 `hcl:"longitude"`
+}
+
+type PublicIds struct {
+	// SuffixLength is the length of the random suffix of public ids. Defaults
+	// to 10.
+	SuffixLength int `hcl:"suffix_length"`
+
+	// Checksum appends a checksum character to the suffix of public ids so
+	// mistyped ids can be detected.
+	Checksum bool `hcl:"checksum"`
+
+	// TimeSortable starts the suffix of session and connection ids with their
+	// creation time so new ids sort after older ones.
+	TimeSortable bool `hcl:"time_sortable"`
+}
+
+type GrantsCache struct {
+	// MaxEntries is the maximum number of users whose grants are cached.
+	// Defaults to 10000.
+	MaxEntries int `hcl:"max_entries"`
+}
+
+type AuthorizeSessionCache struct {
+	// TimeToLive is how long decisions are cached for. Defaults to 5
+	// seconds.
+	TimeToLive         any           `hcl:"time_to_live"`
+	TimeToLiveDuration time.Duration `hcl:"-"`
+
+	// MaxEntries is the maximum number of cached decisions. Defaults to
+	// 10000.
+	MaxEntries int `hcl:"max_entries"`
+}
+
+type CredentialCache struct {
+	// TimeToLive is how long secrets are cached for. Defaults to 30
+	// seconds.
+	TimeToLive         any           `hcl:"time_to_live"`
+	TimeToLiveDuration time.Duration `hcl:"-"`
+
+	// MaxEntries is the maximum number of cached secrets. Defaults to
+	// 10000.
+	MaxEntries int `hcl:"max_entries"`
+}
+
+type SessionAuthorizationCheck struct {
+	// Interval is the time between checks. Defaults to 5 minutes.
+	Interval         any           `hcl:"interval"`
+	IntervalDuration time.Duration `hcl:"-"`
+
+	// GracePeriod is the amount of time a user must no longer be authorized
+	// before their sessions are canceled. Defaults to 0, in which case the
+	// sessions are canceled by the first check that finds the user is no
+	// longer authorized.
+	GracePeriod         any           `hcl:"grace_period"`
+	GracePeriodDuration time.Duration `hcl:"-"`
+}
+
+type WorkerFailureDetection struct {
+	// StatusInterval is the expected time between two status reports of a
+	// worker. It should match the status_interval of the workers. Defaults
+	// to 2 seconds.
+	StatusInterval         any           `hcl:"status_interval"`
+	StatusIntervalDuration time.Duration `hcl:"-"`
+
+	// MissedStatusLimit is the number of consecutive status reports a worker
+	// can miss before its connections are closed, when that is shorter than
+	// the worker status grace period. Defaults to 0, in which case only the
+	// worker status grace period applies.
+	MissedStatusLimit int `hcl:"missed_status_limit"`
+
+	// SessionCleanupInterval is the time between two runs of the job which
+	// closes the connections of workers that stopped reporting status.
+	// Defaults to 1 second.
+	SessionCleanupInterval         any           `hcl:"session_cleanup_interval"`
+	SessionCleanupIntervalDuration time.Duration `hcl:"-"`
+}
+
+type EventSubscriptions struct {
+	// EnableWebhooks enables posting notifications to the webhooks of
+	// subscriptions. When disabled, subscriptions with a webhook are refused
+	// and notifications are only written to the event stream.
+	EnableWebhooks bool `hcl:"enable_webhooks"`
+
+	// WebhookTimeout is how long posting a notification to a webhook can
+	// take. Defaults to 5 seconds.
+	WebhookTimeout         any           `hcl:"webhook_timeout"`
+	WebhookTimeoutDuration time.Duration `hcl:"-"`
+}
+
+// Acme configures provisioning the certificates of API listeners with TLS
+// enabled and no certificate file from an ACME certificate authority.
+type Acme struct {
+	// Domains are the names certificates are requested for. Requests for any
+	// other name are refused.
+	Domains []string `hcl:"domains"`
+
+	// Email is the contact address of the ACME account.
+	Email string `hcl:"email"`
+
+	// DirectoryUrl is the directory of the ACME certificate authority.
+	// Defaults to Let's Encrypt.
+	DirectoryUrl string `hcl:"directory_url"`
+
+	// CacheDir is where the account key and certificates are stored so they
+	// survive restarts.
+	CacheDir string `hcl:"cache_dir"`
+
+	// AcceptTermsOfService must be set to accept the terms of service of the
+	// ACME certificate authority.
+	AcceptTermsOfService bool `hcl:"accept_terms_of_service"`
+
+	// HttpChallengeAddress, if set, is the address of a listener answering
+	// HTTP-01 challenges. Otherwise only TLS-ALPN-01 challenges, answered by
+	// the API listeners, are used.
+	HttpChallengeAddress string `hcl:"http_challenge_address"`
+}
+
+// RequestLimits configures limits on the size and shape of API requests,
+// beyond the max_request_size of the API listeners.
+type RequestLimits struct {
+	// MaxAttributeDepth is the maximum nesting depth of objects and lists in
+	// the attributes of a request. Defaults to 32; -1 disables the check.
+	MaxAttributeDepth int `hcl:"max_attribute_depth"`
+
+	// Endpoints override the max_request_size of the API listeners for
+	// requests to matching paths. The first matching endpoint is used.
+	Endpoints []*EndpointRequestLimit `hcl:"endpoint"`
+}
+
+// Discovery configures the defaults returned by the discovery endpoint, which
+// the CLI uses when authenticating without a scope or an auth method.
+type Discovery struct {
+	// DefaultScopeId is the global scope or the org suggested to clients.
+	// Defaults to the global scope.
+	DefaultScopeId string `hcl:"default_scope_id"`
+
+	// DefaultAuthMethodId is the auth method suggested to clients. Defaults to
+	// the primary auth method of the default scope, or of the global scope if
+	// the default scope does not have one.
+	DefaultAuthMethodId string `hcl:"default_auth_method_id"`
+}
+
+type EndpointRequestLimit struct {
+	// Path, the label of the endpoint block, is a pattern matched against
+	// the request path in the syntax of path.Match; for example
+	// "/v1/targets/*:add-host-sources".
+	Path string `hcl:",key"`
+
+	// MaxRequestSize is the maximum size, in bytes, of the body of matching
+	// requests; -1 disables the limit.
+	MaxRequestSize int `hcl:"max_request_size"`
+}
+
 type Plugins struct {
 	ExecutionDir string `hcl:"execution_dir"`
 }
@@ -494,6 +821,9 @@ func Parse(d string) (*Config, error) {
 		if !strutil.Printable(result.Controller.Description) {
 			return nil, errors.New("Controller description contains non-printable characters")
 		}
+		if err := validateRegion(result.Controller.Region); err != nil {
+			return nil, fmt.Errorf("Invalid controller region: %w", err)
+		}
 		if result.Controller.AuthTokenTimeToLive != "" {
 			t, err := parseutil.ParseDurationSecond(result.Controller.AuthTokenTimeToLive)
 			if err != nil {
@@ -510,6 +840,205 @@ func Parse(d string) (*Config, error) {
 			result.Controller.AuthTokenTimeToStaleDuration = t
 		}
 
+		if result.Controller.AuthTokenBinding != nil {
+			b := result.Controller.AuthTokenBinding
+			b.BindingMode, err = authtoken.ParseBindingMode(b.Mode)
+			if err != nil {
+				return nil, fmt.Errorf("Error parsing auth token binding mode: %w", err)
+			}
+			if b.Ipv4PrefixLength < 0 || b.Ipv4PrefixLength > 32 {
+				return nil, errors.New("Auth token binding ipv4 prefix length must be between 1 and 32")
+			}
+			if b.Ipv6PrefixLength < 0 || b.Ipv6PrefixLength > 128 {
+				return nil, errors.New("Auth token binding ipv6 prefix length must be between 1 and 128")
+			}
+		}
+
+		if result.Controller.AuthAnomalyDetection != nil {
+			a := result.Controller.AuthAnomalyDetection
+			a.ImpossibleTravel, err = anomaly.ParseAction(a.ImpossibleTravelAction)
+			if err != nil {
+				return nil, fmt.Errorf("Error parsing auth anomaly detection impossible travel action: %w", err)
+			}
+			if a.MaxTravelSpeedKmh < 0 {
+				return nil, errors.New("Auth anomaly detection max travel speed is negative")
+			}
+			if a.HistorySize < 0 {
+				return nil, errors.New("Auth anomaly detection history size is negative")
+			}
+			for _, n := range a.Networks {
+				n.Prefix, err = netip.ParsePrefix(n.Cidr)
+				if err != nil {
+					return nil, fmt.Errorf("Error parsing auth anomaly detection network %q: %w", n.Cidr, err)
+				}
+				if n.Latitude < -90 || n.Latitude > 90 || n.Longitude < -180 || n.Longitude > 180 {
+					return nil, fmt.Errorf("Auth anomaly detection network %q has invalid coordinates", n.Cidr)
+				}
+			}
+		}
+
+		if result.Controller.PublicIds != nil {
+			p := result.Controller.PublicIds
+			if p.SuffixLength == 0 {
+				p.SuffixLength = db.DefaultPublicIdSuffixLength
+			}
+			if p.SuffixLength < db.DefaultPublicIdSuffixLength || p.SuffixLength > db.MaxPublicIdSuffixLength {
+				return nil, fmt.Errorf("Public id suffix length must be between %d and %d", db.DefaultPublicIdSuffixLength, db.MaxPublicIdSuffixLength)
+			}
+		}
+
+		if result.Controller.GrantsCache != nil && result.Controller.GrantsCache.MaxEntries < 0 {
+			return nil, errors.New("Grants cache max entries is negative")
+		}
+
+		if a := result.Controller.AuthorizeSessionCache; a != nil {
+			if a.TimeToLive != nil && a.TimeToLive != "" {
+				t, err := parseutil.ParseDurationSecond(a.TimeToLive)
+				if err != nil {
+					return nil, fmt.Errorf("Error parsing authorize session cache time to live: %w", err)
+				}
+				if t <= 0 {
+					return nil, errors.New("Authorize session cache time to live must be positive")
+				}
+				a.TimeToLiveDuration = t
+			}
+			if a.MaxEntries < 0 {
+				return nil, errors.New("Authorize session cache max entries is negative")
+			}
+		}
+
+		if c := result.Controller.CredentialCache; c != nil {
+			if c.TimeToLive != nil && c.TimeToLive != "" {
+				t, err := parseutil.ParseDurationSecond(c.TimeToLive)
+				if err != nil {
+					return nil, fmt.Errorf("Error parsing credential cache time to live: %w", err)
+				}
+				if t <= 0 {
+					return nil, errors.New("Credential cache time to live must be positive")
+				}
+				c.TimeToLiveDuration = t
+			}
+			if c.MaxEntries < 0 {
+				return nil, errors.New("Credential cache max entries is negative")
+			}
+		}
+
+		if a := result.Controller.SessionAuthorizationCheck; a != nil {
+			a.IntervalDuration = defaultSessionAuthorizationCheckInterval
+			if a.Interval != nil && a.Interval != "" {
+				t, err := parseutil.ParseDurationSecond(a.Interval)
+				if err != nil {
+					return nil, fmt.Errorf("Error parsing session authorization check interval: %w", err)
+				}
+				if t <= 0 {
+					return nil, errors.New("Session authorization check interval must be positive")
+				}
+				a.IntervalDuration = t
+			}
+			if a.GracePeriod != nil && a.GracePeriod != "" {
+				t, err := parseutil.ParseDurationSecond(a.GracePeriod)
+				if err != nil {
+					return nil, fmt.Errorf("Error parsing session authorization check grace period: %w", err)
+				}
+				if t < 0 {
+					return nil, errors.New("Session authorization check grace period is negative")
+				}
+				a.GracePeriodDuration = t
+			}
+		}
+
+		if f := result.Controller.WorkerFailureDetection; f != nil {
+			f.StatusIntervalDuration = defaultWorkerStatusInterval
+			if f.StatusInterval != nil && f.StatusInterval != "" {
+				t, err := parseutil.ParseDurationSecond(f.StatusInterval)
+				if err != nil {
+					return nil, fmt.Errorf("Error parsing worker failure detection status interval: %w", err)
+				}
+				if t <= 0 {
+					return nil, errors.New("Worker failure detection status interval must be positive")
+				}
+				f.StatusIntervalDuration = t
+			}
+			if f.MissedStatusLimit < 0 {
+				return nil, errors.New("Worker failure detection missed status limit is negative")
+			}
+			if f.SessionCleanupInterval != nil && f.SessionCleanupInterval != "" {
+				t, err := parseutil.ParseDurationSecond(f.SessionCleanupInterval)
+				if err != nil {
+					return nil, fmt.Errorf("Error parsing worker failure detection session cleanup interval: %w", err)
+				}
+				if t <= 0 {
+					return nil, errors.New("Worker failure detection session cleanup interval must be positive")
+				}
+				f.SessionCleanupIntervalDuration = t
+			}
+		}
+
+		if e := result.Controller.EventSubscriptions; e != nil {
+			if e.WebhookTimeout != nil && e.WebhookTimeout != "" {
+				t, err := parseutil.ParseDurationSecond(e.WebhookTimeout)
+				if err != nil {
+					return nil, fmt.Errorf("Error parsing event subscriptions webhook timeout: %w", err)
+				}
+				if t <= 0 {
+					return nil, errors.New("Event subscriptions webhook timeout must be positive")
+				}
+				e.WebhookTimeoutDuration = t
+			}
+		}
+
+		if a := result.Controller.Acme; a != nil {
+			switch {
+			case len(a.Domains) == 0:
+				return nil, errors.New("ACME domains must be set")
+			case a.CacheDir == "":
+				return nil, errors.New("ACME cache_dir must be set")
+			case !a.AcceptTermsOfService:
+				return nil, errors.New("ACME accept_terms_of_service must be set to use ACME")
+			}
+			for _, d := range a.Domains {
+				if d == "" || strings.ContainsAny(d, "/: ") {
+					return nil, fmt.Errorf("Invalid ACME domain %q", d)
+				}
+			}
+		}
+
+		if l := result.Controller.RequestLimits; l != nil {
+			switch {
+			case l.MaxAttributeDepth == 0:
+				l.MaxAttributeDepth = globals.DefaultMaxAttributeDepth
+			case l.MaxAttributeDepth < -1:
+				return nil, errors.New("Request limits max attribute depth must be positive or -1")
+			}
+			for _, e := range l.Endpoints {
+				if !strings.HasPrefix(e.Path, "/") {
+					return nil, fmt.Errorf("Request limits endpoint path %q must start with /", e.Path)
+				}
+				if _, err := path.Match(e.Path, ""); err != nil {
+					return nil, fmt.Errorf("Invalid request limits endpoint path %q: %w", e.Path, err)
+				}
+				if e.MaxRequestSize == 0 || e.MaxRequestSize < -1 {
+					return nil, fmt.Errorf("Request limits endpoint %q max request size must be positive or -1", e.Path)
+				}
+			}
+		}
+
+		if d := result.Controller.Discovery; d != nil {
+			if d.DefaultScopeId != "" && d.DefaultScopeId != scope.Global.String() &&
+				!strings.HasPrefix(d.DefaultScopeId, scope.Org.Prefix()+"_") {
+				return nil, fmt.Errorf("Discovery default scope id %q must be the global scope or an org", d.DefaultScopeId)
+			}
+			if d.DefaultAuthMethodId != "" {
+				switch {
+				case strings.HasPrefix(d.DefaultAuthMethodId, globals.PasswordAuthMethodPrefix+"_"),
+					strings.HasPrefix(d.DefaultAuthMethodId, globals.OidcAuthMethodPrefix+"_"),
+					strings.HasPrefix(d.DefaultAuthMethodId, globals.LdapAuthMethodPrefix+"_"):
+				default:
+					return nil, fmt.Errorf("Discovery default auth method id %q is not a password, OIDC or LDAP auth method", d.DefaultAuthMethodId)
+				}
+			}
+		}
+
 		if result.Controller.GracefulShutdownWait != "" {
 			t, err := parseutil.ParseDurationSecond(result.Controller.GracefulShutdownWait)
 			if err != nil {
@@ -518,6 +1047,21 @@ func Parse(d string) (*Config, error) {
 			result.Controller.GracefulShutdownWaitDuration = t
 		}
 
+		if result.Controller.GracefulShutdownDrainTimeout != nil && result.Controller.GracefulShutdownDrainTimeout != "" {
+			t, err := parseutil.ParseDurationSecond(result.Controller.GracefulShutdownDrainTimeout)
+			if err != nil {
+				return nil, fmt.Errorf("Error parsing graceful shutdown drain timeout: %w", err)
+			}
+			if t < 0 {
+				return nil, errors.New("Graceful shutdown drain timeout is negative")
+			}
+			result.Controller.GracefulShutdownDrainTimeoutDuration = t
+		}
+
+		if len(result.Controller.CredentialPlugins) > 0 && result.Controller.CredentialPluginsDir == "" {
+			return nil, errors.New("Controller credential_plugins_dir must be set when credential_plugins are configured")
+		}
+
 		if result.Controller.Scheduler != nil {
 			if result.Controller.Scheduler.JobRunInterval != "" {
 				t, err := parseutil.ParseDurationSecond(result.Controller.Scheduler.JobRunInterval)
@@ -646,11 +1190,26 @@ func Parse(d string) (*Config, error) {
 			return nil, errors.New("Worker description contains non-printable characters")
 		}
 
-		result.Worker.ControllerGeneratedActivationToken, err = parseutil.ParsePath(result.Worker.ControllerGeneratedActivationToken)
+		result.Worker.ControllerGeneratedActivationToken, err = ParseSecretPath(result.Worker.ControllerGeneratedActivationToken)
 		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
 			return nil, fmt.Errorf("Error parsing worker activation token: %w", err)
 		}
 
+		statusInterval := result.Worker.StatusInterval
+		if util.IsNil(statusInterval) {
+			statusInterval = os.Getenv("BOUNDARY_WORKER_STATUS_INTERVAL")
+		}
+		if statusInterval != nil && statusInterval != "" {
+			t, err := parseutil.ParseDurationSecond(statusInterval)
+			if err != nil {
+				return result, err
+			}
+			result.Worker.StatusIntervalDuration = t
+		}
+		if result.Worker.StatusIntervalDuration < 0 {
+			return nil, errors.New("Status interval value is negative")
+		}
+
 		statusCallTimeoutDuration := result.Worker.StatusCallTimeout
 		if util.IsNil(statusCallTimeoutDuration) {
 			statusCallTimeoutDuration = os.Getenv("BOUNDARY_WORKER_STATUS_CALL_TIMEOUT")
@@ -777,6 +1336,19 @@ func Parse(d string) (*Config, error) {
 			}
 		}
 
+		if result.Worker.Region != "" {
+			if err := validateRegion(result.Worker.Region); err != nil {
+				return nil, fmt.Errorf("Invalid worker region: %w", err)
+			}
+			if v, ok := result.Worker.Tags[RegionTagKey]; ok && (len(v) != 1 || v[0] != result.Worker.Region) {
+				return nil, fmt.Errorf("Worker tag %q conflicts with the worker region %q", RegionTagKey, result.Worker.Region)
+			}
+			if result.Worker.Tags == nil {
+				result.Worker.Tags = make(map[string][]string, 1)
+			}
+			result.Worker.Tags[RegionTagKey] = []string{result.Worker.Region}
+		}
+
 		for k, v := range result.Worker.Tags {
 			if k != strings.ToLower(k) {
 				return nil, fmt.Errorf("Tag key %q is not all lower-case letters", k)
@@ -804,6 +1376,47 @@ func Parse(d string) (*Config, error) {
 		if err != nil {
 			return nil, fmt.Errorf("Failed to parse worker upstreams: %w", err)
 		}
+
+		if u := result.Worker.UpstreamSelection; u != nil {
+			u.ProbeIntervalDuration = defaultUpstreamProbeInterval
+			if u.ProbeInterval != nil && u.ProbeInterval != "" {
+				t, err := parseutil.ParseDurationSecond(u.ProbeInterval)
+				if err != nil {
+					return nil, fmt.Errorf("Error parsing worker upstream selection probe interval: %w", err)
+				}
+				if t <= 0 {
+					return nil, errors.New("Worker upstream selection probe interval must be positive")
+				}
+				u.ProbeIntervalDuration = t
+			}
+			for addr, weight := range u.Weights {
+				if weight <= 0 {
+					return nil, fmt.Errorf("Worker upstream selection weight for %q must be positive", addr)
+				}
+			}
+		}
+
+		switch result.Worker.UpstreamCompression {
+		case "", "none", "zstd":
+		default:
+			return nil, fmt.Errorf("Worker upstream_compression must be either \"none\" or \"zstd\", got %q", result.Worker.UpstreamCompression)
+		}
+
+		if len(result.Worker.ProtocolPlugins) > 0 && result.Worker.ProtocolPluginsDir == "" {
+			return nil, errors.New("Worker protocol_plugins_dir must be set when protocol_plugins are configured")
+		}
+
+		if cl := result.Worker.ConnectionLog; cl != nil {
+			if cl.FileName == "" {
+				return nil, errors.New("Worker connection log file_name must be set")
+			}
+			if cl.RotateDurationHCL != "" {
+				cl.RotateDuration, err = parseutil.ParseDurationSecond(cl.RotateDurationHCL)
+				if err != nil {
+					return nil, fmt.Errorf("Can't parse worker connection log rotation duration %s", cl.RotateDurationHCL)
+				}
+			}
+		}
 	}
 
 	sharedConfig, err := configutil.ParseConfig(d)
@@ -813,6 +1426,9 @@ func Parse(d string) (*Config, error) {
 	result.SharedConfig = sharedConfig
 
 	for _, listener := range result.SharedConfig.Listeners {
+		if _, err := ListenerAddressFamily(listener); err != nil {
+			return nil, err
+		}
 		if strutil.StrListContains(listener.Purpose, "api") &&
 			(listener.CorsDisableDefaultAllowedOriginValues == nil || !*listener.CorsDisableDefaultAllowedOriginValues) {
 			switch listener.CorsEnabled {
@@ -859,6 +1475,18 @@ func Parse(d string) (*Config, error) {
 		}
 	}
 
+	result.ListenerTlsWatchIntervalDuration = defaultListenerTlsWatchInterval
+	if result.ListenerTlsWatchInterval != nil {
+		t, err := parseutil.ParseDurationSecond(result.ListenerTlsWatchInterval)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing listener_tls_watch_interval: %w", err)
+		}
+		if t < 0 {
+			return nil, errors.New("listener_tls_watch_interval must not be negative")
+		}
+		result.ListenerTlsWatchIntervalDuration = t
+	}
+
 	for _, f := range extraParsingFuncs {
 		if err := f(result); err != nil {
 			return nil, err
@@ -951,6 +1579,8 @@ func parseEventing(eventObj *ast.ObjectItem) (*event.EventerConfig, error) {
 				// If we haven't found the type any other way, they _must_
 				// specify this block even though there are no config parameters
 				s.Type = event.StderrSink
+			case s.StdoutConfig != nil:
+				s.Type = event.StdoutSink
 			case s.FileConfig != nil:
 				s.Type = event.FileSink
 			default:
@@ -964,6 +1594,9 @@ func parseEventing(eventObj *ast.ObjectItem) (*event.EventerConfig, error) {
 			// always populated if it's the type
 			s.StderrConfig = new(event.StderrSinkTypeConfig)
 		}
+		if s.Type == event.StdoutSink && s.StdoutConfig == nil {
+			s.StdoutConfig = new(event.StdoutSinkTypeConfig)
+		}
 
 		// parse the duration string specified in a file config into a time.Duration
 		if s.FileConfig != nil && s.FileConfig.RotateDurationHCL != "" {
@@ -1116,10 +1749,9 @@ func (c *Config) SetupWorkerInitialUpstreams() error {
 			break
 		}
 		// Best effort see if it's a domain name and if not assume it must match
-		host, _, err := net.SplitHostPort(c.Worker.InitialUpstreams[0])
-		if err != nil && strings.Contains(err.Error(), globals.MissingPortErrStr) {
+		host, _, err := util.SplitHostPort(c.Worker.InitialUpstreams[0])
+		if errors.Is(err, util.ErrMissingPort) {
 			err = nil
-			host = c.Worker.InitialUpstreams[0]
 		}
 		if err == nil {
 			ip := net.ParseIP(host)
@@ -1135,3 +1767,50 @@ func (c *Config) SetupWorkerInitialUpstreams() error {
 
 	return nil
 }
+
+// Address families a tcp listener can be restricted to with the
+// address_family key
+const (
+	AddressFamilyIpv4 = "ipv4"
+	AddressFamilyIpv6 = "ipv6"
+	AddressFamilyDual = "dual"
+)
+
+// ListenerAddressFamily returns the value of the address_family key of the
+// listener, which is empty if it is not set.
+func ListenerAddressFamily(l *listenerutil.ListenerConfig) (string, error) {
+	raw, ok := l.RawConfig["address_family"]
+	if !ok {
+		return "", nil
+	}
+	family, ok := raw.(string)
+	if !ok {
+		return "", fmt.Errorf("Listener address_family must be a string")
+	}
+	switch family {
+	case AddressFamilyIpv4, AddressFamilyIpv6, AddressFamilyDual:
+		if l.Type != "tcp" {
+			return "", fmt.Errorf("Listener address_family is only supported for tcp listeners")
+		}
+		return family, nil
+	default:
+		return "", fmt.Errorf("Invalid listener address_family %q, must be %q, %q or %q", family, AddressFamilyIpv4, AddressFamilyIpv6, AddressFamilyDual)
+	}
+}
+
+// RegionTagKey is the key of the worker tag holding the worker's region.
+const RegionTagKey = "region"
+
+// validateRegion checks that a controller or worker region can be used as the
+// value of a worker tag.
+func validateRegion(region string) error {
+	switch {
+	case region != strings.ToLower(region):
+		return fmt.Errorf("region %q is not all lower-case", region)
+	case !strutil.Printable(region):
+		return fmt.Errorf("region %q contains non-printable characters", region)
+	case strings.ContainsAny(region, ", "):
+		return fmt.Errorf("region %q cannot contain commas or spaces", region)
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/cmd/config"
 	"github.com/hashicorp/boundary/internal/observability/event"
@@ -414,12 +415,13 @@ func TestLoad(t *testing.T) {
 					WorkerStatusGracePeriodDuration: 0,
 					LivenessTimeToStaleDuration:     0,
 				},
-				DevController:           false,
-				DevUiPassthroughDir:     "",
-				DevControllerKey:        "",
-				DevWorkerAuthKey:        "",
-				DevWorkerAuthStorageKey: "",
-				DevRecoveryKey:          "",
+				DevController:                    false,
+				DevUiPassthroughDir:              "",
+				DevControllerKey:                 "",
+				DevWorkerAuthKey:                 "",
+				DevWorkerAuthStorageKey:          "",
+				DevRecoveryKey:                   "",
+				ListenerTlsWatchIntervalDuration: time.Minute,
 				Eventing: &event.EventerConfig{
 					AuditEnabled:        false,
 					ObservationsEnabled: true,
@@ -828,12 +830,13 @@ func TestLoad(t *testing.T) {
 					WorkerStatusGracePeriodDuration: 0,
 					LivenessTimeToStaleDuration:     0,
 				},
-				DevController:           false,
-				DevUiPassthroughDir:     "",
-				DevControllerKey:        "",
-				DevWorkerAuthKey:        "",
-				DevWorkerAuthStorageKey: "",
-				DevRecoveryKey:          "",
+				DevController:                    false,
+				DevUiPassthroughDir:              "",
+				DevControllerKey:                 "",
+				DevWorkerAuthKey:                 "",
+				DevWorkerAuthStorageKey:          "",
+				DevRecoveryKey:                   "",
+				ListenerTlsWatchIntervalDuration: time.Minute,
 				Eventing: &event.EventerConfig{
 					AuditEnabled:        false,
 					ObservationsEnabled: true,
@@ -1242,12 +1245,13 @@ func TestLoad(t *testing.T) {
 					WorkerStatusGracePeriodDuration: 0,
 					LivenessTimeToStaleDuration:     0,
 				},
-				DevController:           false,
-				DevUiPassthroughDir:     "",
-				DevControllerKey:        "",
-				DevWorkerAuthKey:        "",
-				DevWorkerAuthStorageKey: "",
-				DevRecoveryKey:          "",
+				DevController:                    false,
+				DevUiPassthroughDir:              "",
+				DevControllerKey:                 "",
+				DevWorkerAuthKey:                 "",
+				DevWorkerAuthStorageKey:          "",
+				DevRecoveryKey:                   "",
+				ListenerTlsWatchIntervalDuration: time.Minute,
 				Eventing: &event.EventerConfig{
 					AuditEnabled:        false,
 					ObservationsEnabled: true,
@@ -1656,12 +1660,13 @@ func TestLoad(t *testing.T) {
 					WorkerStatusGracePeriodDuration: 0,
 					LivenessTimeToStaleDuration:     0,
 				},
-				DevController:           false,
-				DevUiPassthroughDir:     "",
-				DevControllerKey:        "",
-				DevWorkerAuthKey:        "",
-				DevWorkerAuthStorageKey: "",
-				DevRecoveryKey:          "",
+				DevController:                    false,
+				DevUiPassthroughDir:              "",
+				DevControllerKey:                 "",
+				DevWorkerAuthKey:                 "",
+				DevWorkerAuthStorageKey:          "",
+				DevRecoveryKey:                   "",
+				ListenerTlsWatchIntervalDuration: time.Minute,
 				Eventing: &event.EventerConfig{
 					AuditEnabled:        false,
 					ObservationsEnabled: true,
@@ -1741,12 +1746,13 @@ func TestLoad(t *testing.T) {
 					WorkerStatusGracePeriodDuration: 0,
 					LivenessTimeToStaleDuration:     0,
 				},
-				DevController:           false,
-				DevUiPassthroughDir:     "",
-				DevControllerKey:        "",
-				DevWorkerAuthKey:        "",
-				DevWorkerAuthStorageKey: "",
-				DevRecoveryKey:          "",
+				DevController:                    false,
+				DevUiPassthroughDir:              "",
+				DevControllerKey:                 "",
+				DevWorkerAuthKey:                 "",
+				DevWorkerAuthStorageKey:          "",
+				DevRecoveryKey:                   "",
+				ListenerTlsWatchIntervalDuration: time.Minute,
 				Eventing: &event.EventerConfig{
 					AuditEnabled:        false,
 					ObservationsEnabled: true,
@@ -6,10 +6,13 @@ package config
 import (
 	"fmt"
 	"net/http"
+	"net/netip"
 	"os"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/boundary/internal/auth/anomaly"
+	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/observability/event"
 	configutil "github.com/hashicorp/go-secure-stdlib/configutil/v2"
 	"github.com/hashicorp/go-secure-stdlib/listenerutil"
@@ -45,7 +48,8 @@ func TestDevController(t *testing.T) {
 	}
 
 	exp := &Config{
-		Eventing: event.DefaultEventerConfig(),
+		ListenerTlsWatchIntervalDuration: time.Minute,
+		Eventing:                         event.DefaultEventerConfig(),
 		SharedConfig: &configutil.SharedConfig{
 			DisableMlock: true,
 			Listeners: []*listenerutil.ListenerConfig{
@@ -197,7 +201,8 @@ func TestDevWorker(t *testing.T) {
 	}
 
 	exp := &Config{
-		Eventing: event.DefaultEventerConfig(),
+		ListenerTlsWatchIntervalDuration: time.Minute,
+		Eventing:                         event.DefaultEventerConfig(),
 		SharedConfig: &configutil.SharedConfig{
 			DisableMlock: true,
 			Listeners: []*listenerutil.ListenerConfig{
@@ -392,7 +397,8 @@ func TestDevCombined(t *testing.T) {
 	}
 
 	exp := &Config{
-		Eventing: event.DefaultEventerConfig(),
+		ListenerTlsWatchIntervalDuration: time.Minute,
+		Eventing:                         event.DefaultEventerConfig(),
 		SharedConfig: &configutil.SharedConfig{
 			DisableMlock: true,
 			Listeners: []*listenerutil.ListenerConfig{
@@ -1175,6 +1181,46 @@ func TestController_EventingConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "ecs-stdout",
+			config: []string{
+				`events {
+					audit_enabled = true
+					observations_enabled = true
+					sink "stdout" {
+						name = "ecs-sink"
+						format = "ecs-json"
+						event_types = ["audit", "observation"]
+						sample_rate = 0.25
+					}
+				}`,
+				`events {
+					audit_enabled = true
+					observations_enabled = true
+					sink {
+						name = "ecs-sink"
+						format = "ecs-json"
+						event_types = ["audit", "observation"]
+						sample_rate = 0.25
+						stdout = {}
+					}
+				}`,
+			},
+			wantEventerConfig: &event.EventerConfig{
+				AuditEnabled:        true,
+				ObservationsEnabled: true,
+				Sinks: []*event.SinkConfig{
+					{
+						Type:         "stdout",
+						Name:         "ecs-sink",
+						Format:       "ecs-json",
+						EventTypes:   []event.Type{"audit", "observation"},
+						StdoutConfig: &event.StdoutSinkTypeConfig{},
+						SampleRate:   0.25,
+					},
+				},
+			},
+		},
 		{
 			name: "audit_config",
 			config: []string{
@@ -1221,6 +1267,46 @@ func TestController_EventingConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "audit_config_redactions",
+			config: []string{
+				`events {
+					audit_enabled = true
+					sink {
+						name = "audit-sink"
+						format = "cloudevents-json"
+						event_types = ["audit"]
+						file {
+							file_name = "audit.log"
+						}
+						audit_config {
+							audit_redaction_patterns = ["tok_[a-z0-9]+"]
+							audit_redaction_fields   = ["request.details.attributes.token"]
+							kms_key_id               = "compliance"
+						}
+					}
+				}`,
+			},
+			wantEventerConfig: &event.EventerConfig{
+				AuditEnabled: true,
+				Sinks: []*event.SinkConfig{
+					{
+						Type:       "file",
+						Name:       "audit-sink",
+						Format:     "cloudevents-json",
+						EventTypes: []event.Type{"audit"},
+						FileConfig: &event.FileSinkTypeConfig{
+							FileName: "audit.log",
+						},
+						AuditConfig: &event.AuditConfig{
+							RedactionPatterns: []string{"tok_[a-z0-9]+"},
+							RedactionFields:   []string{"request.details.attributes.token"},
+							KmsKeyId:          "compliance",
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -2396,3 +2482,1338 @@ func TestSetupWorkerInitialUpstreams(t *testing.T) {
 		})
 	}
 }
+
+func TestParsingAuthTokenBinding(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name    string
+		config  string
+		wantErr bool
+		want    *AuthTokenBinding
+	}{
+		{
+			name:   "undefined",
+			config: `controller {}`,
+		},
+		{
+			name: "client-ip",
+			config: `
+controller {
+  auth_token_binding {
+    mode               = "client_ip"
+    ipv4_prefix_length = 24
+  }
+}
+`,
+			want: &AuthTokenBinding{
+				Mode:             "client_ip",
+				BindingMode:      authtoken.ClientIpBindingMode,
+				Ipv4PrefixLength: 24,
+			},
+		},
+		{
+			name: "invalid-mode",
+			config: `
+controller {
+  auth_token_binding {
+    mode = "session"
+  }
+}
+`,
+			wantErr: true,
+		},
+		{
+			name: "invalid-prefix-length",
+			config: `
+controller {
+  auth_token_binding {
+    mode               = "client_ip"
+    ipv6_prefix_length = 129
+  }
+}
+`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := Parse(tt.config)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, out.Controller.AuthTokenBinding)
+		})
+	}
+}
+
+func TestParsingPublicIds(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name    string
+		config  string
+		wantErr bool
+		want    *PublicIds
+	}{
+		{
+			name:   "undefined",
+			config: `controller {}`,
+		},
+		{
+			name:   "defaults",
+			config: `controller { public_ids {} }`,
+			want:   &PublicIds{SuffixLength: 10},
+		},
+		{
+			name: "suffix-length-and-checksum",
+			config: `
+controller {
+  public_ids {
+    suffix_length = 16
+    checksum      = true
+  }
+}
+`,
+			want: &PublicIds{SuffixLength: 16, Checksum: true},
+		},
+		{
+			name:   "time-sortable",
+			config: `controller { public_ids { time_sortable = true } }`,
+			want:   &PublicIds{SuffixLength: 10, TimeSortable: true},
+		},
+		{
+			name:    "suffix-length-too-short",
+			config:  `controller { public_ids { suffix_length = 8 } }`,
+			wantErr: true,
+		},
+		{
+			name:    "suffix-length-too-long",
+			config:  `controller { public_ids { suffix_length = 33 } }`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := Parse(tt.config)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, out.Controller.PublicIds)
+		})
+	}
+}
+
+func TestParsingGrantsCache(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name    string
+		config  string
+		wantErr bool
+		want    *GrantsCache
+	}{
+		{
+			name:   "undefined",
+			config: `controller {}`,
+		},
+		{
+			name:   "defaults",
+			config: `controller { grants_cache {} }`,
+			want:   &GrantsCache{},
+		},
+		{
+			name:   "max-entries",
+			config: `controller { grants_cache { max_entries = 500 } }`,
+			want:   &GrantsCache{MaxEntries: 500},
+		},
+		{
+			name:    "negative-max-entries",
+			config:  `controller { grants_cache { max_entries = -1 } }`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := Parse(tt.config)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, out.Controller.GrantsCache)
+		})
+	}
+}
+
+func TestParsingAuthorizeSessionCache(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name    string
+		config  string
+		wantErr bool
+		want    *AuthorizeSessionCache
+	}{
+		{
+			name:   "undefined",
+			config: `controller {}`,
+		},
+		{
+			name:   "defaults",
+			config: `controller { authorize_session_cache {} }`,
+			want:   &AuthorizeSessionCache{},
+		},
+		{
+			name: "time-to-live-and-max-entries",
+			config: `
+controller {
+  authorize_session_cache {
+    time_to_live = "10s"
+    max_entries  = 500
+  }
+}
+`,
+			want: &AuthorizeSessionCache{
+				TimeToLive:         "10s",
+				TimeToLiveDuration: 10 * time.Second,
+				MaxEntries:         500,
+			},
+		},
+		{
+			name:    "invalid-time-to-live",
+			config:  `controller { authorize_session_cache { time_to_live = "soon" } }`,
+			wantErr: true,
+		},
+		{
+			name:    "zero-time-to-live",
+			config:  `controller { authorize_session_cache { time_to_live = "0s" } }`,
+			wantErr: true,
+		},
+		{
+			name:    "negative-max-entries",
+			config:  `controller { authorize_session_cache { max_entries = -1 } }`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := Parse(tt.config)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, out.Controller.AuthorizeSessionCache)
+		})
+	}
+}
+
+func TestParsingCredentialCache(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name    string
+		config  string
+		wantErr bool
+		want    *CredentialCache
+	}{
+		{
+			name:   "undefined",
+			config: `controller {}`,
+		},
+		{
+			name:   "defaults",
+			config: `controller { credential_cache {} }`,
+			want:   &CredentialCache{},
+		},
+		{
+			name: "time-to-live-and-max-entries",
+			config: `
+controller {
+  credential_cache {
+    time_to_live = "2m"
+    max_entries  = 500
+  }
+}
+`,
+			want: &CredentialCache{
+				TimeToLive:         "2m",
+				TimeToLiveDuration: 2 * time.Minute,
+				MaxEntries:         500,
+			},
+		},
+		{
+			name:    "invalid-time-to-live",
+			config:  `controller { credential_cache { time_to_live = "soon" } }`,
+			wantErr: true,
+		},
+		{
+			name:    "zero-time-to-live",
+			config:  `controller { credential_cache { time_to_live = "0s" } }`,
+			wantErr: true,
+		},
+		{
+			name:    "negative-max-entries",
+			config:  `controller { credential_cache { max_entries = -1 } }`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := Parse(tt.config)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, out.Controller.CredentialCache)
+		})
+	}
+}
+
+func TestParsingSessionAuthorizationCheck(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name    string
+		config  string
+		wantErr bool
+		want    *SessionAuthorizationCheck
+	}{
+		{
+			name:   "undefined",
+			config: `controller {}`,
+		},
+		{
+			name:   "defaults",
+			config: `controller { session_authorization_check {} }`,
+			want:   &SessionAuthorizationCheck{IntervalDuration: 5 * time.Minute},
+		},
+		{
+			name: "interval-and-grace-period",
+			config: `
+controller {
+  session_authorization_check {
+    interval     = "1m"
+    grace_period = "30s"
+  }
+}
+`,
+			want: &SessionAuthorizationCheck{
+				Interval:            "1m",
+				IntervalDuration:    time.Minute,
+				GracePeriod:         "30s",
+				GracePeriodDuration: 30 * time.Second,
+			},
+		},
+		{
+			name:    "invalid-interval",
+			config:  `controller { session_authorization_check { interval = "soon" } }`,
+			wantErr: true,
+		},
+		{
+			name:    "zero-interval",
+			config:  `controller { session_authorization_check { interval = "0s" } }`,
+			wantErr: true,
+		},
+		{
+			name:    "negative-grace-period",
+			config:  `controller { session_authorization_check { grace_period = "-1s" } }`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := Parse(tt.config)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, out.Controller.SessionAuthorizationCheck)
+		})
+	}
+}
+
+func TestParsingWorkerFailureDetection(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name    string
+		config  string
+		wantErr bool
+		want    *WorkerFailureDetection
+	}{
+		{
+			name:   "undefined",
+			config: `controller {}`,
+		},
+		{
+			name:   "defaults",
+			config: `controller { worker_failure_detection {} }`,
+			want:   &WorkerFailureDetection{StatusIntervalDuration: 2 * time.Second},
+		},
+		{
+			name: "all-settings",
+			config: `
+controller {
+  worker_failure_detection {
+    status_interval          = "1s"
+    missed_status_limit      = 3
+    session_cleanup_interval = "500ms"
+  }
+}
+`,
+			want: &WorkerFailureDetection{
+				StatusInterval:                 "1s",
+				StatusIntervalDuration:         time.Second,
+				MissedStatusLimit:              3,
+				SessionCleanupInterval:         "500ms",
+				SessionCleanupIntervalDuration: 500 * time.Millisecond,
+			},
+		},
+		{
+			name:    "invalid-status-interval",
+			config:  `controller { worker_failure_detection { status_interval = "soon" } }`,
+			wantErr: true,
+		},
+		{
+			name:    "zero-status-interval",
+			config:  `controller { worker_failure_detection { status_interval = "0s" } }`,
+			wantErr: true,
+		},
+		{
+			name:    "negative-missed-status-limit",
+			config:  `controller { worker_failure_detection { missed_status_limit = -1 } }`,
+			wantErr: true,
+		},
+		{
+			name:    "negative-session-cleanup-interval",
+			config:  `controller { worker_failure_detection { session_cleanup_interval = "-1s" } }`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := Parse(tt.config)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, out.Controller.WorkerFailureDetection)
+		})
+	}
+}
+
+func TestParsingWorkerStatusInterval(t *testing.T) {
+	t.Parallel()
+	out, err := Parse(`worker { status_interval = "500ms" }`)
+	require.NoError(t, err)
+	assert.Equal(t, 500*time.Millisecond, out.Worker.StatusIntervalDuration)
+
+	_, err = Parse(`worker { status_interval = "-1s" }`)
+	require.Error(t, err)
+}
+
+func TestParsingGracefulShutdownDrainTimeout(t *testing.T) {
+	t.Parallel()
+	out, err := Parse(`controller {}`)
+	require.NoError(t, err)
+	assert.Zero(t, out.Controller.GracefulShutdownDrainTimeoutDuration)
+
+	out, err = Parse(`controller { graceful_shutdown_drain_timeout = "45s" }`)
+	require.NoError(t, err)
+	assert.Equal(t, 45*time.Second, out.Controller.GracefulShutdownDrainTimeoutDuration)
+
+	_, err = Parse(`controller { graceful_shutdown_drain_timeout = "-1s" }`)
+	require.Error(t, err)
+
+	_, err = Parse(`controller { graceful_shutdown_drain_timeout = "soon" }`)
+	require.Error(t, err)
+}
+
+func TestParsingEventSubscriptions(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name    string
+		config  string
+		wantErr bool
+		want    *EventSubscriptions
+	}{
+		{
+			name:   "undefined",
+			config: `controller {}`,
+		},
+		{
+			name:   "defaults",
+			config: `controller { event_subscriptions {} }`,
+			want:   &EventSubscriptions{},
+		},
+		{
+			name: "webhooks",
+			config: `
+controller {
+  event_subscriptions {
+    enable_webhooks = true
+    webhook_timeout = "10s"
+  }
+}
+`,
+			want: &EventSubscriptions{
+				EnableWebhooks:         true,
+				WebhookTimeout:         "10s",
+				WebhookTimeoutDuration: 10 * time.Second,
+			},
+		},
+		{
+			name:    "invalid-webhook-timeout",
+			config:  `controller { event_subscriptions { webhook_timeout = "soon" } }`,
+			wantErr: true,
+		},
+		{
+			name:    "zero-webhook-timeout",
+			config:  `controller { event_subscriptions { webhook_timeout = "0s" } }`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := Parse(tt.config)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, out.Controller.EventSubscriptions)
+		})
+	}
+}
+
+func TestParsingAuthAnomalyDetection(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name    string
+		config  string
+		wantErr bool
+		want    *AuthAnomalyDetection
+	}{
+		{
+			name:   "undefined",
+			config: `controller {}`,
+		},
+		{
+			name:   "defaults",
+			config: `controller { auth_anomaly_detection {} }`,
+			want: &AuthAnomalyDetection{
+				ImpossibleTravel: anomaly.AllowAction,
+			},
+		},
+		{
+			name: "deny",
+			config: `
+controller {
+  auth_anomaly_detection {
+    impossible_travel_action = "deny"
+    max_travel_speed_kmh     = 800
+  }
+}
+`,
+			want: &AuthAnomalyDetection{
+				ImpossibleTravelAction: "deny",
+				ImpossibleTravel:       anomaly.DenyAction,
+				MaxTravelSpeedKmh:      800,
+			},
+		},
+		{
+			name: "networks",
+			config: `
+controller {
+  auth_anomaly_detection {
+    network "203.0.113.0/24" {
+      country_code = "US"
+      city         = "New York"
+      latitude     = 40.71
+      longitude    = -74.01
+    }
+    network "2001:db8::/32" {
+      country_code = "GB"
+    }
+  }
+}
+`,
+			want: &AuthAnomalyDetection{
+				ImpossibleTravel: anomaly.AllowAction,
+				Networks: []*AuthAnomalyNetwork{
+					{
+						Cidr:        "203.0.113.0/24",
+						Prefix:      netip.MustParsePrefix("203.0.113.0/24"),
+						CountryCode: "US",
+						City:        "New York",
+						Latitude:    40.71,
+						Longitude:   -74.01,
+					},
+					{
+						Cidr:        "2001:db8::/32",
+						Prefix:      netip.MustParsePrefix("2001:db8::/32"),
+						CountryCode: "GB",
+					},
+				},
+			},
+		},
+		{
+			name: "invalid-action",
+			config: `
+controller {
+  auth_anomaly_detection {
+    impossible_travel_action = "block"
+  }
+}
+`,
+			wantErr: true,
+		},
+		{
+			name: "invalid-network",
+			config: `
+controller {
+  auth_anomaly_detection {
+    network "203.0.113.0" {}
+  }
+}
+`,
+			wantErr: true,
+		},
+		{
+			name: "invalid-coordinates",
+			config: `
+controller {
+  auth_anomaly_detection {
+    network "203.0.113.0/24" {
+      latitude = 91
+    }
+  }
+}
+`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := Parse(tt.config)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, out.Controller.AuthAnomalyDetection)
+		})
+	}
+}
+
+func TestParsingWorkerConnectionLog(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name    string
+		config  string
+		wantErr bool
+		want    *event.FileSinkTypeConfig
+	}{
+		{
+			name:   "undefined",
+			config: `worker {}`,
+		},
+		{
+			name: "valid",
+			config: `
+worker {
+  connection_log {
+    path             = "/var/log/boundary"
+    file_name        = "connections.log"
+    rotate_bytes     = 1048576
+    rotate_duration  = "24h"
+    rotate_max_files = 7
+  }
+}
+`,
+			want: &event.FileSinkTypeConfig{
+				Path:              "/var/log/boundary",
+				FileName:          "connections.log",
+				RotateBytes:       1048576,
+				RotateDuration:    24 * time.Hour,
+				RotateDurationHCL: "24h",
+				RotateMaxFiles:    7,
+			},
+		},
+		{
+			name: "missing-file-name",
+			config: `
+worker {
+  connection_log {
+    path = "/var/log/boundary"
+  }
+}
+`,
+			wantErr: true,
+		},
+		{
+			name: "invalid-rotate-duration",
+			config: `
+worker {
+  connection_log {
+    file_name       = "connections.log"
+    rotate_duration = "daily"
+  }
+}
+`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := Parse(tt.config)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, out.Worker.ConnectionLog)
+		})
+	}
+}
+
+func TestParsingWorkerProtocolPlugins(t *testing.T) {
+	t.Parallel()
+	out, err := Parse(`
+worker {
+  protocol_plugins     = ["mysql", "vnc"]
+  protocol_plugins_dir = "/opt/boundary/plugins"
+}
+`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mysql", "vnc"}, out.Worker.ProtocolPlugins)
+	assert.Equal(t, "/opt/boundary/plugins", out.Worker.ProtocolPluginsDir)
+
+	_, err = Parse(`
+worker {
+  protocol_plugins = ["mysql"]
+}
+`)
+	require.Error(t, err)
+}
+
+func TestParsingWorkerUpstreamCompression(t *testing.T) {
+	t.Parallel()
+	out, err := Parse(`worker {}`)
+	require.NoError(t, err)
+	assert.Empty(t, out.Worker.UpstreamCompression)
+
+	out, err = Parse(`
+worker {
+  upstream_compression = "zstd"
+}
+`)
+	require.NoError(t, err)
+	assert.Equal(t, "zstd", out.Worker.UpstreamCompression)
+
+	_, err = Parse(`
+worker {
+  upstream_compression = "lz4"
+}
+`)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "upstream_compression")
+}
+
+func TestParsingListenerTlsWatchInterval(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name    string
+		config  string
+		wantErr bool
+		want    time.Duration
+	}{
+		{
+			name:   "default",
+			config: `controller {}`,
+			want:   time.Minute,
+		},
+		{
+			name:   "string",
+			config: `listener_tls_watch_interval = "15s"`,
+			want:   15 * time.Second,
+		},
+		{
+			name:   "seconds",
+			config: `listener_tls_watch_interval = 30`,
+			want:   30 * time.Second,
+		},
+		{
+			name:   "disabled",
+			config: `listener_tls_watch_interval = 0`,
+			want:   0,
+		},
+		{
+			name:    "invalid",
+			config:  `listener_tls_watch_interval = "often"`,
+			wantErr: true,
+		},
+		{
+			name:    "negative",
+			config:  `listener_tls_watch_interval = "-1s"`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := Parse(tt.config)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, out.ListenerTlsWatchIntervalDuration)
+		})
+	}
+}
+
+func TestParsingAcme(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name    string
+		config  string
+		wantErr string
+		want    *Acme
+	}{
+		{
+			name:   "undefined",
+			config: `controller {}`,
+		},
+		{
+			name: "valid",
+			config: `
+controller {
+  acme {
+    domains                 = ["boundary.example.com"]
+    email                   = "ops@example.com"
+    directory_url           = "https://acme-staging-v02.api.letsencrypt.org/directory"
+    cache_dir               = "/var/lib/boundary/acme"
+    accept_terms_of_service = true
+    http_challenge_address  = ":80"
+  }
+}
+`,
+			want: &Acme{
+				Domains:              []string{"boundary.example.com"},
+				Email:                "ops@example.com",
+				DirectoryUrl:         "https://acme-staging-v02.api.letsencrypt.org/directory",
+				CacheDir:             "/var/lib/boundary/acme",
+				AcceptTermsOfService: true,
+				HttpChallengeAddress: ":80",
+			},
+		},
+		{
+			name:    "missing-domains",
+			config:  `controller { acme { cache_dir = "/tmp" accept_terms_of_service = true } }`,
+			wantErr: "ACME domains must be set",
+		},
+		{
+			name:    "missing-cache-dir",
+			config:  `controller { acme { domains = ["boundary.example.com"] accept_terms_of_service = true } }`,
+			wantErr: "ACME cache_dir must be set",
+		},
+		{
+			name:    "terms-not-accepted",
+			config:  `controller { acme { domains = ["boundary.example.com"] cache_dir = "/tmp" } }`,
+			wantErr: "accept_terms_of_service",
+		},
+		{
+			name:    "invalid-domain",
+			config:  `controller { acme { domains = ["boundary.example.com:9200"] cache_dir = "/tmp" accept_terms_of_service = true } }`,
+			wantErr: "Invalid ACME domain",
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := Parse(tt.config)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, out.Controller.Acme)
+		})
+	}
+}
+
+func TestParsingListenerAddressFamily(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name    string
+		config  string
+		wantErr string
+		want    string
+	}{
+		{
+			name: "unset",
+			config: `
+listener "tcp" {
+	purpose = "api"
+}`,
+		},
+		{
+			name: "dual",
+			config: `
+listener "tcp" {
+	purpose = "api"
+	address = "[::]:9200"
+	address_family = "dual"
+}`,
+			want: AddressFamilyDual,
+		},
+		{
+			name: "ipv6",
+			config: `
+listener "tcp" {
+	purpose = "cluster"
+	address_family = "ipv6"
+}`,
+			want: AddressFamilyIpv6,
+		},
+		{
+			name: "invalid",
+			config: `
+listener "tcp" {
+	purpose = "api"
+	address_family = "ipv5"
+}`,
+			wantErr: `Invalid listener address_family "ipv5"`,
+		},
+		{
+			name: "unix",
+			config: `
+listener "unix" {
+	purpose = "api"
+	address = "/tmp/boundary.sock"
+	address_family = "ipv4"
+}`,
+			wantErr: "only supported for tcp listeners",
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := Parse(tt.config)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, out.Listeners, 1)
+			got, err := ListenerAddressFamily(out.Listeners[0])
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParsingRegion(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name                 string
+		config               string
+		wantErr              string
+		wantControllerRegion string
+		wantWorkerTags       map[string][]string
+	}{
+		{
+			name: "unset",
+			config: `
+controller {
+	name = "test-controller"
+}
+worker {
+	name = "test-worker"
+}`,
+		},
+		{
+			name: "regions",
+			config: `
+controller {
+	name = "test-controller"
+	region = "us-east-1"
+}
+worker {
+	name = "test-worker"
+	region = "us-east-1"
+	tags {
+		type = ["dev"]
+	}
+}`,
+			wantControllerRegion: "us-east-1",
+			wantWorkerTags: map[string][]string{
+				"type":   {"dev"},
+				"region": {"us-east-1"},
+			},
+		},
+		{
+			name: "matching-region-tag",
+			config: `
+worker {
+	name = "test-worker"
+	region = "eu-west-1"
+	tags {
+		region = ["eu-west-1"]
+	}
+}`,
+			wantWorkerTags: map[string][]string{
+				"region": {"eu-west-1"},
+			},
+		},
+		{
+			name: "conflicting-region-tag",
+			config: `
+worker {
+	name = "test-worker"
+	region = "eu-west-1"
+	tags {
+		region = ["us-east-1"]
+	}
+}`,
+			wantErr: `Worker tag "region" conflicts with the worker region "eu-west-1"`,
+		},
+		{
+			name: "upper-case-controller-region",
+			config: `
+controller {
+	name = "test-controller"
+	region = "US-EAST-1"
+}`,
+			wantErr: "Invalid controller region",
+		},
+		{
+			name: "worker-region-with-comma",
+			config: `
+worker {
+	name = "test-worker"
+	region = "us-east-1,eu-west-1"
+}`,
+			wantErr: "Invalid worker region",
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := Parse(tt.config)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			if out.Controller != nil {
+				assert.Equal(t, tt.wantControllerRegion, out.Controller.Region)
+			}
+			if out.Worker != nil {
+				assert.Equal(t, tt.wantWorkerTags, out.Worker.Tags)
+			}
+		})
+	}
+}
+
+func TestParsingUpstreamSelection(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name    string
+		config  string
+		wantErr bool
+		want    *UpstreamSelection
+	}{
+		{
+			name:   "undefined",
+			config: `worker {}`,
+		},
+		{
+			name:   "defaults",
+			config: `worker { upstream_selection {} }`,
+			want:   &UpstreamSelection{ProbeIntervalDuration: 30 * time.Second},
+		},
+		{
+			name: "weights-and-probe-interval",
+			config: `
+worker {
+  upstream_selection {
+    probe_interval = "10s"
+    weights = {
+      "controller-1.example.com:9201" = 10
+      "controller-2.example.com"      = 1
+    }
+  }
+}
+`,
+			want: &UpstreamSelection{
+				Weights: map[string]int{
+					"controller-1.example.com:9201": 10,
+					"controller-2.example.com":      1,
+				},
+				ProbeInterval:         "10s",
+				ProbeIntervalDuration: 10 * time.Second,
+			},
+		},
+		{
+			name:    "invalid-probe-interval",
+			config:  `worker { upstream_selection { probe_interval = "soon" } }`,
+			wantErr: true,
+		},
+		{
+			name:    "zero-weight",
+			config:  `worker { upstream_selection { weights = { "127.0.0.1" = 0 } } }`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := Parse(tt.config)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, out.Worker.UpstreamSelection)
+		})
+	}
+}
+
+func TestParsingRequestLimits(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name    string
+		config  string
+		wantErr string
+		want    *RequestLimits
+	}{
+		{
+			name: "unset",
+			config: `
+controller {
+	name = "test-controller"
+}`,
+		},
+		{
+			name: "defaults",
+			config: `
+controller {
+	name = "test-controller"
+	request_limits {}
+}`,
+			want: &RequestLimits{MaxAttributeDepth: 32},
+		},
+		{
+			name: "endpoints",
+			config: `
+controller {
+	name = "test-controller"
+	request_limits {
+		max_attribute_depth = 8
+		endpoint "/v1/targets/*:add-host-sources" {
+			max_request_size = 10485760
+		}
+		endpoint "/v1/credential-libraries*" {
+			max_request_size = -1
+		}
+	}
+}`,
+			want: &RequestLimits{
+				MaxAttributeDepth: 8,
+				Endpoints: []*EndpointRequestLimit{
+					{Path: "/v1/targets/*:add-host-sources", MaxRequestSize: 10485760},
+					{Path: "/v1/credential-libraries*", MaxRequestSize: -1},
+				},
+			},
+		},
+		{
+			name: "depth-disabled",
+			config: `
+controller {
+	name = "test-controller"
+	request_limits {
+		max_attribute_depth = -1
+	}
+}`,
+			want: &RequestLimits{MaxAttributeDepth: -1},
+		},
+		{
+			name: "invalid-depth",
+			config: `
+controller {
+	name = "test-controller"
+	request_limits {
+		max_attribute_depth = -2
+	}
+}`,
+			wantErr: "Request limits max attribute depth must be positive or -1",
+		},
+		{
+			name: "relative-path",
+			config: `
+controller {
+	name = "test-controller"
+	request_limits {
+		endpoint "v1/targets" {
+			max_request_size = 1024
+		}
+	}
+}`,
+			wantErr: `Request limits endpoint path "v1/targets" must start with /`,
+		},
+		{
+			name: "bad-pattern",
+			config: `
+controller {
+	name = "test-controller"
+	request_limits {
+		endpoint "/v1/targets/[" {
+			max_request_size = 1024
+		}
+	}
+}`,
+			wantErr: `Invalid request limits endpoint path "/v1/targets/["`,
+		},
+		{
+			name: "missing-size",
+			config: `
+controller {
+	name = "test-controller"
+	request_limits {
+		endpoint "/v1/targets" {
+		}
+	}
+}`,
+			wantErr: `Request limits endpoint "/v1/targets" max request size must be positive or -1`,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := Parse(tt.config)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, out.Controller.RequestLimits)
+		})
+	}
+}
+
+func TestParsingDiscovery(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name    string
+		config  string
+		wantErr string
+		want    *Discovery
+	}{
+		{
+			name: "unset",
+			config: `
+controller {
+	name = "test-controller"
+}`,
+		},
+		{
+			name: "defaults",
+			config: `
+controller {
+	name = "test-controller"
+	discovery {
+		default_scope_id = "o_1234567890"
+		default_auth_method_id = "amoidc_1234567890"
+	}
+}`,
+			want: &Discovery{
+				DefaultScopeId:      "o_1234567890",
+				DefaultAuthMethodId: "amoidc_1234567890",
+			},
+		},
+		{
+			name: "global",
+			config: `
+controller {
+	name = "test-controller"
+	discovery {
+		default_scope_id = "global"
+	}
+}`,
+			want: &Discovery{DefaultScopeId: "global"},
+		},
+		{
+			name: "project-scope",
+			config: `
+controller {
+	name = "test-controller"
+	discovery {
+		default_scope_id = "p_1234567890"
+	}
+}`,
+			wantErr: `Discovery default scope id "p_1234567890" must be the global scope or an org`,
+		},
+		{
+			name: "invalid-auth-method",
+			config: `
+controller {
+	name = "test-controller"
+	discovery {
+		default_auth_method_id = "o_1234567890"
+	}
+}`,
+			wantErr: `Discovery default auth method id "o_1234567890" is not a password, OIDC or LDAP auth method`,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := Parse(tt.config)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, out.Controller.Discovery)
+		})
+	}
+}
+
+func TestParsingControllerCredentialPlugins(t *testing.T) {
+	t.Parallel()
+	out, err := Parse(`
+controller {
+  credential_plugins     = ["conjur"]
+  credential_plugins_dir = "/opt/boundary/plugins"
+}
+`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"conjur"}, out.Controller.CredentialPlugins)
+	assert.Equal(t, "/opt/boundary/plugins", out.Controller.CredentialPluginsDir)
+
+	_, err = Parse(`
+controller {
+  credential_plugins = ["conjur"]
+}
+`)
+	require.Error(t, err)
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+	vault "github.com/hashicorp/vault/api"
+)
+
+// vaultSecretPrefix is the scheme of references to a field of a Vault secret.
+const vaultSecretPrefix = "vault://"
+
+// ParseSecretPath resolves a reference to a secret in a sensitive config
+// field. In addition to the "env://" and "file://" references supported by
+// parseutil.ParsePath, a reference of the form "vault://<path>#<field>" is
+// resolved by reading the field of the Vault secret at the path. The Vault
+// client is configured from the standard VAULT_* environment variables, such
+// as VAULT_ADDR and VAULT_TOKEN. Secrets of both versions of the KV secrets
+// engine are supported; for version 2 the path must include "data/".
+//
+// As with parseutil.ParsePath, a value which is not a reference is returned
+// unchanged.
+func ParseSecretPath(value string) (string, error) {
+	if !strings.HasPrefix(value, vaultSecretPrefix) {
+		return parseutil.ParsePath(value)
+	}
+
+	path, field, ok := strings.Cut(strings.TrimPrefix(value, vaultSecretPrefix), "#")
+	if !ok || path == "" || field == "" {
+		return "", fmt.Errorf("vault secret reference %q must have the form %s<path>#<field>", value, vaultSecretPrefix)
+	}
+	client, err := vault.NewClient(vault.DefaultConfig())
+	if err != nil {
+		return "", fmt.Errorf("error creating vault client: %w", err)
+	}
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return "", fmt.Errorf("error reading vault secret %q: %w", path, err)
+	}
+	if secret == nil || secret.Data == nil {
+		return "", fmt.Errorf("vault secret %q not found", path)
+	}
+	data := secret.Data
+	// Secrets of version 2 of the KV secrets engine nest the fields of the
+	// secret under "data", next to its "metadata".
+	if nested, ok := data["data"].(map[string]any); ok {
+		if _, ok := data["metadata"]; ok {
+			data = nested
+		}
+	}
+	v, ok := data[field]
+	if !ok {
+		return "", fmt.Errorf("vault secret %q has no field %q", path, field)
+	}
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("field %q of vault secret %q is not a string", field, path)
+	}
+	return s, nil
+}
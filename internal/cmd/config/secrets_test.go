@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSecretPath(t *testing.T) {
+	secrets := map[string]map[string]any{
+		// version 1 of the KV secrets engine
+		"/v1/kv/boundary": {
+			"data": map[string]any{"db_url": "postgresql://kv1"},
+		},
+		// version 2 of the KV secrets engine
+		"/v1/secret/data/boundary": {
+			"data": map[string]any{
+				"data":     map[string]any{"db_url": "postgresql://kv2", "port": 5432},
+				"metadata": map[string]any{"version": 1},
+			},
+		},
+	}
+	vaultServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "test-token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		s, ok := secrets[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(s)
+	}))
+	t.Cleanup(vaultServer.Close)
+	t.Setenv("VAULT_ADDR", vaultServer.URL)
+	t.Setenv("VAULT_TOKEN", "test-token")
+
+	t.Setenv("BOUNDARY_TEST_SECRET", "postgresql://env")
+	secretFile := filepath.Join(t.TempDir(), "secret")
+	require.NoError(t, os.WriteFile(secretFile, []byte("postgresql://file\n"), 0o600))
+
+	tests := []struct {
+		name            string
+		value           string
+		want            string
+		wantErrContains string
+	}{
+		{name: "plain", value: "plain", want: "plain"},
+		{name: "other-url", value: "postgresql://plain", want: "postgresql://plain"},
+		{name: "env", value: "env://BOUNDARY_TEST_SECRET", want: "postgresql://env"},
+		{name: "file", value: "file://" + secretFile, want: "postgresql://file"},
+		{name: "vault-kv1", value: "vault://kv/boundary#db_url", want: "postgresql://kv1"},
+		{name: "vault-kv2", value: "vault://secret/data/boundary#db_url", want: "postgresql://kv2"},
+		{name: "vault-missing-field-name", value: "vault://secret/data/boundary", wantErrContains: "must have the form"},
+		{name: "vault-missing-secret", value: "vault://secret/data/missing#db_url", wantErrContains: `vault secret "secret/data/missing" not found`},
+		{name: "vault-missing-field", value: "vault://secret/data/boundary#password", wantErrContains: `has no field "password"`},
+		{name: "vault-not-a-string", value: "vault://secret/data/boundary#port", wantErrContains: "is not a string"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSecretPath(tt.value)
+			if tt.wantErrContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErrContains)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -51,6 +51,10 @@ type cmdInfo struct {
 	// HasScopeName controls whether to add scope name options
 	HasScopeName bool
 
+	// HasNameLookup controls whether the read command can look up the
+	// resource via its name and scope ID instead of its ID
+	HasNameLookup bool
+
 	// VersionedActions controls which actions to add a case for version checking
 	VersionedActions []string
 
@@ -248,6 +252,22 @@ var inputStructs = map[string][]*cmdInfo{
 			NeedsSubtypeInCreate: true,
 			PrefixAttributeFieldErrorsWithSubactionPrefix: true,
 		},
+		{
+			ResourceType:         resource.CredentialStore.String(),
+			Pkg:                  "credentialstores",
+			StdActions:           []string{"create", "update"},
+			SubActionPrefix:      "azure",
+			HasExtraCommandVars:  true,
+			SkipNormalHelp:       true,
+			HasExtraHelpFunc:     true,
+			HasId:                true,
+			HasName:              true,
+			HasDescription:       true,
+			Container:            "Scope",
+			VersionedActions:     []string{"update"},
+			NeedsSubtypeInCreate: true,
+			PrefixAttributeFieldErrorsWithSubactionPrefix: true,
+		},
 	},
 	"credentiallibraries": {
 		{
@@ -306,15 +326,32 @@ var inputStructs = map[string][]*cmdInfo{
 			VersionedActions:     []string{"update"},
 			PrefixAttributeFieldErrorsWithSubactionPrefix: true,
 		},
+		{
+			ResourceType:         resource.CredentialLibrary.String(),
+			Pkg:                  "credentiallibraries",
+			StdActions:           []string{"create", "update"},
+			SubActionPrefix:      "azure-secret",
+			HasExtraCommandVars:  true,
+			SkipNormalHelp:       true,
+			HasExtraHelpFunc:     true,
+			HasId:                true,
+			HasName:              true,
+			HasDescription:       true,
+			NeedsSubtypeInCreate: true,
+			Container:            "CredentialStore",
+			VersionedActions:     []string{"update"},
+			PrefixAttributeFieldErrorsWithSubactionPrefix: true,
+		},
 	},
 	"credentials": {
 		{
-			ResourceType:     resource.Credential.String(),
-			Pkg:              "credentials",
-			StdActions:       []string{"read", "delete", "list"},
-			HasExtraHelpFunc: true,
-			Container:        "CredentialStore",
-			HasId:            true,
+			ResourceType:        resource.Credential.String(),
+			Pkg:                 "credentials",
+			StdActions:          []string{"read", "delete", "list"},
+			HasExtraCommandVars: true,
+			HasExtraHelpFunc:    true,
+			Container:           "CredentialStore",
+			HasId:               true,
 		},
 		{
 			ResourceType:         resource.Credential.String(),
@@ -348,6 +385,22 @@ var inputStructs = map[string][]*cmdInfo{
 			NeedsSubtypeInCreate: true,
 			PrefixAttributeFieldErrorsWithSubactionPrefix: true,
 		},
+		{
+			ResourceType:         resource.Credential.String(),
+			Pkg:                  "credentials",
+			StdActions:           []string{"create", "update"},
+			SubActionPrefix:      "x509_certificate",
+			HasExtraCommandVars:  true,
+			SkipNormalHelp:       true,
+			HasExtraHelpFunc:     true,
+			HasId:                true,
+			HasName:              true,
+			HasDescription:       true,
+			Container:            "CredentialStore",
+			VersionedActions:     []string{"update"},
+			NeedsSubtypeInCreate: true,
+			PrefixAttributeFieldErrorsWithSubactionPrefix: true,
+		},
 		{
 			ResourceType:         resource.Credential.String(),
 			Pkg:                  "credentials",
@@ -376,6 +429,7 @@ var inputStructs = map[string][]*cmdInfo{
 			Container:           "Scope",
 			HasName:             true,
 			HasDescription:      true,
+			HasNameLookup:       true,
 			VersionedActions:    []string{"update", "add-members", "remove-members", "set-members"},
 		},
 	},
@@ -538,6 +592,7 @@ var inputStructs = map[string][]*cmdInfo{
 			Container:           "Scope",
 			HasName:             true,
 			HasDescription:      true,
+			HasNameLookup:       true,
 			VersionedActions:    []string{"update", "add-grants", "remove-grants", "set-grants", "add-principals", "remove-principals", "set-principals"},
 		},
 	},
@@ -551,6 +606,7 @@ var inputStructs = map[string][]*cmdInfo{
 			Container:           "Scope",
 			HasName:             true,
 			HasDescription:      true,
+			HasNameLookup:       true,
 			VersionedActions:    []string{"update"},
 		},
 	},
@@ -576,6 +632,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasExampleCliOutput: true,
 			HasName:             true,
 			HasDescription:      true,
+			HasNameLookup:       true,
 			Container:           "Scope",
 			VersionedActions:    []string{"add-host-sources", "remove-host-sources", "set-host-sources", "add-credential-sources", "remove-credential-sources", "set-credential-sources"},
 		},
@@ -621,20 +678,22 @@ var inputStructs = map[string][]*cmdInfo{
 			Container:           "Scope",
 			HasName:             true,
 			HasDescription:      true,
+			HasNameLookup:       true,
 			VersionedActions:    []string{"update", "add-accounts", "remove-accounts", "set-accounts"},
 		},
 	},
 	"workers": {
 		{
-			ResourceType:     resource.Worker.String(),
-			Pkg:              "workers",
-			StdActions:       []string{"read", "update", "delete", "list"},
-			HasExtraHelpFunc: true,
-			HasId:            true,
-			Container:        "Scope",
-			HasName:          true,
-			HasDescription:   true,
-			VersionedActions: []string{"update", "add-worker-tags", "set-worker-tags", "remove-worker-tags"},
+			ResourceType:        resource.Worker.String(),
+			Pkg:                 "workers",
+			StdActions:          []string{"read", "update", "delete", "list"},
+			HasExtraHelpFunc:    true,
+			HasId:               true,
+			Container:           "Scope",
+			HasName:             true,
+			HasDescription:      true,
+			VersionedActions:    []string{"update", "add-worker-tags", "set-worker-tags", "remove-worker-tags"},
+			HasExtraCommandVars: true,
 		},
 		{
 			ResourceType:          resource.Worker.String(),
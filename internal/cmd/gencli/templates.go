@@ -209,6 +209,23 @@ func (c *{{ camelCase .SubActionPrefix }}Command) Flags() *base.FlagSets {
 	f := set.NewFlagSet("Command Options")
 	common.PopulateCommonFlags(c.Command, f, "{{ if .SubActionPrefix }}{{ kebabCase .SubActionPrefix }}-type {{ end }}{{ lowerSpaceCase .ResourceType }}", flags{{ camelCase .SubActionPrefix }}Map, c.Func)
 
+	{{ if .HasNameLookup }}
+	if c.Func == "read" {
+		f.StringVar(&base.StringVar{
+			Name:   "name",
+			Target: &c.FlagName,
+			Usage:  "Name of the {{ lowerSpaceCase .ResourceType }}, if reading it via its name and scope ID instead of its ID.",
+		})
+		f.StringVar(&base.StringVar{
+			Name:       "scope-id",
+			Target:     &c.FlagScopeId,
+			EnvVar:     "BOUNDARY_SCOPE_ID",
+			Completion: complete.PredictAnything,
+			Usage:      "ID of the scope containing the {{ lowerSpaceCase .ResourceType }}, if reading it via its name.",
+		})
+	}
+	{{ end }}
+
 	{{ if .HasGenericAttributes }}
 	f = set.NewFlagSet("Attribute Options")
 		attrsInput := common.CombinedSliceFlagValuePopulationInput{
@@ -289,8 +306,21 @@ func (c *{{ camelCase .SubActionPrefix }}Command) Run(args []string) int {
 		return base.CommandUserError
 	}
 
+	{{ if .HasNameLookup }}
+	if c.Func == "read" {
+		switch {
+		case c.FlagId == "" && (c.FlagName == "" || c.FlagScopeId == ""):
+			c.PrintCliError(errors.New("ID was not passed in, but no combination of name and scope ID was passed in either"))
+			return base.CommandUserError
+		case c.FlagId != "" && c.FlagName != "":
+			c.PrintCliError(errors.New("Cannot specify an ID and also a name"))
+			return base.CommandUserError
+		}
+	}
+	{{ end }}
+
 	{{ if .HasId }}
-	if strutil.StrListContains(flags{{ camelCase .SubActionPrefix }}Map[c.Func], "id") && c.FlagId == "" {
+	if strutil.StrListContains(flags{{ camelCase .SubActionPrefix }}Map[c.Func], "id") && c.FlagId == "" {{ if .HasNameLookup }}&& c.Func != "read" {{ end }}{
 			c.PrintCliError(errors.New("ID is required but not passed in via -id"))
 			return base.CommandUserError
 	}
@@ -352,6 +382,16 @@ func (c *{{ camelCase .SubActionPrefix }}Command) Run(args []string) int {
 	}
 	{{ .Pkg }}Client := {{ .Pkg }}.NewClient(client)
 
+	{{ if (eq .Container "Scope") }}
+	switch {
+	case c.Func == "create", c.Func == "list" && !c.FlagRecursive:
+		if err := c.CheckAuthorizedAction(client, c.FlagScopeId, "{{ .ResourceType }}", c.Func); err != nil {
+			c.PrintCliError(err)
+			return base.CommandUserError
+		}
+	}
+	{{ end }}
+
 	{{ if .HasName }}
 	switch c.FlagName {
 	case "":
@@ -498,6 +538,11 @@ func (c *{{ camelCase .SubActionPrefix }}Command) Run(args []string) int {
 	{{ end }}
 	{{ if eq $action "read" }}
 	case "read":
+		{{- if $input.HasNameLookup }}
+		if c.FlagName != "" {
+			opts = append(opts, {{ $input.Pkg }}.WithLookupByName(c.FlagName, c.FlagScopeId))
+		}
+		{{- end }}
 		{{ $action }}Result, err = {{ $input.Pkg }}Client.Read(c.Context, c.FlagId, opts...)
 		if exitCode := c.checkFuncError(err); exitCode > 0 {
 			return exitCode
@@ -156,6 +156,9 @@ func createOpsHandler(lncfg *listenerutil.ListenerConfig, c *controller.Controll
 		// either a controller or worker is starting up, but just to be safe.
 		mux.Handle("/health", h)
 	}
+	if c != nil && c.GetDeprecationHandler() != nil {
+		mux.Handle("/deprecations", c.GetDeprecationHandler())
+	}
 	mux.Handle("/metrics", promhttp.Handler())
 	return cleanhttp.PrintablePathCheckHandler(mux, nil), nil
 }
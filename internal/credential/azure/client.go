@@ -0,0 +1,253 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+const (
+	// keyVaultApiVersion is the version of the Azure Key Vault REST API used
+	// to retrieve secrets.
+	keyVaultApiVersion = "7.4"
+
+	// imdsTokenEndpoint is the endpoint of the Azure Instance Metadata
+	// Service issuing managed identity tokens on virtual machines and
+	// Kubernetes nodes.
+	imdsTokenEndpoint   = "http://169.254.169.254/metadata/identity/oauth2/token"
+	imdsTokenApiVersion = "2018-02-01"
+
+	// appServiceTokenApiVersion is the version of the managed identity
+	// endpoint of App Service and Container Apps, which is set in the
+	// IDENTITY_ENDPOINT environment variable.
+	appServiceTokenApiVersion = "2019-08-01"
+
+	// tokenRefreshWindow is how long before its expiration a cached token is
+	// replaced.
+	tokenRefreshWindow = 5 * time.Minute
+
+	clientTimeout = 30 * time.Second
+)
+
+// A secret is a version of a secret of an Azure Key Vault.
+type secret struct {
+	Id          string `json:"id"`
+	Value       string `json:"value"`
+	ContentType string `json:"contentType"`
+}
+
+// secretClient retrieves secrets from an Azure Key Vault.
+type secretClient interface {
+	// getSecret returns the version of the secret name. The current
+	// version is returned if version is empty.
+	getSecret(ctx context.Context, name, version string) (*secret, error)
+}
+
+// clientFactory creates a secretClient for the Azure Key Vault of a
+// credential store.
+type clientFactory func(ctx context.Context, cs *CredentialStore) (secretClient, error)
+
+type keyVaultClient struct {
+	vaultUri   string
+	tokens     *managedIdentityTokenSource
+	httpClient *http.Client
+}
+
+func newKeyVaultClient(ctx context.Context, cs *CredentialStore) (secretClient, error) {
+	const op = "azure.newKeyVaultClient"
+	u, err := url.Parse(cs.GetVaultUri())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter))
+	}
+	// The resource of the tokens is the Key Vault service of the cloud the
+	// vault is in, for example https://vault.azure.net for myvault.vault.azure.net.
+	_, domain, ok := strings.Cut(u.Hostname(), ".")
+	if u.Scheme != "https" || !ok || domain == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid vault uri %q", cs.GetVaultUri()))
+	}
+	return &keyVaultClient{
+		vaultUri:   strings.TrimSuffix(u.String(), "/"),
+		tokens:     tokenSources.get("https://"+domain, cs.GetManagedIdentityClientId()),
+		httpClient: &http.Client{Timeout: clientTimeout},
+	}, nil
+}
+
+type keyVaultError struct {
+	Error struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func (c *keyVaultClient) getSecret(ctx context.Context, name, version string) (*secret, error) {
+	const op = "azure.(keyVaultClient).getSecret"
+	token, err := c.tokens.token(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	u := fmt.Sprintf("%s/secrets/%s", c.vaultUri, url.PathEscape(name))
+	if version != "" {
+		u = fmt.Sprintf("%s/%s", u, url.PathEscape(version))
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u+"?api-version="+keyVaultApiVersion, nil)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to retrieve secret from azure key vault"))
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if resp.StatusCode != http.StatusOK {
+		var kvErr keyVaultError
+		msg := string(body)
+		if json.Unmarshal(body, &kvErr) == nil && kvErr.Error.Message != "" {
+			msg = fmt.Sprintf("%s: %s", kvErr.Error.Code, kvErr.Error.Message)
+		}
+		return nil, errors.New(ctx, errors.Unknown, op, fmt.Sprintf("azure key vault returned %d for secret %s: %s", resp.StatusCode, name, msg))
+	}
+	var s secret
+	if err := json.Unmarshal(body, &s); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to decode secret"))
+	}
+	return &s, nil
+}
+
+// tokenSourceRegistry holds the managed identity token sources, so that
+// tokens are cached across clients.
+type tokenSourceRegistry struct {
+	m map[string]*managedIdentityTokenSource
+
+	sync.Mutex
+}
+
+var tokenSources = tokenSourceRegistry{
+	m: map[string]*managedIdentityTokenSource{},
+}
+
+func (r *tokenSourceRegistry) get(resource, clientId string) *managedIdentityTokenSource {
+	r.Lock()
+	defer r.Unlock()
+
+	key := resource + "|" + clientId
+	ts, ok := r.m[key]
+	if !ok {
+		ts = &managedIdentityTokenSource{
+			resource:   resource,
+			clientId:   clientId,
+			httpClient: &http.Client{Timeout: clientTimeout},
+		}
+		r.m[key] = ts
+	}
+	return ts
+}
+
+// managedIdentityTokenSource gets access tokens for resource from the
+// managed identity endpoint of the host the controller runs on. If clientId
+// is empty, the tokens are issued to the system-assigned managed identity.
+type managedIdentityTokenSource struct {
+	resource   string
+	clientId   string
+	httpClient *http.Client
+
+	mu        sync.Mutex
+	current   string
+	expiresAt time.Time
+}
+
+type managedIdentityToken struct {
+	AccessToken string `json:"access_token"`
+	// ExpiresOn is the expiration of the token in seconds since the epoch.
+	// It is a string in the responses of the managed identity endpoints.
+	ExpiresOn json.Number `json:"expires_on"`
+}
+
+func (ts *managedIdentityTokenSource) token(ctx context.Context) (string, error) {
+	const op = "azure.(managedIdentityTokenSource).token"
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	if ts.current != "" && time.Now().Add(tokenRefreshWindow).Before(ts.expiresAt) {
+		return ts.current, nil
+	}
+
+	req, err := ts.newRequest(ctx)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	resp, err := ts.httpClient.Do(req)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op, errors.WithMsg("unable to get managed identity token"))
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.New(ctx, errors.Unknown, op, fmt.Sprintf("managed identity endpoint returned %d: %s", resp.StatusCode, body))
+	}
+	var tok managedIdentityToken
+	if err := json.Unmarshal(body, &tok); err != nil {
+		return "", errors.Wrap(ctx, err, op, errors.WithMsg("unable to decode managed identity token"))
+	}
+	if tok.AccessToken == "" {
+		return "", errors.New(ctx, errors.Unknown, op, "managed identity endpoint returned an empty token")
+	}
+	expiresOn, err := strconv.ParseInt(tok.ExpiresOn.String(), 10, 64)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op, errors.WithMsg("invalid managed identity token expiration"))
+	}
+
+	ts.current, ts.expiresAt = tok.AccessToken, time.Unix(expiresOn, 0)
+	return ts.current, nil
+}
+
+// newRequest creates the token request to the managed identity endpoint.
+// App Service and Container Apps expose the endpoint in the
+// IDENTITY_ENDPOINT and IDENTITY_HEADER environment variables, other hosts
+// use the Instance Metadata Service.
+func (ts *managedIdentityTokenSource) newRequest(ctx context.Context) (*http.Request, error) {
+	endpoint, header := os.Getenv("IDENTITY_ENDPOINT"), os.Getenv("IDENTITY_HEADER")
+	apiVersion := appServiceTokenApiVersion
+	if endpoint == "" || header == "" {
+		endpoint, apiVersion = imdsTokenEndpoint, imdsTokenApiVersion
+	}
+
+	q := url.Values{}
+	q.Set("api-version", apiVersion)
+	q.Set("resource", ts.resource)
+	if ts.clientId != "" {
+		q.Set("client_id", ts.clientId)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+"?"+q.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint == imdsTokenEndpoint {
+		req.Header.Set("Metadata", "true")
+	} else {
+		req.Header.Set("X-IDENTITY-HEADER", header)
+	}
+	return req, nil
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/credential/azure/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testIdentityEndpoint starts a managed identity endpoint issuing tokens
+// expiring after expiresIn and points the token sources to it.
+func testIdentityEndpoint(t *testing.T, expiresIn time.Duration) *atomic.Int32 {
+	t.Helper()
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.Header.Get("X-IDENTITY-HEADER") != "test-header" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		expiresOn := time.Now().Add(expiresIn).Unix()
+		fmt.Fprintf(w, `{"access_token":"token-%s-%s","expires_on":"%d"}`,
+			r.URL.Query().Get("resource"), r.URL.Query().Get("client_id"), expiresOn)
+	}))
+	t.Cleanup(srv.Close)
+	t.Setenv("IDENTITY_ENDPOINT", srv.URL)
+	t.Setenv("IDENTITY_HEADER", "test-header")
+	return &requests
+}
+
+func TestManagedIdentityTokenSource(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("caches-token", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		requests := testIdentityEndpoint(t, time.Hour)
+		ts := &managedIdentityTokenSource{resource: "https://vault.azure.net", clientId: "client", httpClient: http.DefaultClient}
+
+		tok, err := ts.token(ctx)
+		require.NoError(err)
+		assert.Equal("token-https://vault.azure.net-client", tok)
+		tok, err = ts.token(ctx)
+		require.NoError(err)
+		assert.Equal("token-https://vault.azure.net-client", tok)
+		assert.Equal(int32(1), requests.Load())
+	})
+
+	t.Run("refreshes-expiring-token", func(t *testing.T) {
+		require := require.New(t)
+		requests := testIdentityEndpoint(t, time.Minute)
+		ts := &managedIdentityTokenSource{resource: "https://vault.azure.net", httpClient: http.DefaultClient}
+
+		_, err := ts.token(ctx)
+		require.NoError(err)
+		_, err = ts.token(ctx)
+		require.NoError(err)
+		assert.Equal(t, int32(2), requests.Load())
+	})
+
+	t.Run("error", func(t *testing.T) {
+		testIdentityEndpoint(t, time.Hour)
+		t.Setenv("IDENTITY_HEADER", "wrong-header")
+		ts := &managedIdentityTokenSource{resource: "https://vault.azure.net", httpClient: http.DefaultClient}
+
+		_, err := ts.token(ctx)
+		assert.ErrorContains(t, err, "returned 401")
+	})
+}
+
+func TestNewKeyVaultClient(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name         string
+		vaultUri     string
+		wantResource string
+		wantErr      bool
+	}{
+		{name: "public-cloud", vaultUri: "https://myvault.vault.azure.net/", wantResource: "https://vault.azure.net"},
+		{name: "government-cloud", vaultUri: "https://myvault.vault.usgovcloudapi.net", wantResource: "https://vault.usgovcloudapi.net"},
+		{name: "http", vaultUri: "http://myvault.vault.azure.net", wantErr: true},
+		{name: "no-domain", vaultUri: "https://myvault", wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			cs := &CredentialStore{CredentialStore: &store.CredentialStore{VaultUri: tt.vaultUri}}
+			c, err := newKeyVaultClient(ctx, cs)
+			if tt.wantErr {
+				assert.Error(err)
+				return
+			}
+			require.NoError(err)
+			kvc := c.(*keyVaultClient)
+			assert.Equal(tt.wantResource, kvc.tokens.resource)
+			assert.NotContains(kvc.vaultUri[len("https://"):], "/")
+		})
+	}
+}
+
+func TestKeyVaultClient_getSecret(t *testing.T) {
+	ctx := context.Background()
+	testIdentityEndpoint(t, time.Hour)
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token-https://vault.azure.net-" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/secrets/db-creds":
+			fmt.Fprint(w, `{"id":"https://myvault.vault.azure.net/secrets/db-creds/current","value":"current-value"}`)
+		case "/secrets/db-creds/0123456789abcdef0123456789abcdef":
+			fmt.Fprint(w, `{"id":"https://myvault.vault.azure.net/secrets/db-creds/0123456789abcdef0123456789abcdef","value":"old-value"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error":{"code":"SecretNotFound","message":"A secret with (name/id) missing was not found in this key vault."}}`)
+		}
+	}))
+	defer srv.Close()
+
+	c := &keyVaultClient{
+		vaultUri:   srv.URL,
+		tokens:     &managedIdentityTokenSource{resource: "https://vault.azure.net", httpClient: http.DefaultClient},
+		httpClient: srv.Client(),
+	}
+
+	tests := []struct {
+		name      string
+		secret    string
+		version   string
+		wantValue string
+		wantErr   string
+	}{
+		{name: "current", secret: "db-creds", wantValue: "current-value"},
+		{name: "version", secret: "db-creds", version: "0123456789abcdef0123456789abcdef", wantValue: "old-value"},
+		{name: "not-found", secret: "missing", wantErr: "404 for secret missing: SecretNotFound"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			s, err := c.getSecret(ctx, tt.secret, tt.version)
+			if tt.wantErr != "" {
+				assert.ErrorContains(err, tt.wantErr)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.wantValue, s.Value)
+			assert.Contains(s.Id, "/secrets/"+tt.secret+"/")
+		})
+	}
+
+	t.Run("api-version", func(t *testing.T) {
+		var got string
+		srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = r.URL.Query().Get("api-version")
+			fmt.Fprint(w, `{"id":"id","value":"v"}`)
+		}))
+		defer srv.Close()
+		c := &keyVaultClient{vaultUri: srv.URL, tokens: c.tokens, httpClient: srv.Client()}
+		_, err := c.getSecret(ctx, "name", "")
+		require.NoError(t, err)
+		assert.Equal(t, keyVaultApiVersion, got)
+	})
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azure
+
+import (
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/azure/store"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"google.golang.org/protobuf/proto"
+)
+
+// A CredentialLibrary contains the name of a secret in an Azure Key Vault
+// and is owned by a credential store.
+type CredentialLibrary struct {
+	*store.CredentialLibrary
+	tableName string `gorm:"-"`
+}
+
+// NewCredentialLibrary creates a new in memory CredentialLibrary for the
+// secret secretName assigned to storeId. Name, description, secret version
+// and credential type are the only valid options. All other options are
+// ignored.
+func NewCredentialLibrary(storeId string, secretName string, opt ...Option) (*CredentialLibrary, error) {
+	opts := getOpts(opt...)
+	l := &CredentialLibrary{
+		CredentialLibrary: &store.CredentialLibrary{
+			StoreId:        storeId,
+			Name:           opts.withName,
+			Description:    opts.withDescription,
+			SecretName:     secretName,
+			SecretVersion:  opts.withSecretVersion,
+			CredentialType: string(opts.withCredentialType),
+		},
+	}
+	return l, nil
+}
+
+func allocCredentialLibrary() *CredentialLibrary {
+	return &CredentialLibrary{
+		CredentialLibrary: &store.CredentialLibrary{},
+	}
+}
+
+func (l *CredentialLibrary) clone() *CredentialLibrary {
+	cp := proto.Clone(l.CredentialLibrary)
+	return &CredentialLibrary{
+		CredentialLibrary: cp.(*store.CredentialLibrary),
+	}
+}
+
+// TableName returns the table name.
+func (l *CredentialLibrary) TableName() string {
+	if l.tableName != "" {
+		return l.tableName
+	}
+	return "credential_azure_library"
+}
+
+// SetTableName sets the table name.
+func (l *CredentialLibrary) SetTableName(n string) {
+	l.tableName = n
+}
+
+func (l *CredentialLibrary) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{l.PublicId},
+		"resource-type":      []string{"credential-azure-library"},
+		"op-type":            []string{op.String()},
+	}
+	if l.StoreId != "" {
+		metadata["store-id"] = []string{l.StoreId}
+	}
+	return metadata
+}
+
+// CredentialType returns the type of credential the library retrieves.
+func (l *CredentialLibrary) CredentialType() credential.Type {
+	switch ct := l.GetCredentialType(); ct {
+	case "":
+		return credential.UnspecifiedType
+	default:
+		return credential.Type(ct)
+	}
+}
+
+var _ credential.Library = (*CredentialLibrary)(nil)
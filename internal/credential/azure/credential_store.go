@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azure
+
+import (
+	"github.com/hashicorp/boundary/internal/credential/azure/store"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"google.golang.org/protobuf/proto"
+)
+
+// A CredentialStore contains credential libraries for the secrets of an
+// Azure Key Vault. It is owned by a project.
+type CredentialStore struct {
+	*store.CredentialStore
+	tableName string `gorm:"-"`
+}
+
+// NewCredentialStore creates a new in memory CredentialStore for the Azure
+// Key Vault at vaultUri assigned to projectId. Name, description and managed
+// identity client id are the only valid options. All other options are
+// ignored.
+func NewCredentialStore(projectId string, vaultUri string, opt ...Option) (*CredentialStore, error) {
+	opts := getOpts(opt...)
+	cs := &CredentialStore{
+		CredentialStore: &store.CredentialStore{
+			ProjectId:               projectId,
+			Name:                    opts.withName,
+			Description:             opts.withDescription,
+			VaultUri:                vaultUri,
+			ManagedIdentityClientId: opts.withManagedIdentityClientId,
+		},
+	}
+	return cs, nil
+}
+
+func allocCredentialStore() *CredentialStore {
+	return &CredentialStore{
+		CredentialStore: &store.CredentialStore{},
+	}
+}
+
+func (cs *CredentialStore) clone() *CredentialStore {
+	cp := proto.Clone(cs.CredentialStore)
+	return &CredentialStore{
+		CredentialStore: cp.(*store.CredentialStore),
+	}
+}
+
+// TableName returns the table name.
+func (cs *CredentialStore) TableName() string {
+	if cs.tableName != "" {
+		return cs.tableName
+	}
+	return "credential_azure_store"
+}
+
+// SetTableName sets the table name.
+func (cs *CredentialStore) SetTableName(n string) {
+	cs.tableName = n
+}
+
+func (cs *CredentialStore) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{cs.PublicId},
+		"resource-type":      []string{"credential-azure-store"},
+		"op-type":            []string{op.String()},
+	}
+	if cs.ProjectId != "" {
+		metadata["project-id"] = []string{cs.ProjectId}
+	}
+	return metadata
+}
@@ -0,0 +1,7 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package azure provides access to credentials retrieved from the secrets of
+// an Azure Key Vault. Boundary authenticates to Azure Key Vault with a
+// managed identity of the controller.
+package azure
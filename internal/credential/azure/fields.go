@@ -0,0 +1,14 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azure
+
+// These constants are the field names used in the azure related field masks.
+const (
+	nameField                    = "Name"
+	descriptionField             = "Description"
+	vaultUriField                = "VaultUri"
+	managedIdentityClientIdField = "ManagedIdentityClientId"
+	secretNameField              = "SecretName"
+	secretVersionField           = "SecretVersion"
+)
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azure
+
+import "github.com/hashicorp/boundary/internal/credential"
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withName                    string
+	withDescription             string
+	withLimit                   int
+	withManagedIdentityClientId string
+	withSecretVersion           string
+	withCredentialType          credential.Type
+}
+
+func getDefaultOptions() options {
+	return options{
+		withCredentialType: credential.UnspecifiedType,
+	}
+}
+
+// WithDescription provides an optional description.
+func WithDescription(desc string) Option {
+	return func(o *options) {
+		o.withDescription = desc
+	}
+}
+
+// WithName provides an optional name.
+func WithName(name string) Option {
+	return func(o *options) {
+		o.withName = name
+	}
+}
+
+// WithLimit provides an option to provide a limit. Intentionally allowing
+// negative integers. If WithLimit < 0, then unlimited results are
+// returned. If WithLimit == 0, then default limits are used for results.
+func WithLimit(l int) Option {
+	return func(o *options) {
+		o.withLimit = l
+	}
+}
+
+// WithManagedIdentityClientId provides an optional client id of the
+// user-assigned managed identity used to authenticate to Azure Key Vault.
+func WithManagedIdentityClientId(id string) Option {
+	return func(o *options) {
+		o.withManagedIdentityClientId = id
+	}
+}
+
+// WithSecretVersion provides an optional version of the secret a credential
+// library retrieves.
+func WithSecretVersion(version string) Option {
+	return func(o *options) {
+		o.withSecretVersion = version
+	}
+}
+
+// WithCredentialType provides an optional credential type to associate with
+// a credential library.
+func WithCredentialType(t credential.Type) Option {
+	return func(o *options) {
+		o.withCredentialType = t
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azure
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/types/subtypes"
+)
+
+func init() {
+	if err := subtypes.Register(credential.Domain, Subtype, globals.AzureCredentialStorePrefix); err != nil {
+		panic(err)
+	}
+	if err := subtypes.Register(credential.Domain, SecretLibrarySubtype, globals.AzureCredentialLibraryPrefix); err != nil {
+		panic(err)
+	}
+}
+
+// Subtypes of the resources in the azure package.
+const (
+	Subtype              = subtypes.Subtype("azure")
+	SecretLibrarySubtype = subtypes.Subtype("azure-secret")
+)
+
+func newCredentialStoreId(ctx context.Context) (string, error) {
+	id, err := db.NewPublicId(globals.AzureCredentialStorePrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, "azure.newCredentialStoreId")
+	}
+	return id, nil
+}
+
+func newCredentialLibraryId(ctx context.Context) (string, error) {
+	id, err := db.NewPublicId(globals.AzureCredentialLibraryPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, "azure.newCredentialLibraryId")
+	}
+	return id, nil
+}
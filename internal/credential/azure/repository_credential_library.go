@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/go-dbw"
+)
+
+// CreateCredentialLibrary inserts l into the repository and returns a new
+// CredentialLibrary containing the credential library's PublicId. l is not
+// changed. l must contain a valid StoreId and a SecretName. l must not
+// contain a PublicId. The PublicId is generated and assigned by this method.
+//
+// l.Name, l.Description and l.SecretVersion are optional. If l.Name is set,
+// it must be unique within l.StoreId. If l.SecretVersion is set, the library
+// always retrieves that version of the secret.
+//
+// Both l.CreateTime and l.UpdateTime are ignored.
+func (r *Repository) CreateCredentialLibrary(ctx context.Context, projectId string, l *CredentialLibrary, _ ...Option) (*CredentialLibrary, error) {
+	const op = "azure.(Repository).CreateCredentialLibrary"
+	if l == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil CredentialLibrary")
+	}
+	if l.CredentialLibrary == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil embedded l")
+	}
+	if l.StoreId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no store id")
+	}
+	if l.SecretName == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no secret name")
+	}
+	if l.PublicId != "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id not empty")
+	}
+	if projectId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no project id")
+	}
+	switch l.CredentialType() {
+	case credential.UnspecifiedType, credential.UsernamePasswordType, credential.SshPrivateKeyType:
+	default:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported credential type %q", l.CredentialType()))
+	}
+	l = l.clone()
+
+	id, err := newCredentialLibraryId(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	l.PublicId = id
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var newCredentialLibrary *CredentialLibrary
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			newCredentialLibrary = l.clone()
+			if err := w.Create(ctx, newCredentialLibrary,
+				db.WithOplog(oplogWrapper, newCredentialLibrary.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in credential store: %s: name %s already exists", l.StoreId, l.Name)))
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in credential store: %s", l.StoreId)))
+	}
+	return newCredentialLibrary, nil
+}
+
+// UpdateCredentialLibrary updates the repository entry for l.PublicId with
+// the values in l for the fields listed in fieldMaskPaths. It returns a
+// new CredentialLibrary containing the updated values and a count of the
+// number of records updated. l is not changed.
+//
+// l must contain a valid PublicId. Only Name, Description, SecretName and
+// SecretVersion can be changed. SecretName cannot be set to NULL. If l.Name
+// is set to a non-empty string, it must be unique within l.StoreId.
+//
+// An attribute of l will be set to NULL in the database if the attribute
+// in l is the zero value and it is included in fieldMaskPaths.
+func (r *Repository) UpdateCredentialLibrary(ctx context.Context, projectId string, l *CredentialLibrary, version uint32, fieldMaskPaths []string, _ ...Option) (*CredentialLibrary, int, error) {
+	const op = "azure.(Repository).UpdateCredentialLibrary"
+	if l == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing CredentialLibrary")
+	}
+	if l.CredentialLibrary == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing embedded CredentialLibrary")
+	}
+	if l.PublicId == "" {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	if version == 0 {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing version")
+	}
+	if projectId == "" {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing project id")
+	}
+	l = l.clone()
+
+	for _, f := range fieldMaskPaths {
+		switch {
+		case strings.EqualFold(nameField, f):
+		case strings.EqualFold(descriptionField, f):
+		case strings.EqualFold(secretNameField, f) && l.SecretName != "":
+		case strings.EqualFold(secretVersionField, f):
+		default:
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, f)
+		}
+	}
+	dbMask, nullFields := dbw.BuildUpdatePaths(
+		map[string]any{
+			nameField:          l.Name,
+			descriptionField:   l.Description,
+			secretNameField:    l.SecretName,
+			secretVersionField: l.SecretVersion,
+		},
+		fieldMaskPaths,
+		nil,
+	)
+	if len(dbMask) == 0 && len(nullFields) == 0 {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "missing field mask")
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected,
+			errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsUpdated int
+	var returnedCredentialLibrary *CredentialLibrary
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			returnedCredentialLibrary = l.clone()
+			var err error
+			rowsUpdated, err = w.Update(ctx, returnedCredentialLibrary,
+				dbMask, nullFields,
+				db.WithOplog(oplogWrapper, returnedCredentialLibrary.oplog(oplog.OpType_OP_TYPE_UPDATE)),
+				db.WithVersion(&version))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("name %s already exists: %s", l.Name, l.PublicId)))
+		}
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(l.PublicId))
+	}
+
+	return returnedCredentialLibrary, rowsUpdated, nil
+}
+
+// LookupCredentialLibrary returns the CredentialLibrary for publicId.
+// Returns nil, nil if no CredentialLibrary is found for publicId.
+func (r *Repository) LookupCredentialLibrary(ctx context.Context, publicId string, _ ...Option) (*CredentialLibrary, error) {
+	const op = "azure.(Repository).LookupCredentialLibrary"
+	if publicId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+	l := allocCredentialLibrary()
+	l.PublicId = publicId
+	if err := r.reader.LookupByPublicId(ctx, l); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", publicId)))
+	}
+	return l, nil
+}
+
+// DeleteCredentialLibrary deletes publicId from the repository and returns
+// the number of records deleted.
+func (r *Repository) DeleteCredentialLibrary(ctx context.Context, projectId string, publicId string, _ ...Option) (int, error) {
+	const op = "azure.(Repository).DeleteCredentialLibrary"
+	if publicId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+	if projectId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no project id")
+	}
+
+	l := allocCredentialLibrary()
+	l.PublicId = publicId
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsDeleted int
+	_, err = r.writer.DoTx(
+		ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) (err error) {
+			dl := l.clone()
+			rowsDeleted, err = w.Delete(ctx, dl, db.WithOplog(oplogWrapper, l.oplog(oplog.OpType_OP_TYPE_DELETE)))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsDeleted > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 CredentialLibrary would have been deleted")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("delete failed for %s", l.PublicId)))
+	}
+
+	return rowsDeleted, nil
+}
+
+// ListCredentialLibraries returns a slice of CredentialLibraries for the
+// storeId. WithLimit is the only option supported.
+func (r *Repository) ListCredentialLibraries(ctx context.Context, storeId string, opt ...Option) ([]*CredentialLibrary, error) {
+	const op = "azure.(Repository).ListCredentialLibraries"
+	if storeId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no storeId")
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	var libs []*CredentialLibrary
+	err := r.reader.SearchWhere(ctx, &libs, "store_id = ?", []any{storeId}, db.WithLimit(limit))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return libs, nil
+}
@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+var _ credential.Issuer = (*Repository)(nil)
+
+// Issue retrieves the secrets of the requested libraries from Azure Key
+// Vault for the session sessionId. The secrets are owned by Azure Key Vault,
+// so they are neither stored nor revoked by Boundary.
+//
+// The value of a secret is converted to the credential type of its library.
+// A value which is a JSON object is used as is, any other value is returned
+// as the "value" attribute of the secret data.
+func (r *Repository) Issue(ctx context.Context, sessionId string, requests []credential.Request, _ ...credential.Option) ([]credential.Dynamic, error) {
+	const op = "azure.(Repository).Issue"
+	if sessionId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no session id")
+	}
+	if len(requests) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no requests")
+	}
+
+	libIds := make([]string, 0, len(requests))
+	for _, req := range requests {
+		libIds = append(libIds, req.SourceId)
+	}
+	var libs []*CredentialLibrary
+	if err := r.reader.SearchWhere(ctx, &libs, "public_id in (?)", []any{libIds}); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	libsById := make(map[string]*CredentialLibrary, len(libs))
+	storeIds := make([]string, 0, len(libs))
+	for _, l := range libs {
+		libsById[l.GetPublicId()] = l
+		storeIds = append(storeIds, l.GetStoreId())
+	}
+	var stores []*CredentialStore
+	if err := r.reader.SearchWhere(ctx, &stores, "public_id in (?)", []any{storeIds}); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	storesById := make(map[string]*CredentialStore, len(stores))
+	for _, cs := range stores {
+		storesById[cs.GetPublicId()] = cs
+	}
+
+	clients := make(map[string]secretClient, len(stores))
+	creds := make([]credential.Dynamic, 0, len(requests))
+	for _, req := range requests {
+		lib, ok := libsById[req.SourceId]
+		if !ok {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("credential library %s not found", req.SourceId))
+		}
+		client, ok := clients[lib.GetStoreId()]
+		if !ok {
+			cs, ok := storesById[lib.GetStoreId()]
+			if !ok {
+				return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("credential store %s not found", lib.GetStoreId()))
+			}
+			var err error
+			client, err = r.newClient(ctx, cs)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create azure key vault client"))
+			}
+			clients[lib.GetStoreId()] = client
+		}
+
+		s, err := client.getSecret(ctx, lib.GetSecretName(), lib.GetSecretVersion())
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to retrieve secret for credential library %s", lib.GetPublicId())))
+		}
+		cred, err := convert(ctx, &baseCred{
+			id:         s.Id,
+			sessionId:  sessionId,
+			lib:        lib,
+			purpose:    req.Purpose,
+			secretData: secretData(s),
+		})
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		creds = append(creds, cred)
+	}
+	return creds, nil
+}
+
+var _ credential.Revoker = (*Repository)(nil)
+
+// Revoke is a no-op. The secrets issued from Azure Key Vault are not leases,
+// there is nothing to revoke when the session sessionId ends.
+func (r *Repository) Revoke(ctx context.Context, sessionId string) error {
+	const op = "azure.(Repository).Revoke"
+	if sessionId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "no session id")
+	}
+	return nil
+}
+
+// secretData returns the value of s as a JSON object.
+func secretData(s *secret) map[string]any {
+	var data map[string]any
+	if err := json.Unmarshal([]byte(s.Value), &data); err != nil || data == nil {
+		data = map[string]any{"value": s.Value}
+	}
+	return data
+}
+
+type baseCred struct {
+	id         string
+	sessionId  string
+	lib        *CredentialLibrary
+	purpose    credential.Purpose
+	secretData map[string]any
+}
+
+func (bc *baseCred) GetPublicId() string           { return bc.id }
+func (bc *baseCred) GetSessionId() string          { return bc.sessionId }
+func (bc *baseCred) Secret() credential.SecretData { return bc.secretData }
+func (bc *baseCred) Library() credential.Library   { return bc.lib }
+func (bc *baseCred) Purpose() credential.Purpose   { return bc.purpose }
+
+// stringAttribute returns the top level attribute name of the secret data
+// if it is a string.
+func (bc *baseCred) stringAttribute(name string) string {
+	s, _ := bc.secretData[name].(string)
+	return s
+}
+
+// convert converts bc to the credential type of its library if it is not
+// UnspecifiedType.
+func convert(ctx context.Context, bc *baseCred) (credential.Dynamic, error) {
+	const op = "azure.convert"
+	switch bc.lib.CredentialType() {
+	case credential.UsernamePasswordType:
+		c := &usrPassCred{
+			baseCred: bc,
+			username: bc.stringAttribute("username"),
+			password: credential.Password(bc.stringAttribute("password")),
+		}
+		if c.username == "" || c.password == "" {
+			return nil, errors.New(ctx, errors.InvalidParameter, op,
+				fmt.Sprintf("secret %s does not contain a username and a password", bc.lib.GetSecretName()))
+		}
+		return c, nil
+	case credential.SshPrivateKeyType:
+		c := &sshPrivateKeyCred{
+			baseCred:   bc,
+			username:   bc.stringAttribute("username"),
+			privateKey: credential.PrivateKey(bc.stringAttribute("private_key")),
+			passphrase: []byte(bc.stringAttribute("private_key_passphrase")),
+		}
+		if c.username == "" || len(c.privateKey) == 0 {
+			return nil, errors.New(ctx, errors.InvalidParameter, op,
+				fmt.Sprintf("secret %s does not contain a username and a private key", bc.lib.GetSecretName()))
+		}
+		return c, nil
+	}
+	return bc, nil
+}
+
+var _ credential.UsernamePassword = (*usrPassCred)(nil)
+
+type usrPassCred struct {
+	*baseCred
+	username string
+	password credential.Password
+}
+
+func (c *usrPassCred) Username() string              { return c.username }
+func (c *usrPassCred) Password() credential.Password { return c.password }
+
+var _ credential.SshPrivateKey = (*sshPrivateKeyCred)(nil)
+
+type sshPrivateKeyCred struct {
+	*baseCred
+	username   string
+	privateKey credential.PrivateKey
+	passphrase []byte
+}
+
+func (c *sshPrivateKeyCred) Username() string                  { return c.username }
+func (c *sshPrivateKeyCred) PrivateKey() credential.PrivateKey { return c.privateKey }
+func (c *sshPrivateKeyCred) PrivateKeyPassphrase() []byte      { return c.passphrase }
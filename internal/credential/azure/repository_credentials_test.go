@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/azure/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSecretClient returns the secrets of a map keyed by secret name.
+type fakeSecretClient map[string]string
+
+func (c fakeSecretClient) getSecret(_ context.Context, name, version string) (*secret, error) {
+	v, ok := c[name]
+	if !ok {
+		return nil, fmt.Errorf("secret %s not found", name)
+	}
+	return &secret{Id: fmt.Sprintf("%s/secrets/%s/%s", TestVaultUri, name, version), Value: v}, nil
+}
+
+func TestConvert(t *testing.T) {
+	ctx := context.Background()
+	lib := func(ct credential.Type) *CredentialLibrary {
+		return &CredentialLibrary{CredentialLibrary: &store.CredentialLibrary{SecretName: "secret", CredentialType: string(ct)}}
+	}
+	tests := []struct {
+		name     string
+		credType credential.Type
+		value    string
+		wantErr  bool
+	}{
+		{
+			name:     "unspecified-text",
+			credType: credential.UnspecifiedType,
+			value:    "plain",
+		},
+		{
+			name:     "username-password",
+			credType: credential.UsernamePasswordType,
+			value:    `{"username":"user","password":"pass"}`,
+		},
+		{
+			name:     "username-password-missing-password",
+			credType: credential.UsernamePasswordType,
+			value:    `{"username":"user"}`,
+			wantErr:  true,
+		},
+		{
+			name:     "ssh-private-key",
+			credType: credential.SshPrivateKeyType,
+			value:    `{"username":"user","private_key":"key","private_key_passphrase":"phrase"}`,
+		},
+		{
+			name:     "ssh-private-key-text",
+			credType: credential.SshPrivateKeyType,
+			value:    "key",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := convert(ctx, &baseCred{lib: lib(tt.credType), secretData: secretData(&secret{Value: tt.value})})
+			if tt.wantErr {
+				assert.Error(err)
+				return
+			}
+			require.NoError(err)
+			switch c := got.(type) {
+			case credential.UsernamePassword:
+				assert.Equal(credential.UsernamePasswordType, tt.credType)
+				assert.Equal("user", c.Username())
+				assert.Equal(credential.Password("pass"), c.Password())
+			case credential.SshPrivateKey:
+				assert.Equal(credential.SshPrivateKeyType, tt.credType)
+				assert.Equal("user", c.Username())
+				assert.Equal(credential.PrivateKey("key"), c.PrivateKey())
+				assert.Equal([]byte("phrase"), c.PrivateKeyPassphrase())
+			default:
+				assert.Equal(credential.UnspecifiedType, tt.credType)
+				assert.Equal(map[string]any{"value": "plain"}, c.Secret())
+			}
+		})
+	}
+}
+
+func TestRepository_Issue(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, prj.GetPublicId(), TestVaultUri)
+	libs := TestCredentialLibraries(t, conn, cs.GetPublicId(), 1)
+	upLibs := TestCredentialLibraries(t, conn, cs.GetPublicId(), 2, WithCredentialType(credential.UsernamePasswordType))
+
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+	repo.newClient = func(context.Context, *CredentialStore) (secretClient, error) {
+		return fakeSecretClient{
+			"test-secret-0": `{"username":"user","password":"pass"}`,
+			"test-secret-1": "not json",
+		}, nil
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.Issue(ctx, "s_1234567890", []credential.Request{
+			{SourceId: libs[0].GetPublicId(), Purpose: credential.BrokeredPurpose},
+			{SourceId: upLibs[0].GetPublicId(), Purpose: credential.InjectedApplicationPurpose},
+		})
+		require.NoError(err)
+		require.Len(got, 2)
+		assert.Equal(libs[0].GetPublicId(), got[0].Library().GetPublicId())
+		assert.Equal(credential.BrokeredPurpose, got[0].Purpose())
+		assert.Equal("s_1234567890", got[0].GetSessionId())
+		up, ok := got[1].(credential.UsernamePassword)
+		require.True(ok)
+		assert.Equal("user", up.Username())
+		assert.Equal(credential.InjectedApplicationPurpose, got[1].Purpose())
+	})
+
+	t.Run("invalid-mapping", func(t *testing.T) {
+		_, err := repo.Issue(ctx, "s_1234567890", []credential.Request{
+			{SourceId: upLibs[1].GetPublicId(), Purpose: credential.BrokeredPurpose},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("unknown-library", func(t *testing.T) {
+		_, err := repo.Issue(ctx, "s_1234567890", []credential.Request{
+			{SourceId: "clazr_1234567890", Purpose: credential.BrokeredPurpose},
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestRepository_Revoke(t *testing.T) {
+	ctx := context.Background()
+	r := &Repository{}
+	require.Error(t, r.Revoke(ctx, ""))
+	assert.NoError(t, r.Revoke(ctx, "s_1234567890"))
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/stretchr/testify/require"
+)
+
+// TestVaultUri is the uri of the Azure Key Vault used by the test
+// credential stores.
+const TestVaultUri = "https://boundary-test.vault.azure.net"
+
+// TestCredentialStore creates an azure credential store in the provided DB
+// with the provided project id and vault uri. If any errors are encountered
+// during the creation of the store, the test will fail.
+func TestCredentialStore(t testing.TB, conn *db.DB, projectId, vaultUri string, opts ...Option) *CredentialStore {
+	t.Helper()
+	ctx := context.Background()
+	w := db.New(conn)
+
+	cs, err := NewCredentialStore(projectId, vaultUri, opts...)
+	require.NoError(t, err)
+	id, err := newCredentialStoreId(ctx)
+	require.NoError(t, err)
+	cs.PublicId = id
+
+	require.NoError(t, w.Create(ctx, cs))
+	return cs
+}
+
+// TestCredentialLibraries creates count number of azure credential
+// libraries in the provided DB with the provided store id. The libraries
+// retrieve the secrets test-secret-0 to test-secret-<count-1>. If any errors
+// are encountered during the creation of the libraries, the test will fail.
+func TestCredentialLibraries(t testing.TB, conn *db.DB, storeId string, count int, opts ...Option) []*CredentialLibrary {
+	t.Helper()
+	ctx := context.Background()
+	w := db.New(conn)
+
+	libs := make([]*CredentialLibrary, 0, count)
+	for i := 0; i < count; i++ {
+		lib, err := NewCredentialLibrary(storeId, fmt.Sprintf("test-secret-%d", i), opts...)
+		require.NoError(t, err)
+		id, err := newCredentialLibraryId(ctx)
+		require.NoError(t, err)
+		lib.PublicId = id
+
+		require.NoError(t, w.Create(ctx, lib))
+		libs = append(libs, lib)
+	}
+	return libs
+}
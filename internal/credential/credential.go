@@ -35,6 +35,7 @@ const (
 	SshPrivateKeyType    Type = "ssh_private_key"
 	SshCertificateType   Type = "ssh_certificate"
 	JsonType             Type = "json"
+	X509CertificateType  Type = "x509_certificate"
 )
 
 // A Library is a resource that provides credentials that are of the same
@@ -87,6 +88,14 @@ type Dynamic interface {
 	Purpose() Purpose
 }
 
+// SecretMetadata is a dynamic credential that carries metadata about the
+// secret it was created from, such as the creation time of a versioned
+// secret.
+type SecretMetadata interface {
+	Dynamic
+	SecretMetadata() map[string]any
+}
+
 // Static is a static credential that is stored directly in a credential store.
 type Static interface {
 	boundary.Resource
@@ -122,12 +131,35 @@ type Issuer interface {
 	Issue(ctx context.Context, sessionId string, requests []Request, opt ...Option) ([]Dynamic, error)
 }
 
-// Revoker revokes dynamic credentials.
+// Revoker revokes dynamic credentials. Every dynamic credential subtype
+// implements Revoker.
 type Revoker interface {
 	// Revoke revokes the dynamic credentials issued for sessionid.
 	Revoke(ctx context.Context, sessionId string) error
 }
 
+// PendingRevoker revokes the dynamic credentials of a subtype that are set
+// for revocation in the system which issued them. A RevocationJob uses a
+// PendingRevoker to revoke credentials and to retry failed revocations.
+type PendingRevoker interface {
+	// PendingRevocations returns the public ids of up to limit credentials
+	// which are set for revocation and are due for a revocation attempt. A
+	// credential is due if it has no row in
+	// credential_dynamic_revocation_attempt or the next_attempt_time of its
+	// row has passed.
+	PendingRevocations(ctx context.Context, limit int) ([]string, error)
+
+	// RevokeCredential revokes the credential in the system which issued it
+	// and sets the credential as revoked.
+	RevokeCredential(ctx context.Context, credentialId string) error
+
+	// FailRevocation sets the credential as failed to be revoked. It is
+	// called when the credential cannot be revoked within the maximum number
+	// of attempts. The credential must no longer be returned by
+	// PendingRevocations.
+	FailRevocation(ctx context.Context, credentialId string) error
+}
+
 // Password represents a secret password.
 type Password string
 
@@ -154,3 +186,12 @@ type SshPrivateKey interface {
 	PrivateKey() PrivateKey
 	PrivateKeyPassphrase() []byte
 }
+
+// X509Certificate is a credential containing an X.509 client certificate and
+// its private key, both PEM encoded. The certificate may be followed by the
+// intermediate certificates of its chain.
+type X509Certificate interface {
+	Credential
+	Certificate() []byte
+	PrivateKey() PrivateKey
+}
@@ -26,7 +26,9 @@ type Option func(*options) error
 
 // options = how options are represented
 type options struct {
-	WithTemplateData template.Data
+	WithTemplateData          template.Data
+	WithLimit                 int
+	WithMaxRevocationAttempts int
 }
 
 func getDefaultOptions() *options {
@@ -40,3 +42,22 @@ func WithTemplateData(with template.Data) Option {
 		return nil
 	}
 }
+
+// WithLimit provides an option to provide a limit. Intentionally allowing
+// negative integers. If WithLimit < 0, then unlimited results are returned.
+// If WithLimit == 0, then default limits are used for results.
+func WithLimit(l int) Option {
+	return func(o *options) error {
+		o.WithLimit = l
+		return nil
+	}
+}
+
+// WithMaxRevocationAttempts provides the number of failed attempts to revoke
+// a dynamic credential after which the revocation is recorded as failed.
+func WithMaxRevocationAttempts(n int) Option {
+	return func(o *options) error {
+		o.WithMaxRevocationAttempts = n
+		return nil
+	}
+}
@@ -21,4 +21,18 @@ func Test_GetOpts(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, "foo", *opts.WithTemplateData.User.Id)
 	})
+	t.Run("WithLimit", func(t *testing.T) {
+		opts := getDefaultOptions()
+		assert.Zero(t, opts.WithLimit)
+		opts, err := GetOpts(WithLimit(5))
+		require.NoError(t, err)
+		assert.Equal(t, 5, opts.WithLimit)
+	})
+	t.Run("WithMaxRevocationAttempts", func(t *testing.T) {
+		opts := getDefaultOptions()
+		assert.Zero(t, opts.WithMaxRevocationAttempts)
+		opts, err := GetOpts(WithMaxRevocationAttempts(3))
+		require.NoError(t, err)
+		assert.Equal(t, 3, opts.WithMaxRevocationAttempts)
+	})
 }
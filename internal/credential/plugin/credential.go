@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/credential/plugin/store"
+	"github.com/hashicorp/boundary/internal/db/sanitize"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// CredentialStatus represents the status of a plugin credential stored in
+// the repository.
+type CredentialStatus string
+
+const (
+	// ActiveCredential represents a credential that is being used in an
+	// active session.
+	ActiveCredential CredentialStatus = "active"
+
+	// RevokeCredential represents a credential that needs to be revoked by
+	// the plugin of its store.
+	RevokeCredential CredentialStatus = "revoke"
+
+	// RevokedCredential represents a credential that has been revoked. This
+	// is a terminal status.
+	RevokedCredential CredentialStatus = "revoked"
+
+	// ExpiredCredential represents a credential that expired. This is a
+	// terminal status.
+	ExpiredCredential CredentialStatus = "expired"
+
+	// UnknownCredentialStatus represents a credential that has an unknown
+	// status.
+	UnknownCredentialStatus CredentialStatus = "unknown"
+
+	// RevokeFailedCredential represents a credential that could not be
+	// revoked within the maximum number of revocation attempts. This is a
+	// terminal status.
+	RevokeFailedCredential CredentialStatus = "revoke_failed"
+)
+
+// A Credential contains the data of a revocable credential issued by the
+// plugin of a credential store. It is owned by a credential library.
+type Credential struct {
+	*store.Credential
+	tableName string `gorm:"-"`
+}
+
+func newCredential(ctx context.Context, storeId, libraryId, sessionId, externalId string, expiration time.Duration) (*Credential, error) {
+	const op = "plugin.newCredential"
+	switch {
+	case storeId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no store id")
+	case libraryId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no library id")
+	case sessionId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no session id")
+	}
+	externalId = sanitize.String(externalId)
+	if externalId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no external id")
+	}
+
+	c := &Credential{
+		Credential: &store.Credential{
+			StoreId:     storeId,
+			LibraryId:   libraryId,
+			SessionId:   sessionId,
+			ExternalId:  externalId,
+			IsRevocable: true,
+			Status:      string(ActiveCredential),
+		},
+	}
+	if expiration > 0 {
+		c.ExpirationTime = timestamp.New(time.Now().Add(expiration.Round(time.Second)))
+	}
+	return c, nil
+}
+
+func allocCredential() *Credential {
+	return &Credential{
+		Credential: &store.Credential{},
+	}
+}
+
+func (c *Credential) clone() *Credential {
+	cp := proto.Clone(c.Credential)
+	return &Credential{
+		Credential: cp.(*store.Credential),
+	}
+}
+
+// TableName returns the table name.
+func (c *Credential) TableName() string {
+	if c.tableName != "" {
+		return c.tableName
+	}
+	return "credential_plugin_credential"
+}
+
+// SetTableName sets the table name.
+func (c *Credential) SetTableName(n string) {
+	c.tableName = n
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/boundary/internal/credential/plugin/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/libs/crypto"
+	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// A CredentialStore contains credential libraries which issue credentials
+// from the plugin of the store. It is owned by a project.
+type CredentialStore struct {
+	*store.CredentialStore
+	tableName string `gorm:"-"`
+
+	// Secrets are passed to the plugin when the store is created or
+	// updated. They are never stored, only the data the plugin returns to
+	// persist is.
+	Secrets *structpb.Struct `gorm:"-"`
+}
+
+// NewCredentialStore creates a new in memory CredentialStore for the plugin
+// pluginId assigned to projectId. Name, description, attributes and secrets
+// are the only valid options. All other options are ignored.
+func NewCredentialStore(ctx context.Context, projectId, pluginId string, opt ...Option) (*CredentialStore, error) {
+	const op = "plugin.NewCredentialStore"
+	opts := getOpts(opt...)
+
+	attrs, err := proto.Marshal(opts.withAttributes)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter))
+	}
+
+	cs := &CredentialStore{
+		CredentialStore: &store.CredentialStore{
+			ProjectId:   projectId,
+			PluginId:    pluginId,
+			Name:        opts.withName,
+			Description: opts.withDescription,
+			Attributes:  attrs,
+		},
+		Secrets: opts.withSecrets,
+	}
+	return cs, nil
+}
+
+func allocCredentialStore() *CredentialStore {
+	return &CredentialStore{
+		CredentialStore: &store.CredentialStore{},
+	}
+}
+
+// clone provides a deep copy of the CredentialStore with the exception of
+// the secrets. The secrets are shallow copied.
+func (cs *CredentialStore) clone() *CredentialStore {
+	cp := proto.Clone(cs.CredentialStore)
+	return &CredentialStore{
+		CredentialStore: cp.(*store.CredentialStore),
+		Secrets:         cs.Secrets,
+	}
+}
+
+// TableName returns the table name.
+func (cs *CredentialStore) TableName() string {
+	if cs.tableName != "" {
+		return cs.tableName
+	}
+	return "credential_plugin_store"
+}
+
+// SetTableName sets the table name.
+func (cs *CredentialStore) SetTableName(n string) {
+	cs.tableName = n
+}
+
+func (cs *CredentialStore) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{cs.PublicId},
+		"resource-type":      []string{"credential-plugin-store"},
+		"op-type":            []string{op.String()},
+	}
+	if cs.ProjectId != "" {
+		metadata["project-id"] = []string{cs.ProjectId}
+	}
+	return metadata
+}
+
+// hmacSecrets sets SecretsHmac to an hmac of the secrets of the store, or
+// clears it if the store has no secrets.
+func (cs *CredentialStore) hmacSecrets(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "plugin.(CredentialStore).hmacSecrets"
+	secretsMap := cs.Secrets.AsMap()
+	if len(secretsMap) == 0 {
+		cs.SecretsHmac = nil
+		return nil
+	}
+	if cipher == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing cipher")
+	}
+	// Go's JSON encoding is stable (that is, it alphabetizes keys) so it's a
+	// good option to produce an HMAC-able string.
+	jsonSecrets, err := json.Marshal(secretsMap)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	hm, err := crypto.HmacSha256(ctx, jsonSecrets, cipher, []byte(cs.PublicId), nil)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	cs.SecretsHmac = []byte(hm)
+	return nil
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/credential/plugin/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/hashicorp/go-kms-wrapping/v2/extras/structwrapping"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// CredentialStoreSecret contains the encrypted data the plugin of a
+// credential store persists between calls. It is owned by a
+// CredentialStore.
+type CredentialStoreSecret struct {
+	*store.CredentialStoreSecret
+	tableName string `gorm:"-"`
+}
+
+// newCredentialStoreSecret creates an in memory credential store secret.
+// All options are ignored.
+func newCredentialStoreSecret(ctx context.Context, storeId string, secret *structpb.Struct, _ ...Option) (*CredentialStoreSecret, error) {
+	const op = "plugin.newCredentialStoreSecret"
+	css := &CredentialStoreSecret{
+		CredentialStoreSecret: &store.CredentialStoreSecret{
+			StoreId: storeId,
+		},
+	}
+
+	if secret != nil {
+		data, err := proto.Marshal(secret)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter))
+		}
+		css.Secret = data
+	}
+	return css, nil
+}
+
+func allocCredentialStoreSecret() *CredentialStoreSecret {
+	return &CredentialStoreSecret{
+		CredentialStoreSecret: &store.CredentialStoreSecret{},
+	}
+}
+
+func (s *CredentialStoreSecret) clone() *CredentialStoreSecret {
+	cp := proto.Clone(s.CredentialStoreSecret)
+	return &CredentialStoreSecret{
+		CredentialStoreSecret: cp.(*store.CredentialStoreSecret),
+	}
+}
+
+// TableName returns the table name.
+func (s *CredentialStoreSecret) TableName() string {
+	if s.tableName != "" {
+		return s.tableName
+	}
+	return "credential_plugin_store_secret"
+}
+
+// SetTableName sets the table name.
+func (s *CredentialStoreSecret) SetTableName(n string) {
+	s.tableName = n
+}
+
+func (s *CredentialStoreSecret) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "plugin.(CredentialStoreSecret).encrypt"
+	if len(s.Secret) == 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, "no secret defined")
+	}
+	if err := structwrapping.WrapStruct(ctx, cipher, s.CredentialStoreSecret, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	var err error
+	s.KeyId, err = cipher.KeyId(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to discover wrapper key id"))
+	}
+	s.Secret = nil
+	return nil
+}
+
+func (s *CredentialStoreSecret) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "plugin.(CredentialStoreSecret).decrypt"
+	if err := structwrapping.UnwrapStruct(ctx, cipher, s.CredentialStoreSecret, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	s.CtSecret = nil
+	return nil
+}
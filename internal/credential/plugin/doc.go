@@ -0,0 +1,9 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package plugin provides credential stores and credential libraries which
+// issue credentials from an external credential provider, such as CyberArk
+// Conjur, through a credential plugin. Credential plugins implement the
+// CredentialPluginService gRPC service and are run out of process, so a
+// provider can be integrated without being compiled into Boundary.
+package plugin
@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+// These constants are the field names used in the plugin related field masks.
+const (
+	nameField        = "Name"
+	descriptionField = "Description"
+	attributesField  = "Attributes"
+	secretsField     = "Secrets"
+	secretsHmacField = "SecretsHmac"
+)
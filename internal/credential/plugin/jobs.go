@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+)
+
+const credentialRevocationJobName = "plugin_credential_revocation"
+
+// RegisterJobs registers the jobs of the plugin credential package with
+// the scheduler. plgm is a map from plugin resource id to credential plugin
+// client.
+func RegisterJobs(ctx context.Context, scheduler *scheduler.Scheduler, r db.Reader, w db.Writer, kms *kms.Kms, plgm map[string]plgpb.CredentialPluginServiceClient) error {
+	const op = "plugin.RegisterJobs"
+	credRevoke, err := newCredentialRevocationJob(ctx, r, w, kms, plgm)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if err = scheduler.RegisterJob(ctx, credRevoke); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("credential revocation job"))
+	}
+	return nil
+}
+
+// CredentialRevocationJob is the recurring job that revokes plugin
+// credentials that are no longer being used by an active or pending
+// session. It is a credential.RevocationJob which uses itself as the
+// credential.PendingRevoker for plugin credentials.
+// The CredentialRevocationJob is not thread safe, an attempt to Run the job
+// concurrently will result in an JobAlreadyRunning error.
+type CredentialRevocationJob struct {
+	*credential.RevocationJob
+	repo   *Repository
+	reader db.Reader
+	writer db.Writer
+}
+
+var _ credential.PendingRevoker = (*CredentialRevocationJob)(nil)
+
+// newCredentialRevocationJob creates a new in-memory CredentialRevocationJob.
+//
+// WithLimit is the only supported option.
+func newCredentialRevocationJob(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms, plgm map[string]plgpb.CredentialPluginServiceClient, opt ...Option) (*CredentialRevocationJob, error) {
+	const op = "plugin.newCredentialRevocationJob"
+	repo, err := NewRepository(ctx, r, w, kms, plgm)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	opts := getOpts(opt...)
+	if opts.withLimit == 0 {
+		// zero signals the boundary defaults should be used.
+		opts.withLimit = db.DefaultLimit
+	}
+	j := &CredentialRevocationJob{
+		repo:   repo,
+		reader: r,
+		writer: w,
+	}
+	j.RevocationJob, err = credential.NewRevocationJob(ctx, w, credentialRevocationJobName,
+		"Periodically revokes plugin credentials that are no longer in use and have been set for revocation (in the revoke state).",
+		j, credential.WithLimit(opts.withLimit))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return j, nil
+}
+
+// PendingRevocations returns the public ids of up to limit credentials in the
+// revoke state which are due for a revocation attempt.
+func (r *CredentialRevocationJob) PendingRevocations(ctx context.Context, limit int) ([]string, error) {
+	const op = "plugin.(CredentialRevocationJob).PendingRevocations"
+	var creds []*Credential
+	err := r.reader.SearchWhere(ctx, &creds, pendingRevocationWhere, []any{RevokeCredential}, db.WithLimit(limit))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	ids := make([]string, 0, len(creds))
+	for _, c := range creds {
+		ids = append(ids, c.GetPublicId())
+	}
+	return ids, nil
+}
+
+// RevokeCredential revokes the credential with the plugin of its credential
+// store and sets the credential to the revoked state.
+func (r *CredentialRevocationJob) RevokeCredential(ctx context.Context, credentialId string) error {
+	const op = "plugin.(CredentialRevocationJob).RevokeCredential"
+	c := allocCredential()
+	c.PublicId = credentialId
+	if err := r.reader.LookupByPublicId(ctx, c); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	cs, persisted, err := r.repo.getCredentialStore(ctx, c.GetStoreId())
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	client, err := r.repo.pluginClient(ctx, cs.GetPluginId())
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	plgCs, err := toPluginStore(ctx, cs)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if _, err := client.RevokeCredential(ctx, &plgpb.RevokeCredentialRequest{
+		Store:      plgCs,
+		ExternalId: c.GetExternalId(),
+		Persisted:  persisted,
+	}); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to revoke credential"))
+	}
+	if err := r.updateStatus(ctx, credentialId, RevokedCredential); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("credential revoked but failed to update repo"))
+	}
+	return nil
+}
+
+// FailRevocation sets the credential to the revoke failed state.
+func (r *CredentialRevocationJob) FailRevocation(ctx context.Context, credentialId string) error {
+	const op = "plugin.(CredentialRevocationJob).FailRevocation"
+	if err := r.updateStatus(ctx, credentialId, RevokeFailedCredential); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+func (r *CredentialRevocationJob) updateStatus(ctx context.Context, credentialId string, status CredentialStatus) error {
+	const op = "plugin.(CredentialRevocationJob).updateStatus"
+	numRows, err := r.writer.Exec(ctx, updateCredentialStatusQuery, []any{status, credentialId})
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if numRows != 1 {
+		return errors.New(ctx, errors.Unknown, op, "failed to update credential status")
+	}
+	return nil
+}
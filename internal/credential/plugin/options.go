@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"github.com/hashicorp/boundary/internal/credential"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withName           string
+	withDescription    string
+	withLimit          int
+	withAttributes     *structpb.Struct
+	withSecrets        *structpb.Struct
+	withCredentialType credential.Type
+}
+
+func getDefaultOptions() options {
+	return options{
+		withAttributes:     &structpb.Struct{},
+		withCredentialType: credential.UnspecifiedType,
+	}
+}
+
+// WithDescription provides an optional description.
+func WithDescription(desc string) Option {
+	return func(o *options) {
+		o.withDescription = desc
+	}
+}
+
+// WithName provides an optional name.
+func WithName(name string) Option {
+	return func(o *options) {
+		o.withName = name
+	}
+}
+
+// WithLimit provides an option to provide a limit. Intentionally allowing
+// negative integers. If WithLimit < 0, then unlimited results are
+// returned. If WithLimit == 0, then default limits are used for results.
+func WithLimit(l int) Option {
+	return func(o *options) {
+		o.withLimit = l
+	}
+}
+
+// WithAttributes provides optional attributes which are passed to the
+// plugin of a credential store.
+func WithAttributes(attrs *structpb.Struct) Option {
+	return func(o *options) {
+		o.withAttributes = attrs
+	}
+}
+
+// WithSecrets provides optional secrets which are passed to the plugin when
+// a credential store is created or updated.
+func WithSecrets(secrets *structpb.Struct) Option {
+	return func(o *options) {
+		o.withSecrets = secrets
+	}
+}
+
+// WithCredentialType provides an optional credential type to associate with
+// a credential library.
+func WithCredentialType(t credential.Type) Option {
+	return func(o *options) {
+		o.withCredentialType = t
+	}
+}
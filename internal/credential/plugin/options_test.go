@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func Test_GetOpts(t *testing.T) {
+	t.Parallel()
+	t.Run("WithName", func(t *testing.T) {
+		opts := getOpts(WithName("test"))
+		testOpts := getDefaultOptions()
+		testOpts.withName = "test"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithDescription", func(t *testing.T) {
+		opts := getOpts(WithDescription("test desc"))
+		testOpts := getDefaultOptions()
+		testOpts.withDescription = "test desc"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithLimit", func(t *testing.T) {
+		opts := getOpts(WithLimit(5))
+		testOpts := getDefaultOptions()
+		testOpts.withLimit = 5
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithAttributes", func(t *testing.T) {
+		attrs := &structpb.Struct{Fields: map[string]*structpb.Value{"foo": structpb.NewStringValue("bar")}}
+		opts := getOpts(WithAttributes(attrs))
+		testOpts := getDefaultOptions()
+		testOpts.withAttributes = attrs
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithSecrets", func(t *testing.T) {
+		secrets := &structpb.Struct{Fields: map[string]*structpb.Value{"api_key": structpb.NewStringValue("secret")}}
+		opts := getOpts(WithSecrets(secrets))
+		testOpts := getDefaultOptions()
+		testOpts.withSecrets = secrets
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithCredentialType", func(t *testing.T) {
+		opts := getOpts(WithCredentialType(credential.UsernamePasswordType))
+		testOpts := getDefaultOptions()
+		testOpts.withCredentialType = credential.UsernamePasswordType
+		assert.Equal(t, opts, testOpts)
+	})
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/types/subtypes"
+)
+
+func init() {
+	if err := subtypes.Register(credential.Domain, Subtype, globals.PluginCredentialStorePrefix, DynamicCredentialPrefix); err != nil {
+		panic(err)
+	}
+	if err := subtypes.Register(credential.Domain, GenericLibrarySubtype, globals.PluginCredentialLibraryPrefix); err != nil {
+		panic(err)
+	}
+}
+
+// PublicId prefixes for the resources in the plugin package.
+const (
+	// DynamicCredentialPrefix is the prefix for plugin dynamic credentials
+	DynamicCredentialPrefix = "cdplg"
+
+	Subtype               = subtypes.Subtype("plugin")
+	GenericLibrarySubtype = subtypes.Subtype("plugin-generic")
+)
+
+func newCredentialStoreId(ctx context.Context) (string, error) {
+	id, err := db.NewPublicId(globals.PluginCredentialStorePrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, "plugin.newCredentialStoreId")
+	}
+	return id, nil
+}
+
+func newCredentialLibraryId(ctx context.Context) (string, error) {
+	id, err := db.NewPublicId(globals.PluginCredentialLibraryPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, "plugin.newCredentialLibraryId")
+	}
+	return id, nil
+}
+
+func newCredentialId(ctx context.Context) (string, error) {
+	id, err := db.NewPublicId(DynamicCredentialPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, "plugin.newCredentialId")
+	}
+	return id, nil
+}
@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+const (
+	updateSessionCredentialQuery = `
+update session_credential_dynamic
+   set credential_id = @public_id
+ where library_id = @library_id
+   and session_id = @session_id
+   and credential_purpose = @purpose
+   and credential_id is null
+returning *;
+`
+
+	revokeCredentialsQuery = `
+update credential_plugin_credential
+   set status = 'revoke'
+ where session_id = ?
+   and status = 'active';
+`
+
+	updateCredentialStatusQuery = `
+update credential_plugin_credential
+   set status = ?
+ where public_id = ?;
+`
+
+	pendingRevocationWhere = `
+status = ?
+and public_id not in (
+  select credential_id from credential_dynamic_revocation_attempt
+   where next_attempt_time > now()
+)
+`
+)
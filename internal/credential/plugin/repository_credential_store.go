@@ -0,0 +1,595 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/libs/patchstruct"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/util"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentialstores"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"github.com/hashicorp/go-dbw"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// normalizeStoreAttributes allows a plugin to normalize attributes before
+// they are saved
+func normalizeStoreAttributes(ctx context.Context, plgClient plgpb.CredentialPluginServiceClient, plgCs *pb.CredentialStore) error {
+	const op = "plugin.(Repository).normalizeStoreAttributes"
+	switch {
+	case util.IsNil(plgClient):
+		return errors.New(ctx, errors.InvalidParameter, op, "plugin client is nil")
+	case plgCs == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "credential store is nil")
+	case plgCs.GetAttributes() == nil:
+		return nil
+	}
+
+	ret, err := plgClient.NormalizeStoreData(ctx, &plgpb.NormalizeStoreDataRequest{
+		Attributes: plgCs.GetAttributes(),
+	})
+	switch {
+	case err == nil:
+		if ret.Attributes != nil {
+			plgCs.Attrs = &pb.CredentialStore_Attributes{
+				Attributes: ret.Attributes,
+			}
+		}
+	case status.Code(err) == codes.Unimplemented:
+		// Do nothing
+	default:
+		return errors.Wrap(ctx, err, op, errors.WithMsg("error asking plugin to normalize credential store data"))
+	}
+
+	return nil
+}
+
+// CreateCredentialStore inserts cs into the repository and returns a new
+// CredentialStore containing the credential store's PublicId. cs is not
+// changed. cs must not contain a PublicId. The PublicId is generated and
+// assigned by this method. cs must contain a valid ProjectId and PluginId.
+//
+// cs.Name, cs.Description, cs.Attributes and cs.Secrets are optional. If
+// cs.Name is set, it must be unique within cs.ProjectId. cs.Secrets are
+// passed to the plugin and are not stored, the data the plugin returns to
+// persist is stored encrypted but not included in the returned
+// CredentialStore. Both cs.CreateTime and cs.UpdateTime are ignored.
+func (r *Repository) CreateCredentialStore(ctx context.Context, cs *CredentialStore, _ ...Option) (*CredentialStore, error) {
+	const op = "plugin.(Repository).CreateCredentialStore"
+	if cs == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing CredentialStore")
+	}
+	if cs.CredentialStore == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing embedded CredentialStore")
+	}
+	if cs.ProjectId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing project id")
+	}
+	if cs.PluginId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing plugin id")
+	}
+	if cs.PublicId != "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id not empty")
+	}
+
+	cs = cs.clone()
+	id, err := newCredentialStoreId(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	cs.PublicId = id
+
+	// Use PatchBytes' functionality that does not add keys where the values
+	// are nil to the resulting struct since we do not want to store nil valued
+	// attributes.
+	cs.Attributes, err = patchstruct.PatchBytes([]byte{}, cs.Attributes)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	databaseWrapper, err := r.kms.GetWrapper(ctx, cs.ProjectId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := cs.hmacSecrets(ctx, databaseWrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("error hmac'ing passed-in secrets"))
+	}
+
+	plgClient, err := r.pluginClient(ctx, cs.GetPluginId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	plgCs, err := toPluginStore(ctx, cs)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if err := normalizeStoreAttributes(ctx, plgClient, plgCs); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if cs.Attributes, err = proto.Marshal(plgCs.GetAttributes()); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, cs.ProjectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	// If the call to the plugin succeeded, we do not want to call it again if
+	// the transaction failed and is being retried.
+	var pluginCalledSuccessfully bool
+	var plgResp *plgpb.OnCreateStoreResponse
+
+	var newCredentialStore *CredentialStore
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			msgs := make([]*oplog.Message, 0, 2)
+			ticket, err := w.GetTicket(ctx, cs)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+			}
+
+			newCredentialStore = cs.clone()
+			var csOplogMsg oplog.Message
+			if err := w.Create(ctx, newCredentialStore, db.NewOplogMsg(&csOplogMsg)); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			msgs = append(msgs, &csOplogMsg)
+
+			if !pluginCalledSuccessfully {
+				plgResp, err = plgClient.OnCreateStore(ctx, &plgpb.OnCreateStoreRequest{
+					Store:   plgCs,
+					Secrets: cs.Secrets,
+				})
+				if err != nil && status.Code(err) != codes.Unimplemented {
+					return errors.Wrap(ctx, err, op)
+				}
+				pluginCalledSuccessfully = true
+			}
+
+			if len(plgResp.GetPersisted().GetSecrets().GetFields()) > 0 {
+				secret, err := newCredentialStoreSecret(ctx, id, plgResp.GetPersisted().GetSecrets())
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				if err := secret.encrypt(ctx, databaseWrapper); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				var sOplogMsg oplog.Message
+				if err := w.Create(ctx, secret, db.NewOplogMsg(&sOplogMsg)); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				msgs = append(msgs, &sOplogMsg)
+			}
+
+			metadata := cs.oplog(oplog.OpType_OP_TYPE_CREATE)
+			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, metadata, msgs); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in project: %s: name %s already exists", cs.ProjectId, cs.Name)))
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in project: %s", cs.ProjectId)))
+	}
+
+	newCredentialStore.Secrets = nil
+	return newCredentialStore, nil
+}
+
+// LookupCredentialStore returns the CredentialStore for publicId. Returns
+// nil, nil if no CredentialStore is found for publicId.
+func (r *Repository) LookupCredentialStore(ctx context.Context, publicId string, _ ...Option) (*CredentialStore, error) {
+	const op = "plugin.(Repository).LookupCredentialStore"
+	if publicId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+	cs := allocCredentialStore()
+	cs.PublicId = publicId
+	if err := r.reader.LookupByPublicId(ctx, cs); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", publicId)))
+	}
+	return cs, nil
+}
+
+// UpdateCredentialStore updates the repository entry for cs.PublicId with
+// the values in cs for the fields listed in fieldMaskPaths. It returns a
+// new CredentialStore containing the updated values and a count of the
+// number of records updated. cs is not changed.
+//
+// cs must contain a valid PublicId. Only Name, Description, Attributes and
+// Secrets can be changed. If cs.Name is set to a non-empty string, it must
+// be unique within cs.ProjectId. Attributes are patched into the current
+// attributes of the store, a key set to null is removed.
+//
+// Updates are sent to the OnUpdateStore hook of the plugin along with any
+// secrets included in cs. The update is aborted if the hook fails.
+func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialStore, version uint32, fieldMaskPaths []string, _ ...Option) (*CredentialStore, int, error) {
+	const op = "plugin.(Repository).UpdateCredentialStore"
+	if cs == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing CredentialStore")
+	}
+	if cs.CredentialStore == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing embedded CredentialStore")
+	}
+	if cs.PublicId == "" {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	if version == 0 {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing version")
+	}
+	if len(fieldMaskPaths) == 0 {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "missing field mask")
+	}
+
+	currentStore, currentPersisted, err := r.getCredentialStore(ctx, cs.PublicId)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("credential store %s not found", cs.PublicId))
+		}
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	if currentStore.GetVersion() != version {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.VersionMismatch, op, fmt.Sprintf("credential store version mismatch, want=%d, got=%d", currentStore.GetVersion(), version))
+	}
+
+	databaseWrapper, err := r.kms.GetWrapper(ctx, currentStore.ProjectId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+
+	newStore := currentStore.clone()
+	var updateAttributes, updateSecrets bool
+	var dbMask, nullFields []string
+	for _, f := range fieldMaskPaths {
+		switch {
+		case strings.EqualFold(nameField, f):
+			newStore.Name = cs.Name
+		case strings.EqualFold(descriptionField, f):
+			newStore.Description = cs.Description
+		case strings.EqualFold(attributesField, strings.Split(f, ".")[0]):
+			updateAttributes = true
+		case strings.EqualFold(secretsField, strings.Split(f, ".")[0]):
+			updateSecrets = true
+		default:
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, f)
+		}
+	}
+	dbMask, nullFields = dbw.BuildUpdatePaths(
+		map[string]any{
+			nameField:        newStore.Name,
+			descriptionField: newStore.Description,
+		},
+		fieldMaskPaths,
+		nil,
+	)
+
+	if updateAttributes {
+		if newStore.Attributes, err = patchstruct.PatchBytes(newStore.Attributes, cs.Attributes); err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("error in credential store attribute JSON"))
+		}
+		dbMask = append(dbMask, attributesField)
+	}
+	if updateSecrets {
+		newStore.Secrets = cs.Secrets
+		if err := newStore.hmacSecrets(ctx, databaseWrapper); err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("error hmac'ing passed-in secrets"))
+		}
+		if len(newStore.SecretsHmac) == 0 {
+			nullFields = append(nullFields, secretsHmacField)
+		} else {
+			dbMask = append(dbMask, secretsHmacField)
+		}
+	}
+
+	plgClient, err := r.pluginClient(ctx, currentStore.GetPluginId())
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	currPlgCs, err := toPluginStore(ctx, currentStore)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	newPlgCs, err := toPluginStore(ctx, newStore)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	if updateAttributes {
+		if err := normalizeStoreAttributes(ctx, plgClient, newPlgCs); err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+		if newStore.Attributes, err = proto.Marshal(newPlgCs.GetAttributes()); err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, currentStore.ProjectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var pluginCalledSuccessfully bool
+	var plgResp *plgpb.OnUpdateStoreResponse
+
+	var returnedStore *CredentialStore
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			msgs := make([]*oplog.Message, 0, 2)
+			mask, nulls := append([]string{}, dbMask...), nullFields
+			ticket, err := w.GetTicket(ctx, newStore)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+			}
+
+			if !pluginCalledSuccessfully {
+				plgResp, err = plgClient.OnUpdateStore(ctx, &plgpb.OnUpdateStoreRequest{
+					CurrentStore: currPlgCs,
+					NewStore:     newPlgCs,
+					Secrets:      newStore.Secrets,
+					Persisted:    currentPersisted,
+				})
+				if err != nil && status.Code(err) != codes.Unimplemented {
+					return errors.Wrap(ctx, err, op)
+				}
+				pluginCalledSuccessfully = true
+			}
+
+			if persisted := plgResp.GetPersisted().GetSecrets(); persisted != nil {
+				secret, err := newCredentialStoreSecret(ctx, currentStore.GetPublicId(), persisted)
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				var sOplogMsg oplog.Message
+				switch {
+				case len(persisted.GetFields()) == 0:
+					if _, err := w.Delete(ctx, secret, db.NewOplogMsg(&sOplogMsg)); err != nil {
+						return errors.Wrap(ctx, err, op)
+					}
+				default:
+					if err := secret.encrypt(ctx, databaseWrapper); err != nil {
+						return errors.Wrap(ctx, err, op)
+					}
+					if err := w.Create(ctx, secret,
+						db.WithOnConflict(&db.OnConflict{
+							Target: db.Columns{"store_id"},
+							Action: db.SetColumns([]string{"secret", "key_id"}),
+						}),
+						db.NewOplogMsg(&sOplogMsg),
+					); err != nil {
+						return errors.Wrap(ctx, err, op)
+					}
+				}
+				msgs = append(msgs, &sOplogMsg)
+				if len(mask) == 0 && len(nulls) == 0 {
+					// Only the persisted data changed, the version of the
+					// store still needs to be incremented.
+					mask = append(mask, "Version")
+				}
+			}
+
+			returnedStore = newStore.clone()
+			if len(mask) == 0 && len(nulls) == 0 {
+				return nil
+			}
+			if len(mask) == 1 && mask[0] == "Version" {
+				returnedStore.Version = version + 1
+			}
+			var csOplogMsg oplog.Message
+			rowsUpdated, err := w.Update(ctx, returnedStore, mask, nulls,
+				db.NewOplogMsg(&csOplogMsg),
+				db.WithVersion(&version))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated != 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("expected 1 credential store to be updated, got %d", rowsUpdated))
+			}
+			msgs = append(msgs, &csOplogMsg)
+
+			metadata := newStore.oplog(oplog.OpType_OP_TYPE_UPDATE)
+			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, metadata, msgs); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in %s: name %s already exists", newStore.PublicId, newStore.Name)))
+		}
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in %s", newStore.PublicId)))
+	}
+
+	// Even if no columns of the store were updated, the store was found with
+	// the appropriate version, so 1 row updated is returned.
+	returnedStore.Secrets = nil
+	return returnedStore, 1, nil
+}
+
+// ListCredentialStores returns a slice of CredentialStores for the
+// projectIds. WithLimit is the only option supported.
+func (r *Repository) ListCredentialStores(ctx context.Context, projectIds []string, opt ...Option) ([]*CredentialStore, error) {
+	const op = "plugin.(Repository).ListCredentialStores"
+	if len(projectIds) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no projectIds")
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	var credentialStores []*CredentialStore
+	err := r.reader.SearchWhere(ctx, &credentialStores, "project_id in (?)", []any{projectIds}, db.WithLimit(limit))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return credentialStores, nil
+}
+
+// DeleteCredentialStore deletes publicId from the repository and returns
+// the number of records deleted. The OnDeleteStore hook of the plugin is
+// called before the store is deleted, an error returned by the plugin is
+// logged and ignored. All options are ignored.
+func (r *Repository) DeleteCredentialStore(ctx context.Context, publicId string, _ ...Option) (int, error) {
+	const op = "plugin.(Repository).DeleteCredentialStore"
+	if publicId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	cs, persisted, err := r.getCredentialStore(ctx, publicId)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return db.NoRowsAffected, nil
+		}
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	if cs.ProjectId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no project id")
+	}
+
+	plgClient, err := r.pluginClient(ctx, cs.GetPluginId())
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	plgCs, err := toPluginStore(ctx, cs)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	if _, err := plgClient.OnDeleteStore(ctx, &plgpb.OnDeleteStoreRequest{
+		Store:     plgCs,
+		Persisted: persisted,
+	}); err != nil {
+		// Even if the plugin returns an error, we ignore it and proceed with
+		// deleting the credential store.
+		event.WriteError(ctx, op, err, event.WithInfoMsg("plugin deleting credential store", "credential plugin id", cs.GetPluginId()))
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, cs.ProjectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsDeleted int
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) (err error) {
+			dcs := cs.clone()
+			rowsDeleted, err = w.Delete(ctx, dcs, db.WithOplog(oplogWrapper, dcs.oplog(oplog.OpType_OP_TYPE_DELETE)))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsDeleted > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been deleted")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(publicId))
+	}
+
+	return rowsDeleted, nil
+}
+
+// getCredentialStore returns the CredentialStore for publicId and the data
+// its plugin persisted, if any. An error is returned if the store is not
+// found.
+func (r *Repository) getCredentialStore(ctx context.Context, publicId string) (*CredentialStore, *plgpb.CredentialStorePersisted, error) {
+	const op = "plugin.(Repository).getCredentialStore"
+	cs := allocCredentialStore()
+	cs.PublicId = publicId
+	if err := r.reader.LookupByPublicId(ctx, cs); err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", publicId)))
+	}
+	secret := allocCredentialStoreSecret()
+	if err := r.reader.LookupWhere(ctx, secret, "store_id = ?", []any{publicId}); err != nil {
+		if errors.IsNotFoundError(err) {
+			return cs, nil, nil
+		}
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	persisted, err := toPluginPersistedData(ctx, r.kms, cs.GetProjectId(), secret)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	return cs, persisted, nil
+}
+
+// toPluginStore returns a credential store in the format expected by the
+// credential plugin system.
+func toPluginStore(ctx context.Context, in *CredentialStore) (*pb.CredentialStore, error) {
+	const op = "plugin.toPluginStore"
+	if in == nil || in.CredentialStore == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil storage credential store")
+	}
+	var name, description *wrapperspb.StringValue
+	if inName := in.GetName(); inName != "" {
+		name = wrapperspb.String(inName)
+	}
+	if inDescription := in.GetDescription(); inDescription != "" {
+		description = wrapperspb.String(inDescription)
+	}
+
+	attrs := &structpb.Struct{}
+	if err := proto.Unmarshal(in.GetAttributes(), attrs); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to unmarshal attributes"))
+	}
+	return &pb.CredentialStore{
+		Id:          in.GetPublicId(),
+		ScopeId:     in.GetProjectId(),
+		Name:        name,
+		Description: description,
+		Version:     in.GetVersion(),
+		Type:        Subtype.String(),
+		Attrs: &pb.CredentialStore_Attributes{
+			Attributes: attrs,
+		},
+	}, nil
+}
+
+// toPluginPersistedData converts a *CredentialStoreSecret from storage to a
+// *plgpb.CredentialStorePersisted expected by a plugin. projectId must be
+// set.
+func toPluginPersistedData(ctx context.Context, kmsCache *kms.Kms, projectId string, secret *CredentialStoreSecret) (*plgpb.CredentialStorePersisted, error) {
+	const op = "plugin.toPluginPersistedData"
+	if projectId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "empty project id")
+	}
+	if secret == nil {
+		return nil, nil
+	}
+	dbWrapper, err := kmsCache.GetWrapper(ctx, projectId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get db wrapper"))
+	}
+	if err := secret.decrypt(ctx, dbWrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	secrets := &structpb.Struct{}
+	if err := proto.Unmarshal(secret.GetSecret(), secrets); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unmarshaling secret"))
+	}
+	return &plgpb.CredentialStorePersisted{Secrets: secrets}, nil
+}
@@ -0,0 +1,294 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentiallibraries"
+	cspb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentialstores"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ credential.Issuer = (*Repository)(nil)
+
+// Issue issues credentials from the plugins of the credential stores of
+// the requested libraries for the session sessionId.
+//
+// A credential the plugin reports as revocable is stored and assigned to
+// sessionId, it is revoked by the plugin when the session ends. Any other
+// credential is owned by the external credential provider, so it is
+// neither stored nor revoked by Boundary.
+func (r *Repository) Issue(ctx context.Context, sessionId string, requests []credential.Request, _ ...credential.Option) ([]credential.Dynamic, error) {
+	const op = "plugin.(Repository).Issue"
+	if sessionId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no session id")
+	}
+	if len(requests) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no requests")
+	}
+
+	libIds := make([]string, 0, len(requests))
+	for _, req := range requests {
+		libIds = append(libIds, req.SourceId)
+	}
+	var libs []*CredentialLibrary
+	if err := r.reader.SearchWhere(ctx, &libs, "public_id in (?)", []any{libIds}); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	libsById := make(map[string]*CredentialLibrary, len(libs))
+	for _, l := range libs {
+		libsById[l.GetPublicId()] = l
+	}
+
+	type storeData struct {
+		store     *cspb.CredentialStore
+		persisted *plgpb.CredentialStorePersisted
+		client    plgpb.CredentialPluginServiceClient
+	}
+	stores := make(map[string]*storeData, len(libs))
+	creds := make([]credential.Dynamic, 0, len(requests))
+	for _, req := range requests {
+		lib, ok := libsById[req.SourceId]
+		if !ok {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("credential library %s not found", req.SourceId))
+		}
+		sd, ok := stores[lib.GetStoreId()]
+		if !ok {
+			cs, persisted, err := r.getCredentialStore(ctx, lib.GetStoreId())
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			client, err := r.pluginClient(ctx, cs.GetPluginId())
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			plgCs, err := toPluginStore(ctx, cs)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			sd = &storeData{store: plgCs, persisted: persisted, client: client}
+			stores[lib.GetStoreId()] = sd
+		}
+		plgLib, err := toPluginLibrary(ctx, lib)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+
+		resp, err := sd.client.IssueCredential(ctx, &plgpb.IssueCredentialRequest{
+			Store:     sd.store,
+			Library:   plgLib,
+			SessionId: sessionId,
+			Persisted: sd.persisted,
+		})
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to issue credential from credential library %s", lib.GetPublicId())))
+		}
+		if resp.GetExternalId() == "" {
+			return nil, errors.New(ctx, errors.InvalidDynamicCredential, op, fmt.Sprintf("plugin returned no external id for credential library %s", lib.GetPublicId()))
+		}
+
+		id := resp.GetExternalId()
+		if resp.GetRevocable() {
+			if id, err = r.storeCredential(ctx, lib, sessionId, req.Purpose, resp); err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+		}
+		cred, err := convert(ctx, &baseCred{
+			id:         id,
+			sessionId:  sessionId,
+			lib:        lib,
+			purpose:    req.Purpose,
+			secretData: resp.GetSecret().AsMap(),
+		})
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		creds = append(creds, cred)
+	}
+	return creds, nil
+}
+
+// storeCredential stores the revocable credential the plugin issued from
+// lib and assigns it to the session sessionId. It returns the public id of
+// the stored credential.
+func (r *Repository) storeCredential(ctx context.Context, lib *CredentialLibrary, sessionId string, purpose credential.Purpose, resp *plgpb.IssueCredentialResponse) (string, error) {
+	const op = "plugin.(Repository).storeCredential"
+	cred, err := newCredential(ctx, lib.GetStoreId(), lib.GetPublicId(), sessionId, resp.GetExternalId(),
+		time.Duration(resp.GetLeaseDurationSeconds())*time.Second)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	if cred.PublicId, err = newCredentialId(ctx); err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+
+	updateQueryValues := []any{
+		sql.Named("public_id", cred.PublicId),
+		sql.Named("library_id", cred.LibraryId),
+		sql.Named("session_id", sessionId),
+		sql.Named("purpose", string(purpose)),
+	}
+	if _, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			if err := w.Create(ctx, cred.clone()); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			rowsUpdated, err := w.Exec(ctx, updateSessionCredentialQuery, updateQueryValues)
+			switch {
+			case err != nil:
+				return errors.Wrap(ctx, err, op)
+			case rowsUpdated == 0:
+				return errors.New(ctx, errors.InvalidDynamicCredential, op, "no matching dynamic credential for session found")
+			case rowsUpdated > 1:
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 session credential would have been updated")
+			}
+			return nil
+		},
+	); err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return cred.PublicId, nil
+}
+
+var _ credential.Revoker = (*Repository)(nil)
+
+// Revoke sets all active credentials issued from credential plugins for
+// sessionId to be revoked. The credentials are revoked by the plugins of
+// their credential stores in the credential revocation job.
+func (r *Repository) Revoke(ctx context.Context, sessionId string) error {
+	const op = "plugin.(Repository).Revoke"
+	if sessionId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "no session id")
+	}
+
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			if _, err := w.Exec(ctx, revokeCredentialsQuery, []any{sessionId}); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	return err
+}
+
+// toPluginLibrary returns a credential library in the format expected by
+// the credential plugin system.
+func toPluginLibrary(ctx context.Context, in *CredentialLibrary) (*pb.CredentialLibrary, error) {
+	const op = "plugin.toPluginLibrary"
+	if in == nil || in.CredentialLibrary == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil storage credential library")
+	}
+	var name, description *wrapperspb.StringValue
+	if inName := in.GetName(); inName != "" {
+		name = wrapperspb.String(inName)
+	}
+	if inDescription := in.GetDescription(); inDescription != "" {
+		description = wrapperspb.String(inDescription)
+	}
+
+	attrs := &structpb.Struct{}
+	if err := proto.Unmarshal(in.GetAttributes(), attrs); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to unmarshal attributes"))
+	}
+	return &pb.CredentialLibrary{
+		Id:                in.GetPublicId(),
+		CredentialStoreId: in.GetStoreId(),
+		Name:              name,
+		Description:       description,
+		Version:           in.GetVersion(),
+		Type:              GenericLibrarySubtype.String(),
+		CredentialType:    string(in.CredentialType()),
+		Attrs: &pb.CredentialLibrary_Attributes{
+			Attributes: attrs,
+		},
+	}, nil
+}
+
+type baseCred struct {
+	id         string
+	sessionId  string
+	lib        *CredentialLibrary
+	purpose    credential.Purpose
+	secretData map[string]any
+}
+
+func (bc *baseCred) GetPublicId() string           { return bc.id }
+func (bc *baseCred) GetSessionId() string          { return bc.sessionId }
+func (bc *baseCred) Secret() credential.SecretData { return bc.secretData }
+func (bc *baseCred) Library() credential.Library   { return bc.lib }
+func (bc *baseCred) Purpose() credential.Purpose   { return bc.purpose }
+
+// stringAttribute returns the top level attribute name of the secret data
+// if it is a string.
+func (bc *baseCred) stringAttribute(name string) string {
+	s, _ := bc.secretData[name].(string)
+	return s
+}
+
+// convert converts bc to the credential type of its library if it is not
+// UnspecifiedType.
+func convert(ctx context.Context, bc *baseCred) (credential.Dynamic, error) {
+	const op = "plugin.convert"
+	switch bc.lib.CredentialType() {
+	case credential.UsernamePasswordType:
+		c := &usrPassCred{
+			baseCred: bc,
+			username: bc.stringAttribute("username"),
+			password: credential.Password(bc.stringAttribute("password")),
+		}
+		if c.username == "" || c.password == "" {
+			return nil, errors.New(ctx, errors.InvalidParameter, op,
+				fmt.Sprintf("credential from library %s does not contain a username and a password", bc.lib.GetPublicId()))
+		}
+		return c, nil
+	case credential.SshPrivateKeyType:
+		c := &sshPrivateKeyCred{
+			baseCred:   bc,
+			username:   bc.stringAttribute("username"),
+			privateKey: credential.PrivateKey(bc.stringAttribute("private_key")),
+			passphrase: []byte(bc.stringAttribute("private_key_passphrase")),
+		}
+		if c.username == "" || len(c.privateKey) == 0 {
+			return nil, errors.New(ctx, errors.InvalidParameter, op,
+				fmt.Sprintf("credential from library %s does not contain a username and a private key", bc.lib.GetPublicId()))
+		}
+		return c, nil
+	}
+	return bc, nil
+}
+
+var _ credential.UsernamePassword = (*usrPassCred)(nil)
+
+type usrPassCred struct {
+	*baseCred
+	username string
+	password credential.Password
+}
+
+func (c *usrPassCred) Username() string              { return c.username }
+func (c *usrPassCred) Password() credential.Password { return c.password }
+
+var _ credential.SshPrivateKey = (*sshPrivateKeyCred)(nil)
+
+type sshPrivateKeyCred struct {
+	*baseCred
+	username   string
+	privateKey credential.PrivateKey
+	passphrase []byte
+}
+
+func (c *sshPrivateKeyCred) Username() string                  { return c.username }
+func (c *sshPrivateKeyCred) PrivateKey() credential.PrivateKey { return c.privateKey }
+func (c *sshPrivateKeyCred) PrivateKeyPassphrase() []byte      { return c.passphrase }
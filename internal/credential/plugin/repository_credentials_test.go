@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/plugin/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestConvert(t *testing.T) {
+	ctx := context.Background()
+	lib := func(ct credential.Type) *CredentialLibrary {
+		return &CredentialLibrary{CredentialLibrary: &store.CredentialLibrary{PublicId: "clplg_1234567890", CredentialType: string(ct)}}
+	}
+	tests := []struct {
+		name     string
+		credType credential.Type
+		secret   map[string]any
+		wantErr  bool
+	}{
+		{
+			name:     "unspecified",
+			credType: credential.UnspecifiedType,
+			secret:   map[string]any{"value": "plain"},
+		},
+		{
+			name:     "username-password",
+			credType: credential.UsernamePasswordType,
+			secret:   map[string]any{"username": "user", "password": "pass"},
+		},
+		{
+			name:     "username-password-missing-password",
+			credType: credential.UsernamePasswordType,
+			secret:   map[string]any{"username": "user"},
+			wantErr:  true,
+		},
+		{
+			name:     "ssh-private-key",
+			credType: credential.SshPrivateKeyType,
+			secret:   map[string]any{"username": "user", "private_key": "key", "private_key_passphrase": "phrase"},
+		},
+		{
+			name:     "ssh-private-key-missing-key",
+			credType: credential.SshPrivateKeyType,
+			secret:   map[string]any{"username": "user"},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := convert(ctx, &baseCred{lib: lib(tt.credType), secretData: tt.secret})
+			if tt.wantErr {
+				assert.Error(err)
+				return
+			}
+			require.NoError(err)
+			switch c := got.(type) {
+			case credential.UsernamePassword:
+				assert.Equal(credential.UsernamePasswordType, tt.credType)
+				assert.Equal("user", c.Username())
+				assert.Equal(credential.Password("pass"), c.Password())
+			case credential.SshPrivateKey:
+				assert.Equal(credential.SshPrivateKeyType, tt.credType)
+				assert.Equal("user", c.Username())
+				assert.Equal(credential.PrivateKey("key"), c.PrivateKey())
+				assert.Equal([]byte("phrase"), c.PrivateKeyPassphrase())
+			default:
+				assert.Equal(credential.UnspecifiedType, tt.credType)
+				assert.Equal(tt.secret, c.Secret())
+			}
+		})
+	}
+}
+
+func TestToPluginLibrary(t *testing.T) {
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+
+	attrs, err := structpb.NewStruct(map[string]any{"variable_id": "prod/db/password"})
+	require.NoError(err)
+	lib, err := NewCredentialLibrary(ctx, "csplg_1234567890",
+		WithName("conjur"),
+		WithAttributes(attrs),
+		WithCredentialType(credential.UsernamePasswordType))
+	require.NoError(err)
+	lib.PublicId = "clplg_1234567890"
+
+	got, err := toPluginLibrary(ctx, lib)
+	require.NoError(err)
+	assert.Equal("clplg_1234567890", got.GetId())
+	assert.Equal("csplg_1234567890", got.GetCredentialStoreId())
+	assert.Equal("conjur", got.GetName().GetValue())
+	assert.Nil(got.GetDescription())
+	assert.Equal(GenericLibrarySubtype.String(), got.GetType())
+	assert.Equal(string(credential.UsernamePasswordType), got.GetCredentialType())
+	assert.True(proto.Equal(attrs, got.GetAttributes()))
+
+	_, err = toPluginLibrary(ctx, nil)
+	assert.Error(err)
+}
+
+func TestToPluginStore(t *testing.T) {
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+
+	attrs, err := structpb.NewStruct(map[string]any{"appliance_url": "https://conjur.example.com"})
+	require.NoError(err)
+	cs, err := NewCredentialStore(ctx, "p_1234567890", "pl_1234567890",
+		WithDescription("conjur store"),
+		WithAttributes(attrs))
+	require.NoError(err)
+	cs.PublicId = "csplg_1234567890"
+
+	got, err := toPluginStore(ctx, cs)
+	require.NoError(err)
+	assert.Equal("csplg_1234567890", got.GetId())
+	assert.Equal("p_1234567890", got.GetScopeId())
+	assert.Nil(got.GetName())
+	assert.Equal("conjur store", got.GetDescription().GetValue())
+	assert.Equal(Subtype.String(), got.GetType())
+	assert.True(proto.Equal(attrs, got.GetAttributes()))
+
+	_, err = toPluginStore(ctx, nil)
+	assert.Error(err)
+}
@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credential
+
+const (
+	upsertRevocationAttemptQuery = `
+insert into credential_dynamic_revocation_attempt
+  (credential_id, attempt_count, last_error, next_attempt_time)
+values
+  (@credential_id, 1, @last_error, wt_add_seconds_to_now(@base_seconds))
+on conflict (credential_id) do update
+  set attempt_count     = credential_dynamic_revocation_attempt.attempt_count + 1,
+      last_error        = excluded.last_error,
+      next_attempt_time = wt_add_seconds_to_now(
+        least(@max_seconds, @base_seconds * power(2, credential_dynamic_revocation_attempt.attempt_count))::integer
+      )
+returning attempt_count;
+`
+
+	failRevocationAttemptQuery = `
+update credential_dynamic_revocation_attempt
+   set failed_time = now()
+ where credential_id = ?;
+`
+
+	deleteRevocationAttemptQuery = `
+delete from credential_dynamic_revocation_attempt
+ where credential_id = ?;
+`
+)
@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credential
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	ua "go.uber.org/atomic"
+)
+
+const (
+	// revocationNextRunIn is how often a RevocationJob runs. It is also the
+	// delay before the first retry of a failed revocation.
+	revocationNextRunIn = 5 * time.Minute
+
+	// maxRevocationBackoff is the longest delay between two attempts to
+	// revoke a credential.
+	maxRevocationBackoff = 6 * time.Hour
+
+	// DefaultMaxRevocationAttempts is the number of failed attempts to revoke
+	// a credential after which the revocation is recorded as failed.
+	DefaultMaxRevocationAttempts = 10
+)
+
+var _ scheduler.Job = (*RevocationJob)(nil)
+
+// RevocationJob is a recurring job that revokes the dynamic credentials of a
+// subtype which are set for revocation. A failed revocation is retried with
+// an exponential backoff, starting at the run interval of the job, until the
+// maximum number of attempts is reached. The revocation is then recorded as
+// failed and the credential is no longer retried.
+//
+// Each dynamic credential subtype registers its own RevocationJob with the
+// PendingRevoker of the subtype. A RevocationJob is not thread safe, an
+// attempt to Run the job concurrently will result in a JobAlreadyRunning
+// error.
+type RevocationJob struct {
+	writer      db.Writer
+	revoker     PendingRevoker
+	name        string
+	description string
+	limit       int
+	maxAttempts int
+
+	running      ua.Bool
+	numCreds     int
+	numProcessed int
+}
+
+// NewRevocationJob creates a new in-memory RevocationJob with the name and
+// description which revokes credentials with revoker.
+//
+// WithLimit and WithMaxRevocationAttempts are the only supported options.
+func NewRevocationJob(ctx context.Context, w db.Writer, name, description string, revoker PendingRevoker, opt ...Option) (*RevocationJob, error) {
+	const op = "credential.NewRevocationJob"
+	switch {
+	case w == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing db.Writer")
+	case name == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing name")
+	case revoker == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing revoker")
+	}
+
+	opts, err := GetOpts(opt...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if opts.WithLimit == 0 {
+		// zero signals the boundary defaults should be used.
+		opts.WithLimit = db.DefaultLimit
+	}
+	if opts.WithMaxRevocationAttempts <= 0 {
+		opts.WithMaxRevocationAttempts = DefaultMaxRevocationAttempts
+	}
+	return &RevocationJob{
+		writer:      w,
+		revoker:     revoker,
+		name:        name,
+		description: description,
+		limit:       opts.WithLimit,
+		maxAttempts: opts.WithMaxRevocationAttempts,
+	}, nil
+}
+
+// Status returns the current status of the revocation job. Total is the
+// total number of credentials that are due for revocation. Completed is the
+// number of credentials already processed.
+func (j *RevocationJob) Status() scheduler.JobStatus {
+	return scheduler.JobStatus{
+		Completed: j.numProcessed,
+		Total:     j.numCreds,
+	}
+}
+
+// Run revokes each credential which is due for revocation. A failed
+// revocation is recorded so it is retried after a backoff, or set as failed
+// once the maximum number of attempts is reached. Can not be run in
+// parallel, if Run is invoked while already running an error with code
+// JobAlreadyRunning will be returned.
+func (j *RevocationJob) Run(ctx context.Context) error {
+	const op = "credential.(RevocationJob).Run"
+	if !j.running.CAS(j.running.Load(), true) {
+		return errors.New(ctx, errors.JobAlreadyRunning, op, "job already running")
+	}
+	defer j.running.Store(false)
+
+	// Verify context is not done before running
+	if err := ctx.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	ids, err := j.revoker.PendingRevocations(ctx, j.limit)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	// Set numProcessed and numCreds for status report
+	j.numProcessed, j.numCreds = 0, len(ids)
+	for _, id := range ids {
+		// Verify context is not done before revoking next credential
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if revokeErr := j.revoker.RevokeCredential(ctx, id); revokeErr != nil {
+			if err := j.recordFailure(ctx, id, revokeErr); err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("error recording failed credential revocation", "credential id", id))
+			}
+		} else if _, err := j.writer.Exec(ctx, deleteRevocationAttemptQuery, []any{id}); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error clearing credential revocation attempts", "credential id", id))
+		}
+		j.numProcessed++
+	}
+
+	return nil
+}
+
+// recordFailure records a failed attempt to revoke the credential. When the
+// maximum number of attempts is reached the revocation is set as failed.
+func (j *RevocationJob) recordFailure(ctx context.Context, credentialId string, revokeErr error) error {
+	const op = "credential.(RevocationJob).recordFailure"
+	rows, err := j.writer.Query(ctx, upsertRevocationAttemptQuery, []any{
+		sql.Named("credential_id", credentialId),
+		sql.Named("last_error", revokeErr.Error()),
+		sql.Named("base_seconds", int(revocationNextRunIn.Seconds())),
+		sql.Named("max_seconds", int(maxRevocationBackoff.Seconds())),
+	})
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var attempts int
+	for rows.Next() {
+		if err := rows.Scan(&attempts); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	if attempts < j.maxAttempts {
+		event.WriteError(ctx, op, revokeErr, event.WithInfoMsg("error revoking credential", "credential id", credentialId, "attempt", attempts))
+		return nil
+	}
+
+	if err := j.revoker.FailRevocation(ctx, credentialId); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if _, err := j.writer.Exec(ctx, failRevocationAttemptQuery, []any{credentialId}); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	event.WriteError(ctx, op, revokeErr, event.WithInfoMsg("unable to revoke credential, no further attempts will be made", "credential id", credentialId, "attempts", attempts))
+	return nil
+}
+
+// NextRunIn determines when the next revocation job should run.
+func (j *RevocationJob) NextRunIn(_ context.Context) (time.Duration, error) {
+	return revocationNextRunIn, nil
+}
+
+// Name is the unique name of the job.
+func (j *RevocationJob) Name() string {
+	return j.name
+}
+
+// Description is the human readable description of the job.
+func (j *RevocationJob) Description() string {
+	return j.description
+}
@@ -0,0 +1,258 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package static
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	ua "go.uber.org/atomic"
+)
+
+const (
+	credentialRotationJobName = "static_credential_rotation"
+	credentialCheckInJobName  = "static_credential_check_in"
+
+	defaultRotationNextRunIn = time.Minute
+	defaultCheckInNextRunIn  = 30 * time.Second
+)
+
+// RegisterJobs registers the static credential jobs with the scheduler.
+func RegisterJobs(ctx context.Context, scheduler *scheduler.Scheduler, r db.Reader, w db.Writer, kms *kms.Kms) error {
+	const op = "static.RegisterJobs"
+	credRotation, err := newCredentialRotationJob(ctx, r, w, kms)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if err = scheduler.RegisterJob(ctx, credRotation); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("credential rotation job"))
+	}
+	credCheckIn, err := newCredentialCheckInJob(ctx, r, w, kms)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if err = scheduler.RegisterJob(ctx, credCheckIn); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("credential check in job"))
+	}
+	return nil
+}
+
+// CredentialRotationJob is the recurring job that rotates static credentials
+// whose next rotation time has passed. The CredentialRotationJob is not thread
+// safe, an attempt to Run the job concurrently will result in a
+// JobAlreadyRunning error.
+type CredentialRotationJob struct {
+	reader db.Reader
+	writer db.Writer
+	kms    *kms.Kms
+
+	limit        int
+	running      ua.Bool
+	numProcessed int
+	numCreds     int
+}
+
+// newCredentialRotationJob creates a new in-memory CredentialRotationJob.
+//
+// WithLimit is the only supported option.
+func newCredentialRotationJob(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*CredentialRotationJob, error) {
+	const op = "static.newCredentialRotationJob"
+	switch {
+	case r == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing db.Reader")
+	case w == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing db.Writer")
+	case kms == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing kms")
+	}
+
+	opts := getOpts(opt...)
+	if opts.withLimit == 0 {
+		// zero signals the boundary defaults should be used.
+		opts.withLimit = db.DefaultLimit
+	}
+	return &CredentialRotationJob{
+		reader: r,
+		writer: w,
+		kms:    kms,
+		limit:  opts.withLimit,
+	}, nil
+}
+
+// Status returns the current status of the credential rotation job.
+func (r *CredentialRotationJob) Status() scheduler.JobStatus {
+	return scheduler.JobStatus{
+		Completed: r.numProcessed,
+		Total:     r.numCreds,
+	}
+}
+
+// Run rotates the static credentials whose next rotation time has passed.
+// Failed rotations are recorded in the rotation schedule of the credential
+// and don't stop the other credentials from being rotated. Can not be run in
+// parallel, if Run is invoked while already running an error with code
+// JobAlreadyRunning will be returned.
+func (r *CredentialRotationJob) Run(ctx context.Context) error {
+	const op = "static.(CredentialRotationJob).Run"
+	if !r.running.CAS(r.running.Load(), true) {
+		return errors.New(ctx, errors.JobAlreadyRunning, op, "job already running")
+	}
+	defer r.running.Store(false)
+
+	// Verify context is not done before running
+	if err := ctx.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	repo, err := NewRepository(ctx, r.reader, r.writer, r.kms)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	ids, err := repo.listDueCredentialRotations(ctx, r.limit)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	// Set numProcessed and numCreds for status report
+	r.numProcessed, r.numCreds = 0, len(ids)
+	for _, id := range ids {
+		// Verify context is not done before rotating the next credential
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+
+		if _, err := repo.RotateCredential(ctx, id); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error rotating static credential", "credential id", id))
+		}
+		r.numProcessed++
+	}
+	return nil
+}
+
+// NextRunIn determine when the next credential rotation job should run.
+func (r *CredentialRotationJob) NextRunIn(_ context.Context) (time.Duration, error) {
+	return defaultRotationNextRunIn, nil
+}
+
+// Name is the unique name of the job.
+func (r *CredentialRotationJob) Name() string {
+	return credentialRotationJobName
+}
+
+// Description is the human readable description of the job.
+func (r *CredentialRotationJob) Description() string {
+	return "Periodically rotates static credentials according to their rotation schedules."
+}
+
+// CredentialCheckInJob is the recurring job that releases the exclusive
+// static credentials checked in by terminated sessions. Credentials with a
+// rotation schedule are rotated before they are released. The
+// CredentialCheckInJob is not thread safe, an attempt to Run the job
+// concurrently will result in a JobAlreadyRunning error.
+type CredentialCheckInJob struct {
+	reader db.Reader
+	writer db.Writer
+	kms    *kms.Kms
+
+	limit        int
+	running      ua.Bool
+	numProcessed int
+	numLeases    int
+}
+
+// newCredentialCheckInJob creates a new in-memory CredentialCheckInJob.
+//
+// WithLimit is the only supported option.
+func newCredentialCheckInJob(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*CredentialCheckInJob, error) {
+	const op = "static.newCredentialCheckInJob"
+	switch {
+	case r == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing db.Reader")
+	case w == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing db.Writer")
+	case kms == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing kms")
+	}
+
+	opts := getOpts(opt...)
+	if opts.withLimit == 0 {
+		// zero signals the boundary defaults should be used.
+		opts.withLimit = db.DefaultLimit
+	}
+	return &CredentialCheckInJob{
+		reader: r,
+		writer: w,
+		kms:    kms,
+		limit:  opts.withLimit,
+	}, nil
+}
+
+// Status returns the current status of the credential check in job.
+func (r *CredentialCheckInJob) Status() scheduler.JobStatus {
+	return scheduler.JobStatus{
+		Completed: r.numProcessed,
+		Total:     r.numLeases,
+	}
+}
+
+// Run releases the checked in leases of static credentials. A credential
+// whose rotation fails stays leased and is attempted again on the next run.
+// Can not be run in parallel, if Run is invoked while already running an
+// error with code JobAlreadyRunning will be returned.
+func (r *CredentialCheckInJob) Run(ctx context.Context) error {
+	const op = "static.(CredentialCheckInJob).Run"
+	if !r.running.CAS(r.running.Load(), true) {
+		return errors.New(ctx, errors.JobAlreadyRunning, op, "job already running")
+	}
+	defer r.running.Store(false)
+
+	// Verify context is not done before running
+	if err := ctx.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	repo, err := NewRepository(ctx, r.reader, r.writer, r.kms)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	ids, err := repo.listCheckedInCredentialLeases(ctx, r.limit)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	// Set numProcessed and numLeases for status report
+	r.numProcessed, r.numLeases = 0, len(ids)
+	for _, id := range ids {
+		// Verify context is not done before checking in the next credential
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+
+		if err := repo.checkInCredential(ctx, id); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error checking in static credential", "credential id", id))
+		}
+		r.numProcessed++
+	}
+	return nil
+}
+
+// NextRunIn determine when the next credential check in job should run.
+func (r *CredentialCheckInJob) NextRunIn(_ context.Context) (time.Duration, error) {
+	return defaultCheckInNextRunIn, nil
+}
+
+// Name is the unique name of the job.
+func (r *CredentialCheckInJob) Name() string {
+	return credentialCheckInJobName
+}
+
+// Description is the human readable description of the job.
+func (r *CredentialCheckInJob) Description() string {
+	return "Periodically releases exclusive static credentials checked in by terminated sessions, rotating them first if they have a rotation schedule."
+}
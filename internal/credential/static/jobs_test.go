@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package static
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCredentialRotationJob(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+
+	_, err := newCredentialRotationJob(ctx, nil, rw, kms)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	_, err = newCredentialRotationJob(ctx, rw, nil, kms)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	_, err = newCredentialRotationJob(ctx, rw, rw, nil)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+
+	job, err := newCredentialRotationJob(ctx, rw, rw, kms, WithLimit(10))
+	require.NoError(t, err)
+	assert.Equal(t, 10, job.limit)
+	assert.Equal(t, credentialRotationJobName, job.Name())
+}
+
+func TestCredentialRotationJob_Run(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(err)
+
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+	due := TestUsernamePasswordCredential(t, conn, wrapper, "due", "pass", cs.GetPublicId(), prj.GetPublicId())
+	notDue := TestUsernamePasswordCredential(t, conn, wrapper, "not-due", "pass", cs.GetPublicId(), prj.GetPublicId())
+	_, err = repo.SetCredentialRotation(ctx, due.GetPublicId(), GenerateRotatorName, time.Hour)
+	require.NoError(err)
+	_, err = repo.SetCredentialRotation(ctx, notDue.GetPublicId(), GenerateRotatorName, time.Hour)
+	require.NoError(err)
+	_, err = rw.Exec(ctx, "update credential_static_rotation set next_rotation_time = now() - interval '1 minute' where credential_id = ?", []any{due.GetPublicId()})
+	require.NoError(err)
+
+	job, err := newCredentialRotationJob(ctx, rw, rw, kms)
+	require.NoError(err)
+	require.NoError(job.Run(ctx))
+	assert.Equal(1, job.Status().Total)
+	assert.Equal(1, job.Status().Completed)
+
+	got, err := repo.LookupCredentialRotation(ctx, due.GetPublicId())
+	require.NoError(err)
+	assert.False(got.LastRotationTime.IsZero())
+	got, err = repo.LookupCredentialRotation(ctx, notDue.GetPublicId())
+	require.NoError(err)
+	assert.True(got.LastRotationTime.IsZero())
+}
+
+func TestCredentialCheckInJob_Run(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(err)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iamRepo)
+	cs := TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+	cred := TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", cs.GetPublicId(), prj.GetPublicId())
+	_, err = repo.SetCredentialRotation(ctx, cred.GetPublicId(), GenerateRotatorName, time.Hour)
+	require.NoError(err)
+
+	sess := session.TestDefaultSession(t, conn, wrapper, iamRepo)
+	require.NoError(repo.CheckOutCredentials(ctx, sess.GetPublicId(), []string{cred.GetPublicId()}))
+	session.TestState(t, conn, sess.GetPublicId(), session.StatusTerminated)
+
+	job, err := newCredentialCheckInJob(ctx, rw, rw, kms)
+	require.NoError(err)
+	assert.Equal(credentialCheckInJobName, job.Name())
+	require.NoError(job.Run(ctx))
+	assert.Equal(1, job.Status().Total)
+	assert.Equal(1, job.Status().Completed)
+
+	lease, err := repo.LookupCredentialLease(ctx, cred.GetPublicId())
+	require.NoError(err)
+	assert.Nil(lease)
+	rot, err := repo.LookupCredentialRotation(ctx, cred.GetPublicId())
+	require.NoError(err)
+	assert.False(rot.LastRotationTime.IsZero())
+}
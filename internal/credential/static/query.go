@@ -40,4 +40,105 @@ from credential_static_json_credential json
 where store.project_id = ?
   and json.key_id = ?;
 `
+
+	credStaticX509CertificateRewrapQuery = `
+select distinct
+  x509.public_id,
+  x509.store_id,
+  x509.private_key_encrypted,
+  x509.key_id
+from credential_static_x509_certificate_credential x509
+  inner join credential_static_store store
+    on store.public_id = x509.store_id
+where store.project_id = ?
+  and x509.key_id = ?;
+`
+)
+
+const (
+	setCredentialRotationQuery = `
+insert into credential_static_rotation
+  (credential_id, rotator, rotation_period_seconds, next_rotation_time)
+values
+  (@credential_id, @rotator, @rotation_period_seconds, now() + make_interval(secs => @rotation_period_seconds::integer))
+on conflict (credential_id) do update
+  set rotator                 = excluded.rotator,
+      rotation_period_seconds = excluded.rotation_period_seconds,
+      next_rotation_time      = excluded.next_rotation_time;
+`
+
+	lookupCredentialRotationQuery = `
+select rot.credential_id,
+       rot.rotator,
+       rot.rotation_period_seconds,
+       rot.next_rotation_time,
+       rot.last_rotation_time,
+       rot.last_rotation_error,
+       store.project_id
+  from credential_static_rotation rot
+  join credential_static cred
+    on cred.public_id = rot.credential_id
+  join credential_store store
+    on store.public_id = cred.store_id
+ where rot.credential_id = @credential_id;
+`
+
+	deleteCredentialRotationQuery = `
+delete from credential_static_rotation
+ where credential_id = @credential_id;
+`
+
+	listDueCredentialRotationsQuery = `
+  select credential_id
+    from credential_static_rotation
+   where next_rotation_time <= now()
+order by next_rotation_time
+   limit @limit;
+`
+
+	rotationSucceededQuery = `
+update credential_static_rotation
+   set last_rotation_time  = now(),
+       last_rotation_error = null,
+       next_rotation_time  = now() + make_interval(secs => rotation_period_seconds)
+ where credential_id = @credential_id;
+`
+
+	rotationFailedQuery = `
+update credential_static_rotation
+   set last_rotation_error = @last_rotation_error,
+       next_rotation_time  = now() + least(make_interval(secs => rotation_period_seconds), make_interval(secs => @retry_seconds::integer))
+ where credential_id = @credential_id;
+`
+)
+
+const (
+	checkOutCredentialQuery = `
+insert into credential_static_lease
+  (credential_id, session_id)
+values
+  (@credential_id, @session_id);
+`
+
+	lookupCredentialLeaseQuery = `
+select credential_id,
+       session_id,
+       create_time,
+       check_in_time
+  from credential_static_lease
+ where credential_id = @credential_id;
+`
+
+	listCheckedInCredentialLeasesQuery = `
+  select credential_id
+    from credential_static_lease
+   where check_in_time is not null
+order by check_in_time
+   limit @limit;
+`
+
+	deleteCredentialLeaseQuery = `
+delete from credential_static_lease
+ where credential_id = @credential_id;
+`
 )
@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package static
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+)
+
+// MaxBatchCreateCredentials is the maximum number of credentials which can be
+// created with a single call to CreateCredentials.
+const MaxBatchCreateCredentials = 1000
+
+// CreateCredentials inserts creds into the repository in a single transaction
+// and returns the new credentials, in the order of creds, containing their
+// PublicIds. Either all of creds are created or none of them are. creds is not
+// changed.
+//
+// Each credential must be a *UsernamePasswordCredential,
+// *SshPrivateKeyCredential, *JsonCredential or *X509CertificateCredential and
+// must meet the requirements of the matching CreateXCredential method. The
+// credentials must belong to credential stores in projectId. No more than
+// MaxBatchCreateCredentials credentials can be created at once.
+//
+// The secrets of the credentials are encrypted and HmacSha256s of the secrets
+// are calculated. Only the HMACs are returned, the plain-text and encrypted
+// secrets are not returned.
+func (r *Repository) CreateCredentials(ctx context.Context, projectId string, creds []credential.Static, _ ...Option) ([]credential.Static, error) {
+	const op = "static.(Repository).CreateCredentials"
+	switch {
+	case projectId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing project id")
+	case len(creds) == 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing credentials")
+	case len(creds) > MaxBatchCreateCredentials:
+		return nil, errors.New(ctx, errors.InvalidParameter, op,
+			fmt.Sprintf("too many credentials: %d, the maximum is %d", len(creds), MaxBatchCreateCredentials))
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+	databaseWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+
+	prepared := make([]credential.Static, 0, len(creds))
+	for i, c := range creds {
+		pc, err := prepareCredentialForCreate(ctx, databaseWrapper, c)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("credential %d", i)))
+		}
+		prepared = append(prepared, pc)
+	}
+
+	var newCreds []credential.Static
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			newCreds = make([]credential.Static, 0, len(prepared))
+			for _, c := range prepared {
+				var newCred credential.Static
+				var metadata oplog.Metadata
+				switch c := c.(type) {
+				case *UsernamePasswordCredential:
+					nc := c.clone()
+					newCred, metadata = nc, nc.oplog(oplog.OpType_OP_TYPE_CREATE)
+				case *SshPrivateKeyCredential:
+					nc := c.clone()
+					newCred, metadata = nc, nc.oplog(oplog.OpType_OP_TYPE_CREATE)
+				case *JsonCredential:
+					nc := c.clone()
+					newCred, metadata = nc, nc.oplog(oplog.OpType_OP_TYPE_CREATE)
+				case *X509CertificateCredential:
+					nc := c.clone()
+					newCred, metadata = nc, nc.oplog(oplog.OpType_OP_TYPE_CREATE)
+				}
+				if err := w.Create(ctx, newCred, db.WithOplog(oplogWrapper, metadata)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in store: %s", c.GetStoreId())))
+				}
+				newCreds = append(newCreds, newCred)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("name already exists"))
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	// Clear the secret fields, only the HMACs should be returned
+	for _, c := range newCreds {
+		switch c := c.(type) {
+		case *UsernamePasswordCredential:
+			c.CtPassword = nil
+			c.Password = nil
+		case *SshPrivateKeyCredential:
+			c.PrivateKeyEncrypted = nil
+			c.PrivateKey = nil
+			c.PrivateKeyPassphraseEncrypted = nil
+			c.PrivateKeyPassphrase = nil
+		case *JsonCredential:
+			c.ObjectEncrypted = nil
+			c.Object = nil
+		case *X509CertificateCredential:
+			c.PrivateKeyEncrypted = nil
+			c.PrivateKey = nil
+		}
+	}
+	return newCreds, nil
+}
+
+// prepareCredentialForCreate validates c and returns a clone of c with a new
+// PublicId and its secrets encrypted with databaseWrapper.
+func prepareCredentialForCreate(ctx context.Context, databaseWrapper wrapping.Wrapper, c credential.Static) (credential.Static, error) {
+	const op = "static.prepareCredentialForCreate"
+	var id string
+	var err error
+	switch c := c.(type) {
+	case *UsernamePasswordCredential:
+		switch {
+		case c == nil:
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "missing credential")
+		case c.UsernamePasswordCredential == nil:
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "missing embedded credential")
+		case c.Username == "":
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "missing username")
+		case c.Password == nil:
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "missing password")
+		}
+		id, err = credential.NewUsernamePasswordCredentialId(ctx)
+	case *SshPrivateKeyCredential:
+		switch {
+		case c == nil:
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "missing credential")
+		case c.SshPrivateKeyCredential == nil:
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "missing embedded credential")
+		case c.Username == "":
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "missing username")
+		case c.PrivateKey == nil:
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "missing private key")
+		}
+		id, err = credential.NewSshPrivateKeyCredentialId(ctx)
+	case *JsonCredential:
+		switch {
+		case c == nil:
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "missing credential")
+		case c.JsonCredential == nil:
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "missing embedded credential")
+		case c.Object == nil:
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "missing object")
+		}
+		id, err = credential.NewJsonCredentialId(ctx)
+	case *X509CertificateCredential:
+		switch {
+		case c == nil:
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "missing credential")
+		case c.X509CertificateCredential == nil:
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "missing embedded credential")
+		case c.Certificate == nil:
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "missing certificate")
+		case c.PrivateKey == nil:
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "missing private key")
+		}
+		id, err = credential.NewX509CertificateCredentialId(ctx)
+	case nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing credential")
+	default:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported credential type %T", c))
+	}
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if c.GetStoreId() == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing store id")
+	}
+	if c.GetPublicId() != "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id not empty")
+	}
+
+	switch c := c.(type) {
+	case *UsernamePasswordCredential:
+		nc := c.clone()
+		nc.PublicId = id
+		if err := nc.encrypt(ctx, databaseWrapper); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		return nc, nil
+	case *SshPrivateKeyCredential:
+		nc := c.clone()
+		nc.PublicId = id
+		if err := nc.encrypt(ctx, databaseWrapper); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		return nc, nil
+	case *JsonCredential:
+		nc := c.clone()
+		nc.PublicId = id
+		if err := nc.encrypt(ctx, databaseWrapper); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		return nc, nil
+	case *X509CertificateCredential:
+		nc := c.clone()
+		nc.PublicId = id
+		if err := nc.encrypt(ctx, databaseWrapper); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		return nc, nil
+	}
+	return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported credential type %T", c))
+}
@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package static
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestRepository_CreateCredentials(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	ctx := context.Background()
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, wrapper, prj.PublicId)
+
+	kkms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(ctx, rw, rw, kkms)
+	require.NoError(t, err)
+
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		up, err := NewUsernamePasswordCredential(cs.PublicId, "user", "pass", WithName("batch-up"))
+		require.NoError(err)
+		spk, err := NewSshPrivateKeyCredential(ctx, cs.PublicId, "user", credential.PrivateKey(TestSshPrivateKeyPem), WithName("batch-spk"))
+		require.NoError(err)
+		// the object is passed as a literal so the lock of its struct isn't copied
+		fields := map[string]*structpb.Value{
+			"username": structpb.NewStringValue("user"),
+			"password": structpb.NewStringValue("password"),
+		}
+		js, err := NewJsonCredential(ctx, cs.PublicId, credential.JsonObject{Struct: structpb.Struct{Fields: fields}}, WithName("batch-json"))
+		require.NoError(err)
+		cert, key := TestX509CertificateKeyPair(t, "batch")
+		x5, err := NewX509CertificateCredential(ctx, cs.PublicId, cert, key, WithName("batch-x509"))
+		require.NoError(err)
+
+		got, err := repo.CreateCredentials(ctx, prj.PublicId, []credential.Static{up, spk, js, x5})
+		require.NoError(err)
+		require.Len(got, 4)
+
+		gotUp, ok := got[0].(*UsernamePasswordCredential)
+		require.True(ok)
+		assertPublicId(t, globals.UsernamePasswordCredentialPrefix, gotUp.PublicId)
+		assert.Equal("batch-up", gotUp.Name)
+		assert.Empty(gotUp.Password)
+		assert.Empty(gotUp.CtPassword)
+		assert.NotEmpty(gotUp.PasswordHmac)
+
+		gotSpk, ok := got[1].(*SshPrivateKeyCredential)
+		require.True(ok)
+		assertPublicId(t, globals.SshPrivateKeyCredentialPrefix, gotSpk.PublicId)
+		assert.Empty(gotSpk.PrivateKey)
+		assert.Empty(gotSpk.PrivateKeyEncrypted)
+		assert.NotEmpty(gotSpk.PrivateKeyHmac)
+
+		gotJs, ok := got[2].(*JsonCredential)
+		require.True(ok)
+		assertPublicId(t, globals.JsonCredentialPrefix, gotJs.PublicId)
+		assert.Empty(gotJs.Object)
+		assert.Empty(gotJs.ObjectEncrypted)
+		assert.NotEmpty(gotJs.ObjectHmac)
+
+		gotX5, ok := got[3].(*X509CertificateCredential)
+		require.True(ok)
+		assertPublicId(t, globals.X509CertificateCredentialPrefix, gotX5.PublicId)
+		assert.Empty(gotX5.PrivateKey)
+		assert.Empty(gotX5.PrivateKeyEncrypted)
+		assert.NotEmpty(gotX5.PrivateKeyHmac)
+
+		// The input is not changed
+		assert.Empty(up.PublicId)
+		assert.NotEmpty(up.Password)
+
+		for _, c := range got {
+			assert.NoError(db.TestVerifyOplog(t, rw, c.GetPublicId(), db.WithOperation(oplog.OpType_OP_TYPE_CREATE), db.WithCreateNotBefore(10*time.Second)))
+		}
+
+		ids := make([]string, 0, len(got))
+		for _, c := range got {
+			ids = append(ids, c.GetPublicId())
+		}
+		retrieved, err := repo.Retrieve(ctx, prj.PublicId, ids)
+		require.NoError(err)
+		assert.Len(retrieved, 4)
+	})
+
+	t.Run("duplicate-names-rolls-back", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		bcs := TestCredentialStore(t, conn, wrapper, prj.PublicId)
+		in1, err := NewUsernamePasswordCredential(bcs.PublicId, "user", "pass", WithName("dup"))
+		require.NoError(err)
+		in2, err := NewUsernamePasswordCredential(bcs.PublicId, "user", "pass", WithName("unique"))
+		require.NoError(err)
+		in3, err := NewUsernamePasswordCredential(bcs.PublicId, "user", "pass", WithName("dup"))
+		require.NoError(err)
+
+		got, err := repo.CreateCredentials(ctx, prj.PublicId, []credential.Static{in1, in2, in3})
+		assert.Truef(errors.Match(errors.T(errors.NotUnique), err), "want err code: %v got err: %v", errors.NotUnique, err)
+		assert.Nil(got)
+
+		list, err := repo.ListCredentials(ctx, bcs.PublicId)
+		require.NoError(err)
+		assert.Empty(list)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		valid, err := NewUsernamePasswordCredential(cs.PublicId, "user", "pass")
+		require.NoError(t, err)
+		noStore, err := NewUsernamePasswordCredential("", "user", "pass")
+		require.NoError(t, err)
+		withId, err := NewUsernamePasswordCredential(cs.PublicId, "user", "pass")
+		require.NoError(t, err)
+		withId.PublicId = "credup_1234567890"
+		tooMany := make([]credential.Static, MaxBatchCreateCredentials+1)
+		for i := range tooMany {
+			tooMany[i] = valid
+		}
+
+		tests := []struct {
+			name      string
+			projectId string
+			creds     []credential.Static
+		}{
+			{
+				name:  "missing-project-id",
+				creds: []credential.Static{valid},
+			},
+			{
+				name:      "missing-credentials",
+				projectId: prj.PublicId,
+			},
+			{
+				name:      "too-many-credentials",
+				projectId: prj.PublicId,
+				creds:     tooMany,
+			},
+			{
+				name:      "nil-credential",
+				projectId: prj.PublicId,
+				creds:     []credential.Static{valid, nil},
+			},
+			{
+				name:      "missing-embedded-credential",
+				projectId: prj.PublicId,
+				creds:     []credential.Static{&UsernamePasswordCredential{}},
+			},
+			{
+				name:      "missing-store-id",
+				projectId: prj.PublicId,
+				creds:     []credential.Static{valid, noStore},
+			},
+			{
+				name:      "public-id-not-empty",
+				projectId: prj.PublicId,
+				creds:     []credential.Static{withId},
+			},
+		}
+		for _, tt := range tests {
+			tt := tt
+			t.Run(tt.name, func(t *testing.T) {
+				assert := assert.New(t)
+				got, err := repo.CreateCredentials(ctx, tt.projectId, tt.creds)
+				assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %v got err: %v", errors.InvalidParameter, err)
+				assert.Nil(got)
+			})
+		}
+	})
+}
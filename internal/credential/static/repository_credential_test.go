@@ -6,6 +6,7 @@ package static
 import (
 	"context"
 	"encoding/json"
+	"strings"
 	"testing"
 	"time"
 
@@ -2446,3 +2447,82 @@ func TestRepository_UpdateJsonCredential(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_X509CertificateCredential(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kkms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(ctx, rw, rw, kkms)
+	require.NoError(t, err)
+
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, wrapper, prj.PublicId)
+	certificate, privateKey := TestX509CertificateKeyPair(t, "client.example.com")
+
+	t.Run("create-invalid", func(t *testing.T) {
+		cred, err := NewX509CertificateCredential(ctx, cs.PublicId, nil, privateKey)
+		require.NoError(t, err)
+		_, err = repo.CreateX509CertificateCredential(ctx, prj.PublicId, cred)
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+
+		cred, err = NewX509CertificateCredential(ctx, cs.PublicId, certificate, nil)
+		require.NoError(t, err)
+		_, err = repo.CreateX509CertificateCredential(ctx, prj.PublicId, cred)
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+	})
+
+	t.Run("lifecycle", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		cred, err := NewX509CertificateCredential(ctx, cs.PublicId, certificate, privateKey, WithName("x509"))
+		require.NoError(err)
+		got, err := repo.CreateX509CertificateCredential(ctx, prj.PublicId, cred)
+		require.NoError(err)
+		assert.True(strings.HasPrefix(got.GetPublicId(), globals.X509CertificateCredentialPrefix+"_"))
+		assert.Equal(certificate, got.GetCertificate())
+		assert.Empty(got.GetPrivateKey())
+		assert.Empty(got.GetPrivateKeyEncrypted())
+		assert.NotEmpty(got.GetPrivateKeyHmac())
+		assert.NoError(db.TestVerifyOplog(t, rw, got.GetPublicId(), db.WithOperation(oplog.OpType_OP_TYPE_CREATE), db.WithCreateNotBefore(10*time.Second)))
+
+		looked, err := repo.LookupCredential(ctx, got.GetPublicId())
+		require.NoError(err)
+		require.IsType(&X509CertificateCredential{}, looked)
+		assert.Equal(got.GetPrivateKeyHmac(), looked.(*X509CertificateCredential).GetPrivateKeyHmac())
+		assert.Empty(looked.(*X509CertificateCredential).GetPrivateKeyEncrypted())
+
+		listed, err := repo.ListCredentials(ctx, cs.PublicId)
+		require.NoError(err)
+		require.Len(listed, 1)
+		assert.Equal(got.GetPublicId(), listed[0].GetPublicId())
+
+		// the certificate and the private key must be updated together
+		newCertificate, newPrivateKey := TestX509CertificateKeyPair(t, "new.example.com")
+		update, err := NewX509CertificateCredential(ctx, cs.PublicId, newCertificate, newPrivateKey)
+		require.NoError(err)
+		update.PublicId = got.GetPublicId()
+		_, _, err = repo.UpdateX509CertificateCredential(ctx, prj.PublicId, update, got.GetVersion(), []string{certificateField})
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+
+		updated, rowsUpdated, err := repo.UpdateX509CertificateCredential(ctx, prj.PublicId, update, got.GetVersion(), []string{certificateField, privateKeyField})
+		require.NoError(err)
+		assert.Equal(1, rowsUpdated)
+		assert.Equal(newCertificate, updated.GetCertificate())
+		assert.NotEqual(got.GetPrivateKeyHmac(), updated.GetPrivateKeyHmac())
+		assert.Empty(updated.GetPrivateKey())
+
+		retrieved, err := repo.Retrieve(ctx, prj.PublicId, []string{got.GetPublicId()})
+		require.NoError(err)
+		require.Len(retrieved, 1)
+		assert.Equal([]byte(newPrivateKey), retrieved[0].(*X509CertificateCredential).GetPrivateKey())
+
+		deleted, err := repo.DeleteCredential(ctx, prj.PublicId, got.GetPublicId())
+		require.NoError(err)
+		assert.Equal(1, deleted)
+		looked, err = repo.LookupCredential(ctx, got.GetPublicId())
+		require.NoError(err)
+		assert.Nil(looked)
+	})
+}
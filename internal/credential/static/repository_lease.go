@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package static
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/go-secure-stdlib/strutil"
+)
+
+// CredentialLease is the check out of an exclusive static credential by a
+// session.
+type CredentialLease struct {
+	CredentialId string
+	SessionId    string
+	CreateTime   time.Time
+	// CheckInTime is the time the session was terminated. It is zero while
+	// the credential is checked out. Credentials with a rotation schedule
+	// stay leased after being checked in until they have been rotated.
+	CheckInTime time.Time
+}
+
+type credentialLeaseResult struct {
+	CredentialId string
+	SessionId    string
+	CreateTime   time.Time
+	CheckInTime  sql.NullTime
+}
+
+// CheckOutCredentials checks out the static credentials with credentialIds
+// for the session with sessionId. Either all the credentials are checked out
+// or none are. An error with code Conflict is returned if a credential is
+// leased by another session.
+func (r *Repository) CheckOutCredentials(ctx context.Context, sessionId string, credentialIds []string, _ ...Option) error {
+	const op = "static.(Repository).CheckOutCredentials"
+	switch {
+	case sessionId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	case len(credentialIds) == 0:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing credential ids")
+	}
+	credentialIds = strutil.RemoveDuplicates(credentialIds, false)
+
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			for _, id := range credentialIds {
+				_, err := w.Exec(ctx, checkOutCredentialQuery, []any{
+					sql.Named("credential_id", id),
+					sql.Named("session_id", sessionId),
+				})
+				switch {
+				case errors.IsUniqueError(err):
+					return errors.New(ctx, errors.Conflict, op, fmt.Sprintf("credential %s is checked out by another session", id))
+				case err != nil:
+					return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to check out credential %s", id)))
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// LookupCredentialLease returns the lease of the static credential with
+// credentialId. Returns nil, nil if the credential is not leased.
+func (r *Repository) LookupCredentialLease(ctx context.Context, credentialId string, _ ...Option) (*CredentialLease, error) {
+	const op = "static.(Repository).LookupCredentialLease"
+	if credentialId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing credential id")
+	}
+	rows, err := r.reader.Query(ctx, lookupCredentialLeaseQuery, []any{sql.Named("credential_id", credentialId)})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var lease *CredentialLease
+	for rows.Next() {
+		var res credentialLeaseResult
+		if err := r.reader.ScanRows(ctx, rows, &res); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+		}
+		lease = &CredentialLease{
+			CredentialId: res.CredentialId,
+			SessionId:    res.SessionId,
+			CreateTime:   res.CreateTime,
+			CheckInTime:  res.CheckInTime.Time,
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return lease, nil
+}
+
+// checkInCredential releases the checked in lease of the static credential
+// with credentialId. If the credential has a rotation schedule it is rotated
+// first, and the lease is kept if the rotation fails.
+func (r *Repository) checkInCredential(ctx context.Context, credentialId string) error {
+	const op = "static.(Repository).checkInCredential"
+	rot, err := r.lookupCredentialRotation(ctx, credentialId)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if rot != nil {
+		if _, err := r.RotateCredential(ctx, credentialId); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	}
+	if _, err := r.writer.Exec(ctx, deleteCredentialLeaseQuery, []any{sql.Named("credential_id", credentialId)}); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete lease"))
+	}
+	return nil
+}
+
+// listCheckedInCredentialLeases returns the ids of up to limit credentials
+// whose leases have been checked in, the longest waiting first.
+func (r *Repository) listCheckedInCredentialLeases(ctx context.Context, limit int) ([]string, error) {
+	const op = "static.(Repository).listCheckedInCredentialLeases"
+	rows, err := r.reader.Query(ctx, listCheckedInCredentialLeasesQuery, []any{sql.Named("limit", limit)})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return ids, nil
+}
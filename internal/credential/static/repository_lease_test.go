@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package static
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CheckOutCredentials(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(err)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iamRepo)
+	cs := TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+	cred1 := TestUsernamePasswordCredential(t, conn, wrapper, "user1", "pass", cs.GetPublicId(), prj.GetPublicId())
+	cred2 := TestUsernamePasswordCredential(t, conn, wrapper, "user2", "pass", cs.GetPublicId(), prj.GetPublicId())
+	sess1 := session.TestDefaultSession(t, conn, wrapper, iamRepo)
+	sess2 := session.TestDefaultSession(t, conn, wrapper, iamRepo)
+
+	err = repo.CheckOutCredentials(ctx, "", []string{cred1.GetPublicId()})
+	assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+	err = repo.CheckOutCredentials(ctx, sess1.GetPublicId(), nil)
+	assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+
+	require.NoError(repo.CheckOutCredentials(ctx, sess1.GetPublicId(), []string{cred1.GetPublicId(), cred1.GetPublicId()}))
+	lease, err := repo.LookupCredentialLease(ctx, cred1.GetPublicId())
+	require.NoError(err)
+	require.NotNil(lease)
+	assert.Equal(sess1.GetPublicId(), lease.SessionId)
+	assert.True(lease.CheckInTime.IsZero())
+
+	// Checking out a leased credential fails and none of the credentials
+	// are checked out.
+	err = repo.CheckOutCredentials(ctx, sess2.GetPublicId(), []string{cred2.GetPublicId(), cred1.GetPublicId()})
+	assert.True(errors.Match(errors.T(errors.Conflict), err))
+	lease, err = repo.LookupCredentialLease(ctx, cred2.GetPublicId())
+	require.NoError(err)
+	assert.Nil(lease)
+}
+
+func TestCredentialLease_SessionTerminated(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(err)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iamRepo)
+	cs := TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+	plain := TestUsernamePasswordCredential(t, conn, wrapper, "plain", "pass", cs.GetPublicId(), prj.GetPublicId())
+	rotated := TestUsernamePasswordCredential(t, conn, wrapper, "rotated", "pass", cs.GetPublicId(), prj.GetPublicId())
+	_, err = repo.SetCredentialRotation(ctx, rotated.GetPublicId(), GenerateRotatorName, time.Hour)
+	require.NoError(err)
+
+	sess := session.TestDefaultSession(t, conn, wrapper, iamRepo)
+	require.NoError(repo.CheckOutCredentials(ctx, sess.GetPublicId(), []string{plain.GetPublicId(), rotated.GetPublicId()}))
+	session.TestState(t, conn, sess.GetPublicId(), session.StatusTerminated)
+
+	// The credential without a rotation schedule is released immediately.
+	lease, err := repo.LookupCredentialLease(ctx, plain.GetPublicId())
+	require.NoError(err)
+	assert.Nil(lease)
+
+	// The rotated credential stays leased until the check in job runs.
+	lease, err = repo.LookupCredentialLease(ctx, rotated.GetPublicId())
+	require.NoError(err)
+	require.NotNil(lease)
+	assert.False(lease.CheckInTime.IsZero())
+}
+
+func TestCredentialLease_SessionDeleted(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(err)
+	sessRepo, err := session.NewRepository(ctx, rw, rw, kms)
+	require.NoError(err)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iamRepo)
+	cs := TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+	plain := TestUsernamePasswordCredential(t, conn, wrapper, "plain", "pass", cs.GetPublicId(), prj.GetPublicId())
+	rotated := TestUsernamePasswordCredential(t, conn, wrapper, "rotated", "pass", cs.GetPublicId(), prj.GetPublicId())
+	terminatedRotated := TestUsernamePasswordCredential(t, conn, wrapper, "terminated", "pass", cs.GetPublicId(), prj.GetPublicId())
+	for _, c := range []*UsernamePasswordCredential{rotated, terminatedRotated} {
+		_, err = repo.SetCredentialRotation(ctx, c.GetPublicId(), GenerateRotatorName, time.Hour)
+		require.NoError(err)
+	}
+
+	// A session deleted without being terminated checks in its credentials.
+	sess := session.TestDefaultSession(t, conn, wrapper, iamRepo)
+	require.NoError(repo.CheckOutCredentials(ctx, sess.GetPublicId(), []string{plain.GetPublicId(), rotated.GetPublicId()}))
+	// A terminated session's rotated credentials stay leased after the
+	// session is deleted, until the check in job rotates them.
+	terminated := session.TestDefaultSession(t, conn, wrapper, iamRepo)
+	require.NoError(repo.CheckOutCredentials(ctx, terminated.GetPublicId(), []string{terminatedRotated.GetPublicId()}))
+	session.TestState(t, conn, terminated.GetPublicId(), session.StatusTerminated)
+
+	for _, s := range []*session.Session{sess, terminated} {
+		n, err := sessRepo.DeleteSession(ctx, s.GetPublicId())
+		require.NoError(err)
+		assert.Equal(1, n)
+	}
+
+	lease, err := repo.LookupCredentialLease(ctx, plain.GetPublicId())
+	require.NoError(err)
+	assert.Nil(lease)
+
+	for _, c := range []*UsernamePasswordCredential{rotated, terminatedRotated} {
+		lease, err = repo.LookupCredentialLease(ctx, c.GetPublicId())
+		require.NoError(err)
+		require.NotNil(lease)
+		assert.False(lease.CheckInTime.IsZero())
+	}
+}
@@ -0,0 +1,255 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package static
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/types/subtypes"
+	"golang.org/x/crypto/ssh"
+)
+
+// rotationRetryInterval is the longest time after which a failed rotation is
+// attempted again.
+const rotationRetryInterval = 5 * time.Minute
+
+type credentialRotationResult struct {
+	CredentialId          string
+	Rotator               string
+	RotationPeriodSeconds uint32
+	NextRotationTime      time.Time
+	LastRotationTime      sql.NullTime
+	LastRotationError     sql.NullString
+	ProjectId             string
+}
+
+func (c *credentialRotationResult) toCredentialRotation() *CredentialRotation {
+	return &CredentialRotation{
+		CredentialId:          c.CredentialId,
+		Rotator:               c.Rotator,
+		RotationPeriodSeconds: c.RotationPeriodSeconds,
+		NextRotationTime:      c.NextRotationTime,
+		LastRotationTime:      c.LastRotationTime.Time,
+		LastRotationError:     c.LastRotationError.String,
+	}
+}
+
+// SetCredentialRotation sets the rotation schedule of the static credential
+// with credentialId, replacing any existing schedule. The credential is
+// rotated by the registered Rotator named rotator every period, starting one
+// period from now. Only username password and SSH private key credentials can
+// be rotated.
+func (r *Repository) SetCredentialRotation(ctx context.Context, credentialId, rotator string, period time.Duration, _ ...Option) (*CredentialRotation, error) {
+	const op = "static.(Repository).SetCredentialRotation"
+	switch {
+	case credentialId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing credential id")
+	case rotator == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing rotator")
+	case period < time.Second:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "rotation period must be at least one second")
+	case period.Seconds() > math.MaxInt32:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "rotation period is too long")
+	}
+	switch subtypes.SubtypeFromId(credential.Domain, credentialId) {
+	case credential.UsernamePasswordSubtype, credential.SshPrivateKeySubtype:
+	default:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("credential %s cannot be rotated", credentialId))
+	}
+	if _, ok := rotators.get(rotator); !ok {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unknown rotator %q", rotator))
+	}
+
+	_, err := r.writer.Exec(ctx, setCredentialRotationQuery, []any{
+		sql.Named("credential_id", credentialId),
+		sql.Named("rotator", rotator),
+		sql.Named("rotation_period_seconds", int32(period/time.Second)),
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	rot, err := r.LookupCredentialRotation(ctx, credentialId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return rot, nil
+}
+
+// LookupCredentialRotation returns the rotation schedule of the static
+// credential with credentialId. Returns nil, nil if the credential has no
+// rotation schedule.
+func (r *Repository) LookupCredentialRotation(ctx context.Context, credentialId string, _ ...Option) (*CredentialRotation, error) {
+	const op = "static.(Repository).LookupCredentialRotation"
+	if credentialId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing credential id")
+	}
+	res, err := r.lookupCredentialRotation(ctx, credentialId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.toCredentialRotation(), nil
+}
+
+func (r *Repository) lookupCredentialRotation(ctx context.Context, credentialId string) (*credentialRotationResult, error) {
+	const op = "static.(Repository).lookupCredentialRotation"
+	rows, err := r.reader.Query(ctx, lookupCredentialRotationQuery, []any{sql.Named("credential_id", credentialId)})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var res *credentialRotationResult
+	for rows.Next() {
+		res = &credentialRotationResult{}
+		if err := r.reader.ScanRows(ctx, rows, res); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return res, nil
+}
+
+// DeleteCredentialRotation deletes the rotation schedule of the static
+// credential with credentialId. It returns 0 if the credential has no
+// rotation schedule.
+func (r *Repository) DeleteCredentialRotation(ctx context.Context, credentialId string, _ ...Option) (int, error) {
+	const op = "static.(Repository).DeleteCredentialRotation"
+	if credentialId == "" {
+		return 0, errors.New(ctx, errors.InvalidParameter, op, "missing credential id")
+	}
+	rowsDeleted, err := r.writer.Exec(ctx, deleteCredentialRotationQuery, []any{sql.Named("credential_id", credentialId)})
+	if err != nil {
+		return 0, errors.Wrap(ctx, err, op)
+	}
+	return rowsDeleted, nil
+}
+
+// RotateCredential rotates the static credential with credentialId now using
+// the rotator of its rotation schedule, and schedules the next rotation one
+// rotation period from now. If the rotation fails, the error is recorded in
+// the rotation schedule and the rotation is attempted again after the
+// shorter of the rotation period and five minutes.
+func (r *Repository) RotateCredential(ctx context.Context, credentialId string, _ ...Option) (*CredentialRotation, error) {
+	const op = "static.(Repository).RotateCredential"
+	if credentialId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing credential id")
+	}
+	res, err := r.lookupCredentialRotation(ctx, credentialId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if res == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("credential %s has no rotation schedule", credentialId))
+	}
+
+	if rotErr := r.rotate(ctx, res); rotErr != nil {
+		if _, err := r.writer.Exec(ctx, rotationFailedQuery, []any{
+			sql.Named("credential_id", credentialId),
+			sql.Named("last_rotation_error", rotErr.Error()),
+			sql.Named("retry_seconds", int32(rotationRetryInterval/time.Second)),
+		}); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to record rotation failure"))
+		}
+		return nil, errors.Wrap(ctx, rotErr, op)
+	}
+
+	if _, err := r.writer.Exec(ctx, rotationSucceededQuery, []any{sql.Named("credential_id", credentialId)}); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to record rotation"))
+	}
+	rot, err := r.LookupCredentialRotation(ctx, credentialId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return rot, nil
+}
+
+// rotate gets a new secret for the credential from its rotator and stores it.
+func (r *Repository) rotate(ctx context.Context, res *credentialRotationResult) error {
+	const op = "static.(Repository).rotate"
+	rotator, ok := rotators.get(res.Rotator)
+	if !ok {
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unknown rotator %q", res.Rotator))
+	}
+
+	creds, err := r.Retrieve(ctx, res.ProjectId, []string{res.CredentialId})
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	switch c := creds[0].(type) {
+	case *UsernamePasswordCredential:
+		pw, err := rotator.RotateUsernamePassword(ctx, c)
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("rotator %q failed", res.Rotator)))
+		}
+		if pw == "" {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("rotator %q returned an empty password", res.Rotator))
+		}
+		c.Password = []byte(pw)
+		if _, _, err := r.UpdateUsernamePasswordCredential(ctx, res.ProjectId, c, c.Version, []string{passwordField}); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+
+	case *SshPrivateKeyCredential:
+		key, passphrase, err := rotator.RotateSshPrivateKey(ctx, c)
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("rotator %q failed", res.Rotator)))
+		}
+		if len(passphrase) == 0 {
+			_, err = ssh.ParsePrivateKey(key)
+		} else {
+			_, err = ssh.ParsePrivateKeyWithPassphrase(key, passphrase)
+		}
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter),
+				errors.WithMsg(fmt.Sprintf("rotator %q returned an invalid private key", res.Rotator)))
+		}
+		fieldMask := []string{privateKeyField}
+		if len(passphrase) > 0 || len(c.PrivateKeyPassphrase) > 0 {
+			fieldMask = append(fieldMask, PrivateKeyPassphraseField)
+		}
+		c.PrivateKey = key
+		c.PrivateKeyPassphrase = passphrase
+		if _, _, err := r.UpdateSshPrivateKeyCredential(ctx, res.ProjectId, c, c.Version, fieldMask); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+
+	default:
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("credential %s cannot be rotated", res.CredentialId))
+	}
+	return nil
+}
+
+// listDueCredentialRotations returns the ids of up to limit credentials whose
+// next rotation time has passed, the most overdue first.
+func (r *Repository) listDueCredentialRotations(ctx context.Context, limit int) ([]string, error) {
+	const op = "static.(Repository).listDueCredentialRotations"
+	rows, err := r.reader.Query(ctx, listDueCredentialRotationsQuery, []any{sql.Named("limit", limit)})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return ids, nil
+}
@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package static
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh/testdata"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const failingRotatorName = "test-failing"
+
+type failingRotator struct{}
+
+func (failingRotator) RotateUsernamePassword(context.Context, *UsernamePasswordCredential) (credential.Password, error) {
+	return "", fmt.Errorf("rotation failed")
+}
+
+func (failingRotator) RotateSshPrivateKey(context.Context, *SshPrivateKeyCredential) (credential.PrivateKey, []byte, error) {
+	return nil, nil, fmt.Errorf("rotation failed")
+}
+
+func init() {
+	RegisterRotator(failingRotatorName, failingRotator{})
+}
+
+func TestRepository_SetCredentialRotation(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+	upCred := TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", cs.GetPublicId(), prj.GetPublicId())
+	// the object is passed as a literal so the lock of its struct isn't copied
+	fields := map[string]*structpb.Value{
+		"username": structpb.NewStringValue("user"),
+		"password": structpb.NewStringValue("password"),
+	}
+	jsonCred := TestJsonCredential(t, conn, wrapper, cs.GetPublicId(), prj.GetPublicId(), credential.JsonObject{Struct: structpb.Struct{Fields: fields}})
+
+	tests := []struct {
+		name         string
+		credentialId string
+		rotator      string
+		period       time.Duration
+		wantErrCode  errors.Code
+	}{
+		{
+			name:         "missing-credential-id",
+			rotator:      GenerateRotatorName,
+			period:       time.Hour,
+			wantErrCode:  errors.InvalidParameter,
+			credentialId: "",
+		},
+		{
+			name:         "missing-rotator",
+			credentialId: upCred.GetPublicId(),
+			period:       time.Hour,
+			wantErrCode:  errors.InvalidParameter,
+		},
+		{
+			name:         "unknown-rotator",
+			credentialId: upCred.GetPublicId(),
+			rotator:      "unknown",
+			period:       time.Hour,
+			wantErrCode:  errors.InvalidParameter,
+		},
+		{
+			name:         "period-too-short",
+			credentialId: upCred.GetPublicId(),
+			rotator:      GenerateRotatorName,
+			period:       time.Millisecond,
+			wantErrCode:  errors.InvalidParameter,
+		},
+		{
+			name:         "json-credential",
+			credentialId: jsonCred.GetPublicId(),
+			rotator:      GenerateRotatorName,
+			period:       time.Hour,
+			wantErrCode:  errors.InvalidParameter,
+		},
+		{
+			name:         "valid",
+			credentialId: upCred.GetPublicId(),
+			rotator:      GenerateRotatorName,
+			period:       time.Hour,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := repo.SetCredentialRotation(ctx, tt.credentialId, tt.rotator, tt.period)
+			if tt.wantErrCode != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantErrCode), err), "want err: %q got: %q", tt.wantErrCode, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			require.NotNil(got)
+			assert.Equal(tt.credentialId, got.CredentialId)
+			assert.Equal(tt.rotator, got.Rotator)
+			assert.Equal(tt.period, got.RotationPeriod())
+			assert.WithinDuration(time.Now().Add(tt.period), got.NextRotationTime, time.Minute)
+			assert.True(got.LastRotationTime.IsZero())
+			assert.Empty(got.LastRotationError)
+		})
+	}
+
+	t.Run("replace", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.SetCredentialRotation(ctx, upCred.GetPublicId(), failingRotatorName, 2*time.Hour)
+		require.NoError(err)
+		assert.Equal(failingRotatorName, got.Rotator)
+		assert.Equal(2*time.Hour, got.RotationPeriod())
+	})
+
+	t.Run("lookup-and-delete", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.LookupCredentialRotation(ctx, upCred.GetPublicId())
+		require.NoError(err)
+		require.NotNil(got)
+
+		n, err := repo.DeleteCredentialRotation(ctx, upCred.GetPublicId())
+		require.NoError(err)
+		assert.Equal(1, n)
+
+		got, err = repo.LookupCredentialRotation(ctx, upCred.GetPublicId())
+		require.NoError(err)
+		assert.Nil(got)
+
+		n, err = repo.DeleteCredentialRotation(ctx, upCred.GetPublicId())
+		require.NoError(err)
+		assert.Equal(0, n)
+	})
+}
+
+func TestRepository_RotateCredential(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+
+	t.Run("no-schedule", func(t *testing.T) {
+		upCred := TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", cs.GetPublicId(), prj.GetPublicId())
+		_, err := repo.RotateCredential(ctx, upCred.GetPublicId())
+		assert.True(t, errors.Match(errors.T(errors.RecordNotFound), err))
+	})
+
+	t.Run("username-password", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		upCred := TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", cs.GetPublicId(), prj.GetPublicId())
+		_, err := repo.SetCredentialRotation(ctx, upCred.GetPublicId(), GenerateRotatorName, time.Hour)
+		require.NoError(err)
+
+		got, err := repo.RotateCredential(ctx, upCred.GetPublicId())
+		require.NoError(err)
+		assert.WithinDuration(time.Now(), got.LastRotationTime, time.Minute)
+		assert.WithinDuration(time.Now().Add(time.Hour), got.NextRotationTime, time.Minute)
+
+		creds, err := repo.Retrieve(ctx, prj.GetPublicId(), []string{upCred.GetPublicId()})
+		require.NoError(err)
+		require.Len(creds, 1)
+		rotated := creds[0].(*UsernamePasswordCredential)
+		assert.Equal("user", rotated.GetUsername())
+		assert.NotEqual("pass", string(rotated.GetPassword()))
+		assert.Len(rotated.GetPassword(), generatedPasswordLength)
+		assert.Equal(upCred.GetVersion()+1, rotated.GetVersion())
+	})
+
+	t.Run("ssh-private-key", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		spkCred := TestSshPrivateKeyCredential(t, conn, wrapper, "user",
+			string(testdata.PEMEncryptedKeys[0].PEMBytes), cs.GetPublicId(), prj.GetPublicId(),
+			WithPrivateKeyPassphrase([]byte(testdata.PEMEncryptedKeys[0].EncryptionKey)))
+		_, err := repo.SetCredentialRotation(ctx, spkCred.GetPublicId(), GenerateRotatorName, time.Hour)
+		require.NoError(err)
+
+		_, err = repo.RotateCredential(ctx, spkCred.GetPublicId())
+		require.NoError(err)
+
+		creds, err := repo.Retrieve(ctx, prj.GetPublicId(), []string{spkCred.GetPublicId()})
+		require.NoError(err)
+		require.Len(creds, 1)
+		rotated := creds[0].(*SshPrivateKeyCredential)
+		assert.NotEqual(testdata.PEMEncryptedKeys[0].PEMBytes, rotated.GetPrivateKey())
+		assert.Empty(rotated.GetPrivateKeyPassphrase())
+	})
+
+	t.Run("failing-rotator", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		upCred := TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", cs.GetPublicId(), prj.GetPublicId())
+		_, err := repo.SetCredentialRotation(ctx, upCred.GetPublicId(), failingRotatorName, time.Hour)
+		require.NoError(err)
+
+		_, err = repo.RotateCredential(ctx, upCred.GetPublicId())
+		require.Error(err)
+
+		got, err := repo.LookupCredentialRotation(ctx, upCred.GetPublicId())
+		require.NoError(err)
+		assert.Contains(got.LastRotationError, "rotation failed")
+		assert.True(got.LastRotationTime.IsZero())
+		// Failed rotations are retried sooner than the rotation period
+		assert.WithinDuration(time.Now().Add(rotationRetryInterval), got.NextRotationTime, time.Minute)
+
+		creds, err := repo.Retrieve(ctx, prj.GetPublicId(), []string{upCred.GetPublicId()})
+		require.NoError(err)
+		assert.Equal("pass", string(creds[0].(*UsernamePasswordCredential).GetPassword()))
+	})
+}
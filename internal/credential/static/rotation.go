@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package static
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/credential"
+)
+
+// GenerateRotatorName is the name of the built-in rotator, which generates
+// new secrets without applying them anywhere. It is suited to credentials
+// whose secrets are only known to Boundary, such as credentials injected into
+// sessions.
+const GenerateRotatorName = "generate"
+
+// A Rotator creates new secrets for static credentials. Rotators which manage
+// the systems the credentials are used with should change the secret there
+// before returning it. Rotators must be safe for concurrent use.
+type Rotator interface {
+	// RotateUsernamePassword returns a new password for c. The password of
+	// c is the current one.
+	RotateUsernamePassword(ctx context.Context, c *UsernamePasswordCredential) (credential.Password, error)
+
+	// RotateSshPrivateKey returns a new private key, and its passphrase if
+	// any, for c. The private key of c is the current one.
+	RotateSshPrivateKey(ctx context.Context, c *SshPrivateKeyCredential) (credential.PrivateKey, []byte, error)
+}
+
+type rotatorRegistry struct {
+	m map[string]Rotator
+
+	sync.RWMutex
+}
+
+func (r *rotatorRegistry) set(name string, rotator Rotator) {
+	r.Lock()
+	defer r.Unlock()
+
+	if _, previouslySet := r.m[name]; previouslySet {
+		panic(fmt.Sprintf("static credential rotator %s already registered", name))
+	}
+	r.m[name] = rotator
+}
+
+func (r *rotatorRegistry) get(name string) (Rotator, bool) {
+	r.RLock()
+	defer r.RUnlock()
+
+	rotator, ok := r.m[name]
+	return rotator, ok
+}
+
+var rotators = rotatorRegistry{
+	m: map[string]Rotator{
+		GenerateRotatorName: generateRotator{},
+	},
+}
+
+// RegisterRotator registers a Rotator under name, so that it can be used in
+// credential rotation schedules. It panics if a rotator is already registered
+// with the same name.
+func RegisterRotator(name string, rotator Rotator) {
+	rotators.set(name, rotator)
+}
+
+// CredentialRotation is the rotation schedule of a static credential.
+type CredentialRotation struct {
+	CredentialId string
+	// Rotator is the name of the registered Rotator used to rotate the
+	// credential.
+	Rotator               string
+	RotationPeriodSeconds uint32
+	NextRotationTime      time.Time
+	// LastRotationTime is the zero time if the credential has not been
+	// rotated yet.
+	LastRotationTime time.Time
+	// LastRotationError is the error of the last rotation attempt, if it
+	// failed.
+	LastRotationError string
+}
+
+// RotationPeriod returns the time between two rotations of the credential.
+func (c *CredentialRotation) RotationPeriod() time.Duration {
+	return time.Duration(c.RotationPeriodSeconds) * time.Second
+}
+
+// generatedPasswordLength is the length of the passwords generated by the
+// generate rotator.
+const generatedPasswordLength = 32
+
+const generatedPasswordChars = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+type generateRotator struct{}
+
+func (generateRotator) RotateUsernamePassword(_ context.Context, _ *UsernamePasswordCredential) (credential.Password, error) {
+	pw := make([]byte, generatedPasswordLength)
+	max := big.NewInt(int64(len(generatedPasswordChars)))
+	for i := range pw {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		pw[i] = generatedPasswordChars[n.Int64()]
+	}
+	return credential.Password(pw), nil
+}
+
+func (generateRotator) RotateSshPrivateKey(_ context.Context, _ *SshPrivateKeyCredential) (credential.PrivateKey, []byte, error) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil, nil
+}
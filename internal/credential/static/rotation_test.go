@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package static
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestGenerateRotator(t *testing.T) {
+	ctx := context.Background()
+	rotator, ok := rotators.get(GenerateRotatorName)
+	require.True(t, ok)
+
+	t.Run("username-password", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		pw1, err := rotator.RotateUsernamePassword(ctx, nil)
+		require.NoError(err)
+		assert.Len(pw1, generatedPasswordLength)
+		pw2, err := rotator.RotateUsernamePassword(ctx, nil)
+		require.NoError(err)
+		assert.NotEqual(pw1, pw2)
+	})
+
+	t.Run("ssh-private-key", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		key, passphrase, err := rotator.RotateSshPrivateKey(ctx, nil)
+		require.NoError(err)
+		assert.Empty(passphrase)
+		signer, err := ssh.ParsePrivateKey(key)
+		require.NoError(err)
+		assert.Equal(ssh.KeyAlgoED25519, signer.PublicKey().Type())
+	})
+}
+
+func TestRegisterRotator(t *testing.T) {
+	assert := assert.New(t)
+	assert.Panics(func() { RegisterRotator(GenerateRotatorName, generateRotator{}) })
+
+	const name = "test-register-rotator"
+	_, ok := rotators.get(name)
+	assert.False(ok)
+	RegisterRotator(name, generateRotator{})
+	t.Cleanup(func() {
+		rotators.Lock()
+		defer rotators.Unlock()
+		delete(rotators.m, name)
+	})
+	_, ok = rotators.get(name)
+	assert.True(ok)
+}
+
+func TestCredentialRotation_RotationPeriod(t *testing.T) {
+	rot := &CredentialRotation{RotationPeriodSeconds: 90}
+	assert.Equal(t, 90*time.Second, rot.RotationPeriod())
+}
@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package static
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/static/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/libs/crypto"
+	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"google.golang.org/protobuf/proto"
+)
+
+var _ credential.Static = (*X509CertificateCredential)(nil)
+
+// A X509CertificateCredential contains the credential with an X.509 client
+// certificate and its private key. It is owned by a credential store.
+type X509CertificateCredential struct {
+	*store.X509CertificateCredential
+	tableName string `gorm:"-"`
+}
+
+// NewX509CertificateCredential creates a new in memory static Credential
+// containing a PEM encoded X.509 client certificate, which may be followed by
+// the intermediate certificates of its chain, and its PEM encoded private key
+// that is assigned to storeId. If both the certificate and the private key
+// are set, the private key must match the certificate. Name and description
+// are the only valid options. All other options are ignored.
+func NewX509CertificateCredential(
+	ctx context.Context,
+	storeId string,
+	certificate []byte,
+	privateKey credential.PrivateKey,
+	opt ...Option,
+) (*X509CertificateCredential, error) {
+	const op = "static.NewX509CertificateCredential"
+
+	switch {
+	case len(certificate) != 0 && len(privateKey) != 0:
+		// X509KeyPair parses the certificate chain and the private key and
+		// verifies the private key matches the leaf certificate.
+		if _, err := tls.X509KeyPair(certificate, privateKey); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter))
+		}
+	case len(certificate) != 0:
+		if err := validateCertificate(ctx, certificate); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	case len(privateKey) != 0:
+		if err := validatePrivateKey(ctx, privateKey); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+
+	opts := getOpts(opt...)
+	l := &X509CertificateCredential{
+		X509CertificateCredential: &store.X509CertificateCredential{
+			StoreId:     storeId,
+			Name:        opts.withName,
+			Description: opts.withDescription,
+			Certificate: certificate,
+			PrivateKey:  privateKey,
+		},
+	}
+	return l, nil
+}
+
+// validateCertificate returns an error if certificate does not contain at
+// least one PEM encoded X.509 certificate or if any of its PEM blocks is not
+// a valid certificate.
+func validateCertificate(ctx context.Context, certificate []byte) error {
+	const op = "static.validateCertificate"
+	var found bool
+	for block, rest := pem.Decode(certificate); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unexpected pem block type %q", block.Type))
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter))
+		}
+		found = true
+	}
+	if !found {
+		return errors.New(ctx, errors.InvalidParameter, op, "no pem encoded certificate")
+	}
+	return nil
+}
+
+// validatePrivateKey returns an error if privateKey is not a PEM encoded
+// PKCS #1, PKCS #8 or EC private key.
+func validatePrivateKey(ctx context.Context, privateKey []byte) error {
+	const op = "static.validatePrivateKey"
+	block, _ := pem.Decode(privateKey)
+	if block == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "no pem encoded private key")
+	}
+	if _, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		return nil
+	}
+	if _, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return nil
+	}
+	if _, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return nil
+	}
+	return errors.New(ctx, errors.InvalidParameter, op, "unable to parse private key")
+}
+
+func allocX509CertificateCredential() *X509CertificateCredential {
+	return &X509CertificateCredential{
+		X509CertificateCredential: &store.X509CertificateCredential{},
+	}
+}
+
+func (c *X509CertificateCredential) clone() *X509CertificateCredential {
+	cp := proto.Clone(c.X509CertificateCredential)
+	return &X509CertificateCredential{
+		X509CertificateCredential: cp.(*store.X509CertificateCredential),
+	}
+}
+
+// TableName returns the table name.
+func (c *X509CertificateCredential) TableName() string {
+	if c.tableName != "" {
+		return c.tableName
+	}
+	return "credential_static_x509_certificate_credential"
+}
+
+// SetTableName sets the table name.
+func (c *X509CertificateCredential) SetTableName(n string) {
+	c.tableName = n
+}
+
+func (c *X509CertificateCredential) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{c.PublicId},
+		"resource-type":      []string{"credential-static-x509-certificate"},
+		"op-type":            []string{op.String()},
+	}
+	if c.StoreId != "" {
+		metadata["store-id"] = []string{c.StoreId}
+	}
+	return metadata
+}
+
+func (c *X509CertificateCredential) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "static.(X509CertificateCredential).encrypt"
+
+	if len(c.PrivateKey) == 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, "no private key defined")
+	}
+
+	keyId, err := cipher.KeyId(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("error reading cipher key id"))
+	}
+	c.KeyId = keyId
+
+	blobInfo, err := cipher.Encrypt(ctx, c.PrivateKey)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	protoBytes, err := proto.Marshal(blobInfo)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encode))
+	}
+	c.PrivateKeyEncrypted = protoBytes
+	if err := c.hmacPrivateKey(ctx, cipher); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+func (c *X509CertificateCredential) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "static.(X509CertificateCredential).decrypt"
+
+	if len(c.PrivateKeyEncrypted) > 0 {
+		dec := new(wrapping.BlobInfo)
+		if err := proto.Unmarshal(c.PrivateKeyEncrypted, dec); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decode))
+		}
+		pt, err := cipher.Decrypt(ctx, dec)
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+		}
+		c.PrivateKey = pt
+	}
+	return nil
+}
+
+func (c *X509CertificateCredential) hmacPrivateKey(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "static.(X509CertificateCredential).hmacPrivateKey"
+	if cipher == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing cipher")
+	}
+	hm, err := crypto.HmacSha256(ctx, c.PrivateKey, cipher, []byte(c.StoreId), nil)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	c.PrivateKeyHmac = []byte(hm)
+	return nil
+}
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package static
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/libs/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestX509CertificateCredential_New(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	certificate, privateKey := TestX509CertificateKeyPair(t, "client.example.com")
+	otherCertificate, otherPrivateKey := TestX509CertificateKeyPair(t, "other.example.com")
+
+	tests := []struct {
+		name        string
+		certificate []byte
+		privateKey  credential.PrivateKey
+		wantErr     bool
+	}{
+		{
+			name:        "valid",
+			certificate: certificate,
+			privateKey:  privateKey,
+		},
+		{
+			name:        "valid-chain",
+			certificate: append(append([]byte{}, certificate...), otherCertificate...),
+			privateKey:  privateKey,
+		},
+		{
+			name:        "valid-certificate-only",
+			certificate: certificate,
+		},
+		{
+			name:       "valid-private-key-only",
+			privateKey: privateKey,
+		},
+		{
+			name:        "mismatched-private-key",
+			certificate: certificate,
+			privateKey:  otherPrivateKey,
+			wantErr:     true,
+		},
+		{
+			name:        "invalid-certificate",
+			certificate: []byte("not a certificate"),
+			wantErr:     true,
+		},
+		{
+			name:        "private-key-as-certificate",
+			certificate: privateKey,
+			wantErr:     true,
+		},
+		{
+			name:       "invalid-private-key",
+			privateKey: credential.PrivateKey("not a private key"),
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewX509CertificateCredential(ctx, "csst_1234567890", tt.certificate, tt.privateKey, WithName("x509"))
+			if tt.wantErr {
+				assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal("csst_1234567890", got.GetStoreId())
+			assert.Equal("x509", got.GetName())
+			assert.Equal(tt.certificate, got.GetCertificate())
+			assert.Equal([]byte(tt.privateKey), got.GetPrivateKey())
+		})
+	}
+}
+
+func TestX509CertificateCredential_Encrypt(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kkms := kms.TestKms(t, conn, wrapper)
+
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, wrapper, prj.PublicId)
+	certificate, privateKey := TestX509CertificateKeyPair(t, "client.example.com")
+
+	assert, require := assert.New(t), require.New(t)
+	cred := TestX509CertificateCredential(t, conn, wrapper, certificate, privateKey, cs.PublicId, prj.PublicId)
+	databaseWrapper, err := kkms.GetWrapper(ctx, prj.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(err)
+
+	got := allocX509CertificateCredential()
+	got.PublicId = cred.GetPublicId()
+	require.NoError(db.New(conn).LookupByPublicId(ctx, got))
+	assert.Equal(certificate, got.GetCertificate())
+	assert.Empty(got.GetPrivateKey())
+	assert.NotEmpty(got.GetPrivateKeyEncrypted())
+	assert.Equal(cred.GetKeyId(), got.GetKeyId())
+
+	require.NoError(got.decrypt(ctx, databaseWrapper))
+	assert.Equal([]byte(privateKey), got.GetPrivateKey())
+
+	hm, err := crypto.HmacSha256(ctx, privateKey, databaseWrapper, []byte(cs.GetPublicId()), nil)
+	require.NoError(err)
+	assert.Equal([]byte(hm), got.GetPrivateKeyHmac())
+}
@@ -38,6 +38,11 @@ const (
 	// UnknownCredentialStatus represents a credential that has an unknown
 	// status.
 	UnknownCredentialStatus CredentialStatus = "unknown"
+
+	// RevokeFailedCredential represents a credential that could not be
+	// revoked within the maximum number of revocation attempts. This is a
+	// terminal status.
+	RevokeFailedCredential CredentialStatus = "revoke_failed"
 )
 
 // A Credential contains the data for a Vault lease. It is owned by a credential library.
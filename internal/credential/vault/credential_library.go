@@ -33,8 +33,9 @@ type CredentialLibrary struct {
 
 // NewCredentialLibrary creates a new in memory CredentialLibrary
 // for a Vault backend at vaultPath assigned to storeId.
-// Name, description, method, request body, credential type, and mapping
-// override are the only valid options. All other options are ignored.
+// Name, description, method, request body, credential type, mapping
+// override, mapping expression, secret version, max requests per minute, and
+// namespace are the only valid options. All other options are ignored.
 func NewCredentialLibrary(storeId string, vaultPath string, opt ...Option) (*CredentialLibrary, error) {
 	const op = "vault.NewCredentialLibrary"
 	opts := getOpts(opt...)
@@ -42,13 +43,17 @@ func NewCredentialLibrary(storeId string, vaultPath string, opt ...Option) (*Cre
 	l := &CredentialLibrary{
 		MappingOverride: opts.withMappingOverride,
 		CredentialLibrary: &store.CredentialLibrary{
-			StoreId:         storeId,
-			Name:            opts.withName,
-			Description:     opts.withDescription,
-			VaultPath:       vaultPath,
-			HttpRequestBody: opts.withRequestBody,
-			HttpMethod:      string(opts.withMethod),
-			CredentialType:  string(opts.withCredentialType),
+			StoreId:              storeId,
+			Name:                 opts.withName,
+			Description:          opts.withDescription,
+			VaultPath:            vaultPath,
+			HttpRequestBody:      opts.withRequestBody,
+			HttpMethod:           string(opts.withMethod),
+			CredentialType:       string(opts.withCredentialType),
+			MappingExpression:    opts.withMappingExpression,
+			KvSecretVersion:      opts.withKvSecretVersion,
+			MaxRequestsPerMinute: opts.withMaxRequestsPerMinute,
+			Namespace:            opts.withNamespace,
 		},
 	}
 
@@ -59,6 +64,17 @@ func (l *CredentialLibrary) validate(ctx context.Context, caller errors.Op) erro
 	switch {
 	case !validMappingOverride(l.MappingOverride, l.CredentialType()):
 		return errors.New(ctx, errors.VaultInvalidMappingOverride, caller, "invalid credential type for mapping override")
+	case l.KvSecretVersion > 0 && Method(l.HttpMethod) != MethodGet:
+		return errors.New(ctx, errors.InvalidParameter, caller, "secret version can only be set with the GET http method")
+	case l.MappingExpression != "" && l.MappingOverride != nil:
+		return errors.New(ctx, errors.InvalidParameter, caller, "mapping expression and mapping override cannot both be set")
+	case l.MappingExpression != "":
+		if _, err := parseMappingExpression(ctx, l.MappingExpression, l.CredentialType()); err != nil {
+			return errors.Wrap(ctx, err, caller)
+		}
+	}
+	if err := validateRequestBody(ctx, l.HttpRequestBody); err != nil {
+		return errors.Wrap(ctx, err, caller)
 	}
 	return nil
 }
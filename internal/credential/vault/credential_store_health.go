@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"crypto/x509"
+	"database/sql"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	vault "github.com/hashicorp/vault/api"
+)
+
+// HealthStatus is the status of a credential store health check.
+type HealthStatus string
+
+// The statuses of a credential store health check.
+const (
+	// HealthyStatus means the credential store can issue credentials.
+	HealthyStatus HealthStatus = "healthy"
+
+	// DegradedStatus means the credential store can issue credentials but
+	// will soon stop being able to, such as when its certificates are about
+	// to expire.
+	DegradedStatus HealthStatus = "degraded"
+
+	// UnhealthyStatus means the credential store cannot issue credentials.
+	UnhealthyStatus HealthStatus = "unhealthy"
+)
+
+// healthWarningWindow is how long before its Vault token or a certificate
+// expires that a credential store is degraded.
+const healthWarningWindow = 7 * 24 * time.Hour
+
+// CredentialStoreHealth is the result of the latest health check of a
+// credential store.
+type CredentialStoreHealth struct {
+	StoreId   string
+	Status    HealthStatus
+	Message   string
+	CheckTime time.Time
+	// TokenExpirationTime is the time the Vault token of the store expires.
+	// It is zero if it is unknown or the token does not expire.
+	TokenExpirationTime time.Time
+	// CertificateExpirationTime is the time the earliest expiring of the CA
+	// and client certificates of the store expires. It is zero if the store
+	// has no certificates.
+	CertificateExpirationTime time.Time
+}
+
+type credentialStoreHealthResult struct {
+	StoreId                   string
+	Status                    string
+	Message                   sql.NullString
+	CheckTime                 time.Time
+	TokenExpirationTime       sql.NullTime
+	CertificateExpirationTime sql.NullTime
+}
+
+// ListCredentialStoreHealth returns the latest health checks of the
+// credential stores with storeIds, by store id. Stores which have not been
+// checked yet are not included.
+func (r *Repository) ListCredentialStoreHealth(ctx context.Context, storeIds []string, _ ...Option) (map[string]*CredentialStoreHealth, error) {
+	const op = "vault.(Repository).ListCredentialStoreHealth"
+	if len(storeIds) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing store ids")
+	}
+	rows, err := r.reader.Query(ctx, listCredentialStoreHealthQuery, []any{
+		sql.Named("store_ids", "{"+strings.Join(storeIds, ",")+"}"),
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	health := make(map[string]*CredentialStoreHealth, len(storeIds))
+	for rows.Next() {
+		var res credentialStoreHealthResult
+		if err := r.reader.ScanRows(ctx, rows, &res); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+		}
+		health[res.StoreId] = &CredentialStoreHealth{
+			StoreId:                   res.StoreId,
+			Status:                    HealthStatus(res.Status),
+			Message:                   res.Message.String,
+			CheckTime:                 res.CheckTime,
+			TokenExpirationTime:       res.TokenExpirationTime.Time,
+			CertificateExpirationTime: res.CertificateExpirationTime.Time,
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return health, nil
+}
+
+// checkHealth checks whether the decrypted store s can issue credentials: it
+// must have a current Vault token which Vault accepts, and none of its
+// certificates can have expired.
+func checkHealth(ctx context.Context, s *clientStore, now time.Time) *CredentialStoreHealth {
+	h := &CredentialStoreHealth{
+		StoreId: s.PublicId,
+		Status:  HealthyStatus,
+	}
+	var messages []string
+	report := func(status HealthStatus, msg string) {
+		if status == UnhealthyStatus || h.Status == HealthyStatus {
+			h.Status = status
+		}
+		messages = append(messages, msg)
+	}
+
+	certExpiration, err := earliestCertificateExpiration(s.CaCert, s.ClientCert)
+	switch {
+	case err != nil:
+		report(UnhealthyStatus, err.Error())
+	case certExpiration.IsZero():
+	case !now.Before(certExpiration):
+		report(UnhealthyStatus, fmt.Sprintf("certificate expired at %s", certExpiration.Format(time.RFC3339)))
+	case certExpiration.Sub(now) < healthWarningWindow:
+		report(DegradedStatus, fmt.Sprintf("certificate expires at %s", certExpiration.Format(time.RFC3339)))
+	}
+	h.CertificateExpirationTime = certExpiration
+
+	if s.token() == nil {
+		report(UnhealthyStatus, "no current vault token")
+		h.Message = strings.Join(messages, "; ")
+		return h
+	}
+	client, err := s.client(ctx)
+	if err != nil {
+		report(UnhealthyStatus, err.Error())
+		h.Message = strings.Join(messages, "; ")
+		return h
+	}
+	var respErr *vault.ResponseError
+	secret, err := client.lookupToken(ctx)
+	switch {
+	case errors.As(err, &respErr) && respErr.StatusCode == http.StatusForbidden:
+		report(UnhealthyStatus, "vault token is invalid or expired")
+	case err != nil:
+		report(UnhealthyStatus, fmt.Sprintf("unable to reach vault: %v", err))
+	default:
+		ttl, err := secret.TokenTTL()
+		if err != nil {
+			report(UnhealthyStatus, fmt.Sprintf("unable to get vault token expiration: %v", err))
+			break
+		}
+		if ttl <= 0 {
+			break
+		}
+		h.TokenExpirationTime = now.Add(ttl)
+		if renewable, _ := secret.TokenIsRenewable(); !renewable && ttl < healthWarningWindow {
+			report(DegradedStatus, fmt.Sprintf("vault token cannot be renewed and expires at %s", h.TokenExpirationTime.Format(time.RFC3339)))
+		}
+	}
+	h.Message = strings.Join(messages, "; ")
+	return h
+}
+
+// earliestCertificateExpiration returns the earliest expiration time of the
+// certificates in the PEM encoded bundles, or the zero time if there are
+// none.
+func earliestCertificateExpiration(bundles ...[]byte) (time.Time, error) {
+	var earliest time.Time
+	for _, rest := range bundles {
+		for {
+			var block *pem.Block
+			block, rest = pem.Decode(rest)
+			if block == nil {
+				break
+			}
+			if block.Type != "CERTIFICATE" {
+				continue
+			}
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return time.Time{}, fmt.Errorf("unable to parse certificate: %w", err)
+			}
+			if earliest.IsZero() || cert.NotAfter.Before(earliest) {
+				earliest = cert.NotAfter
+			}
+		}
+	}
+	return earliest, nil
+}
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEarliestCertificateExpiration(t *testing.T) {
+	ca := testCaCert(t)
+	bundle := testClientCert(t, ca)
+	earliest := ca.certificate.NotAfter
+	if bundle.Cert.certificate.NotAfter.Before(earliest) {
+		earliest = bundle.Cert.certificate.NotAfter
+	}
+	key := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: []byte("not a certificate")})
+
+	tests := []struct {
+		name    string
+		bundles [][]byte
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			name: "no-certificates",
+		},
+		{
+			name:    "one-certificate",
+			bundles: [][]byte{ca.Cert},
+			want:    ca.certificate.NotAfter,
+		},
+		{
+			name:    "multiple-bundles",
+			bundles: [][]byte{ca.Cert, append(bundle.Cert.Cert, bundle.CA.Cert...)},
+			want:    earliest,
+		},
+		{
+			name:    "skips-other-blocks",
+			bundles: [][]byte{append(key, ca.Cert...)},
+			want:    ca.certificate.NotAfter,
+		},
+		{
+			name:    "invalid-certificate",
+			bundles: [][]byte{pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("invalid")})},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := earliestCertificateExpiration(tt.bundles...)
+			if tt.wantErr {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+			assert.True(tt.want.Equal(got), "want %s, got %s", tt.want, got)
+		})
+	}
+}
+
+func TestCheckHealth_NoToken(t *testing.T) {
+	ctx := context.Background()
+	ca := testCaCert(t)
+	notAfter := ca.certificate.NotAfter
+
+	tests := []struct {
+		name       string
+		caCert     []byte
+		now        time.Time
+		wantStatus HealthStatus
+		wantMsg    string
+	}{
+		{
+			name:       "no-token",
+			now:        time.Now(),
+			wantStatus: UnhealthyStatus,
+			wantMsg:    "no current vault token",
+		},
+		{
+			name:       "expiring-certificate",
+			caCert:     ca.Cert,
+			now:        notAfter.Add(-time.Hour),
+			wantStatus: UnhealthyStatus,
+			wantMsg:    "certificate expires at " + notAfter.Format(time.RFC3339) + "; no current vault token",
+		},
+		{
+			name:       "expired-certificate",
+			caCert:     ca.Cert,
+			now:        notAfter.Add(time.Hour),
+			wantStatus: UnhealthyStatus,
+			wantMsg:    "certificate expired at " + notAfter.Format(time.RFC3339) + "; no current vault token",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			s := allocClientStore()
+			s.PublicId = "csvlt_1234567890"
+			s.CaCert = tt.caCert
+			got := checkHealth(ctx, s, tt.now)
+			assert.Equal(s.PublicId, got.StoreId)
+			assert.Equal(tt.wantStatus, got.Status)
+			assert.Equal(tt.wantMsg, got.Message)
+			assert.True(got.TokenExpirationTime.IsZero())
+			if len(tt.caCert) > 0 {
+				assert.True(notAfter.Equal(got.CertificateExpirationTime))
+			}
+		})
+	}
+}
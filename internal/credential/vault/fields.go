@@ -12,6 +12,11 @@ const (
 	httpMethodField      = "HttpMethod"
 	httpRequestBodyField = "HttpRequestBody"
 
+	mappingExpressionField = "MappingExpression"
+	kvSecretVersionField   = "KvSecretVersion"
+
+	maxRequestsPerMinuteField = "MaxRequestsPerMinute"
+
 	usernameField = "Username"
 	keyTypeField  = "KeyType"
 	keyBitsField  = "KeyBits"
@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package mappingexpression provides a small expression language used to
+// map the data of a Vault secret to the attributes of a credential.
+//
+// An expression is a list of assignments, one per line, of the form:
+//
+//	attribute = template
+//
+// where template is a Go text/template executed against the secret data.
+// Blank lines and lines starting with '#' are ignored. For example, the
+// following maps a KV-v2 secret storing a user and pass to a username and
+// password:
+//
+//	username = {{ .data.user }}
+//	password = {{ .data.pass }}
+//
+// Besides the builtin template functions, base64decode and trim are
+// available to decode base64 encoded values and remove surrounding
+// whitespace.
+package mappingexpression
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mappingexpression
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+var funcs = template.FuncMap{
+	"base64decode": func(s string) (string, error) {
+		b, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	},
+	"trim": strings.TrimSpace,
+}
+
+// An Expression maps the data of a Vault secret to a set of attributes.
+type Expression struct {
+	assignments map[string]*template.Template
+}
+
+// Parse parses expr. Every attribute assigned in expr must be in attrs and
+// every attribute in required must be assigned. An attribute can only be
+// assigned once.
+func Parse(ctx context.Context, expr string, attrs, required []string) (*Expression, error) {
+	const op = "mappingexpression.Parse"
+	if strings.TrimSpace(expr) == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "empty mapping expression")
+	}
+
+	valid := make(map[string]bool, len(attrs))
+	for _, a := range attrs {
+		valid[a] = true
+	}
+
+	e := &Expression{assignments: make(map[string]*template.Template)}
+	for i, line := range strings.Split(expr, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		attr, raw, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("line %d: missing '=' in assignment", i+1))
+		}
+		attr, raw = strings.TrimSpace(attr), strings.TrimSpace(raw)
+		switch {
+		case !valid[attr]:
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("line %d: unknown attribute %q, must be one of %s", i+1, attr, strings.Join(attrs, ", ")))
+		case e.assignments[attr] != nil:
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("line %d: attribute %q assigned more than once", i+1, attr))
+		case raw == "":
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("line %d: missing template for attribute %q", i+1, attr))
+		}
+		tmpl, err := template.New(attr).Option("missingkey=error").Funcs(funcs).Parse(raw)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter), errors.WithMsg(fmt.Sprintf("line %d", i+1)))
+		}
+		e.assignments[attr] = tmpl
+	}
+
+	var missing []string
+	for _, r := range required {
+		if e.assignments[r] == nil {
+			missing = append(missing, r)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("missing assignment for %s", strings.Join(missing, ", ")))
+	}
+	return e, nil
+}
+
+// Evaluate executes the templates of e against d and returns the
+// resulting attributes. An error is returned if a template references data
+// not present in d.
+func (e *Expression) Evaluate(ctx context.Context, d map[string]any) (map[string]any, error) {
+	const op = "mappingexpression.(Expression).Evaluate"
+	out := make(map[string]any, len(e.assignments))
+	for _, attr := range e.attributes() {
+		var buf bytes.Buffer
+		if err := e.assignments[attr].Execute(&buf, d); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.VaultInvalidCredentialMapping), errors.WithMsg(fmt.Sprintf("attribute %q", attr)))
+		}
+		out[attr] = buf.String()
+	}
+	return out, nil
+}
+
+// attributes returns the attributes assigned in e in a stable order.
+func (e *Expression) attributes() []string {
+	attrs := make([]string, 0, len(e.assignments))
+	for a := range e.assignments {
+		attrs = append(attrs, a)
+	}
+	sort.Strings(attrs)
+	return attrs
+}
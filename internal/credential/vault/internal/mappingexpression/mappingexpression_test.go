@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mappingexpression
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	attrs := []string{"username", "password"}
+	required := []string{"username", "password"}
+
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr string
+	}{
+		{
+			name: "valid",
+			expr: "username = {{ .user }}\npassword = {{ .pass }}",
+		},
+		{
+			name: "comments-and-blank-lines",
+			expr: "# map the kv secret\n\n  username = {{ .data.user }}\n\npassword={{ .data.pass }}\n",
+		},
+		{
+			name:    "empty",
+			expr:    " \n ",
+			wantErr: "empty mapping expression",
+		},
+		{
+			name:    "missing-equals",
+			expr:    "username {{ .user }}\npassword = {{ .pass }}",
+			wantErr: "line 1: missing '=' in assignment",
+		},
+		{
+			name:    "unknown-attribute",
+			expr:    "username = {{ .user }}\npassword = {{ .pass }}\ndomain = {{ .domain }}",
+			wantErr: `line 3: unknown attribute "domain"`,
+		},
+		{
+			name:    "duplicate-attribute",
+			expr:    "username = {{ .user }}\nusername = {{ .name }}\npassword = {{ .pass }}",
+			wantErr: `line 2: attribute "username" assigned more than once`,
+		},
+		{
+			name:    "missing-template",
+			expr:    "username =\npassword = {{ .pass }}",
+			wantErr: `line 1: missing template for attribute "username"`,
+		},
+		{
+			name:    "invalid-template",
+			expr:    "username = {{ .user \npassword = {{ .pass }}",
+			wantErr: "line 1",
+		},
+		{
+			name:    "unknown-function",
+			expr:    "username = {{ upper .user }}\npassword = {{ .pass }}",
+			wantErr: "line 1",
+		},
+		{
+			name:    "missing-required",
+			expr:    "username = {{ .user }}",
+			wantErr: "missing assignment for password",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := Parse(ctx, tt.expr, attrs, required)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, got.assignments, 2)
+		})
+	}
+}
+
+func TestExpression_Evaluate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	attrs := []string{"username", "private_key", "private_key_passphrase"}
+	required := []string{"username", "private_key"}
+
+	tests := []struct {
+		name    string
+		expr    string
+		data    map[string]any
+		want    map[string]any
+		wantErr string
+	}{
+		{
+			name: "nested",
+			expr: "username = {{ .data.login.name }}\nprivate_key = {{ .data.key }}",
+			data: map[string]any{
+				"data": map[string]any{
+					"login": map[string]any{"name": "user"},
+					"key":   "pk",
+				},
+			},
+			want: map[string]any{"username": "user", "private_key": "pk"},
+		},
+		{
+			name: "functions",
+			expr: "username = {{ trim .user }}\nprivate_key = {{ base64decode .key }}\nprivate_key_passphrase = {{ index . \"pass-phrase\" }}",
+			data: map[string]any{"user": "  user\n", "key": "cGs=", "pass-phrase": "phrase"},
+			want: map[string]any{"username": "user", "private_key": "pk", "private_key_passphrase": "phrase"},
+		},
+		{
+			name: "literal-and-concatenation",
+			expr: "username = admin@{{ .domain }}\nprivate_key = {{ .key }}",
+			data: map[string]any{"domain": "example.com", "key": "pk"},
+			want: map[string]any{"username": "admin@example.com", "private_key": "pk"},
+		},
+		{
+			name:    "missing-key",
+			expr:    "username = {{ .user }}\nprivate_key = {{ .key }}",
+			data:    map[string]any{"key": "pk"},
+			wantErr: `attribute "username"`,
+		},
+		{
+			name:    "invalid-base64",
+			expr:    "username = {{ .user }}\nprivate_key = {{ base64decode .key }}",
+			data:    map[string]any{"user": "user", "key": "not base64!"},
+			wantErr: `attribute "private_key"`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			e, err := Parse(ctx, tt.expr, attrs, required)
+			require.NoError(t, err)
+			got, err := e.Evaluate(ctx, tt.data)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
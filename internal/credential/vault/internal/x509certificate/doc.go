@@ -0,0 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package x509certificate provides access to the X.509 certificate and
+// private key stored in a Vault secret.
+package x509certificate
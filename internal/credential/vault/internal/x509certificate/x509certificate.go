@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package x509certificate
+
+import (
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/mitchellh/pointerstructure"
+)
+
+type (
+	data map[string]any
+
+	// extractFunc attempts to extract the certificate and private key
+	// from sd using the provided attribute names, using a known
+	// Vault data response format.
+	extractFunc func(sd data, certificateAttr, privateKeyAttr string) ([]byte, credential.PrivateKey)
+)
+
+// Extract attempts to extract the values of the certificate and private key
+// stored within the provided data using the given attribute names.
+//
+// Extract does not return partial results, i.e. if one of the attributes
+// were extracted but not the other (nil, nil) will be returned.
+func Extract(d data, certificateAttr, privateKeyAttr string) ([]byte, credential.PrivateKey) {
+	for _, f := range []extractFunc{
+		defaultExtract,
+		kv2Extract,
+	} {
+		certificate, privateKey := f(d, certificateAttr, privateKeyAttr)
+		if certificate != nil && privateKey != nil {
+			// got valid certificate and privateKey from secret
+			return certificate, privateKey
+		}
+	}
+
+	return nil, nil
+}
+
+// defaultExtract looks for the certificateAttr and privateKeyAttr in the
+// data map. This is the format of the response of the issue endpoint of the
+// Vault PKI secrets engine.
+func defaultExtract(sd data, certificateAttr, privateKeyAttr string) (certificate []byte, privateKey credential.PrivateKey) {
+	if sd == nil {
+		// nothing to do return early
+		return nil, nil
+	}
+
+	c, ok := lookup(sd, certificateAttr)
+	if !ok {
+		return nil, nil
+	}
+	if c, ok := c.(string); ok && c != "" {
+		certificate = []byte(c)
+	}
+
+	pk, ok := lookup(sd, privateKeyAttr)
+	if !ok {
+		return nil, nil
+	}
+	if p, ok := pk.(string); ok && p != "" {
+		privateKey = credential.PrivateKey(p)
+	}
+
+	return certificate, privateKey
+}
+
+// lookup returns the value of attr in sd. If attr starts with a '/' it is
+// a JSON pointer into sd.
+func lookup(sd data, attr string) (any, bool) {
+	if strings.HasPrefix(attr, "/") {
+		v, err := pointerstructure.Get(sd, attr)
+		if err != nil {
+			return nil, false
+		}
+		return v, true
+	}
+	return sd[attr], true
+}
+
+// kv2Extract looks for the the certificateAttr and privateKeyAttr in the
+// embedded 'data' field within the data map.
+//
+// Additionaly it validates the data is in the expected KV-v2 format:
+//
+//	{
+//		"data": {},
+//		"metadata: {}
+//	}
+//
+// If the format does not match, it returns (nil, nil). See:
+// https://www.vaultproject.io/api/secret/kv/kv-v2#sample-response-1
+func kv2Extract(sd data, certificateAttr, privateKeyAttr string) (certificate []byte, privateKey credential.PrivateKey) {
+	if sd == nil {
+		// nothing to do return early
+		return nil, nil
+	}
+
+	var data, metadata map[string]any
+	for k, v := range sd {
+		switch k {
+		case "data":
+			var ok bool
+			if data, ok = v.(map[string]any); !ok {
+				// data field should be of type map[string]any in KV-v2
+				return nil, nil
+			}
+		case "metadata":
+			var ok bool
+			if metadata, ok = v.(map[string]any); !ok {
+				// metadata field should be of type map[string]any in KV-v2
+				return nil, nil
+			}
+		default:
+			// secretData contains a non valid KV-v2 top level field
+			return nil, nil
+		}
+	}
+	if data == nil || metadata == nil {
+		// missing required KV-v2 field
+		return nil, nil
+	}
+
+	if c, ok := data[certificateAttr].(string); ok && c != "" {
+		certificate = []byte(c)
+	}
+	if p, ok := data[privateKeyAttr].(string); ok && p != "" {
+		privateKey = credential.PrivateKey(p)
+	}
+
+	return certificate, privateKey
+}
@@ -5,9 +5,11 @@ package vault
 
 import (
 	"context"
+	"database/sql"
 	"net/http"
 	"time"
 
+	"github.com/hashicorp/boundary/internal/credential"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/kms"
@@ -25,6 +27,7 @@ const (
 	credentialRevocationJobName   = "vault_credential_revocation"
 	credentialStoreCleanupJobName = "vault_credential_store_cleanup"
 	credentialCleanupJobName      = "vault_credential_cleanup"
+	credentialStoreHealthJobName  = "vault_credential_store_health"
 
 	defaultNextRunIn = 5 * time.Minute
 	renewalWindow    = 10 * time.Minute
@@ -53,7 +56,7 @@ func RegisterJobs(ctx context.Context, scheduler *scheduler.Scheduler, r db.Read
 	if err = scheduler.RegisterJob(ctx, credRenewal); err != nil {
 		return errors.Wrap(ctx, err, op, errors.WithMsg("credential renewal job"))
 	}
-	credRevoke, err := newCredentialRevocationJob(r, w, kms)
+	credRevoke, err := newCredentialRevocationJob(ctx, r, w, kms)
 	if err != nil {
 		return errors.Wrap(ctx, err, op)
 	}
@@ -74,6 +77,13 @@ func RegisterJobs(ctx context.Context, scheduler *scheduler.Scheduler, r db.Read
 	if err = scheduler.RegisterJob(ctx, credCleanup); err != nil {
 		return errors.Wrap(ctx, err, op, errors.WithMsg("credential cleanup job"))
 	}
+	credStoreHealth, err := newCredentialStoreHealthJob(r, w, kms)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if err = scheduler.RegisterJob(ctx, credStoreHealth); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("credential store health job"))
+	}
 	return nil
 }
 
@@ -628,32 +638,32 @@ func (r *CredentialRenewalJob) Description() string {
 }
 
 // CredentialRevocationJob is the recurring job that revokes Vault credentials that are no
-// longer being used by an active or pending session.
+// longer being used by an active or pending session. It is a credential.RevocationJob
+// which uses itself as the credential.PendingRevoker for Vault credentials.
 // The CredentialRevocationJob is not thread safe, an attempt to Run the job concurrently
 // will result in an JobAlreadyRunning error.
 type CredentialRevocationJob struct {
+	*credential.RevocationJob
 	reader db.Reader
 	writer db.Writer
 	kms    *kms.Kms
 	limit  int
-
-	running      ua.Bool
-	numCreds     int
-	numProcessed int
 }
 
+var _ credential.PendingRevoker = (*CredentialRevocationJob)(nil)
+
 // newCredentialRevocationJob creates a new in-memory CredentialRevocationJob.
 //
 // WithLimit is the only supported option.
-func newCredentialRevocationJob(r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*CredentialRevocationJob, error) {
+func newCredentialRevocationJob(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*CredentialRevocationJob, error) {
 	const op = "vault.newCredentialRevocationJob"
 	switch {
 	case r == nil:
-		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing db.Reader")
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing db.Reader")
 	case w == nil:
-		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing db.Writer")
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing db.Writer")
 	case kms == nil:
-		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing kms")
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing kms")
 	}
 
 	opts := getOpts(opt...)
@@ -661,62 +671,67 @@ func newCredentialRevocationJob(r db.Reader, w db.Writer, kms *kms.Kms, opt ...O
 		// zero signals the boundary defaults should be used.
 		opts.withLimit = db.DefaultLimit
 	}
-	return &CredentialRevocationJob{
+	j := &CredentialRevocationJob{
 		reader: r,
 		writer: w,
 		kms:    kms,
 		limit:  opts.withLimit,
-	}, nil
-}
-
-// Status returns the current status of the credential revocation job.  Total is the total number
-// of credentials that are set to be revoked. Completed is the number of credentials already revoked.
-func (r *CredentialRevocationJob) Status() scheduler.JobStatus {
-	return scheduler.JobStatus{
-		Completed: r.numProcessed,
-		Total:     r.numCreds,
 	}
+	var err error
+	j.RevocationJob, err = credential.NewRevocationJob(ctx, w, credentialRevocationJobName,
+		"Periodically revokes dynamic credentials that are no longer in use and have been set for revocation (in the revoke state).",
+		j, credential.WithLimit(opts.withLimit))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return j, nil
 }
 
-// Run queries the vault credential repo for credentials that need to be revoked, it then creates
-// a vault client and revokes each credential.  Can not be run in parallel, if Run is invoked while
-// already running an error with code JobAlreadyRunning will be returned.
-func (r *CredentialRevocationJob) Run(ctx context.Context) error {
-	const op = "vault.(CredentialRevocationJob).Run"
-	if !r.running.CAS(r.running.Load(), true) {
-		return errors.New(ctx, errors.JobAlreadyRunning, op, "job already running")
+// PendingRevocations returns the public ids of up to limit credentials in the
+// revoke state which are due for a revocation attempt.
+func (r *CredentialRevocationJob) PendingRevocations(ctx context.Context, limit int) ([]string, error) {
+	const op = "vault.(CredentialRevocationJob).PendingRevocations"
+	var creds []*Credential
+	err := r.reader.SearchWhere(ctx, &creds, pendingRevocationWhere, []any{RevokeCredential}, db.WithLimit(limit))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
 	}
-	defer r.running.Store(false)
+	ids := make([]string, 0, len(creds))
+	for _, c := range creds {
+		ids = append(ids, c.GetPublicId())
+	}
+	return ids, nil
+}
 
-	// Verify context is not done before running
-	if err := ctx.Err(); err != nil {
+// RevokeCredential revokes the lease of the credential in Vault and sets the
+// credential to the revoked state.
+func (r *CredentialRevocationJob) RevokeCredential(ctx context.Context, credentialId string) error {
+	const op = "vault.(CredentialRevocationJob).RevokeCredential"
+	c := &privateCredential{}
+	if err := r.reader.LookupWhere(ctx, c, "public_id = ?", []any{credentialId}); err != nil {
 		return errors.Wrap(ctx, err, op)
 	}
+	return r.revokeCred(ctx, c)
+}
 
-	var creds []*privateCredential
-	err := r.reader.SearchWhere(ctx, &creds, "status = ?", []any{RevokeCredential}, db.WithLimit(r.limit))
+// FailRevocation sets the credential to the revoke failed state.
+func (r *CredentialRevocationJob) FailRevocation(ctx context.Context, credentialId string) error {
+	const op = "vault.(CredentialRevocationJob).FailRevocation"
+	cred := allocCredential()
+	cred.PublicId = credentialId
+	query, values := cred.updateStatusQuery(RevokeFailedCredential)
+	numRows, err := r.writer.Exec(ctx, query, values)
 	if err != nil {
 		return errors.Wrap(ctx, err, op)
 	}
-
-	// Set numProcessed and numTokens for status report
-	r.numProcessed, r.numCreds = 0, len(creds)
-	for _, c := range creds {
-		// Verify context is not done before renewing next token
-		if err := ctx.Err(); err != nil {
-			return errors.Wrap(ctx, err, op)
-		}
-		if err := r.revokeCred(ctx, c); err != nil {
-			event.WriteError(ctx, op, err, event.WithInfoMsg("error revoking credential", "credential id", c.PublicId))
-		}
-		r.numProcessed++
+	if numRows != 1 {
+		return errors.New(ctx, errors.Unknown, op, "failed to update credential status")
 	}
-
 	return nil
 }
 
 func (r *CredentialRevocationJob) revokeCred(ctx context.Context, c *privateCredential) error {
-	const op = "vault.(CredentialRenewalJob).revokeCred"
+	const op = "vault.(CredentialRevocationJob).revokeCred"
 	databaseWrapper, err := r.kms.GetWrapper(ctx, c.ProjectId, kms.KeyPurposeDatabase)
 	if err != nil {
 		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
@@ -754,21 +769,6 @@ func (r *CredentialRevocationJob) revokeCred(ctx context.Context, c *privateCred
 	return nil
 }
 
-// NextRunIn determine when the next credential revocation job should run.
-func (r *CredentialRevocationJob) NextRunIn(_ context.Context) (time.Duration, error) {
-	return defaultNextRunIn, nil
-}
-
-// Name is the unique name of the job.
-func (r *CredentialRevocationJob) Name() string {
-	return credentialRevocationJobName
-}
-
-// Description is the human readable description of the job.
-func (r *CredentialRevocationJob) Description() string {
-	return "Periodically revokes dynamic credentials that are no longer in use and have been set for revocation (in the revoke state)."
-}
-
 // CredentialStoreCleanupJob is the recurring job that deletes Vault credential stores that
 // have been soft deleted and tokens have been revoked or expired.
 // The CredentialStoreCleanupJob is not thread safe, an attempt to Run the job concurrently
@@ -957,3 +957,149 @@ func (r *CredentialCleanupJob) Name() string {
 func (r *CredentialCleanupJob) Description() string {
 	return "Periodically deletes Vault credentials that are no longer attached to a session (have a null session_id) and are not active in Vault."
 }
+
+// CredentialStoreHealthJob is the recurring job that checks whether Vault
+// credential stores can issue credentials and records the result in the
+// credential_store_health table. The stores checked the longest time ago are
+// checked first. The CredentialStoreHealthJob is not thread safe, an attempt
+// to Run the job concurrently will result in an JobAlreadyRunning error.
+type CredentialStoreHealthJob struct {
+	reader db.Reader
+	writer db.Writer
+	kms    *kms.Kms
+	limit  int
+
+	running      ua.Bool
+	numStores    int
+	numProcessed int
+}
+
+// newCredentialStoreHealthJob creates a new in-memory CredentialStoreHealthJob.
+//
+// WithLimit is the only supported option.
+func newCredentialStoreHealthJob(r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*CredentialStoreHealthJob, error) {
+	const op = "vault.newCredentialStoreHealthJob"
+	switch {
+	case r == nil:
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing db.Reader")
+	case w == nil:
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing db.Writer")
+	case kms == nil:
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing kms")
+	}
+
+	opts := getOpts(opt...)
+	if opts.withLimit == 0 {
+		// zero signals the boundary defaults should be used.
+		opts.withLimit = db.DefaultLimit
+	}
+	return &CredentialStoreHealthJob{
+		reader: r,
+		writer: w,
+		kms:    kms,
+		limit:  opts.withLimit,
+	}, nil
+}
+
+// Status returns the current status of the credential store health job.
+// Total is the total number of stores to check. Completed is the number of
+// stores already checked.
+func (r *CredentialStoreHealthJob) Status() scheduler.JobStatus {
+	return scheduler.JobStatus{
+		Completed: r.numProcessed,
+		Total:     r.numStores,
+	}
+}
+
+// Run checks the health of the credential stores checked the longest time
+// ago. Can not be run in parallel, if Run is invoked while already running an
+// error with code JobAlreadyRunning will be returned.
+func (r *CredentialStoreHealthJob) Run(ctx context.Context) error {
+	const op = "vault.(CredentialStoreHealthJob).Run"
+	if !r.running.CAS(r.running.Load(), true) {
+		return errors.New(ctx, errors.JobAlreadyRunning, op, "job already running")
+	}
+	defer r.running.Store(false)
+
+	// Verify context is not done before running
+	if err := ctx.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	rows, err := r.reader.Query(ctx, listCredentialStoreHealthCheckQuery, []any{sql.Named("limit", r.limit)})
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	var stores []*clientStore
+	for rows.Next() {
+		s := allocClientStore()
+		if err := r.reader.ScanRows(ctx, rows, s); err != nil {
+			rows.Close()
+			return errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+		}
+		stores = append(stores, s)
+	}
+	if err := rows.Err(); err != nil {
+		rows.Close()
+		return errors.Wrap(ctx, err, op)
+	}
+	rows.Close()
+
+	// Set numProcessed and numStores for status report
+	r.numProcessed, r.numStores = 0, len(stores)
+
+	for _, s := range stores {
+		// Verify context is not done before checking next store
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if err := r.checkStore(ctx, s); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error checking credential store health", "credential store id", s.PublicId))
+		}
+		r.numProcessed++
+	}
+
+	return nil
+}
+
+func (r *CredentialStoreHealthJob) checkStore(ctx context.Context, s *clientStore) error {
+	const op = "vault.(CredentialStoreHealthJob).checkStore"
+	databaseWrapper, err := r.kms.GetWrapper(ctx, s.ProjectId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+	if err = s.decrypt(ctx, databaseWrapper); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	h := checkHealth(ctx, s, time.Now())
+	if h.Status != HealthyStatus {
+		event.WriteSysEvent(ctx, op, "Vault credential store is not healthy", "credential store id", s.PublicId, "status", h.Status, "message", h.Message)
+	}
+	_, err = r.writer.Exec(ctx, upsertCredentialStoreHealthQuery, []any{
+		sql.Named("store_id", h.StoreId),
+		sql.Named("status", string(h.Status)),
+		sql.Named("message", h.Message),
+		sql.Named("token_expiration_time", sql.NullTime{Time: h.TokenExpirationTime, Valid: !h.TokenExpirationTime.IsZero()}),
+		sql.Named("certificate_expiration_time", sql.NullTime{Time: h.CertificateExpirationTime, Valid: !h.CertificateExpirationTime.IsZero()}),
+	})
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to record credential store health"))
+	}
+	return nil
+}
+
+// NextRunIn determine when the next credential store health job should run.
+func (r *CredentialStoreHealthJob) NextRunIn(_ context.Context) (time.Duration, error) {
+	return defaultNextRunIn, nil
+}
+
+// Name is the unique name of the job.
+func (r *CredentialStoreHealthJob) Name() string {
+	return credentialStoreHealthJobName
+}
+
+// Description is the human readable description of the job.
+func (r *CredentialStoreHealthJob) Description() string {
+	return "Periodically checks that Vault credential stores have a valid token, can reach Vault and have no expired certificates."
+}
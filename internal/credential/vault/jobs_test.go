@@ -1121,7 +1121,7 @@ func TestCredentialRenewalJob_RunLimits(t *testing.T) {
 
 			err = r.Run(context.Background())
 			require.NoError(err)
-			assert.Equal(tt.wantLen, r.numCreds)
+			assert.Equal(tt.wantLen, r.Status().Total)
 
 			// Set all credentials to revoked for next test
 			_, err = rw.Exec(context.Background(), "update credential_vault_credential set status = 'revoked'", nil)
@@ -1543,7 +1543,7 @@ func TestNewCredentialRevocationJob(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			got, err := newCredentialRevocationJob(tt.args.r, tt.args.w, tt.args.kms, tt.options...)
+			got, err := newCredentialRevocationJob(context.Background(), tt.args.r, tt.args.w, tt.args.kms, tt.options...)
 			if tt.wantErr {
 				require.Error(err)
 				assert.Nil(got)
@@ -1661,12 +1661,12 @@ func TestCredentialRevocationJob_RunLimits(t *testing.T) {
 				testVaultCred(t, conn, v, cl, sess, repoToken, status, 5*time.Minute)
 			}
 
-			r, err := newCredentialRevocationJob(rw, rw, kmsCache, tt.opts...)
+			r, err := newCredentialRevocationJob(context.Background(), rw, rw, kmsCache, tt.opts...)
 			require.NoError(err)
 
 			err = r.Run(context.Background())
 			require.NoError(err)
-			assert.Equal(tt.wantLen, r.numCreds)
+			assert.Equal(tt.wantLen, r.Status().Total)
 
 			// Set all credentials to revoked for next test
 			_, err = rw.Exec(context.Background(), "update credential_vault_credential set status = 'revoked'", nil)
@@ -1727,13 +1727,13 @@ func TestCredentialRevocationJob_Run(t *testing.T) {
 	repoToken := allocToken()
 	require.NoError(rw.LookupWhere(context.Background(), &repoToken, "token_hmac = ?", []any{cs.outputToken.TokenHmac}))
 
-	r, err := newCredentialRevocationJob(rw, rw, kmsCache)
+	r, err := newCredentialRevocationJob(context.Background(), rw, rw, kmsCache)
 	require.NoError(err)
 
 	err = r.Run(context.Background())
 	require.NoError(err)
 	// No credentials should have been revoked
-	assert.Equal(0, r.numCreds)
+	assert.Equal(0, r.Status().Total)
 
 	secret1, _ := testVaultCred(t, conn, v, cl, sess, repoToken, ActiveCredential, 5*time.Minute)
 	revokeSecret, revokeCred := testVaultCred(t, conn, v, cl, sess, repoToken, RevokeCredential, 5*time.Minute)
@@ -1752,7 +1752,7 @@ func TestCredentialRevocationJob_Run(t *testing.T) {
 	err = r.Run(context.Background())
 	require.NoError(err)
 	// The revoke credential should have been revoked
-	assert.Equal(1, r.numCreds)
+	assert.Equal(1, r.Status().Total)
 
 	// revokeCred should now have a status of revoked
 	lookupCred = allocCredential()
@@ -1821,7 +1821,7 @@ func TestCredentialRevocationJob_RunDeleted(t *testing.T) {
 	repoToken := allocToken()
 	require.NoError(rw.LookupWhere(context.Background(), &repoToken, "token_hmac = ?", []any{cs.outputToken.TokenHmac}))
 
-	r, err := newCredentialRevocationJob(rw, rw, kmsCache)
+	r, err := newCredentialRevocationJob(context.Background(), rw, rw, kmsCache)
 	require.NoError(err)
 
 	secret, cred := testVaultCred(t, conn, v, cl, sess, repoToken, ActiveCredential, 5*time.Hour)
@@ -1829,7 +1829,7 @@ func TestCredentialRevocationJob_RunDeleted(t *testing.T) {
 	err = r.Run(context.Background())
 	require.NoError(err)
 	// No credentials should have been revoked as expiration is 5 hours from now
-	assert.Equal(0, r.numCreds)
+	assert.Equal(0, r.Status().Total)
 
 	// Deleting the library should set the cred library_id to null, but not revoke the cred
 	count, err := rw.Delete(context.Background(), cl)
@@ -1839,7 +1839,7 @@ func TestCredentialRevocationJob_RunDeleted(t *testing.T) {
 	err = r.Run(context.Background())
 	require.NoError(err)
 	// No credentials should have been revoked
-	assert.Equal(0, r.numCreds)
+	assert.Equal(0, r.Status().Total)
 
 	// Verify the cred has a status of active with an empty libraryId
 	lookupCred := allocCredential()
@@ -1866,7 +1866,7 @@ func TestCredentialRevocationJob_RunDeleted(t *testing.T) {
 	err = r.Run(context.Background())
 	require.NoError(err)
 	// The revoke credential should have been revoked
-	assert.Equal(1, r.numCreds)
+	assert.Equal(1, r.Status().Total)
 
 	// cred should now have a status of revoked
 	lookupCred = allocCredential()
@@ -2233,7 +2233,7 @@ func TestCredentialCleanupJob_Run(t *testing.T) {
 	// No credentials should be cleaned up
 	err = r.Run(context.Background())
 	require.NoError(err)
-	assert.Equal(0, r.numCreds)
+	assert.Equal(0, r.Status().Total)
 
 	// Delete sess1
 	count, err := rw.Delete(context.Background(), sess1)
@@ -2243,7 +2243,7 @@ func TestCredentialCleanupJob_Run(t *testing.T) {
 	// Credentials are still in the revoke state so none should be deleted yet
 	err = r.Run(context.Background())
 	require.NoError(err)
-	assert.Equal(0, r.numCreds)
+	assert.Equal(0, r.Status().Total)
 
 	query, queryArgs := sess1Cred1.updateStatusQuery(RevokedCredential)
 	count, err = rw.Exec(context.Background(), query, queryArgs)
@@ -2268,7 +2268,7 @@ func TestCredentialCleanupJob_Run(t *testing.T) {
 	// Only the three credentials associated with the deleted session should be deleted
 	err = r.Run(context.Background())
 	require.NoError(err)
-	assert.Equal(3, r.numCreds)
+	assert.Equal(3, r.Status().Total)
 
 	// Session 1 creds should no longer exist
 	lookupCred := allocCredential()
@@ -2284,3 +2284,140 @@ func TestCredentialCleanupJob_Run(t *testing.T) {
 	require.NoError(rw.LookupById(context.Background(), lookupCred))
 	assert.Equal(string(RevokedCredential), lookupCred.Status)
 }
+
+func TestNewCredentialStoreHealthJob(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+
+	type args struct {
+		r   db.Reader
+		w   db.Writer
+		kms *kms.Kms
+	}
+	tests := []struct {
+		name        string
+		args        args
+		options     []Option
+		wantLimit   int
+		wantErr     bool
+		wantErrCode errors.Code
+	}{
+		{
+			name:        "nil reader",
+			wantErr:     true,
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name: "nil writer",
+			args: args{
+				r: rw,
+			},
+			wantErr:     true,
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name: "nil kms",
+			args: args{
+				r: rw,
+				w: rw,
+			},
+			wantErr:     true,
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name: "valid-no-options",
+			args: args{
+				r:   rw,
+				w:   rw,
+				kms: kmsCache,
+			},
+			wantLimit: db.DefaultLimit,
+		},
+		{
+			name: "valid-with-limit",
+			args: args{
+				r:   rw,
+				w:   rw,
+				kms: kmsCache,
+			},
+			options:   []Option{WithLimit(100)},
+			wantLimit: 100,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			got, err := newCredentialStoreHealthJob(tt.args.r, tt.args.w, tt.args.kms, tt.options...)
+			if tt.wantErr {
+				require.Error(err)
+				assert.Nil(got)
+				assert.Truef(errors.Match(errors.T(tt.wantErrCode), err), "Unexpected error %s", err)
+				return
+			}
+			require.NoError(err)
+			require.NotNil(got)
+			assert.Equal(tt.args.r, got.reader)
+			assert.Equal(tt.args.w, got.writer)
+			assert.Equal(tt.args.kms, got.kms)
+			assert.Equal(tt.wantLimit, got.limit)
+		})
+	}
+}
+
+func TestCredentialStoreHealthJob_Run(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	v := NewTestVaultServer(t)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kmsCache, sche)
+	require.NoError(err)
+
+	_, token := v.CreateToken(t)
+	credStoreIn, err := NewCredentialStore(prj.GetPublicId(), v.Addr, []byte(token))
+	require.NoError(err)
+	cs, err := repo.CreateCredentialStore(ctx, credStoreIn)
+	require.NoError(err)
+
+	health, err := repo.ListCredentialStoreHealth(ctx, []string{cs.GetPublicId()})
+	require.NoError(err)
+	assert.Empty(health)
+
+	r, err := newCredentialStoreHealthJob(rw, rw, kmsCache)
+	require.NoError(err)
+	require.NoError(r.Run(ctx))
+	assert.Equal(1, r.numStores)
+	assert.Equal(1, r.numProcessed)
+
+	health, err = repo.ListCredentialStoreHealth(ctx, []string{cs.GetPublicId()})
+	require.NoError(err)
+	require.Contains(health, cs.GetPublicId())
+	got := health[cs.GetPublicId()]
+	assert.Equal(HealthyStatus, got.Status)
+	assert.Empty(got.Message)
+	assert.False(got.CheckTime.IsZero())
+	assert.False(got.TokenExpirationTime.IsZero())
+	assert.True(got.CertificateExpirationTime.IsZero())
+
+	// Revoke the token of the store in Vault, the next check should find the
+	// store unhealthy
+	v.RevokeToken(t, token)
+	require.NoError(r.Run(ctx))
+
+	health, err = repo.ListCredentialStoreHealth(ctx, []string{cs.GetPublicId()})
+	require.NoError(err)
+	require.Contains(health, cs.GetPublicId())
+	got = health[cs.GetPublicId()]
+	assert.Equal(UnhealthyStatus, got.Status)
+	assert.Equal("vault token is invalid or expired", got.Message)
+}
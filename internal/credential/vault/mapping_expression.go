@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/vault/internal/mappingexpression"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// parseMappingExpression parses expr for a library retrieving credentials
+// of type ct. The attributes which can be assigned in expr are the
+// default attribute names of ct. Only the username password, ssh private
+// key and x509 certificate credential types support mapping expressions.
+func parseMappingExpression(ctx context.Context, expr string, ct credential.Type) (*mappingexpression.Expression, error) {
+	const op = "vault.parseMappingExpression"
+	switch ct {
+	case credential.UsernamePasswordType:
+		return mappingexpression.Parse(ctx, expr,
+			[]string{"username", "password"},
+			[]string{"username", "password"})
+	case credential.SshPrivateKeyType:
+		return mappingexpression.Parse(ctx, expr,
+			[]string{"username", "private_key", "private_key_passphrase"},
+			[]string{"username", "private_key"})
+	case credential.X509CertificateType:
+		return mappingexpression.Parse(ctx, expr,
+			[]string{"certificate", "private_key"},
+			[]string{"certificate", "private_key"})
+	default:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("mapping expressions are not supported for credential type %q", ct))
+	}
+}
+
+// mapSecret evaluates expr against the secret data sd and returns the
+// mapped data, which uses the default attribute names of ct.
+func mapSecret(ctx context.Context, expr string, ct credential.Type, sd map[string]any) (map[string]any, error) {
+	const op = "vault.mapSecret"
+	e, err := parseMappingExpression(ctx, expr, ct)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.VaultInvalidCredentialMapping))
+	}
+	m, err := e.Evaluate(ctx, sd)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return m, nil
+}
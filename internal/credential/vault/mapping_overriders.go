@@ -21,13 +21,16 @@ func validMappingOverride(m MappingOverride, ct credential.Type) bool {
 		return ct == credential.UsernamePasswordType
 	case *SshPrivateKeyOverride:
 		return ct == credential.SshPrivateKeyType
+	case *X509CertificateOverride:
+		return ct == credential.X509CertificateType
 	default:
 		return false // an unknown mapping override type is never valid
 	}
 }
 
 // A MappingOverride is an interface holding one of the mapping override
-// types: UsernamePasswordOverride.
+// types: UsernamePasswordOverride, SshPrivateKeyOverride or
+// X509CertificateOverride.
 type MappingOverride interface {
 	clone() MappingOverride
 	setLibraryId(i string)
@@ -168,3 +171,66 @@ func (o *SshPrivateKeyOverride) TableName() string {
 func (o *SshPrivateKeyOverride) SetTableName(n string) {
 	o.tableName = n
 }
+
+// A X509CertificateOverride contains optional values for overriding the
+// default mappings used to map a Vault secret to a X509Certificate credential
+// type for the credential library that owns it.
+type X509CertificateOverride struct {
+	*store.X509CertificateOverride
+	tableName string `gorm:"-"`
+}
+
+var _ MappingOverride = (*X509CertificateOverride)(nil)
+
+// NewX509CertificateOverride creates a new in memory X509CertificateOverride.
+// WithOverrideCertificateAttribute and WithOverridePrivateKeyAttribute are
+// the only valid options. All other options are ignored.
+func NewX509CertificateOverride(opt ...Option) *X509CertificateOverride {
+	opts := getOpts(opt...)
+	o := &X509CertificateOverride{
+		X509CertificateOverride: &store.X509CertificateOverride{
+			CertificateAttribute: sanitize.String(opts.withOverrideCertificateAttribute),
+			PrivateKeyAttribute:  sanitize.String(opts.withOverridePrivateKeyAttribute),
+		},
+	}
+	return o
+}
+
+func allocX509CertificateOverride() *X509CertificateOverride {
+	return &X509CertificateOverride{
+		X509CertificateOverride: &store.X509CertificateOverride{},
+	}
+}
+
+func (o *X509CertificateOverride) clone() MappingOverride {
+	cp := proto.Clone(o.X509CertificateOverride)
+	return &X509CertificateOverride{
+		X509CertificateOverride: cp.(*store.X509CertificateOverride),
+	}
+}
+
+func (o *X509CertificateOverride) setLibraryId(i string) {
+	o.LibraryId = i
+}
+
+func (o *X509CertificateOverride) sanitize() {
+	if sentinel.Is(o.CertificateAttribute) {
+		o.CertificateAttribute = ""
+	}
+	if sentinel.Is(o.PrivateKeyAttribute) {
+		o.PrivateKeyAttribute = ""
+	}
+}
+
+// TableName returns the table name.
+func (o *X509CertificateOverride) TableName() string {
+	if o.tableName != "" {
+		return o.tableName
+	}
+	return "credential_vault_library_x509_certificate_mapping_override"
+}
+
+// SetTableName sets the table name.
+func (o *X509CertificateOverride) SetTableName(n string) {
+	o.tableName = n
+}
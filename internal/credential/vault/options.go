@@ -36,7 +36,11 @@ type options struct {
 	withOverridePasswordAttribute             string
 	withOverridePrivateKeyAttribute           string
 	withOverridePrivateKeyPassphraseAttribute string
+	withOverrideCertificateAttribute          string
 	withMappingOverride                       MappingOverride
+	withMappingExpression                     string
+	withKvSecretVersion                       uint32
+	withMaxRequestsPerMinute                  uint32
 
 	withKeyType         string
 	withKeyBits         uint32
@@ -44,6 +48,9 @@ type options struct {
 	withKeyId           string
 	withCriticalOptions string
 	withExtensions      string
+
+	withSecretCache    *SecretCache
+	withRequestLimiter *RequestLimiter
 }
 
 func getDefaultOptions() options {
@@ -177,6 +184,14 @@ func WithOverridePrivateKeyPassphraseAttribute(s string) Option {
 	}
 }
 
+// WithOverrideCertificateAttribute provides the name of an attribute in the
+// Data field of a Vault api.Secret that maps to a certificate value.
+func WithOverrideCertificateAttribute(s string) Option {
+	return func(o *options) {
+		o.withOverrideCertificateAttribute = s
+	}
+}
+
 // WithMappingOverride provides an optional mapping override to use for
 // mapping the Data fields of a Vault api.Secret to a credential.
 func WithMappingOverride(m MappingOverride) Option {
@@ -185,6 +200,31 @@ func WithMappingOverride(m MappingOverride) Option {
 	}
 }
 
+// WithMappingExpression provides an optional mapping expression to use for
+// mapping the Data fields of a Vault api.Secret to a credential.
+func WithMappingExpression(e string) Option {
+	return func(o *options) {
+		o.withMappingExpression = e
+	}
+}
+
+// WithKvSecretVersion provides an optional version of a KV-v2 secret to read
+// with a credential library. The latest version is read if not set.
+func WithKvSecretVersion(v uint32) Option {
+	return func(o *options) {
+		o.withKvSecretVersion = v
+	}
+}
+
+// WithMaxRequestsPerMinute provides an optional maximum number of requests
+// per minute a credential library sends to Vault. The requests are not
+// limited if not set.
+func WithMaxRequestsPerMinute(n uint32) Option {
+	return func(o *options) {
+		o.withMaxRequestsPerMinute = n
+	}
+}
+
 // WithKeyType provides an optional ssh private key type to use
 // with a ssh certificate credential library. Must be rsa, ed25519, or ecdsa.
 func WithKeyType(t string) Option {
@@ -229,3 +269,19 @@ func WithExtensions(s string) Option {
 		o.withExtensions = s
 	}
 }
+
+// WithSecretCache provides an optional cache for the secrets read from Vault
+// when issuing credentials.
+func WithSecretCache(c *SecretCache) Option {
+	return func(o *options) {
+		o.withSecretCache = c
+	}
+}
+
+// WithRequestLimiter provides an optional limiter for the requests sent to
+// Vault when issuing credentials.
+func WithRequestLimiter(l *RequestLimiter) Option {
+	return func(o *options) {
+		o.withRequestLimiter = l
+	}
+}
@@ -4,6 +4,7 @@
 package vault
 
 import (
+	"context"
 	"testing"
 
 	"github.com/hashicorp/boundary/internal/credential"
@@ -130,4 +131,37 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withMappingOverride = unknownMapper(1)
 		assert.Equal(t, opts, testOpts)
 	})
+	t.Run("WithMappingExpression", func(t *testing.T) {
+		opts := getOpts(WithMappingExpression("username = {{ .user }}"))
+		testOpts := getDefaultOptions()
+		testOpts.withMappingExpression = "username = {{ .user }}"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithKvSecretVersion", func(t *testing.T) {
+		opts := getOpts(WithKvSecretVersion(3))
+		testOpts := getDefaultOptions()
+		testOpts.withKvSecretVersion = 3
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithMaxRequestsPerMinute", func(t *testing.T) {
+		opts := getOpts(WithMaxRequestsPerMinute(60))
+		testOpts := getDefaultOptions()
+		testOpts.withMaxRequestsPerMinute = 60
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithSecretCache", func(t *testing.T) {
+		c, err := NewSecretCache(context.Background(), 0, 0)
+		require.NoError(t, err)
+		opts := getOpts(WithSecretCache(c))
+		testOpts := getDefaultOptions()
+		testOpts.withSecretCache = c
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithRequestLimiter", func(t *testing.T) {
+		l := NewRequestLimiter()
+		opts := getOpts(WithRequestLimiter(l))
+		testOpts := getDefaultOptions()
+		testOpts.withRequestLimiter = l
+		assert.Equal(t, opts, testOpts)
+	})
 }
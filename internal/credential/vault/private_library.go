@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/boundary/internal/credential"
 	"github.com/hashicorp/boundary/internal/credential/vault/internal/sshprivatekey"
 	"github.com/hashicorp/boundary/internal/credential/vault/internal/usernamepassword"
+	"github.com/hashicorp/boundary/internal/credential/vault/internal/x509certificate"
 	"github.com/hashicorp/boundary/internal/db/sentinel"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -36,7 +37,10 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
-var _ credential.Dynamic = (*baseCred)(nil)
+var (
+	_ credential.Dynamic        = (*baseCred)(nil)
+	_ credential.SecretMetadata = (*baseCred)(nil)
+)
 
 type baseCred struct {
 	*Credential
@@ -45,12 +49,13 @@ type baseCred struct {
 	secretData map[string]any
 }
 
-func (bc *baseCred) Secret() credential.SecretData { return bc.secretData }
-func (bc *baseCred) Library() credential.Library   { return bc.lib }
-func (bc *baseCred) Purpose() credential.Purpose   { return bc.lib.GetPurpose() }
-func (bc *baseCred) getExpiration() time.Duration  { return bc.expiration }
-func (bc *baseCred) getCredential() *Credential    { return bc.Credential }
-func (bc *baseCred) isRevokable() bool             { return bc.ExternalId != sentinel.ExternalIdNone }
+func (bc *baseCred) Secret() credential.SecretData  { return bc.secretData }
+func (bc *baseCred) Library() credential.Library    { return bc.lib }
+func (bc *baseCred) Purpose() credential.Purpose    { return bc.lib.GetPurpose() }
+func (bc *baseCred) SecretMetadata() map[string]any { return kvMetadata(bc.secretData) }
+func (bc *baseCred) getExpiration() time.Duration   { return bc.expiration }
+func (bc *baseCred) getCredential() *Credential     { return bc.Credential }
+func (bc *baseCred) isRevokable() bool              { return bc.ExternalId != sentinel.ExternalIdNone }
 
 // convert converts bc to a specific credential type if bc is not
 // UnspecifiedType.
@@ -60,6 +65,8 @@ func convert(ctx context.Context, bc *baseCred) (dynamicCred, error) {
 		return baseToUsrPass(ctx, bc)
 	case credential.SshPrivateKeyType:
 		return baseToSshPriKey(ctx, bc)
+	case credential.X509CertificateType:
+		return baseToX509Cert(ctx, bc)
 	}
 	return bc, nil
 }
@@ -97,7 +104,14 @@ func baseToUsrPass(ctx context.Context, bc *baseCred) (*usrPassCred, error) {
 	if pAttr == "" {
 		pAttr = "password"
 	}
-	username, password := usernamepassword.Extract(bc.secretData, uAttr, pAttr)
+	sd := bc.secretData
+	if lib.MappingExpression != "" {
+		var err error
+		if sd, err = mapSecret(ctx, lib.MappingExpression, credential.UsernamePasswordType, sd); err != nil {
+			return nil, err
+		}
+	}
+	username, password := usernamepassword.Extract(sd, uAttr, pAttr)
 	if username == "" || password == "" {
 		return nil, errors.E(ctx, errors.WithCode(errors.VaultInvalidCredentialMapping))
 	}
@@ -147,7 +161,14 @@ func baseToSshPriKey(ctx context.Context, bc *baseCred) (*sshPrivateKeyCred, err
 	if pAttr == "" {
 		pAttr = "private_key_passphrase"
 	}
-	username, pk, pass := sshprivatekey.Extract(bc.secretData, uAttr, pkAttr, pAttr)
+	sd := bc.secretData
+	if lib.MappingExpression != "" {
+		var err error
+		if sd, err = mapSecret(ctx, lib.MappingExpression, credential.SshPrivateKeyType, sd); err != nil {
+			return nil, err
+		}
+	}
+	username, pk, pass := sshprivatekey.Extract(sd, uAttr, pkAttr, pAttr)
 	if username == "" || pk == nil {
 		return nil, errors.E(ctx, errors.WithCode(errors.VaultInvalidCredentialMapping))
 	}
@@ -160,6 +181,58 @@ func baseToSshPriKey(ctx context.Context, bc *baseCred) (*sshPrivateKeyCred, err
 	}, nil
 }
 
+var _ credential.X509Certificate = (*x509CertCred)(nil)
+
+type x509CertCred struct {
+	*baseCred
+	certificate []byte
+	privateKey  credential.PrivateKey
+}
+
+func (c *x509CertCred) Certificate() []byte               { return c.certificate }
+func (c *x509CertCred) PrivateKey() credential.PrivateKey { return c.privateKey }
+
+func baseToX509Cert(ctx context.Context, bc *baseCred) (*x509CertCred, error) {
+	switch {
+	case bc == nil:
+		return nil, errors.E(ctx, errors.WithCode(errors.InvalidParameter), errors.WithMsg("nil baseCred"))
+	case bc.lib == nil:
+		return nil, errors.E(ctx, errors.WithCode(errors.InvalidParameter), errors.WithMsg("nil baseCred.lib"))
+	case bc.Library().CredentialType() != credential.X509CertificateType:
+		return nil, errors.E(ctx, errors.WithCode(errors.InvalidParameter), errors.WithMsg("invalid credential type"))
+	}
+
+	lib, ok := bc.lib.(*genericIssuingCredentialLibrary)
+	if !ok {
+		return nil, errors.E(ctx, errors.WithCode(errors.InvalidParameter), errors.WithMsg("baseCred.lib is not of type genericIssuingCredentialLibrary"))
+	}
+
+	cAttr, pkAttr := lib.CertificateAttribute, lib.PrivateKeyAttribute
+	if cAttr == "" {
+		cAttr = "certificate"
+	}
+	if pkAttr == "" {
+		pkAttr = "private_key"
+	}
+	sd := bc.secretData
+	if lib.MappingExpression != "" {
+		var err error
+		if sd, err = mapSecret(ctx, lib.MappingExpression, credential.X509CertificateType, sd); err != nil {
+			return nil, err
+		}
+	}
+	cert, pk := x509certificate.Extract(sd, cAttr, pkAttr)
+	if cert == nil || pk == nil {
+		return nil, errors.E(ctx, errors.WithCode(errors.VaultInvalidCredentialMapping))
+	}
+
+	return &x509CertCred{
+		baseCred:    bc,
+		certificate: cert,
+		privateKey:  pk,
+	}, nil
+}
+
 type sshCertCred struct {
 	*sshPrivateKeyCred
 	certificate []byte
@@ -208,7 +281,14 @@ type genericIssuingCredentialLibrary struct {
 	PasswordAttribute             string
 	PrivateKeyAttribute           string
 	PrivateKeyPassphraseAttribute string
+	CertificateAttribute          string
+	MappingExpression             string
+	KvSecretVersion               uint32
+	MaxRequestsPerMinute          uint32
 	Purpose                       credential.Purpose
+
+	secretCache    *SecretCache
+	requestLimiter *RequestLimiter
 }
 
 func (pl *genericIssuingCredentialLibrary) clone() *genericIssuingCredentialLibrary {
@@ -222,6 +302,10 @@ func (pl *genericIssuingCredentialLibrary) clone() *genericIssuingCredentialLibr
 		PasswordAttribute:             pl.PasswordAttribute,
 		PrivateKeyAttribute:           pl.PrivateKeyAttribute,
 		PrivateKeyPassphraseAttribute: pl.PrivateKeyPassphraseAttribute,
+		CertificateAttribute:          pl.CertificateAttribute,
+		MappingExpression:             pl.MappingExpression,
+		KvSecretVersion:               pl.KvSecretVersion,
+		MaxRequestsPerMinute:          pl.MaxRequestsPerMinute,
 		Name:                          pl.Name,
 		Description:                   pl.Description,
 		CreateTime:                    proto.Clone(pl.CreateTime).(*timestamp.Timestamp),
@@ -314,14 +398,6 @@ func (pl *genericIssuingCredentialLibrary) retrieveCredential(ctx context.Contex
 		return nil, errors.Wrap(ctx, err, op)
 	}
 
-	client, err := pl.client(ctx)
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
-	}
-
-	var secret *vault.Secret
-	var reqErr error
-
 	// Template the path
 	path := pl.VaultPath
 	if path != "" {
@@ -348,22 +424,48 @@ func (pl *genericIssuingCredentialLibrary) retrieveCredential(ctx context.Contex
 		}
 	}
 
-	switch Method(pl.HttpMethod) {
-	case MethodGet:
-		secret, reqErr = client.get(ctx, path)
-	case MethodPost:
-		secret, reqErr = client.post(ctx, path, []byte(body))
-	default:
-		return nil, errors.New(ctx, errors.Internal, op, fmt.Sprintf("unknown http method: library: %s", pl.PublicId))
+	cacheKey := secretCacheKey{
+		libraryId:     pl.PublicId,
+		tokenHmac:     string(pl.TokenHmac),
+		namespace:     pl.Namespace,
+		method:        pl.HttpMethod,
+		path:          path,
+		body:          body,
+		secretVersion: pl.KvSecretVersion,
 	}
+	secret, cached := pl.secretCache.get(cacheKey)
+	if !cached {
+		if !pl.requestLimiter.allow(pl.PublicId, pl.MaxRequestsPerMinute) {
+			return nil, errors.New(ctx, errors.TooManyRequests, op, fmt.Sprintf("credential library %s exceeded its limit of %d requests per minute", pl.PublicId, pl.MaxRequestsPerMinute))
+		}
+		client, err := pl.client(ctx)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
 
-	if reqErr != nil {
-		// TODO(mgaffney) 05/2021: detect if the error is because of an
-		// expired or invalid token
-		return nil, errors.Wrap(ctx, reqErr, op)
-	}
-	if secret == nil {
-		return nil, errors.E(ctx, errors.WithCode(errors.VaultEmptySecret), errors.WithOp(op))
+		var reqErr error
+		switch Method(pl.HttpMethod) {
+		case MethodGet:
+			if pl.KvSecretVersion > 0 {
+				secret, reqErr = client.getVersion(ctx, path, pl.KvSecretVersion)
+			} else {
+				secret, reqErr = client.get(ctx, path)
+			}
+		case MethodPost:
+			secret, reqErr = client.post(ctx, path, []byte(body))
+		default:
+			return nil, errors.New(ctx, errors.Internal, op, fmt.Sprintf("unknown http method: library: %s", pl.PublicId))
+		}
+
+		if reqErr != nil {
+			// TODO(mgaffney) 05/2021: detect if the error is because of an
+			// expired or invalid token
+			return nil, errors.Wrap(ctx, reqErr, op)
+		}
+		if secret == nil {
+			return nil, errors.E(ctx, errors.WithCode(errors.VaultEmptySecret), errors.WithOp(op))
+		}
+		pl.secretCache.set(cacheKey, secret)
 	}
 
 	leaseDuration := time.Duration(secret.LeaseDuration) * time.Second
@@ -373,6 +475,7 @@ func (pl *genericIssuingCredentialLibrary) retrieveCredential(ctx context.Contex
 	}
 	cred.PublicId = credId
 	cred.IsRenewable = secret.Renewable
+	cred.Namespace = pl.Namespace
 
 	dCred := &baseCred{
 		Credential: cred,
@@ -441,7 +544,12 @@ func (r *Repository) getIssueCredLibraries(ctx context.Context, requests []crede
 		if err := pl.decrypt(ctx, databaseWrapper); err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
-		decryptedLibs = append(decryptedLibs, pl.toTypedIssuingCredentialLibrary())
+		lib := pl.toTypedIssuingCredentialLibrary()
+		if gl, ok := lib.(*genericIssuingCredentialLibrary); ok {
+			gl.secretCache = r.secretCache
+			gl.requestLimiter = r.requestLimiter
+		}
+		decryptedLibs = append(decryptedLibs, lib)
 	}
 
 	return decryptedLibs, nil
@@ -480,6 +588,10 @@ type privateCredentialLibraryAllTypes struct {
 	PasswordAttribute             string
 	PrivateKeyAttribute           string
 	PrivateKeyPassphraseAttribute string
+	CertificateAttribute          string
+	MappingExpression             string
+	KvSecretVersion               uint32
+	MaxRequestsPerMinute          uint32
 	Purpose                       credential.Purpose `gorm:"-"`
 	KeyType                       string
 	KeyBits                       int
@@ -537,6 +649,10 @@ func (pl *privateCredentialLibraryAllTypes) clone() *privateCredentialLibraryAll
 		PasswordAttribute:             pl.PasswordAttribute,
 		PrivateKeyAttribute:           pl.PrivateKeyAttribute,
 		PrivateKeyPassphraseAttribute: pl.PrivateKeyPassphraseAttribute,
+		CertificateAttribute:          pl.CertificateAttribute,
+		MappingExpression:             pl.MappingExpression,
+		KvSecretVersion:               pl.KvSecretVersion,
+		MaxRequestsPerMinute:          pl.MaxRequestsPerMinute,
 		Name:                          pl.Name,
 		Description:                   pl.Description,
 		CreateTime:                    proto.Clone(pl.CreateTime).(*timestamp.Timestamp),
@@ -618,6 +734,10 @@ func (pl *privateCredentialLibraryAllTypes) toTypedIssuingCredentialLibrary() is
 			PasswordAttribute:             pl.PasswordAttribute,
 			PrivateKeyAttribute:           pl.PrivateKeyAttribute,
 			PrivateKeyPassphraseAttribute: pl.PrivateKeyPassphraseAttribute,
+			CertificateAttribute:          pl.CertificateAttribute,
+			MappingExpression:             pl.MappingExpression,
+			KvSecretVersion:               pl.KvSecretVersion,
+			MaxRequestsPerMinute:          pl.MaxRequestsPerMinute,
 			Name:                          pl.Name,
 			Description:                   pl.Description,
 			CreateTime:                    pl.CreateTime,
@@ -985,6 +1105,7 @@ func (lib *sshCertIssuingCredentialLibrary) retrieveCredential(ctx context.Conte
 	}
 	cred.PublicId = credId
 	cred.IsRenewable = secret.Renewable // possibly set to just false
+	cred.Namespace = lib.Namespace
 
 	// same location for both
 	cert, ok := secret.Data["signed_key"].(string)
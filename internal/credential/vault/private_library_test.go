@@ -749,6 +749,55 @@ func TestBaseToUsrPass(t *testing.T) {
 				password: credential.Password("default-password"),
 			},
 		},
+		{
+			name: "valid-mapping-expression",
+			given: &baseCred{
+				lib: &genericIssuingCredentialLibrary{
+					CredType:          string(credential.UsernamePasswordType),
+					MappingExpression: "username = {{ .data.login.user }}\npassword = {{ .data.login.pass }}",
+				},
+				secretData: map[string]any{
+					"data": map[string]any{
+						"login": map[string]any{
+							"user": "expression-username",
+							"pass": "expression-password",
+						},
+					},
+				},
+			},
+			want: &usrPassCred{
+				username: "expression-username",
+				password: credential.Password("expression-password"),
+			},
+		},
+		{
+			name: "invalid-mapping-expression-missing-key",
+			given: &baseCred{
+				lib: &genericIssuingCredentialLibrary{
+					CredType:          string(credential.UsernamePasswordType),
+					MappingExpression: "username = {{ .user }}\npassword = {{ .pass }}",
+				},
+				secretData: map[string]any{
+					"username": "my-username",
+					"password": "my-password",
+				},
+			},
+			wantErr: errors.VaultInvalidCredentialMapping,
+		},
+		{
+			name: "invalid-mapping-expression-empty-value",
+			given: &baseCred{
+				lib: &genericIssuingCredentialLibrary{
+					CredType:          string(credential.UsernamePasswordType),
+					MappingExpression: "username = {{ .user }}\npassword = {{ .pass }}",
+				},
+				secretData: map[string]any{
+					"user": "my-username",
+					"pass": "",
+				},
+			},
+			wantErr: errors.VaultInvalidCredentialMapping,
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -1204,6 +1253,38 @@ func TestBaseToSshPriKey(t *testing.T) {
 				privateKey: credential.PrivateKey("default-pk"),
 			},
 		},
+		{
+			name: "valid-mapping-expression",
+			given: &baseCred{
+				lib: &genericIssuingCredentialLibrary{
+					CredType:          string(credential.SshPrivateKeyType),
+					MappingExpression: "username = {{ .user }}\nprivate_key = {{ base64decode .key }}\nprivate_key_passphrase = {{ .pass }}",
+				},
+				secretData: map[string]any{
+					"user": "expression-username",
+					"key":  "ZXhwcmVzc2lvbi1waw==",
+					"pass": "expression-pass",
+				},
+			},
+			want: &sshPrivateKeyCred{
+				username:   "expression-username",
+				privateKey: credential.PrivateKey("expression-pk"),
+				passphrase: []byte("expression-pass"),
+			},
+		},
+		{
+			name: "invalid-mapping-expression-missing-key",
+			given: &baseCred{
+				lib: &genericIssuingCredentialLibrary{
+					CredType:          string(credential.SshPrivateKeyType),
+					MappingExpression: "username = {{ .user }}\nprivate_key = {{ .key }}",
+				},
+				secretData: map[string]any{
+					"user": "expression-username",
+				},
+			},
+			wantErr: errors.VaultInvalidCredentialMapping,
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -136,6 +136,14 @@ update credential_vault_credential
    and status = 'active';
 `
 
+	pendingRevocationWhere = `
+status = ?
+and public_id not in (
+  select credential_id from credential_dynamic_revocation_attempt
+   where next_attempt_time > now()
+)
+`
+
 	updateCredentialStatusByTokenQuery = `
 update credential_vault_credential
    set status = ?
@@ -189,4 +197,32 @@ delete from credential_vault_credential
  where session_id is null
    and status not in ('active', 'revoke')
 `
+
+	listCredentialStoreHealthCheckQuery = `
+select store.*
+  from credential_vault_store_client store
+  left join credential_store_health health
+    on health.store_id = store.public_id
+ order by health.check_time nulls first
+ limit @limit;
+`
+
+	upsertCredentialStoreHealthQuery = `
+insert into credential_store_health
+  (store_id, status, message, token_expiration_time, certificate_expiration_time)
+values
+  (@store_id, @status, nullif(@message, ''), @token_expiration_time, @certificate_expiration_time)
+on conflict (store_id) do update
+  set status                      = excluded.status,
+      message                     = excluded.message,
+      check_time                  = now(),
+      token_expiration_time       = excluded.token_expiration_time,
+      certificate_expiration_time = excluded.certificate_expiration_time;
+`
+
+	listCredentialStoreHealthQuery = `
+select store_id, status, message, check_time, token_expiration_time, certificate_expiration_time
+  from credential_store_health
+ where store_id = any(@store_ids);
+`
 )
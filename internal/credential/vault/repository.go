@@ -20,12 +20,18 @@ type Repository struct {
 	// defaultLimit provides a default for limiting the number of results
 	// returned from the repo
 	defaultLimit int
+	// secretCache is the optional cache of the secrets read from Vault
+	secretCache *SecretCache
+	// requestLimiter is the optional limiter of the requests sent to Vault
+	requestLimiter *RequestLimiter
 }
 
 // NewRepository creates a new Repository. The returned repository should
 // only be used for one transaction and it is not safe for concurrent go
 // routines to access it. WithLimit option is used as a repo wide default
-// limit applied to all ListX methods.
+// limit applied to all ListX methods. WithSecretCache sets the cache used
+// when issuing credentials. WithRequestLimiter sets the limiter enforcing the
+// max requests per minute of credential libraries when issuing credentials.
 func NewRepository(r db.Reader, w db.Writer, kms *kms.Kms, scheduler *scheduler.Scheduler, opt ...Option) (*Repository, error) {
 	const op = "vault.NewRepository"
 	switch {
@@ -46,10 +52,12 @@ func NewRepository(r db.Reader, w db.Writer, kms *kms.Kms, scheduler *scheduler.
 	}
 
 	return &Repository{
-		reader:       r,
-		writer:       w,
-		kms:          kms,
-		scheduler:    scheduler,
-		defaultLimit: opts.withLimit,
+		reader:         r,
+		writer:         w,
+		kms:            kms,
+		scheduler:      scheduler,
+		defaultLimit:   opts.withLimit,
+		secretCache:    opts.withSecretCache,
+		requestLimiter: opts.withRequestLimiter,
 	}, nil
 }
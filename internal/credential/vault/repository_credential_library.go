@@ -118,8 +118,13 @@ func (r *Repository) CreateCredentialLibrary(ctx context.Context, projectId stri
 // number of records updated. l is not changed.
 //
 // l must contain a valid PublicId. Only Name, Description, VaultPath,
-// HttpMethod, HttpRequestBody, and MappingOverride can be updated. If
-// l.Name is set to a non-empty string, it must be unique within l.StoreId.
+// HttpMethod, HttpRequestBody, MappingOverride, MappingExpression,
+// KvSecretVersion, MaxRequestsPerMinute, and Namespace can be updated. If
+// l.Name is set to a non-empty string, it must be unique within l.StoreId. A
+// library cannot have both a MappingOverride and a MappingExpression. A
+// KvSecretVersion can only be set if the HttpMethod is GET. The
+// HttpRequestBody can only be templated with the supported session context
+// fields. Credentials already issued keep the Namespace they were issued in.
 //
 // An attribute of l will be set to NULL in the database if the attribute
 // in l is the zero value and it is included in fieldMaskPaths except for
@@ -145,14 +150,22 @@ func (r *Repository) UpdateCredentialLibrary(ctx context.Context, projectId stri
 	}
 	l = l.clone()
 
-	var updateMappingOverride bool
+	var updateMappingOverride, updateMappingExpression, updateHttpMethod, updateKvSecretVersion, updateHttpRequestBody bool
 	for _, f := range fieldMaskPaths {
 		switch {
 		case strings.EqualFold(nameField, f):
 		case strings.EqualFold(descriptionField, f):
 		case strings.EqualFold(vaultPathField, f):
 		case strings.EqualFold(httpMethodField, f):
+			updateHttpMethod = true
 		case strings.EqualFold(httpRequestBodyField, f):
+			updateHttpRequestBody = true
+		case strings.EqualFold(mappingExpressionField, f):
+			updateMappingExpression = true
+		case strings.EqualFold(kvSecretVersionField, f):
+			updateKvSecretVersion = true
+		case strings.EqualFold(maxRequestsPerMinuteField, f):
+		case strings.EqualFold(namespaceField, f):
 		case strings.EqualFold(MappingOverrideField, f):
 			updateMappingOverride = true
 		default:
@@ -162,12 +175,16 @@ func (r *Repository) UpdateCredentialLibrary(ctx context.Context, projectId stri
 	var dbMask, nullFields []string
 	dbMask, nullFields = dbw.BuildUpdatePaths(
 		map[string]any{
-			nameField:            l.Name,
-			descriptionField:     l.Description,
-			vaultPathField:       l.VaultPath,
-			httpMethodField:      l.HttpMethod,
-			httpRequestBodyField: l.HttpRequestBody,
-			MappingOverrideField: l.MappingOverride,
+			nameField:                 l.Name,
+			descriptionField:          l.Description,
+			vaultPathField:            l.VaultPath,
+			httpMethodField:           l.HttpMethod,
+			httpRequestBodyField:      l.HttpRequestBody,
+			mappingExpressionField:    l.MappingExpression,
+			kvSecretVersionField:      l.KvSecretVersion,
+			maxRequestsPerMinuteField: l.MaxRequestsPerMinute,
+			namespaceField:            l.Namespace,
+			MappingOverrideField:      l.MappingOverride,
 		},
 		fieldMaskPaths,
 		nil,
@@ -199,6 +216,39 @@ func (r *Repository) UpdateCredentialLibrary(ctx context.Context, projectId stri
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.VaultInvalidMappingOverride, op, "invalid mapping override for credential type")
 	}
 
+	mappingOverride, mappingExpression := origLib.MappingOverride, origLib.MappingExpression
+	if updateMappingOverride {
+		mappingOverride = l.MappingOverride
+	}
+	if updateMappingExpression {
+		mappingExpression = l.MappingExpression
+	}
+	switch {
+	case mappingExpression != "" && mappingOverride != nil:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "mapping expression and mapping override cannot both be set")
+	case updateMappingExpression && mappingExpression != "":
+		if _, err := parseMappingExpression(ctx, mappingExpression, origLib.CredentialType()); err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+	}
+
+	if updateHttpRequestBody {
+		if err := validateRequestBody(ctx, l.HttpRequestBody); err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+	}
+
+	httpMethod, kvSecretVersion := origLib.HttpMethod, origLib.KvSecretVersion
+	if updateHttpMethod {
+		httpMethod = l.HttpMethod
+	}
+	if updateKvSecretVersion {
+		kvSecretVersion = l.KvSecretVersion
+	}
+	if kvSecretVersion > 0 && Method(httpMethod) != MethodGet {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "secret version can only be set with the GET http method")
+	}
+
 	var filteredDbMask, filteredNullFields []string
 	for _, f := range dbMask {
 		switch {
@@ -363,6 +413,11 @@ type listLookupLibrary struct {
 	PasswordAttribute             string
 	PrivateKeyAttribute           string
 	PrivateKeyPassphraseAttribute string
+	CertificateAttribute          string
+	MappingExpression             string
+	KvSecretVersion               uint32
+	MaxRequestsPerMinute          uint32
+	Namespace                     string
 }
 
 func allocListLookupLibrary() *listLookupLibrary {
@@ -382,6 +437,10 @@ func (pl *listLookupLibrary) toCredentialLibrary() *CredentialLibrary {
 	cl.HttpMethod = pl.HttpMethod
 	cl.HttpRequestBody = pl.HttpRequestBody
 	cl.CredentialLibrary.CredentialType = pl.CredentialType
+	cl.MappingExpression = pl.MappingExpression
+	cl.KvSecretVersion = pl.KvSecretVersion
+	cl.MaxRequestsPerMinute = pl.MaxRequestsPerMinute
+	cl.Namespace = pl.Namespace
 
 	switch pl.CredentialType {
 	case string(credential.UsernamePasswordType):
@@ -403,6 +462,15 @@ func (pl *listLookupLibrary) toCredentialLibrary() *CredentialLibrary {
 			pk.sanitize()
 			cl.MappingOverride = pk
 		}
+	case string(credential.X509CertificateType):
+		if pl.CertificateAttribute != "" || pl.PrivateKeyAttribute != "" {
+			x := allocX509CertificateOverride()
+			x.LibraryId = pl.PublicId
+			x.CertificateAttribute = pl.CertificateAttribute
+			x.PrivateKeyAttribute = pl.PrivateKeyAttribute
+			x.sanitize()
+			cl.MappingOverride = x
+		}
 	}
 	return cl
 }
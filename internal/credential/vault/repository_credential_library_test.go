@@ -438,6 +438,135 @@ func TestRepository_CreateCredentialLibrary(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "valid-username-password-credential-type-with-mapping-expression",
+			in: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					StoreId:           cs.GetPublicId(),
+					HttpMethod:        "GET",
+					VaultPath:         "/some/path",
+					CredentialType:    string(credential.UsernamePasswordType),
+					MappingExpression: "username = {{ .data.user }}\npassword = {{ .data.pass }}",
+				},
+			},
+			want: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					StoreId:           cs.GetPublicId(),
+					HttpMethod:        "GET",
+					VaultPath:         "/some/path",
+					CredentialType:    string(credential.UsernamePasswordType),
+					MappingExpression: "username = {{ .data.user }}\npassword = {{ .data.pass }}",
+				},
+			},
+		},
+		{
+			name: "invalid-mapping-expression-for-credential-type",
+			in: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					StoreId:           cs.GetPublicId(),
+					HttpMethod:        "GET",
+					VaultPath:         "/some/path",
+					CredentialType:    string(credential.UsernamePasswordType),
+					MappingExpression: "username = {{ .data.user }}\nprivate_key = {{ .data.key }}",
+				},
+			},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name: "invalid-mapping-expression-unspecified-credential-type",
+			in: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					StoreId:           cs.GetPublicId(),
+					HttpMethod:        "GET",
+					VaultPath:         "/some/path",
+					MappingExpression: "username = {{ .data.user }}\npassword = {{ .data.pass }}",
+				},
+			},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name: "invalid-mapping-expression-and-mapping-override",
+			in: &CredentialLibrary{
+				MappingOverride: NewUsernamePasswordOverride(WithOverrideUsernameAttribute("utest")),
+				CredentialLibrary: &store.CredentialLibrary{
+					StoreId:           cs.GetPublicId(),
+					HttpMethod:        "GET",
+					VaultPath:         "/some/path",
+					CredentialType:    string(credential.UsernamePasswordType),
+					MappingExpression: "username = {{ .data.user }}\npassword = {{ .data.pass }}",
+				},
+			},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name: "valid-secret-version",
+			in: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					StoreId:         cs.GetPublicId(),
+					HttpMethod:      "GET",
+					VaultPath:       "/secret/data/my-secret",
+					KvSecretVersion: 3,
+				},
+			},
+			want: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					StoreId:         cs.GetPublicId(),
+					HttpMethod:      "GET",
+					VaultPath:       "/secret/data/my-secret",
+					KvSecretVersion: 3,
+				},
+			},
+		},
+		{
+			name: "invalid-secret-version-with-post",
+			in: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					StoreId:         cs.GetPublicId(),
+					HttpMethod:      "POST",
+					VaultPath:       "/secret/data/my-secret",
+					KvSecretVersion: 3,
+				},
+			},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name: "valid-namespace",
+			in: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					StoreId:    cs.GetPublicId(),
+					HttpMethod: "GET",
+					VaultPath:  "/database/creds/my-role",
+					Namespace:  "team-a",
+				},
+			},
+			want: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					StoreId:    cs.GetPublicId(),
+					HttpMethod: "GET",
+					VaultPath:  "/database/creds/my-role",
+					Namespace:  "team-a",
+				},
+			},
+		},
+		{
+			name: "valid-max-requests-per-minute",
+			in: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					StoreId:              cs.GetPublicId(),
+					HttpMethod:           "GET",
+					VaultPath:            "/database/creds/my-role",
+					MaxRequestsPerMinute: 30,
+				},
+			},
+			want: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					StoreId:              cs.GetPublicId(),
+					HttpMethod:           "GET",
+					VaultPath:            "/database/creds/my-role",
+					MaxRequestsPerMinute: 30,
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -464,6 +593,10 @@ func TestRepository_CreateCredentialLibrary(t *testing.T) {
 			assert.Equal(tt.want.Name, got.Name)
 			assert.Equal(tt.want.Description, got.Description)
 			assert.Equal(tt.want.CredentialType(), got.CredentialType())
+			assert.Equal(tt.want.MappingExpression, got.MappingExpression)
+			assert.Equal(tt.want.KvSecretVersion, got.KvSecretVersion)
+			assert.Equal(tt.want.MaxRequestsPerMinute, got.MaxRequestsPerMinute)
+			assert.Equal(tt.want.Namespace, got.Namespace)
 			assert.Equal(got.CreateTime, got.UpdateTime)
 
 			assert.NoError(db.TestVerifyOplog(t, rw, got.GetPublicId(), db.WithOperation(oplog.OpType_OP_TYPE_CREATE), db.WithCreateNotBefore(10*time.Second)))
@@ -640,6 +773,34 @@ func TestRepository_UpdateCredentialLibrary(t *testing.T) {
 		}
 	}
 
+	changeMappingExpression := func(e string) func(*CredentialLibrary) *CredentialLibrary {
+		return func(l *CredentialLibrary) *CredentialLibrary {
+			l.MappingExpression = e
+			return l
+		}
+	}
+
+	changeKvSecretVersion := func(v uint32) func(*CredentialLibrary) *CredentialLibrary {
+		return func(l *CredentialLibrary) *CredentialLibrary {
+			l.KvSecretVersion = v
+			return l
+		}
+	}
+
+	changeMaxRequestsPerMinute := func(n uint32) func(*CredentialLibrary) *CredentialLibrary {
+		return func(l *CredentialLibrary) *CredentialLibrary {
+			l.MaxRequestsPerMinute = n
+			return l
+		}
+	}
+
+	changeNamespace := func(n string) func(*CredentialLibrary) *CredentialLibrary {
+		return func(l *CredentialLibrary) *CredentialLibrary {
+			l.Namespace = n
+			return l
+		}
+	}
+
 	makeNil := func() func(*CredentialLibrary) *CredentialLibrary {
 		return func(l *CredentialLibrary) *CredentialLibrary {
 			return nil
@@ -1463,6 +1624,263 @@ func TestRepository_UpdateCredentialLibrary(t *testing.T) {
 			},
 			wantCount: 1,
 		},
+		{
+			name: "add-mapping-expression",
+			orig: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					HttpMethod:     "GET",
+					VaultPath:      "/some/path",
+					Name:           "test-name-repo",
+					CredentialType: string(credential.UsernamePasswordType),
+				},
+			},
+			chgFn: changeMappingExpression("username = {{ .data.user }}\\npassword = {{ .data.pass }}"),
+			masks: []string{"MappingExpression"},
+			want: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					HttpMethod:        "GET",
+					VaultPath:         "/some/path",
+					Name:              "test-name-repo",
+					CredentialType:    string(credential.UsernamePasswordType),
+					MappingExpression: "username = {{ .data.user }}\\npassword = {{ .data.pass }}",
+				},
+			},
+			wantCount: 1,
+		},
+		{
+			name: "delete-mapping-expression",
+			orig: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					HttpMethod:        "GET",
+					VaultPath:         "/some/path",
+					Name:              "test-name-repo",
+					CredentialType:    string(credential.UsernamePasswordType),
+					MappingExpression: "username = {{ .data.user }}\\npassword = {{ .data.pass }}",
+				},
+			},
+			chgFn: changeMappingExpression(""),
+			masks: []string{"MappingExpression"},
+			want: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					HttpMethod:     "GET",
+					VaultPath:      "/some/path",
+					Name:           "test-name-repo",
+					CredentialType: string(credential.UsernamePasswordType),
+				},
+			},
+			wantCount: 1,
+		},
+		{
+			name: "invalid-mapping-expression",
+			orig: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					HttpMethod:     "GET",
+					VaultPath:      "/some/path",
+					Name:           "test-name-repo",
+					CredentialType: string(credential.UsernamePasswordType),
+				},
+			},
+			chgFn:   changeMappingExpression("username = {{ .data.user }}"),
+			masks:   []string{"MappingExpression"},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name: "add-mapping-expression-with-mapping-override",
+			orig: &CredentialLibrary{
+				MappingOverride: NewUsernamePasswordOverride(
+					WithOverrideUsernameAttribute("orig-username"),
+				),
+				CredentialLibrary: &store.CredentialLibrary{
+					HttpMethod:     "GET",
+					VaultPath:      "/some/path",
+					Name:           "test-name-repo",
+					CredentialType: string(credential.UsernamePasswordType),
+				},
+			},
+			chgFn:   changeMappingExpression("username = {{ .data.user }}\\npassword = {{ .data.pass }}"),
+			masks:   []string{"MappingExpression"},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name: "replace-mapping-override-with-mapping-expression",
+			orig: &CredentialLibrary{
+				MappingOverride: NewUsernamePasswordOverride(
+					WithOverrideUsernameAttribute("orig-username"),
+				),
+				CredentialLibrary: &store.CredentialLibrary{
+					HttpMethod:     "GET",
+					VaultPath:      "/some/path",
+					Name:           "test-name-repo",
+					CredentialType: string(credential.UsernamePasswordType),
+				},
+			},
+			chgFn: combine(changeMappingOverride(nil), changeMappingExpression("username = {{ .data.user }}\\npassword = {{ .data.pass }}")),
+			masks: []string{"MappingOverride", "MappingExpression"},
+			want: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					HttpMethod:        "GET",
+					VaultPath:         "/some/path",
+					Name:              "test-name-repo",
+					CredentialType:    string(credential.UsernamePasswordType),
+					MappingExpression: "username = {{ .data.user }}\\npassword = {{ .data.pass }}",
+				},
+			},
+			wantCount: 1,
+		},
+		{
+			name: "add-secret-version",
+			orig: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					HttpMethod: "GET",
+					VaultPath:  "/secret/data/my-secret",
+					Name:       "test-name-repo",
+				},
+			},
+			chgFn: changeKvSecretVersion(2),
+			masks: []string{"KvSecretVersion"},
+			want: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					HttpMethod:      "GET",
+					VaultPath:       "/secret/data/my-secret",
+					Name:            "test-name-repo",
+					KvSecretVersion: 2,
+				},
+			},
+			wantCount: 1,
+		},
+		{
+			name: "delete-secret-version",
+			orig: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					HttpMethod:      "GET",
+					VaultPath:       "/secret/data/my-secret",
+					Name:            "test-name-repo",
+					KvSecretVersion: 2,
+				},
+			},
+			chgFn: changeKvSecretVersion(0),
+			masks: []string{"KvSecretVersion"},
+			want: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					HttpMethod: "GET",
+					VaultPath:  "/secret/data/my-secret",
+					Name:       "test-name-repo",
+				},
+			},
+			wantCount: 1,
+		},
+		{
+			name: "add-secret-version-with-post",
+			orig: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					HttpMethod: "POST",
+					VaultPath:  "/secret/data/my-secret",
+					Name:       "test-name-repo",
+				},
+			},
+			chgFn:   changeKvSecretVersion(2),
+			masks:   []string{"KvSecretVersion"},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name: "change-method-to-post-with-secret-version",
+			orig: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					HttpMethod:      "GET",
+					VaultPath:       "/secret/data/my-secret",
+					Name:            "test-name-repo",
+					KvSecretVersion: 2,
+				},
+			},
+			chgFn:   changeHttpMethod(MethodPost),
+			masks:   []string{"HttpMethod"},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name: "add-max-requests-per-minute",
+			orig: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					HttpMethod: "GET",
+					VaultPath:  "/database/creds/my-role",
+					Name:       "test-name-repo",
+				},
+			},
+			chgFn: changeMaxRequestsPerMinute(30),
+			masks: []string{"MaxRequestsPerMinute"},
+			want: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					HttpMethod:           "GET",
+					VaultPath:            "/database/creds/my-role",
+					Name:                 "test-name-repo",
+					MaxRequestsPerMinute: 30,
+				},
+			},
+			wantCount: 1,
+		},
+		{
+			name: "delete-max-requests-per-minute",
+			orig: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					HttpMethod:           "GET",
+					VaultPath:            "/database/creds/my-role",
+					Name:                 "test-name-repo",
+					MaxRequestsPerMinute: 30,
+				},
+			},
+			chgFn: changeMaxRequestsPerMinute(0),
+			masks: []string{"MaxRequestsPerMinute"},
+			want: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					HttpMethod: "GET",
+					VaultPath:  "/database/creds/my-role",
+					Name:       "test-name-repo",
+				},
+			},
+			wantCount: 1,
+		},
+		{
+			name: "change-namespace",
+			orig: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					HttpMethod: "GET",
+					VaultPath:  "/database/creds/my-role",
+					Name:       "test-name-repo",
+					Namespace:  "team-a",
+				},
+			},
+			chgFn: changeNamespace("team-b"),
+			masks: []string{"Namespace"},
+			want: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					HttpMethod: "GET",
+					VaultPath:  "/database/creds/my-role",
+					Name:       "test-name-repo",
+					Namespace:  "team-b",
+				},
+			},
+			wantCount: 1,
+		},
+		{
+			name: "delete-namespace",
+			orig: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					HttpMethod: "GET",
+					VaultPath:  "/database/creds/my-role",
+					Name:       "test-name-repo",
+					Namespace:  "team-a",
+				},
+			},
+			chgFn: changeNamespace(""),
+			masks: []string{"Namespace"},
+			want: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					HttpMethod: "GET",
+					VaultPath:  "/database/creds/my-role",
+					Name:       "test-name-repo",
+				},
+			},
+			wantCount: 1,
+		},
 	}
 
 	for _, tt := range tests {
@@ -1519,6 +1937,34 @@ func TestRepository_UpdateCredentialLibrary(t *testing.T) {
 				assert.Equal(tt.want.Description, got.Description)
 			}
 
+			switch tt.want.MappingExpression {
+			case "":
+				dbassert.IsNull(got, "mapping_expression")
+			default:
+				assert.Equal(tt.want.MappingExpression, got.MappingExpression)
+			}
+
+			switch tt.want.KvSecretVersion {
+			case 0:
+				dbassert.IsNull(got, "kv_secret_version")
+			default:
+				assert.Equal(tt.want.KvSecretVersion, got.KvSecretVersion)
+			}
+
+			switch tt.want.MaxRequestsPerMinute {
+			case 0:
+				dbassert.IsNull(got, "max_requests_per_minute")
+			default:
+				assert.Equal(tt.want.MaxRequestsPerMinute, got.MaxRequestsPerMinute)
+			}
+
+			switch tt.want.Namespace {
+			case "":
+				dbassert.IsNull(got, "namespace")
+			default:
+				assert.Equal(tt.want.Namespace, got.Namespace)
+			}
+
 			if tt.wantCount > 0 {
 				assert.NoError(db.TestVerifyOplog(t, rw, got.GetPublicId(), db.WithOperation(oplog.OpType_OP_TYPE_UPDATE), db.WithCreateNotBefore(10*time.Second)))
 			}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/util/template"
+)
+
+// requestBodyTemplateFields are the fields of the session context the HTTP
+// request body of a library can be templated with.
+var requestBodyTemplateFields = map[string]bool{
+	"User.Id":           true,
+	"User.Name":         true,
+	"User.FullName":     true,
+	"User.Email":        true,
+	"Account.Id":        true,
+	"Account.Name":      true,
+	"Account.LoginName": true,
+	"Account.Subject":   true,
+	"Account.Email":     true,
+	"Target.Id":         true,
+	"Target.Name":       true,
+}
+
+// requestBodyTemplateAttributesPrefix is the prefix of the custom attributes
+// of an LDAP account, any of which the request body can be templated with.
+const requestBodyTemplateAttributesPrefix = "Account.Attributes."
+
+// validateRequestBody returns an error if body is not a valid template or
+// references a field which is not in requestBodyTemplateFields.
+func validateRequestBody(ctx context.Context, body []byte) error {
+	const op = "vault.validateRequestBody"
+	if len(body) == 0 {
+		return nil
+	}
+	parsed, err := template.New(ctx, string(body))
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter), errors.WithMsg("invalid request body template"))
+	}
+	for _, f := range parsed.Fields() {
+		if requestBodyTemplateFields[f] ||
+			(strings.HasPrefix(f, requestBodyTemplateAttributesPrefix) && len(f) > len(requestBodyTemplateAttributesPrefix)) {
+			continue
+		}
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("request body template references unsupported field %q", f))
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateRequestBody(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name    string
+		body    string
+		wantErr bool
+	}{
+		{name: "empty"},
+		{name: "no-template", body: `{"common_name":"boundary"}`},
+		{name: "session-context", body: `{"role":"{{ .Target.Name }}-{{ truncateFrom .Account.Email "@" }}","user":"{{ .User.Id }}"}`},
+		{name: "account-attribute", body: `{"role":"{{ .Account.Attributes.department }}"}`},
+		{name: "invalid-template", body: `{"role":"{{ .User.Id "}`, wantErr: true},
+		{name: "unsupported-field", body: `{"role":"{{ .Session.Id }}"}`, wantErr: true},
+		{name: "whole-domain", body: `{"role":"{{ .User }}"}`, wantErr: true},
+		{name: "attributes-map", body: `{"role":"{{ .Account.Attributes }}"}`, wantErr: true},
+		{name: "with-dot", body: `{{ with .User }}{{ .Id }}{{ end }}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRequestBody(ctx, []byte(tt.body))
+			if tt.wantErr {
+				assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err), "got %v", err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// RequestLimiter enforces the max requests per minute of credential libraries
+// so that a misbehaving client authorizing sessions in a loop cannot exhaust
+// the secrets engine a library reads from. Each library has its own token
+// bucket which holds up to max requests per minute tokens and is refilled at
+// the same rate, so a library can send a burst of up to its limit after being
+// idle.
+//
+// Requests answered from the SecretCache are not sent to Vault and are not
+// limited. A nil RequestLimiter limits nothing. A RequestLimiter is safe for
+// concurrent use and is meant to be shared by all of the repositories created
+// by a controller, so the limits are enforced per controller.
+type RequestLimiter struct {
+	now func() time.Time
+
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+// NewRequestLimiter creates a RequestLimiter.
+func NewRequestLimiter() *RequestLimiter {
+	return &RequestLimiter{
+		now:      time.Now,
+		limiters: make(map[string]*rate.Limiter),
+	}
+}
+
+// allow reports whether the library with libraryId can send a request to
+// Vault without exceeding maxPerMinute. A request is always allowed if
+// maxPerMinute is zero.
+func (l *RequestLimiter) allow(libraryId string, maxPerMinute uint32) bool {
+	if l == nil {
+		return true
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if maxPerMinute == 0 {
+		delete(l.limiters, libraryId)
+		return true
+	}
+	lim, ok := l.limiters[libraryId]
+	if !ok || lim.Burst() != int(maxPerMinute) {
+		// The bucket of a library starts full, and starts over when the
+		// limit of the library is updated.
+		lim = rate.NewLimiter(rate.Every(time.Minute/time.Duration(maxPerMinute)), int(maxPerMinute))
+		l.limiters[libraryId] = lim
+	}
+	return lim.AllowN(l.now(), 1)
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestLimiter(t *testing.T) {
+	const libId = "clvlt_1234567890"
+
+	t.Run("nil", func(t *testing.T) {
+		var l *RequestLimiter
+		for i := 0; i < 10; i++ {
+			assert.True(t, l.allow(libId, 1))
+		}
+	})
+	t.Run("unlimited", func(t *testing.T) {
+		l := NewRequestLimiter()
+		for i := 0; i < 100; i++ {
+			assert.True(t, l.allow(libId, 0))
+		}
+		assert.Empty(t, l.limiters)
+	})
+	t.Run("token-bucket", func(t *testing.T) {
+		l := NewRequestLimiter()
+		now := time.Now()
+		l.now = func() time.Time { return now }
+
+		// a full bucket allows a burst of up to the limit
+		for i := 0; i < 6; i++ {
+			assert.True(t, l.allow(libId, 6))
+		}
+		assert.False(t, l.allow(libId, 6))
+
+		// other libraries have their own bucket
+		assert.True(t, l.allow("clvlt_0987654321", 6))
+
+		// one token is added every ten seconds
+		now = now.Add(5 * time.Second)
+		assert.False(t, l.allow(libId, 6))
+		now = now.Add(5 * time.Second)
+		assert.True(t, l.allow(libId, 6))
+		assert.False(t, l.allow(libId, 6))
+
+		// the bucket is refilled after a minute
+		now = now.Add(time.Minute)
+		for i := 0; i < 6; i++ {
+			assert.True(t, l.allow(libId, 6))
+		}
+		assert.False(t, l.allow(libId, 6))
+	})
+	t.Run("updated-limit", func(t *testing.T) {
+		l := NewRequestLimiter()
+		now := time.Now()
+		l.now = func() time.Time { return now }
+
+		assert.True(t, l.allow(libId, 1))
+		assert.False(t, l.allow(libId, 1))
+
+		// updating the limit starts the bucket over
+		for i := 0; i < 3; i++ {
+			assert.True(t, l.allow(libId, 3))
+		}
+		assert.False(t, l.allow(libId, 3))
+
+		// removing the limit removes the bucket of the library
+		assert.True(t, l.allow(libId, 0))
+		assert.NotContains(t, l.limiters, libId)
+	})
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	vault "github.com/hashicorp/vault/api"
+)
+
+const (
+	// DefaultSecretCacheTimeToLive is how long secrets are cached for when no
+	// time to live is given.
+	DefaultSecretCacheTimeToLive = 30 * time.Second
+
+	// DefaultSecretCacheMaxEntries is the number of secrets cached when no
+	// maximum is given.
+	DefaultSecretCacheMaxEntries = 10000
+)
+
+// secretCacheKey identifies a request made to Vault by a credential library.
+// The path and body are the ones generated from the templates of the library,
+// so requests templated with the data of different users are cached
+// separately. The token hmac ensures a secret read with a previous token of
+// the credential store is not used once the token is replaced.
+type secretCacheKey struct {
+	libraryId     string
+	tokenHmac     string
+	namespace     string
+	method        string
+	path          string
+	body          string
+	secretVersion uint32
+}
+
+type secretCacheEntry struct {
+	secret  *vault.Secret
+	expires time.Time
+}
+
+// SecretCache is an in-memory cache of the secrets read from Vault by
+// credential libraries, so that authorizing sessions at a high rate does not
+// overload Vault. Only secrets without a lease, such as those of the KV
+// secrets engine, are cached. Leased secrets are revoked when the session they
+// were issued for is terminated, so each session needs its own.
+//
+// A nil SecretCache caches nothing. A SecretCache is safe for concurrent use
+// and is meant to be shared by all of the repositories created by a
+// controller.
+type SecretCache struct {
+	ttl        time.Duration
+	maxEntries int
+	now        func() time.Time
+
+	mu      sync.Mutex
+	entries map[secretCacheKey]secretCacheEntry
+}
+
+// NewSecretCache creates a SecretCache which holds at most maxEntries secrets
+// for up to ttl. If ttl is zero, DefaultSecretCacheTimeToLive is used. If
+// maxEntries is zero, DefaultSecretCacheMaxEntries is used.
+func NewSecretCache(ctx context.Context, ttl time.Duration, maxEntries int) (*SecretCache, error) {
+	const op = "vault.NewSecretCache"
+	switch {
+	case ttl < 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "time to live is negative")
+	case maxEntries < 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "max entries is negative")
+	}
+	if ttl == 0 {
+		ttl = DefaultSecretCacheTimeToLive
+	}
+	if maxEntries == 0 {
+		maxEntries = DefaultSecretCacheMaxEntries
+	}
+	return &SecretCache{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		now:        time.Now,
+		entries:    make(map[secretCacheKey]secretCacheEntry),
+	}, nil
+}
+
+// get returns the secret cached for the key, if it has not expired.
+func (c *SecretCache) get(k secretCacheKey) (*vault.Secret, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[k]
+	if !ok {
+		return nil, false
+	}
+	if !c.now().Before(e.expires) {
+		delete(c.entries, k)
+		return nil, false
+	}
+	return e.secret, true
+}
+
+// set caches the secret for the key unless it has a lease. When the cache is
+// full, expired entries are removed first, then arbitrary entries until there
+// is room for the new one.
+func (c *SecretCache) set(k secretCacheKey, s *vault.Secret) {
+	if c == nil || s == nil || s.LeaseID != "" {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	if _, ok := c.entries[k]; !ok && len(c.entries) >= c.maxEntries {
+		for ek, e := range c.entries {
+			if !now.Before(e.expires) {
+				delete(c.entries, ek)
+			}
+		}
+		for ek := range c.entries {
+			if len(c.entries) < c.maxEntries {
+				break
+			}
+			delete(c.entries, ek)
+		}
+	}
+	c.entries[k] = secretCacheEntry{
+		secret:  s,
+		expires: now.Add(c.ttl),
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	vault "github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSecretCache(t *testing.T) {
+	ctx := context.Background()
+	c, err := NewSecretCache(ctx, 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultSecretCacheTimeToLive, c.ttl)
+	assert.Equal(t, DefaultSecretCacheMaxEntries, c.maxEntries)
+
+	c, err = NewSecretCache(ctx, time.Minute, 5)
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, c.ttl)
+	assert.Equal(t, 5, c.maxEntries)
+
+	_, err = NewSecretCache(ctx, -time.Second, 0)
+	require.Error(t, err)
+	_, err = NewSecretCache(ctx, 0, -1)
+	require.Error(t, err)
+}
+
+func TestSecretCache(t *testing.T) {
+	ctx := context.Background()
+	key := secretCacheKey{libraryId: "clvlt_1234567890", method: "GET", path: "secret/data/app"}
+	secret := &vault.Secret{Data: map[string]any{"username": "app", "password": "pass"}}
+
+	t.Run("nil", func(t *testing.T) {
+		var c *SecretCache
+		c.set(key, secret)
+		_, ok := c.get(key)
+		assert.False(t, ok)
+	})
+	t.Run("time-to-live", func(t *testing.T) {
+		c, err := NewSecretCache(ctx, time.Minute, 0)
+		require.NoError(t, err)
+		now := time.Now()
+		c.now = func() time.Time { return now }
+
+		_, ok := c.get(key)
+		assert.False(t, ok)
+		c.set(key, secret)
+		got, ok := c.get(key)
+		require.True(t, ok)
+		assert.Equal(t, secret, got)
+
+		// a request with other parameters is not served from the cache
+		other := key
+		other.path = "secret/data/other"
+		_, ok = c.get(other)
+		assert.False(t, ok)
+
+		now = now.Add(time.Minute)
+		_, ok = c.get(key)
+		assert.False(t, ok)
+	})
+	t.Run("leased", func(t *testing.T) {
+		c, err := NewSecretCache(ctx, 0, 0)
+		require.NoError(t, err)
+		c.set(key, &vault.Secret{LeaseID: "database/creds/app/1234", Data: secret.Data})
+		_, ok := c.get(key)
+		assert.False(t, ok)
+	})
+	t.Run("max-entries", func(t *testing.T) {
+		c, err := NewSecretCache(ctx, time.Minute, 2)
+		require.NoError(t, err)
+		now := time.Now()
+		c.now = func() time.Time { return now }
+
+		k1, k2, k3 := key, key, key
+		k2.path, k3.path = "secret/data/2", "secret/data/3"
+		c.set(k1, secret)
+		now = now.Add(30 * time.Second)
+		c.set(k2, secret)
+		now = now.Add(30 * time.Second)
+
+		// the expired entry is removed to make room
+		c.set(k3, secret)
+		assert.Len(t, c.entries, 2)
+		_, ok := c.get(k2)
+		assert.True(t, ok)
+		_, ok = c.get(k3)
+		assert.True(t, ok)
+
+		// then arbitrary entries once nothing has expired
+		c.set(k1, secret)
+		assert.Len(t, c.entries, 2)
+		_, ok = c.get(k1)
+		assert.True(t, ok)
+	})
+}
@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+// kvMetadataKeys are the attributes of the metadata of a KV-v2 secret which
+// are returned with a brokered credential.
+var kvMetadataKeys = []string{"created_time", "custom_metadata", "version"}
+
+// kvMetadata returns the metadata of the KV-v2 secret in sd. Nil is
+// returned if sd is not the response of a read from a KV-v2 secrets
+// engine, which contains the secret in "data" and its metadata in
+// "metadata".
+func kvMetadata(sd map[string]any) map[string]any {
+	if _, ok := sd["data"].(map[string]any); !ok {
+		return nil
+	}
+	md, ok := sd["metadata"].(map[string]any)
+	if !ok {
+		return nil
+	}
+	out := make(map[string]any, len(kvMetadataKeys))
+	for _, k := range kvMetadataKeys {
+		if v, ok := md[k]; ok && v != nil {
+			out[k] = v
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
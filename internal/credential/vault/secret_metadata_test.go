@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_kvMetadata(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		sd   map[string]any
+		want map[string]any
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "kv-v1",
+			sd:   map[string]any{"username": "user", "password": "pass"},
+		},
+		{
+			name: "metadata-without-data",
+			sd: map[string]any{
+				"metadata": map[string]any{"created_time": "2023-03-01T10:00:00Z"},
+			},
+		},
+		{
+			name: "kv-v2",
+			sd: map[string]any{
+				"data": map[string]any{"username": "user", "password": "pass"},
+				"metadata": map[string]any{
+					"created_time":    "2023-03-01T10:00:00Z",
+					"custom_metadata": map[string]any{"owner": "ops"},
+					"deletion_time":   "",
+					"destroyed":       false,
+					"version":         json.Number("3"),
+				},
+			},
+			want: map[string]any{
+				"created_time":    "2023-03-01T10:00:00Z",
+				"custom_metadata": map[string]any{"owner": "ops"},
+				"version":         json.Number("3"),
+			},
+		},
+		{
+			name: "kv-v2-null-custom-metadata",
+			sd: map[string]any{
+				"data": map[string]any{"username": "user", "password": "pass"},
+				"metadata": map[string]any{
+					"created_time":    "2023-03-01T10:00:00Z",
+					"custom_metadata": nil,
+				},
+			},
+			want: map[string]any{
+				"created_time": "2023-03-01T10:00:00Z",
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, kvMetadata(tt.sd))
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"crypto/tls"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -26,6 +27,7 @@ type vaultClient interface {
 	lookupToken(context.Context) (*vault.Secret, error)
 	swapToken(context.Context, TokenSecret) (old TokenSecret)
 	get(context.Context, string) (*vault.Secret, error)
+	getVersion(context.Context, string, uint32) (*vault.Secret, error)
 	post(context.Context, string, []byte) (*vault.Secret, error)
 	capabilities(context.Context, []string) (pathCapabilities, error)
 }
@@ -216,6 +218,18 @@ func (c *client) get(ctx context.Context, path string) (*vault.Secret, error) {
 	return s, nil
 }
 
+// getVersion reads version of the KV-v2 secret at path.
+func (c *client) getVersion(ctx context.Context, path string, version uint32) (*vault.Secret, error) {
+	const op = "vault.(client).getVersion"
+	s, err := c.cl.Logical().ReadWithData(path, map[string][]string{
+		"version": {strconv.FormatUint(uint64(version), 10)},
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.VaultCredentialRequest), errors.WithMsg(fmt.Sprintf("vault: %s", c.cl.Address())))
+	}
+	return s, nil
+}
+
 func (c *client) post(ctx context.Context, path string, data []byte) (*vault.Secret, error) {
 	const op = "vault.(client).post"
 
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cluster
+
+import (
+	"io"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/grpc/encoding"
+)
+
+// ZstdCompressor is the name of the grpc compressor which compresses the
+// messages of the RPCs between workers and their upstreams with zstd. It's
+// registered by this package, so both controllers and workers can decompress
+// the messages of a worker which compresses its RPCs, and their responses are
+// compressed with it too.
+const ZstdCompressor = "zstd"
+
+func init() {
+	encoding.RegisterCompressor(newZstdCompressor())
+}
+
+// zstdCompressor is a grpc encoding.Compressor which pools its zstd encoders
+// and decoders, since they're costly to allocate for every message.
+type zstdCompressor struct {
+	encoders sync.Pool
+	decoders sync.Pool
+}
+
+var _ encoding.Compressor = (*zstdCompressor)(nil)
+
+func newZstdCompressor() *zstdCompressor {
+	return &zstdCompressor{
+		encoders: sync.Pool{
+			New: func() any {
+				// the writer is set when the encoder is reset, so creating it
+				// can't fail.
+				e, _ := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+				return e
+			},
+		},
+		decoders: sync.Pool{
+			New: func() any {
+				d, _ := zstd.NewReader(nil, zstd.WithDecoderConcurrency(1))
+				return d
+			},
+		},
+	}
+}
+
+// Name returns the name of the compressor.
+func (c *zstdCompressor) Name() string {
+	return ZstdCompressor
+}
+
+// Compress returns a writer which compresses the data written to it into w.
+// The data isn't completely written to w until the writer is closed.
+func (c *zstdCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	e := c.encoders.Get().(*zstd.Encoder)
+	e.Reset(w)
+	return &zstdWriter{Encoder: e, pool: &c.encoders}, nil
+}
+
+// Decompress returns a reader which decompresses the data read from r.
+func (c *zstdCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	d := c.decoders.Get().(*zstd.Decoder)
+	if err := d.Reset(r); err != nil {
+		c.decoders.Put(d)
+		return nil, err
+	}
+	return &zstdReader{Decoder: d, pool: &c.decoders}, nil
+}
+
+// zstdWriter returns its encoder to the pool once it's closed.
+type zstdWriter struct {
+	*zstd.Encoder
+	pool *sync.Pool
+}
+
+func (w *zstdWriter) Close() error {
+	err := w.Encoder.Close()
+	w.pool.Put(w.Encoder)
+	return err
+}
+
+// zstdReader returns its decoder to the pool once all of its data has been
+// read.
+type zstdReader struct {
+	*zstd.Decoder
+	pool *sync.Pool
+}
+
+func (r *zstdReader) Read(p []byte) (int, error) {
+	if r.Decoder == nil {
+		return 0, io.EOF
+	}
+	n, err := r.Decoder.Read(p)
+	if err == io.EOF {
+		r.pool.Put(r.Decoder)
+		r.Decoder = nil
+	}
+	return n, err
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cluster
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/encoding"
+)
+
+func TestZstdCompressor(t *testing.T) {
+	t.Parallel()
+	c := encoding.GetCompressor(ZstdCompressor)
+	require.NotNil(t, c)
+	assert.Equal(t, ZstdCompressor, c.Name())
+
+	// each message is compressed and decompressed more than once so the
+	// pooled encoders and decoders are reused.
+	msgs := [][]byte{
+		bytes.Repeat([]byte("worker status "), 1000),
+		[]byte("a"),
+		{},
+		bytes.Repeat([]byte("worker status "), 1000),
+	}
+	for _, msg := range msgs {
+		var compressed bytes.Buffer
+		w, err := c.Compress(&compressed)
+		require.NoError(t, err)
+		_, err = w.Write(msg)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		if len(msg) > 1000 {
+			assert.Less(t, compressed.Len(), len(msg))
+		}
+
+		r, err := c.Decompress(&compressed)
+		require.NoError(t, err)
+		got, err := io.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, len(msg), len(got))
+		assert.True(t, bytes.Equal(msg, got))
+
+		// reading past the end keeps returning io.EOF
+		n, err := r.Read(make([]byte, 1))
+		assert.Zero(t, n)
+		assert.ErrorIs(t, err, io.EOF)
+	}
+}
@@ -162,6 +162,7 @@ func (ws *workerServiceServer) Status(ctx context.Context, req *pbs.StatusReques
 		thisController := &pbs.UpstreamServer{
 			Address: c.Address,
 			Type:    pbs.UpstreamServer_TYPE_CONTROLLER,
+			Region:  c.Region,
 		}
 		responseControllers = append(responseControllers, thisController)
 	}
@@ -513,7 +514,7 @@ func (ws *workerServiceServer) ActivateSession(ctx context.Context, req *pbs.Act
 		return nil, status.Errorf(codes.Internal, "error getting session repo: %v", err)
 	}
 
-	sessionInfo, sessionStates, err := sessRepo.ActivateSession(ctx, req.GetSessionId(), req.GetVersion(), []byte(req.GetTofuToken()))
+	sessionInfo, sessionStates, err := sessRepo.ActivateSession(ctx, req.GetSessionId(), req.GetVersion(), []byte(req.GetTofuToken()), session.WithBannerAcknowledged(req.GetBannerAcknowledged()))
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "error looking up session: %v", err)
 	}
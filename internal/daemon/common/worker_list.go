@@ -12,6 +12,7 @@ import (
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
 	"github.com/hashicorp/boundary/version"
 	"github.com/hashicorp/go-bexpr"
+	"github.com/hashicorp/go-secure-stdlib/strutil"
 	"github.com/mitchellh/pointerstructure"
 	"google.golang.org/grpc/codes"
 )
@@ -47,6 +48,25 @@ func (w WorkerList) WorkerInfos() []*pb.WorkerInfo {
 	return ret
 }
 
+// PreferRegion returns a new WorkerList with the workers whose "region" tag
+// contains the given region first, keeping the relative order of the workers
+// otherwise.
+func (w WorkerList) PreferRegion(region string) WorkerList {
+	if region == "" {
+		return w
+	}
+	ret := make([]*server.Worker, 0, len(w))
+	var others []*server.Worker
+	for _, worker := range w {
+		if strutil.StrListContains(worker.CanonicalTags()["region"], region) {
+			ret = append(ret, worker)
+		} else {
+			others = append(others, worker)
+		}
+	}
+	return append(ret, others...)
+}
+
 // SupportsFeature returns a new WorkerList composed of all workers in this
 // WorkerList which supports the provided feature.
 func (w WorkerList) SupportsFeature(f version.Feature) WorkerList {
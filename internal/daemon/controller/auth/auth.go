@@ -5,6 +5,7 @@ package auth
 
 import (
 	"context"
+	"encoding/json"
 	stderrors "errors"
 	"fmt"
 	"net/http"
@@ -17,6 +18,7 @@ import (
 	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
+	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -69,6 +71,10 @@ type VerifyResults struct {
 	Error       error
 	Scope       *scopes.ScopeInfo
 
+	// AuthTokenCreateTime is the create time of the auth token used for the
+	// request. It is the zero time if no valid auth token was provided.
+	AuthTokenCreateTime time.Time
+
 	// AuthenticatedFinished means that the request has passed through the
 	// authentication system successfully. This does _not_ indicate whether a
 	// token was provided on the request. Requests for `u_anon` will still have
@@ -160,6 +166,16 @@ func NewVerifierContext(ctx context.Context,
 	return NewVerifierContextWithAccounts(ctx, iamRepoFn, authTokenRepoFn, serversRepoFn, nil, nil, nil, kms, requestInfo)
 }
 
+// RequestInfoFromContext returns the request info carried by the verifier in
+// the context, if any.
+func RequestInfoFromContext(ctx context.Context) (*authpb.RequestInfo, bool) {
+	v, ok := ctx.Value(verifierKey).(*verifier)
+	if !ok || v.requestInfo == nil {
+		return nil, false
+	}
+	return v.requestInfo, true
+}
+
 // Verify takes in a context that has expected parameters as values and runs an
 // authn/authz check. It returns a user ID, the scope ID for the request (which
 // may come from the URL and may come from the token) and whether or not to
@@ -261,6 +277,7 @@ func Verify(ctx context.Context, opt ...Option) (ret VerifyResults) {
 		Id:      opts.withId,
 		Pin:     opts.withPin,
 		Type:    opts.withType,
+		Tags:    opts.withTags,
 	}
 	// Global scope has no parent ID; account for this
 	if opts.withId == scope.Global.String() && opts.withType == resource.Scope {
@@ -275,7 +292,7 @@ func Verify(ctx context.Context, opt ...Option) (ret VerifyResults) {
 	var grantTuples []perms.GrantTuple
 	var userData template.Data
 	var err error
-	authResults, ret.UserData, ret.Scope, v.acl, grantTuples, err = v.performAuthCheck(ctx)
+	authResults, ret.UserData, ret.Scope, v.acl, grantTuples, ret.AuthTokenCreateTime, err = v.performAuthCheck(ctx)
 	if err != nil {
 		event.WriteError(ctx, op, err, event.WithInfoMsg("error performing authn/authz check"))
 		return
@@ -347,6 +364,8 @@ func Verify(ctx context.Context, opt ...Option) (ret VerifyResults) {
 	if reqInfo != nil {
 		reqInfo.UserId = ret.UserId
 		reqInfo.OutputFields = authResults.OutputFields
+		reqInfo.Resource = v.res
+		reqInfo.Action = v.act
 	}
 
 	ret.Error = nil
@@ -485,6 +504,7 @@ func (v verifier) performAuthCheck(ctx context.Context) (
 	scopeInfo *scopes.ScopeInfo,
 	retAcl perms.ACL,
 	grantTuples []perms.GrantTuple,
+	authTokenCreateTime time.Time,
 	retErr error,
 ) {
 	const op = "auth.(verifier).performAuthCheck"
@@ -518,7 +538,9 @@ func (v verifier) performAuthCheck(ctx context.Context) (
 			retErr = errors.Wrap(ctx, err, op)
 			return
 		}
-		at, err := tokenRepo.ValidateToken(v.ctx, v.requestInfo.PublicId, v.requestInfo.Token)
+		at, err := tokenRepo.ValidateToken(v.ctx, v.requestInfo.PublicId, v.requestInfo.Token,
+			authtoken.WithClientIp(v.requestInfo.ClientIp),
+			authtoken.WithClientCertificateFingerprint(v.requestInfo.ClientCertificateFingerprint))
 		if err != nil {
 			// Continue as the anonymous user as maybe this token is expired but
 			// we can still perform the action
@@ -532,7 +554,9 @@ func (v verifier) performAuthCheck(ctx context.Context) (
 				event.WriteError(ctx, op, stderrors.New("perform auth check: valid token did not map to a user, likely because no account is associated with the user any longer; continuing as u_anon"), event.WithInfo("token_id", at.GetPublicId()))
 				userData.User.Id = util.Pointer(globals.AnonymousUserId)
 				userData.Account.Id = nil
+				break
 			}
+			authTokenCreateTime = at.GetCreateTime().GetTimestamp().AsTime()
 		}
 	}
 
@@ -593,6 +617,12 @@ func (v verifier) performAuthCheck(ctx context.Context) (
 		userData.Account.Email = util.Pointer(acct.GetEmail())
 		userData.Account.LoginName = util.Pointer(acct.GetLoginName())
 		userData.Account.Subject = util.Pointer(acct.GetSubject())
+		if ldapAcct, ok := acct.(*ldap.Account); ok && ldapAcct.GetEntryAttributes() != "" {
+			if err := json.Unmarshal([]byte(ldapAcct.GetEntryAttributes()), &userData.Account.Attributes); err != nil {
+				retErr = errors.Wrap(ctx, err, op, errors.WithMsg("error unmarshaling account entry attributes"))
+				return
+			}
+		}
 	}
 
 	// Look up scope details to return. We can skip a lookup when using the
@@ -47,3 +47,33 @@ func CalculateAuthorizedCollectionActions(ctx context.Context,
 	}
 	return ret, nil
 }
+
+// CalculateAuthorizedActions returns the actions the caller is allowed to
+// perform on at least one resource of each resource type in the scope
+// scopeId, keyed by resource type. Actions allowed on all resource types are
+// keyed by resource.All.
+func CalculateAuthorizedActions(ctx context.Context,
+	authResults VerifyResults,
+	scopeId string,
+) (map[string]*structpb.ListValue, error) {
+	var authorized map[resource.Type]action.ActionSet
+	switch {
+	case authResults.v != nil &&
+		(authResults.v.requestInfo.DisableAuthEntirely ||
+			authResults.v.requestInfo.TokenFormat == uint32(AuthTokenTypeRecoveryKms)):
+		// Everything is allowed, as in fetchActions
+		authorized = map[resource.Type]action.ActionSet{resource.All: {action.All}}
+	default:
+		authorized = authResults.ACL().AuthorizedActions(scopeId, authResults.UserId)
+	}
+
+	ret := make(map[string]*structpb.ListValue, len(authorized))
+	for k, v := range authorized {
+		lv, err := structpb.NewList(strutil.StringListToInterfaceList(v.Strings()))
+		if err != nil {
+			return nil, err
+		}
+		ret[k.String()] = lv
+	}
+	return ret, nil
+}
@@ -33,6 +33,7 @@ type options struct {
 	withRecoveryTokenNotAllowed bool
 	withAnonymousUserNotAllowed bool
 	withResource                *perms.Resource
+	withTags                    map[string][]string
 }
 
 func getDefaultOptions() options {
@@ -93,6 +94,14 @@ func WithAnonymousUserNotAllowed(notAllowed bool) Option {
 	}
 }
 
+// WithTags specifies the tags of the resource, to match against the tags of
+// grants
+func WithTags(tags map[string][]string) Option {
+	return func(o *options) {
+		o.withTags = tags
+	}
+}
+
 // WithResource specifies a resouce to use
 func WithResource(resource *perms.Resource) Option {
 	return func(o *options) {
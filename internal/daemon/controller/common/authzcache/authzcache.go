@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package authzcache provides a short-lived cache of session authorization
+// decisions, so that clients authorizing bursts of sessions to the same target,
+// such as parallel ansible runs, don't each pay for resolving grants, looking
+// up the target and listing the hosts of its host sources.
+//
+// Cached decisions are dropped when their time to live elapses, and when the
+// resources they were made against are changed through the controller caching
+// them. Changes made through other controllers are only seen once the time to
+// live elapses, so it should be kept short.
+package authzcache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/util/template"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+)
+
+const (
+	// DefaultTimeToLive is how long decisions are cached for when no time to
+	// live is given.
+	DefaultTimeToLive = 5 * time.Second
+
+	// DefaultMaxEntries is the number of decisions cached when no maximum is
+	// given.
+	DefaultMaxEntries = 10000
+)
+
+// Key identifies a decision. Decisions are cached per auth token, including the
+// encrypted part of the token so that only the token holder can use them, and
+// per client ip and client certificate fingerprint so that decisions can't be
+// used by clients a token isn't bound to.
+type Key struct {
+	AuthTokenId                  string
+	EncryptedToken               string
+	ClientIp                     string
+	ClientCertificateFingerprint string
+	TargetId                     string
+}
+
+// Decision is the outcome of authorizing a session to a target, along with the
+// target state it was made against.
+type Decision struct {
+	UserId              string
+	UserData            template.Data
+	AuthTokenId         string
+	AuthTokenCreateTime time.Time
+	Scope               *scopes.ScopeInfo
+
+	Target            target.Target
+	HostSources       []target.HostSource
+	CredentialSources []target.CredentialSource
+	// Endpoints are the endpoints of the host sources. They are only set if
+	// the target has no address.
+	Endpoints []*host.Endpoint
+	// ConnectionLimitExempt is true if the user is granted the
+	// exceed-connection-limit action on the target, so their sessions are
+	// not limited by the session connection limit of the target.
+	ConnectionLimitExempt bool
+}
+
+type entry struct {
+	decision *Decision
+	expires  time.Time
+}
+
+// Cache is an in-memory cache of session authorization decisions. A nil Cache
+// caches nothing, so callers don't need to check whether caching is enabled.
+// A Cache is safe for concurrent use.
+type Cache struct {
+	ttl        time.Duration
+	maxEntries int
+	now        func() time.Time
+
+	mu      sync.Mutex
+	entries map[Key]entry
+}
+
+// New creates a Cache. Supported options are WithTimeToLive and
+// WithMaxEntries.
+func New(ctx context.Context, opt ...Option) (*Cache, error) {
+	const op = "authzcache.New"
+	opts := getOpts(opt...)
+	switch {
+	case opts.withTimeToLive < 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "time to live is negative")
+	case opts.withMaxEntries < 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "max entries is negative")
+	}
+	c := &Cache{
+		ttl:        opts.withTimeToLive,
+		maxEntries: opts.withMaxEntries,
+		now:        opts.withNow,
+		entries:    make(map[Key]entry),
+	}
+	if c.ttl == 0 {
+		c.ttl = DefaultTimeToLive
+	}
+	if c.maxEntries == 0 {
+		c.maxEntries = DefaultMaxEntries
+	}
+	if c.now == nil {
+		c.now = time.Now
+	}
+	return c, nil
+}
+
+// Get returns the decision cached for the key, if it has not expired.
+func (c *Cache) Get(k Key) (*Decision, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[k]
+	if !ok {
+		return nil, false
+	}
+	if !c.now().Before(e.expires) {
+		delete(c.entries, k)
+		return nil, false
+	}
+	return e.decision, true
+}
+
+// Set caches the decision for the key. When the cache is full, expired
+// entries are removed first, then arbitrary entries until there is room for
+// the new one.
+func (c *Cache) Set(k Key, d *Decision) {
+	if c == nil || d == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	if _, ok := c.entries[k]; !ok && len(c.entries) >= c.maxEntries {
+		for ek, e := range c.entries {
+			if !now.Before(e.expires) {
+				delete(c.entries, ek)
+			}
+		}
+		for ek := range c.entries {
+			if len(c.entries) < c.maxEntries {
+				break
+			}
+			delete(c.entries, ek)
+		}
+	}
+	c.entries[k] = entry{
+		decision: d,
+		expires:  now.Add(c.ttl),
+	}
+}
+
+// InvalidateTarget removes the decisions made for the target.
+func (c *Cache) InvalidateTarget(targetId string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k := range c.entries {
+		if k.TargetId == targetId {
+			delete(c.entries, k)
+		}
+	}
+}
+
+// InvalidateAll removes every decision.
+func (c *Cache) InvalidateAll() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[Key]entry)
+}
+
+// Len returns the number of cached decisions, including expired ones which
+// have not been removed yet.
+func (c *Cache) Len() int {
+	if c == nil {
+		return 0
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
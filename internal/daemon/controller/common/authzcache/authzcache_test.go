@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package authzcache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+	c, err := New(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultTimeToLive, c.ttl)
+	assert.Equal(t, DefaultMaxEntries, c.maxEntries)
+
+	c, err = New(ctx, WithTimeToLive(time.Second), WithMaxEntries(5))
+	require.NoError(t, err)
+	assert.Equal(t, time.Second, c.ttl)
+	assert.Equal(t, 5, c.maxEntries)
+
+	_, err = New(ctx, WithTimeToLive(-time.Second))
+	require.Error(t, err)
+	_, err = New(ctx, WithMaxEntries(-1))
+	require.Error(t, err)
+}
+
+func TestCache(t *testing.T) {
+	ctx := context.Background()
+	k1 := Key{AuthTokenId: "at_1234567890", EncryptedToken: "token", ClientIp: "127.0.0.1", TargetId: "ttcp_1234567890"}
+	k2 := Key{AuthTokenId: "at_1234567890", EncryptedToken: "token", ClientIp: "127.0.0.1", TargetId: "ttcp_0987654321"}
+	d := &Decision{UserId: "u_1234567890", AuthTokenId: "at_1234567890"}
+
+	t.Run("expiry", func(t *testing.T) {
+		now := time.Now()
+		c, err := New(ctx, WithTimeToLive(time.Second), withNow(func() time.Time { return now }))
+		require.NoError(t, err)
+		_, ok := c.Get(k1)
+		assert.False(t, ok)
+
+		c.Set(k1, d)
+		got, ok := c.Get(k1)
+		require.True(t, ok)
+		assert.Equal(t, d, got)
+
+		// a different client ip or token does not get the decision
+		other := k1
+		other.ClientIp = "127.0.0.2"
+		_, ok = c.Get(other)
+		assert.False(t, ok)
+		other = k1
+		other.EncryptedToken = "other"
+		_, ok = c.Get(other)
+		assert.False(t, ok)
+		other = k1
+		other.ClientCertificateFingerprint = "other"
+		_, ok = c.Get(other)
+		assert.False(t, ok)
+
+		now = now.Add(time.Second)
+		_, ok = c.Get(k1)
+		assert.False(t, ok)
+		assert.Equal(t, 0, c.Len())
+	})
+	t.Run("max-entries", func(t *testing.T) {
+		now := time.Now()
+		c, err := New(ctx, WithMaxEntries(1), withNow(func() time.Time { return now }))
+		require.NoError(t, err)
+		c.Set(k1, d)
+		c.Set(k2, d)
+		assert.Equal(t, 1, c.Len())
+		_, ok := c.Get(k2)
+		assert.True(t, ok)
+
+		// replacing an entry does not evict
+		c.Set(k2, d)
+		assert.Equal(t, 1, c.Len())
+	})
+	t.Run("invalidate", func(t *testing.T) {
+		c, err := New(ctx)
+		require.NoError(t, err)
+		c.Set(k1, d)
+		c.Set(k2, d)
+		c.InvalidateTarget(k1.TargetId)
+		_, ok := c.Get(k1)
+		assert.False(t, ok)
+		_, ok = c.Get(k2)
+		assert.True(t, ok)
+
+		c.InvalidateAll()
+		assert.Equal(t, 0, c.Len())
+	})
+	t.Run("nil", func(t *testing.T) {
+		var c *Cache
+		c.Set(k1, d)
+		_, ok := c.Get(k1)
+		assert.False(t, ok)
+		c.InvalidateTarget(k1.TargetId)
+		c.InvalidateAll()
+		assert.Equal(t, 0, c.Len())
+	})
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package authzcache
+
+import "time"
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withTimeToLive time.Duration
+	withMaxEntries int
+	withNow        func() time.Time
+}
+
+func getDefaultOptions() options {
+	return options{}
+}
+
+// WithTimeToLive provides an option to specify how long decisions are cached
+// for. Defaults to DefaultTimeToLive.
+func WithTimeToLive(ttl time.Duration) Option {
+	return func(o *options) {
+		o.withTimeToLive = ttl
+	}
+}
+
+// WithMaxEntries provides an option to specify the maximum number of cached
+// decisions. Defaults to DefaultMaxEntries.
+func WithMaxEntries(max int) Option {
+	return func(o *options) {
+		o.withMaxEntries = max
+	}
+}
+
+// withNow provides an option to specify the clock, for tests.
+func withNow(now func() time.Time) Option {
+	return func(o *options) {
+		o.withNow = now
+	}
+}
@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package authzcache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetOpts(t *testing.T) {
+	t.Parallel()
+	t.Run("WithTimeToLive", func(t *testing.T) {
+		opts := getOpts(WithTimeToLive(time.Minute))
+		testOpts := getDefaultOptions()
+		testOpts.withTimeToLive = time.Minute
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithMaxEntries", func(t *testing.T) {
+		opts := getOpts(WithMaxEntries(5))
+		testOpts := getDefaultOptions()
+		testOpts.withMaxEntries = 5
+		assert.Equal(t, opts, testOpts)
+	})
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
+	"github.com/hashicorp/boundary/internal/credential/azure"
+	credplugin "github.com/hashicorp/boundary/internal/credential/plugin"
 	credstatic "github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	pluginhost "github.com/hashicorp/boundary/internal/host/plugin"
@@ -15,12 +17,15 @@ import (
 	hostplugin "github.com/hashicorp/boundary/internal/plugin/host"
 	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/subscription"
 )
 
 type (
 	AuthTokenRepoFactory         = oidc.AuthTokenRepoFactory
 	VaultCredentialRepoFactory   = func() (*vault.Repository, error)
 	StaticCredentialRepoFactory  = func() (*credstatic.Repository, error)
+	AzureCredentialRepoFactory   = func() (*azure.Repository, error)
+	PluginCredentialRepoFactory  = func() (*credplugin.Repository, error)
 	IamRepoFactory               = iam.IamRepoFactory
 	OidcAuthRepoFactory          = oidc.OidcRepoFactory
 	LdapAuthRepoFactory          = ldap.RepoFactory
@@ -31,6 +36,7 @@ type (
 	HostPluginRepoFactory        func() (*hostplugin.Repository, error)
 	ConnectionRepoFactory        func() (*session.ConnectionRepository, error)
 	WorkerAuthRepoStorageFactory func() (*server.WorkerAuthRepositoryStorage, error)
+	EventSubscriptionRepoFactory = subscription.RepoFactory
 )
 
 // Downstreamers provides at least a minimum interface that must be met by a
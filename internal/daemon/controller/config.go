@@ -4,6 +4,7 @@
 package controller
 
 import (
+	"github.com/hashicorp/boundary/internal/auth/anomaly"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/boundary/internal/cmd/config"
 )
@@ -17,4 +18,7 @@ type Config struct {
 	RawConfig *config.Config
 	// If set, authorization checking occurrs but failures are ignored
 	DisableAuthorizationFailures bool
+	// If set, used to resolve the location of clients when auth anomaly
+	// detection is enabled, instead of the networks in its configuration
+	AuthAnomalyResolver anomaly.Resolver
 }
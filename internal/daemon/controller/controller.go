@@ -11,19 +11,25 @@ import (
 	"sync"
 	"sync/atomic"
 
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/auth/anomaly"
 	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/credential/azure"
+	credplugin "github.com/hashicorp/boundary/internal/credential/plugin"
 	credstatic "github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/daemon/cluster"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
+	"github.com/hashicorp/boundary/internal/daemon/controller/common/authzcache"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/health"
 	"github.com/hashicorp/boundary/internal/daemon/controller/internal/metric"
 	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/deprecation"
 	"github.com/hashicorp/boundary/internal/errors"
 	pluginhost "github.com/hashicorp/boundary/internal/host/plugin"
 	"github.com/hashicorp/boundary/internal/host/static"
@@ -38,6 +44,7 @@ import (
 	"github.com/hashicorp/boundary/internal/server"
 	serversjob "github.com/hashicorp/boundary/internal/server/job"
 	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/subscription"
 	"github.com/hashicorp/boundary/internal/target"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	host_plugin_assets "github.com/hashicorp/boundary/plugins/host"
@@ -87,9 +94,14 @@ type Controller struct {
 	baseContext context.Context
 	baseCancel  context.CancelFunc
 	started     *ua.Bool
+	draining    *ua.Bool
 
 	tickerWg    *sync.WaitGroup
 	schedulerWg *sync.WaitGroup
+	// schedulerCancel stops the scheduler without canceling the base
+	// context, so its runs can be released during shutdown while this
+	// controller is still registered.
+	schedulerCancel context.CancelFunc
 
 	workerAuthCache *sync.Map
 
@@ -116,6 +128,8 @@ type Controller struct {
 	AuthTokenRepoFn         common.AuthTokenRepoFactory
 	VaultCredentialRepoFn   common.VaultCredentialRepoFactory
 	StaticCredentialRepoFn  common.StaticCredentialRepoFactory
+	AzureCredentialRepoFn   common.AzureCredentialRepoFactory
+	PluginCredentialRepoFn  common.PluginCredentialRepoFactory
 	IamRepoFn               common.IamRepoFactory
 	OidcRepoFn              common.OidcAuthRepoFactory
 	LdapRepoFn              common.LdapAuthRepoFactory
@@ -128,6 +142,7 @@ type Controller struct {
 	HostPluginRepoFn        common.HostPluginRepoFactory
 	TargetRepoFn            target.RepositoryFactory
 	WorkerAuthRepoStorageFn common.WorkerAuthRepoStorageFactory
+	EventSubscriptionRepoFn common.EventSubscriptionRepoFactory
 
 	scheduler *scheduler.Scheduler
 
@@ -140,14 +155,32 @@ type Controller struct {
 	HealthService *health.Service
 
 	pkiConnManager *cluster.DownstreamManager
+
+	// Used to evaluate authentication attempts, if enabled
+	authAnomalyDetector *anomaly.Detector
+
+	// Used to cache session authorization decisions, if enabled
+	authzCache *authzcache.Cache
+
+	// The options used to create iam repositories
+	iamOpts []iam.Option
+
+	// Used to track the clients using deprecated API fields and behaviors
+	deprecationTracker *deprecation.Tracker
+
+	// Used to deliver the notifications of event subscriptions
+	eventNotifier *subscription.Notifier
 }
 
 func New(ctx context.Context, conf *Config) (*Controller, error) {
 	metric.InitializeApiCollectors(conf.PrometheusRegisterer)
+	oidc.InitializeJwksCollectors(conf.PrometheusRegisterer)
+	ldap.InitializeConnectionCollectors(conf.PrometheusRegisterer)
 	c := &Controller{
 		conf:                    conf,
 		logger:                  conf.Logger.Named("controller"),
 		started:                 ua.NewBool(false),
+		draining:                ua.NewBool(false),
 		tickerWg:                new(sync.WaitGroup),
 		schedulerWg:             new(sync.WaitGroup),
 		workerAuthCache:         new(sync.Map),
@@ -178,6 +211,15 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 		return nil, fmt.Errorf("error auto-generating controller name: %w", err)
 	}
 
+	if p := conf.RawConfig.Controller.PublicIds; p != nil {
+		if err := db.ConfigurePublicIds(ctx, p.SuffixLength, p.Checksum); err != nil {
+			return nil, fmt.Errorf("error configuring public ids: %w", err)
+		}
+		if p.TimeSortable {
+			db.ConfigureTimeSortablePublicIds(ctx, globals.SessionPrefix, session.ConnectionPrefix)
+		}
+	}
+
 	if !conf.RawConfig.DisableMlock {
 		// Ensure our memory usage is locked into physical RAM
 		if err := mlock.LockMemory(); err != nil {
@@ -276,6 +318,13 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 		conf.HostPlugins = make(map[string]plugin.HostPluginServiceClient)
 	}
 
+	if err := c.loadCredentialPlugins(ctx); err != nil {
+		return nil, err
+	}
+	if conf.CredentialPlugins == nil {
+		conf.CredentialPlugins = make(map[string]plugin.CredentialPluginServiceClient)
+	}
+
 	// Set up repo stuff
 	dbase := db.New(c.conf.Database)
 	c.kms, err = kms.New(ctx, dbase, dbase)
@@ -291,8 +340,44 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 		return nil, fmt.Errorf("error adding config keys to kms: %w", err)
 	}
 
+	iamOpts := []iam.Option{iam.WithRandomReader(c.conf.SecureRandomReader)}
+	if g := c.conf.RawConfig.Controller.GrantsCache; g != nil {
+		grantsCache, err := iam.NewGrantsCache(ctx, g.MaxEntries)
+		if err != nil {
+			return nil, fmt.Errorf("error creating grants cache: %w", err)
+		}
+		iamOpts = append(iamOpts, iam.WithGrantsCache(grantsCache))
+	}
+	if c.conf.RawConfig.Controller.TenantIsolation {
+		iamOpts = append(iamOpts, iam.WithTenantIsolation(true))
+	}
+	c.iamOpts = iamOpts
+
+	if a := c.conf.RawConfig.Controller.AuthorizeSessionCache; a != nil {
+		c.authzCache, err = authzcache.New(ctx,
+			authzcache.WithTimeToLive(a.TimeToLiveDuration),
+			authzcache.WithMaxEntries(a.MaxEntries))
+		if err != nil {
+			return nil, fmt.Errorf("error creating authorize session cache: %w", err)
+		}
+	}
+
+	vaultOpts := []vault.Option{vault.WithRequestLimiter(vault.NewRequestLimiter())}
+	if cc := c.conf.RawConfig.Controller.CredentialCache; cc != nil {
+		secretCache, err := vault.NewSecretCache(ctx, cc.TimeToLiveDuration, cc.MaxEntries)
+		if err != nil {
+			return nil, fmt.Errorf("error creating credential cache: %w", err)
+		}
+		vaultOpts = append(vaultOpts, vault.WithSecretCache(secretCache))
+	}
+
+	c.deprecationTracker, err = deprecation.NewTracker(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error creating deprecation tracker: %w", err)
+	}
+
 	// we need to get all the scopes so we can reconcile the DEKs for each scope.
-	iamRepo, err := iam.NewRepository(dbase, dbase, c.kms, iam.WithRandomReader(c.conf.SecureRandomReader))
+	iamRepo, err := iam.NewRepository(dbase, dbase, c.kms, iamOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize iam repository: %w", err)
 	}
@@ -336,7 +421,7 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 		return nil, fmt.Errorf("error creating new scheduler: %w", err)
 	}
 	c.IamRepoFn = func() (*iam.Repository, error) {
-		return iam.NewRepository(dbase, dbase, c.kms, iam.WithRandomReader(c.conf.SecureRandomReader))
+		return iam.NewRepository(dbase, dbase, c.kms, iamOpts...)
 	}
 	c.StaticHostRepoFn = func() (*static.Repository, error) {
 		return static.NewRepository(dbase, dbase, c.kms)
@@ -347,17 +432,57 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 	c.HostPluginRepoFn = func() (*host.Repository, error) {
 		return host.NewRepository(dbase, dbase, c.kms)
 	}
+	authTokenOpts := []authtoken.Option{
+		authtoken.WithTokenTimeToLiveDuration(c.conf.RawConfig.Controller.AuthTokenTimeToLiveDuration),
+		authtoken.WithTokenTimeToStaleDuration(c.conf.RawConfig.Controller.AuthTokenTimeToStaleDuration),
+	}
+	if b := c.conf.RawConfig.Controller.AuthTokenBinding; b != nil {
+		authTokenOpts = append(authTokenOpts,
+			authtoken.WithBindingMode(b.BindingMode),
+			authtoken.WithBindingIpv4PrefixLength(b.Ipv4PrefixLength),
+			authtoken.WithBindingIpv6PrefixLength(b.Ipv6PrefixLength))
+	}
+	if a := c.conf.RawConfig.Controller.AuthAnomalyDetection; a != nil {
+		resolver := c.conf.AuthAnomalyResolver
+		if resolver == nil && len(a.Networks) > 0 {
+			networks := make([]anomaly.Network, 0, len(a.Networks))
+			for _, n := range a.Networks {
+				networks = append(networks, anomaly.Network{
+					Prefix: n.Prefix,
+					Location: anomaly.Location{
+						CountryCode: n.CountryCode,
+						City:        n.City,
+						Latitude:    n.Latitude,
+						Longitude:   n.Longitude,
+					},
+				})
+			}
+			resolver, err = anomaly.NewNetworkResolver(ctx, networks)
+			if err != nil {
+				return nil, fmt.Errorf("error creating auth anomaly network resolver: %w", err)
+			}
+		}
+		c.authAnomalyDetector = anomaly.NewDetector(
+			anomaly.WithResolver(resolver),
+			anomaly.WithMaxTravelSpeedKmh(a.MaxTravelSpeedKmh),
+			anomaly.WithImpossibleTravelAction(a.ImpossibleTravel),
+			anomaly.WithHistorySize(a.HistorySize))
+	}
 	c.AuthTokenRepoFn = func() (*authtoken.Repository, error) {
-		return authtoken.NewRepository(dbase, dbase, c.kms,
-			authtoken.WithTokenTimeToLiveDuration(c.conf.RawConfig.Controller.AuthTokenTimeToLiveDuration),
-			authtoken.WithTokenTimeToStaleDuration(c.conf.RawConfig.Controller.AuthTokenTimeToStaleDuration))
+		return authtoken.NewRepository(dbase, dbase, c.kms, authTokenOpts...)
 	}
 	c.VaultCredentialRepoFn = func() (*vault.Repository, error) {
-		return vault.NewRepository(dbase, dbase, c.kms, c.scheduler)
+		return vault.NewRepository(dbase, dbase, c.kms, c.scheduler, vaultOpts...)
 	}
 	c.StaticCredentialRepoFn = func() (*credstatic.Repository, error) {
 		return credstatic.NewRepository(ctx, dbase, dbase, c.kms)
 	}
+	c.AzureCredentialRepoFn = func() (*azure.Repository, error) {
+		return azure.NewRepository(ctx, dbase, dbase, c.kms)
+	}
+	c.PluginCredentialRepoFn = func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(ctx, dbase, dbase, c.kms, c.conf.CredentialPlugins)
+	}
 	c.ServersRepoFn = func() (*server.Repository, error) {
 		return server.NewRepository(dbase, dbase, c.kms)
 	}
@@ -385,6 +510,20 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 	c.WorkerAuthRepoStorageFn = func() (*server.WorkerAuthRepositoryStorage, error) {
 		return server.NewRepositoryStorage(ctx, dbase, dbase, c.kms)
 	}
+	c.EventSubscriptionRepoFn = func() (*subscription.Repository, error) {
+		return subscription.NewRepository(ctx, dbase, dbase)
+	}
+
+	var notifierOpts []subscription.Option
+	if e := c.conf.RawConfig.Controller.EventSubscriptions; e != nil {
+		notifierOpts = append(notifierOpts,
+			subscription.WithWebhooks(e.EnableWebhooks),
+			subscription.WithWebhookTimeout(e.WebhookTimeoutDuration))
+	}
+	c.eventNotifier, err = subscription.NewNotifier(ctx, c.EventSubscriptionRepoFn, c.IamRepoFn, notifierOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating event subscription notifier: %w", err)
+	}
 
 	// Check that credentials are available at startup, to avoid some harmless
 	// but nasty-looking errors
@@ -434,15 +573,21 @@ func (c *Controller) Start() error {
 	if err := c.upsertController(c.baseContext); err != nil {
 		return fmt.Errorf("error upserting controller: %w", err)
 	}
-	if err := c.scheduler.Start(c.baseContext, c.schedulerWg); err != nil {
+	var schedulerContext context.Context
+	schedulerContext, c.schedulerCancel = context.WithCancel(c.baseContext)
+	if err := c.scheduler.Start(schedulerContext, c.schedulerWg); err != nil {
 		return fmt.Errorf("error starting scheduler: %w", err)
 	}
 
-	c.tickerWg.Add(5)
+	c.tickerWg.Add(6)
 	go func() {
 		defer c.tickerWg.Done()
 		c.startStatusTicking(c.baseContext)
 	}()
+	go func() {
+		defer c.tickerWg.Done()
+		c.eventNotifier.Run(c.baseContext)
+	}()
 	go func() {
 		defer c.tickerWg.Done()
 		c.startNonceCleanupTicking(c.baseContext)
@@ -512,10 +657,28 @@ func (c *Controller) registerJobs() error {
 	if err := vault.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms); err != nil {
 		return err
 	}
+	if err := credstatic.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms); err != nil {
+		return err
+	}
 	if err := pluginhost.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms, c.conf.HostPlugins); err != nil {
 		return err
 	}
-	if err := session.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms, c.workerStatusGracePeriod); err != nil {
+	if err := credplugin.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms, c.conf.CredentialPlugins); err != nil {
+		return err
+	}
+	sessionJobOpts := []session.Option{session.WithIamOptions(c.iamOpts...)}
+	if a := c.conf.RawConfig.Controller.SessionAuthorizationCheck; a != nil {
+		sessionJobOpts = append(sessionJobOpts,
+			session.WithAuthorizationCheck(a.IntervalDuration),
+			session.WithAuthorizationGrace(a.GracePeriodDuration))
+	}
+	if f := c.conf.RawConfig.Controller.WorkerFailureDetection; f != nil {
+		sessionJobOpts = append(sessionJobOpts,
+			session.WithWorkerStatusInterval(f.StatusIntervalDuration),
+			session.WithMissedStatusLimit(f.MissedStatusLimit),
+			session.WithCleanupInterval(f.SessionCleanupIntervalDuration))
+	}
+	if err := session.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms, c.workerStatusGracePeriod, sessionJobOpts...); err != nil {
 		return err
 	}
 	if err := serversjob.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms); err != nil {
@@ -537,11 +700,24 @@ func (c *Controller) Shutdown() error {
 		event.WriteSysEvent(context.TODO(), op, "already shut down, skipping")
 	}
 	defer c.started.Store(false)
+
+	// Stop the scheduler and release its runs before deregistering, since
+	// deleting this controller clears the controller id of its runs and
+	// would fail the runs the scheduler claims in the meantime.
+	c.draining.Store(true)
+	c.stopScheduler()
+
+	// Stop advertising this controller to workers and drain the API before
+	// canceling the base context, which cancels in-flight requests.
+	c.deregisterController()
+	if err := c.drainHttpServers(); err != nil {
+		event.WriteError(context.TODO(), op, err, event.WithInfoMsg("error draining api listeners"))
+	}
+
 	c.baseCancel()
 	if err := c.stopServersAndListeners(); err != nil {
 		return fmt.Errorf("error stopping controller servers and listeners: %w", err)
 	}
-	c.schedulerWg.Wait()
 	c.tickerWg.Wait()
 	if c.conf.Eventer != nil {
 		if err := c.conf.Eventer.FlushNodes(context.Background()); err != nil {
@@ -551,6 +727,55 @@ func (c *Controller) Shutdown() error {
 	return nil
 }
 
+// stopScheduler cancels the scheduler, waits for its running jobs to return
+// and releases their runs.
+func (c *Controller) stopScheduler() {
+	if c.schedulerCancel != nil {
+		c.schedulerCancel()
+	}
+	c.schedulerWg.Wait()
+	c.releaseJobRuns()
+}
+
+// deregisterController deletes this controller from the database, so other
+// controllers stop returning it to workers as an upstream and workers move
+// their connections before it stops.
+func (c *Controller) deregisterController() {
+	const op = "controller.(Controller).deregisterController"
+	if c.ServersRepoFn == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownDbTimeout)
+	defer cancel()
+	repo, err := c.ServersRepoFn()
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error fetching repository for controller deregistration"))
+		return
+	}
+	if _, err := repo.DeleteController(ctx, c.conf.RawConfig.Controller.Name); err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error deregistering controller"))
+	}
+}
+
+// releaseJobRuns interrupts the job runs of this controller which were
+// canceled by the shutdown, so other controllers run their jobs right away.
+func (c *Controller) releaseJobRuns() {
+	const op = "controller.(Controller).releaseJobRuns"
+	if c.scheduler == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownDbTimeout)
+	defer cancel()
+	runs, err := c.scheduler.ReleaseRuns(ctx)
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error releasing job runs"))
+		return
+	}
+	if len(runs) > 0 {
+		event.WriteSysEvent(ctx, op, "released job runs", "count", len(runs))
+	}
+}
+
 // WorkerStatusUpdateTimes returns the map, which specifically is held in _this_
 // controller, not the DB. It's used in tests to verify that a given controller
 // is receiving updates from an expected set of workers, to test out balancing
@@ -9,11 +9,13 @@ import (
 	"path/filepath"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/boundary/internal/cmd/config"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	host_plugin_assets "github.com/hashicorp/boundary/plugins/host"
 	"github.com/hashicorp/go-secure-stdlib/listenerutil"
@@ -319,3 +321,61 @@ func Test_ControllerStart(t *testing.T) {
 	assert.Contains(t, jobNames, "data-key-version-destruction-monitor-job")
 	assert.Contains(t, jobNames, "session-rewrapping-job")
 }
+
+// blockingJob runs until its context is canceled.
+type blockingJob struct {
+	started chan struct{}
+}
+
+func (j *blockingJob) Status() scheduler.JobStatus { return scheduler.JobStatus{} }
+func (j *blockingJob) Run(ctx context.Context) error {
+	close(j.started)
+	<-ctx.Done()
+	return ctx.Err()
+}
+func (j *blockingJob) NextRunIn(context.Context) (time.Duration, error) { return time.Hour, nil }
+func (j *blockingJob) Name() string                                     { return "blocking-test-job" }
+func (j *blockingJob) Description() string                              { return "blocks until canceled" }
+
+func Test_ControllerShutdown(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	tc := &TestController{
+		t:              t,
+		ctx:            ctx,
+		cancel:         cancel,
+		opts:           nil,
+		shutdownDoneCh: make(chan struct{}),
+		shutdownOnce:   new(sync.Once),
+	}
+	conf := TestControllerConfig(t, ctx, tc, nil)
+
+	c, err := New(ctx, conf)
+	require.NoError(t, err)
+	j := &blockingJob{started: make(chan struct{})}
+	require.NoError(t, c.scheduler.RegisterJob(ctx, j))
+	require.NoError(t, c.Start())
+	c.scheduler.RunNow()
+
+	select {
+	case <-j.started:
+	case <-time.After(10 * time.Second):
+		require.FailNow(t, "timed out waiting for job to run")
+	}
+	require.NoError(t, c.Shutdown())
+
+	sqlDb, err := tc.DbConn().SqlDB(ctx)
+	require.NoError(t, err)
+
+	// The run was released before the controller deregistered, deregistering
+	// first would have cleared its controller id and left it running
+	var status string
+	err = sqlDb.QueryRowContext(ctx, "select status from job_run where job_name = $1", j.Name()).Scan(&status)
+	require.NoError(t, err)
+	assert.Equal(t, "interrupted", status)
+
+	var count int
+	err = sqlDb.QueryRowContext(ctx, "select count(*) from server_controller where private_id = $1", conf.RawConfig.Controller.Name).Scan(&count)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/boundary/internal/observability/event"
+	credentialplugin "github.com/hashicorp/boundary/internal/plugin/credential"
+	external_credential_plugins "github.com/hashicorp/boundary/sdk/plugins/credential"
+	"github.com/hashicorp/go-secure-stdlib/pluginutil/v2"
+)
+
+// loadCredentialPlugins starts each of the controller's configured
+// credential plugins and registers them with the server. The plugins are
+// stopped by the server's shutdown funcs.
+func (c *Controller) loadCredentialPlugins(ctx context.Context) error {
+	const op = "controller.(Controller).loadCredentialPlugins"
+	names := c.conf.RawConfig.Controller.CredentialPlugins
+	if len(names) == 0 {
+		return nil
+	}
+	pluginLogger, err := event.NewHclogLogger(ctx, c.conf.Eventer)
+	if err != nil {
+		return fmt.Errorf("%s: error creating credential plugin logger: %w", op, err)
+	}
+	pluginsFs := os.DirFS(c.conf.RawConfig.Controller.CredentialPluginsDir)
+
+	for _, name := range names {
+		client, cleanup, err := external_credential_plugins.CreateCredentialPlugin(
+			ctx,
+			name,
+			external_credential_plugins.WithPluginOptions(
+				pluginutil.WithPluginExecutionDirectory(c.conf.RawConfig.Plugins.ExecutionDir),
+				pluginutil.WithPluginsFilesystem(external_credential_plugins.CredentialPluginPrefix, pluginsFs),
+			),
+			external_credential_plugins.WithLogger(pluginLogger.Named(name)),
+		)
+		if err != nil {
+			return fmt.Errorf("%s: error creating %s credential plugin: %w", op, name, err)
+		}
+		c.conf.ShutdownFuncs = append(c.conf.ShutdownFuncs, cleanup)
+
+		if _, err := c.conf.RegisterCredentialPlugin(ctx, name, client,
+			credentialplugin.WithDescription(fmt.Sprintf("External %s credential plugin", name))); err != nil {
+			return fmt.Errorf("%s: error registering %s credential plugin: %w", op, name, err)
+		}
+		event.WriteSysEvent(ctx, op, "loaded credential plugin", "plugin", name)
+	}
+	return nil
+}
@@ -13,11 +13,14 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
+	"github.com/hashicorp/boundary/internal/daemon/controller/common/authzcache"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
 	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/deprecation"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/subscription"
 	"github.com/hashicorp/boundary/internal/types/subtypes"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/test/bufconn"
@@ -75,6 +78,10 @@ func newGrpcServer(
 	ldapAuthRepoFn common.LdapAuthRepoFactory,
 	kms *kms.Kms,
 	eventer *event.Eventer,
+	maxAttributeDepth int,
+	authzCache *authzcache.Cache,
+	deprecationTracker *deprecation.Tracker,
+	notifier *subscription.Notifier,
 ) (*grpc.Server, string, error) {
 	const op = "controller.newGrpcServer"
 	ticket, err := db.NewPrivateId("gwticket")
@@ -90,12 +97,16 @@ func newGrpcServer(
 		grpc.MaxSendMsgSize(math.MaxInt32),
 		grpc.UnaryInterceptor(
 			grpc_middleware.ChainUnaryServer(
-				requestCtxInterceptor,                         // populated requestInfo from headers into the request ctx
-				errorInterceptor(ctx),                         // convert domain and api errors into headers for the http proxy
-				subtypes.AttributeTransformerInterceptor(ctx), // convert to/from generic attributes from/to subtype specific attributes
-				auditRequestInterceptor(ctx),                  // before we get started, audit the request
-				statusCodeInterceptor(ctx),                    // convert grpc codes into http status codes for the http proxy (can modify the resp)
-				auditResponseInterceptor(ctx),                 // as we finish, audit the response
+				requestCtxInterceptor,                             // populated requestInfo from headers into the request ctx
+				errorInterceptor(ctx),                             // convert domain and api errors into headers for the http proxy
+				deprecationInterceptor(ctx, deprecationTracker),   // return and track the deprecations used by the request
+				attributeDepthInterceptor(ctx, maxAttributeDepth), // reject requests with too deeply nested attributes
+				subtypes.AttributeTransformerInterceptor(ctx),     // convert to/from generic attributes from/to subtype specific attributes
+				auditRequestInterceptor(ctx),                      // before we get started, audit the request
+				statusCodeInterceptor(ctx),                        // convert grpc codes into http status codes for the http proxy (can modify the resp)
+				auditResponseInterceptor(ctx),                     // as we finish, audit the response
+				authorizationCacheInterceptor(ctx, authzCache),    // drop cached session authorizations the request may have changed
+				eventSubscriptionInterceptor(ctx, notifier),       // notify the users subscribed to the resource the request changed
 				grpc_recovery.UnaryServerInterceptor( // recover from panics with a grpc internal error
 					grpc_recovery.WithRecoveryHandlerContext(recoveryHandler()),
 				),
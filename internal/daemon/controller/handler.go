@@ -9,16 +9,20 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/textproto"
 	"os"
+	"path"
 	"strings"
 	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/cmd/config"
 	"github.com/hashicorp/boundary/internal/daemon/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/accounts"
@@ -123,6 +127,16 @@ func (c *Controller) GetHealthHandler(lcfg *listenerutil.ListenerConfig) (http.H
 	return common.WrapWithEventsHandler(wrapped, c.conf.Eventer, c.kms, lcfg)
 }
 
+// GetDeprecationHandler returns a handler serving the report of the clients
+// which have used deprecated API fields and behaviors since the controller
+// started.
+func (c *Controller) GetDeprecationHandler() http.Handler {
+	if c.deprecationTracker == nil {
+		return nil
+	}
+	return c.deprecationTracker
+}
+
 func registerHealthGrpcGatewayEndpoint(ctx context.Context, gwMux *runtime.ServeMux, dialOptions ...grpc.DialOption) error {
 	return opsservices.RegisterHealthServiceHandlerFromEndpoint(ctx, gwMux, gatewayTarget, dialOptions)
 }
@@ -161,7 +175,7 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 		services.RegisterAccountServiceServer(s, accts)
 	}
 	if _, ok := currentServices[services.AuthMethodService_ServiceDesc.ServiceName]; !ok {
-		authMethods, err := authmethods.NewService(c.kms, c.PasswordAuthRepoFn, c.OidcRepoFn, c.IamRepoFn, c.AuthTokenRepoFn, c.LdapRepoFn)
+		authMethods, err := authmethods.NewService(c.kms, c.PasswordAuthRepoFn, c.OidcRepoFn, c.IamRepoFn, c.AuthTokenRepoFn, c.LdapRepoFn, handlers.WithAnomalyDetector(c.authAnomalyDetector))
 		if err != nil {
 			return fmt.Errorf("failed to create auth method handler service: %w", err)
 		}
@@ -175,7 +189,14 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 		services.RegisterAuthTokenServiceServer(s, authtoks)
 	}
 	if _, ok := currentServices[services.ScopeService_ServiceDesc.ServiceName]; !ok {
-		os, err := scopes.NewService(c.baseContext, c.IamRepoFn, c.kms)
+		scopeOpts := []handlers.Option{
+			handlers.WithEventSubscriptionRepo(c.EventSubscriptionRepoFn),
+			handlers.WithEventSubscriptionWebhooks(c.eventNotifier.WebhooksEnabled()),
+		}
+		if d := c.conf.RawConfig.Controller.Discovery; d != nil {
+			scopeOpts = append(scopeOpts, handlers.WithDiscoveryDefaults(d.DefaultScopeId, d.DefaultAuthMethodId))
+		}
+		os, err := scopes.NewService(c.baseContext, c.IamRepoFn, c.kms, scopeOpts...)
 		if err != nil {
 			return fmt.Errorf("failed to create scope handler service: %w", err)
 		}
@@ -200,8 +221,11 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 			c.StaticHostRepoFn,
 			c.VaultCredentialRepoFn,
 			c.StaticCredentialRepoFn,
+			c.AzureCredentialRepoFn,
 			c.downstreamWorkers,
-			c.workerStatusGracePeriod)
+			c.workerStatusGracePeriod,
+			c.authzCache,
+			handlers.WithRegion(c.conf.RawConfig.Controller.Region))
 		if err != nil {
 			return fmt.Errorf("failed to create target handler service: %w", err)
 		}
@@ -236,14 +260,14 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 		services.RegisterManagedGroupServiceServer(s, mgs)
 	}
 	if _, ok := currentServices[services.CredentialStoreService_ServiceDesc.ServiceName]; !ok {
-		cs, err := credentialstores.NewService(c.baseContext, c.VaultCredentialRepoFn, c.StaticCredentialRepoFn, c.IamRepoFn)
+		cs, err := credentialstores.NewService(c.baseContext, c.VaultCredentialRepoFn, c.StaticCredentialRepoFn, c.AzureCredentialRepoFn, c.IamRepoFn)
 		if err != nil {
 			return fmt.Errorf("failed to create credential store handler service: %w", err)
 		}
 		services.RegisterCredentialStoreServiceServer(s, cs)
 	}
 	if _, ok := currentServices[services.CredentialLibraryService_ServiceDesc.ServiceName]; !ok {
-		cl, err := credentiallibraries.NewService(c.VaultCredentialRepoFn, c.IamRepoFn)
+		cl, err := credentiallibraries.NewService(c.VaultCredentialRepoFn, c.AzureCredentialRepoFn, c.IamRepoFn)
 		if err != nil {
 			return fmt.Errorf("failed to create credential library handler service: %w", err)
 		}
@@ -251,7 +275,7 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 	}
 	if _, ok := currentServices[services.WorkerService_ServiceDesc.ServiceName]; !ok {
 		ws, err := workers.NewService(c.baseContext, c.ServersRepoFn, c.IamRepoFn, c.WorkerAuthRepoStorageFn,
-			c.downstreamWorkers)
+			c.downstreamWorkers, c.workerStatusGracePeriod)
 		if err != nil {
 			return fmt.Errorf("failed to create worker handler service: %w", err)
 		}
@@ -345,6 +369,10 @@ func wrapHandlerWithCommonFuncs(h http.Handler, c *Controller, props HandlerProp
 	if maxRequestSize == 0 {
 		maxRequestSize = globals.DefaultMaxRequestSize
 	}
+	var endpointLimits []*config.EndpointRequestLimit
+	if c.conf.RawConfig.Controller != nil && c.conf.RawConfig.Controller.RequestLimits != nil {
+		endpointLimits = c.conf.RawConfig.Controller.RequestLimits.Endpoints
+	}
 
 	disableAuthzFailures := c.conf.DisableAuthorizationFailures ||
 		(c.conf.RawConfig.DevController && os.Getenv("BOUNDARY_DEV_SKIP_AUTHZ") != "")
@@ -361,8 +389,23 @@ func wrapHandlerWithCommonFuncs(h http.Handler, c *Controller, props HandlerProp
 		defer cancelFunc()
 
 		// Add a size limiter if desired
-		if maxRequestSize > 0 {
-			ctx = context.WithValue(ctx, globals.ContextMaxRequestSizeTypeKey, maxRequestSize)
+		if limit := requestSizeLimit(r.URL.Path, maxRequestSize, endpointLimits); limit > 0 {
+			ctx = context.WithValue(ctx, globals.ContextMaxRequestSizeTypeKey, limit)
+			if err := limitRequestBody(r, limit); err != nil {
+				var apiErr *handlers.ApiError
+				if !errors.As(err, &apiErr) {
+					event.WriteError(ctx, op, err, event.WithInfoMsg("error reading request body"))
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				writeApiError(ctx, w, apiErr)
+				return
+			}
+		}
+
+		if apiErr := checkNameLookup(r); apiErr != nil {
+			writeApiError(ctx, w, apiErr)
+			return
 		}
 
 		// Add values for authn/authz checking
@@ -385,6 +428,10 @@ func wrapHandlerWithCommonFuncs(h http.Handler, c *Controller, props HandlerProp
 			return
 		}
 
+		if r.TLS != nil && len(r.TLS.PeerCertificates) > 0 {
+			requestInfo.ClientCertificateFingerprint = authtoken.CertificateFingerprint(r.TLS.PeerCertificates[0])
+		}
+
 		// Serialize the request info to send it across the wire to the
 		// grpc-gateway via an http header
 		requestInfo.Ticket = c.apiGrpcGatewayTicket // allows the grpc-gateway to verify the request info came from it's in-memory companion http proxy
@@ -405,6 +452,84 @@ func wrapHandlerWithCommonFuncs(h http.Handler, c *Controller, props HandlerProp
 	})
 }
 
+// requestSizeLimit returns the maximum body size of requests to the path: the
+// limit of the first endpoint whose path pattern matches, otherwise the limit
+// of the listener.
+func requestSizeLimit(urlPath string, listenerLimit int64, endpoints []*config.EndpointRequestLimit) int64 {
+	for _, e := range endpoints {
+		if ok, _ := path.Match(e.Path, urlPath); ok {
+			return int64(e.MaxRequestSize)
+		}
+	}
+	return listenerLimit
+}
+
+// nameLookupCollections are the collections whose resources can be read by
+// their name and the ID of the scope containing them.
+var nameLookupCollections = map[string]bool{
+	"groups":  true,
+	"roles":   true,
+	"scopes":  true,
+	"targets": true,
+	"users":   true,
+}
+
+// checkNameLookup returns an api error if the request reads a resource by name
+// from a collection whose resources can't be read by name. Otherwise the
+// grpc-gateway would ignore the name and look up the resource by the ID in
+// the path.
+func checkNameLookup(r *http.Request) *handlers.ApiError {
+	if r.Method != http.MethodGet || r.URL.Query().Get("name") == "" {
+		return nil
+	}
+	collection, id, ok := strings.Cut(strings.TrimPrefix(r.URL.Path, "/v1/"), "/")
+	if !ok || id == "" || strings.ContainsAny(id, "/:") || nameLookupCollections[collection] {
+		return nil
+	}
+	return handlers.InvalidArgumentErrorf("Error in provided request.", map[string]string{
+		"name": fmt.Sprintf("Reading %s by name is not supported; only groups, roles, scopes, targets, and users can be read by name.", collection),
+	})
+}
+
+// limitRequestBody reads the body of the request, returning an api error if
+// it is larger than limit, and replaces it with the bytes read so it can be
+// read again by the handler.
+func limitRequestBody(r *http.Request, limit int64) error {
+	if r.Body == nil || r.Body == http.NoBody {
+		return nil
+	}
+	if r.ContentLength > limit {
+		return handlers.RequestTooLargeErrorf("Request body of %d bytes exceeds the maximum size of %d bytes.", r.ContentLength, limit)
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, limit+1))
+	if err != nil {
+		return err
+	}
+	if int64(len(body)) > limit {
+		return handlers.RequestTooLargeErrorf("Request body exceeds the maximum size of %d bytes.", limit)
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	return nil
+}
+
+// writeApiError writes the api error as the response, in the format of the
+// errors returned by the grpc-gateway.
+func writeApiError(ctx context.Context, w http.ResponseWriter, apiErr *handlers.ApiError) {
+	const op = "controller.writeApiError"
+	mar := handlers.JSONMarshaler()
+	buf, err := mar.Marshal(apiErr.Inner)
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("failed to marshal error response"))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", mar.ContentType(apiErr.Inner))
+	w.WriteHeader(int(apiErr.Status))
+	if _, err := w.Write(buf); err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("failed to write response"))
+	}
+}
+
 func wrapHandlerWithCors(h http.Handler, props HandlerProperties) http.Handler {
 	allowedMethods := []string{
 		http.MethodDelete,
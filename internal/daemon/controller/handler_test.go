@@ -13,11 +13,13 @@ import (
 	"io/ioutil"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"strings"
 	"testing"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/hashicorp/boundary/internal/cmd/config"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/stretchr/testify/assert"
@@ -412,3 +414,104 @@ func TestStreamingResponse(t *testing.T) {
 	require.True(t, string(read) == string(blob), "Got: %q", string(read))
 	require.Equal(t, i, n)
 }
+
+func TestRequestSizeLimit(t *testing.T) {
+	endpoints := []*config.EndpointRequestLimit{
+		{Path: "/v1/targets/*:add-host-sources", MaxRequestSize: 4096},
+		{Path: "/v1/targets/*", MaxRequestSize: -1},
+	}
+	assert.Equal(t, int64(4096), requestSizeLimit("/v1/targets/ttcp_1234567890:add-host-sources", 1024, endpoints))
+	assert.Equal(t, int64(-1), requestSizeLimit("/v1/targets/ttcp_1234567890", 1024, endpoints))
+	assert.Equal(t, int64(1024), requestSizeLimit("/v1/targets", 1024, endpoints))
+	assert.Equal(t, int64(1024), requestSizeLimit("/v1/scopes/global", 1024, nil))
+}
+
+func TestCheckNameLookup(t *testing.T) {
+	tests := []struct {
+		method  string
+		url     string
+		wantErr bool
+	}{
+		{method: http.MethodGet, url: "/v1/targets/foo?name=foo&scope_id=p_1234567890"},
+		{method: http.MethodGet, url: "/v1/users/foo?name=foo&scope_id=global"},
+		{method: http.MethodGet, url: "/v1/host-catalogs/hcst_1234567890"},
+		{method: http.MethodGet, url: "/v1/host-catalogs?scope_id=p_1234567890&name=foo"},
+		{method: http.MethodGet, url: "/v1/host-catalogs/foo:custom?name=foo"},
+		{method: http.MethodPatch, url: "/v1/host-catalogs/hcst_1234567890?name=foo"},
+		{method: http.MethodGet, url: "/v1/host-catalogs/foo?name=foo&scope_id=p_1234567890", wantErr: true},
+		{method: http.MethodGet, url: "/v1/workers/foo?name=foo&scope_id=global", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.url, func(t *testing.T) {
+			apiErr := checkNameLookup(httptest.NewRequest(tt.method, tt.url, nil))
+			if !tt.wantErr {
+				assert.Nil(t, apiErr)
+				return
+			}
+			require.NotNil(t, apiErr)
+			assert.Equal(t, int32(http.StatusBadRequest), apiErr.Status)
+			assert.Contains(t, apiErr.Inner.GetDetails().GetRequestFields()[0].GetDescription(), "is not supported")
+		})
+	}
+}
+
+func TestLimitRequestBody(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		contentLength int64
+		limit         int64
+		wantErr       bool
+	}{
+		{
+			name:          "under-limit",
+			body:          `{"name":"foo"}`,
+			contentLength: -1,
+			limit:         1024,
+		},
+		{
+			name:          "at-limit",
+			body:          "12345",
+			contentLength: 5,
+			limit:         5,
+		},
+		{
+			name:          "content-length-over-limit",
+			body:          "123456",
+			contentLength: 6,
+			limit:         5,
+			wantErr:       true,
+		},
+		{
+			name:          "unknown-length-over-limit",
+			body:          "123456",
+			contentLength: -1,
+			limit:         5,
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			r := httptest.NewRequest(http.MethodPost, "/v1/targets", strings.NewReader(tt.body))
+			r.ContentLength = tt.contentLength
+			err := limitRequestBody(r, tt.limit)
+			if tt.wantErr {
+				require.Error(err)
+				var apiErr *handlers.ApiError
+				require.True(errors.As(err, &apiErr))
+				assert.Equal(int32(http.StatusRequestEntityTooLarge), apiErr.Status)
+
+				w := httptest.NewRecorder()
+				writeApiError(context.Background(), w, apiErr)
+				assert.Equal(http.StatusRequestEntityTooLarge, w.Code)
+				assert.Contains(w.Body.String(), `"kind":"ResourceExhausted"`)
+				return
+			}
+			require.NoError(err)
+			body, err := io.ReadAll(r.Body)
+			require.NoError(err)
+			assert.Equal(tt.body, string(body))
+		})
+	}
+}
@@ -54,11 +54,12 @@ const (
 	emailClaimField = "attributes.email"
 
 	// ldap field names
-	loginAttrField    = "attributes.login_name"
-	nameAttrField     = "attributes.full_name"
-	emailAttrField    = "attributes.email"
-	dnAttrField       = "attributes.dn"
-	memberOfAttrField = "attributes.member_of_groups"
+	loginAttrField      = "attributes.login_name"
+	nameAttrField       = "attributes.full_name"
+	emailAttrField      = "attributes.email"
+	dnAttrField         = "attributes.dn"
+	memberOfAttrField   = "attributes.member_of_groups"
+	entryAttrsAttrField = "attributes.entry_attributes"
 
 	domain = "auth"
 )
@@ -1122,6 +1123,16 @@ func toProto(ctx context.Context, in auth.Account, opt ...handlers.Option) (*pb.
 			}
 			attrs.LdapAccountAttributes.MemberOfGroups = decodedGroups
 		}
+		if s := i.GetEntryAttributes(); s != "" {
+			m := make(map[string]any)
+			var err error
+			if err = json.Unmarshal([]byte(s), &m); err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("error unmarshaling stored entry attributes"))
+			}
+			if attrs.LdapAccountAttributes.EntryAttributes, err = structpb.NewStruct(m); err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("error converting stored entry attributes to protobuf struct"))
+			}
+		}
 		out.Attrs = attrs
 	}
 	return &out, nil
@@ -1240,6 +1251,9 @@ func validateCreateRequest(req *pbs.CreateAccountRequest) error {
 				if len(attrs.GetMemberOfGroups()) > 0 {
 					badFields[memberOfAttrField] = "This is a read only field."
 				}
+				if len(attrs.GetEntryAttributes().GetFields()) > 0 {
+					badFields[entryAttrsAttrField] = "This is a read only field."
+				}
 			}
 		default:
 			badFields[authMethodIdField] = "Unknown auth method type from ID."
@@ -1295,6 +1309,9 @@ func validateUpdateRequest(req *pbs.UpdateAccountRequest) error {
 			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), memberOfAttrField) {
 				badFields[memberOfAttrField] = "Field cannot be updated."
 			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), entryAttrsAttrField) {
+				badFields[entryAttrsAttrField] = "Field cannot be updated."
+			}
 		}
 		return badFields
 	}, globals.PasswordAccountPreviousPrefix, globals.PasswordAccountPrefix, globals.OidcAccountPrefix, globals.LdapAccountPrefix)
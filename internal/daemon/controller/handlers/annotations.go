@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package handlers
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// reValidCostCenter matches the values accepted by the wt_cost_center domain.
+var reValidCostCenter = regexp.MustCompile("^[A-Za-z0-9][A-Za-z0-9._-]{0,63}$")
+
+// ValidateAnnotations adds an entry to badFields for each set annotation which
+// does not match its format. Annotations which are not set are not validated.
+func ValidateAnnotations(a *scopes.Annotations, badFields map[string]string) {
+	if a == nil {
+		return
+	}
+	if a.GetOwner() != nil {
+		owner := strings.TrimSpace(a.GetOwner().GetValue())
+		switch {
+		case owner == "":
+			badFields["annotations.owner"] = "This cannot be empty."
+		case len(owner) >= 128:
+			badFields["annotations.owner"] = "Must be less than 128 characters."
+		case !ValidNameDescription(owner):
+			badFields["annotations.owner"] = "Contains non-printable characters."
+		}
+	}
+	if a.GetCostCenter() != nil && !reValidCostCenter.MatchString(a.GetCostCenter().GetValue()) {
+		badFields["annotations.cost_center"] = `Must start with a letter or digit and contain only letters, digits, ".", "_" and "-", up to 64 characters.`
+	}
+	if a.GetTicketUrl() != nil {
+		u, err := url.Parse(a.GetTicketUrl().GetValue())
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			badFields["annotations.ticket_url"] = "Must be an http or https URL."
+		}
+	}
+}
+
+// ToAnnotationsProto returns the annotations of a resource as a proto, or nil
+// if none of them are set.
+func ToAnnotationsProto(owner, costCenter, ticketUrl string) *scopes.Annotations {
+	if owner == "" && costCenter == "" && ticketUrl == "" {
+		return nil
+	}
+	out := &scopes.Annotations{}
+	if owner != "" {
+		out.Owner = wrapperspb.String(owner)
+	}
+	if costCenter != "" {
+		out.CostCenter = wrapperspb.String(costCenter)
+	}
+	if ticketUrl != "" {
+		out.TicketUrl = wrapperspb.String(ticketUrl)
+	}
+	return out
+}
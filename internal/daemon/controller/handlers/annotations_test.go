@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package handlers
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestValidateAnnotations(t *testing.T) {
+	cases := []struct {
+		name string
+		in   *scopes.Annotations
+		want []string
+	}{
+		{name: "nil"},
+		{name: "unset", in: &scopes.Annotations{}},
+		{
+			name: "valid",
+			in: &scopes.Annotations{
+				Owner:      wrapperspb.String("Platform Team"),
+				CostCenter: wrapperspb.String("CC-1234.eng_ops"),
+				TicketUrl:  wrapperspb.String("https://tickets.example.com/browse/OPS-1"),
+			},
+		},
+		{
+			name: "invalid",
+			in: &scopes.Annotations{
+				Owner:      wrapperspb.String("  "),
+				CostCenter: wrapperspb.String("-CC 1234"),
+				TicketUrl:  wrapperspb.String("tickets.example.com/OPS-1"),
+			},
+			want: []string{"annotations.owner", "annotations.cost_center", "annotations.ticket_url"},
+		},
+		{
+			name: "non-http-ticket-url",
+			in:   &scopes.Annotations{TicketUrl: wrapperspb.String("ftp://tickets.example.com/OPS-1")},
+			want: []string{"annotations.ticket_url"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			badFields := map[string]string{}
+			ValidateAnnotations(tc.in, badFields)
+			var got []string
+			for k := range badFields {
+				got = append(got, k)
+			}
+			assert.ElementsMatch(t, tc.want, got)
+		})
+	}
+}
+
+func TestToAnnotationsProto(t *testing.T) {
+	assert.Nil(t, ToAnnotationsProto("", "", ""))
+	assert.Equal(t,
+		&scopes.Annotations{Owner: wrapperspb.String("Platform Team"), TicketUrl: wrapperspb.String("https://tickets.example.com/OPS-1")},
+		ToAnnotationsProto("Platform Team", "", "https://tickets.example.com/OPS-1"))
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/anomaly"
 	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
@@ -23,9 +24,11 @@ import (
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/accounts"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/authtokens"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/managed_groups"
+	"github.com/hashicorp/boundary/internal/deprecation"
 	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/observability/event"
 	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/requests"
 	"github.com/hashicorp/boundary/internal/types/action"
@@ -37,6 +40,7 @@ import (
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
 	"github.com/hashicorp/go-secure-stdlib/strutil"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -59,6 +63,10 @@ const (
 	isPrimaryField    = "is_primary"
 
 	domain = "auth"
+
+	// userAgentMdKey is the metadata key the grpc-gateway uses to forward the
+	// client's User-Agent header
+	userAgentMdKey = "grpcgateway-user-agent"
 )
 
 var (
@@ -89,6 +97,8 @@ type Service struct {
 	iamRepoFn  common.IamRepoFactory
 	atRepoFn   common.AuthTokenRepoFactory
 	ldapRepoFn common.LdapAuthRepoFactory
+
+	anomalyDetector *anomaly.Detector
 }
 
 var _ pbs.AuthMethodServiceServer = (*Service)(nil)
@@ -114,7 +124,8 @@ func NewService(kms *kms.Kms, pwRepoFn common.PasswordAuthRepoFactory, oidcRepoF
 	if atRepoFn == nil {
 		return Service{}, fmt.Errorf("nil auth token repository provided")
 	}
-	s := Service{kms: kms, pwRepoFn: pwRepoFn, oidcRepoFn: oidcRepoFn, iamRepoFn: iamRepoFn, atRepoFn: atRepoFn, ldapRepoFn: ldapRepoFn}
+	opts := handlers.GetOpts(opt...)
+	s := Service{kms: kms, pwRepoFn: pwRepoFn, oidcRepoFn: oidcRepoFn, iamRepoFn: iamRepoFn, atRepoFn: atRepoFn, ldapRepoFn: ldapRepoFn, anomalyDetector: opts.WithAnomalyDetector}
 
 	return s, nil
 }
@@ -308,6 +319,10 @@ func (s Service) UpdateAuthMethod(ctx context.Context, req *pbs.UpdateAuthMethod
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
+	if handlers.ClearsDeletionProtection(req.GetUpdateMask().GetPaths(), req.GetItem().GetDeletionProtected()) &&
+		!authResults.FetchActionSetForId(ctx, req.GetId(), action.ActionSet{action.ClearDeletionProtection}).HasAction(action.ClearDeletionProtection) {
+		return nil, handlers.ForbiddenError()
+	}
 	am, dryRun, err := s.updateInRepo(ctx, authResults.Scope.GetId(), req)
 	if err != nil {
 		switch {
@@ -420,6 +435,9 @@ func (s Service) DeleteAuthMethod(ctx context.Context, req *pbs.DeleteAuthMethod
 // Authenticate implements the interface pbs.AuthenticationServiceServer.
 func (s Service) Authenticate(ctx context.Context, req *pbs.AuthenticateRequest) (*pbs.AuthenticateResponse, error) {
 	const op = "authmethod_service.(Service).Authenticate"
+	if req.GetType() == "" && req.GetTokenType() != "" {
+		deprecation.Record(ctx, deprecation.AuthenticateTokenType)
+	}
 	if err := validateAuthenticateRequest(req); err != nil {
 		return nil, err
 	}
@@ -444,16 +462,66 @@ func (s Service) Authenticate(ctx context.Context, req *pbs.AuthenticateRequest)
 		return nil, authResults.Error
 	}
 
+	var resp *pbs.AuthenticateResponse
+	var err error
 	switch subtypes.SubtypeFromId(domain, req.GetAuthMethodId()) {
 	case password.Subtype:
-		return s.authenticatePassword(ctx, req, &authResults)
+		resp, err = s.authenticatePassword(ctx, req, &authResults)
 
 	case oidc.Subtype:
-		return s.authenticateOidc(ctx, req, &authResults)
+		resp, err = s.authenticateOidc(ctx, req, &authResults)
 	case ldap.Subtype:
-		return s.authenticateLdap(ctx, req, &authResults)
+		resp, err = s.authenticateLdap(ctx, req, &authResults)
+	default:
+		return nil, errors.New(ctx, errors.Internal, op, "Invalid auth method subtype not caught in validation function.")
 	}
-	return nil, errors.New(ctx, errors.Internal, op, "Invalid auth method subtype not caught in validation function.")
+	return s.evaluateAuthentication(ctx, req, resp, err)
+}
+
+// evaluateAuthentication passes the outcome of an authentication attempt to
+// the service's anomaly detector, if any.  Steps of multi-step flows which
+// neither fail nor issue a token are not evaluated.  If the detector denies the
+// attempt, the issued token is deleted and the attempt fails.
+func (s Service) evaluateAuthentication(ctx context.Context, req *pbs.AuthenticateRequest, resp *pbs.AuthenticateResponse, authErr error) (*pbs.AuthenticateResponse, error) {
+	const op = "authmethod_service.(Service).evaluateAuthentication"
+	tok := resp.GetAuthTokenResponse()
+	if s.anomalyDetector == nil || (authErr == nil && tok == nil) {
+		return resp, authErr
+	}
+	attempt := anomaly.Attempt{
+		AuthMethodId: req.GetAuthMethodId(),
+		AccountId:    tok.GetAccountId(),
+		UserId:       tok.GetUserId(),
+		Success:      authErr == nil,
+	}
+	switch {
+	case req.GetPasswordLoginAttributes() != nil:
+		attempt.LoginName = req.GetPasswordLoginAttributes().GetLoginName()
+	case req.GetLdapLoginAttributes() != nil:
+		attempt.LoginName = req.GetLdapLoginAttributes().GetLoginName()
+	}
+	if reqCtx, ok := requests.RequestContextFromCtx(ctx); ok {
+		attempt.ClientIp = reqCtx.ClientIp
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ua := md.Get(userAgentMdKey); len(ua) > 0 {
+			attempt.UserAgent = ua[0]
+		}
+	}
+
+	decision := s.anomalyDetector.Evaluate(ctx, attempt)
+	if authErr != nil || decision.Action != anomaly.DenyAction {
+		return resp, authErr
+	}
+	atRepo, err := s.atRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := atRepo.DeleteAuthToken(ctx, tok.GetId()); err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("unable to delete auth token denied by anomaly detection"))
+		return nil, errors.New(ctx, errors.Internal, op, "Unable to authenticate.")
+	}
+	return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "Unable to authenticate.")
 }
 
 func (s Service) getFromRepo(ctx context.Context, id string) (auth.AuthMethod, error) {
@@ -808,6 +876,9 @@ func toAuthMethodProto(ctx context.Context, in auth.AuthMethod, opt ...handlers.
 	if outputFields.Has(globals.IsPrimaryField) {
 		out.IsPrimary = in.GetIsPrimaryAuthMethod()
 	}
+	if outputFields.Has(globals.DeletionProtectedField) && in.GetDeletionProtected() {
+		out.DeletionProtected = wrapperspb.Bool(true)
+	}
 	if outputFields.Has(globals.DescriptionField) && in.GetDescription() != "" {
 		out.Description = wrapperspb.String(in.GetDescription())
 	}
@@ -854,18 +925,23 @@ func toAuthMethodProto(ctx context.Context, in auth.AuthMethod, opt ...handlers.
 			break
 		}
 		attrs := &pb.OidcAuthMethodAttributes{
-			ClientId:          wrapperspb.String(i.GetClientId()),
-			ClientSecretHmac:  i.ClientSecretHmac,
-			IdpCaCerts:        i.GetCertificates(),
-			State:             i.GetOperationalState(),
-			SigningAlgorithms: i.GetSigningAlgs(),
-			AllowedAudiences:  i.GetAudClaims(),
-			ClaimsScopes:      i.GetClaimsScopes(),
-			AccountClaimMaps:  i.GetAccountClaimMaps(),
+			ClientId:             wrapperspb.String(i.GetClientId()),
+			ClientSecretHmac:     i.ClientSecretHmac,
+			IdpCaCerts:           i.GetCertificates(),
+			State:                i.GetOperationalState(),
+			SigningAlgorithms:    i.GetSigningAlgs(),
+			AllowedAudiences:     i.GetAudClaims(),
+			ClaimsScopes:         i.GetClaimsScopes(),
+			AccountClaimMaps:     i.GetAccountClaimMaps(),
+			JwtValidationPubKeys: i.GetJwtValidationPubKeys(),
+			BoundClaims:          i.GetBoundClaims(),
 		}
 		if i.DisableDiscoveredConfigValidation {
 			attrs.DisableDiscoveredConfigValidation = true
 		}
+		if i.GetJwtLoginEnabled() {
+			attrs.JwtLoginEnabled = wrapperspb.Bool(true)
+		}
 		if i.GetIssuer() != "" {
 			attrs.Issuer = wrapperspb.String(i.Issuer)
 		}
@@ -934,6 +1010,21 @@ func toAuthMethodProto(ctx context.Context, in auth.AuthMethod, opt ...handlers.
 		if len(i.GetAccountAttributeMaps()) > 0 {
 			attrs.AccountAttributeMaps = i.GetAccountAttributeMaps()
 		}
+		if len(i.GetAlternateUserFilters()) > 0 {
+			attrs.AlternateUserFilters = i.GetAlternateUserFilters()
+		}
+		if i.GetNestedGroupResolution() != "" {
+			attrs.NestedGroupResolution = wrapperspb.String(i.GetNestedGroupResolution())
+		}
+		if i.GetMaxNestedGroupDepth() != 0 {
+			attrs.MaxNestedGroupDepth = wrapperspb.UInt32(i.GetMaxNestedGroupDepth())
+		}
+		if i.GetGroupSearchPageSize() != 0 {
+			attrs.GroupSearchPageSize = wrapperspb.UInt32(i.GetGroupSearchPageSize())
+		}
+		if i.GetGroupSearchSizeLimit() != 0 {
+			attrs.GroupSearchSizeLimit = wrapperspb.UInt32(i.GetGroupSearchSizeLimit())
+		}
 
 		out.Attrs = &pb.AuthMethod_LdapAuthMethodsAttributes{
 			LdapAuthMethodsAttributes: attrs,
@@ -956,6 +1047,19 @@ func toAuthTokenProto(t *authtoken.AuthToken) *pba.AuthToken {
 	}
 }
 
+// authTokenClientOptions returns the options describing the client making the
+// request, which are used to bind issued auth tokens to that client.
+func authTokenClientOptions(ctx context.Context) []authtoken.Option {
+	reqCtx, ok := requests.RequestContextFromCtx(ctx)
+	if !ok {
+		return nil
+	}
+	return []authtoken.Option{
+		authtoken.WithClientIp(reqCtx.ClientIp),
+		authtoken.WithClientCertificateFingerprint(reqCtx.ClientCertificateFingerprint),
+	}
+}
+
 // A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
 // requirements on the structure of the request.  They verify that:
 //   - The path passed in is correctly formatted
@@ -1061,6 +1165,7 @@ func validateCreateRequest(ctx context.Context, req *pbs.CreateAuthMethodRequest
 						foundTo[m.To] = true
 					}
 				}
+				validateOidcJwtAttributes(ctx, attrs, badFields)
 			}
 		case ldap.Subtype:
 			if len(req.GetItem().GetLdapAuthMethodsAttributes().GetUrls()) == 0 {
@@ -1192,6 +1297,7 @@ func validateUpdateRequest(ctx context.Context, req *pbs.UpdateAuthMethodRequest
 						}
 					}
 				}
+				validateOidcJwtAttributes(ctx, attrs, badFields)
 			}
 		case ldap.Subtype:
 			if req.GetItem().GetType() != "" && subtypes.SubtypeFromType(domain, req.GetItem().GetType()) != ldap.Subtype {
@@ -1411,6 +1517,14 @@ func transformAuthenticateRequestAttributes(msg proto.Message) error {
 			authRequest.Attrs = &pbs.AuthenticateRequest_OidcAuthMethodAuthenticateTokenRequest{
 				OidcAuthMethodAuthenticateTokenRequest: newAttrs,
 			}
+		case jwtCommand:
+			newAttrs := &pb.OidcAuthMethodAuthenticateJwtRequest{}
+			if err := handlers.StructToProto(attrs, newAttrs); err != nil {
+				return err
+			}
+			authRequest.Attrs = &pbs.AuthenticateRequest_OidcAuthMethodAuthenticateJwtRequest{
+				OidcAuthMethodAuthenticateJwtRequest: newAttrs,
+			}
 		default:
 			return fmt.Errorf("%s: unknown command %q", op, authRequest.GetCommand())
 		}
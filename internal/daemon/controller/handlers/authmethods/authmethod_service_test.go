@@ -754,26 +754,30 @@ func TestCreate(t *testing.T) {
 				Type:    ldap.Subtype.String(),
 				Attrs: &pb.AuthMethod_LdapAuthMethodsAttributes{
 					LdapAuthMethodsAttributes: &pb.LdapAuthMethodAttributes{
-						StartTls:             true,
-						InsecureTls:          true,
-						DiscoverDn:           true,
-						AnonGroupSearch:      true,
-						UpnDomain:            wrapperspb.String("upn_domain"),
-						Urls:                 []string{"ldap://ldap1", "ldaps://ldap1"},
-						BindDn:               wrapperspb.String("bind-dn"),
-						BindPassword:         wrapperspb.String("bind-password"),
-						UserDn:               wrapperspb.String("user-dn"),
-						UserAttr:             wrapperspb.String("user-attr"),
-						UserFilter:           wrapperspb.String("user-filter"),
-						EnableGroups:         true,
-						GroupDn:              wrapperspb.String("group-dn"),
-						GroupAttr:            wrapperspb.String("group-attr"),
-						GroupFilter:          wrapperspb.String("group-filter"),
-						Certificates:         []string{testEncodedCert},
-						ClientCertificate:    wrapperspb.String(testEncodedCert),
-						ClientCertificateKey: wrapperspb.String(string(testEncodedKey)),
-						UseTokenGroups:       true,
-						AccountAttributeMaps: []string{"mail=email"},
+						StartTls:              true,
+						InsecureTls:           true,
+						DiscoverDn:            true,
+						AnonGroupSearch:       true,
+						UpnDomain:             wrapperspb.String("upn_domain"),
+						Urls:                  []string{"ldap://ldap1", "ldaps://ldap1"},
+						BindDn:                wrapperspb.String("bind-dn"),
+						BindPassword:          wrapperspb.String("bind-password"),
+						UserDn:                wrapperspb.String("user-dn"),
+						UserAttr:              wrapperspb.String("user-attr"),
+						UserFilter:            wrapperspb.String("user-filter"),
+						EnableGroups:          true,
+						GroupDn:               wrapperspb.String("group-dn"),
+						GroupAttr:             wrapperspb.String("group-attr"),
+						GroupFilter:           wrapperspb.String("group-filter"),
+						Certificates:          []string{testEncodedCert},
+						ClientCertificate:     wrapperspb.String(testEncodedCert),
+						ClientCertificateKey:  wrapperspb.String(string(testEncodedKey)),
+						UseTokenGroups:        true,
+						AccountAttributeMaps:  []string{"mail=email"},
+						NestedGroupResolution: wrapperspb.String("recursive"),
+						MaxNestedGroupDepth:   wrapperspb.UInt32(3),
+						GroupSearchPageSize:   wrapperspb.UInt32(100),
+						GroupSearchSizeLimit:  wrapperspb.UInt32(1000),
 					},
 				},
 			}},
@@ -789,25 +793,29 @@ func TestCreate(t *testing.T) {
 					Type:        ldap.Subtype.String(),
 					Attrs: &pb.AuthMethod_LdapAuthMethodsAttributes{
 						LdapAuthMethodsAttributes: &pb.LdapAuthMethodAttributes{
-							State:                string(ldap.InactiveState),
-							StartTls:             true,
-							InsecureTls:          true,
-							DiscoverDn:           true,
-							AnonGroupSearch:      true,
-							UpnDomain:            wrapperspb.String("upn_domain"),
-							Urls:                 []string{"ldap://ldap1", "ldaps://ldap1"},
-							BindDn:               wrapperspb.String("bind-dn"),
-							UserDn:               wrapperspb.String("user-dn"),
-							UserAttr:             wrapperspb.String("user-attr"),
-							UserFilter:           wrapperspb.String("user-filter"),
-							EnableGroups:         true,
-							GroupDn:              wrapperspb.String("group-dn"),
-							GroupAttr:            wrapperspb.String("group-attr"),
-							GroupFilter:          wrapperspb.String("group-filter"),
-							Certificates:         []string{testEncodedCert},
-							ClientCertificate:    wrapperspb.String(testEncodedCert),
-							UseTokenGroups:       true,
-							AccountAttributeMaps: []string{"mail=email"},
+							State:                 string(ldap.InactiveState),
+							StartTls:              true,
+							InsecureTls:           true,
+							DiscoverDn:            true,
+							AnonGroupSearch:       true,
+							UpnDomain:             wrapperspb.String("upn_domain"),
+							Urls:                  []string{"ldap://ldap1", "ldaps://ldap1"},
+							BindDn:                wrapperspb.String("bind-dn"),
+							UserDn:                wrapperspb.String("user-dn"),
+							UserAttr:              wrapperspb.String("user-attr"),
+							UserFilter:            wrapperspb.String("user-filter"),
+							EnableGroups:          true,
+							GroupDn:               wrapperspb.String("group-dn"),
+							GroupAttr:             wrapperspb.String("group-attr"),
+							GroupFilter:           wrapperspb.String("group-filter"),
+							Certificates:          []string{testEncodedCert},
+							ClientCertificate:     wrapperspb.String(testEncodedCert),
+							UseTokenGroups:        true,
+							AccountAttributeMaps:  []string{"mail=email"},
+							NestedGroupResolution: wrapperspb.String("recursive"),
+							MaxNestedGroupDepth:   wrapperspb.UInt32(3),
+							GroupSearchPageSize:   wrapperspb.UInt32(100),
+							GroupSearchSizeLimit:  wrapperspb.UInt32(1000),
 						},
 					},
 					AuthorizedActions:           ldapAuthorizedActions,
@@ -1343,6 +1351,51 @@ func TestCreate(t *testing.T) {
 			err:         handlers.ApiErrorWithCode(codes.InvalidArgument),
 			errContains: "invalid attributes.account_attribute_maps (unable to parse)",
 		},
+		{
+			name: "ldap-auth-method-invalid-nested-group-resolution",
+			req: &pbs.CreateAuthMethodRequest{Item: &pb.AuthMethod{
+				ScopeId: o.GetPublicId(),
+				Type:    ldap.Subtype.String(),
+				Attrs: &pb.AuthMethod_LdapAuthMethodsAttributes{
+					LdapAuthMethodsAttributes: &pb.LdapAuthMethodAttributes{
+						Urls:                  []string{"ldap://ldap1"},
+						NestedGroupResolution: wrapperspb.String("memberOf"),
+					},
+				},
+			}},
+			err:         handlers.ApiErrorWithCode(codes.InvalidArgument),
+			errContains: "attributes.nested_group_resolution must be either",
+		},
+		{
+			name: "ldap-auth-method-invalid-group-search-page-size",
+			req: &pbs.CreateAuthMethodRequest{Item: &pb.AuthMethod{
+				ScopeId: o.GetPublicId(),
+				Type:    ldap.Subtype.String(),
+				Attrs: &pb.AuthMethod_LdapAuthMethodsAttributes{
+					LdapAuthMethodsAttributes: &pb.LdapAuthMethodAttributes{
+						Urls:                []string{"ldap://ldap1"},
+						GroupSearchPageSize: wrapperspb.UInt32(0),
+					},
+				},
+			}},
+			err:         handlers.ApiErrorWithCode(codes.InvalidArgument),
+			errContains: "attributes.group_search_page_size must be greater than 0",
+		},
+		{
+			name: "ldap-auth-method-invalid-group-search-size-limit",
+			req: &pbs.CreateAuthMethodRequest{Item: &pb.AuthMethod{
+				ScopeId: o.GetPublicId(),
+				Type:    ldap.Subtype.String(),
+				Attrs: &pb.AuthMethod_LdapAuthMethodsAttributes{
+					LdapAuthMethodsAttributes: &pb.LdapAuthMethodAttributes{
+						Urls:                 []string{"ldap://ldap1"},
+						GroupSearchSizeLimit: wrapperspb.UInt32(0),
+					},
+				},
+			}},
+			err:         handlers.ApiErrorWithCode(codes.InvalidArgument),
+			errContains: "attributes.group_search_size_limit must be greater than 0",
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -19,6 +19,7 @@ import (
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/types/action"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authmethods"
+	"github.com/hashicorp/go-secure-stdlib/strutil"
 	"google.golang.org/grpc/codes"
 )
 
@@ -36,17 +37,23 @@ func init() {
 		action.Update,
 		action.Delete,
 		action.Authenticate,
+		action.ClearDeletionProtection,
 	}
 }
 
 const (
-	urlsField                 = "attributes.urls"
-	bindDnField               = "attributes.bind_dn"
-	bindPasswordField         = "attributes.bind_password"
-	clientCertificateField    = "attributes.client_certificate"
-	clientCertificateKeyField = "attributes.client_certificate_key"
-	certificatesField         = "attributes.certificates"
-	accountAttributesMapField = "attributes.account_attribute_maps"
+	urlsField                  = "attributes.urls"
+	bindDnField                = "attributes.bind_dn"
+	bindPasswordField          = "attributes.bind_password"
+	clientCertificateField     = "attributes.client_certificate"
+	clientCertificateKeyField  = "attributes.client_certificate_key"
+	certificatesField          = "attributes.certificates"
+	accountAttributesMapField  = "attributes.account_attribute_maps"
+	alternateUserFiltersField  = "attributes.alternate_user_filters"
+	nestedGroupResolutionField = "attributes.nested_group_resolution"
+	maxNestedGroupDepthField   = "attributes.max_nested_group_depth"
+	groupSearchPageSizeField   = "attributes.group_search_page_size"
+	groupSearchSizeLimitField  = "attributes.group_search_size_limit"
 )
 
 func (s Service) authenticateLdap(ctx context.Context, req *pbs.AuthenticateRequest, authResults *auth.VerifyResults) (*pbs.AuthenticateResponse, error) {
@@ -83,7 +90,7 @@ func (s Service) authenticateLdap(ctx context.Context, req *pbs.AuthenticateRequ
 		return atRepo, nil
 	}
 
-	rawTk, err := ldap.Authenticate(ctx, ldapFn, iamFn, atFn, req.GetAuthMethodId(), reqAttrs.GetLoginName(), reqAttrs.GetPassword())
+	rawTk, err := ldap.Authenticate(ctx, ldapFn, iamFn, atFn, req.GetAuthMethodId(), reqAttrs.GetLoginName(), reqAttrs.GetPassword(), authTokenClientOptions(ctx)...)
 	if err != nil {
 		// let's not send back too much info about the error
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "Unable to authenticate.")
@@ -159,6 +166,9 @@ func toStorageLdapAuthMethod(ctx context.Context, scopeId string, in *pb.AuthMet
 	if in.GetDescription() != nil {
 		opts = append(opts, ldap.WithDescription(ctx, in.GetDescription().GetValue()))
 	}
+	if in.GetDeletionProtected().GetValue() {
+		opts = append(opts, ldap.WithDeletionProtected(ctx))
+	}
 	var urls []*url.URL
 	if attrs != nil {
 		if attrs.GetState() != "" {
@@ -188,6 +198,9 @@ func toStorageLdapAuthMethod(ctx context.Context, scopeId string, in *pb.AuthMet
 		if attrs.UserFilter.GetValue() != "" {
 			opts = append(opts, ldap.WithUserFilter(ctx, attrs.UserFilter.GetValue()))
 		}
+		if len(attrs.GetAlternateUserFilters()) > 0 {
+			opts = append(opts, ldap.WithAlternateUserFilters(ctx, attrs.GetAlternateUserFilters()...))
+		}
 		if attrs.EnableGroups {
 			opts = append(opts, ldap.WithEnableGroups(ctx))
 		}
@@ -228,6 +241,18 @@ func toStorageLdapAuthMethod(ctx context.Context, scopeId string, in *pb.AuthMet
 		if attrs.UseTokenGroups {
 			opts = append(opts, ldap.WithUseTokenGroups(ctx))
 		}
+		if attrs.GetNestedGroupResolution().GetValue() != "" {
+			opts = append(opts, ldap.WithNestedGroupResolution(ctx, ldap.NestedGroupResolution(attrs.GetNestedGroupResolution().GetValue())))
+		}
+		if attrs.GetMaxNestedGroupDepth().GetValue() != 0 {
+			opts = append(opts, ldap.WithMaxNestedGroupDepth(ctx, attrs.GetMaxNestedGroupDepth().GetValue()))
+		}
+		if attrs.GetGroupSearchPageSize().GetValue() != 0 {
+			opts = append(opts, ldap.WithGroupSearchPageSize(ctx, attrs.GetGroupSearchPageSize().GetValue()))
+		}
+		if attrs.GetGroupSearchSizeLimit().GetValue() != 0 {
+			opts = append(opts, ldap.WithGroupSearchSizeLimit(ctx, attrs.GetGroupSearchSizeLimit().GetValue()))
+		}
 		if len(attrs.AccountAttributeMaps) > 0 {
 			attribMaps, err := ldap.ParseAccountAttributeMaps(ctx, attrs.AccountAttributeMaps...)
 			if err != nil {
@@ -325,6 +350,30 @@ func validateLdapAttributes(ctx context.Context, attrs *pb.LdapAuthMethodAttribu
 			badFields[accountAttributesMapField] = fmt.Sprintf("invalid %s (unable to parse)", accountAttributesMapField)
 		}
 	}
+	if len(attrs.GetAlternateUserFilters()) > 0 {
+		if len(strutil.RemoveDuplicates(attrs.GetAlternateUserFilters(), false)) != len(attrs.GetAlternateUserFilters()) {
+			badFields[alternateUserFiltersField] = fmt.Sprintf("%s cannot contain duplicates", alternateUserFiltersField)
+		}
+		for _, f := range attrs.GetAlternateUserFilters() {
+			if strings.TrimSpace(f) == "" {
+				badFields[alternateUserFiltersField] = fmt.Sprintf("%s cannot contain empty filters", alternateUserFiltersField)
+			}
+		}
+	}
+	switch ldap.NestedGroupResolution(attrs.GetNestedGroupResolution().GetValue()) {
+	case "", ldap.InChainNestedGroupResolution, ldap.RecursiveNestedGroupResolution:
+	default:
+		badFields[nestedGroupResolutionField] = fmt.Sprintf("%s must be either %q or %q", nestedGroupResolutionField, ldap.InChainNestedGroupResolution, ldap.RecursiveNestedGroupResolution)
+	}
+	if attrs.GetMaxNestedGroupDepth() != nil && attrs.GetMaxNestedGroupDepth().GetValue() == 0 {
+		badFields[maxNestedGroupDepthField] = fmt.Sprintf("%s must be greater than 0", maxNestedGroupDepthField)
+	}
+	if attrs.GetGroupSearchPageSize() != nil && attrs.GetGroupSearchPageSize().GetValue() == 0 {
+		badFields[groupSearchPageSizeField] = fmt.Sprintf("%s must be greater than 0", groupSearchPageSizeField)
+	}
+	if attrs.GetGroupSearchSizeLimit() != nil && attrs.GetGroupSearchSizeLimit().GetValue() == 0 {
+		badFields[groupSearchSizeLimitField] = fmt.Sprintf("%s must be greater than 0", groupSearchSizeLimitField)
+	}
 }
 
 func validateAuthenticateLdapRequest(req *pbs.AuthenticateRequest) error {
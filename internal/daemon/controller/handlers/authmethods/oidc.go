@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/boundary/internal/auth/oidc"
@@ -27,6 +28,7 @@ const (
 	startCommand    = "start"
 	callbackCommand = "callback"
 	tokenCommand    = "token"
+	jwtCommand      = "jwt"
 
 	// token request/response fields
 	statusField = "status"
@@ -46,6 +48,9 @@ const (
 	codeField                              = "attributes.code"
 	claimsScopesField                      = "attributes.claims_scopes"
 	accountClaimMapsField                  = "attributes.account_claim_maps"
+	jwtValidationPubKeysField              = "attributes.jwt_validation_pub_keys"
+	boundClaimsField                       = "attributes.bound_claims"
+	jwtTokenField                          = "attributes.token"
 )
 
 var oidcMaskManager handlers.MaskManager
@@ -63,6 +68,7 @@ func init() {
 		action.Delete,
 		action.ChangeState,
 		action.Authenticate,
+		action.ClearDeletionProtection,
 	}
 }
 
@@ -159,6 +165,8 @@ func (s Service) authenticateOidc(ctx context.Context, req *pbs.AuthenticateRequ
 		return s.authenticateOidcCallback(ctx, req)
 	case tokenCommand:
 		return s.authenticateOidcToken(ctx, req, authResults)
+	case jwtCommand:
+		return s.authenticateOidcJwt(ctx, req, authResults)
 	}
 
 	return &pbs.AuthenticateResponse{Command: req.GetCommand()}, nil
@@ -291,7 +299,7 @@ func (s Service) authenticateOidcToken(ctx context.Context, req *pbs.Authenticat
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "Empty token ID in request attributes.")
 	}
 
-	token, err := oidc.TokenRequest(ctx, s.kms, s.atRepoFn, req.GetAuthMethodId(), attrs.TokenId)
+	token, err := oidc.TokenRequest(ctx, s.kms, s.atRepoFn, req.GetAuthMethodId(), attrs.TokenId, authTokenClientOptions(ctx)...)
 	if err != nil {
 		switch {
 		case errors.Match(errors.T(errors.Forbidden), err):
@@ -329,6 +337,42 @@ func (s Service) authenticateOidcToken(ctx context.Context, req *pbs.Authenticat
 	return s.convertToAuthenticateResponse(ctx, req, authResults, responseToken)
 }
 
+func (s Service) authenticateOidcJwt(ctx context.Context, req *pbs.AuthenticateRequest, authResults *auth.VerifyResults) (*pbs.AuthenticateResponse, error) {
+	const op = "authmethod_service.(Service).authenticateOidcJwt"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "Nil request.")
+	}
+	if authResults == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "Nil auth results.")
+	}
+	attrs := req.GetOidcAuthMethodAuthenticateJwtRequest()
+	if attrs == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "Nil request attributes.")
+	}
+
+	rawTk, err := oidc.JwtLogin(
+		ctx,
+		s.oidcRepoFn,
+		oidc.IamRepoFactory(s.iamRepoFn),
+		s.atRepoFn,
+		req.GetAuthMethodId(),
+		attrs.GetToken(),
+		authTokenClientOptions(ctx)...)
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error authenticating with jwt"))
+		// let's not send back too much info about the error
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "Unable to authenticate.")
+	}
+	tk, err := s.ConvertInternalAuthTokenToApiAuthToken(
+		ctx,
+		rawTk,
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return s.convertToAuthenticateResponse(ctx, req, authResults, tk)
+}
+
 func validateAuthenticateOidcRequest(req *pbs.AuthenticateRequest) error {
 	badFields := make(map[string]string)
 
@@ -385,6 +429,24 @@ func validateAuthenticateOidcRequest(req *pbs.AuthenticateRequest) error {
 			badFields[tokenTypeField] = `The only accepted types are "token" and "cookie".`
 		}
 
+	case jwtCommand:
+		attrs := req.GetOidcAuthMethodAuthenticateJwtRequest()
+		switch {
+		case attrs == nil:
+			badFields["attributes"] = "Attributes field not supplied request"
+		case attrs.GetToken() == "":
+			badFields[jwtTokenField] = "This is a required field."
+		}
+		tokenType := req.GetType()
+		if tokenType == "" {
+			// Fall back to deprecated field if type is not set
+			tokenType = req.GetTokenType()
+		}
+		tType := strings.ToLower(strings.TrimSpace(tokenType))
+		if tType != "" && tType != "token" && tType != "cookie" {
+			badFields[tokenTypeField] = `The only accepted types are "token" and "cookie".`
+		}
+
 	default:
 		badFields[commandField] = "Invalid command for this auth method type."
 	}
@@ -411,6 +473,9 @@ func toStorageOidcAuthMethod(ctx context.Context, scopeId string, in *pb.AuthMet
 	if in.GetDescription() != nil {
 		opts = append(opts, oidc.WithDescription(in.GetDescription().GetValue()))
 	}
+	if in.GetDeletionProtected() != nil {
+		opts = append(opts, oidc.WithDeletionProtected(in.GetDeletionProtected().GetValue()))
+	}
 
 	if iss := strings.TrimSpace(attrs.GetIssuer().GetValue()); iss != "" {
 		// Strip off everything after and including ".well-known/openid-configuration"
@@ -483,9 +548,52 @@ func toStorageOidcAuthMethod(ctx context.Context, scopeId string, in *pb.AuthMet
 		opts = append(opts, oidc.WithAccountClaimMap(claimsMap))
 	}
 
+	if len(attrs.GetJwtValidationPubKeys()) > 0 {
+		opts = append(opts, oidc.WithJwtValidationPubKeys(attrs.GetJwtValidationPubKeys()...))
+	}
+	if attrs.GetJwtLoginEnabled() != nil {
+		opts = append(opts, oidc.WithJwtLoginEnabled(attrs.GetJwtLoginEnabled().GetValue()))
+	}
+	if len(attrs.GetBoundClaims()) > 0 {
+		bound, err := oidc.ParseBoundClaims(ctx, attrs.GetBoundClaims()...)
+		if err != nil {
+			return nil, false, false, errors.WrapDeprecated(err, op)
+		}
+		// normalize the bound claims, so they match the claim=value format
+		// they're read from the repository with.
+		boundClaims := make([]string, 0, len(attrs.GetBoundClaims()))
+		for c, values := range bound {
+			for _, v := range values {
+				boundClaims = append(boundClaims, fmt.Sprintf("%s=%s", c, v))
+			}
+		}
+		sort.Strings(boundClaims)
+		opts = append(opts, oidc.WithBoundClaims(boundClaims...))
+	}
+
 	u, err := oidc.NewAuthMethod(ctx, scopeId, clientId, clientSecret, opts...)
 	if err != nil {
 		return nil, false, false, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build auth method: %v.", err)
 	}
 	return u, attrs.GetDryRun(), attrs.GetDisableDiscoveredConfigValidation(), nil
 }
+
+// validateOidcJwtAttributes validates the attributes used to authenticate with
+// the jwt command.
+func validateOidcJwtAttributes(ctx context.Context, attrs *pb.OidcAuthMethodAttributes, badFields map[string]string) {
+	for _, k := range attrs.GetJwtValidationPubKeys() {
+		if _, err := oidc.ParseJwtValidationPubKey(ctx, k); err != nil {
+			badFields[jwtValidationPubKeysField] = fmt.Sprintf("Cannot parse public keys. %v", err.Error())
+			break
+		}
+	}
+	if _, err := oidc.ParseBoundClaims(ctx, attrs.GetBoundClaims()...); err != nil {
+		badFields[boundClaimsField] = fmt.Sprintf("Contains invalid bound claim %q", err.Error())
+	}
+	for _, bc := range attrs.GetBoundClaims() {
+		if strings.Contains(bc, "|") {
+			badFields[boundClaimsField] = fmt.Sprintf(`%q cannot contain "|"`, bc)
+			break
+		}
+	}
+}
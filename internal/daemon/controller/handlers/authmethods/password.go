@@ -41,6 +41,7 @@ func init() {
 		action.Update,
 		action.Delete,
 		action.Authenticate,
+		action.ClearDeletionProtection,
 	}
 }
 
@@ -125,7 +126,7 @@ func (s Service) authenticateWithPwRepo(ctx context.Context, scopeId, authMethod
 	if err != nil {
 		return nil, err
 	}
-	tok, err := atRepo.CreateAuthToken(ctx, u, acct.GetPublicId())
+	tok, err := atRepo.CreateAuthToken(ctx, u, acct.GetPublicId(), authTokenClientOptions(ctx)...)
 	if err != nil {
 		return nil, err
 	}
@@ -186,6 +187,9 @@ func toStoragePwAuthMethod(scopeId string, item *pb.AuthMethod) (*password.AuthM
 	if item.GetDescription() != nil {
 		opts = append(opts, password.WithDescription(item.GetDescription().GetValue()))
 	}
+	if item.GetDeletionProtected() != nil {
+		opts = append(opts, password.WithDeletionProtected(item.GetDeletionProtected().GetValue()))
+	}
 	u, err := password.NewAuthMethod(scopeId, opts...)
 	if err != nil {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build auth method for creation: %v.", err)
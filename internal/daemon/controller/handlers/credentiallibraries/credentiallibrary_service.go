@@ -11,11 +11,14 @@ import (
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/azure"
+	azurestore "github.com/hashicorp/boundary/internal/credential/azure/store"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/credential/vault/store"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/deprecation"
 	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/perms"
@@ -37,12 +40,18 @@ const (
 	vaultPathField             = "attributes.path"
 	httpMethodField            = "attributes.http_method"
 	httpRequestBodyField       = "attributes.http_request_body"
+	mappingExpressionField     = "attributes.mapping_expression"
+	kvSecretVersionField       = "attributes.kv_secret_version"
+	maxRequestsPerMinuteField  = "attributes.max_requests_per_minute"
+	namespaceField             = "attributes.namespace"
 	credentialMappingPathField = "credential_mapping_overrides"
 	sshCertUsernameField       = "attributes.username"
 	keyTypeField               = "attributes.key_type"
 	keyBitsField               = "attributes.key_bits"
 	criticalOptionsField       = "attributes.critical_options"
 	extensionsField            = "attributes.extensions"
+	secretNameField            = "attributes.secret_name"
+	secretVersionField         = "attributes.secret_version"
 	domain                     = "credential"
 )
 
@@ -52,11 +61,13 @@ const (
 	passwordAttribute     string = "password_attribute"
 	privateKeyAttribute   string = "private_key_attribute"
 	pkPassphraseAttribute string = "private_key_passphrase_attribute"
+	certificateAttribute  string = "certificate_attribute"
 )
 
 var (
 	maskManager        handlers.MaskManager
 	sshCertMaskManager handlers.MaskManager
+	azureMaskManager   handlers.MaskManager
 
 	// IdActions contains the set of actions that can be performed on
 	// individual resources
@@ -77,6 +88,7 @@ var (
 	validCredentialTypesVaultGeneric = []credential.Type{
 		credential.UsernamePasswordType,
 		credential.SshPrivateKeyType,
+		credential.X509CertificateType,
 		credential.UnspecifiedType,
 	}
 
@@ -97,20 +109,25 @@ func init() {
 		handlers.MaskSource{&pb.CredentialLibrary{}, &pb.VaultSSHCertificateCredentialLibraryAttributes{}}); err != nil {
 		panic(err)
 	}
+	if azureMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&azurestore.CredentialLibrary{}},
+		handlers.MaskSource{&pb.CredentialLibrary{}, &pb.AzureSecretCredentialLibraryAttributes{}}); err != nil {
+		panic(err)
+	}
 }
 
 // Service handles request as described by the pbs.CredentialLibraryServiceServer interface.
 type Service struct {
 	pbs.UnsafeCredentialLibraryServiceServer
 
-	iamRepoFn common.IamRepoFactory
-	repoFn    common.VaultCredentialRepoFactory
+	iamRepoFn   common.IamRepoFactory
+	repoFn      common.VaultCredentialRepoFactory
+	azureRepoFn common.AzureCredentialRepoFactory
 }
 
 var _ pbs.CredentialLibraryServiceServer = (*Service)(nil)
 
 // NewService returns a credential library service which handles credential library related requests to boundary.
-func NewService(repo common.VaultCredentialRepoFactory, iamRepo common.IamRepoFactory) (Service, error) {
+func NewService(repo common.VaultCredentialRepoFactory, azureRepo common.AzureCredentialRepoFactory, iamRepo common.IamRepoFactory) (Service, error) {
 	const op = "credentiallibraries.NewService"
 	if iamRepo == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing iam repository")
@@ -118,7 +135,10 @@ func NewService(repo common.VaultCredentialRepoFactory, iamRepo common.IamRepoFa
 	if repo == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing vault credential repository")
 	}
-	return Service{iamRepoFn: iamRepo, repoFn: repo}, nil
+	if azureRepo == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing azure credential repository")
+	}
+	return Service{iamRepoFn: iamRepo, repoFn: repo, azureRepoFn: azureRepo}, nil
 }
 
 // ListCredentialLibraries implements the interface pbs.CredentialLibraryServiceServer
@@ -224,6 +244,12 @@ func (s Service) GetCredentialLibrary(ctx context.Context, req *pbs.GetCredentia
 func (s Service) CreateCredentialLibrary(ctx context.Context, req *pbs.CreateCredentialLibraryRequest) (*pbs.CreateCredentialLibraryResponse, error) {
 	const op = "credentiallibraries.(Service).CreateCredentialLibrary"
 
+	// Checked before validation, which converts the deprecated subtype to
+	// vault-generic.
+	if subtypes.SubtypeFromType(domain, req.GetItem().GetType()) == vault.Subtype ||
+		req.GetItem().GetVaultCredentialLibraryAttributes() != nil {
+		deprecation.Record(ctx, deprecation.VaultCredentialLibrarySubtype)
+	}
 	if err := validateCreateRequest(req); err != nil {
 		return nil, err
 	}
@@ -272,6 +298,19 @@ func (s Service) UpdateCredentialLibrary(ctx context.Context, req *pbs.UpdateCre
 	var currentCredentialType credential.Type
 	var mo vault.MappingOverride
 	switch subtypes.SubtypeFromId(domain, req.GetId()) {
+	case azure.SecretLibrarySubtype:
+		azureRepo, err := s.azureRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		cur, err := azureRepo.LookupCredentialLibrary(ctx, req.Id)
+		if err != nil {
+			return nil, err
+		}
+		if cur == nil {
+			return nil, handlers.NotFoundErrorf("Credential Library %q doesn't exist.", req.GetId())
+		}
+		currentCredentialType = cur.CredentialType()
 	case vault.SSHCertificateLibrarySubtype:
 		cur, err := repo.LookupSSHCertificateCredentialLibrary(ctx, req.Id)
 		if err != nil {
@@ -339,6 +378,22 @@ func (s Service) DeleteCredentialLibrary(ctx context.Context, req *pbs.DeleteCre
 
 func (s Service) listFromRepo(ctx context.Context, storeId string) ([]credential.Library, error) {
 	const op = "credentiallibraries.(Service).listFromRepo"
+	if subtypes.SubtypeFromId(domain, storeId) == azure.Subtype {
+		repo, err := s.azureRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		azureCsl, err := repo.ListCredentialLibraries(ctx, storeId)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		csl := make([]credential.Library, 0, len(azureCsl))
+		for _, s := range azureCsl {
+			csl = append(csl, s)
+		}
+		return csl, nil
+	}
+
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
@@ -387,6 +442,19 @@ func (s Service) getFromRepo(ctx context.Context, id string) (credential.Library
 			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("ssh certificate credential library %q not found", id))
 		}
 		return cs, err
+	case azure.SecretLibrarySubtype:
+		azureRepo, err := s.azureRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		cs, err := azureRepo.LookupCredentialLibrary(ctx, id)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if cs == nil {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("azure secret credential library %q not found", id))
+		}
+		return cs, nil
 	}
 	return nil, errors.New(ctx, errors.InvalidParameter, op, "unrecognized credential library subtype")
 }
@@ -412,6 +480,23 @@ func (s Service) createInRepo(ctx context.Context, scopeId string, item *pb.Cred
 			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create ssh certificate credential library but no error returned from repository.")
 		}
 		out = rl
+	case azure.SecretLibrarySubtype:
+		cl, err := toStorageAzureSecretLibrary(item.GetCredentialStoreId(), item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		repo, err := s.azureRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		rl, err := repo.CreateCredentialLibrary(ctx, scopeId, cl)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create azure secret credential library"))
+		}
+		if rl == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create azure secret credential library but no error returned from repository.")
+		}
+		out = rl
 	default:
 		cl, err := toStorageVaultLibrary(item.GetCredentialStoreId(), item)
 		if err != nil {
@@ -465,6 +550,27 @@ func (s Service) updateInRepo(
 		return nil, errors.Wrap(ctx, err, op)
 	}
 	switch subtypes.SubtypeFromId(domain, id) {
+	case azure.SecretLibrarySubtype:
+		dbMasks = append(dbMasks, azureMaskManager.Translate(masks)...)
+		if len(dbMasks) == 0 {
+			return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+		}
+		cl, err := toStorageAzureSecretLibrary(item.GetCredentialStoreId(), item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		cl.PublicId = id
+		azureRepo, err := s.azureRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		out, rowsUpdated, err = azureRepo.UpdateCredentialLibrary(ctx, projId, cl, item.GetVersion(), dbMasks)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential library"))
+		}
+		if rowsUpdated == 0 {
+			return nil, handlers.NotFoundErrorf("Credential Library %q doesn't exist or incorrect version provided.", id)
+		}
 	case vault.SSHCertificateLibrarySubtype:
 		dbMasks = append(dbMasks, sshCertMaskManager.Translate(masks)...)
 		if getMapUpdate(criticalOptionsField, masks) {
@@ -519,6 +625,11 @@ func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool,
 	switch subtypes.SubtypeFromId(domain, id) {
 	case vault.SSHCertificateLibrarySubtype:
 		rows, err = repo.DeleteSSHCertificateCredentialLibrary(ctx, scopeId, id)
+	case azure.SecretLibrarySubtype:
+		var azureRepo *azure.Repository
+		if azureRepo, err = s.azureRepoFn(); err == nil {
+			rows, err = azureRepo.DeleteCredentialLibrary(ctx, scopeId, id)
+		}
 	default:
 		rows, err = repo.DeleteCredentialLibrary(ctx, scopeId, id)
 	}
@@ -539,6 +650,11 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 		res.Error = err
 		return res
 	}
+	azureRepo, err := s.azureRepoFn()
+	if err != nil {
+		res.Error = err
+		return res
+	}
 
 	var parentId string
 	opts := []auth.Option{auth.WithType(resource.CredentialLibrary), auth.WithAction(a)}
@@ -571,6 +687,17 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 				return res
 			}
 			parentId = cl.GetStoreId()
+		case azure.SecretLibrarySubtype:
+			cl, err := azureRepo.LookupCredentialLibrary(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			if cl == nil {
+				res.Error = handlers.NotFoundError()
+				return res
+			}
+			parentId = cl.GetStoreId()
 		default:
 			res.Error = errors.New(ctx, errors.InvalidParameter, op, "unrecognized credential library subtype from id")
 			return res
@@ -596,6 +723,17 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 			return res
 		}
 		opts = append(opts, auth.WithScopeId(cs.GetProjectId()))
+	case azure.Subtype:
+		cs, err := azureRepo.LookupCredentialStore(ctx, parentId)
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		if cs == nil {
+			res.Error = handlers.NotFoundError()
+			return res
+		}
+		opts = append(opts, auth.WithScopeId(cs.GetProjectId()))
 	default:
 		res.Error = errors.New(ctx, errors.InvalidParameter, op, "unrecognized credential store subtype from id")
 		return res
@@ -674,6 +812,14 @@ func toProto(in credential.Library, opt ...handlers.Option) (*pb.CredentialLibra
 					if mapping.PrivateKeyPassphraseAttribute != "" {
 						m[pkPassphraseAttribute] = mapping.PrivateKeyPassphraseAttribute
 					}
+
+				case *vault.X509CertificateOverride:
+					if mapping.CertificateAttribute != "" {
+						m[certificateAttribute] = mapping.CertificateAttribute
+					}
+					if mapping.PrivateKeyAttribute != "" {
+						m[privateKeyAttribute] = mapping.PrivateKeyAttribute
+					}
 				}
 				if len(m) > 0 {
 					mp, err := structpb.NewStruct(m)
@@ -694,6 +840,18 @@ func toProto(in credential.Library, opt ...handlers.Option) (*pb.CredentialLibra
 			if vaultIn.GetHttpRequestBody() != nil {
 				attrs.HttpRequestBody = wrapperspb.String(string(vaultIn.GetHttpRequestBody()))
 			}
+			if vaultIn.GetMappingExpression() != "" {
+				attrs.MappingExpression = wrapperspb.String(vaultIn.GetMappingExpression())
+			}
+			if vaultIn.GetKvSecretVersion() > 0 {
+				attrs.KvSecretVersion = wrapperspb.UInt32(vaultIn.GetKvSecretVersion())
+			}
+			if vaultIn.GetMaxRequestsPerMinute() > 0 {
+				attrs.MaxRequestsPerMinute = wrapperspb.UInt32(vaultIn.GetMaxRequestsPerMinute())
+			}
+			if vaultIn.GetNamespace() != "" {
+				attrs.Namespace = wrapperspb.String(vaultIn.GetNamespace())
+			}
 			out.Attrs = &pb.CredentialLibrary_VaultGenericCredentialLibraryAttributes{
 				VaultGenericCredentialLibraryAttributes: attrs,
 			}
@@ -738,7 +896,25 @@ func toProto(in credential.Library, opt ...handlers.Option) (*pb.CredentialLibra
 				VaultSshCertificateCredentialLibraryAttributes: attrs,
 			}
 		}
-
+	case azure.SecretLibrarySubtype:
+		azureIn, ok := in.(*azure.CredentialLibrary)
+		if !ok {
+			return nil, errors.NewDeprecated(errors.Internal, op, "unable to cast to azure secret credential library")
+		}
+		if outputFields.Has(globals.CredentialTypeField) && azureIn.CredentialType() != credential.UnspecifiedType {
+			out.CredentialType = string(azureIn.CredentialType())
+		}
+		if outputFields.Has(globals.AttributesField) {
+			attrs := &pb.AzureSecretCredentialLibraryAttributes{
+				SecretName: wrapperspb.String(azureIn.GetSecretName()),
+			}
+			if azureIn.GetSecretVersion() != "" {
+				attrs.SecretVersion = wrapperspb.String(azureIn.GetSecretVersion())
+			}
+			out.Attrs = &pb.CredentialLibrary_AzureSecretCredentialLibraryAttributes{
+				AzureSecretCredentialLibraryAttributes: attrs,
+			}
+		}
 	}
 	return &out, nil
 }
@@ -764,6 +940,18 @@ func toStorageVaultLibrary(storeId string, in *pb.CredentialLibrary) (out *vault
 	if attrs.GetHttpRequestBody() != nil {
 		opts = append(opts, vault.WithRequestBody([]byte(attrs.GetHttpRequestBody().GetValue())))
 	}
+	if attrs.GetMappingExpression() != nil {
+		opts = append(opts, vault.WithMappingExpression(attrs.GetMappingExpression().GetValue()))
+	}
+	if attrs.GetKvSecretVersion() != nil {
+		opts = append(opts, vault.WithKvSecretVersion(attrs.GetKvSecretVersion().GetValue()))
+	}
+	if attrs.GetMaxRequestsPerMinute() != nil {
+		opts = append(opts, vault.WithMaxRequestsPerMinute(attrs.GetMaxRequestsPerMinute().GetValue()))
+	}
+	if attrs.GetNamespace().GetValue() != "" {
+		opts = append(opts, vault.WithNamespace(attrs.GetNamespace().GetValue()))
+	}
 
 	credentialType := credential.Type(in.GetCredentialType())
 	switch credentialType {
@@ -797,6 +985,20 @@ func toStorageVaultLibrary(storeId string, in *pb.CredentialLibrary) (out *vault
 		if len(mapOpts) > 0 {
 			opts = append(opts, vault.WithMappingOverride(vault.NewSshPrivateKeyOverride(mapOpts...)))
 		}
+
+	case credential.X509CertificateType:
+		opts = append(opts, vault.WithCredentialType(credentialType))
+		overrides := in.CredentialMappingOverrides.AsMap()
+		var mapOpts []vault.Option
+		if cert := overrides[certificateAttribute]; cert != nil {
+			mapOpts = append(mapOpts, vault.WithOverrideCertificateAttribute(cert.(string)))
+		}
+		if pk := overrides[privateKeyAttribute]; pk != nil {
+			mapOpts = append(mapOpts, vault.WithOverridePrivateKeyAttribute(pk.(string)))
+		}
+		if len(mapOpts) > 0 {
+			opts = append(opts, vault.WithMappingOverride(vault.NewX509CertificateOverride(mapOpts...)))
+		}
 	}
 
 	cs, err := vault.NewCredentialLibrary(storeId, attrs.GetPath().GetValue(), opts...)
@@ -806,6 +1008,31 @@ func toStorageVaultLibrary(storeId string, in *pb.CredentialLibrary) (out *vault
 	return cs, err
 }
 
+func toStorageAzureSecretLibrary(storeId string, in *pb.CredentialLibrary) (out *azure.CredentialLibrary, err error) {
+	const op = "credentiallibraries.toStorageAzureSecretLibrary"
+	var opts []azure.Option
+	if in.GetName() != nil {
+		opts = append(opts, azure.WithName(in.GetName().GetValue()))
+	}
+	if in.GetDescription() != nil {
+		opts = append(opts, azure.WithDescription(in.GetDescription().GetValue()))
+	}
+	if ct := credential.Type(in.GetCredentialType()); ct != "" {
+		opts = append(opts, azure.WithCredentialType(ct))
+	}
+
+	attrs := in.GetAzureSecretCredentialLibraryAttributes()
+	if attrs.GetSecretVersion() != nil {
+		opts = append(opts, azure.WithSecretVersion(attrs.GetSecretVersion().GetValue()))
+	}
+
+	cl, err := azure.NewCredentialLibrary(storeId, attrs.GetSecretName().GetValue(), opts...)
+	if err != nil {
+		return nil, errors.WrapDeprecated(err, op, errors.WithMsg("unable to build credential library"))
+	}
+	return cl, err
+}
+
 func toStorageVaultSSHCertificateLibrary(storeId string, in *pb.CredentialLibrary) (out *vault.SSHCertificateCredentialLibrary, err error) {
 	const op = "credentiallibraries.toStorageVaultSSHCertificateLibrary"
 	var opts []vault.Option
@@ -862,6 +1089,8 @@ func validateGetRequest(req *pbs.GetCredentialLibraryRequest) error {
 	switch subtypes.SubtypeFromId(domain, req.GetId()) {
 	case vault.SSHCertificateLibrarySubtype:
 		prefix = globals.VaultSshCertificateCredentialLibraryPrefix
+	case azure.SecretLibrarySubtype:
+		prefix = globals.AzureCredentialLibraryPrefix
 	default:
 		prefix = globals.VaultCredentialLibraryPrefix
 	}
@@ -944,6 +1173,23 @@ func validateCreateRequest(req *pbs.CreateCredentialLibraryRequest) error {
 					badFields[httpRequestBodyField] = fmt.Sprintf("Field can only be set if %q is set to the value 'POST'.", httpMethodField)
 				}
 				validateMapping(badFields, credential.Type(req.GetItem().GetCredentialType()), req.GetItem().CredentialMappingOverrides.AsMap())
+				if e := attrs.GetMappingExpression(); e != nil {
+					validateMappingExpression(badFields, credential.Type(req.GetItem().GetCredentialType()), req.GetItem().CredentialMappingOverrides.AsMap())
+				}
+				if v := attrs.GetKvSecretVersion(); v != nil {
+					switch {
+					case v.GetValue() == 0:
+						badFields[kvSecretVersionField] = "If set, value must be greater than 0."
+					case strings.ToUpper(attrs.GetHttpMethod().GetValue()) == "POST":
+						badFields[kvSecretVersionField] = fmt.Sprintf("Field can only be set if %q is set to the value 'GET'.", httpMethodField)
+					}
+				}
+				if v := attrs.GetMaxRequestsPerMinute(); v != nil && v.GetValue() == 0 {
+					badFields[maxRequestsPerMinuteField] = "If set, value must be greater than 0."
+				}
+				if ns := attrs.GetNamespace(); ns != nil && strings.TrimSpace(ns.GetValue()) == "" {
+					badFields[namespaceField] = "If set, value must not be empty."
+				}
 			case vault.SSHCertificateLibrarySubtype:
 				if req.GetItem().GetCredentialType() != "" {
 					badFields[globals.CredentialTypeField] = fmt.Sprintf("This field is read only and cannot be set.")
@@ -972,6 +1218,23 @@ func validateCreateRequest(req *pbs.CreateCredentialLibraryRequest) error {
 				}
 				validateKeyBits(badFields, attrs.GetKeyBits().GetValue(), attrs.GetKeyType().GetValue())
 			}
+		case azure.Subtype:
+			if t := req.GetItem().GetType(); t != "" && subtypes.SubtypeFromType(domain, t) != azure.SecretLibrarySubtype {
+				badFields[globals.CredentialStoreIdField] = fmt.Sprintf("Type must be the azure subtype %q", azure.SecretLibrarySubtype.String())
+			}
+			req.GetItem().Type = azure.SecretLibrarySubtype.String()
+			switch ct := credential.Type(req.GetItem().GetCredentialType()); ct {
+			case "", credential.UnspecifiedType, credential.UsernamePasswordType, credential.SshPrivateKeyType:
+			default:
+				badFields[globals.CredentialTypeField] = fmt.Sprintf("Unknown credential type %q", ct)
+			}
+			if req.GetItem().GetCredentialMappingOverrides() != nil {
+				badFields[globals.CredentialMappingOverridesField] = "This field is not supported by azure secret credential libraries."
+			}
+			attrs := req.GetItem().GetAzureSecretCredentialLibraryAttributes()
+			if attrs.GetSecretName().GetValue() == "" {
+				badFields[secretNameField] = "This is a required field."
+			}
 		default:
 			badFields[globals.CredentialStoreIdField] = "This field must be a valid credential store id."
 		}
@@ -987,6 +1250,8 @@ func validateUpdateRequest(req *pbs.UpdateCredentialLibraryRequest, currentCrede
 		prefix = globals.VaultCredentialLibraryPrefix
 	case vault.SSHCertificateLibrarySubtype:
 		prefix = globals.VaultSshCertificateCredentialLibraryPrefix
+	case azure.SecretLibrarySubtype:
+		prefix = globals.AzureCredentialLibraryPrefix
 	}
 	return handlers.ValidateUpdateRequest(req, req.GetItem(), func() map[string]string {
 		badFields := map[string]string{}
@@ -1014,6 +1279,15 @@ func validateUpdateRequest(req *pbs.UpdateCredentialLibraryRequest, currentCrede
 					badFields[httpRequestBodyField] = fmt.Sprintf("Field can only be set if %q is set to the value 'POST'.", httpMethodField)
 				}
 				validateMapping(badFields, currentCredentialType, req.GetItem().CredentialMappingOverrides.AsMap())
+				if e := attrs.GetMappingExpression(); handlers.MaskContains(req.GetUpdateMask().GetPaths(), mappingExpressionField) && e.GetValue() != "" {
+					validateMappingExpression(badFields, currentCredentialType, req.GetItem().CredentialMappingOverrides.AsMap())
+				}
+				if v := attrs.GetKvSecretVersion(); handlers.MaskContains(req.GetUpdateMask().GetPaths(), kvSecretVersionField) && v.GetValue() != 0 && strings.ToUpper(attrs.GetHttpMethod().GetValue()) == "POST" {
+					badFields[kvSecretVersionField] = fmt.Sprintf("Field can only be set if %q is set to the value 'GET'.", httpMethodField)
+				}
+				if ns := attrs.GetNamespace(); handlers.MaskContains(req.GetUpdateMask().GetPaths(), namespaceField) && ns.GetValue() != "" && strings.TrimSpace(ns.GetValue()) == "" {
+					badFields[namespaceField] = "If set, value must not be empty."
+				}
 			}
 		case vault.SSHCertificateLibrarySubtype:
 			if req.GetItem().GetType() != "" && subtypes.SubtypeFromType(domain, req.GetItem().GetType()) != vault.SSHCertificateLibrarySubtype {
@@ -1035,18 +1309,29 @@ func validateUpdateRequest(req *pbs.UpdateCredentialLibraryRequest, currentCrede
 				}
 				validateKeyBits(badFields, attrs.GetKeyBits().GetValue(), attrs.GetKeyType().GetValue())
 			}
+		case azure.SecretLibrarySubtype:
+			if req.GetItem().GetType() != "" && subtypes.SubtypeFromType(domain, req.GetItem().GetType()) != azure.SecretLibrarySubtype {
+				badFields[globals.TypeField] = "Cannot modify resource type."
+			}
+			if req.GetItem().GetCredentialType() != "" && req.GetItem().GetCredentialType() != string(currentCredentialType) {
+				badFields[globals.CredentialTypeField] = "Cannot modify credential type."
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), secretNameField) &&
+				req.GetItem().GetAzureSecretCredentialLibraryAttributes().GetSecretName().GetValue() == "" {
+				badFields[secretNameField] = "This is a required field and cannot be set to empty."
+			}
 		}
 		return badFields
 	}, prefix)
 }
 
 func validateDeleteRequest(req *pbs.DeleteCredentialLibraryRequest) error {
-	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.VaultCredentialLibraryPrefix, globals.VaultSshCertificateCredentialLibraryPrefix)
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.VaultCredentialLibraryPrefix, globals.VaultSshCertificateCredentialLibraryPrefix, globals.AzureCredentialLibraryPrefix)
 }
 
 func validateListRequest(req *pbs.ListCredentialLibrariesRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(handlers.Id(req.GetCredentialStoreId()), globals.VaultCredentialStorePrefix) {
+	if !handlers.ValidId(handlers.Id(req.GetCredentialStoreId()), globals.VaultCredentialStorePrefix, globals.AzureCredentialStorePrefix) {
 		badFields[globals.CredentialStoreIdField] = "This field must be a valid credential store id."
 	}
 	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
@@ -1073,6 +1358,9 @@ func validateMapping(badFields map[string]string, credentialType credential.Type
 		validFields[usernameAttribute] = true
 		validFields[privateKeyAttribute] = true
 		validFields[pkPassphraseAttribute] = true
+	case credential.X509CertificateType:
+		validFields[certificateAttribute] = true
+		validFields[privateKeyAttribute] = true
 	default:
 		badFields[globals.CredentialTypeField] = fmt.Sprintf("Unknown credential type %q", credentialType)
 		return
@@ -1089,6 +1377,26 @@ func validateMapping(badFields map[string]string, credentialType credential.Type
 	}
 }
 
+// validateMappingExpression appends to badFields if a mapping expression
+// cannot be used with credentialType or is set together with overrides.
+// Overrides being cleared are ignored. The expression itself is validated
+// when the library is stored.
+func validateMappingExpression(badFields map[string]string, credentialType credential.Type, overrides map[string]any) {
+	switch credentialType {
+	case credential.UsernamePasswordType, credential.SshPrivateKeyType, credential.X509CertificateType:
+	case "", credential.UnspecifiedType:
+		badFields[mappingExpressionField] = fmt.Sprintf("This field can only be set if %q is set", globals.CredentialTypeField)
+	default:
+		badFields[mappingExpressionField] = fmt.Sprintf("This field is not supported for credential type %q", credentialType)
+	}
+	for _, v := range overrides {
+		if v != nil {
+			badFields[mappingExpressionField] = fmt.Sprintf("This field cannot be set together with %q", globals.CredentialMappingOverridesField)
+			break
+		}
+	}
+}
+
 // validateKeyBits appends to badFields if keyBits and keyType aren't accepted combinations for an SSHCertificateCredentialLibrary.
 // If keyType is an empty string, validateKeyBits only validates keyBits.
 func validateKeyBits(badFields map[string]string, keyBits uint32, keyType string) {
@@ -1191,6 +1499,27 @@ func getMappingUpdates(credentialType credential.Type, current vault.MappingOver
 		default:
 			ret[pkPassphraseAttribute] = currentpPass
 		}
+
+	case credential.X509CertificateType:
+		var currentCert, currentPk any
+		if overrides, ok := current.(*vault.X509CertificateOverride); ok {
+			currentCert = overrides.CertificateAttribute
+			currentPk = overrides.PrivateKeyAttribute
+		}
+
+		switch {
+		case masks[certificateAttribute]:
+			ret[certificateAttribute] = new[certificateAttribute]
+		default:
+			ret[certificateAttribute] = currentCert
+		}
+
+		switch {
+		case masks[privateKeyAttribute]:
+			ret[privateKeyAttribute] = new[privateKeyAttribute]
+		default:
+			ret[privateKeyAttribute] = currentPk
+		}
 	}
 
 	return ret, true
@@ -5,6 +5,7 @@ package credentials
 
 import (
 	"context"
+	"crypto/tls"
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
@@ -38,6 +39,8 @@ const (
 	privateKeyField           = "attributes.private_key"
 	privateKeyPassphraseField = "attributes.private_key_passphrase"
 	objectField               = "attributes.object"
+	certificateField          = "attributes.certificate"
+	itemsField                = "items"
 	domain                    = "credential"
 )
 
@@ -45,12 +48,14 @@ var (
 	upMaskManager   handlers.MaskManager
 	spkMaskManager  handlers.MaskManager
 	jsonMaskManager handlers.MaskManager
+	x509MaskManager handlers.MaskManager
 
 	// IdActions contains the set of actions that can be performed on
 	// individual resources
 	IdActions = action.ActionSet{
 		action.NoOp,
 		action.Read,
+		action.ReadSecret,
 		action.Update,
 		action.Delete,
 	}
@@ -59,6 +64,7 @@ var (
 	// this collection
 	CollectionActions = action.ActionSet{
 		action.Create,
+		action.BatchCreate,
 		action.List,
 	}
 )
@@ -77,6 +83,10 @@ func init() {
 		handlers.MaskSource{&pb.Credential{}, &pb.JsonAttributes{}}); err != nil {
 		panic(err)
 	}
+	if x509MaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&store.X509CertificateCredential{}},
+		handlers.MaskSource{&pb.Credential{}, &pb.X509CertificateAttributes{}}); err != nil {
+		panic(err)
+	}
 }
 
 // Service handles request as described by the pbs.CredentialServiceServer interface.
@@ -169,11 +179,23 @@ func (s Service) GetCredential(ctx context.Context, req *pbs.GetCredentialReques
 	if err := validateGetRequest(req); err != nil {
 		return nil, err
 	}
-	authResults := s.authResult(ctx, req.GetId(), action.Read)
+	// Secret material is only returned when explicitly requested, which
+	// requires the read:secret action rather than read.
+	act := action.Read
+	if req.GetIncludeSecret() {
+		act = action.ReadSecret
+	}
+	authResults := s.authResult(ctx, req.GetId(), act)
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	c, err := s.getFromRepo(ctx, req.GetId())
+	var c credential.Static
+	var err error
+	if req.GetIncludeSecret() {
+		c, err = s.getSecretFromRepo(ctx, authResults.Scope.GetId(), req.GetId())
+	} else {
+		c, err = s.getFromRepo(ctx, req.GetId())
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -241,6 +263,48 @@ func (s Service) CreateCredential(ctx context.Context, req *pbs.CreateCredential
 	}, nil
 }
 
+// BatchCreateCredentials implements the interface pbs.CredentialServiceServer.
+func (s Service) BatchCreateCredentials(ctx context.Context, req *pbs.BatchCreateCredentialsRequest) (*pbs.BatchCreateCredentialsResponse, error) {
+	const op = "credentials.(Service).BatchCreateCredentials"
+
+	if err := validateBatchCreateRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetCredentialStoreId(), action.BatchCreate)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	creds, err := s.batchCreateInRepo(ctx, authResults.Scope.GetId(), req.GetCredentialStoreId(), req.GetItems())
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	items := make([]*pb.Credential, 0, len(creds))
+	for _, c := range creds {
+		outputOpts := make([]handlers.Option, 0, 3)
+		outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+		if outputFields.Has(globals.ScopeField) {
+			outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+		}
+		if outputFields.Has(globals.AuthorizedActionsField) {
+			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, c.GetPublicId(), IdActions).Strings()))
+		}
+
+		item, err := toProto(c, outputOpts...)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return &pbs.BatchCreateCredentialsResponse{Items: items}, nil
+}
+
 // UpdateCredential implements the interface pbs.CredentialServiceServer.
 func (s Service) UpdateCredential(ctx context.Context, req *pbs.UpdateCredentialRequest) (*pbs.UpdateCredentialResponse, error) {
 	const op = "credentials.(Service).UpdateCredential"
@@ -334,6 +398,23 @@ func (s Service) getFromRepo(ctx context.Context, id string) (credential.Static,
 	return cred, err
 }
 
+// getSecretFromRepo returns the credential with its secret fields decrypted.
+func (s Service) getSecretFromRepo(ctx context.Context, projectId, id string) (credential.Static, error) {
+	const op = "credentials.(Service).getSecretFromRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	creds, err := repo.Retrieve(ctx, projectId, []string{id})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(creds) != 1 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("credential %q not found", id))
+	}
+	return creds[0], nil
+}
+
 func (s Service) createInRepo(ctx context.Context, scopeId string, item *pb.Credential) (credential.Static, error) {
 	const op = "credentials.(Service).createInRepo"
 	switch item.GetType() {
@@ -388,11 +469,65 @@ func (s Service) createInRepo(ctx context.Context, scopeId string, item *pb.Cred
 			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create credential but no error returned from repository.")
 		}
 		return out, nil
+	case credential.X509CertificateSubtype.String():
+		cred, err := toX509CertificateStorageCredential(ctx, item.GetCredentialStoreId(), item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		repo, err := s.repoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		out, err := repo.CreateX509CertificateCredential(ctx, scopeId, cred)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential"))
+		}
+		if out == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create credential but no error returned from repository.")
+		}
+		return out, nil
 	default:
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, fmt.Sprintf("Unsupported credential type %q", item.GetType()))
 	}
 }
 
+func (s Service) batchCreateInRepo(ctx context.Context, scopeId, storeId string, items []*pb.Credential) ([]credential.Static, error) {
+	const op = "credentials.(Service).batchCreateInRepo"
+	creds := make([]credential.Static, 0, len(items))
+	for _, item := range items {
+		var cred credential.Static
+		var err error
+		switch item.GetType() {
+		case credential.UsernamePasswordSubtype.String():
+			cred, err = toUsernamePasswordStorageCredential(ctx, storeId, item)
+		case credential.SshPrivateKeySubtype.String():
+			cred, err = toSshPrivateKeyStorageCredential(ctx, storeId, item)
+		case credential.JsonSubtype.String():
+			cred, err = toJsonStorageCredential(ctx, storeId, item)
+		case credential.X509CertificateSubtype.String():
+			cred, err = toX509CertificateStorageCredential(ctx, storeId, item)
+		default:
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, fmt.Sprintf("Unsupported credential type %q", item.GetType()))
+		}
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		creds = append(creds, cred)
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, err := repo.CreateCredentials(ctx, scopeId, creds)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credentials"))
+	}
+	if len(out) != len(creds) {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create credentials but no error returned from repository.")
+	}
+	return out, nil
+}
+
 func (s Service) updateInRepo(
 	ctx context.Context,
 	scopeId, storeId, id string,
@@ -487,6 +622,30 @@ func (s Service) updateInRepo(
 		}
 		return out, nil
 
+	case credential.X509CertificateSubtype:
+		dbMasks = append(dbMasks, x509MaskManager.Translate(masks)...)
+		if len(dbMasks) == 0 {
+			return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+		}
+
+		cred, err := toX509CertificateStorageCredential(ctx, storeId, in)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to convert to x509 certificate storage credential"))
+		}
+		cred.PublicId = id
+		repo, err := s.repoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		out, rowsUpdated, err := repo.UpdateX509CertificateCredential(ctx, scopeId, cred, item.GetVersion(), dbMasks)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential"))
+		}
+		if rowsUpdated == 0 {
+			return nil, handlers.NotFoundErrorf("Credential %q doesn't exist or incorrect version provided.", id)
+		}
+		return out, nil
+
 	default:
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, fmt.Sprintf("Unsupported credential type %q", item.GetType()))
 
@@ -522,7 +681,7 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 	var parentId string
 	opts := []auth.Option{auth.WithType(resource.Credential), auth.WithAction(a)}
 	switch a {
-	case action.List, action.Create:
+	case action.List, action.Create, action.BatchCreate:
 		parentId = id
 	default:
 		opts = append(opts, auth.WithId(id))
@@ -581,6 +740,8 @@ func toProto(in credential.Static, opt ...handlers.Option) (*pb.Credential, erro
 			out.Type = credential.SshPrivateKeySubtype.String()
 		case *static.JsonCredential:
 			out.Type = credential.JsonSubtype.String()
+		case *static.X509CertificateCredential:
+			out.Type = credential.X509CertificateSubtype.String()
 		}
 	}
 	if outputFields.Has(globals.DescriptionField) && in.GetDescription() != "" {
@@ -605,32 +766,66 @@ func toProto(in credential.Static, opt ...handlers.Option) (*pb.Credential, erro
 		out.AuthorizedActions = opts.WithAuthorizedActions
 	}
 
+	// Secret fields are only populated on credentials retrieved with their
+	// secrets decrypted, which is only done for the read:secret action.
 	switch cred := in.(type) {
 	case *static.UsernamePasswordCredential:
 		if outputFields.Has(globals.AttributesField) {
+			attrs := &pb.UsernamePasswordAttributes{
+				Username:     wrapperspb.String(cred.GetUsername()),
+				PasswordHmac: base64.RawURLEncoding.EncodeToString(cred.GetPasswordHmac()),
+			}
+			if len(cred.GetPassword()) > 0 {
+				attrs.Password = wrapperspb.String(string(cred.GetPassword()))
+			}
 			out.Attrs = &pb.Credential_UsernamePasswordAttributes{
-				UsernamePasswordAttributes: &pb.UsernamePasswordAttributes{
-					Username:     wrapperspb.String(cred.GetUsername()),
-					PasswordHmac: base64.RawURLEncoding.EncodeToString(cred.GetPasswordHmac()),
-				},
+				UsernamePasswordAttributes: attrs,
 			}
 		}
 	case *static.SshPrivateKeyCredential:
 		if outputFields.Has(globals.AttributesField) {
+			attrs := &pb.SshPrivateKeyAttributes{
+				Username:                 wrapperspb.String(cred.GetUsername()),
+				PrivateKeyHmac:           base64.RawURLEncoding.EncodeToString(cred.GetPrivateKeyHmac()),
+				PrivateKeyPassphraseHmac: base64.RawURLEncoding.EncodeToString(cred.GetPrivateKeyPassphraseHmac()),
+			}
+			if len(cred.GetPrivateKey()) > 0 {
+				attrs.PrivateKey = wrapperspb.String(string(cred.GetPrivateKey()))
+			}
+			if len(cred.GetPrivateKeyPassphrase()) > 0 {
+				attrs.PrivateKeyPassphrase = wrapperspb.String(string(cred.GetPrivateKeyPassphrase()))
+			}
 			out.Attrs = &pb.Credential_SshPrivateKeyAttributes{
-				SshPrivateKeyAttributes: &pb.SshPrivateKeyAttributes{
-					Username:                 wrapperspb.String(cred.GetUsername()),
-					PrivateKeyHmac:           base64.RawURLEncoding.EncodeToString(cred.GetPrivateKeyHmac()),
-					PrivateKeyPassphraseHmac: base64.RawURLEncoding.EncodeToString(cred.GetPrivateKeyPassphraseHmac()),
-				},
+				SshPrivateKeyAttributes: attrs,
 			}
 		}
 	case *static.JsonCredential:
 		if outputFields.Has(globals.AttributesField) {
+			attrs := &pb.JsonAttributes{
+				ObjectHmac: base64.RawURLEncoding.EncodeToString(cred.GetObjectHmac()),
+			}
+			if len(cred.GetObject()) > 0 {
+				object := &structpb.Struct{}
+				if err := object.UnmarshalJSON(cred.GetObject()); err != nil {
+					return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to parse json credential object.")
+				}
+				attrs.Object = object
+			}
 			out.Attrs = &pb.Credential_JsonAttributes{
-				JsonAttributes: &pb.JsonAttributes{
-					ObjectHmac: base64.RawURLEncoding.EncodeToString(cred.GetObjectHmac()),
-				},
+				JsonAttributes: attrs,
+			}
+		}
+	case *static.X509CertificateCredential:
+		if outputFields.Has(globals.AttributesField) {
+			attrs := &pb.X509CertificateAttributes{
+				Certificate:    wrapperspb.String(string(cred.GetCertificate())),
+				PrivateKeyHmac: base64.RawURLEncoding.EncodeToString(cred.GetPrivateKeyHmac()),
+			}
+			if len(cred.GetPrivateKey()) > 0 {
+				attrs.PrivateKey = wrapperspb.String(string(cred.GetPrivateKey()))
+			}
+			out.Attrs = &pb.Credential_X509CertificateAttributes{
+				X509CertificateAttributes: attrs,
 			}
 		}
 	}
@@ -719,6 +914,30 @@ func toJsonStorageCredential(ctx context.Context, storeId string, in *pb.Credent
 	return cs, err
 }
 
+func toX509CertificateStorageCredential(ctx context.Context, storeId string, in *pb.Credential) (out *static.X509CertificateCredential, err error) {
+	const op = "credentials.toX509CertificateStorageCredential"
+	var opts []static.Option
+	if in.GetName() != nil {
+		opts = append(opts, static.WithName(in.GetName().GetValue()))
+	}
+	if in.GetDescription() != nil {
+		opts = append(opts, static.WithDescription(in.GetDescription().GetValue()))
+	}
+
+	attrs := in.GetX509CertificateAttributes()
+	cs, err := static.NewX509CertificateCredential(
+		ctx,
+		storeId,
+		[]byte(attrs.GetCertificate().GetValue()),
+		credential.PrivateKey(attrs.GetPrivateKey().GetValue()),
+		opts...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to build credential"))
+	}
+
+	return cs, err
+}
+
 // A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
 // requirements on the structure of the request.  They verify that:
 //   - The path passed in is correctly formatted
@@ -732,6 +951,7 @@ func validateGetRequest(req *pbs.GetCredentialRequest) error {
 		globals.UsernamePasswordCredentialPreviousPrefix,
 		globals.SshPrivateKeyCredentialPrefix,
 		globals.JsonCredentialPrefix,
+		globals.X509CertificateCredentialPrefix,
 	)
 }
 
@@ -741,63 +961,132 @@ func validateCreateRequest(req *pbs.CreateCredentialRequest) error {
 		if !handlers.ValidId(handlers.Id(req.Item.GetCredentialStoreId()), globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix) {
 			badFields[globals.CredentialStoreIdField] = "This field must be a valid credential store id."
 		}
+		for k, v := range validateCreateAttributes(req.GetItem()) {
+			badFields[k] = v
+		}
+		return badFields
+	})
+}
 
-		switch req.Item.GetType() {
-		case credential.UsernamePasswordSubtype.String():
-			if req.Item.GetUsernamePasswordAttributes().GetUsername().GetValue() == "" {
-				badFields[usernameField] = "Field required for creating a username-password credential."
+func validateBatchCreateRequest(req *pbs.BatchCreateCredentialsRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetCredentialStoreId()), globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix) {
+		badFields[globals.CredentialStoreIdField] = "This field must be a valid credential store id."
+	}
+	switch {
+	case len(req.GetItems()) == 0:
+		badFields[itemsField] = "At least one credential is required."
+	case len(req.GetItems()) > static.MaxBatchCreateCredentials:
+		badFields[itemsField] = fmt.Sprintf("At most %d credentials can be created in a single request.", static.MaxBatchCreateCredentials)
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+
+	for i, item := range req.GetItems() {
+		prefix := fmt.Sprintf("%s[%d].", itemsField, i)
+		if item == nil {
+			badFields[fmt.Sprintf("%s[%d]", itemsField, i)] = "This field must not be empty."
+			continue
+		}
+		err := handlers.ValidateCreateRequest(item, func() map[string]string {
+			badFields := map[string]string{}
+			if item.GetCredentialStoreId() != "" && item.GetCredentialStoreId() != req.GetCredentialStoreId() {
+				badFields[globals.CredentialStoreIdField] = "If set, this field must match the credential store id of the request."
 			}
-			if req.Item.GetUsernamePasswordAttributes().GetPassword().GetValue() == "" {
-				badFields[passwordField] = "Field required for creating a username-password credential."
+			for k, v := range validateCreateAttributes(item) {
+				badFields[k] = v
 			}
-
-		case credential.SshPrivateKeySubtype.String():
-			if req.Item.GetSshPrivateKeyAttributes().GetUsername().GetValue() == "" {
-				badFields[usernameField] = "Field required for creating an SSH private key credential."
+			return badFields
+		})
+		var apiErr *handlers.ApiError
+		if errors.As(err, &apiErr) {
+			for _, f := range apiErr.Inner.GetDetails().GetRequestFields() {
+				badFields[prefix+f.GetName()] = f.GetDescription()
 			}
-			privateKey := req.Item.GetSshPrivateKeyAttributes().GetPrivateKey().GetValue()
-			passphrase := req.Item.GetSshPrivateKeyAttributes().GetPrivateKeyPassphrase().GetValue()
-			if privateKey == "" {
-				badFields[privateKeyField] = "Field required for creating an SSH private key credential."
-			} else {
-				switch passphrase {
-				case "":
-					if _, err := ssh.ParsePrivateKey([]byte(privateKey)); err != nil {
-						badFields[privateKeyField] = fmt.Sprintf("Unable to parse given private key value: %v.", err)
-					}
-				default:
-					if _, err := ssh.ParsePrivateKeyWithPassphrase([]byte(privateKey), []byte(passphrase)); err != nil {
-						if errors.Is(err, x509.IncorrectPasswordError) {
-							badFields[privateKeyPassphraseField] = "Incorrect private key passphrase."
+		}
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
+// validateCreateAttributes validates the type and the attributes of a
+// credential being created.
+func validateCreateAttributes(item *pb.Credential) map[string]string {
+	badFields := map[string]string{}
+	switch item.GetType() {
+	case credential.UsernamePasswordSubtype.String():
+		if item.GetUsernamePasswordAttributes().GetUsername().GetValue() == "" {
+			badFields[usernameField] = "Field required for creating a username-password credential."
+		}
+		if item.GetUsernamePasswordAttributes().GetPassword().GetValue() == "" {
+			badFields[passwordField] = "Field required for creating a username-password credential."
+		}
+
+	case credential.SshPrivateKeySubtype.String():
+		if item.GetSshPrivateKeyAttributes().GetUsername().GetValue() == "" {
+			badFields[usernameField] = "Field required for creating an SSH private key credential."
+		}
+		privateKey := item.GetSshPrivateKeyAttributes().GetPrivateKey().GetValue()
+		passphrase := item.GetSshPrivateKeyAttributes().GetPrivateKeyPassphrase().GetValue()
+		if privateKey == "" {
+			badFields[privateKeyField] = "Field required for creating an SSH private key credential."
+		} else {
+			switch passphrase {
+			case "":
+				if _, err := ssh.ParsePrivateKey([]byte(privateKey)); err != nil {
+					badFields[privateKeyField] = fmt.Sprintf("Unable to parse given private key value: %v.", err)
+				}
+			default:
+				if _, err := ssh.ParsePrivateKeyWithPassphrase([]byte(privateKey), []byte(passphrase)); err != nil {
+					if errors.Is(err, x509.IncorrectPasswordError) {
+						badFields[privateKeyPassphraseField] = "Incorrect private key passphrase."
+					} else {
+						if _, err := ssh.ParsePrivateKey([]byte(privateKey)); err == nil {
+							badFields[privateKeyPassphraseField] = "Passphrase supplied for unencrypted key."
 						} else {
-							if _, err := ssh.ParsePrivateKey([]byte(privateKey)); err == nil {
-								badFields[privateKeyPassphraseField] = "Passphrase supplied for unencrypted key."
-							} else {
-								badFields[privateKeyField] = fmt.Sprintf("Unable to parse given private key value: %v.", err)
-							}
+							badFields[privateKeyField] = fmt.Sprintf("Unable to parse given private key value: %v.", err)
 						}
 					}
 				}
 			}
+		}
 
-		case credential.JsonSubtype.String():
-			object := req.GetItem().GetJsonAttributes().GetObject()
-			if object == nil && len(object.AsMap()) <= 0 {
-				badFields[objectField] = "Field required for creating a json credential."
-			}
-			objectBytes, err := json.Marshal(object)
-			if err != nil {
-				badFields[objectField] = "Unable to parse given json value"
-			} else if len(objectBytes) <= 0 {
-				badFields[objectField] = "Field required for creating a json credential."
-			}
+	case credential.JsonSubtype.String():
+		object := item.GetJsonAttributes().GetObject()
+		if object == nil && len(object.AsMap()) <= 0 {
+			badFields[objectField] = "Field required for creating a json credential."
+		}
+		objectBytes, err := json.Marshal(object)
+		if err != nil {
+			badFields[objectField] = "Unable to parse given json value"
+		} else if len(objectBytes) <= 0 {
+			badFields[objectField] = "Field required for creating a json credential."
+		}
 
-		default:
-			badFields[globals.TypeField] = fmt.Sprintf("Unsupported credential type %q", req.Item.GetType())
+	case credential.X509CertificateSubtype.String():
+		attrs := item.GetX509CertificateAttributes()
+		certificate := attrs.GetCertificate().GetValue()
+		privateKey := attrs.GetPrivateKey().GetValue()
+		if certificate == "" {
+			badFields[certificateField] = "Field required for creating an X.509 certificate credential."
+		}
+		if privateKey == "" {
+			badFields[privateKeyField] = "Field required for creating an X.509 certificate credential."
+		}
+		if certificate != "" && privateKey != "" {
+			if _, err := tls.X509KeyPair([]byte(certificate), []byte(privateKey)); err != nil {
+				badFields[certificateField] = fmt.Sprintf("Unable to parse given certificate and private key: %v.", err)
+			}
 		}
 
-		return badFields
-	})
+	default:
+		badFields[globals.TypeField] = fmt.Sprintf("Unsupported credential type %q", item.GetType())
+	}
+
+	return badFields
 }
 
 func validateUpdateRequest(req *pbs.UpdateCredentialRequest) error {
@@ -863,6 +1152,31 @@ func validateUpdateRequest(req *pbs.UpdateCredentialRequest) error {
 				badFields[objectField] = "This is a required field and cannot be set to empty."
 			}
 
+		case credential.X509CertificateSubtype:
+			attrs := req.GetItem().GetX509CertificateAttributes()
+			certificate := attrs.GetCertificate().GetValue()
+			privateKey := attrs.GetPrivateKey().GetValue()
+			updateCertificate := handlers.MaskContains(req.GetUpdateMask().GetPaths(), certificateField)
+			updatePrivateKey := handlers.MaskContains(req.GetUpdateMask().GetPaths(), privateKeyField)
+			switch {
+			case updateCertificate && !updatePrivateKey:
+				badFields[privateKeyField] = "The certificate and private key must be updated together."
+			case updatePrivateKey && !updateCertificate:
+				badFields[certificateField] = "The certificate and private key must be updated together."
+			case updateCertificate && updatePrivateKey:
+				if certificate == "" {
+					badFields[certificateField] = "This is a required field and cannot be set to empty."
+				}
+				if privateKey == "" {
+					badFields[privateKeyField] = "This is a required field and cannot be set to empty."
+				}
+				if certificate != "" && privateKey != "" {
+					if _, err := tls.X509KeyPair([]byte(certificate), []byte(privateKey)); err != nil {
+						badFields[certificateField] = fmt.Sprintf("Unable to parse given certificate and private key: %v.", err)
+					}
+				}
+			}
+
 		default:
 			badFields[globals.IdField] = "Unknown credential type."
 		}
@@ -873,6 +1187,7 @@ func validateUpdateRequest(req *pbs.UpdateCredentialRequest) error {
 		globals.UsernamePasswordCredentialPreviousPrefix,
 		globals.SshPrivateKeyCredentialPrefix,
 		globals.JsonCredentialPrefix,
+		globals.X509CertificateCredentialPrefix,
 	)
 }
 
@@ -884,6 +1199,7 @@ func validateDeleteRequest(req *pbs.DeleteCredentialRequest) error {
 		globals.UsernamePasswordCredentialPreviousPrefix,
 		globals.SshPrivateKeyCredentialPrefix,
 		globals.JsonCredentialPrefix,
+		globals.X509CertificateCredentialPrefix,
 	)
 }
 
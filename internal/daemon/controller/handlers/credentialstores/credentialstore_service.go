@@ -8,9 +8,13 @@ import (
 	"encoding/base64"
 	"encoding/pem"
 	"fmt"
+	"net/url"
+	"strings"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/azure"
+	azurestore "github.com/hashicorp/boundary/internal/credential/azure/store"
 	"github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/credential/vault/store"
@@ -31,6 +35,7 @@ import (
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentialstores"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -41,11 +46,13 @@ const (
 	caCertsField           = "attributes.ca_cert"
 	clientCertField        = "attributes.client_certificate"
 	clientCertKeyField     = "attributes.certificate_key"
+	azureVaultUriField     = "attributes.vault_uri"
 	domain                 = "credential"
 )
 
 var (
-	maskManager handlers.MaskManager
+	maskManager      handlers.MaskManager
+	azureMaskManager handlers.MaskManager
 
 	// IdActions contains the set of actions that can be performed on
 	// individual resources
@@ -83,6 +90,10 @@ func init() {
 		handlers.MaskSource{&pb.CredentialStore{}, &pb.VaultCredentialStoreAttributes{}}); err != nil {
 		panic(err)
 	}
+	if azureMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&azurestore.CredentialStore{}},
+		handlers.MaskSource{&pb.CredentialStore{}, &pb.AzureCredentialStoreAttributes{}}); err != nil {
+		panic(err)
+	}
 }
 
 // Service handles request as described by the pbs.CredentialStoreServiceServer interface.
@@ -92,6 +103,7 @@ type Service struct {
 	iamRepoFn    common.IamRepoFactory
 	vaultRepoFn  common.VaultCredentialRepoFactory
 	staticRepoFn common.StaticCredentialRepoFactory
+	azureRepoFn  common.AzureCredentialRepoFactory
 }
 
 var _ pbs.CredentialStoreServiceServer = (*Service)(nil)
@@ -101,6 +113,7 @@ func NewService(
 	ctx context.Context,
 	vaultRepo common.VaultCredentialRepoFactory,
 	staticRepo common.StaticCredentialRepoFactory,
+	azureRepo common.AzureCredentialRepoFactory,
 	iamRepo common.IamRepoFactory,
 ) (Service, error) {
 	const op = "credentialstores.NewService"
@@ -113,7 +126,10 @@ func NewService(
 	if staticRepo == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing static credential repository")
 	}
-	return Service{iamRepoFn: iamRepo, vaultRepoFn: vaultRepo, staticRepoFn: staticRepo}, nil
+	if azureRepo == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing azure credential repository")
+	}
+	return Service{iamRepoFn: iamRepo, vaultRepoFn: vaultRepo, staticRepoFn: staticRepo, azureRepoFn: azureRepo}, nil
 }
 
 // ListCredentialStores implements the interface pbs.CredentialStoreServiceServer
@@ -153,6 +169,11 @@ func (s Service) ListCredentialStores(ctx context.Context, req *pbs.ListCredenti
 		return &pbs.ListCredentialStoresResponse{}, nil
 	}
 
+	health, err := s.healthFromRepo(ctx, csl...)
+	if err != nil {
+		return nil, err
+	}
+
 	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
 		return nil, err
@@ -190,6 +211,9 @@ func (s Service) ListCredentialStores(ctx context.Context, req *pbs.ListCredenti
 		if err != nil {
 			return nil, err
 		}
+		if outputFields.Has(globals.HealthField) {
+			item.Health = healthToProto(health[item.GetId()])
+		}
 
 		filterable, err := subtypes.Filterable(item)
 		if err != nil {
@@ -243,6 +267,13 @@ func (s Service) GetCredentialStore(ctx context.Context, req *pbs.GetCredentialS
 	if err != nil {
 		return nil, err
 	}
+	if outputFields.Has(globals.HealthField) {
+		health, err := s.healthFromRepo(ctx, cs)
+		if err != nil {
+			return nil, err
+		}
+		item.Health = healthToProto(health[cs.GetPublicId()])
+	}
 
 	return &pbs.GetCredentialStoreResponse{Item: item}, nil
 }
@@ -377,17 +408,53 @@ func (s Service) listFromRepo(ctx context.Context, scopeIds []string) ([]credent
 		return nil, errors.Wrap(ctx, err, op)
 	}
 
-	csl := make([]credential.Store, 0, len(staticCsl)+len(vaultCsl))
+	azureRepo, err := s.azureRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	azureCsl, err := azureRepo.ListCredentialStores(ctx, scopeIds)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	csl := make([]credential.Store, 0, len(staticCsl)+len(vaultCsl)+len(azureCsl))
 	for _, s := range vaultCsl {
 		csl = append(csl, s)
 	}
 	for _, s := range staticCsl {
 		csl = append(csl, s)
 	}
+	for _, s := range azureCsl {
+		csl = append(csl, s)
+	}
 
 	return csl, nil
 }
 
+// healthFromRepo returns the latest health checks of the vault credential
+// stores in stores, by store id.
+func (s Service) healthFromRepo(ctx context.Context, stores ...credential.Store) (map[string]*vault.CredentialStoreHealth, error) {
+	const op = "credentialstores.(Service).healthFromRepo"
+	var ids []string
+	for _, cs := range stores {
+		if subtypes.SubtypeFromId(domain, cs.GetPublicId()) == vault.Subtype {
+			ids = append(ids, cs.GetPublicId())
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	repo, err := s.vaultRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	health, err := repo.ListCredentialStoreHealth(ctx, ids)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return health, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (credential.Store, error) {
 	const op = "credentialstores.(Service).getFromRepo"
 
@@ -417,6 +484,19 @@ func (s Service) getFromRepo(ctx context.Context, id string) (credential.Store,
 		if cs != nil {
 			return cs, nil
 		}
+
+	case azure.Subtype:
+		repo, err := s.azureRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		cs, err := repo.LookupCredentialStore(ctx, id)
+		if err != nil && !errors.IsNotFoundError(err) {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if cs != nil {
+			return cs, nil
+		}
 	}
 
 	return nil, handlers.NotFoundErrorf("credential store %q not found", id)
@@ -456,6 +536,21 @@ func (s Service) createInRepo(ctx context.Context, projId string, item *pb.Crede
 		}
 		return out, nil
 
+	case azure.Subtype.String():
+		cs, err := toStorageAzureStore(ctx, projId, item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		repo, err := s.azureRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		out, err := repo.CreateCredentialStore(ctx, cs)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential store"))
+		}
+		return out, nil
+
 	default:
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create credential store, unknown type.")
 	}
@@ -468,6 +563,9 @@ func (s Service) updateInRepo(ctx context.Context, projId, id string, mask []str
 	var rowsUpdated int
 
 	dbMask := maskManager.Translate(mask)
+	if subtypes.SubtypeFromId(domain, id) == azure.Subtype {
+		dbMask = azureMaskManager.Translate(mask)
+	}
 	if len(dbMask) == 0 {
 		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
 	}
@@ -504,6 +602,22 @@ func (s Service) updateInRepo(ctx context.Context, projId, id string, mask []str
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential store"))
 		}
+
+	case azure.Subtype:
+		cs, err := toStorageAzureStore(ctx, projId, item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		cs.PublicId = id
+
+		repo, err := s.azureRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		out, rowsUpdated, err = repo.UpdateCredentialStore(ctx, cs, item.GetVersion(), dbMask)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential store"))
+		}
 	}
 	if rowsUpdated == 0 {
 		return nil, handlers.NotFoundErrorf("Credential Store %q doesn't exist or incorrect version provided.", id)
@@ -541,6 +655,19 @@ func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 			}
 			return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete credential store"))
 		}
+
+	case azure.Subtype:
+		repo, err := s.azureRepoFn()
+		if err != nil {
+			return false, err
+		}
+		rows, err = repo.DeleteCredentialStore(ctx, id)
+		if err != nil {
+			if errors.IsNotFoundError(err) {
+				return false, nil
+			}
+			return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete credential store"))
+		}
 	}
 	return rows > 0, nil
 }
@@ -562,6 +689,11 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 		res.Error = err
 		return res
 	}
+	azureRepo, err := s.azureRepoFn()
+	if err != nil {
+		res.Error = err
+		return res
+	}
 
 	var parentId string
 	opts := []auth.Option{auth.WithType(resource.CredentialStore), auth.WithAction(a)}
@@ -603,6 +735,18 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 				return res
 			}
 			parentId = cs.GetProjectId()
+
+		case azure.Subtype:
+			cs, err := azureRepo.LookupCredentialStore(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			if cs == nil {
+				res.Error = handlers.NotFoundError()
+				return res
+			}
+			parentId = cs.GetProjectId()
 		}
 		opts = append(opts, auth.WithId(id))
 	}
@@ -610,6 +754,24 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 	return auth.Verify(ctx, opts...)
 }
 
+func healthToProto(in *vault.CredentialStoreHealth) *pb.CredentialStoreHealth {
+	if in == nil {
+		return nil
+	}
+	out := &pb.CredentialStoreHealth{
+		Status:    string(in.Status),
+		Message:   in.Message,
+		CheckTime: timestamppb.New(in.CheckTime),
+	}
+	if !in.TokenExpirationTime.IsZero() {
+		out.TokenExpirationTime = timestamppb.New(in.TokenExpirationTime)
+	}
+	if !in.CertificateExpirationTime.IsZero() {
+		out.CertificateExpirationTime = timestamppb.New(in.CertificateExpirationTime)
+	}
+	return out
+}
+
 func toProto(ctx context.Context, in credential.Store, opt ...handlers.Option) (*pb.CredentialStore, error) {
 	const op = "credentialstores.toProto"
 
@@ -694,6 +856,21 @@ func toProto(ctx context.Context, in credential.Store, opt ...handlers.Option) (
 			out.Attrs = &pb.CredentialStore_VaultCredentialStoreAttributes{
 				VaultCredentialStoreAttributes: attrs,
 			}
+
+		case azure.Subtype:
+			azureIn, ok := in.(*azure.CredentialStore)
+			if !ok {
+				return nil, errors.New(ctx, errors.Internal, op, "unable to cast to azure credential store")
+			}
+			attrs := &pb.AzureCredentialStoreAttributes{
+				VaultUri: wrapperspb.String(azureIn.GetVaultUri()),
+			}
+			if azureIn.GetManagedIdentityClientId() != "" {
+				attrs.ManagedIdentityClientId = wrapperspb.String(azureIn.GetManagedIdentityClientId())
+			}
+			out.Attrs = &pb.CredentialStore_AzureCredentialStoreAttributes{
+				AzureCredentialStoreAttributes: attrs,
+			}
 		}
 	}
 	return &out, nil
@@ -716,6 +893,28 @@ func toStorageStaticStore(ctx context.Context, scopeId string, in *pb.Credential
 	return cs, err
 }
 
+func toStorageAzureStore(ctx context.Context, scopeId string, in *pb.CredentialStore) (out *azure.CredentialStore, err error) {
+	const op = "credentialstores.toStorageAzureStore"
+	var opts []azure.Option
+	if in.GetName() != nil {
+		opts = append(opts, azure.WithName(in.GetName().GetValue()))
+	}
+	if in.GetDescription() != nil {
+		opts = append(opts, azure.WithDescription(in.GetDescription().GetValue()))
+	}
+
+	attrs := in.GetAzureCredentialStoreAttributes()
+	if attrs.GetManagedIdentityClientId().GetValue() != "" {
+		opts = append(opts, azure.WithManagedIdentityClientId(attrs.GetManagedIdentityClientId().GetValue()))
+	}
+
+	cs, err := azure.NewCredentialStore(scopeId, attrs.GetVaultUri().GetValue(), opts...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to build credential store for creation"))
+	}
+	return cs, err
+}
+
 func toStorageVaultStore(ctx context.Context, scopeId string, in *pb.CredentialStore) (out *vault.CredentialStore, err error) {
 	const op = "credentialstores.toStorageVaultStore"
 	var opts []vault.Option
@@ -778,7 +977,7 @@ func toStorageVaultStore(ctx context.Context, scopeId string, in *pb.CredentialS
 //   - All required parameters are set
 //   - There are no conflicting parameters provided
 func validateGetRequest(req *pbs.GetCredentialStoreRequest) error {
-	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.VaultCredentialStorePrefix, globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix)
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.VaultCredentialStorePrefix, globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix, globals.AzureCredentialStorePrefix)
 }
 
 func validateCreateRequest(ctx context.Context, req *pbs.CreateCredentialStoreRequest) error {
@@ -827,6 +1026,13 @@ func validateCreateRequest(ctx context.Context, req *pbs.CreateCredentialStoreRe
 			}
 		case static.Subtype:
 			// No additional validation required for static credential store
+		case azure.Subtype:
+			attrs := req.GetItem().GetAzureCredentialStoreAttributes()
+			if attrs.GetVaultUri().GetValue() == "" {
+				badFields[azureVaultUriField] = "Field required for creating an azure credential store."
+			} else if msg := validateAzureVaultUri(attrs.GetVaultUri().GetValue()); msg != "" {
+				badFields[azureVaultUriField] = msg
+			}
 		default:
 			badFields[globals.TypeField] = "This is a required field and must be a known credential store type."
 		}
@@ -873,13 +1079,25 @@ func validateUpdateRequest(ctx context.Context, req *pbs.UpdateCredentialStoreRe
 					badFields[clientCertField] = fmt.Sprintf("Invalid values: %q", err.Error())
 				}
 			}
+		case azure.Subtype:
+			if req.GetItem().GetType() != "" && subtypes.SubtypeFromType(domain, req.GetItem().GetType()) != azure.Subtype {
+				badFields["type"] = "Cannot modify resource type."
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), azureVaultUriField) {
+				uri := req.GetItem().GetAzureCredentialStoreAttributes().GetVaultUri().GetValue()
+				if uri == "" {
+					badFields[azureVaultUriField] = "This is a required field and cannot be unset."
+				} else if msg := validateAzureVaultUri(uri); msg != "" {
+					badFields[azureVaultUriField] = msg
+				}
+			}
 		}
 		return badFields
-	}, globals.VaultCredentialStorePrefix, globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix)
+	}, globals.VaultCredentialStorePrefix, globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix, globals.AzureCredentialStorePrefix)
 }
 
 func validateDeleteRequest(req *pbs.DeleteCredentialStoreRequest) error {
-	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.VaultCredentialStorePrefix, globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix)
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.VaultCredentialStorePrefix, globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix, globals.AzureCredentialStorePrefix)
 }
 
 func validateListRequest(req *pbs.ListCredentialStoresRequest) error {
@@ -906,6 +1124,9 @@ func calculateAuthorizedCollectionActions(ctx context.Context, authResults auth.
 
 	case static.Subtype:
 		collectionActions, err = auth.CalculateAuthorizedCollectionActions(ctx, authResults, staticCollectionTypeMap, authResults.Scope.Id, id)
+
+	case azure.Subtype:
+		collectionActions, err = auth.CalculateAuthorizedCollectionActions(ctx, authResults, vaultCollectionTypeMap, authResults.Scope.Id, id)
 	}
 	if err != nil {
 		return nil, err
@@ -913,3 +1134,19 @@ func calculateAuthorizedCollectionActions(ctx context.Context, authResults auth.
 
 	return collectionActions, nil
 }
+
+// validateAzureVaultUri checks that uri is the https uri of an Azure Key
+// Vault, such as https://myvault.vault.azure.net. It returns the reason uri
+// is invalid or an empty string.
+func validateAzureVaultUri(uri string) string {
+	u, err := url.Parse(uri)
+	switch {
+	case err != nil:
+		return fmt.Sprintf("Invalid uri: %q.", err.Error())
+	case u.Scheme != "https":
+		return "The uri must use https."
+	case !strings.Contains(u.Host, "."), strings.Trim(u.Path, "/") != "", u.RawQuery != "", u.User != nil:
+		return "The uri must be the uri of an Azure Key Vault, such as https://myvault.vault.azure.net."
+	}
+	return ""
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/credential/azure"
 	credstatic "github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
@@ -53,6 +54,7 @@ var (
 		"credentials": {
 			Values: []*structpb.Value{
 				structpb.NewStringValue("create"),
+				structpb.NewStringValue("batch-create"),
 				structpb.NewStringValue("list"),
 			},
 		},
@@ -79,6 +81,9 @@ func TestList(t *testing.T) {
 	staticRepoFn := func() (*credstatic.Repository, error) {
 		return credstatic.NewRepository(context.Background(), rw, rw, kms)
 	}
+	azureRepoFn := func() (*azure.Repository, error) {
+		return azure.NewRepository(context.Background(), rw, rw, kms)
+	}
 
 	_, prjNoStores := iam.TestScopes(t, iamRepo)
 	_, prj := iam.TestScopes(t, iamRepo)
@@ -173,7 +178,7 @@ func TestList(t *testing.T) {
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			s, err := NewService(ctx, vaultRepoFn, staticRepoFn, iamRepoFn)
+			s, err := NewService(ctx, vaultRepoFn, staticRepoFn, azureRepoFn, iamRepoFn)
 			require.NoError(t, err, "Couldn't create new host set service.")
 
 			// Test non-anonymous listing
@@ -221,6 +226,9 @@ func TestCreateVault(t *testing.T) {
 	staticRepoFn := func() (*credstatic.Repository, error) {
 		return credstatic.NewRepository(context.Background(), rw, rw, kms)
 	}
+	azureRepoFn := func() (*azure.Repository, error) {
+		return azure.NewRepository(context.Background(), rw, rw, kms)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 	defaultCreated := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0].GetCreateTime().GetTimestamp()
@@ -532,7 +540,7 @@ func TestCreateVault(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := NewService(ctx, vaultRepoFn, staticRepoFn, iamRepoFn)
+			s, err := NewService(ctx, vaultRepoFn, staticRepoFn, azureRepoFn, iamRepoFn)
 			require.NoError(err, "Error when getting new credential store service.")
 			defer cleanup(s)
 
@@ -602,6 +610,9 @@ func TestCreateStatic(t *testing.T) {
 	staticRepoFn := func() (*credstatic.Repository, error) {
 		return credstatic.NewRepository(context.Background(), rw, rw, kms)
 	}
+	azureRepoFn := func() (*azure.Repository, error) {
+		return azure.NewRepository(context.Background(), rw, rw, kms)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 	defaultCreated := credstatic.TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
@@ -709,7 +720,7 @@ func TestCreateStatic(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := NewService(ctx, vaultRepoFn, staticRepoFn, iamRepoFn)
+			s, err := NewService(ctx, vaultRepoFn, staticRepoFn, azureRepoFn, iamRepoFn)
 			require.NoError(err, "Error when getting new credential store service.")
 			defer cleanup(s)
 
@@ -750,6 +761,118 @@ func TestCreateStatic(t *testing.T) {
 	}
 }
 
+func TestCreateAzure(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	rw := db.New(conn)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	vaultRepoFn := func() (*vault.Repository, error) {
+		return vault.NewRepository(rw, rw, kms, sche)
+	}
+	staticRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(context.Background(), rw, rw, kms)
+	}
+	azureRepoFn := func() (*azure.Repository, error) {
+		return azure.NewRepository(context.Background(), rw, rw, kms)
+	}
+
+	_, prj := iam.TestScopes(t, iamRepo)
+
+	cases := []struct {
+		name    string
+		req     *pbs.CreateCredentialStoreRequest
+		res     *pbs.CreateCredentialStoreResponse
+		wantErr bool
+	}{
+		{
+			name: "Must specify vault uri",
+			req: &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+				ScopeId: prj.GetPublicId(),
+				Type:    azure.Subtype.String(),
+			}},
+			wantErr: true,
+		},
+		{
+			name: "Vault uri must use https",
+			req: &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+				ScopeId: prj.GetPublicId(),
+				Type:    azure.Subtype.String(),
+				Attrs: &pb.CredentialStore_AzureCredentialStoreAttributes{
+					AzureCredentialStoreAttributes: &pb.AzureCredentialStoreAttributes{
+						VaultUri: wrapperspb.String("http://myvault.vault.azure.net"),
+					},
+				},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "Create a valid azure CredentialStore",
+			req: &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+				ScopeId: prj.GetPublicId(),
+				Name:    wrapperspb.String("name"),
+				Type:    azure.Subtype.String(),
+				Attrs: &pb.CredentialStore_AzureCredentialStoreAttributes{
+					AzureCredentialStoreAttributes: &pb.AzureCredentialStoreAttributes{
+						VaultUri:                wrapperspb.String("https://myvault.vault.azure.net"),
+						ManagedIdentityClientId: wrapperspb.String("00000000-0000-0000-0000-000000000000"),
+					},
+				},
+			}},
+			res: &pbs.CreateCredentialStoreResponse{
+				Uri: fmt.Sprintf("credential-stores/%s_", globals.AzureCredentialStorePrefix),
+				Item: &pb.CredentialStore{
+					ScopeId:                     prj.GetPublicId(),
+					Scope:                       &scopepb.ScopeInfo{Id: prj.GetPublicId(), Type: prj.GetType(), ParentScopeId: prj.GetParentId()},
+					Name:                        wrapperspb.String("name"),
+					Version:                     1,
+					Type:                        azure.Subtype.String(),
+					AuthorizedActions:           testAuthorizedActions,
+					AuthorizedCollectionActions: testAuthorizedVaultCollectionActions,
+					Attrs: &pb.CredentialStore_AzureCredentialStoreAttributes{
+						AzureCredentialStoreAttributes: &pb.AzureCredentialStoreAttributes{
+							VaultUri:                wrapperspb.String("https://myvault.vault.azure.net"),
+							ManagedIdentityClientId: wrapperspb.String("00000000-0000-0000-0000-000000000000"),
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			s, err := NewService(ctx, vaultRepoFn, staticRepoFn, azureRepoFn, iamRepoFn)
+			require.NoError(err, "Error when getting new credential store service.")
+
+			got, gErr := s.CreateCredentialStore(auth.DisabledAuthTestContext(iamRepoFn, tc.req.GetItem().GetScopeId()), tc.req)
+			if tc.wantErr {
+				assert.True(errors.Is(gErr, handlers.ApiErrorWithCode(codes.InvalidArgument)), "CreateCredentialStore(...) got error %v, wanted invalid argument", gErr)
+				return
+			}
+			require.NoError(gErr)
+			assert.Contains(got.GetUri(), tc.res.Uri)
+			assert.True(strings.HasPrefix(got.GetItem().GetId(), globals.AzureCredentialStorePrefix+"_"))
+			assert.Empty(cmp.Diff(got, tc.res,
+				protocmp.Transform(),
+				protocmp.SortRepeatedFields(got),
+				protocmp.IgnoreFields(&pbs.CreateCredentialStoreResponse{}, "uri"),
+				protocmp.IgnoreFields(&pb.CredentialStore{}, "id", "created_time", "updated_time"),
+			))
+
+			_, err = s.DeleteCredentialStore(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId()), &pbs.DeleteCredentialStoreRequest{Id: got.GetItem().GetId()})
+			require.NoError(err)
+		})
+	}
+}
+
 func TestGet(t *testing.T) {
 	ctx := context.Background()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -768,13 +891,16 @@ func TestGet(t *testing.T) {
 	staticRepoFn := func() (*credstatic.Repository, error) {
 		return credstatic.NewRepository(context.Background(), rw, rw, kms)
 	}
+	azureRepoFn := func() (*azure.Repository, error) {
+		return azure.NewRepository(context.Background(), rw, rw, kms)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 
 	vaultStore := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
 	staticStore := credstatic.TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
 	staticStorePrev := credstatic.TestCredentialStore(t, conn, wrapper, prj.GetPublicId(), credstatic.WithPublicId(fmt.Sprintf("%s_1234567890", globals.StaticCredentialStorePreviousPrefix)))
-	s, err := NewService(ctx, vaultRepoFn, staticRepoFn, iamRepoFn)
+	s, err := NewService(ctx, vaultRepoFn, staticRepoFn, azureRepoFn, iamRepoFn)
 	require.NoError(t, err)
 
 	cases := []struct {
@@ -902,12 +1028,15 @@ func TestDelete(t *testing.T) {
 	staticRepoFn := func() (*credstatic.Repository, error) {
 		return credstatic.NewRepository(context.Background(), rw, rw, kms)
 	}
+	azureRepoFn := func() (*azure.Repository, error) {
+		return azure.NewRepository(context.Background(), rw, rw, kms)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 
 	vaultStore := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 2)[0]
 	staticStore := credstatic.TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
-	s, err := NewService(ctx, vaultRepoFn, staticRepoFn, iamRepoFn)
+	s, err := NewService(ctx, vaultRepoFn, staticRepoFn, azureRepoFn, iamRepoFn)
 	require.NoError(t, err)
 
 	cases := []struct {
@@ -976,11 +1105,14 @@ func TestUpdateVault(t *testing.T) {
 	staticRepoFn := func() (*credstatic.Repository, error) {
 		return credstatic.NewRepository(context.Background(), rw, rw, kms)
 	}
+	azureRepoFn := func() (*azure.Repository, error) {
+		return azure.NewRepository(context.Background(), rw, rw, kms)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
 
-	s, err := NewService(ctx, vaultRepoFn, staticRepoFn, iamRepoFn)
+	s, err := NewService(ctx, vaultRepoFn, staticRepoFn, azureRepoFn, iamRepoFn)
 	require.NoError(t, err)
 
 	fieldmask := func(paths ...string) *fieldmaskpb.FieldMask {
@@ -1309,11 +1441,14 @@ func TestUpdateStatic(t *testing.T) {
 	staticRepoFn := func() (*credstatic.Repository, error) {
 		return credstatic.NewRepository(context.Background(), rw, rw, kms)
 	}
+	azureRepoFn := func() (*azure.Repository, error) {
+		return azure.NewRepository(context.Background(), rw, rw, kms)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
 
-	s, err := NewService(ctx, vaultRepoFn, staticRepoFn, iamRepoFn)
+	s, err := NewService(ctx, vaultRepoFn, staticRepoFn, azureRepoFn, iamRepoFn)
 	require.NoError(t, err)
 
 	fieldmask := func(paths ...string) *fieldmaskpb.FieldMask {
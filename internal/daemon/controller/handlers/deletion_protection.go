@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package handlers
+
+import (
+	"strings"
+
+	"github.com/hashicorp/boundary/globals"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// ClearsDeletionProtection reports whether an update with the update mask
+// paths and deletion_protected value clears the deletion protection of a
+// resource. Clearing it requires the clear-deletion-protection action in
+// addition to the update action.
+func ClearsDeletionProtection(paths []string, protected *wrapperspb.BoolValue) bool {
+	if protected.GetValue() {
+		return false
+	}
+	for _, p := range paths {
+		for _, v := range strings.Split(p, ",") {
+			if strings.TrimSpace(v) == globals.DeletionProtectedField {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestClearsDeletionProtection(t *testing.T) {
+	cases := []struct {
+		name      string
+		paths     []string
+		protected *wrapperspb.BoolValue
+		want      bool
+	}{
+		{name: "not-in-mask", paths: []string{"name"}},
+		{name: "enable", paths: []string{"deletion_protected"}, protected: wrapperspb.Bool(true)},
+		{name: "disable", paths: []string{"deletion_protected"}, protected: wrapperspb.Bool(false), want: true},
+		{name: "null", paths: []string{"deletion_protected"}, want: true},
+		{name: "comma-separated", paths: []string{"name, deletion_protected"}, want: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, ClearsDeletionProtection(tc.paths, tc.protected))
+		})
+	}
+}
@@ -108,6 +108,30 @@ func NotFoundErrorf(msg string, a ...any) *ApiError {
 	}
 }
 
+// RequestTooLargeErrorf returns an ApiError indicating a request exceeded the
+// size or attribute depth limits of its endpoint.
+func RequestTooLargeErrorf(msg string, a ...any) *ApiError {
+	return &ApiError{
+		Status: http.StatusRequestEntityTooLarge,
+		Inner: &pb.Error{
+			Kind:    codes.ResourceExhausted.String(),
+			Message: fmt.Sprintf(msg, a...),
+		},
+	}
+}
+
+// AmbiguousLookupErrorf returns an ApiError indicating the name and scope
+// given to look up a resource matched more than one resource.
+func AmbiguousLookupErrorf(msg string, a ...any) *ApiError {
+	return &ApiError{
+		Status: http.StatusConflict,
+		Inner: &pb.Error{
+			Kind:    codes.AlreadyExists.String(),
+			Message: fmt.Sprintf(msg, a...),
+		},
+	}
+}
+
 var unauthorizedError = &ApiError{
 	Status: http.StatusForbidden,
 	Inner: &pb.Error{
@@ -4,33 +4,55 @@
 package handlers
 
 import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/filter"
 	"github.com/hashicorp/go-bexpr"
+	"github.com/hashicorp/go-bexpr/grammar"
+	"github.com/mitchellh/pointerstructure"
 )
 
 // filterItem captures all the different namespaces that can be used when
 // filtering an item.
 type filterItem struct {
 	Item any `json:"item"`
+
+	// Comparisons holds the results of the comparisons of the filter, which
+	// are replaced by lookups of their result before building the evaluator.
+	Comparisons map[string]bool `json:"comparisons,omitempty"`
 }
 
 type Filter struct {
-	eval *bexpr.Evaluator
+	eval        *bexpr.Evaluator
+	comparisons []*comparison
 }
 
 // NewFilter returns a Filter which can be evluated against.  An empty string paramter indicates
 // all items passed to it should succeed.
+//
+// In addition to the operators of go-bexpr, a selector can be compared with a
+// number or a time using <, <=, > and >=, for instance
+// "/item/created_time" > "2023-01-02T15:04:05Z". Times are RFC 3339 times or
+// durations relative to the time of the evaluation, such as "-24h".
 func NewFilter(f string) (*Filter, error) {
 	const op = "handlers.NewFilter"
 	if f == "" {
 		return &Filter{}, nil
 	}
+	f, comparisons, err := extractComparisons(f)
+	if err != nil {
+		return nil, errors.WrapDeprecated(err, op, errors.WithMsg("couldn't build filter"), errors.WithCode(errors.InvalidParameter))
+	}
 	e, err := bexpr.CreateEvaluator(f, bexpr.WithTagName("json"), bexpr.WithHookFn(filter.WellKnownTypeFilterHook))
 	if err != nil {
 		return nil, errors.WrapDeprecated(err, op, errors.WithMsg("couldn't build filter"), errors.WithCode(errors.InvalidParameter))
 	}
-	return &Filter{eval: e}, nil
+	return &Filter{eval: e, comparisons: comparisons}, nil
 }
 
 // Match returns if the provided interface matches the filter.
@@ -41,10 +63,244 @@ func (f *Filter) Match(item any) bool {
 	if f.eval == nil {
 		return true
 	}
-	m, err := f.eval.Evaluate(filterItem{Item: item})
+	fi := filterItem{Item: item}
+	if len(f.comparisons) > 0 {
+		now := time.Now()
+		fi.Comparisons = make(map[string]bool, len(f.comparisons))
+		for _, c := range f.comparisons {
+			fi.Comparisons[c.key] = c.match(fi, now)
+		}
+	}
+	m, err := f.eval.Evaluate(fi)
 	// There isn't a clear way to differentiate between a JSON Pointer which doesn't represent
 	// the structure of the object being Matched and a JSON Pointer which references a field which
 	// is part of a sub structure that is nil in this item. Because of this, any filter which would
 	// result in an error using the underlying library is simply interpreted as not a match.
 	return err == nil && m
 }
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// comparison is a comparison of the value of a selector with a number or a
+// time.
+type comparison struct {
+	key  string
+	path []string
+	op   string
+
+	isNumber bool
+	number   float64
+
+	isTime     bool
+	time       time.Time
+	isRelative bool
+	relative   time.Duration
+}
+
+func newComparison(key, selector, op, value string) (*comparison, error) {
+	// Parse the selector with the go-bexpr grammar so that it accepts the
+	// same selectors as the other operators.
+	ast, err := grammar.Parse("", []byte(selector+" is empty"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid selector %s for operator %s: %w", selector, op, err)
+	}
+	me, ok := ast.(*grammar.MatchExpression)
+	if !ok {
+		return nil, fmt.Errorf("invalid selector %s for operator %s", selector, op)
+	}
+	c := &comparison{
+		key:  key,
+		path: me.Selector.Path,
+		op:   op,
+	}
+	if n, err := strconv.ParseFloat(value, 64); err == nil {
+		c.isNumber, c.number = true, n
+		return c, nil
+	}
+	if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		c.isTime, c.time = true, t
+		return c, nil
+	}
+	if d, err := time.ParseDuration(value); err == nil {
+		c.isTime, c.isRelative, c.relative = true, true, d
+		return c, nil
+	}
+	return nil, fmt.Errorf("value %q for operator %s is not a number, a time or a duration", value, op)
+}
+
+// match returns whether the value of the selector in fi compares with the
+// value of c. Values which are missing or aren't of the type of the value of
+// c don't match.
+func (c *comparison) match(fi filterItem, now time.Time) bool {
+	ptr := pointerstructure.Pointer{
+		Parts: c.path,
+		Config: pointerstructure.Config{
+			TagName:                 "json",
+			ValueTransformationHook: filter.WellKnownTypeFilterHook,
+		},
+	}
+	raw, err := ptr.Get(fi)
+	if err != nil || raw == nil {
+		return false
+	}
+	v := reflect.ValueOf(raw)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return false
+		}
+		v = v.Elem()
+	}
+
+	var cmp int
+	switch {
+	case c.isTime && v.Type() == timeType:
+		t := c.time
+		if c.isRelative {
+			t = now.Add(c.relative)
+		}
+		vt := v.Interface().(time.Time)
+		switch {
+		case vt.Before(t):
+			cmp = -1
+		case vt.After(t):
+			cmp = 1
+		}
+	case c.isNumber:
+		var n float64
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			n = float64(v.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			n = float64(v.Uint())
+		case reflect.Float32, reflect.Float64:
+			n = v.Float()
+		default:
+			return false
+		}
+		switch {
+		case n < c.number:
+			cmp = -1
+		case n > c.number:
+			cmp = 1
+		}
+	default:
+		return false
+	}
+
+	switch c.op {
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	default:
+		return false
+	}
+}
+
+type filterTokenKind int
+
+const (
+	filterTokenOther filterTokenKind = iota
+	filterTokenQuoted
+	filterTokenWord
+	filterTokenComparison
+)
+
+type filterToken struct {
+	kind       filterTokenKind
+	start, end int
+}
+
+// tokenizeFilter splits f into the tokens relevant to finding comparisons:
+// quoted strings, words and comparison operators.
+func tokenizeFilter(f string) ([]filterToken, error) {
+	var tokens []filterToken
+	for i := 0; i < len(f); {
+		switch ch := f[i]; {
+		case ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r':
+			i++
+		case ch == '"' || ch == '`':
+			j := i + 1
+			for ; j < len(f) && f[j] != ch; j++ {
+				if ch == '"' && f[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(f) {
+				return nil, fmt.Errorf("unterminated string starting at %d", i)
+			}
+			tokens = append(tokens, filterToken{kind: filterTokenQuoted, start: i, end: j + 1})
+			i = j + 1
+		case ch == '<' || ch == '>':
+			end := i + 1
+			if end < len(f) && f[end] == '=' {
+				end++
+			}
+			tokens = append(tokens, filterToken{kind: filterTokenComparison, start: i, end: end})
+			i = end
+		case ch == '(' || ch == ')' || ch == '=' || ch == '!':
+			tokens = append(tokens, filterToken{kind: filterTokenOther, start: i, end: i + 1})
+			i++
+		default:
+			j := i
+			for ; j < len(f) && !strings.ContainsRune(" \t\n\r\"`<>()=!", rune(f[j])); j++ {
+			}
+			tokens = append(tokens, filterToken{kind: filterTokenWord, start: i, end: j})
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+// extractComparisons replaces the comparisons in f, which go-bexpr doesn't
+// support, with lookups of their result in the comparisons namespace of the
+// filtered item, and returns them.
+func extractComparisons(f string) (string, []*comparison, error) {
+	tokens, err := tokenizeFilter(f)
+	if err != nil {
+		return "", nil, err
+	}
+	var comparisons []*comparison
+	var b strings.Builder
+	last := 0
+	for i, tok := range tokens {
+		if tok.kind != filterTokenComparison {
+			continue
+		}
+		o := f[tok.start:tok.end]
+		if i == 0 || i == len(tokens)-1 {
+			return "", nil, fmt.Errorf("operator %s is missing an operand", o)
+		}
+		left, right := tokens[i-1], tokens[i+1]
+		if (left.kind != filterTokenQuoted && left.kind != filterTokenWord) ||
+			(right.kind != filterTokenQuoted && right.kind != filterTokenWord) {
+			return "", nil, fmt.Errorf("operator %s is missing an operand", o)
+		}
+		if left.start < last {
+			return "", nil, fmt.Errorf("comparisons can't be chained")
+		}
+		value := f[right.start:right.end]
+		if right.kind == filterTokenQuoted {
+			if value[0] == '`' {
+				value = value[1 : len(value)-1]
+			} else if value, err = strconv.Unquote(value); err != nil {
+				return "", nil, fmt.Errorf("invalid value %s for operator %s: %w", f[right.start:right.end], o, err)
+			}
+		}
+		key := fmt.Sprintf("c%d", len(comparisons))
+		c, err := newComparison(key, f[left.start:left.end], o, value)
+		if err != nil {
+			return "", nil, err
+		}
+		comparisons = append(comparisons, c)
+		b.WriteString(f[last:left.start])
+		fmt.Fprintf(&b, `"/comparisons/%s" == true`, key)
+		last = right.end
+	}
+	b.WriteString(f[last:])
+	return b.String(), comparisons, nil
+}
@@ -4,10 +4,13 @@
 package handlers
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -120,3 +123,160 @@ func TestNewFilter(t *testing.T) {
 		})
 	}
 }
+
+func TestNewFilter_comparisons(t *testing.T) {
+	now := time.Now()
+	type item struct {
+		Count       int32                   `json:"count"`
+		Size        *wrapperspb.UInt64Value `json:"size"`
+		Ratio       float64                 `json:"ratio"`
+		Name        string                  `json:"name"`
+		CreatedTime *timestamppb.Timestamp  `json:"created_time"`
+	}
+	in := item{
+		Count:       5,
+		Size:        wrapperspb.UInt64(1024),
+		Ratio:       0.5,
+		Name:        "a<b",
+		CreatedTime: timestamppb.New(now.Add(-time.Hour)),
+	}
+	cases := []struct {
+		name   string
+		filter string
+		fErr   bool
+		match  bool
+	}{
+		{
+			name:   "greater than",
+			filter: `"/item/count" > 4`,
+			match:  true,
+		},
+		{
+			name:   "greater than no match",
+			filter: `"/item/count" > 5`,
+			match:  false,
+		},
+		{
+			name:   "greater than or equal",
+			filter: `"/item/count" >= 5`,
+			match:  true,
+		},
+		{
+			name:   "less than",
+			filter: `"/item/count" < 6`,
+			match:  true,
+		},
+		{
+			name:   "less than or equal no match",
+			filter: `"/item/count"<=4`,
+			match:  false,
+		},
+		{
+			name:   "range",
+			filter: `"/item/count" >= 1 and "/item/count" <= 10`,
+			match:  true,
+		},
+		{
+			name:   "range no match",
+			filter: `"/item/count" >= 6 and "/item/count" <= 10`,
+			match:  false,
+		},
+		{
+			name:   "wrapper",
+			filter: `"/item/size" > "1000"`,
+			match:  true,
+		},
+		{
+			name:   "float",
+			filter: `"/item/ratio" < 0.75`,
+			match:  true,
+		},
+		{
+			name:   "bexpr selector",
+			filter: `item.count > 4`,
+			match:  true,
+		},
+		{
+			name:   "combined with other operators",
+			filter: `"/item/name" == "a<b" and ("/item/count" > 10 or "/item/name" != "foo")`,
+			match:  true,
+		},
+		{
+			name:   "negated",
+			filter: `not ("/item/count" > 4)`,
+			match:  false,
+		},
+		{
+			name:   "absolute time",
+			filter: fmt.Sprintf(`"/item/created_time" > %q`, now.Add(-2*time.Hour).UTC().Format(time.RFC3339)),
+			match:  true,
+		},
+		{
+			name:   "absolute time no match",
+			filter: fmt.Sprintf(`"/item/created_time" > %q`, now.UTC().Format(time.RFC3339)),
+			match:  false,
+		},
+		{
+			name:   "relative time",
+			filter: `"/item/created_time" > "-2h" and "/item/created_time" < "-30m"`,
+			match:  true,
+		},
+		{
+			name:   "relative time no match",
+			filter: `"/item/created_time" > "-30m"`,
+			match:  false,
+		},
+		{
+			name:   "number against time",
+			filter: `"/item/created_time" > 5`,
+			match:  false,
+		},
+		{
+			name:   "time against number",
+			filter: `"/item/count" > "-1h"`,
+			match:  false,
+		},
+		{
+			name:   "string field",
+			filter: `"/item/name" > 5`,
+			match:  false,
+		},
+		{
+			name:   "missing field",
+			filter: `"/item/missing" > 5`,
+			match:  false,
+		},
+		{
+			name:   "invalid value",
+			filter: `"/item/count" > "five"`,
+			fErr:   true,
+		},
+		{
+			name:   "missing operand",
+			filter: `"/item/count" >`,
+			fErr:   true,
+		},
+		{
+			name:   "chained",
+			filter: `1 < "/item/count" < 10`,
+			fErr:   true,
+		},
+		{
+			name:   "unterminated string",
+			filter: `"/item/count > 5`,
+			fErr:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			f, err := NewFilter(tc.filter)
+			if tc.fErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.match, f.Match(in))
+		})
+	}
+}
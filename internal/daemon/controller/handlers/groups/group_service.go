@@ -157,11 +157,15 @@ func (s Service) GetGroup(ctx context.Context, req *pbs.GetGroupRequest) (*pbs.G
 	if err := validateGetRequest(req); err != nil {
 		return nil, err
 	}
-	authResults := s.authResult(ctx, req.GetId(), action.Read)
+	id, err := handlers.LookupId(ctx, req, s.lookupIdByName)
+	if err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, id, action.Read)
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	u, members, err := s.getFromRepo(ctx, req.GetId())
+	u, members, err := s.getFromRepo(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -562,6 +566,15 @@ func (s Service) removeMembersInRepo(ctx context.Context, groupId string, userId
 	return out, m, nil
 }
 
+// lookupIdByName returns the ID of the group named name in the scope with scopeId.
+func (s Service) lookupIdByName(ctx context.Context, scopeId, name string) (string, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return "", err
+	}
+	return repo.LookupPublicIdByName(ctx, resource.Group, scopeId, name)
+}
+
 func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.VerifyResults {
 	res := auth.VerifyResults{}
 	repo, err := s.repoFn()
@@ -658,7 +671,7 @@ func toProto(ctx context.Context, in *iam.Group, members []*iam.GroupMember, opt
 //   - All required parameters are set
 //   - There are no conflicting parameters provided
 func validateGetRequest(req *pbs.GetGroupRequest) error {
-	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.GroupPrefix)
+	return handlers.ValidateNameLookupGetRequest(handlers.NoopValidatorFn, req, globals.GroupPrefix)
 }
 
 func validateCreateRequest(req *pbs.CreateGroupRequest) error {
@@ -6,7 +6,6 @@ package hosts
 import (
 	"context"
 	"fmt"
-	"net"
 	"strings"
 
 	"github.com/hashicorp/boundary/globals"
@@ -25,6 +24,7 @@ import (
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/internal/types/subtypes"
+	"github.com/hashicorp/boundary/internal/util"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hosts"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/plugins"
 	"google.golang.org/grpc/codes"
@@ -647,11 +647,11 @@ func validateCreateRequest(req *pbs.CreateHostRequest) error {
 					len(attrs.GetAddress().GetValue()) > static.MaxHostAddressLength {
 					badFields[globals.AttributesAddressField] = fmt.Sprintf("Address length must be between %d and %d characters.", static.MinHostAddressLength, static.MaxHostAddressLength)
 				} else {
-					_, _, err := net.SplitHostPort(attrs.GetAddress().GetValue())
+					_, _, err := util.SplitHostPort(attrs.GetAddress().GetValue())
 					switch {
 					case err == nil:
 						badFields[globals.AttributesAddressField] = "Address for static hosts does not support a port."
-					case strings.Contains(err.Error(), globals.MissingPortErrStr):
+					case errors.Is(err, util.ErrMissingPort):
 						// Bare hostname, which we want
 					default:
 						badFields[globals.AttributesAddressField] = fmt.Sprintf("Error parsing address: %v.", err)
@@ -684,11 +684,11 @@ func validateUpdateRequest(req *pbs.UpdateHostRequest) error {
 						len(strings.TrimSpace(attrs.GetAddress().GetValue())) > static.MaxHostAddressLength {
 						badFields[globals.AttributesAddressField] = fmt.Sprintf("Address length must be between %d and %d characters.", static.MinHostAddressLength, static.MaxHostAddressLength)
 					} else {
-						_, _, err := net.SplitHostPort(attrs.GetAddress().GetValue())
+						_, _, err := util.SplitHostPort(attrs.GetAddress().GetValue())
 						switch {
 						case err == nil:
 							badFields[globals.AttributesAddressField] = "Address for static hosts does not support a port."
-						case strings.Contains(err.Error(), globals.MissingPortErrStr):
+						case errors.Is(err, util.ErrMissingPort):
 							// Bare hostname, which we want
 						default:
 							badFields[globals.AttributesAddressField] = fmt.Sprintf("Error parsing address: %v.", err)
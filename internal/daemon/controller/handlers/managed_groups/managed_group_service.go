@@ -366,6 +366,15 @@ func (s Service) createOidcInRepo(ctx context.Context, am auth.AuthMethod, item
 	if item.GetDescription() != nil {
 		opts = append(opts, oidc.WithDescription(item.GetDescription().GetValue()))
 	}
+	if len(item.GetUnionGroupIds()) > 0 {
+		opts = append(opts, oidc.WithUnionGroupIds(item.GetUnionGroupIds()...))
+	}
+	if len(item.GetIntersectionGroupIds()) > 0 {
+		opts = append(opts, oidc.WithIntersectionGroupIds(item.GetIntersectionGroupIds()...))
+	}
+	if len(item.GetDifferenceGroupIds()) > 0 {
+		opts = append(opts, oidc.WithDifferenceGroupIds(item.GetDifferenceGroupIds()...))
+	}
 	attrs := item.GetOidcManagedGroupAttributes()
 	mg, err := oidc.NewManagedGroup(ctx, am.GetPublicId(), attrs.GetFilter(), opts...)
 	if err != nil {
@@ -398,6 +407,15 @@ func (s Service) createLdapInRepo(ctx context.Context, am auth.AuthMethod, item
 	if item.GetDescription() != nil {
 		opts = append(opts, ldap.WithDescription(ctx, item.GetDescription().GetValue()))
 	}
+	if len(item.GetUnionGroupIds()) > 0 {
+		opts = append(opts, ldap.WithUnionGroupIds(ctx, item.GetUnionGroupIds()...))
+	}
+	if len(item.GetIntersectionGroupIds()) > 0 {
+		opts = append(opts, ldap.WithIntersectionGroupIds(ctx, item.GetIntersectionGroupIds()...))
+	}
+	if len(item.GetDifferenceGroupIds()) > 0 {
+		opts = append(opts, ldap.WithDifferenceGroupIds(ctx, item.GetDifferenceGroupIds()...))
+	}
 	attrs := item.GetLdapManagedGroupAttributes()
 	mg, err := ldap.NewManagedGroup(ctx, am.GetPublicId(), attrs.GetGroupNames(), opts...)
 	if err != nil {
@@ -462,6 +480,9 @@ func (s Service) updateOidcInRepo(ctx context.Context, scopeId, amId, id string,
 	}
 	// Set this regardless; it'll only take effect if the masks contain the value
 	mg.Filter = item.GetOidcManagedGroupAttributes().GetFilter()
+	if err := setOperandIds(ctx, item, &mg.UnionGroupIds, &mg.IntersectionGroupIds, &mg.DifferenceGroupIds); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
 
 	version := item.GetVersion()
 
@@ -502,6 +523,9 @@ func (s Service) updateLdapInRepo(ctx context.Context, scopeId, amId, id string,
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to encode group names"))
 	}
 	mg.GroupNames = string(encodedGroupNames)
+	if err := setOperandIds(ctx, item, &mg.UnionGroupIds, &mg.IntersectionGroupIds, &mg.DifferenceGroupIds); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
 
 	version := item.GetVersion()
 
@@ -523,6 +547,23 @@ func (s Service) updateLdapInRepo(ctx context.Context, scopeId, amId, id string,
 	return out, nil
 }
 
+// setOperandIds marshals the set operation operands of the item into the
+// storage fields of a managed group.
+func setOperandIds(ctx context.Context, item *pb.ManagedGroup, union, intersection, difference *string) error {
+	const op = "managed_groups.setOperandIds"
+	var err error
+	if *union, err = auth.MarshalSetOperandIds(ctx, item.GetUnionGroupIds()); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if *intersection, err = auth.MarshalSetOperandIds(ctx, item.GetIntersectionGroupIds()); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if *difference, err = auth.MarshalSetOperandIds(ctx, item.GetDifferenceGroupIds()); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
 func (s Service) updateInRepo(ctx context.Context, scopeId, authMethodId string, req *pbs.UpdateManagedGroupRequest) (auth.ManagedGroup, error) {
 	const op = "managed_groups.(Service).updateInRepo"
 	var out auth.ManagedGroup
@@ -731,6 +772,29 @@ func toProto(ctx context.Context, in auth.ManagedGroup, opt ...handlers.Option)
 	if outputFields.Has(globals.MemberIdsField) {
 		out.MemberIds = opts.WithMemberIds
 	}
+	var unionIds, intersectionIds, differenceIds string
+	switch i := in.(type) {
+	case *oidc.ManagedGroup:
+		unionIds, intersectionIds, differenceIds = i.GetUnionGroupIds(), i.GetIntersectionGroupIds(), i.GetDifferenceGroupIds()
+	case *ldap.ManagedGroup:
+		unionIds, intersectionIds, differenceIds = i.GetUnionGroupIds(), i.GetIntersectionGroupIds(), i.GetDifferenceGroupIds()
+	}
+	var err error
+	if outputFields.Has(globals.UnionGroupIdsField) {
+		if out.UnionGroupIds, err = auth.UnmarshalSetOperandIds(ctx, unionIds); err != nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "unable to unmarshal union group ids")
+		}
+	}
+	if outputFields.Has(globals.IntersectionGroupIdsField) {
+		if out.IntersectionGroupIds, err = auth.UnmarshalSetOperandIds(ctx, intersectionIds); err != nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "unable to unmarshal intersection group ids")
+		}
+	}
+	if outputFields.Has(globals.DifferenceGroupIdsField) {
+		if out.DifferenceGroupIds, err = auth.UnmarshalSetOperandIds(ctx, differenceIds); err != nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "unable to unmarshal difference group ids")
+		}
+	}
 	switch i := in.(type) {
 	case *oidc.ManagedGroup:
 		if outputFields.Has(globals.TypeField) {
@@ -823,6 +887,7 @@ func validateCreateRequest(req *pbs.CreateManagedGroupRequest) error {
 		default:
 			badFields[globals.AuthMethodIdField] = "Unknown auth method type from ID."
 		}
+		validateSetOperandIds("", req.GetItem(), badFields)
 		return badFields
 	})
 }
@@ -867,10 +932,31 @@ func validateUpdateRequest(req *pbs.UpdateManagedGroupRequest) error {
 		default:
 			badFields[globals.IdField] = "Unrecognized resource type."
 		}
+		validateSetOperandIds(req.GetId(), req.GetItem(), badFields)
 		return badFields
 	}, globals.OidcManagedGroupPrefix, globals.LdapManagedGroupPrefix)
 }
 
+// validateSetOperandIds verifies that the union, intersection and difference
+// operands of the item are formatted managed group or static group ids which
+// don't reference the managed group being updated.
+func validateSetOperandIds(id string, item *pb.ManagedGroup, badFields map[string]string) {
+	for field, ids := range map[string][]string{
+		globals.UnionGroupIdsField:        item.GetUnionGroupIds(),
+		globals.IntersectionGroupIdsField: item.GetIntersectionGroupIds(),
+		globals.DifferenceGroupIdsField:   item.GetDifferenceGroupIds(),
+	} {
+		for _, operand := range ids {
+			switch {
+			case !handlers.ValidId(handlers.Id(operand), globals.OidcManagedGroupPrefix, globals.LdapManagedGroupPrefix, globals.GroupPrefix):
+				badFields[field] = fmt.Sprintf("Incorrectly formatted identifier %q.", operand)
+			case id != "" && operand == id:
+				badFields[field] = "A managed group cannot be a set operand of itself."
+			}
+		}
+	}
+}
+
 func validateDeleteRequest(req *pbs.DeleteManagedGroupRequest) error {
 	const op = "managed_groups.validateDeleteRequest"
 	if req == nil {
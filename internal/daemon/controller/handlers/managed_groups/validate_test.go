@@ -121,6 +121,35 @@ func TestValidateCreateRequest(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "bad set operand",
+			item: &pb.ManagedGroup{
+				Type:          oidc.Subtype.String(),
+				AuthMethodId:  globals.OidcAuthMethodPrefix + "_1234567890",
+				UnionGroupIds: []string{globals.OidcManagedGroupPrefix + "_1234567890", "u_1234567890"},
+				Attrs: &pb.ManagedGroup_OidcManagedGroupAttributes{
+					OidcManagedGroupAttributes: &pb.OidcManagedGroupAttributes{
+						Filter: `"/foo/bar" == "zipzap"`,
+					},
+				},
+			},
+			errContains: fieldError(globals.UnionGroupIdsField, `Incorrectly formatted identifier "u_1234567890".`),
+		},
+		{
+			name: "set operands",
+			item: &pb.ManagedGroup{
+				Type:                 ldap.Subtype.String(),
+				AuthMethodId:         globals.LdapAuthMethodPrefix + "_1234567890",
+				UnionGroupIds:        []string{globals.LdapManagedGroupPrefix + "_1234567890"},
+				IntersectionGroupIds: []string{globals.GroupPrefix + "_1234567890"},
+				DifferenceGroupIds:   []string{globals.OidcManagedGroupPrefix + "_1234567890"},
+				Attrs: &pb.ManagedGroup_LdapManagedGroupAttributes{
+					LdapManagedGroupAttributes: &pb.LdapManagedGroupAttributes{
+						GroupNames: []string{"admin"},
+					},
+				},
+			},
+		},
 	}
 	for _, tc := range cases {
 		tc := tc // capture range variable
@@ -195,6 +224,18 @@ func TestValidateUpdateRequest(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "set operand of itself",
+			req: &pbs.UpdateManagedGroupRequest{
+				Id:         globals.LdapManagedGroupPrefix + "_1234567890",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{globals.DifferenceGroupIdsField}},
+				Item: &pb.ManagedGroup{
+					Version:            1,
+					DifferenceGroupIds: []string{globals.LdapManagedGroupPrefix + "_1234567890"},
+				},
+			},
+			errContains: fieldError(globals.DifferenceGroupIdsField, "A managed group cannot be a set operand of itself."),
+		},
 	}
 	for _, tc := range cases {
 		tc := tc // capture range variable
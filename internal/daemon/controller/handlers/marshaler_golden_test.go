@@ -0,0 +1,266 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	_ "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/accounts"
+	_ "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authmethods"
+	_ "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authtokens"
+	_ "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentiallibraries"
+	_ "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentials"
+	_ "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentialstores"
+	_ "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/groups"
+	_ "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostcatalogs"
+	_ "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hosts"
+	_ "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostsets"
+	_ "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/managedgroups"
+	_ "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/plugins"
+	_ "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/roles"
+	_ "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	_ "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/sessions"
+	_ "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+	_ "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/users"
+	_ "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/workers"
+)
+
+var updateGolden = flag.Bool("update-golden", false, "update the golden files of TestJSONMarshalerGolden")
+
+const (
+	goldenResourcesPackage = "controller.api.resources."
+	goldenDir              = "testdata/json_golden"
+	// goldenMaxDepth stops filling recursive messages
+	goldenMaxDepth = 4
+)
+
+// TestJSONMarshalerGolden marshals every message of the public API resources,
+// with every field set, and compares the output to golden files, so that
+// changes to the JSON wire format caused by proto edits are caught in review.
+// A golden file is written for every option of every oneof of the message,
+// such as the subtype attributes of a resource.
+//
+// When the wire format changes intentionally, regenerate the golden files
+// with:
+//
+//	go test ./internal/daemon/controller/handlers -run TestJSONMarshalerGolden -update-golden
+func TestJSONMarshalerGolden(t *testing.T) {
+	var msgs []protoreflect.MessageDescriptor
+	protoregistry.GlobalFiles.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		if !strings.HasPrefix(string(fd.Package()), goldenResourcesPackage) {
+			return true
+		}
+		for i := 0; i < fd.Messages().Len(); i++ {
+			msgs = append(msgs, fd.Messages().Get(i))
+		}
+		return true
+	})
+	require.NotEmpty(t, msgs)
+
+	want := make(map[string]bool)
+	for _, md := range msgs {
+		for _, c := range goldenCases(md) {
+			name := string(md.FullName())
+			if c != "" {
+				name += "." + c
+			}
+			want[name+".json"] = true
+			t.Run(name, func(t *testing.T) {
+				mt, err := protoregistry.GlobalTypes.FindMessageByName(md.FullName())
+				require.NoError(t, err)
+				m := mt.New()
+				fillGolden(m, c, 0)
+
+				b, err := JSONMarshaler().Marshal(m.Interface())
+				require.NoError(t, err)
+				var indented bytes.Buffer
+				require.NoError(t, json.Indent(&indented, b, "", "  "))
+				indented.WriteString("\n")
+
+				path := filepath.Join(goldenDir, name+".json")
+				if *updateGolden {
+					require.NoError(t, os.MkdirAll(goldenDir, 0o755))
+					require.NoError(t, os.WriteFile(path, indented.Bytes(), 0o644))
+					return
+				}
+				golden, err := os.ReadFile(path)
+				require.NoError(t, err, "missing golden file; run with -update-golden to create it")
+				assert.Equal(t, string(golden), indented.String(), "JSON wire format of %s changed; run with -update-golden if intended", name)
+
+				// The golden file must also unmarshal back into the message
+				got := mt.New().Interface()
+				require.NoError(t, JSONMarshaler().Unmarshal(golden, got))
+				assert.True(t, proto.Equal(m.Interface(), got))
+			})
+		}
+	}
+
+	if *updateGolden {
+		files, err := os.ReadDir(goldenDir)
+		require.NoError(t, err)
+		for _, f := range files {
+			if !want[f.Name()] {
+				require.NoError(t, os.Remove(filepath.Join(goldenDir, f.Name())))
+			}
+		}
+		return
+	}
+	files, err := os.ReadDir(goldenDir)
+	require.NoError(t, err)
+	for _, f := range files {
+		assert.True(t, want[f.Name()], "golden file %s doesn't match a message; run with -update-golden to remove it", f.Name())
+	}
+}
+
+// goldenCases returns the oneof options a golden file is written for, named
+// "<oneof>.<field>". A message without oneofs has a single unnamed case.
+func goldenCases(md protoreflect.MessageDescriptor) []string {
+	var cases []string
+	for i := 0; i < md.Oneofs().Len(); i++ {
+		od := md.Oneofs().Get(i)
+		if od.IsSynthetic() {
+			continue
+		}
+		for j := 0; j < od.Fields().Len(); j++ {
+			cases = append(cases, fmt.Sprintf("%s.%s", od.Name(), od.Fields().Get(j).Name()))
+		}
+	}
+	sort.Strings(cases)
+	if len(cases) == 0 {
+		return []string{""}
+	}
+	return cases
+}
+
+// fillGolden sets every field of m to a deterministic value derived from the
+// field. For each oneof, the option named by goldenCase is set, or the first
+// one when the case names another oneof.
+func fillGolden(m protoreflect.Message, goldenCase string, depth int) {
+	md := m.Descriptor()
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		if od := fd.ContainingOneof(); od != nil && !od.IsSynthetic() {
+			chosen := od.Fields().Get(0)
+			for j := 0; j < od.Fields().Len(); j++ {
+				if fmt.Sprintf("%s.%s", od.Name(), od.Fields().Get(j).Name()) == goldenCase {
+					chosen = od.Fields().Get(j)
+				}
+			}
+			if fd != chosen {
+				continue
+			}
+		}
+		switch {
+		case fd.IsMap():
+			mp := m.Mutable(fd).Map()
+			k := goldenScalar(fd.MapKey()).MapKey()
+			if fd.MapValue().Message() != nil {
+				if depth >= goldenMaxDepth {
+					continue
+				}
+				v := mp.NewValue()
+				fillGoldenMessage(v.Message(), depth+1)
+				mp.Set(k, v)
+			} else {
+				mp.Set(k, goldenScalar(fd.MapValue()))
+			}
+		case fd.IsList():
+			l := m.Mutable(fd).List()
+			if fd.Message() != nil {
+				if depth >= goldenMaxDepth {
+					continue
+				}
+				v := l.NewElement()
+				fillGoldenMessage(v.Message(), depth+1)
+				l.Append(v)
+			} else {
+				l.Append(goldenScalar(fd))
+			}
+		case fd.Message() != nil:
+			if depth >= goldenMaxDepth {
+				continue
+			}
+			fillGoldenMessage(m.Mutable(fd).Message(), depth+1)
+		default:
+			m.Set(fd, goldenScalar(fd))
+		}
+	}
+}
+
+// fillGoldenMessage fills a nested message. Well known types are given
+// values with a stable JSON representation.
+func fillGoldenMessage(m protoreflect.Message, depth int) {
+	var v proto.Message
+	switch m.Descriptor().FullName() {
+	case "google.protobuf.Timestamp":
+		v = &timestamppb.Timestamp{Seconds: 1600000000, Nanos: 123000000}
+	case "google.protobuf.Duration":
+		v = durationpb.New(90e9)
+	case "google.protobuf.Struct":
+		v = &structpb.Struct{Fields: map[string]*structpb.Value{"key": structpb.NewStringValue("value")}}
+	case "google.protobuf.Value":
+		v = structpb.NewStringValue("value")
+	case "google.protobuf.ListValue":
+		v = &structpb.ListValue{Values: []*structpb.Value{structpb.NewStringValue("value")}}
+	case "google.protobuf.FieldMask":
+		// Field masks aren't part of resources
+		return
+	}
+	if v != nil {
+		proto.Merge(m.Interface(), v)
+		return
+	}
+	fillGolden(m, "", depth)
+}
+
+// goldenScalar returns the value of a scalar field, derived from its name so
+// that fields of the same kind are told apart in the golden files.
+func goldenScalar(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	n := int64(fd.Number())
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return protoreflect.ValueOfBool(true)
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		v := values.Get(0)
+		if values.Len() > 1 {
+			v = values.Get(1)
+		}
+		return protoreflect.ValueOfEnum(v.Number())
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return protoreflect.ValueOfInt32(int32(n))
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return protoreflect.ValueOfInt64(n)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return protoreflect.ValueOfUint32(uint32(n))
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return protoreflect.ValueOfUint64(uint64(n))
+	case protoreflect.FloatKind:
+		return protoreflect.ValueOfFloat32(float32(n) + 0.5)
+	case protoreflect.DoubleKind:
+		return protoreflect.ValueOfFloat64(float64(n) + 0.5)
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(string(fd.Name()))
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes([]byte(fd.Name()))
+	}
+	panic(fmt.Sprintf("unhandled kind %s of field %s", fd.Kind(), fd.FullName()))
+}
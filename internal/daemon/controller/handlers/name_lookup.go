@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package handlers
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/types/scope"
+)
+
+// NameLookupRequest is a get request which can identify the resource by its
+// name and the ID of the scope containing it instead of by its ID. When the
+// name is used the ID is set to the same value, since it takes the place of
+// the ID in the path of the request.
+type NameLookupRequest interface {
+	GetRequest
+	GetName() string
+	GetScopeId() string
+}
+
+// NameLookupFunc returns the ID of the resource named name in the scope with
+// scopeId, or an empty string if there is none.
+type NameLookupFunc func(ctx context.Context, scopeId, name string) (string, error)
+
+// ValidateNameLookupGetRequest validates a get request which can look up the
+// resource by name. Without a name it is validated like ValidateGetRequest.
+// With a name, the ID must match the name and the scope ID must be set.
+func ValidateNameLookupGetRequest(fn CustomValidatorFunc, r NameLookupRequest, prefix ...string) error {
+	if r.GetName() == "" {
+		return ValidateGetRequest(func() map[string]string {
+			badFields := map[string]string{}
+			if r.GetScopeId() != "" {
+				badFields["scope_id"] = "Scope ID provided when name was empty."
+			}
+			for k, v := range fn() {
+				badFields[k] = v
+			}
+			return badFields
+		}, r, prefix...)
+	}
+	badFields := map[string]string{}
+	if r.GetName() != r.GetId() {
+		badFields["name"] = "Name provided but does not match the given ID value from the URL."
+	}
+	if r.GetScopeId() != scope.Global.String() && !ValidId(Id(r.GetScopeId()), scope.Org.Prefix(), scope.Project.Prefix()) {
+		badFields["scope_id"] = "A valid scope ID must be provided when name is used."
+	}
+	for k, v := range fn() {
+		badFields[k] = v
+	}
+	if len(badFields) > 0 {
+		return InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
+// LookupId returns the ID of the resource identified by r. If r has no name
+// its ID is returned, otherwise the ID is looked up with fn. A not found error
+// is returned if there is no resource with the name in the scope.
+func LookupId(ctx context.Context, r NameLookupRequest, fn NameLookupFunc) (string, error) {
+	if r.GetName() == "" {
+		return r.GetId(), nil
+	}
+	id, err := fn(ctx, r.GetScopeId(), r.GetName())
+	if err != nil {
+		return "", err
+	}
+	if id == "" {
+		return "", NotFoundError()
+	}
+	return id, nil
+}
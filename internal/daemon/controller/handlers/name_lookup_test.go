@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateNameLookupGetRequest(t *testing.T) {
+	cases := []struct {
+		name       string
+		req        *pbs.GetUserRequest
+		wantFields []string
+	}{
+		{name: "id", req: &pbs.GetUserRequest{Id: "u_1234567890"}},
+		{name: "bad-id", req: &pbs.GetUserRequest{Id: "bad_1234567890"}, wantFields: []string{"id"}},
+		{name: "scope-id-without-name", req: &pbs.GetUserRequest{Id: "u_1234567890", ScopeId: "global"}, wantFields: []string{"scope_id"}},
+		{name: "name-global", req: &pbs.GetUserRequest{Id: "alice", Name: "alice", ScopeId: "global"}},
+		{name: "name-org", req: &pbs.GetUserRequest{Id: "alice", Name: "alice", ScopeId: "o_1234567890"}},
+		{name: "name-mismatch", req: &pbs.GetUserRequest{Id: "u_1234567890", Name: "alice", ScopeId: "global"}, wantFields: []string{"name"}},
+		{name: "name-without-scope-id", req: &pbs.GetUserRequest{Id: "alice", Name: "alice"}, wantFields: []string{"scope_id"}},
+		{name: "name-bad-scope-id", req: &pbs.GetUserRequest{Id: "alice", Name: "alice", ScopeId: "u_1234567890"}, wantFields: []string{"scope_id"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateNameLookupGetRequest(NoopValidatorFn, tc.req, "u")
+			if len(tc.wantFields) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			errorIncludesFields(t, err, tc.wantFields)
+		})
+	}
+}
+
+func TestLookupId(t *testing.T) {
+	ctx := context.Background()
+	lookupFn := func(_ context.Context, scopeId, name string) (string, error) {
+		switch {
+		case scopeId == "global" && name == "alice":
+			return "u_1234567890", nil
+		case name == "error":
+			return "", errors.New("lookup failed")
+		}
+		return "", nil
+	}
+
+	t.Run("id", func(t *testing.T) {
+		id, err := LookupId(ctx, &pbs.GetUserRequest{Id: "u_0987654321"}, lookupFn)
+		require.NoError(t, err)
+		assert.Equal(t, "u_0987654321", id)
+	})
+	t.Run("name", func(t *testing.T) {
+		id, err := LookupId(ctx, &pbs.GetUserRequest{Id: "alice", Name: "alice", ScopeId: "global"}, lookupFn)
+		require.NoError(t, err)
+		assert.Equal(t, "u_1234567890", id)
+	})
+	t.Run("not-found", func(t *testing.T) {
+		_, err := LookupId(ctx, &pbs.GetUserRequest{Id: "bob", Name: "bob", ScopeId: "global"}, lookupFn)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, NotFoundError()))
+	})
+	t.Run("error", func(t *testing.T) {
+		_, err := LookupId(ctx, &pbs.GetUserRequest{Id: "error", Name: "error", ScopeId: "global"}, lookupFn)
+		require.Error(t, err)
+	})
+}
@@ -87,4 +87,13 @@ func Test_GetOpts(t *testing.T) {
 		opts = GetOpts(WithHostSetIds(out))
 		require.Equal(out, opts.WithHostSetIds)
 	})
+	t.Run("WithRegion", func(t *testing.T) {
+		assert := assert.New(t)
+
+		opts := GetOpts()
+		assert.Empty(opts.WithRegion)
+
+		opts = GetOpts(WithRegion("us-east-1"))
+		assert.Equal("us-east-1", opts.WithRegion)
+	})
 }
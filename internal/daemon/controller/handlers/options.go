@@ -4,7 +4,9 @@
 package handlers
 
 import (
+	"github.com/hashicorp/boundary/internal/auth/anomaly"
 	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/subscription"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/plugins"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -36,6 +38,12 @@ type options struct {
 	WithManagedGroupIds             []string
 	WithMemberIds                   []string
 	WithHostSetIds                  []string
+	WithAnomalyDetector             *anomaly.Detector
+	WithRegion                      string
+	WithEventSubscriptionRepo       subscription.RepoFactory
+	WithEventSubscriptionWebhooks   bool
+	WithDefaultScopeId              string
+	WithDefaultAuthMethodId         string
 }
 
 func getDefaultOptions() options {
@@ -122,3 +130,45 @@ func WithHostSetIds(ids []string) Option {
 		o.WithHostSetIds = ids
 	}
 }
+
+// WithAnomalyDetector provides an option when creating services to evaluate
+// authentication attempts with the given detector
+func WithAnomalyDetector(d *anomaly.Detector) Option {
+	return func(o *options) {
+		o.WithAnomalyDetector = d
+	}
+}
+
+// WithRegion provides an option when creating services to prefer resources in
+// the given region
+func WithRegion(region string) Option {
+	return func(o *options) {
+		o.WithRegion = region
+	}
+}
+
+// WithEventSubscriptionRepo provides an option when creating services to
+// manage the event subscriptions of users
+func WithEventSubscriptionRepo(fn subscription.RepoFactory) Option {
+	return func(o *options) {
+		o.WithEventSubscriptionRepo = fn
+	}
+}
+
+// WithEventSubscriptionWebhooks provides an option when creating services to
+// allow event subscriptions with a webhook
+func WithEventSubscriptionWebhooks(enabled bool) Option {
+	return func(o *options) {
+		o.WithEventSubscriptionWebhooks = enabled
+	}
+}
+
+// WithDiscoveryDefaults provides an option when creating services to set the
+// scope and auth method suggested to clients which authenticate without
+// specifying them
+func WithDiscoveryDefaults(scopeId, authMethodId string) Option {
+	return func(o *options) {
+		o.WithDefaultScopeId = scopeId
+		o.WithDefaultAuthMethodId = authMethodId
+	}
+}
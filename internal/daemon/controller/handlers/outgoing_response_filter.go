@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/boundary/internal/deprecation"
 	"github.com/hashicorp/boundary/internal/errors"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
@@ -29,6 +30,13 @@ const (
 	statusField              = "status"
 	StatusCodeHeader         = "x-http-code"
 	statusCodeMetadataHeader = "Grpc-Metadata-X-Http-Code"
+
+	// DeprecationHeader is the header listing the ids of the deprecated fields
+	// and behaviors used by a request. Each id is also returned as a Warning
+	// header describing its replacement.
+	DeprecationHeader         = "x-boundary-deprecation"
+	deprecationMetadataHeader = "Grpc-Metadata-X-Boundary-Deprecation"
+	warningHeader             = "Warning"
 )
 
 // SetStatusCode allows a grpc service handler to set the outgoing http status
@@ -51,6 +59,19 @@ func OutgoingResponseFilter(ctx context.Context, w http.ResponseWriter, m proto.
 	const op = "handlers.OutgoingResponseFilter"
 
 	if md, ok := runtime.ServerMetadataFromContext(ctx); ok {
+		// return deprecations recorded by the grpc service without the
+		// grpc-metadata prefix, along with a warning for each of them
+		if ids := md.HeaderMD.Get(DeprecationHeader); len(ids) > 0 {
+			delete(md.HeaderMD, DeprecationHeader)
+			delete(w.Header(), deprecationMetadataHeader)
+			for _, id := range ids {
+				w.Header().Add(DeprecationHeader, id)
+				if msg := deprecation.Id(id).Message(); msg != "" {
+					w.Header().Add(warningHeader, fmt.Sprintf("299 - %q", msg))
+				}
+			}
+		}
+
 		// set http status codes based on metadata set by the grpc service
 		if statusCodes := md.HeaderMD.Get(StatusCodeHeader); len(statusCodes) > 0 {
 			defer func() {
@@ -5,10 +5,12 @@ package handlers
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/hashicorp/boundary/internal/deprecation"
 	emptypb "github.com/hashicorp/boundary/internal/gen/controller/api"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
@@ -70,3 +72,23 @@ func TestOutgoingResponseFilter_StatusCode(t *testing.T) {
 		})
 	}
 }
+
+func TestOutgoingResponseFilter_Deprecations(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	rec := httptest.NewRecorder()
+	// The gateway has already copied the header metadata into the response
+	rec.Header().Add(deprecationMetadataHeader, string(deprecation.AuthenticateTokenType))
+	rec.Header().Add(deprecationMetadataHeader, "unknown")
+	md := runtime.ServerMetadata{HeaderMD: metadata.MD{
+		DeprecationHeader: []string{string(deprecation.AuthenticateTokenType), "unknown"},
+		StatusCodeHeader:  []string{"204"},
+	}}
+	ctx := runtime.NewServerMetadataContext(context.Background(), md)
+	require.NoError(OutgoingResponseFilter(ctx, rec, &emptypb.EmptyResponse{}))
+
+	resp := rec.Result()
+	assert.Equal(http.StatusNoContent, resp.StatusCode)
+	assert.Empty(resp.Header.Values(deprecationMetadataHeader))
+	assert.Equal([]string{string(deprecation.AuthenticateTokenType), "unknown"}, resp.Header.Values(DeprecationHeader))
+	assert.Equal([]string{fmt.Sprintf("299 - %q", deprecation.AuthenticateTokenType.Message())}, resp.Header.Values(warningHeader))
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common/scopeids"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/deprecation"
 	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/iam"
@@ -43,6 +44,7 @@ var (
 		action.AddGrants,
 		action.SetGrants,
 		action.RemoveGrants,
+		action.Simulate,
 	}
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -160,11 +162,15 @@ func (s Service) GetRole(ctx context.Context, req *pbs.GetRoleRequest) (*pbs.Get
 	if err := validateGetRequest(req); err != nil {
 		return nil, err
 	}
-	authResults := s.authResult(ctx, req.GetId(), action.Read)
+	id, err := handlers.LookupId(ctx, req, s.lookupIdByName)
+	if err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, id, action.Read)
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	r, prs, rgs, err := s.getFromRepo(ctx, req.GetId())
+	r, prs, rgs, err := s.getFromRepo(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -404,6 +410,7 @@ func (s Service) AddRoleGrants(ctx context.Context, req *pbs.AddRoleGrantsReques
 	if err := validateAddRoleGrantsRequest(req); err != nil {
 		return nil, err
 	}
+	recordGrantDeprecations(ctx, req.GetGrantStrings())
 	authResults := s.authResult(ctx, req.GetId(), action.AddGrants)
 	if authResults.Error != nil {
 		return nil, authResults.Error
@@ -442,6 +449,7 @@ func (s Service) SetRoleGrants(ctx context.Context, req *pbs.SetRoleGrantsReques
 	if err := validateSetRoleGrantsRequest(req); err != nil {
 		return nil, err
 	}
+	recordGrantDeprecations(ctx, req.GetGrantStrings())
 	authResults := s.authResult(ctx, req.GetId(), action.SetGrants)
 	if authResults.Error != nil {
 		return nil, authResults.Error
@@ -511,6 +519,22 @@ func (s Service) RemoveRoleGrants(ctx context.Context, req *pbs.RemoveRoleGrants
 	return &pbs.RemoveRoleGrantsResponse{Item: item}, nil
 }
 
+// SimulateRole implements the interface pbs.RoleServiceServer.
+func (s Service) SimulateRole(ctx context.Context, req *pbs.SimulateRoleRequest) (*pbs.SimulateRoleResponse, error) {
+	if err := validateSimulateRoleRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Simulate)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	items, err := s.simulateInRepo(ctx, req.GetId(), strutil.RemoveDuplicates(req.GetGrantStrings(), false))
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.SimulateRoleResponse{Items: items}, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (*iam.Role, []*iam.PrincipalRole, []*iam.RoleGrant, error) {
 	repo, err := s.repoFn()
 	if err != nil {
@@ -541,6 +565,15 @@ func (s Service) createInRepo(ctx context.Context, scopeId string, item *pb.Role
 	if item.GetGrantScopeId() != nil {
 		opts = append(opts, iam.WithGrantScopeId(item.GetGrantScopeId().GetValue()))
 	}
+	if item.GetGrantBoundary().GetValue() {
+		opts = append(opts, iam.WithGrantBoundary(true))
+	}
+	if item.GetProjectTemplate().GetValue() {
+		opts = append(opts, iam.WithProjectTemplate(true))
+	}
+	if item.GetProjectTemplateSync().GetValue() {
+		opts = append(opts, iam.WithProjectTemplateSync(true))
+	}
 	u, err := iam.NewRole(scopeId, opts...)
 	if err != nil {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build role for creation: %v.", err)
@@ -571,6 +604,15 @@ func (s Service) updateInRepo(ctx context.Context, scopeId, id string, mask []st
 	if grantScopeId := item.GetGrantScopeId(); grantScopeId != nil {
 		opts = append(opts, iam.WithGrantScopeId(grantScopeId.GetValue()))
 	}
+	if grantBoundary := item.GetGrantBoundary(); grantBoundary != nil {
+		opts = append(opts, iam.WithGrantBoundary(grantBoundary.GetValue()))
+	}
+	if projectTemplate := item.GetProjectTemplate(); projectTemplate != nil {
+		opts = append(opts, iam.WithProjectTemplate(projectTemplate.GetValue()))
+	}
+	if projectTemplateSync := item.GetProjectTemplateSync(); projectTemplateSync != nil {
+		opts = append(opts, iam.WithProjectTemplateSync(projectTemplateSync.GetValue()))
+	}
 	version := item.GetVersion()
 
 	u, err := iam.NewRole(scopeId, opts...)
@@ -596,6 +638,71 @@ func (s Service) updateInRepo(ctx context.Context, scopeId, id string, mask []st
 	return out, pr, gr, nil
 }
 
+// simulateInRepo returns, for each user of the role, the permissions that the
+// grants would allow the user that the user's current grants do not.
+func (s Service) simulateInRepo(ctx context.Context, roleId string, grants []string) ([]*pb.SimulatedPermission, error) {
+	const op = "roles.(Service).simulateInRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	r, _, _, err := repo.LookupRole(ctx, roleId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if r == nil {
+		return nil, handlers.NotFoundErrorf("Role %q doesn't exist.", roleId)
+	}
+	userIds, err := repo.ListRoleUserIds(ctx, roleId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	var out []*pb.SimulatedPermission
+	for _, userId := range userIds {
+		tuples, err := repo.GrantsForUser(ctx, userId)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		current := make([]perms.Grant, 0, len(tuples))
+		for _, t := range tuples {
+			g, err := perms.Parse(t.ScopeId, t.Grant, perms.WithUserId(userId))
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed to parse grant %#v", t.Grant)))
+			}
+			current = append(current, g)
+		}
+		acl := perms.NewACL(current...)
+		for _, grant := range grants {
+			g, err := perms.Parse(r.GetGrantScopeId(), grant, perms.WithUserId(userId))
+			if err != nil {
+				return nil, handlers.InvalidArgumentErrorf("Errors in provided fields.", map[string]string{"grant_strings": fmt.Sprintf("Improperly formatted grant %q.", grant)})
+			}
+			acts := acl.NewlyAllowed(g, userId)
+			if len(acts) == 0 {
+				continue
+			}
+			typ := g.Type()
+			if typ == resource.Unknown {
+				typ = globals.ResourceTypeFromPrefix(g.Id())
+			}
+			act := make([]string, 0, len(acts))
+			for _, a := range acts {
+				act = append(act, a.String())
+			}
+			out = append(out, &pb.SimulatedPermission{
+				UserId:       userId,
+				Grant:        g.CanonicalString(),
+				ScopeId:      r.GetGrantScopeId(),
+				ResourceId:   g.Id(),
+				ResourceType: typ.String(),
+				Actions:      act,
+			})
+		}
+	}
+	return out, nil
+}
+
 func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 	const op = "roles.(Service).deleteFromRepo"
 	repo, err := s.repoFn()
@@ -695,6 +802,9 @@ func (s Service) addGrantsInRepo(ctx context.Context, roleId string, grants []st
 	}
 	_, err = repo.AddRoleGrants(ctx, roleId, version, strutil.RemoveDuplicates(grants, false))
 	if err != nil {
+		if errors.Match(errors.T(errors.Forbidden), err) {
+			return nil, nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.PermissionDenied, "Unable to add grants to role: %v.", err)
+		}
 		// TODO: Figure out a way to surface more helpful error info beyond the Internal error.
 		return nil, nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to add grants to role: %v.", err)
 	}
@@ -720,6 +830,9 @@ func (s Service) setGrantsInRepo(ctx context.Context, roleId string, grants []st
 	}
 	_, _, err = repo.SetRoleGrants(ctx, roleId, version, strutil.RemoveDuplicates(grants, false))
 	if err != nil {
+		if errors.Match(errors.T(errors.Forbidden), err) {
+			return nil, nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.PermissionDenied, "Unable to set grants on role: %v.", err)
+		}
 		// TODO: Figure out a way to surface more helpful error info beyond the Internal error.
 		return nil, nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to set grants on role: %v.", err)
 	}
@@ -754,6 +867,15 @@ func (s Service) removeGrantsInRepo(ctx context.Context, roleId string, grants [
 	return out, pr, roleGrants, nil
 }
 
+// lookupIdByName returns the ID of the role named name in the scope with scopeId.
+func (s Service) lookupIdByName(ctx context.Context, scopeId, name string) (string, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return "", err
+	}
+	return repo.LookupPublicIdByName(ctx, resource.Role, scopeId, name)
+}
+
 func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.VerifyResults {
 	res := auth.VerifyResults{}
 	repo, err := s.repoFn()
@@ -831,6 +953,18 @@ func toProto(ctx context.Context, in *iam.Role, principals []*iam.PrincipalRole,
 	if outputFields.Has(globals.GrantScopeIdField) && in.GetGrantScopeId() != "" {
 		out.GrantScopeId = &wrapperspb.StringValue{Value: in.GetGrantScopeId()}
 	}
+	if outputFields.Has(globals.GrantBoundaryField) && in.GetGrantBoundary() {
+		out.GrantBoundary = &wrapperspb.BoolValue{Value: true}
+	}
+	if outputFields.Has(globals.ProjectTemplateField) && in.GetProjectTemplate() {
+		out.ProjectTemplate = &wrapperspb.BoolValue{Value: true}
+	}
+	if outputFields.Has(globals.ProjectTemplateSyncField) && in.GetProjectTemplateSync() {
+		out.ProjectTemplateSync = &wrapperspb.BoolValue{Value: true}
+	}
+	if outputFields.Has(globals.TemplateRoleIdField) {
+		out.TemplateRoleId = in.GetTemplateRoleId()
+	}
 	if outputFields.Has(globals.PrincipalIdsField) {
 		for _, p := range principals {
 			out.PrincipalIds = append(out.PrincipalIds, p.GetPrincipalId())
@@ -886,7 +1020,7 @@ func toProto(ctx context.Context, in *iam.Role, principals []*iam.PrincipalRole,
 //   - All required parameters are set
 //   - There are no conflicting parameters provided
 func validateGetRequest(req *pbs.GetRoleRequest) error {
-	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.RolePrefix)
+	return handlers.ValidateNameLookupGetRequest(handlers.NoopValidatorFn, req, globals.RolePrefix)
 }
 
 func validateCreateRequest(req *pbs.CreateRoleRequest) error {
@@ -903,6 +1037,18 @@ func validateCreateRequest(req *pbs.CreateRoleRequest) error {
 				badFields["grant_scope_id"] = "When the role is in a project scope this value must be that project's scope ID."
 			}
 		}
+		if item.GetGrantBoundary().GetValue() && handlers.ValidId(handlers.Id(item.GetScopeId()), scope.Project.Prefix()) {
+			badFields[globals.GrantBoundaryField] = "Boundary roles cannot be created in a project scope."
+		}
+		if (item.GetProjectTemplate().GetValue() || item.GetProjectTemplateSync().GetValue()) && !handlers.ValidId(handlers.Id(item.GetScopeId()), scope.Org.Prefix()) {
+			badFields[globals.ProjectTemplateField] = "Project template roles can only be created in an org scope."
+		}
+		if item.GetProjectTemplateSync().GetValue() && !item.GetProjectTemplate().GetValue() {
+			badFields[globals.ProjectTemplateSyncField] = "This field can only be set on project template roles."
+		}
+		if item.GetTemplateRoleId() != "" {
+			badFields[globals.TemplateRoleIdField] = "This is a read only field."
+		}
 		if item.GetPrincipals() != nil {
 			badFields["principals"] = "This is a read only field."
 		}
@@ -928,6 +1074,9 @@ func validateUpdateRequest(req *pbs.UpdateRoleRequest) error {
 		if req.GetItem().GetGrantStrings() != nil {
 			badFields["grant_strings"] = "This is a read only field and cannot be specified in an update request."
 		}
+		if req.GetItem().GetTemplateRoleId() != "" {
+			badFields[globals.TemplateRoleIdField] = "This is a read only field and cannot be specified in an update request."
+		}
 		if req.GetItem().GetGrantScopeId() != nil && handlers.ValidId(handlers.Id(req.GetItem().GetScopeId()), scope.Project.Prefix()) {
 			if req.GetItem().GetGrantScopeId().GetValue() != req.GetItem().GetScopeId() {
 				badFields["grant_scope_id"] = "When the role is in a project scope this value must be that project's scope ID"
@@ -1073,6 +1222,16 @@ func validateAddRoleGrantsRequest(req *pbs.AddRoleGrantsRequest) error {
 	return nil
 }
 
+// recordGrantDeprecations records the use of deprecated grant formats in the
+// grants added to a role.
+func recordGrantDeprecations(ctx context.Context, grants []string) {
+	for _, g := range grants {
+		if perms.UsesLegacyTemplate(g) {
+			deprecation.Record(ctx, deprecation.LegacyGrantTemplate)
+		}
+	}
+}
+
 func validateSetRoleGrantsRequest(req *pbs.SetRoleGrantsRequest) error {
 	badFields := map[string]string{}
 	if !handlers.ValidId(handlers.Id(req.GetId()), globals.RolePrefix) {
@@ -1104,6 +1263,30 @@ func validateSetRoleGrantsRequest(req *pbs.SetRoleGrantsRequest) error {
 	return nil
 }
 
+func validateSimulateRoleRequest(req *pbs.SimulateRoleRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.RolePrefix) {
+		badFields["id"] = "Incorrectly formatted identifier."
+	}
+	if len(req.GetGrantStrings()) == 0 {
+		badFields["grant_strings"] = "Must be non-empty."
+	}
+	for _, v := range req.GetGrantStrings() {
+		if len(v) == 0 {
+			badFields["grant_strings"] = "Grant strings must not be empty."
+			break
+		}
+		if _, err := perms.Parse("p_anything", v); err != nil {
+			badFields["grant_strings"] = fmt.Sprintf("Improperly formatted grant %q.", v)
+			break
+		}
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
 func validateRemoveRoleGrantsRequest(req *pbs.RemoveRoleGrantsRequest) error {
 	badFields := map[string]string{}
 	if !handlers.ValidId(handlers.Id(req.GetId()), globals.RolePrefix) {
@@ -37,7 +37,7 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-var testAuthorizedActions = []string{"no-op", "read", "update", "delete", "add-principals", "set-principals", "remove-principals", "add-grants", "set-grants", "remove-grants"}
+var testAuthorizedActions = []string{"no-op", "read", "update", "delete", "add-principals", "set-principals", "remove-principals", "add-grants", "set-grants", "remove-grants", "simulate"}
 
 func createDefaultRolesAndRepo(t *testing.T) (*iam.Role, *iam.Role, func() (*iam.Repository, error)) {
 	t.Helper()
@@ -495,6 +495,81 @@ func TestCreate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Create a valid Boundary Role",
+			req: &pbs.CreateRoleRequest{Item: &pb.Role{
+				ScopeId:       defaultOrgRole.GetScopeId(),
+				Name:          &wrapperspb.StringValue{Value: "boundary"},
+				GrantBoundary: &wrapperspb.BoolValue{Value: true},
+			}},
+			res: &pbs.CreateRoleResponse{
+				Uri: fmt.Sprintf("roles/%s_", globals.RolePrefix),
+				Item: &pb.Role{
+					ScopeId:           defaultOrgRole.GetScopeId(),
+					Scope:             &scopes.ScopeInfo{Id: defaultOrgRole.GetScopeId(), Type: scope.Org.String(), ParentScopeId: scope.Global.String()},
+					Name:              &wrapperspb.StringValue{Value: "boundary"},
+					GrantScopeId:      &wrapperspb.StringValue{Value: defaultOrgRole.GetScopeId()},
+					GrantBoundary:     &wrapperspb.BoolValue{Value: true},
+					Version:           1,
+					AuthorizedActions: testAuthorizedActions,
+				},
+			},
+		},
+		{
+			name: "Boundary Role in a project",
+			req: &pbs.CreateRoleRequest{
+				Item: &pb.Role{
+					ScopeId:       defaultProjRole.GetScopeId(),
+					GrantBoundary: &wrapperspb.BoolValue{Value: true},
+				},
+			},
+			res: nil,
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Create a valid Project Template Role",
+			req: &pbs.CreateRoleRequest{Item: &pb.Role{
+				ScopeId:             defaultOrgRole.GetScopeId(),
+				Name:                &wrapperspb.StringValue{Value: "template"},
+				ProjectTemplate:     &wrapperspb.BoolValue{Value: true},
+				ProjectTemplateSync: &wrapperspb.BoolValue{Value: true},
+			}},
+			res: &pbs.CreateRoleResponse{
+				Uri: fmt.Sprintf("roles/%s_", globals.RolePrefix),
+				Item: &pb.Role{
+					ScopeId:             defaultOrgRole.GetScopeId(),
+					Scope:               &scopes.ScopeInfo{Id: defaultOrgRole.GetScopeId(), Type: scope.Org.String(), ParentScopeId: scope.Global.String()},
+					Name:                &wrapperspb.StringValue{Value: "template"},
+					GrantScopeId:        &wrapperspb.StringValue{Value: defaultOrgRole.GetScopeId()},
+					ProjectTemplate:     &wrapperspb.BoolValue{Value: true},
+					ProjectTemplateSync: &wrapperspb.BoolValue{Value: true},
+					Version:             1,
+					AuthorizedActions:   testAuthorizedActions,
+				},
+			},
+		},
+		{
+			name: "Project Template Role in a project",
+			req: &pbs.CreateRoleRequest{
+				Item: &pb.Role{
+					ScopeId:         defaultProjRole.GetScopeId(),
+					ProjectTemplate: &wrapperspb.BoolValue{Value: true},
+				},
+			},
+			res: nil,
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Project Template Sync without Project Template",
+			req: &pbs.CreateRoleRequest{
+				Item: &pb.Role{
+					ScopeId:             defaultOrgRole.GetScopeId(),
+					ProjectTemplateSync: &wrapperspb.BoolValue{Value: true},
+				},
+			},
+			res: nil,
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 		{
 			name: "Invalid grant scope ID",
 			req: &pbs.CreateRoleRequest{
@@ -2056,3 +2131,81 @@ func TestRemoveGrants(t *testing.T) {
 		})
 	}
 }
+
+func TestSimulate(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrap)
+	repoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+
+	s, err := roles.NewService(repoFn)
+	require.NoError(t, err, "Error when getting new role service.")
+
+	o, _ := iam.TestScopes(t, iamRepo)
+	u := iam.TestUser(t, iamRepo, o.GetPublicId())
+	role := iam.TestRole(t, conn, o.GetPublicId())
+	_ = iam.TestUserRole(t, conn, role.GetPublicId(), u.GetPublicId())
+	_ = iam.TestRoleGrant(t, conn, role.GetPublicId(), "id=*;type=host-catalog;actions=read")
+
+	t.Run("success", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := s.SimulateRole(auth.DisabledAuthTestContext(repoFn, o.GetPublicId()), &pbs.SimulateRoleRequest{
+			Id:           role.GetPublicId(),
+			GrantStrings: []string{"id=*;type=host-catalog;actions=read,update", "id=*;type=host-catalog;actions=read"},
+		})
+		require.NoError(err)
+		require.Len(got.GetItems(), 1)
+		item := got.GetItems()[0]
+		assert.Equal(u.GetPublicId(), item.GetUserId())
+		assert.Equal("id=*;type=host-catalog;actions=read,update", item.GetGrant())
+		assert.Equal(o.GetPublicId(), item.GetScopeId())
+		assert.Equal("*", item.GetResourceId())
+		assert.Equal("host-catalog", item.GetResourceType())
+		assert.Equal([]string{"update"}, item.GetActions())
+
+		// The role isn't changed
+		_, _, grants, err := iamRepo.LookupRole(context.Background(), role.GetPublicId())
+		require.NoError(err)
+		require.Len(grants, 1)
+	})
+
+	failCases := []struct {
+		name string
+		req  *pbs.SimulateRoleRequest
+		err  error
+	}{
+		{
+			name: "Bad Role Id",
+			req: &pbs.SimulateRoleRequest{
+				Id:           "bad id",
+				GrantStrings: []string{"id=*;type=*;actions=create"},
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "No grants",
+			req: &pbs.SimulateRoleRequest{
+				Id: role.GetPublicId(),
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Unparsable grant",
+			req: &pbs.SimulateRoleRequest{
+				Id:           role.GetPublicId(),
+				GrantStrings: []string{"id=*;type=*;actions=create", "unparseable"},
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range failCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			_, gErr := s.SimulateRole(auth.DisabledAuthTestContext(repoFn, o.GetPublicId()), tc.req)
+			require.Error(gErr)
+			assert.True(errors.Is(gErr, tc.err), "SimulateRole(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+		})
+	}
+}
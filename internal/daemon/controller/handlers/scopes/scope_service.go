@@ -31,6 +31,7 @@ import (
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/requests"
+	"github.com/hashicorp/boundary/internal/subscription"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/internal/types/scope"
@@ -52,6 +53,9 @@ var (
 		action.Read,
 		action.Update,
 		action.Delete,
+		action.ReadLoginMetadata,
+		action.SubscribeEvents,
+		action.ClearDeletionProtection,
 	}
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -63,6 +67,7 @@ var (
 		action.RotateScopeKeys,
 		action.ListScopeKeyVersionDestructionJobs,
 		action.DestroyScopeKeyVersion,
+		action.ListAuthorizedActions,
 	}
 
 	scopeCollectionTypeMapMap = map[string]map[resource.Type]action.ActionSet{
@@ -99,7 +104,7 @@ var (
 
 func init() {
 	var err error
-	if maskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&store.Scope{}}, handlers.MaskSource{&pb.Scope{}}); err != nil {
+	if maskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&store.Scope{}}, handlers.MaskSource{&pb.Scope{}, &pb.Annotations{}, &pb.LoginMetadata{}}); err != nil {
 		panic(err)
 	}
 }
@@ -110,12 +115,22 @@ type Service struct {
 
 	repoFn  common.IamRepoFactory
 	kmsRepo *kms.Kms
+
+	// subscriptionRepoFn is nil if event subscriptions are not available
+	subscriptionRepoFn common.EventSubscriptionRepoFactory
+	webhooks           bool
+
+	// the configured discovery defaults, either of which may be empty
+	defaultScopeId      string
+	defaultAuthMethodId string
 }
 
 var _ pbs.ScopeServiceServer = (*Service)(nil)
 
 // NewService returns a project service which handles project related requests to boundary.
-func NewService(ctx context.Context, repo common.IamRepoFactory, kmsRepo *kms.Kms) (Service, error) {
+// The WithEventSubscriptionRepo, WithEventSubscriptionWebhooks and
+// WithDiscoveryDefaults options are supported.
+func NewService(ctx context.Context, repo common.IamRepoFactory, kmsRepo *kms.Kms, opt ...handlers.Option) (Service, error) {
 	const op = "scopes.(Service).NewService"
 	if util.IsNil(repo) {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing iam repository")
@@ -123,7 +138,15 @@ func NewService(ctx context.Context, repo common.IamRepoFactory, kmsRepo *kms.Km
 	if kmsRepo == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing kms")
 	}
-	return Service{repoFn: repo, kmsRepo: kmsRepo}, nil
+	opts := handlers.GetOpts(opt...)
+	return Service{
+		repoFn:              repo,
+		kmsRepo:             kmsRepo,
+		subscriptionRepoFn:  opts.WithEventSubscriptionRepo,
+		webhooks:            opts.WithEventSubscriptionWebhooks,
+		defaultScopeId:      opts.WithDefaultScopeId,
+		defaultAuthMethodId: opts.WithDefaultAuthMethodId,
+	}, nil
 }
 
 // ListScopes implements the interface pbs.ScopeServiceServer.
@@ -220,11 +243,15 @@ func (s Service) GetScope(ctx context.Context, req *pbs.GetScopeRequest) (*pbs.G
 	if err := validateGetRequest(req); err != nil {
 		return nil, err
 	}
-	authResults := s.authResult(ctx, req.GetId(), action.Read)
+	id, err := handlers.LookupId(ctx, req, s.lookupIdByName)
+	if err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, id, action.Read)
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	p, err := s.getFromRepo(ctx, req.GetId())
+	p, err := s.getFromRepo(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -319,6 +346,10 @@ func (s Service) UpdateScope(ctx context.Context, req *pbs.UpdateScopeRequest) (
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
+	if handlers.ClearsDeletionProtection(req.GetUpdateMask().GetPaths(), req.GetItem().GetDeletionProtected()) &&
+		!authResults.FetchActionSetForId(ctx, req.GetId(), action.ActionSet{action.ClearDeletionProtection}).HasAction(action.ClearDeletionProtection) {
+		return nil, handlers.ForbiddenError()
+	}
 	p, err := s.updateInRepo(ctx, authResults.Scope, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
 	if err != nil {
 		return nil, err
@@ -405,7 +436,21 @@ func (s Service) ListKeys(ctx context.Context, req *pbs.ListKeysRequest) (*pbs.L
 			outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
 		}
 
-		protoItem, err := keyToProto(ctx, item, outputOpts...)
+		// Versions of the sessions key carry the public key verifying the
+		// session authorizations they signed
+		var publicKeys map[string][]byte
+		if string(item.Purpose) == kms.KeyPurposeSessions.String() && outputFields.Has(globals.KeyVersionsField) {
+			publicKeys = make(map[string][]byte, len(item.Versions))
+			for _, v := range item.Versions {
+				pk, err := s.kmsRepo.SessionAuthorizationPublicKey(ctx, req.GetId(), v.Id)
+				if err != nil {
+					return nil, err
+				}
+				publicKeys[v.Id] = pk
+			}
+		}
+
+		protoItem, err := keyToProto(ctx, item, publicKeys, outputOpts...)
 		if err != nil {
 			return nil, err
 		}
@@ -415,6 +460,105 @@ func (s Service) ListKeys(ctx context.Context, req *pbs.ListKeysRequest) (*pbs.L
 	return &pbs.ListKeysResponse{Items: finalItems}, nil
 }
 
+// ListAuthorizedActions implements the interface pbs.ScopeServiceServer.
+func (s Service) ListAuthorizedActions(ctx context.Context, req *pbs.ListAuthorizedActionsRequest) (*pbs.ListAuthorizedActionsResponse, error) {
+	if req.GetId() == "" {
+		req.Id = scope.Global.String()
+	}
+	if err := validateListAuthorizedActionsRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.ListAuthorizedActions)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+
+	authorized, err := auth.CalculateAuthorizedActions(ctx, authResults, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.ListAuthorizedActionsResponse{AuthorizedActions: authorized}, nil
+}
+
+// ReadLoginMetadata implements the interface pbs.ScopeServiceServer.
+func (s Service) ReadLoginMetadata(ctx context.Context, req *pbs.ReadLoginMetadataRequest) (*pbs.ReadLoginMetadataResponse, error) {
+	const op = "scope.(Service).ReadLoginMetadata"
+	if req.GetId() == "" {
+		req.Id = scope.Global.String()
+	}
+	if err := validateReadLoginMetadataRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.ReadLoginMetadata)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	scp, err := repo.LookupScope(ctx, req.GetId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if scp == nil {
+		return nil, handlers.NotFoundErrorf("Scope %q doesn't exist.", req.GetId())
+	}
+	item := toLoginMetadataProto(scp)
+	if item == nil {
+		item = &pb.LoginMetadata{}
+	}
+	return &pbs.ReadLoginMetadataResponse{Item: item}, nil
+}
+
+// DiscoverDefaults implements the interface pbs.ScopeServiceServer. The
+// suggested scope is the configured default scope, or the global scope if none
+// is configured or the configured one no longer exists. The suggested auth
+// method is the configured default auth method, or else the primary auth method
+// of the suggested scope, or else the primary auth method of the global scope.
+func (s Service) DiscoverDefaults(ctx context.Context, req *pbs.DiscoverDefaultsRequest) (*pbs.DiscoverDefaultsResponse, error) {
+	const op = "scope.(Service).DiscoverDefaults"
+	authResults := s.authResult(ctx, scope.Global.String(), action.ReadLoginMetadata)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	glob, err := repo.LookupScope(ctx, scope.Global.String())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if glob == nil {
+		return nil, handlers.NotFoundErrorf("Scope %q doesn't exist.", scope.Global.String())
+	}
+	scp := glob
+	if s.defaultScopeId != "" && s.defaultScopeId != scope.Global.String() {
+		org, err := repo.LookupScope(ctx, s.defaultScopeId)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if org != nil {
+			scp = org
+		}
+	}
+
+	authMethodId := s.defaultAuthMethodId
+	if authMethodId == "" {
+		authMethodId = scp.GetPrimaryAuthMethodId()
+	}
+	if authMethodId == "" {
+		authMethodId = glob.GetPrimaryAuthMethodId()
+	}
+	return &pbs.DiscoverDefaultsResponse{
+		ScopeId:      scp.GetPublicId(),
+		AuthMethodId: authMethodId,
+	}, nil
+}
+
 // RotateKeys implements the interface pbs.ScopeServiceServer.
 func (s Service) RotateKeys(ctx context.Context, req *pbs.RotateKeysRequest) (*pbs.RotateKeysResponse, error) {
 	if req.GetScopeId() == "" {
@@ -505,6 +649,106 @@ func (s Service) DestroyKeyVersion(ctx context.Context, req *pbs.DestroyKeyVersi
 	}, nil
 }
 
+// AddEventSubscription implements the interface pbs.ScopeServiceServer.
+func (s Service) AddEventSubscription(ctx context.Context, req *pbs.AddEventSubscriptionRequest) (*pbs.AddEventSubscriptionResponse, error) {
+	const op = "scopes.(Service).AddEventSubscription"
+	if req.GetScopeId() == "" {
+		req.ScopeId = scope.Global.String()
+	}
+	if err := validateAddEventSubscriptionRequest(req, s.webhooks); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetScopeId(), action.SubscribeEvents)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.subscriptionRepo()
+	if err != nil {
+		return nil, err
+	}
+
+	var opts []subscription.Option
+	if req.GetResourceId() != "" {
+		opts = append(opts, subscription.WithResourceId(req.GetResourceId()))
+	}
+	if req.GetType() != "" {
+		opts = append(opts, subscription.WithResourceType(resource.Map[req.GetType()]))
+	}
+	if req.GetWebhookUrl() != "" {
+		opts = append(opts, subscription.WithWebhookUrl(req.GetWebhookUrl()))
+	}
+	sub, err := subscription.NewSubscription(ctx, authResults.UserId, req.GetScopeId(), opts...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	sub, err = repo.CreateSubscription(ctx, sub)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &pbs.AddEventSubscriptionResponse{Item: eventSubscriptionToProto(sub)}, nil
+}
+
+// ListEventSubscriptions implements the interface pbs.ScopeServiceServer.
+func (s Service) ListEventSubscriptions(ctx context.Context, req *pbs.ListEventSubscriptionsRequest) (*pbs.ListEventSubscriptionsResponse, error) {
+	const op = "scopes.(Service).ListEventSubscriptions"
+	if req.GetScopeId() == "" {
+		req.ScopeId = scope.Global.String()
+	}
+	if err := validateListEventSubscriptionsRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetScopeId(), action.SubscribeEvents)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.subscriptionRepo()
+	if err != nil {
+		return nil, err
+	}
+	// Users can only see their own subscriptions.
+	subs, err := repo.ListSubscriptions(ctx, authResults.UserId, req.GetScopeId(), subscription.WithLimit(-1))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	items := make([]*pb.EventSubscription, 0, len(subs))
+	for _, sub := range subs {
+		items = append(items, eventSubscriptionToProto(sub))
+	}
+	return &pbs.ListEventSubscriptionsResponse{Items: items}, nil
+}
+
+// RemoveEventSubscription implements the interface pbs.ScopeServiceServer.
+func (s Service) RemoveEventSubscription(ctx context.Context, req *pbs.RemoveEventSubscriptionRequest) (*pbs.RemoveEventSubscriptionResponse, error) {
+	const op = "scopes.(Service).RemoveEventSubscription"
+	if req.GetScopeId() == "" {
+		req.ScopeId = scope.Global.String()
+	}
+	if err := validateRemoveEventSubscriptionRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetScopeId(), action.SubscribeEvents)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.subscriptionRepo()
+	if err != nil {
+		return nil, err
+	}
+	sub, err := repo.LookupSubscription(ctx, req.GetSubscriptionId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	// The subscriptions of other users are reported as not found so their
+	// existence is not disclosed.
+	if sub == nil || sub.UserId != authResults.UserId || sub.ScopeId != req.GetScopeId() {
+		return nil, handlers.NotFoundErrorf("Event subscription %q doesn't exist.", req.GetSubscriptionId())
+	}
+	if _, err := repo.DeleteSubscription(ctx, sub.PublicId); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return nil, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (*iam.Scope, error) {
 	repo, err := s.repoFn()
 	if err != nil {
@@ -530,6 +774,20 @@ func (s Service) createInRepo(ctx context.Context, authResults auth.VerifyResult
 	if item.GetDescription() != nil {
 		opts = append(opts, iam.WithDescription(item.GetDescription().GetValue()))
 	}
+	if item.GetAuthTokenTimeToLiveSeconds() != nil {
+		opts = append(opts, iam.WithAuthTokenTimeToLive(item.GetAuthTokenTimeToLiveSeconds().GetValue()))
+	}
+	if item.GetAuthTokenTimeToStaleSeconds() != nil {
+		opts = append(opts, iam.WithAuthTokenTimeToStale(item.GetAuthTokenTimeToStaleSeconds().GetValue()))
+	}
+	opts = append(opts, annotationOpts(item.GetAnnotations())...)
+	opts = append(opts, loginMetadataOpts(item.GetLoginMetadata())...)
+	if item.GetIsolated() != nil {
+		opts = append(opts, iam.WithIsolated(item.GetIsolated().GetValue()))
+	}
+	if item.GetDeletionProtected() != nil {
+		opts = append(opts, iam.WithDeletionProtected(item.GetDeletionProtected().GetValue()))
+	}
 	opts = append(opts, iam.WithSkipAdminRoleCreation(req.GetSkipAdminRoleCreation()))
 	opts = append(opts, iam.WithSkipDefaultRoleCreation(req.GetSkipDefaultRoleCreation()))
 
@@ -578,6 +836,20 @@ func (s Service) updateInRepo(ctx context.Context, parentScope *pb.ScopeInfo, sc
 		scopePrimaryAuthMethodId = primaryAuthMethodId.GetValue()
 		opts = append(opts, iam.WithPrimaryAuthMethodId(scopePrimaryAuthMethodId))
 	}
+	if ttl := item.GetAuthTokenTimeToLiveSeconds(); ttl != nil {
+		opts = append(opts, iam.WithAuthTokenTimeToLive(ttl.GetValue()))
+	}
+	if tts := item.GetAuthTokenTimeToStaleSeconds(); tts != nil {
+		opts = append(opts, iam.WithAuthTokenTimeToStale(tts.GetValue()))
+	}
+	opts = append(opts, annotationOpts(item.GetAnnotations())...)
+	opts = append(opts, loginMetadataOpts(item.GetLoginMetadata())...)
+	if item.GetIsolated() != nil {
+		opts = append(opts, iam.WithIsolated(item.GetIsolated().GetValue()))
+	}
+	if item.GetDeletionProtected() != nil {
+		opts = append(opts, iam.WithDeletionProtected(item.GetDeletionProtected().GetValue()))
+	}
 	version := item.GetVersion()
 
 	var iamScope *iam.Scope
@@ -592,6 +864,13 @@ func (s Service) updateInRepo(ctx context.Context, parentScope *pb.ScopeInfo, sc
 		iamScope.Description = scopeDesc
 		iamScope.Name = scopeName
 		iamScope.PrimaryAuthMethodId = scopePrimaryAuthMethodId
+		iamScope.AnnotationOwner = strings.TrimSpace(item.GetAnnotations().GetOwner().GetValue())
+		iamScope.AnnotationCostCenter = item.GetAnnotations().GetCostCenter().GetValue()
+		iamScope.AnnotationTicketUrl = item.GetAnnotations().GetTicketUrl().GetValue()
+		iamScope.LoginDisplayName = strings.TrimSpace(item.GetLoginMetadata().GetDisplayName().GetValue())
+		iamScope.LoginSupportContact = strings.TrimSpace(item.GetLoginMetadata().GetSupportContact().GetValue())
+		iamScope.LoginMessageOfTheDay = strings.TrimSpace(item.GetLoginMetadata().GetMessageOfTheDay().GetValue())
+		iamScope.DeletionProtected = item.GetDeletionProtected().GetValue()
 	case parentScope.GetType() == scope.Global.String():
 		iamScope, err = iam.NewOrg(opts...)
 	case parentScope.GetType() == scope.Org.String():
@@ -667,6 +946,15 @@ func (s Service) listFromRepo(ctx context.Context, scopeIds []string) ([]*iam.Sc
 	return scps, nil
 }
 
+// lookupIdByName returns the ID of the scope named name in the parent scope with scopeId.
+func (s Service) lookupIdByName(ctx context.Context, scopeId, name string) (string, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return "", err
+	}
+	return repo.LookupPublicIdByName(ctx, resource.Scope, scopeId, name)
+}
+
 func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.VerifyResults {
 	res := auth.VerifyResults{}
 	repo, err := s.repoFn()
@@ -678,7 +966,7 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 	var parentId string
 	opts := []auth.Option{auth.WithType(resource.Scope), auth.WithAction(a)}
 	switch a {
-	case action.List, action.Create, action.ListScopeKeys, action.ListScopeKeyVersionDestructionJobs, action.DestroyScopeKeyVersion:
+	case action.List, action.Create, action.ListScopeKeys, action.ListScopeKeyVersionDestructionJobs, action.DestroyScopeKeyVersion, action.ListAuthorizedActions:
 		parentId = id
 		s, err := repo.LookupScope(ctx, parentId)
 		if err != nil {
@@ -750,11 +1038,31 @@ func ToProto(ctx context.Context, in *iam.Scope, opt ...handlers.Option) (*pb.Sc
 	if outputFields.Has(globals.PrimaryAuthMethodIdField) && in.GetPrimaryAuthMethodId() != "" {
 		out.PrimaryAuthMethodId = &wrapperspb.StringValue{Value: in.GetPrimaryAuthMethodId()}
 	}
+	if outputFields.Has(globals.AuthTokenTimeToLiveSecondsField) && in.GetAuthTokenTimeToLiveSeconds() != 0 {
+		out.AuthTokenTimeToLiveSeconds = wrapperspb.UInt32(in.GetAuthTokenTimeToLiveSeconds())
+	}
+	if outputFields.Has(globals.AuthTokenTimeToStaleSecondsField) && in.GetAuthTokenTimeToStaleSeconds() != 0 {
+		out.AuthTokenTimeToStaleSeconds = wrapperspb.UInt32(in.GetAuthTokenTimeToStaleSeconds())
+	}
+	if outputFields.Has(globals.AnnotationsField) {
+		out.Annotations = handlers.ToAnnotationsProto(in.GetAnnotationOwner(), in.GetAnnotationCostCenter(), in.GetAnnotationTicketUrl())
+	}
+	if outputFields.Has(globals.LoginMetadataField) {
+		out.LoginMetadata = toLoginMetadataProto(in)
+	}
+	if outputFields.Has(globals.IsolatedField) && in.GetIsolated() {
+		out.Isolated = wrapperspb.Bool(true)
+	}
+	if outputFields.Has(globals.DeletionProtectedField) && in.GetDeletionProtected() {
+		out.DeletionProtected = wrapperspb.Bool(true)
+	}
 
 	return &out, nil
 }
 
-func keyToProto(ctx context.Context, in wrappingKms.Key, opt ...handlers.Option) (*pb.Key, error) {
+// keyToProto converts the key into its api representation. publicKeys are
+// the session authorization public keys of the key versions, by version id.
+func keyToProto(ctx context.Context, in wrappingKms.Key, publicKeys map[string][]byte, opt ...handlers.Option) (*pb.Key, error) {
 	opts := handlers.GetOpts(opt...)
 	if opts.WithOutputFields == nil {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "output fields not found when building key proto")
@@ -780,9 +1088,10 @@ func keyToProto(ctx context.Context, in wrappingKms.Key, opt ...handlers.Option)
 	if outputFields.Has(globals.KeyVersionsField) {
 		for _, keyVersion := range in.Versions {
 			out.Versions = append(out.Versions, &pb.KeyVersion{
-				Id:          keyVersion.Id,
-				Version:     uint32(keyVersion.Version),
-				CreatedTime: timestamppb.New(keyVersion.CreateTime),
+				Id:                            keyVersion.Id,
+				Version:                       uint32(keyVersion.Version),
+				CreatedTime:                   timestamppb.New(keyVersion.CreateTime),
+				SessionAuthorizationPublicKey: publicKeys[keyVersion.Id],
 			})
 		}
 	}
@@ -824,7 +1133,34 @@ func destructionJobToProto(ctx context.Context, in *kms.DataKeyVersionDestructio
 //   - The path passed in is correctly formatted
 //   - All required parameters are set
 //   - There are no conflicting parameters provided
+func (s Service) subscriptionRepo() (*subscription.Repository, error) {
+	if s.subscriptionRepoFn == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition, "Event subscriptions are not available.")
+	}
+	return s.subscriptionRepoFn()
+}
+
+func eventSubscriptionToProto(in *subscription.Subscription) *pb.EventSubscription {
+	out := &pb.EventSubscription{
+		Id:         in.PublicId,
+		ScopeId:    in.ScopeId,
+		UserId:     in.UserId,
+		ResourceId: in.ResourceId,
+		WebhookUrl: in.WebhookUrl,
+	}
+	if in.ResourceType != resource.Unknown {
+		out.Type = in.ResourceType.String()
+	}
+	if !in.CreateTime.IsZero() {
+		out.CreatedTime = timestamppb.New(in.CreateTime)
+	}
+	return out
+}
+
 func validateGetRequest(req *pbs.GetScopeRequest) error {
+	if req.GetName() != "" {
+		return handlers.ValidateNameLookupGetRequest(handlers.NoopValidatorFn, req)
+	}
 	badFields := map[string]string{}
 	id := req.GetId()
 	switch {
@@ -840,6 +1176,9 @@ func validateGetRequest(req *pbs.GetScopeRequest) error {
 	default:
 		badFields["id"] = "Invalidly formatted scope id."
 	}
+	if req.GetScopeId() != "" {
+		badFields["scope_id"] = "Scope ID provided when name was empty."
+	}
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
 	}
@@ -876,6 +1215,12 @@ func validateCreateRequest(req *pbs.CreateScopeRequest) error {
 	if item.GetVersion() != 0 {
 		badFields["version"] = "This cannot be specified at create time."
 	}
+	validateAuthTokenLifetimes(item, strings.EqualFold(scope.Global.String(), item.GetScopeId()), badFields)
+	if item.GetIsolated() != nil && !strings.EqualFold(scope.Global.String(), item.GetScopeId()) {
+		badFields[globals.IsolatedField] = "This can only be set on org scopes."
+	}
+	handlers.ValidateAnnotations(item.GetAnnotations(), badFields)
+	validateLoginMetadata(item.GetLoginMetadata(), badFields)
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
 	}
@@ -932,6 +1277,12 @@ func validateUpdateRequest(req *pbs.UpdateScopeRequest) error {
 	if item.GetPrimaryAuthMethodId().GetValue() != "" && !handlers.ValidId(handlers.Id(item.GetPrimaryAuthMethodId().GetValue()), globals.PasswordAuthMethodPrefix, globals.OidcAuthMethodPrefix, globals.LdapAuthMethodPrefix) {
 		badFields["primary_auth_method_id"] = "Improperly formatted identifier."
 	}
+	validateAuthTokenLifetimes(item, strings.HasPrefix(id, scope.Org.Prefix()), badFields)
+	if item.GetIsolated() != nil && !strings.HasPrefix(id, scope.Org.Prefix()) {
+		badFields[globals.IsolatedField] = "This can only be set on org scopes."
+	}
+	handlers.ValidateAnnotations(item.GetAnnotations(), badFields)
+	validateLoginMetadata(item.GetLoginMetadata(), badFields)
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
 	}
@@ -939,6 +1290,103 @@ func validateUpdateRequest(req *pbs.UpdateScopeRequest) error {
 	return nil
 }
 
+// validateAuthTokenLifetimes adds to badFields any problems with the auth
+// token lifetime overrides in item, which are only allowed on org scopes.
+func validateAuthTokenLifetimes(item *pb.Scope, isOrg bool, badFields map[string]string) {
+	for field, v := range map[string]*wrapperspb.UInt32Value{
+		globals.AuthTokenTimeToLiveSecondsField:  item.GetAuthTokenTimeToLiveSeconds(),
+		globals.AuthTokenTimeToStaleSecondsField: item.GetAuthTokenTimeToStaleSeconds(),
+	} {
+		switch {
+		case v == nil:
+		case !isOrg:
+			badFields[field] = "This can only be set on org scopes."
+		case v.GetValue() == 0:
+			badFields[field] = "This must be greater than zero."
+		}
+	}
+}
+
+// annotationOpts returns the options which set the annotations in a.
+func annotationOpts(a *pb.Annotations) []iam.Option {
+	var opts []iam.Option
+	if a.GetOwner() != nil {
+		opts = append(opts, iam.WithAnnotationOwner(strings.TrimSpace(a.GetOwner().GetValue())))
+	}
+	if a.GetCostCenter() != nil {
+		opts = append(opts, iam.WithAnnotationCostCenter(a.GetCostCenter().GetValue()))
+	}
+	if a.GetTicketUrl() != nil {
+		opts = append(opts, iam.WithAnnotationTicketUrl(a.GetTicketUrl().GetValue()))
+	}
+	return opts
+}
+
+// loginMetadataOpts returns the options which set the login metadata in m.
+func loginMetadataOpts(m *pb.LoginMetadata) []iam.Option {
+	var opts []iam.Option
+	if m.GetDisplayName() != nil {
+		opts = append(opts, iam.WithLoginDisplayName(strings.TrimSpace(m.GetDisplayName().GetValue())))
+	}
+	if m.GetSupportContact() != nil {
+		opts = append(opts, iam.WithLoginSupportContact(strings.TrimSpace(m.GetSupportContact().GetValue())))
+	}
+	if m.GetMessageOfTheDay() != nil {
+		opts = append(opts, iam.WithLoginMessageOfTheDay(strings.TrimSpace(m.GetMessageOfTheDay().GetValue())))
+	}
+	return opts
+}
+
+// toLoginMetadataProto returns the login metadata of in as a proto, or nil if
+// none of it is set.
+func toLoginMetadataProto(in *iam.Scope) *pb.LoginMetadata {
+	if in.GetLoginDisplayName() == "" && in.GetLoginSupportContact() == "" && in.GetLoginMessageOfTheDay() == "" {
+		return nil
+	}
+	out := &pb.LoginMetadata{}
+	if in.GetLoginDisplayName() != "" {
+		out.DisplayName = wrapperspb.String(in.GetLoginDisplayName())
+	}
+	if in.GetLoginSupportContact() != "" {
+		out.SupportContact = wrapperspb.String(in.GetLoginSupportContact())
+	}
+	if in.GetLoginMessageOfTheDay() != "" {
+		out.MessageOfTheDay = wrapperspb.String(in.GetLoginMessageOfTheDay())
+	}
+	return out
+}
+
+// validateLoginMetadata adds an entry to badFields for each set field of m
+// which is empty or too long. Fields which are not set are not validated.
+func validateLoginMetadata(m *pb.LoginMetadata, badFields map[string]string) {
+	if m == nil {
+		return
+	}
+	if m.GetDisplayName() != nil {
+		name := strings.TrimSpace(m.GetDisplayName().GetValue())
+		switch {
+		case name == "":
+			badFields["login_metadata.display_name"] = "This cannot be empty."
+		case len(name) >= 128:
+			badFields["login_metadata.display_name"] = "Must be less than 128 characters."
+		case !handlers.ValidNameDescription(name):
+			badFields["login_metadata.display_name"] = "Contains non-printable characters."
+		}
+	}
+	if m.GetSupportContact() != nil {
+		contact := strings.TrimSpace(m.GetSupportContact().GetValue())
+		switch {
+		case contact == "":
+			badFields["login_metadata.support_contact"] = "This cannot be empty."
+		case len(contact) >= 256:
+			badFields["login_metadata.support_contact"] = "Must be less than 256 characters."
+		}
+	}
+	if m.GetMessageOfTheDay() != nil && strings.TrimSpace(m.GetMessageOfTheDay().GetValue()) == "" {
+		badFields["login_metadata.message_of_the_day"] = "This cannot be empty."
+	}
+}
+
 func validateDeleteRequest(req *pbs.DeleteScopeRequest) error {
 	badFields := map[string]string{}
 	id := req.GetId()
@@ -987,6 +1435,28 @@ func validateListKeysRequest(req *pbs.ListKeysRequest) error {
 	return nil
 }
 
+func validateListAuthorizedActionsRequest(req *pbs.ListAuthorizedActionsRequest) error {
+	badFields := map[string]string{}
+	if req.GetId() != scope.Global.String() && !handlers.ValidId(handlers.Id(req.GetId()), scope.Org.Prefix()) && !handlers.ValidId(handlers.Id(req.GetId()), scope.Project.Prefix()) {
+		badFields["id"] = "Must be 'global', a valid org scope id or a valid project scope id when listing authorized actions."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
+func validateReadLoginMetadataRequest(req *pbs.ReadLoginMetadataRequest) error {
+	badFields := map[string]string{}
+	if req.GetId() != scope.Global.String() && !handlers.ValidId(handlers.Id(req.GetId()), scope.Org.Prefix()) && !handlers.ValidId(handlers.Id(req.GetId()), scope.Project.Prefix()) {
+		badFields["id"] = "Must be 'global', a valid org scope id or a valid project scope id when reading login metadata."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
 func validateRotateKeysRequest(req *pbs.RotateKeysRequest) error {
 	badFields := map[string]string{}
 	if req.GetScopeId() != scope.Global.String() && !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Org.Prefix()) && !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Project.Prefix()) {
@@ -1023,3 +1493,60 @@ func validateDestroyKeyVersionRequest(req *pbs.DestroyKeyVersionRequest) error {
 	}
 	return nil
 }
+
+func validateAddEventSubscriptionRequest(req *pbs.AddEventSubscriptionRequest, webhooks bool) error {
+	badFields := map[string]string{}
+	if req.GetScopeId() != scope.Global.String() && !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Org.Prefix()) && !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Project.Prefix()) {
+		badFields["scope_id"] = "Must be 'global', a valid org scope id or a valid project scope id when adding an event subscription."
+	}
+	switch {
+	case req.GetResourceId() == "" && req.GetType() == "":
+		badFields["resource_id"] = "One of resource_id and type must be set."
+	case req.GetResourceId() != "" && req.GetType() != "":
+		badFields["resource_id"] = "Only one of resource_id and type can be set."
+	case req.GetResourceId() != "":
+		if globals.ResourceTypeFromPrefix(req.GetResourceId()) == resource.Unknown {
+			badFields["resource_id"] = "Must be the ID of a resource."
+		}
+	default:
+		if t, ok := resource.Map[req.GetType()]; !ok || t == resource.Unknown || t == resource.All {
+			badFields["type"] = "Unknown resource type."
+		}
+	}
+	if req.GetWebhookUrl() != "" {
+		if !webhooks {
+			badFields["webhook_url"] = "Webhooks are not enabled on this controller."
+		} else if err := subscription.ValidateWebhookUrl(req.GetWebhookUrl()); err != nil {
+			badFields["webhook_url"] = fmt.Sprintf("Invalid webhook url: %v.", err)
+		}
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
+func validateListEventSubscriptionsRequest(req *pbs.ListEventSubscriptionsRequest) error {
+	badFields := map[string]string{}
+	if req.GetScopeId() != scope.Global.String() && !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Org.Prefix()) && !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Project.Prefix()) {
+		badFields["scope_id"] = "Must be 'global', a valid org scope id or a valid project scope id when listing event subscriptions."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
+func validateRemoveEventSubscriptionRequest(req *pbs.RemoveEventSubscriptionRequest) error {
+	badFields := map[string]string{}
+	if req.GetScopeId() != scope.Global.String() && !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Org.Prefix()) && !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Project.Prefix()) {
+		badFields["scope_id"] = "Must be 'global', a valid org scope id or a valid project scope id when removing an event subscription."
+	}
+	if !handlers.ValidId(handlers.Id(req.GetSubscriptionId()), subscription.Prefix) {
+		badFields["subscription_id"] = "Must be a valid event subscription ID."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
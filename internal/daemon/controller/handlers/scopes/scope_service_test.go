@@ -5,6 +5,7 @@ package scopes_test
 
 import (
 	"context"
+	"crypto/ed25519"
 	"errors"
 	"fmt"
 	"strings"
@@ -14,6 +15,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
+	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/daemon/controller"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
@@ -26,6 +28,7 @@ import (
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/server"
+	"github.com/hashicorp/boundary/internal/subscription"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
 	wrappingKms "github.com/hashicorp/go-kms-wrapping/extras/kms/v2"
@@ -40,7 +43,7 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
-var testAuthorizedActions = []string{"no-op", "read", "update", "delete"}
+var testAuthorizedActions = []string{"no-op", "read", "update", "delete", "read-login-metadata", "subscribe-events"}
 
 func createDefaultScopesRepoAndKms(t *testing.T) (*iam.Scope, *iam.Scope, func() (*iam.Repository, error), *kms.Kms) {
 	t.Helper()
@@ -102,6 +105,7 @@ var globalAuthorizedCollectionActions = map[string]*structpb.ListValue{
 			structpb.NewStringValue("rotate-keys"),
 			structpb.NewStringValue("list-key-version-destruction-jobs"),
 			structpb.NewStringValue("destroy-key-version"),
+			structpb.NewStringValue("list-authorized-actions"),
 		},
 	},
 	"users": {
@@ -153,6 +157,7 @@ var orgAuthorizedCollectionActions = map[string]*structpb.ListValue{
 			structpb.NewStringValue("rotate-keys"),
 			structpb.NewStringValue("list-key-version-destruction-jobs"),
 			structpb.NewStringValue("destroy-key-version"),
+			structpb.NewStringValue("list-authorized-actions"),
 		},
 	},
 	"users": {
@@ -199,6 +204,7 @@ var projectAuthorizedCollectionActions = map[string]*structpb.ListValue{
 			structpb.NewStringValue("rotate-keys"),
 			structpb.NewStringValue("list-key-version-destruction-jobs"),
 			structpb.NewStringValue("destroy-key-version"),
+			structpb.NewStringValue("list-authorized-actions"),
 		},
 	},
 	"targets": {
@@ -738,6 +744,155 @@ func TestCreate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "Create a valid Org with auth token lifetimes",
+			scopeId: scope.Global.String(),
+			req: &pbs.CreateScopeRequest{
+				Item: &pb.Scope{
+					ScopeId:                     scope.Global.String(),
+					Description:                 &wrapperspb.StringValue{Value: "desc"},
+					Type:                        scope.Org.String(),
+					AuthTokenTimeToLiveSeconds:  wrapperspb.UInt32(3600),
+					AuthTokenTimeToStaleSeconds: wrapperspb.UInt32(600),
+				},
+			},
+			res: &pbs.CreateScopeResponse{
+				Uri: "scopes/o_",
+				Item: &pb.Scope{
+					ScopeId:                     scope.Global.String(),
+					Scope:                       &pb.ScopeInfo{Id: scope.Global.String(), Type: scope.Global.String(), Name: scope.Global.String(), Description: "Global Scope"},
+					Description:                 &wrapperspb.StringValue{Value: "desc"},
+					Version:                     1,
+					Type:                        scope.Org.String(),
+					AuthTokenTimeToLiveSeconds:  wrapperspb.UInt32(3600),
+					AuthTokenTimeToStaleSeconds: wrapperspb.UInt32(600),
+					AuthorizedActions:           testAuthorizedActions,
+					AuthorizedCollectionActions: orgAuthorizedCollectionActions,
+				},
+			},
+		},
+		{
+			name:    "Create a valid Org with annotations",
+			scopeId: scope.Global.String(),
+			req: &pbs.CreateScopeRequest{
+				Item: &pb.Scope{
+					ScopeId: scope.Global.String(),
+					Type:    scope.Org.String(),
+					Annotations: &pb.Annotations{
+						Owner:      wrapperspb.String("Platform Team"),
+						CostCenter: wrapperspb.String("CC-1234"),
+						TicketUrl:  wrapperspb.String("https://tickets.example.com/OPS-1"),
+					},
+				},
+			},
+			res: &pbs.CreateScopeResponse{
+				Uri: "scopes/o_",
+				Item: &pb.Scope{
+					ScopeId: scope.Global.String(),
+					Scope:   &pb.ScopeInfo{Id: scope.Global.String(), Type: scope.Global.String(), Name: scope.Global.String(), Description: "Global Scope"},
+					Version: 1,
+					Type:    scope.Org.String(),
+					Annotations: &pb.Annotations{
+						Owner:      wrapperspb.String("Platform Team"),
+						CostCenter: wrapperspb.String("CC-1234"),
+						TicketUrl:  wrapperspb.String("https://tickets.example.com/OPS-1"),
+					},
+					AuthorizedActions:           testAuthorizedActions,
+					AuthorizedCollectionActions: orgAuthorizedCollectionActions,
+				},
+			},
+		},
+		{
+			name:    "Org with invalid cost center annotation",
+			scopeId: scope.Global.String(),
+			req: &pbs.CreateScopeRequest{
+				Item: &pb.Scope{
+					ScopeId:     scope.Global.String(),
+					Type:        scope.Org.String(),
+					Annotations: &pb.Annotations{CostCenter: wrapperspb.String("CC 1234")},
+				},
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name:    "Create a valid Org with login metadata",
+			scopeId: scope.Global.String(),
+			req: &pbs.CreateScopeRequest{
+				Item: &pb.Scope{
+					ScopeId: scope.Global.String(),
+					Type:    scope.Org.String(),
+					LoginMetadata: &pb.LoginMetadata{
+						DisplayName:     wrapperspb.String("  Engineering  "),
+						SupportContact:  wrapperspb.String("help@example.com"),
+						MessageOfTheDay: wrapperspb.String("Maintenance on Saturday"),
+					},
+				},
+			},
+			res: &pbs.CreateScopeResponse{
+				Uri: "scopes/o_",
+				Item: &pb.Scope{
+					ScopeId: scope.Global.String(),
+					Scope:   &pb.ScopeInfo{Id: scope.Global.String(), Type: scope.Global.String(), Name: scope.Global.String(), Description: "Global Scope"},
+					Version: 1,
+					Type:    scope.Org.String(),
+					LoginMetadata: &pb.LoginMetadata{
+						DisplayName:     wrapperspb.String("Engineering"),
+						SupportContact:  wrapperspb.String("help@example.com"),
+						MessageOfTheDay: wrapperspb.String("Maintenance on Saturday"),
+					},
+					AuthorizedActions:           testAuthorizedActions,
+					AuthorizedCollectionActions: orgAuthorizedCollectionActions,
+				},
+			},
+		},
+		{
+			name:    "Org with empty login message of the day",
+			scopeId: scope.Global.String(),
+			req: &pbs.CreateScopeRequest{
+				Item: &pb.Scope{
+					ScopeId:       scope.Global.String(),
+					Type:          scope.Org.String(),
+					LoginMetadata: &pb.LoginMetadata{MessageOfTheDay: wrapperspb.String("  ")},
+				},
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name:    "Project with auth token lifetimes",
+			scopeId: defaultOrg.GetPublicId(),
+			req: &pbs.CreateScopeRequest{
+				Item: &pb.Scope{
+					ScopeId:                    defaultOrg.GetPublicId(),
+					Type:                       scope.Project.String(),
+					AuthTokenTimeToLiveSeconds: wrapperspb.UInt32(3600),
+				},
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name:    "Isolated project",
+			scopeId: defaultOrg.GetPublicId(),
+			req: &pbs.CreateScopeRequest{
+				Item: &pb.Scope{
+					ScopeId:  defaultOrg.GetPublicId(),
+					Type:     scope.Project.String(),
+					Isolated: wrapperspb.Bool(true),
+				},
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name:    "Org with zero auth token time to stale",
+			scopeId: scope.Global.String(),
+			req: &pbs.CreateScopeRequest{
+				Item: &pb.Scope{
+					ScopeId:                     scope.Global.String(),
+					Type:                        scope.Org.String(),
+					AuthTokenTimeToStaleSeconds: wrapperspb.UInt32(0),
+				},
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 		{
 			name:    "Project with bad type specified",
 			scopeId: defaultOrg.GetPublicId(),
@@ -1796,10 +1951,336 @@ func TestListKeys(t *testing.T) {
 					// Sort by purpose for comparison since it is the only unique and predictable field
 					protocmp.SortRepeated(func(i, j *pb.Key) bool { return i.GetPurpose() < j.GetPurpose() }),
 					protocmp.IgnoreFields(&pb.Key{}, "id", "created_time"),
-					protocmp.IgnoreFields(&pb.KeyVersion{}, "id", "created_time"),
+					protocmp.IgnoreFields(&pb.KeyVersion{}, "id", "created_time", "session_authorization_public_key"),
 				),
 				"ListKeys(%q) got response\n%q, wanted\n%q", tt.req, got, tt.res,
 			)
+			for _, k := range got.GetItems() {
+				for _, v := range k.GetVersions() {
+					if k.GetPurpose() == "sessions" {
+						assert.Len(v.GetSessionAuthorizationPublicKey(), ed25519.PublicKeySize)
+					} else {
+						assert.Empty(v.GetSessionAuthorizationPublicKey())
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestListAuthorizedActions(t *testing.T) {
+	tc := controller.NewTestController(t, nil)
+	t.Cleanup(tc.Shutdown)
+
+	aToken := tc.Token()
+	uToken := tc.UnprivilegedToken()
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return tc.IamRepo(), nil
+	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return tc.ServersRepo(), nil
+	}
+	authTokenRepoFn := func() (*authtoken.Repository, error) {
+		return tc.AuthTokenRepo(), nil
+	}
+
+	privCtx := auth.NewVerifierContext(
+		context.Background(),
+		iamRepoFn,
+		authTokenRepoFn,
+		serversRepoFn,
+		tc.Kms(),
+		&authpb.RequestInfo{
+			PublicId:       aToken.Id,
+			EncryptedToken: strings.Split(aToken.Token, "_")[2],
+			TokenFormat:    uint32(auth.AuthTokenTypeBearer),
+		})
+
+	unprivCtx := auth.NewVerifierContext(
+		context.Background(),
+		iamRepoFn,
+		authTokenRepoFn,
+		serversRepoFn,
+		tc.Kms(),
+		&authpb.RequestInfo{
+			PublicId:       uToken.Id,
+			EncryptedToken: strings.Split(uToken.Token, "_")[2],
+			TokenFormat:    uint32(auth.AuthTokenTypeBearer),
+		})
+
+	_, proj := iam.TestScopes(t, tc.IamRepo())
+
+	// Add new role allowing the unprivileged user to read targets and list
+	// its authorized actions in the project
+	role := iam.TestRole(t, tc.DbConn(), proj.PublicId)
+	_, err := tc.IamRepo().AddRoleGrants(context.Background(), role.PublicId, 1, []string{
+		"type=scope;actions=list-authorized-actions",
+		"id=*;type=target;actions=read,authorize-session",
+		"type=target;actions=list",
+	})
+	require.NoError(t, err)
+	_, err = tc.IamRepo().AddPrincipalRoles(context.Background(), role.PublicId, 2, []string{uToken.UserId})
+	require.NoError(t, err)
+
+	listValue := func(acts ...any) *structpb.ListValue {
+		lv, err := structpb.NewList(acts)
+		require.NoError(t, err)
+		return lv
+	}
+
+	cases := []struct {
+		name    string
+		req     *pbs.ListAuthorizedActionsRequest
+		res     *pbs.ListAuthorizedActionsResponse
+		authCtx context.Context
+		err     error
+	}{
+		{
+			name: "List authorized actions in the global scope",
+			req:  &pbs.ListAuthorizedActionsRequest{Id: "global"},
+			res: &pbs.ListAuthorizedActionsResponse{
+				AuthorizedActions: map[string]*structpb.ListValue{
+					"*": listValue("*"),
+				},
+			},
+			authCtx: privCtx,
+		},
+		{
+			name: "List authorized actions in a project",
+			req:  &pbs.ListAuthorizedActionsRequest{Id: proj.GetPublicId()},
+			res: &pbs.ListAuthorizedActionsResponse{
+				AuthorizedActions: map[string]*structpb.ListValue{
+					"scope":  listValue("list-authorized-actions"),
+					"target": listValue("authorize-session", "list", "read"),
+				},
+			},
+			authCtx: unprivCtx,
+		},
+		{
+			name:    "List authorized actions in a non existing project",
+			req:     &pbs.ListAuthorizedActionsRequest{Id: "p_DoesntExist"},
+			err:     handlers.ApiErrorWithCode(codes.NotFound),
+			authCtx: privCtx,
+		},
+		{
+			name:    "Wrong id prefix",
+			req:     &pbs.ListAuthorizedActionsRequest{Id: "j_1234567890"},
+			err:     handlers.ApiErrorWithCode(codes.InvalidArgument),
+			authCtx: privCtx,
+		},
+		{
+			name:    "unauthorized",
+			req:     &pbs.ListAuthorizedActionsRequest{Id: "global"},
+			err:     handlers.ApiErrorWithCode(codes.PermissionDenied),
+			authCtx: unprivCtx,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			s, err := scopes.NewService(context.Background(), iamRepoFn, tc.Kms())
+			require.NoError(err, "Couldn't create new project service.")
+
+			got, gErr := s.ListAuthorizedActions(tt.authCtx, tt.req)
+			if tt.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tt.err), "ListAuthorizedActions(%+v) got error\n%v, wanted\n%v", tt.req, gErr, tt.err)
+			} else {
+				require.NoError(gErr)
+			}
+			assert.Empty(cmp.Diff(tt.res, got, protocmp.Transform()), "ListAuthorizedActions(%q) got response\n%q, wanted\n%q", tt.req, got, tt.res)
+		})
+	}
+}
+
+func TestReadLoginMetadata(t *testing.T) {
+	tc := controller.NewTestController(t, nil)
+	t.Cleanup(tc.Shutdown)
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return tc.IamRepo(), nil
+	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return tc.ServersRepo(), nil
+	}
+	authTokenRepoFn := func() (*authtoken.Repository, error) {
+		return tc.AuthTokenRepo(), nil
+	}
+
+	anonCtx := auth.NewVerifierContext(
+		context.Background(),
+		iamRepoFn,
+		authTokenRepoFn,
+		serversRepoFn,
+		tc.Kms(),
+		&authpb.RequestInfo{
+			TokenFormat: uint32(auth.AuthTokenTypeUnknown),
+		})
+
+	org := iam.TestOrg(t, tc.IamRepo(),
+		iam.WithLoginDisplayName("Engineering"),
+		iam.WithLoginSupportContact("help@example.com"),
+		iam.WithLoginMessageOfTheDay("Maintenance on Saturday"),
+	)
+	proj := iam.TestProject(t, tc.IamRepo(), org.GetPublicId())
+
+	// Without the default roles the anonymous user is not granted
+	// read-login-metadata on the project
+	noDefaultOrg := iam.TestOrg(t, tc.IamRepo(), iam.WithSkipDefaultRoleCreation(true))
+	noDefaultProj := iam.TestProject(t, tc.IamRepo(), noDefaultOrg.GetPublicId())
+
+	cases := []struct {
+		name string
+		req  *pbs.ReadLoginMetadataRequest
+		res  *pbs.ReadLoginMetadataResponse
+		err  error
+	}{
+		{
+			name: "Read login metadata of an org",
+			req:  &pbs.ReadLoginMetadataRequest{Id: org.GetPublicId()},
+			res: &pbs.ReadLoginMetadataResponse{
+				Item: &pb.LoginMetadata{
+					DisplayName:     wrapperspb.String("Engineering"),
+					SupportContact:  wrapperspb.String("help@example.com"),
+					MessageOfTheDay: wrapperspb.String("Maintenance on Saturday"),
+				},
+			},
+		},
+		{
+			name: "Read unset login metadata of the global scope",
+			req:  &pbs.ReadLoginMetadataRequest{},
+			res:  &pbs.ReadLoginMetadataResponse{Item: &pb.LoginMetadata{}},
+		},
+		{
+			name: "Read unset login metadata of a project",
+			req:  &pbs.ReadLoginMetadataRequest{Id: proj.GetPublicId()},
+			res:  &pbs.ReadLoginMetadataResponse{Item: &pb.LoginMetadata{}},
+		},
+		{
+			name: "Read login metadata of a non existing org",
+			req:  &pbs.ReadLoginMetadataRequest{Id: "o_DoesntExist"},
+			err:  handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name: "Wrong id prefix",
+			req:  &pbs.ReadLoginMetadataRequest{Id: "j_1234567890"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "unauthorized",
+			req:  &pbs.ReadLoginMetadataRequest{Id: noDefaultProj.GetPublicId()},
+			err:  handlers.ApiErrorWithCode(codes.PermissionDenied),
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			s, err := scopes.NewService(context.Background(), iamRepoFn, tc.Kms())
+			require.NoError(err, "Couldn't create new project service.")
+
+			got, gErr := s.ReadLoginMetadata(anonCtx, tt.req)
+			if tt.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tt.err), "ReadLoginMetadata(%+v) got error\n%v, wanted\n%v", tt.req, gErr, tt.err)
+			} else {
+				require.NoError(gErr)
+			}
+			assert.Empty(cmp.Diff(tt.res, got, protocmp.Transform()), "ReadLoginMetadata(%q) got response\n%q, wanted\n%q", tt.req, got, tt.res)
+		})
+	}
+}
+
+func TestDiscoverDefaults(t *testing.T) {
+	tc := controller.NewTestController(t, nil)
+	t.Cleanup(tc.Shutdown)
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return tc.IamRepo(), nil
+	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return tc.ServersRepo(), nil
+	}
+	authTokenRepoFn := func() (*authtoken.Repository, error) {
+		return tc.AuthTokenRepo(), nil
+	}
+
+	anonCtx := auth.NewVerifierContext(
+		context.Background(),
+		iamRepoFn,
+		authTokenRepoFn,
+		serversRepoFn,
+		tc.Kms(),
+		&authpb.RequestInfo{
+			TokenFormat: uint32(auth.AuthTokenTypeUnknown),
+		})
+
+	glob, err := tc.IamRepo().LookupScope(context.Background(), scope.Global.String())
+	require.NoError(t, err)
+	require.NotEmpty(t, glob.GetPrimaryAuthMethodId())
+
+	org := iam.TestOrg(t, tc.IamRepo())
+	orgAm := password.TestAuthMethod(t, tc.DbConn(), org.GetPublicId())
+	iam.TestSetPrimaryAuthMethod(t, tc.IamRepo(), org, orgAm.GetPublicId())
+	noPrimaryOrg := iam.TestOrg(t, tc.IamRepo())
+
+	cases := []struct {
+		name string
+		opts []handlers.Option
+		res  *pbs.DiscoverDefaultsResponse
+	}{
+		{
+			name: "Nothing configured",
+			res: &pbs.DiscoverDefaultsResponse{
+				ScopeId:      scope.Global.String(),
+				AuthMethodId: glob.GetPrimaryAuthMethodId(),
+			},
+		},
+		{
+			name: "Org with a primary auth method",
+			opts: []handlers.Option{handlers.WithDiscoveryDefaults(org.GetPublicId(), "")},
+			res: &pbs.DiscoverDefaultsResponse{
+				ScopeId:      org.GetPublicId(),
+				AuthMethodId: orgAm.GetPublicId(),
+			},
+		},
+		{
+			name: "Org without a primary auth method",
+			opts: []handlers.Option{handlers.WithDiscoveryDefaults(noPrimaryOrg.GetPublicId(), "")},
+			res: &pbs.DiscoverDefaultsResponse{
+				ScopeId:      noPrimaryOrg.GetPublicId(),
+				AuthMethodId: glob.GetPrimaryAuthMethodId(),
+			},
+		},
+		{
+			name: "Non existing org",
+			opts: []handlers.Option{handlers.WithDiscoveryDefaults("o_DoesntExist", "")},
+			res: &pbs.DiscoverDefaultsResponse{
+				ScopeId:      scope.Global.String(),
+				AuthMethodId: glob.GetPrimaryAuthMethodId(),
+			},
+		},
+		{
+			name: "Configured auth method",
+			opts: []handlers.Option{handlers.WithDiscoveryDefaults(org.GetPublicId(), "ampw_1234567890")},
+			res: &pbs.DiscoverDefaultsResponse{
+				ScopeId:      org.GetPublicId(),
+				AuthMethodId: "ampw_1234567890",
+			},
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			s, err := scopes.NewService(context.Background(), iamRepoFn, tc.Kms(), tt.opts...)
+			require.NoError(err, "Couldn't create new project service.")
+
+			got, gErr := s.DiscoverDefaults(anonCtx, &pbs.DiscoverDefaultsRequest{})
+			require.NoError(gErr)
+			assert.Empty(cmp.Diff(tt.res, got, protocmp.Transform()), "DiscoverDefaults() got response\n%q, wanted\n%q", got, tt.res)
 		})
 	}
 }
@@ -2435,3 +2916,141 @@ func TestDestroyKeyVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestEventSubscriptions(t *testing.T) {
+	tc := controller.NewTestController(t, nil)
+	t.Cleanup(tc.Shutdown)
+
+	aToken := tc.Token()
+	uToken := tc.UnprivilegedToken()
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return tc.IamRepo(), nil
+	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return tc.ServersRepo(), nil
+	}
+	authTokenRepoFn := func() (*authtoken.Repository, error) {
+		return tc.AuthTokenRepo(), nil
+	}
+	rw := db.New(tc.DbConn())
+	subscriptionRepoFn := func() (*subscription.Repository, error) {
+		return subscription.NewRepository(context.Background(), rw, rw)
+	}
+
+	privCtx := auth.NewVerifierContext(
+		context.Background(),
+		iamRepoFn,
+		authTokenRepoFn,
+		serversRepoFn,
+		tc.Kms(),
+		&authpb.RequestInfo{
+			PublicId:       aToken.Id,
+			EncryptedToken: strings.Split(aToken.Token, "_")[2],
+			TokenFormat:    uint32(auth.AuthTokenTypeBearer),
+		})
+
+	unprivCtx := auth.NewVerifierContext(
+		context.Background(),
+		iamRepoFn,
+		authTokenRepoFn,
+		serversRepoFn,
+		tc.Kms(),
+		&authpb.RequestInfo{
+			PublicId:       uToken.Id,
+			EncryptedToken: strings.Split(uToken.Token, "_")[2],
+			TokenFormat:    uint32(auth.AuthTokenTypeBearer),
+		})
+
+	_, proj := iam.TestScopes(t, tc.IamRepo(), iam.WithUserId(aToken.UserId))
+
+	s, err := scopes.NewService(context.Background(), iamRepoFn, tc.Kms(),
+		handlers.WithEventSubscriptionRepo(subscriptionRepoFn))
+	require.NoError(t, err)
+
+	t.Run("add", func(t *testing.T) {
+		cases := []struct {
+			name    string
+			req     *pbs.AddEventSubscriptionRequest
+			authCtx context.Context
+			err     error
+		}{
+			{
+				name:    "by id",
+				req:     &pbs.AddEventSubscriptionRequest{ScopeId: proj.GetPublicId(), ResourceId: "ttcp_1234567890"},
+				authCtx: privCtx,
+			},
+			{
+				name:    "by type",
+				req:     &pbs.AddEventSubscriptionRequest{ScopeId: proj.GetPublicId(), Type: "target"},
+				authCtx: privCtx,
+			},
+			{
+				name:    "unauthorized",
+				req:     &pbs.AddEventSubscriptionRequest{ScopeId: proj.GetPublicId(), Type: "target"},
+				authCtx: unprivCtx,
+				err:     handlers.ApiErrorWithCode(codes.PermissionDenied),
+			},
+			{
+				name:    "id and type",
+				req:     &pbs.AddEventSubscriptionRequest{ScopeId: proj.GetPublicId(), ResourceId: "ttcp_1234567890", Type: "target"},
+				authCtx: privCtx,
+				err:     handlers.ApiErrorWithCode(codes.InvalidArgument),
+			},
+			{
+				name:    "unknown type",
+				req:     &pbs.AddEventSubscriptionRequest{ScopeId: proj.GetPublicId(), Type: "*"},
+				authCtx: privCtx,
+				err:     handlers.ApiErrorWithCode(codes.InvalidArgument),
+			},
+			{
+				name:    "webhooks disabled",
+				req:     &pbs.AddEventSubscriptionRequest{ScopeId: proj.GetPublicId(), Type: "target", WebhookUrl: "https://example.com/hook"},
+				authCtx: privCtx,
+				err:     handlers.ApiErrorWithCode(codes.InvalidArgument),
+			},
+		}
+		for _, tt := range cases {
+			t.Run(tt.name, func(t *testing.T) {
+				assert, require := assert.New(t), require.New(t)
+				got, err := s.AddEventSubscription(tt.authCtx, tt.req)
+				if tt.err != nil {
+					require.Error(err)
+					assert.True(errors.Is(err, tt.err), "AddEventSubscription(%+v) got error\n%v, wanted\n%v", tt.req, err, tt.err)
+					return
+				}
+				require.NoError(err)
+				assert.True(strings.HasPrefix(got.GetItem().GetId(), subscription.Prefix+"_"))
+				assert.Equal(aToken.UserId, got.GetItem().GetUserId())
+				assert.Equal(tt.req.GetResourceId(), got.GetItem().GetResourceId())
+				assert.Equal(tt.req.GetType(), got.GetItem().GetType())
+			})
+		}
+	})
+
+	t.Run("list and remove", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		list, err := s.ListEventSubscriptions(privCtx, &pbs.ListEventSubscriptionsRequest{ScopeId: proj.GetPublicId()})
+		require.NoError(err)
+		require.Len(list.GetItems(), 2)
+
+		_, err = s.RemoveEventSubscription(unprivCtx, &pbs.RemoveEventSubscriptionRequest{ScopeId: proj.GetPublicId(), SubscriptionId: list.GetItems()[0].GetId()})
+		assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.PermissionDenied)))
+
+		_, err = s.RemoveEventSubscription(privCtx, &pbs.RemoveEventSubscriptionRequest{ScopeId: proj.GetPublicId(), SubscriptionId: list.GetItems()[0].GetId()})
+		require.NoError(err)
+		_, err = s.RemoveEventSubscription(privCtx, &pbs.RemoveEventSubscriptionRequest{ScopeId: proj.GetPublicId(), SubscriptionId: list.GetItems()[0].GetId()})
+		assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.NotFound)))
+
+		list, err = s.ListEventSubscriptions(privCtx, &pbs.ListEventSubscriptionsRequest{ScopeId: proj.GetPublicId()})
+		require.NoError(err)
+		assert.Len(list.GetItems(), 1)
+	})
+
+	t.Run("not available", func(t *testing.T) {
+		s, err := scopes.NewService(context.Background(), iamRepoFn, tc.Kms())
+		require.NoError(t, err)
+		_, err = s.ListEventSubscriptions(privCtx, &pbs.ListEventSubscriptionsRequest{ScopeId: proj.GetPublicId()})
+		assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.FailedPrecondition)))
+	})
+}
@@ -292,6 +292,142 @@ func (s Service) CancelSession(ctx context.Context, req *pbs.CancelSessionReques
 	return &pbs.CancelSessionResponse{Item: item}, nil
 }
 
+// UploadSessionTranscript implements the interface pbs.SessionServiceServer.
+func (s Service) UploadSessionTranscript(ctx context.Context, req *pbs.UploadSessionTranscriptRequest) (*pbs.UploadSessionTranscriptResponse, error) {
+	const op = "sessions.(Service).UploadSessionTranscript"
+
+	if err := validateUploadTranscriptRequest(req); err != nil {
+		return nil, err
+	}
+	// The transcript is captured by the client of the session, so only the
+	// user of the session can upload it.
+	authResults := s.authResult(ctx, req.GetId(), action.ReadSelf, false)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	ses, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if ses.UserId != authResults.UserId {
+		return nil, handlers.ForbiddenError()
+	}
+
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := repo.AddClientTranscript(ctx, req.GetId(), req.GetTranscript(), session.WithTranscriptTruncated(req.GetTruncated())); err != nil {
+		if errors.Match(errors.T(errors.NotUnique), err) {
+			return nil, handlers.ConflictErrorf("The transcript of the session has already been uploaded.")
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to add transcript"))
+	}
+	return &pbs.UploadSessionTranscriptResponse{}, nil
+}
+
+// GetSessionTranscript implements the interface pbs.SessionServiceServer.
+func (s Service) GetSessionTranscript(ctx context.Context, req *pbs.GetSessionTranscriptRequest) (*pbs.GetSessionTranscriptResponse, error) {
+	const op = "sessions.(Service).GetSessionTranscript"
+
+	if err := validateGetTranscriptRequest(req); err != nil {
+		return nil, err
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	// Transcripts are kept after their session is deleted, so they are
+	// authorized against the project stored with the transcript.
+	t, err := repo.LookupClientTranscript(ctx, req.GetId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if t == nil {
+		return nil, handlers.NotFoundErrorf("Session %q has no transcript.", req.GetId())
+	}
+	authResults := auth.Verify(ctx,
+		auth.WithType(resource.Session),
+		auth.WithAction(action.Read),
+		auth.WithId(req.GetId()),
+		auth.WithScopeId(t.ProjectId))
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+
+	return &pbs.GetSessionTranscriptResponse{
+		Transcript:   t.Transcript,
+		Truncated:    t.Truncated,
+		UploadedTime: t.CreateTime.GetTimestamp(),
+	}, nil
+}
+
+// ListMySessions implements the interface pbs.SessionServiceServer.
+func (s Service) ListMySessions(ctx context.Context, req *pbs.ListMySessionsRequest) (*pbs.ListMySessionsResponse, error) {
+	const op = "sessions.(Service).ListMySessions"
+
+	if err := validateListMySessionsRequest(req); err != nil {
+		return nil, err
+	}
+
+	authResults := s.authResult(ctx, req.GetScopeId(), action.List, false)
+	if authResults.Error != nil {
+		// As with ListSessions, keep going for recursive requests as we may
+		// have authorization on downstream scopes.
+		if (authResults.Error == handlers.ForbiddenError() || authResults.Error == handlers.UnauthenticatedError()) &&
+			req.GetRecursive() &&
+			authResults.AuthenticationFinished {
+		} else {
+			return nil, authResults.Error
+		}
+	}
+	// The history only contains the sessions of the requesting user, so it
+	// requires an authenticated user.
+	if authResults.UserId == "" || authResults.UserId == globals.AnonymousUserId {
+		return nil, handlers.UnauthenticatedError()
+	}
+
+	var scopeIds map[string]*scopes.ScopeInfo
+	var err error
+	if !req.GetRecursive() {
+		scopeIds = map[string]*scopes.ScopeInfo{authResults.Scope.Id: authResults.Scope}
+	} else {
+		scopeIds, err = authResults.ScopesAuthorizedForList(ctx, req.GetScopeId(), resource.Session)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	projectIds := make([]string, 0, len(scopeIds))
+	for id := range scopeIds {
+		projectIds = append(projectIds, id)
+	}
+
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	history, err := repo.ListSessionHistory(ctx, authResults.UserId, projectIds)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(history) == 0 {
+		return &pbs.ListMySessionsResponse{}, nil
+	}
+
+	filter, err := handlers.NewFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	finalItems := make([]*pb.SessionHistory, 0, len(history))
+	for _, h := range history {
+		item := toHistoryProto(h, scopeIds[h.ProjectId])
+		if filter.Match(item) {
+			finalItems = append(finalItems, item)
+		}
+	}
+	return &pbs.ListMySessionsResponse{Items: finalItems}, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (*session.Session, error) {
 	repo, err := s.repoFn()
 	if err != nil {
@@ -413,6 +549,12 @@ func toProto(ctx context.Context, in *session.Session, opt ...handlers.Option) (
 	if outputFields.Has(globals.TerminationReasonField) {
 		out.TerminationReason = in.TerminationReason
 	}
+	if outputFields.Has(globals.BannerField) {
+		out.Banner = in.Banner
+	}
+	if outputFields.Has(globals.BannerAcknowledgedTimeField) {
+		out.BannerAcknowledgedTime = in.BannerAcknowledgedTime.GetTimestamp()
+	}
 	if outputFields.Has(globals.ScopeField) {
 		out.Scope = opts.WithScope
 	}
@@ -466,6 +608,34 @@ func toProto(ctx context.Context, in *session.Session, opt ...handlers.Option) (
 //   - The path passed in is correctly formatted
 //   - All required parameters are set
 //   - There are no conflicting parameters provided
+func toHistoryProto(in *session.History, scp *scopes.ScopeInfo) *pb.SessionHistory {
+	out := &pb.SessionHistory{
+		Id:                in.SessionId,
+		TargetId:          in.TargetId,
+		Scope:             scp,
+		ScopeId:           in.ProjectId,
+		CreatedTime:       in.StartTime.GetTimestamp(),
+		TerminatedTime:    in.EndTime.GetTimestamp(),
+		DurationSeconds:   uint32(in.Duration().Seconds()),
+		TerminationReason: in.TerminationReason,
+		ConnectionCount:   in.ConnectionCount,
+		BytesUp:           in.BytesUp,
+		BytesDown:         in.BytesDown,
+	}
+	for _, c := range in.Connections {
+		out.Connections = append(out.Connections, &pb.ConnectionHistory{
+			Id:              c.ConnectionId,
+			ConnectedTime:   c.ConnectedTime.GetTimestamp(),
+			ClosedTime:      c.ClosedTime.GetTimestamp(),
+			DurationSeconds: uint32(c.Duration().Seconds()),
+			BytesUp:         c.BytesUp,
+			BytesDown:       c.BytesDown,
+			ClosedReason:    c.ClosedReason,
+		})
+	}
+	return out
+}
+
 func validateGetRequest(req *pbs.GetSessionRequest) error {
 	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.SessionPrefix)
 }
@@ -485,6 +655,21 @@ func validateListRequest(req *pbs.ListSessionsRequest) error {
 	return nil
 }
 
+func validateListMySessionsRequest(req *pbs.ListMySessionsRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Project.Prefix()) &&
+		!req.GetRecursive() {
+		badFields["scope_id"] = "This field must be a valid project scope ID or the list operation must be recursive."
+	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
+	}
+	return nil
+}
+
 func validateCancelRequest(req *pbs.CancelSessionRequest) error {
 	badFields := map[string]string{}
 	if !handlers.ValidId(handlers.Id(req.GetId()), globals.SessionPrefix) {
@@ -498,3 +683,31 @@ func validateCancelRequest(req *pbs.CancelSessionRequest) error {
 	}
 	return nil
 }
+
+func validateUploadTranscriptRequest(req *pbs.UploadSessionTranscriptRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.SessionPrefix) {
+		badFields["id"] = "Improperly formatted identifier."
+	}
+	switch {
+	case len(req.GetTranscript()) == 0:
+		badFields["transcript"] = "Required field."
+	case len(req.GetTranscript()) > session.MaxClientTranscriptSize:
+		badFields["transcript"] = fmt.Sprintf("Must not exceed %d bytes.", session.MaxClientTranscriptSize)
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Invalid fields provided in request.", badFields)
+	}
+	return nil
+}
+
+func validateGetTranscriptRequest(req *pbs.GetSessionTranscriptRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.SessionPrefix) {
+		badFields["id"] = "Improperly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
+	}
+	return nil
+}
@@ -68,6 +68,27 @@ func dynamicToWorkerCredential(ctx context.Context, cred credential.Dynamic) (se
 	return data, nil
 }
 
+// sshCertificateToWorkerCredential converts a client certificate issued by
+// the SSH certificate authority of a target into a session.Credential
+// suitable for passing to a Boundary worker.
+func sshCertificateToWorkerCredential(ctx context.Context, username string, privateKey, certificate []byte) (session.Credential, error) {
+	const op = "targets.sshCertificateToWorkerCredential"
+	workerCred := &serverpb.Credential{
+		Credential: &serverpb.Credential_SshCertificate{
+			SshCertificate: &serverpb.SshCertificate{
+				Username:    username,
+				PrivateKey:  string(privateKey),
+				Certificate: string(certificate),
+			},
+		},
+	}
+	data, err := proto.Marshal(workerCred)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("marshalling ssh certificate to proto"))
+	}
+	return data, nil
+}
+
 func dynamicToSessionCredential(ctx context.Context, cred credential.Dynamic) (*pb.SessionCredential, error) {
 	const op = "targets.dynamicToSessionCredential"
 	l := cred.Library()
@@ -125,11 +146,39 @@ func dynamicToSessionCredential(ctx context.Context, cred credential.Dynamic) (*
 				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("creating proto struct for credential"))
 			}
 
+		case credential.X509Certificate:
+			credData, err = handlers.ProtoToStruct(
+				&pb.X509CertificateCredential{
+					Certificate: string(c.Certificate()),
+					PrivateKey:  string(c.PrivateKey()),
+				},
+			)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("creating proto struct for credential"))
+			}
+
 		default:
 			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported credential %T", c))
 		}
 	}
 
+	var secretMetadata *structpb.Struct
+	if c, ok := cred.(credential.SecretMetadata); ok && len(c.SecretMetadata()) > 0 {
+		// Re-decode for the same reason as the secret above.
+		jMetadata, err := json.Marshal(c.SecretMetadata())
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("marshalling secret metadata to json"))
+		}
+		var dMetadata map[string]any
+		if err := json.Unmarshal(jMetadata, &dMetadata); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("decoding secret metadata json for proto marshaling"))
+		}
+		secretMetadata, err = structpb.NewStruct(dMetadata)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("creating proto struct for secret metadata"))
+		}
+	}
+
 	return &pb.SessionCredential{
 		CredentialSource: &pb.CredentialSource{
 			Id:                l.GetPublicId(),
@@ -143,7 +192,8 @@ func dynamicToSessionCredential(ctx context.Context, cred credential.Dynamic) (*
 			Raw:     base64.StdEncoding.EncodeToString(jSecret),
 			Decoded: sSecret,
 		},
-		Credential: credData,
+		Credential:     credData,
+		SecretMetadata: secretMetadata,
 	}, nil
 }
 
@@ -230,6 +280,23 @@ func staticToSessionCredential(ctx context.Context, cred credential.Static) (*pb
 			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("creating proto struct for ssh private key credential"))
 		}
 
+	case *credstatic.X509CertificateCredential:
+		var err error
+		credType = string(credential.X509CertificateType)
+		credData, err = handlers.ProtoToStruct(
+			&pb.X509CertificateCredential{
+				Certificate: string(c.GetCertificate()),
+				PrivateKey:  string(c.GetPrivateKey()),
+			},
+		)
+		secret = map[string]any{
+			"certificate": string(c.GetCertificate()),
+			"private_key": string(c.GetPrivateKey()),
+		}
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("creating proto struct for x509 certificate credential"))
+		}
+
 	case *credstatic.JsonCredential:
 		var err error
 		credType = string(credential.JsonType)
@@ -5,11 +5,13 @@ package targets
 
 import (
 	"context"
+	"crypto/ed25519"
 	stderrors "errors"
 	"fmt"
 	"math/rand"
 	"net"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 	"sync/atomic"
@@ -17,9 +19,11 @@ import (
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/azure"
 	wl "github.com/hashicorp/boundary/internal/daemon/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
+	"github.com/hashicorp/boundary/internal/daemon/controller/common/authzcache"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -28,6 +32,7 @@ import (
 	"github.com/hashicorp/boundary/internal/host/plugin"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/observability/event"
 	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/requests"
 	"github.com/hashicorp/boundary/internal/server"
@@ -37,14 +42,19 @@ import (
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	"github.com/hashicorp/boundary/internal/types/subtypes"
+	"github.com/hashicorp/boundary/internal/util"
+	"github.com/hashicorp/boundary/internal/util/template"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
 	fm "github.com/hashicorp/boundary/version"
 	"github.com/hashicorp/go-bexpr"
 	"github.com/hashicorp/go-secure-stdlib/strutil"
 	"github.com/mr-tron/base58"
+	"golang.org/x/crypto/ssh"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protowire"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
@@ -85,6 +95,12 @@ var (
 		action.SetCredentialSources,
 		action.RemoveCredentialSources,
 		action.AuthorizeSession,
+		action.ListAvailableHosts,
+		action.ExceedConnectionLimit,
+		action.ClearDeletionProtection,
+		action.EnableSshCertificateAuthority,
+		action.DisableSshCertificateAuthority,
+		action.SignSshHostKey,
 	}
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -116,9 +132,12 @@ type Service struct {
 	staticHostRepoFn        common.StaticRepoFactory
 	vaultCredRepoFn         common.VaultCredentialRepoFactory
 	staticCredRepoFn        common.StaticCredentialRepoFactory
+	azureCredRepoFn         common.AzureCredentialRepoFactory
 	downstreams             common.Downstreamers
 	kmsCache                *kms.Kms
 	workerStatusGracePeriod *atomic.Int64
+	region                  string
+	authzCache              *authzcache.Cache
 }
 
 var _ pbs.TargetServiceServer = (*Service)(nil)
@@ -135,8 +154,11 @@ func NewService(
 	staticHostRepoFn common.StaticRepoFactory,
 	vaultCredRepoFn common.VaultCredentialRepoFactory,
 	staticCredRepoFn common.StaticCredentialRepoFactory,
+	azureCredRepoFn common.AzureCredentialRepoFactory,
 	downstreams common.Downstreamers,
 	workerStatusGracePeriod *atomic.Int64,
+	authzCache *authzcache.Cache,
+	opt ...handlers.Option,
 ) (Service, error) {
 	const op = "targets.NewService"
 	if repoFn == nil {
@@ -163,6 +185,10 @@ func NewService(
 	if staticCredRepoFn == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing static credential repository")
 	}
+	if azureCredRepoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing azure credential repository")
+	}
+	opts := handlers.GetOpts(opt...)
 	return Service{
 		repoFn:                  repoFn,
 		iamRepoFn:               iamRepoFn,
@@ -172,9 +198,12 @@ func NewService(
 		staticHostRepoFn:        staticHostRepoFn,
 		vaultCredRepoFn:         vaultCredRepoFn,
 		staticCredRepoFn:        staticCredRepoFn,
+		azureCredRepoFn:         azureCredRepoFn,
 		downstreams:             downstreams,
 		kmsCache:                kmsCache,
 		workerStatusGracePeriod: workerStatusGracePeriod,
+		region:                  opts.WithRegion,
+		authzCache:              authzCache,
 	}, nil
 }
 
@@ -216,13 +245,41 @@ func (s Service) ListTargets(ctx context.Context, req *pbs.ListTargetsRequest) (
 		return &pbs.ListTargetsResponse{}, nil
 	}
 
-	tl, err := s.listFromRepo(ctx, userPerms)
+	var listOpts []target.Option
+	var recentIds []string
+	switch {
+	case req.GetFavorites():
+		repo, err := s.repoFn()
+		if err != nil {
+			return nil, err
+		}
+		favoriteIds, err := repo.ListFavoriteTargetIds(ctx, authResults.UserId)
+		if err != nil {
+			return nil, err
+		}
+		listOpts = append(listOpts, target.WithTargetIds(favoriteIds))
+	case req.GetRecent():
+		repo, err := s.repoFn()
+		if err != nil {
+			return nil, err
+		}
+		recentIds, err = repo.ListRecentTargetIds(ctx, authResults.UserId)
+		if err != nil {
+			return nil, err
+		}
+		listOpts = append(listOpts, target.WithTargetIds(recentIds))
+	}
+
+	tl, err := s.listFromRepo(ctx, userPerms, listOpts...)
 	if err != nil {
 		return nil, err
 	}
 	if len(tl) == 0 {
 		return &pbs.ListTargetsResponse{}, nil
 	}
+	if len(recentIds) > 0 {
+		sortByIds(tl, recentIds)
+	}
 
 	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
@@ -231,7 +288,7 @@ func (s Service) ListTargets(ctx context.Context, req *pbs.ListTargetsRequest) (
 
 	finalItems := make([]*pb.Target, 0, len(tl))
 	for _, item := range tl {
-		pr := perms.Resource{Id: item.GetPublicId(), ScopeId: item.GetProjectId(), Type: resource.Target}
+		pr := perms.Resource{Id: item.GetPublicId(), ScopeId: item.GetProjectId(), Type: resource.Target, Tags: item.GetTags()}
 		outputFields := authResults.FetchOutputFields(pr, action.List).SelfOrDefaults(authResults.UserId)
 
 		outputOpts := make([]handlers.Option, 0, 3)
@@ -269,11 +326,19 @@ func (s Service) GetTarget(ctx context.Context, req *pbs.GetTargetRequest) (*pbs
 	if err := validateGetRequest(req); err != nil {
 		return nil, err
 	}
-	authResults := s.authResult(ctx, req.GetId(), action.Read)
+	authResults := s.authResult(ctx, req.GetId(), action.Read,
+		target.WithName(req.GetName()),
+		target.WithProjectId(req.GetScopeId()),
+		target.WithProjectName(req.GetScopeName()),
+	)
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	t, ts, cl, err := s.getFromRepo(ctx, req.GetId())
+	rt, ok := authResults.RoundTripValue.(target.Target)
+	if !ok || rt == nil {
+		return nil, stderrors.New("get target: expected to get a target back from auth results")
+	}
+	t, ts, cl, err := s.getFromRepo(ctx, rt.GetPublicId())
 	if err != nil {
 		return nil, err
 	}
@@ -349,6 +414,10 @@ func (s Service) UpdateTarget(ctx context.Context, req *pbs.UpdateTargetRequest)
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
+	if handlers.ClearsDeletionProtection(req.GetUpdateMask().GetPaths(), req.GetItem().GetDeletionProtected()) &&
+		!authResults.FetchActionSetForId(ctx, req.GetId(), action.ActionSet{action.ClearDeletionProtection}).HasAction(action.ClearDeletionProtection) {
+		return nil, handlers.ForbiddenError()
+	}
 	t, ts, cl, err := s.updateInRepo(ctx, authResults.Scope.GetId(), req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
 	if err != nil {
 		return nil, err
@@ -403,7 +472,7 @@ func (s Service) AddTargetHostSources(ctx context.Context, req *pbs.AddTargetHos
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	t, ts, cl, err := s.addHostSourcesInRepo(ctx, req.GetId(), req.GetHostSourceIds(), req.GetVersion())
+	t, ts, cl, err := s.addHostSourcesInRepo(ctx, req.GetId(), req.GetHostSourceIds(), req.GetFallbackHostSourceIds(), req.GetVersion())
 	if err != nil {
 		return nil, err
 	}
@@ -441,7 +510,7 @@ func (s Service) SetTargetHostSources(ctx context.Context, req *pbs.SetTargetHos
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	t, ts, cl, err := s.setHostSourcesInRepo(ctx, req.GetId(), req.GetHostSourceIds(), req.GetVersion())
+	t, ts, cl, err := s.setHostSourcesInRepo(ctx, req.GetId(), req.GetHostSourceIds(), req.GetFallbackHostSourceIds(), req.GetVersion())
 	if err != nil {
 		return nil, err
 	}
@@ -519,7 +588,7 @@ func (s Service) AddTargetCredentialSources(ctx context.Context, req *pbs.AddTar
 	}
 
 	brokeredCredentialSources := strutil.MergeSlices(req.GetApplicationCredentialSourceIds(), req.GetBrokeredCredentialSourceIds())
-	t, ts, cl, err := s.addCredentialSourcesInRepo(ctx, req.GetId(), brokeredCredentialSources, req.GetInjectedApplicationCredentialSourceIds(), req.GetVersion())
+	t, ts, cl, err := s.addCredentialSourcesInRepo(ctx, req.GetId(), brokeredCredentialSources, req.GetInjectedApplicationCredentialSourceIds(), req.GetExclusiveCredentialSourceIds(), req.GetFallbackCredentialSourceIds(), req.GetVersion())
 	if err != nil {
 		return nil, err
 	}
@@ -559,7 +628,7 @@ func (s Service) SetTargetCredentialSources(ctx context.Context, req *pbs.SetTar
 	}
 
 	brokeredCredentialSources := strutil.MergeSlices(req.GetApplicationCredentialSourceIds(), req.GetBrokeredCredentialSourceIds())
-	t, ts, cl, err := s.setCredentialSourcesInRepo(ctx, req.GetId(), brokeredCredentialSources, req.GetInjectedApplicationCredentialSourceIds(), req.GetVersion())
+	t, ts, cl, err := s.setCredentialSourcesInRepo(ctx, req.GetId(), brokeredCredentialSources, req.GetInjectedApplicationCredentialSourceIds(), req.GetExclusiveCredentialSourceIds(), req.GetFallbackCredentialSourceIds(), req.GetVersion())
 	if err != nil {
 		return nil, err
 	}
@@ -626,6 +695,84 @@ func (s Service) RemoveTargetCredentialSources(ctx context.Context, req *pbs.Rem
 	return &pbs.RemoveTargetCredentialSourcesResponse{Item: item}, nil
 }
 
+// EnableTargetSshCertificateAuthority implements the interface pbs.TargetServiceServer.
+func (s Service) EnableTargetSshCertificateAuthority(ctx context.Context, req *pbs.EnableTargetSshCertificateAuthorityRequest) (*pbs.EnableTargetSshCertificateAuthorityResponse, error) {
+	const op = "targets.(Service).EnableTargetSshCertificateAuthority"
+
+	if err := validateEnableSshCertificateAuthorityRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.EnableSshCertificateAuthority)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	ca, err := repo.EnableSshCertificateAuthority(ctx, req.GetId(), req.GetUsername())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &pbs.EnableTargetSshCertificateAuthorityResponse{Item: toSshCertificateAuthorityProto(ca)}, nil
+}
+
+// DisableTargetSshCertificateAuthority implements the interface pbs.TargetServiceServer.
+func (s Service) DisableTargetSshCertificateAuthority(ctx context.Context, req *pbs.DisableTargetSshCertificateAuthorityRequest) (*pbs.DisableTargetSshCertificateAuthorityResponse, error) {
+	const op = "targets.(Service).DisableTargetSshCertificateAuthority"
+
+	if err := validateDisableSshCertificateAuthorityRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.DisableSshCertificateAuthority)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := repo.DisableSshCertificateAuthority(ctx, req.GetId()); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &pbs.DisableTargetSshCertificateAuthorityResponse{}, nil
+}
+
+// SignTargetSshHostKey implements the interface pbs.TargetServiceServer.
+func (s Service) SignTargetSshHostKey(ctx context.Context, req *pbs.SignTargetSshHostKeyRequest) (*pbs.SignTargetSshHostKeyResponse, error) {
+	const op = "targets.(Service).SignTargetSshHostKey"
+
+	if err := validateSignSshHostKeyRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.SignSshHostKey)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	ca, err := repo.LookupSshCertificateAuthority(ctx, req.GetId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if ca == nil {
+		return nil, handlers.NotFoundErrorf("Target %q does not have an SSH certificate authority.", req.GetId())
+	}
+	validFor := time.Duration(req.GetValidSeconds()) * time.Second
+	cert, err := ca.SignHostKey(ctx, req.GetPublicKey(), req.GetPrincipals(), validFor)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &pbs.SignTargetSshHostKeyResponse{
+		Item: &pb.SshHostCertificate{
+			Certificate:                   string(cert),
+			CertificateAuthorityPublicKey: ca.GetPublicKey(),
+		},
+	}, nil
+}
+
 // If set, use the worker_filter or egress_worker_filter to filter the selected workers
 // and ensure we have workers available to service this request.
 func AuthorizeSessionWithWorkerFilter(_ context.Context, t target.Target, selectedWorkers wl.WorkerList, _ string, _ common.Downstreamers) (wl.WorkerList, error) {
@@ -664,68 +811,16 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 	if err := validateAuthorizeSessionRequest(req); err != nil {
 		return nil, err
 	}
-	authResults := s.authResult(ctx, req.GetId(), action.AuthorizeSession,
-		target.WithName(req.GetName()),
-		target.WithProjectId(req.GetScopeId()),
-		target.WithProjectName(req.GetScopeName()),
-	)
-	if authResults.Error != nil {
-		return nil, authResults.Error
-	}
-
-	if authResults.RoundTripValue == nil {
-		return nil, stderrors.New("authorize session: expected to get a target back from auth results")
-	}
-	t, ok := authResults.RoundTripValue.(target.Target)
-	if !ok {
-		return nil, stderrors.New("authorize session: round tripped auth results value is not a target")
-	}
-	if t == nil {
-		return nil, stderrors.New("authorize session: round tripped target is nil")
-	}
-
-	// This could happen if, say, u_recovery was used or u_anon was granted. But
-	// don't allow it. It's one thing if grants give access to resources within
-	// Boundary, even if those could eventually be used to provide an unintended
-	// user access to a remote system. It's quite another to enable anonymous
-	// access directly to a remote system.
-	//
-	// Note that even if u_anon or u_auth are given grants we can still validate
-	// a token! So this is just checking that a valid token was provided. The
-	// actual reality of this works out to excluding:
-	//
-	// * True anonymous access (no token provided and u_anon)
-	//
-	// * u_recovery access (which is fine, recovery is meant for recovering
-	// system state, no real reason to allow it to then connect to systems)
-	if authResults.AuthTokenId == "" {
-		return nil, handlers.ForbiddenError()
-	}
-
-	if t.GetDefaultPort() == 0 {
-		return nil, handlers.ConflictErrorf("Target does not have default port defined.")
-	}
-
-	// Get the target information
-	repo, err := s.repoFn()
+	d, err := s.authorizeSessionDecision(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	t, hostSources, credSources, err := repo.LookupTarget(ctx, t.GetPublicId())
-	if err != nil {
-		if errors.IsNotFoundError(err) {
-			return nil, handlers.NotFoundErrorf("Target %q not found.", t.GetPublicId())
-		}
+	t, credSources := d.Target, d.CredentialSources
+	// The age of the auth token changes between requests, so it is checked
+	// even when the decision is cached
+	if err := validateAuthAge(t, d.AuthTokenCreateTime, time.Now()); err != nil {
 		return nil, err
 	}
-	if t == nil {
-		return nil, handlers.NotFoundErrorf("Target %q not found.", t.GetPublicId())
-	}
-	if len(credSources) > 0 {
-		if err := validateCredentialSourcesFn(ctx, t.GetType(), credSources); err != nil {
-			return nil, err
-		}
-	}
 
 	// Instantiate some repos
 	sessionRepo, err := s.sessionRepoFn()
@@ -746,40 +841,7 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 
 	default:
 		requestedId := req.GetHostId()
-		staticHostRepo, err := s.staticHostRepoFn()
-		if err != nil {
-			return nil, err
-		}
-		pluginHostRepo, err := s.pluginHostRepoFn()
-		if err != nil {
-			return nil, err
-		}
-
-		var pluginHostSetIds []string
-		var endpoints []*host.Endpoint
-		for _, hSource := range hostSources {
-			hsId := hSource.Id()
-			switch subtypes.SubtypeFromId(hostDomain, hsId) {
-			case static.Subtype:
-				eps, err := staticHostRepo.Endpoints(ctx, hsId)
-				if err != nil {
-					return nil, err
-				}
-				endpoints = append(endpoints, eps...)
-			default:
-				// Batch the plugin host set ids since each round trip to the plugin
-				// has the potential to be expensive.
-				pluginHostSetIds = append(pluginHostSetIds, hsId)
-			}
-		}
-		if len(pluginHostSetIds) > 0 {
-			eps, err := pluginHostRepo.Endpoints(ctx, pluginHostSetIds)
-			if err != nil {
-				return nil, err
-			}
-			endpoints = append(endpoints, eps...)
-		}
-
+		endpoints := d.Endpoints
 		if len(endpoints) == 0 {
 			return nil, handlers.NotFoundErrorf("No host sources or address found for given target.")
 		}
@@ -802,12 +864,31 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		}
 
 		if chosenEndpoint == nil {
+			endpoints, err = preferredEndpoints(ctx, sessionRepo, d.HostSources, endpoints)
+			if err != nil {
+				return nil, err
+			}
 			chosenEndpoint = endpoints[rand.Intn(len(endpoints))]
 		}
 
 		hostId = chosenEndpoint.HostId
 		hostSetId = chosenEndpoint.SetId
 		h = chosenEndpoint.Address
+
+		// Plugin hosts may have their port assigned dynamically, so let the
+		// plugin resolve it. The endpoint, including the port, is fixed for
+		// the lifetime of the session.
+		if subtypes.SubtypeFromId(hostDomain, hostSetId) != static.Subtype {
+			pluginHostRepo, err := s.pluginHostRepoFn()
+			if err != nil {
+				return nil, err
+			}
+			port, err := pluginHostRepo.ResolvePort(ctx, chosenEndpoint, t.GetDefaultPort())
+			if err != nil {
+				return nil, err
+			}
+			p = strconv.FormatUint(uint64(port), 10)
+		}
 	}
 
 	if h == "" {
@@ -819,10 +900,11 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 	// Ensure we don't have a port from the address, which would be unexpected
 	// FIXME: We've decided to hold off on making this an error until 0.14. In
 	// the meantime, ignore any port coming from the host address.
-	hostWithoutPort, _, err := net.SplitHostPort(h)
+	hostWithoutPort, _, err := util.SplitHostPort(h)
 	switch {
-	case err != nil && strings.Contains(err.Error(), globals.MissingPortErrStr):
-		// This is what we expect
+	case errors.Is(err, util.ErrMissingPort):
+		// This is what we expect; an IPv6 address may be enclosed in brackets
+		h = hostWithoutPort
 	case err != nil:
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("error when parsing the chosen endpoint host address"))
 	case err == nil:
@@ -856,40 +938,76 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 	rand.Shuffle(len(selectedWorkers), func(i, j int) {
 		selectedWorkers[i], selectedWorkers[j] = selectedWorkers[j], selectedWorkers[i]
 	})
+	// Clients connect to the first worker, so put the workers in this
+	// controller's region first
+	selectedWorkers = wl.WorkerList(selectedWorkers).PreferRegion(s.region)
 
-	var vaultReqs []credential.Request
-	var staticIds []string
+	var vaultReqs, azureReqs []credential.Request
+	var staticIds, exclusiveIds []string
 	var dynCreds []*session.DynamicCredential
-	var staticCreds []*session.StaticCredential
+	var staticCreds, retrievedStaticCreds []*session.StaticCredential
+	// Credential sources with a priority are fallbacks for the other
+	// credential sources with a priority and the same purpose. All of them
+	// are recorded in the session but only the first one, in order of
+	// priority, which returns a credential is used.
+	fallbackSources := make(map[credential.Purpose][]target.CredentialSource)
 	for _, cs := range credSources {
+		if cs.Priority() > 0 {
+			fallbackSources[cs.CredentialPurpose()] = append(fallbackSources[cs.CredentialPurpose()], cs)
+			switch cs.Type() {
+			case target.LibraryCredentialSourceType:
+				dynCreds = append(dynCreds, session.NewDynamicCredential(cs.Id(), cs.CredentialPurpose()))
+			case target.StaticCredentialSourceType:
+				staticCreds = append(staticCreds, session.NewStaticCredential(cs.Id(), cs.CredentialPurpose()))
+			}
+			continue
+		}
 		switch cs.Type() {
 		case target.LibraryCredentialSourceType:
-			vaultReqs = append(vaultReqs, credential.Request{
+			req := credential.Request{
 				SourceId: cs.Id(),
 				Purpose:  cs.CredentialPurpose(),
-			})
+			}
+			if subtypes.SubtypeFromId(credential.Domain, cs.Id()) == azure.SecretLibrarySubtype {
+				azureReqs = append(azureReqs, req)
+			} else {
+				vaultReqs = append(vaultReqs, req)
+			}
 			dynCreds = append(dynCreds, session.NewDynamicCredential(cs.Id(), cs.CredentialPurpose()))
 		case target.StaticCredentialSourceType:
 			staticIds = append(staticIds, cs.Id())
-			staticCreds = append(staticCreds, session.NewStaticCredential(cs.Id(), cs.CredentialPurpose()))
+			sc := session.NewStaticCredential(cs.Id(), cs.CredentialPurpose())
+			staticCreds = append(staticCreds, sc)
+			retrievedStaticCreds = append(retrievedStaticCreds, sc)
+			if cs.Exclusive() {
+				exclusiveIds = append(exclusiveIds, cs.Id())
+			}
 		}
 	}
 
+	// Sessions of users granted the exceed-connection-limit action on the
+	// target are not limited by its session connection limit
+	connectionLimit := t.GetSessionConnectionLimit()
+	if d.ConnectionLimitExempt {
+		connectionLimit = -1
+	}
+
 	expTime := timestamppb.Now()
 	expTime.Seconds += int64(t.GetSessionMaxSeconds())
 	sessionComposition := session.ComposedOf{
-		UserId:              authResults.UserId,
+		UserId:              d.UserId,
 		HostId:              hostId,
 		TargetId:            t.GetPublicId(),
 		HostSetId:           hostSetId,
-		AuthTokenId:         authResults.AuthTokenId,
-		ProjectId:           authResults.Scope.Id,
+		AuthTokenId:         d.AuthTokenId,
+		ProjectId:           d.Scope.Id,
 		Endpoint:            endpointUrl.String(),
 		ExpirationTime:      &timestamp.Timestamp{Timestamp: expTime},
-		ConnectionLimit:     t.GetSessionConnectionLimit(),
+		ConnectionLimit:     connectionLimit,
 		WorkerFilter:        t.GetWorkerFilter(),
 		EgressWorkerFilter:  t.GetEgressWorkerFilter(),
 		IngressWorkerFilter: t.GetIngressWorkerFilter(),
+		Banner:              t.GetBanner(),
 		DynamicCredentials:  dynCreds,
 		StaticCredentials:   staticCreds,
 	}
@@ -897,7 +1015,7 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 	if err != nil {
 		return nil, err
 	}
-	wrapper, err := s.kmsCache.GetWrapper(ctx, authResults.Scope.Id, kms.KeyPurposeSessions)
+	wrapper, err := s.kmsCache.GetWrapper(ctx, d.Scope.Id, kms.KeyPurposeSessions)
 	if err != nil {
 		return nil, err
 	}
@@ -905,19 +1023,68 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 	if err != nil {
 		return nil, err
 	}
+	// Exclusive static credentials are checked back in when the session is
+	// terminated.
+	if len(exclusiveIds) > 0 {
+		credRepo, err := s.staticCredRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if err := credRepo.CheckOutCredentials(ctx, sess.GetPublicId(), exclusiveIds); err != nil {
+			// The session can't be used without its credentials, so cancel
+			// it rather than leaving it pending until it expires. This is
+			// expected whenever an exclusive credential is checked out by
+			// another session.
+			if _, cancelErr := sessionRepo.CancelSession(ctx, sess.GetPublicId(), sess.Version); cancelErr != nil {
+				event.WriteError(ctx, op, cancelErr, event.WithInfoMsg("unable to cancel session after failing to check out its credentials", "session_id", sess.GetPublicId()))
+			}
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	// Failing to record the target as recently connected to shouldn't fail
+	// the authorization. The recovery user isn't stored, so it has no recent
+	// targets.
+	if d.UserId != globals.RecoveryUserId {
+		repo, err := s.repoFn()
+		if err == nil {
+			err = repo.RecordRecentTarget(ctx, d.UserId, t.GetPublicId())
+		}
+		if err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to record recent target"))
+		}
+	}
+
+	// Credential libraries can be templated with the target the session is
+	// being authorized to, along with the user and account.
+	templateData := d.UserData
+	templateData.Target = template.Target{
+		Id:   util.Pointer(t.GetPublicId()),
+		Name: util.Pointer(t.GetName()),
+	}
 
 	var dynamic []credential.Dynamic
-	var staticCredsById map[string]credential.Static
+	staticCredsById := make(map[string]credential.Static)
 	if len(vaultReqs) > 0 {
 		credRepo, err := s.vaultCredRepoFn()
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
-		dynamic, err = credRepo.Issue(ctx, sess.GetPublicId(), vaultReqs, credential.WithTemplateData(authResults.UserData))
+		dynamic, err = credRepo.Issue(ctx, sess.GetPublicId(), vaultReqs, credential.WithTemplateData(templateData))
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
 	}
+	if len(azureReqs) > 0 {
+		credRepo, err := s.azureCredRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		azureCreds, err := credRepo.Issue(ctx, sess.GetPublicId(), azureReqs)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		dynamic = append(dynamic, azureCreds...)
+	}
 
 	if len(staticIds) > 0 {
 		credRepo, err := s.staticCredRepoFn()
@@ -932,12 +1099,32 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 			return nil, errors.Wrap(ctx, err, op)
 		}
 
-		staticCredsById = make(map[string]credential.Static)
 		for _, c := range creds {
 			staticCredsById[c.GetPublicId()] = c
 		}
 	}
 
+	for _, purpose := range []credential.Purpose{credential.BrokeredPurpose, credential.InjectedApplicationPurpose} {
+		sources := fallbackSources[purpose]
+		if len(sources) == 0 {
+			continue
+		}
+		sort.SliceStable(sources, func(i, j int) bool {
+			return sources[i].Priority() < sources[j].Priority()
+		})
+		dyn, static, err := s.issueFallbackCredential(ctx, sess.GetPublicId(), t.GetProjectId(), sources, credential.WithTemplateData(templateData))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		switch {
+		case dyn != nil:
+			dynamic = append(dynamic, dyn)
+		case static != nil:
+			staticCredsById[static.GetPublicId()] = static
+			retrievedStaticCreds = append(retrievedStaticCreds, session.NewStaticCredential(static.GetPublicId(), purpose))
+		}
+	}
+
 	var creds []*pb.SessionCredential
 	var workerCreds []session.Credential
 	for _, cred := range dynamic {
@@ -961,7 +1148,7 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		}
 	}
 
-	for _, sc := range staticCreds {
+	for _, sc := range retrievedStaticCreds {
 		switch sc.CredentialPurpose {
 		case string(credential.InjectedApplicationPurpose):
 			c, err := staticToWorkerCredential(ctx, staticCredsById[sc.CredentialStaticId])
@@ -982,6 +1169,28 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		}
 	}
 
+	// Targets with an SSH certificate authority are issued a client
+	// certificate for each session, which is injected by the worker.
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	ca, err := repo.LookupSshCertificateAuthority(ctx, t.GetPublicId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if ca != nil {
+		privateKey, certificate, err := ca.IssueClientCertificate(ctx, sess.PublicId, sess.ExpirationTime.GetTimestamp().AsTime())
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		c, err := sshCertificateToWorkerCredential(ctx, ca.GetUsername(), privateKey, certificate)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		workerCreds = append(workerCreds, c)
+	}
+
 	if len(workerCreds) > 0 {
 		// store credentials in repo, worker will request creds when a connection is established
 		err = sessionRepo.AddSessionCredentials(ctx, sess.ProjectId, sess.PublicId, workerCreds)
@@ -1000,7 +1209,7 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 	sad := &pb.SessionAuthorizationData{
 		SessionId:       sess.PublicId,
 		TargetId:        t.GetPublicId(),
-		Scope:           authResults.Scope,
+		Scope:           d.Scope,
 		CreatedTime:     sess.CreateTime.GetTimestamp(),
 		Type:            t.GetType().String(),
 		Certificate:     sess.Certificate,
@@ -1008,69 +1217,230 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		HostId:          hostId,
 		Endpoint:        endpointUrl.String(),
 		WorkerInfo:      wl.WorkerList(selectedWorkers).WorkerInfos(),
-		ConnectionLimit: t.GetSessionConnectionLimit(),
+		ConnectionLimit: connectionLimit,
+		Banner:          sess.Banner,
 	}
 	marshaledSad, err := proto.Marshal(sad)
 	if err != nil {
 		return nil, err
 	}
+	marshaledSad, err = s.signSessionAuthorizationData(ctx, d.Scope.Id, marshaledSad)
+	if err != nil {
+		return nil, err
+	}
 	encodedMarshaledSad := base58.FastBase58Encoding(marshaledSad)
 
 	ret := &pb.SessionAuthorization{
 		SessionId:          sess.PublicId,
 		TargetId:           t.GetPublicId(),
-		Scope:              authResults.Scope,
+		Scope:              d.Scope,
 		CreatedTime:        sess.CreateTime.GetTimestamp(),
 		Type:               t.GetType().String(),
 		AuthorizationToken: encodedMarshaledSad,
-		UserId:             authResults.UserId,
+		UserId:             d.UserId,
 		HostId:             hostId,
 		HostSetId:          hostSetId,
 		Endpoint:           endpointUrl.String(),
 		Credentials:        creds,
+		Banner:             sess.Banner,
 	}
 	return &pbs.AuthorizeSessionResponse{Item: ret}, nil
 }
 
-func (s Service) getFromRepo(ctx context.Context, id string) (target.Target, []target.HostSource, []target.CredentialSource, error) {
-	repo, err := s.repoFn()
-	if err != nil {
-		return nil, nil, nil, err
-	}
-	u, hs, cl, err := repo.LookupTarget(ctx, id)
-	if err != nil {
-		if errors.IsNotFoundError(err) {
-			return nil, nil, nil, handlers.NotFoundErrorf("Target %q doesn't exist.", id)
+// authorizeSessionDecision authorizes the request and looks up the target and
+// the endpoints of its host sources. If an authorization cache is configured,
+// a decision cached for the auth token and target is reused.
+func (s Service) authorizeSessionDecision(ctx context.Context, req *pbs.AuthorizeSessionRequest) (*authzcache.Decision, error) {
+	// Only requests identifying the target by id can be looked up before
+	// they are authorized
+	var key authzcache.Key
+	cacheable := false
+	if reqInfo, ok := auth.RequestInfoFromContext(ctx); ok &&
+		s.authzCache != nil &&
+		globals.ResourceTypeFromPrefix(req.GetId()) == resource.Target &&
+		reqInfo.PublicId != "" &&
+		reqInfo.EncryptedToken != "" &&
+		!reqInfo.DisableAuthEntirely &&
+		reqInfo.UserIdOverride == "" {
+		cacheable = true
+		key = authzcache.Key{
+			AuthTokenId:                  reqInfo.PublicId,
+			EncryptedToken:               reqInfo.EncryptedToken,
+			ClientIp:                     reqInfo.ClientIp,
+			ClientCertificateFingerprint: reqInfo.ClientCertificateFingerprint,
+			TargetId:                     req.GetId(),
+		}
+		if d, ok := s.authzCache.Get(key); ok {
+			// The scope is part of the response, so the cached one isn't
+			// shared with it
+			cd := *d
+			cd.Scope = proto.Clone(d.Scope).(*scopes.ScopeInfo)
+			return &cd, nil
 		}
-		return nil, nil, nil, err
 	}
-	if u == nil {
-		return nil, nil, nil, handlers.NotFoundErrorf("Target %q doesn't exist.", id)
+
+	authResults := s.authResult(ctx, req.GetId(), action.AuthorizeSession,
+		target.WithName(req.GetName()),
+		target.WithProjectId(req.GetScopeId()),
+		target.WithProjectName(req.GetScopeName()),
+	)
+	if authResults.Error != nil {
+		return nil, authResults.Error
 	}
-	return u, hs, cl, nil
-}
 
-func (s Service) createInRepo(ctx context.Context, item *pb.Target) (target.Target, []target.HostSource, []target.CredentialSource, error) {
-	const op = "targets.(Service).createInRepo"
-	opts := []target.Option{target.WithName(item.GetName().GetValue())}
-	if item.GetDescription() != nil {
-		opts = append(opts, target.WithDescription(item.GetDescription().GetValue()))
+	if authResults.RoundTripValue == nil {
+		return nil, stderrors.New("authorize session: expected to get a target back from auth results")
 	}
-	if item.GetSessionMaxSeconds() != nil {
-		opts = append(opts, target.WithSessionMaxSeconds(item.GetSessionMaxSeconds().GetValue()))
+	t, ok := authResults.RoundTripValue.(target.Target)
+	if !ok {
+		return nil, stderrors.New("authorize session: round tripped auth results value is not a target")
 	}
-	if item.GetSessionConnectionLimit() != nil {
-		opts = append(opts, target.WithSessionConnectionLimit(item.GetSessionConnectionLimit().GetValue()))
+	if t == nil {
+		return nil, stderrors.New("authorize session: round tripped target is nil")
 	}
-	if item.GetEgressWorkerFilter() != nil {
-		opts = append(opts, target.WithEgressWorkerFilter(item.GetEgressWorkerFilter().GetValue()))
+
+	// This could happen if, say, u_recovery was used or u_anon was granted. But
+	// don't allow it. It's one thing if grants give access to resources within
+	// Boundary, even if those could eventually be used to provide an unintended
+	// user access to a remote system. It's quite another to enable anonymous
+	// access directly to a remote system.
+	//
+	// Note that even if u_anon or u_auth are given grants we can still validate
+	// a token! So this is just checking that a valid token was provided. The
+	// actual reality of this works out to excluding:
+	//
+	// * True anonymous access (no token provided and u_anon)
+	//
+	// * u_recovery access (which is fine, recovery is meant for recovering
+	// system state, no real reason to allow it to then connect to systems)
+	if authResults.AuthTokenId == "" {
+		return nil, handlers.ForbiddenError()
+	}
+
+	if t.GetDefaultPort() == 0 {
+		return nil, handlers.ConflictErrorf("Target does not have default port defined.")
+	}
+
+	// Get the target information
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	t, hostSources, credSources, err := repo.LookupTarget(ctx, t.GetPublicId())
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, handlers.NotFoundErrorf("Target %q not found.", t.GetPublicId())
+		}
+		return nil, err
+	}
+	if t == nil {
+		return nil, handlers.NotFoundErrorf("Target %q not found.", t.GetPublicId())
+	}
+	if len(credSources) > 0 {
+		if err := validateCredentialSourcesFn(ctx, t.GetType(), credSources); err != nil {
+			return nil, err
+		}
+	}
+
+	d := &authzcache.Decision{
+		UserId:              authResults.UserId,
+		UserData:            authResults.UserData,
+		AuthTokenId:         authResults.AuthTokenId,
+		AuthTokenCreateTime: authResults.AuthTokenCreateTime,
+		Scope:               authResults.Scope,
+		Target:              t,
+		HostSources:         hostSources,
+		CredentialSources:   credSources,
+	}
+	d.ConnectionLimitExempt = authResults.FetchActionSetForId(ctx, t.GetPublicId(), action.ActionSet{action.ExceedConnectionLimit}).HasAction(action.ExceedConnectionLimit)
+	if t.GetAddress() == "" {
+		d.Endpoints, err = s.hostSourceEndpoints(ctx, hostSources)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if cacheable {
+		cd := *d
+		cd.Scope = proto.Clone(d.Scope).(*scopes.ScopeInfo)
+		s.authzCache.Set(key, &cd)
+	}
+	return d, nil
+}
+
+// signSessionAuthorizationData appends the signing key id and signature
+// fields to the marshaled session authorization data, so that the token can be
+// verified offline with the public key of the sessions key version of the
+// project. The signature covers the marshaled bytes as given, so verifiers
+// check it against the token with the two appended fields removed, without
+// having to marshal the data again.
+func (s Service) signSessionAuthorizationData(ctx context.Context, projectId string, marshaledSad []byte) ([]byte, error) {
+	const op = "targets.(Service).signSessionAuthorizationData"
+	key, keyId, err := s.kmsCache.SessionAuthorizationSigningKey(ctx, projectId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	sig := ed25519.Sign(key, marshaledSad)
+	fields := (&pb.SessionAuthorizationData{}).ProtoReflect().Descriptor().Fields()
+	signed := append([]byte{}, marshaledSad...)
+	signed = protowire.AppendTag(signed, fields.ByName("signing_key_id").Number(), protowire.BytesType)
+	signed = protowire.AppendString(signed, keyId)
+	signed = protowire.AppendTag(signed, fields.ByName("signature").Number(), protowire.BytesType)
+	signed = protowire.AppendBytes(signed, sig)
+	return signed, nil
+}
+
+func (s Service) getFromRepo(ctx context.Context, id string) (target.Target, []target.HostSource, []target.CredentialSource, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	u, hs, cl, err := repo.LookupTarget(ctx, id)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil, nil, handlers.NotFoundErrorf("Target %q doesn't exist.", id)
+		}
+		return nil, nil, nil, err
+	}
+	if u == nil {
+		return nil, nil, nil, handlers.NotFoundErrorf("Target %q doesn't exist.", id)
+	}
+	return u, hs, cl, nil
+}
+
+func (s Service) createInRepo(ctx context.Context, item *pb.Target) (target.Target, []target.HostSource, []target.CredentialSource, error) {
+	const op = "targets.(Service).createInRepo"
+	opts := []target.Option{target.WithName(item.GetName().GetValue())}
+	if item.GetDescription() != nil {
+		opts = append(opts, target.WithDescription(item.GetDescription().GetValue()))
+	}
+	if item.GetSessionMaxSeconds() != nil {
+		opts = append(opts, target.WithSessionMaxSeconds(item.GetSessionMaxSeconds().GetValue()))
+	}
+	if item.GetSessionConnectionLimit() != nil {
+		opts = append(opts, target.WithSessionConnectionLimit(item.GetSessionConnectionLimit().GetValue()))
+	}
+	if item.GetEgressWorkerFilter() != nil {
+		opts = append(opts, target.WithEgressWorkerFilter(item.GetEgressWorkerFilter().GetValue()))
 	}
 	if item.GetIngressWorkerFilter() != nil {
 		opts = append(opts, target.WithIngressWorkerFilter(item.GetIngressWorkerFilter().GetValue()))
 	}
+	if item.GetMaxAuthAgeSeconds() != nil {
+		opts = append(opts, target.WithMaxAuthAgeSeconds(item.GetMaxAuthAgeSeconds().GetValue()))
+	}
+	if item.GetBanner() != nil {
+		opts = append(opts, target.WithBanner(strings.TrimSpace(item.GetBanner().GetValue())))
+	}
+	opts = append(opts, annotationOpts(item.GetAnnotations())...)
+	if item.GetDeletionProtected() != nil {
+		opts = append(opts, target.WithDeletionProtected(item.GetDeletionProtected().GetValue()))
+	}
 	if item.GetAddress() != nil {
 		opts = append(opts, target.WithAddress(strings.TrimSpace(item.GetAddress().GetValue())))
 	}
+	if item.GetTags() != nil {
+		opts = append(opts, target.WithTags(tagsFromProto(item.GetTags())))
+	}
 
 	attr, err := subtypeRegistry.newAttribute(target.SubtypeFromType(item.GetType()), item.GetAttrs())
 	if err != nil {
@@ -1122,10 +1492,24 @@ func (s Service) updateInRepo(ctx context.Context, scopeId, id string, mask []st
 	if ingressFilter := item.GetIngressWorkerFilter(); ingressFilter != nil {
 		opts = append(opts, target.WithIngressWorkerFilter(item.GetIngressWorkerFilter().GetValue()))
 	}
+	if item.GetMaxAuthAgeSeconds() != nil {
+		opts = append(opts, target.WithMaxAuthAgeSeconds(item.GetMaxAuthAgeSeconds().GetValue()))
+	}
+	if item.GetBanner() != nil {
+		opts = append(opts, target.WithBanner(strings.TrimSpace(item.GetBanner().GetValue())))
+	}
+	opts = append(opts, annotationOpts(item.GetAnnotations())...)
+	if item.GetDeletionProtected() != nil {
+		opts = append(opts, target.WithDeletionProtected(item.GetDeletionProtected().GetValue()))
+	}
 	if item.GetAddress() != nil {
 		dbMask = append(dbMask, "Address")
 		opts = append(opts, target.WithAddress(strings.TrimSpace(item.GetAddress().GetValue())))
 	}
+	if handlers.MaskContains(mask, globals.TagsField) {
+		dbMask = append(dbMask, "Tags")
+		opts = append(opts, target.WithTags(tagsFromProto(item.GetTags())))
+	}
 	subtype := target.SubtypeFromId(id)
 
 	attr, err := subtypeRegistry.newAttribute(subtype, item.GetAttrs())
@@ -1183,24 +1567,37 @@ func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 	return rows > 0, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, perms []perms.Permission) ([]target.Target, error) {
+func (s Service) listFromRepo(ctx context.Context, perms []perms.Permission, opt ...target.Option) ([]target.Target, error) {
 	repo, err := s.repoFn(target.WithPermissions(perms))
 	if err != nil {
 		return nil, err
 	}
-	ul, err := repo.ListTargets(ctx)
+	ul, err := repo.ListTargets(ctx, opt...)
 	if err != nil {
 		return nil, err
 	}
 	return ul, nil
 }
 
-func (s Service) addHostSourcesInRepo(ctx context.Context, targetId string, hostSourceIds []string, version uint32) (target.Target, []target.HostSource, []target.CredentialSource, error) {
+// sortByIds sorts the targets in the order of their ids in ids.
+func sortByIds(tl []target.Target, ids []string) {
+	pos := make(map[string]int, len(ids))
+	for i, id := range ids {
+		pos[id] = i
+	}
+	sort.SliceStable(tl, func(i, j int) bool {
+		return pos[tl[i].GetPublicId()] < pos[tl[j].GetPublicId()]
+	})
+}
+
+func (s Service) addHostSourcesInRepo(ctx context.Context, targetId string, hostSourceIds []string, fallbackIds []string, version uint32) (target.Target, []target.HostSource, []target.CredentialSource, error) {
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	out, hs, cl, err := repo.AddTargetHostSources(ctx, targetId, version, strutil.RemoveDuplicates(hostSourceIds, false))
+	// The order of the fallback ids is their priority, so they are not
+	// deduplicated; duplicates are rejected by the request validation.
+	out, hs, cl, err := repo.AddTargetHostSources(ctx, targetId, version, strutil.RemoveDuplicates(hostSourceIds, false), target.WithFallbackHostSources(fallbackIds))
 	if err != nil {
 		var internalErr *errors.Err
 		if stderrors.As(err, &internalErr) && internalErr.Code == errors.Conflict {
@@ -1217,13 +1614,15 @@ func (s Service) addHostSourcesInRepo(ctx context.Context, targetId string, host
 	return out, hs, cl, nil
 }
 
-func (s Service) setHostSourcesInRepo(ctx context.Context, targetId string, hostSourceIds []string, version uint32) (target.Target, []target.HostSource, []target.CredentialSource, error) {
+func (s Service) setHostSourcesInRepo(ctx context.Context, targetId string, hostSourceIds []string, fallbackIds []string, version uint32) (target.Target, []target.HostSource, []target.CredentialSource, error) {
 	const op = "targets.(Service).setSourcesInRepo"
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	_, _, _, err = repo.SetTargetHostSources(ctx, targetId, version, strutil.RemoveDuplicates(hostSourceIds, false))
+	// The order of the fallback ids is their priority, so they are not
+	// deduplicated; duplicates are rejected by the request validation.
+	_, _, _, err = repo.SetTargetHostSources(ctx, targetId, version, strutil.RemoveDuplicates(hostSourceIds, false), target.WithFallbackHostSources(fallbackIds))
 	if err != nil {
 		var internalErr *errors.Err
 		if stderrors.As(err, &internalErr) && internalErr.Code == errors.Conflict {
@@ -1266,7 +1665,81 @@ func (s Service) removeHostSourcesInRepo(ctx context.Context, targetId string, h
 	return out, hs, cl, nil
 }
 
-func (s Service) addCredentialSourcesInRepo(ctx context.Context, targetId string, brokeredIds []string, injectedAppIds []string, version uint32) (target.Target, []target.HostSource, []target.CredentialSource, error) {
+// issueFallbackCredential tries the fallback credential sources, which must
+// be sorted by priority, in order and returns the credential of the first one
+// which returns a credential. The sources which fail are written as error
+// events. If none of the sources returns a credential, the error of the last
+// one is returned.
+func (s Service) issueFallbackCredential(ctx context.Context, sessionId, projectId string, sources []target.CredentialSource, opt ...credential.Option) (credential.Dynamic, credential.Static, error) {
+	const op = "targets.(Service).issueFallbackCredential"
+	var lastErr error
+	for _, cs := range sources {
+		dyn, static, err := s.issueCredential(ctx, sessionId, projectId, cs, opt...)
+		if err == nil {
+			return dyn, static, nil
+		}
+		event.WriteError(ctx, op, err, event.WithInfoMsg("fallback credential source failed", "credential_source_id", cs.Id(), "purpose", cs.CredentialPurpose(), "session_id", sessionId))
+		lastErr = err
+	}
+	return nil, nil, errors.Wrap(ctx, lastErr, op, errors.WithMsg("all fallback credential sources failed"))
+}
+
+// issueCredential issues a credential for sessionId from the credential
+// library cs, or retrieves the static credential cs and checks it out if it
+// is exclusive.
+func (s Service) issueCredential(ctx context.Context, sessionId, projectId string, cs target.CredentialSource, opt ...credential.Option) (credential.Dynamic, credential.Static, error) {
+	const op = "targets.(Service).issueCredential"
+	switch cs.Type() {
+	case target.LibraryCredentialSourceType:
+		reqs := []credential.Request{{SourceId: cs.Id(), Purpose: cs.CredentialPurpose()}}
+		var dynamic []credential.Dynamic
+		if subtypes.SubtypeFromId(credential.Domain, cs.Id()) == azure.SecretLibrarySubtype {
+			credRepo, err := s.azureCredRepoFn()
+			if err != nil {
+				return nil, nil, errors.Wrap(ctx, err, op)
+			}
+			if dynamic, err = credRepo.Issue(ctx, sessionId, reqs); err != nil {
+				return nil, nil, errors.Wrap(ctx, err, op)
+			}
+		} else {
+			credRepo, err := s.vaultCredRepoFn()
+			if err != nil {
+				return nil, nil, errors.Wrap(ctx, err, op)
+			}
+			if dynamic, err = credRepo.Issue(ctx, sessionId, reqs, opt...); err != nil {
+				return nil, nil, errors.Wrap(ctx, err, op)
+			}
+		}
+		if len(dynamic) != 1 {
+			return nil, nil, errors.New(ctx, errors.NotSpecificIntegrity, op, fmt.Sprintf("expected 1 credential from %s, got %d", cs.Id(), len(dynamic)))
+		}
+		return dynamic[0], nil, nil
+
+	case target.StaticCredentialSourceType:
+		credRepo, err := s.staticCredRepoFn()
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		creds, err := credRepo.Retrieve(ctx, projectId, []string{cs.Id()})
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		if len(creds) != 1 {
+			return nil, nil, errors.New(ctx, errors.NotSpecificIntegrity, op, fmt.Sprintf("expected 1 credential for %s, got %d", cs.Id(), len(creds)))
+		}
+		if cs.Exclusive() {
+			if err := credRepo.CheckOutCredentials(ctx, sessionId, []string{cs.Id()}); err != nil {
+				return nil, nil, errors.Wrap(ctx, err, op)
+			}
+		}
+		return nil, creds[0], nil
+
+	default:
+		return nil, nil, errors.New(ctx, errors.Internal, op, fmt.Sprintf("unsupported credential source type %q", cs.Type()))
+	}
+}
+
+func (s Service) addCredentialSourcesInRepo(ctx context.Context, targetId string, brokeredIds []string, injectedAppIds []string, exclusiveIds []string, fallbackIds []string, version uint32) (target.Target, []target.HostSource, []target.CredentialSource, error) {
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, nil, nil, err
@@ -1279,6 +1752,12 @@ func (s Service) addCredentialSourcesInRepo(ctx context.Context, targetId string
 	if len(injectedAppIds) > 0 {
 		creds.InjectedApplicationCredentialIds = strutil.RemoveDuplicates(injectedAppIds, false)
 	}
+	if len(exclusiveIds) > 0 {
+		creds.ExclusiveCredentialIds = strutil.RemoveDuplicates(exclusiveIds, false)
+	}
+	// The order of the fallback ids is their priority, so they are not
+	// sorted by removing duplicates.
+	creds.FallbackCredentialIds = fallbackIds
 
 	out, hs, credSources, err := repo.AddTargetCredentialSources(ctx, targetId, version, creds)
 	if err != nil {
@@ -1291,7 +1770,7 @@ func (s Service) addCredentialSourcesInRepo(ctx context.Context, targetId string
 	return out, hs, credSources, nil
 }
 
-func (s Service) setCredentialSourcesInRepo(ctx context.Context, targetId string, brokeredIds []string, injectedAppIds []string, version uint32) (target.Target, []target.HostSource, []target.CredentialSource, error) {
+func (s Service) setCredentialSourcesInRepo(ctx context.Context, targetId string, brokeredIds []string, injectedAppIds []string, exclusiveIds []string, fallbackIds []string, version uint32) (target.Target, []target.HostSource, []target.CredentialSource, error) {
 	const op = "targets.(Service).setCredentialSourcesInRepo"
 	repo, err := s.repoFn()
 	if err != nil {
@@ -1305,6 +1784,12 @@ func (s Service) setCredentialSourcesInRepo(ctx context.Context, targetId string
 	if len(injectedAppIds) > 0 {
 		ids.InjectedApplicationCredentialIds = strutil.RemoveDuplicates(injectedAppIds, false)
 	}
+	if len(exclusiveIds) > 0 {
+		ids.ExclusiveCredentialIds = strutil.RemoveDuplicates(exclusiveIds, false)
+	}
+	// The order of the fallback ids is their priority, so they are not
+	// sorted by removing duplicates.
+	ids.FallbackCredentialIds = fallbackIds
 
 	_, _, _, err = repo.SetTargetCredentialSources(ctx, targetId, version, ids)
 	if err != nil {
@@ -1351,6 +1836,226 @@ func (s Service) removeCredentialSourcesInRepo(ctx context.Context, targetId str
 	return out, hs, credSources, nil
 }
 
+// AddTargetFavorite implements the interface pbs.TargetServiceServer.
+func (s Service) AddTargetFavorite(ctx context.Context, req *pbs.AddTargetFavoriteRequest) (*pbs.AddTargetFavoriteResponse, error) {
+	const op = "targets.(Service).AddTargetFavorite"
+	if err := validateFavoriteRequest(req); err != nil {
+		return nil, err
+	}
+	userId, err := s.favoriteUserId(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	if err := repo.AddFavoriteTarget(ctx, userId, req.GetId()); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &pbs.AddTargetFavoriteResponse{}, nil
+}
+
+// RemoveTargetFavorite implements the interface pbs.TargetServiceServer.
+func (s Service) RemoveTargetFavorite(ctx context.Context, req *pbs.RemoveTargetFavoriteRequest) (*pbs.RemoveTargetFavoriteResponse, error) {
+	const op = "targets.(Service).RemoveTargetFavorite"
+	if err := validateFavoriteRequest(req); err != nil {
+		return nil, err
+	}
+	userId, err := s.favoriteUserId(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	if err := repo.RemoveFavoriteTarget(ctx, userId, req.GetId()); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &pbs.RemoveTargetFavoriteResponse{}, nil
+}
+
+// favoriteUserId authorizes changing the favorites of the requester and
+// returns the requester's user id. Favorites are a preference of the user
+// rather than a change to the target, so being able to read the target is
+// enough.
+func (s Service) favoriteUserId(ctx context.Context, targetId string) (string, error) {
+	authResults := s.authResult(ctx, targetId, action.Read)
+	if authResults.Error != nil {
+		return "", authResults.Error
+	}
+	// Favorites of tokenless requests would be shared by everyone using the
+	// anonymous user
+	if authResults.AuthTokenId == "" {
+		return "", handlers.ForbiddenError()
+	}
+	return authResults.UserId, nil
+}
+
+// ListAvailableHosts implements the interface pbs.TargetServiceServer.
+func (s Service) ListAvailableHosts(ctx context.Context, req *pbs.ListAvailableHostsRequest) (*pbs.ListAvailableHostsResponse, error) {
+	const op = "targets.(Service).ListAvailableHosts"
+	if err := validateListAvailableHostsRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.ListAvailableHosts,
+		target.WithName(req.GetName()),
+		target.WithProjectId(req.GetScopeId()),
+		target.WithProjectName(req.GetScopeName()),
+	)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	t, ok := authResults.RoundTripValue.(target.Target)
+	if !ok || t == nil {
+		return nil, stderrors.New("list available hosts: expected to get a target back from auth results")
+	}
+	// As with AuthorizeSession, hosts are never reachable anonymously.
+	if authResults.AuthTokenId == "" {
+		return nil, handlers.ForbiddenError()
+	}
+	// Only the hosts of targets the requester can authorize sessions against
+	// are reachable.
+	if !authResults.FetchActionSetForId(ctx, t.GetPublicId(), action.ActionSet{action.AuthorizeSession}).HasAction(action.AuthorizeSession) {
+		return &pbs.ListAvailableHostsResponse{}, nil
+	}
+
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	id := t.GetPublicId()
+	t, hostSources, _, err := repo.LookupTarget(ctx, id)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, handlers.NotFoundErrorf("Target %q not found.", id)
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if t == nil {
+		return nil, handlers.NotFoundErrorf("Target %q not found.", id)
+	}
+	if t.GetAddress() != "" {
+		return &pbs.ListAvailableHostsResponse{}, nil
+	}
+
+	endpoints, err := s.hostSourceEndpoints(ctx, hostSources)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*pb.AvailableHost, 0, len(endpoints))
+	for _, ep := range endpoints {
+		items = append(items, &pb.AvailableHost{
+			HostId:       ep.HostId,
+			HostSourceId: ep.SetId,
+			Address:      ep.Address,
+			Name:         ep.Name,
+		})
+	}
+	return &pbs.ListAvailableHostsResponse{Items: items}, nil
+}
+
+// hostSourceEndpoints returns the endpoints of the hosts in the host sources
+// which a session can connect to.
+func (s Service) hostSourceEndpoints(ctx context.Context, hostSources []target.HostSource) ([]*host.Endpoint, error) {
+	staticHostRepo, err := s.staticHostRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	pluginHostRepo, err := s.pluginHostRepoFn()
+	if err != nil {
+		return nil, err
+	}
+
+	var pluginHostSetIds []string
+	var endpoints []*host.Endpoint
+	for _, hSource := range hostSources {
+		hsId := hSource.Id()
+		switch subtypes.SubtypeFromId(hostDomain, hsId) {
+		case static.Subtype:
+			eps, err := staticHostRepo.Endpoints(ctx, hsId)
+			if err != nil {
+				return nil, err
+			}
+			endpoints = append(endpoints, eps...)
+		default:
+			// Batch the plugin host set ids since each round trip to the plugin
+			// has the potential to be expensive.
+			pluginHostSetIds = append(pluginHostSetIds, hsId)
+		}
+	}
+	if len(pluginHostSetIds) > 0 {
+		eps, err := pluginHostRepo.Endpoints(ctx, pluginHostSetIds)
+		if err != nil {
+			return nil, err
+		}
+		endpoints = append(endpoints, eps...)
+	}
+	return endpoints, nil
+}
+
+// preferredEndpoints returns the endpoints of endpoints, which must be the
+// endpoints of hostSources, a session should connect to. If some of the host
+// sources are fallback host sources, they are tried in order of priority and
+// the endpoints of the healthy hosts of the first one with any are returned.
+// Otherwise the endpoints of the host sources which are not fallback host
+// sources are returned, or all of the endpoints if there are none.
+func preferredEndpoints(ctx context.Context, sessionRepo *session.Repository, hostSources []target.HostSource, endpoints []*host.Endpoint) ([]*host.Endpoint, error) {
+	const op = "targets.preferredEndpoints"
+	var fallbacks []target.HostSource
+	for _, hs := range hostSources {
+		if hs.Priority() > 0 {
+			fallbacks = append(fallbacks, hs)
+		}
+	}
+	if len(fallbacks) == 0 {
+		return endpoints, nil
+	}
+	sort.Slice(fallbacks, func(i, j int) bool {
+		return fallbacks[i].Priority() < fallbacks[j].Priority()
+	})
+
+	bySet := make(map[string][]*host.Endpoint, len(hostSources))
+	hostIds := make([]string, 0, len(endpoints))
+	for _, ep := range endpoints {
+		bySet[ep.SetId] = append(bySet[ep.SetId], ep)
+		hostIds = append(hostIds, ep.HostId)
+	}
+	unhealthyIds, err := sessionRepo.ListUnhealthyHosts(ctx, strutil.RemoveDuplicates(hostIds, false))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	unhealthy := make(map[string]bool, len(unhealthyIds))
+	for _, id := range unhealthyIds {
+		unhealthy[id] = true
+	}
+
+	for _, hs := range fallbacks {
+		var healthy []*host.Endpoint
+		for _, ep := range bySet[hs.Id()] {
+			if !unhealthy[ep.HostId] {
+				healthy = append(healthy, ep)
+			}
+		}
+		if len(healthy) > 0 {
+			return healthy, nil
+		}
+		event.WriteSysEvent(ctx, op, "fallback host source has no healthy hosts", "host_source_id", hs.Id())
+	}
+
+	var others []*host.Endpoint
+	for _, hs := range hostSources {
+		if hs.Priority() == 0 {
+			others = append(others, bySet[hs.Id()]...)
+		}
+	}
+	if len(others) > 0 {
+		return others, nil
+	}
+	return endpoints, nil
+}
+
 func (s Service) authResult(ctx context.Context, id string, a action.Type, lookupOpt ...target.Option) auth.VerifyResults {
 	res := auth.VerifyResults{}
 
@@ -1384,7 +2089,7 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type, looku
 		if err != nil {
 			// TODO: Fix this with new/better error handling
 			if strings.Contains(err.Error(), "more than one row returned by a subquery") {
-				res.Error = handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition, "Scope name is ambiguous (matches more than one scope), use scope ID with target name instead, or use target ID.")
+				res.Error = handlers.AmbiguousLookupErrorf("Scope name is ambiguous (matches more than one scope), use scope ID with target name instead, or use target ID.")
 			} else {
 				res.Error = err
 			}
@@ -1396,7 +2101,7 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type, looku
 		}
 		id = t.GetPublicId()
 		parentId = t.GetProjectId()
-		opts = append(opts, auth.WithId(id))
+		opts = append(opts, auth.WithId(id), auth.WithTags(t.GetTags()))
 	}
 	opts = append(opts, auth.WithScopeId(parentId))
 	ret := auth.Verify(ctx, opts...)
@@ -1404,6 +2109,16 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type, looku
 	return ret
 }
 
+func toSshCertificateAuthorityProto(in *target.SshCertificateAuthority) *pb.SshCertificateAuthority {
+	return &pb.SshCertificateAuthority{
+		TargetId:    in.GetTargetId(),
+		Username:    in.GetUsername(),
+		PublicKey:   in.GetPublicKey(),
+		CreatedTime: in.GetCreateTime().GetTimestamp(),
+		UpdatedTime: in.GetUpdateTime().GetTimestamp(),
+	}
+}
+
 func toProto(ctx context.Context, in target.Target, hostSources []target.HostSource, credSources []target.CredentialSource, opt ...handlers.Option) (*pb.Target, error) {
 	const op = "target_service.toProto"
 	opts := handlers.GetOpts(opt...)
@@ -1452,6 +2167,25 @@ func toProto(ctx context.Context, in target.Target, hostSources []target.HostSou
 	if outputFields.Has(globals.IngressWorkerFilterField) && in.GetIngressWorkerFilter() != "" {
 		out.IngressWorkerFilter = wrapperspb.String(in.GetIngressWorkerFilter())
 	}
+	if outputFields.Has(globals.MaxAuthAgeSecondsField) && in.GetMaxAuthAgeSeconds() != 0 {
+		out.MaxAuthAgeSeconds = wrapperspb.UInt32(in.GetMaxAuthAgeSeconds())
+	}
+	if outputFields.Has(globals.BannerField) && in.GetBanner() != "" {
+		out.Banner = wrapperspb.String(in.GetBanner())
+	}
+	if outputFields.Has(globals.AnnotationsField) {
+		out.Annotations = handlers.ToAnnotationsProto(in.GetAnnotationOwner(), in.GetAnnotationCostCenter(), in.GetAnnotationTicketUrl())
+	}
+	if outputFields.Has(globals.DeletionProtectedField) && in.GetDeletionProtected() {
+		out.DeletionProtected = wrapperspb.Bool(true)
+	}
+	if outputFields.Has(globals.TagsField) && len(in.GetTags()) > 0 {
+		var err error
+		out.Tags, err = tagsToProto(in.GetTags())
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("error preparing tags proto"))
+		}
+	}
 	if outputFields.Has(globals.ScopeField) {
 		out.Scope = opts.WithScope
 	}
@@ -1468,6 +2202,7 @@ func toProto(ctx context.Context, in target.Target, hostSources []target.HostSou
 			out.HostSources = append(out.HostSources, &pb.HostSource{
 				Id:            hs.Id(),
 				HostCatalogId: hs.HostCatalogId(),
+				Priority:      hs.Priority(),
 			})
 		}
 	}
@@ -1485,12 +2220,16 @@ func toProto(ctx context.Context, in target.Target, hostSources []target.HostSou
 			brokeredSources = append(brokeredSources, &pb.CredentialSource{
 				Id:                cs.Id(),
 				CredentialStoreId: cs.CredentialStoreId(),
+				Exclusive:         cs.Exclusive(),
+				Priority:          cs.Priority(),
 			})
 
 		case credential.InjectedApplicationPurpose:
 			injectedAppSources = append(injectedAppSources, &pb.CredentialSource{
 				Id:                cs.Id(),
 				CredentialStoreId: cs.CredentialStoreId(),
+				Exclusive:         cs.Exclusive(),
+				Priority:          cs.Priority(),
 			})
 			injectedAppSourceIds = append(injectedAppSourceIds, cs.Id())
 
@@ -1532,7 +2271,12 @@ func toProto(ctx context.Context, in target.Target, hostSources []target.HostSou
 //   - All required parameters are set
 //   - There are no conflicting parameters provided
 func validateGetRequest(req *pbs.GetTargetRequest) error {
-	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, target.Prefixes()...)
+	badFields := map[string]string{}
+	validateTargetLookup(req.GetId(), req.GetName(), req.GetScopeId(), req.GetScopeName(), badFields)
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
 }
 
 func validateCreateRequest(req *pbs.CreateTargetRequest) error {
@@ -1556,6 +2300,14 @@ func validateCreateRequest(req *pbs.CreateTargetRequest) error {
 		if req.GetItem().GetSessionMaxSeconds() != nil && req.GetItem().GetSessionMaxSeconds().GetValue() == 0 {
 			badFields[globals.SessionMaxSecondsField] = "This must be greater than zero."
 		}
+		if req.GetItem().GetMaxAuthAgeSeconds() != nil && req.GetItem().GetMaxAuthAgeSeconds().GetValue() == 0 {
+			badFields[globals.MaxAuthAgeSecondsField] = "This must be greater than zero."
+		}
+		if req.GetItem().GetBanner() != nil && strings.TrimSpace(req.GetItem().GetBanner().GetValue()) == "" {
+			badFields[globals.BannerField] = "This cannot be empty."
+		}
+		handlers.ValidateAnnotations(req.GetItem().GetAnnotations(), badFields)
+		validateTags(req.GetItem().GetTags(), badFields)
 		if req.GetItem().GetType() == "" {
 			badFields[globals.TypeField] = "This is a required field."
 		} else if target.SubtypeFromType(req.GetItem().GetType()) == "" {
@@ -1580,11 +2332,11 @@ func validateCreateRequest(req *pbs.CreateTargetRequest) error {
 				len(address.GetValue()) > static.MaxHostAddressLength {
 				badFields[globals.AddressField] = fmt.Sprintf("Address length must be between %d and %d characters.", static.MinHostAddressLength, static.MaxHostAddressLength)
 			}
-			_, _, err := net.SplitHostPort(address.GetValue())
+			_, _, err := util.SplitHostPort(address.GetValue())
 			switch {
 			case err == nil:
 				badFields[globals.AddressField] = "Address does not support a port."
-			case strings.Contains(err.Error(), globals.MissingPortErrStr):
+			case errors.Is(err, util.ErrMissingPort):
 			default:
 				badFields[globals.AddressField] = fmt.Sprintf("Error parsing address: %v.", err)
 			}
@@ -1626,6 +2378,14 @@ func validateUpdateRequest(req *pbs.UpdateTargetRequest) error {
 		if req.GetItem().GetSessionMaxSeconds() != nil && req.GetItem().GetSessionMaxSeconds().GetValue() == 0 {
 			badFields[globals.SessionMaxSecondsField] = "This must be greater than zero."
 		}
+		if req.GetItem().GetMaxAuthAgeSeconds() != nil && req.GetItem().GetMaxAuthAgeSeconds().GetValue() == 0 {
+			badFields[globals.MaxAuthAgeSecondsField] = "This must be greater than zero."
+		}
+		if req.GetItem().GetBanner() != nil && strings.TrimSpace(req.GetItem().GetBanner().GetValue()) == "" {
+			badFields[globals.BannerField] = "This cannot be empty."
+		}
+		handlers.ValidateAnnotations(req.GetItem().GetAnnotations(), badFields)
+		validateTags(req.GetItem().GetTags(), badFields)
 		// worker_filter is mutually exclusive from ingress and egress filter
 		workerFilterFound := false
 		if workerFilter := req.GetItem().GetWorkerFilter(); workerFilter != nil {
@@ -1656,11 +2416,11 @@ func validateUpdateRequest(req *pbs.UpdateTargetRequest) error {
 				len(address.GetValue()) > static.MaxHostAddressLength {
 				badFields[globals.AddressField] = fmt.Sprintf("Address length must be between %d and %d characters.", static.MinHostAddressLength, static.MaxHostAddressLength)
 			}
-			_, _, err := net.SplitHostPort(address.GetValue())
+			_, _, err := util.SplitHostPort(address.GetValue())
 			switch {
 			case err == nil:
 				badFields[globals.AddressField] = "Address does not support a port."
-			case strings.Contains(err.Error(), globals.MissingPortErrStr):
+			case errors.Is(err, util.ErrMissingPort):
 			default:
 				badFields[globals.AddressField] = fmt.Sprintf("Error parsing address: %v.", err)
 			}
@@ -1700,12 +2460,26 @@ func validateListRequest(req *pbs.ListTargetsRequest) error {
 	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
 		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
 	}
+	if req.GetFavorites() && req.GetRecent() {
+		badFields["recent"] = "This field cannot be set along with favorites."
+	}
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
 	}
 	return nil
 }
 
+func validateFavoriteRequest(req interface{ GetId() string }) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), target.Prefixes()...) {
+		badFields[globals.IdField] = "Incorrectly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
 func validateAddHostSourcesRequest(req *pbs.AddTargetHostSourcesRequest) error {
 	badFields := map[string]string{}
 	if !handlers.ValidId(handlers.Id(req.GetId()), target.Prefixes()...) {
@@ -1723,6 +2497,9 @@ func validateAddHostSourcesRequest(req *pbs.AddTargetHostSourcesRequest) error {
 			break
 		}
 	}
+	if msg := validateFallbackHostSourceIds(req.GetFallbackHostSourceIds(), req.GetHostSourceIds()); msg != "" {
+		badFields[globals.FallbackHostSourceIdsField] = msg
+	}
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
 	}
@@ -1743,12 +2520,33 @@ func validateSetHostSourcesRequest(req *pbs.SetTargetHostSourcesRequest) error {
 			break
 		}
 	}
+	if msg := validateFallbackHostSourceIds(req.GetFallbackHostSourceIds(), req.GetHostSourceIds()); msg != "" {
+		badFields[globals.FallbackHostSourceIdsField] = msg
+	}
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
 	}
 	return nil
 }
 
+// validateFallbackHostSourceIds returns a message describing why fallbackIds
+// are invalid, or an empty string if they are valid. Each fallback id must be
+// unique, since its position is its priority, and must also be one of
+// hostSourceIds.
+func validateFallbackHostSourceIds(fallbackIds, hostSourceIds []string) string {
+	seen := make(map[string]bool, len(fallbackIds))
+	for _, id := range fallbackIds {
+		if seen[id] {
+			return fmt.Sprintf("Fallback host source %q is provided more than once.", id)
+		}
+		seen[id] = true
+		if !strutil.StrListContains(hostSourceIds, id) {
+			return fmt.Sprintf("Fallback host source %q must also be provided as a host source.", id)
+		}
+	}
+	return ""
+}
+
 func validateRemoveHostSourcesRequest(req *pbs.RemoveTargetHostSourcesRequest) error {
 	badFields := map[string]string{}
 	if !handlers.ValidId(handlers.Id(req.GetId()), target.Prefixes()...) {
@@ -1788,10 +2586,12 @@ func validateAddCredentialSourcesRequest(req *pbs.AddTargetCredentialSourcesRequ
 	for _, cl := range req.GetApplicationCredentialSourceIds() {
 		if !handlers.ValidId(handlers.Id(cl),
 			globals.VaultCredentialLibraryPrefix,
+			globals.AzureCredentialLibraryPrefix,
 			globals.UsernamePasswordCredentialPrefix,
 			globals.UsernamePasswordCredentialPreviousPrefix,
 			globals.SshPrivateKeyCredentialPrefix,
-			globals.JsonCredentialPrefix) {
+			globals.JsonCredentialPrefix,
+			globals.X509CertificateCredentialPrefix) {
 			badFields[globals.ApplicationCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted credential source identifier %q.", cl)
 			break
 		}
@@ -1799,10 +2599,12 @@ func validateAddCredentialSourcesRequest(req *pbs.AddTargetCredentialSourcesRequ
 	for _, cl := range req.GetBrokeredCredentialSourceIds() {
 		if !handlers.ValidId(handlers.Id(cl),
 			globals.VaultCredentialLibraryPrefix,
+			globals.AzureCredentialLibraryPrefix,
 			globals.UsernamePasswordCredentialPrefix,
 			globals.UsernamePasswordCredentialPreviousPrefix,
 			globals.SshPrivateKeyCredentialPrefix,
-			globals.JsonCredentialPrefix) {
+			globals.JsonCredentialPrefix,
+			globals.X509CertificateCredentialPrefix) {
 			badFields[globals.BrokeredCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted credential source identifier %q.", cl)
 			break
 		}
@@ -1810,6 +2612,7 @@ func validateAddCredentialSourcesRequest(req *pbs.AddTargetCredentialSourcesRequ
 	for _, cl := range req.GetInjectedApplicationCredentialSourceIds() {
 		if !handlers.ValidId(handlers.Id(cl),
 			globals.VaultCredentialLibraryPrefix,
+			globals.AzureCredentialLibraryPrefix,
 			globals.VaultSshCertificateCredentialLibraryPrefix,
 			globals.UsernamePasswordCredentialPrefix,
 			globals.UsernamePasswordCredentialPreviousPrefix,
@@ -1818,6 +2621,25 @@ func validateAddCredentialSourcesRequest(req *pbs.AddTargetCredentialSourcesRequ
 			break
 		}
 	}
+	sourceIds := strutil.MergeSlices(req.GetApplicationCredentialSourceIds(), req.GetBrokeredCredentialSourceIds(), req.GetInjectedApplicationCredentialSourceIds())
+	for _, cl := range req.GetExclusiveCredentialSourceIds() {
+		if !handlers.ValidId(handlers.Id(cl),
+			globals.UsernamePasswordCredentialPrefix,
+			globals.UsernamePasswordCredentialPreviousPrefix,
+			globals.SshPrivateKeyCredentialPrefix,
+			globals.JsonCredentialPrefix,
+			globals.X509CertificateCredentialPrefix) {
+			badFields[globals.ExclusiveCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted static credential identifier %q.", cl)
+			break
+		}
+		if !strutil.StrListContains(sourceIds, cl) {
+			badFields[globals.ExclusiveCredentialSourceIdsField] = fmt.Sprintf("Exclusive credential %q must also be provided as a brokered or injected application credential source.", cl)
+			break
+		}
+	}
+	if msg := validateFallbackCredentialSourceIds(req.GetFallbackCredentialSourceIds(), sourceIds); msg != "" {
+		badFields[globals.FallbackCredentialSourceIdsField] = msg
+	}
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
 	}
@@ -1836,10 +2658,12 @@ func validateSetCredentialSourcesRequest(req *pbs.SetTargetCredentialSourcesRequ
 	for _, cl := range req.GetApplicationCredentialSourceIds() {
 		if !handlers.ValidId(handlers.Id(cl),
 			globals.VaultCredentialLibraryPrefix,
+			globals.AzureCredentialLibraryPrefix,
 			globals.UsernamePasswordCredentialPrefix,
 			globals.UsernamePasswordCredentialPreviousPrefix,
 			globals.SshPrivateKeyCredentialPrefix,
-			globals.JsonCredentialPrefix) {
+			globals.JsonCredentialPrefix,
+			globals.X509CertificateCredentialPrefix) {
 			badFields[globals.ApplicationCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted credential source identifier %q.", cl)
 			break
 		}
@@ -1847,10 +2671,12 @@ func validateSetCredentialSourcesRequest(req *pbs.SetTargetCredentialSourcesRequ
 	for _, cl := range req.GetBrokeredCredentialSourceIds() {
 		if !handlers.ValidId(handlers.Id(cl),
 			globals.VaultCredentialLibraryPrefix,
+			globals.AzureCredentialLibraryPrefix,
 			globals.UsernamePasswordCredentialPrefix,
 			globals.UsernamePasswordCredentialPreviousPrefix,
 			globals.SshPrivateKeyCredentialPrefix,
-			globals.JsonCredentialPrefix) {
+			globals.JsonCredentialPrefix,
+			globals.X509CertificateCredentialPrefix) {
 			badFields[globals.BrokeredCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted credential source identifier %q.", cl)
 			break
 		}
@@ -1858,6 +2684,7 @@ func validateSetCredentialSourcesRequest(req *pbs.SetTargetCredentialSourcesRequ
 	for _, cl := range req.GetInjectedApplicationCredentialSourceIds() {
 		if !handlers.ValidId(handlers.Id(cl),
 			globals.VaultCredentialLibraryPrefix,
+			globals.AzureCredentialLibraryPrefix,
 			globals.VaultSshCertificateCredentialLibraryPrefix,
 			globals.UsernamePasswordCredentialPrefix,
 			globals.UsernamePasswordCredentialPreviousPrefix,
@@ -1866,12 +2693,49 @@ func validateSetCredentialSourcesRequest(req *pbs.SetTargetCredentialSourcesRequ
 			break
 		}
 	}
+	sourceIds := strutil.MergeSlices(req.GetApplicationCredentialSourceIds(), req.GetBrokeredCredentialSourceIds(), req.GetInjectedApplicationCredentialSourceIds())
+	for _, cl := range req.GetExclusiveCredentialSourceIds() {
+		if !handlers.ValidId(handlers.Id(cl),
+			globals.UsernamePasswordCredentialPrefix,
+			globals.UsernamePasswordCredentialPreviousPrefix,
+			globals.SshPrivateKeyCredentialPrefix,
+			globals.JsonCredentialPrefix,
+			globals.X509CertificateCredentialPrefix) {
+			badFields[globals.ExclusiveCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted static credential identifier %q.", cl)
+			break
+		}
+		if !strutil.StrListContains(sourceIds, cl) {
+			badFields[globals.ExclusiveCredentialSourceIdsField] = fmt.Sprintf("Exclusive credential %q must also be provided as a brokered or injected application credential source.", cl)
+			break
+		}
+	}
+	if msg := validateFallbackCredentialSourceIds(req.GetFallbackCredentialSourceIds(), sourceIds); msg != "" {
+		badFields[globals.FallbackCredentialSourceIdsField] = msg
+	}
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
 	}
 	return nil
 }
 
+// validateFallbackCredentialSourceIds returns a message describing why
+// fallbackIds are invalid, or an empty string if they are valid. Each
+// fallback id must be unique, since its position is its priority, and must
+// also be one of sourceIds.
+func validateFallbackCredentialSourceIds(fallbackIds, sourceIds []string) string {
+	seen := make(map[string]bool, len(fallbackIds))
+	for _, cl := range fallbackIds {
+		if seen[cl] {
+			return fmt.Sprintf("Fallback credential source %q is provided more than once.", cl)
+		}
+		seen[cl] = true
+		if !strutil.StrListContains(sourceIds, cl) {
+			return fmt.Sprintf("Fallback credential source %q must also be provided as a brokered or injected application credential source.", cl)
+		}
+	}
+	return ""
+}
+
 func validateRemoveCredentialSourcesRequest(req *pbs.RemoveTargetCredentialSourcesRequest) error {
 	badFields := map[string]string{}
 	if !handlers.ValidId(handlers.Id(req.GetId()), target.Prefixes()...) {
@@ -1888,10 +2752,12 @@ func validateRemoveCredentialSourcesRequest(req *pbs.RemoveTargetCredentialSourc
 	for _, cl := range req.GetApplicationCredentialSourceIds() {
 		if !handlers.ValidId(handlers.Id(cl),
 			globals.VaultCredentialLibraryPrefix,
+			globals.AzureCredentialLibraryPrefix,
 			globals.UsernamePasswordCredentialPrefix,
 			globals.UsernamePasswordCredentialPreviousPrefix,
 			globals.SshPrivateKeyCredentialPrefix,
-			globals.JsonCredentialPrefix) {
+			globals.JsonCredentialPrefix,
+			globals.X509CertificateCredentialPrefix) {
 			badFields[globals.ApplicationCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted credential source identifier %q.", cl)
 			break
 		}
@@ -1899,10 +2765,12 @@ func validateRemoveCredentialSourcesRequest(req *pbs.RemoveTargetCredentialSourc
 	for _, cl := range req.GetBrokeredCredentialSourceIds() {
 		if !handlers.ValidId(handlers.Id(cl),
 			globals.VaultCredentialLibraryPrefix,
+			globals.AzureCredentialLibraryPrefix,
 			globals.UsernamePasswordCredentialPrefix,
 			globals.UsernamePasswordCredentialPreviousPrefix,
 			globals.SshPrivateKeyCredentialPrefix,
-			globals.JsonCredentialPrefix) {
+			globals.JsonCredentialPrefix,
+			globals.X509CertificateCredentialPrefix) {
 			badFields[globals.BrokeredCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted credential source identifier %q.", cl)
 			break
 		}
@@ -1910,11 +2778,13 @@ func validateRemoveCredentialSourcesRequest(req *pbs.RemoveTargetCredentialSourc
 	for _, cl := range req.GetInjectedApplicationCredentialSourceIds() {
 		if !handlers.ValidId(handlers.Id(cl),
 			globals.VaultCredentialLibraryPrefix,
+			globals.AzureCredentialLibraryPrefix,
 			globals.VaultSshCertificateCredentialLibraryPrefix,
 			globals.UsernamePasswordCredentialPrefix,
 			globals.UsernamePasswordCredentialPreviousPrefix,
 			globals.SshPrivateKeyCredentialPrefix,
-			globals.JsonCredentialPrefix) {
+			globals.JsonCredentialPrefix,
+			globals.X509CertificateCredentialPrefix) {
 			badFields[globals.InjectedApplicationCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted credential source identifier %q.", cl)
 			break
 		}
@@ -1925,13 +2795,107 @@ func validateRemoveCredentialSourcesRequest(req *pbs.RemoveTargetCredentialSourc
 	return nil
 }
 
+// validateAuthAge returns an error if the target has a max auth age and the auth
+// token used to authorize the session was created longer ago than that. The
+// user must re-authenticate to get a fresh token before a session can be
+// authorized.
+func validateAuthAge(t target.Target, authTokenCreateTime, now time.Time) error {
+	maxAge := t.GetMaxAuthAgeSeconds()
+	if maxAge == 0 {
+		return nil
+	}
+	if authTokenCreateTime.IsZero() || now.Sub(authTokenCreateTime) > time.Duration(maxAge)*time.Second {
+		return handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated,
+			"Target requires authentication within the last %d seconds; re-authenticate and try again.", maxAge)
+	}
+	return nil
+}
+
+func validateEnableSshCertificateAuthorityRequest(req *pbs.EnableTargetSshCertificateAuthorityRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), target.Prefixes()...) {
+		badFields[globals.IdField] = "Incorrectly formatted identifier."
+	}
+	if strings.TrimSpace(req.GetUsername()) == "" {
+		badFields[globals.UsernameField] = "Required field."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
+func validateDisableSshCertificateAuthorityRequest(req *pbs.DisableTargetSshCertificateAuthorityRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), target.Prefixes()...) {
+		badFields[globals.IdField] = "Incorrectly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
+func validateSignSshHostKeyRequest(req *pbs.SignTargetSshHostKeyRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), target.Prefixes()...) {
+		badFields[globals.IdField] = "Incorrectly formatted identifier."
+	}
+	if strings.TrimSpace(req.GetPublicKey()) == "" {
+		badFields[globals.PublicKeyField] = "Required field."
+	} else if pub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(req.GetPublicKey())); err != nil {
+		badFields[globals.PublicKeyField] = "Must be a public key in the authorized_keys format."
+	} else if _, ok := pub.(*ssh.Certificate); ok {
+		badFields[globals.PublicKeyField] = "Must be a public key, not a certificate."
+	}
+	if len(req.GetPrincipals()) == 0 {
+		badFields[globals.PrincipalsField] = "At least one principal must be provided."
+	}
+	for _, p := range req.GetPrincipals() {
+		if strings.TrimSpace(p) == "" {
+			badFields[globals.PrincipalsField] = "Principals must not be empty."
+			break
+		}
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
+func validateListAvailableHostsRequest(req *pbs.ListAvailableHostsRequest) error {
+	badFields := map[string]string{}
+	validateTargetLookup(req.GetId(), req.GetName(), req.GetScopeId(), req.GetScopeName(), badFields)
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
 func validateAuthorizeSessionRequest(req *pbs.AuthorizeSessionRequest) error {
 	badFields := map[string]string{}
-	nameEmpty := req.GetName() == ""
-	scopeIdEmpty := req.GetScopeId() == ""
-	scopeNameEmpty := req.GetScopeName() == ""
+	validateTargetLookup(req.GetId(), req.GetName(), req.GetScopeId(), req.GetScopeName(), badFields)
+	if req.GetHostId() != "" {
+		switch subtypes.SubtypeFromId(hostDomain, req.GetHostId()) {
+		case static.Subtype, plugin.Subtype:
+		default:
+			badFields[globals.HostIdField] = "Incorrectly formatted identifier."
+		}
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
+// validateTargetLookup validates the fields identifying a target either by
+// its ID or by its name and scope, and adds any errors to badFields.
+func validateTargetLookup(id, name, scopeId, scopeName string, badFields map[string]string) {
+	nameEmpty := name == ""
+	scopeIdEmpty := scopeId == ""
+	scopeNameEmpty := scopeName == ""
 	if nameEmpty {
-		if !handlers.ValidId(handlers.Id(req.GetId()), target.Prefixes()...) {
+		if !handlers.ValidId(handlers.Id(id), target.Prefixes()...) {
 			badFields[globals.IdField] = "Incorrectly formatted identifier."
 		}
 		if !scopeIdEmpty {
@@ -1941,7 +2905,7 @@ func validateAuthorizeSessionRequest(req *pbs.AuthorizeSessionRequest) error {
 			badFields[globals.ScopeIdField] = "Scope name provided when target name was empty."
 		}
 	} else {
-		if req.GetName() != req.GetId() {
+		if name != id {
 			badFields[globals.NameField] = "Target name provided but does not match the given ID value from the URL."
 		}
 		switch {
@@ -1953,15 +2917,85 @@ func validateAuthorizeSessionRequest(req *pbs.AuthorizeSessionRequest) error {
 			badFields["scope_name"] = "Scope ID and scope name cannot both be provided when target name is used."
 		}
 	}
-	if req.GetHostId() != "" {
-		switch subtypes.SubtypeFromId(hostDomain, req.GetHostId()) {
-		case static.Subtype, plugin.Subtype:
-		default:
-			badFields[globals.HostIdField] = "Incorrectly formatted identifier."
+}
+
+// annotationOpts returns the options which set the annotations in a.
+func annotationOpts(a *scopes.Annotations) []target.Option {
+	var opts []target.Option
+	if a.GetOwner() != nil {
+		opts = append(opts, target.WithAnnotationOwner(strings.TrimSpace(a.GetOwner().GetValue())))
+	}
+	if a.GetCostCenter() != nil {
+		opts = append(opts, target.WithAnnotationCostCenter(a.GetCostCenter().GetValue()))
+	}
+	if a.GetTicketUrl() != nil {
+		opts = append(opts, target.WithAnnotationTicketUrl(a.GetTicketUrl().GetValue()))
+	}
+	return opts
+}
+
+// validateTags adds the errors of the tags of a target to badFields. Tag keys
+// and values must be lowercase strings of up to 512 characters.
+func validateTags(tags map[string]*structpb.ListValue, badFields map[string]string) {
+	for k, lv := range tags {
+		if msg := validateTag(k); msg != "" {
+			badFields[globals.TagsField] = "Tag keys " + msg
+			return
+		}
+		if len(lv.GetValues()) == 0 {
+			badFields[globals.TagsField] = "Tag values must be non-empty."
+			return
+		}
+		for _, v := range lv.GetValues() {
+			if _, ok := v.GetKind().(*structpb.Value_StringValue); !ok {
+				badFields[globals.TagsField] = "Tag values must be strings."
+				return
+			}
+			if msg := validateTag(v.GetStringValue()); msg != "" {
+				badFields[globals.TagsField] = "Tag values " + msg
+				return
+			}
 		}
 	}
-	if len(badFields) > 0 {
-		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+}
+
+func validateTag(str string) string {
+	str = strings.TrimSpace(str)
+	switch {
+	case len(str) == 0:
+		return "must be non-empty."
+	case len(str) > 512:
+		return "must be within 512 characters."
+	case strings.ToLower(str) != str:
+		return "must be lowercase."
+	default:
+		return ""
 	}
-	return nil
+}
+
+// tagsFromProto returns the tags of a target from their proto form.
+func tagsFromProto(in map[string]*structpb.ListValue) map[string][]string {
+	ret := make(map[string][]string, len(in))
+	for k, lv := range in {
+		for _, v := range lv.GetValues() {
+			ret[k] = append(ret[k], v.GetStringValue())
+		}
+	}
+	return ret
+}
+
+// tagsToProto returns the proto form of the tags of a target.
+func tagsToProto(in map[string][]string) (map[string]*structpb.ListValue, error) {
+	ret := make(map[string]*structpb.ListValue, len(in))
+	for k, v := range in {
+		values := make([]any, 0, len(v))
+		for _, t := range v {
+			values = append(values, t)
+		}
+		var err error
+		if ret[k], err = structpb.NewList(values); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
 }
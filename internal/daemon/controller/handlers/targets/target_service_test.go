@@ -8,17 +8,23 @@ import (
 	"crypto/rand"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/daemon/common"
 	"github.com/hashicorp/boundary/internal/db"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/server"
+	"github.com/hashicorp/boundary/internal/target"
 	"github.com/hashicorp/boundary/internal/target/targettest"
 	"github.com/hashicorp/boundary/internal/target/targettest/store"
 	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func TestWorkerList_Addresses(t *testing.T) {
@@ -132,3 +138,145 @@ func TestWorkerList_EgressFilter(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateAuthAge(t *testing.T) {
+	now := time.Now()
+	newTarget := func(t *testing.T, maxAge uint32) target.Target {
+		t.Helper()
+		tar, err := targettest.New("p_1234567890", target.WithMaxAuthAgeSeconds(maxAge))
+		require.NoError(t, err)
+		return tar
+	}
+	tests := []struct {
+		name       string
+		maxAge     uint32
+		createTime time.Time
+		wantErr    bool
+	}{
+		{
+			name:       "no-max-age",
+			createTime: now.Add(-24 * time.Hour),
+		},
+		{
+			name:       "within-max-age",
+			maxAge:     300,
+			createTime: now.Add(-time.Minute),
+		},
+		{
+			name:       "beyond-max-age",
+			maxAge:     300,
+			createTime: now.Add(-10 * time.Minute),
+			wantErr:    true,
+		},
+		{
+			name:    "missing-create-time",
+			maxAge:  300,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateAuthAge(newTarget(t, tt.maxAge), tt.createTime, now)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "re-authenticate")
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_validateCreateRequest_Banner(t *testing.T) {
+	newReq := func(banner *wrapperspb.StringValue) *pbs.CreateTargetRequest {
+		return &pbs.CreateTargetRequest{Item: &pb.Target{
+			ScopeId: "p_1234567890",
+			Name:    wrapperspb.String("name"),
+			Type:    "tcp",
+			Banner:  banner,
+		}}
+	}
+	tests := []struct {
+		name    string
+		banner  *wrapperspb.StringValue
+		wantErr bool
+	}{
+		{
+			name: "no-banner",
+		},
+		{
+			name:   "banner",
+			banner: wrapperspb.String("Authorized use only."),
+		},
+		{
+			name:    "empty-banner",
+			banner:  wrapperspb.String(" \n"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCreateRequest(newReq(tt.banner))
+			bannerErr := fmt.Sprintf("{name: %q", globals.BannerField)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), bannerErr)
+				return
+			}
+			if err != nil {
+				assert.NotContains(t, err.Error(), bannerErr)
+			}
+		})
+	}
+}
+
+func Test_validateCreateRequest_Annotations(t *testing.T) {
+	newReq := func(a *scopes.Annotations) *pbs.CreateTargetRequest {
+		return &pbs.CreateTargetRequest{Item: &pb.Target{
+			ScopeId:     "p_1234567890",
+			Name:        wrapperspb.String("name"),
+			Type:        "tcp",
+			Annotations: a,
+		}}
+	}
+	tests := []struct {
+		name      string
+		in        *scopes.Annotations
+		wantField string
+	}{
+		{
+			name: "no-annotations",
+		},
+		{
+			name: "annotations",
+			in: &scopes.Annotations{
+				Owner:      wrapperspb.String("Platform Team"),
+				CostCenter: wrapperspb.String("CC-1234"),
+				TicketUrl:  wrapperspb.String("https://tickets.example.com/OPS-1"),
+			},
+		},
+		{
+			name:      "invalid-cost-center",
+			in:        &scopes.Annotations{CostCenter: wrapperspb.String("CC 1234")},
+			wantField: "annotations.cost_center",
+		},
+		{
+			name:      "invalid-ticket-url",
+			in:        &scopes.Annotations{TicketUrl: wrapperspb.String("OPS-1")},
+			wantField: "annotations.ticket_url",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCreateRequest(newReq(tt.in))
+			if tt.wantField != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), fmt.Sprintf("{name: %q", tt.wantField))
+				return
+			}
+			if err != nil {
+				assert.NotContains(t, err.Error(), "annotations.")
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ package tcp_test
 
 import (
 	"context"
+	"crypto/ed25519"
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
@@ -17,12 +18,14 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	apitargets "github.com/hashicorp/boundary/api/targets"
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/azure"
 	credstatic "github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
@@ -78,6 +81,7 @@ var testAuthorizedActions = []string{
 	"set-credential-sources",
 	"remove-credential-sources",
 	"authorize-session",
+	"list-available-hosts",
 }
 
 // Create a variable that we can overwrite in enterprise tests
@@ -109,10 +113,13 @@ func testService(t *testing.T, ctx context.Context, conn *db.DB, kms *kms.Kms, w
 	vaultCredRepoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(rw, rw, kms, sche)
 	}
+	azureCredRepoFn := func() (*azure.Repository, error) {
+		return azure.NewRepository(ctx, rw, rw, kms)
+	}
 	staticCredRepoFn := func() (*credstatic.Repository, error) {
 		return credstatic.NewRepository(context.Background(), rw, rw, kms)
 	}
-	return targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, nil, statusGracePeriod)
+	return targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, azureCredRepoFn, nil, statusGracePeriod, nil)
 }
 
 func TestGet(t *testing.T) {
@@ -1465,6 +1472,26 @@ func TestAddTargetHostSources(t *testing.T) {
 			},
 			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
 		},
+		{
+			name: "Fallback host source not in request",
+			req: &pbs.AddTargetHostSourcesRequest{
+				Id:                    tar.GetPublicId(),
+				Version:               tar.GetVersion(),
+				HostSourceIds:         []string{hs[0].GetPublicId()},
+				FallbackHostSourceIds: []string{hs[1].GetPublicId()},
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Duplicate fallback host source",
+			req: &pbs.AddTargetHostSourcesRequest{
+				Id:                    tar.GetPublicId(),
+				Version:               tar.GetVersion(),
+				HostSourceIds:         []string{hs[0].GetPublicId()},
+				FallbackHostSourceIds: []string{hs[0].GetPublicId(), hs[0].GetPublicId()},
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 	}
 	for _, tc := range failCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -1615,6 +1642,16 @@ func TestSetTargetHostSources(t *testing.T) {
 			},
 			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
 		},
+		{
+			name: "Fallback host source not in request",
+			req: &pbs.SetTargetHostSourcesRequest{
+				Id:                    tar.GetPublicId(),
+				Version:               tar.GetVersion(),
+				HostSourceIds:         []string{hs[0].GetPublicId()},
+				FallbackHostSourceIds: []string{hs[1].GetPublicId()},
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 	}
 	for _, tc := range failCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -1965,6 +2002,46 @@ func TestAddTargetCredentialSources(t *testing.T) {
 			},
 			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
 		},
+		{
+			name: "Exclusive library",
+			req: &pbs.AddTargetCredentialSourcesRequest{
+				Id:                           tar.GetPublicId(),
+				Version:                      tar.GetVersion(),
+				BrokeredCredentialSourceIds:  []string{cls[0].GetPublicId()},
+				ExclusiveCredentialSourceIds: []string{cls[0].GetPublicId()},
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Exclusive source not in request",
+			req: &pbs.AddTargetCredentialSourcesRequest{
+				Id:                           tar.GetPublicId(),
+				Version:                      tar.GetVersion(),
+				BrokeredCredentialSourceIds:  []string{cls[0].GetPublicId()},
+				ExclusiveCredentialSourceIds: []string{globals.UsernamePasswordCredentialPrefix + "_1234567890"},
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Fallback source not in request",
+			req: &pbs.AddTargetCredentialSourcesRequest{
+				Id:                          tar.GetPublicId(),
+				Version:                     tar.GetVersion(),
+				BrokeredCredentialSourceIds: []string{cls[0].GetPublicId()},
+				FallbackCredentialSourceIds: []string{cls[1].GetPublicId()},
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Duplicate fallback source",
+			req: &pbs.AddTargetCredentialSourcesRequest{
+				Id:                          tar.GetPublicId(),
+				Version:                     tar.GetVersion(),
+				BrokeredCredentialSourceIds: []string{cls[0].GetPublicId()},
+				FallbackCredentialSourceIds: []string{cls[0].GetPublicId(), cls[0].GetPublicId()},
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 	}
 	for _, tc := range failCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -2427,6 +2504,9 @@ func TestAuthorizeSession(t *testing.T) {
 	vaultCredRepoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(rw, rw, kms, sche)
 	}
+	azureCredRepoFn := func() (*azure.Repository, error) {
+		return azure.NewRepository(ctx, rw, rw, kms)
+	}
 	staticCredRepoFn := func() (*credstatic.Repository, error) {
 		return credstatic.NewRepository(ctx, rw, rw, kms)
 	}
@@ -2526,7 +2606,7 @@ func TestAuthorizeSession(t *testing.T) {
 	sec, tok := v.CreateToken(t, vault.WithPolicies([]string{"default", "boundary-controller", "pki"}))
 
 	vaultStore := vault.TestCredentialStore(t, conn, wrapper, proj.GetPublicId(), v.Addr, tok, sec.Auth.Accessor)
-	credService, err := credentiallibraries.NewService(vaultCredRepoFn, iamRepoFn)
+	credService, err := credentiallibraries.NewService(vaultCredRepoFn, azureCredRepoFn, iamRepoFn)
 	require.NoError(t, err)
 	clsResp, err := credService.CreateCredentialLibrary(ctx, &pbs.CreateCredentialLibraryRequest{Item: &credlibpb.CredentialLibrary{
 		CredentialStoreId: vaultStore.GetPublicId(),
@@ -2582,7 +2662,7 @@ func TestAuthorizeSession(t *testing.T) {
 
 	statusGracePeriod := new(atomic.Int64)
 	statusGracePeriod.Store(int64(server.DefaultLiveness))
-	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, nil, statusGracePeriod)
+	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, azureCredRepoFn, nil, statusGracePeriod, nil)
 	require.NoError(t, err)
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -2684,10 +2764,50 @@ func TestAuthorizeSession(t *testing.T) {
 
 			gotCred.Secret = nil
 
+			sad, err := apitargets.VerifySessionAuthorizationToken(got.GetAuthorizationToken(), map[string]ed25519.PublicKey{})
+			require.Error(t, err)
+			require.Nil(t, sad)
+			_, keyId, err := kms.SessionAuthorizationSigningKey(ctx, proj.GetPublicId())
+			require.NoError(t, err)
+			pubKey, err := kms.SessionAuthorizationPublicKey(ctx, proj.GetPublicId(), keyId)
+			require.NoError(t, err)
+			sad, err = apitargets.VerifySessionAuthorizationToken(got.GetAuthorizationToken(), map[string]ed25519.PublicKey{keyId: pubKey})
+			require.NoError(t, err)
+			assert.Equal(t, got.GetSessionId(), sad.SessionId)
+			assert.Equal(t, got.GetEndpoint(), sad.Endpoint)
+
 			got.AuthorizationToken, got.SessionId, got.CreatedTime = "", "", nil
 			assert.Empty(t, cmp.Diff(got, want, protocmp.Transform()))
 		})
 	}
+
+	t.Run("connection limit exemption", func(t *testing.T) {
+		tar := tcp.TestTarget(ctx, t, conn, proj.GetPublicId(), "connection limit exemption",
+			target.WithDefaultPort(defaultPort), target.WithSessionConnectionLimit(1))
+		_, err := s.AddTargetHostSources(ctx, &pbs.AddTargetHostSourcesRequest{
+			Id:            tar.GetPublicId(),
+			Version:       tar.GetVersion(),
+			HostSourceIds: []string{shs.GetPublicId()},
+		})
+		require.NoError(t, err)
+		server.TestKmsWorker(t, conn, wrapper)
+		sessionRepo, err := sessionRepoFn()
+		require.NoError(t, err)
+
+		// Granting all actions does not exempt the user from the limit
+		asRes, err := s.AuthorizeSession(ctx, &pbs.AuthorizeSessionRequest{Id: tar.GetPublicId()})
+		require.NoError(t, err)
+		sess, _, err := sessionRepo.LookupSession(ctx, asRes.GetItem().GetSessionId())
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), sess.ConnectionLimit)
+
+		_ = iam.TestRoleGrant(t, conn, r.GetPublicId(), "id=*;type=target;actions=exceed-connection-limit")
+		asRes, err = s.AuthorizeSession(ctx, &pbs.AuthorizeSessionRequest{Id: tar.GetPublicId()})
+		require.NoError(t, err)
+		sess, _, err = sessionRepo.LookupSession(ctx, asRes.GetItem().GetSessionId())
+		require.NoError(t, err)
+		assert.Equal(t, int32(-1), sess.ConnectionLimit)
+	})
 }
 
 func TestAuthorizeSessionTypedCredentials(t *testing.T) {
@@ -2722,6 +2842,9 @@ func TestAuthorizeSessionTypedCredentials(t *testing.T) {
 	vaultCredRepoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(rw, rw, kms, sche)
 	}
+	azureCredRepoFn := func() (*azure.Repository, error) {
+		return azure.NewRepository(ctx, rw, rw, kms)
+	}
 	staticCredRepoFn := func() (*credstatic.Repository, error) {
 		return credstatic.NewRepository(ctx, rw, rw, kms)
 	}
@@ -2751,7 +2874,7 @@ func TestAuthorizeSessionTypedCredentials(t *testing.T) {
 
 	statusGracePeriod := new(atomic.Int64)
 	statusGracePeriod.Store(int64(server.DefaultLiveness))
-	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, nil, statusGracePeriod)
+	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, azureCredRepoFn, nil, statusGracePeriod, nil)
 	require.NoError(t, err)
 
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
@@ -2764,7 +2887,7 @@ func TestAuthorizeSessionTypedCredentials(t *testing.T) {
 	sec, tok := v.CreateToken(t, vault.WithPolicies([]string{"default", "boundary-controller", "secret"}))
 
 	vaultStore := vault.TestCredentialStore(t, conn, wrapper, proj.GetPublicId(), v.Addr, tok, sec.Auth.Accessor)
-	credLibService, err := credentiallibraries.NewService(vaultCredRepoFn, iamRepoFn)
+	credLibService, err := credentiallibraries.NewService(vaultCredRepoFn, azureCredRepoFn, iamRepoFn)
 	require.NoError(t, err)
 
 	// Create secret in vault with default username and password fields
@@ -3314,6 +3437,9 @@ func TestAuthorizeSession_Errors(t *testing.T) {
 	vaultCredRepoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(rw, rw, kms, sche)
 	}
+	azureCredRepoFn := func() (*azure.Repository, error) {
+		return azure.NewRepository(ctx, rw, rw, kms)
+	}
 	staticCredRepoFn := func() (*credstatic.Repository, error) {
 		return credstatic.NewRepository(ctx, rw, rw, kms)
 	}
@@ -3324,7 +3450,7 @@ func TestAuthorizeSession_Errors(t *testing.T) {
 
 	statusGracePeriod := new(atomic.Int64)
 	statusGracePeriod.Store(int64(server.DefaultLiveness))
-	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, nil, statusGracePeriod)
+	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, azureCredRepoFn, nil, statusGracePeriod, nil)
 	require.NoError(t, err)
 
 	// Authorized user gets full permissions
@@ -3411,7 +3537,7 @@ func TestAuthorizeSession_Errors(t *testing.T) {
 	}
 
 	libraryExists := func(tar target.Target) (version uint32) {
-		credService, err := credentiallibraries.NewService(vaultCredRepoFn, iamRepoFn)
+		credService, err := credentiallibraries.NewService(vaultCredRepoFn, azureCredRepoFn, iamRepoFn)
 		require.NoError(t, err)
 		clsResp, err := credService.CreateCredentialLibrary(ctx, &pbs.CreateCredentialLibraryRequest{Item: &credlibpb.CredentialLibrary{
 			CredentialStoreId: store.GetPublicId(),
@@ -3436,7 +3562,7 @@ func TestAuthorizeSession_Errors(t *testing.T) {
 	}
 
 	misConfiguredlibraryExists := func(tar target.Target) (version uint32) {
-		credService, err := credentiallibraries.NewService(vaultCredRepoFn, iamRepoFn)
+		credService, err := credentiallibraries.NewService(vaultCredRepoFn, azureCredRepoFn, iamRepoFn)
 		require.NoError(t, err)
 		clsResp, err := credService.CreateCredentialLibrary(ctx, &pbs.CreateCredentialLibraryRequest{Item: &credlibpb.CredentialLibrary{
 			CredentialStoreId: store.GetPublicId(),
@@ -3461,7 +3587,7 @@ func TestAuthorizeSession_Errors(t *testing.T) {
 	}
 
 	expiredTokenLibrary := func(tar target.Target) (version uint32) {
-		credService, err := credentiallibraries.NewService(vaultCredRepoFn, iamRepoFn)
+		credService, err := credentiallibraries.NewService(vaultCredRepoFn, azureCredRepoFn, iamRepoFn)
 		require.NoError(t, err)
 		clsResp, err := credService.CreateCredentialLibrary(ctx, &pbs.CreateCredentialLibraryRequest{Item: &credlibpb.CredentialLibrary{
 			CredentialStoreId: expiredStore.GetPublicId(),
@@ -3550,3 +3676,95 @@ func decodeJsonSecret(t *testing.T, in string) map[string]any {
 	require.NoError(t, dec.Decode(&ret))
 	return ret
 }
+
+func TestListAvailableHosts(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(rw, rw, kms)
+	}
+	atRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(rw, rw, kms)
+	}
+	passwordAuthRepoFn := func() (*password.Repository, error) {
+		return password.NewRepository(rw, rw, kms)
+	}
+	oidcAuthRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(ctx, rw, rw, kms)
+	}
+	ldapAuthRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
+
+	org, proj := iam.TestScopes(t, iamRepo)
+	at := authtoken.TestAuthToken(t, conn, kms, org.GetPublicId())
+	ctx = auth.NewVerifierContextWithAccounts(requests.NewRequestContext(ctx),
+		iamRepoFn,
+		atRepoFn,
+		serversRepoFn,
+		passwordAuthRepoFn,
+		oidcAuthRepoFn,
+		ldapAuthRepoFn,
+		kms,
+		&authpb.RequestInfo{
+			Token:       at.GetToken(),
+			TokenFormat: uint32(auth.AuthTokenTypeBearer),
+			PublicId:    at.GetPublicId(),
+		})
+
+	r := iam.TestRole(t, conn, proj.GetPublicId())
+	_ = iam.TestUserRole(t, conn, r.GetPublicId(), at.GetIamUserId())
+	_ = iam.TestRoleGrant(t, conn, r.GetPublicId(), "id=*;type=target;actions=list-available-hosts")
+
+	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
+	hs := static.TestHosts(t, conn, hc.GetPublicId(), 2)
+	shs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+	_ = static.TestSetMembers(t, conn, shs.GetPublicId(), hs)
+
+	tar := tcp.TestTarget(ctx, t, conn, proj.GetPublicId(), "test", target.WithHostSources([]string{shs.GetPublicId()}))
+	addressTar := tcp.TestTarget(ctx, t, conn, proj.GetPublicId(), "address", target.WithAddress("127.0.0.1"))
+
+	s, err := testService(t, ctx, conn, kms, wrapper)
+	require.NoError(t, err)
+
+	// the hosts are not reachable without authorize-session
+	got, err := s.ListAvailableHosts(ctx, &pbs.ListAvailableHostsRequest{Id: tar.GetPublicId()})
+	require.NoError(t, err)
+	assert.Empty(t, got.GetItems())
+
+	_ = iam.TestRoleGrant(t, conn, r.GetPublicId(), "id=*;type=target;actions=authorize-session")
+	got, err = s.ListAvailableHosts(ctx, &pbs.ListAvailableHostsRequest{Id: tar.GetPublicId()})
+	require.NoError(t, err)
+	want := &pbs.ListAvailableHostsResponse{Items: []*pb.AvailableHost{
+		{HostId: hs[0].GetPublicId(), HostSourceId: shs.GetPublicId(), Address: hs[0].GetAddress(), Name: hs[0].GetName()},
+		{HostId: hs[1].GetPublicId(), HostSourceId: shs.GetPublicId(), Address: hs[1].GetAddress(), Name: hs[1].GetName()},
+	}}
+	assert.Empty(t, cmp.Diff(want, got, protocmp.Transform(), protocmp.SortRepeated(func(a, b *pb.AvailableHost) bool {
+		return a.GetHostId() < b.GetHostId()
+	})))
+
+	// the target can be looked up by name and scope
+	got, err = s.ListAvailableHosts(ctx, &pbs.ListAvailableHostsRequest{Id: tar.GetName(), Name: tar.GetName(), ScopeId: proj.GetPublicId()})
+	require.NoError(t, err)
+	assert.Empty(t, cmp.Diff(want, got, protocmp.Transform(), protocmp.SortRepeated(func(a, b *pb.AvailableHost) bool {
+		return a.GetHostId() < b.GetHostId()
+	})))
+
+	// targets with an address have no hosts
+	got, err = s.ListAvailableHosts(ctx, &pbs.ListAvailableHostsRequest{Id: addressTar.GetPublicId()})
+	require.NoError(t, err)
+	assert.Empty(t, got.GetItems())
+
+	_, err = s.ListAvailableHosts(ctx, &pbs.ListAvailableHostsRequest{Id: "hst_1234567890"})
+	assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)))
+
+	_, err = s.ListAvailableHosts(ctx, &pbs.ListAvailableHostsRequest{Id: tcp.TargetPrefix + "_1234567890"})
+	assert.True(t, errors.Is(err, handlers.NotFoundError()))
+}
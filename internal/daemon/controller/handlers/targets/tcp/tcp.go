@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/boundary/internal/target/store"
 	"github.com/hashicorp/boundary/internal/target/tcp"
 	tcpStore "github.com/hashicorp/boundary/internal/target/tcp/store"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
 )
 
@@ -83,7 +84,7 @@ func init() {
 
 	if maskManager, err = handlers.NewMaskManager(
 		handlers.MaskDestination{&tcpStore.Target{}, &store.TargetAddress{}},
-		handlers.MaskSource{&pb.Target{}, &pb.TcpTargetAttributes{}},
+		handlers.MaskSource{&pb.Target{}, &pb.TcpTargetAttributes{}, &scopes.Annotations{}},
 	); err != nil {
 		panic(err)
 	}
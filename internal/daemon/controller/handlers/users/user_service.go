@@ -157,11 +157,15 @@ func (s Service) GetUser(ctx context.Context, req *pbs.GetUserRequest) (*pbs.Get
 	if err := validateGetRequest(req); err != nil {
 		return nil, err
 	}
-	authResults := s.authResult(ctx, req.GetId(), action.Read)
+	id, err := handlers.LookupId(ctx, req, s.lookupIdByName)
+	if err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, id, action.Read)
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	u, accts, err := s.getFromRepo(ctx, req.GetId())
+	u, accts, err := s.getFromRepo(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -559,6 +563,15 @@ func (s Service) removeInRepo(ctx context.Context, userId string, accountIds []s
 	return out, accts, nil
 }
 
+// lookupIdByName returns the ID of the user named name in the scope with scopeId.
+func (s Service) lookupIdByName(ctx context.Context, scopeId, name string) (string, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return "", err
+	}
+	return repo.LookupPublicIdByName(ctx, resource.User, scopeId, name)
+}
+
 func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.VerifyResults {
 	res := auth.VerifyResults{}
 	repo, err := s.repoFn()
@@ -666,7 +679,7 @@ func toProto(ctx context.Context, in *iam.User, accts []string, opt ...handlers.
 //   - All required parameters are set
 //   - There are no conflicting parameters provided
 func validateGetRequest(req *pbs.GetUserRequest) error {
-	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.UserPrefix)
+	return handlers.ValidateNameLookupGetRequest(handlers.NoopValidatorFn, req, globals.UserPrefix)
 }
 
 func validateCreateRequest(req *pbs.CreateUserRequest) error {
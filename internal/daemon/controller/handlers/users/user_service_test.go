@@ -118,12 +118,29 @@ func TestGet(t *testing.T) {
 			req:  &pbs.GetUserRequest{Id: u.GetPublicId()},
 			res:  &pbs.GetUserResponse{Item: wantU},
 		},
+		{
+			name: "Get an Existing User by Name",
+			req:  &pbs.GetUserRequest{Id: u.GetName(), Name: u.GetName(), ScopeId: u.GetScopeId()},
+			res:  &pbs.GetUserResponse{Item: wantU},
+		},
 		{
 			name: "Get a non existant User",
 			req:  &pbs.GetUserRequest{Id: globals.UserPrefix + "_DoesntExis"},
 			res:  nil,
 			err:  handlers.ApiErrorWithCode(codes.NotFound),
 		},
+		{
+			name: "Get a non existant User by Name",
+			req:  &pbs.GetUserRequest{Id: "doesnt-exist", Name: "doesnt-exist", ScopeId: u.GetScopeId()},
+			res:  nil,
+			err:  handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name: "Name without scope id",
+			req:  &pbs.GetUserRequest{Id: u.GetName(), Name: u.GetName()},
+			res:  nil,
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 		{
 			name: "Wrong id prefix",
 			req:  &pbs.GetUserRequest{Id: "j_1234567890"},
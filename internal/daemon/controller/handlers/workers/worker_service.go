@@ -9,8 +9,11 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	"github.com/hashicorp/boundary/globals"
+	wl "github.com/hashicorp/boundary/internal/daemon/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common/scopeids"
@@ -26,6 +29,7 @@ import (
 	"github.com/hashicorp/boundary/internal/types/scope"
 	"github.com/hashicorp/boundary/internal/util"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/workers"
+	"github.com/hashicorp/go-bexpr"
 	"github.com/hashicorp/go-secure-stdlib/strutil"
 	"github.com/hashicorp/nodeenrollment/types"
 	"github.com/mr-tron/base58"
@@ -63,6 +67,7 @@ var (
 		action.List,
 		action.ReadCertificateAuthority,
 		action.ReinitializeCertificateAuthority,
+		action.TestWorkerFilter,
 	}
 	// downstreamWorkers returns a list of worker ids which are directly
 	// connected downstream of the provided worker.
@@ -88,13 +93,19 @@ type Service struct {
 	workerAuthFn common.WorkerAuthRepoStorageFactory
 	iamRepoFn    common.IamRepoFactory
 	downstreams  common.Downstreamers
+
+	// workerStatusGracePeriod is the amount of time since a worker's last
+	// status update after which it is no longer considered connected.
+	workerStatusGracePeriod *atomic.Int64
 }
 
 var _ pbs.WorkerServiceServer = (*Service)(nil)
 
 // NewService returns a worker service which handles worker related requests to boundary.
+// If workerStatusGracePeriod is nil, the default liveness is used to determine
+// which workers are connected.
 func NewService(ctx context.Context, repo common.ServersRepoFactory, iamRepoFn common.IamRepoFactory,
-	workerAuthFn common.WorkerAuthRepoStorageFactory, ds common.Downstreamers,
+	workerAuthFn common.WorkerAuthRepoStorageFactory, ds common.Downstreamers, workerStatusGracePeriod *atomic.Int64,
 ) (Service, error) {
 	const op = "workers.NewService"
 	if repo == nil {
@@ -106,7 +117,7 @@ func NewService(ctx context.Context, repo common.ServersRepoFactory, iamRepoFn c
 	if workerAuthFn == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing worker auth repository")
 	}
-	return Service{repoFn: repo, iamRepoFn: iamRepoFn, workerAuthFn: workerAuthFn, downstreams: ds}, nil
+	return Service{repoFn: repo, iamRepoFn: iamRepoFn, workerAuthFn: workerAuthFn, downstreams: ds, workerStatusGracePeriod: workerStatusGracePeriod}, nil
 }
 
 // ListWorkers implements the interface pbs.WorkerServiceServer.
@@ -157,6 +168,7 @@ func (s Service) ListWorkers(ctx context.Context, req *pbs.ListWorkersRequest) (
 	for _, item := range ul {
 		res.Id = item.GetPublicId()
 		res.ScopeId = item.GetScopeId()
+		res.Tags = item.CanonicalTags()
 		authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
 		if len(authorizedActions) == 0 {
 			continue
@@ -517,6 +529,73 @@ func (s Service) ReinitializeCertificateAuthority(ctx context.Context, req *pbs.
 	return &pbs.ReinitializeCertificateAuthorityResponse{Item: ca}, nil
 }
 
+// TestWorkerFilter returns the connected workers which match the provided
+// worker filter. It allows a filter to be checked against the live workers
+// before it is set on a target.
+func (s Service) TestWorkerFilter(ctx context.Context, req *pbs.TestWorkerFilterRequest) (*pbs.TestWorkerFilterResponse, error) {
+	const op = "workers.(Service).TestWorkerFilter"
+	if err := validateTestWorkerFilterRequest(req); err != nil {
+		return nil, err
+	}
+
+	authResults := s.authResult(ctx, req.GetScopeId(), action.TestWorkerFilter)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+
+	eval, err := bexpr.CreateEvaluator(req.GetFilter())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	var liveness time.Duration
+	if s.workerStatusGracePeriod != nil {
+		liveness = time.Duration(s.workerStatusGracePeriod.Load())
+	}
+	workers, err := repo.ListWorkers(ctx, []string{scope.Global.String()}, server.WithLiveness(liveness))
+	if err != nil {
+		return nil, err
+	}
+	matched, err := wl.WorkerList(workers).Filtered(eval)
+	if err != nil {
+		return nil, err
+	}
+
+	finalItems := make([]*pb.Worker, 0, len(matched))
+	res := perms.Resource{
+		Type: resource.Worker,
+	}
+	for _, item := range matched {
+		res.Id = item.GetPublicId()
+		res.ScopeId = item.GetScopeId()
+		res.Tags = item.CanonicalTags()
+		authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
+		if len(authorizedActions) == 0 {
+			continue
+		}
+
+		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+		outputOpts := make([]handlers.Option, 0, 3)
+		outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+		if outputFields.Has(globals.ScopeField) {
+			outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+		}
+		if outputFields.Has(globals.AuthorizedActionsField) {
+			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
+		}
+
+		item, err := s.toProto(ctx, item, outputOpts...)
+		if err != nil {
+			return nil, err
+		}
+		finalItems = append(finalItems, item)
+	}
+	return &pbs.TestWorkerFilterResponse{Items: finalItems}, nil
+}
+
 func (s Service) listFromRepo(ctx context.Context, scopeIds []string) ([]*server.Worker, error) {
 	repo, err := s.repoFn()
 	if err != nil {
@@ -702,7 +781,7 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 	var parentId string
 	opts := []auth.Option{auth.WithType(resource.Worker), auth.WithAction(a)}
 	switch a {
-	case action.List, action.CreateWorkerLed, action.CreateControllerLed, action.ReadCertificateAuthority, action.ReinitializeCertificateAuthority:
+	case action.List, action.CreateWorkerLed, action.CreateControllerLed, action.ReadCertificateAuthority, action.ReinitializeCertificateAuthority, action.TestWorkerFilter:
 		parentId = id
 	default:
 		w, err := repo.LookupWorker(ctx, id)
@@ -715,7 +794,7 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 			return res
 		}
 		parentId = w.GetScopeId()
-		opts = append(opts, auth.WithId(id))
+		opts = append(opts, auth.WithId(id), auth.WithTags(w.CanonicalTags()))
 	}
 	opts = append(opts, auth.WithScopeId(parentId))
 	return auth.Verify(ctx, opts...)
@@ -1129,3 +1208,22 @@ func validateReinitCaRequest(req *pbs.ReinitializeCertificateAuthorityRequest) e
 
 	return nil
 }
+
+func validateTestWorkerFilterRequest(req *pbs.TestWorkerFilterRequest) error {
+	badFields := map[string]string{}
+	if req.GetScopeId() != scope.Global.String() {
+		badFields["scope_id"] = "Must be 'global' when testing a worker filter."
+	}
+	switch {
+	case req.GetFilter() == "":
+		badFields["filter"] = "This field is required."
+	default:
+		if _, err := bexpr.CreateEvaluator(req.GetFilter()); err != nil {
+			badFields["filter"] = "Unable to successfully parse filter expression."
+		}
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
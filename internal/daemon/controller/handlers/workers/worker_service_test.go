@@ -221,7 +221,7 @@ func TestGet(t *testing.T) {
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			s, err := NewService(context.Background(), repoFn, iamRepoFn, workerAuthRepoFn, nil)
+			s, err := NewService(context.Background(), repoFn, iamRepoFn, workerAuthRepoFn, nil, nil)
 			require.NoError(t, err, "Couldn't create new worker service.")
 
 			got, err := s.GetWorker(auth.DisabledAuthTestContext(iamRepoFn, tc.scopeId), tc.req)
@@ -354,7 +354,7 @@ func TestList(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := NewService(context.Background(), repoFn, iamRepoFn, workerAuthRepoFn, nil)
+			s, err := NewService(context.Background(), repoFn, iamRepoFn, workerAuthRepoFn, nil, nil)
 			require.NoError(err, "Couldn't create new worker service.")
 
 			// Test with a non-anon user
@@ -407,7 +407,7 @@ func TestDelete(t *testing.T) {
 		return workerAuthRepo, nil
 	}
 
-	s, err := NewService(ctx, repoFn, iamRepoFn, workerAuthRepoFn, nil)
+	s, err := NewService(ctx, repoFn, iamRepoFn, workerAuthRepoFn, nil, nil)
 	require.NoError(t, err, "Error when getting new worker service.")
 
 	w := server.TestKmsWorker(t, conn, wrap)
@@ -504,7 +504,7 @@ func TestUpdate(t *testing.T) {
 	toMerge := &pbs.UpdateWorkerRequest{
 		Id: wkr.GetPublicId(),
 	}
-	workerService, err := NewService(ctx, repoFn, iamRepoFn, workerAuthRepoFn, nil)
+	workerService, err := NewService(ctx, repoFn, iamRepoFn, workerAuthRepoFn, nil, nil)
 	require.NoError(t, err)
 	expectedScope := &scopes.ScopeInfo{Id: scope.Global.String(), Type: scope.Global.String(), Name: scope.Global.String(), Description: "Global Scope"}
 
@@ -916,7 +916,7 @@ func TestUpdate_KMS(t *testing.T) {
 	toMerge := &pbs.UpdateWorkerRequest{
 		Id: wkr.GetPublicId(),
 	}
-	workerService, err := NewService(ctx, repoFn, iamRepoFn, workerAuthRepoFn, nil)
+	workerService, err := NewService(ctx, repoFn, iamRepoFn, workerAuthRepoFn, nil, nil)
 	require.NoError(t, err)
 
 	cases := []struct {
@@ -996,7 +996,7 @@ func TestUpdate_BadVersion(t *testing.T) {
 		return repo, nil
 	}
 
-	workerService, err := NewService(ctx, repoFn, iamRepoFn, workerAuthRepoFn, nil)
+	workerService, err := NewService(ctx, repoFn, iamRepoFn, workerAuthRepoFn, nil, nil)
 	require.NoError(t, err, "Failed to create a new host set service.")
 
 	wkr := server.TestPkiWorker(t, conn, wrapper)
@@ -1034,7 +1034,7 @@ func TestCreateWorkerLed(t *testing.T) {
 		return workerAuthRepo, nil
 	}
 
-	testSrv, err := NewService(testCtx, repoFn, iamRepoFn, workerAuthRepoFn, nil)
+	testSrv, err := NewService(testCtx, repoFn, iamRepoFn, workerAuthRepoFn, nil, nil)
 	require.NoError(t, err, "Error when getting new worker service.")
 
 	// Get an initial set of authorized node credentials
@@ -1286,7 +1286,7 @@ func TestCreateWorkerLed(t *testing.T) {
 				repoFn := func() (*server.Repository, error) {
 					return server.NewRepository(rw, &db.Db{}, testKms)
 				}
-				testSrv, err := NewService(testCtx, repoFn, iamRepoFn, workerAuthRepoFn, nil)
+				testSrv, err := NewService(testCtx, repoFn, iamRepoFn, workerAuthRepoFn, nil, nil)
 				require.NoError(t, err, "Error when getting new worker service.")
 				return testSrv
 			}(),
@@ -1319,7 +1319,7 @@ func TestCreateWorkerLed(t *testing.T) {
 						return server.NewRepository(rw, rw, testKms)
 					}
 				}
-				testSrv, err := NewService(testCtx, repoFn, iamRepoFn, workerAuthRepoFn, nil)
+				testSrv, err := NewService(testCtx, repoFn, iamRepoFn, workerAuthRepoFn, nil, nil)
 				require.NoError(t, err, "Error when getting new worker service.")
 				return testSrv
 			}(),
@@ -1419,7 +1419,7 @@ func TestCreateControllerLed(t *testing.T) {
 		return rootStorage, nil
 	}
 
-	testSrv, err := NewService(testCtx, repoFn, iamRepoFn, authRepoFn, nil)
+	testSrv, err := NewService(testCtx, repoFn, iamRepoFn, authRepoFn, nil, nil)
 	require.NoError(t, err, "Error when getting new worker service.")
 
 	// Get an initial set of authorized node credentials
@@ -1633,7 +1633,7 @@ func TestCreateControllerLed(t *testing.T) {
 				repoFn := func() (*server.Repository, error) {
 					return server.NewRepository(rw, &db.Db{}, testKms)
 				}
-				testSrv, err := NewService(testCtx, repoFn, iamRepoFn, authRepoFn, nil)
+				testSrv, err := NewService(testCtx, repoFn, iamRepoFn, authRepoFn, nil, nil)
 				require.NoError(t, err, "Error when getting new worker service.")
 				return testSrv
 			}(),
@@ -1665,7 +1665,7 @@ func TestCreateControllerLed(t *testing.T) {
 						return server.NewRepository(rw, rw, testKms)
 					}
 				}
-				testSrv, err := NewService(testCtx, repoFn, iamRepoFn, authRepoFn, nil)
+				testSrv, err := NewService(testCtx, repoFn, iamRepoFn, authRepoFn, nil, nil)
 				require.NoError(t, err, "Error when getting new worker service.")
 				return testSrv
 			}(),
@@ -1767,7 +1767,7 @@ func TestService_AddWorkerTags(t *testing.T) {
 	workerAuthRepoFn := func() (*server.WorkerAuthRepositoryStorage, error) {
 		return workerAuthRepo, nil
 	}
-	s, err := NewService(context.Background(), repoFn, iamRepoFn, workerAuthRepoFn, nil)
+	s, err := NewService(context.Background(), repoFn, iamRepoFn, workerAuthRepoFn, nil, nil)
 	require.NoError(err)
 	worker := server.TestKmsWorker(t, conn, wrapper)
 
@@ -1915,7 +1915,7 @@ func TestService_SetWorkerTags(t *testing.T) {
 	workerAuthRepoFn := func() (*server.WorkerAuthRepositoryStorage, error) {
 		return workerAuthRepo, nil
 	}
-	s, err := NewService(context.Background(), repoFn, iamRepoFn, workerAuthRepoFn, nil)
+	s, err := NewService(context.Background(), repoFn, iamRepoFn, workerAuthRepoFn, nil, nil)
 	require.NoError(err)
 	worker := server.TestKmsWorker(t, conn, wrapper)
 
@@ -2066,7 +2066,7 @@ func TestService_RemoveWorkerTags(t *testing.T) {
 	workerAuthRepoFn := func() (*server.WorkerAuthRepositoryStorage, error) {
 		return workerAuthRepo, nil
 	}
-	s, err := NewService(context.Background(), repoFn, iamRepoFn, workerAuthRepoFn, nil)
+	s, err := NewService(context.Background(), repoFn, iamRepoFn, workerAuthRepoFn, nil, nil)
 	require.NoError(err)
 	worker := server.TestKmsWorker(t, conn, wrapper)
 
@@ -2265,7 +2265,7 @@ func TestReadCertificateAuthority(t *testing.T) {
 	_, err = rotation.RotateRootCertificates(ctx, workerAuthRepo)
 	require.NoError(err)
 
-	testSrv, err := NewService(ctx, repoFn, iamRepoFn, workerAuthRepoFn, nil)
+	testSrv, err := NewService(ctx, repoFn, iamRepoFn, workerAuthRepoFn, nil, nil)
 	require.NoError(err, "Error when getting new worker service.")
 
 	tests := []struct {
@@ -2341,7 +2341,7 @@ func TestReinitializeCertificateAuthority(t *testing.T) {
 	_, err = rotation.RotateRootCertificates(ctx, workerAuthRepo)
 	require.NoError(err)
 
-	testSrv, err := NewService(ctx, repoFn, iamRepoFn, workerAuthRepoFn, nil)
+	testSrv, err := NewService(ctx, repoFn, iamRepoFn, workerAuthRepoFn, nil, nil)
 	require.NoError(err, "Error when getting new worker service.")
 
 	tests := []struct {
@@ -2389,3 +2389,86 @@ func TestReinitializeCertificateAuthority(t *testing.T) {
 		})
 	}
 }
+
+func TestTestWorkerFilter(t *testing.T) {
+	ctx := context.Background()
+	wrapper := db.TestWrapper(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	repoFn := func() (*server.Repository, error) {
+		return server.NewRepository(rw, rw, kmsCache)
+	}
+	workerAuthRepo, err := server.NewRepositoryStorage(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+	workerAuthRepoFn := func() (*server.WorkerAuthRepositoryStorage, error) {
+		return workerAuthRepo, nil
+	}
+
+	east := server.TestKmsWorker(t, conn, wrapper, server.WithName("east"), server.WithWorkerTags(&server.Tag{Key: "region", Value: "us-east-1"}))
+	_ = server.TestKmsWorker(t, conn, wrapper, server.WithName("west"), server.WithWorkerTags(&server.Tag{Key: "region", Value: "us-west-2"}))
+	// PKI workers which have never sent a status are not connected
+	_ = server.TestPkiWorker(t, conn, wrapper, server.WithName("offline"))
+
+	testSrv, err := NewService(ctx, repoFn, iamRepoFn, workerAuthRepoFn, nil, nil)
+	require.NoError(t, err, "Error when getting new worker service.")
+
+	tests := []struct {
+		name            string
+		req             *pbs.TestWorkerFilterRequest
+		wantIds         []string
+		wantErrIs       error
+		wantErrContains string
+	}{
+		{
+			name:            "invalid-scope",
+			req:             &pbs.TestWorkerFilterRequest{ScopeId: "o_1234567890", Filter: `"/name" == "east"`},
+			wantErrIs:       handlers.ApiErrorWithCode(codes.InvalidArgument),
+			wantErrContains: "Must be 'global'",
+		},
+		{
+			name:            "missing-filter",
+			req:             &pbs.TestWorkerFilterRequest{ScopeId: scope.Global.String()},
+			wantErrIs:       handlers.ApiErrorWithCode(codes.InvalidArgument),
+			wantErrContains: "This field is required.",
+		},
+		{
+			name:            "invalid-filter",
+			req:             &pbs.TestWorkerFilterRequest{ScopeId: scope.Global.String(), Filter: `"/name" ==`},
+			wantErrIs:       handlers.ApiErrorWithCode(codes.InvalidArgument),
+			wantErrContains: "Unable to successfully parse filter expression.",
+		},
+		{
+			name:    "match-tag",
+			req:     &pbs.TestWorkerFilterRequest{ScopeId: scope.Global.String(), Filter: `"us-east-1" in "/tags/region"`},
+			wantIds: []string{east.GetPublicId()},
+		},
+		{
+			name: "no-match",
+			req:  &pbs.TestWorkerFilterRequest{ScopeId: scope.Global.String(), Filter: `"/name" == "offline"`},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := testSrv.TestWorkerFilter(auth.DisabledAuthTestContext(iamRepoFn, scope.Global.String()), tc.req)
+			if tc.wantErrIs != nil {
+				require.Error(err)
+				assert.ErrorIs(err, tc.wantErrIs)
+				assert.Contains(err.Error(), tc.wantErrContains)
+				return
+			}
+			require.NoError(err)
+			var gotIds []string
+			for _, w := range got.GetItems() {
+				gotIds = append(gotIds, w.GetId())
+			}
+			assert.ElementsMatch(tc.wantIds, gotIds)
+		})
+	}
+}
@@ -7,14 +7,18 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"path"
 	"reflect"
 	"runtime/debug"
+	"strings"
 
 	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	commonSrv "github.com/hashicorp/boundary/internal/daemon/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
+	"github.com/hashicorp/boundary/internal/daemon/controller/common/authzcache"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/deprecation"
 	"github.com/hashicorp/boundary/internal/errors"
 	pb "github.com/hashicorp/boundary/internal/gen/controller/api"
 	authpb "github.com/hashicorp/boundary/internal/gen/controller/auth"
@@ -22,12 +26,15 @@ import (
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/observability/event"
 	"github.com/hashicorp/boundary/internal/requests"
+	"github.com/hashicorp/boundary/internal/subscription"
 	"github.com/mr-tron/base58"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 const (
@@ -121,8 +128,10 @@ func requestCtxInterceptor(
 		// We could use requests.NewRequestContext but this saves an immediate
 		// lookup.
 		interceptorCtx = context.WithValue(interceptorCtx, requests.ContextRequestInformationKey, &requests.RequestContext{
-			Path:   requestInfo.Path,
-			Method: requestInfo.Method,
+			Path:                         requestInfo.Path,
+			Method:                       requestInfo.Method,
+			ClientIp:                     requestInfo.ClientIp,
+			ClientCertificateFingerprint: requestInfo.ClientCertificateFingerprint,
 		})
 
 		// This event request info is required by downstream handlers
@@ -201,6 +210,230 @@ func errorInterceptor(
 	}
 }
 
+// attributeDepthInterceptor rejects requests whose attributes, or any other
+// structpb fields, nest objects and lists deeper than maxDepth. A maxDepth
+// less than 1 disables the check.
+func attributeDepthInterceptor(
+	_ context.Context,
+	maxDepth int,
+) grpc.UnaryServerInterceptor {
+	return func(interceptorCtx context.Context,
+		req any,
+		_ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (any, error,
+	) {
+		if m, ok := req.(proto.Message); ok && maxDepth > 0 {
+			if depth := structDepth(m.ProtoReflect()); depth > maxDepth {
+				return nil, handlers.RequestTooLargeErrorf("Request attributes are nested %d levels deep, which exceeds the maximum depth of %d.", depth, maxDepth)
+			}
+		}
+		return handler(interceptorCtx, req)
+	}
+}
+
+// authorizationCacheInterceptor drops cached session authorization decisions
+// once a request that may change them succeeds. Changes to a target only drop
+// the decisions made for it; any other change, such as to roles, host sets or
+// credential libraries, drops all of them, as working out which decisions it
+// affects would cost more than making them again.
+func authorizationCacheInterceptor(
+	_ context.Context,
+	cache *authzcache.Cache,
+) grpc.UnaryServerInterceptor {
+	return func(interceptorCtx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (any, error,
+	) {
+		resp, err := handler(interceptorCtx, req)
+		if cache == nil || err != nil || info == nil {
+			return resp, err
+		}
+		service, method := path.Split(info.FullMethod)
+		switch {
+		case strings.HasPrefix(method, "Get"),
+			strings.HasPrefix(method, "List"),
+			method == "AuthorizeSession",
+			method == "Authenticate":
+			return resp, err
+		}
+		if r, ok := req.(interface{ GetId() string }); ok &&
+			strings.HasSuffix(strings.TrimSuffix(service, "/"), ".TargetService") && r.GetId() != "" {
+			cache.InvalidateTarget(r.GetId())
+			return resp, err
+		}
+		cache.InvalidateAll()
+		return resp, err
+	}
+}
+
+// userAgentMdKey is the metadata key the grpc gateway forwards the http
+// User-Agent header as.
+const userAgentMdKey = "grpcgateway-user-agent"
+
+// deprecationInterceptor returns the deprecated fields and behaviors recorded
+// while handling a request to the client as headers, and counts their use by
+// the client in tracker.
+func deprecationInterceptor(
+	_ context.Context,
+	tracker *deprecation.Tracker,
+) grpc.UnaryServerInterceptor {
+	const op = "controller.deprecationInterceptor"
+	return func(interceptorCtx context.Context,
+		req any,
+		_ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (any, error,
+	) {
+		resp, err := handler(interceptorCtx, req)
+		ids := deprecation.Recorded(interceptorCtx)
+		if len(ids) == 0 {
+			return resp, err
+		}
+
+		client := deprecation.Client{}
+		if reqCtx, ok := requests.RequestContextFromCtx(interceptorCtx); ok {
+			client.UserId = reqCtx.UserId
+			client.ClientIp = reqCtx.ClientIp
+		}
+		if md, ok := metadata.FromIncomingContext(interceptorCtx); ok {
+			if ua := md.Get(userAgentMdKey); len(ua) > 0 {
+				client.UserAgent = ua[0]
+			}
+		}
+		tracker.Observe(client, ids...)
+
+		values := make([]string, 0, len(ids))
+		for _, id := range ids {
+			values = append(values, string(id))
+		}
+		if hdrErr := grpc.SetHeader(interceptorCtx, metadata.MD{handlers.DeprecationHeader: values}); hdrErr != nil {
+			event.WriteError(interceptorCtx, op, hdrErr, event.WithInfoMsg("unable to set deprecation header"))
+		}
+		return resp, err
+	}
+}
+
+// eventSubscriptionInterceptor queues a change for notifier when a request
+// changing a resource succeeds, so the users subscribed to the resource are
+// notified.
+func eventSubscriptionInterceptor(
+	_ context.Context,
+	notifier *subscription.Notifier,
+) grpc.UnaryServerInterceptor {
+	return func(interceptorCtx context.Context,
+		req any,
+		_ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (any, error,
+	) {
+		resp, err := handler(interceptorCtx, req)
+		if notifier == nil || err != nil {
+			return resp, err
+		}
+		reqCtx, ok := requests.RequestContextFromCtx(interceptorCtx)
+		if !ok || reqCtx.Resource == nil || !subscription.IsChange(reqCtx.Action) {
+			return resp, err
+		}
+		id := reqCtx.Resource.Id
+		if id == "" {
+			// The id of a created resource is only known from the response.
+			id = responseItemId(resp)
+		}
+		if id == "" {
+			return resp, err
+		}
+		notifier.Notify(interceptorCtx, &subscription.Change{
+			ScopeId:      reqCtx.Resource.ScopeId,
+			ResourceId:   id,
+			ResourceType: reqCtx.Resource.Type,
+			Pin:          reqCtx.Resource.Pin,
+			Action:       reqCtx.Action,
+			UserId:       reqCtx.UserId,
+		})
+		return resp, err
+	}
+}
+
+// responseItemId returns the id of the item of a response, or an empty string
+// if the response has no item.
+func responseItemId(resp any) string {
+	m, ok := resp.(proto.Message)
+	if !ok {
+		return ""
+	}
+	r := m.ProtoReflect()
+	itemField := r.Descriptor().Fields().ByName("item")
+	if itemField == nil || itemField.Message() == nil || !r.Has(itemField) {
+		return ""
+	}
+	item := r.Get(itemField).Message()
+	idField := item.Descriptor().Fields().ByName("id")
+	if idField == nil || idField.Kind() != protoreflect.StringKind {
+		return ""
+	}
+	return item.Get(idField).String()
+}
+
+// structDepth returns the deepest nesting of objects and lists within the
+// structpb fields of m.
+func structDepth(m protoreflect.Message) int {
+	switch v := m.Interface().(type) {
+	case *structpb.Struct:
+		return structValueDepth(structpb.NewStructValue(v))
+	case *structpb.ListValue:
+		return structValueDepth(structpb.NewListValue(v))
+	case *structpb.Value:
+		return structValueDepth(v)
+	}
+	var depth int
+	deeper := func(v protoreflect.Value) {
+		if d := structDepth(v.Message()); d > depth {
+			depth = d
+		}
+	}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList():
+			if fd.Message() != nil {
+				for l, i := v.List(), 0; i < l.Len(); i++ {
+					deeper(l.Get(i))
+				}
+			}
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				v.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+					deeper(v)
+					return true
+				})
+			}
+		case fd.Message() != nil:
+			deeper(v)
+		}
+		return true
+	})
+	return depth
+}
+
+func structValueDepth(v *structpb.Value) int {
+	var depth int
+	switch k := v.GetKind().(type) {
+	case *structpb.Value_StructValue:
+		for _, f := range k.StructValue.GetFields() {
+			if d := structValueDepth(f); d > depth {
+				depth = d
+			}
+		}
+	case *structpb.Value_ListValue:
+		for _, e := range k.ListValue.GetValues() {
+			if d := structValueDepth(e); d > depth {
+				depth = d
+			}
+		}
+	default:
+		return 0
+	}
+	return depth + 1
+}
+
 func statusCodeInterceptor(
 	_ context.Context,
 ) grpc.UnaryServerInterceptor {
@@ -15,17 +15,22 @@ import (
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
+	"github.com/hashicorp/boundary/internal/daemon/controller/common/authzcache"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
 	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/deprecation"
 	"github.com/hashicorp/boundary/internal/errors"
 	pb_api "github.com/hashicorp/boundary/internal/gen/controller/api"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	authpb "github.com/hashicorp/boundary/internal/gen/controller/auth"
 	pberrors "github.com/hashicorp/boundary/internal/gen/errors"
 	"github.com/hashicorp/boundary/internal/gen/testing/interceptor"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/requests"
 	"github.com/hashicorp/boundary/internal/server"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
 	"github.com/hashicorp/go-hclog"
 	"github.com/mr-tron/base58"
 	"github.com/stretchr/testify/assert"
@@ -33,6 +38,8 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func Test_requestCtxInterceptor(t *testing.T) {
@@ -583,3 +590,246 @@ func (g *testGreeter) SayHello(ctx context.Context, req *interceptor.SayHelloReq
 		return &interceptor.SayHelloResponse{Message: "hello"}, nil
 	}
 }
+
+func Test_attributeDepthInterceptor(t *testing.T) {
+	ctx := context.Background()
+	nested := func(depth int) *structpb.Struct {
+		var v any = "value"
+		for i := 1; i < depth; i++ {
+			if i%2 == 0 {
+				v = []any{v}
+			} else {
+				v = map[string]any{"key": v}
+			}
+		}
+		s, err := structpb.NewStruct(map[string]any{"key": v, "flat": "value"})
+		require.NoError(t, err)
+		return s
+	}
+	request := func(attrs *structpb.Struct) *pbs.CreateTargetRequest {
+		return &pbs.CreateTargetRequest{Item: &targets.Target{
+			Name:  wrapperspb.String("name"),
+			Attrs: &targets.Target_Attributes{Attributes: attrs},
+		}}
+	}
+	tests := []struct {
+		name     string
+		req      any
+		maxDepth int
+		wantErr  bool
+	}{
+		{
+			name:     "no-attributes",
+			req:      &pbs.CreateTargetRequest{Item: &targets.Target{Name: wrapperspb.String("name")}},
+			maxDepth: 1,
+		},
+		{
+			name:     "flat-attributes",
+			req:      request(nested(1)),
+			maxDepth: 1,
+		},
+		{
+			name:     "at-max-depth",
+			req:      request(nested(4)),
+			maxDepth: 4,
+		},
+		{
+			name:     "exceeds-max-depth",
+			req:      request(nested(5)),
+			maxDepth: 4,
+			wantErr:  true,
+		},
+		{
+			name:     "disabled",
+			req:      request(nested(50)),
+			maxDepth: -1,
+		},
+		{
+			name:     "not-a-proto",
+			req:      "request",
+			maxDepth: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			interceptor := attributeDepthInterceptor(ctx, tt.maxDepth)
+			var called bool
+			_, err := interceptor(ctx, tt.req, nil, func(ctx context.Context, req any) (any, error) {
+				called = true
+				return nil, nil
+			})
+			if tt.wantErr {
+				require.Error(err)
+				assert.False(called)
+				var apiErr *handlers.ApiError
+				require.True(errors.As(err, &apiErr))
+				assert.Equal(int32(http.StatusRequestEntityTooLarge), apiErr.Status)
+				return
+			}
+			require.NoError(err)
+			assert.True(called)
+		})
+	}
+}
+
+func Test_authorizationCacheInterceptor(t *testing.T) {
+	ctx := context.Background()
+	k1 := authzcache.Key{AuthTokenId: "at_1234567890", TargetId: "ttcp_1234567890"}
+	k2 := authzcache.Key{AuthTokenId: "at_1234567890", TargetId: "ttcp_0987654321"}
+	tests := []struct {
+		name       string
+		method     string
+		req        any
+		handlerErr error
+		wantKeys   []authzcache.Key
+	}{
+		{
+			name:     "read",
+			method:   "/controller.api.services.v1.TargetService/GetTarget",
+			req:      &pbs.GetTargetRequest{Id: k1.TargetId},
+			wantKeys: []authzcache.Key{k1, k2},
+		},
+		{
+			name:     "authorize-session",
+			method:   "/controller.api.services.v1.TargetService/AuthorizeSession",
+			req:      &pbs.AuthorizeSessionRequest{Id: k1.TargetId},
+			wantKeys: []authzcache.Key{k1, k2},
+		},
+		{
+			name:     "target-update",
+			method:   "/controller.api.services.v1.TargetService/UpdateTarget",
+			req:      &pbs.UpdateTargetRequest{Id: k1.TargetId},
+			wantKeys: []authzcache.Key{k2},
+		},
+		{
+			name:       "failed-update",
+			method:     "/controller.api.services.v1.TargetService/UpdateTarget",
+			req:        &pbs.UpdateTargetRequest{Id: k1.TargetId},
+			handlerErr: fmt.Errorf("failed"),
+			wantKeys:   []authzcache.Key{k1, k2},
+		},
+		{
+			name:   "other-update",
+			method: "/controller.api.services.v1.RoleService/AddRoleGrants",
+			req:    &pbs.AddRoleGrantsRequest{Id: "r_1234567890"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			c, err := authzcache.New(ctx)
+			require.NoError(err)
+			c.Set(k1, &authzcache.Decision{})
+			c.Set(k2, &authzcache.Decision{})
+			interceptor := authorizationCacheInterceptor(ctx, c)
+			_, err = interceptor(ctx, tt.req, &grpc.UnaryServerInfo{FullMethod: tt.method}, func(ctx context.Context, req any) (any, error) {
+				return nil, tt.handlerErr
+			})
+			assert.Equal(tt.handlerErr, err)
+			assert.Equal(len(tt.wantKeys), c.Len())
+			for _, k := range tt.wantKeys {
+				_, ok := c.Get(k)
+				assert.True(ok)
+			}
+		})
+	}
+}
+
+type headerStream struct {
+	grpc.ServerTransportStream
+	header metadata.MD
+}
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func Test_deprecationInterceptor(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name       string
+		record     []deprecation.Id
+		handlerErr error
+		wantIds    []string
+	}{
+		{
+			name: "none",
+		},
+		{
+			name:    "recorded",
+			record:  []deprecation.Id{deprecation.AuthenticateTokenType, deprecation.LegacyGrantTemplate},
+			wantIds: []string{string(deprecation.AuthenticateTokenType), string(deprecation.LegacyGrantTemplate)},
+		},
+		{
+			name:       "recorded-with-error",
+			record:     []deprecation.Id{deprecation.AuthenticateTokenType},
+			handlerErr: fmt.Errorf("failed"),
+			wantIds:    []string{string(deprecation.AuthenticateTokenType)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			tracker, err := deprecation.NewTracker(ctx)
+			require.NoError(err)
+			stream := &headerStream{}
+			reqCtx := requests.NewRequestContext(ctx, requests.WithUserId("u_1234567890"))
+			reqCtx = metadata.NewIncomingContext(reqCtx, metadata.Pairs(userAgentMdKey, "boundary-cli"))
+			reqCtx = grpc.NewContextWithServerTransportStream(reqCtx, stream)
+
+			interceptor := deprecationInterceptor(ctx, tracker)
+			_, err = interceptor(reqCtx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+				for _, id := range tt.record {
+					deprecation.Record(ctx, id)
+				}
+				return nil, tt.handlerErr
+			})
+			assert.Equal(tt.handlerErr, err)
+			assert.Equal(tt.wantIds, stream.header.Get(handlers.DeprecationHeader))
+
+			report := tracker.Report()
+			require.Len(report, len(tt.record))
+			for _, u := range report {
+				require.Len(u.Clients, 1)
+				assert.Equal("u_1234567890", u.Clients[0].UserId)
+				assert.Equal("boundary-cli", u.Clients[0].UserAgent)
+			}
+		})
+	}
+}
+
+func Test_responseItemId(t *testing.T) {
+	tests := []struct {
+		name string
+		resp any
+		want string
+	}{
+		{
+			name: "create-response",
+			resp: &pbs.CreateTargetResponse{Item: &targets.Target{Id: "ttcp_1234567890"}},
+			want: "ttcp_1234567890",
+		},
+		{
+			name: "no-item",
+			resp: &pbs.CreateTargetResponse{},
+		},
+		{
+			name: "no-item-field",
+			resp: &pbs.DeleteTargetResponse{},
+		},
+		{
+			name: "not-a-proto",
+			resp: "ttcp_1234567890",
+		},
+		{
+			name: "nil",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, responseItemId(tt.resp))
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/boundary/internal/daemon/cluster"
 	"github.com/hashicorp/boundary/internal/daemon/common"
@@ -46,7 +47,11 @@ func closeListener(_ context.Context, l net.Listener, _ any) error {
 func (c *Controller) startListeners() error {
 	servers := make([]func(), 0, len(c.conf.Listeners))
 
-	grpcServer, gwTicket, err := newGrpcServer(c.baseContext, c.IamRepoFn, c.AuthTokenRepoFn, c.ServersRepoFn, c.PasswordAuthRepoFn, c.OidcRepoFn, c.LdapRepoFn, c.kms, c.conf.Eventer)
+	maxAttributeDepth := globals.DefaultMaxAttributeDepth
+	if c.conf.RawConfig.Controller != nil && c.conf.RawConfig.Controller.RequestLimits != nil {
+		maxAttributeDepth = c.conf.RawConfig.Controller.RequestLimits.MaxAttributeDepth
+	}
+	grpcServer, gwTicket, err := newGrpcServer(c.baseContext, c.IamRepoFn, c.AuthTokenRepoFn, c.ServersRepoFn, c.PasswordAuthRepoFn, c.OidcRepoFn, c.LdapRepoFn, c.kms, c.conf.Eventer, maxAttributeDepth, c.authzCache, c.deprecationTracker, c.eventNotifier)
 	if err != nil {
 		return fmt.Errorf("failed to create new grpc server: %w", err)
 	}
@@ -267,6 +272,48 @@ func (c *Controller) configureForCluster(ln *base.ServerListener) (func(), error
 	}, nil
 }
 
+// drainHttpServers stops the HTTP servers of the API listeners from accepting
+// new requests and waits for their in-flight requests to complete. Each server
+// is given the graceful shutdown drain timeout, or the max request duration of
+// its listener if none is configured. Requests still running after it are
+// canceled along with the base context.
+func (c *Controller) drainHttpServers() error {
+	const op = "controller.(Controller).drainHttpServers"
+	var drainTimeout time.Duration
+	if c.conf != nil && c.conf.RawConfig != nil && c.conf.RawConfig.Controller != nil {
+		drainTimeout = c.conf.RawConfig.Controller.GracefulShutdownDrainTimeoutDuration
+	}
+
+	var mg multierror.Group
+	for i := range c.apiListeners {
+		ln := c.apiListeners[i]
+		if ln == nil || ln.HTTPServer == nil {
+			continue
+		}
+		timeout := drainTimeout
+		if timeout == 0 && ln.Config != nil {
+			timeout = ln.Config.MaxRequestDuration
+		}
+		mg.Go(func() error {
+			// The base context cancels the requests, so the deadline can't
+			// derive from it.
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+			err := ln.HTTPServer.Shutdown(ctx)
+			switch {
+			case err == nil, errors.Is(err, http.ErrServerClosed):
+				return nil
+			case errors.Is(err, context.DeadlineExceeded):
+				event.WriteSysEvent(c.baseContext, op, "api listener drain timeout reached, canceling remaining requests", "timeout", timeout.String())
+				return nil
+			default:
+				return err
+			}
+		})
+	}
+	return mg.Wait().ErrorOrNil()
+}
+
 func (c *Controller) stopServersAndListeners() error {
 	var mg multierror.Group
 	mg.Go(c.stopClusterGrpcServerAndListener)
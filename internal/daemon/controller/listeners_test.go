@@ -517,6 +517,49 @@ func TestStopHttpServersAndListeners(t *testing.T) {
 	}
 }
 
+func TestDrainHttpServers(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	started := make(chan struct{})
+	s := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		close(started)
+		time.Sleep(500 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	})}
+	go s.Serve(l)
+
+	statusCodes := make(chan int, 1)
+	go func() {
+		resp, err := http.Get("http://" + l.Addr().String())
+		if err != nil {
+			statusCodes <- 0
+			return
+		}
+		resp.Body.Close()
+		statusCodes <- resp.StatusCode
+	}()
+	<-started
+
+	c := &Controller{
+		baseContext: context.Background(),
+		apiListeners: []*base.ServerListener{
+			{
+				ApiListener: l,
+				HTTPServer:  s,
+				Config:      &listenerutil.ListenerConfig{Type: "tcp", MaxRequestDuration: 5 * time.Second},
+			},
+		},
+	}
+	require.NoError(t, c.drainHttpServers())
+
+	// The in-flight request completed before the server stopped
+	require.Equal(t, http.StatusOK, <-statusCodes)
+
+	// New requests are refused
+	_, err = http.Get("http://" + l.Addr().String())
+	require.Error(t, err)
+}
+
 func TestStopApiGrpcServerAndListener(t *testing.T) {
 	tests := []struct {
 		name         string
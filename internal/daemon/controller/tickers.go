@@ -23,6 +23,10 @@ const (
 	workerConnectionMaintenanceInterval = 3 * time.Second
 	statusInterval                      = 10 * time.Second
 	terminationInterval                 = 1 * time.Minute
+
+	// shutdownDbTimeout bounds the database calls made during shutdown,
+	// after the base context is canceled.
+	shutdownDbTimeout = 10 * time.Second
 )
 
 // This is exported so it can be tweaked in tests
@@ -38,6 +42,11 @@ func (c *Controller) startStatusTicking(cancelCtx context.Context) {
 			return
 
 		case <-timer.C:
+			if c.draining.Load() {
+				// The controller deregistered itself during shutdown
+				timer.Reset(statusInterval)
+				continue
+			}
 			if err := c.upsertController(cancelCtx); err != nil {
 				event.WriteError(cancelCtx, op, err, event.WithInfoMsg("error fetching repository for status update"))
 			}
@@ -51,6 +60,7 @@ func (c *Controller) upsertController(ctx context.Context) error {
 	controller := &store.Controller{
 		PrivateId: c.conf.RawConfig.Controller.Name,
 		Address:   c.conf.RawConfig.Controller.PublicClusterAddr,
+		Region:    c.conf.RawConfig.Controller.Region,
 	}
 	repo, err := c.ServersRepoFn()
 	if err != nil {
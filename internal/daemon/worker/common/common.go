@@ -9,10 +9,10 @@ import (
 	"github.com/hashicorp/boundary/internal/server"
 )
 
-// In the future we could make this configurable
 const (
-	// StatusInterval is the base duration used in the calculation of the random backoff
-	// during the worker status report
+	// StatusInterval is the default base duration used in the calculation of the
+	// random backoff during the worker status report. It can be changed with the
+	// status_interval worker setting.
 	StatusInterval = 2 * time.Second
 
 	// DefaultStatusTimeout is the timeout duration on status calls to the controller from
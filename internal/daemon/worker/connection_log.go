@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/eventlogger"
+)
+
+// connectionLogEntry is the structured record written to the connection log
+// when a proxied connection is closed.
+type connectionLogEntry struct {
+	Time         time.Time `json:"time"`
+	SessionId    string    `json:"session_id"`
+	ConnectionId string    `json:"connection_id"`
+	Endpoint     string    `json:"endpoint"`
+	ClientAddr   string    `json:"client_address"`
+	BytesUp      int64     `json:"bytes_up"`
+	BytesDown    int64     `json:"bytes_down"`
+	Duration     string    `json:"duration"`
+	DurationMs   int64     `json:"duration_ms"`
+}
+
+// connectionLogger writes a connectionLogEntry for each closed connection to a
+// local file which is rotated per the worker's connection_log config. It is
+// independent of the eventer so the entries are retained on the worker host
+// regardless of the configured event sinks.
+type connectionLogger struct {
+	sink *eventlogger.FileSink
+}
+
+// newConnectionLogger returns a connectionLogger for the config. A nil config
+// returns a nil connectionLogger, which discards all entries.
+func newConnectionLogger(cfg *event.FileSinkTypeConfig) (*connectionLogger, error) {
+	const op = "worker.newConnectionLogger"
+	if cfg == nil {
+		return nil, nil
+	}
+	if cfg.FileName == "" {
+		return nil, fmt.Errorf("%s: missing connection log file name", op)
+	}
+	return &connectionLogger{
+		sink: &eventlogger.FileSink{
+			Format:      eventlogger.JSONFormat,
+			Path:        cfg.Path,
+			FileName:    cfg.FileName,
+			MaxBytes:    cfg.RotateBytes,
+			MaxDuration: cfg.RotateDuration,
+			MaxFiles:    cfg.RotateMaxFiles,
+		},
+	}, nil
+}
+
+// write appends the entry to the connection log.
+func (l *connectionLogger) write(ctx context.Context, entry *connectionLogEntry) error {
+	const op = "worker.(connectionLogger).write"
+	if l == nil {
+		return nil
+	}
+	if entry == nil {
+		return fmt.Errorf("%s: missing entry", op)
+	}
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("%s: unable to marshal entry: %w", op, err)
+	}
+	e := &eventlogger.Event{
+		Type:      eventlogger.EventType("connection"),
+		CreatedAt: entry.Time,
+		Payload:   entry,
+	}
+	e.FormattedAs(eventlogger.JSONFormat, append(b, '\n'))
+	if _, err := l.sink.Process(ctx, e); err != nil {
+		return fmt.Errorf("%s: unable to write entry: %w", op, err)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package worker
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectionLogger(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("nil-config", func(t *testing.T) {
+		l, err := newConnectionLogger(nil)
+		require.NoError(t, err)
+		assert.Nil(t, l)
+		assert.NoError(t, l.write(ctx, &connectionLogEntry{}))
+	})
+	t.Run("missing-file-name", func(t *testing.T) {
+		_, err := newConnectionLogger(&event.FileSinkTypeConfig{Path: t.TempDir()})
+		require.Error(t, err)
+	})
+	t.Run("write", func(t *testing.T) {
+		dir := t.TempDir()
+		l, err := newConnectionLogger(&event.FileSinkTypeConfig{Path: dir, FileName: "connections.log"})
+		require.NoError(t, err)
+		require.Error(t, l.write(ctx, nil))
+
+		want := []*connectionLogEntry{
+			{
+				Time:         time.Now().UTC().Truncate(time.Second),
+				SessionId:    "s_1234567890",
+				ConnectionId: "sc_1234567890",
+				Endpoint:     "tcp://127.0.0.1:22",
+				ClientAddr:   "127.0.0.1:50000",
+				BytesUp:      10,
+				BytesDown:    20,
+				Duration:     "1.5s",
+				DurationMs:   1500,
+			},
+			{
+				Time:         time.Now().UTC().Truncate(time.Second),
+				SessionId:    "s_1234567890",
+				ConnectionId: "sc_0987654321",
+				Endpoint:     "tcp://127.0.0.1:22",
+				ClientAddr:   "127.0.0.1:50001",
+			},
+		}
+		for _, e := range want {
+			require.NoError(t, l.write(ctx, e))
+		}
+
+		files, err := filepath.Glob(filepath.Join(dir, "connections*.log"))
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+		f, err := os.Open(files[0])
+		require.NoError(t, err)
+		defer f.Close()
+
+		var got []*connectionLogEntry
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			var e connectionLogEntry
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &e))
+			got = append(got, &e)
+		}
+		require.NoError(t, scanner.Err())
+		assert.Equal(t, want, got)
+	})
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/util"
 	"github.com/hashicorp/boundary/version"
 	"github.com/hashicorp/go-secure-stdlib/base62"
 	"github.com/hashicorp/nodeenrollment"
@@ -53,9 +54,9 @@ func (w *Worker) StartControllerConnections() error {
 		case strings.HasPrefix(addr, "/"):
 			initialAddrs = append(initialAddrs, addr)
 		default:
-			host, port, err := net.SplitHostPort(addr)
-			if err != nil && strings.Contains(err.Error(), globals.MissingPortErrStr) {
-				host, port, err = net.SplitHostPort(net.JoinHostPort(addr, "9201"))
+			host, port, err := util.SplitHostPort(addr)
+			if errors.Is(err, util.ErrMissingPort) {
+				port, err = "9201", nil
 			}
 			if err != nil {
 				return fmt.Errorf("error parsing upstream address: %w", err)
@@ -74,6 +75,9 @@ func (w *Worker) StartControllerConnections() error {
 		}
 	}
 
+	if w.upstreamSelector != nil {
+		initialAddrs = w.upstreamSelector.rank(initialAddrs)
+	}
 	for _, ar := range w.addressReceivers {
 		ar.InitialAddresses(initialAddrs)
 	}
@@ -83,13 +87,16 @@ func (w *Worker) StartControllerConnections() error {
 	return nil
 }
 
+// UpstreamDialFunc dials an upstream server at addr.
+type UpstreamDialFunc func(ctx context.Context, addr string) (net.Conn, error)
+
 // upstreamDialerFunc dials an upstream server. extraAlpnProtos can be provided
 // to kms and pki connections and are used for identifying, on the server side,
 // the intended purpose of the connection.  upstreamDialerFunc takes an optional
 // stateProvidingFunction which, if the connection is made using the nodeenrollment
 // library, sends the state provided by it to the server and can be retrieved
 // from the resulting *protocol.Conn.
-func (w *Worker) upstreamDialerFunc(extraAlpnProtos ...string) func(context.Context, string) (net.Conn, error) {
+func (w *Worker) upstreamDialerFunc(extraAlpnProtos ...string) UpstreamDialFunc {
 	const op = "worker.(Worker).upstreamDialerFunc"
 	return func(ctx context.Context, addr string) (net.Conn, error) {
 		var conn net.Conn
@@ -194,9 +201,15 @@ func (w *Worker) v1KmsAuthDialFn(ctx context.Context, addr string, extraAlpnProt
 func (w *Worker) createClientConn(addr string) error {
 	const op = "worker.(Worker).createClientConn"
 	defaultTimeout := (time.Second + time.Nanosecond).String()
+	// With upstream selection, stay connected to the first reachable upstream
+	// in the ranked list rather than spreading requests across all of them
+	lbPolicy := "round_robin"
+	if w.upstreamSelector != nil {
+		lbPolicy = "pick_first"
+	}
 	defServiceConfig := fmt.Sprintf(`
 	  {
-		"loadBalancingConfig": [ { "round_robin": {} } ],
+		"loadBalancingConfig": [ { %q: {} } ],
 		"methodConfig": [
 		  {
 			"name": [],
@@ -205,7 +218,7 @@ func (w *Worker) createClientConn(addr string) error {
 		  }
 		]
 	  }
-	  `, defaultTimeout)
+	  `, lbPolicy, defaultTimeout)
 	var res resolver.Builder
 	for _, v := range w.addressReceivers {
 		if rec, ok := v.(*grpcResolverReceiver); ok {
@@ -215,12 +228,17 @@ func (w *Worker) createClientConn(addr string) error {
 	if res == nil {
 		return errors.New(w.baseContext, errors.Internal, op, "unable to find a resolver.Builder amongst the address receivers")
 	}
+	dialer := w.upstreamDialerFunc()
+	if w.TestUpstreamDialerWrapper != nil {
+		dialer = w.TestUpstreamDialerWrapper(dialer)
+	}
+	dialer = w.currentUpstreamDialer(dialer)
 	dialOpts := []grpc.DialOption{
 		grpc.WithResolvers(res),
 		grpc.WithUnaryInterceptor(metric.InstrumentClusterClient()),
 		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(math.MaxInt32)),
 		grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(math.MaxInt32)),
-		grpc.WithContextDialer(w.upstreamDialerFunc()),
+		grpc.WithContextDialer(dialer),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithDefaultServiceConfig(defServiceConfig),
 		// Don't have the resolver reach out for a service config from the
@@ -235,6 +253,9 @@ func (w *Worker) createClientConn(addr string) error {
 			},
 		}),
 	}
+	if w.upstreamCompressor != nil {
+		dialOpts = append(dialOpts, grpc.WithChainUnaryInterceptor(w.upstreamCompressor.unaryInterceptor()))
+	}
 	cc, err := grpc.DialContext(w.baseContext,
 		fmt.Sprintf("%s:///%s", res.Scheme(), addr),
 		dialOpts...,
@@ -250,6 +271,22 @@ func (w *Worker) createClientConn(addr string) error {
 	return nil
 }
 
+// currentUpstreamDialer wraps dialer to record the upstream most recently
+// connected to.
+func (w *Worker) currentUpstreamDialer(dialer UpstreamDialFunc) UpstreamDialFunc {
+	const op = "worker.(Worker).currentUpstreamDialer"
+	return func(ctx context.Context, addr string) (net.Conn, error) {
+		conn, err := dialer(ctx, addr)
+		if err == nil && conn != nil {
+			if w.currentUpstream.Load() != addr {
+				event.WriteSysEvent(ctx, op, fmt.Sprintf("Connected to upstream %s", addr))
+			}
+			w.currentUpstream.Store(addr)
+		}
+		return conn, err
+	}
+}
+
 func (w *Worker) workerAuthTLSConfig(extraAlpnProtos ...string) (*tls.Config, *base.WorkerAuthInfo, error) {
 	var err error
 	info := &base.WorkerAuthInfo{
@@ -13,11 +13,13 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/daemon/common"
 	"github.com/hashicorp/boundary/internal/daemon/worker/internal/metric"
 	proxyHandlers "github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy/protocolplugin"
 	"github.com/hashicorp/boundary/internal/daemon/worker/session"
 	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
@@ -185,7 +187,7 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 				return
 			}
 			if handshake.Command == proxy.HANDSHAKECOMMAND_HANDSHAKECOMMAND_UNSPECIFIED {
-				err = sess.RequestActivate(ctx, handshake.GetTofuToken())
+				err = sess.RequestActivate(ctx, handshake.GetTofuToken(), handshake.GetBannerAcknowledged())
 				if err != nil {
 					event.WriteError(ctx, op, err, event.WithInfoMsg("unable to validate session"))
 					if err = conn.Close(websocket.StatusInternalError, "unable to activate session"); err != nil {
@@ -231,7 +233,22 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 			return
 		}
 
+		connStart := time.Now()
 		defer func() {
+			connDuration := time.Since(connStart)
+			if err := w.connectionLog.write(ctx, &connectionLogEntry{
+				Time:         time.Now(),
+				SessionId:    sess.GetId(),
+				ConnectionId: acResp.GetConnectionId(),
+				Endpoint:     sess.GetEndpoint(),
+				ClientAddr:   clientAddr.String(),
+				BytesUp:      cc.BytesRead(),
+				BytesDown:    cc.BytesWritten(),
+				Duration:     connDuration.String(),
+				DurationMs:   connDuration.Milliseconds(),
+			}); err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("unable to write connection log", "session_id", sessionId, "connection_id", acResp.GetConnectionId()))
+			}
 			ccd := map[string]*session.ConnectionCloseData{
 				acResp.GetConnectionId(): {
 					SessionId: sess.GetId(),
@@ -283,7 +300,12 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 		}
 
 		// Verify the protocol has a supported proxy before calling RequestAuthorizeConnection
-		handleProxyFn, err := proxyHandlers.GetHandler(workerId, acResp.GetProtocolContext())
+		var handleProxyFn proxyHandlers.Handler
+		if pp, ok := w.protocolPlugins[endpointUrl.Scheme]; ok {
+			handleProxyFn, err = protocolplugin.NewHandler(ctx, pp, sessionId, sess.GetEndpoint())
+		} else {
+			handleProxyFn, err = proxyHandlers.GetHandler(workerId, acResp.GetProtocolContext())
+		}
 		if err != nil {
 			conn.Close(proxyHandlers.WebsocketStatusProtocolSetupError, "unable to get proxy handler")
 			event.WriteError(ctx, op, err)
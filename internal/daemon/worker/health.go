@@ -55,7 +55,11 @@ func (w *Worker) HealthInformation() *pbhealth.HealthInfo {
 		state = v.(server.OperationalState)
 	}
 	healthInfo := &pbhealth.HealthInfo{
-		State: state.String(),
+		State:    state.String(),
+		Upstream: w.currentUpstream.Load(),
+	}
+	if w.upstreamSelector != nil {
+		healthInfo.Upstreams = w.upstreamSelector.upstreamInfo()
 	}
 
 	if w.sessionManager == nil {
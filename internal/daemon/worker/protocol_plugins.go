@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	external_protocol_plugins "github.com/hashicorp/boundary/sdk/plugins/protocol"
+	"github.com/hashicorp/go-secure-stdlib/pluginutil/v2"
+)
+
+// loadProtocolPlugins starts each of the worker's configured protocol plugins
+// and returns the plugins keyed by the protocols they handle. The plugins are
+// stopped by the server's shutdown funcs.
+func (w *Worker) loadProtocolPlugins(ctx context.Context) (map[string]plgpb.ProtocolPluginServiceClient, error) {
+	const op = "worker.(Worker).loadProtocolPlugins"
+	names := w.conf.RawConfig.Worker.ProtocolPlugins
+	if len(names) == 0 {
+		return nil, nil
+	}
+	pluginLogger, err := event.NewHclogLogger(ctx, w.conf.Eventer)
+	if err != nil {
+		return nil, fmt.Errorf("%s: error creating protocol plugin logger: %w", op, err)
+	}
+	pluginsFs := os.DirFS(w.conf.RawConfig.Worker.ProtocolPluginsDir)
+
+	plugins := make(map[string]plgpb.ProtocolPluginServiceClient, len(names))
+	for _, name := range names {
+		client, cleanup, err := external_protocol_plugins.CreateProtocolPlugin(
+			ctx,
+			name,
+			external_protocol_plugins.WithPluginOptions(
+				pluginutil.WithPluginExecutionDirectory(w.conf.RawConfig.Plugins.ExecutionDir),
+				pluginutil.WithPluginsFilesystem(external_protocol_plugins.ProtocolPluginPrefix, pluginsFs),
+			),
+			external_protocol_plugins.WithLogger(pluginLogger.Named(name)),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: error creating %s protocol plugin: %w", op, name, err)
+		}
+		w.conf.ShutdownFuncs = append(w.conf.ShutdownFuncs, cleanup)
+
+		resp, err := client.GetProtocols(ctx, &plgpb.GetProtocolsRequest{})
+		if err != nil {
+			return nil, fmt.Errorf("%s: error getting protocols of %s protocol plugin: %w", op, name, err)
+		}
+		if len(resp.GetProtocols()) == 0 {
+			return nil, fmt.Errorf("%s: %s protocol plugin handles no protocols", op, name)
+		}
+		for _, protocol := range resp.GetProtocols() {
+			if protocol == proxy.TcpHandlerName {
+				return nil, fmt.Errorf("%s: %s protocol plugin cannot handle the built-in %q protocol", op, name, protocol)
+			}
+			if _, found := plugins[protocol]; found {
+				return nil, fmt.Errorf("%s: protocol %q is handled by more than one protocol plugin", op, protocol)
+			}
+			plugins[protocol] = client
+		}
+		event.WriteSysEvent(ctx, op, "loaded protocol plugin", "plugin", name, "protocols", resp.GetProtocols())
+	}
+	return plugins, nil
+}
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package protocolplugin contains the proxy handler for protocols provided by
+// out-of-tree protocol plugins.
+package protocolplugin
+
+import (
+	"context"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	"github.com/hashicorp/boundary/internal/errors"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// readBufferSize is the size of the buffers used when reading from the client
+// and endpoint connections.
+const readBufferSize = 32 * 1024
+
+// NewHandler returns a proxy.Handler which relays the data of the client
+// connection and the connection created by the ProxyDialer through the
+// plugin's Proxy stream.
+func NewHandler(ctx context.Context, client plgpb.ProtocolPluginServiceClient, sessionId, endpoint string) (proxy.Handler, error) {
+	const op = "protocolplugin.NewHandler"
+	if client == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing protocol plugin client")
+	}
+	return func(ctx context.Context, _ proxy.DecryptFn, conn net.Conn, out *proxy.ProxyDialer, connId string, protocolCtx *anypb.Any) (proxy.ProxyConnFn, error) {
+		const op = "protocolplugin.handleProxy"
+		switch {
+		case conn == nil:
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "conn is nil")
+		case out == nil:
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "proxy dialer is nil")
+		case len(connId) == 0:
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "connection id is empty")
+		}
+		remoteConn, err := out.Dial(ctx)
+		if err != nil {
+			return nil, err
+		}
+		streamCtx, streamCancel := context.WithCancel(ctx)
+		stream, err := client.Proxy(streamCtx)
+		if err != nil {
+			streamCancel()
+			_ = remoteConn.Close()
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to open protocol plugin stream"))
+		}
+		start := &plgpb.ProxyRequest{
+			Request: &plgpb.ProxyRequest_Start{
+				Start: &plgpb.ProxyStart{
+					SessionId:       sessionId,
+					ConnectionId:    connId,
+					Endpoint:        endpoint,
+					ProtocolContext: protocolCtx,
+				},
+			},
+		}
+		if err := stream.Send(start); err != nil {
+			streamCancel()
+			_ = remoteConn.Close()
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to start protocol plugin stream"))
+		}
+
+		return func(ctx context.Context) {
+			defer streamCancel()
+			var sendLock sync.Mutex
+			send := func(req *plgpb.ProxyRequest) error {
+				sendLock.Lock()
+				defer sendLock.Unlock()
+				return stream.Send(req)
+			}
+			closeAll := func() {
+				_ = conn.Close()
+				_ = remoteConn.Close()
+				streamCancel()
+			}
+
+			connWg := new(sync.WaitGroup)
+			connWg.Add(3)
+			go func() {
+				defer connWg.Done()
+				relay(conn, send, func(b []byte) *plgpb.ProxyRequest {
+					return &plgpb.ProxyRequest{Request: &plgpb.ProxyRequest_ClientData{ClientData: b}}
+				})
+				closeAll()
+			}()
+			go func() {
+				defer connWg.Done()
+				relay(remoteConn, send, func(b []byte) *plgpb.ProxyRequest {
+					return &plgpb.ProxyRequest{Request: &plgpb.ProxyRequest_EndpointData{EndpointData: b}}
+				})
+				closeAll()
+			}()
+			go func() {
+				defer connWg.Done()
+				defer closeAll()
+				for {
+					resp, err := stream.Recv()
+					if err != nil {
+						return
+					}
+					switch r := resp.GetResponse().(type) {
+					case *plgpb.ProxyResponse_ClientData:
+						if _, err := conn.Write(r.ClientData); err != nil {
+							return
+						}
+					case *plgpb.ProxyResponse_EndpointData:
+						if _, err := remoteConn.Write(r.EndpointData); err != nil {
+							return
+						}
+					case *plgpb.ProxyResponse_Close:
+						return
+					}
+				}
+			}()
+			connWg.Wait()
+		}, nil
+	}, nil
+}
+
+// relay sends the data read from the reader to the plugin until the reader
+// returns an error, at which point it tells the plugin the stream is closed.
+func relay(r io.Reader, send func(*plgpb.ProxyRequest) error, wrap func([]byte) *plgpb.ProxyRequest) {
+	buf := make([]byte, readBufferSize)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			b := make([]byte, n)
+			copy(b, buf[:n])
+			if sendErr := send(wrap(b)); sendErr != nil {
+				return
+			}
+		}
+		if err != nil {
+			_ = send(&plgpb.ProxyRequest{Request: &plgpb.ProxyRequest_Close{Close: &plgpb.ProxyClose{}}})
+			return
+		}
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package protocolplugin
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// upperPlugin upper cases the data the client sends to the endpoint and
+// passes the endpoint's data to the client unchanged.
+type upperPlugin struct {
+	plgpb.UnimplementedProtocolPluginServiceServer
+	starts chan *plgpb.ProxyStart
+}
+
+func (p *upperPlugin) Proxy(stream plgpb.ProtocolPluginService_ProxyServer) error {
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			return nil
+		}
+		var resp *plgpb.ProxyResponse
+		switch r := req.GetRequest().(type) {
+		case *plgpb.ProxyRequest_Start:
+			p.starts <- r.Start
+			continue
+		case *plgpb.ProxyRequest_ClientData:
+			resp = &plgpb.ProxyResponse{Response: &plgpb.ProxyResponse_EndpointData{EndpointData: bytes.ToUpper(r.ClientData)}}
+		case *plgpb.ProxyRequest_EndpointData:
+			resp = &plgpb.ProxyResponse{Response: &plgpb.ProxyResponse_ClientData{ClientData: r.EndpointData}}
+		case *plgpb.ProxyRequest_Close:
+			return stream.Send(&plgpb.ProxyResponse{Response: &plgpb.ProxyResponse_Close{Close: &plgpb.ProxyClose{}}})
+		}
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
+
+func TestNewHandler(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	_, err := NewHandler(ctx, nil, "s_1234567890", "tcp://127.0.0.1:22")
+	require.Error(t, err)
+
+	impl := &upperPlugin{starts: make(chan *plgpb.ProxyStart, 1)}
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	plgpb.RegisterProtocolPluginServiceServer(srv, impl)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+	cc, err := grpc.DialContext(ctx, "bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { cc.Close() })
+
+	// The endpoint echoes everything it receives.
+	endpoint, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { endpoint.Close() })
+	go func() {
+		c, err := endpoint.Accept()
+		if err != nil {
+			return
+		}
+		_, _ = io.Copy(c, c)
+		_ = c.Close()
+	}()
+	dialer, err := proxy.NewProxyDialer(ctx, func(...proxy.Option) (net.Conn, error) {
+		return net.Dial("tcp", endpoint.Addr().String())
+	})
+	require.NoError(t, err)
+
+	h, err := NewHandler(ctx, plgpb.NewProtocolPluginServiceClient(cc), "s_1234567890", "tcp://127.0.0.1:22")
+	require.NoError(t, err)
+
+	_, err = h(ctx, nil, nil, dialer, "sc_1234567890", nil)
+	require.Error(t, err)
+
+	client, proxyConn := net.Pipe()
+	fn, err := h(ctx, nil, proxyConn, dialer, "sc_1234567890", nil)
+	require.NoError(t, err)
+	start := <-impl.starts
+	assert.Equal(t, "s_1234567890", start.GetSessionId())
+	assert.Equal(t, "sc_1234567890", start.GetConnectionId())
+
+	done := make(chan struct{})
+	go func() {
+		fn(ctx)
+		close(done)
+	}()
+
+	_, err = client.Write([]byte("hello"))
+	require.NoError(t, err)
+	got := make([]byte, 5)
+	_, err = io.ReadFull(client, got)
+	require.NoError(t, err)
+	assert.Equal(t, "HELLO", string(got))
+
+	require.NoError(t, client.Close())
+	<-done
+}
@@ -98,8 +98,9 @@ type Session interface {
 
 	// RequestActivate sends session activation request to the controller.  The Session's
 	// status is then updated with the result of the call.  After a successful
-	// call to RequestActivate, subsequent calls will fail.
-	RequestActivate(ctx context.Context, tofu string) error
+	// call to RequestActivate, subsequent calls will fail. bannerAcknowledged
+	// reports whether the user acknowledged the banner of the session's target.
+	RequestActivate(ctx context.Context, tofu string, bannerAcknowledged bool) error
 
 	// ApplyConnectionCounterCallbacks sets a connection's bytes up and bytes
 	// down callbacks to the provided functions. Both functions must be safe for
@@ -304,8 +305,8 @@ func (s *sess) RequestCancel(ctx context.Context) error {
 	return nil
 }
 
-func (s *sess) RequestActivate(ctx context.Context, tofu string) error {
-	st, err := activate(ctx, s.client, s.GetId(), tofu, s.resp.GetVersion())
+func (s *sess) RequestActivate(ctx context.Context, tofu string, bannerAcknowledged bool) error {
+	st, err := activate(ctx, s.client, s.GetId(), tofu, s.resp.GetVersion(), bannerAcknowledged)
 	if err != nil {
 		return err
 	}
@@ -423,11 +424,12 @@ func (s *sess) ApplyConnectionCounterCallbacks(connId string, bytesUp func() int
 
 // activate is a helper worker function that sends session activation request to the
 // controller.
-func activate(ctx context.Context, sessClient pbs.SessionServiceClient, sessionId, tofuToken string, version uint32) (pbs.SESSIONSTATUS, error) {
+func activate(ctx context.Context, sessClient pbs.SessionServiceClient, sessionId, tofuToken string, version uint32, bannerAcknowledged bool) (pbs.SESSIONSTATUS, error) {
 	resp, err := sessClient.ActivateSession(ctx, &pbs.ActivateSessionRequest{
-		SessionId: sessionId,
-		TofuToken: tofuToken,
-		Version:   version,
+		SessionId:          sessionId,
+		TofuToken:          tofuToken,
+		Version:            version,
+		BannerAcknowledged: bannerAcknowledged,
 	})
 	if err != nil {
 		return pbs.SESSIONSTATUS_SESSIONSTATUS_UNSPECIFIED, fmt.Errorf("error activating session: %w", err)
@@ -110,12 +110,15 @@ func TestSession_RequestActivate(t *testing.T) {
 		},
 		status: pbs.SESSIONSTATUS_SESSIONSTATUS_PENDING,
 	}
-	assert.Error(t, sess.RequestActivate(context.Background(), "tofu"))
+	assert.Error(t, sess.RequestActivate(context.Background(), "tofu", false))
 
-	mockClient.ActivateSessionFn = func(context.Context, *pbs.ActivateSessionRequest) (*pbs.ActivateSessionResponse, error) {
+	var gotReq *pbs.ActivateSessionRequest
+	mockClient.ActivateSessionFn = func(_ context.Context, req *pbs.ActivateSessionRequest) (*pbs.ActivateSessionResponse, error) {
+		gotReq = req
 		return &pbs.ActivateSessionResponse{Status: pbs.SESSIONSTATUS_SESSIONSTATUS_ACTIVE}, nil
 	}
-	assert.NoError(t, sess.RequestActivate(context.Background(), "tofu"))
+	assert.NoError(t, sess.RequestActivate(context.Background(), "tofu", true))
+	assert.True(t, gotReq.GetBannerAcknowledged())
 	assert.Equal(t, pbs.SESSIONSTATUS_SESSIONSTATUS_ACTIVE, sess.GetStatus())
 }
 
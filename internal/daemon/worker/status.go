@@ -10,7 +10,6 @@ import (
 	"math/rand"
 	"time"
 
-	"github.com/hashicorp/boundary/internal/daemon/worker/common"
 	"github.com/hashicorp/boundary/internal/daemon/worker/session"
 	pb "github.com/hashicorp/boundary/internal/gen/controller/servers"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
@@ -46,7 +45,8 @@ func (w *Worker) startStatusTicking(cancelCtx context.Context, sessionManager se
 		if r.Float32() > 0.5 {
 			f = -1 * f
 		}
-		return common.StatusInterval + time.Duration(f*float64(time.Second))
+		interval := time.Duration(w.statusInterval.Load())
+		return interval + time.Duration(f*float64(interval)/2)
 	}
 
 	timer := time.NewTimer(0)
@@ -260,10 +260,7 @@ func (w *Worker) sendWorkerStatus(cancelCtx context.Context, sessionManager sess
 	var addrs []string
 	// This may be empty if we are in a multiple hop scenario
 	if len(result.CalculatedUpstreams) > 0 {
-		addrs = make([]string, 0, len(result.CalculatedUpstreams))
-		for _, v := range result.CalculatedUpstreams {
-			addrs = append(addrs, v.Address)
-		}
+		addrs = upstreamAddresses(result.CalculatedUpstreams, w.conf.RawConfig.Worker.Region)
 	} else if checkHCPBUpstreams != nil && checkHCPBUpstreams(w) {
 		// This is a worker that is one hop away from managed workers, so attempt to get that list
 		hcpbWorkersCtx, hcpbWorkersCancel := context.WithTimeout(cancelCtx, time.Duration(w.statusCallTimeoutDuration.Load()))
@@ -338,21 +335,44 @@ func (w *Worker) sendWorkerStatus(cancelCtx context.Context, sessionManager sess
 }
 
 // Update address receivers and dialing listeners with new addrs
+// upstreamAddresses returns the addresses of the upstreams. If the worker has
+// a region and some of the upstreams are in the same region, only the
+// addresses of those are returned, so that connections stay within the region
+// while it has a live upstream.
+func upstreamAddresses(upstreams []*pbs.UpstreamServer, region string) []string {
+	addrs := make([]string, 0, len(upstreams))
+	var regionAddrs []string
+	for _, v := range upstreams {
+		addrs = append(addrs, v.Address)
+		if region != "" && v.GetRegion() == region {
+			regionAddrs = append(regionAddrs, v.Address)
+		}
+	}
+	if len(regionAddrs) > 0 {
+		return regionAddrs
+	}
+	return addrs
+}
+
 func (w *Worker) updateAddresses(cancelCtx context.Context, addrs []string, addressReceivers *[]addressReceiver) {
 	const op = "worker.(Worker).updateAddrs"
 
 	if len(addrs) > 0 {
+		resolverAddrs := addrs
+		if w.upstreamSelector != nil {
+			resolverAddrs = w.upstreamSelector.rank(addrs)
+		}
 		lastStatus := w.lastStatusSuccess.Load().(*LastStatusInformation)
 		// Compare upstreams; update resolver if there is a difference, and emit an event with old and new addresses
 		if lastStatus != nil && !strutil.EquivalentSlices(lastStatus.LastCalculatedUpstreams, addrs) {
 			upstreamsMessage := fmt.Sprintf("Upstreams has changed; old upstreams were: %s, new upstreams are: %s", lastStatus.LastCalculatedUpstreams, addrs)
 			event.WriteSysEvent(cancelCtx, op, upstreamsMessage)
 			for _, as := range *addressReceivers {
-				as.SetAddresses(addrs)
+				as.SetAddresses(resolverAddrs)
 			}
 		} else if lastStatus == nil {
 			for _, as := range *addressReceivers {
-				as.SetAddresses(addrs)
+				as.SetAddresses(resolverAddrs)
 			}
 			event.WriteSysEvent(cancelCtx, op, fmt.Sprintf("Upstreams after first status set to: %s", addrs))
 		}
@@ -11,8 +11,10 @@ import (
 	"time"
 
 	"github.com/hashicorp/boundary/internal/cmd/base"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/internal/observability/event"
 	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -65,3 +67,19 @@ func TestWorkerWaitForNextSuccessfulStatusUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestUpstreamAddresses(t *testing.T) {
+	upstreams := []*pbs.UpstreamServer{
+		{Address: "10.0.0.1:9201", Type: pbs.UpstreamServer_TYPE_CONTROLLER, Region: "us-east-1"},
+		{Address: "10.1.0.1:9201", Type: pbs.UpstreamServer_TYPE_CONTROLLER, Region: "eu-west-1"},
+		{Address: "10.0.0.2:9201", Type: pbs.UpstreamServer_TYPE_CONTROLLER, Region: "us-east-1"},
+		{Address: "10.2.0.1:9201", Type: pbs.UpstreamServer_TYPE_CONTROLLER},
+	}
+	all := []string{"10.0.0.1:9201", "10.1.0.1:9201", "10.0.0.2:9201", "10.2.0.1:9201"}
+
+	assert.Equal(t, all, upstreamAddresses(upstreams, ""))
+	assert.Equal(t, []string{"10.0.0.1:9201", "10.0.0.2:9201"}, upstreamAddresses(upstreams, "us-east-1"))
+	assert.Equal(t, []string{"10.1.0.1:9201"}, upstreamAddresses(upstreams, "eu-west-1"))
+	// With no upstream in the worker's region all of them are used
+	assert.Equal(t, all, upstreamAddresses(upstreams, "ap-south-1"))
+}
@@ -215,6 +215,10 @@ type TestWorkerOpts struct {
 
 	// If set, override the normal auth rotation period
 	AuthRotationPeriod time.Duration
+
+	// If set, wraps the dialer used for the connection to the upstreams, for
+	// example with a TestFaultInjector
+	UpstreamDialerWrapper func(UpstreamDialFunc) UpstreamDialFunc
 }
 
 func NewTestWorker(t testing.TB, opts *TestWorkerOpts) *TestWorker {
@@ -325,6 +329,7 @@ func NewTestWorker(t testing.TB, opts *TestWorkerOpts) *TestWorker {
 	}
 
 	tw.w.TestOverrideAuthRotationPeriod = opts.AuthRotationPeriod
+	tw.w.TestUpstreamDialerWrapper = opts.UpstreamDialerWrapper
 
 	if opts.NonceFn != nil {
 		tw.w.nonceFn = opts.NonceFn
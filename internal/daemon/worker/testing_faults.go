@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package worker
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"time"
+)
+
+// ErrTestDialDropped is returned by dials dropped by a TestFaultInjector
+var ErrTestDialDropped = errors.New("dial dropped by test fault injector")
+
+// TestFaultInjector injects faults into the connections of a worker to its
+// upstreams. Pass its Wrap method as the UpstreamDialerWrapper of a
+// TestWorker; faults can then be changed at any time during the test.
+type TestFaultInjector struct {
+	mu        sync.Mutex
+	latency   time.Duration
+	dropDials bool
+	conns     map[*faultConn]struct{}
+}
+
+// NewTestFaultInjector returns a TestFaultInjector which doesn't inject any
+// fault until told to.
+func NewTestFaultInjector() *TestFaultInjector {
+	return &TestFaultInjector{
+		conns: make(map[*faultConn]struct{}),
+	}
+}
+
+// Wrap returns a dialer that dials with next, subject to the faults of the
+// injector.
+func (f *TestFaultInjector) Wrap(next UpstreamDialFunc) UpstreamDialFunc {
+	return func(ctx context.Context, addr string) (net.Conn, error) {
+		f.mu.Lock()
+		drop, latency := f.dropDials, f.latency
+		f.mu.Unlock()
+		if drop {
+			return nil, ErrTestDialDropped
+		}
+		if latency > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(latency):
+			}
+		}
+		conn, err := next(ctx, addr)
+		if err != nil {
+			return nil, err
+		}
+		fc := &faultConn{Conn: conn, f: f}
+		f.mu.Lock()
+		f.conns[fc] = struct{}{}
+		f.mu.Unlock()
+		return fc, nil
+	}
+}
+
+// SetLatency delays every dial and every write on the connections by d. A
+// zero duration removes the latency.
+func (f *TestFaultInjector) SetLatency(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.latency = d
+}
+
+// SetDropDials makes new dials fail with ErrTestDialDropped while drop is
+// true. Existing connections are not affected; see Disconnect.
+func (f *TestFaultInjector) SetDropDials(drop bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.dropDials = drop
+}
+
+// Disconnect closes all the open connections, which forces the worker to
+// reconnect to its upstreams. Combined with SetDropDials it simulates the
+// upstreams going away.
+func (f *TestFaultInjector) Disconnect() {
+	f.mu.Lock()
+	conns := f.conns
+	f.conns = make(map[*faultConn]struct{})
+	f.mu.Unlock()
+	for c := range conns {
+		_ = c.Conn.Close()
+	}
+}
+
+// OpenConnections returns the number of open connections made through the
+// injector.
+func (f *TestFaultInjector) OpenConnections() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.conns)
+}
+
+type faultConn struct {
+	net.Conn
+	f *TestFaultInjector
+}
+
+func (c *faultConn) Write(b []byte) (int, error) {
+	c.f.mu.Lock()
+	latency := c.f.latency
+	c.f.mu.Unlock()
+	if latency > 0 {
+		time.Sleep(latency)
+	}
+	return c.Conn.Write(b)
+}
+
+func (c *faultConn) Close() error {
+	c.f.mu.Lock()
+	delete(c.f.conns, c)
+	c.f.mu.Unlock()
+	return c.Conn.Close()
+}
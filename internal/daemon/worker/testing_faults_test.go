@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package worker
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTestFaultInjector(t *testing.T) {
+	ctx := context.Background()
+	var servers []net.Conn
+	dial := func(context.Context, string) (net.Conn, error) {
+		client, server := net.Pipe()
+		servers = append(servers, server)
+		return client, nil
+	}
+	f := NewTestFaultInjector()
+	wrapped := f.Wrap(dial)
+
+	conn, err := wrapped(ctx, "upstream")
+	require.NoError(t, err)
+	assert.Equal(t, 1, f.OpenConnections())
+
+	// Latency delays writes
+	f.SetLatency(50 * time.Millisecond)
+	go func() { _, _ = io.ReadAll(servers[0]) }()
+	start := time.Now()
+	_, err = conn.Write([]byte("hello"))
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+	f.SetLatency(0)
+
+	// Dropped dials fail without dialing
+	f.SetDropDials(true)
+	_, err = wrapped(ctx, "upstream")
+	assert.ErrorIs(t, err, ErrTestDialDropped)
+	assert.Len(t, servers, 1)
+	f.SetDropDials(false)
+
+	// Disconnect closes the open connections
+	conn2, err := wrapped(ctx, "upstream")
+	require.NoError(t, err)
+	assert.Equal(t, 2, f.OpenConnections())
+	f.Disconnect()
+	assert.Equal(t, 0, f.OpenConnections())
+	for _, c := range []net.Conn{conn, conn2} {
+		_, err = c.Write([]byte("hello"))
+		assert.ErrorIs(t, err, io.ErrClosedPipe)
+	}
+
+	// Closing a connection stops tracking it
+	conn3, err := wrapped(ctx, "upstream")
+	require.NoError(t, err)
+	require.NoError(t, conn3.Close())
+	assert.Equal(t, 0, f.OpenConnections())
+}
@@ -53,7 +53,7 @@ func TestTestWorkerLookupSession(t *testing.T) {
 	mockSessionClient.ActivateSessionFn = func(_ context.Context, _ *pbs.ActivateSessionRequest) (*pbs.ActivateSessionResponse, error) {
 		return &pbs.ActivateSessionResponse{Status: pbs.SESSIONSTATUS_SESSIONSTATUS_ACTIVE}, nil
 	}
-	require.NoError(s.RequestActivate(ctx, "tofu"))
+	require.NoError(s.RequestActivate(ctx, "tofu", false))
 
 	mockSessionClient.AuthorizeConnectionFn = func(_ context.Context, req *pbs.AuthorizeConnectionRequest) (*pbs.AuthorizeConnectionResponse, error) {
 		return &pbs.AuthorizeConnectionResponse{
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/hashicorp/boundary/internal/daemon/cluster"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// decompressorNotInstalledErrMsg is part of the message of the error returned
+// by a grpc server which can't decompress the request.
+const decompressorNotInstalledErrMsg = "Decompressor is not installed"
+
+// upstreamCompressor negotiates the compression of the unary RPCs the worker
+// makes to its upstreams. RPCs are compressed until an upstream returns an
+// error because it can't decompress them (e.g. a controller from a release
+// without compression support), after which the RPC is retried uncompressed
+// and no further RPCs are compressed.
+type upstreamCompressor struct {
+	name        string
+	unsupported atomic.Bool
+}
+
+// newUpstreamCompressor returns an upstreamCompressor for the worker's
+// upstream_compression config. It returns nil when RPCs aren't compressed.
+func newUpstreamCompressor(compression string) *upstreamCompressor {
+	switch compression {
+	case cluster.ZstdCompressor:
+		return &upstreamCompressor{name: compression}
+	default:
+		return nil
+	}
+}
+
+// unaryInterceptor returns a grpc.UnaryClientInterceptor which compresses the
+// RPCs.
+func (c *upstreamCompressor) unaryInterceptor() grpc.UnaryClientInterceptor {
+	const op = "worker.(upstreamCompressor).unaryInterceptor"
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if c.unsupported.Load() {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		err := invoker(ctx, method, req, reply, cc, append(opts, grpc.UseCompressor(c.name))...)
+		if !decompressorNotInstalled(err) {
+			return err
+		}
+		if c.unsupported.CompareAndSwap(false, true) {
+			event.WriteSysEvent(ctx, op, fmt.Sprintf("Upstream can't decompress %s compressed RPCs, no longer compressing them", c.name))
+		}
+		// the upstream rejected the request before handling it, so it's safe
+		// to retry it.
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+func decompressorNotInstalled(err error) bool {
+	if err == nil {
+		return false
+	}
+	st, ok := status.FromError(err)
+	return ok && st.Code() == codes.Unimplemented && strings.Contains(st.Message(), decompressorNotInstalledErrMsg)
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package worker
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/daemon/cluster"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestNewUpstreamCompressor(t *testing.T) {
+	t.Parallel()
+	assert.Nil(t, newUpstreamCompressor(""))
+	assert.Nil(t, newUpstreamCompressor("none"))
+	c := newUpstreamCompressor("zstd")
+	require.NotNil(t, c)
+	assert.Equal(t, cluster.ZstdCompressor, c.name)
+}
+
+func TestUpstreamCompressor_unaryInterceptor(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	compressorOf := func(opts []grpc.CallOption) string {
+		for _, o := range opts {
+			if c, ok := o.(grpc.CompressorCallOption); ok {
+				return c.CompressorType
+			}
+		}
+		return ""
+	}
+	notInstalledErr := status.Errorf(codes.Unimplemented, "grpc: Decompressor is not installed for grpc-encoding %q", cluster.ZstdCompressor)
+
+	t.Run("compressed", func(t *testing.T) {
+		var calls []string
+		interceptor := newUpstreamCompressor("zstd").unaryInterceptor()
+		invoker := func(_ context.Context, _ string, _, _ any, _ *grpc.ClientConn, opts ...grpc.CallOption) error {
+			calls = append(calls, compressorOf(opts))
+			return nil
+		}
+		require.NoError(t, interceptor(ctx, "/test", nil, nil, nil, invoker))
+		require.NoError(t, interceptor(ctx, "/test", nil, nil, nil, invoker))
+		assert.Equal(t, []string{"zstd", "zstd"}, calls)
+	})
+	t.Run("other-errors-are-returned", func(t *testing.T) {
+		var calls []string
+		wantErr := status.Error(codes.Unimplemented, "unknown method")
+		interceptor := newUpstreamCompressor("zstd").unaryInterceptor()
+		invoker := func(_ context.Context, _ string, _, _ any, _ *grpc.ClientConn, opts ...grpc.CallOption) error {
+			calls = append(calls, compressorOf(opts))
+			return wantErr
+		}
+		assert.Equal(t, wantErr, interceptor(ctx, "/test", nil, nil, nil, invoker))
+		assert.Equal(t, []string{"zstd"}, calls)
+
+		calls = nil
+		wantErr = errors.New("not a status")
+		assert.Equal(t, wantErr, interceptor(ctx, "/test", nil, nil, nil, invoker))
+		assert.Equal(t, []string{"zstd"}, calls)
+	})
+	t.Run("fallback", func(t *testing.T) {
+		var calls []string
+		interceptor := newUpstreamCompressor("zstd").unaryInterceptor()
+		invoker := func(_ context.Context, _ string, _, _ any, _ *grpc.ClientConn, opts ...grpc.CallOption) error {
+			c := compressorOf(opts)
+			calls = append(calls, c)
+			if c != "" {
+				return notInstalledErr
+			}
+			return nil
+		}
+		// the rejected call is retried uncompressed
+		require.NoError(t, interceptor(ctx, "/test", nil, nil, nil, invoker))
+		assert.Equal(t, []string{"zstd", ""}, calls)
+
+		// and later calls aren't compressed
+		calls = nil
+		require.NoError(t, interceptor(ctx, "/test", nil, nil, nil, invoker))
+		assert.Equal(t, []string{""}, calls)
+	})
+}
+
+func TestUpstreamCompressor_grpc(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	l := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	healthpb.RegisterHealthServer(s, health.NewServer())
+	go func() { _ = s.Serve(l) }()
+	t.Cleanup(s.Stop)
+
+	var gotCompressors []string
+	recordCompressor := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		for _, o := range opts {
+			if c, ok := o.(grpc.CompressorCallOption); ok {
+				gotCompressors = append(gotCompressors, c.CompressorType)
+			}
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	cc, err := grpc.DialContext(ctx, "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return l.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(newUpstreamCompressor("zstd").unaryInterceptor(), recordCompressor),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = cc.Close() })
+
+	resp, err := healthpb.NewHealthClient(cc).Check(ctx, &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.GetStatus())
+	assert.Equal(t, []string{cluster.ZstdCompressor}, gotCompressors)
+}
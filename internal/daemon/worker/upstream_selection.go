@@ -0,0 +1,249 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbhealth "github.com/hashicorp/boundary/internal/gen/worker/health"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/util"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// upstreamProbeFunc measures the latency to the upstream at addr.
+type upstreamProbeFunc func(ctx context.Context, addr string) (time.Duration, error)
+
+type upstreamProbe struct {
+	latency   time.Duration
+	reachable bool
+}
+
+// upstreamSelector ranks the upstreams of a worker. Reachable upstreams are
+// ranked by their probed latency divided by their weight, followed by the
+// upstreams not probed yet and then the unreachable ones, each by weight.
+// The worker connects to the best ranked upstream and, as the grpc client
+// stays on its upstream as long as it is in the list, only moves to the next
+// one when its upstream becomes unreachable.
+type upstreamSelector struct {
+	weights  map[string]int
+	interval time.Duration
+	probe    upstreamProbeFunc
+
+	mu     sync.Mutex
+	addrs  []string
+	probes map[string]upstreamProbe
+	ranked []string
+}
+
+func newUpstreamSelector(conf *config.UpstreamSelection) *upstreamSelector {
+	s := &upstreamSelector{
+		weights:  make(map[string]int, len(conf.Weights)),
+		interval: conf.ProbeIntervalDuration,
+		probe:    dialUpstreamProbe,
+		probes:   make(map[string]upstreamProbe),
+	}
+	for addr, weight := range conf.Weights {
+		s.weights[normalizeUpstreamAddr(addr)] = weight
+	}
+	return s
+}
+
+// normalizeUpstreamAddr adds the default cluster port to addresses without a
+// port, the same way initial upstreams are.
+func normalizeUpstreamAddr(addr string) string {
+	if strings.HasPrefix(addr, "/") {
+		return addr
+	}
+	host, port, err := util.SplitHostPort(addr)
+	switch {
+	case errors.Is(err, util.ErrMissingPort):
+		port = "9201"
+	case err != nil:
+		return addr
+	}
+	return net.JoinHostPort(host, port)
+}
+
+// dialUpstreamProbe measures the time to open a connection to the upstream.
+func dialUpstreamProbe(ctx context.Context, addr string) (time.Duration, error) {
+	network := "tcp"
+	if strings.HasPrefix(addr, "/") {
+		network = "unix"
+	}
+	start := time.Now()
+	conn, err := (&net.Dialer{}).DialContext(ctx, network, addr)
+	if err != nil {
+		return 0, err
+	}
+	latency := time.Since(start)
+	_ = conn.Close()
+	return latency, nil
+}
+
+func (s *upstreamSelector) weight(addr string) int {
+	if w, ok := s.weights[addr]; ok {
+		return w
+	}
+	return 1
+}
+
+// rank sets the upstreams to rank and returns them best ranked first.
+func (s *upstreamSelector) rank(addrs []string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.addrs = append(s.addrs[:0], addrs...)
+	s.ranked = s.rankLocked()
+	return append([]string(nil), s.ranked...)
+}
+
+func (s *upstreamSelector) rankLocked() []string {
+	const (
+		reachable = iota
+		unprobed
+		unreachable
+	)
+	group := func(addr string) int {
+		p, ok := s.probes[addr]
+		switch {
+		case !ok:
+			return unprobed
+		case p.reachable:
+			return reachable
+		default:
+			return unreachable
+		}
+	}
+	ranked := append([]string(nil), s.addrs...)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		a, b := ranked[i], ranked[j]
+		ga, gb := group(a), group(b)
+		switch {
+		case ga != gb:
+			return ga < gb
+		case ga == reachable:
+			// Compare latency_a / weight_a with latency_b / weight_b
+			return int64(s.probes[a].latency)*int64(s.weight(b)) < int64(s.probes[b].latency)*int64(s.weight(a))
+		default:
+			return s.weight(a) > s.weight(b)
+		}
+	})
+	return ranked
+}
+
+// probeAll probes the upstreams and returns them best ranked first, and
+// whether the ranking changed.
+func (s *upstreamSelector) probeAll(ctx context.Context, timeout time.Duration) ([]string, bool) {
+	s.mu.Lock()
+	addrs := append([]string(nil), s.addrs...)
+	s.mu.Unlock()
+
+	results := make([]upstreamProbe, len(addrs))
+	var wg sync.WaitGroup
+	for i, addr := range addrs {
+		wg.Add(1)
+		go func(i int, addr string) {
+			defer wg.Done()
+			probeCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			latency, err := s.probe(probeCtx, addr)
+			results[i] = upstreamProbe{latency: latency, reachable: err == nil}
+		}(i, addr)
+	}
+	wg.Wait()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, addr := range addrs {
+		s.probes[addr] = results[i]
+	}
+	// Drop the probes of upstreams that have gone away
+	current := make(map[string]bool, len(s.addrs))
+	for _, addr := range s.addrs {
+		current[addr] = true
+	}
+	for addr := range s.probes {
+		if !current[addr] {
+			delete(s.probes, addr)
+		}
+	}
+	ranked := s.rankLocked()
+	changed := !sameOrder(ranked, s.ranked)
+	s.ranked = ranked
+	return append([]string(nil), ranked...), changed
+}
+
+// upstreamInfo returns the upstreams best ranked first, for health checks.
+func (s *upstreamSelector) upstreamInfo() []*pbhealth.UpstreamInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ret := make([]*pbhealth.UpstreamInfo, 0, len(s.ranked))
+	for _, addr := range s.ranked {
+		info := &pbhealth.UpstreamInfo{
+			Address:   addr,
+			Weight:    int32(s.weight(addr)),
+			Reachable: true,
+		}
+		if p, ok := s.probes[addr]; ok {
+			info.Reachable = p.reachable
+			if p.reachable {
+				info.Latency = durationpb.New(p.latency)
+			}
+		}
+		ret = append(ret, info)
+	}
+	return ret
+}
+
+func sameOrder(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// startUpstreamProbing periodically probes the upstreams and updates the grpc
+// resolver when their ranking changes.
+func (w *Worker) startUpstreamProbing(cancelCtx context.Context) {
+	const op = "worker.(Worker).startUpstreamProbing"
+	s := w.upstreamSelector
+	if s == nil {
+		return
+	}
+	timer := time.NewTimer(0)
+	for {
+		select {
+		case <-cancelCtx.Done():
+			event.WriteSysEvent(w.baseContext, op, "upstream probing shutting down")
+			return
+
+		case <-timer.C:
+			ranked, changed := s.probeAll(cancelCtx, time.Duration(w.statusCallTimeoutDuration.Load()))
+			if changed && len(ranked) > 0 {
+				event.WriteSysEvent(cancelCtx, op, fmt.Sprintf("Upstream ranking changed to: %s", ranked))
+				w.statusLock.Lock()
+				for _, ar := range w.addressReceivers {
+					if ar.Type() == grpcResolverReceiverType {
+						ar.SetAddresses(ranked)
+					}
+				}
+				w.statusLock.Unlock()
+			}
+			timer.Reset(s.interval)
+		}
+	}
+}
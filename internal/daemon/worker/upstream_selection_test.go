@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpstreamSelector(t *testing.T) {
+	ctx := context.Background()
+	const (
+		a = "a.example.com:9201"
+		b = "b.example.com:9201"
+		c = "c.example.com:9201"
+	)
+	s := newUpstreamSelector(&config.UpstreamSelection{
+		Weights:               map[string]int{"a.example.com": 1, b: 4},
+		ProbeIntervalDuration: time.Second,
+	})
+	assert.Equal(t, map[string]int{a: 1, b: 4}, s.weights)
+
+	// Before probing, upstreams are ranked by weight
+	assert.Equal(t, []string{b, a, c}, s.rank([]string{a, b, c}))
+
+	latencies := map[string]time.Duration{a: 10 * time.Millisecond, b: 20 * time.Millisecond}
+	s.probe = func(_ context.Context, addr string) (time.Duration, error) {
+		l, ok := latencies[addr]
+		if !ok {
+			return 0, fmt.Errorf("unreachable")
+		}
+		return l, nil
+	}
+
+	// b is slower but its weight makes up for it, and c is unreachable
+	ranked, changed := s.probeAll(ctx, time.Second)
+	assert.Equal(t, []string{b, a, c}, ranked)
+	assert.False(t, changed)
+
+	latencies[b] = 50 * time.Millisecond
+	ranked, changed = s.probeAll(ctx, time.Second)
+	assert.Equal(t, []string{a, b, c}, ranked)
+	assert.True(t, changed)
+
+	info := s.upstreamInfo()
+	require.Len(t, info, 3)
+	assert.Equal(t, a, info[0].GetAddress())
+	assert.Equal(t, int32(1), info[0].GetWeight())
+	assert.True(t, info[0].GetReachable())
+	assert.Equal(t, 10*time.Millisecond, info[0].GetLatency().AsDuration())
+	assert.Equal(t, int32(4), info[1].GetWeight())
+	assert.False(t, info[2].GetReachable())
+	assert.Nil(t, info[2].GetLatency())
+
+	// A reachable upstream that was not probed yet ranks after probed ones
+	// but before unreachable ones
+	const d = "d.example.com:9201"
+	assert.Equal(t, []string{a, b, d, c}, s.rank([]string{a, b, c, d}))
+
+	// Probes of upstreams that have gone away are dropped
+	s.rank([]string{a})
+	_, changed = s.probeAll(ctx, time.Second)
+	assert.False(t, changed)
+	assert.Len(t, s.probes, 1)
+}
+
+func TestNormalizeUpstreamAddr(t *testing.T) {
+	assert.Equal(t, "127.0.0.1:9201", normalizeUpstreamAddr("127.0.0.1"))
+	assert.Equal(t, "127.0.0.1:9202", normalizeUpstreamAddr("127.0.0.1:9202"))
+	assert.Equal(t, "[::1]:9201", normalizeUpstreamAddr("::1"))
+	assert.Equal(t, "/tmp/upstream.sock", normalizeUpstreamAddr("/tmp/upstream.sock"))
+}
@@ -29,6 +29,7 @@ import (
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/internal/observability/event"
 	"github.com/hashicorp/boundary/internal/server"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-secure-stdlib/base62"
 	"github.com/hashicorp/go-secure-stdlib/mlock"
@@ -100,6 +101,17 @@ type Worker struct {
 	// receives address updates and contains the grpc resolver.
 	addressReceivers []addressReceiver
 
+	// upstreamSelector ranks the upstreams; it is nil if the worker has no
+	// upstream_selection config, in which case requests are spread across
+	// all upstreams.
+	upstreamSelector *upstreamSelector
+	// upstreamCompressor compresses the RPCs to the upstreams; it is nil if
+	// the worker's upstream_compression config doesn't enable compression.
+	upstreamCompressor *upstreamCompressor
+	// currentUpstream is the address of the upstream most recently connected
+	// to.
+	currentUpstream *ua.String
+
 	sessionManager session.Manager
 
 	controllerStatusConn *atomic.Value
@@ -112,6 +124,14 @@ type Worker struct {
 
 	proxyListener *base.ServerListener
 
+	// connectionLog records closed connections to a local file; it is nil if
+	// the worker has no connection_log config.
+	connectionLog *connectionLogger
+
+	// protocolPlugins are the loaded protocol plugins keyed by the protocols
+	// they handle.
+	protocolPlugins map[string]plgpb.ProtocolPluginServiceClient
+
 	// Used to generate a random nonce for Controller connections
 	nonceFn randFn
 
@@ -135,6 +155,7 @@ type Worker struct {
 
 	// Timing variables. These are atomics for SIGHUP support, and are int64
 	// because they are casted to time.Duration.
+	statusInterval              *atomic.Int64
 	successfulStatusGracePeriod *atomic.Int64
 	statusCallTimeoutDuration   *atomic.Int64
 
@@ -142,6 +163,10 @@ type Worker struct {
 	TestOverrideX509VerifyDnsName  string
 	TestOverrideX509VerifyCertPool *x509.CertPool
 	TestOverrideAuthRotationPeriod time.Duration
+	// TestUpstreamDialerWrapper, if set, wraps the dialer used for the
+	// connection to the upstream controllers or workers, letting tests inject
+	// faults into it
+	TestUpstreamDialerWrapper func(UpstreamDialFunc) UpstreamDialFunc
 
 	statusLock sync.Mutex
 
@@ -169,8 +194,10 @@ func New(conf *Config) (*Worker, error) {
 		WorkerAuthCurrentKeyId:      new(ua.String),
 		operationalState:            new(atomic.Value),
 		pkiConnManager:              cluster.NewDownstreamManager(),
+		statusInterval:              new(atomic.Int64),
 		successfulStatusGracePeriod: new(atomic.Int64),
 		statusCallTimeoutDuration:   new(atomic.Int64),
+		currentUpstream:             new(ua.String),
 	}
 
 	if reverseConnReceiverFactory != nil {
@@ -188,6 +215,19 @@ func New(conf *Config) (*Worker, error) {
 
 	w.parseAndStoreTags(conf.RawConfig.Worker.Tags)
 
+	if conf.RawConfig.Worker.UpstreamSelection != nil {
+		w.upstreamSelector = newUpstreamSelector(conf.RawConfig.Worker.UpstreamSelection)
+	}
+	w.upstreamCompressor = newUpstreamCompressor(conf.RawConfig.Worker.UpstreamCompression)
+
+	var err error
+	if w.connectionLog, err = newConnectionLogger(conf.RawConfig.Worker.ConnectionLog); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if w.protocolPlugins, err = w.loadProtocolPlugins(context.Background()); err != nil {
+		return nil, err
+	}
+
 	if conf.SecureRandomReader == nil {
 		conf.SecureRandomReader = rand.Reader
 	}
@@ -207,6 +247,12 @@ func New(conf *Config) (*Worker, error) {
 				err)
 		}
 	}
+	switch conf.RawConfig.Worker.StatusIntervalDuration {
+	case 0:
+		w.statusInterval.Store(int64(common.StatusInterval))
+	default:
+		w.statusInterval.Store(int64(conf.RawConfig.Worker.StatusIntervalDuration))
+	}
 	switch conf.RawConfig.Worker.SuccessfulStatusGracePeriodDuration {
 	case 0:
 		w.successfulStatusGracePeriod.Store(int64(server.DefaultLiveness))
@@ -267,10 +313,14 @@ func (w *Worker) Reload(ctx context.Context, newConf *config.Config) {
 		event.WriteSysEvent(ctx, op, upstreamsMessage)
 		w.conf.RawConfig.Worker.InitialUpstreams = newConf.Worker.InitialUpstreams
 
+		addrs := w.conf.RawConfig.Worker.InitialUpstreams
+		if w.upstreamSelector != nil {
+			addrs = w.upstreamSelector.rank(addrs)
+		}
 		for _, ar := range w.addressReceivers {
-			ar.SetAddresses(w.conf.RawConfig.Worker.InitialUpstreams)
+			ar.SetAddresses(addrs)
 			// set InitialAddresses in case the worker has not successfully dialed yet
-			ar.InitialAddresses(w.conf.RawConfig.Worker.InitialUpstreams)
+			ar.InitialAddresses(addrs)
 		}
 	}
 }
@@ -440,6 +490,14 @@ func (w *Worker) Start() error {
 		w.startAuthRotationTicking(w.baseContext)
 	}()
 
+	if w.upstreamSelector != nil {
+		w.tickerWg.Add(1)
+		go func() {
+			defer w.tickerWg.Done()
+			w.startUpstreamProbing(w.baseContext)
+		}()
+	}
+
 	if w.downstreamReceiver != nil {
 		w.tickerWg.Add(2)
 		servNameFn := func() string {
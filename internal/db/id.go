@@ -5,24 +5,168 @@ package db
 
 import (
 	"bytes"
+	"context"
 	"fmt"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/go-secure-stdlib/base62"
 	"golang.org/x/crypto/blake2b"
 )
 
+const (
+	// DefaultPublicIdSuffixLength is the default length of the random suffix
+	// of a public id, excluding any checksum character.
+	DefaultPublicIdSuffixLength = 10
+
+	// MaxPublicIdSuffixLength is the maximum configurable length of the random
+	// suffix of a public id.
+	MaxPublicIdSuffixLength = 32
+
+	// base62Charset is the charset used by base62, in the same order.
+	base62Charset = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+
+	// timeSortableLength is the length of the time component of time sortable
+	// public ids. Ten base36 characters hold any 48 bit millisecond timestamp.
+	timeSortableLength = 10
+)
+
+// publicIdFormat is the format of newly generated public ids, set by
+// ConfigurePublicIds.
+var publicIdFormat = struct {
+	sync.RWMutex
+	suffixLength         int
+	checksum             bool
+	timeSortablePrefixes map[string]struct{}
+}{
+	suffixLength: DefaultPublicIdSuffixLength,
+}
+
+// ConfigurePublicIds sets the length of the random suffix of newly generated
+// public ids and whether a checksum character is appended to the suffix. It
+// applies to the whole process and is expected to be called once at startup.
+// Existing ids are unaffected, and ids derived with WithPrngValues always use
+// the default format so they stay stable.
+func ConfigurePublicIds(ctx context.Context, suffixLength int, checksum bool) error {
+	const op = "db.ConfigurePublicIds"
+	if suffixLength < DefaultPublicIdSuffixLength || suffixLength > MaxPublicIdSuffixLength {
+		return errors.New(ctx, errors.InvalidParameter, op,
+			fmt.Sprintf("suffix length must be between %d and %d", DefaultPublicIdSuffixLength, MaxPublicIdSuffixLength))
+	}
+	publicIdFormat.Lock()
+	defer publicIdFormat.Unlock()
+	publicIdFormat.suffixLength = suffixLength
+	publicIdFormat.checksum = checksum
+	return nil
+}
+
+// ConfigureTimeSortablePublicIds sets the prefixes of the public ids which are
+// generated as time sortable ids. Their suffix starts with the generation time
+// in milliseconds, so new ids of a high-churn resource sort after older ones
+// and are appended to the end of database indexes. Like ULIDs, the time is
+// followed by a random component. It applies to the whole process and is
+// expected to be called once at startup; calling it without prefixes restores
+// random ids for all prefixes.
+func ConfigureTimeSortablePublicIds(_ context.Context, prefixes ...string) {
+	set := make(map[string]struct{}, len(prefixes))
+	for _, p := range prefixes {
+		set[p] = struct{}{}
+	}
+	publicIdFormat.Lock()
+	defer publicIdFormat.Unlock()
+	publicIdFormat.timeSortablePrefixes = set
+}
+
 func NewPrivateId(prefix string, opt ...Option) (string, error) {
-	return newId(prefix, opt...)
+	return newId(prefix, DefaultPublicIdSuffixLength, opt...)
 }
 
-// NewPublicId creates a new public id with the prefix
+// NewPublicId creates a new public id with the prefix. The length of the
+// suffix and whether it ends with a checksum character are set by
+// ConfigurePublicIds, and whether the suffix starts with the generation time by
+// ConfigureTimeSortablePublicIds.
 func NewPublicId(prefix string, opt ...Option) (string, error) {
-	return newId(prefix, opt...)
+	const op = "db.NewPublicId"
+	opts := GetOpts(opt...)
+	if len(opts.withPrngValues) > 0 {
+		return newId(prefix, DefaultPublicIdSuffixLength, opt...)
+	}
+	publicIdFormat.RLock()
+	suffixLength, checksum := publicIdFormat.suffixLength, publicIdFormat.checksum
+	_, timeSortable := publicIdFormat.timeSortablePrefixes[prefix]
+	publicIdFormat.RUnlock()
+
+	id, err := newId(prefix, suffixLength, opt...)
+	if err != nil {
+		return "", err
+	}
+	if timeSortable {
+		id = prefix + "_" + timeSortableComponent(time.Now()) + id[len(prefix)+1:]
+	}
+	if checksum {
+		c, err := checksumChar(id[len(prefix)+1:])
+		if err != nil {
+			return "", errors.WrapDeprecated(err, op)
+		}
+		id += string(c)
+	}
+	return id, nil
+}
+
+// ValidPublicIdChecksum reports whether the last character of the suffix of
+// the public id is a valid checksum of the rest of the suffix. It can be used
+// to detect mistyped ids when public ids are configured with checksums.
+func ValidPublicIdChecksum(id string) bool {
+	i := strings.LastIndex(id, "_")
+	if i < 0 || len(id)-i-1 < 2 {
+		return false
+	}
+	suffix := id[i+1:]
+	c, err := checksumChar(suffix[:len(suffix)-1])
+	if err != nil {
+		return false
+	}
+	return c == suffix[len(suffix)-1]
+}
+
+// timeSortableComponent returns the time in milliseconds since the epoch as a
+// fixed width base36 string. Only digits and lower case letters are used so the
+// components sort the same way in byte order and in the case insensitive
+// collations commonly used by databases.
+func timeSortableComponent(t time.Time) string {
+	c := strconv.FormatInt(t.UnixMilli(), 36)
+	if len(c) < timeSortableLength {
+		c = strings.Repeat("0", timeSortableLength-len(c)) + c
+	}
+	return c
+}
+
+// checksumChar returns the Luhn mod 62 check character of s, which detects
+// any single mistyped character and most transpositions of adjacent
+// characters.
+func checksumChar(s string) (byte, error) {
+	const n = len(base62Charset)
+	factor, sum := 2, 0
+	for i := len(s) - 1; i >= 0; i-- {
+		v := strings.IndexByte(base62Charset, s[i])
+		if v < 0 {
+			return 0, fmt.Errorf("invalid base62 character %q", s[i])
+		}
+		addend := factor * v
+		sum += addend/n + addend%n
+		if factor == 2 {
+			factor = 1
+		} else {
+			factor = 2
+		}
+	}
+	return base62Charset[(n-sum%n)%n], nil
 }
 
-func newId(prefix string, opt ...Option) (string, error) {
+func newId(prefix string, length int, opt ...Option) (string, error) {
 	const op = "db.newId"
 	if prefix == "" {
 		return "", errors.NewDeprecated(errors.InvalidParameter, op, "missing prefix")
@@ -33,9 +177,9 @@ func newId(prefix string, opt ...Option) (string, error) {
 	if len(opts.withPrngValues) > 0 {
 		sum := blake2b.Sum256([]byte(strings.Join(opts.withPrngValues, "|")))
 		reader := bytes.NewReader(sum[0:])
-		publicId, err = base62.RandomWithReader(10, reader)
+		publicId, err = base62.RandomWithReader(length, reader)
 	} else {
-		publicId, err = base62.Random(10)
+		publicId, err = base62.Random(length)
 	}
 	if err != nil {
 		return "", errors.WrapDeprecated(err, op, errors.WithMsg("unable to generate id"), errors.WithCode(errors.Io))
@@ -4,8 +4,11 @@
 package db
 
 import (
+	"context"
+	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -146,3 +149,118 @@ func TestPseudoRandomId(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigurePublicIds(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		require.NoError(t, ConfigurePublicIds(ctx, DefaultPublicIdSuffixLength, false))
+	})
+
+	assert.Error(t, ConfigurePublicIds(ctx, DefaultPublicIdSuffixLength-1, false))
+	assert.Error(t, ConfigurePublicIds(ctx, MaxPublicIdSuffixLength+1, false))
+
+	t.Run("suffix-length", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		require.NoError(ConfigurePublicIds(ctx, 20, false))
+		got, err := NewPublicId("id")
+		require.NoError(err)
+		assert.Len(got, 20+len("id_"))
+
+		// Private and pseudo-random ids keep the default length.
+		got, err = NewPrivateId("id")
+		require.NoError(err)
+		assert.Len(got, DefaultPublicIdSuffixLength+len("id_"))
+		got, err = NewPublicId("id", WithPrngValues([]string{"foo", "bar"}))
+		require.NoError(err)
+		assert.Len(got, DefaultPublicIdSuffixLength+len("id_"))
+	})
+	t.Run("checksum", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		require.NoError(ConfigurePublicIds(ctx, 16, true))
+		got, err := NewPublicId("id")
+		require.NoError(err)
+		assert.Len(got, 16+1+len("id_"))
+		assert.True(ValidPublicIdChecksum(got))
+
+		// Changing any single character of the suffix invalidates the checksum.
+		for i := len("id_"); i < len(got); i++ {
+			for _, c := range []byte(base62Charset) {
+				if c == got[i] {
+					continue
+				}
+				typo := got[:i] + string(c) + got[i+1:]
+				assert.False(ValidPublicIdChecksum(typo), typo)
+			}
+		}
+	})
+}
+
+func TestConfigureTimeSortablePublicIds(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		ConfigureTimeSortablePublicIds(ctx)
+		require.NoError(t, ConfigurePublicIds(ctx, DefaultPublicIdSuffixLength, false))
+	})
+	ConfigureTimeSortablePublicIds(ctx, "ts")
+
+	t.Run("sortable", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		var ids []string
+		for i := 0; i < 3; i++ {
+			id, err := NewPublicId("ts")
+			require.NoError(err)
+			assert.Len(id, timeSortableLength+DefaultPublicIdSuffixLength+len("ts_"))
+			ids = append(ids, id)
+			time.Sleep(2 * time.Millisecond)
+		}
+		assert.True(sort.StringsAreSorted(ids), ids)
+
+		// Other prefixes and pseudo-random ids are not time sortable.
+		got, err := NewPublicId("id")
+		require.NoError(err)
+		assert.Len(got, DefaultPublicIdSuffixLength+len("id_"))
+		got, err = NewPublicId("ts", WithPrngValues([]string{"foo", "bar"}))
+		require.NoError(err)
+		assert.Len(got, DefaultPublicIdSuffixLength+len("ts_"))
+	})
+	t.Run("checksum", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		require.NoError(ConfigurePublicIds(ctx, DefaultPublicIdSuffixLength, true))
+		got, err := NewPublicId("ts")
+		require.NoError(err)
+		assert.Len(got, timeSortableLength+DefaultPublicIdSuffixLength+1+len("ts_"))
+		assert.True(ValidPublicIdChecksum(got))
+	})
+	t.Run("component", func(t *testing.T) {
+		assert := assert.New(t)
+		assert.Equal("0000000000", timeSortableComponent(time.UnixMilli(0)))
+		assert.Equal("000000000a", timeSortableComponent(time.UnixMilli(10)))
+		assert.Less(timeSortableComponent(time.UnixMilli(35)), timeSortableComponent(time.UnixMilli(36)))
+	})
+}
+
+func TestValidPublicIdChecksum(t *testing.T) {
+	tests := []struct {
+		name string
+		id   string
+		want bool
+	}{
+		{name: "empty", id: ""},
+		{name: "no-suffix", id: "id_"},
+		{name: "no-separator", id: "id1234567890"},
+		{name: "invalid-char", id: "id_12345-67890"},
+		{name: "valid", id: "id_" + "1234567890" + string(mustChecksumChar(t, "1234567890")), want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ValidPublicIdChecksum(tt.id))
+		})
+	}
+}
+
+func mustChecksumChar(t *testing.T, s string) byte {
+	t.Helper()
+	c, err := checksumChar(s)
+	require.NoError(t, err)
+	return c
+}
@@ -0,0 +1,30 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- auth_token_time_to_live_seconds and auth_token_time_to_stale_seconds allow
+  -- an org to override the controller's configured auth token lifetime and
+  -- idle timeout for tokens issued to its accounts.  A null value means the
+  -- controller's configured value is used.  Overrides can only shorten the
+  -- configured values; that bound is enforced by the controller since the
+  -- configured values are not known to the database.
+  alter table iam_scope
+    add column auth_token_time_to_live_seconds int
+      constraint auth_token_time_to_live_seconds_must_be_greater_than_0
+      check(auth_token_time_to_live_seconds > 0),
+    add column auth_token_time_to_stale_seconds int
+      constraint auth_token_time_to_stale_seconds_must_be_greater_than_0
+      check(auth_token_time_to_stale_seconds > 0),
+    add constraint auth_token_lifetime_only_allowed_for_org_scopes
+      check(
+        type = 'org'
+        or (auth_token_time_to_live_seconds is null and auth_token_time_to_stale_seconds is null)
+      );
+
+  comment on column iam_scope.auth_token_time_to_live_seconds is
+    'auth_token_time_to_live_seconds is an optional org override of the total lifetime of auth tokens issued to accounts in the org.';
+  comment on column iam_scope.auth_token_time_to_stale_seconds is
+    'auth_token_time_to_stale_seconds is an optional org override of how long auth tokens issued to accounts in the org can go unused before becoming invalid.';
+
+commit;
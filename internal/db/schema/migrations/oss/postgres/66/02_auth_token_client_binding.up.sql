@@ -0,0 +1,35 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- client_ip_range and client_certificate_fingerprint bind an auth token to
+  -- the client it was issued to.  When set, the token is only valid when
+  -- presented by a client within the ip range or presenting a tls client
+  -- certificate with the fingerprint.  Both are null for unbound tokens.
+  alter table auth_token
+    add column client_ip_range cidr,
+    add column client_certificate_fingerprint text
+      constraint client_certificate_fingerprint_must_not_be_empty
+      check(length(trim(client_certificate_fingerprint)) > 0);
+
+  -- replaces view from 2/05_authtoken.up.sql
+  create or replace view auth_token_account as
+        select at.public_id,
+               at.token,
+               at.auth_account_id,
+               at.create_time,
+               at.update_time,
+               at.approximate_last_access_time,
+               at.expiration_time,
+               aa.scope_id,
+               aa.iam_user_id,
+               aa.auth_method_id,
+               at.status,
+               at.client_ip_range,
+               at.client_certificate_fingerprint
+          from auth_token as at
+    inner join auth_account as aa
+            on at.auth_account_id = aa.public_id;
+
+commit;
@@ -0,0 +1,26 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- to_attribute may now be one of the standard account attributes (fullname,
+  -- email) or a custom attribute name which is stored in the account's
+  -- entry_attributes.
+  -- replaces constraint from 65/01_ldap.up.sql
+  alter table auth_ldap_account_attribute_map
+    drop constraint to_attribute_valid_values;
+  alter table auth_ldap_account_attribute_map
+    add constraint to_attribute_valid_values
+      check (
+        lower(to_attribute) in ('fullname', 'email')
+        or to_attribute ~ '^[a-zA-Z][a-zA-Z0-9_]{0,127}$'
+      );
+
+  -- entry_attributes are the json marshalled custom attributes mapped from the
+  -- authenticated user's entry via the auth method's account attribute maps.
+  alter table auth_ldap_account
+    add column entry_attributes jsonb -- will be null until the first successful authentication
+      constraint entry_attributes_must_be_an_object
+        check(jsonb_typeof(entry_attributes) = 'object');
+
+commit;
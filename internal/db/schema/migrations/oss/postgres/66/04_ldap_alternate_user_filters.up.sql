@@ -0,0 +1,107 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- auth_ldap_alternate_user_filter entries are optional user search filters
+  -- which are tried, in filter_priority order, when the auth method's user
+  -- filter doesn't find the user's entry.
+  --
+  -- Updates will be implemented as a delete + insert with the auth_ldap_method
+  -- being used as the root aggregate for auth_ldap_alternate_user_filter
+  -- updates.
+  create table auth_ldap_alternate_user_filter (
+    create_time wt_timestamp,
+    ldap_method_id wt_public_id not null
+      constraint auth_ldap_method_fkey
+        references auth_ldap_method(public_id)
+        on delete cascade
+        on update cascade,
+    filter_priority int not null
+      constraint filter_priority_less_than_one
+        check(filter_priority >= 1),
+    user_filter text not null
+      constraint user_filter_too_short
+        check (length(trim(user_filter)) > 0)
+      constraint user_filter_too_long
+        check (length(trim(user_filter)) < 2049),
+    primary key(ldap_method_id, filter_priority),
+    constraint auth_ldap_alternate_user_filter_ldap_method_id_user_filter_uq
+      unique(ldap_method_id, user_filter)
+  );
+  comment on table auth_ldap_alternate_user_filter is
+    'auth_ldap_alternate_user_filter entries specify optional user search filters '
+    'which are tried when the auth method''s user filter does not find the user';
+
+  create trigger default_create_time_column before insert on auth_ldap_alternate_user_filter
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on auth_ldap_alternate_user_filter
+    for each row execute procedure immutable_columns('ldap_method_id', 'filter_priority', 'user_filter', 'create_time');
+
+  -- replaces view from 65/01_ldap.up.sql
+  create or replace view ldap_auth_method_with_value_obj as 
+  select 
+    case when s.primary_auth_method_id is not null then
+      true
+    else false end
+    as is_primary_auth_method,
+    am.public_id,
+    am.scope_id,
+    am.name,
+    am.description,
+    am.create_time,
+    am.update_time,
+    am.version,
+    am.state,
+    am.start_tls,
+    am.insecure_tls,
+    am.discover_dn,
+    am.anon_group_search,
+    am.upn_domain,
+    am.enable_groups,
+    am.use_token_groups,
+    -- the string_agg(..) column will be null if there are no associated value objects
+    string_agg(distinct url.url, '|') as urls,
+    string_agg(distinct cert.certificate, '|') as certs,
+    string_agg(distinct concat_ws('=', aam.from_attribute, aam.to_attribute), '|') as account_attribute_map,
+  
+    -- the rest of the fields are zero to one relationships that are stored in
+    -- related tables. Since we're outer joining with these tables, we need to
+    -- either add them to the group by, use an aggregating func, or handle
+    -- multiple rows returning for each auth method. I've chosen to just use
+    -- string_agg(...) 
+    string_agg(distinct uc.user_dn, '|') as user_dn, 
+    string_agg(distinct uc.user_attr, '|') as user_attr, 
+    string_agg(distinct uc.user_filter, '|') as user_filter, 
+    string_agg(distinct gc.group_dn, '|') as group_dn, 
+    string_agg(distinct gc.group_attr, '|') as group_attr, 
+    string_agg(distinct gc.group_filter, '|') as group_filter, 
+    string_agg(distinct cc.certificate_key, '|') as client_certificate_key, 
+    string_agg(distinct cc.certificate_key_hmac, '|') as client_certificate_key_hmac, 
+    string_agg(distinct cc.key_id, '|') as client_certificate_key_id, 
+    string_agg(distinct cc.certificate, '|') as client_certificate_cert,
+    string_agg(distinct bc.dn, '|') as bind_dn, 
+    string_agg(distinct bc.password, '|') as bind_password, 
+    string_agg(distinct bc.password_hmac, '|') as bind_password_hmac,
+    string_agg(distinct bc.key_id, '|') as bind_password_key_id,
+    -- user filters commonly contain the '|' delimiter, so the alternate user
+    -- filters are aggregated as an ordered json array instead.
+    (select jsonb_agg(auf.user_filter order by auf.filter_priority)
+       from auth_ldap_alternate_user_filter auf
+      where auf.ldap_method_id = am.public_id) as alternate_user_filters
+  from 	
+    auth_ldap_method am 
+    left outer join iam_scope                       s     on am.public_id = s.primary_auth_method_id 
+    left outer join auth_ldap_url                   url   on am.public_id = url.ldap_method_id
+    left outer join auth_ldap_certificate           cert  on am.public_id = cert.ldap_method_id
+    left outer join auth_ldap_account_attribute_map aam   on am.public_id = aam.ldap_method_id
+    left outer join auth_ldap_user_entry_search     uc    on am.public_id = uc.ldap_method_id
+    left outer join auth_ldap_group_entry_search    gc    on am.public_id = gc.ldap_method_id
+    left outer join auth_ldap_client_certificate    cc    on am.public_id = cc.ldap_method_id
+    left outer join auth_ldap_bind_credential       bc    on am.public_id = bc.ldap_method_id
+  group by am.public_id, is_primary_auth_method; -- there can be only one public_id + is_primary_auth_method, so group by isn't a problem.
+  comment on view ldap_auth_method_with_value_obj is
+    'ldap auth method with its associated value objects (urls, certs, search config, etc)';
+
+commit;
@@ -0,0 +1,117 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  alter table auth_oidc_method
+    add column jwt_login_enabled boolean not null default false;
+  comment on column auth_oidc_method.jwt_login_enabled is
+    'jwt_login_enabled is true if workloads can authenticate with a JWT issued by the auth method''s issuer.';
+
+  -- auth_oidc_jwt_validation_pub_key entries are optional PEM encoded public
+  -- keys used to verify JWTs presented to the auth method's jwt login command.
+  -- When an auth method has any entries, they are used instead of the
+  -- provider's JWKS.
+  create table auth_oidc_jwt_validation_pub_key (
+    create_time wt_timestamp,
+    oidc_method_id wt_public_id not null
+      constraint auth_oidc_method_fkey
+        references auth_oidc_method(public_id)
+        on delete cascade
+        on update cascade,
+    public_key text not null
+      constraint public_key_must_not_be_empty
+        check(length(trim(public_key)) > 0),
+    primary key(oidc_method_id, public_key)
+  );
+  comment on table auth_oidc_jwt_validation_pub_key is
+    'auth_oidc_jwt_validation_pub_key entries are optional PEM encoded public keys used to verify JWTs '
+    'presented to an oidc auth method. An oidc auth method may have 0 or more of these optional keys. '
+    'If an auth method has any entries, they are used instead of the oidc provider''s JWKS';
+
+  create trigger default_create_time_column before insert on auth_oidc_jwt_validation_pub_key
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on auth_oidc_jwt_validation_pub_key
+    for each row execute procedure immutable_columns('oidc_method_id', 'public_key', 'create_time');
+
+  -- auth_oidc_bound_claim entries are optional claim values which must be
+  -- present in a token for it to be used to authenticate with the auth method.
+  -- A token must match at least one value for every bound claim.
+  create table auth_oidc_bound_claim (
+    create_time wt_timestamp,
+    oidc_method_id wt_public_id not null
+      constraint auth_oidc_method_fkey
+        references auth_oidc_method(public_id)
+        on delete cascade
+        on update cascade,
+    claim text not null
+      constraint claim_must_not_be_empty
+        check(length(trim(claim)) > 0)
+      constraint claim_must_be_less_than_1024_chars
+        check(length(trim(claim)) < 1024),
+    value text not null
+      constraint value_must_not_be_empty
+        check(length(trim(value)) > 0)
+      constraint value_must_be_less_than_1024_chars
+        check(length(trim(value)) < 1024),
+    primary key(oidc_method_id, claim, value)
+  );
+  comment on table auth_oidc_bound_claim is
+    'auth_oidc_bound_claim entries are the optional claim values a token must have to authenticate '
+    'with an oidc auth method. There can be 0 or more for each parent oidc auth method.';
+
+  create trigger default_create_time_column before insert on auth_oidc_bound_claim
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on auth_oidc_bound_claim
+    for each row execute procedure immutable_columns('oidc_method_id', 'claim', 'value', 'create_time');
+
+  -- replaces view from 56/02_add_data_key_foreign_key_references.up.sql
+  drop view oidc_auth_method_with_value_obj;
+  create view oidc_auth_method_with_value_obj as
+  select
+    case when s.primary_auth_method_id is not null then
+      true
+    else false end
+    as is_primary_auth_method,
+    am.public_id,
+    am.scope_id,
+    am.name,
+    am.description,
+    am.create_time,
+    am.update_time,
+    am.version,
+    am.state,
+    am.api_url,
+    am.disable_discovered_config_validation,
+    am.issuer,
+    am.client_id,
+    am.client_secret,
+    am.client_secret_hmac,
+    am.key_id,
+    am.max_age,
+    -- the string_agg(..) column will be null if there are no associated value objects
+    string_agg(distinct alg.signing_alg_name, '|') as algs,
+    string_agg(distinct aud.aud_claim, '|') as auds,
+    string_agg(distinct cert.certificate, '|') as certs,
+    string_agg(distinct cs.scope, '|') as claims_scopes,
+    string_agg(distinct concat_ws('=', acm.from_claim, acm.to_claim), '|') as account_claim_maps,
+    string_agg(distinct pk.public_key, '|') as jwt_validation_pub_keys,
+    string_agg(distinct concat_ws('=', bc.claim, bc.value), '|') as bound_claims,
+    am.jwt_login_enabled
+  from
+    auth_oidc_method am
+    left outer join iam_scope                        s     on am.public_id = s.primary_auth_method_id
+    left outer join auth_oidc_signing_alg            alg   on am.public_id = alg.oidc_method_id
+    left outer join auth_oidc_aud_claim              aud   on am.public_id = aud.oidc_method_id
+    left outer join auth_oidc_certificate            cert  on am.public_id = cert.oidc_method_id
+    left outer join auth_oidc_scope                  cs    on am.public_id = cs.oidc_method_id
+    left outer join auth_oidc_account_claim_map      acm   on am.public_id = acm.oidc_method_id
+    left outer join auth_oidc_jwt_validation_pub_key pk    on am.public_id = pk.oidc_method_id
+    left outer join auth_oidc_bound_claim            bc    on am.public_id = bc.oidc_method_id
+  group by am.public_id, is_primary_auth_method; -- there can be only one public_id + is_primary_auth_method, so group by isn't a problem.
+  comment on view oidc_auth_method_with_value_obj is
+    'oidc auth method with its associated value objects (algs, auds, certs, scopes, account claim maps, jwt validation pub keys and bound claims) as columns with | delimited values';
+
+commit;
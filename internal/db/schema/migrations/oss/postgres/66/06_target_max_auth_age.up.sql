@@ -0,0 +1,60 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- max_auth_age_seconds is the optional maximum age of a user's
+  -- authentication (the age of their auth token) when authorizing a session to
+  -- the target. null equals no maximum.
+  alter table target_tcp
+    add column max_auth_age_seconds int
+      constraint max_auth_age_seconds_must_be_greater_than_0
+        check(max_auth_age_seconds > 0);
+
+  alter table target_ssh
+    add column max_auth_age_seconds int
+      constraint max_auth_age_seconds_must_be_greater_than_0
+        check(max_auth_age_seconds > 0);
+
+  -- Replaces target_all_subtypes defined in 64/01_ssh_targets.up.sql
+  -- The new column is added to the end of the view, so it can be replaced
+  -- without dropping the whx_* views which depend on it.
+  create or replace view target_all_subtypes as
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    'tcp' as type,
+    max_auth_age_seconds
+  from target_tcp
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    'ssh' as type,
+    max_auth_age_seconds
+  from
+    target_ssh;
+
+commit;
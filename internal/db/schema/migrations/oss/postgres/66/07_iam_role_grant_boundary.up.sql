@@ -0,0 +1,12 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- grant_boundary marks a role as a boundary role. The grants of boundary
+  -- roles in a scope cap the grants that can be added to roles in the scope's
+  -- child scopes.
+  alter table iam_role
+    add column grant_boundary boolean not null default false;
+
+commit;
@@ -0,0 +1,43 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- project_template marks an org role as a template which is copied into
+  -- every new project created in the org. When project_template_sync is set,
+  -- changes to the template's grants are applied to the roles copied from it.
+  -- template_role_id is set on project roles that were copied from a
+  -- template and is cleared if the template is deleted.
+  alter table iam_role
+    add column project_template boolean not null default false,
+    add column project_template_sync boolean not null default false,
+    add column template_role_id wt_public_id null
+      constraint iam_role_template_role_fkey
+        references iam_role(public_id)
+        on delete set null
+        on update cascade,
+    add constraint project_template_sync_requires_project_template
+      check(project_template or not project_template_sync);
+
+  create index iam_role_template_role_id_ix
+    on iam_role (template_role_id);
+
+  create function project_template_scope_id_valid() returns trigger
+  as $$
+  begin
+    if new.project_template then
+      perform from iam_scope where public_id = new.scope_id and type = 'org';
+      if not found then
+        raise exception 'invalid scope type for project template role';
+      end if;
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+  comment on function project_template_scope_id_valid is
+    'project_template_scope_id_valid ensures that only roles in org scopes can be project templates.';
+
+  create trigger project_template_scope_id_valid before insert or update on iam_role
+    for each row execute procedure project_template_scope_id_valid();
+
+commit;
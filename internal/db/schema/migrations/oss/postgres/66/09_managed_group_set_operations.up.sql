@@ -0,0 +1,132 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- union_group_ids, intersection_group_ids and difference_group_ids are
+  -- optional json arrays of managed group and static group ids which are
+  -- composed with a managed group's own members.
+  alter table auth_oidc_managed_group
+    add column union_group_ids jsonb null
+      constraint union_group_ids_must_be_an_array
+        check(jsonb_typeof(union_group_ids) = 'array'),
+    add column intersection_group_ids jsonb null
+      constraint intersection_group_ids_must_be_an_array
+        check(jsonb_typeof(intersection_group_ids) = 'array'),
+    add column difference_group_ids jsonb null
+      constraint difference_group_ids_must_be_an_array
+        check(jsonb_typeof(difference_group_ids) = 'array');
+
+  alter table auth_ldap_managed_group
+    add column union_group_ids jsonb null
+      constraint union_group_ids_must_be_an_array
+        check(jsonb_typeof(union_group_ids) = 'array'),
+    add column intersection_group_ids jsonb null
+      constraint intersection_group_ids_must_be_an_array
+        check(jsonb_typeof(intersection_group_ids) = 'array'),
+    add column difference_group_ids jsonb null
+      constraint difference_group_ids_must_be_an_array
+        check(jsonb_typeof(difference_group_ids) = 'array');
+
+  -- replaces view from 65/01_ldap.up.sql
+  drop view auth_managed_group_member_account;
+
+  -- auth_managed_group_member_account applies the set operations of each
+  -- managed group to the members of its subtype. An operand's members are the
+  -- accounts of the managed group's auth method which are members of the
+  -- operand managed group before its own set operations are applied, or whose
+  -- user is a member of the operand static group. The members of a managed
+  -- group are:
+  --   ((subtype members ∪ union operands) ∩ intersection operands) − difference operands
+  create view auth_managed_group_member_account as
+  with
+  subtype_member (create_time, managed_group_id, member_id) as (
+    select
+      oidc.create_time,
+      oidc.managed_group_id,
+      oidc.member_id
+    from
+      auth_oidc_managed_group_member_account oidc
+    union
+    select
+      ldap.create_time,
+      ldap.managed_group_id,
+      ldap.member_id
+    from
+      auth_ldap_managed_group_member_account ldap
+  ),
+  managed_group (create_time, public_id, auth_method_id, union_group_ids, intersection_group_ids, difference_group_ids) as (
+    select
+      create_time, public_id, auth_method_id, union_group_ids, intersection_group_ids, difference_group_ids
+    from
+      auth_oidc_managed_group
+    union all
+    select
+      create_time, public_id, auth_method_id, union_group_ids, intersection_group_ids, difference_group_ids
+    from
+      auth_ldap_managed_group
+  ),
+  operand_member (operand_id, auth_method_id, member_id) as (
+    select
+      sm.managed_group_id, a.auth_method_id, sm.member_id
+    from
+      subtype_member sm
+      join auth_account a on a.public_id = sm.member_id
+    union
+    select
+      gm.group_id, a.auth_method_id, a.public_id
+    from
+      iam_group_member_user gm
+      join auth_account a on a.iam_user_id = gm.member_id
+  ),
+  candidate (create_time, managed_group_id, member_id) as (
+    select
+      create_time, managed_group_id, member_id
+    from
+      subtype_member
+    union
+    select
+      mg.create_time, mg.public_id, om.member_id
+    from
+      managed_group mg
+      cross join jsonb_array_elements_text(mg.union_group_ids) as u(operand_id)
+      join operand_member om
+        on om.operand_id = u.operand_id
+       and om.auth_method_id = mg.auth_method_id
+  )
+  select distinct on (c.managed_group_id, c.member_id)
+    c.create_time,
+    c.managed_group_id,
+    c.member_id
+  from
+    candidate c
+    join managed_group mg on mg.public_id = c.managed_group_id
+  where
+    not exists (
+      select
+      from
+        jsonb_array_elements_text(mg.intersection_group_ids) as i(operand_id)
+      where
+        not exists (
+          select
+          from
+            operand_member om
+          where
+            om.operand_id = i.operand_id
+            and om.member_id = c.member_id
+        )
+    )
+    and not exists (
+      select
+      from
+        jsonb_array_elements_text(mg.difference_group_ids) as d(operand_id)
+        join operand_member om
+          on om.operand_id = d.operand_id
+         and om.member_id = c.member_id
+    )
+  order by c.managed_group_id, c.member_id, c.create_time;
+  comment on view auth_managed_group_member_account is
+    'auth_managed_group_member_account is the join view for the members of oidc and ldap managed groups '
+    'after the set operations of each managed group are applied.';
+
+commit;
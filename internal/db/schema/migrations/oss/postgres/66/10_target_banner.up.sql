@@ -0,0 +1,75 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- banner is an optional legal or usage banner that must be displayed to and
+  -- acknowledged by the user before a session to the target is activated.
+  alter table target_tcp
+    add column banner text
+      constraint banner_must_not_be_empty
+        check(length(trim(banner)) > 0);
+
+  alter table target_ssh
+    add column banner text
+      constraint banner_must_not_be_empty
+        check(length(trim(banner)) > 0);
+
+  -- Replaces target_all_subtypes defined in 66/06_target_max_auth_age.up.sql
+  -- The new column is added to the end of the view, so it can be replaced
+  -- without dropping the whx_* views which depend on it.
+  create or replace view target_all_subtypes as
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    'tcp' as type,
+    max_auth_age_seconds,
+    banner
+  from target_tcp
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    'ssh' as type,
+    max_auth_age_seconds,
+    banner
+  from
+    target_ssh;
+
+  -- banner is the target's banner when the session was created, so the
+  -- acknowledgement refers to the text the user was shown.
+  -- banner_acknowledged_time is when the user acknowledged the banner, which
+  -- is required to activate a session with a banner.
+  alter table session
+    add column banner text,
+    add column banner_acknowledged_time timestamp with time zone;
+
+  -- Replaces trigger from 59/01_target_ingress_egress_worker_filters.up.sql
+  drop trigger immutable_columns on session;
+  create trigger immutable_columns before update on session
+    for each row execute procedure immutable_columns('public_id', 'certificate', 'expiration_time', 'connection_limit',
+      'create_time', 'endpoint', 'worker_filter', 'egress_worker_filter', 'ingress_worker_filter', 'banner');
+
+commit;
@@ -0,0 +1,493 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- wt_cost_center defines a type for the cost center annotation of a resource,
+  -- an identifier used for chargeback such as "CC-1234" or "eng.platform".
+  create domain wt_cost_center as text
+    constraint wt_cost_center_invalid
+      check (value ~ '^[A-Za-z0-9][A-Za-z0-9._-]{0,63}$');
+  comment on domain wt_cost_center is
+    'standard column for cost center annotations';
+
+  -- The annotation columns hold structured metadata about the ownership of a
+  -- resource.  Unlike names and descriptions they have a fixed schema so they
+  -- can be reported on, and they are exported to the warehouse for chargeback.
+  alter table iam_scope
+    add column annotation_owner wt_name,
+    add column annotation_cost_center wt_cost_center,
+    add column annotation_ticket_url wt_url;
+
+  comment on column iam_scope.annotation_owner is
+    'annotation_owner is the optional person or team which owns the scope.';
+  comment on column iam_scope.annotation_cost_center is
+    'annotation_cost_center is the optional cost center the scope is charged to.';
+  comment on column iam_scope.annotation_ticket_url is
+    'annotation_ticket_url is an optional link to the ticket which tracks the scope.';
+
+  alter table target_tcp
+    add column annotation_owner wt_name,
+    add column annotation_cost_center wt_cost_center,
+    add column annotation_ticket_url wt_url;
+
+  alter table target_ssh
+    add column annotation_owner wt_name,
+    add column annotation_cost_center wt_cost_center,
+    add column annotation_ticket_url wt_url;
+
+  -- Replaces target_all_subtypes defined in 66/10_target_banner.up.sql
+  create or replace view target_all_subtypes as
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    'tcp' as type,
+    max_auth_age_seconds,
+    banner,
+    annotation_owner,
+    annotation_cost_center,
+    annotation_ticket_url
+  from target_tcp
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    'ssh' as type,
+    max_auth_age_seconds,
+    banner,
+    annotation_owner,
+    annotation_cost_center,
+    annotation_ticket_url
+  from
+    target_ssh;
+
+  -- The annotations of the target and the scopes it belongs to are added to
+  -- the host dimension.  Rows which already exist have no known annotations.
+  alter table wh_host_dimension
+    add column target_owner             wh_dim_text default 'None',
+    add column target_cost_center       wh_dim_text default 'None',
+    add column target_ticket_url        wh_dim_text default 'None',
+    add column project_owner            wh_dim_text default 'None',
+    add column project_cost_center      wh_dim_text default 'None',
+    add column project_ticket_url       wh_dim_text default 'None',
+    add column organization_owner       wh_dim_text default 'None',
+    add column organization_cost_center wh_dim_text default 'None',
+    add column organization_ticket_url  wh_dim_text default 'None';
+
+  alter table wh_host_dimension
+    alter column target_owner             drop default,
+    alter column target_cost_center       drop default,
+    alter column target_ticket_url        drop default,
+    alter column project_owner            drop default,
+    alter column project_cost_center      drop default,
+    alter column project_ticket_url       drop default,
+    alter column organization_owner       drop default,
+    alter column organization_cost_center drop default,
+    alter column organization_ticket_url  drop default;
+
+  -- Replaces view from 26/02_wh_network_address_dimensions.up.sql
+  drop view whx_host_dimension_target;
+  create view whx_host_dimension_target as
+  select key,
+         network_address_group_key,
+         host_id,
+         host_type,
+         host_name,
+         host_description,
+         host_set_id,
+         host_set_type,
+         host_set_name,
+         host_set_description,
+         host_catalog_id,
+         host_catalog_type,
+         host_catalog_name,
+         host_catalog_description,
+         target_id,
+         target_type,
+         target_name,
+         target_description,
+         target_default_port_number,
+         target_session_max_seconds,
+         target_session_connection_limit,
+         project_id,
+         project_name,
+         project_description,
+         organization_id,
+         organization_name,
+         organization_description,
+         target_owner,
+         target_cost_center,
+         target_ticket_url,
+         project_owner,
+         project_cost_center,
+         project_ticket_url,
+         organization_owner,
+         organization_cost_center,
+         organization_ticket_url
+  from wh_host_dimension
+  where current_row_indicator = 'Current'
+  ;
+
+  -- Replaces view from 64/01_ssh_targets.up.sql
+  drop view whx_host_dimension_source;
+  create view whx_host_dimension_source as
+  with 
+  host_sources (
+    host_id, host_type, host_name, host_description,
+    host_set_id, host_set_type, host_set_name, host_set_description,
+    host_catalog_id, host_catalog_type, host_catalog_name, host_catalog_description,
+    target_id, target_type, target_name, target_description,
+    target_default_port_number, target_session_max_seconds, target_session_connection_limit,
+    project_id, project_name, project_description,
+    organization_id, organization_name, organization_description,
+    target_owner, target_cost_center, target_ticket_url,
+    project_owner, project_cost_center, project_ticket_url,
+    organization_owner, organization_cost_center, organization_ticket_url
+  ) as (
+    select -- id is the first column in the target view
+      h.public_id                     as host_id,
+      case when sh.public_id is not null then 'static host'
+          when ph.public_id is not null then 'plugin host'
+          else 'Unknown' end          as host_type,
+      case when sh.public_id is not null then coalesce(sh.name, 'None')
+          when ph.public_id is not null then coalesce(ph.name, 'None')
+          else 'Unknown' end          as host_name,
+      case when sh.public_id is not null then coalesce(sh.description, 'None')
+          when ph.public_id is not null then coalesce(ph.description, 'None')
+          else 'Unknown' end          as host_description,
+      hs.public_id                     as host_set_id,
+      case when shs.public_id is not null then 'static host set'
+          when phs.public_id is not null then 'plugin host set'
+          else 'Unknown' end          as host_set_type,
+      case
+        when shs.public_id is not null then coalesce(shs.name, 'None')
+        when phs.public_id is not null then coalesce(phs.name, 'None')
+        else 'None'
+        end                            as host_set_name,
+      case
+        when shs.public_id is not null then coalesce(shs.description, 'None')
+        when phs.public_id is not null then coalesce(phs.description, 'None')
+        else 'None'
+        end                            as host_set_description,
+      hc.public_id                     as host_catalog_id,
+      case when shc.public_id is not null then 'static host catalog'
+          when phc.public_id is not null then 'plugin host catalog'
+          else 'Unknown' end          as host_catalog_type,
+      case
+        when shc.public_id is not null then coalesce(shc.name, 'None')
+        when phc.public_id is not null then coalesce(phc.name, 'None')
+        else 'None'
+        end                            as host_catalog_name,
+      case
+        when shc.public_id is not null then coalesce(shc.description, 'None')
+        when phc.public_id is not null then coalesce(phc.description, 'None')
+        else 'None'
+        end                            as host_catalog_description,
+      t.public_id                     as target_id,
+      case
+        when t.type = 'tcp' then 'tcp target'
+        when t.type = 'ssh' then 'ssh target'
+        else 'Unknown'
+        end                           as target_type,
+      coalesce(t.name, 'None')        as target_name,
+      coalesce(t.description, 'None') as target_description,
+      coalesce(t.default_port, 0)     as target_default_port_number,
+      t.session_max_seconds           as target_session_max_seconds,
+      t.session_connection_limit      as target_session_connection_limit,
+      p.public_id                     as project_id,
+      coalesce(p.name, 'None')        as project_name,
+      coalesce(p.description, 'None') as project_description,
+      o.public_id                     as organization_id,
+      coalesce(o.name, 'None')        as organization_name,
+      coalesce(o.description, 'None') as organization_description,
+      coalesce(t.annotation_owner, 'None')       as target_owner,
+      coalesce(t.annotation_cost_center, 'None') as target_cost_center,
+      coalesce(t.annotation_ticket_url, 'None')  as target_ticket_url,
+      coalesce(p.annotation_owner, 'None')       as project_owner,
+      coalesce(p.annotation_cost_center, 'None') as project_cost_center,
+      coalesce(p.annotation_ticket_url, 'None')  as project_ticket_url,
+      coalesce(o.annotation_owner, 'None')       as organization_owner,
+      coalesce(o.annotation_cost_center, 'None') as organization_cost_center,
+      coalesce(o.annotation_ticket_url, 'None')  as organization_ticket_url
+    from host as h
+      join host_catalog as hc                on h.catalog_id = hc.public_id
+      join host_set as hs                    on h.catalog_id = hs.catalog_id
+      join target_host_set as ts             on hs.public_id = ts.host_set_id
+      join target_all_subtypes as t          on ts.target_id = t.public_id
+      join iam_scope as p                    on t.project_id = p.public_id and p.type = 'project'
+      join iam_scope as o                    on p.parent_id = o.public_id and o.type = 'org'
+
+      left join static_host as sh            on sh.public_id = h.public_id
+      left join host_plugin_host as ph       on ph.public_id = h.public_id
+      left join static_host_catalog as shc   on shc.public_id = hc.public_id
+      left join host_plugin_catalog as phc   on phc.public_id = hc.public_id
+      left join static_host_set as shs       on shs.public_id = hs.public_id
+      left join host_plugin_set as phs       on phs.public_id = hs.public_id
+  ),
+  host_target_address (
+    host_id, host_type, host_name, host_description,
+    host_set_id, host_set_type, host_set_name, host_set_description,
+    host_catalog_id, host_catalog_type, host_catalog_name, host_catalog_description,
+    target_id, target_type, target_name, target_description,
+    target_default_port_number, target_session_max_seconds, target_session_connection_limit,
+    project_id, project_name, project_description,
+    organization_id, organization_name, organization_description,
+    target_owner, target_cost_center, target_ticket_url,
+    project_owner, project_cost_center, project_ticket_url,
+    organization_owner, organization_cost_center, organization_ticket_url
+  ) as (
+    select
+      'Not Applicable'                as host_id,
+      'direct address'                as host_type,
+      'Not Applicable'                as host_name,
+      'Not Applicable'                as host_description,
+      'Not Applicable'                as host_set_id,
+      'Not Applicable'                as host_set_type,
+      'Not Applicable'                as host_set_name,
+      'Not Applicable'                as host_set_description,
+      'Not Applicable'                as host_catalog_id,
+      'Not Applicable'                as host_catalog_type,
+      'Not Applicable'                as host_catalog_name,
+      'Not Applicable'                as host_catalog_description,
+      t.public_id                     as target_id,
+      case
+        when t.type = 'tcp' then 'tcp target'
+        when t.type = 'ssh' then 'ssh target'
+        else 'Unknown'
+        end                           as target_type,
+      coalesce(t.name, 'None')        as target_name,
+      coalesce(t.description, 'None') as target_description,
+      coalesce(t.default_port, 0)     as target_default_port_number,
+      t.session_max_seconds           as target_session_max_seconds,
+      t.session_connection_limit      as target_session_connection_limit,
+      p.public_id                     as project_id,
+      coalesce(p.name, 'None')        as project_name,
+      coalesce(p.description, 'None') as project_description,
+      o.public_id                     as organization_id,
+      coalesce(o.name, 'None')        as organization_name,
+      coalesce(o.description, 'None') as organization_description,
+      coalesce(t.annotation_owner, 'None')       as target_owner,
+      coalesce(t.annotation_cost_center, 'None') as target_cost_center,
+      coalesce(t.annotation_ticket_url, 'None')  as target_ticket_url,
+      coalesce(p.annotation_owner, 'None')       as project_owner,
+      coalesce(p.annotation_cost_center, 'None') as project_cost_center,
+      coalesce(p.annotation_ticket_url, 'None')  as project_ticket_url,
+      coalesce(o.annotation_owner, 'None')       as organization_owner,
+      coalesce(o.annotation_cost_center, 'None') as organization_cost_center,
+      coalesce(o.annotation_ticket_url, 'None')  as organization_ticket_url
+    from target_all_subtypes as t
+    right join target_address as ta on t.public_id = ta.target_id
+    left join iam_scope as p        on p.public_id = t.project_id
+    left join iam_scope as o        on o.public_id = p.parent_id
+  )
+  select * from host_sources
+  union
+  select * from host_target_address;
+
+  -- Replaces function from 60/03_wh_sessions.up.sql
+  create or replace function wh_upsert_host() returns trigger
+  as $$
+  declare
+    p_target_id    wt_public_id;
+    p_host_key     wh_dim_key;
+    src            whx_host_dimension_target%rowtype;
+    target         whx_host_dimension_target%rowtype;
+    addr_group_key wh_dim_key;
+  begin
+    select target_id into p_target_id
+      from session
+    where session.public_id = new.session_id;
+
+    if p_target_id is null then
+      raise exception 'target_id is null';
+    end if;
+
+    select * into target
+    from whx_host_dimension_target as t
+    where t.host_id               = new.host_id
+      and t.host_set_id           = new.host_set_id
+      and t.target_id             = p_target_id;
+
+    select wh_upsert_network_address_dimension(new.host_id) into addr_group_key;
+
+    select target.key, addr_group_key, t.* into src
+    from whx_host_dimension_source as t
+    where t.host_id               = new.host_id
+      and t.host_set_id           = new.host_set_id
+      and t.target_id             = p_target_id;
+
+    if src is distinct from target then
+
+      -- expire the current row
+      update wh_host_dimension
+      set current_row_indicator = 'Expired',
+          row_expiration_time   = current_timestamp
+      where host_id               = new.host_id
+        and host_set_id           = new.host_set_id
+        and target_id             = p_target_id
+        and current_row_indicator = 'Current';
+
+      -- insert a new row
+      insert into wh_host_dimension (
+        host_id,                    host_type,                  host_name,                       host_description,
+        network_address_group_key,
+        host_set_id,                host_set_type,              host_set_name,                   host_set_description,
+        host_catalog_id,            host_catalog_type,          host_catalog_name,               host_catalog_description,
+        target_id,                  target_type,                target_name,                     target_description,
+        target_default_port_number, target_session_max_seconds, target_session_connection_limit,
+        project_id,                 project_name,               project_description,
+        organization_id,            organization_name,          organization_description,
+        target_owner,               target_cost_center,         target_ticket_url,
+        project_owner,              project_cost_center,        project_ticket_url,
+        organization_owner,         organization_cost_center,   organization_ticket_url,
+        current_row_indicator,      row_effective_time,         row_expiration_time
+      )
+      select host_id,                    host_type,                  host_name,                       host_description,
+             addr_group_key,
+             host_set_id,                host_set_type,              host_set_name,                   host_set_description,
+             host_catalog_id,            host_catalog_type,          host_catalog_name,               host_catalog_description,
+             target_id,                  target_type,                target_name,                     target_description,
+             target_default_port_number, target_session_max_seconds, target_session_connection_limit,
+             project_id,                 project_name,               project_description,
+             organization_id,            organization_name,          organization_description,
+             target_owner,               target_cost_center,         target_ticket_url,
+             project_owner,              project_cost_center,        project_ticket_url,
+             organization_owner,         organization_cost_center,   organization_ticket_url,
+             'Current',                  current_timestamp,          'infinity'::timestamptz
+      from whx_host_dimension_source
+      where host_id               = new.host_id
+        and host_set_id           = new.host_set_id
+        and target_id             = p_target_id;
+
+    end if;
+
+    select key into p_host_key
+    from wh_host_dimension as t
+    where t.current_row_indicator = 'Current'
+      and t.host_id               = new.host_id
+      and t.host_set_id           = new.host_set_id
+      and t.target_id             = p_target_id;
+
+    update wh_session_accumulating_fact
+      set host_key = p_host_key
+    where session_id = new.session_id;
+
+    return new;
+  end;
+  $$ language plpgsql;
+
+  -- Replaces function from 60/03_wh_sessions.up.sql
+  create or replace function wh_upsert_host_direct_network_address() returns trigger
+  as $$
+  declare
+    p_address      text;
+    src            whx_host_dimension_target%rowtype;
+    target         whx_host_dimension_target%rowtype;
+    addr_group_key wh_dim_key;
+    p_host_key     wh_dim_key;
+  begin
+    select address into p_address
+      from target_address
+    where target_address.target_id = new.target_id;
+
+    if p_address is null then
+      raise exception 'target address is null';
+    end if;
+
+    select * into target
+    from whx_host_dimension_target as t
+    where t.host_id               = 'Not Applicable'
+      and t.host_set_id           = 'Not Applicable'
+      and t.target_id             = new.target_id;
+
+    select wh_upsert_direct_network_address_dimension(p_address, new.target_id) into addr_group_key;
+
+    select target.key, addr_group_key, t.* into src
+    from whx_host_dimension_source as t
+    where t.host_id               = 'Not Applicable'
+      and t.host_set_id           = 'Not Applicable'
+      and t.target_id             = new.target_id;
+
+    if src is distinct from target then
+
+      -- expire the current row
+      update wh_host_dimension
+      set current_row_indicator = 'Expired',
+          row_expiration_time   = current_timestamp
+      where host_id               = 'Not Applicable'
+        and host_set_id           = 'Not Applicable'
+        and target_id             = new.target_id
+        and current_row_indicator = 'Current';
+
+      -- insert a new row
+      insert into wh_host_dimension (
+        host_id,                    host_type,                  host_name,                       host_description,
+        network_address_group_key,
+        host_set_id,                host_set_type,              host_set_name,                   host_set_description,
+        host_catalog_id,            host_catalog_type,          host_catalog_name,               host_catalog_description,
+        target_id,                  target_type,                target_name,                     target_description,
+        target_default_port_number, target_session_max_seconds, target_session_connection_limit,
+        project_id,                 project_name,               project_description,
+        organization_id,            organization_name,          organization_description,
+        target_owner,               target_cost_center,         target_ticket_url,
+        project_owner,              project_cost_center,        project_ticket_url,
+        organization_owner,         organization_cost_center,   organization_ticket_url,
+        current_row_indicator,      row_effective_time,         row_expiration_time
+      )
+      select host_id,                    host_type,                  host_name,                       host_description,
+             addr_group_key,
+             host_set_id,                host_set_type,              host_set_name,                   host_set_description,
+             host_catalog_id,            host_catalog_type,          host_catalog_name,               host_catalog_description,
+             target_id,                  target_type,                target_name,                     target_description,
+             target_default_port_number, target_session_max_seconds, target_session_connection_limit,
+             project_id,                 project_name,               project_description,
+             organization_id,            organization_name,          organization_description,
+             target_owner,               target_cost_center,         target_ticket_url,
+             project_owner,              project_cost_center,        project_ticket_url,
+             organization_owner,         organization_cost_center,   organization_ticket_url,
+             'Current',                  current_timestamp,          'infinity'::timestamptz
+      from whx_host_dimension_source
+      where host_id               = 'Not Applicable'
+        and host_set_id           = 'Not Applicable'
+        and target_id             = new.target_id;
+
+    end if;
+
+    select key into p_host_key
+    from wh_host_dimension as t
+    where t.current_row_indicator = 'Current'
+      and t.host_id               = 'Not Applicable'
+      and t.host_set_id           = 'Not Applicable'
+      and t.target_id             = new.target_id;
+
+    update wh_session_accumulating_fact
+      set host_key = p_host_key
+    where session_id = new.session_id;
+
+    return new;
+  end;
+  $$ language plpgsql;
+
+commit;
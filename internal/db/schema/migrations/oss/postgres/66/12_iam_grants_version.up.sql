@@ -0,0 +1,196 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- iam_grants_version is a single row table holding a counter which is
+  -- incremented whenever a change is made which can alter the grants resolved
+  -- for any number of users, such as changing a role. Changes which can only
+  -- alter the grants of one user increment the user's iam_user_grants_version
+  -- instead. Controllers use both to invalidate the grants they have cached.
+  create table iam_grants_version (
+    singleton boolean primary key default true
+      constraint singleton_must_be_true
+        check(singleton),
+    version bigint not null default 1
+      constraint version_must_be_positive
+        check(version > 0),
+    update_time wt_timestamp
+  );
+  comment on table iam_grants_version is
+    'iam_grants_version is a single row table holding a counter which is incremented '
+    'whenever a role, grant, group principal or managed group which can alter the grants of users is changed.';
+
+  insert into iam_grants_version default values;
+
+  create trigger default_update_time_column before update on iam_grants_version
+    for each row execute procedure update_time_column();
+
+  create function iam_grants_version_increment() returns trigger
+  as $$
+  begin
+    update iam_grants_version
+       set version = version + 1;
+    return null;
+  end;
+  $$ language plpgsql;
+  comment on function iam_grants_version_increment is
+    'iam_grants_version_increment is an after trigger function which increments the iam grants version.';
+
+  create trigger iam_grants_version_increment after insert or update or delete on iam_role
+    for each row execute function iam_grants_version_increment();
+
+  create trigger iam_grants_version_increment after insert or update or delete on iam_role_grant
+    for each row execute function iam_grants_version_increment();
+
+  create trigger iam_grants_version_increment after insert or update or delete on iam_group_role
+    for each row execute function iam_grants_version_increment();
+
+  create trigger iam_grants_version_increment after insert or update or delete on iam_managed_group_role
+    for each row execute function iam_grants_version_increment();
+
+  -- iam_user_grants_version holds a counter per user which is incremented
+  -- whenever a change is made which can only alter the grants resolved for
+  -- that user, so the change doesn't invalidate the cached grants of every
+  -- user, and concurrent changes for different users don't contend on the
+  -- iam_grants_version row.
+  create table iam_user_grants_version (
+    iam_user_id wt_user_id primary key
+      references iam_user(public_id)
+      on delete cascade
+      on update cascade,
+    version bigint not null default 1
+      constraint version_must_be_positive
+        check(version > 0),
+    update_time wt_timestamp
+  );
+  comment on table iam_user_grants_version is
+    'iam_user_grants_version holds a counter per user which is incremented '
+    'whenever a principal or membership which can only alter the grants of the user is changed.';
+
+  create trigger default_update_time_column before update on iam_user_grants_version
+    for each row execute procedure update_time_column();
+
+  create function iam_user_grants_version_increment(user_id text) returns void
+  as $$
+    -- users being deleted are skipped since their row is deleted with them
+    insert into iam_user_grants_version
+      (iam_user_id)
+    select public_id
+      from iam_user
+     where public_id = user_id
+        on conflict (iam_user_id) do update
+       set version = iam_user_grants_version.version + 1;
+  $$ language sql;
+  comment on function iam_user_grants_version_increment is
+    'iam_user_grants_version_increment increments the iam grants version of the user.';
+
+  -- u_anon and u_auth are principals for every user, so adding them to or
+  -- removing them from a role increments the iam grants version.
+  create function iam_user_role_grants_version_increment() returns trigger
+  as $$
+  declare
+    principal_ids text[];
+  begin
+    if tg_op = 'INSERT' then
+      principal_ids = array[new.principal_id];
+    elsif tg_op = 'DELETE' then
+      principal_ids = array[old.principal_id];
+    else
+      principal_ids = array[old.principal_id, new.principal_id];
+    end if;
+    if principal_ids && array['u_anon', 'u_auth'] then
+      update iam_grants_version
+         set version = version + 1;
+    else
+      perform iam_user_grants_version_increment(id)
+         from unnest(principal_ids) as id;
+    end if;
+    return null;
+  end;
+  $$ language plpgsql;
+  comment on function iam_user_role_grants_version_increment is
+    'iam_user_role_grants_version_increment is an after trigger function which increments the grants version of the principal of an iam_user_role.';
+
+  create trigger iam_grants_version_increment after insert or update or delete on iam_user_role
+    for each row execute function iam_user_role_grants_version_increment();
+
+  create function iam_group_member_user_grants_version_increment() returns trigger
+  as $$
+  begin
+    if tg_op in ('UPDATE', 'DELETE') then
+      perform iam_user_grants_version_increment(old.member_id);
+    end if;
+    if tg_op in ('INSERT', 'UPDATE') then
+      perform iam_user_grants_version_increment(new.member_id);
+    end if;
+    return null;
+  end;
+  $$ language plpgsql;
+  comment on function iam_group_member_user_grants_version_increment is
+    'iam_group_member_user_grants_version_increment is an after trigger function which increments the grants version of the member of an iam_group_member_user.';
+
+  create trigger iam_grants_version_increment after insert or update or delete on iam_group_member_user
+    for each row execute function iam_group_member_user_grants_version_increment();
+
+  -- A new account isn't a member of any managed group, and is only added to
+  -- them by inserting into their member tables, so only changing the user of
+  -- an account or deleting it can alter the grants of a user.
+  create function auth_account_grants_version_increment() returns trigger
+  as $$
+  begin
+    if old.iam_user_id is not null then
+      perform iam_user_grants_version_increment(old.iam_user_id);
+    end if;
+    if tg_op = 'UPDATE' then
+      if new.iam_user_id is not null then
+        perform iam_user_grants_version_increment(new.iam_user_id);
+      end if;
+    end if;
+    return null;
+  end;
+  $$ language plpgsql;
+  comment on function auth_account_grants_version_increment is
+    'auth_account_grants_version_increment is an after trigger function which increments the grants version of the users of an auth_account.';
+
+  create trigger iam_grants_version_increment after delete or update of iam_user_id on auth_account
+    for each row execute function auth_account_grants_version_increment();
+
+  create function auth_managed_group_member_account_grants_version_increment() returns trigger
+  as $$
+  begin
+    if tg_op in ('UPDATE', 'DELETE') then
+      perform iam_user_grants_version_increment(aa.iam_user_id)
+         from auth_account as aa
+        where aa.public_id = old.member_id
+          and aa.iam_user_id is not null;
+    end if;
+    if tg_op in ('INSERT', 'UPDATE') then
+      perform iam_user_grants_version_increment(aa.iam_user_id)
+         from auth_account as aa
+        where aa.public_id = new.member_id
+          and aa.iam_user_id is not null;
+    end if;
+    return null;
+  end;
+  $$ language plpgsql;
+  comment on function auth_managed_group_member_account_grants_version_increment is
+    'auth_managed_group_member_account_grants_version_increment is an after trigger function which increments the grants version of the user of a managed group member account.';
+
+  create trigger iam_grants_version_increment after insert or update or delete on auth_oidc_managed_group_member_account
+    for each row execute function auth_managed_group_member_account_grants_version_increment();
+
+  create trigger iam_grants_version_increment after insert or update or delete on auth_ldap_managed_group_member_account
+    for each row execute function auth_managed_group_member_account_grants_version_increment();
+
+  -- The set operations of a managed group change the members of the managed
+  -- group. See 66/09_managed_group_set_operations.up.sql
+  create trigger iam_grants_version_increment
+    after delete or update of union_group_ids, intersection_group_ids, difference_group_ids on auth_oidc_managed_group
+    for each row execute function iam_grants_version_increment();
+
+  create trigger iam_grants_version_increment
+    after delete or update of union_group_ids, intersection_group_ids, difference_group_ids on auth_ldap_managed_group
+    for each row execute function iam_grants_version_increment();
+
+commit;
@@ -0,0 +1,13 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  alter table server_controller
+    add column region text
+      constraint region_must_not_be_empty
+        check(length(trim(region)) > 0);
+  comment on column server_controller.region is
+    'region is the optional region the controller runs in, used to prefer controllers and workers in the same region.';
+
+commit;
@@ -0,0 +1,19 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- wt_blind_index is the type of columns holding the blind index of an
+  -- encrypted field, as computed by kms.(Kms).BlindIndex. A table with such a
+  -- column should also reference the kms_data_key_version used to compute the
+  -- index, so its rows are rewrapped, and the index recomputed, when the key is
+  -- rotated.
+  create domain wt_blind_index as text
+    constraint wt_blind_index_must_be_prefixed
+      check(value like 'bidx:%')
+    constraint wt_blind_index_must_not_be_too_short
+      check(length(value) > 5);
+  comment on domain wt_blind_index is
+    'hmac of the value of an encrypted field with a key derived from a database key, used to look up the field by value without decrypting it';
+
+commit;
@@ -0,0 +1,51 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- target_user_favorite holds the targets users have marked as favorites.
+  create table target_user_favorite (
+    user_id wt_user_id
+      references iam_user(public_id)
+      on delete cascade
+      on update cascade,
+    target_id wt_public_id
+      references target(public_id)
+      on delete cascade
+      on update cascade,
+    create_time wt_timestamp,
+    primary key(user_id, target_id)
+  );
+  comment on table target_user_favorite is
+    'target_user_favorite is a table where each row is a target a user has marked as a favorite.';
+
+  create trigger immutable_columns before update on target_user_favorite
+    for each row execute procedure immutable_columns('user_id', 'target_id', 'create_time');
+
+  create trigger default_create_time_column before insert on target_user_favorite
+    for each row execute procedure default_create_time();
+
+  -- target_user_recent holds the targets users most recently authorized
+  -- sessions against.  Only the most recent rows of each user are kept.
+  create table target_user_recent (
+    user_id wt_user_id
+      references iam_user(public_id)
+      on delete cascade
+      on update cascade,
+    target_id wt_public_id
+      references target(public_id)
+      on delete cascade
+      on update cascade,
+    last_connect_time timestamp with time zone not null default current_timestamp,
+    primary key(user_id, target_id)
+  );
+  comment on table target_user_recent is
+    'target_user_recent is a table where each row is a target a user recently authorized a session against.';
+
+  create trigger immutable_columns before update on target_user_recent
+    for each row execute procedure immutable_columns('user_id', 'target_id');
+
+  create index target_user_recent_user_id_last_connect_time_ix
+    on target_user_recent (user_id, last_connect_time desc);
+
+commit;
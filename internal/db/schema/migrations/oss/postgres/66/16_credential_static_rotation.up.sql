@@ -0,0 +1,42 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- credential_static_rotation holds the rotation schedules of static
+  -- credentials. The static credential rotation job rotates the credentials
+  -- whose next_rotation_time has passed using the named rotator.
+  create table credential_static_rotation (
+    credential_id wt_public_id primary key
+      constraint credential_static_fkey
+        references credential_static (public_id)
+        on delete cascade
+        on update cascade,
+    rotator text not null
+      constraint rotator_must_not_be_empty
+        check(length(trim(rotator)) > 0),
+    rotation_period_seconds integer not null
+      constraint rotation_period_seconds_must_be_greater_than_0
+        check(rotation_period_seconds > 0),
+    next_rotation_time timestamp with time zone not null,
+    last_rotation_time timestamp with time zone,
+    last_rotation_error text,
+    create_time wt_timestamp,
+    update_time wt_timestamp
+  );
+  comment on table credential_static_rotation is
+    'credential_static_rotation is a table where each row is the rotation schedule of a static credential.';
+
+  create trigger immutable_columns before update on credential_static_rotation
+    for each row execute procedure immutable_columns('credential_id', 'create_time');
+
+  create trigger default_create_time_column before insert on credential_static_rotation
+    for each row execute procedure default_create_time();
+
+  create trigger update_time_column before update on credential_static_rotation
+    for each row execute procedure update_time_column();
+
+  create index credential_static_rotation_next_rotation_time_ix
+    on credential_static_rotation (next_rotation_time);
+
+commit;
@@ -0,0 +1,257 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  create table credential_azure_store (
+    public_id wt_public_id primary key,
+    project_id wt_public_id not null,
+    name wt_name,
+    description wt_description,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    vault_uri text not null
+      constraint vault_uri_must_be_https_url
+        check(vault_uri ~ '^https://[^/\s]+/?$'),
+    -- managed_identity_client_id is the client id of the user-assigned
+    -- managed identity used to authenticate to Azure Key Vault. If null, the
+    -- system-assigned managed identity is used.
+    managed_identity_client_id text
+      constraint managed_identity_client_id_must_not_be_empty
+        check(length(trim(managed_identity_client_id)) > 0),
+    constraint credential_store_fkey
+      foreign key (project_id, public_id)
+      references credential_store (project_id, public_id)
+      on delete cascade
+      on update cascade,
+    constraint credential_azure_store_project_id_name_uq
+      unique(project_id, name)
+  );
+  comment on table credential_azure_store is
+    'credential_azure_store is a table where each row is a resource that represents an Azure Key Vault credential store. '
+    'It is a credential_store subtype and an aggregate root.';
+
+  create trigger update_version_column after update on credential_azure_store
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on credential_azure_store
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on credential_azure_store
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on credential_azure_store
+    for each row execute procedure immutable_columns('public_id', 'project_id', 'create_time');
+
+  create trigger insert_credential_store_subtype before insert on credential_azure_store
+    for each row execute procedure insert_credential_store_subtype();
+
+  create trigger delete_credential_store_subtype after delete on credential_azure_store
+    for each row execute procedure delete_credential_store_subtype();
+
+  create table credential_azure_library (
+    public_id wt_public_id primary key,
+    store_id wt_public_id not null
+      constraint credential_azure_store_fkey
+        references credential_azure_store (public_id)
+        on delete cascade
+        on update cascade,
+    name wt_name,
+    description wt_description,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    -- secret_name is the name of the secret in Azure Key Vault.
+    secret_name text not null
+      constraint secret_name_must_be_valid
+        check(secret_name ~ '^[0-9a-zA-Z-]{1,127}$'),
+    -- secret_version pins the version of the secret. If null, the current
+    -- version of the secret is used.
+    secret_version text
+      constraint secret_version_must_be_valid
+        check(secret_version ~ '^[0-9a-f]{32}$'),
+    credential_type text,
+    project_id wt_public_id not null,
+    constraint credential_azure_library_store_id_name_uq
+      unique(store_id, name),
+    constraint credential_azure_library_store_id_public_id_uq
+      unique(store_id, public_id),
+    constraint credential_library_fkey
+      foreign key (project_id, store_id, public_id, credential_type)
+      references credential_library (project_id, store_id, public_id, credential_type)
+      on delete cascade
+      on update cascade
+  );
+  comment on table credential_azure_library is
+    'credential_azure_library is a table where each row is a resource that represents a credential library '
+    'which retrieves a secret from Azure Key Vault. '
+    'It is a credential_library subtype and a child table of credential_azure_store.';
+
+  create function default_azure_credential_type() returns trigger
+  as $$
+  begin
+    if new.credential_type is null then
+      new.credential_type = 'unspecified';
+    elsif new.credential_type not in ('unspecified', 'username_password', 'ssh_private_key') then
+      raise exception 'credential_azure_library does not support % credentials', new.credential_type;
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+  comment on function default_azure_credential_type is
+    'default_azure_credential_type sets a null credential_type to unspecified and rejects the credential types '
+    'credential_azure_library does not support.';
+
+  create trigger default_azure_credential_type before insert on credential_azure_library
+    for each row execute procedure default_azure_credential_type();
+  create trigger insert_credential_library_subtype before insert on credential_azure_library
+    for each row execute procedure insert_credential_library_subtype();
+  create trigger default_create_time_column before insert on credential_azure_library
+    for each row execute procedure default_create_time();
+  create trigger delete_credential_library_subtype after delete on credential_azure_library
+    for each row execute procedure delete_credential_library_subtype();
+  create trigger immutable_columns before update on credential_azure_library
+    for each row execute procedure immutable_columns('public_id', 'store_id', 'project_id', 'credential_type', 'create_time');
+  create trigger update_time_column before update on credential_azure_library
+    for each row execute procedure update_time_column();
+  create trigger update_version_column after update on credential_azure_library
+    for each row execute procedure update_version_column();
+
+  insert into oplog_ticket (name, version)
+  values
+    ('credential_azure_store', 1),
+    ('credential_azure_library', 1);
+
+  -- The whx_credential_dimension_source view shows the current values in the
+  -- operational tables of the credential dimension.
+  -- Replaces whx_credential_dimension_source defined in 64/01_ssh_targets.up.sql
+  create or replace view whx_credential_dimension_source as
+    with vault_generic_library as (
+      select vcl.public_id                                        as public_id,
+             'vault generic credential library'                   as type,
+             coalesce(vcl.name,        'None')                    as name,
+             coalesce(vcl.description, 'None')                    as description,
+             vcl.vault_path                                       as vault_path,
+             vcl.http_method                                      as http_method,
+             case
+               when vcl.http_method = 'GET' then 'Not Applicable'
+               else coalesce(vcl.http_request_body::text, 'None')
+             end                                                  as http_request_body,
+             'Not Applicable'                                     as username,
+             'Not Applicable'                                     as key_type_and_bits
+        from credential_vault_library as vcl
+    ),
+    vault_ssh_cert_library as (
+      select vsccl.public_id                                      as public_id,
+             'vault ssh certificate credential library'           as type,
+             coalesce(vsccl.name,        'None')                  as name,
+             coalesce(vsccl.description, 'None')                  as description,
+             vsccl.vault_path                                     as vault_path,
+             'Not Applicable'                                     as http_method,
+             'Not Applicable'                                     as http_request_body,
+             vsccl.username                                       as username,
+             case
+               when vsccl.key_type = 'ed25519' then vsccl.key_type
+               else vsccl.key_type || '-' || vsccl.key_bits::text
+             end                                                  as key_type_and_bits
+        from credential_vault_ssh_cert_library as vsccl
+    ),
+    azure_library as (
+      select azl.public_id                                        as public_id,
+             'azure credential library'                           as type,
+             coalesce(azl.name,        'None')                    as name,
+             coalesce(azl.description, 'None')                    as description,
+             'Not Applicable'                                     as vault_path,
+             'Not Applicable'                                     as http_method,
+             'Not Applicable'                                     as http_request_body,
+             'Not Applicable'                                     as username,
+             'Not Applicable'                                     as key_type_and_bits
+        from credential_azure_library as azl
+    ),
+    final as (
+          select s.public_id                                              as session_id,
+                 scd.credential_purpose                                   as credential_purpose,
+                 cl.public_id                                             as credential_library_id,
+                 coalesce(vcl.type, vsccl.type, azl.type)                 as credential_library_type,
+                 coalesce(vcl.name, vsccl.name, azl.name)                 as credential_library_name,
+                 coalesce(vcl.description, vsccl.description, azl.description) as credential_library_description,
+                 coalesce(vcl.vault_path, vsccl.vault_path, azl.vault_path) as credential_library_vault_path,
+                 coalesce(vcl.http_method, vsccl.http_method, azl.http_method) as credential_library_vault_http_method,
+                 coalesce(vcl.http_request_body, vsccl.http_request_body, azl.http_request_body) as credential_library_vault_http_request_body,
+                 coalesce(vcl.username, vsccl.username, azl.username)     as credential_library_username,
+                 coalesce(vcl.key_type_and_bits, vsccl.key_type_and_bits, azl.key_type_and_bits) as credential_library_key_type_and_bits,
+                 cs.public_id                                             as credential_store_id,
+                 case
+                   when vcs is not null then 'vault credential store'
+                   when azs is not null then 'azure credential store'
+                   else 'None'
+                 end                                                      as credential_store_type,
+                 coalesce(vcs.name,              azs.name,        'None') as credential_store_name,
+                 coalesce(vcs.description,       azs.description, 'None') as credential_store_description,
+                 coalesce(vcs.namespace,         'None')                  as credential_store_vault_namespace,
+                 coalesce(vcs.vault_address,     'None')                  as credential_store_vault_address,
+                 t.public_id                                              as target_id,
+                 case
+                   when tt.type = 'tcp' then 'tcp target'
+                   when tt.type = 'ssh' then 'ssh target'
+                   else 'Unknown'
+                 end                                                      as target_type,
+                 coalesce(tt.name,               'None')                  as target_name,
+                 coalesce(tt.description,        'None')                  as target_description,
+                 coalesce(tt.default_port,       0)                       as target_default_port_number,
+                 tt.session_max_seconds                                   as target_session_max_seconds,
+                 tt.session_connection_limit                              as target_session_connection_limit,
+                 p.public_id                                              as project_id,
+                 coalesce(p.name,                'None')                  as project_name,
+                 coalesce(p.description,         'None')                  as project_description,
+                 o.public_id                                              as organization_id,
+                 coalesce(o.name,                'None')                  as organization_name,
+                 coalesce(o.description,         'None')                  as organization_description
+            from session_credential_dynamic as scd
+            join session                as s     on scd.session_id = s.public_id
+            join credential_library     as cl    on scd.library_id = cl.public_id
+            join credential_store       as cs    on cl.store_id    = cs.public_id
+            join target                 as t     on s.target_id    = t.public_id
+            join iam_scope              as p     on p.public_id    = t.project_id and p.type = 'project'
+            join iam_scope              as o     on p.parent_id    = o.public_id  and o.type = 'org'
+       left join vault_generic_library  as vcl   on cl.public_id   = vcl.public_id
+       left join vault_ssh_cert_library as vsccl on cl.public_id   = vsccl.public_id
+       left join azure_library          as azl   on cl.public_id   = azl.public_id
+       left join credential_vault_store as vcs   on cs.public_id   = vcs.public_id
+       left join credential_azure_store as azs   on cs.public_id   = azs.public_id
+       left join target_all_subtypes    as tt    on t.public_id    = tt.public_id
+    )
+    select session_id,
+           credential_purpose,
+           credential_library_id,
+           credential_library_type,
+           credential_library_name,
+           credential_library_description,
+           credential_library_vault_path,
+           credential_library_vault_http_method,
+           credential_library_vault_http_request_body,
+           credential_library_username,
+           credential_library_key_type_and_bits,
+           credential_store_id,
+           credential_store_type,
+           credential_store_name,
+           credential_store_description,
+           credential_store_vault_namespace,
+           credential_store_vault_address,
+           target_id,
+           target_type,
+           target_name,
+           target_description,
+           target_default_port_number,
+           target_session_max_seconds,
+           target_session_connection_limit,
+           project_id,
+           project_name,
+           project_description,
+           organization_id,
+           organization_name,
+           organization_description
+      from final;
+
+commit;
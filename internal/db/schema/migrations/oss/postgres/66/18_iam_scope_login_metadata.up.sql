@@ -0,0 +1,28 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- The login metadata columns hold information shown to users before they
+  -- authenticate to a scope, so that clients can brand the login of each
+  -- business unit served by a cluster.  They can be read by the anonymous user
+  -- through the read-login-metadata action.
+  alter table iam_scope
+    add column login_display_name wt_name,
+    add column login_support_contact text
+      constraint login_support_contact_must_not_be_empty
+        check(length(trim(login_support_contact)) > 0)
+      constraint login_support_contact_must_be_less_than_256_chars
+        check(length(trim(login_support_contact)) < 256),
+    add column login_message_of_the_day text
+      constraint login_message_of_the_day_must_not_be_empty
+        check(length(trim(login_message_of_the_day)) > 0);
+
+  comment on column iam_scope.login_display_name is
+    'login_display_name is the optional name of the scope shown to users logging in to it.';
+  comment on column iam_scope.login_support_contact is
+    'login_support_contact is the optional contact, such as an email address or URL, for users having trouble logging in to the scope.';
+  comment on column iam_scope.login_message_of_the_day is
+    'login_message_of_the_day is an optional message shown to users logging in to the scope.';
+
+commit;
@@ -0,0 +1,50 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- event_subscription holds the subscriptions of users to changes of
+  -- resources. A subscription matches either a single resource, or all the
+  -- resources of a type in a scope.
+  create table event_subscription (
+    public_id wt_public_id primary key,
+    user_id wt_user_id not null
+      references iam_user(public_id)
+      on delete cascade
+      on update cascade,
+    scope_id wt_scope_id not null
+      references iam_scope(public_id)
+      on delete cascade
+      on update cascade,
+    resource_id text
+      constraint resource_id_must_not_be_empty
+        check(length(trim(resource_id)) > 0),
+    resource_type text
+      constraint resource_type_must_not_be_empty
+        check(length(trim(resource_type)) > 0),
+    webhook_url text
+      constraint webhook_url_must_not_be_empty
+        check(length(trim(webhook_url)) > 0),
+    create_time wt_timestamp,
+    constraint resource_id_or_resource_type_must_be_set
+      check(num_nonnulls(resource_id, resource_type) = 1)
+  );
+  comment on table event_subscription is
+    'event_subscription is a table where each row is a subscription of a user to changes of a resource, or of the resources of a type in a scope.';
+
+  create trigger immutable_columns before update on event_subscription
+    for each row execute procedure immutable_columns('public_id', 'user_id', 'scope_id', 'resource_id', 'resource_type', 'create_time');
+
+  create trigger default_create_time_column before insert on event_subscription
+    for each row execute procedure default_create_time();
+
+  create index event_subscription_user_id_scope_id_ix
+    on event_subscription (user_id, scope_id);
+  create index event_subscription_resource_id_ix
+    on event_subscription (resource_id)
+    where resource_id is not null;
+  create index event_subscription_scope_id_resource_type_ix
+    on event_subscription (scope_id, resource_type)
+    where resource_type is not null;
+
+commit;
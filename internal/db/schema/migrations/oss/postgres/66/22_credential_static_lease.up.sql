@@ -0,0 +1,121 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- An exclusive static credential can only be checked out by one session at
+  -- a time.
+  alter table target_static_credential
+    add column exclusive boolean not null default false;
+
+  comment on column target_static_credential.exclusive is
+    'exclusive is true if the static credential can only be checked out by one session at a time.';
+
+  -- Replaces view from 33/02_target.up.sql
+  drop view target_credential_source;
+  create view target_credential_source
+  as
+    select
+      tcl.target_id,
+      tcl.credential_library_id as credential_source_id,
+      tcl.credential_purpose,
+      cl.store_id,
+      'library' as type,
+      false as exclusive
+    from
+      target_credential_library tcl,
+      credential_library cl
+    where
+      cl.public_id = tcl.credential_library_id
+    union
+    select
+      tcs.target_id,
+      tcs.credential_static_id as credential_source_id,
+      tcs.credential_purpose,
+      cst.store_id,
+      'static' as type,
+      tcs.exclusive
+    from
+      target_static_credential tcs,
+      credential_static cst
+    where
+      cst.public_id = tcs.credential_static_id;
+  comment on view target_credential_source is
+    'target_credential_source is a view where each row contains a credential source and the id of the parent credential store. '
+    'No encrypted data is returned. This view can be used to retrieve data which will be returned external to boundary.';
+
+  -- credential_static_lease holds the static credentials which are checked
+  -- out exclusively by a session. A credential can only have one lease. The
+  -- lease is checked in when the session is terminated or deleted. Leases of
+  -- credentials with a rotation schedule are kept until the static credential
+  -- check in job has rotated the credential, even if the session has been
+  -- deleted by then, so session_id does not reference the session.
+  create table credential_static_lease (
+    credential_id wt_public_id primary key
+      constraint credential_static_fkey
+        references credential_static (public_id)
+        on delete cascade
+        on update cascade,
+    session_id wt_public_id not null,
+    create_time wt_timestamp,
+    check_in_time timestamp with time zone
+  );
+  comment on table credential_static_lease is
+    'credential_static_lease is a table where each row is a static credential checked out exclusively by a session.';
+
+  create trigger immutable_columns before update on credential_static_lease
+    for each row execute procedure immutable_columns('credential_id', 'session_id', 'create_time');
+
+  create trigger default_create_time_column before insert on credential_static_lease
+    for each row execute procedure default_create_time();
+
+  create index credential_static_lease_session_id_ix
+    on credential_static_lease (session_id);
+
+  -- check_in_credential_static_session_leases checks in the static
+  -- credentials checked out by a session. Leases of credentials without a
+  -- rotation schedule are deleted.
+  create function check_in_credential_static_session_leases(leasing_session_id text) returns void
+  as $$
+  begin
+    delete from credential_static_lease
+     where session_id = leasing_session_id
+       and credential_id not in (select credential_id from credential_static_rotation);
+    update credential_static_lease
+       set check_in_time = now()
+     where session_id = leasing_session_id
+       and check_in_time is null;
+  end;
+  $$ language plpgsql;
+
+  -- check_in_credential_static_leases checks in the static credentials
+  -- checked out by a session when the session enters the terminated state.
+  create function check_in_credential_static_leases() returns trigger
+  as $$
+  begin
+    if new.state = 'terminated' then
+      perform check_in_credential_static_session_leases(new.session_id);
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+
+  create trigger check_in_credential_static_leases after insert on session_state
+    for each row execute procedure check_in_credential_static_leases();
+
+  -- check_in_deleted_session_credential_static_leases checks in the static
+  -- credentials checked out by a session when the session is deleted, so the
+  -- credentials are released, or rotated by the check in job, even if the
+  -- session was never terminated.
+  create function check_in_deleted_session_credential_static_leases() returns trigger
+  as $$
+  begin
+    perform check_in_credential_static_session_leases(old.public_id);
+    return old;
+  end;
+  $$ language plpgsql;
+
+  create trigger check_in_deleted_session_credential_static_leases before delete on session
+    for each row execute procedure check_in_deleted_session_credential_static_leases();
+
+commit;
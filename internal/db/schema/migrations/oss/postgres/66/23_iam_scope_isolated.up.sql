@@ -0,0 +1,22 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- isolated marks an org whose resources can not be reached by the roles of
+  -- the global scope when the controller runs with tenant isolation enabled.
+  -- The isolation itself is enforced by the controller since the controller's
+  -- configuration is not known to the database.
+  alter table iam_scope
+    add column isolated boolean not null default false,
+    add constraint isolated_only_allowed_for_org_scopes
+      check(type = 'org' or not isolated);
+
+  comment on column iam_scope.isolated is
+    'isolated is true if the org is isolated from the roles of the global scope when tenant isolation is enabled.';
+
+  -- Changing whether an org is isolated changes the grants which apply to it.
+  create trigger iam_grants_version_increment after update of isolated on iam_scope
+    for each row execute function iam_grants_version_increment();
+
+commit;
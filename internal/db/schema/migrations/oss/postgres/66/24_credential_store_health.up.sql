@@ -0,0 +1,41 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  create table credential_store_health_status_enm (
+    name text primary key
+      constraint only_predefined_health_statuses_allowed
+        check (name in ('healthy', 'degraded', 'unhealthy'))
+  );
+  comment on table credential_store_health_status_enm is
+    'credential_store_health_status_enm is an enumeration table for the status of a credential store health check.';
+
+  insert into credential_store_health_status_enm (name)
+  values
+    ('healthy'),
+    ('degraded'),
+    ('unhealthy');
+
+  -- credential_store_health holds the result of the latest health check of a
+  -- credential store. Each row is replaced by the next check of the store.
+  create table credential_store_health (
+    store_id wt_public_id primary key
+      constraint credential_store_fkey
+        references credential_store (public_id)
+        on delete cascade
+        on update cascade,
+    status text not null
+      constraint credential_store_health_status_enm_fkey
+        references credential_store_health_status_enm (name)
+        on delete restrict
+        on update cascade,
+    message text,
+    check_time wt_timestamp,
+    token_expiration_time timestamp with time zone,
+    certificate_expiration_time timestamp with time zone
+  );
+  comment on table credential_store_health is
+    'credential_store_health is a table where each row is the result of the latest health check of a credential store.';
+
+commit;
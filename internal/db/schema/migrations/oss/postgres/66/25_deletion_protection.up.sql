@@ -0,0 +1,252 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- A deletion protected resource can not be deleted, either directly or
+  -- along with the scope which contains it, until the protection has been
+  -- cleared.
+  create function deletion_protected_violation() returns trigger
+  as $$
+  begin
+    if old.deletion_protected then
+      raise exception 'deletion protected: %', old.public_id using
+        errcode = '23603',
+        schema = tg_table_schema,
+        table = tg_table_name;
+    end if;
+    return old;
+  end;
+  $$ language plpgsql;
+  comment on function deletion_protected_violation is
+    'deletion_protected_violation is a before delete trigger function which prevents deleting deletion protected rows.';
+
+  alter table iam_scope
+    add column deletion_protected boolean not null default false;
+  comment on column iam_scope.deletion_protected is
+    'deletion_protected is true if the scope can not be deleted until the protection has been cleared.';
+  create trigger deletion_protected_violation before delete on iam_scope
+    for each row execute procedure deletion_protected_violation();
+
+  alter table auth_password_method
+    add column deletion_protected boolean not null default false;
+  comment on column auth_password_method.deletion_protected is
+    'deletion_protected is true if the auth method can not be deleted until the protection has been cleared.';
+  create trigger deletion_protected_violation before delete on auth_password_method
+    for each row execute procedure deletion_protected_violation();
+
+  alter table auth_oidc_method
+    add column deletion_protected boolean not null default false;
+  comment on column auth_oidc_method.deletion_protected is
+    'deletion_protected is true if the auth method can not be deleted until the protection has been cleared.';
+  create trigger deletion_protected_violation before delete on auth_oidc_method
+    for each row execute procedure deletion_protected_violation();
+
+  alter table auth_ldap_method
+    add column deletion_protected boolean not null default false;
+  comment on column auth_ldap_method.deletion_protected is
+    'deletion_protected is true if the auth method can not be deleted until the protection has been cleared.';
+  create trigger deletion_protected_violation before delete on auth_ldap_method
+    for each row execute procedure deletion_protected_violation();
+
+  alter table target_tcp
+    add column deletion_protected boolean not null default false;
+  comment on column target_tcp.deletion_protected is
+    'deletion_protected is true if the target can not be deleted until the protection has been cleared.';
+  create trigger deletion_protected_violation before delete on target_tcp
+    for each row execute procedure deletion_protected_violation();
+
+  alter table target_ssh
+    add column deletion_protected boolean not null default false;
+  comment on column target_ssh.deletion_protected is
+    'deletion_protected is true if the target can not be deleted until the protection has been cleared.';
+  create trigger deletion_protected_violation before delete on target_ssh
+    for each row execute procedure deletion_protected_violation();
+
+  -- Replaces view from 2/20_pass.up.sql
+  create or replace view auth_password_method_with_is_primary as
+  select
+    case when s.primary_auth_method_id is not null then
+      true
+    else false end
+    as is_primary_auth_method,
+    am.public_id,
+    am.scope_id,
+    am.password_conf_id,
+    am.name,
+    am.description,
+    am.create_time,
+    am.update_time,
+    am.version,
+    am.min_login_name_length,
+    am.min_password_length,
+    am.deletion_protected
+  from
+    auth_password_method am
+    left outer join iam_scope s on am.public_id = s.primary_auth_method_id;
+  comment on view auth_password_method_with_is_primary is
+    'password auth method with an is_primary_auth_method bool';
+
+  -- Replaces view from 66/05_oidc_jwt_login.up.sql
+  create or replace view oidc_auth_method_with_value_obj as
+  select
+    case when s.primary_auth_method_id is not null then
+      true
+    else false end
+    as is_primary_auth_method,
+    am.public_id,
+    am.scope_id,
+    am.name,
+    am.description,
+    am.create_time,
+    am.update_time,
+    am.version,
+    am.state,
+    am.api_url,
+    am.disable_discovered_config_validation,
+    am.issuer,
+    am.client_id,
+    am.client_secret,
+    am.client_secret_hmac,
+    am.key_id,
+    am.max_age,
+    -- the string_agg(..) column will be null if there are no associated value objects
+    string_agg(distinct alg.signing_alg_name, '|') as algs,
+    string_agg(distinct aud.aud_claim, '|') as auds,
+    string_agg(distinct cert.certificate, '|') as certs,
+    string_agg(distinct cs.scope, '|') as claims_scopes,
+    string_agg(distinct concat_ws('=', acm.from_claim, acm.to_claim), '|') as account_claim_maps,
+    string_agg(distinct pk.public_key, '|') as jwt_validation_pub_keys,
+    string_agg(distinct concat_ws('=', bc.claim, bc.value), '|') as bound_claims,
+    am.jwt_login_enabled,
+    am.deletion_protected
+  from
+    auth_oidc_method am
+    left outer join iam_scope                        s     on am.public_id = s.primary_auth_method_id
+    left outer join auth_oidc_signing_alg            alg   on am.public_id = alg.oidc_method_id
+    left outer join auth_oidc_aud_claim              aud   on am.public_id = aud.oidc_method_id
+    left outer join auth_oidc_certificate            cert  on am.public_id = cert.oidc_method_id
+    left outer join auth_oidc_scope                  cs    on am.public_id = cs.oidc_method_id
+    left outer join auth_oidc_account_claim_map      acm   on am.public_id = acm.oidc_method_id
+    left outer join auth_oidc_jwt_validation_pub_key pk    on am.public_id = pk.oidc_method_id
+    left outer join auth_oidc_bound_claim            bc    on am.public_id = bc.oidc_method_id
+  group by am.public_id, is_primary_auth_method; -- there can be only one public_id + is_primary_auth_method, so group by isn't a problem.
+  comment on view oidc_auth_method_with_value_obj is
+    'oidc auth method with its associated value objects (algs, auds, certs, scopes, account claim maps, jwt validation pub keys and bound claims) as columns with | delimited values';
+
+  -- Replaces view from 66/04_ldap_alternate_user_filters.up.sql
+  create or replace view ldap_auth_method_with_value_obj as
+  select
+    case when s.primary_auth_method_id is not null then
+      true
+    else false end
+    as is_primary_auth_method,
+    am.public_id,
+    am.scope_id,
+    am.name,
+    am.description,
+    am.create_time,
+    am.update_time,
+    am.version,
+    am.state,
+    am.start_tls,
+    am.insecure_tls,
+    am.discover_dn,
+    am.anon_group_search,
+    am.upn_domain,
+    am.enable_groups,
+    am.use_token_groups,
+    -- the string_agg(..) column will be null if there are no associated value objects
+    string_agg(distinct url.url, '|') as urls,
+    string_agg(distinct cert.certificate, '|') as certs,
+    string_agg(distinct concat_ws('=', aam.from_attribute, aam.to_attribute), '|') as account_attribute_map,
+
+    -- the rest of the fields are zero to one relationships that are stored in
+    -- related tables. Since we're outer joining with these tables, we need to
+    -- either add them to the group by, use an aggregating func, or handle
+    -- multiple rows returning for each auth method. I've chosen to just use
+    -- string_agg(...)
+    string_agg(distinct uc.user_dn, '|') as user_dn,
+    string_agg(distinct uc.user_attr, '|') as user_attr,
+    string_agg(distinct uc.user_filter, '|') as user_filter,
+    string_agg(distinct gc.group_dn, '|') as group_dn,
+    string_agg(distinct gc.group_attr, '|') as group_attr,
+    string_agg(distinct gc.group_filter, '|') as group_filter,
+    string_agg(distinct cc.certificate_key, '|') as client_certificate_key,
+    string_agg(distinct cc.certificate_key_hmac, '|') as client_certificate_key_hmac,
+    string_agg(distinct cc.key_id, '|') as client_certificate_key_id,
+    string_agg(distinct cc.certificate, '|') as client_certificate_cert,
+    string_agg(distinct bc.dn, '|') as bind_dn,
+    string_agg(distinct bc.password, '|') as bind_password,
+    string_agg(distinct bc.password_hmac, '|') as bind_password_hmac,
+    string_agg(distinct bc.key_id, '|') as bind_password_key_id,
+    -- user filters commonly contain the '|' delimiter, so the alternate user
+    -- filters are aggregated as an ordered json array instead.
+    (select jsonb_agg(auf.user_filter order by auf.filter_priority)
+       from auth_ldap_alternate_user_filter auf
+      where auf.ldap_method_id = am.public_id) as alternate_user_filters,
+    am.deletion_protected
+  from
+    auth_ldap_method am
+    left outer join iam_scope                       s     on am.public_id = s.primary_auth_method_id
+    left outer join auth_ldap_url                   url   on am.public_id = url.ldap_method_id
+    left outer join auth_ldap_certificate           cert  on am.public_id = cert.ldap_method_id
+    left outer join auth_ldap_account_attribute_map aam   on am.public_id = aam.ldap_method_id
+    left outer join auth_ldap_user_entry_search     uc    on am.public_id = uc.ldap_method_id
+    left outer join auth_ldap_group_entry_search    gc    on am.public_id = gc.ldap_method_id
+    left outer join auth_ldap_client_certificate    cc    on am.public_id = cc.ldap_method_id
+    left outer join auth_ldap_bind_credential       bc    on am.public_id = bc.ldap_method_id
+  group by am.public_id, is_primary_auth_method; -- there can be only one public_id + is_primary_auth_method, so group by isn't a problem.
+  comment on view ldap_auth_method_with_value_obj is
+    'ldap auth method with its associated value objects (urls, certs, search config, etc)';
+
+  -- Replaces target_all_subtypes defined in 66/11_resource_annotations.up.sql
+  create or replace view target_all_subtypes as
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    'tcp' as type,
+    max_auth_age_seconds,
+    banner,
+    annotation_owner,
+    annotation_cost_center,
+    annotation_ticket_url,
+    deletion_protected
+  from target_tcp
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    'ssh' as type,
+    max_auth_age_seconds,
+    banner,
+    annotation_owner,
+    annotation_cost_center,
+    annotation_ticket_url,
+    deletion_protected
+  from
+    target_ssh;
+
+commit;
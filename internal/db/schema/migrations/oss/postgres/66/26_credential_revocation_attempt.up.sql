@@ -0,0 +1,58 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- credential_dynamic_revocation_attempt holds the failed attempts to revoke
+  -- a dynamic credential. A row is removed when the credential is revoked. A
+  -- row with a failed_time is a terminal failure, the credential is no longer
+  -- retried.
+  create table credential_dynamic_revocation_attempt (
+    credential_id wt_public_id primary key
+      constraint credential_dynamic_fkey
+        references credential_dynamic (public_id)
+        on delete cascade
+        on update cascade,
+    attempt_count integer not null
+      constraint attempt_count_must_be_greater_than_zero
+        check(attempt_count > 0),
+    last_error text,
+    next_attempt_time wt_timestamp,
+    failed_time timestamp with time zone,
+    create_time wt_timestamp,
+    update_time wt_timestamp
+  );
+  comment on table credential_dynamic_revocation_attempt is
+    'credential_dynamic_revocation_attempt is a table where each row holds the failed attempts to revoke a dynamic credential.';
+
+  create trigger default_create_time_column before insert on credential_dynamic_revocation_attempt
+    for each row execute procedure default_create_time();
+
+  create trigger update_time_column before update on credential_dynamic_revocation_attempt
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on credential_dynamic_revocation_attempt
+    for each row execute procedure immutable_columns('credential_id', 'create_time');
+
+  -- revoke_failed is the terminal status of a vault credential which could not
+  -- be revoked within the maximum number of attempts.
+  alter table credential_vault_credential_status_enm
+    drop constraint only_predefined_credential_statuses_allowed;
+  alter table credential_vault_credential_status_enm
+    add constraint only_predefined_credential_statuses_allowed
+      check (
+        name in (
+          'active',
+          'revoke',
+          'revoked',
+          'expired',
+          'unknown',
+          'revoke_failed'
+        )
+      );
+
+  insert into credential_vault_credential_status_enm (name)
+  values
+    ('revoke_failed');
+
+commit;
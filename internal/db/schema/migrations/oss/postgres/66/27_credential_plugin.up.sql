@@ -0,0 +1,307 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  create table plugin_credential (
+    public_id wt_plugin_id primary key,
+    scope_id wt_scope_id not null
+      constraint iam_scope_global_fkey
+        references iam_scope_global(scope_id)
+        on delete cascade
+        on update cascade,
+    name wt_name,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    constraint plugin_fkey
+      foreign key (scope_id, public_id)
+        references plugin(scope_id, public_id)
+        on delete cascade
+        on update cascade,
+    constraint plugin_credential_scope_id_name_uq
+      unique(scope_id, name)
+  );
+  comment on table plugin_credential is
+    'plugin_credential is a table where each row is a plugin which provides credential stores. '
+    'It is a plugin subtype.';
+
+  create trigger update_version_column after update on plugin_credential
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on plugin_credential
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on plugin_credential
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on plugin_credential
+    for each row execute procedure immutable_columns('public_id', 'create_time');
+
+  create trigger insert_plugin_subtype before insert on plugin_credential
+    for each row execute procedure insert_plugin_subtype();
+
+  create trigger update_plugin_subtype before update on plugin_credential
+    for each row execute procedure update_plugin_subtype();
+
+  create trigger delete_plugin_subtype after delete on plugin_credential
+    for each row execute procedure delete_plugin_subtype();
+
+  create table credential_plugin_store (
+    public_id wt_public_id primary key,
+    project_id wt_public_id not null,
+    plugin_id wt_plugin_id not null
+      constraint plugin_credential_fkey
+        references plugin_credential (public_id)
+        on delete cascade
+        on update cascade,
+    name wt_name,
+    description wt_description,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    attributes bytea not null,
+    secrets_hmac bytea,
+    constraint credential_store_fkey
+      foreign key (project_id, public_id)
+      references credential_store (project_id, public_id)
+      on delete cascade
+      on update cascade,
+    constraint credential_plugin_store_project_id_name_uq
+      unique(project_id, name)
+  );
+  comment on table credential_plugin_store is
+    'credential_plugin_store is a table where each row is a resource that represents a credential store '
+    'provided by a credential plugin. It is a credential_store subtype and an aggregate root.';
+
+  create trigger update_version_column after update on credential_plugin_store
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on credential_plugin_store
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on credential_plugin_store
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on credential_plugin_store
+    for each row execute procedure immutable_columns('public_id', 'project_id', 'plugin_id', 'create_time');
+
+  create trigger insert_credential_store_subtype before insert on credential_plugin_store
+    for each row execute procedure insert_credential_store_subtype();
+
+  create trigger delete_credential_store_subtype after delete on credential_plugin_store
+    for each row execute procedure delete_credential_store_subtype();
+
+  create table credential_plugin_store_secret (
+    store_id wt_public_id primary key
+      constraint credential_plugin_store_fkey
+        references credential_plugin_store (public_id)
+        on delete cascade
+        on update cascade,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    secret bytea not null -- encrypted value
+      constraint secret_must_not_be_empty
+        check(length(secret) > 0),
+    key_id kms_private_id not null
+      constraint kms_data_key_version_fkey
+        references kms_data_key_version (private_id)
+        on delete restrict
+        on update cascade
+  );
+  comment on table credential_plugin_store_secret is
+    'credential_plugin_store_secret is a table where each row holds the encrypted data the plugin of a '
+    'credential_plugin_store persists between calls.';
+
+  create trigger update_time_column before update on credential_plugin_store_secret
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on credential_plugin_store_secret
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on credential_plugin_store_secret
+    for each row execute procedure immutable_columns('store_id', 'create_time');
+
+  create table credential_plugin_library (
+    public_id wt_public_id primary key,
+    store_id wt_public_id not null
+      constraint credential_plugin_store_fkey
+        references credential_plugin_store (public_id)
+        on delete cascade
+        on update cascade,
+    name wt_name,
+    description wt_description,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    attributes bytea not null,
+    credential_type text,
+    project_id wt_public_id not null,
+    constraint credential_plugin_library_store_id_name_uq
+      unique(store_id, name),
+    constraint credential_plugin_library_store_id_public_id_uq
+      unique(store_id, public_id),
+    constraint credential_library_fkey
+      foreign key (project_id, store_id, public_id, credential_type)
+      references credential_library (project_id, store_id, public_id, credential_type)
+      on delete cascade
+      on update cascade
+  );
+  comment on table credential_plugin_library is
+    'credential_plugin_library is a table where each row is a resource that represents a credential library '
+    'which issues credentials from the plugin of its store. '
+    'It is a credential_library subtype and a child table of credential_plugin_store.';
+
+  create function default_plugin_credential_type() returns trigger
+  as $$
+  begin
+    if new.credential_type is null then
+      new.credential_type = 'unspecified';
+    elsif new.credential_type not in ('unspecified', 'username_password', 'ssh_private_key') then
+      raise exception 'credential_plugin_library does not support % credentials', new.credential_type;
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+  comment on function default_plugin_credential_type is
+    'default_plugin_credential_type sets a null credential_type to unspecified and rejects the credential types '
+    'credential_plugin_library does not support.';
+
+  create trigger default_plugin_credential_type before insert on credential_plugin_library
+    for each row execute procedure default_plugin_credential_type();
+  create trigger insert_credential_library_subtype before insert on credential_plugin_library
+    for each row execute procedure insert_credential_library_subtype();
+  create trigger default_create_time_column before insert on credential_plugin_library
+    for each row execute procedure default_create_time();
+  create trigger delete_credential_library_subtype after delete on credential_plugin_library
+    for each row execute procedure delete_credential_library_subtype();
+  create trigger immutable_columns before update on credential_plugin_library
+    for each row execute procedure immutable_columns('public_id', 'store_id', 'project_id', 'credential_type', 'create_time');
+  create trigger update_time_column before update on credential_plugin_library
+    for each row execute procedure update_time_column();
+  create trigger update_version_column after update on credential_plugin_library
+    for each row execute procedure update_version_column();
+
+  create table credential_plugin_credential_status_enm (
+    name text primary key
+      constraint only_predefined_credential_statuses_allowed
+      check (
+        name in (
+          'active',
+          'revoke',
+          'revoked',
+          'expired',
+          'unknown',
+          'revoke_failed'
+        )
+      )
+  );
+  comment on table credential_plugin_credential_status_enm is
+    'credential_plugin_credential_status_enm is an enumeration table for the status of plugin credentials. '
+    'It contains rows for representing the active, revoke, revoked, expired, unknown and revoke_failed statuses.';
+
+  insert into credential_plugin_credential_status_enm (name)
+  values
+    ('active'),
+    ('revoke'),
+    ('revoked'),
+    ('expired'),
+    ('unknown'),
+    ('revoke_failed');
+
+  create table credential_plugin_credential (
+    public_id wt_public_id primary key,
+    library_id wt_public_id
+      constraint credential_plugin_library_fkey
+        references credential_plugin_library (public_id)
+        on delete set null
+        on update cascade,
+    session_id wt_public_id
+      constraint session_fkey
+        references session (public_id)
+        on delete set null
+        on update cascade,
+    -- store_id is kept so a credential can be revoked by the plugin of its
+    -- store after the library is deleted.
+    store_id wt_public_id not null
+      constraint credential_plugin_store_fkey
+        references credential_plugin_store (public_id)
+        on delete cascade
+        on update cascade,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    external_id wt_sentinel not null,
+    expiration_time timestamp with time zone,
+    is_revocable boolean not null,
+    status text not null
+      constraint credential_plugin_credential_status_enm_fkey
+        references credential_plugin_credential_status_enm (name)
+        on delete restrict
+        on update cascade,
+    constraint credential_dynamic_fkey
+      foreign key (library_id, public_id)
+      references credential_dynamic (library_id, public_id)
+      on delete cascade
+      on update cascade,
+    constraint credential_plugin_credential_library_id_public_id_uq
+      unique(library_id, public_id)
+  );
+  comment on table credential_plugin_credential is
+    'credential_plugin_credential is a table where each row contains a credential issued by the plugin of a '
+    'credential_plugin_store from a credential_plugin_library for a session.';
+
+  create trigger update_version_column after update on credential_plugin_credential
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on credential_plugin_credential
+    for each row execute procedure update_time_column();
+
+  -- update_credential_status_column() is defined in 10/04_vault_credential.up.sql
+  create trigger update_credential_status_column before update on credential_plugin_credential
+    for each row execute procedure update_credential_status_column();
+
+  create trigger not_null_columns before insert on credential_plugin_credential
+    for each row execute procedure not_null_columns('library_id', 'session_id');
+
+  create trigger default_create_time_column before insert on credential_plugin_credential
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on credential_plugin_credential
+    for each row execute procedure immutable_columns('external_id', 'store_id', 'create_time');
+
+  create trigger insert_credential_dynamic_subtype before insert on credential_plugin_credential
+    for each row execute procedure insert_credential_dynamic_subtype();
+
+  create trigger delete_credential_dynamic_subtype after delete on credential_plugin_credential
+    for each row execute procedure delete_credential_dynamic_subtype();
+
+  -- revoke_credentials revokes any active credentials for a session when the
+  -- session enters the canceling or terminated states.
+  -- Replaces the revoke_credentials function defined in 10/06_session.up.sql
+  create or replace function revoke_credentials() returns trigger
+  as $$
+  begin
+    if new.state in ('canceling', 'terminated') then
+      update credential_vault_credential
+         set status = 'revoke'
+       where session_id = new.session_id
+         and status = 'active';
+
+      update credential_plugin_credential
+         set status = 'revoke'
+       where session_id = new.session_id
+         and status = 'active';
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+
+  insert into oplog_ticket (name, version)
+  values
+    ('plugin_credential', 1),
+    ('credential_plugin_store', 1),
+    ('credential_plugin_library', 1);
+
+commit;
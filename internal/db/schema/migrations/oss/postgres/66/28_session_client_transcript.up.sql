@@ -0,0 +1,46 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  create table session_client_transcript (
+    -- session_id does not reference the session, the transcript is kept after
+    -- terminated sessions are deleted.
+    session_id wt_public_id primary key,
+    project_id wt_scope_id not null
+      constraint iam_scope_project_fkey
+        references iam_scope_project (scope_id)
+        on delete cascade
+        on update cascade,
+    -- org_id is the scope of the key the transcript is encrypted with.
+    org_id wt_scope_id not null
+      constraint iam_scope_org_fkey
+        references iam_scope_org (scope_id)
+        on delete cascade
+        on update cascade,
+    user_id wt_user_id,
+    transcript bytea not null -- encrypted value
+      constraint transcript_must_not_be_empty
+        check(length(transcript) > 0),
+    truncated boolean not null default false,
+    key_id kms_private_id not null
+      constraint kms_data_key_version_fkey
+        references kms_data_key_version (private_id)
+        on delete restrict
+        on update cascade,
+    create_time wt_timestamp
+  );
+  comment on table session_client_transcript is
+    'session_client_transcript is a table where each row contains the encrypted terminal transcript '
+    'captured by the client of a session which could not be recorded by a worker.';
+
+  create trigger default_create_time_column before insert on session_client_transcript
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on session_client_transcript
+    for each row execute procedure immutable_columns('session_id', 'project_id', 'org_id', 'user_id', 'truncated', 'create_time');
+
+  create index session_client_transcript_org_id_key_id_ix
+    on session_client_transcript (org_id, key_id);
+
+commit;
@@ -0,0 +1,63 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- Credential sources of a target with a priority are fallbacks for the
+  -- other credential sources of the target with a priority and the same
+  -- purpose. They are tried in order of priority when a session is
+  -- authorized, and only the first one which returns a credential is used.
+  alter table target_credential_library
+    add column priority int
+      constraint priority_must_be_greater_than_0
+        check(priority > 0);
+
+  comment on column target_credential_library.priority is
+    'priority is the order in which the credential library is tried among the fallback credential sources of the target '
+    'with the same purpose. It is null if the credential library is not a fallback credential source.';
+
+  alter table target_static_credential
+    add column priority int
+      constraint priority_must_be_greater_than_0
+        check(priority > 0);
+
+  comment on column target_static_credential.priority is
+    'priority is the order in which the static credential is tried among the fallback credential sources of the target '
+    'with the same purpose. It is null if the static credential is not a fallback credential source.';
+
+  -- Replaces view from 66/22_credential_static_lease.up.sql
+  drop view target_credential_source;
+  create view target_credential_source
+  as
+    select
+      tcl.target_id,
+      tcl.credential_library_id as credential_source_id,
+      tcl.credential_purpose,
+      cl.store_id,
+      'library' as type,
+      false as exclusive,
+      tcl.priority
+    from
+      target_credential_library tcl,
+      credential_library cl
+    where
+      cl.public_id = tcl.credential_library_id
+    union
+    select
+      tcs.target_id,
+      tcs.credential_static_id as credential_source_id,
+      tcs.credential_purpose,
+      cst.store_id,
+      'static' as type,
+      tcs.exclusive,
+      tcs.priority
+    from
+      target_static_credential tcs,
+      credential_static cst
+    where
+      cst.public_id = tcs.credential_static_id;
+  comment on view target_credential_source is
+    'target_credential_source is a view where each row contains a credential source and the id of the parent credential store. '
+    'No encrypted data is returned. This view can be used to retrieve data which will be returned external to boundary.';
+
+commit;
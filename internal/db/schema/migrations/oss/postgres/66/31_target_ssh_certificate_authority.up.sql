@@ -0,0 +1,51 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- target_ssh_certificate_authority holds the SSH certificate authority
+  -- Boundary manages for a target. The certificate authority signs a client
+  -- certificate for each session authorized against the target, which is
+  -- injected by the worker, and the host keys of the endpoints of the
+  -- target.
+  create table target_ssh_certificate_authority (
+    target_id wt_public_id primary key
+      constraint target_fkey
+        references target (public_id)
+        on delete cascade
+        on update cascade,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    username text not null
+      constraint username_must_not_be_empty
+        check(length(trim(username)) > 0),
+    public_key text not null
+      constraint public_key_must_not_be_empty
+        check(length(trim(public_key)) > 0),
+    private_key bytea not null -- encrypted value
+      constraint private_key_must_not_be_empty
+        check(length(private_key) > 0),
+    key_id kms_private_id not null
+      constraint kms_data_key_version_fkey
+        references kms_data_key_version (private_id)
+        on delete restrict
+        on update cascade
+  );
+  comment on table target_ssh_certificate_authority is
+    'target_ssh_certificate_authority is a table where each row is the SSH certificate authority of a target. '
+    'The private key of the certificate authority is encrypted with a database key of the project of the target.';
+
+  create trigger update_time_column before update on target_ssh_certificate_authority
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on target_ssh_certificate_authority
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on target_ssh_certificate_authority
+    for each row execute procedure immutable_columns('target_id', 'public_key', 'create_time');
+
+  insert into oplog_ticket (name, version)
+  values
+    ('target_ssh_certificate_authority', 1);
+
+commit;
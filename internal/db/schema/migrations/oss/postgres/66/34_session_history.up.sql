@@ -0,0 +1,129 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  create table session_history (
+    -- session_id does not reference the session, the history is kept after
+    -- terminated sessions are deleted.
+    session_id wt_public_id primary key,
+    user_id wt_user_id not null
+      constraint iam_user_fkey
+        references iam_user (public_id)
+        on delete cascade
+        on update cascade,
+    project_id wt_scope_id not null
+      constraint iam_scope_project_fkey
+        references iam_scope_project (scope_id)
+        on delete cascade
+        on update cascade,
+    -- target_id does not reference the target, the history is kept after
+    -- the target is deleted.
+    target_id wt_public_id not null,
+    termination_reason text,
+    connection_count integer not null default 0
+      constraint connection_count_must_not_be_negative
+        check(connection_count >= 0),
+    bytes_up bigint not null default 0
+      constraint bytes_up_must_not_be_negative
+        check(bytes_up >= 0),
+    bytes_down bigint not null default 0
+      constraint bytes_down_must_not_be_negative
+        check(bytes_down >= 0),
+    start_time timestamp with time zone not null,
+    end_time timestamp with time zone not null
+      constraint start_and_end_times_in_sequence
+        check(start_time <= end_time),
+    create_time wt_timestamp
+  );
+  comment on table session_history is
+    'session_history is a table where each row contains the duration, the bytes transferred and '
+    'the termination reason of a terminated session, kept for the user of the session after the '
+    'session is deleted.';
+
+  create trigger default_create_time_column before insert on session_history
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on session_history
+    for each row execute procedure immutable_columns('session_id', 'user_id', 'project_id', 'target_id', 'start_time', 'end_time', 'create_time');
+
+  create index session_history_user_id_create_time_ix
+    on session_history (user_id, create_time);
+
+  create table session_connection_history (
+    connection_id wt_public_id primary key,
+    session_id wt_public_id not null
+      constraint session_history_fkey
+        references session_history (session_id)
+        on delete cascade
+        on update cascade,
+    bytes_up bigint not null default 0
+      constraint bytes_up_must_not_be_negative
+        check(bytes_up >= 0),
+    bytes_down bigint not null default 0
+      constraint bytes_down_must_not_be_negative
+        check(bytes_down >= 0),
+    closed_reason text,
+    -- connected_time is null if the connection was closed before it was
+    -- established.
+    connected_time timestamp with time zone,
+    closed_time timestamp with time zone,
+    create_time wt_timestamp
+  );
+  comment on table session_connection_history is
+    'session_connection_history is a table where each row contains the bytes transferred and '
+    'the closed reason of a connection of a session in session_history.';
+
+  create trigger default_create_time_column before insert on session_connection_history
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on session_connection_history
+    for each row execute procedure immutable_columns('connection_id', 'session_id', 'create_time');
+
+  create index session_connection_history_session_id_ix
+    on session_connection_history (session_id);
+
+  -- insert_session_history records the history of a session and of its
+  -- connections when the session enters the terminated state. Sessions
+  -- without a user are not recorded.
+  create function insert_session_history() returns trigger
+  as $$
+  begin
+    if new.state = 'terminated' then
+      insert into session_history
+        (session_id, user_id, project_id, target_id, termination_reason,
+         connection_count, bytes_up, bytes_down, start_time, end_time)
+      select s.public_id, s.user_id, s.project_id, s.target_id, s.termination_reason,
+             count(c.public_id), coalesce(sum(c.bytes_up), 0), coalesce(sum(c.bytes_down), 0),
+             s.create_time, new.start_time
+        from session s
+   left join session_connection c
+          on c.session_id = s.public_id
+       where s.public_id = new.session_id
+         and s.user_id is not null
+         and s.project_id is not null
+         and s.target_id is not null
+    group by s.public_id
+          on conflict do nothing;
+
+      insert into session_connection_history
+        (connection_id, session_id, bytes_up, bytes_down, closed_reason, connected_time, closed_time)
+      select c.public_id, c.session_id, coalesce(c.bytes_up, 0), coalesce(c.bytes_down, 0), c.closed_reason,
+             (select min(cs.start_time) from session_connection_state cs
+               where cs.connection_id = c.public_id and cs.state = 'connected'),
+             (select min(cs.start_time) from session_connection_state cs
+               where cs.connection_id = c.public_id and cs.state = 'closed')
+        from session_connection c
+        join session_history h
+          on h.session_id = c.session_id
+       where c.session_id = new.session_id
+          on conflict do nothing;
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+
+  create trigger insert_session_history after insert on session_state
+    for each row execute procedure insert_session_history();
+
+commit;
@@ -0,0 +1,32 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- Host sources of a target with a priority are fallbacks for each other.
+  -- When a session is authorized, the first of them, in order of priority,
+  -- with a healthy host is used.
+  alter table target_host_set
+    add column priority int
+      constraint priority_must_be_greater_than_0
+        check(priority > 0);
+
+  comment on column target_host_set.priority is
+    'priority is the order in which the host set is preferred among the fallback host sources of the target. '
+    'It is null if the host set is not a fallback host source.';
+
+  -- Replaces view from 0/41_targets.up.sql
+  create or replace view target_set
+  as
+  select
+    hs.public_id,
+    hs.catalog_id,
+    ths.target_id,
+    ths.priority
+  from
+    target_host_set ths,
+    host_set hs
+  where
+    hs.public_id = ths.host_set_id;
+
+commit;
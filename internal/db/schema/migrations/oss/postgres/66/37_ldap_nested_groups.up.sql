@@ -0,0 +1,88 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  alter table auth_ldap_method
+    add column nested_group_resolution text
+      constraint only_predefined_nested_group_resolutions_allowed
+        check(nested_group_resolution in ('in-chain', 'recursive')),
+    add column max_nested_group_depth int
+      constraint max_nested_group_depth_must_be_greater_than_0
+        check(max_nested_group_depth > 0);
+
+  comment on column auth_ldap_method.nested_group_resolution is
+    'nested_group_resolution is how the nested group memberships of an authenticated user are resolved. '
+    'It is null if nested groups are not resolved.';
+  comment on column auth_ldap_method.max_nested_group_depth is
+    'max_nested_group_depth is the maximum depth of nested groups searched when resolving them recursively.';
+
+  -- Replaces view from 66/25_deletion_protection.up.sql
+  create or replace view ldap_auth_method_with_value_obj as
+  select
+    case when s.primary_auth_method_id is not null then
+      true
+    else false end
+    as is_primary_auth_method,
+    am.public_id,
+    am.scope_id,
+    am.name,
+    am.description,
+    am.create_time,
+    am.update_time,
+    am.version,
+    am.state,
+    am.start_tls,
+    am.insecure_tls,
+    am.discover_dn,
+    am.anon_group_search,
+    am.upn_domain,
+    am.enable_groups,
+    am.use_token_groups,
+    -- the string_agg(..) column will be null if there are no associated value objects
+    string_agg(distinct url.url, '|') as urls,
+    string_agg(distinct cert.certificate, '|') as certs,
+    string_agg(distinct concat_ws('=', aam.from_attribute, aam.to_attribute), '|') as account_attribute_map,
+
+    -- the rest of the fields are zero to one relationships that are stored in
+    -- related tables. Since we're outer joining with these tables, we need to
+    -- either add them to the group by, use an aggregating func, or handle
+    -- multiple rows returning for each auth method. I've chosen to just use
+    -- string_agg(...)
+    string_agg(distinct uc.user_dn, '|') as user_dn,
+    string_agg(distinct uc.user_attr, '|') as user_attr,
+    string_agg(distinct uc.user_filter, '|') as user_filter,
+    string_agg(distinct gc.group_dn, '|') as group_dn,
+    string_agg(distinct gc.group_attr, '|') as group_attr,
+    string_agg(distinct gc.group_filter, '|') as group_filter,
+    string_agg(distinct cc.certificate_key, '|') as client_certificate_key,
+    string_agg(distinct cc.certificate_key_hmac, '|') as client_certificate_key_hmac,
+    string_agg(distinct cc.key_id, '|') as client_certificate_key_id,
+    string_agg(distinct cc.certificate, '|') as client_certificate_cert,
+    string_agg(distinct bc.dn, '|') as bind_dn,
+    string_agg(distinct bc.password, '|') as bind_password,
+    string_agg(distinct bc.password_hmac, '|') as bind_password_hmac,
+    string_agg(distinct bc.key_id, '|') as bind_password_key_id,
+    -- user filters commonly contain the '|' delimiter, so the alternate user
+    -- filters are aggregated as an ordered json array instead.
+    (select jsonb_agg(auf.user_filter order by auf.filter_priority)
+       from auth_ldap_alternate_user_filter auf
+      where auf.ldap_method_id = am.public_id) as alternate_user_filters,
+    am.deletion_protected,
+    am.nested_group_resolution,
+    am.max_nested_group_depth
+  from
+    auth_ldap_method am
+    left outer join iam_scope                       s     on am.public_id = s.primary_auth_method_id
+    left outer join auth_ldap_url                   url   on am.public_id = url.ldap_method_id
+    left outer join auth_ldap_certificate           cert  on am.public_id = cert.ldap_method_id
+    left outer join auth_ldap_account_attribute_map aam   on am.public_id = aam.ldap_method_id
+    left outer join auth_ldap_user_entry_search     uc    on am.public_id = uc.ldap_method_id
+    left outer join auth_ldap_group_entry_search    gc    on am.public_id = gc.ldap_method_id
+    left outer join auth_ldap_client_certificate    cc    on am.public_id = cc.ldap_method_id
+    left outer join auth_ldap_bind_credential       bc    on am.public_id = bc.ldap_method_id
+  group by am.public_id, is_primary_auth_method; -- there can be only one public_id + is_primary_auth_method, so group by isn't a problem.
+  comment on view ldap_auth_method_with_value_obj is
+    'ldap auth method with its associated value objects (urls, certs, search config, etc)';
+
+commit;
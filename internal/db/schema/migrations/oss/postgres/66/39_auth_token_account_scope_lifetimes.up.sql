@@ -0,0 +1,31 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- adds the auth token lifetime overrides of the account's scope, so
+  -- validating an auth token doesn't need to look up its scope.
+  -- replaces view from 66/02_auth_token_client_binding.up.sql
+  create or replace view auth_token_account as
+        select at.public_id,
+               at.token,
+               at.auth_account_id,
+               at.create_time,
+               at.update_time,
+               at.approximate_last_access_time,
+               at.expiration_time,
+               aa.scope_id,
+               aa.iam_user_id,
+               aa.auth_method_id,
+               at.status,
+               at.client_ip_range,
+               at.client_certificate_fingerprint,
+               coalesce(s.auth_token_time_to_live_seconds, 0)  as scope_auth_token_time_to_live_seconds,
+               coalesce(s.auth_token_time_to_stale_seconds, 0) as scope_auth_token_time_to_stale_seconds
+          from auth_token as at
+    inner join auth_account as aa
+            on at.auth_account_id = aa.public_id
+    inner join iam_scope as s
+            on aa.scope_id = s.public_id;
+
+commit;
@@ -0,0 +1,22 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  create table target_tag (
+    target_id wt_public_id
+      constraint target_fkey
+        references target (public_id)
+        on delete cascade
+        on update cascade,
+    key wt_tagpair,
+    value wt_tagpair,
+    primary key(target_id, key, value)
+  );
+  comment on table target_tag is
+    'target_tag entries are the tags of a target. Grants can select targets by their tags.';
+
+  create index target_tag_key_value_ix
+    on target_tag (key, value);
+
+commit;
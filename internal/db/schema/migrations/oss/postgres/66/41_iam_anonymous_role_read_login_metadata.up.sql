@@ -0,0 +1,31 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- The default role for the anonymous user only allowed listing scopes, so
+  -- login metadata could not be read before authenticating on existing
+  -- installations. The read-login-metadata action is added to the scope grant
+  -- of roles for the anonymous user which still have the default grant.
+  drop trigger immutable_role_grant on iam_role_grant;
+
+  update iam_role_grant
+     set canonical_grant = 'id=*;type=scope;actions=list,no-op,read-login-metadata',
+         raw_grant       = 'id=*;type=scope;actions=list,no-op,read-login-metadata'
+   where canonical_grant = 'id=*;type=scope;actions=list,no-op'
+     and role_id in (
+           select role_id
+             from iam_user_role
+            where principal_id = 'u_anon'
+         )
+     and not exists (
+           select 1
+             from iam_role_grant g
+            where g.role_id = iam_role_grant.role_id
+              and g.canonical_grant = 'id=*;type=scope;actions=list,no-op,read-login-metadata'
+         );
+
+  create trigger immutable_role_grant before update on iam_role_grant
+    for each row execute procedure iam_immutable_role_grant();
+
+commit;
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oss_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/common"
+	"github.com/hashicorp/boundary/internal/db/schema"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/testing/dbtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrations_AnonymousRoleReadLoginMetadata(t *testing.T) {
+	const (
+		priorMigration   = 66040
+		currentMigration = 66041
+
+		defaultGrant  = "id=*;type=scope;actions=list,no-op"
+		migratedGrant = "id=*;type=scope;actions=list,no-op,read-login-metadata"
+	)
+
+	t.Parallel()
+	ctx := context.Background()
+	dialect := dbtest.Postgres
+
+	c, u, _, err := dbtest.StartUsingTemplate(dialect, dbtest.WithTemplate(dbtest.Template1))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, c())
+	})
+	d, err := common.SqlOpen(dialect, u)
+	require.NoError(t, err)
+
+	// migration to the prior migration (before the one we want to test)
+	m, err := schema.NewManager(ctx, schema.Dialect(dialect), d, schema.WithEditions(
+		schema.TestCreatePartialEditions(schema.Dialect(dialect), schema.PartialEditions{"oss": priorMigration}),
+	))
+	require.NoError(t, err)
+
+	_, err = m.ApplyMigrations(ctx)
+	require.NoError(t, err)
+	state, err := m.CurrentState(ctx)
+	require.NoError(t, err)
+	want := &schema.State{
+		Initialized: true,
+		Editions: []schema.EditionState{
+			{
+				Name:                  "oss",
+				BinarySchemaVersion:   priorMigration,
+				DatabaseSchemaVersion: priorMigration,
+				DatabaseSchemaState:   schema.Equal,
+			},
+		},
+	}
+	require.Equal(t, want, state)
+
+	// Get a connection
+	dbType, err := db.StringToDbType(dialect)
+	require.NoError(t, err)
+	conn, err := db.Open(ctx, dbType, u)
+	require.NoError(t, err)
+
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+
+	// The default role of the anonymous user, a role of another user with the
+	// same grant, and a role of the anonymous user which already has the
+	// migrated grant.
+	anonRole := iam.TestRole(t, conn, "global")
+	iam.TestRoleGrant(t, conn, anonRole.GetPublicId(), defaultGrant)
+	iam.TestRoleGrant(t, conn, anonRole.GetPublicId(), "id=*;type=auth-method;actions=authenticate,list")
+	iam.TestUserRole(t, conn, anonRole.GetPublicId(), globals.AnonymousUserId)
+
+	user := iam.TestUser(t, iamRepo, "global")
+	userRole := iam.TestRole(t, conn, "global")
+	iam.TestRoleGrant(t, conn, userRole.GetPublicId(), defaultGrant)
+	iam.TestUserRole(t, conn, userRole.GetPublicId(), user.GetPublicId())
+
+	migratedRole := iam.TestRole(t, conn, "global")
+	iam.TestRoleGrant(t, conn, migratedRole.GetPublicId(), defaultGrant)
+	iam.TestRoleGrant(t, conn, migratedRole.GetPublicId(), migratedGrant)
+	iam.TestUserRole(t, conn, migratedRole.GetPublicId(), globals.AnonymousUserId)
+
+	// now we're ready for the migration we want to test.
+	m, err = schema.NewManager(ctx, schema.Dialect(dialect), d, schema.WithEditions(
+		schema.TestCreatePartialEditions(schema.Dialect(dialect), schema.PartialEditions{"oss": currentMigration}),
+	))
+	require.NoError(t, err)
+
+	_, err = m.ApplyMigrations(ctx)
+	require.NoError(t, err)
+	state, err = m.CurrentState(ctx)
+	require.NoError(t, err)
+	want = &schema.State{
+		Initialized: true,
+		Editions: []schema.EditionState{
+			{
+				Name:                  "oss",
+				BinarySchemaVersion:   currentMigration,
+				DatabaseSchemaVersion: currentMigration,
+				DatabaseSchemaState:   schema.Equal,
+			},
+		},
+	}
+	require.Equal(t, want, state)
+
+	tests := []struct {
+		roleId string
+		want   []string
+	}{
+		{
+			roleId: anonRole.GetPublicId(),
+			want:   []string{migratedGrant, "id=*;type=auth-method;actions=authenticate,list"},
+		},
+		{
+			roleId: userRole.GetPublicId(),
+			want:   []string{defaultGrant},
+		},
+		{
+			roleId: migratedRole.GetPublicId(),
+			want:   []string{defaultGrant, migratedGrant},
+		},
+	}
+	for _, tt := range tests {
+		_, _, roleGrants, err := iamRepo.LookupRole(ctx, tt.roleId)
+		require.NoError(t, err)
+		var got []string
+		for _, g := range roleGrants {
+			got = append(got, g.GetRawGrant())
+		}
+		assert.ElementsMatch(t, tt.want, got, "role %s", tt.roleId)
+	}
+}
@@ -0,0 +1,96 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+-- grants_version tests:
+--  the iam grants version is incremented by changes which can alter the
+--  grants of any number of users, the iam user grants version of a user is
+--  incremented by changes which can only alter the grants of that user, and
+--  neither is incremented by changes which cannot alter grants
+
+begin;
+  select plan(16);
+
+  create temporary table last_version as
+  select version from iam_grants_version;
+
+  create temporary view user_version as
+  select u.public_id as iam_user_id, coalesce(v.version, 0) as version
+    from iam_user as u
+    left join iam_user_grants_version as v
+           on v.iam_user_id = u.public_id;
+
+  create temporary table last_user_version as
+  select * from user_version;
+
+  insert into iam_role_grant
+    (role_id, canonical_grant, raw_grant)
+  values
+    ('r_go____name', 'type=color;action=read', 'read colors');
+  select ok((select version from iam_grants_version) > (select version from last_version), 'inserting a role grant increments the version');
+  update last_version set version = (select version from iam_grants_version);
+
+  insert into iam_user_role
+    (role_id, principal_id)
+  values
+    ('r_gg____shop', 'u_______gary');
+  select ok((select version from user_version where iam_user_id = 'u_______gary') > (select version from last_user_version where iam_user_id = 'u_______gary'), 'adding a user to a role increments the user version');
+  select is((select version from user_version where iam_user_id = 'u______cindy'), (select version from last_user_version where iam_user_id = 'u______cindy'), 'adding a user to a role does not increment the version of other users');
+  select is((select version from iam_grants_version), (select version from last_version), 'adding a user to a role does not increment the version');
+  delete from last_user_version;
+  insert into last_user_version select * from user_version;
+
+  insert into iam_user_role
+    (role_id, principal_id)
+  values
+    ('r_go____name', 'u_auth');
+  select ok((select version from iam_grants_version) > (select version from last_version), 'adding u_auth to a role increments the version');
+  update last_version set version = (select version from iam_grants_version);
+
+  delete from iam_group_member_user
+   where group_id  = 'g___cb-group'
+     and member_id = 'u______cindy';
+  select ok((select version from user_version where iam_user_id = 'u______cindy') > (select version from last_user_version where iam_user_id = 'u______cindy'), 'removing a user from a group increments the user version');
+  select is((select version from iam_grants_version), (select version from last_version), 'removing a user from a group does not increment the version');
+  delete from last_user_version;
+  insert into last_user_version select * from user_version;
+
+  update iam_role
+     set grant_scope_id = 'p____bcolors'
+   where public_id = 'r_go____name';
+  select ok((select version from iam_grants_version) > (select version from last_version), 'changing the grant scope of a role increments the version');
+  update last_version set version = (select version from iam_grants_version);
+
+  delete from iam_role_grant
+   where role_id = 'r_gg_____buy';
+  select ok((select version from iam_grants_version) > (select version from last_version), 'deleting a role grant increments the version');
+  update last_version set version = (select version from iam_grants_version);
+
+  insert into auth_password_account
+    (auth_method_id, public_id, login_name)
+  values
+    ('apm___colors', 'apa____cathy', 'cathy');
+  select is((select version from iam_grants_version), (select version from last_version), 'inserting an account does not increment the version');
+  select results_eq('select * from user_version order by iam_user_id', 'select * from last_user_version order by iam_user_id', 'inserting an account does not increment any user version');
+
+  update auth_account
+     set iam_user_id = 'u______carly'
+   where public_id = 'apa____cathy';
+  select ok((select version from user_version where iam_user_id = 'u______carly') > (select version from last_user_version where iam_user_id = 'u______carly'), 'changing the user of an account increments the user version');
+  select is((select version from iam_grants_version), (select version from last_version), 'changing the user of an account does not increment the version');
+  delete from last_user_version;
+  insert into last_user_version select * from user_version;
+
+  delete from auth_password_account
+   where public_id = 'apa____cathy';
+  select ok((select version from user_version where iam_user_id = 'u______carly') > (select version from last_user_version where iam_user_id = 'u______carly'), 'deleting an account increments the version of its user');
+  delete from last_user_version;
+  insert into last_user_version select * from user_version;
+
+  update iam_group
+     set name = 'Blue Color Group Renamed'
+   where public_id = 'g___cb-group';
+  select is((select version from iam_grants_version), (select version from last_version), 'renaming a group does not increment the version');
+  select results_eq('select * from user_version order by iam_user_id', 'select * from last_user_version order by iam_user_id', 'renaming a group does not increment any user version');
+
+  select * from finish();
+rollback;
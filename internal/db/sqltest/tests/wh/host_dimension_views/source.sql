@@ -3,7 +3,7 @@
 
 -- source tests the whx_host_dimension_source view.
 begin;
-  select plan(3);
+  select plan(4);
   select wtt_load('widgets', 'iam', 'kms', 'auth', 'hosts', 'targets');
 
   -- Static hosts
@@ -13,7 +13,10 @@ begin;
     'c___wb-sthcl', 'static host catalog', 'Big Widget Static Catalog', 'None',
     't_________wb', 'tcp target',          'Big Widget Target',         'None', 0,              28800, -1,
     'p____bwidget', 'Big Widget Factory',  'None',
-    'o_____widget', 'Widget Inc',          'None'
+    'o_____widget', 'Widget Inc',          'None',
+    'None',         'None',                'None',
+    'None',         'None',                'None',
+    'None',         'None',                'None'
   )::whx_host_dimension_source)
     from whx_host_dimension_source as s
    where s.host_id     = 'h_____wb__01'
@@ -27,7 +30,10 @@ begin;
     'c___wb-plghcl',      'plugin host catalog', 'Big Widget Plugin Catalog', 'None',
     't_________wb',       'tcp target',          'Big Widget Target',         'None', 0,              28800, -1,
     'p____bwidget',       'Big Widget Factory',  'None',
-    'o_____widget',       'Widget Inc',          'None'
+    'o_____widget',       'Widget Inc',          'None',
+    'None',               'None',                'None',
+    'None',               'None',                'None',
+    'None',               'None',                'None'
     )::whx_host_dimension_source)
   from whx_host_dimension_source as s
   where s.host_id     = 'h_____wb__01-plgh'
@@ -35,6 +41,27 @@ begin;
     and s.target_id   = 't_________wb';
 
 
+  -- Annotations of the target and its scopes
+  update target_tcp
+     set annotation_owner       = 'Widget Ops',
+         annotation_cost_center = 'CC-1234'
+   where public_id = 't_________wb';
+  update iam_scope
+     set annotation_ticket_url = 'https://tickets.widget/OPS-1'
+   where public_id = 'o_____widget';
+
+  select is(row(s.target_owner,       s.target_cost_center,       s.target_ticket_url,
+                s.project_owner,      s.project_cost_center,      s.project_ticket_url,
+                s.organization_owner, s.organization_cost_center, s.organization_ticket_url),
+            row('Widget Ops'::text,   'CC-1234'::text,            'None'::text,
+                'None'::text,         'None'::text,               'None'::text,
+                'None'::text,         'None'::text,               'https://tickets.widget/OPS-1'::text))
+    from whx_host_dimension_source as s
+   where s.host_id     = 'h_____wb__01'
+     and s.host_set_id = 's___2wb-sths'
+     and s.target_id   = 't_________wb';
+
+
 -- network address dimension
   declare cwant cursor for select
       address, address_type, ip_address_family, private_ip_address_indicator,
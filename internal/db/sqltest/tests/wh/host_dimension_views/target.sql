@@ -36,6 +36,9 @@ begin;
       target_id,             target_type,               target_name,              target_description,       target_default_port_number, target_session_max_seconds, target_session_connection_limit,
       project_id,            project_name,              project_description,
       organization_id,       organization_name,         organization_description,
+      target_owner,          target_cost_center,        target_ticket_url,
+      project_owner,         project_cost_center,       project_ticket_url,
+      organization_owner,    organization_cost_center,  organization_ticket_url,
       current_row_indicator, row_effective_time,        row_expiration_time
     )
   values
@@ -47,6 +50,9 @@ begin;
       't_________wb', 'tcp target',                    'Big Widget Target',         'None', 0,              28800, 1,
       'p____bwidget', 'Big Widget Factory',            'None',
       'o_____widget', 'Widget Inc',                    'None',
+      'None',         'None',                          'None',
+      'None',         'None',                          'None',
+      'None',         'None',                          'None',
       'Expired',      '2021-07-21T11:01'::timestamptz, '2021-07-21T12:01'::timestamptz
     ),
     (
@@ -57,6 +63,9 @@ begin;
       't_________wb', 'tcp target',                    'Big Widget Target',         'None', 0,              28800, 1,
       'p____bwidget', 'Big Widget Factory',            'None',
       'o_____widget', 'Widget Inc',                    'None',
+      'None',         'None',                          'None',
+      'None',         'None',                          'None',
+      'None',         'None',                          'None',
       'Current',      '2021-07-21T12:01'::timestamptz, 'infinity'::timestamptz
     );
 
@@ -67,7 +76,10 @@ begin;
     'c___wb-sthcl', 'static host catalog', 'Big Widget Static Catalog', 'None',
     't_________wb', 'tcp target',          'Big Widget Target',         'None', 0,              28800, 1,
     'p____bwidget', 'Big Widget Factory',  'None',
-    'o_____widget', 'Widget Inc',          'None'
+    'o_____widget', 'Widget Inc',          'None',
+    'None',         'None',                'None',
+    'None',         'None',                'None',
+    'None',         'None',                'None'
   )::whx_host_dimension_target)
     from whx_host_dimension_target as t
    where t.host_id     = 'h_____wb__01'
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package deprecation records the deprecated fields and behaviors used by API
+// requests, so that responses can warn clients about them and operators can see
+// which clients still rely on them before they are removed.
+//
+// Handlers call Record when a request uses something deprecated. Once the
+// request has been handled the recorded ids are returned to the client as
+// response headers and counted by a Tracker, which serves a report of the
+// clients using each of them.
+package deprecation
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/requests"
+)
+
+// Id identifies a deprecated field or behavior of the API.
+type Id string
+
+const (
+	// AuthenticateTokenType is the use of the token_type field of an
+	// authenticate request instead of type.
+	AuthenticateTokenType Id = "authenticate-token-type"
+
+	// LegacyGrantTemplate is the use of the {{user.id}} or {{account.id}}
+	// templates in a grant instead of {{.User.Id}} or {{.Account.Id}}.
+	LegacyGrantTemplate Id = "legacy-grant-template"
+
+	// VaultCredentialLibrarySubtype is the use of the vault credential library
+	// type instead of vault-generic.
+	VaultCredentialLibrarySubtype Id = "vault-credential-library-subtype"
+)
+
+var messages = map[Id]string{
+	AuthenticateTokenType:         `The "token_type" field of authenticate requests is deprecated, use "type" instead.`,
+	LegacyGrantTemplate:           `The "{{user.id}}" and "{{account.id}}" grant templates are deprecated, use "{{.User.Id}}" and "{{.Account.Id}}" instead.`,
+	VaultCredentialLibrarySubtype: `The "vault" credential library type is deprecated, use "vault-generic" instead.`,
+}
+
+// Message returns the message given to clients using the deprecated field or
+// behavior, naming its replacement. An empty string is returned for unknown
+// ids.
+func (id Id) Message() string {
+	return messages[id]
+}
+
+// Record notes that the request in ctx used the deprecated field or behavior
+// id. Recording the same id more than once for a request has no further
+// effect. Nothing is recorded if ctx has no request context.
+func Record(ctx context.Context, id Id) {
+	reqCtx, ok := requests.RequestContextFromCtx(ctx)
+	if !ok {
+		return
+	}
+	for _, d := range reqCtx.Deprecations {
+		if d == string(id) {
+			return
+		}
+	}
+	reqCtx.Deprecations = append(reqCtx.Deprecations, string(id))
+}
+
+// Recorded returns the ids recorded for the request in ctx, in the order they
+// were first recorded.
+func Recorded(ctx context.Context) []Id {
+	reqCtx, ok := requests.RequestContextFromCtx(ctx)
+	if !ok || len(reqCtx.Deprecations) == 0 {
+		return nil
+	}
+	ids := make([]Id, 0, len(reqCtx.Deprecations))
+	for _, d := range reqCtx.Deprecations {
+		ids = append(ids, Id(d))
+	}
+	return ids
+}
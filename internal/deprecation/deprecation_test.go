@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package deprecation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/requests"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestId_Message(t *testing.T) {
+	t.Parallel()
+	for id := range messages {
+		assert.NotEmpty(t, id.Message(), id)
+	}
+	assert.Empty(t, Id("unknown").Message())
+}
+
+func TestRecord(t *testing.T) {
+	t.Parallel()
+	t.Run("no-request-context", func(t *testing.T) {
+		ctx := context.Background()
+		Record(ctx, AuthenticateTokenType)
+		assert.Nil(t, Recorded(ctx))
+	})
+	t.Run("records-once", func(t *testing.T) {
+		ctx := requests.NewRequestContext(context.Background())
+		assert.Nil(t, Recorded(ctx))
+		Record(ctx, LegacyGrantTemplate)
+		Record(ctx, AuthenticateTokenType)
+		Record(ctx, LegacyGrantTemplate)
+		assert.Equal(t, []Id{LegacyGrantTemplate, AuthenticateTokenType}, Recorded(ctx))
+	})
+}
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package deprecation
+
+import "time"
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withMaxClients int
+	withNow        func() time.Time
+}
+
+func getDefaultOptions() options {
+	return options{}
+}
+
+// WithMaxClients provides an option to specify the maximum number of clients
+// tracked for each id. Defaults to DefaultMaxClients.
+func WithMaxClients(max int) Option {
+	return func(o *options) {
+		o.withMaxClients = max
+	}
+}
+
+// withNow provides an option to specify the clock, for tests.
+func withNow(now func() time.Time) Option {
+	return func(o *options) {
+		o.withNow = now
+	}
+}
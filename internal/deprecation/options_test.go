@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package deprecation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetOpts(t *testing.T) {
+	t.Parallel()
+	t.Run("WithMaxClients", func(t *testing.T) {
+		opts := getOpts(WithMaxClients(5))
+		testOpts := getDefaultOptions()
+		testOpts.withMaxClients = 5
+		assert.Equal(t, opts, testOpts)
+	})
+}
@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package deprecation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// DefaultMaxClients is the number of clients tracked for each id when no
+// maximum is given.
+const DefaultMaxClients = 1000
+
+// Client identifies the client making a request. Clients are tracked per user
+// and user agent; the ip is only reported as the last one seen.
+type Client struct {
+	UserId    string
+	UserAgent string
+	ClientIp  string
+}
+
+type clientKey struct {
+	userId    string
+	userAgent string
+}
+
+// ClientUsage is the use of a deprecated field or behavior by a client.
+type ClientUsage struct {
+	UserId       string    `json:"user_id,omitempty"`
+	UserAgent    string    `json:"user_agent,omitempty"`
+	LastClientIp string    `json:"last_client_ip,omitempty"`
+	Count        uint64    `json:"count"`
+	FirstSeen    time.Time `json:"first_seen"`
+	LastSeen     time.Time `json:"last_seen"`
+}
+
+// Usage is the use of a deprecated field or behavior by all clients seen since
+// the tracker was created.
+type Usage struct {
+	Id      Id             `json:"id"`
+	Message string         `json:"message"`
+	Count   uint64         `json:"count"`
+	Clients []*ClientUsage `json:"clients"`
+}
+
+// Tracker counts the use of deprecated fields and behaviors by clients. Only
+// the most recently seen clients are kept for each id, but the total count for
+// an id includes clients which are no longer kept. A nil Tracker tracks
+// nothing. A Tracker is safe for concurrent use.
+type Tracker struct {
+	maxClients int
+	now        func() time.Time
+
+	mu     sync.Mutex
+	counts map[Id]uint64
+	usage  map[Id]map[clientKey]*ClientUsage
+}
+
+// NewTracker creates a Tracker. The supported option is WithMaxClients.
+func NewTracker(ctx context.Context, opt ...Option) (*Tracker, error) {
+	const op = "deprecation.NewTracker"
+	opts := getOpts(opt...)
+	if opts.withMaxClients < 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "max clients is negative")
+	}
+	t := &Tracker{
+		maxClients: opts.withMaxClients,
+		now:        opts.withNow,
+		counts:     make(map[Id]uint64),
+		usage:      make(map[Id]map[clientKey]*ClientUsage),
+	}
+	if t.maxClients == 0 {
+		t.maxClients = DefaultMaxClients
+	}
+	if t.now == nil {
+		t.now = time.Now
+	}
+	return t, nil
+}
+
+// Observe counts the use of the ids by c. When the clients kept for an id are
+// at the maximum, the least recently seen one is dropped to make room for a new
+// client.
+func (t *Tracker) Observe(c Client, ids ...Id) {
+	if t == nil || len(ids) == 0 {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.now()
+	k := clientKey{userId: c.UserId, userAgent: c.UserAgent}
+	for _, id := range ids {
+		t.counts[id]++
+		clients, ok := t.usage[id]
+		if !ok {
+			clients = make(map[clientKey]*ClientUsage)
+			t.usage[id] = clients
+		}
+		cu, ok := clients[k]
+		if !ok {
+			if len(clients) >= t.maxClients {
+				evictOldest(clients)
+			}
+			cu = &ClientUsage{
+				UserId:    c.UserId,
+				UserAgent: c.UserAgent,
+				FirstSeen: now,
+			}
+			clients[k] = cu
+		}
+		cu.Count++
+		cu.LastSeen = now
+		cu.LastClientIp = c.ClientIp
+	}
+}
+
+func evictOldest(clients map[clientKey]*ClientUsage) {
+	var oldest clientKey
+	var oldestSeen time.Time
+	first := true
+	for k, cu := range clients {
+		if first || cu.LastSeen.Before(oldestSeen) {
+			oldest, oldestSeen, first = k, cu.LastSeen, false
+		}
+	}
+	delete(clients, oldest)
+}
+
+// Report returns the usage of each id observed, ordered by id. The clients of
+// each id are ordered from the most to the least recently seen.
+func (t *Tracker) Report() []*Usage {
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	report := make([]*Usage, 0, len(t.counts))
+	for id, count := range t.counts {
+		u := &Usage{
+			Id:      id,
+			Message: id.Message(),
+			Count:   count,
+			Clients: make([]*ClientUsage, 0, len(t.usage[id])),
+		}
+		for _, cu := range t.usage[id] {
+			c := *cu
+			u.Clients = append(u.Clients, &c)
+		}
+		sort.Slice(u.Clients, func(i, j int) bool {
+			return u.Clients[i].LastSeen.After(u.Clients[j].LastSeen)
+		})
+		report = append(report, u)
+	}
+	sort.Slice(report, func(i, j int) bool {
+		return report[i].Id < report[j].Id
+	})
+	return report
+}
+
+// ServeHTTP writes the report of the tracker as JSON.
+func (t *Tracker) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	b, err := json.Marshal(map[string]any{"deprecations": t.Report()})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(b)
+}
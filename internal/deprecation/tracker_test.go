@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package deprecation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTracker(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tr, err := NewTracker(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultMaxClients, tr.maxClients)
+
+	_, err = NewTracker(ctx, WithMaxClients(-1))
+	assert.Error(t, err)
+}
+
+func TestTracker_Observe(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+	tr, err := NewTracker(ctx, WithMaxClients(2), withNow(func() time.Time { return now }))
+	require.NoError(t, err)
+
+	cli := Client{UserId: "u_1234567890", UserAgent: "boundary-cli/0.12.0", ClientIp: "127.0.0.1"}
+	tr.Observe(cli, AuthenticateTokenType, LegacyGrantTemplate)
+	now = now.Add(time.Minute)
+	cli.ClientIp = "127.0.0.2"
+	tr.Observe(cli, AuthenticateTokenType)
+	now = now.Add(time.Minute)
+	tr.Observe(Client{UserId: "u_anon", UserAgent: "curl/7.88.1"}, AuthenticateTokenType)
+
+	report := tr.Report()
+	require.Len(t, report, 2)
+	assert.Equal(t, AuthenticateTokenType, report[0].Id)
+	assert.Equal(t, AuthenticateTokenType.Message(), report[0].Message)
+	assert.Equal(t, uint64(3), report[0].Count)
+	require.Len(t, report[0].Clients, 2)
+	assert.Equal(t, "u_anon", report[0].Clients[0].UserId)
+	assert.Equal(t, &ClientUsage{
+		UserId:       "u_1234567890",
+		UserAgent:    "boundary-cli/0.12.0",
+		LastClientIp: "127.0.0.2",
+		Count:        2,
+		FirstSeen:    now.Add(-2 * time.Minute),
+		LastSeen:     now.Add(-time.Minute),
+	}, report[0].Clients[1])
+	assert.Equal(t, LegacyGrantTemplate, report[1].Id)
+	assert.Equal(t, uint64(1), report[1].Count)
+
+	// A new client evicts the least recently seen one, but is still counted
+	// in the total.
+	now = now.Add(time.Minute)
+	tr.Observe(Client{UserId: "u_0987654321"}, AuthenticateTokenType)
+	report = tr.Report()
+	assert.Equal(t, uint64(4), report[0].Count)
+	require.Len(t, report[0].Clients, 2)
+	assert.Equal(t, "u_0987654321", report[0].Clients[0].UserId)
+	assert.Equal(t, "u_anon", report[0].Clients[1].UserId)
+
+	var nilTracker *Tracker
+	nilTracker.Observe(cli, AuthenticateTokenType)
+	assert.Nil(t, nilTracker.Report())
+}
+
+func TestTracker_ServeHTTP(t *testing.T) {
+	t.Parallel()
+	tr, err := NewTracker(context.Background())
+	require.NoError(t, err)
+	tr.Observe(Client{UserId: "u_1234567890"}, VaultCredentialLibrarySubtype)
+
+	rec := httptest.NewRecorder()
+	tr.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/deprecations", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	var got struct {
+		Deprecations []*Usage `json:"deprecations"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Len(t, got.Deprecations, 1)
+	assert.Equal(t, VaultCredentialLibrarySubtype, got.Deprecations[0].Id)
+	assert.Equal(t, "u_1234567890", got.Deprecations[0].Clients[0].UserId)
+
+	rec = httptest.NewRecorder()
+	tr.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/deprecations", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
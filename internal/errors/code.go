@@ -80,10 +80,11 @@ const (
 
 	// General system errors are reserved Codes 400-599 and align with http
 	// client and server error codes
-	Unauthorized Code = 401 // Unauthorized represents the operation is unauthorized
-	Forbidden    Code = 403 // Forbidden represents the operation is forbidden
-	Conflict     Code = 409 // Conflict represents the operation failed due to failed pre-condition or was aborted.
-	Internal     Code = 500 // InternalError represents the system encountered an unexpected condition.
+	Unauthorized    Code = 401 // Unauthorized represents the operation is unauthorized
+	Forbidden       Code = 403 // Forbidden represents the operation is forbidden
+	Conflict        Code = 409 // Conflict represents the operation failed due to failed pre-condition or was aborted.
+	TooManyRequests Code = 429 // TooManyRequests represents the operation was rejected because a rate limit was exceeded.
+	Internal        Code = 500 // InternalError represents the system encountered an unexpected condition.
 
 	// DB errors are reserved Codes from 1000-1999
 	CheckConstraint      Code = 1000 // CheckConstraint represents a check constraint error
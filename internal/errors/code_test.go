@@ -135,6 +135,11 @@ func TestCode_Both_String_Info(t *testing.T) {
 			c:    Forbidden,
 			want: Forbidden,
 		},
+		{
+			name: "TooManyRequests",
+			c:    TooManyRequests,
+			want: TooManyRequests,
+		},
 		{
 			name: "AuthMethodInactive",
 			c:    AuthMethodInactive,
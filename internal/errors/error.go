@@ -208,6 +208,8 @@ func Convert(e error) *Err {
 			case "23514": // check_violation
 				msg := fmt.Sprintf("%s constraint failed", pgxError.ConstraintName)
 				return E(ctx, WithoutEvent(), WithMsg(msg), WithWrap(E(ctx, WithoutEvent(), WithCode(CheckConstraint), WithMsg("check constraint violated")))).(*Err)
+			case "23603": // deletion_protected_violation
+				return E(ctx, WithoutEvent(), WithCode(Conflict), WithMsg(pgxError.Message)).(*Err)
 			default:
 				return E(ctx, WithoutEvent(), WithCode(NotSpecificIntegrity), WithMsg(pgxError.Message)).(*Err)
 			}
@@ -457,6 +457,14 @@ func TestConvertError(t *testing.T) {
 			},
 			wantErr: errors.EDeprecated(errors.WithCode(errors.NotSpecificIntegrity)),
 		},
+		{
+			name: "DeletionProtected",
+			e: &pgconn.PgError{
+				Code:    "23603",
+				Message: "deletion protected: ttcp_1234567890",
+			},
+			wantErr: errors.EDeprecated(errors.WithCode(errors.Conflict), errors.WithMsg("deletion protected: ttcp_1234567890")),
+		},
 		{
 			name:    "convert-domain-error",
 			e:       testErr,
@@ -159,6 +159,10 @@ var errorCodeInfo = map[Code]Info{
 		Message: "conflict",
 		Kind:    Integrity,
 	},
+	TooManyRequests: {
+		Message: "too many requests",
+		Kind:    External,
+	},
 	CheckConstraint: {
 		Message: "constraint check failed",
 		Kind:    Integrity,
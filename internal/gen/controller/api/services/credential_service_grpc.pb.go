@@ -32,6 +32,11 @@ type CredentialServiceClient interface {
 	// non existing resource an error is returned.  If a name is provided that is
 	// in use in another Credential in the same store, an error is returned.
 	CreateCredential(ctx context.Context, in *CreateCredentialRequest, opts ...grpc.CallOption) (*CreateCredentialResponse, error)
+	// BatchCreateCredentials creates and stores up to 1000 Credentials in the
+	// provided Credential Store in a single transaction. The request must include
+	// the credential_store_id in which the Credentials will be created. If any
+	// Credential cannot be created, none are created and an error is returned.
+	BatchCreateCredentials(ctx context.Context, in *BatchCreateCredentialsRequest, opts ...grpc.CallOption) (*BatchCreateCredentialsResponse, error)
 	// UpdateCredential updates an existing Credential in boundary.  The provided
 	// Credential must not have any read only fields set.  The update mask must be
 	// included in the request and contain at least 1 mutable field.  To unset
@@ -81,6 +86,15 @@ func (c *credentialServiceClient) CreateCredential(ctx context.Context, in *Crea
 	return out, nil
 }
 
+func (c *credentialServiceClient) BatchCreateCredentials(ctx context.Context, in *BatchCreateCredentialsRequest, opts ...grpc.CallOption) (*BatchCreateCredentialsResponse, error) {
+	out := new(BatchCreateCredentialsResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.CredentialService/BatchCreateCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *credentialServiceClient) UpdateCredential(ctx context.Context, in *UpdateCredentialRequest, opts ...grpc.CallOption) (*UpdateCredentialResponse, error) {
 	out := new(UpdateCredentialResponse)
 	err := c.cc.Invoke(ctx, "/controller.api.services.v1.CredentialService/UpdateCredential", in, out, opts...)
@@ -117,6 +131,11 @@ type CredentialServiceServer interface {
 	// non existing resource an error is returned.  If a name is provided that is
 	// in use in another Credential in the same store, an error is returned.
 	CreateCredential(context.Context, *CreateCredentialRequest) (*CreateCredentialResponse, error)
+	// BatchCreateCredentials creates and stores up to 1000 Credentials in the
+	// provided Credential Store in a single transaction. The request must include
+	// the credential_store_id in which the Credentials will be created. If any
+	// Credential cannot be created, none are created and an error is returned.
+	BatchCreateCredentials(context.Context, *BatchCreateCredentialsRequest) (*BatchCreateCredentialsResponse, error)
 	// UpdateCredential updates an existing Credential in boundary.  The provided
 	// Credential must not have any read only fields set.  The update mask must be
 	// included in the request and contain at least 1 mutable field.  To unset
@@ -145,6 +164,9 @@ func (UnimplementedCredentialServiceServer) ListCredentials(context.Context, *Li
 func (UnimplementedCredentialServiceServer) CreateCredential(context.Context, *CreateCredentialRequest) (*CreateCredentialResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateCredential not implemented")
 }
+func (UnimplementedCredentialServiceServer) BatchCreateCredentials(context.Context, *BatchCreateCredentialsRequest) (*BatchCreateCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateCredentials not implemented")
+}
 func (UnimplementedCredentialServiceServer) UpdateCredential(context.Context, *UpdateCredentialRequest) (*UpdateCredentialResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredential not implemented")
 }
@@ -218,6 +240,24 @@ func _CredentialService_CreateCredential_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CredentialService_BatchCreateCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialServiceServer).BatchCreateCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.CredentialService/BatchCreateCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialServiceServer).BatchCreateCredentials(ctx, req.(*BatchCreateCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CredentialService_UpdateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateCredentialRequest)
 	if err := dec(in); err != nil {
@@ -273,6 +313,10 @@ var CredentialService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateCredential",
 			Handler:    _CredentialService_CreateCredential_Handler,
 		},
+		{
+			MethodName: "BatchCreateCredentials",
+			Handler:    _CredentialService_BatchCreateCredentials_Handler,
+		},
 		{
 			MethodName: "UpdateCredential",
 			Handler:    _CredentialService_UpdateCredential_Handler,
@@ -79,6 +79,13 @@ type RoleServiceClient interface {
 	// grants will be removed. If missing, malformed, or references a non-existing
 	// resource, an error is returned.
 	RemoveRoleGrants(ctx context.Context, in *RemoveRoleGrantsRequest, opts ...grpc.CallOption) (*RemoveRoleGrantsResponse, error)
+	// SimulateRole returns the permissions that the provided grants would newly
+	// allow the users of the specified Role, without changing the Role. The
+	// users are the Role's user principals and the members of its group and
+	// managed group principals. If the Role ID is missing, malformed, or
+	// references a non-existing resource, or a grant is invalid, an error is
+	// returned.
+	SimulateRole(ctx context.Context, in *SimulateRoleRequest, opts ...grpc.CallOption) (*SimulateRoleResponse, error)
 }
 
 type roleServiceClient struct {
@@ -188,6 +195,15 @@ func (c *roleServiceClient) RemoveRoleGrants(ctx context.Context, in *RemoveRole
 	return out, nil
 }
 
+func (c *roleServiceClient) SimulateRole(ctx context.Context, in *SimulateRoleRequest, opts ...grpc.CallOption) (*SimulateRoleResponse, error) {
+	out := new(SimulateRoleResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.RoleService/SimulateRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RoleServiceServer is the server API for RoleService service.
 // All implementations must embed UnimplementedRoleServiceServer
 // for forward compatibility
@@ -253,6 +269,13 @@ type RoleServiceServer interface {
 	// grants will be removed. If missing, malformed, or references a non-existing
 	// resource, an error is returned.
 	RemoveRoleGrants(context.Context, *RemoveRoleGrantsRequest) (*RemoveRoleGrantsResponse, error)
+	// SimulateRole returns the permissions that the provided grants would newly
+	// allow the users of the specified Role, without changing the Role. The
+	// users are the Role's user principals and the members of its group and
+	// managed group principals. If the Role ID is missing, malformed, or
+	// references a non-existing resource, or a grant is invalid, an error is
+	// returned.
+	SimulateRole(context.Context, *SimulateRoleRequest) (*SimulateRoleResponse, error)
 	mustEmbedUnimplementedRoleServiceServer()
 }
 
@@ -293,6 +316,9 @@ func (UnimplementedRoleServiceServer) SetRoleGrants(context.Context, *SetRoleGra
 func (UnimplementedRoleServiceServer) RemoveRoleGrants(context.Context, *RemoveRoleGrantsRequest) (*RemoveRoleGrantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveRoleGrants not implemented")
 }
+func (UnimplementedRoleServiceServer) SimulateRole(context.Context, *SimulateRoleRequest) (*SimulateRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateRole not implemented")
+}
 func (UnimplementedRoleServiceServer) mustEmbedUnimplementedRoleServiceServer() {}
 
 // UnsafeRoleServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -504,6 +530,24 @@ func _RoleService_RemoveRoleGrants_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RoleService_SimulateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).SimulateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.RoleService/SimulateRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).SimulateRole(ctx, req.(*SimulateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RoleService_ServiceDesc is the grpc.ServiceDesc for RoleService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -555,6 +599,10 @@ var RoleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveRoleGrants",
 			Handler:    _RoleService_RemoveRoleGrants_Handler,
 		},
+		{
+			MethodName: "SimulateRole",
+			Handler:    _RoleService_SimulateRole_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/role_service.proto",
@@ -47,6 +47,19 @@ type ScopeServiceClient interface {
 	// ListKeys lists all the keys found in the scope specified. If the scope
 	// is not found an error is returned.
 	ListKeys(ctx context.Context, in *ListKeysRequest, opts ...grpc.CallOption) (*ListKeysResponse, error)
+	// ListAuthorizedActions returns the actions the caller is allowed to
+	// perform on each resource type in the scope specified.
+	ListAuthorizedActions(ctx context.Context, in *ListAuthorizedActionsRequest, opts ...grpc.CallOption) (*ListAuthorizedActionsResponse, error)
+	// ReadLoginMetadata returns the information shown to users before they log
+	// in to the scope specified. If the scope is empty, the global scope is
+	// used. By default it is granted to the anonymous user, so it can be called
+	// without authenticating.
+	ReadLoginMetadata(ctx context.Context, in *ReadLoginMetadataRequest, opts ...grpc.CallOption) (*ReadLoginMetadataResponse, error)
+	// DiscoverDefaults returns the scope and the auth method suggested to
+	// clients which authenticate without specifying them, as configured on the
+	// controller. It is authorized by the read-login-metadata action on the
+	// global scope, so by default it can be called without authenticating.
+	DiscoverDefaults(ctx context.Context, in *DiscoverDefaultsRequest, opts ...grpc.CallOption) (*DiscoverDefaultsResponse, error)
 	// RotateKeys rotates and optionally rewraps all the keys found in the
 	// scope specified. If the scope is not found an error is returned. If
 	// the scope is empty, the global scope is used.
@@ -57,6 +70,16 @@ type ScopeServiceClient interface {
 	// existing data, it will start an asynchronous process to complete this operation
 	// before destroying the key. Use ListKeyVersionDestructionJobs to monitor pending destruction jobs.
 	DestroyKeyVersion(ctx context.Context, in *DestroyKeyVersionRequest, opts ...grpc.CallOption) (*DestroyKeyVersionResponse, error)
+	// AddEventSubscription subscribes the caller to the changes of a resource,
+	// or of all the resources of a type, in the scope specified. Notifications
+	// are only delivered for the resources the caller is allowed to read.
+	AddEventSubscription(ctx context.Context, in *AddEventSubscriptionRequest, opts ...grpc.CallOption) (*AddEventSubscriptionResponse, error)
+	// ListEventSubscriptions lists the caller's event subscriptions in the scope
+	// specified.
+	ListEventSubscriptions(ctx context.Context, in *ListEventSubscriptionsRequest, opts ...grpc.CallOption) (*ListEventSubscriptionsResponse, error)
+	// RemoveEventSubscription removes one of the caller's event subscriptions
+	// in the scope specified.
+	RemoveEventSubscription(ctx context.Context, in *RemoveEventSubscriptionRequest, opts ...grpc.CallOption) (*RemoveEventSubscriptionResponse, error)
 }
 
 type scopeServiceClient struct {
@@ -121,6 +144,33 @@ func (c *scopeServiceClient) ListKeys(ctx context.Context, in *ListKeysRequest,
 	return out, nil
 }
 
+func (c *scopeServiceClient) ListAuthorizedActions(ctx context.Context, in *ListAuthorizedActionsRequest, opts ...grpc.CallOption) (*ListAuthorizedActionsResponse, error) {
+	out := new(ListAuthorizedActionsResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ScopeService/ListAuthorizedActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scopeServiceClient) ReadLoginMetadata(ctx context.Context, in *ReadLoginMetadataRequest, opts ...grpc.CallOption) (*ReadLoginMetadataResponse, error) {
+	out := new(ReadLoginMetadataResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ScopeService/ReadLoginMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scopeServiceClient) DiscoverDefaults(ctx context.Context, in *DiscoverDefaultsRequest, opts ...grpc.CallOption) (*DiscoverDefaultsResponse, error) {
+	out := new(DiscoverDefaultsResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ScopeService/DiscoverDefaults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *scopeServiceClient) RotateKeys(ctx context.Context, in *RotateKeysRequest, opts ...grpc.CallOption) (*RotateKeysResponse, error) {
 	out := new(RotateKeysResponse)
 	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ScopeService/RotateKeys", in, out, opts...)
@@ -148,6 +198,33 @@ func (c *scopeServiceClient) DestroyKeyVersion(ctx context.Context, in *DestroyK
 	return out, nil
 }
 
+func (c *scopeServiceClient) AddEventSubscription(ctx context.Context, in *AddEventSubscriptionRequest, opts ...grpc.CallOption) (*AddEventSubscriptionResponse, error) {
+	out := new(AddEventSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ScopeService/AddEventSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scopeServiceClient) ListEventSubscriptions(ctx context.Context, in *ListEventSubscriptionsRequest, opts ...grpc.CallOption) (*ListEventSubscriptionsResponse, error) {
+	out := new(ListEventSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ScopeService/ListEventSubscriptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scopeServiceClient) RemoveEventSubscription(ctx context.Context, in *RemoveEventSubscriptionRequest, opts ...grpc.CallOption) (*RemoveEventSubscriptionResponse, error) {
+	out := new(RemoveEventSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ScopeService/RemoveEventSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ScopeServiceServer is the server API for ScopeService service.
 // All implementations must embed UnimplementedScopeServiceServer
 // for forward compatibility
@@ -181,6 +258,19 @@ type ScopeServiceServer interface {
 	// ListKeys lists all the keys found in the scope specified. If the scope
 	// is not found an error is returned.
 	ListKeys(context.Context, *ListKeysRequest) (*ListKeysResponse, error)
+	// ListAuthorizedActions returns the actions the caller is allowed to
+	// perform on each resource type in the scope specified.
+	ListAuthorizedActions(context.Context, *ListAuthorizedActionsRequest) (*ListAuthorizedActionsResponse, error)
+	// ReadLoginMetadata returns the information shown to users before they log
+	// in to the scope specified. If the scope is empty, the global scope is
+	// used. By default it is granted to the anonymous user, so it can be called
+	// without authenticating.
+	ReadLoginMetadata(context.Context, *ReadLoginMetadataRequest) (*ReadLoginMetadataResponse, error)
+	// DiscoverDefaults returns the scope and the auth method suggested to
+	// clients which authenticate without specifying them, as configured on the
+	// controller. It is authorized by the read-login-metadata action on the
+	// global scope, so by default it can be called without authenticating.
+	DiscoverDefaults(context.Context, *DiscoverDefaultsRequest) (*DiscoverDefaultsResponse, error)
 	// RotateKeys rotates and optionally rewraps all the keys found in the
 	// scope specified. If the scope is not found an error is returned. If
 	// the scope is empty, the global scope is used.
@@ -191,6 +281,16 @@ type ScopeServiceServer interface {
 	// existing data, it will start an asynchronous process to complete this operation
 	// before destroying the key. Use ListKeyVersionDestructionJobs to monitor pending destruction jobs.
 	DestroyKeyVersion(context.Context, *DestroyKeyVersionRequest) (*DestroyKeyVersionResponse, error)
+	// AddEventSubscription subscribes the caller to the changes of a resource,
+	// or of all the resources of a type, in the scope specified. Notifications
+	// are only delivered for the resources the caller is allowed to read.
+	AddEventSubscription(context.Context, *AddEventSubscriptionRequest) (*AddEventSubscriptionResponse, error)
+	// ListEventSubscriptions lists the caller's event subscriptions in the scope
+	// specified.
+	ListEventSubscriptions(context.Context, *ListEventSubscriptionsRequest) (*ListEventSubscriptionsResponse, error)
+	// RemoveEventSubscription removes one of the caller's event subscriptions
+	// in the scope specified.
+	RemoveEventSubscription(context.Context, *RemoveEventSubscriptionRequest) (*RemoveEventSubscriptionResponse, error)
 	mustEmbedUnimplementedScopeServiceServer()
 }
 
@@ -216,6 +316,15 @@ func (UnimplementedScopeServiceServer) DeleteScope(context.Context, *DeleteScope
 func (UnimplementedScopeServiceServer) ListKeys(context.Context, *ListKeysRequest) (*ListKeysResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListKeys not implemented")
 }
+func (UnimplementedScopeServiceServer) ListAuthorizedActions(context.Context, *ListAuthorizedActionsRequest) (*ListAuthorizedActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuthorizedActions not implemented")
+}
+func (UnimplementedScopeServiceServer) ReadLoginMetadata(context.Context, *ReadLoginMetadataRequest) (*ReadLoginMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadLoginMetadata not implemented")
+}
+func (UnimplementedScopeServiceServer) DiscoverDefaults(context.Context, *DiscoverDefaultsRequest) (*DiscoverDefaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscoverDefaults not implemented")
+}
 func (UnimplementedScopeServiceServer) RotateKeys(context.Context, *RotateKeysRequest) (*RotateKeysResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RotateKeys not implemented")
 }
@@ -225,6 +334,15 @@ func (UnimplementedScopeServiceServer) ListKeyVersionDestructionJobs(context.Con
 func (UnimplementedScopeServiceServer) DestroyKeyVersion(context.Context, *DestroyKeyVersionRequest) (*DestroyKeyVersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DestroyKeyVersion not implemented")
 }
+func (UnimplementedScopeServiceServer) AddEventSubscription(context.Context, *AddEventSubscriptionRequest) (*AddEventSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEventSubscription not implemented")
+}
+func (UnimplementedScopeServiceServer) ListEventSubscriptions(context.Context, *ListEventSubscriptionsRequest) (*ListEventSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEventSubscriptions not implemented")
+}
+func (UnimplementedScopeServiceServer) RemoveEventSubscription(context.Context, *RemoveEventSubscriptionRequest) (*RemoveEventSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveEventSubscription not implemented")
+}
 func (UnimplementedScopeServiceServer) mustEmbedUnimplementedScopeServiceServer() {}
 
 // UnsafeScopeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -346,6 +464,60 @@ func _ScopeService_ListKeys_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ScopeService_ListAuthorizedActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuthorizedActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScopeServiceServer).ListAuthorizedActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ScopeService/ListAuthorizedActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScopeServiceServer).ListAuthorizedActions(ctx, req.(*ListAuthorizedActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScopeService_ReadLoginMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadLoginMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScopeServiceServer).ReadLoginMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ScopeService/ReadLoginMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScopeServiceServer).ReadLoginMetadata(ctx, req.(*ReadLoginMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScopeService_DiscoverDefaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscoverDefaultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScopeServiceServer).DiscoverDefaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ScopeService/DiscoverDefaults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScopeServiceServer).DiscoverDefaults(ctx, req.(*DiscoverDefaultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ScopeService_RotateKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RotateKeysRequest)
 	if err := dec(in); err != nil {
@@ -400,6 +572,60 @@ func _ScopeService_DestroyKeyVersion_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ScopeService_AddEventSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddEventSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScopeServiceServer).AddEventSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ScopeService/AddEventSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScopeServiceServer).AddEventSubscription(ctx, req.(*AddEventSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScopeService_ListEventSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScopeServiceServer).ListEventSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ScopeService/ListEventSubscriptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScopeServiceServer).ListEventSubscriptions(ctx, req.(*ListEventSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScopeService_RemoveEventSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveEventSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScopeServiceServer).RemoveEventSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ScopeService/RemoveEventSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScopeServiceServer).RemoveEventSubscription(ctx, req.(*RemoveEventSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ScopeService_ServiceDesc is the grpc.ServiceDesc for ScopeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -431,6 +657,18 @@ var ScopeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListKeys",
 			Handler:    _ScopeService_ListKeys_Handler,
 		},
+		{
+			MethodName: "ListAuthorizedActions",
+			Handler:    _ScopeService_ListAuthorizedActions_Handler,
+		},
+		{
+			MethodName: "ReadLoginMetadata",
+			Handler:    _ScopeService_ReadLoginMetadata_Handler,
+		},
+		{
+			MethodName: "DiscoverDefaults",
+			Handler:    _ScopeService_DiscoverDefaults_Handler,
+		},
 		{
 			MethodName: "RotateKeys",
 			Handler:    _ScopeService_RotateKeys_Handler,
@@ -443,6 +681,18 @@ var ScopeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DestroyKeyVersion",
 			Handler:    _ScopeService_DestroyKeyVersion_Handler,
 		},
+		{
+			MethodName: "AddEventSubscription",
+			Handler:    _ScopeService_AddEventSubscription_Handler,
+		},
+		{
+			MethodName: "ListEventSubscriptions",
+			Handler:    _ScopeService_ListEventSubscriptions_Handler,
+		},
+		{
+			MethodName: "RemoveEventSubscription",
+			Handler:    _ScopeService_RemoveEventSubscription_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/scope_service.proto",
@@ -32,6 +32,20 @@ type SessionServiceClient interface {
 	// is returned if the request attempts to cancel a Session that does
 	// not exist.
 	CancelSession(ctx context.Context, in *CancelSessionRequest, opts ...grpc.CallOption) (*CancelSessionResponse, error)
+	// UploadSessionTranscript stores a terminal transcript captured by the
+	// client of a Session, for Sessions which cannot be recorded by a worker.
+	// The transcript is encrypted with a key of the org of the Session. Only the
+	// user of the Session can upload its transcript, and only once.
+	UploadSessionTranscript(ctx context.Context, in *UploadSessionTranscriptRequest, opts ...grpc.CallOption) (*UploadSessionTranscriptResponse, error)
+	// GetSessionTranscript returns the transcript uploaded by the client of a
+	// Session. The requester must be able to read the Sessions of all users in
+	// the project of the Session.
+	GetSessionTranscript(ctx context.Context, in *GetSessionTranscriptRequest, opts ...grpc.CallOption) (*GetSessionTranscriptResponse, error)
+	// ListMySessions returns the history of the terminated Sessions of the
+	// requesting user, with their duration, the bytes transferred and why they
+	// and their connections were closed. The history is kept after the Sessions
+	// are deleted. The requester must be able to list Sessions in the scope.
+	ListMySessions(ctx context.Context, in *ListMySessionsRequest, opts ...grpc.CallOption) (*ListMySessionsResponse, error)
 }
 
 type sessionServiceClient struct {
@@ -69,6 +83,33 @@ func (c *sessionServiceClient) CancelSession(ctx context.Context, in *CancelSess
 	return out, nil
 }
 
+func (c *sessionServiceClient) UploadSessionTranscript(ctx context.Context, in *UploadSessionTranscriptRequest, opts ...grpc.CallOption) (*UploadSessionTranscriptResponse, error) {
+	out := new(UploadSessionTranscriptResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.SessionService/UploadSessionTranscript", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) GetSessionTranscript(ctx context.Context, in *GetSessionTranscriptRequest, opts ...grpc.CallOption) (*GetSessionTranscriptResponse, error) {
+	out := new(GetSessionTranscriptResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.SessionService/GetSessionTranscript", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) ListMySessions(ctx context.Context, in *ListMySessionsRequest, opts ...grpc.CallOption) (*ListMySessionsResponse, error) {
+	out := new(ListMySessionsResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.SessionService/ListMySessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SessionServiceServer is the server API for SessionService service.
 // All implementations must embed UnimplementedSessionServiceServer
 // for forward compatibility
@@ -87,6 +128,20 @@ type SessionServiceServer interface {
 	// is returned if the request attempts to cancel a Session that does
 	// not exist.
 	CancelSession(context.Context, *CancelSessionRequest) (*CancelSessionResponse, error)
+	// UploadSessionTranscript stores a terminal transcript captured by the
+	// client of a Session, for Sessions which cannot be recorded by a worker.
+	// The transcript is encrypted with a key of the org of the Session. Only the
+	// user of the Session can upload its transcript, and only once.
+	UploadSessionTranscript(context.Context, *UploadSessionTranscriptRequest) (*UploadSessionTranscriptResponse, error)
+	// GetSessionTranscript returns the transcript uploaded by the client of a
+	// Session. The requester must be able to read the Sessions of all users in
+	// the project of the Session.
+	GetSessionTranscript(context.Context, *GetSessionTranscriptRequest) (*GetSessionTranscriptResponse, error)
+	// ListMySessions returns the history of the terminated Sessions of the
+	// requesting user, with their duration, the bytes transferred and why they
+	// and their connections were closed. The history is kept after the Sessions
+	// are deleted. The requester must be able to list Sessions in the scope.
+	ListMySessions(context.Context, *ListMySessionsRequest) (*ListMySessionsResponse, error)
 	mustEmbedUnimplementedSessionServiceServer()
 }
 
@@ -103,6 +158,15 @@ func (UnimplementedSessionServiceServer) ListSessions(context.Context, *ListSess
 func (UnimplementedSessionServiceServer) CancelSession(context.Context, *CancelSessionRequest) (*CancelSessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelSession not implemented")
 }
+func (UnimplementedSessionServiceServer) UploadSessionTranscript(context.Context, *UploadSessionTranscriptRequest) (*UploadSessionTranscriptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadSessionTranscript not implemented")
+}
+func (UnimplementedSessionServiceServer) GetSessionTranscript(context.Context, *GetSessionTranscriptRequest) (*GetSessionTranscriptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessionTranscript not implemented")
+}
+func (UnimplementedSessionServiceServer) ListMySessions(context.Context, *ListMySessionsRequest) (*ListMySessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMySessions not implemented")
+}
 func (UnimplementedSessionServiceServer) mustEmbedUnimplementedSessionServiceServer() {}
 
 // UnsafeSessionServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -170,6 +234,60 @@ func _SessionService_CancelSession_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SessionService_UploadSessionTranscript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadSessionTranscriptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).UploadSessionTranscript(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.SessionService/UploadSessionTranscript",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).UploadSessionTranscript(ctx, req.(*UploadSessionTranscriptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_GetSessionTranscript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionTranscriptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).GetSessionTranscript(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.SessionService/GetSessionTranscript",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).GetSessionTranscript(ctx, req.(*GetSessionTranscriptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_ListMySessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMySessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).ListMySessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.SessionService/ListMySessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).ListMySessions(ctx, req.(*ListMySessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SessionService_ServiceDesc is the grpc.ServiceDesc for SessionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -189,6 +307,18 @@ var SessionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelSession",
 			Handler:    _SessionService_CancelSession_Handler,
 		},
+		{
+			MethodName: "UploadSessionTranscript",
+			Handler:    _SessionService_UploadSessionTranscript_Handler,
+		},
+		{
+			MethodName: "GetSessionTranscript",
+			Handler:    _SessionService_GetSessionTranscript_Handler,
+		},
+		{
+			MethodName: "ListMySessions",
+			Handler:    _SessionService_ListMySessions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/session_service.proto",
@@ -48,6 +48,19 @@ type TargetServiceClient interface {
 	DeleteTarget(ctx context.Context, in *DeleteTargetRequest, opts ...grpc.CallOption) (*DeleteTargetResponse, error)
 	// AuthorizeSession creates authorization information from a given Target.
 	AuthorizeSession(ctx context.Context, in *AuthorizeSessionRequest, opts ...grpc.CallOption) (*AuthorizeSessionResponse, error)
+	// ListAvailableHosts lists the Hosts a Session authorized against the
+	// Target can currently connect to. This is the set of Hosts that a Host ID
+	// passed to AuthorizeSession is chosen from. If the requester cannot
+	// authorize Sessions against the Target, or the Target has an address
+	// instead of Host Sources, the list is empty.
+	ListAvailableHosts(ctx context.Context, in *ListAvailableHostsRequest, opts ...grpc.CallOption) (*ListAvailableHostsResponse, error)
+	// AddTargetFavorite adds a Target to the favorites of the requester, which
+	// can be listed by setting favorites in ListTargets. The requester must be
+	// able to read the Target.
+	AddTargetFavorite(ctx context.Context, in *AddTargetFavoriteRequest, opts ...grpc.CallOption) (*AddTargetFavoriteResponse, error)
+	// RemoveTargetFavorite removes a Target from the favorites of the
+	// requester. The requester must be able to read the Target.
+	RemoveTargetFavorite(ctx context.Context, in *RemoveTargetFavoriteRequest, opts ...grpc.CallOption) (*RemoveTargetFavoriteResponse, error)
 	// AddTargetHostSources adds Host Sources to this Target. The provided request
 	// must include the Target ID to which the Host Sources will be added. All
 	// Host Sources added to the provided Target must be a child of a Catalog that
@@ -97,6 +110,23 @@ type TargetServiceClient interface {
 	// Credential Source is attempted to be removed from the Target when the
 	// Target does not have the Credential Source.
 	RemoveTargetCredentialSources(ctx context.Context, in *RemoveTargetCredentialSourcesRequest, opts ...grpc.CallOption) (*RemoveTargetCredentialSourcesResponse, error)
+	// EnableTargetSshCertificateAuthority enables the SSH certificate authority
+	// Boundary manages for the Target. The certificate authority issues a
+	// client certificate for the provided username for each Session authorized
+	// against the Target, which is injected by the worker. Enabling the
+	// certificate authority of a Target which already has one updates the
+	// username and keeps its key. The Target must support injected application
+	// credentials.
+	EnableTargetSshCertificateAuthority(ctx context.Context, in *EnableTargetSshCertificateAuthorityRequest, opts ...grpc.CallOption) (*EnableTargetSshCertificateAuthorityResponse, error)
+	// DisableTargetSshCertificateAuthority deletes the SSH certificate
+	// authority of the Target. Sessions authorized afterwards are not issued
+	// client certificates.
+	DisableTargetSshCertificateAuthority(ctx context.Context, in *DisableTargetSshCertificateAuthorityRequest, opts ...grpc.CallOption) (*DisableTargetSshCertificateAuthorityResponse, error)
+	// SignTargetSshHostKey signs the host key of an endpoint of the Target
+	// with the SSH certificate authority of the Target, so clients which trust
+	// the certificate authority can authenticate the endpoint. An error is
+	// returned if the Target does not have an SSH certificate authority.
+	SignTargetSshHostKey(ctx context.Context, in *SignTargetSshHostKeyRequest, opts ...grpc.CallOption) (*SignTargetSshHostKeyResponse, error)
 }
 
 type targetServiceClient struct {
@@ -161,6 +191,33 @@ func (c *targetServiceClient) AuthorizeSession(ctx context.Context, in *Authoriz
 	return out, nil
 }
 
+func (c *targetServiceClient) ListAvailableHosts(ctx context.Context, in *ListAvailableHostsRequest, opts ...grpc.CallOption) (*ListAvailableHostsResponse, error) {
+	out := new(ListAvailableHostsResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.TargetService/ListAvailableHosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *targetServiceClient) AddTargetFavorite(ctx context.Context, in *AddTargetFavoriteRequest, opts ...grpc.CallOption) (*AddTargetFavoriteResponse, error) {
+	out := new(AddTargetFavoriteResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.TargetService/AddTargetFavorite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *targetServiceClient) RemoveTargetFavorite(ctx context.Context, in *RemoveTargetFavoriteRequest, opts ...grpc.CallOption) (*RemoveTargetFavoriteResponse, error) {
+	out := new(RemoveTargetFavoriteResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.TargetService/RemoveTargetFavorite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *targetServiceClient) AddTargetHostSources(ctx context.Context, in *AddTargetHostSourcesRequest, opts ...grpc.CallOption) (*AddTargetHostSourcesResponse, error) {
 	out := new(AddTargetHostSourcesResponse)
 	err := c.cc.Invoke(ctx, "/controller.api.services.v1.TargetService/AddTargetHostSources", in, out, opts...)
@@ -215,6 +272,33 @@ func (c *targetServiceClient) RemoveTargetCredentialSources(ctx context.Context,
 	return out, nil
 }
 
+func (c *targetServiceClient) EnableTargetSshCertificateAuthority(ctx context.Context, in *EnableTargetSshCertificateAuthorityRequest, opts ...grpc.CallOption) (*EnableTargetSshCertificateAuthorityResponse, error) {
+	out := new(EnableTargetSshCertificateAuthorityResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.TargetService/EnableTargetSshCertificateAuthority", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *targetServiceClient) DisableTargetSshCertificateAuthority(ctx context.Context, in *DisableTargetSshCertificateAuthorityRequest, opts ...grpc.CallOption) (*DisableTargetSshCertificateAuthorityResponse, error) {
+	out := new(DisableTargetSshCertificateAuthorityResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.TargetService/DisableTargetSshCertificateAuthority", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *targetServiceClient) SignTargetSshHostKey(ctx context.Context, in *SignTargetSshHostKeyRequest, opts ...grpc.CallOption) (*SignTargetSshHostKeyResponse, error) {
+	out := new(SignTargetSshHostKeyResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.TargetService/SignTargetSshHostKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TargetServiceServer is the server API for TargetService service.
 // All implementations must embed UnimplementedTargetServiceServer
 // for forward compatibility
@@ -249,6 +333,19 @@ type TargetServiceServer interface {
 	DeleteTarget(context.Context, *DeleteTargetRequest) (*DeleteTargetResponse, error)
 	// AuthorizeSession creates authorization information from a given Target.
 	AuthorizeSession(context.Context, *AuthorizeSessionRequest) (*AuthorizeSessionResponse, error)
+	// ListAvailableHosts lists the Hosts a Session authorized against the
+	// Target can currently connect to. This is the set of Hosts that a Host ID
+	// passed to AuthorizeSession is chosen from. If the requester cannot
+	// authorize Sessions against the Target, or the Target has an address
+	// instead of Host Sources, the list is empty.
+	ListAvailableHosts(context.Context, *ListAvailableHostsRequest) (*ListAvailableHostsResponse, error)
+	// AddTargetFavorite adds a Target to the favorites of the requester, which
+	// can be listed by setting favorites in ListTargets. The requester must be
+	// able to read the Target.
+	AddTargetFavorite(context.Context, *AddTargetFavoriteRequest) (*AddTargetFavoriteResponse, error)
+	// RemoveTargetFavorite removes a Target from the favorites of the
+	// requester. The requester must be able to read the Target.
+	RemoveTargetFavorite(context.Context, *RemoveTargetFavoriteRequest) (*RemoveTargetFavoriteResponse, error)
 	// AddTargetHostSources adds Host Sources to this Target. The provided request
 	// must include the Target ID to which the Host Sources will be added. All
 	// Host Sources added to the provided Target must be a child of a Catalog that
@@ -298,6 +395,23 @@ type TargetServiceServer interface {
 	// Credential Source is attempted to be removed from the Target when the
 	// Target does not have the Credential Source.
 	RemoveTargetCredentialSources(context.Context, *RemoveTargetCredentialSourcesRequest) (*RemoveTargetCredentialSourcesResponse, error)
+	// EnableTargetSshCertificateAuthority enables the SSH certificate authority
+	// Boundary manages for the Target. The certificate authority issues a
+	// client certificate for the provided username for each Session authorized
+	// against the Target, which is injected by the worker. Enabling the
+	// certificate authority of a Target which already has one updates the
+	// username and keeps its key. The Target must support injected application
+	// credentials.
+	EnableTargetSshCertificateAuthority(context.Context, *EnableTargetSshCertificateAuthorityRequest) (*EnableTargetSshCertificateAuthorityResponse, error)
+	// DisableTargetSshCertificateAuthority deletes the SSH certificate
+	// authority of the Target. Sessions authorized afterwards are not issued
+	// client certificates.
+	DisableTargetSshCertificateAuthority(context.Context, *DisableTargetSshCertificateAuthorityRequest) (*DisableTargetSshCertificateAuthorityResponse, error)
+	// SignTargetSshHostKey signs the host key of an endpoint of the Target
+	// with the SSH certificate authority of the Target, so clients which trust
+	// the certificate authority can authenticate the endpoint. An error is
+	// returned if the Target does not have an SSH certificate authority.
+	SignTargetSshHostKey(context.Context, *SignTargetSshHostKeyRequest) (*SignTargetSshHostKeyResponse, error)
 	mustEmbedUnimplementedTargetServiceServer()
 }
 
@@ -323,6 +437,15 @@ func (UnimplementedTargetServiceServer) DeleteTarget(context.Context, *DeleteTar
 func (UnimplementedTargetServiceServer) AuthorizeSession(context.Context, *AuthorizeSessionRequest) (*AuthorizeSessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeSession not implemented")
 }
+func (UnimplementedTargetServiceServer) ListAvailableHosts(context.Context, *ListAvailableHostsRequest) (*ListAvailableHostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAvailableHosts not implemented")
+}
+func (UnimplementedTargetServiceServer) AddTargetFavorite(context.Context, *AddTargetFavoriteRequest) (*AddTargetFavoriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTargetFavorite not implemented")
+}
+func (UnimplementedTargetServiceServer) RemoveTargetFavorite(context.Context, *RemoveTargetFavoriteRequest) (*RemoveTargetFavoriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTargetFavorite not implemented")
+}
 func (UnimplementedTargetServiceServer) AddTargetHostSources(context.Context, *AddTargetHostSourcesRequest) (*AddTargetHostSourcesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddTargetHostSources not implemented")
 }
@@ -341,6 +464,15 @@ func (UnimplementedTargetServiceServer) SetTargetCredentialSources(context.Conte
 func (UnimplementedTargetServiceServer) RemoveTargetCredentialSources(context.Context, *RemoveTargetCredentialSourcesRequest) (*RemoveTargetCredentialSourcesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveTargetCredentialSources not implemented")
 }
+func (UnimplementedTargetServiceServer) EnableTargetSshCertificateAuthority(context.Context, *EnableTargetSshCertificateAuthorityRequest) (*EnableTargetSshCertificateAuthorityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableTargetSshCertificateAuthority not implemented")
+}
+func (UnimplementedTargetServiceServer) DisableTargetSshCertificateAuthority(context.Context, *DisableTargetSshCertificateAuthorityRequest) (*DisableTargetSshCertificateAuthorityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTargetSshCertificateAuthority not implemented")
+}
+func (UnimplementedTargetServiceServer) SignTargetSshHostKey(context.Context, *SignTargetSshHostKeyRequest) (*SignTargetSshHostKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignTargetSshHostKey not implemented")
+}
 func (UnimplementedTargetServiceServer) mustEmbedUnimplementedTargetServiceServer() {}
 
 // UnsafeTargetServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -462,6 +594,60 @@ func _TargetService_AuthorizeSession_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TargetService_ListAvailableHosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAvailableHostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TargetServiceServer).ListAvailableHosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.TargetService/ListAvailableHosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TargetServiceServer).ListAvailableHosts(ctx, req.(*ListAvailableHostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TargetService_AddTargetFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTargetFavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TargetServiceServer).AddTargetFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.TargetService/AddTargetFavorite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TargetServiceServer).AddTargetFavorite(ctx, req.(*AddTargetFavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TargetService_RemoveTargetFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTargetFavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TargetServiceServer).RemoveTargetFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.TargetService/RemoveTargetFavorite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TargetServiceServer).RemoveTargetFavorite(ctx, req.(*RemoveTargetFavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TargetService_AddTargetHostSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddTargetHostSourcesRequest)
 	if err := dec(in); err != nil {
@@ -570,6 +756,60 @@ func _TargetService_RemoveTargetCredentialSources_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TargetService_EnableTargetSshCertificateAuthority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableTargetSshCertificateAuthorityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TargetServiceServer).EnableTargetSshCertificateAuthority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.TargetService/EnableTargetSshCertificateAuthority",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TargetServiceServer).EnableTargetSshCertificateAuthority(ctx, req.(*EnableTargetSshCertificateAuthorityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TargetService_DisableTargetSshCertificateAuthority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTargetSshCertificateAuthorityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TargetServiceServer).DisableTargetSshCertificateAuthority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.TargetService/DisableTargetSshCertificateAuthority",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TargetServiceServer).DisableTargetSshCertificateAuthority(ctx, req.(*DisableTargetSshCertificateAuthorityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TargetService_SignTargetSshHostKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignTargetSshHostKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TargetServiceServer).SignTargetSshHostKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.TargetService/SignTargetSshHostKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TargetServiceServer).SignTargetSshHostKey(ctx, req.(*SignTargetSshHostKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TargetService_ServiceDesc is the grpc.ServiceDesc for TargetService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -601,6 +841,18 @@ var TargetService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AuthorizeSession",
 			Handler:    _TargetService_AuthorizeSession_Handler,
 		},
+		{
+			MethodName: "ListAvailableHosts",
+			Handler:    _TargetService_ListAvailableHosts_Handler,
+		},
+		{
+			MethodName: "AddTargetFavorite",
+			Handler:    _TargetService_AddTargetFavorite_Handler,
+		},
+		{
+			MethodName: "RemoveTargetFavorite",
+			Handler:    _TargetService_RemoveTargetFavorite_Handler,
+		},
 		{
 			MethodName: "AddTargetHostSources",
 			Handler:    _TargetService_AddTargetHostSources_Handler,
@@ -625,6 +877,18 @@ var TargetService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveTargetCredentialSources",
 			Handler:    _TargetService_RemoveTargetCredentialSources_Handler,
 		},
+		{
+			MethodName: "EnableTargetSshCertificateAuthority",
+			Handler:    _TargetService_EnableTargetSshCertificateAuthority_Handler,
+		},
+		{
+			MethodName: "DisableTargetSshCertificateAuthority",
+			Handler:    _TargetService_DisableTargetSshCertificateAuthority_Handler,
+		},
+		{
+			MethodName: "SignTargetSshHostKey",
+			Handler:    _TargetService_SignTargetSshHostKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/target_service.proto",
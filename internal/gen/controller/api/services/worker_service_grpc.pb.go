@@ -68,6 +68,10 @@ type WorkerServiceClient interface {
 	ReadCertificateAuthority(ctx context.Context, in *ReadCertificateAuthorityRequest, opts ...grpc.CallOption) (*ReadCertificateAuthorityResponse, error)
 	// ReinitializeCas removes both current and next root certs and replaces them with a new set
 	ReinitializeCertificateAuthority(ctx context.Context, in *ReinitializeCertificateAuthorityRequest, opts ...grpc.CallOption) (*ReinitializeCertificateAuthorityResponse, error)
+	// TestWorkerFilter evaluates a worker filter against the workers which are
+	// currently connected to the controllers and returns the ones which match,
+	// so that a filter can be validated before it is set on a target.
+	TestWorkerFilter(ctx context.Context, in *TestWorkerFilterRequest, opts ...grpc.CallOption) (*TestWorkerFilterResponse, error)
 }
 
 type workerServiceClient struct {
@@ -177,6 +181,15 @@ func (c *workerServiceClient) ReinitializeCertificateAuthority(ctx context.Conte
 	return out, nil
 }
 
+func (c *workerServiceClient) TestWorkerFilter(ctx context.Context, in *TestWorkerFilterRequest, opts ...grpc.CallOption) (*TestWorkerFilterResponse, error) {
+	out := new(TestWorkerFilterResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.WorkerService/TestWorkerFilter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkerServiceServer is the server API for WorkerService service.
 // All implementations must embed UnimplementedWorkerServiceServer
 // for forward compatibility
@@ -231,6 +244,10 @@ type WorkerServiceServer interface {
 	ReadCertificateAuthority(context.Context, *ReadCertificateAuthorityRequest) (*ReadCertificateAuthorityResponse, error)
 	// ReinitializeCas removes both current and next root certs and replaces them with a new set
 	ReinitializeCertificateAuthority(context.Context, *ReinitializeCertificateAuthorityRequest) (*ReinitializeCertificateAuthorityResponse, error)
+	// TestWorkerFilter evaluates a worker filter against the workers which are
+	// currently connected to the controllers and returns the ones which match,
+	// so that a filter can be validated before it is set on a target.
+	TestWorkerFilter(context.Context, *TestWorkerFilterRequest) (*TestWorkerFilterResponse, error)
 	mustEmbedUnimplementedWorkerServiceServer()
 }
 
@@ -271,6 +288,9 @@ func (UnimplementedWorkerServiceServer) ReadCertificateAuthority(context.Context
 func (UnimplementedWorkerServiceServer) ReinitializeCertificateAuthority(context.Context, *ReinitializeCertificateAuthorityRequest) (*ReinitializeCertificateAuthorityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReinitializeCertificateAuthority not implemented")
 }
+func (UnimplementedWorkerServiceServer) TestWorkerFilter(context.Context, *TestWorkerFilterRequest) (*TestWorkerFilterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestWorkerFilter not implemented")
+}
 func (UnimplementedWorkerServiceServer) mustEmbedUnimplementedWorkerServiceServer() {}
 
 // UnsafeWorkerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -482,6 +502,24 @@ func _WorkerService_ReinitializeCertificateAuthority_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkerService_TestWorkerFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestWorkerFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServiceServer).TestWorkerFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.WorkerService/TestWorkerFilter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServiceServer).TestWorkerFilter(ctx, req.(*TestWorkerFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WorkerService_ServiceDesc is the grpc.ServiceDesc for WorkerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -533,6 +571,10 @@ var WorkerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReinitializeCertificateAuthority",
 			Handler:    _WorkerService_ReinitializeCertificateAuthority_Handler,
 		},
+		{
+			MethodName: "TestWorkerFilter",
+			Handler:    _WorkerService_TestWorkerFilter_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/worker_service.proto",
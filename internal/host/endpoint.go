@@ -9,4 +9,7 @@ type Endpoint struct {
 	HostId  string
 	SetId   string
 	Address string
+
+	// Name is the name of the host, if it has one.
+	Name string
 }
@@ -57,3 +57,7 @@ func (tpc *WrappingPluginClient) OnDeleteSet(ctx context.Context, req *plgpb.OnD
 func (tpc *WrappingPluginClient) ListHosts(ctx context.Context, req *plgpb.ListHostsRequest, opts ...grpc.CallOption) (*plgpb.ListHostsResponse, error) {
 	return tpc.Server.ListHosts(ctx, req)
 }
+
+func (tpc *WrappingPluginClient) ResolveHostPort(ctx context.Context, req *plgpb.ResolveHostPortRequest, opts ...grpc.CallOption) (*plgpb.ResolveHostPortResponse, error) {
+	return tpc.Server.ResolveHostPort(ctx, req)
+}
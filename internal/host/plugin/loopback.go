@@ -31,6 +31,7 @@ type loopbackPluginHostInfo struct {
 	ExternalId  string   `mapstructure:"external_id"`
 	IpAddresses []string `mapstructure:"ip_addresses"`
 	DnsNames    []string `mapstructure:"dns_names"`
+	Port        uint32   `mapstructure:"port"`
 }
 
 // NewLoopbackPlugin returns a new loopback plugin
@@ -45,6 +46,7 @@ func NewLoopbackPlugin() plgpb.HostPluginServiceServer {
 	ret.OnUpdateSetFn = ret.onUpdateSet
 	ret.OnDeleteSetFn = ret.onDeleteSet
 	ret.ListHostsFn = ret.listHosts
+	ret.ResolveHostPortFn = ret.resolveHostPort
 	return ret
 }
 
@@ -191,3 +193,16 @@ func (l *loopbackPlugin) listHosts(ctx context.Context, req *plgpb.ListHostsRequ
 	}
 	return resp, nil
 }
+
+func (l *loopbackPlugin) resolveHostPort(ctx context.Context, req *plgpb.ResolveHostPortRequest) (*plgpb.ResolveHostPortResponse, error) {
+	const op = "plugin.(loopbackPlugin).resolveHostPort"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "req is nil")
+	}
+	for _, host := range l.hostMap[req.GetSet().GetId()] {
+		if host.ExternalId == req.GetExternalId() {
+			return &plgpb.ResolveHostPortResponse{Port: host.Port}, nil
+		}
+	}
+	return &plgpb.ResolveHostPortResponse{}, nil
+}
@@ -371,3 +371,44 @@ func TestLoopbackPluginArrays(t *testing.T) {
 		})
 	}
 }
+
+func TestLoopbackPlugin_ResolveHostPort(t *testing.T) {
+	require, assert := tr.New(t), ta.New(t)
+	ctx := context.Background()
+
+	plg := NewLoopbackPlugin()
+	attrs, err := structpb.NewStruct(map[string]any{
+		loopbackPluginHostInfoAttrField: []any{
+			map[string]any{
+				"external_id":  "host1",
+				"ip_addresses": []any{"1.2.3.4"},
+				"port":         5432,
+			},
+			map[string]any{
+				"external_id":  "host2",
+				"ip_addresses": []any{"2.3.4.5"},
+			},
+		},
+	})
+	require.NoError(err)
+	set := &hostsets.HostSet{
+		Id: "set1",
+		Attrs: &hostsets.HostSet_Attributes{
+			Attributes: attrs,
+		},
+	}
+	_, err = plg.OnCreateSet(ctx, &plgpb.OnCreateSetRequest{Set: set})
+	require.NoError(err)
+
+	resp, err := plg.ResolveHostPort(ctx, &plgpb.ResolveHostPortRequest{Set: set, ExternalId: "host1", DefaultPort: 22})
+	require.NoError(err)
+	assert.Equal(uint32(5432), resp.GetPort())
+
+	resp, err = plg.ResolveHostPort(ctx, &plgpb.ResolveHostPortRequest{Set: set, ExternalId: "host2", DefaultPort: 22})
+	require.NoError(err)
+	assert.Zero(resp.GetPort())
+
+	resp, err = plg.ResolveHostPort(ctx, &plgpb.ResolveHostPortRequest{Set: set, ExternalId: "unknown", DefaultPort: 22})
+	require.NoError(err)
+	assert.Zero(resp.GetPort())
+}
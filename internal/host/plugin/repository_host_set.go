@@ -6,6 +6,7 @@ package plugin
 import (
 	"context"
 	"fmt"
+	"math"
 	"strings"
 	"time"
 
@@ -863,9 +864,78 @@ func (r *Repository) Endpoints(ctx context.Context, setIds []string) ([]*host.En
 				HostId:  h.GetPublicId(),
 				SetId:   sId,
 				Address: addr,
+				Name:    h.GetName(),
 			})
 		}
 	}
 
 	return es, nil
 }
+
+// ResolvePort returns the port a session to the endpoint, which must be a
+// host of a set in this repository, connects to. The plugin of the host's
+// catalog is asked for the port, so that it can be discovered on platforms
+// where it is assigned dynamically. If the plugin does not implement port
+// resolution, or returns a port of 0, defaultPort is returned.
+func (r *Repository) ResolvePort(ctx context.Context, ep *host.Endpoint, defaultPort uint32) (uint32, error) {
+	const op = "plugin.(Repository).ResolvePort"
+	switch {
+	case ep == nil:
+		return 0, errors.New(ctx, errors.InvalidParameter, op, "no endpoint")
+	case ep.HostId == "":
+		return 0, errors.New(ctx, errors.InvalidParameter, op, "no host id")
+	case ep.SetId == "":
+		return 0, errors.New(ctx, errors.InvalidParameter, op, "no set id")
+	}
+
+	sa := &hostSetAgg{PublicId: ep.SetId}
+	if err := r.reader.LookupByPublicId(ctx, sa); err != nil {
+		return 0, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("can't retrieve set %q", ep.SetId)))
+	}
+	plgClient, ok := r.plugins[sa.PluginId]
+	if !ok || plgClient == nil {
+		return 0, errors.New(ctx, errors.Internal, op, fmt.Sprintf("expected plugin %q not available", sa.PluginId))
+	}
+	s, err := sa.toHostSet(ctx)
+	if err != nil {
+		return 0, errors.Wrap(ctx, err, op)
+	}
+	plgSet, err := toPluginSet(ctx, s)
+	if err != nil {
+		return 0, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("converting set %q to plugin set", s.GetPublicId())))
+	}
+
+	ha := &hostAgg{PublicId: ep.HostId}
+	if err := r.reader.LookupByPublicId(ctx, ha); err != nil {
+		return 0, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("can't retrieve host %q", ep.HostId)))
+	}
+
+	c, persisted, err := r.getCatalog(ctx, s.GetCatalogId())
+	if err != nil {
+		return 0, errors.Wrap(ctx, err, op)
+	}
+	plgHc, err := toPluginCatalog(ctx, c)
+	if err != nil {
+		return 0, errors.Wrap(ctx, err, op)
+	}
+
+	resp, err := plgClient.ResolveHostPort(ctx, &plgpb.ResolveHostPortRequest{
+		Catalog:     plgHc,
+		Set:         plgSet,
+		ExternalId:  ha.ExternalId,
+		Address:     ep.Address,
+		DefaultPort: defaultPort,
+		Persisted:   persisted,
+	})
+	switch {
+	case status.Code(err) == codes.Unimplemented:
+		return defaultPort, nil
+	case err != nil:
+		return 0, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("resolving port of host %q", ep.HostId)))
+	case resp.GetPort() == 0:
+		return defaultPort, nil
+	case resp.GetPort() > math.MaxUint16:
+		return 0, errors.New(ctx, errors.Internal, op, fmt.Sprintf("plugin returned invalid port %d for host %q", resp.GetPort(), ep.HostId))
+	}
+	return resp.GetPort(), nil
+}
@@ -1546,6 +1546,110 @@ func TestRepository_Endpoints(t *testing.T) {
 	}
 }
 
+func TestRepository_ResolvePort(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	sched := scheduler.TestScheduler(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iamRepo)
+
+	dynamicPlg := hostplg.TestPlugin(t, conn, "dynamic")
+	staticPlg := hostplg.TestPlugin(t, conn, "unimplemented")
+	var gotReq *plgpb.ResolveHostPortRequest
+	plgm := map[string]plgpb.HostPluginServiceClient{
+		dynamicPlg.GetPublicId(): NewWrappingPluginClient(&TestPluginServer{
+			ResolveHostPortFn: func(_ context.Context, req *plgpb.ResolveHostPortRequest) (*plgpb.ResolveHostPortResponse, error) {
+				gotReq = req
+				switch req.GetExternalId() {
+				case "unknown":
+					return &plgpb.ResolveHostPortResponse{}, nil
+				case "invalid":
+					return &plgpb.ResolveHostPortResponse{Port: 70000}, nil
+				}
+				return &plgpb.ResolveHostPortResponse{Port: 5432}, nil
+			},
+		}),
+		staticPlg.GetPublicId(): NewWrappingPluginClient(&TestPluginServer{}),
+	}
+
+	dynamicCatalog := TestCatalog(t, conn, prj.PublicId, dynamicPlg.GetPublicId())
+	dynamicSet := TestSet(t, conn, kms, sched, dynamicCatalog, plgm)
+	dynamicHost := TestHost(t, conn, dynamicCatalog.GetPublicId(), "dynamic")
+	unknownHost := TestHost(t, conn, dynamicCatalog.GetPublicId(), "unknown")
+	invalidHost := TestHost(t, conn, dynamicCatalog.GetPublicId(), "invalid")
+	TestSetMembers(t, conn, dynamicSet.GetPublicId(), []*Host{dynamicHost, unknownHost, invalidHost})
+
+	staticCatalog := TestCatalog(t, conn, prj.PublicId, staticPlg.GetPublicId())
+	staticSet := TestSet(t, conn, kms, sched, staticCatalog, plgm)
+	staticHost := TestHost(t, conn, staticCatalog.GetPublicId(), "static")
+	TestSetMembers(t, conn, staticSet.GetPublicId(), []*Host{staticHost})
+
+	repo, err := NewRepository(rw, rw, kms, sched, plgm)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		ep        *host.Endpoint
+		want      uint32
+		wantIsErr errors.Code
+	}{
+		{
+			name:      "nil-endpoint",
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "no-set-id",
+			ep:        &host.Endpoint{HostId: dynamicHost.GetPublicId()},
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name: "resolved",
+			ep:   &host.Endpoint{HostId: dynamicHost.GetPublicId(), SetId: dynamicSet.GetPublicId(), Address: "10.0.0.5"},
+			want: 5432,
+		},
+		{
+			name: "plugin-returns-zero",
+			ep:   &host.Endpoint{HostId: unknownHost.GetPublicId(), SetId: dynamicSet.GetPublicId(), Address: "10.0.0.6"},
+			want: 22,
+		},
+		{
+			name:      "plugin-returns-invalid",
+			ep:        &host.Endpoint{HostId: invalidHost.GetPublicId(), SetId: dynamicSet.GetPublicId(), Address: "10.0.0.7"},
+			wantIsErr: errors.Internal,
+		},
+		{
+			name: "unimplemented",
+			ep:   &host.Endpoint{HostId: staticHost.GetPublicId(), SetId: staticSet.GetPublicId(), Address: "10.0.0.8"},
+			want: 22,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := repo.ResolvePort(ctx, tt.ep, 22)
+			if tt.wantIsErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantIsErr), err), "want err: %q got: %q", tt.wantIsErr, err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, got)
+		})
+	}
+
+	_, err = repo.ResolvePort(ctx, &host.Endpoint{HostId: dynamicHost.GetPublicId(), SetId: dynamicSet.GetPublicId(), Address: "10.0.0.5"}, 22)
+	require.NoError(t, err)
+	require.NotNil(t, gotReq)
+	assert.Equal(t, dynamicCatalog.GetPublicId(), gotReq.GetCatalog().GetId())
+	assert.Equal(t, dynamicSet.GetPublicId(), gotReq.GetSet().GetId())
+	assert.Equal(t, "dynamic", gotReq.GetExternalId())
+	assert.Equal(t, "10.0.0.5", gotReq.GetAddress())
+	assert.Equal(t, uint32(22), gotReq.GetDefaultPort())
+}
+
 func TestRepository_ListSets(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
 	rw := db.New(conn)
@@ -245,6 +245,7 @@ type TestPluginServer struct {
 	OnUpdateSetFn          func(context.Context, *plgpb.OnUpdateSetRequest) (*plgpb.OnUpdateSetResponse, error)
 	OnDeleteSetFn          func(context.Context, *plgpb.OnDeleteSetRequest) (*plgpb.OnDeleteSetResponse, error)
 	ListHostsFn            func(context.Context, *plgpb.ListHostsRequest) (*plgpb.ListHostsResponse, error)
+	ResolveHostPortFn      func(context.Context, *plgpb.ResolveHostPortRequest) (*plgpb.ResolveHostPortResponse, error)
 	plgpb.UnimplementedHostPluginServiceServer
 }
 
@@ -310,3 +311,10 @@ func (t TestPluginServer) ListHosts(ctx context.Context, req *plgpb.ListHostsReq
 	}
 	return t.ListHostsFn(ctx, req)
 }
+
+func (t TestPluginServer) ResolveHostPort(ctx context.Context, req *plgpb.ResolveHostPortRequest) (*plgpb.ResolveHostPortResponse, error) {
+	if t.ResolveHostPortFn == nil {
+		return t.UnimplementedHostPluginServiceServer.ResolveHostPort(ctx, req)
+	}
+	return t.ResolveHostPortFn(ctx, req)
+}
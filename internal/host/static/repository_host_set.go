@@ -202,6 +202,7 @@ func (r *Repository) Endpoints(ctx context.Context, setId string) ([]*host.Endpo
 			HostId:  h.GetPublicId(),
 			SetId:   setId,
 			Address: h.GetAddress(),
+			Name:    h.GetName(),
 		})
 	}
 	return es, err
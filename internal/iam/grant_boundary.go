@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/types/scope"
+)
+
+// validateGrantBoundaries ensures that the grants being added to a role in
+// roleScope are covered by the grants of the boundary roles in each of
+// roleScope's ancestor scopes. Ancestor scopes without boundary roles place no
+// restriction on the grants. An errors.Forbidden error is returned for the
+// first grant that exceeds a boundary. The reader is used to look up the
+// boundary roles, so it can be called within a transaction.
+func validateGrantBoundaries(ctx context.Context, reader db.Reader, roleScope *Scope, grants []string) error {
+	const op = "iam.validateGrantBoundaries"
+	switch {
+	case reader == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing reader")
+	case roleScope == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing role scope")
+	}
+	if len(grants) == 0 {
+		return nil
+	}
+	var ancestorScopeIds []string
+	switch roleScope.GetType() {
+	case scope.Project.String():
+		ancestorScopeIds = []string{roleScope.GetParentId(), scope.Global.String()}
+	case scope.Org.String():
+		ancestorScopeIds = []string{scope.Global.String()}
+	default:
+		return nil
+	}
+
+	var boundaryRoles []*Role
+	if err := reader.SearchWhere(ctx, &boundaryRoles, "scope_id in (?) and grant_boundary", []any{ancestorScopeIds}); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to search for boundary roles"))
+	}
+	if len(boundaryRoles) == 0 {
+		return nil
+	}
+	roleScopeIds := make(map[string]string, len(boundaryRoles))
+	roleIds := make([]string, 0, len(boundaryRoles))
+	for _, br := range boundaryRoles {
+		roleScopeIds[br.GetPublicId()] = br.GetScopeId()
+		roleIds = append(roleIds, br.GetPublicId())
+	}
+	var boundaryRoleGrants []*RoleGrant
+	if err := reader.SearchWhere(ctx, &boundaryRoleGrants, "role_id in (?)", []any{roleIds}); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to search for boundary role grants"))
+	}
+	// A boundary role with no grants still bounds its scope's descendants to
+	// nothing, so make sure every scope with a boundary role has an entry
+	boundaries := make(map[string][]perms.Grant, len(ancestorScopeIds))
+	for _, br := range boundaryRoles {
+		if _, ok := boundaries[br.GetScopeId()]; !ok {
+			boundaries[br.GetScopeId()] = []perms.Grant{}
+		}
+	}
+	for _, rg := range boundaryRoleGrants {
+		scopeId := roleScopeIds[rg.GetRoleId()]
+		g, err := perms.Parse(scopeId, rg.GetCanonicalGrant(), perms.WithSkipFinalValidation(true))
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to parse boundary role grant"))
+		}
+		boundaries[scopeId] = append(boundaries[scopeId], g)
+	}
+
+	for _, grant := range grants {
+		g, err := perms.Parse(roleScope.GetPublicId(), grant, perms.WithSkipFinalValidation(true))
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("error parsing grant string"))
+		}
+		for _, scopeId := range ancestorScopeIds {
+			b, ok := boundaries[scopeId]
+			if !ok {
+				continue
+			}
+			if !g.CoveredBy(b) {
+				return errors.New(ctx, errors.Forbidden, op, fmt.Sprintf("grant %q exceeds the grant boundary of scope %s", grant, scopeId))
+			}
+		}
+	}
+	return nil
+}
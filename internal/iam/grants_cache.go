@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/perms"
+)
+
+// DefaultGrantsCacheMaxEntries is the number of users whose grants are cached
+// when no maximum is given.
+const DefaultGrantsCacheMaxEntries = 10000
+
+// GrantsCache is an in-memory cache of the grants resolved for users. Every
+// entry records the grants version it was resolved at and is only used while
+// that is still the current version. The grants version is incremented by the
+// database whenever a role, grant, principal or membership which can alter a
+// user's grants changes, so a change made through any controller invalidates
+// the entries of all controllers. Changes which can only alter the grants of
+// one user, such as a change to the user's memberships, only invalidate the
+// entry of that user.
+//
+// A GrantsCache is safe for concurrent use and is meant to be shared by all of
+// the repositories created by a controller.
+type GrantsCache struct {
+	maxEntries int
+
+	mu      sync.RWMutex
+	entries map[string]grantsCacheEntry
+}
+
+type grantsCacheEntry struct {
+	version grantsVersion
+	grants  []perms.GrantTuple
+}
+
+// grantsVersion is the version of the grants of a user. global is the iam
+// grants version, which is incremented when the grants of any number of users
+// may have changed, and user is the user's own grants version, which is
+// incremented when only the grants of the user may have changed.
+type grantsVersion struct {
+	global uint64
+	user   uint64
+}
+
+// newerThan returns true if v is a later version than o.
+func (v grantsVersion) newerThan(o grantsVersion) bool {
+	if v.global != o.global {
+		return v.global > o.global
+	}
+	return v.user > o.user
+}
+
+// NewGrantsCache creates a GrantsCache which holds the grants of at most
+// maxEntries users. If maxEntries is zero, DefaultGrantsCacheMaxEntries is
+// used.
+func NewGrantsCache(ctx context.Context, maxEntries int) (*GrantsCache, error) {
+	const op = "iam.NewGrantsCache"
+	switch {
+	case maxEntries < 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "max entries is negative")
+	case maxEntries == 0:
+		maxEntries = DefaultGrantsCacheMaxEntries
+	}
+	return &GrantsCache{
+		maxEntries: maxEntries,
+		entries:    make(map[string]grantsCacheEntry),
+	}, nil
+}
+
+// get returns the grants cached for the user if they were resolved at the
+// given grants version.
+func (c *GrantsCache) get(userId string, version grantsVersion) ([]perms.GrantTuple, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	e, ok := c.entries[userId]
+	if !ok || e.version != version {
+		return nil, false
+	}
+	return append([]perms.GrantTuple(nil), e.grants...), true
+}
+
+// set caches the grants resolved for the user at the given grants version.
+// When the cache is full, entries resolved at an older iam grants version are
+// removed first, then arbitrary entries until there is room for the new one.
+func (c *GrantsCache) set(userId string, version grantsVersion, grants []perms.GrantTuple) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[userId]; ok && e.version.newerThan(version) {
+		// a newer version has already been cached by a concurrent request
+		return
+	}
+	if _, ok := c.entries[userId]; !ok && len(c.entries) >= c.maxEntries {
+		for id, e := range c.entries {
+			if e.version.global < version.global {
+				delete(c.entries, id)
+			}
+		}
+		for id := range c.entries {
+			if len(c.entries) < c.maxEntries {
+				break
+			}
+			delete(c.entries, id)
+		}
+	}
+	c.entries[userId] = grantsCacheEntry{
+		version: version,
+		grants:  append([]perms.GrantTuple(nil), grants...),
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGrantsCache(t *testing.T) {
+	ctx := context.Background()
+	c, err := NewGrantsCache(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultGrantsCacheMaxEntries, c.maxEntries)
+
+	c, err = NewGrantsCache(ctx, 5)
+	require.NoError(t, err)
+	assert.Equal(t, 5, c.maxEntries)
+
+	_, err = NewGrantsCache(ctx, -1)
+	require.Error(t, err)
+}
+
+func TestGrantsCache(t *testing.T) {
+	ctx := context.Background()
+	grants := []perms.GrantTuple{{RoleId: "r_1234567890", ScopeId: "global", Grant: "id=*;type=*;actions=read"}}
+	v := func(global, user uint64) grantsVersion {
+		return grantsVersion{global: global, user: user}
+	}
+
+	t.Run("version", func(t *testing.T) {
+		c, err := NewGrantsCache(ctx, 0)
+		require.NoError(t, err)
+		_, ok := c.get("u_1234567890", v(1, 0))
+		assert.False(t, ok)
+
+		c.set("u_1234567890", v(1, 0), grants)
+		got, ok := c.get("u_1234567890", v(1, 0))
+		require.True(t, ok)
+		assert.Equal(t, grants, got)
+
+		// modifying the returned grants does not modify the cached grants
+		got[0].Grant = "id=*;type=*;actions=*"
+		got, ok = c.get("u_1234567890", v(1, 0))
+		require.True(t, ok)
+		assert.Equal(t, grants, got)
+
+		_, ok = c.get("u_1234567890", v(2, 0))
+		assert.False(t, ok)
+		_, ok = c.get("u_1234567890", v(1, 1))
+		assert.False(t, ok)
+
+		// grants resolved at an older version do not replace newer ones
+		c.set("u_1234567890", v(3, 0), nil)
+		c.set("u_1234567890", v(2, 5), grants)
+		got, ok = c.get("u_1234567890", v(3, 0))
+		require.True(t, ok)
+		assert.Empty(t, got)
+
+		c.set("u_1234567890", v(3, 2), grants)
+		c.set("u_1234567890", v(3, 1), nil)
+		got, ok = c.get("u_1234567890", v(3, 2))
+		require.True(t, ok)
+		assert.Equal(t, grants, got)
+	})
+	t.Run("user-version", func(t *testing.T) {
+		c, err := NewGrantsCache(ctx, 0)
+		require.NoError(t, err)
+		c.set("u_1", v(1, 1), grants)
+		c.set("u_2", v(1, 1), grants)
+
+		// incrementing the version of one user leaves the grants of other
+		// users cached
+		_, ok := c.get("u_1", v(1, 2))
+		assert.False(t, ok)
+		_, ok = c.get("u_2", v(1, 1))
+		assert.True(t, ok)
+	})
+	t.Run("max-entries", func(t *testing.T) {
+		c, err := NewGrantsCache(ctx, 2)
+		require.NoError(t, err)
+		c.set("u_1", v(1, 0), grants)
+		c.set("u_2", v(2, 0), grants)
+		c.set("u_3", v(2, 0), grants)
+		assert.Len(t, c.entries, 2)
+		// the entry resolved at an older version is removed first
+		_, ok := c.get("u_1", v(1, 0))
+		assert.False(t, ok)
+		_, ok = c.get("u_2", v(2, 0))
+		assert.True(t, ok)
+
+		c.set("u_4", v(2, 0), grants)
+		assert.Len(t, c.entries, 2)
+		_, ok = c.get("u_4", v(2, 0))
+		assert.True(t, ok)
+	})
+}
+
+func TestGrantsVersion_newerThan(t *testing.T) {
+	tests := []struct {
+		name string
+		v, o grantsVersion
+		want bool
+	}{
+		{name: "equal", v: grantsVersion{global: 1, user: 1}, o: grantsVersion{global: 1, user: 1}, want: false},
+		{name: "newer-global", v: grantsVersion{global: 2}, o: grantsVersion{global: 1, user: 5}, want: true},
+		{name: "older-global", v: grantsVersion{global: 1, user: 5}, o: grantsVersion{global: 2}, want: false},
+		{name: "newer-user", v: grantsVersion{global: 1, user: 2}, o: grantsVersion{global: 1, user: 1}, want: true},
+		{name: "older-user", v: grantsVersion{global: 1, user: 1}, o: grantsVersion{global: 1, user: 2}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.v.newerThan(tt.o))
+		})
+	}
+}
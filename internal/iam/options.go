@@ -32,6 +32,21 @@ type options struct {
 	withRandomReader            io.Reader
 	withAccountIds              []string
 	withPrimaryAuthMethodId     string
+	withAuthTokenTimeToLive     uint32
+	withAuthTokenTimeToStale    uint32
+	withGrantBoundary           bool
+	withProjectTemplate         bool
+	withProjectTemplateSync     bool
+	withAnnotationOwner         string
+	withAnnotationCostCenter    string
+	withAnnotationTicketUrl     string
+	withLoginDisplayName        string
+	withLoginSupportContact     string
+	withLoginMessageOfTheDay    string
+	withGrantsCache             *GrantsCache
+	withIsolated                bool
+	withDeletionProtected       bool
+	withTenantIsolation         bool
 }
 
 func getDefaultOptions() options {
@@ -144,3 +159,124 @@ func WithPrimaryAuthMethodId(id string) Option {
 		o.withPrimaryAuthMethodId = id
 	}
 }
+
+// WithAuthTokenTimeToLive provides an option to specify, in seconds, the
+// auth token time to live for the scope.
+func WithAuthTokenTimeToLive(seconds uint32) Option {
+	return func(o *options) {
+		o.withAuthTokenTimeToLive = seconds
+	}
+}
+
+// WithAuthTokenTimeToStale provides an option to specify, in seconds, the
+// auth token time to stale for the scope.
+func WithAuthTokenTimeToStale(seconds uint32) Option {
+	return func(o *options) {
+		o.withAuthTokenTimeToStale = seconds
+	}
+}
+
+// WithGrantBoundary provides an option to specify that a role is a boundary
+// role.
+func WithGrantBoundary(isBoundary bool) Option {
+	return func(o *options) {
+		o.withGrantBoundary = isBoundary
+	}
+}
+
+// WithProjectTemplate provides an option to specify that a role is a project
+// template role.
+func WithProjectTemplate(isTemplate bool) Option {
+	return func(o *options) {
+		o.withProjectTemplate = isTemplate
+	}
+}
+
+// WithProjectTemplateSync provides an option to specify that grant changes to
+// a project template role are applied to the roles copied from it.
+func WithProjectTemplateSync(sync bool) Option {
+	return func(o *options) {
+		o.withProjectTemplateSync = sync
+	}
+}
+
+// WithAnnotationOwner provides an option to specify the person or team which
+// owns the scope.
+func WithAnnotationOwner(owner string) Option {
+	return func(o *options) {
+		o.withAnnotationOwner = owner
+	}
+}
+
+// WithAnnotationCostCenter provides an option to specify the cost center the
+// scope is charged to.
+func WithAnnotationCostCenter(costCenter string) Option {
+	return func(o *options) {
+		o.withAnnotationCostCenter = costCenter
+	}
+}
+
+// WithAnnotationTicketUrl provides an option to specify a link to the ticket
+// which tracks the scope.
+func WithAnnotationTicketUrl(url string) Option {
+	return func(o *options) {
+		o.withAnnotationTicketUrl = url
+	}
+}
+
+// WithLoginDisplayName provides an option to specify the name of the scope
+// shown to users logging in to it.
+func WithLoginDisplayName(name string) Option {
+	return func(o *options) {
+		o.withLoginDisplayName = name
+	}
+}
+
+// WithLoginSupportContact provides an option to specify the contact for users
+// having trouble logging in to the scope.
+func WithLoginSupportContact(contact string) Option {
+	return func(o *options) {
+		o.withLoginSupportContact = contact
+	}
+}
+
+// WithLoginMessageOfTheDay provides an option to specify a message shown to
+// users logging in to the scope.
+func WithLoginMessageOfTheDay(msg string) Option {
+	return func(o *options) {
+		o.withLoginMessageOfTheDay = msg
+	}
+}
+
+// WithGrantsCache provides an option to specify the cache used by the
+// repository to look up and store the grants resolved for users.
+func WithGrantsCache(c *GrantsCache) Option {
+	return func(o *options) {
+		o.withGrantsCache = c
+	}
+}
+
+// WithIsolated provides an option to mark an org scope as isolated from the
+// roles of the global scope.
+func WithIsolated(isolated bool) Option {
+	return func(o *options) {
+		o.withIsolated = isolated
+	}
+}
+
+// WithDeletionProtected provides an option to protect a scope from being
+// deleted.
+func WithDeletionProtected(protected bool) Option {
+	return func(o *options) {
+		o.withDeletionProtected = protected
+	}
+}
+
+// WithTenantIsolation provides an option to enable tenant isolation, in
+// which the grants of roles in the global scope do not apply to isolated
+// orgs and their projects.
+func WithTenantIsolation(enabled bool) Option {
+	return func(o *options) {
+		o.withTenantIsolation = enabled
+	}
+}
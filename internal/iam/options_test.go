@@ -82,4 +82,88 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withPrimaryAuthMethodId = "test"
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithAuthTokenTimeToLive", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithAuthTokenTimeToLive(3600))
+		testOpts := getDefaultOptions()
+		testOpts.withAuthTokenTimeToLive = 3600
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithAuthTokenTimeToStale", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithAuthTokenTimeToStale(600))
+		testOpts := getDefaultOptions()
+		testOpts.withAuthTokenTimeToStale = 600
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithGrantBoundary", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithGrantBoundary(true))
+		testOpts := getDefaultOptions()
+		testOpts.withGrantBoundary = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithProjectTemplate", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithProjectTemplate(true), WithProjectTemplateSync(true))
+		testOpts := getDefaultOptions()
+		testOpts.withProjectTemplate = true
+		testOpts.withProjectTemplateSync = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithAnnotations", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(
+			WithAnnotationOwner("platform-team"),
+			WithAnnotationCostCenter("CC-1234"),
+			WithAnnotationTicketUrl("https://tickets.example.com/OPS-1"),
+		)
+		testOpts := getDefaultOptions()
+		testOpts.withAnnotationOwner = "platform-team"
+		testOpts.withAnnotationCostCenter = "CC-1234"
+		testOpts.withAnnotationTicketUrl = "https://tickets.example.com/OPS-1"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithLoginMetadata", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(
+			WithLoginDisplayName("Engineering"),
+			WithLoginSupportContact("help@example.com"),
+			WithLoginMessageOfTheDay("Maintenance on Saturday"),
+		)
+		testOpts := getDefaultOptions()
+		testOpts.withLoginDisplayName = "Engineering"
+		testOpts.withLoginSupportContact = "help@example.com"
+		testOpts.withLoginMessageOfTheDay = "Maintenance on Saturday"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithGrantsCache", func(t *testing.T) {
+		assert := assert.New(t)
+		c := &GrantsCache{}
+		opts := getOpts(WithGrantsCache(c))
+		testOpts := getDefaultOptions()
+		testOpts.withGrantsCache = c
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithIsolated", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithIsolated(true))
+		testOpts := getDefaultOptions()
+		testOpts.withIsolated = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithDeletionProtected", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithDeletionProtected(true))
+		testOpts := getDefaultOptions()
+		testOpts.withDeletionProtected = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithTenantIsolation", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithTenantIsolation(true))
+		testOpts := getDefaultOptions()
+		testOpts.withTenantIsolation = true
+		assert.Equal(opts, testOpts)
+	})
 }
@@ -111,4 +111,40 @@ const (
 	select * from final
 	order by action, member_id;
 	`
+
+	// roleUserIdsQuery - given a role id, return the ids of the users that are
+	// principals of the role directly, or via a group or managed group.
+	roleUserIdsQuery = `
+	with
+	group_users (user_id) as (
+	  select iam_group_member_user.member_id
+		from iam_group_member_user
+	   inner join iam_group_role
+		  on iam_group_member_user.group_id = iam_group_role.principal_id
+	   where iam_group_role.role_id = @role_id
+	),
+	managed_group_users (user_id) as (
+	  select auth_account.iam_user_id
+		from auth_account
+	   inner join auth_managed_group_member_account
+		  on auth_account.public_id = auth_managed_group_member_account.member_id
+	   inner join iam_managed_group_role
+		  on auth_managed_group_member_account.managed_group_id = iam_managed_group_role.principal_id
+	   where iam_managed_group_role.role_id = @role_id
+		 and auth_account.iam_user_id is not null
+	),
+	final (user_id) as (
+	  select principal_id
+		from iam_user_role
+	   where role_id = @role_id
+	   union
+	  select user_id
+		from group_users
+	   union
+	  select user_id
+		from managed_group_users
+	)
+	select user_id from final
+	order by user_id;
+	`
 )
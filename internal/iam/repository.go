@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/internal/types/scope"
 )
 
@@ -25,10 +26,19 @@ type Repository struct {
 
 	// defaultLimit provides a default for limiting the number of results returned from the repo
 	defaultLimit int
+
+	// grantsCache is the optional cache of the grants resolved for users
+	grantsCache *GrantsCache
+
+	// tenantIsolation is true if the grants of roles in the global scope do
+	// not apply to isolated orgs and their projects
+	tenantIsolation bool
 }
 
 // NewRepository creates a new iam Repository. Supports the options: WithLimit
-// which sets a default limit on results returned by repo operations.
+// which sets a default limit on results returned by repo operations,
+// WithGrantsCache which sets the cache used by GrantsForUser and
+// WithTenantIsolation which enables tenant isolation.
 func NewRepository(r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*Repository, error) {
 	const op = "iam.NewRepository"
 	if r == nil {
@@ -46,10 +56,12 @@ func NewRepository(r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*Repo
 		opts.withLimit = db.DefaultLimit
 	}
 	return &Repository{
-		reader:       r,
-		writer:       w,
-		kms:          kms,
-		defaultLimit: opts.withLimit,
+		reader:          r,
+		writer:          w,
+		kms:             kms,
+		defaultLimit:    opts.withLimit,
+		grantsCache:     opts.withGrantsCache,
+		tenantIsolation: opts.withTenantIsolation,
 	}, nil
 }
 
@@ -65,6 +77,45 @@ func (r *Repository) list(ctx context.Context, resources any, where string, args
 	return r.reader.SearchWhere(ctx, resources, where, args, db.WithLimit(limit))
 }
 
+// LookupPublicIdByName returns the public id of the user, group, role, or
+// scope of resourceType named name in the scope with scopeId, or an empty
+// string if there is none. For a scope, scopeId is the id of its parent scope.
+func (r *Repository) LookupPublicIdByName(ctx context.Context, resourceType resource.Type, scopeId, name string, _ ...Option) (string, error) {
+	const op = "iam.(Repository).LookupPublicIdByName"
+	switch {
+	case scopeId == "":
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	case name == "":
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing name")
+	}
+	var res Resource
+	where := "scope_id = ? and name = ?"
+	switch resourceType {
+	case resource.User:
+		u := AllocUser()
+		res = &u
+	case resource.Group:
+		g := allocGroup()
+		res = &g
+	case resource.Role:
+		rl := allocRole()
+		res = &rl
+	case resource.Scope:
+		s := AllocScope()
+		res = &s
+		where = "parent_id = ? and name = ?"
+	default:
+		return "", errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported resource type %q", resourceType.String()))
+	}
+	if err := r.reader.LookupWhere(ctx, res, where, []any{scopeId, name}); err != nil {
+		if errors.IsNotFoundError(err) {
+			return "", nil
+		}
+		return "", errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s in %s", name, scopeId)))
+	}
+	return res.GetPublicId(), nil
+}
+
 // create will create a new iam resource in the db repository with an oplog entry
 func (r *Repository) create(ctx context.Context, resource Resource, _ ...Option) (Resource, error) {
 	const op = "iam.(Repository).create"
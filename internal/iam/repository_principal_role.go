@@ -5,6 +5,7 @@ package iam
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strings"
 
@@ -464,6 +465,33 @@ func (r *Repository) ListPrincipalRoles(ctx context.Context, roleId string, opt
 	return principals, nil
 }
 
+// ListRoleUserIds returns the ids of the users that are principals of the
+// roleId, either directly or as a member of a group or managed group that is a
+// principal of the role.
+func (r *Repository) ListRoleUserIds(ctx context.Context, roleId string, _ ...Option) ([]string, error) {
+	const op = "iam.(Repository).ListRoleUserIds"
+	if roleId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing role id")
+	}
+	rows, err := r.reader.Query(ctx, roleUserIdsQuery, []any{sql.Named("role_id", roleId)})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to query users of role %s", roleId)))
+	}
+	defer rows.Close()
+	var userIds []string
+	for rows.Next() {
+		var userId string
+		if err := rows.Scan(&userId); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to scan row"))
+		}
+		userIds = append(userIds, userId)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get next user id"))
+	}
+	return userIds, nil
+}
+
 type PrincipalSet struct {
 	AddUserRoles            []any
 	AddGroupRoles           []any
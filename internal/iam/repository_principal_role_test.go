@@ -681,3 +681,34 @@ func TestRepository_SetPrincipalRoles(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_ListRoleUserIds(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	repo := TestRepo(t, conn, wrapper)
+	org, _ := TestScopes(t, repo)
+	role := TestRole(t, conn, org.PublicId)
+
+	userIds, err := repo.ListRoleUserIds(ctx, role.PublicId)
+	require.NoError(err)
+	assert.Empty(userIds)
+
+	direct := TestUser(t, repo, org.PublicId)
+	TestUserRole(t, conn, role.PublicId, direct.PublicId)
+	member := TestUser(t, repo, org.PublicId)
+	grp := TestGroup(t, conn, org.PublicId)
+	TestGroupMember(t, conn, grp.PublicId, member.PublicId)
+	TestGroupMember(t, conn, grp.PublicId, direct.PublicId)
+	TestGroupRole(t, conn, role.PublicId, grp.PublicId)
+	TestUser(t, repo, org.PublicId)
+
+	userIds, err = repo.ListRoleUserIds(ctx, role.PublicId)
+	require.NoError(err)
+	assert.ElementsMatch([]string{direct.PublicId, member.PublicId}, userIds)
+
+	_, err = repo.ListRoleUserIds(ctx, "")
+	require.Error(err)
+}
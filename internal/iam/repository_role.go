@@ -67,6 +67,9 @@ func (r *Repository) UpdateRole(ctx context.Context, role *Role, version uint32,
 		case strings.EqualFold("name", f):
 		case strings.EqualFold("description", f):
 		case strings.EqualFold("grantscopeid", f):
+		case strings.EqualFold("grantboundary", f):
+		case strings.EqualFold("projecttemplate", f):
+		case strings.EqualFold("projecttemplatesync", f):
 		default:
 			return nil, nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, fmt.Sprintf("invalid field mask: %s", f))
 		}
@@ -74,12 +77,15 @@ func (r *Repository) UpdateRole(ctx context.Context, role *Role, version uint32,
 	var dbMask, nullFields []string
 	dbMask, nullFields = dbw.BuildUpdatePaths(
 		map[string]any{
-			"name":         role.Name,
-			"description":  role.Description,
-			"GrantScopeId": role.GrantScopeId,
+			"name":                role.Name,
+			"description":         role.Description,
+			"GrantScopeId":        role.GrantScopeId,
+			"GrantBoundary":       role.GrantBoundary,
+			"ProjectTemplate":     role.ProjectTemplate,
+			"ProjectTemplateSync": role.ProjectTemplateSync,
 		},
 		fieldMaskPaths,
-		nil,
+		[]string{"GrantBoundary", "ProjectTemplate", "ProjectTemplateSync"},
 	)
 	if len(dbMask) == 0 && len(nullFields) == 0 {
 		return nil, nil, nil, db.NoRowsAffected, errors.E(ctx, errors.WithCode(errors.EmptyFieldMask), errors.WithOp(op))
@@ -45,6 +45,9 @@ func (r *Repository) AddRoleGrants(ctx context.Context, roleId string, roleVersi
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to get role %s scope", roleId)))
 	}
+	if err := validateGrantBoundaries(ctx, r.reader, scope, grants); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
 	oplogWrapper, err := r.kms.GetWrapper(ctx, scope.GetPublicId(), kms.KeyPurposeOplog)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
@@ -90,6 +93,10 @@ func (r *Repository) AddRoleGrants(ctx context.Context, roleId string, roleVersi
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
 			}
 
+			if err := r.syncProjectTemplateRoleGrants(ctx, reader, w, roleId); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+
 			return nil
 		},
 	)
@@ -210,6 +217,10 @@ func (r *Repository) DeleteRoleGrants(ctx context.Context, roleId string, roleVe
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
 			}
 
+			if err := r.syncProjectTemplateRoleGrants(ctx, reader, w, roleId); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+
 			return nil
 		},
 	)
@@ -299,6 +310,15 @@ func (r *Repository) SetRoleGrants(ctx context.Context, roleId string, roleVersi
 	if err != nil {
 		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to get role %s scope", roleId)))
 	}
+	if len(addRoleGrants) > 0 {
+		newGrants := make([]string, 0, len(addRoleGrants))
+		for _, rg := range addRoleGrants {
+			newGrants = append(newGrants, rg.(*RoleGrant).GetRawGrant())
+		}
+		if err := validateGrantBoundaries(ctx, r.reader, scope, newGrants); err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+	}
 	oplogWrapper, err := r.kms.GetWrapper(ctx, scope.GetPublicId(), kms.KeyPurposeOplog)
 	if err != nil {
 		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
@@ -361,6 +381,10 @@ func (r *Repository) SetRoleGrants(ctx context.Context, roleId string, roleVersi
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
 			}
 
+			if err := r.syncProjectTemplateRoleGrants(ctx, reader, w, roleId); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+
 			currentRoleGrants, err = r.ListRoleGrants(ctx, roleId)
 			if err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to retrieve current role grants after set"))
@@ -389,15 +413,42 @@ func (r *Repository) ListRoleGrants(ctx context.Context, roleId string, opt ...O
 	return roleGrants, nil
 }
 
+// GrantsForUser returns the grants of the roles the user is a principal of,
+// either directly, through a group or through a managed group. If the
+// repository has a grants cache, the cached grants are returned while the
+// grants version they were resolved at is current.
 func (r *Repository) GrantsForUser(ctx context.Context, userId string, _ ...Option) ([]perms.GrantTuple, error) {
 	const op = "iam.(Repository).GrantsForUser"
 	if userId == "" {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing user id")
 	}
 
+	var version grantsVersion
+	if r.grantsCache != nil {
+		// The version must be read before the grants so grants resolved while
+		// they are being changed are cached with the older version.
+		var err error
+		version, err = r.lookupGrantsVersion(ctx, userId)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if grants, ok := r.grantsCache.get(userId, version); ok {
+			return grants, nil
+		}
+	}
+
 	const (
-		anonUser    = `where public_id in (?)`
-		authUser    = `where public_id in ('u_anon', 'u_auth', ?)`
+		anonUser = `where public_id in (?)`
+		authUser = `where public_id in ('u_anon', 'u_auth', ?)`
+		// isolatedScopes removes the grants of roles in the global scope
+		// for isolated orgs and their projects.
+		isolatedScopes = `
+     and not (iam_role.scope_id = 'global'
+              and iam_role.grant_scope_id in (select s.public_id
+                                                from iam_scope s
+                                                left join iam_scope p
+                                                  on p.public_id = s.parent_id
+                                               where s.isolated or p.isolated))`
 		grantsQuery = `
 with
 users (id) as (
@@ -457,6 +508,7 @@ roles (role_id, grant_scope_id) as (
     from iam_role,
          user_group_roles
    where public_id in (user_group_roles.role_id)
+  %s -- isolatedScopes
 ),
 final (role_id, role_scope, role_grant) as (
   select roles.role_id,
@@ -471,13 +523,15 @@ select role_id as role_id, role_scope as scope_id, role_grant as grant from fina
 	`
 	)
 
-	var query string
-	switch userId {
-	case globals.AnonymousUserId:
-		query = fmt.Sprintf(grantsQuery, anonUser)
-	default:
-		query = fmt.Sprintf(grantsQuery, authUser)
+	users := authUser
+	if userId == globals.AnonymousUserId {
+		users = anonUser
 	}
+	var isolation string
+	if r.tenantIsolation {
+		isolation = isolatedScopes
+	}
+	query := fmt.Sprintf(grantsQuery, users, isolation)
 
 	var grants []perms.GrantTuple
 	rows, err := r.reader.Query(ctx, query, []any{userId})
@@ -492,5 +546,40 @@ select role_id as role_id, role_scope as scope_id, role_grant as grant from fina
 		}
 		grants = append(grants, g)
 	}
+	if r.grantsCache != nil {
+		r.grantsCache.set(userId, version, grants)
+	}
 	return grants, nil
 }
+
+// lookupGrantsVersion returns the current grants version of the user, which
+// is incremented by the database whenever the grants of the user may have
+// changed.
+func (r *Repository) lookupGrantsVersion(ctx context.Context, userId string) (grantsVersion, error) {
+	const op = "iam.(Repository).lookupGrantsVersion"
+	const query = `
+select g.version,
+       coalesce(u.version, 0)
+  from iam_grants_version as g
+  left join iam_user_grants_version as u
+         on u.iam_user_id = ?;
+`
+	rows, err := r.reader.Query(ctx, query, []any{userId})
+	if err != nil {
+		return grantsVersion{}, errors.Wrap(ctx, err, op, errors.WithMsg("unable to query grants version"))
+	}
+	defer rows.Close()
+	var version grantsVersion
+	for rows.Next() {
+		if err := rows.Scan(&version.global, &version.user); err != nil {
+			return grantsVersion{}, errors.Wrap(ctx, err, op, errors.WithMsg("unable to scan row"))
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return grantsVersion{}, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get grants version"))
+	}
+	if version.global == 0 {
+		return grantsVersion{}, errors.New(ctx, errors.RecordNotFound, op, "grants version not found")
+	}
+	return version, nil
+}
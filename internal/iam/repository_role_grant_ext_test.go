@@ -253,3 +253,99 @@ func TestGrantsForUser(t *testing.T) {
 		t.Log("finished user", user.PublicId, "total roles", len(expectedRoleIds), "roles from users", rolesFromUsers, "roles from groups", rolesFromGroups, "roles from managed groups", rolesFromManagedGroups)
 	}
 }
+
+func TestGrantsForUser_GrantsCache(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	cache, err := iam.NewGrantsCache(ctx, 0)
+	require.NoError(t, err)
+	iamRepo := iam.TestRepo(t, conn, wrap, iam.WithGrantsCache(cache))
+
+	o, _ := iam.TestScopes(
+		t,
+		iamRepo,
+		iam.WithSkipAdminRoleCreation(true),
+		iam.WithSkipDefaultRoleCreation(true),
+	)
+	user := iam.TestUser(t, iamRepo, o.GetPublicId())
+	role := iam.TestRole(t, conn, o.GetPublicId())
+	iam.TestRoleGrant(t, conn, role.PublicId, "id=*;type=*;actions=read")
+	iam.TestUserRole(t, conn, role.PublicId, user.PublicId)
+
+	grants := func() []string {
+		tuples, err := iamRepo.GrantsForUser(ctx, user.PublicId)
+		require.NoError(t, err)
+		var got []string
+		for _, tuple := range tuples {
+			if tuple.RoleId == role.PublicId {
+				got = append(got, tuple.Grant)
+			}
+		}
+		return got
+	}
+	assert.ElementsMatch(t, []string{"id=*;type=*;actions=read"}, grants())
+	// the cached grants are returned while nothing has changed
+	assert.ElementsMatch(t, []string{"id=*;type=*;actions=read"}, grants())
+
+	// adding a grant to the role invalidates the cached grants
+	iam.TestRoleGrant(t, conn, role.PublicId, "id=*;type=*;actions=update")
+	assert.ElementsMatch(t, []string{"id=*;type=*;actions=read", "id=*;type=*;actions=update"}, grants())
+
+	// as does removing the user from the role
+	role, _, _, err = iamRepo.LookupRole(ctx, role.PublicId)
+	require.NoError(t, err)
+	_, err = iamRepo.DeletePrincipalRoles(ctx, role.PublicId, role.Version, []string{user.PublicId})
+	require.NoError(t, err)
+	assert.Empty(t, grants())
+}
+
+func TestGrantsForUser_TenantIsolation(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrap, iam.WithTenantIsolation(true))
+
+	o, p := iam.TestScopes(
+		t,
+		iamRepo,
+		iam.WithSkipAdminRoleCreation(true),
+		iam.WithSkipDefaultRoleCreation(true),
+	)
+	user := iam.TestUser(t, iamRepo, scope.Global.String())
+	globalRole := iam.TestRole(t, conn, scope.Global.String())
+	iam.TestRoleGrant(t, conn, globalRole.PublicId, "id=*;type=*;actions=read")
+	iam.TestUserRole(t, conn, globalRole.PublicId, user.PublicId)
+	orgRole := iam.TestRole(t, conn, scope.Global.String(), iam.WithGrantScopeId(o.GetPublicId()))
+	iam.TestRoleGrant(t, conn, orgRole.PublicId, "id=*;type=*;actions=read")
+	iam.TestUserRole(t, conn, orgRole.PublicId, user.PublicId)
+	projRole := iam.TestRole(t, conn, o.GetPublicId(), iam.WithGrantScopeId(p.GetPublicId()))
+	iam.TestRoleGrant(t, conn, projRole.PublicId, "id=*;type=*;actions=read")
+	iam.TestUserRole(t, conn, projRole.PublicId, user.PublicId)
+
+	roles := func() []string {
+		tuples, err := iamRepo.GrantsForUser(ctx, user.PublicId)
+		require.NoError(t, err)
+		var got []string
+		for _, tuple := range tuples {
+			switch tuple.RoleId {
+			case globalRole.PublicId, orgRole.PublicId, projRole.PublicId:
+				got = append(got, tuple.RoleId)
+			}
+		}
+		return got
+	}
+	assert.ElementsMatch(t, []string{globalRole.PublicId, orgRole.PublicId, projRole.PublicId}, roles())
+
+	// isolating the org removes the grants of the global role for the org,
+	// but not those of the roles of the org itself
+	o.Isolated = true
+	o, _, err := iamRepo.UpdateScope(ctx, o, o.Version, []string{"Isolated"})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{globalRole.PublicId, projRole.PublicId}, roles())
+
+	// the org cannot be made non isolated again
+	o.Isolated = false
+	_, _, err = iamRepo.UpdateScope(ctx, o, o.Version, []string{"Isolated"})
+	require.Error(t, err)
+}
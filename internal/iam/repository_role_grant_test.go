@@ -566,3 +566,51 @@ func TestRepository_SetRoleGrants_Parameters(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_RoleGrants_GrantBoundary(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	repo := TestRepo(t, conn, wrapper)
+	org, proj := TestScopes(t, repo)
+
+	boundary := TestRole(t, conn, org.PublicId, WithGrantBoundary(true))
+	TestRoleGrant(t, conn, boundary.PublicId, "id=*;type=target;actions=read,authorize-session")
+	TestRoleGrant(t, conn, boundary.PublicId, "id=*;type=host-catalog;actions=*")
+
+	t.Run("add", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		role := TestRole(t, conn, proj.PublicId)
+		_, err := repo.AddRoleGrants(ctx, role.PublicId, 1, []string{"id=*;type=target;actions=read"})
+		require.NoError(err)
+
+		_, err = repo.AddRoleGrants(ctx, role.PublicId, 2, []string{"id=*;type=target;actions=read,delete"})
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.Forbidden), err))
+	})
+	t.Run("set", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		role := TestRole(t, conn, proj.PublicId)
+		_, _, err := repo.SetRoleGrants(ctx, role.PublicId, 1, []string{"id=*;type=host-catalog;actions=read,update"})
+		require.NoError(err)
+
+		_, _, err = repo.SetRoleGrants(ctx, role.PublicId, 2, []string{"id=*;type=*;actions=*"})
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.Forbidden), err))
+	})
+	t.Run("boundary-scope-not-restricted", func(t *testing.T) {
+		role := TestRole(t, conn, org.PublicId)
+		_, err := repo.AddRoleGrants(ctx, role.PublicId, 1, []string{"id=*;type=*;actions=*"})
+		require.NoError(t, err)
+	})
+	t.Run("not-a-boundary", func(t *testing.T) {
+		require := require.New(t)
+		otherOrg, otherProj := TestScopes(t, repo)
+		other := TestRole(t, conn, otherOrg.PublicId)
+		TestRoleGrant(t, conn, other.PublicId, "id=*;type=target;actions=read")
+		role := TestRole(t, conn, otherProj.PublicId)
+		_, err := repo.AddRoleGrants(ctx, role.PublicId, 1, []string{"id=*;type=*;actions=*"})
+		require.NoError(err)
+	})
+}
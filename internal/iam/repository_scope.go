@@ -304,7 +304,7 @@ func (r *Repository) CreateScope(ctx context.Context, s *Scope, userId string, o
 						grants = append(grants, roleGrant)
 
 					default:
-						roleGrant, err := NewRoleGrant(defaultRolePublicId, "id=*;type=scope;actions=list,no-op")
+						roleGrant, err := NewRoleGrant(defaultRolePublicId, "id=*;type=scope;actions=list,no-op,read-login-metadata")
 						if err != nil {
 							return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory role grant"))
 						}
@@ -367,6 +367,12 @@ func (r *Repository) CreateScope(ctx context.Context, s *Scope, userId string, o
 				}
 			}
 
+			if s.Type == scope.Project.String() {
+				if err := createProjectTemplateRoles(ctx, dbr, w, s, childOplogWrapper); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create roles from project templates"))
+				}
+			}
+
 			return nil
 		},
 	)
@@ -400,17 +406,38 @@ func (r *Repository) UpdateScope(ctx context.Context, scope *Scope, version uint
 	var dbMask, nullFields []string
 	dbMask, nullFields = dbw.BuildUpdatePaths(
 		map[string]any{
-			"name":                scope.Name,
-			"description":         scope.Description,
-			"PrimaryAuthMethodId": scope.PrimaryAuthMethodId, // gorm: it's important that the field start with a capital letter.
+			"name":                        scope.Name,
+			"description":                 scope.Description,
+			"PrimaryAuthMethodId":         scope.PrimaryAuthMethodId, // gorm: it's important that the field start with a capital letter.
+			"AuthTokenTimeToLiveSeconds":  scope.AuthTokenTimeToLiveSeconds,
+			"AuthTokenTimeToStaleSeconds": scope.AuthTokenTimeToStaleSeconds,
+			"AnnotationOwner":             scope.AnnotationOwner,
+			"AnnotationCostCenter":        scope.AnnotationCostCenter,
+			"AnnotationTicketUrl":         scope.AnnotationTicketUrl,
+			"LoginDisplayName":            scope.LoginDisplayName,
+			"LoginSupportContact":         scope.LoginSupportContact,
+			"LoginMessageOfTheDay":        scope.LoginMessageOfTheDay,
+			"Isolated":                    scope.Isolated,
+			"DeletionProtected":           scope.DeletionProtected,
 		},
 		fieldMaskPaths,
-		nil,
+		[]string{"Isolated", "DeletionProtected"},
 	)
 	// nada to update, so reload scope from db and return it
 	if len(dbMask) == 0 && len(nullFields) == 0 {
 		return nil, db.NoRowsAffected, errors.E(ctx, errors.WithCode(errors.EmptyFieldMask), errors.WithOp(op))
 	}
+	// With tenant isolation enabled an isolated org could otherwise be
+	// reached again by anyone allowed to update it from the global scope.
+	if r.tenantIsolation && contains(dbMask, "Isolated") && !scope.Isolated {
+		current, err := r.LookupScope(ctx, scope.PublicId)
+		if err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+		if current.GetIsolated() {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "an isolated org cannot be made non isolated while tenant isolation is enabled")
+		}
+	}
 	resource, rowsUpdated, err := r.update(ctx, scope, version, dbMask, nullFields)
 	if err != nil {
 		if errors.IsUniqueError(err) {
@@ -12,6 +12,7 @@ import (
 	"github.com/golang/protobuf/ptypes"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
 	iam_store "github.com/hashicorp/boundary/internal/iam/store"
 	"github.com/hashicorp/boundary/internal/oplog"
 	"github.com/hashicorp/boundary/internal/types/scope"
@@ -262,6 +263,26 @@ func Test_Repository_Scope_Delete(t *testing.T) {
 		require.NoError(err) // no error is expected if the resource isn't in the db
 		assert.Equal(0, rowsDeleted)
 	})
+	t.Run("deletion-protected", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ctx := context.Background()
+		s := TestOrg(t, repo, WithDeletionProtected(true))
+		assert.True(s.GetDeletionProtected())
+
+		rowsDeleted, err := repo.DeleteScope(ctx, s.PublicId)
+		require.Error(err)
+		assert.Truef(errors.Match(errors.T(errors.Conflict), err), "unexpected error %v", err)
+		assert.Equal(0, rowsDeleted)
+
+		s.DeletionProtected = false
+		updated, _, err := repo.UpdateScope(ctx, s, s.Version, []string{"DeletionProtected"})
+		require.NoError(err)
+		assert.False(updated.GetDeletionProtected())
+
+		rowsDeleted, err = repo.DeleteScope(ctx, s.PublicId)
+		require.NoError(err)
+		assert.Equal(1, rowsDeleted)
+	})
 }
 
 func TestRepository_UpdateScope(t *testing.T) {
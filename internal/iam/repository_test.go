@@ -12,10 +12,12 @@ import (
 	"github.com/golang/protobuf/ptypes"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
 	iam_store "github.com/hashicorp/boundary/internal/iam/store"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/oplog"
 	"github.com/hashicorp/boundary/internal/oplog/store"
+	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -328,3 +330,47 @@ func TestRepository_update(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_LookupPublicIdByName(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	repo := TestRepo(t, conn, wrapper)
+	org, proj := TestScopes(t, repo, WithName("lookup-org"))
+	user := TestUser(t, repo, org.GetPublicId(), WithName("lookup-user"))
+	group := TestGroup(t, conn, proj.GetPublicId(), WithName("lookup-group"))
+	role := TestRole(t, conn, org.GetPublicId(), WithName("lookup-role"))
+
+	tests := []struct {
+		name         string
+		resourceType resource.Type
+		scopeId      string
+		lookupName   string
+		want         string
+		wantErr      bool
+	}{
+		{name: "user", resourceType: resource.User, scopeId: org.GetPublicId(), lookupName: "lookup-user", want: user.GetPublicId()},
+		{name: "group", resourceType: resource.Group, scopeId: proj.GetPublicId(), lookupName: "lookup-group", want: group.GetPublicId()},
+		{name: "role", resourceType: resource.Role, scopeId: org.GetPublicId(), lookupName: "lookup-role", want: role.GetPublicId()},
+		{name: "scope", resourceType: resource.Scope, scopeId: scope.Global.String(), lookupName: "lookup-org", want: org.GetPublicId()},
+		{name: "other-scope", resourceType: resource.User, scopeId: scope.Global.String(), lookupName: "lookup-user"},
+		{name: "unknown-name", resourceType: resource.User, scopeId: org.GetPublicId(), lookupName: "unknown"},
+		{name: "missing-scope-id", resourceType: resource.User, lookupName: "lookup-user", wantErr: true},
+		{name: "missing-name", resourceType: resource.User, scopeId: org.GetPublicId(), wantErr: true},
+		{name: "unsupported-type", resourceType: resource.Target, scopeId: proj.GetPublicId(), lookupName: "lookup-user", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := repo.LookupPublicIdByName(ctx, tt.resourceType, tt.scopeId, tt.lookupName)
+			if tt.wantErr {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, got)
+		})
+	}
+}
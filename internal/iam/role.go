@@ -33,7 +33,8 @@ var (
 )
 
 // NewRole creates a new in memory role with a scope (project/org)
-// allowed options include: withDescripion, WithName, withGrantScopeId.
+// allowed options include: withDescripion, WithName, withGrantScopeId,
+// WithGrantBoundary, WithProjectTemplate, WithProjectTemplateSync.
 func NewRole(scopeId string, opt ...Option) (*Role, error) {
 	const op = "iam.NewRole"
 	if scopeId == "" {
@@ -42,10 +43,13 @@ func NewRole(scopeId string, opt ...Option) (*Role, error) {
 	opts := getOpts(opt...)
 	r := &Role{
 		Role: &store.Role{
-			ScopeId:      scopeId,
-			Name:         opts.withName,
-			Description:  opts.withDescription,
-			GrantScopeId: opts.withGrantScopeId,
+			ScopeId:             scopeId,
+			Name:                opts.withName,
+			Description:         opts.withDescription,
+			GrantScopeId:        opts.withGrantScopeId,
+			GrantBoundary:       opts.withGrantBoundary,
+			ProjectTemplate:     opts.withProjectTemplate,
+			ProjectTemplateSync: opts.withProjectTemplateSync,
 		},
 	}
 	return r, nil
@@ -0,0 +1,290 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+)
+
+// createProjectTemplateRoles copies the project template roles of the
+// project's parent org, along with their grants and principals, into the
+// newly created project. It must be called within the transaction creating
+// the project.
+func createProjectTemplateRoles(ctx context.Context, dbr db.Reader, w db.Writer, project *Scope, oplogWrapper wrapping.Wrapper) error {
+	const op = "iam.createProjectTemplateRoles"
+	switch {
+	case project == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing project")
+	case oplogWrapper == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing oplog wrapper")
+	}
+	var templates []*Role
+	if err := dbr.SearchWhere(ctx, &templates, "scope_id = ? and project_template", []any{project.ParentId}); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to search for project template roles"))
+	}
+	for _, t := range templates {
+		var templateGrants []*RoleGrant
+		if err := dbr.SearchWhere(ctx, &templateGrants, "role_id = ?", []any{t.PublicId}); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to search for grants of template role %s", t.PublicId)))
+		}
+		var templatePrincipals []*PrincipalRole
+		if err := dbr.SearchWhere(ctx, &templatePrincipals, "role_id = ?", []any{t.PublicId}); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to search for principals of template role %s", t.PublicId)))
+		}
+
+		role, err := NewRole(project.PublicId, WithName(t.Name), WithDescription(t.Description))
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("error instantiating new role"))
+		}
+		role.PublicId, err = newRoleId()
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("error generating public id for new role"))
+		}
+		role.TemplateRoleId = t.PublicId
+		roleMetadata := oplog.Metadata{
+			"resource-public-id": []string{role.PublicId},
+			"scope-id":           []string{project.PublicId},
+			"scope-type":         []string{project.Type},
+			"resource-type":      []string{resource.Role.String()},
+			"op-type":            []string{oplog.OpType_OP_TYPE_CREATE.String()},
+		}
+		if err := w.Create(ctx, role, db.WithOplog(oplogWrapper, roleMetadata)); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("error creating role from template role %s", t.PublicId)))
+		}
+		if len(templateGrants) == 0 && len(templatePrincipals) == 0 {
+			continue
+		}
+
+		msgs := make([]*oplog.Message, 0, 1+len(templateGrants)+len(templatePrincipals))
+		roleTicket, err := w.GetTicket(ctx, role)
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+		}
+
+		// We need to update the role version as that's the aggregate
+		var roleOplogMsg oplog.Message
+		rowsUpdated, err := w.Update(ctx, role, []string{"Version"}, nil, db.NewOplogMsg(&roleOplogMsg), db.WithVersion(&role.Version))
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update role version"))
+		}
+		if rowsUpdated != 1 {
+			return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated role but %d rows updated", rowsUpdated))
+		}
+		msgs = append(msgs, &roleOplogMsg)
+
+		if len(templateGrants) > 0 {
+			rawGrants := make([]string, 0, len(templateGrants))
+			for _, g := range templateGrants {
+				rawGrants = append(rawGrants, g.RawGrant)
+			}
+			// The org's grant boundaries may have changed since the template's
+			// grants were added, so check them against the new project.
+			if err := validateGrantBoundaries(ctx, dbr, project, rawGrants); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("grants of template role %s", t.PublicId)))
+			}
+			grants := make([]any, 0, len(templateGrants))
+			for _, g := range templateGrants {
+				roleGrant, err := NewRoleGrant(role.PublicId, g.RawGrant)
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory role grant"))
+				}
+				grants = append(grants, roleGrant)
+			}
+			roleGrantOplogMsgs := make([]*oplog.Message, 0, len(grants))
+			if err := w.CreateItems(ctx, grants, db.NewOplogMsgs(&roleGrantOplogMsgs)); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to add grants"))
+			}
+			msgs = append(msgs, roleGrantOplogMsgs...)
+		}
+
+		var users, groups, managedGroups []any
+		for _, p := range templatePrincipals {
+			switch p.Type {
+			case UserRoleType.String():
+				ur, err := NewUserRole(role.PublicId, p.PrincipalId)
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory role user"))
+				}
+				users = append(users, ur)
+			case GroupRoleType.String():
+				gr, err := NewGroupRole(role.PublicId, p.PrincipalId)
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory role group"))
+				}
+				groups = append(groups, gr)
+			case ManagedGroupRoleType.String():
+				mgr, err := NewManagedGroupRole(role.PublicId, p.PrincipalId)
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory role managed group"))
+				}
+				managedGroups = append(managedGroups, mgr)
+			default:
+				return errors.New(ctx, errors.Internal, op, fmt.Sprintf("unknown principal type %q for template role %s", p.Type, t.PublicId))
+			}
+		}
+		for _, principals := range [][]any{users, groups, managedGroups} {
+			if len(principals) == 0 {
+				continue
+			}
+			principalOplogMsgs := make([]*oplog.Message, 0, len(principals))
+			if err := w.CreateItems(ctx, principals, db.NewOplogMsgs(&principalOplogMsgs)); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to add principals"))
+			}
+			msgs = append(msgs, principalOplogMsgs...)
+		}
+
+		metadata := oplog.Metadata{
+			"op-type":            []string{oplog.OpType_OP_TYPE_CREATE.String()},
+			"scope-id":           []string{project.PublicId},
+			"scope-type":         []string{project.Type},
+			"resource-public-id": []string{role.PublicId},
+		}
+		if err := w.WriteOplogEntryWith(ctx, oplogWrapper, roleTicket, metadata, msgs); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+		}
+	}
+	return nil
+}
+
+// syncProjectTemplateRoleGrants sets the grants of every role copied from the
+// template role to the template's current grants. It does nothing if the role
+// is not a project template with sync enabled. It must be called within the
+// transaction changing the template's grants, so the template and the roles
+// copied from it are never out of sync.
+func (r *Repository) syncProjectTemplateRoleGrants(ctx context.Context, reader db.Reader, w db.Writer, templateRoleId string) error {
+	const op = "iam.(Repository).syncProjectTemplateRoleGrants"
+	switch {
+	case reader == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing reader")
+	case w == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing writer")
+	case templateRoleId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing template role id")
+	}
+	template := allocRole()
+	template.PublicId = templateRoleId
+	if err := reader.LookupByPublicId(ctx, &template); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to lookup role %s", templateRoleId)))
+	}
+	if !template.ProjectTemplate || !template.ProjectTemplateSync {
+		return nil
+	}
+	var templateGrants []*RoleGrant
+	if err := reader.SearchWhere(ctx, &templateGrants, "role_id = ?", []any{templateRoleId}); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to search for template role grants"))
+	}
+	var derived []*Role
+	if err := reader.SearchWhere(ctx, &derived, "template_role_id = ?", []any{templateRoleId}); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to search for roles copied from template"))
+	}
+	for _, d := range derived {
+		if err := r.setDerivedRoleGrants(ctx, reader, w, d, templateGrants); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to sync grants of role %s", d.PublicId)))
+		}
+	}
+	return nil
+}
+
+// setDerivedRoleGrants sets the grants of a role copied from a template role
+// to the template's grants, using the reader and writer of the transaction
+// changing the template.
+func (r *Repository) setDerivedRoleGrants(ctx context.Context, reader db.Reader, w db.Writer, role *Role, templateGrants []*RoleGrant) error {
+	const op = "iam.(Repository).setDerivedRoleGrants"
+	var current []*RoleGrant
+	if err := reader.SearchWhere(ctx, &current, "role_id = ?", []any{role.PublicId}); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to search for grants"))
+	}
+	found := make(map[string]*RoleGrant, len(current))
+	for _, rg := range current {
+		found[rg.CanonicalGrant] = rg
+	}
+	var addRoleGrants, deleteRoleGrants []any
+	var newGrants []string
+	for _, tg := range templateGrants {
+		if _, ok := found[tg.CanonicalGrant]; ok {
+			delete(found, tg.CanonicalGrant)
+			continue
+		}
+		rg, err := NewRoleGrant(role.PublicId, tg.RawGrant)
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory role grant"))
+		}
+		addRoleGrants = append(addRoleGrants, rg)
+		newGrants = append(newGrants, tg.RawGrant)
+	}
+	for _, rg := range found {
+		deleteRoleGrants = append(deleteRoleGrants, rg)
+	}
+	if len(addRoleGrants) == 0 && len(deleteRoleGrants) == 0 {
+		return nil
+	}
+
+	scope, err := role.GetScope(ctx, reader)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to get role %s scope", role.PublicId)))
+	}
+	if err := validateGrantBoundaries(ctx, reader, scope, newGrants); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scope.GetPublicId(), kms.KeyPurposeOplog)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	msgs := make([]*oplog.Message, 0, 1+len(addRoleGrants)+len(deleteRoleGrants))
+	roleTicket, err := w.GetTicket(ctx, role)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+	}
+	updatedRole := allocRole()
+	updatedRole.PublicId = role.PublicId
+	updatedRole.Version = role.Version + 1
+	var roleOplogMsg oplog.Message
+	rowsUpdated, err := w.Update(ctx, &updatedRole, []string{"Version"}, nil, db.NewOplogMsg(&roleOplogMsg), db.WithVersion(&role.Version))
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update role version"))
+	}
+	if rowsUpdated != 1 {
+		return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated role and %d rows updated", rowsUpdated))
+	}
+	msgs = append(msgs, &roleOplogMsg)
+
+	if len(addRoleGrants) > 0 {
+		roleGrantOplogMsgs := make([]*oplog.Message, 0, len(addRoleGrants))
+		if err := w.CreateItems(ctx, addRoleGrants, db.NewOplogMsgs(&roleGrantOplogMsgs)); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to add grants"))
+		}
+		msgs = append(msgs, roleGrantOplogMsgs...)
+	}
+	if len(deleteRoleGrants) > 0 {
+		roleGrantOplogMsgs := make([]*oplog.Message, 0, len(deleteRoleGrants))
+		rowsDeleted, err := w.DeleteItems(ctx, deleteRoleGrants, db.NewOplogMsgs(&roleGrantOplogMsgs))
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete role grants"))
+		}
+		if rowsDeleted != len(deleteRoleGrants) {
+			return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("role grants deleted %d did not match request for %d", rowsDeleted, len(deleteRoleGrants)))
+		}
+		msgs = append(msgs, roleGrantOplogMsgs...)
+	}
+
+	metadata := oplog.Metadata{
+		"op-type":            []string{oplog.OpType_OP_TYPE_DELETE.String(), oplog.OpType_OP_TYPE_CREATE.String()},
+		"scope-id":           []string{scope.PublicId},
+		"scope-type":         []string{scope.Type},
+		"resource-public-id": []string{role.PublicId},
+	}
+	if err := w.WriteOplogEntryWith(ctx, oplogWrapper, roleTicket, metadata, msgs); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+	}
+	return nil
+}
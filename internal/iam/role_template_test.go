@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_ProjectTemplateRoles(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	repo := TestRepo(t, conn, wrapper)
+	org := TestOrg(t, repo)
+	user := TestUser(t, repo, org.PublicId)
+	grp := TestGroup(t, conn, org.PublicId)
+
+	template := TestRole(t, conn, org.PublicId, WithName("operators"), WithProjectTemplate(true), WithProjectTemplateSync(true))
+	TestRoleGrant(t, conn, template.PublicId, "id=*;type=target;actions=read")
+	TestUserRole(t, conn, template.PublicId, user.PublicId)
+	TestGroupRole(t, conn, template.PublicId, grp.PublicId)
+
+	unsynced := TestRole(t, conn, org.PublicId, WithName("auditors"), WithProjectTemplate(true))
+	TestRoleGrant(t, conn, unsynced.PublicId, "id=*;type=session;actions=list")
+
+	// not a template, so not copied
+	TestRole(t, conn, org.PublicId, WithName("org only"))
+
+	proj := TestProject(t, repo, org.PublicId)
+	var copies []*Role
+	require.NoError(t, repo.reader.SearchWhere(ctx, &copies, "scope_id = ? and template_role_id is not null", []any{proj.PublicId}))
+	require.Len(t, copies, 2)
+	byTemplate := map[string]*Role{}
+	for _, c := range copies {
+		byTemplate[c.TemplateRoleId] = c
+	}
+
+	t.Run("copied", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		c, ok := byTemplate[template.PublicId]
+		require.True(ok)
+		assert.Equal("operators", c.Name)
+		assert.False(c.ProjectTemplate)
+
+		grants, err := repo.ListRoleGrants(ctx, c.PublicId)
+		require.NoError(err)
+		require.Len(grants, 1)
+		assert.Equal("id=*;type=target;actions=read", grants[0].RawGrant)
+
+		principals, err := repo.ListPrincipalRoles(ctx, c.PublicId)
+		require.NoError(err)
+		var ids []string
+		for _, p := range principals {
+			ids = append(ids, p.PrincipalId)
+		}
+		assert.ElementsMatch([]string{user.PublicId, grp.PublicId}, ids)
+	})
+	t.Run("synced", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		current, _, _, err := repo.LookupRole(ctx, template.PublicId)
+		require.NoError(err)
+		_, err = repo.AddRoleGrants(ctx, template.PublicId, current.Version, []string{"id=*;type=target;actions=authorize-session"})
+		require.NoError(err)
+
+		grants, err := repo.ListRoleGrants(ctx, byTemplate[template.PublicId].PublicId)
+		require.NoError(err)
+		var raw []string
+		for _, g := range grants {
+			raw = append(raw, g.RawGrant)
+		}
+		assert.ElementsMatch([]string{"id=*;type=target;actions=read", "id=*;type=target;actions=authorize-session"}, raw)
+	})
+	t.Run("not-synced", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		current, _, _, err := repo.LookupRole(ctx, unsynced.PublicId)
+		require.NoError(err)
+		_, _, err = repo.SetRoleGrants(ctx, unsynced.PublicId, current.Version, []string{"id=*;type=session;actions=*"})
+		require.NoError(err)
+
+		grants, err := repo.ListRoleGrants(ctx, byTemplate[unsynced.PublicId].PublicId)
+		require.NoError(err)
+		require.Len(grants, 1)
+		assert.Equal("id=*;type=session;actions=list", grants[0].RawGrant)
+	})
+	t.Run("template-in-project", func(t *testing.T) {
+		role, err := NewRole(proj.PublicId, WithProjectTemplate(true))
+		require.NoError(t, err)
+		_, err = repo.CreateRole(ctx, role)
+		require.Error(t, err)
+	})
+}
+
+func TestRepository_ProjectTemplateRoles_GrantBoundary(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	repo := TestRepo(t, conn, wrapper)
+	org := TestOrg(t, repo)
+
+	template := TestRole(t, conn, org.PublicId, WithProjectTemplate(true), WithProjectTemplateSync(true))
+	TestRoleGrant(t, conn, template.PublicId, "id=*;type=target;actions=read")
+	proj := TestProject(t, repo, org.PublicId)
+	var copies []*Role
+	require.NoError(t, repo.reader.SearchWhere(ctx, &copies, "scope_id = ? and template_role_id = ?", []any{proj.PublicId, template.PublicId}))
+	require.Len(t, copies, 1)
+	derived := copies[0]
+
+	// the boundary is added after the template's grants, which are not
+	// restricted by it since they are in the org
+	boundary := TestRole(t, conn, org.PublicId, WithGrantBoundary(true))
+	TestRoleGrant(t, conn, boundary.PublicId, "id=*;type=target;actions=read")
+
+	t.Run("sync-exceeds-boundary", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		current, _, _, err := repo.LookupRole(ctx, template.PublicId)
+		require.NoError(err)
+		_, err = repo.AddRoleGrants(ctx, template.PublicId, current.Version, []string{"id=*;type=session;actions=list"})
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.Forbidden), err))
+
+		// the template's grants were rolled back along with the sync
+		grants, err := repo.ListRoleGrants(ctx, template.PublicId)
+		require.NoError(err)
+		require.Len(grants, 1)
+		assert.Equal("id=*;type=target;actions=read", grants[0].RawGrant)
+		grants, err = repo.ListRoleGrants(ctx, derived.PublicId)
+		require.NoError(err)
+		require.Len(grants, 1)
+		assert.Equal("id=*;type=target;actions=read", grants[0].RawGrant)
+	})
+	t.Run("copy-exceeds-boundary", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		unsynced := TestRole(t, conn, org.PublicId, WithProjectTemplate(true))
+		TestRoleGrant(t, conn, unsynced.PublicId, "id=*;type=*;actions=*")
+
+		p, err := NewProject(org.PublicId)
+		require.NoError(err)
+		_, err = repo.CreateScope(ctx, p, "")
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.Forbidden), err))
+	})
+}
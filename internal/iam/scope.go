@@ -59,7 +59,13 @@ func NewProject(orgPublicId string, opt ...Option) (*Scope, error) {
 // friendly name. WithDescription specifies the scope's description. WithScope
 // specifies the Scope's parent and must be filled in. The type of the parent is
 // used to determine the type of the child. WithPrimaryAuthMethodId specifies
-// the primary auth method for the scope
+// the primary auth method for the scope. WithAuthTokenTimeToLive and
+// WithAuthTokenTimeToStale specify auth token lifetime overrides for org
+// scopes. WithAnnotationOwner, WithAnnotationCostCenter and
+// WithAnnotationTicketUrl specify the scope's annotations.
+// WithLoginDisplayName, WithLoginSupportContact and WithLoginMessageOfTheDay
+// specify the scope's login metadata. WithIsolated marks an org scope as
+// isolated. WithDeletionProtected protects the scope from being deleted.
 func newScope(parent *Scope, opt ...Option) (*Scope, error) {
 	const op = "iam.newScope"
 	if parent == nil || parent.PublicId == "" {
@@ -77,13 +83,29 @@ func newScope(parent *Scope, opt ...Option) (*Scope, error) {
 	}
 
 	opts := getOpts(opt...)
+	if typ != scope.Org && (opts.withAuthTokenTimeToLive != 0 || opts.withAuthTokenTimeToStale != 0) {
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "auth token lifetimes can only be set on org scopes")
+	}
+	if typ != scope.Org && opts.withIsolated {
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "only org scopes can be isolated")
+	}
 	s := &Scope{
 		Scope: &store.Scope{
-			Type:                typ.String(),
-			Name:                opts.withName,
-			Description:         opts.withDescription,
-			ParentId:            parent.PublicId,
-			PrimaryAuthMethodId: opts.withPrimaryAuthMethodId,
+			Type:                        typ.String(),
+			Name:                        opts.withName,
+			Description:                 opts.withDescription,
+			ParentId:                    parent.PublicId,
+			PrimaryAuthMethodId:         opts.withPrimaryAuthMethodId,
+			AuthTokenTimeToLiveSeconds:  opts.withAuthTokenTimeToLive,
+			AuthTokenTimeToStaleSeconds: opts.withAuthTokenTimeToStale,
+			AnnotationOwner:             opts.withAnnotationOwner,
+			AnnotationCostCenter:        opts.withAnnotationCostCenter,
+			AnnotationTicketUrl:         opts.withAnnotationTicketUrl,
+			LoginDisplayName:            opts.withLoginDisplayName,
+			LoginSupportContact:         opts.withLoginSupportContact,
+			LoginMessageOfTheDay:        opts.withLoginMessageOfTheDay,
+			Isolated:                    opts.withIsolated,
+			DeletionProtected:           opts.withDeletionProtected,
 		},
 	}
 
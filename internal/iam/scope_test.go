@@ -54,6 +54,82 @@ func TestScope_New(t *testing.T) {
 		require.Nil(s)
 		assert.Contains(err.Error(), "iam.NewProject: iam.newScope: child scope is missing its parent: parameter violation: error #100")
 	})
+	t.Run("org-with-auth-token-lifetimes", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		w := db.New(conn)
+		s, err := NewOrg(WithAuthTokenTimeToLive(3600), WithAuthTokenTimeToStale(600))
+		require.NoError(err)
+		s.PublicId, err = newScopeId(scope.Org)
+		require.NoError(err)
+		require.NoError(w.Create(context.Background(), s))
+		assert.Equal(uint32(3600), s.GetAuthTokenTimeToLiveSeconds())
+		assert.Equal(uint32(600), s.GetAuthTokenTimeToStaleSeconds())
+	})
+	t.Run("proj-with-auth-token-lifetimes", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		s, err := NewProject("o_1234567890", WithAuthTokenTimeToLive(3600))
+		require.Error(err)
+		require.Nil(s)
+		assert.Contains(err.Error(), "iam.NewProject: iam.newScope: auth token lifetimes can only be set on org scopes: parameter violation: error #100")
+	})
+	t.Run("isolated-org", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		w := db.New(conn)
+		s, err := NewOrg(WithIsolated(true))
+		require.NoError(err)
+		s.PublicId, err = newScopeId(scope.Org)
+		require.NoError(err)
+		require.NoError(w.Create(context.Background(), s))
+		assert.True(s.GetIsolated())
+	})
+	t.Run("isolated-proj", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		s, err := NewProject("o_1234567890", WithIsolated(true))
+		require.Error(err)
+		require.Nil(s)
+		assert.Contains(err.Error(), "iam.NewProject: iam.newScope: only org scopes can be isolated: parameter violation: error #100")
+	})
+	t.Run("with-annotations", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		w := db.New(conn)
+		s, err := NewOrg(
+			WithAnnotationOwner("platform-team"),
+			WithAnnotationCostCenter("CC-1234"),
+			WithAnnotationTicketUrl("https://tickets.example.com/OPS-1"),
+		)
+		require.NoError(err)
+		s.PublicId, err = newScopeId(scope.Org)
+		require.NoError(err)
+		require.NoError(w.Create(context.Background(), s))
+		assert.Equal("platform-team", s.GetAnnotationOwner())
+		assert.Equal("CC-1234", s.GetAnnotationCostCenter())
+		assert.Equal("https://tickets.example.com/OPS-1", s.GetAnnotationTicketUrl())
+	})
+	t.Run("with-login-metadata", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		w := db.New(conn)
+		s, err := NewOrg(
+			WithLoginDisplayName("Engineering"),
+			WithLoginSupportContact("help@example.com"),
+			WithLoginMessageOfTheDay("Maintenance on Saturday"),
+		)
+		require.NoError(err)
+		s.PublicId, err = newScopeId(scope.Org)
+		require.NoError(err)
+		require.NoError(w.Create(context.Background(), s))
+		assert.Equal("Engineering", s.GetLoginDisplayName())
+		assert.Equal("help@example.com", s.GetLoginSupportContact())
+		assert.Equal("Maintenance on Saturday", s.GetLoginMessageOfTheDay())
+	})
+	t.Run("with-invalid-cost-center", func(t *testing.T) {
+		require := require.New(t)
+		w := db.New(conn)
+		s, err := NewOrg(WithAnnotationCostCenter("CC 1234"))
+		require.NoError(err)
+		s.PublicId, err = newScopeId(scope.Org)
+		require.NoError(err)
+		require.Error(w.Create(context.Background(), s))
+	})
 }
 
 func TestScope_Create(t *testing.T) {
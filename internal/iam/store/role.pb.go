@@ -57,6 +57,22 @@ type Role struct {
 	// the role's scope that is used when compiling these grants into an ACL
 	// @inject_tag: `gorm:"default:null"`
 	GrantScopeId string `protobuf:"bytes,80,opt,name=grant_scope_id,json=grantScopeId,proto3" json:"grant_scope_id,omitempty" gorm:"default:null"`
+	// grant_boundary marks the role as a boundary role; its grants cap the
+	// grants that can be added to roles in child scopes of the role's scope
+	// @inject_tag: `gorm:"not_null;default:false"`
+	GrantBoundary bool `protobuf:"varint,90,opt,name=grant_boundary,json=grantBoundary,proto3" json:"grant_boundary,omitempty" gorm:"not_null;default:false"`
+	// project_template marks an org role as a template which is copied into
+	// every new project created in the org
+	// @inject_tag: `gorm:"not_null;default:false"`
+	ProjectTemplate bool `protobuf:"varint,100,opt,name=project_template,json=projectTemplate,proto3" json:"project_template,omitempty" gorm:"not_null;default:false"`
+	// project_template_sync indicates that changes to a template role's grants
+	// are applied to the roles copied from it
+	// @inject_tag: `gorm:"not_null;default:false"`
+	ProjectTemplateSync bool `protobuf:"varint,110,opt,name=project_template_sync,json=projectTemplateSync,proto3" json:"project_template_sync,omitempty" gorm:"not_null;default:false"`
+	// template_role_id is the id of the template role this role was copied
+	// from, if any
+	// @inject_tag: `gorm:"default:null"`
+	TemplateRoleId string `protobuf:"bytes,120,opt,name=template_role_id,json=templateRoleId,proto3" json:"template_role_id,omitempty" gorm:"default:null"`
 }
 
 func (x *Role) Reset() {
@@ -147,6 +163,34 @@ func (x *Role) GetGrantScopeId() string {
 	return ""
 }
 
+func (x *Role) GetGrantBoundary() bool {
+	if x != nil {
+		return x.GrantBoundary
+	}
+	return false
+}
+
+func (x *Role) GetProjectTemplate() bool {
+	if x != nil {
+		return x.ProjectTemplate
+	}
+	return false
+}
+
+func (x *Role) GetProjectTemplateSync() bool {
+	if x != nil {
+		return x.ProjectTemplateSync
+	}
+	return false
+}
+
+func (x *Role) GetTemplateRoleId() string {
+	if x != nil {
+		return x.TemplateRoleId
+	}
+	return ""
+}
+
 var File_controller_storage_iam_store_v1_role_proto protoreflect.FileDescriptor
 
 var file_controller_storage_iam_store_v1_role_proto_rawDesc = []byte{
@@ -160,7 +204,7 @@ var file_controller_storage_iam_store_v1_role_proto_rawDesc = []byte{
 	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72,
 	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x74, 0x69,
 	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa4, 0x03, 0x0a, 0x04, 0x52,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd4, 0x05, 0x0a, 0x04, 0x52,
 	0x6f, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64,
 	0x12, 0x19, 0x0a, 0x08, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x14, 0x20, 0x01,
@@ -187,6 +231,25 @@ var file_controller_storage_iam_store_v1_role_proto_rawDesc = []byte{
 	0x22, 0xc2, 0xdd, 0x29, 0x1e, 0x0a, 0x0c, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x70,
 	0x65, 0x49, 0x64, 0x12, 0x0e, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65,
 	0x5f, 0x69, 0x64, 0x52, 0x0c, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x49,
+	0x64, 0x12, 0x4a, 0x0a, 0x0e, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64,
+	0x61, 0x72, 0x79, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x08, 0x42, 0x23, 0xc2, 0xdd, 0x29, 0x1f, 0x0a,
+	0x0d, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x12, 0x0e,
+	0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x52, 0x0d,
+	0x67, 0x72, 0x61, 0x6e, 0x74, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x12, 0x52, 0x0a,
+	0x10, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x42, 0x27, 0xc2, 0xdd, 0x29, 0x23, 0x0a, 0x0f, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x10,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x52, 0x0f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x12, 0x64, 0x0a, 0x15, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x30, 0xc2, 0xdd, 0x29, 0x2c, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x15, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x79,
+	0x6e, 0x63, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x78, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x49,
 	0x64, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
 	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61,
 	0x72, 0x79, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x69, 0x61, 0x6d, 0x2f,
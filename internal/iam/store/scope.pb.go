@@ -60,6 +60,48 @@ type Scope struct {
 	// users.
 	// @inject_tag: `gorm:"default:null"`
 	PrimaryAuthMethodId string `protobuf:"bytes,20,opt,name=primary_auth_method_id,json=primaryAuthMethodId,proto3" json:"primary_auth_method_id,omitempty" gorm:"default:null"`
+	// auth_token_time_to_live_seconds optionally overrides the controller's
+	// configured auth token time to live for tokens issued to accounts in the
+	// scope.  Only valid for org scopes.
+	// @inject_tag: `gorm:"default:null"`
+	AuthTokenTimeToLiveSeconds uint32 `protobuf:"varint,30,opt,name=auth_token_time_to_live_seconds,json=authTokenTimeToLiveSeconds,proto3" json:"auth_token_time_to_live_seconds,omitempty" gorm:"default:null"`
+	// auth_token_time_to_stale_seconds optionally overrides the controller's
+	// configured auth token time to stale for tokens issued to accounts in the
+	// scope.  Only valid for org scopes.
+	// @inject_tag: `gorm:"default:null"`
+	AuthTokenTimeToStaleSeconds uint32 `protobuf:"varint,31,opt,name=auth_token_time_to_stale_seconds,json=authTokenTimeToStaleSeconds,proto3" json:"auth_token_time_to_stale_seconds,omitempty" gorm:"default:null"`
+	// annotation_owner is the optional person or team which owns the scope.
+	// @inject_tag: `gorm:"default:null"`
+	AnnotationOwner string `protobuf:"bytes,40,opt,name=annotation_owner,json=annotationOwner,proto3" json:"annotation_owner,omitempty" gorm:"default:null"`
+	// annotation_cost_center is the optional cost center the scope is charged
+	// to.
+	// @inject_tag: `gorm:"default:null"`
+	AnnotationCostCenter string `protobuf:"bytes,41,opt,name=annotation_cost_center,json=annotationCostCenter,proto3" json:"annotation_cost_center,omitempty" gorm:"default:null"`
+	// annotation_ticket_url is an optional link to the ticket which tracks the
+	// scope.
+	// @inject_tag: `gorm:"default:null"`
+	AnnotationTicketUrl string `protobuf:"bytes,42,opt,name=annotation_ticket_url,json=annotationTicketUrl,proto3" json:"annotation_ticket_url,omitempty" gorm:"default:null"`
+	// login_display_name is the optional name of the scope shown to users
+	// logging in to it.
+	// @inject_tag: `gorm:"default:null"`
+	LoginDisplayName string `protobuf:"bytes,50,opt,name=login_display_name,json=loginDisplayName,proto3" json:"login_display_name,omitempty" gorm:"default:null"`
+	// login_support_contact is the optional contact for users having trouble
+	// logging in to the scope.
+	// @inject_tag: `gorm:"default:null"`
+	LoginSupportContact string `protobuf:"bytes,51,opt,name=login_support_contact,json=loginSupportContact,proto3" json:"login_support_contact,omitempty" gorm:"default:null"`
+	// login_message_of_the_day is an optional message shown to users logging
+	// in to the scope.
+	// @inject_tag: `gorm:"default:null"`
+	LoginMessageOfTheDay string `protobuf:"bytes,52,opt,name=login_message_of_the_day,json=loginMessageOfTheDay,proto3" json:"login_message_of_the_day,omitempty" gorm:"default:null"`
+	// isolated marks an org as isolated from the roles of the global scope
+	// when the controller runs with tenant isolation enabled.  Only valid for
+	// org scopes.
+	// @inject_tag: `gorm:"default:false"`
+	Isolated bool `protobuf:"varint,60,opt,name=isolated,proto3" json:"isolated,omitempty" gorm:"default:false"`
+	// deletion_protected prevents the scope from being deleted until it is
+	// cleared by a user granted the clear-deletion-protection action.
+	// @inject_tag: `gorm:"default:false"`
+	DeletionProtected bool `protobuf:"varint,70,opt,name=deletion_protected,json=deletionProtected,proto3" json:"deletion_protected,omitempty" gorm:"default:false"`
 }
 
 func (x *Scope) Reset() {
@@ -157,6 +199,76 @@ func (x *Scope) GetPrimaryAuthMethodId() string {
 	return ""
 }
 
+func (x *Scope) GetAuthTokenTimeToLiveSeconds() uint32 {
+	if x != nil {
+		return x.AuthTokenTimeToLiveSeconds
+	}
+	return 0
+}
+
+func (x *Scope) GetAuthTokenTimeToStaleSeconds() uint32 {
+	if x != nil {
+		return x.AuthTokenTimeToStaleSeconds
+	}
+	return 0
+}
+
+func (x *Scope) GetAnnotationOwner() string {
+	if x != nil {
+		return x.AnnotationOwner
+	}
+	return ""
+}
+
+func (x *Scope) GetAnnotationCostCenter() string {
+	if x != nil {
+		return x.AnnotationCostCenter
+	}
+	return ""
+}
+
+func (x *Scope) GetAnnotationTicketUrl() string {
+	if x != nil {
+		return x.AnnotationTicketUrl
+	}
+	return ""
+}
+
+func (x *Scope) GetLoginDisplayName() string {
+	if x != nil {
+		return x.LoginDisplayName
+	}
+	return ""
+}
+
+func (x *Scope) GetLoginSupportContact() string {
+	if x != nil {
+		return x.LoginSupportContact
+	}
+	return ""
+}
+
+func (x *Scope) GetLoginMessageOfTheDay() string {
+	if x != nil {
+		return x.LoginMessageOfTheDay
+	}
+	return ""
+}
+
+func (x *Scope) GetIsolated() bool {
+	if x != nil {
+		return x.Isolated
+	}
+	return false
+}
+
+func (x *Scope) GetDeletionProtected() bool {
+	if x != nil {
+		return x.DeletionProtected
+	}
+	return false
+}
+
 var File_controller_storage_iam_store_v1_scope_proto protoreflect.FileDescriptor
 
 var file_controller_storage_iam_store_v1_scope_proto_rawDesc = []byte{
@@ -170,7 +282,7 @@ var file_controller_storage_iam_store_v1_scope_proto_rawDesc = []byte{
 	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2f, 0x63, 0x6f, 0x6e, 0x74,
 	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x74,
 	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd9, 0x03, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf1, 0x0b, 0x0a, 0x05,
 	0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f,
 	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
 	0x49, 0x64, 0x12, 0x4b, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d,
@@ -200,11 +312,77 @@ var file_controller_storage_iam_store_v1_scope_proto_rawDesc = []byte{
 	0x74, 0x68, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x49, 0x64, 0x12, 0x16, 0x70, 0x72, 0x69, 0x6d,
 	0x61, 0x72, 0x79, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f,
 	0x69, 0x64, 0x52, 0x13, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x41, 0x75, 0x74, 0x68, 0x4d,
-	0x65, 0x74, 0x68, 0x6f, 0x64, 0x49, 0x64, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2f,
-	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x2f, 0x69, 0x61, 0x6d, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x3b, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x49, 0x64, 0x12, 0x86, 0x01, 0x0a, 0x1f, 0x61, 0x75, 0x74, 0x68,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x6c,
+	0x69, 0x76, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x1e, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x41, 0xc2, 0xdd, 0x29, 0x3d, 0x0a, 0x1a, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x4c, 0x69, 0x76, 0x65, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x1f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x6c, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x52, 0x1a, 0x61, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54,
+	0x69, 0x6d, 0x65, 0x54, 0x6f, 0x4c, 0x69, 0x76, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x12, 0x8a, 0x01, 0x0a, 0x20, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x43, 0xc2, 0xdd, 0x29,
+	0x3f, 0x0a, 0x1b, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x69, 0x6d, 0x65,
+	0x54, 0x6f, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x20,
+	0x61, 0x75, 0x74, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x74, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x52, 0x1b, 0x61, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x54,
+	0x6f, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x53, 0x0a,
+	0x10, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc2, 0xdd, 0x29, 0x24, 0x0a, 0x0f, 0x41,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x11,
+	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x52, 0x0f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x12, 0x69, 0x0a, 0x16, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x29, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x33, 0xc2, 0xdd, 0x29, 0x2f, 0x0a, 0x14, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x73, 0x74, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x17,
+	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x63, 0x6f, 0x73, 0x74,
+	0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x14, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x73, 0x74, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x65, 0x0a,
+	0x15, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc2, 0xdd,
+	0x29, 0x2d, 0x0a, 0x13, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x52,
+	0x13, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x55, 0x72, 0x6c, 0x12, 0x61, 0x0a, 0x12, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x32, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x33, 0xc2, 0xdd, 0x29, 0x2f, 0x0a, 0x10, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x44, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x10, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x44, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x6d, 0x0a, 0x15, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x18, 0x33, 0x20, 0x01, 0x28, 0x09, 0x42, 0x39, 0xc2, 0xdd, 0x29, 0x35, 0x0a, 0x13, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x12, 0x1e, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x2e, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x52, 0x13, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x75, 0x0a, 0x18, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x64,
+	0x61, 0x79, 0x18, 0x34, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3d, 0xc2, 0xdd, 0x29, 0x39, 0x0a, 0x14,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x66, 0x54, 0x68,
+	0x65, 0x44, 0x61, 0x79, 0x12, 0x21, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6f, 0x66, 0x5f,
+	0x74, 0x68, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x52, 0x14, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x66, 0x54, 0x68, 0x65, 0x44, 0x61, 0x79, 0x12, 0x34, 0x0a,
+	0x08, 0x69, 0x73, 0x6f, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x08, 0x42,
+	0x18, 0xc2, 0xdd, 0x29, 0x14, 0x0a, 0x08, 0x49, 0x73, 0x6f, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x08, 0x69, 0x73, 0x6f, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x52, 0x08, 0x69, 0x73, 0x6f, 0x6c, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x5a, 0x0a, 0x12, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x46, 0x20, 0x01, 0x28, 0x08, 0x42,
+	0x2b, 0xc2, 0xdd, 0x29, 0x27, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x12, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x11, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42,
+	0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61,
+	0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79,
+	0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x69, 0x61, 0x6d, 0x2f, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x3b, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/libs/crypto"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+)
+
+// blindIndexPrefix is prepended to blind indexes, to tell them apart from
+// other hmacs stored in the database.
+const blindIndexPrefix = "bidx:"
+
+// BlindIndex returns the blind index of the value of an encrypted field,
+// computed with the current database key of the scope. A blind index is an
+// hmac of the value keyed by a key derived from the database key and the
+// field, so an encrypted field can be looked up by value with an equality
+// match on an indexed column instead of decrypting and comparing every row.
+// The field names the column the value is stored in, for example
+// "auth_password_account.login_name", so that equal values stored in different
+// fields have unrelated indexes.
+//
+// The id of the key version used is returned along with the index. It should
+// be stored with the index, and the index recomputed with the current key
+// version when the row is rewrapped; use BlindIndexes to look up values whose
+// rows may not have been rewrapped yet.
+func (k *Kms) BlindIndex(ctx context.Context, scopeId, field string, value []byte) (string, string, error) {
+	const op = "kms.(Kms).BlindIndex"
+	switch {
+	case field == "":
+		return "", "", errors.New(ctx, errors.InvalidParameter, op, "missing field")
+	case value == nil:
+		return "", "", errors.New(ctx, errors.InvalidParameter, op, "missing value")
+	}
+	wrapper, err := k.GetWrapper(ctx, scopeId, KeyPurposeDatabase)
+	if err != nil {
+		return "", "", errors.Wrap(ctx, err, op)
+	}
+	keyId, err := wrapper.KeyId(ctx)
+	if err != nil {
+		return "", "", errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get key id"))
+	}
+	idx, err := blindIndex(ctx, wrapper, field, value)
+	if err != nil {
+		return "", "", errors.Wrap(ctx, err, op)
+	}
+	return idx, keyId, nil
+}
+
+// BlindIndexes returns the blind indexes of the value of an encrypted field
+// computed with every version of the database key of the scope, current
+// version first. Looking up rows whose index is in the returned set finds the
+// ones indexed with an older key version which have not been rewrapped yet.
+// See BlindIndex for details.
+func (k *Kms) BlindIndexes(ctx context.Context, scopeId, field string, value []byte) ([]string, error) {
+	const op = "kms.(Kms).BlindIndexes"
+	switch {
+	case field == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing field")
+	case value == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing value")
+	}
+	current, _, err := k.BlindIndex(ctx, scopeId, field, value)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	keys, err := k.ListKeys(ctx, scopeId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	ret := []string{current}
+	for _, key := range keys {
+		if string(key.Purpose) != KeyPurposeDatabase.String() {
+			continue
+		}
+		for _, v := range key.Versions {
+			wrapper, err := k.GetWrapper(ctx, scopeId, KeyPurposeDatabase, WithKeyId(v.Id))
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			idx, err := blindIndex(ctx, wrapper, field, value)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			if idx != current {
+				ret = append(ret, idx)
+			}
+		}
+	}
+	return ret, nil
+}
+
+// blindIndex computes the blind index of the value with the key of the
+// wrapper. When given a multiwrapper its encrypting wrapper is used.
+func blindIndex(ctx context.Context, wrapper wrapping.Wrapper, field string, value []byte) (string, error) {
+	const op = "kms.blindIndex"
+	idx, err := crypto.HmacSha256(ctx, value, wrapper, []byte(field), []byte("blind-index"), crypto.WithPrefix(blindIndexPrefix), crypto.WithBase64Encoding())
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	return idx, nil
+}
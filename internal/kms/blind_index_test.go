@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_blindIndex(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	wrapper := db.TestWrapper(t)
+
+	idx, err := blindIndex(ctx, wrapper, "auth_password_account.login_name", []byte("alice"))
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(idx, blindIndexPrefix))
+
+	again, err := blindIndex(ctx, wrapper, "auth_password_account.login_name", []byte("alice"))
+	require.NoError(t, err)
+	assert.Equal(t, idx, again, "blind indexes must be deterministic")
+
+	otherValue, err := blindIndex(ctx, wrapper, "auth_password_account.login_name", []byte("bob"))
+	require.NoError(t, err)
+	assert.NotEqual(t, idx, otherValue)
+
+	otherField, err := blindIndex(ctx, wrapper, "auth_ldap_account.login_name", []byte("alice"))
+	require.NoError(t, err)
+	assert.NotEqual(t, idx, otherField)
+
+	otherKey, err := blindIndex(ctx, db.TestWrapper(t), "auth_password_account.login_name", []byte("alice"))
+	require.NoError(t, err)
+	assert.NotEqual(t, idx, otherKey)
+}
+
+func Test_BlindIndexes(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	kmsCache := TestKms(t, conn, db.TestWrapper(t))
+	require.NoError(t, kmsCache.CreateKeys(ctx, "global"))
+
+	const field = "auth_password_account.login_name"
+	_, _, err := kmsCache.BlindIndex(ctx, "global", "", []byte("alice"))
+	require.Error(t, err)
+	_, _, err = kmsCache.BlindIndex(ctx, "global", field, nil)
+	require.Error(t, err)
+
+	idx, keyId, err := kmsCache.BlindIndex(ctx, "global", field, []byte("alice"))
+	require.NoError(t, err)
+	assert.NotEmpty(t, keyId)
+
+	idxs, err := kmsCache.BlindIndexes(ctx, "global", field, []byte("alice"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{idx}, idxs)
+
+	require.NoError(t, kmsCache.RotateKeys(ctx, "global"))
+
+	rotatedIdx, rotatedKeyId, err := kmsCache.BlindIndex(ctx, "global", field, []byte("alice"))
+	require.NoError(t, err)
+	assert.NotEqual(t, idx, rotatedIdx)
+	assert.NotEqual(t, keyId, rotatedKeyId)
+
+	// Rows indexed with the previous key version are still found
+	idxs, err = kmsCache.BlindIndexes(ctx, "global", field, []byte("alice"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{rotatedIdx, idx}, idxs)
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"crypto/ed25519"
+	"io"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/libs/crypto"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+)
+
+// sessionAuthorizationSigningInfo is the context info used to derive the
+// keys signing session authorizations from the sessions key.
+const sessionAuthorizationSigningInfo = "session-authorization-signing"
+
+// SessionAuthorizationSigningKey returns the Ed25519 key that signs the
+// session authorizations of targets in the scope, along with the id of the
+// version of the sessions key it is derived from. The public key of every
+// version can be retrieved with SessionAuthorizationPublicKey, so that
+// signatures can be verified by parties that do not have access to the kms.
+func (k *Kms) SessionAuthorizationSigningKey(ctx context.Context, scopeId string) (ed25519.PrivateKey, string, error) {
+	const op = "kms.(Kms).SessionAuthorizationSigningKey"
+	wrapper, err := k.GetWrapper(ctx, scopeId, KeyPurposeSessions)
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+	keyId, err := wrapper.KeyId(ctx)
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get key id"))
+	}
+	key, err := sessionAuthorizationSigningKey(ctx, wrapper)
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+	return key, keyId, nil
+}
+
+// SessionAuthorizationPublicKey returns the public key verifying the session
+// authorizations signed with the key derived from the given version of the
+// sessions key of the scope.
+func (k *Kms) SessionAuthorizationPublicKey(ctx context.Context, scopeId, keyId string) (ed25519.PublicKey, error) {
+	const op = "kms.(Kms).SessionAuthorizationPublicKey"
+	if keyId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing key id")
+	}
+	wrapper, err := k.GetWrapper(ctx, scopeId, KeyPurposeSessions, WithKeyId(keyId))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	key, err := sessionAuthorizationSigningKey(ctx, wrapper)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return key.Public().(ed25519.PublicKey), nil
+}
+
+// sessionAuthorizationSigningKey derives the signing key from the key of the
+// wrapper. When given a multiwrapper its encrypting wrapper is used.
+func sessionAuthorizationSigningKey(ctx context.Context, wrapper wrapping.Wrapper) (ed25519.PrivateKey, error) {
+	const op = "kms.sessionAuthorizationSigningKey"
+	reader, err := crypto.NewDerivedReader(ctx, wrapper, ed25519.SeedSize, nil, []byte(sessionAuthorizationSigningInfo))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	seed := make([]byte, ed25519.SeedSize)
+	if _, err := io.ReadFull(reader, seed); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to read seed"))
+	}
+	return ed25519.NewKeyFromSeed(seed), nil
+}
@@ -17,6 +17,7 @@ type matcher interface {
 var (
 	_ matcher = (*dnsMatcher)(nil)
 	_ matcher = (*cidrMatcher)(nil)
+	_ matcher = (*familyMatcher)(nil)
 )
 
 // DnsMatcher is a function that given an input returns true if there is a
@@ -49,3 +50,18 @@ func (m cidrMatcher) Match(in string) bool {
 	}
 	return m.ipNet.Contains(ip)
 }
+
+// familyMatcher is a function that given an input returns true if the input is
+// an IP address of the given family
+type familyMatcher struct {
+	ipv6 bool
+}
+
+// Match satisfies the matcher interface
+func (m familyMatcher) Match(in string) bool {
+	ip := net.ParseIP(in)
+	if ip == nil {
+		return false
+	}
+	return (ip.To4() == nil) == m.ipv6
+}
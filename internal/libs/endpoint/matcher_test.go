@@ -48,4 +48,17 @@ func TestMatchers(t *testing.T) {
 		assert.True(t, d.Match("2001:1234:3092::abcd:dead:beef:2423"))
 		assert.False(t, d.Match("2001:1244:3092::abcd:dead:beef:2423"))
 	})
+	t.Run("familyMatcherIpv4Matcher", func(t *testing.T) {
+		d := familyMatcher{}
+		assert.True(t, d.Match("1.2.3.4"))
+		assert.False(t, d.Match("2001:1234::1"))
+		assert.False(t, d.Match("foo.bar"))
+	})
+	t.Run("familyMatcherIpv6Matcher", func(t *testing.T) {
+		d := familyMatcher{ipv6: true}
+		assert.True(t, d.Match("2001:1234::1"))
+		assert.False(t, d.Match("1.2.3.4"))
+		assert.False(t, d.Match("::ffff:1.2.3.4"))
+		assert.False(t, d.Match("foo.bar"))
+	})
 }
@@ -97,6 +97,16 @@ func WithPreferenceOrder(with []string) Option {
 					pattern: pattern,
 				}
 
+			case strings.HasPrefix(input, "family:"):
+				switch family := strings.TrimPrefix(input, "family:"); family {
+				case "ipv4":
+					m = familyMatcher{}
+				case "ipv6":
+					m = familyMatcher{ipv6: true}
+				default:
+					return fmt.Errorf("unknown address family %q, must be ipv4 or ipv6", family)
+				}
+
 			default:
 				return fmt.Errorf("preference string %q is not supported", input)
 			}
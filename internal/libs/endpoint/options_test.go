@@ -43,6 +43,20 @@ func Test_GetOpts(t *testing.T) {
 		_, err := getOpts(WithPreferenceOrder([]string{"dns:"}))
 		require.Error(t, err)
 	})
+	t.Run("WithPreferenceOrderBadFamily", func(t *testing.T) {
+		_, err := getOpts(WithPreferenceOrder([]string{"family:ipv5"}))
+		require.Error(t, err)
+	})
+	t.Run("WithPreferenceOrderFamily", func(t *testing.T) {
+		opts, err := getOpts(WithPreferenceOrder([]string{"family:ipv6", "family:ipv4"}))
+		require.NoError(t, err)
+		testOpts := getDefaultOptions()
+		testOpts.withMatchers = []matcher{
+			familyMatcher{ipv6: true},
+			familyMatcher{},
+		}
+		assert.Equal(t, opts, testOpts)
+	})
 	t.Run("WithPreferenceOrderBadPref", func(t *testing.T) {
 		_, err := getOpts(WithPreferenceOrder([]string{"abc:15.3.25.6/33", "1.2.3.4"}))
 		require.Error(t, err)
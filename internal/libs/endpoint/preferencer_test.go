@@ -152,4 +152,24 @@ func TestPreferencer(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, exp, out)
 	})
+	t.Run("familyPreferenceReturnsIp6", func(t *testing.T) {
+		const exp = "2001::1"
+		p, err := NewPreferencer(ctx, WithPreferenceOrder([]string{"family:ipv6", "family:ipv4"}))
+		require.NoError(t, err)
+		out, err := p.Choose(
+			ctx,
+			WithIpAddrs([]string{"192.168.4.3", exp}),
+			WithDnsNames([]string{"foo.bar.com"}),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, exp, out)
+
+		out, err = p.Choose(
+			ctx,
+			WithIpAddrs([]string{"192.168.4.3"}),
+			WithDnsNames([]string{"foo.bar.com"}),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, "192.168.4.3", out)
+	})
 }
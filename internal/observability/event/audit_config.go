@@ -5,6 +5,7 @@ package event
 
 import (
 	"fmt"
+	"regexp"
 
 	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
 )
@@ -16,13 +17,28 @@ type AuditConfig struct {
 	FilterOverrides    AuditFilterOperations `hcl:"-"`
 	FilterOverridesHCL map[string]string     `hcl:"audit_filter_overrides"`
 
+	// RedactionPatterns provide an optional set of regular expressions.  Any
+	// text in an audit event's values which matches one of them is redacted.
+	RedactionPatterns []string `hcl:"audit_redaction_patterns"`
+
+	// RedactionFields provide an optional set of field paths (e.g.
+	// "request.details.item.attributes.token") whose values are redacted
+	// from audit events.
+	RedactionFields []string `hcl:"audit_redaction_fields"`
+
+	// KmsKeyId optionally specifies the key_id of a kms with the audit-sink
+	// purpose, which is used for the sink's audit event crypto operations
+	// (encrypt and hmac-sha256) instead of the controller's audit key.
+	KmsKeyId string `hcl:"kms_key_id"`
+
 	// wrapper to use for audit event crypto operations.
 	wrapper wrapping.Wrapper
 }
 
 // NewAuditConfig creates a new config starting with the DefaultAuditConfig()
-// and applying options. Supported options are: WithWrapper and
-// WithFilterOperations.
+// and applying options. Supported options are: WithWrapper,
+// WithFilterOperations, WithRedactionPatterns, WithRedactionFields and
+// WithAuditKmsKeyId.
 func NewAuditConfig(opt ...Option) (*AuditConfig, error) {
 	const op = "event.NewAuditConfig"
 	opts := getOpts(opt...)
@@ -33,6 +49,15 @@ func NewAuditConfig(opt ...Option) (*AuditConfig, error) {
 	if opts.withFilterOperations != nil {
 		c.FilterOverrides = opts.withFilterOperations
 	}
+	if opts.withRedactionPatterns != nil {
+		c.RedactionPatterns = opts.withRedactionPatterns
+	}
+	if opts.withRedactionFields != nil {
+		c.RedactionFields = opts.withRedactionFields
+	}
+	if opts.withAuditKmsKeyId != "" {
+		c.KmsKeyId = opts.withAuditKmsKeyId
+	}
 	if err := c.Validate(); err != nil {
 		return nil, fmt.Errorf("%s: invalid configuration: %w", op, err)
 	}
@@ -49,6 +74,17 @@ func (ac *AuditConfig) Validate() error {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	for _, p := range ac.RedactionPatterns {
+		if _, err := regexp.Compile(p); err != nil {
+			return fmt.Errorf("%s: invalid redaction pattern (%s): %s: %w", op, p, err, ErrInvalidParameter)
+		}
+	}
+	for _, f := range ac.RedactionFields {
+		if f == "" {
+			return fmt.Errorf("%s: empty redaction field: %w", op, ErrInvalidParameter)
+		}
+	}
+
 	// Note: we don't validate the wrapper here because it may not be set yet.
 
 	return nil
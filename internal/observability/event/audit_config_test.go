@@ -46,6 +46,22 @@ func TestAuditConfig_Validate(t *testing.T) {
 			wantIsError:     ErrInvalidParameter,
 			wantErrContains: "invalid filter override operation (invalid-operation)",
 		},
+		{
+			name: "invalid-redaction-pattern",
+			ac: &AuditConfig{
+				RedactionPatterns: []string{"tok_[a-z"},
+			},
+			wantIsError:     ErrInvalidParameter,
+			wantErrContains: "invalid redaction pattern (tok_[a-z)",
+		},
+		{
+			name: "empty-redaction-field",
+			ac: &AuditConfig{
+				RedactionFields: []string{""},
+			},
+			wantIsError:     ErrInvalidParameter,
+			wantErrContains: "empty redaction field",
+		},
 		{
 			name: "valid-default",
 			ac:   DefaultAuditConfig(),
@@ -86,12 +102,27 @@ func TestNewAuditConfig(t *testing.T) {
 		},
 		{
 			name: "valid-with-all-opts",
-			opts: []Option{WithAuditWrapper(wrapper), WithFilterOperations(filterOps)},
+			opts: []Option{
+				WithAuditWrapper(wrapper),
+				WithFilterOperations(filterOps),
+				WithRedactionPatterns(`tok_[a-z0-9]+`),
+				WithRedactionFields("request.details.token"),
+				WithAuditKmsKeyId("compliance"),
+			},
 			want: &AuditConfig{
-				FilterOverrides: filterOps,
-				wrapper:         wrapper,
+				FilterOverrides:   filterOps,
+				RedactionPatterns: []string{`tok_[a-z0-9]+`},
+				RedactionFields:   []string{"request.details.token"},
+				KmsKeyId:          "compliance",
+				wrapper:           wrapper,
 			},
 		},
+		{
+			name:            "invalid-redaction-pattern",
+			opts:            []Option{WithRedactionPatterns("tok_[a-z")},
+			wantIsError:     ErrInvalidParameter,
+			wantErrContains: "invalid redaction pattern",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
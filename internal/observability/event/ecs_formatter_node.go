@@ -0,0 +1,269 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package event
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/eventlogger"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+)
+
+const (
+	// ecsVersion defines the version of the Elastic Common Schema that events
+	// are formatted as
+	ecsVersion  = "8.11.0"
+	ecsNodeName = "ecs-formatter-filter"
+)
+
+// ecsFormatterFilter will format a boundary event as an Elastic Common Schema
+// (ECS) json entry.  Audit and observation events can optionally be sampled.
+type ecsFormatterFilter struct {
+	serverName string
+	// sampleRate is the fraction of audit and observation events which are
+	// kept.  Zero means every event is kept.
+	sampleRate float64
+	predicate  func(ctx context.Context, i any) (bool, error)
+	allow      []*filter
+	deny       []*filter
+	signer     signer
+	l          sync.RWMutex
+}
+
+// newECSFormatterFilter creates a new ECS filter node.  Supports the WithAllow,
+// WithDeny and WithSampleRate options.
+func newECSFormatterFilter(serverName string, opt ...Option) (*ecsFormatterFilter, error) {
+	const op = "event.newECSFormatterFilter"
+	opts := getOpts(opt...)
+	if opts.withSampleRate < 0 || opts.withSampleRate > 1 {
+		return nil, fmt.Errorf("%s: sample rate must be between 0 and 1: %w", op, ErrInvalidParameter)
+	}
+	n := ecsFormatterFilter{
+		serverName: serverName,
+		sampleRate: opts.withSampleRate,
+	}
+	// intentionally not checking if allow and/or deny optional filters were
+	// supplied since having a filter node with no filters is okay.
+
+	if len(opts.withAllow) > 0 {
+		n.allow = make([]*filter, 0, len((opts.withAllow)))
+		for i := range opts.withAllow {
+			f, err := newFilter(opts.withAllow[i])
+			if err != nil {
+				return nil, fmt.Errorf("%s: invalid allow filter '%s': %w", op, opts.withAllow[i], err)
+			}
+			n.allow = append(n.allow, f)
+		}
+	}
+	if len(opts.withDeny) > 0 {
+		n.deny = make([]*filter, 0, len((opts.withDeny)))
+		for i := range opts.withDeny {
+			f, err := newFilter(opts.withDeny[i])
+			if err != nil {
+				return nil, fmt.Errorf("%s: invalid deny filter '%s': %w", op, opts.withDeny[i], err)
+			}
+			n.deny = append(n.deny, f)
+		}
+	}
+	// the predicate is applied to the event payload, just like the hclog
+	// formatter, so its default deny filters can be reused.
+	defaultDenyFilters, err := defaultHclogEventsDenyFilters()
+	if err != nil {
+		return nil, err
+	}
+	n.deny = append(n.deny, defaultDenyFilters...)
+	n.predicate = newPredicate(n.allow, n.deny)
+
+	return &n, nil
+}
+
+// Rotate supports rotating the filter's wrapper. No options are currently
+// supported.
+func (f *ecsFormatterFilter) Rotate(w wrapping.Wrapper, _ ...Option) error {
+	const op = "event.(ecsFormatterFilter).Rotate"
+	if w == nil {
+		return fmt.Errorf("%s: missing wrapper: %w", op, ErrInvalidParameter)
+	}
+	f.l.Lock()
+	defer f.l.Unlock()
+	h, err := newSigner(context.Background(), w, nil, nil)
+	if err != nil {
+		return err
+	}
+	f.signer = h
+	return nil
+}
+
+// Reopen is a no op
+func (_ *ecsFormatterFilter) Reopen() error { return nil }
+
+// Type describes the type of the node as a Formatter.
+func (_ *ecsFormatterFilter) Type() eventlogger.NodeType {
+	return eventlogger.NodeTypeFormatterFilter
+}
+
+// Name returns a representation of the ECS formatter's name
+func (_ *ecsFormatterFilter) Name() string {
+	return ecsNodeName
+}
+
+// Process formats the Boundary event as an ECS json entry and stores that
+// formatted data in Event.Formatted with a key of "ecs-json"
+// (ECSJSONSinkFormat).  The Boundary event is included in the entry under the
+// "boundary" key.
+//
+// If the node has a Predicate, then the filter will be applied to
+// event.Payload.  If the node has a sample rate, then audit and observation
+// events are sampled after the predicate is applied.
+func (f *ecsFormatterFilter) Process(ctx context.Context, e *eventlogger.Event) (*eventlogger.Event, error) {
+	const op = "event.(ecsFormatterFilter).Process"
+	if e == nil {
+		return nil, errors.New("event is nil")
+	}
+
+	if f.predicate != nil {
+		keep, err := f.predicate(ctx, e.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("%s: unable to filter: %w", op, err)
+		}
+		if !keep {
+			// Return nil to signal that the event should be discarded.
+			return nil, nil
+		}
+	}
+
+	timestamp := e.CreatedAt
+	var id, action, level, errMsg string
+	var reqInfo *RequestInfo
+	switch string(e.Type) {
+	case string(AuditType):
+		a, ok := e.Payload.(*audit)
+		if !ok {
+			return nil, fmt.Errorf("%s: audit event payload is not an audit: %T", op, e.Payload)
+		}
+		id, action, level, reqInfo = a.Id, a.Type, "info", a.RequestInfo
+		if !a.Timestamp.IsZero() {
+			timestamp = a.Timestamp
+		}
+	case string(ObservationType):
+		m, ok := e.Payload.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s: observation event payload is not a map: %T", op, e.Payload)
+		}
+		level = "info"
+		reqInfo, _ = m[RequestInfoField].(*RequestInfo)
+	case string(ErrorType):
+		er, ok := e.Payload.(*err)
+		if !ok {
+			return nil, fmt.Errorf("%s: error event payload is not an error: %T", op, e.Payload)
+		}
+		id, action, level, errMsg, reqInfo = string(er.Id), string(er.Op), "error", er.Error, er.RequestInfo
+	case string(SystemType):
+		s, ok := e.Payload.(*sysEvent)
+		if !ok {
+			return nil, fmt.Errorf("%s: system event payload is not a system event: %T", op, e.Payload)
+		}
+		id, action, level = string(s.Id), string(s.Op), "info"
+	default:
+		return nil, fmt.Errorf("%s: unknown event type %s", op, e.Type)
+	}
+
+	switch string(e.Type) {
+	case string(AuditType), string(ObservationType):
+		sampleKey := id
+		if reqInfo != nil && reqInfo.Id != "" {
+			sampleKey = reqInfo.Id
+		}
+		if !f.sampled(sampleKey) {
+			return nil, nil
+		}
+	}
+
+	entry := map[string]any{
+		"@timestamp": timestamp.UTC().Format(time.RFC3339Nano),
+		"message":    string(e.Type) + " event",
+		"ecs":        map[string]any{"version": ecsVersion},
+		"log":        map[string]any{"level": level},
+		"service":    map[string]any{"type": "boundary"},
+		"boundary":   e.Payload,
+	}
+	if f.serverName != "" {
+		entry["service"] = map[string]any{"type": "boundary", "node": map[string]any{"name": f.serverName}}
+	}
+	ecsEvent := map[string]any{
+		"kind":    "event",
+		"module":  "boundary",
+		"dataset": "boundary." + string(e.Type),
+	}
+	if id != "" {
+		ecsEvent["id"] = id
+	}
+	if action != "" {
+		ecsEvent["action"] = action
+	}
+	entry["event"] = ecsEvent
+	if errMsg != "" {
+		entry["error"] = map[string]any{"message": errMsg}
+	}
+	if reqInfo != nil {
+		if reqInfo.Id != "" {
+			entry["http"] = map[string]any{"request": map[string]any{"id": reqInfo.Id}}
+		}
+		if reqInfo.Path != "" {
+			entry["url"] = map[string]any{"path": reqInfo.Path}
+		}
+		if reqInfo.ClientIp != "" {
+			entry["client"] = map[string]any{"ip": reqInfo.ClientIp}
+		}
+	}
+
+	buf, err := json.Marshal(entry)
+	if err != nil {
+		return nil, fmt.Errorf("%s: unable to format: %w", op, err)
+	}
+	f.l.RLock()
+	s := f.signer
+	f.l.RUnlock()
+	if s != nil && string(e.Type) == string(AuditType) {
+		bufHmac, err := s(ctx, buf)
+		if err != nil {
+			return nil, fmt.Errorf("%s: unable to hmac-sha256: %w", op, err)
+		}
+		entry["serialized"] = base64.RawURLEncoding.EncodeToString(buf)
+		entry["serialized_hmac"] = bufHmac
+		buf, err = json.Marshal(entry)
+		if err != nil {
+			return nil, fmt.Errorf("%s: unable to format after hmac-sha256: %w", op, err)
+		}
+	}
+	e.FormattedAs(string(ECSJSONSinkFormat), append(buf, '\n'))
+
+	return e, nil
+}
+
+// sampled reports whether an event with the sample key should be kept.  The
+// key is hashed, so every event with the same key (such as the audit and
+// observation events of a request) is either kept or discarded together.
+func (f *ecsFormatterFilter) sampled(key string) bool {
+	if f.sampleRate <= 0 || f.sampleRate >= 1 {
+		return true
+	}
+	if key == "" {
+		return rand.Float64() < f.sampleRate
+	}
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	return float64(h.Sum64())/math.MaxUint64 < f.sampleRate
+}
+
+var _ eventlogger.Node = &ecsFormatterFilter{}
@@ -0,0 +1,321 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/eventlogger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestECSFormatter_Process(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	testAudit := func() *audit {
+		return &audit{
+			Id:        "audit_1",
+			Version:   auditVersion,
+			Type:      string(ApiRequest),
+			Timestamp: now,
+			RequestInfo: &RequestInfo{
+				Id:       "req_1",
+				Method:   "GET",
+				Path:     "/v1/targets",
+				ClientIp: "127.0.0.1",
+			},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		formatter       func(t *testing.T) *ecsFormatterFilter
+		e               *eventlogger.Event
+		wantErrContains string
+		wantDiscarded   bool
+		want            map[string]any
+	}{
+		{
+			name: "nil event",
+			formatter: func(t *testing.T) *ecsFormatterFilter {
+				return &ecsFormatterFilter{}
+			},
+			wantErrContains: "event is nil",
+		},
+		{
+			name: "invalid-event-type",
+			formatter: func(t *testing.T) *ecsFormatterFilter {
+				return &ecsFormatterFilter{}
+			},
+			e:               &eventlogger.Event{Type: eventlogger.EventType("invalid-type")},
+			wantErrContains: "unknown event type invalid-type",
+		},
+		{
+			name: "audit",
+			formatter: func(t *testing.T) *ecsFormatterFilter {
+				f, err := newECSFormatterFilter("test-server")
+				require.NoError(t, err)
+				return f
+			},
+			e: &eventlogger.Event{
+				Type:      eventlogger.EventType(AuditType),
+				CreatedAt: now.Add(time.Second),
+				Payload:   testAudit(),
+			},
+			want: map[string]any{
+				"@timestamp": "2023-03-01T12:00:00Z",
+				"message":    "audit event",
+				"ecs":        map[string]any{"version": ecsVersion},
+				"log":        map[string]any{"level": "info"},
+				"service":    map[string]any{"type": "boundary", "node": map[string]any{"name": "test-server"}},
+				"event": map[string]any{
+					"kind":    "event",
+					"module":  "boundary",
+					"dataset": "boundary.audit",
+					"id":      "audit_1",
+					"action":  "APIRequest",
+				},
+				"http":   map[string]any{"request": map[string]any{"id": "req_1"}},
+				"url":    map[string]any{"path": "/v1/targets"},
+				"client": map[string]any{"ip": "127.0.0.1"},
+				"boundary": map[string]any{
+					"id":        "audit_1",
+					"version":   auditVersion,
+					"type":      "APIRequest",
+					"timestamp": "2023-03-01T12:00:00Z",
+					"request_info": map[string]any{
+						"id":        "req_1",
+						"method":    "GET",
+						"path":      "/v1/targets",
+						"client_ip": "127.0.0.1",
+					},
+				},
+			},
+		},
+		{
+			name: "observation",
+			formatter: func(t *testing.T) *ecsFormatterFilter {
+				f, err := newECSFormatterFilter("")
+				require.NoError(t, err)
+				return f
+			},
+			e: &eventlogger.Event{
+				Type:      eventlogger.EventType(ObservationType),
+				CreatedAt: now,
+				Payload: map[string]any{
+					"version": observationVersion,
+					"latency": 10,
+				},
+			},
+			want: map[string]any{
+				"@timestamp": "2023-03-01T12:00:00Z",
+				"message":    "observation event",
+				"ecs":        map[string]any{"version": ecsVersion},
+				"log":        map[string]any{"level": "info"},
+				"service":    map[string]any{"type": "boundary"},
+				"event": map[string]any{
+					"kind":    "event",
+					"module":  "boundary",
+					"dataset": "boundary.observation",
+				},
+				"boundary": map[string]any{
+					"version": observationVersion,
+					"latency": float64(10),
+				},
+			},
+		},
+		{
+			name: "error",
+			formatter: func(t *testing.T) *ecsFormatterFilter {
+				f, err := newECSFormatterFilter("")
+				require.NoError(t, err)
+				return f
+			},
+			e: &eventlogger.Event{
+				Type:      eventlogger.EventType(ErrorType),
+				CreatedAt: now,
+				Payload: &err{
+					Error:   "bad stuff",
+					Id:      "err_1",
+					Version: errorVersion,
+					Op:      Op("test-op"),
+				},
+			},
+			want: map[string]any{
+				"@timestamp": "2023-03-01T12:00:00Z",
+				"message":    "error event",
+				"ecs":        map[string]any{"version": ecsVersion},
+				"log":        map[string]any{"level": "error"},
+				"service":    map[string]any{"type": "boundary"},
+				"error":      map[string]any{"message": "bad stuff"},
+				"event": map[string]any{
+					"kind":    "event",
+					"module":  "boundary",
+					"dataset": "boundary.error",
+					"id":      "err_1",
+					"action":  "test-op",
+				},
+				"boundary": map[string]any{
+					"error":        "bad stuff",
+					"error_fields": nil,
+					"id":           "err_1",
+					"version":      errorVersion,
+					"op":           "test-op",
+				},
+			},
+		},
+		{
+			name: "denied",
+			formatter: func(t *testing.T) *ecsFormatterFilter {
+				f, err := newECSFormatterFilter("", WithDeny(`"/type" == "APIRequest"`))
+				require.NoError(t, err)
+				return f
+			},
+			e: &eventlogger.Event{
+				Type:    eventlogger.EventType(AuditType),
+				Payload: testAudit(),
+			},
+			wantDiscarded: true,
+		},
+		{
+			name: "sampled-out",
+			formatter: func(t *testing.T) *ecsFormatterFilter {
+				f, err := newECSFormatterFilter("", WithSampleRate(0.000000001))
+				require.NoError(t, err)
+				return f
+			},
+			e: &eventlogger.Event{
+				Type:    eventlogger.EventType(AuditType),
+				Payload: testAudit(),
+			},
+			wantDiscarded: true,
+		},
+		{
+			name: "errors-not-sampled",
+			formatter: func(t *testing.T) *ecsFormatterFilter {
+				f, err := newECSFormatterFilter("", WithSampleRate(0.000000001))
+				require.NoError(t, err)
+				return f
+			},
+			e: &eventlogger.Event{
+				Type:      eventlogger.EventType(ErrorType),
+				CreatedAt: now,
+				Payload: &err{
+					Error:   "bad stuff",
+					Version: errorVersion,
+				},
+			},
+			want: map[string]any{
+				"@timestamp": "2023-03-01T12:00:00Z",
+				"message":    "error event",
+				"ecs":        map[string]any{"version": ecsVersion},
+				"log":        map[string]any{"level": "error"},
+				"service":    map[string]any{"type": "boundary"},
+				"error":      map[string]any{"message": "bad stuff"},
+				"event": map[string]any{
+					"kind":    "event",
+					"module":  "boundary",
+					"dataset": "boundary.error",
+				},
+				"boundary": map[string]any{
+					"error":        "bad stuff",
+					"error_fields": nil,
+					"version":      errorVersion,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			f := tt.formatter(t)
+			e, err := f.Process(ctx, tt.e)
+			if tt.wantErrContains != "" {
+				require.Error(err)
+				assert.Contains(err.Error(), tt.wantErrContains)
+				return
+			}
+			require.NoError(err)
+			if tt.wantDiscarded {
+				assert.Nil(e)
+				return
+			}
+			require.NotNil(e)
+			b, ok := e.Format(string(ECSJSONSinkFormat))
+			require.True(ok)
+			assert.Equal(byte('\n'), b[len(b)-1])
+			var got map[string]any
+			require.NoError(json.Unmarshal(b, &got))
+			assert.Equal(tt.want, got)
+		})
+	}
+	t.Run("audit-hmac", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		f, err := newECSFormatterFilter("")
+		require.NoError(err)
+		require.NoError(f.Rotate(testWrapper(t)))
+		e, err := f.Process(ctx, &eventlogger.Event{
+			Type:    eventlogger.EventType(AuditType),
+			Payload: testAudit(),
+		})
+		require.NoError(err)
+		require.NotNil(e)
+		b, ok := e.Format(string(ECSJSONSinkFormat))
+		require.True(ok)
+		var got map[string]any
+		require.NoError(json.Unmarshal(b, &got))
+		assert.NotEmpty(got["serialized"])
+		assert.NotEmpty(got["serialized_hmac"])
+	})
+}
+
+func TestECSFormatter_sampled(t *testing.T) {
+	t.Parallel()
+	t.Run("no-sampling", func(t *testing.T) {
+		for _, rate := range []float64{0, 1} {
+			f := &ecsFormatterFilter{sampleRate: rate}
+			for i := 0; i < 100; i++ {
+				assert.True(t, f.sampled(fmt.Sprintf("req_%d", i)))
+			}
+		}
+	})
+	t.Run("consistent-by-key", func(t *testing.T) {
+		f := &ecsFormatterFilter{sampleRate: 0.5}
+		for i := 0; i < 100; i++ {
+			key := fmt.Sprintf("req_%d", i)
+			assert.Equal(t, f.sampled(key), f.sampled(key))
+		}
+	})
+	t.Run("rate", func(t *testing.T) {
+		f := &ecsFormatterFilter{sampleRate: 0.25}
+		var kept int
+		const total = 10000
+		for i := 0; i < total; i++ {
+			if f.sampled(fmt.Sprintf("req_%d", i)) {
+				kept++
+			}
+		}
+		assert.InDelta(t, 0.25, float64(kept)/total, 0.05)
+	})
+}
+
+func TestNewECSFormatterFilter(t *testing.T) {
+	t.Parallel()
+	_, err := newECSFormatterFilter("", WithSampleRate(2))
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidParameter)
+
+	_, err = newECSFormatterFilter("", WithAllow("not a valid filter"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid allow filter")
+}
@@ -14,6 +14,7 @@ import (
 	"os"
 	"reflect"
 	"runtime"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -82,6 +83,10 @@ type Eventer struct {
 	errPipelines         []pipeline
 	auditWrapperNodes    []any
 
+	// auditSinkWrapperNodes are the nodes of the sinks which use their own
+	// kms key for audit event crypto operations, keyed by the key's id.
+	auditSinkWrapperNodes map[string][]any
+
 	// Gating is used to delay output of events until after we have a chance to
 	// render startup info, similar to what was done for hclog before eventing
 	// supplanted it. It affects only error and system events.
@@ -96,6 +101,7 @@ type pipeline struct {
 	sinkId          eventlogger.NodeID
 	gateId          eventlogger.NodeID
 	encryptFilterId eventlogger.NodeID
+	redactFilterId  eventlogger.NodeID
 	sinkConfig      *SinkConfig
 }
 
@@ -250,6 +256,8 @@ func NewEventer(log hclog.Logger, serializationLock *sync.Mutex, serverName stri
 		conf:              c,
 		broker:            b,
 		auditWrapperNodes: []any{},
+
+		auditSinkWrapperNodes: map[string][]any{},
 	}
 
 	if !opts.withNow.IsZero() {
@@ -266,14 +274,36 @@ func NewEventer(log hclog.Logger, serializationLock *sync.Mutex, serverName stri
 		w: os.Stderr,
 		l: serializationLock,
 	}
+	// serializedStdout will be shared among all StdoutSinks so their output is not
+	// interwoven
+	serializedStdout := serializedWriter{
+		w: os.Stdout,
+		l: serializationLock,
+	}
 
 	// we need to keep track of all the Sink filenames to ensure they aren't
 	// reused.
 	allSinkFilenames := map[string]bool{}
 
 	for _, s := range c.Sinks {
-		fmtId, fmtNode, err := newFmtFilterNode(serverName, *s, opt...)
-		e.auditWrapperNodes = append(e.auditWrapperNodes, fmtNode)
+		// sinks which use their own kms key for audit events must not use the
+		// eventer's audit wrapper, so their nodes are created without it and
+		// rotated separately once the key is available.
+		sinkOpt := opt
+		kmsKeyId := s.auditKmsKeyId()
+		addWrapperNode := func(n any) {
+			e.auditWrapperNodes = append(e.auditWrapperNodes, n)
+		}
+		if kmsKeyId != "" {
+			sinkOpt = append(append(make([]Option, 0, len(opt)+1), opt...), WithAuditWrapper(nil))
+			addWrapperNode = func(n any) {
+				e.auditSinkWrapperNodes[kmsKeyId] = append(e.auditSinkWrapperNodes[kmsKeyId], n)
+			}
+		}
+		sinkOpts := getOpts(sinkOpt...)
+
+		fmtId, fmtNode, err := newFmtFilterNode(serverName, *s, sinkOpt...)
+		addWrapperNode(fmtNode)
 		if err != nil {
 			return nil, fmt.Errorf("%s: %w", op, err)
 		}
@@ -295,6 +325,16 @@ func NewEventer(log hclog.Logger, serializationLock *sync.Mutex, serverName stri
 				return nil, fmt.Errorf("%s: %w", op, err)
 			}
 			sinkId = eventlogger.NodeID(id)
+		case StdoutSink:
+			sinkNode = &writer.Sink{
+				Format: string(s.Format),
+				Writer: &serializedStdout,
+			}
+			id, err := NewId("stdout")
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			sinkId = eventlogger.NodeID(id)
 		case FileSink:
 			fsc := s.FileConfig
 			if _, found := allSinkFilenames[fsc.Path+fsc.FileName]; found {
@@ -352,18 +392,27 @@ func NewEventer(log hclog.Logger, serializationLock *sync.Mutex, serverName stri
 		}
 		if addToAudit {
 			var fop AuditFilterOperations
+			var redactionPatterns, redactionFields []string
 			if s.AuditConfig != nil {
 				fop = s.AuditConfig.FilterOverrides
+				redactionPatterns = s.AuditConfig.RedactionPatterns
+				redactionFields = s.AuditConfig.RedactionFields
 			}
-			s.AuditConfig, err = NewAuditConfig(WithAuditWrapper(opts.withAuditWrapper), WithFilterOperations(fop))
+			s.AuditConfig, err = NewAuditConfig(
+				WithAuditWrapper(sinkOpts.withAuditWrapper),
+				WithFilterOperations(fop),
+				WithRedactionPatterns(redactionPatterns...),
+				WithRedactionFields(redactionFields...),
+				WithAuditKmsKeyId(kmsKeyId),
+			)
 			if err != nil {
 				return nil, fmt.Errorf("%s: %w", op, err)
 			}
-			encryptFilter, err := NewAuditEncryptFilter(opt...)
+			encryptFilter, err := NewAuditEncryptFilter(sinkOpt...)
 			if err != nil {
 				return nil, fmt.Errorf("%s: %w", op, err)
 			}
-			e.auditWrapperNodes = append(e.auditWrapperNodes, encryptFilter)
+			addWrapperNode(encryptFilter)
 			if len(s.AuditConfig.FilterOverrides) > 0 {
 				overrides := encrypt.DefaultFilterOperations()
 				for k, v := range s.AuditConfig.FilterOverrides {
@@ -379,11 +428,27 @@ func NewEventer(log hclog.Logger, serializationLock *sync.Mutex, serverName stri
 			if err := b.RegisterNode(encryptFilterId, encryptFilter); err != nil {
 				return nil, fmt.Errorf("%s: %w", op, err)
 			}
+			var redactFilterId eventlogger.NodeID
+			if len(s.AuditConfig.RedactionPatterns) > 0 || len(s.AuditConfig.RedactionFields) > 0 {
+				redactFilter, err := newRedactionFilter(s.AuditConfig)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", op, err)
+				}
+				id, err := NewId("redact-audit")
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", op, err)
+				}
+				redactFilterId = eventlogger.NodeID(id)
+				if err := b.RegisterNode(redactFilterId, redactFilter); err != nil {
+					return nil, fmt.Errorf("%s: %w", op, err)
+				}
+			}
 			auditPipelines = append(auditPipelines, pipeline{
 				eventType:       AuditType,
 				fmtId:           fmtId,
 				sinkId:          sinkId,
 				encryptFilterId: encryptFilterId,
+				redactFilterId:  redactFilterId,
 				sinkConfig:      s,
 			})
 		}
@@ -440,11 +505,17 @@ func NewEventer(log hclog.Logger, serializationLock *sync.Mutex, serverName stri
 		if err != nil {
 			return nil, fmt.Errorf("%s: %w", op, err)
 		}
+		// order of nodes is important!  gate (aggregate), then encrypt, then
+		// redact, then filter/format, then write to sink
+		nodeIds := []eventlogger.NodeID{p.gateId, p.encryptFilterId}
+		if p.redactFilterId != "" {
+			nodeIds = append(nodeIds, p.redactFilterId)
+		}
+		nodeIds = append(nodeIds, p.fmtId, p.sinkId)
 		err = e.broker.RegisterPipeline(eventlogger.Pipeline{
 			EventType:  eventlogger.EventType(p.eventType),
 			PipelineID: eventlogger.PipelineID(pipeId),
-			// order of nodes is important!  gate (aggregate), then filter/format, then encrypt, then write to sink
-			NodeIDs: []eventlogger.NodeID{p.gateId, p.encryptFilterId, p.fmtId, p.sinkId},
+			NodeIDs:    nodeIds,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("%s: failed to register audit pipeline: %w", op, err)
@@ -562,6 +633,18 @@ func newFmtFilterNode(serverName string, c SinkConfig, opt ...Option) (eventlogg
 			return "", nil, fmt.Errorf("%s: %w", op, err)
 		}
 
+	case ECSJSONSinkFormat:
+		id, err := NewId(string(c.Format))
+		if err != nil {
+			return "", nil, fmt.Errorf("%s: unable to generate id: %w", op, err)
+		}
+		fmtId = eventlogger.NodeID(id)
+
+		fmtNode, err = newECSFormatterFilter(serverName, WithAllow(c.AllowFilters...), WithDeny(c.DenyFilters...), WithSampleRate(c.SampleRate))
+		if err != nil {
+			return "", nil, fmt.Errorf("%s: %w", op, err)
+		}
+
 	default:
 		id, err := NewId("cloudevents")
 		if err != nil {
@@ -609,17 +692,60 @@ func DefaultSink() *SinkConfig {
 	}
 }
 
+// RotateAuditWrapper rotates the wrapper used for the audit event crypto
+// operations of all the sinks which don't use their own kms key.
 func (e *Eventer) RotateAuditWrapper(ctx context.Context, newWrapper wrapping.Wrapper) error {
 	const op = "event.(Eventer).RotateAuditWrapper"
 	if newWrapper == nil {
 		return fmt.Errorf("%s: missing wrapper: %w", op, ErrInvalidParameter)
 	}
-	for _, n := range e.auditWrapperNodes {
+	if err := rotateWrapperNodes(e.auditWrapperNodes, newWrapper); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// AuditKmsKeyIds returns the ids of the kms keys which sinks use for their
+// audit event crypto operations, instead of the eventer's audit wrapper.
+func (e *Eventer) AuditKmsKeyIds() []string {
+	ids := make([]string, 0, len(e.auditSinkWrapperNodes))
+	for id := range e.auditSinkWrapperNodes {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// RotateSinkAuditWrapper rotates the wrapper used for the audit event crypto
+// operations of the sinks which use the kms key with the kmsKeyId.
+func (e *Eventer) RotateSinkAuditWrapper(ctx context.Context, kmsKeyId string, newWrapper wrapping.Wrapper) error {
+	const op = "event.(Eventer).RotateSinkAuditWrapper"
+	switch {
+	case kmsKeyId == "":
+		return fmt.Errorf("%s: missing kms key id: %w", op, ErrInvalidParameter)
+	case newWrapper == nil:
+		return fmt.Errorf("%s: missing wrapper: %w", op, ErrInvalidParameter)
+	}
+	nodes, ok := e.auditSinkWrapperNodes[kmsKeyId]
+	if !ok {
+		return fmt.Errorf("%s: no sink uses kms key id %q: %w", op, kmsKeyId, ErrInvalidParameter)
+	}
+	if err := rotateWrapperNodes(nodes, newWrapper); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func rotateWrapperNodes(nodes []any, newWrapper wrapping.Wrapper) error {
+	const op = "event.rotateWrapperNodes"
+	for _, n := range nodes {
 		switch w := n.(type) {
 		case *hclogFormatterFilter:
 			w.Rotate(newWrapper)
 		case *cloudEventsFormatterFilter:
 			w.Rotate(newWrapper)
+		case *ecsFormatterFilter:
+			w.Rotate(newWrapper)
 		case *encrypt.Filter:
 			w.Rotate(encrypt.WithWrapper(newWrapper))
 		default:
@@ -158,6 +158,7 @@ func Test_InitSysEventer(t *testing.T) {
 			tt.want.errPipelines = got.errPipelines
 			tt.want.observationPipelines = got.observationPipelines
 			tt.want.auditWrapperNodes = got.auditWrapperNodes
+			tt.want.auditSinkWrapperNodes = got.auditSinkWrapperNodes
 			assert.Equal(tt.want, got)
 		})
 	}
@@ -437,6 +438,65 @@ func Test_NewEventer(t *testing.T) {
 				"audit":       1,
 			},
 		},
+		{
+			name: "valid-audit-config-with-redactions",
+			config: EventerConfig{
+				AuditEnabled: true,
+				Sinks: []*SinkConfig{
+					{
+						Name:       "test",
+						EventTypes: []Type{AuditType},
+						Type:       StderrSink,
+						Format:     JSONSinkFormat,
+						AuditConfig: &AuditConfig{
+							RedactionPatterns: []string{`tok_[a-z0-9]+`},
+							RedactionFields:   []string{"request.details.token"},
+						},
+					},
+				},
+			},
+			opts:       []Option{WithAuditWrapper(twrapper)},
+			lock:       testLock,
+			logger:     testLogger,
+			serverName: "valid-audit-config-with-redactions",
+			want: &Eventer{
+				logger:         testLogger,
+				gatedQueueLock: new(sync.Mutex),
+				conf: EventerConfig{
+					AuditEnabled: true,
+					Sinks: []*SinkConfig{
+						{
+							Name:       "test",
+							EventTypes: []Type{AuditType},
+							Format:     JSONSinkFormat,
+							Type:       StderrSink,
+							AuditConfig: &AuditConfig{
+								wrapper:           twrapper,
+								FilterOverrides:   DefaultAuditFilterOperations(),
+								RedactionPatterns: []string{`tok_[a-z0-9]+`},
+								RedactionFields:   []string{"request.details.token"},
+							},
+						},
+					},
+				},
+			},
+			wantRegistered: []string{
+				"cloudevents",   // fmt for everything
+				"stderr",        // stderr
+				"gated-audit",   // stderr
+				"encrypt-audit", // stderr
+				"redact-audit",  // stderr
+			},
+			wantPipelines: []string{
+				"audit", // stderr
+			},
+			wantThresholds: map[eventlogger.EventType]int{
+				"error":       0,
+				"system":      0,
+				"observation": 0,
+				"audit":       1,
+			},
+		},
 		{
 			name:       "missing-logger",
 			config:     testSetup.EventerConfig,
@@ -691,6 +751,7 @@ func Test_NewEventer(t *testing.T) {
 			tt.want.errPipelines = got.errPipelines
 			tt.want.observationPipelines = got.observationPipelines
 			tt.want.auditWrapperNodes = got.auditWrapperNodes
+			tt.want.auditSinkWrapperNodes = got.auditSinkWrapperNodes
 			assert.Equal(tt.want, got)
 
 			assert.Lenf(testBroker.registeredNodeIds, len(tt.wantRegistered), "got nodes: %q", testBroker.registeredNodeIds)
@@ -1210,3 +1271,109 @@ func TestEventer_RotateAuditWrapper(t *testing.T) {
 		})
 	}
 }
+
+func TestEventer_RotateSinkAuditWrapper(t *testing.T) {
+	t.Parallel()
+	testCtx := context.Background()
+	testLock := &sync.Mutex{}
+	testLogger := testLogger(t, testLock)
+
+	eventerWrapper, sinkWrapper := testWrapper(t), testWrapper(t)
+	c := EventerConfig{
+		AuditEnabled: true,
+		Sinks: []*SinkConfig{
+			{
+				Name:       "operational",
+				EventTypes: []Type{AuditType},
+				Type:       StderrSink,
+				Format:     JSONSinkFormat,
+			},
+			{
+				Name:       "compliance",
+				EventTypes: []Type{AuditType},
+				Type:       StdoutSink,
+				Format:     JSONSinkFormat,
+				AuditConfig: &AuditConfig{
+					FilterOverrides: AuditFilterOperations{
+						SensitiveClassification: EncryptOperation,
+						SecretClassification:    EncryptOperation,
+					},
+					KmsKeyId: "compliance",
+				},
+			},
+		},
+	}
+	eventer, err := NewEventer(testLogger, testLock, "TestEventer_RotateSinkAuditWrapper", c, WithAuditWrapper(eventerWrapper))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"compliance"}, eventer.AuditKmsKeyIds())
+	require.Len(t, eventer.auditWrapperNodes, 2)
+	require.Len(t, eventer.auditSinkWrapperNodes["compliance"], 2)
+
+	encryptWrapper := func(nodes []any) wrapping.Wrapper {
+		for _, n := range nodes {
+			if f, ok := n.(*encrypt.Filter); ok {
+				return f.Wrapper
+			}
+		}
+		return nil
+	}
+	// the sink with its own kms key doesn't use the eventer's audit wrapper
+	assert.Equal(t, eventerWrapper, encryptWrapper(eventer.auditWrapperNodes))
+	assert.Nil(t, encryptWrapper(eventer.auditSinkWrapperNodes["compliance"]))
+	assert.Nil(t, eventer.conf.Sinks[1].AuditConfig.wrapper)
+
+	tests := []struct {
+		name            string
+		kmsKeyId        string
+		w               wrapping.Wrapper
+		wantIsError     error
+		wantErrContains string
+	}{
+		{
+			name:            "missing-kms-key-id",
+			w:               sinkWrapper,
+			wantIsError:     ErrInvalidParameter,
+			wantErrContains: "missing kms key id",
+		},
+		{
+			name:            "missing-wrapper",
+			kmsKeyId:        "compliance",
+			wantIsError:     ErrInvalidParameter,
+			wantErrContains: "missing wrapper",
+		},
+		{
+			name:            "unknown-kms-key-id",
+			kmsKeyId:        "unknown",
+			w:               sinkWrapper,
+			wantIsError:     ErrInvalidParameter,
+			wantErrContains: `no sink uses kms key id "unknown"`,
+		},
+		{
+			name:     "valid",
+			kmsKeyId: "compliance",
+			w:        sinkWrapper,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			err := eventer.RotateSinkAuditWrapper(testCtx, tt.kmsKeyId, tt.w)
+			if tt.wantIsError != nil {
+				require.Error(err)
+				assert.ErrorIs(err, tt.wantIsError)
+				assert.Contains(err.Error(), tt.wantErrContains)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(sinkWrapper, encryptWrapper(eventer.auditSinkWrapperNodes[tt.kmsKeyId]))
+			assert.Equal(eventerWrapper, encryptWrapper(eventer.auditWrapperNodes))
+		})
+	}
+	t.Run("rotate-audit-wrapper", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		newWrapper := testWrapper(t)
+		require.NoError(eventer.RotateAuditWrapper(testCtx, newWrapper))
+		assert.Equal(newWrapper, encryptWrapper(eventer.auditWrapperNodes))
+		assert.Equal(sinkWrapper, encryptWrapper(eventer.auditSinkWrapperNodes["compliance"]))
+	})
+}
@@ -29,25 +29,29 @@ type Option func(*options)
 
 // options = how options are represented
 type options struct {
-	withId               string
-	withDetails          map[string]any
-	withHeader           map[string]any
-	withFlush            bool
-	withInfo             map[string]any
-	withRequestInfo      *RequestInfo
-	withNow              time.Time
-	withRequest          *Request
-	withResponse         *Response
-	withAuth             *Auth
-	withEventer          *Eventer
-	withEventerConfig    *EventerConfig
-	withAllow            []string
-	withDeny             []string
-	withSchema           *url.URL
-	withAuditWrapper     wrapping.Wrapper
-	withFilterOperations AuditFilterOperations
-	withGating           bool
-	withNoGateLocking    bool
+	withId                string
+	withDetails           map[string]any
+	withHeader            map[string]any
+	withFlush             bool
+	withInfo              map[string]any
+	withRequestInfo       *RequestInfo
+	withNow               time.Time
+	withRequest           *Request
+	withResponse          *Response
+	withAuth              *Auth
+	withEventer           *Eventer
+	withEventerConfig     *EventerConfig
+	withAllow             []string
+	withDeny              []string
+	withSchema            *url.URL
+	withAuditWrapper      wrapping.Wrapper
+	withFilterOperations  AuditFilterOperations
+	withRedactionPatterns []string
+	withRedactionFields   []string
+	withAuditKmsKeyId     string
+	withGating            bool
+	withNoGateLocking     bool
+	withSampleRate        float64
 
 	// These options are related to the hclog adapter
 	withHclogLevel hclog.Level
@@ -200,6 +204,14 @@ func WithAuditWrapper(w wrapping.Wrapper) Option {
 	}
 }
 
+// WithSampleRate is an optional rate between 0 and 1 at which audit and
+// observation events are sampled
+func WithSampleRate(r float64) Option {
+	return func(o *options) {
+		o.withSampleRate = r
+	}
+}
+
 // WithFilterOperations is an optional set of filter operations
 func WithFilterOperations(fop AuditFilterOperations) Option {
 	return func(o *options) {
@@ -207,6 +219,30 @@ func WithFilterOperations(fop AuditFilterOperations) Option {
 	}
 }
 
+// WithRedactionPatterns is an optional set of regular expressions matching
+// text to redact from audit events
+func WithRedactionPatterns(p ...string) Option {
+	return func(o *options) {
+		o.withRedactionPatterns = p
+	}
+}
+
+// WithRedactionFields is an optional set of field paths to redact from audit
+// events
+func WithRedactionFields(f ...string) Option {
+	return func(o *options) {
+		o.withRedactionFields = f
+	}
+}
+
+// WithAuditKmsKeyId is an optional id of the kms key used for a sink's audit
+// event crypto operations
+func WithAuditKmsKeyId(id string) Option {
+	return func(o *options) {
+		o.withAuditKmsKeyId = id
+	}
+}
+
 // WithHclogLevel is an option to specify a log level if using the adapter
 func WithHclogLevel(with hclog.Level) Option {
 	return func(o *options) {
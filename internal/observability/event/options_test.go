@@ -175,6 +175,27 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withFilterOperations = overrides
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithRedactionPatterns", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithRedactionPatterns(`tok_[a-z0-9]+`))
+		testOpts := getDefaultOptions()
+		testOpts.withRedactionPatterns = []string{`tok_[a-z0-9]+`}
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithRedactionFields", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithRedactionFields("request.details.token"))
+		testOpts := getDefaultOptions()
+		testOpts.withRedactionFields = []string{"request.details.token"}
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithAuditKmsKeyId", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithAuditKmsKeyId("compliance"))
+		testOpts := getDefaultOptions()
+		testOpts.withAuditKmsKeyId = "compliance"
+		assert.Equal(opts, testOpts)
+	})
 	t.Run("WithHclogLevel", func(t *testing.T) {
 		assert := assert.New(t)
 		opts := getOpts(WithHclogLevel(hclog.Info))
@@ -196,6 +217,13 @@ func Test_GetOpts(t *testing.T) {
 		opts := getOpts(WithNoGateLocking(true))
 		assert.True(opts.withNoGateLocking)
 	})
+	t.Run("WithSampleRate", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithSampleRate(0.25))
+		testOpts := getDefaultOptions()
+		testOpts.withSampleRate = 0.25
+		assert.Equal(opts, testOpts)
+	})
 }
 
 // testWrapper initializes an AEAD wrapping.Wrapper for testing.  Note: this
@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package event
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/eventlogger"
+	"github.com/hashicorp/eventlogger/filters/encrypt"
+	"github.com/mitchellh/copystructure"
+)
+
+// redactionFilter is an eventlogger.Node which redacts the values of an
+// event's payload which match a set of custom redaction patterns or which are
+// located at one of a set of field paths.  It's used by audit pipelines to
+// redact org specific secrets which aren't classified by their message types.
+type redactionFilter struct {
+	patterns []*regexp.Regexp
+	fields   map[string]bool
+}
+
+var _ eventlogger.Node = &redactionFilter{}
+
+// newRedactionFilter returns a new redactionFilter for the AuditConfig's
+// RedactionPatterns and RedactionFields.
+func newRedactionFilter(c *AuditConfig) (*redactionFilter, error) {
+	const op = "event.newRedactionFilter"
+	if c == nil {
+		return nil, fmt.Errorf("%s: missing audit config: %w", op, ErrInvalidParameter)
+	}
+	f := &redactionFilter{
+		patterns: make([]*regexp.Regexp, 0, len(c.RedactionPatterns)),
+		fields:   make(map[string]bool, len(c.RedactionFields)),
+	}
+	for _, p := range c.RedactionPatterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid redaction pattern %q: %w", op, p, ErrInvalidParameter)
+		}
+		f.patterns = append(f.patterns, re)
+	}
+	for _, field := range c.RedactionFields {
+		f.fields[strings.ToLower(field)] = true
+	}
+	return f, nil
+}
+
+// Reopen is a no op
+func (f *redactionFilter) Reopen() error { return nil }
+
+// Type describes the type of the node as a Filter.
+func (f *redactionFilter) Type() eventlogger.NodeType {
+	return eventlogger.NodeTypeFilter
+}
+
+// Process will redact the matching values of the event's payload.
+func (f *redactionFilter) Process(_ context.Context, e *eventlogger.Event) (*eventlogger.Event, error) {
+	const op = "event.(redactionFilter).Process"
+	if e == nil {
+		return nil, fmt.Errorf("%s: missing event: %w", op, ErrInvalidParameter)
+	}
+	if len(f.patterns) == 0 && len(f.fields) == 0 {
+		return e, nil
+	}
+	if e.Payload == nil || reflect.ValueOf(e.Payload).IsZero() {
+		return e, nil
+	}
+
+	// since the node will be modifying the event data, we need our own copy,
+	// otherwise we could be changing the event across other pipelines.
+	dup, err := copystructure.Copy(e)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	e = dup.(*eventlogger.Event)
+
+	// the payload may not be addressable (e.g. a composed audit struct), so
+	// redact an addressable copy of it.
+	payload := reflect.New(reflect.TypeOf(e.Payload)).Elem()
+	payload.Set(reflect.ValueOf(e.Payload))
+	f.redact(payload, "", false)
+	e.Payload = payload.Interface()
+	return e, nil
+}
+
+// redact walks v, redacting its string values.  The path of each value is
+// built from the names its fields are written with by json formatters (e.g.
+// "request.details.item.name").  All the string values at or beneath one of
+// the filter's fields are redacted, and the text of any other string value
+// which matches one of the filter's patterns is redacted.
+func (f *redactionFilter) redact(v reflect.Value, path string, redactAll bool) {
+	if !redactAll && f.fields[strings.ToLower(path)] {
+		redactAll = true
+	}
+	switch v.Kind() {
+	case reflect.String:
+		if !v.CanSet() {
+			return
+		}
+		switch {
+		case redactAll:
+			v.SetString(encrypt.RedactedData)
+		default:
+			s := v.String()
+			for _, re := range f.patterns {
+				s = re.ReplaceAllString(s, encrypt.RedactedData)
+			}
+			v.SetString(s)
+		}
+	case reflect.Ptr:
+		if !v.IsNil() {
+			f.redact(v.Elem(), path, redactAll)
+		}
+	case reflect.Interface:
+		if v.IsNil() {
+			return
+		}
+		elem := v.Elem()
+		if elem.Kind() == reflect.Ptr {
+			f.redact(elem, path, redactAll)
+			return
+		}
+		if !v.CanSet() {
+			return
+		}
+		cp := reflect.New(elem.Type()).Elem()
+		cp.Set(elem)
+		f.redact(cp, path, redactAll)
+		v.Set(cp)
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			name := field.Name
+			if tag, _, _ := strings.Cut(field.Tag.Get("json"), ","); tag != "" {
+				if tag == "-" {
+					continue
+				}
+				name = tag
+			}
+			f.redact(v.Field(i), joinRedactionPath(path, name), redactAll)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			f.redact(v.Index(i), path, redactAll)
+		}
+	case reflect.Map:
+		if v.IsNil() {
+			return
+		}
+		iter := v.MapRange()
+		for iter.Next() {
+			k, elem := iter.Key(), iter.Value()
+			cp := reflect.New(elem.Type()).Elem()
+			cp.Set(elem)
+			f.redact(cp, joinRedactionPath(path, fmt.Sprint(k.Interface())), redactAll)
+			v.SetMapIndex(k, cp)
+		}
+	}
+}
+
+func joinRedactionPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
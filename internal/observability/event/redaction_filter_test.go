@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package event
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	pbs "github.com/hashicorp/boundary/internal/gen/testing/event"
+	"github.com/hashicorp/eventlogger"
+	"github.com/hashicorp/eventlogger/filters/encrypt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func Test_newRedactionFilter(t *testing.T) {
+	t.Parallel()
+	t.Run("missing-config", func(t *testing.T) {
+		_, err := newRedactionFilter(nil)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrInvalidParameter)
+	})
+	t.Run("invalid-pattern", func(t *testing.T) {
+		_, err := newRedactionFilter(&AuditConfig{RedactionPatterns: []string{"tok_[a-z"}})
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrInvalidParameter)
+		assert.Contains(t, err.Error(), "invalid redaction pattern")
+	})
+	t.Run("valid", func(t *testing.T) {
+		f, err := newRedactionFilter(&AuditConfig{
+			RedactionPatterns: []string{`tok_[a-z0-9]+`},
+			RedactionFields:   []string{"Request.Details.Command"},
+		})
+		require.NoError(t, err)
+		assert.Len(t, f.patterns, 1)
+		assert.Equal(t, map[string]bool{"request.details.command": true}, f.fields)
+		assert.Equal(t, eventlogger.NodeTypeFilter, f.Type())
+		assert.NoError(t, f.Reopen())
+	})
+}
+
+func Test_redactionFilter_Process(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Now()
+
+	testAudit := func() audit {
+		return audit{
+			Id:        "ae_1234567890",
+			Version:   auditVersion,
+			Type:      string(ApiRequest),
+			Timestamp: now,
+			Auth: &Auth{
+				UserEmail: "alice@example.com tok_alice",
+			},
+			Request: &Request{
+				Operation: "POST",
+				Endpoint:  "/v1/auth-methods/ampw_1234567890:authenticate",
+				Details: &pbs.TestAuthenticateRequest{
+					AuthMethodId: "ampw_1234567890",
+					Command:      "login with tok_abc123 and tok_def456",
+					Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+						"login_name": structpb.NewStringValue("alice"),
+						"password":   structpb.NewStringValue("fido"),
+					}},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		config  *AuditConfig
+		payload any
+		want    any
+	}{
+		{
+			name:    "no-patterns-or-fields",
+			config:  &AuditConfig{},
+			payload: testAudit(),
+			want:    testAudit(),
+		},
+		{
+			name:    "patterns",
+			config:  &AuditConfig{RedactionPatterns: []string{`tok_[a-z0-9]+`}},
+			payload: testAudit(),
+			want: func() audit {
+				a := testAudit()
+				a.Auth.UserEmail = "alice@example.com " + encrypt.RedactedData
+				a.Request.Details.(*pbs.TestAuthenticateRequest).Command = "login with " + encrypt.RedactedData + " and " + encrypt.RedactedData
+				return a
+			}(),
+		},
+		{
+			name:    "fields",
+			config:  &AuditConfig{RedactionFields: []string{"request.details.attributes.fields.password", "auth.email"}},
+			payload: testAudit(),
+			want: func() audit {
+				a := testAudit()
+				a.Auth.UserEmail = encrypt.RedactedData
+				a.Request.Details.(*pbs.TestAuthenticateRequest).Attributes.Fields["password"] = structpb.NewStringValue(encrypt.RedactedData)
+				return a
+			}(),
+		},
+		{
+			name:    "pointer-payload",
+			config:  &AuditConfig{RedactionFields: []string{"request.endpoint"}},
+			payload: func() *audit { a := testAudit(); return &a }(),
+			want: func() *audit {
+				a := testAudit()
+				a.Request.Endpoint = encrypt.RedactedData
+				return &a
+			}(),
+		},
+		{
+			name:    "string-payload",
+			config:  &AuditConfig{RedactionPatterns: []string{`tok_[a-z0-9]+`}},
+			payload: "tok_abc123",
+			want:    encrypt.RedactedData,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			f, err := newRedactionFilter(tt.config)
+			require.NoError(err)
+			e := &eventlogger.Event{
+				Type:      eventlogger.EventType(AuditType),
+				CreatedAt: now,
+				Payload:   tt.payload,
+			}
+			got, err := f.Process(ctx, e)
+			require.NoError(err)
+			assert.Empty(cmp.Diff(tt.want, got.Payload, protocmp.Transform()))
+		})
+	}
+	t.Run("missing-event", func(t *testing.T) {
+		f, err := newRedactionFilter(&AuditConfig{})
+		require.NoError(t, err)
+		_, err = f.Process(ctx, nil)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrInvalidParameter)
+	})
+}
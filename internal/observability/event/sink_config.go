@@ -18,11 +18,13 @@ type SinkConfig struct {
 	AllowFilters   []string              `hcl:"allow_filters"`    // AllowFilters define a set predicates for including an event in the sink. If any filter matches, the event will be included. The filter should be in a format supported by hashicorp/go-bexpr.
 	DenyFilters    []string              `hcl:"deny_filters"`     // DenyFilters define a set predicates for excluding an event in the sink. If any filter matches, the event will be excluded. The filter should be in a format supported by hashicorp/go-bexpr.
 	Format         SinkFormat            `hcl:"format"`           // Format defines the format for the sink (JSONSinkFormat or TextSinkFormat).
-	Type           SinkType              `hcl:"type"`             // Type defines the type of sink (StderrSink, FileSink, or WriterSink).
+	Type           SinkType              `hcl:"type"`             // Type defines the type of sink (StderrSink, StdoutSink, FileSink, or WriterSink).
 	StderrConfig   *StderrSinkTypeConfig `hcl:"stderr"`           // StderrConfig defines parameters for a stderr output.
+	StdoutConfig   *StdoutSinkTypeConfig `hcl:"stdout"`           // StdoutConfig defines parameters for a stdout output.
 	FileConfig     *FileSinkTypeConfig   `hcl:"file"`             // FileConfig defines parameters for a file output.
 	WriterConfig   *WriterSinkTypeConfig `hcl:"-"`                // WriterConfig defines parameters for an io.Writer output. This is not available via HCL.
 	AuditConfig    *AuditConfig          `hcl:"audit_config"`     // AuditConfig defines optional parameters for audit events (if EventTypes contains audit)
+	SampleRate     float64               `hcl:"sample_rate"`      // SampleRate defines the fraction of audit and observation events sent to the sink. Only supported by the ECSJSONSinkFormat. If not set, every event is sent.
 }
 
 func (sc *SinkConfig) Validate() error {
@@ -38,6 +40,9 @@ func (sc *SinkConfig) Validate() error {
 	if sc.StderrConfig != nil {
 		foundSinkTypeConfigs++
 	}
+	if sc.StdoutConfig != nil {
+		foundSinkTypeConfigs++
+	}
 	if sc.FileConfig != nil {
 		foundSinkTypeConfigs++
 	}
@@ -55,6 +60,11 @@ func (sc *SinkConfig) Validate() error {
 		if foundSinkTypeConfigs == 1 && sc.StderrConfig == nil {
 			return fmt.Errorf("%s: mismatch between sink type and sink configuration block: %w", op, ErrInvalidParameter)
 		}
+	case StdoutSink:
+		// Like stderr, StdoutConfig has no parameters and may be nil
+		if foundSinkTypeConfigs == 1 && sc.StdoutConfig == nil {
+			return fmt.Errorf("%s: mismatch between sink type and sink configuration block: %w", op, ErrInvalidParameter)
+		}
 	case FileSink:
 		// Unlike in the stderr case, this can't be nil, so if it's not nil
 		// we've now verified it's the only block populated
@@ -78,17 +88,23 @@ func (sc *SinkConfig) Validate() error {
 	if len(sc.EventTypes) == 0 {
 		return fmt.Errorf("%s: missing event types: %w", op, ErrInvalidParameter)
 	}
+	switch {
+	case sc.SampleRate < 0 || sc.SampleRate > 1:
+		return fmt.Errorf("%s: sample rate must be between 0 and 1: %w", op, ErrInvalidParameter)
+	case sc.SampleRate != 0 && sc.Format != ECSJSONSinkFormat:
+		return fmt.Errorf("%s: sample rate is only supported by the %s format: %w", op, ECSJSONSinkFormat, ErrInvalidParameter)
+	}
 
 	for _, et := range sc.EventTypes {
 		if err := et.Validate(); err != nil {
 			return fmt.Errorf("%s: %w", op, err)
 		}
 		// well, if there's an event type of audit, we need to check the audit
-		// config, if it's optionally provided.  We are intentionally only
-		// checking the FilterOverrides, because there's no way to specify the
-		// wrapper in a config.
-		if (et == AuditType || et == EveryType) && sc.AuditConfig != nil && sc.AuditConfig.FilterOverrides != nil {
-			if err := sc.AuditConfig.FilterOverrides.Validate(); err != nil {
+		// config, if it's optionally provided.  The AuditConfig doesn't
+		// validate the wrapper, because there's no way to specify the wrapper
+		// in a config.
+		if (et == AuditType || et == EveryType) && sc.AuditConfig != nil {
+			if err := sc.AuditConfig.Validate(); err != nil {
 				return fmt.Errorf("%s: invalid audit config: %w", op, err)
 			}
 		}
@@ -100,6 +116,9 @@ func (sc *SinkConfig) Validate() error {
 // StderrSinkTypeConfig contains configuration structures for file sink types
 type StderrSinkTypeConfig struct{}
 
+// StdoutSinkTypeConfig contains configuration structures for stdout sink types
+type StdoutSinkTypeConfig struct{}
+
 // FileSinkTypeConfig contains configuration structures for file sink types
 type FileSinkTypeConfig struct {
 	Path              string        `hcl:"path"             mapstructure:"path"`             // Path defines the file path for the sink
@@ -143,3 +162,17 @@ func (s SinkFilter) Validate() error {
 	}
 	return nil
 }
+
+// auditKmsKeyId returns the id of the kms key used for the sink's audit event
+// crypto operations, if the sink receives audit events and has its own key.
+func (sc *SinkConfig) auditKmsKeyId() string {
+	if sc.AuditConfig == nil || sc.AuditConfig.KmsKeyId == "" {
+		return ""
+	}
+	for _, et := range sc.EventTypes {
+		if et == AuditType || et == EveryType {
+			return sc.AuditConfig.KmsKeyId
+		}
+	}
+	return ""
+}
@@ -56,6 +56,23 @@ func TestSinkConfig_Validate(t *testing.T) {
 			wantErrIs:       ErrInvalidParameter,
 			wantErrContains: "invalid audit config",
 		},
+		{
+			name: "invalid-audit-redaction-pattern",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{AuditType},
+				Type:       FileSink,
+				FileConfig: &FileSinkTypeConfig{
+					FileName: "tmp.file",
+				},
+				Format: JSONSinkFormat,
+				AuditConfig: &AuditConfig{
+					RedactionPatterns: []string{"tok_[a-z"},
+				},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "invalid audit config",
+		},
 		{
 			name: "missing-name",
 			sc: SinkConfig{
@@ -207,6 +224,53 @@ func TestSinkConfig_Validate(t *testing.T) {
 			wantErrIs:       ErrInvalidParameter,
 			wantErrContains: `too many sink type config blocks`,
 		},
+		{
+			name: "type mismatch stdout type stderr config",
+			sc: SinkConfig{
+				Name:         "stdout",
+				EventTypes:   []Type{EveryType},
+				Type:         StdoutSink,
+				Format:       ECSJSONSinkFormat,
+				StderrConfig: &StderrSinkTypeConfig{},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: `mismatch between sink type and sink configuration block`,
+		},
+		{
+			name: "invalid-sample-rate",
+			sc: SinkConfig{
+				Name:       "stdout",
+				EventTypes: []Type{EveryType},
+				Type:       StdoutSink,
+				Format:     ECSJSONSinkFormat,
+				SampleRate: 1.5,
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "sample rate must be between 0 and 1",
+		},
+		{
+			name: "sample-rate-unsupported-format",
+			sc: SinkConfig{
+				Name:       "stdout",
+				EventTypes: []Type{EveryType},
+				Type:       StdoutSink,
+				Format:     JSONSinkFormat,
+				SampleRate: 0.5,
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "sample rate is only supported by the ecs-json format",
+		},
+		{
+			name: "valid-ecs-stdout",
+			sc: SinkConfig{
+				Name:         "stdout",
+				EventTypes:   []Type{AuditType, ObservationType},
+				Type:         StdoutSink,
+				Format:       ECSJSONSinkFormat,
+				StdoutConfig: &StdoutSinkTypeConfig{},
+				SampleRate:   0.5,
+			},
+		},
 		{
 			name: "valid",
 			sc: SinkConfig{
@@ -281,3 +345,42 @@ func TestSinkFilter_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestSinkConfig_auditKmsKeyId(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		sc   SinkConfig
+		want string
+	}{
+		{
+			name: "no-audit-config",
+			sc:   SinkConfig{EventTypes: []Type{AuditType}},
+		},
+		{
+			name: "no-kms-key-id",
+			sc:   SinkConfig{EventTypes: []Type{AuditType}, AuditConfig: DefaultAuditConfig()},
+		},
+		{
+			name: "not-audit",
+			sc:   SinkConfig{EventTypes: []Type{ErrorType}, AuditConfig: &AuditConfig{KmsKeyId: "compliance"}},
+		},
+		{
+			name: "audit",
+			sc:   SinkConfig{EventTypes: []Type{ErrorType, AuditType}, AuditConfig: &AuditConfig{KmsKeyId: "compliance"}},
+			want: "compliance",
+		},
+		{
+			name: "every-type",
+			sc:   SinkConfig{EventTypes: []Type{EveryType}, AuditConfig: &AuditConfig{KmsKeyId: "compliance"}},
+			want: "compliance",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.sc.auditKmsKeyId())
+		})
+	}
+}
@@ -12,6 +12,7 @@ const (
 	TextSinkFormat      SinkFormat = "cloudevents-text" // TextSinkFormat means the event is formmatted as text
 	TextHclogSinkFormat SinkFormat = "hclog-text"       // TextHclogSinkFormat means the event is formatted as an hclog text entry
 	JSONHclogSinkFormat SinkFormat = "hclog-json"       // JSONHclogSinkFormat means the event is formated as an hclog json entry
+	ECSJSONSinkFormat   SinkFormat = "ecs-json"         // ECSJSONSinkFormat means the event is formatted as an Elastic Common Schema json entry
 )
 
 type SinkFormat string // SinkFormat defines the formatting for a sink in a config file stanza (json)
@@ -23,6 +24,8 @@ func (f SinkFormat) Validate() error {
 		return nil
 	case TextHclogSinkFormat, JSONHclogSinkFormat:
 		return nil
+	case ECSJSONSinkFormat:
+		return nil
 	default:
 		return fmt.Errorf("%s: '%s' is not a valid sink format: %w", op, f, ErrInvalidParameter)
 	}
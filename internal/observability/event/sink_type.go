@@ -9,16 +9,17 @@ import (
 
 const (
 	StderrSink SinkType = "stderr" // StderrSink is written to stderr
+	StdoutSink SinkType = "stdout" // StdoutSink is written to stdout
 	FileSink   SinkType = "file"   // FileSink is written to a file
 	WriterSink SinkType = "writer" // WriterSink is written to an io.Writer
 )
 
-type SinkType string // SinkType defines the type of sink in a config stanza (file, stderr, writer)
+type SinkType string // SinkType defines the type of sink in a config stanza (file, stderr, stdout, writer)
 
 func (t SinkType) Validate() error {
 	const op = "event.(SinkType).validate"
 	switch t {
-	case StderrSink, FileSink, WriterSink:
+	case StderrSink, StdoutSink, FileSink, WriterSink:
 		return nil
 	default:
 		return fmt.Errorf("%s: '%s' is not a valid sink type: %w", op, t, ErrInvalidParameter)
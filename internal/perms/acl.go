@@ -12,6 +12,15 @@ import (
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
 )
 
+// explicitActions are not granted by a grant of all actions and must be
+// granted explicitly, so that roles granted all actions still respect the
+// connection limits of targets and cannot remove the deletion protection of
+// resources.
+var explicitActions = action.ActionSet{
+	action.ExceedConnectionLimit,
+	action.ClearDeletionProtection,
+}
+
 // ACL provides an entry point into the permissions engine for determining if an
 // action is allowed on a resource based on a principal's (user or group) grants.
 type ACL struct {
@@ -37,9 +46,10 @@ type Permission struct {
 	Resource resource.Type
 	Action   action.Type
 
-	ResourceIds []string // Any specific resource ids that have been referred in the grant's `id` field, if applicable.
-	OnlySelf    bool     // The grant only allows actions against the user's own resources.
-	All         bool     // We got a wildcard in the grant string's `id` field.
+	ResourceIds []string            // Any specific resource ids that have been referred in the grant's `id` field, if applicable.
+	Tags        []map[string]string // The tag selectors of grants with a wildcard `id`; resources having all of the tags of any of them apply.
+	OnlySelf    bool                // The grant only allows actions against the user's own resources.
+	All         bool                // We got a wildcard in the grant string's `id` field.
 }
 
 // UserPermissions is a set of Permissions for a User.
@@ -63,6 +73,10 @@ type Resource struct {
 	// Pin if defined would constrain the resource within the collection of the
 	// pin id.
 	Pin string `json:"pin,omitempty"`
+
+	// Tags of the resource, matched against the tags of grants. Only set for
+	// resource types that have tags.
+	Tags map[string][]string `json:"tags,omitempty"`
 }
 
 // NewACL creates an ACL from the grants provided.
@@ -88,7 +102,9 @@ func (a ACL) Allowed(r Resource, aType action.Type, userId string, opt ...Option
 
 	var parentAction action.Type
 	split := strings.Split(aType.String(), ":")
-	if len(split) == 2 {
+	// read:secret is deliberately not granted by read, so that metadata of
+	// resources with secret material can be read without exposing the secret.
+	if len(split) == 2 && aType != action.ReadSecret {
 		parentAction = action.Map[split[0]]
 	}
 	// Now, go through and check the cases indicated above
@@ -110,7 +126,7 @@ func (a ACL) Allowed(r Resource, aType action.Type, userId string, opt ...Option
 			// We don't have this action, but it's a subaction and we have the
 			// parent action. As an example, if we are looking for "read:self"
 			// and have "read", this is sufficient.
-		case grant.actions[action.All]:
+		case grant.actions[action.All] && !explicitActions.HasAction(aType):
 			// All actions are allowed
 		default:
 			// No actions in the grant match what we're looking for, so continue
@@ -143,10 +159,10 @@ func (a ACL) Allowed(r Resource, aType action.Type, userId string, opt ...Option
 			(userId == globals.AnonymousUserId || userId == ""):
 			switch {
 			// Allow discovery of scopes, so that auth methods within can be
-			// discovered
+			// discovered, and of the metadata shown before logging in to them
 			case grant.typ == r.Type &&
 				grant.typ == resource.Scope &&
-				(aType == action.List || aType == action.NoOp):
+				(aType == action.List || aType == action.NoOp || aType == action.ReadLoginMetadata):
 				found = true
 
 			// Allow discovery of and authenticating to auth methods
@@ -198,10 +214,16 @@ func (a ACL) Allowed(r Resource, aType action.Type, userId string, opt ...Option
 		// id=*;type=<resource.type>;actions=<action> where type cannot be
 		// unknown but can be a wildcard to allow any resource at all; or
 		// id=*;type=<resource.type>;output_fields=<fields> with no action.
+		// With tags, id=*;type=<resource.type>;tags=<tags>;actions=<action>
+		// only applies to the resources having all of the tags, and to
+		// listing the collection, as the listed resources are checked
+		// individually.
 		case grant.id == "*" &&
 			grant.typ != resource.Unknown &&
 			(grant.typ == r.Type ||
-				grant.typ == resource.All):
+				grant.typ == resource.All) &&
+			(grant.matchesTags(r.Tags) ||
+				(r.Id == "" && action.List.IsActionOrParent(aType))):
 
 			found = true
 
@@ -265,7 +287,6 @@ func (a ACL) ListPermissions(requestedScopes map[string]*scopes.ScopeInfo,
 			if grant.typ != requestedType && grant.typ != resource.All {
 				continue
 			}
-
 			// We found a grant that matches the requested resource type:
 			// Search to see if one or all actions in the action set have been granted.
 			found := false
@@ -292,17 +313,22 @@ func (a ACL) ListPermissions(requestedScopes map[string]*scopes.ScopeInfo,
 			}
 			p.OnlySelf = p.OnlySelf && excludeList.OnlySelf()
 
-			switch grant.id {
-			case "*":
+			switch {
+			case grant.id == "*" && len(grant.tags) > 0:
+				p.Tags = append(p.Tags, grant.Tags())
+			case grant.id == "*":
 				p.All = true
-			case "":
+			case grant.id == "":
 				continue
 			default:
 				p.ResourceIds = append(p.ResourceIds, grant.id)
 			}
 		}
+		if p.All {
+			p.Tags = nil
+		}
 
-		if p.All || len(p.ResourceIds) > 0 {
+		if p.All || len(p.ResourceIds) > 0 || len(p.Tags) > 0 {
 			perms = append(perms, p)
 		}
 	}
@@ -353,6 +353,161 @@ func Test_ACLAllowed(t *testing.T) {
 				{action: action.CreateWorkerLed, authorized: true},
 			},
 		},
+		{
+			name:     "read does not grant read secret",
+			resource: Resource{ScopeId: "p_a", Id: "credup_foo", Type: resource.Credential},
+			scopeGrants: []scopeGrant{
+				{
+					scope: "p_a",
+					grants: []string{
+						"id=credup_foo;actions=read",
+					},
+				},
+			},
+			actionsAuthorized: []actionAuthorized{
+				{action: action.Read, authorized: true},
+				{action: action.ReadSecret},
+			},
+		},
+		{
+			name:     "read secret",
+			resource: Resource{ScopeId: "p_a", Id: "credup_foo", Type: resource.Credential},
+			scopeGrants: []scopeGrant{
+				{
+					scope: "p_a",
+					grants: []string{
+						"id=credup_foo;actions=read:secret",
+					},
+				},
+			},
+			actionsAuthorized: []actionAuthorized{
+				{action: action.Read},
+				{action: action.ReadSecret, authorized: true},
+			},
+		},
+		{
+			name:     "all actions does not grant exceed connection limit",
+			resource: Resource{ScopeId: "p_a", Id: "ttcp_foo", Type: resource.Target},
+			scopeGrants: []scopeGrant{
+				{
+					scope: "p_a",
+					grants: []string{
+						"id=*;type=*;actions=*",
+					},
+				},
+			},
+			actionsAuthorized: []actionAuthorized{
+				{action: action.AuthorizeSession, authorized: true},
+				{action: action.ExceedConnectionLimit},
+			},
+		},
+		{
+			name:     "exceed connection limit",
+			resource: Resource{ScopeId: "p_a", Id: "ttcp_foo", Type: resource.Target},
+			scopeGrants: []scopeGrant{
+				{
+					scope: "p_a",
+					grants: []string{
+						"id=ttcp_foo;actions=authorize-session,exceed-connection-limit",
+					},
+				},
+			},
+			actionsAuthorized: []actionAuthorized{
+				{action: action.AuthorizeSession, authorized: true},
+				{action: action.ExceedConnectionLimit, authorized: true},
+			},
+		},
+		{
+			name:     "all actions does not grant clear deletion protection",
+			resource: Resource{ScopeId: "p_a", Id: "ttcp_foo", Type: resource.Target},
+			scopeGrants: []scopeGrant{
+				{
+					scope: "p_a",
+					grants: []string{
+						"id=*;type=*;actions=*",
+					},
+				},
+			},
+			actionsAuthorized: []actionAuthorized{
+				{action: action.Update, authorized: true},
+				{action: action.ClearDeletionProtection},
+			},
+		},
+		{
+			name:     "clear deletion protection",
+			resource: Resource{ScopeId: "p_a", Id: "ttcp_foo", Type: resource.Target},
+			scopeGrants: []scopeGrant{
+				{
+					scope: "p_a",
+					grants: []string{
+						"id=ttcp_foo;actions=update,clear-deletion-protection",
+					},
+				},
+			},
+			actionsAuthorized: []actionAuthorized{
+				{action: action.Update, authorized: true},
+				{action: action.ClearDeletionProtection, authorized: true},
+			},
+		},
+		{
+			name: "tags matching",
+			resource: Resource{
+				ScopeId: "global",
+				Id:      "w_foo",
+				Type:    resource.Worker,
+				Tags:    map[string][]string{"env": {"dev", "prod"}, "region": {"us-east-1"}},
+			},
+			scopeGrants: []scopeGrant{
+				{
+					scope: "global",
+					grants: []string{
+						"id=*;type=worker;tags=env:prod,region:us-east-1;actions=read,list;output_fields=id",
+					},
+				},
+			},
+			actionsAuthorized: []actionAuthorized{
+				{action: action.Read, authorized: true, outputFields: []string{"id"}},
+				{action: action.List, authorized: true, outputFields: []string{"id"}},
+				{action: action.Update},
+			},
+		},
+		{
+			name: "tags not matching",
+			resource: Resource{
+				ScopeId: "global",
+				Id:      "w_foo",
+				Type:    resource.Worker,
+				Tags:    map[string][]string{"env": {"dev"}, "region": {"us-east-1"}},
+			},
+			scopeGrants: []scopeGrant{
+				{
+					scope: "global",
+					grants: []string{
+						"id=*;type=worker;tags=env:prod,region:us-east-1;actions=read,list;output_fields=id",
+					},
+				},
+			},
+			actionsAuthorized: []actionAuthorized{
+				{action: action.Read},
+				{action: action.List},
+			},
+		},
+		{
+			name:     "tags list collection",
+			resource: Resource{ScopeId: "global", Type: resource.Worker},
+			scopeGrants: []scopeGrant{
+				{
+					scope: "global",
+					grants: []string{
+						"id=*;type=worker;tags=env:prod;actions=read,list",
+					},
+				},
+			},
+			actionsAuthorized: []actionAuthorized{
+				{action: action.List, authorized: true},
+				{action: action.Read},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -752,6 +907,49 @@ func TestACL_ListPermissions(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Allow targets by tags",
+			aclGrants: []scopeGrant{
+				{
+					scope: "o_1",
+					grants: []string{
+						"id=*;type=target;tags=env:prod;actions=list,read",
+						"id=*;type=target;tags=env:dev,team:red;actions=read",
+						"id=ttcp_1;type=target;actions=read",
+					},
+				},
+				{
+					scope: "o_2",
+					grants: []string{
+						"id=*;type=target;tags=env:prod;actions=read",
+						"id=*;type=target;actions=list,read",
+					},
+				},
+			},
+			scopes:       map[string]*scopes.ScopeInfo{"o_1": nil, "o_2": nil},
+			resourceType: resource.Target,
+			actionSet:    action.ActionSet{action.List, action.Read},
+			expPermissions: []Permission{
+				{
+					ScopeId:     "o_1",
+					Resource:    resource.Target,
+					Action:      action.List,
+					ResourceIds: []string{"ttcp_1"},
+					Tags: []map[string]string{
+						{"env": "prod"},
+						{"env": "dev", "team": "red"},
+					},
+					OnlySelf: false,
+				},
+				{
+					ScopeId:  "o_2",
+					Resource: resource.Target,
+					Action:   action.List,
+					OnlySelf: false,
+					All:      true,
+				},
+			},
+		},
 		{
 			name:         "Allow recovery user full access to targets",
 			userId:       globals.RecoveryUserId,
@@ -856,7 +1054,7 @@ func Test_AnonRestrictions(t *testing.T) {
 				if i == resource.Controller || i == resource.Worker {
 					continue
 				}
-				for j := action.Type(1); j <= action.ReadCertificateAuthority; j++ {
+				for j := action.Type(1); j <= action.BatchCreate; j++ {
 					res := Resource{
 						ScopeId: scope.Global.String(),
 						Id:      "foobar",
@@ -879,7 +1077,7 @@ func Test_AnonRestrictions(t *testing.T) {
 					case true:
 						// Ensure it's one of the specific cases and fail otherwise
 						switch {
-						case i == resource.Scope && (j == action.List || j == action.NoOp):
+						case i == resource.Scope && (j == action.List || j == action.NoOp || j == action.ReadLoginMetadata):
 							assert.True(results.Authorized, fmt.Sprintf("i: %v, j: %v", i, j))
 						case i == resource.AuthMethod && (j == action.List || j == action.NoOp || j == action.Authenticate):
 							assert.True(results.Authorized, fmt.Sprintf("i: %v, j: %v", i, j))
@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package perms
+
+import (
+	"sort"
+
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+)
+
+// AuthorizedActions returns, for each resource type, the actions the ACL
+// allows the user on at least one resource of that type in the scope scopeId.
+// Actions granted on all types are returned under resource.All. The actions of
+// each type are sorted.
+func (a ACL) AuthorizedActions(scopeId, userId string) map[resource.Type]action.ActionSet {
+	ret := make(map[resource.Type]action.ActionSet)
+	for _, g := range a.scopeMap[scopeId] {
+		for act := range g.actions {
+			probe := grantProbe(g, act)
+			if !a.Allowed(probe, act, userId).Authorized {
+				continue
+			}
+			if !ret[probe.Type].HasAction(act) {
+				ret[probe.Type] = append(ret[probe.Type], act)
+			}
+		}
+	}
+	for _, acts := range ret {
+		sort.Slice(acts, func(i, j int) bool {
+			return acts[i].String() < acts[j].String()
+		})
+	}
+	return ret
+}
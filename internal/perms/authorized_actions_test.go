@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package perms
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestACL_AuthorizedActions(t *testing.T) {
+	const scopeId = "p_abcd1234"
+	tests := []struct {
+		name   string
+		grants []string
+		userId string
+		want   map[resource.Type]action.ActionSet
+	}{
+		{
+			name:   "no grants",
+			userId: "u_1234567890",
+			want:   map[resource.Type]action.ActionSet{},
+		},
+		{
+			name: "typed grants",
+			grants: []string{
+				"id=*;type=target;actions=read,authorize-session",
+				"type=target;actions=list",
+				"id=*;type=host-catalog;actions=update,read",
+			},
+			userId: "u_1234567890",
+			want: map[resource.Type]action.ActionSet{
+				resource.Target:      {action.AuthorizeSession, action.List, action.Read},
+				resource.HostCatalog: {action.Read, action.Update},
+			},
+		},
+		{
+			name:   "specific id",
+			grants: []string{"id=ttcp_1234567890;actions=read,update"},
+			userId: "u_1234567890",
+			want: map[resource.Type]action.ActionSet{
+				resource.Target: {action.Read, action.Update},
+			},
+		},
+		{
+			name:   "pinned grant",
+			grants: []string{"id=hcst_1234567890;type=host;actions=read"},
+			userId: "u_1234567890",
+			want: map[resource.Type]action.ActionSet{
+				resource.Host: {action.Read},
+			},
+		},
+		{
+			name: "duplicate actions",
+			grants: []string{
+				"id=*;type=session;actions=read",
+				"id=*;type=session;actions=read,cancel",
+			},
+			userId: "u_1234567890",
+			want: map[resource.Type]action.ActionSet{
+				resource.Session: {action.Cancel, action.Read},
+			},
+		},
+		{
+			name:   "all types and actions",
+			grants: []string{"id=*;type=*;actions=*"},
+			userId: "u_1234567890",
+			want: map[resource.Type]action.ActionSet{
+				resource.All: {action.All},
+			},
+		},
+		{
+			name:   "anonymous user restrictions",
+			grants: []string{"id=*;type=target;actions=read", "id=*;type=auth-method;actions=read,authenticate"},
+			userId: "u_anon",
+			want: map[resource.Type]action.ActionSet{
+				resource.AuthMethod: {action.Authenticate},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			grants := make([]Grant, 0, len(tt.grants))
+			for _, g := range tt.grants {
+				grant, err := Parse(scopeId, g)
+				require.NoError(err)
+				grants = append(grants, grant)
+			}
+			acl := NewACL(grants...)
+			assert.Equal(t, tt.want, acl.AuthorizedActions(scopeId, tt.userId))
+			assert.Empty(t, acl.AuthorizedActions("p_other", tt.userId))
+		})
+	}
+}
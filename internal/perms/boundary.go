@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package perms
+
+import (
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+)
+
+// CoveredBy returns true if every permission provided by the grant is also
+// provided by at least one of the given boundary grants. It is used to ensure
+// that grants added to roles in child scopes do not exceed the grants of
+// boundary roles in their ancestor scopes.
+//
+// The check is conservative: a grant that would only be covered by the union
+// of several boundary grants (e.g. its actions split across them) is not
+// considered covered.
+func (g Grant) CoveredBy(boundaries []Grant) bool {
+	for _, b := range boundaries {
+		if g.coveredByGrant(b) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g Grant) coveredByGrant(b Grant) bool {
+	switch {
+	case b.id == "*", b.id == g.id:
+	default:
+		return false
+	}
+
+	if b.typ != resource.All && b.typ != g.typ {
+		return false
+	}
+
+	if !b.actions[action.All] {
+		for act := range g.actions {
+			if b.actions[act] {
+				continue
+			}
+			// A parent action implies its subactions, with the same
+			// exception the ACL makes for read:secret
+			split := strings.Split(act.String(), ":")
+			if len(split) != 2 || act == action.ReadSecret || !b.actions[action.Map[split[0]]] {
+				return false
+			}
+		}
+	}
+
+	// Unset output fields on the boundary grant place no restriction on the
+	// fields of the grant
+	if _, bHasSetFields := b.OutputFields.Fields(); bHasSetFields && !b.OutputFields.Has("*") {
+		gFields, gHasSetFields := g.OutputFields.Fields()
+		if !gHasSetFields {
+			return false
+		}
+		for _, f := range gFields {
+			if !b.OutputFields.Has(f) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
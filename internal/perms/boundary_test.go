@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package perms
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrant_CoveredBy(t *testing.T) {
+	tests := []struct {
+		name       string
+		grant      string
+		boundaries []string
+		want       bool
+	}{
+		{
+			name:  "no boundaries",
+			grant: "id=*;type=host;actions=read",
+		},
+		{
+			name:       "everything",
+			grant:      "id=*;type=*;actions=*",
+			boundaries: []string{"id=*;type=*;actions=*"},
+			want:       true,
+		},
+		{
+			name:       "exact match",
+			grant:      "id=*;type=host;actions=read,update",
+			boundaries: []string{"id=*;type=host;actions=read,update"},
+			want:       true,
+		},
+		{
+			name:       "wildcard type and actions",
+			grant:      "id=*;type=host;actions=read,update",
+			boundaries: []string{"id=*;type=*;actions=*"},
+			want:       true,
+		},
+		{
+			name:       "specific id covered by wildcard id",
+			grant:      "id=hcst_1234567890;actions=read",
+			boundaries: []string{"id=*;type=*;actions=read"},
+			want:       true,
+		},
+		{
+			name:       "wildcard id not covered by specific id",
+			grant:      "id=*;type=host-catalog;actions=read",
+			boundaries: []string{"id=hcst_1234567890;actions=read"},
+		},
+		{
+			name:       "different type",
+			grant:      "id=*;type=target;actions=read",
+			boundaries: []string{"id=*;type=host;actions=*"},
+		},
+		{
+			name:       "wildcard type not covered by specific type",
+			grant:      "id=*;type=*;actions=read",
+			boundaries: []string{"id=*;type=host;actions=*"},
+		},
+		{
+			name:       "extra action",
+			grant:      "id=*;type=host;actions=read,delete",
+			boundaries: []string{"id=*;type=host;actions=read,update"},
+		},
+		{
+			name:       "wildcard action not covered by specific actions",
+			grant:      "id=*;type=host;actions=*",
+			boundaries: []string{"id=*;type=host;actions=read,update,delete"},
+		},
+		{
+			name:       "actions split across boundaries",
+			grant:      "id=*;type=host;actions=read,delete",
+			boundaries: []string{"id=*;type=host;actions=read", "id=*;type=host;actions=delete"},
+		},
+		{
+			name:       "second boundary matches",
+			grant:      "id=*;type=host;actions=read",
+			boundaries: []string{"id=*;type=target;actions=*", "id=*;type=host;actions=read"},
+			want:       true,
+		},
+		{
+			name:       "subaction covered by parent",
+			grant:      "id=*;type=auth-token;actions=read:self",
+			boundaries: []string{"id=*;type=auth-token;actions=read"},
+			want:       true,
+		},
+		{
+			name:       "parent not covered by subaction",
+			grant:      "id=*;type=auth-token;actions=read",
+			boundaries: []string{"id=*;type=auth-token;actions=read:self"},
+		},
+		{
+			name:       "read secret not covered by read",
+			grant:      "id=*;type=credential;actions=read:secret",
+			boundaries: []string{"id=*;type=credential;actions=read"},
+		},
+		{
+			name:       "output fields covered",
+			grant:      "id=*;type=host;actions=read;output_fields=id,name",
+			boundaries: []string{"id=*;type=host;actions=read;output_fields=id,name,version"},
+			want:       true,
+		},
+		{
+			name:       "extra output field",
+			grant:      "id=*;type=host;actions=read;output_fields=id,address",
+			boundaries: []string{"id=*;type=host;actions=read;output_fields=id,name"},
+		},
+		{
+			name:       "unset output fields not covered by set fields",
+			grant:      "id=*;type=host;actions=read",
+			boundaries: []string{"id=*;type=host;actions=read;output_fields=id,name"},
+		},
+		{
+			name:       "output fields covered by unset fields",
+			grant:      "id=*;type=host;actions=read;output_fields=id",
+			boundaries: []string{"id=*;type=host;actions=read"},
+			want:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			g, err := Parse("o_abcd1234", tt.grant)
+			require.NoError(err)
+			boundaries := make([]Grant, 0, len(tt.boundaries))
+			for _, b := range tt.boundaries {
+				bg, err := Parse("global", b)
+				require.NoError(err)
+				boundaries = append(boundaries, bg)
+			}
+			assert.Equal(t, tt.want, g.CoveredBy(boundaries))
+		})
+	}
+}
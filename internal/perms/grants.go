@@ -6,6 +6,7 @@ package perms
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"sort"
 	"strings"
 	"unicode"
@@ -17,6 +18,17 @@ import (
 	"github.com/hashicorp/boundary/internal/types/scope"
 )
 
+// legacyTemplateRe matches the templates accepted in grant ids before the
+// dotted forms such as {{.User.Id}} were introduced.
+var legacyTemplateRe = regexp.MustCompile(`\{\{\s*(user|account)\.id\s*\}\}`)
+
+// UsesLegacyTemplate reports whether the grant string uses the deprecated
+// {{user.id}} or {{account.id}} templates rather than {{.User.Id}} or
+// {{.Account.Id}}.
+func UsesLegacyTemplate(grantString string) bool {
+	return legacyTemplateRe.MatchString(grantString)
+}
+
 // GrantTuple is simply a struct that can be reference from other code to return
 // a set of scopes and grants to parse
 type GrantTuple struct {
@@ -46,6 +58,10 @@ type Grant struct {
 	// The type, if provided
 	typ resource.Type
 
+	// The tags a resource must have for the grant to apply, if provided. Each
+	// key is matched against the tag values of the resource with that key.
+	tags map[string]string
+
 	// The set of actions being granted
 	actions map[action.Type]bool
 
@@ -65,6 +81,42 @@ func (g Grant) Type() resource.Type {
 	return g.typ
 }
 
+// Tags returns the tag selectors of the grant
+func (g Grant) Tags() map[string]string {
+	return g.tags
+}
+
+// resourceTags returns the tag selectors of the grant in the form of the tags
+// of a resource, for dummy resources matching the grant
+func (g Grant) resourceTags() map[string][]string {
+	if len(g.tags) == 0 {
+		return nil
+	}
+	ret := make(map[string][]string, len(g.tags))
+	for k, v := range g.tags {
+		ret[k] = []string{v}
+	}
+	return ret
+}
+
+// matchesTags returns whether the tags of the resource match every tag
+// selector of the grant
+func (g Grant) matchesTags(tags map[string][]string) bool {
+	for k, v := range g.tags {
+		var found bool
+		for _, rv := range tags[k] {
+			if rv == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
 func (g Grant) Actions() (typs []action.Type, strs []string) {
 	typs = make([]action.Type, 0, len(g.actions))
 	strs = make([]string, 0, len(g.actions))
@@ -99,6 +151,12 @@ func (g Grant) clone() *Grant {
 		id:    g.id,
 		typ:   g.typ,
 	}
+	if g.tags != nil {
+		ret.tags = make(map[string]string, len(g.tags))
+		for k, v := range g.tags {
+			ret.tags[k] = v
+		}
+	}
 	if g.actionsBeingParsed != nil {
 		ret.actionsBeingParsed = append(ret.actionsBeingParsed, g.actionsBeingParsed...)
 	}
@@ -130,6 +188,15 @@ func (g Grant) CanonicalString() string {
 		builder = append(builder, fmt.Sprintf("type=%s", g.typ.String()))
 	}
 
+	if len(g.tags) > 0 {
+		tags := make([]string, 0, len(g.tags))
+		for k, v := range g.tags {
+			tags = append(tags, fmt.Sprintf("%s:%s", k, v))
+		}
+		sort.Strings(tags)
+		builder = append(builder, fmt.Sprintf("tags=%s", strings.Join(tags, ",")))
+	}
+
 	if len(g.actions) > 0 {
 		actions := make([]string, 0, len(g.actions))
 		for action := range g.actions {
@@ -156,6 +223,9 @@ func (g Grant) MarshalJSON() ([]byte, error) {
 	if g.typ != resource.Unknown {
 		res["type"] = g.typ.String()
 	}
+	if len(g.tags) > 0 {
+		res["tags"] = g.tags
+	}
 	if len(g.actions) > 0 {
 		actions := make([]string, 0, len(g.actions))
 		for action := range g.actions {
@@ -200,6 +270,23 @@ func (g *Grant) unmarshalJSON(data []byte) error {
 			return errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("unknown type specifier %q", typ))
 		}
 	}
+	if rawTags, ok := raw["tags"]; ok {
+		interfaceTags, ok := rawTags.(map[string]any)
+		if !ok {
+			return errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("unable to interpret %q as object", "tags"))
+		}
+		g.tags = make(map[string]string, len(interfaceTags))
+		for k, v := range interfaceTags {
+			value, ok := v.(string)
+			if !ok {
+				return errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("unable to interpret value of tag %q as string", k))
+			}
+			if err := validateTag(k, value); err != nil {
+				return errors.WrapDeprecated(err, op)
+			}
+			g.tags[k] = value
+		}
+	}
 	if rawActions, ok := raw["actions"]; ok {
 		interfaceActions, ok := rawActions.([]any)
 		if !ok {
@@ -275,6 +362,23 @@ func (g *Grant) unmarshalText(grantString string) error {
 				return errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("unknown type specifier %q", typeString))
 			}
 
+		case "tags":
+			tags := strings.Split(kv[1], ",")
+			g.tags = make(map[string]string, len(tags))
+			for _, tag := range tags {
+				k, v, ok := strings.Cut(tag, ":")
+				if !ok {
+					return errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("tag %q not formatted correctly, expected key:value", tag))
+				}
+				if err := validateTag(k, v); err != nil {
+					return errors.WrapDeprecated(err, op)
+				}
+				if _, ok := g.tags[k]; ok {
+					return errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("tag key %q specified more than once", k))
+				}
+				g.tags[k] = v
+			}
+
 		case "actions":
 			actions := strings.Split(kv[1], ",")
 			if len(actions) > 0 {
@@ -380,6 +484,10 @@ func Parse(scopeId, grantString string, opt ...Option) (Grant, error) {
 		return Grant{}, errors.WrapDeprecated(err, op)
 	}
 
+	if err := grant.validateTags(); err != nil {
+		return Grant{}, errors.WrapDeprecated(err, op)
+	}
+
 	if !opts.withSkipFinalValidation {
 		switch {
 		case grant.id == "*":
@@ -470,6 +578,7 @@ func Parse(scopeId, grantString string, opt ...Option) (Grant, error) {
 				ScopeId: scopeId,
 				Id:      grant.id,
 				Type:    grant.typ,
+				Tags:    grant.resourceTags(),
 			}
 			if !resource.TopLevelType(grant.typ) {
 				r.Pin = grant.id
@@ -509,6 +618,56 @@ func (g Grant) validateType() error {
 	return nil
 }
 
+// taggedTypes are the resource types that have tags, and so can be selected by
+// the tags of a grant
+var taggedTypes = map[resource.Type]bool{
+	resource.Target: true,
+	resource.Worker: true,
+}
+
+// validateTags ensures that tag selectors are only used with a wildcard id and
+// a type whose resources have tags.
+func (g Grant) validateTags() error {
+	const op = "perms.(Grant).validateTags"
+	if len(g.tags) == 0 {
+		return nil
+	}
+	switch {
+	case g.id != "*":
+		return errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("parsed grant string %q contains tags without a wildcard id", g.CanonicalString()))
+	case !taggedTypes[g.typ]:
+		return errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("parsed grant string %q contains tags for type %s, but resources can only be selected by tags for types: %s", g.CanonicalString(), g.typ.String(), strings.Join(taggedTypeNames(), ", ")))
+	}
+	return nil
+}
+
+// taggedTypeNames returns the sorted names of the taggedTypes.
+func taggedTypeNames() []string {
+	names := make([]string, 0, len(taggedTypes))
+	for t := range taggedTypes {
+		names = append(names, t.String())
+	}
+	sort.Strings(names)
+	return names
+}
+
+// validateTag ensures a tag selector can be represented in the canonical
+// grant string format.
+func validateTag(key, value string) error {
+	const op = "perms.validateTag"
+	switch {
+	case key == "":
+		return errors.NewDeprecated(errors.InvalidParameter, op, "empty tag key found")
+	case value == "":
+		return errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("empty value found for tag %q", key))
+	case strings.ContainsAny(key, ":,;="):
+		return errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("tag key %q contains one of the reserved characters %q", key, ":,;="))
+	case strings.ContainsAny(value, ",;="):
+		return errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("value of tag %q contains one of the reserved characters %q", key, ",;="))
+	}
+	return nil
+}
+
 func (g *Grant) parseAndValidateActions() error {
 	const op = "perms.(Grant).parseAndValidateActions"
 	if len(g.actionsBeingParsed) == 0 {
@@ -177,6 +177,22 @@ func Test_MarshalingAndCloning(t *testing.T) {
 			jsonOutput:      `{"id":"baz","output_fields":["id","name","version"],"type":"group"}`,
 			canonicalString: `id=baz;type=group;output_fields=id,name,version`,
 		},
+		{
+			name: "tags",
+			input: Grant{
+				id: "*",
+				scope: Scope{
+					Type: scope.Global,
+				},
+				typ: resource.Worker,
+				tags: map[string]string{
+					"region": "us-east-1",
+					"env":    "prod",
+				},
+			},
+			jsonOutput:      `{"id":"*","tags":{"env":"prod","region":"us-east-1"},"type":"worker"}`,
+			canonicalString: `id=*;type=worker;tags=env:prod,region:us-east-1`,
+		},
 		{
 			name: "everything",
 			input: Grant{
@@ -319,6 +335,36 @@ func Test_Unmarshaling(t *testing.T) {
 			textInput: `actions=,`,
 			textErr:   `perms.(Grant).unmarshalText: empty action found: parameter violation: error #100`,
 		},
+		{
+			name: "good tags",
+			expected: Grant{
+				tags: map[string]string{
+					"env":    "prod",
+					"region": "us-east-1",
+				},
+			},
+			jsonInput: `{"tags":{"env":"prod","region":"us-east-1"}}`,
+			textInput: `tags=env:prod,region:us-east-1`,
+		},
+		{
+			name:      "bad tags",
+			jsonInput: `{"tags":["env:prod"]}`,
+			jsonErr:   `perms.(Grant).unmarshalJSON: unable to interpret "tags" as object: parameter violation: error #100`,
+			textInput: `tags=env`,
+			textErr:   `perms.(Grant).unmarshalText: tag "env" not formatted correctly, expected key:value: parameter violation: error #100`,
+		},
+		{
+			name:      "empty tag value",
+			jsonInput: `{"tags":{"env":""}}`,
+			jsonErr:   `perms.(Grant).unmarshalJSON: perms.validateTag: empty value found for tag "env": parameter violation: error #100`,
+			textInput: `tags=env:`,
+			textErr:   `perms.(Grant).unmarshalText: perms.validateTag: empty value found for tag "env": parameter violation: error #100`,
+		},
+		{
+			name:      "duplicate tag key",
+			textInput: `tags=env:prod,env:dev`,
+			textErr:   `perms.(Grant).unmarshalText: tag key "env" specified more than once: parameter violation: error #100`,
+		},
 		{
 			name:      "bad json action",
 			jsonInput: `{"actions":[1, true]}`,
@@ -721,6 +767,34 @@ func Test_Parse(t *testing.T) {
 			accountId: fmt.Sprintf("%s_1234567890", globals.PasswordAccountPrefix),
 			err:       `perms.Parse: unknown template "{{superman}}" in grant "id" value: parameter violation: error #100`,
 		},
+		{
+			name:          "good tags",
+			input:         `id=*;type=worker;tags=env:prod;actions=read`,
+			scopeOverride: "global",
+			expected: Grant{
+				scope: Scope{
+					Id:   "global",
+					Type: scope.Global,
+				},
+				id:   "*",
+				typ:  resource.Worker,
+				tags: map[string]string{"env": "prod"},
+				actions: map[action.Type]bool{
+					action.Read: true,
+				},
+			},
+		},
+		{
+			name:          "tags without wildcard id",
+			input:         `type=worker;tags=env:prod;actions=list`,
+			scopeOverride: "global",
+			err:           `perms.Parse: perms.(Grant).validateTags: parsed grant string "type=worker;tags=env:prod;actions=list" contains tags without a wildcard id: parameter violation: error #100`,
+		},
+		{
+			name:  "tags of untagged type",
+			input: `id=*;type=host-catalog;tags=env:prod;actions=read`,
+			err:   `perms.Parse: perms.(Grant).validateTags: parsed grant string "id=*;type=host-catalog;tags=env:prod;actions=read" contains tags for type host-catalog, but resources can only be selected by tags for types: target, worker: parameter violation: error #100`,
+		},
 		{
 			name:      "good old account id template",
 			input:     `id={{    account.id}};actions=update,read`,
@@ -866,3 +940,22 @@ func FuzzParse(f *testing.F) {
 		}
 	})
 }
+
+func TestUsesLegacyTemplate(t *testing.T) {
+	tests := []struct {
+		grant string
+		want  bool
+	}{
+		{grant: "id={{user.id}};actions=read", want: true},
+		{grant: "id={{ user.id }};actions=read", want: true},
+		{grant: `{"id":"{{account.id}}","actions":["read"]}`, want: true},
+		{grant: "id={{.User.Id}};actions=read", want: false},
+		{grant: "id={{.Account.Id}};actions=read", want: false},
+		{grant: "id=*;type=*;actions=*", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.grant, func(t *testing.T) {
+			assert.Equal(t, tt.want, UsesLegacyTemplate(tt.grant))
+		})
+	}
+}
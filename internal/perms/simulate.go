@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package perms
+
+import (
+	"sort"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+)
+
+// NewlyAllowed returns the actions of the grant that the user would be allowed
+// if the grant were added to the ACL, but that the ACL does not currently
+// allow. Each action is checked against a dummy resource matching the grant's
+// id and type, so for instance a grant on all hosts is only considered to be
+// already allowed if the ACL allows the action on all hosts, not just on some
+// of them. The returned actions are sorted.
+func (a ACL) NewlyAllowed(g Grant, userId string) []action.Type {
+	grants := []Grant{g}
+	for _, scopeGrants := range a.scopeMap {
+		grants = append(grants, scopeGrants...)
+	}
+	proposed := NewACL(grants...)
+
+	var ret []action.Type
+	for act := range g.actions {
+		probe := grantProbe(g, act)
+		if proposed.Allowed(probe, act, userId).Authorized &&
+			!a.Allowed(probe, act, userId).Authorized {
+			ret = append(ret, act)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].String() < ret[j].String()
+	})
+	return ret
+}
+
+// grantProbe returns a dummy resource matching the id and type of g, against
+// which act can be checked.
+func grantProbe(g Grant, act action.Type) Resource {
+	r := Resource{
+		ScopeId: g.scope.Id,
+		Id:      g.id,
+		Type:    g.typ,
+		Tags:    g.resourceTags(),
+	}
+	switch {
+	case g.id != "" && g.id != "*" && g.typ == resource.Unknown:
+		// An id without a type operates on the resource itself, so use the
+		// id's type to match grants on all resources of that type
+		r.Type = globals.ResourceTypeFromPrefix(g.id)
+	case !resource.TopLevelType(g.typ):
+		r.Pin = g.id
+	}
+	if (g.id == "" || g.id == "*") &&
+		(action.List.IsActionOrParent(act) || action.Create.IsActionOrParent(act)) {
+		// Collection actions are checked against the collection itself
+		r.Id = ""
+	}
+	return r
+}
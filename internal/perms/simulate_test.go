@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package perms
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestACL_NewlyAllowed(t *testing.T) {
+	const scopeId = "o_abcd1234"
+	tests := []struct {
+		name    string
+		current []string
+		grant   string
+		userId  string
+		want    []action.Type
+	}{
+		{
+			name:   "no current grants",
+			grant:  "id=*;type=host-catalog;actions=read,update",
+			userId: "u_1234567890",
+			want:   []action.Type{action.Read, action.Update},
+		},
+		{
+			name:    "some actions already allowed",
+			current: []string{"id=*;type=host-catalog;actions=read"},
+			grant:   "id=*;type=host-catalog;actions=read,update",
+			userId:  "u_1234567890",
+			want:    []action.Type{action.Update},
+		},
+		{
+			name:    "all actions already allowed",
+			current: []string{"id=*;type=*;actions=*"},
+			grant:   "id=*;type=host-catalog;actions=read,update",
+			userId:  "u_1234567890",
+		},
+		{
+			name:    "specific id does not cover wildcard id",
+			current: []string{"id=hcst_1234567890;actions=read"},
+			grant:   "id=*;type=host-catalog;actions=read",
+			userId:  "u_1234567890",
+			want:    []action.Type{action.Read},
+		},
+		{
+			name:    "wildcard id covers specific id",
+			current: []string{"id=*;type=host-catalog;actions=read"},
+			grant:   "id=hcst_1234567890;actions=read,delete",
+			userId:  "u_1234567890",
+			want:    []action.Type{action.Delete},
+		},
+		{
+			name:    "collection actions",
+			current: []string{"type=host-catalog;actions=list"},
+			grant:   "id=*;type=host-catalog;actions=create,list",
+			userId:  "u_1234567890",
+			want:    []action.Type{action.Create},
+		},
+		{
+			name:    "pinned grant",
+			current: []string{"id=hcst_1234567890;type=host;actions=read"},
+			grant:   "id=hcst_1234567890;type=host;actions=read,update",
+			userId:  "u_1234567890",
+			want:    []action.Type{action.Update},
+		},
+		{
+			name:   "anonymous user restrictions",
+			grant:  "id=*;type=auth-method;actions=read,authenticate",
+			userId: "u_anon",
+			want:   []action.Type{action.Authenticate},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			current := make([]Grant, 0, len(tt.current))
+			for _, c := range tt.current {
+				g, err := Parse(scopeId, c)
+				require.NoError(err)
+				current = append(current, g)
+			}
+			g, err := Parse(scopeId, tt.grant)
+			require.NoError(err)
+			assert.Equal(t, tt.want, NewACL(current...).NewlyAllowed(g, tt.userId))
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/types/action"
 )
 
 // ContextRequestInforation is a type used solely for context keys -- see the
@@ -37,6 +38,22 @@ type RequestContext struct {
 	// OutputFields is the set of fields authorized for output for the
 	// authorized action, if not the default
 	OutputFields *perms.OutputFields
+
+	// ClientIp is the ip of the client that made the request
+	ClientIp string
+
+	// ClientCertificateFingerprint is the fingerprint of the tls client
+	// certificate presented with the request, if any
+	ClientCertificateFingerprint string
+
+	// Resource and Action are the resource and action the request was last
+	// authorized for
+	Resource *perms.Resource
+	Action   action.Type
+
+	// Deprecations contains the ids of the deprecated fields and behaviors used
+	// by the request, see the deprecation package
+	Deprecations []string
 }
 
 // NewRequestContext returns a derived context with a new RequestContext value
@@ -637,3 +637,58 @@ func waitForRunStatus(t *testing.T, repo *job.Repository, runId, status string)
 
 	return run
 }
+
+func TestSchedulerReleaseRuns(t *testing.T) {
+	// do not use t.Parallel() since it relies on the sys eventer
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	rw := db.New(conn)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iam.TestRepo(t, conn, wrapper)
+	testConfig := event.DefaultEventerConfig()
+	testLock := &sync.Mutex{}
+	testLogger := hclog.New(&hclog.LoggerOptions{
+		Mutex: testLock,
+	})
+	err := event.InitSysEventer(testLogger, testLock, "TestSchedulerReleaseRuns", event.WithEventerConfig(testConfig))
+	require.NoError(err)
+
+	sched := TestScheduler(t, conn, wrapper, WithRunJobsLimit(10), WithRunJobsInterval(time.Second))
+
+	fn, jobReady, jobDone := testJobFn()
+	tj := testJob{name: "name", description: "desc", fn: fn, nextRunIn: time.Hour}
+	err = sched.RegisterJob(context.Background(), tj)
+	require.NoError(err)
+
+	baseCtx, baseCnl := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	err = sched.Start(baseCtx, &wg)
+	require.NoError(err)
+
+	// Wait for scheduler to run job
+	<-jobReady
+	runJob, ok := sched.runningJobs.Load(tj.name)
+	require.True(ok)
+	runId := runJob.(*runningJob).runId
+
+	// Canceling the base context leaves the run in the running state
+	baseCnl()
+	<-jobDone
+	wg.Wait()
+
+	repo, err := job.NewRepository(rw, rw, kmsCache)
+	require.NoError(err)
+	run, err := repo.LookupRun(context.Background(), runId)
+	require.NoError(err)
+	assert.Equal(string(job.Running), run.Status)
+
+	runs, err := sched.ReleaseRuns(context.Background())
+	require.NoError(err)
+	require.Len(runs, 1)
+	assert.Equal(runId, runs[0].PrivateId)
+
+	run, err = repo.LookupRun(context.Background(), runId)
+	require.NoError(err)
+	assert.Equal(string(job.Interrupted), run.Status)
+}
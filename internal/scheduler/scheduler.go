@@ -175,6 +175,23 @@ func (s *Scheduler) Start(ctx context.Context, wg *sync.WaitGroup) error {
 	return nil
 }
 
+// ReleaseRuns interrupts all runs allocated to this server, so other servers
+// can run their jobs without waiting for the interrupt threshold. It is meant
+// to be called during shutdown, once the context passed to Start is canceled
+// and the running jobs have returned.
+func (s *Scheduler) ReleaseRuns(ctx context.Context) ([]*job.Run, error) {
+	const op = "scheduler.(Scheduler).ReleaseRuns"
+	repo, err := s.jobRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	runs, err := repo.InterruptRuns(ctx, 0, job.WithControllerId(s.serverId))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return runs, nil
+}
+
 // RunNow attempts to trigger the scheduling loop, if the scheduling loop is actively running it will
 // cause the loop to run again immediately after finishing.
 func (s *Scheduler) RunNow() {
@@ -14,6 +14,11 @@ const (
 	and
 		worker_id = ?`
 
+	deleteControllerQuery = `
+		delete from server_controller
+		where private_id = ?;
+	`
+
 	deleteWorkerAuthQuery = `
 		delete from worker_auth_authorized
  		where worker_key_identifier = @worker_key_identifier;
@@ -61,7 +61,7 @@ func (r *Repository) UpsertController(ctx context.Context, controller *store.Con
 			var err error
 			onConflict := &db.OnConflict{
 				Target: db.Columns{"private_id"},
-				Action: append(db.SetColumns([]string{"description", "address"}), db.SetColumnValues(map[string]any{"update_time": "now()"})...),
+				Action: append(db.SetColumns([]string{"description", "address", "region"}), db.SetColumnValues(map[string]any{"update_time": "now()"})...),
 			}
 			err = w.Create(ctx, controller, db.WithOnConflict(onConflict), db.WithReturnRowsAffected(&rowsUpdated))
 			if err != nil {
@@ -77,3 +77,38 @@ func (r *Repository) UpsertController(ctx context.Context, controller *store.Con
 
 	return int(rowsUpdated), nil
 }
+
+// DeleteController deletes the controller with the privateId. A controller
+// deletes itself when it shuts down, so it is no longer returned to workers
+// as an upstream. It is recreated by its next status update if it keeps
+// running.
+func (r *Repository) DeleteController(ctx context.Context, privateId string) (int, error) {
+	const op = "server.(Repository).DeleteController"
+	if privateId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing private id")
+	}
+
+	var rowsDeleted int
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			var err error
+			rowsDeleted, err = w.Exec(ctx, deleteControllerQuery, []any{privateId})
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsDeleted > 1 {
+				// return err, which will result in a rollback of the delete
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been deleted")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, err
+	}
+
+	return rowsDeleted, nil
+}
@@ -61,6 +61,15 @@ func TestRepository_UpsertController(t *testing.T) {
 			},
 			wantCount: 1,
 		},
+		{
+			name: "valid-controller-with-region",
+			controller: &store.Controller{
+				PrivateId: "test-region-controller",
+				Address:   "127.0.0.1",
+				Region:    "us-east-1",
+			},
+			wantCount: 1,
+		},
 		{
 			name: "valid-controller-short-name",
 			controller: &store.Controller{
@@ -84,3 +93,68 @@ func TestRepository_UpsertController(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_UpsertControllerRegion(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	testRepo, err := NewRepository(rw, rw, testKms)
+	require.NoError(t, err)
+
+	controller := &store.Controller{
+		PrivateId: "test-controller",
+		Address:   "127.0.0.1",
+		Region:    "us-east-1",
+	}
+	_, err = testRepo.UpsertController(ctx, controller)
+	require.NoError(t, err)
+
+	controllers, err := testRepo.ListControllers(ctx)
+	require.NoError(t, err)
+	require.Len(t, controllers, 1)
+	assert.Equal(t, "us-east-1", controllers[0].GetRegion())
+
+	// Removing the region from the config clears it
+	controller.Region = ""
+	_, err = testRepo.UpsertController(ctx, controller)
+	require.NoError(t, err)
+
+	controllers, err = testRepo.ListControllers(ctx)
+	require.NoError(t, err)
+	require.Len(t, controllers, 1)
+	assert.Empty(t, controllers[0].GetRegion())
+}
+
+func TestRepository_DeleteController(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	testRepo, err := NewRepository(rw, rw, testKms)
+	require.NoError(t, err)
+
+	_, err = testRepo.DeleteController(ctx, "")
+	require.Error(t, err)
+
+	for _, id := range []string{"test-controller-1", "test-controller-2"} {
+		_, err = testRepo.UpsertController(ctx, &store.Controller{PrivateId: id, Address: "127.0.0.1"})
+		require.NoError(t, err)
+	}
+
+	deleted, err := testRepo.DeleteController(ctx, "test-controller-1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+
+	controllers, err := testRepo.ListControllers(ctx)
+	require.NoError(t, err)
+	require.Len(t, controllers, 1)
+	assert.Equal(t, "test-controller-2", controllers[0].GetPrivateId())
+
+	// Deleting an unknown controller is not an error
+	deleted, err = testRepo.DeleteController(ctx, "test-controller-1")
+	require.NoError(t, err)
+	assert.Zero(t, deleted)
+}
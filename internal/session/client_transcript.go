@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package session
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/hashicorp/go-kms-wrapping/v2/extras/structwrapping"
+)
+
+// MaxClientTranscriptSize is the maximum size in bytes of a transcript
+// uploaded by the client of a session.
+const MaxClientTranscriptSize = 8 << 20
+
+// ClientTranscript is the terminal transcript captured by the client of a
+// session which could not be recorded by a worker. It is encrypted with the
+// database key of the org of the session, and is kept after the session is
+// deleted.
+type ClientTranscript struct {
+	SessionId    string `gorm:"primary_key"`
+	ProjectId    string
+	OrgId        string
+	UserId       string
+	Transcript   []byte `gorm:"-" wrapping:"pt,transcript_data"`
+	CtTranscript []byte `gorm:"column:transcript" wrapping:"ct,transcript_data"`
+	Truncated    bool
+	KeyId        string
+	CreateTime   *timestamp.Timestamp `gorm:"default:current_timestamp"`
+}
+
+// TableName returns the table name.
+func (t *ClientTranscript) TableName() string {
+	return "session_client_transcript"
+}
+
+func (t *ClientTranscript) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "session.(ClientTranscript).encrypt"
+	if err := structwrapping.WrapStruct(ctx, cipher, t, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	var err error
+	t.KeyId, err = cipher.KeyId(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to discover wrapper key id"))
+	}
+	return nil
+}
+
+func (t *ClientTranscript) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "session.(ClientTranscript).decrypt"
+	if err := structwrapping.UnwrapStruct(ctx, cipher, t, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	return nil
+}
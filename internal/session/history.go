@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package session
+
+import (
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+)
+
+// History is the record of a terminated session kept for the user of the
+// session after the session is deleted. It is recorded when the session
+// enters the terminated state.
+type History struct {
+	SessionId         string `gorm:"primary_key"`
+	UserId            string
+	ProjectId         string
+	TargetId          string
+	TerminationReason string `gorm:"default:null"`
+	ConnectionCount   uint32
+	BytesUp           int64
+	BytesDown         int64
+	StartTime         *timestamp.Timestamp
+	EndTime           *timestamp.Timestamp
+	CreateTime        *timestamp.Timestamp `gorm:"default:current_timestamp"`
+
+	Connections []*ConnectionHistory `gorm:"-"`
+}
+
+// TableName returns the table name.
+func (h *History) TableName() string {
+	return "session_history"
+}
+
+// Duration returns the time between the creation and the termination of the
+// session.
+func (h *History) Duration() time.Duration {
+	return duration(h.StartTime, h.EndTime)
+}
+
+// ConnectionHistory is the record of a connection of a session in History.
+type ConnectionHistory struct {
+	ConnectionId  string `gorm:"primary_key"`
+	SessionId     string
+	BytesUp       int64
+	BytesDown     int64
+	ClosedReason  string               `gorm:"default:null"`
+	ConnectedTime *timestamp.Timestamp `gorm:"default:null"`
+	ClosedTime    *timestamp.Timestamp `gorm:"default:null"`
+	CreateTime    *timestamp.Timestamp `gorm:"default:current_timestamp"`
+}
+
+// TableName returns the table name.
+func (c *ConnectionHistory) TableName() string {
+	return "session_connection_history"
+}
+
+// Duration returns the time between the establishment and the closing of the
+// connection. It returns zero if the connection was never established.
+func (c *ConnectionHistory) Duration() time.Duration {
+	return duration(c.ConnectedTime, c.ClosedTime)
+}
+
+func duration(start, end *timestamp.Timestamp) time.Duration {
+	if start.GetTimestamp() == nil || end.GetTimestamp() == nil {
+		return 0
+	}
+	d := end.AsTime().Sub(start.AsTime())
+	if d < 0 {
+		return 0
+	}
+	return d
+}
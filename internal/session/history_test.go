@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package session
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistory_Duration(t *testing.T) {
+	start := time.Date(2023, time.March, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		start *timestamp.Timestamp
+		end   *timestamp.Timestamp
+		want  time.Duration
+	}{
+		{name: "missing-start", end: timestamp.New(start), want: 0},
+		{name: "missing-end", start: timestamp.New(start), want: 0},
+		{name: "end-before-start", start: timestamp.New(start), end: timestamp.New(start.Add(-time.Minute)), want: 0},
+		{name: "valid", start: timestamp.New(start), end: timestamp.New(start.Add(90 * time.Minute)), want: 90 * time.Minute},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &History{StartTime: tt.start, EndTime: tt.end}
+			assert.Equal(t, tt.want, h.Duration())
+			c := &ConnectionHistory{ConnectedTime: tt.start, ClosedTime: tt.end}
+			assert.Equal(t, tt.want, c.Duration())
+		})
+	}
+}
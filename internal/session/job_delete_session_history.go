@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package session
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/scheduler"
+)
+
+type deleteSessionHistoryJob struct {
+	repo *Repository
+
+	// the amount of time the history of a terminated session is kept.
+	retention time.Duration
+
+	// the number of session history records deleted in the most recent run
+	deletedInRun int
+}
+
+func newDeleteSessionHistoryJob(ctx context.Context, repo *Repository, retention time.Duration) (*deleteSessionHistoryJob, error) {
+	const op = "session.newDeleteSessionHistoryJob"
+	switch {
+	case repo == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing repository")
+	case retention <= 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "retention must be greater than zero")
+	}
+
+	return &deleteSessionHistoryJob{
+		repo:      repo,
+		retention: retention,
+	}, nil
+}
+
+// Status reports the job’s current status.
+func (d *deleteSessionHistoryJob) Status() scheduler.JobStatus {
+	return scheduler.JobStatus{
+		Completed: d.deletedInRun,
+		Total:     d.deletedInRun,
+	}
+}
+
+// Run deletes the history of the sessions terminated before the retention
+// period.
+func (d *deleteSessionHistoryJob) Run(ctx context.Context) error {
+	const op = "session.(deleteSessionHistoryJob).Run"
+	d.deletedInRun = 0
+	var err error
+
+	d.deletedInRun, err = d.repo.deleteSessionHistoryBefore(ctx, d.retention)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// NextRunIn returns the duration until the next job run should be scheduled.
+func (d *deleteSessionHistoryJob) NextRunIn(_ context.Context) (time.Duration, error) {
+	return time.Hour, nil
+}
+
+// Name is the unique name of the job.
+func (d *deleteSessionHistoryJob) Name() string {
+	return "delete_session_history"
+}
+
+// Description is the human readable description of the job.
+func (d *deleteSessionHistoryJob) Description() string {
+	return "Delete the history of sessions terminated before the retention period"
+}
@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package session
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+)
+
+// sessionAuthorizationJob periodically re-evaluates the grants of the users of
+// pending and active sessions and cancels the sessions of users who are no
+// longer authorized to connect to the session's target. Sessions of deleted
+// users, accounts and auth tokens are already canceled by the database.
+type sessionAuthorizationJob struct {
+	repo     *Repository
+	iamRepo  *iam.Repository
+	interval time.Duration
+
+	// the amount of time a session's user must be unauthorized before the
+	// session is canceled.
+	grace time.Duration
+
+	// revokedSince holds the time each session's user was first found to be
+	// unauthorized. It is kept in memory, so the grace period starts over if
+	// the job moves to another controller.
+	revokedSince map[string]time.Time
+
+	// the number of sessions checked and canceled in the most recent run
+	checkedInRun  int
+	canceledInRun int
+}
+
+func newSessionAuthorizationJob(ctx context.Context, repo *Repository, iamRepo *iam.Repository, interval, grace time.Duration) (*sessionAuthorizationJob, error) {
+	const op = "session.newSessionAuthorizationJob"
+	switch {
+	case repo == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing repository")
+	case iamRepo == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing iam repository")
+	case interval <= 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "interval must be positive")
+	case grace < 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "grace is negative")
+	}
+
+	return &sessionAuthorizationJob{
+		repo:         repo,
+		iamRepo:      iamRepo,
+		interval:     interval,
+		grace:        grace,
+		revokedSince: make(map[string]time.Time),
+	}, nil
+}
+
+// Status reports the job’s current status.  The status is periodically persisted by
+// the scheduler when a job is running, and will be used to verify a job is making progress.
+func (j *sessionAuthorizationJob) Status() scheduler.JobStatus {
+	return scheduler.JobStatus{
+		Completed: j.checkedInRun,
+		Total:     j.checkedInRun,
+	}
+}
+
+// Run performs the required work depending on the implementation.
+// The context is used to notify the job that it should exit early.
+func (j *sessionAuthorizationJob) Run(ctx context.Context) error {
+	const op = "session.(sessionAuthorizationJob).Run"
+	j.checkedInRun, j.canceledInRun = 0, 0
+
+	authzs, err := j.repo.listLiveSessionAuthorizations(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	now := time.Now()
+	live := make(map[string]struct{}, len(authzs))
+	// The ACL of a user is shared by all of the user's sessions in a run.
+	acls := make(map[string]perms.ACL)
+	for _, a := range authzs {
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		live[a.sessionId] = struct{}{}
+		acl, ok := acls[a.userId+a.accountId]
+		if !ok {
+			acl, err = j.aclForUser(ctx, a.userId, a.accountId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			acls[a.userId+a.accountId] = acl
+		}
+		j.checkedInRun++
+
+		res := perms.Resource{
+			ScopeId: a.projectId,
+			Id:      a.targetId,
+			Type:    resource.Target,
+			Tags:    a.targetTags,
+		}
+		if acl.Allowed(res, action.AuthorizeSession, a.userId).Authorized {
+			delete(j.revokedSince, a.sessionId)
+			continue
+		}
+		since, ok := j.revokedSince[a.sessionId]
+		if !ok {
+			since = now
+			j.revokedSince[a.sessionId] = since
+		}
+		if now.Sub(since) < j.grace {
+			continue
+		}
+		if _, err := j.repo.CancelSession(ctx, a.sessionId, a.version); err != nil {
+			// The session may have been changed since it was listed; it is
+			// checked again in the next run.
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error canceling session of unauthorized user", "session_id", a.sessionId))
+			continue
+		}
+		delete(j.revokedSince, a.sessionId)
+		j.canceledInRun++
+		event.WriteSysEvent(ctx, op, "canceled session of user no longer authorized to connect to target", "session_id", a.sessionId, "user_id", a.userId, "target_id", a.targetId)
+	}
+	for id := range j.revokedSince {
+		if _, ok := live[id]; !ok {
+			delete(j.revokedSince, id)
+		}
+	}
+	return nil
+}
+
+// aclForUser returns the ACL made of the user's current grants.
+func (j *sessionAuthorizationJob) aclForUser(ctx context.Context, userId, accountId string) (perms.ACL, error) {
+	const op = "session.(sessionAuthorizationJob).aclForUser"
+	grantTuples, err := j.iamRepo.GrantsForUser(ctx, userId)
+	if err != nil {
+		return perms.ACL{}, errors.Wrap(ctx, err, op)
+	}
+	parsedGrants := make([]perms.Grant, 0, len(grantTuples))
+	for _, pair := range grantTuples {
+		// As when authorizing requests, validation is skipped so grants in
+		// formats which have since been restricted simply have no effect.
+		parsed, err := perms.Parse(
+			pair.ScopeId,
+			pair.Grant,
+			perms.WithUserId(userId),
+			perms.WithAccountId(accountId),
+			perms.WithSkipFinalValidation(true))
+		if err != nil {
+			return perms.ACL{}, errors.Wrap(ctx, err, op)
+		}
+		parsedGrants = append(parsedGrants, parsed)
+	}
+	return perms.NewACL(parsedGrants...), nil
+}
+
+// NextRunIn returns the duration until the next job run should be scheduled.  This
+// method is invoked after a run has successfully completed and the next run time
+// is being persisted by the scheduler.  If an error is returned, the error will be logged
+// but the duration returned will still be used in scheduling.  If a zero duration is returned
+// the job will be scheduled to run again immediately.
+func (j *sessionAuthorizationJob) NextRunIn(_ context.Context) (time.Duration, error) {
+	return j.interval, nil
+}
+
+// Name is the unique name of the job.
+func (j *sessionAuthorizationJob) Name() string {
+	return "session_authorization"
+}
+
+// Description is the human readable description of the job.
+func (j *sessionAuthorizationJob) Description() string {
+	return "Cancel pending and active sessions whose users are no longer authorized to connect to the session's target"
+}
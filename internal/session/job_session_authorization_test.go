@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionAuthorizationJob(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+
+	t.Run("new", func(t *testing.T) {
+		_, err := newSessionAuthorizationJob(ctx, nil, iamRepo, time.Minute, 0)
+		assert.Error(t, err)
+		_, err = newSessionAuthorizationJob(ctx, repo, nil, time.Minute, 0)
+		assert.Error(t, err)
+		_, err = newSessionAuthorizationJob(ctx, repo, iamRepo, 0, 0)
+		assert.Error(t, err)
+		_, err = newSessionAuthorizationJob(ctx, repo, iamRepo, time.Minute, -time.Second)
+		assert.Error(t, err)
+	})
+
+	t.Run("run", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		composedOf := TestSessionParams(t, conn, wrapper, iamRepo)
+		role := iam.TestRole(t, conn, composedOf.ProjectId)
+		iam.TestRoleGrant(t, conn, role.PublicId, "id=*;type=target;actions=authorize-session")
+		iam.TestUserRole(t, conn, role.PublicId, composedOf.UserId)
+		s := TestSession(t, conn, wrapper, composedOf)
+
+		job, err := newSessionAuthorizationJob(ctx, repo, iamRepo, time.Minute, time.Hour)
+		require.NoError(err)
+
+		state := func() Status {
+			found, _, err := repo.LookupSession(ctx, s.PublicId)
+			require.NoError(err)
+			return found.States[0].Status
+		}
+
+		// the user is authorized
+		require.NoError(job.Run(ctx))
+		assert.Equal(1, job.checkedInRun)
+		assert.Equal(0, job.canceledInRun)
+		assert.Equal(StatusPending, state())
+
+		// the session is not canceled before the grace period has elapsed
+		role, _, _, err = iamRepo.LookupRole(ctx, role.PublicId)
+		require.NoError(err)
+		_, err = iamRepo.DeletePrincipalRoles(ctx, role.PublicId, role.Version, []string{composedOf.UserId})
+		require.NoError(err)
+		require.NoError(job.Run(ctx))
+		assert.Equal(0, job.canceledInRun)
+		assert.Contains(job.revokedSince, s.PublicId)
+		assert.Equal(StatusPending, state())
+
+		job.grace = 0
+		require.NoError(job.Run(ctx))
+		assert.Equal(1, job.canceledInRun)
+		assert.NotContains(job.revokedSince, s.PublicId)
+		assert.Equal(StatusCanceling, state())
+
+		// canceled sessions are no longer checked
+		require.NoError(job.Run(ctx))
+		assert.Equal(0, job.checkedInRun)
+	})
+}
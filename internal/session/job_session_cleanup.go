@@ -16,6 +16,10 @@ import (
 	"github.com/hashicorp/boundary/internal/scheduler"
 )
 
+// defaultSessionCleanupInterval is the default interval between two runs of
+// the session connection cleanup job.
+const defaultSessionCleanupInterval = time.Second
+
 type closeConnectionsForDeadWorkersResult struct {
 	WorkerId                string
 	LastUpdateTime          time.Time
@@ -39,14 +43,28 @@ type sessionConnectionCleanupJob struct {
 	// for the worker.
 	gracePeriod *atomic.Int64
 
+	// The expected interval between two status reports of a worker and the
+	// number of consecutive reports a worker can miss before its
+	// connections are closed. When missedStatusLimit is zero only the grace
+	// period applies.
+	statusInterval    time.Duration
+	missedStatusLimit int
+
+	// The interval between two runs of the job.
+	nextRunIn time.Duration
+
 	// The total number of connections closed in the last run.
 	totalClosed int
 }
 
 // newSessionConnectionCleanupJob instantiates the session cleanup job.
+//
+// Supports the options WithCleanupInterval, WithWorkerStatusInterval and
+// WithMissedStatusLimit.
 func newSessionConnectionCleanupJob(
 	writer db.Writer,
 	gracePeriod *atomic.Int64,
+	opt ...Option,
 ) (*sessionConnectionCleanupJob, error) {
 	const op = "session.newNewSessionConnectionCleanupJob"
 	switch {
@@ -58,9 +76,24 @@ func newSessionConnectionCleanupJob(
 		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "grace period is zero")
 	}
 
+	opts := getOpts(opt...)
+	switch {
+	case opts.withMissedStatusLimit < 0:
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missed status limit is negative")
+	case opts.withMissedStatusLimit > 0 && opts.withWorkerStatusInterval <= 0:
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing worker status interval")
+	}
+	nextRunIn := opts.withCleanupInterval
+	if nextRunIn <= 0 {
+		nextRunIn = defaultSessionCleanupInterval
+	}
+
 	return &sessionConnectionCleanupJob{
-		writer:      writer,
-		gracePeriod: gracePeriod,
+		writer:            writer,
+		gracePeriod:       gracePeriod,
+		statusInterval:    opts.withWorkerStatusInterval,
+		missedStatusLimit: opts.withMissedStatusLimit,
+		nextRunIn:         nextRunIn,
 	}, nil
 }
 
@@ -74,13 +107,13 @@ func (j *sessionConnectionCleanupJob) Description() string {
 
 // NextRunIn returns the next run time after a job is completed.
 //
-// The next run time is defined for sessionConnectionCleanupJob as one second.
-// This is because the job should run continuously to terminate
-// connections as soon as a worker has not reported in for a long
-// enough time. Only one job will ever run at once, so there is no
-// reason why it cannot run again immediately.
+// The next run time is defined for sessionConnectionCleanupJob as one second,
+// unless set with WithCleanupInterval. This is because the job should run
+// continuously to terminate connections as soon as a worker has not reported
+// in for a long enough time. Only one job will ever run at once, so there is
+// no reason why it cannot run again immediately.
 func (j *sessionConnectionCleanupJob) NextRunIn(_ context.Context) (time.Duration, error) {
-	return time.Second, nil
+	return j.nextRunIn, nil
 }
 
 // Status returns the status of the running job.
@@ -96,21 +129,35 @@ func (j *sessionConnectionCleanupJob) Run(ctx context.Context) error {
 	const op = "session.(sessionConnectionCleanupJob).Run"
 	j.totalClosed = 0
 
-	// Run the atomic dead worker cleanup job.
-	gracePeriod := time.Duration(j.gracePeriod.Load())
+	// Run the atomic dead worker cleanup job. A worker which missed the
+	// limit of consecutive status reports is considered dead before the
+	// grace period has passed.
+	gracePeriod, fastPath := j.effectiveGracePeriod()
 	results, err := j.closeConnectionsForDeadWorkers(ctx, gracePeriod)
 	if err != nil {
 		return errors.Wrap(ctx, err, op)
 	}
 
 	for _, result := range results {
-		event.WriteError(ctx, op, stderrors.New("worker has not reported status within acceptable grace period, all connections closed"),
-			event.WithInfo(
-				"public_id", result.WorkerId,
-				"update_time", result.LastUpdateTime,
-				"grace_period_seconds", gracePeriod.Seconds(),
-				"number_connections_closed", result.NumberConnectionsClosed,
-			))
+		switch {
+		case fastPath:
+			event.WriteError(ctx, op, stderrors.New("worker has missed the limit of consecutive status reports, all connections closed"),
+				event.WithInfo(
+					"public_id", result.WorkerId,
+					"update_time", result.LastUpdateTime,
+					"missed_status_limit", j.missedStatusLimit,
+					"status_interval_seconds", j.statusInterval.Seconds(),
+					"number_connections_closed", result.NumberConnectionsClosed,
+				))
+		default:
+			event.WriteError(ctx, op, stderrors.New("worker has not reported status within acceptable grace period, all connections closed"),
+				event.WithInfo(
+					"public_id", result.WorkerId,
+					"update_time", result.LastUpdateTime,
+					"grace_period_seconds", gracePeriod.Seconds(),
+					"number_connections_closed", result.NumberConnectionsClosed,
+				))
+		}
 
 		j.totalClosed += result.NumberConnectionsClosed
 	}
@@ -132,6 +179,21 @@ func (j *sessionConnectionCleanupJob) Run(ctx context.Context) error {
 	return nil
 }
 
+// effectiveGracePeriod returns the amount of time a worker can go without
+// reporting status before its connections are closed. It is the time for the
+// worker to miss the limit of consecutive status reports if that is shorter
+// than the grace period, in which case fastPath is true.
+func (j *sessionConnectionCleanupJob) effectiveGracePeriod() (gracePeriod time.Duration, fastPath bool) {
+	gracePeriod = time.Duration(j.gracePeriod.Load())
+	if j.missedStatusLimit <= 0 {
+		return gracePeriod, false
+	}
+	if missed := time.Duration(j.missedStatusLimit) * j.statusInterval; missed < gracePeriod {
+		return missed, true
+	}
+	return gracePeriod, false
+}
+
 // closeWorkerlessConnections will close all connections which do not have a
 // worker id associated with them.
 func (j *sessionConnectionCleanupJob) closeWorkerlessConnections(ctx context.Context) (int, error) {
@@ -187,6 +187,53 @@ func TestSessionConnectionCleanupJobNewJobErr(t *testing.T) {
 		errors.WithMsg(fmt.Sprintf("grace period is zero")),
 	))
 	require.Nil(job)
+
+	job, err = newSessionConnectionCleanupJob(rw, grace, WithMissedStatusLimit(3))
+	require.Equal(err, errors.E(
+		ctx,
+		errors.WithCode(errors.InvalidParameter),
+		errors.WithOp(op),
+		errors.WithMsg("missing worker status interval"),
+	))
+	require.Nil(job)
+}
+
+func TestSessionConnectionCleanupJobEffectiveGracePeriod(t *testing.T) {
+	t.Parallel()
+	grace := new(atomic.Int64)
+	grace.Store(int64(15 * time.Second))
+
+	cases := []struct {
+		name         string
+		job          *sessionConnectionCleanupJob
+		wantGrace    time.Duration
+		wantFastPath bool
+	}{
+		{
+			name:      "no-limit",
+			job:       &sessionConnectionCleanupJob{gracePeriod: grace},
+			wantGrace: 15 * time.Second,
+		},
+		{
+			name:         "limit-shorter-than-grace",
+			job:          &sessionConnectionCleanupJob{gracePeriod: grace, statusInterval: 2 * time.Second, missedStatusLimit: 3},
+			wantGrace:    6 * time.Second,
+			wantFastPath: true,
+		},
+		{
+			name:      "limit-longer-than-grace",
+			job:       &sessionConnectionCleanupJob{gracePeriod: grace, statusInterval: 2 * time.Second, missedStatusLimit: 10},
+			wantGrace: 15 * time.Second,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			gotGrace, gotFastPath := tt.job.effectiveGracePeriod()
+			assert.Equal(t, tt.wantGrace, gotGrace)
+			assert.Equal(t, tt.wantFastPath, gotFastPath)
+		})
+	}
 }
 
 func TestCloseConnectionsForDeadWorkers(t *testing.T) {
@@ -11,21 +11,30 @@ import (
 
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/scheduler"
 )
 
-const deleteTerminatedThreshold = time.Hour
+const (
+	deleteTerminatedThreshold = time.Hour
+
+	// sessionHistoryRetention is the amount of time the history of a
+	// terminated session is kept for its user.
+	sessionHistoryRetention = 30 * 24 * time.Hour
+)
 
 // RegisterJobs registers session related jobs with the provided scheduler.
-func RegisterJobs(ctx context.Context, scheduler *scheduler.Scheduler, w db.Writer, r db.Reader, k *kms.Kms, gracePeriod *atomic.Int64) error {
+// Supports the options WithAuthorizationCheck, WithAuthorizationGrace,
+// WithCleanupInterval, WithWorkerStatusInterval and WithMissedStatusLimit.
+func RegisterJobs(ctx context.Context, scheduler *scheduler.Scheduler, w db.Writer, r db.Reader, k *kms.Kms, gracePeriod *atomic.Int64, opt ...Option) error {
 	const op = "session.RegisterJobs"
 
 	if gracePeriod == nil {
 		return errors.New(ctx, errors.InvalidParameter, op, "nil grace period")
 	}
 
-	sessionConnectionCleanupJob, err := newSessionConnectionCleanupJob(w, gracePeriod)
+	sessionConnectionCleanupJob, err := newSessionConnectionCleanupJob(w, gracePeriod, opt...)
 	if err != nil {
 		return fmt.Errorf("error creating session cleanup job: %w", err)
 	}
@@ -44,6 +53,28 @@ func RegisterJobs(ctx context.Context, scheduler *scheduler.Scheduler, w db.Writ
 	if err = scheduler.RegisterJob(ctx, deleteTerminatedJob); err != nil {
 		return fmt.Errorf("error registering delete terminated session job: %w", err)
 	}
+	deleteSessionHistoryJob, err := newDeleteSessionHistoryJob(ctx, repo, sessionHistoryRetention)
+	if err != nil {
+		return fmt.Errorf("error creating delete session history job: %w", err)
+	}
+	if err = scheduler.RegisterJob(ctx, deleteSessionHistoryJob); err != nil {
+		return fmt.Errorf("error registering delete session history job: %w", err)
+	}
+
+	opts := getOpts(opt...)
+	if opts.withAuthorizationCheck > 0 {
+		iamRepo, err := iam.NewRepository(r, w, k, opts.withIamOptions...)
+		if err != nil {
+			return fmt.Errorf("error creating iam repository: %w", err)
+		}
+		authorizationJob, err := newSessionAuthorizationJob(ctx, repo, iamRepo, opts.withAuthorizationCheck, opts.withAuthorizationGrace)
+		if err != nil {
+			return fmt.Errorf("error creating session authorization job: %w", err)
+		}
+		if err = scheduler.RegisterJob(ctx, authorizationJob); err != nil {
+			return fmt.Errorf("error registering session authorization job: %w", err)
+		}
+	}
 
 	return nil
 }
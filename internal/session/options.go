@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/perms"
 )
 
@@ -40,6 +41,14 @@ type options struct {
 	withPermissions              *perms.UserPermissions
 	withIgnoreDecryptionFailures bool
 	withRandomReader             io.Reader
+	withBannerAcknowledged       bool
+	withAuthorizationCheck       time.Duration
+	withAuthorizationGrace       time.Duration
+	withCleanupInterval          time.Duration
+	withWorkerStatusInterval     time.Duration
+	withMissedStatusLimit        int
+	withTranscriptTruncated      bool
+	withIamOptions               []iam.Option
 }
 
 func getDefaultOptions() options {
@@ -149,3 +158,72 @@ func WithRandomReader(rand io.Reader) Option {
 		o.withRandomReader = rand
 	}
 }
+
+// WithBannerAcknowledged is used to indicate that the user acknowledged the
+// banner of the session's target when activating the session.
+func WithBannerAcknowledged(acknowledged bool) Option {
+	return func(o *options) {
+		o.withBannerAcknowledged = acknowledged
+	}
+}
+
+// WithAuthorizationCheck is used to register the job which periodically
+// re-evaluates whether the users of pending and active sessions are still
+// authorized to connect to their targets, and sets the interval between its
+// runs. The job is not registered by default.
+func WithAuthorizationCheck(interval time.Duration) Option {
+	return func(o *options) {
+		o.withAuthorizationCheck = interval
+	}
+}
+
+// WithAuthorizationGrace is used to set the amount of time a session's user
+// must no longer be authorized to connect to the session's target before the
+// session is canceled.
+func WithAuthorizationGrace(grace time.Duration) Option {
+	return func(o *options) {
+		o.withAuthorizationGrace = grace
+	}
+}
+
+// WithCleanupInterval is used to set the interval between runs of the job
+// which closes the connections of workers that stopped reporting status.
+func WithCleanupInterval(interval time.Duration) Option {
+	return func(o *options) {
+		o.withCleanupInterval = interval
+	}
+}
+
+// WithWorkerStatusInterval is used to set the expected interval between two
+// status reports of a worker.
+func WithWorkerStatusInterval(interval time.Duration) Option {
+	return func(o *options) {
+		o.withWorkerStatusInterval = interval
+	}
+}
+
+// WithMissedStatusLimit is used to set the number of consecutive status
+// reports a worker can miss before its connections are closed, regardless of
+// the worker status grace period.
+func WithMissedStatusLimit(limit int) Option {
+	return func(o *options) {
+		o.withMissedStatusLimit = limit
+	}
+}
+
+// WithTranscriptTruncated is used to indicate that the client stopped
+// capturing a transcript because it reached the maximum size.
+func WithTranscriptTruncated(truncated bool) Option {
+	return func(o *options) {
+		o.withTranscriptTruncated = truncated
+	}
+}
+
+// WithIamOptions allows passing through options for the IAM repositories
+// created by the session jobs, such as the grants cache and tenant isolation
+// options of the controller.
+func WithIamOptions(with ...iam.Option) Option {
+	return func(o *options) {
+		o.withIamOptions = with
+	}
+}
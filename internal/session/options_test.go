@@ -6,9 +6,11 @@ package session
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -94,4 +96,46 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withRandomReader = reader
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithBannerAcknowledged", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithBannerAcknowledged(true))
+		testOpts := getDefaultOptions()
+		testOpts.withBannerAcknowledged = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithAuthorizationCheck", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithAuthorizationCheck(time.Minute), WithAuthorizationGrace(time.Second))
+		testOpts := getDefaultOptions()
+		testOpts.withAuthorizationCheck = time.Minute
+		testOpts.withAuthorizationGrace = time.Second
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithCleanupInterval", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithCleanupInterval(5 * time.Second))
+		testOpts := getDefaultOptions()
+		testOpts.withCleanupInterval = 5 * time.Second
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithMissedStatusLimit", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithWorkerStatusInterval(time.Second), WithMissedStatusLimit(3))
+		testOpts := getDefaultOptions()
+		testOpts.withWorkerStatusInterval = time.Second
+		testOpts.withMissedStatusLimit = 3
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithTranscriptTruncated", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithTranscriptTruncated(true))
+		testOpts := getDefaultOptions()
+		testOpts.withTranscriptTruncated = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithIamOptions", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithIamOptions(iam.WithTenantIsolation(true)))
+		assert.Len(opts.withIamOptions, 1)
+	})
 }
@@ -412,6 +412,48 @@ and
 and
 	session_state.start_time < wt_sub_seconds_from_now(@threshold_seconds)
 ;
+`
+	deleteSessionHistory = `
+delete from session_history
+where
+	end_time < wt_sub_seconds_from_now(@retention_seconds)
+;
+`
+	liveSessionAuthorizations = `
+select
+	s.public_id,
+	s.version,
+	s.user_id,
+	s.target_id,
+	s.project_id,
+	at.auth_account_id
+from session s
+	join session_state ss
+		on ss.session_id = s.public_id
+	join auth_token at
+		on at.public_id = s.auth_token_id
+where
+	(ss.state = 'pending' or ss.state = 'active')
+	and ss.end_time is null
+	and s.user_id is not null
+	and s.target_id is not null
+	and s.project_id is not null
+;
+`
+	liveSessionTargetTags = `
+select distinct
+	tt.target_id,
+	tt.key,
+	tt.value
+from target_tag tt
+	join session s
+		on s.target_id = tt.target_id
+	join session_state ss
+		on ss.session_id = s.public_id
+where
+	(ss.state = 'pending' or ss.state = 'active')
+	and ss.end_time is null
+;
 `
 	sessionCredentialRewrapQuery = `
 select distinct
@@ -464,3 +506,47 @@ func batchInsertSessionCredentialDynamic(creds []*DynamicCredential) (string, []
 
 	return q, batchInsertArgs, nil
 }
+
+const (
+	sessionTranscriptScopeQuery = `
+select
+	s.project_id,
+	s.user_id,
+	p.parent_id
+from session s
+	inner join iam_scope p
+		on p.public_id = s.project_id
+where s.public_id = ?;
+`
+
+	// unhealthyHostsQuery returns the ids of the hosts whose most recent
+	// connection closed since the health window was closed before it
+	// connected, which means the worker could not reach the host.
+	unhealthyHostsQuery = `
+with
+last_closed_connection (host_id, connection_id) as (
+	select distinct on (shsh.host_id)
+		shsh.host_id,
+		sc.public_id
+	from session_host_set_host shsh
+		join session_connection sc
+			on sc.session_id = shsh.session_id
+		join session_connection_state cs
+			on cs.connection_id = sc.public_id
+			and cs.state = 'closed'
+	where
+		shsh.host_id = any(@host_ids)
+		and cs.start_time > wt_sub_seconds_from_now(@window_seconds)
+	order by shsh.host_id, cs.start_time desc
+)
+select lcc.host_id
+from last_closed_connection lcc
+where not exists (
+	select
+	from session_connection_state cs
+	where
+		cs.connection_id = lcc.connection_id
+		and cs.state = 'connected'
+);
+`
+)
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package session
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+)
+
+// AddClientTranscript encrypts the transcript captured by the client of the
+// session with the database key of the org of the session and stores it. A
+// session can only have one transcript. Supported options are
+// WithTranscriptTruncated.
+func (r *Repository) AddClientTranscript(ctx context.Context, sessionId string, transcript []byte, opt ...Option) (*ClientTranscript, error) {
+	const op = "session.(Repository).AddClientTranscript"
+	if sessionId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	}
+	if len(transcript) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing transcript")
+	}
+	if len(transcript) > MaxClientTranscriptSize {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("transcript exceeds %d bytes", MaxClientTranscriptSize))
+	}
+	opts := getOpts(opt...)
+
+	t := &ClientTranscript{
+		SessionId:  sessionId,
+		Transcript: transcript,
+		Truncated:  opts.withTranscriptTruncated,
+	}
+	var userId sql.NullString
+	rows, err := r.reader.Query(ctx, sessionTranscriptScopeQuery, []any{sessionId})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		if err := rows.Scan(&t.ProjectId, &userId, &t.OrgId); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if t.ProjectId == "" {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("session %s not found", sessionId))
+	}
+	if !userId.Valid {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("session %s has no user", sessionId))
+	}
+	t.UserId = userId.String
+
+	databaseWrapper, err := r.kms.GetWrapper(ctx, t.OrgId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := t.encrypt(ctx, databaseWrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to encrypt transcript"))
+	}
+
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			if err := w.Create(ctx, t); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to add client transcript"))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, errors.New(ctx, errors.NotUnique, op, fmt.Sprintf("session %s already has a transcript", sessionId))
+		}
+		return nil, err
+	}
+	return t, nil
+}
+
+// LookupClientTranscript returns the decrypted transcript captured by the
+// client of the session. It returns nil if the session has no transcript.
+// All options are ignored.
+func (r *Repository) LookupClientTranscript(ctx context.Context, sessionId string, _ ...Option) (*ClientTranscript, error) {
+	const op = "session.(Repository).LookupClientTranscript"
+	if sessionId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	}
+	t := &ClientTranscript{SessionId: sessionId}
+	if err := r.reader.LookupById(ctx, t); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	databaseWrapper, err := r.kms.GetWrapper(ctx, t.OrgId, kms.KeyPurposeDatabase, kms.WithKeyId(t.KeyId))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := t.decrypt(ctx, databaseWrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to decrypt transcript"))
+	}
+	return t, nil
+}
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package session
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_ClientTranscript(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	rw := db.New(conn)
+	kms := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+
+	t.Run("invalid", func(t *testing.T) {
+		s := TestSession(t, conn, wrapper, TestSessionParams(t, conn, wrapper, iamRepo))
+		tests := []struct {
+			name        string
+			sessionId   string
+			transcript  []byte
+			wantErrCode errors.Code
+		}{
+			{name: "missing-session-id", transcript: []byte("out"), wantErrCode: errors.InvalidParameter},
+			{name: "missing-transcript", sessionId: s.PublicId, wantErrCode: errors.InvalidParameter},
+			{name: "too-large", sessionId: s.PublicId, transcript: bytes.Repeat([]byte("a"), MaxClientTranscriptSize+1), wantErrCode: errors.InvalidParameter},
+			{name: "unknown-session", sessionId: "s_1234567890", transcript: []byte("out"), wantErrCode: errors.RecordNotFound},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := repo.AddClientTranscript(ctx, tt.sessionId, tt.transcript)
+				require.Error(t, err)
+				assert.Truef(t, errors.Match(errors.T(tt.wantErrCode), err), "unexpected error %s", err)
+			})
+		}
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		s := TestSession(t, conn, wrapper, TestSessionParams(t, conn, wrapper, iamRepo))
+
+		got, err := repo.LookupClientTranscript(ctx, s.PublicId)
+		require.NoError(err)
+		assert.Nil(got)
+
+		added, err := repo.AddClientTranscript(ctx, s.PublicId, []byte("$ whoami\nroot\n"), WithTranscriptTruncated(true))
+		require.NoError(err)
+		assert.Equal(s.ProjectId, added.ProjectId)
+		assert.Equal(s.UserId, added.UserId)
+		assert.NotEmpty(added.OrgId)
+		assert.NotEmpty(added.KeyId)
+
+		_, err = repo.AddClientTranscript(ctx, s.PublicId, []byte("again"))
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.NotUnique), err))
+
+		// The transcript is kept after the session is deleted
+		_, err = repo.DeleteSession(ctx, s.PublicId)
+		require.NoError(err)
+
+		got, err = repo.LookupClientTranscript(ctx, s.PublicId)
+		require.NoError(err)
+		require.NotNil(got)
+		assert.Equal("$ whoami\nroot\n", string(got.Transcript))
+		assert.True(got.Truncated)
+		assert.Equal(s.ProjectId, got.ProjectId)
+		assert.NotNil(got.CreateTime)
+	})
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package session
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// ListSessionHistory returns the history of the terminated sessions of the
+// user in the projects, with the history of their connections, most recently
+// terminated first. The history is kept after the sessions are deleted.
+// Supports the WithLimit option.
+func (r *Repository) ListSessionHistory(ctx context.Context, userId string, projectIds []string, opt ...Option) ([]*History, error) {
+	const op = "session.(Repository).ListSessionHistory"
+	if userId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing user id")
+	}
+	if len(projectIds) == 0 {
+		return nil, nil
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+
+	var history []*History
+	if err := r.reader.SearchWhere(ctx, &history, "user_id = ? and project_id in (?)", []any{userId, projectIds},
+		db.WithLimit(limit), db.WithOrder("end_time desc")); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(history) == 0 {
+		return nil, nil
+	}
+
+	sessionIds := make([]string, 0, len(history))
+	bySessionId := make(map[string]*History, len(history))
+	for _, h := range history {
+		sessionIds = append(sessionIds, h.SessionId)
+		bySessionId[h.SessionId] = h
+	}
+	var conns []*ConnectionHistory
+	if err := r.reader.SearchWhere(ctx, &conns, "session_id in (?)", []any{sessionIds},
+		db.WithLimit(-1), db.WithOrder("create_time asc")); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	for _, c := range conns {
+		if h, ok := bySessionId[c.SessionId]; ok {
+			h.Connections = append(h.Connections, c)
+		}
+	}
+	return history, nil
+}
+
+// deleteSessionHistoryBefore deletes the history of the sessions which were
+// terminated more than retention ago.
+func (r *Repository) deleteSessionHistoryBefore(ctx context.Context, retention time.Duration) (int, error) {
+	const op = "session.(Repository).deleteSessionHistoryBefore"
+	args := []any{
+		sql.Named("retention_seconds", retention.Seconds()),
+	}
+	c, err := r.writer.Exec(ctx, deleteSessionHistory, args)
+	if err != nil {
+		return 0, errors.Wrap(ctx, err, op, errors.WithMsg("error deleting session history"))
+	}
+	return c, nil
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_ListSessionHistory(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	rw := db.New(conn)
+	kms := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+	connRepo, err := NewConnectionRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := repo.ListSessionHistory(ctx, "", []string{"p_1234567890"})
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		s := TestSession(t, conn, wrapper, TestSessionParams(t, conn, wrapper, iamRepo))
+		c := TestConnection(t, conn, s.PublicId, "127.0.0.1", 22, "127.0.0.1", 2222, "127.0.0.1")
+		_, err := connRepo.closeConnections(ctx, []CloseWith{{
+			ConnectionId: c.PublicId,
+			BytesUp:      10,
+			BytesDown:    20,
+			ClosedReason: ConnectionNetworkError,
+		}})
+		require.NoError(err)
+
+		// The history is recorded when the session is terminated
+		got, err := repo.ListSessionHistory(ctx, s.UserId, []string{s.ProjectId})
+		require.NoError(err)
+		assert.Empty(got)
+
+		_, err = repo.CancelSession(ctx, s.PublicId, s.Version)
+		require.NoError(err)
+		_, err = repo.TerminateCompletedSessions(ctx)
+		require.NoError(err)
+
+		// The history is kept after the session is deleted
+		_, err = repo.DeleteSession(ctx, s.PublicId)
+		require.NoError(err)
+
+		got, err = repo.ListSessionHistory(ctx, s.UserId, []string{s.ProjectId})
+		require.NoError(err)
+		require.Len(got, 1)
+		h := got[0]
+		assert.Equal(s.PublicId, h.SessionId)
+		assert.Equal(s.TargetId, h.TargetId)
+		assert.Equal(uint32(1), h.ConnectionCount)
+		assert.Equal(int64(10), h.BytesUp)
+		assert.Equal(int64(20), h.BytesDown)
+		assert.NotEmpty(h.TerminationReason)
+		require.Len(h.Connections, 1)
+		assert.Equal(c.PublicId, h.Connections[0].ConnectionId)
+		assert.Equal(ConnectionNetworkError.String(), h.Connections[0].ClosedReason)
+		assert.NotNil(h.Connections[0].ClosedTime)
+
+		got, err = repo.ListSessionHistory(ctx, "u_1234567890", []string{s.ProjectId})
+		require.NoError(err)
+		assert.Empty(got)
+		got, err = repo.ListSessionHistory(ctx, s.UserId, []string{"p_1234567890"})
+		require.NoError(err)
+		assert.Empty(got)
+
+		deleted, err := repo.deleteSessionHistoryBefore(ctx, time.Hour)
+		require.NoError(err)
+		assert.Equal(0, deleted)
+		deleted, err = repo.deleteSessionHistoryBefore(ctx, time.Nanosecond)
+		require.NoError(err)
+		assert.Equal(1, deleted)
+	})
+}
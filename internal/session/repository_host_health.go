@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package session
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// HostHealthWindow is how far back the closed connections to a host are
+// considered when checking whether the host is healthy.
+const HostHealthWindow = 10 * time.Minute
+
+// ListUnhealthyHosts returns the ids of the hosts in hostIds which are
+// unhealthy. A host is unhealthy if the most recent of its session
+// connections closed within the HostHealthWindow was never connected, which
+// happens when the worker cannot reach the host. Hosts without recently
+// closed connections are healthy.
+func (r *Repository) ListUnhealthyHosts(ctx context.Context, hostIds []string, _ ...Option) ([]string, error) {
+	const op = "session.(Repository).ListUnhealthyHosts"
+	if len(hostIds) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing host ids")
+	}
+	rows, err := r.reader.Query(ctx, unhealthyHostsQuery, []any{
+		sql.Named("host_ids", "{"+strings.Join(hostIds, ",")+"}"),
+		sql.Named("window_seconds", HostHealthWindow.Seconds()),
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var unhealthy []string
+	for rows.Next() {
+		var hostId string
+		if err := rows.Scan(&hostId); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+		}
+		unhealthy = append(unhealthy, hostId)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return unhealthy, nil
+}
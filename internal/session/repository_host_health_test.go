@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package session
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_ListUnhealthyHosts(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+	connRepo, err := NewConnectionRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+
+	activeSession := func() *Session {
+		s := TestDefaultSession(t, conn, wrapper, iamRepo)
+		s, _, err := repo.ActivateSession(ctx, s.PublicId, s.Version, TestTofu(t))
+		require.NoError(t, err)
+		return s
+	}
+	// connectedConnection closes a connection which was connected to the
+	// host of s.
+	connectedConnection := func(s *Session) {
+		c := TestConnection(t, conn, s.PublicId, "127.0.0.1", 22, "127.0.0.1", 2222, "127.0.0.1")
+		_, err := connRepo.closeConnections(ctx, []CloseWith{{ConnectionId: c.PublicId, ClosedReason: ConnectionClosedByUser}})
+		require.NoError(t, err)
+	}
+	// failedConnection closes a connection which was never connected to the
+	// host of s, as if the worker could not reach it.
+	failedConnection := func(s *Session) {
+		c, err := NewConnection(s.PublicId, "127.0.0.1", 22, "127.0.0.1", 2222, "127.0.0.1")
+		require.NoError(t, err)
+		c.PublicId, err = newConnectionId()
+		require.NoError(t, err)
+		require.NoError(t, rw.Create(ctx, c))
+		_, err = connRepo.closeConnections(ctx, []CloseWith{{ConnectionId: c.PublicId, ClosedReason: UnknownReason}})
+		require.NoError(t, err)
+	}
+
+	t.Run("missing-host-ids", func(t *testing.T) {
+		got, err := repo.ListUnhealthyHosts(ctx, nil)
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %v got err: %v", errors.InvalidParameter, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("no-connections", func(t *testing.T) {
+		s := activeSession()
+		got, err := repo.ListUnhealthyHosts(ctx, []string{s.HostId})
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("connected", func(t *testing.T) {
+		s := activeSession()
+		failedConnection(s)
+		connectedConnection(s)
+		got, err := repo.ListUnhealthyHosts(ctx, []string{s.HostId})
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("never-connected", func(t *testing.T) {
+		healthy, unhealthy := activeSession(), activeSession()
+		connectedConnection(healthy)
+		connectedConnection(unhealthy)
+		failedConnection(unhealthy)
+		got, err := repo.ListUnhealthyHosts(ctx, []string{healthy.HostId, unhealthy.HostId})
+		require.NoError(t, err)
+		assert.Equal(t, []string{unhealthy.HostId}, got)
+	})
+}
@@ -543,7 +543,12 @@ func (r *Repository) getActivatedSession(ctx context.Context, sessionId string,
 // was canceled or terminated.
 // If ActivateSession receives duplicate requests for the same session, it will return the
 // already active session if the tofu token is correct
-func (r *Repository) ActivateSession(ctx context.Context, sessionId string, sessionVersion uint32, tofuToken []byte) (*Session, []*State, error) {
+// If the session has a banner, the user must have acknowledged it, which is
+// indicated with WithBannerAcknowledged, and the time of the acknowledgement is
+// recorded on the session.
+// Supported Options:
+//   - WithBannerAcknowledged
+func (r *Repository) ActivateSession(ctx context.Context, sessionId string, sessionVersion uint32, tofuToken []byte, opt ...Option) (*Session, []*State, error) {
 	const op = "session.(Repository).ActivateSession"
 	if sessionId == "" {
 		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing session id")
@@ -554,6 +559,7 @@ func (r *Repository) ActivateSession(ctx context.Context, sessionId string, sess
 	if len(tofuToken) == 0 {
 		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing tofu token")
 	}
+	opts := getOpts(opt...)
 
 	updatedSession := AllocSession()
 	updatedSession.PublicId = sessionId
@@ -580,6 +586,15 @@ func (r *Repository) ActivateSession(ctx context.Context, sessionId string, sess
 				return errors.Wrap(ctx, err, op)
 			}
 
+			updateMask := []string{"CtTofuToken", "KeyId"}
+			if foundSession.Banner != "" {
+				if !opts.withBannerAcknowledged {
+					return errors.New(ctx, errors.InvalidSessionState, op, "banner of the session's target was not acknowledged")
+				}
+				updatedSession.BannerAcknowledgedTime = timestamp.Now()
+				updateMask = append(updateMask, "BannerAcknowledgedTime")
+			}
+
 			updatedSession.TofuToken = tofuToken
 			sessionWrapper, err := r.kms.GetWrapper(ctx, foundSession.ProjectId, kms.KeyPurposeSessions)
 			if err != nil {
@@ -588,7 +603,7 @@ func (r *Repository) ActivateSession(ctx context.Context, sessionId string, sess
 			if err := updatedSession.encrypt(ctx, sessionWrapper); err != nil {
 				return errors.Wrap(ctx, err, op)
 			}
-			rowsUpdated, err := w.Update(ctx, &updatedSession, []string{"CtTofuToken", "KeyId"}, nil)
+			rowsUpdated, err := w.Update(ctx, &updatedSession, updateMask, nil)
 			if err != nil {
 				return errors.Wrap(ctx, err, op)
 			}
@@ -758,6 +773,70 @@ func (r *Repository) deleteSessionsTerminatedBefore(ctx context.Context, thresho
 	return c, nil
 }
 
+// sessionAuthorization is the information needed to re-evaluate whether the
+// user of a pending or active session is still authorized to connect to its
+// target.
+type sessionAuthorization struct {
+	sessionId string
+	version   uint32
+	userId    string
+	targetId  string
+	projectId string
+	accountId string
+	// targetTags are the tags of the target, to match against the tags of
+	// grants.
+	targetTags map[string][]string
+}
+
+// listLiveSessionAuthorizations returns the authorization information of all
+// pending and active sessions.
+func (r *Repository) listLiveSessionAuthorizations(ctx context.Context) ([]*sessionAuthorization, error) {
+	const op = "session.(Repository).listLiveSessionAuthorizations"
+	rows, err := r.reader.Query(ctx, liveSessionAuthorizations, nil)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to query live sessions"))
+	}
+	defer rows.Close()
+	var authzs []*sessionAuthorization
+	for rows.Next() {
+		var a sessionAuthorization
+		if err := rows.Scan(&a.sessionId, &a.version, &a.userId, &a.targetId, &a.projectId, &a.accountId); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to scan row"))
+		}
+		authzs = append(authzs, &a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get next live session"))
+	}
+	if len(authzs) == 0 {
+		return authzs, nil
+	}
+
+	tagRows, err := r.reader.Query(ctx, liveSessionTargetTags, nil)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to query target tags of live sessions"))
+	}
+	defer tagRows.Close()
+	targetTags := make(map[string]map[string][]string)
+	for tagRows.Next() {
+		var targetId, key, value string
+		if err := tagRows.Scan(&targetId, &key, &value); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to scan target tag row"))
+		}
+		if targetTags[targetId] == nil {
+			targetTags[targetId] = make(map[string][]string)
+		}
+		targetTags[targetId][key] = append(targetTags[targetId][key], value)
+	}
+	if err := tagRows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get next target tag"))
+	}
+	for _, a := range authzs {
+		a.targetTags = targetTags[a.targetId]
+	}
+	return authzs, nil
+}
+
 func fetchStates(ctx context.Context, r db.Reader, sessionId string, opt ...db.Option) ([]*State, error) {
 	const op = "session.fetchStates"
 	var states []*State
@@ -1433,6 +1433,47 @@ func TestRepository_ActivateSession(t *testing.T) {
 	}
 }
 
+func TestRepository_ActivateSession_Banner(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kms := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+
+	newSession := func(t *testing.T) *Session {
+		composedOf := TestSessionParams(t, conn, wrapper, iamRepo)
+		composedOf.Banner = "Authorized use only."
+		return TestSession(t, conn, wrapper, composedOf)
+	}
+
+	t.Run("not-acknowledged", func(t *testing.T) {
+		s := newSession(t)
+		_, _, err := repo.ActivateSession(ctx, s.PublicId, s.Version, TestTofu(t))
+		require.Error(t, err)
+		assert.True(t, errors.Match(errors.T(errors.InvalidSessionState), err))
+
+		found, _, err := repo.LookupSession(ctx, s.PublicId)
+		require.NoError(t, err)
+		assert.Nil(t, found.BannerAcknowledgedTime)
+		assert.Equal(t, StatusPending, found.States[0].Status)
+	})
+	t.Run("acknowledged", func(t *testing.T) {
+		s := newSession(t)
+		_, _, err := repo.ActivateSession(ctx, s.PublicId, s.Version, TestTofu(t), WithBannerAcknowledged(true))
+		require.NoError(t, err)
+
+		found, _, err := repo.LookupSession(ctx, s.PublicId)
+		require.NoError(t, err)
+		assert.Equal(t, "Authorized use only.", found.Banner)
+		require.NotNil(t, found.BannerAcknowledgedTime)
+		assert.Equal(t, StatusActive, found.States[0].Status)
+	})
+}
+
 func TestRepository_DeleteSession(t *testing.T) {
 	t.Parallel()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -15,6 +15,7 @@ import (
 func init() {
 	kms.RegisterTableRewrapFn(defaultSessionTableName, sessionRewrapFn)
 	kms.RegisterTableRewrapFn("session_credential", sessionCredentialRewrapFn)
+	kms.RegisterTableRewrapFn("session_client_transcript", sessionClientTranscriptRewrapFn)
 }
 
 func rewrapParameterChecks(ctx context.Context, dataKeyVersionId string, scopeId string, reader db.Reader, writer db.Writer, kmsRepo kms.GetWrapperer) string {
@@ -125,3 +126,31 @@ func sessionRewrapFn(ctx context.Context, dataKeyVersionId string, scopeId strin
 	}
 	return nil
 }
+
+func sessionClientTranscriptRewrapFn(ctx context.Context, dataKeyVersionId, scopeId string, reader db.Reader, writer db.Writer, kmsRepo kms.GetWrapperer) error {
+	const op = "session.sessionClientTranscriptRewrapFn"
+	if errStr := rewrapParameterChecks(ctx, dataKeyVersionId, scopeId, reader, writer, kmsRepo); errStr != "" {
+		return errors.New(ctx, errors.InvalidParameter, op, errStr)
+	}
+	var transcripts []*ClientTranscript
+	// Transcripts are encrypted with the key of their org, and an index exists on (org_id, key_id).
+	if err := reader.SearchWhere(ctx, &transcripts, "org_id=? and key_id=?", []any{scopeId, dataKeyVersionId}, db.WithLimit(-1)); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to query sql for rows that need rewrapping"))
+	}
+	wrapper, err := kmsRepo.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to fetch kms wrapper for rewrapping"))
+	}
+	for _, t := range transcripts {
+		if err := t.decrypt(ctx, wrapper); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to decrypt session client transcript"))
+		}
+		if err := t.encrypt(ctx, wrapper); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to re-encrypt session client transcript"))
+		}
+		if _, err := writer.Update(ctx, t, []string{"CtTranscript", "KeyId"}, nil); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to update session client transcript row with rewrapped fields"))
+		}
+	}
+	return nil
+}
@@ -57,6 +57,9 @@ type ComposedOf struct {
 	WorkerFilter        string
 	EgressWorkerFilter  string
 	IngressWorkerFilter string
+	// Banner is the target's banner, which the user must acknowledge before
+	// the session is activated. Banner is optional.
+	Banner string
 	// DynamicCredentials are dynamic credentials that will be retrieved
 	// for the session. DynamicCredentials optional.
 	DynamicCredentials []*DynamicCredential
@@ -110,6 +113,11 @@ type Session struct {
 	EgressWorkerFilter  string `json:"-" gorm:"default:null"`
 	IngressWorkerFilter string `json:"-" gorm:"default:null"`
 
+	// Banner of the target when the session was created
+	Banner string `json:"banner,omitempty" gorm:"default:null"`
+	// BannerAcknowledgedTime is when the user acknowledged the banner
+	BannerAcknowledgedTime *timestamp.Timestamp `json:"banner_acknowledged_time,omitempty" gorm:"default:null"`
+
 	// key_id is the ID of the key version used to encrypt any fields in this struct
 	KeyId string `json:"key_id,omitempty" gorm:"default:null"`
 
@@ -169,6 +177,7 @@ func New(c ComposedOf, _ ...Option) (*Session, error) {
 		WorkerFilter:        c.WorkerFilter,
 		EgressWorkerFilter:  c.EgressWorkerFilter,
 		IngressWorkerFilter: c.IngressWorkerFilter,
+		Banner:              c.Banner,
 		DynamicCredentials:  c.DynamicCredentials,
 		StaticCredentials:   c.StaticCredentials,
 	}
@@ -200,6 +209,7 @@ func (s *Session) Clone() any {
 		WorkerFilter:        s.WorkerFilter,
 		EgressWorkerFilter:  s.EgressWorkerFilter,
 		IngressWorkerFilter: s.IngressWorkerFilter,
+		Banner:              s.Banner,
 		KeyId:               s.KeyId,
 	}
 	if len(s.States) > 0 {
@@ -251,6 +261,14 @@ func (s *Session) Clone() any {
 			},
 		}
 	}
+	if s.BannerAcknowledgedTime != nil {
+		clone.BannerAcknowledgedTime = &timestamp.Timestamp{
+			Timestamp: &timestamppb.Timestamp{
+				Seconds: s.BannerAcknowledgedTime.Timestamp.Seconds,
+				Nanos:   s.BannerAcknowledgedTime.Timestamp.Nanos,
+			},
+		}
+	}
 	if s.CreateTime != nil {
 		clone.CreateTime = &timestamp.Timestamp{
 			Timestamp: &timestamppb.Timestamp{
@@ -318,6 +336,8 @@ func (s *Session) VetForWrite(ctx context.Context, _ db.Reader, opType db.OpType
 			return errors.New(ctx, errors.InvalidParameter, op, "egress worker filter is immutable")
 		case contains(opts.WithFieldMaskPaths, "IngressWorkerFilter"):
 			return errors.New(ctx, errors.InvalidParameter, op, "ingress worker filter is immutable")
+		case contains(opts.WithFieldMaskPaths, "Banner"):
+			return errors.New(ctx, errors.InvalidParameter, op, "banner is immutable")
 		case contains(opts.WithFieldMaskPaths, "DynamicCredentials"):
 			return errors.New(ctx, errors.InvalidParameter, op, "dynamic credentials are immutable")
 		case contains(opts.WithFieldMaskPaths, "StaticCredentials"):
@@ -0,0 +1,16 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package subscription implements subscriptions of users to changes of
+// resources, so that tooling can watch resources without polling lists.
+//
+// A subscription belongs to a user and a scope, and matches either a single
+// resource or all the resources of a type in the scope. When a resource is
+// changed through the API, the Notifier delivers a notification for each
+// matching subscription whose user is allowed to read the resource. Each
+// notification is written to the event stream as an observation, and posted
+// to the webhook of the subscription if it has one and webhooks are enabled.
+//
+// Notifications are delivered in the background on a best effort basis: they
+// are dropped when the controller cannot keep up, and are not retried.
+package subscription
@@ -0,0 +1,281 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package subscription
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+)
+
+const (
+	// DefaultQueueSize is the number of changes which can be waiting to be
+	// delivered before new changes are dropped.
+	DefaultQueueSize = 1000
+
+	// DefaultWebhookTimeout is how long posting a notification to a webhook
+	// can take.
+	DefaultWebhookTimeout = 5 * time.Second
+
+	// NotificationEventHeader is the header of the observation events
+	// carrying notifications.
+	NotificationEventHeader = "event_subscription_notification"
+)
+
+// A Change is a successful change of a resource made through the API.
+type Change struct {
+	ScopeId      string
+	ResourceId   string
+	ResourceType resource.Type
+	// Pin is the id of the parent of the resource, for resources within a
+	// collection such as hosts and credentials.
+	Pin    string
+	Action action.Type
+	// UserId is the id of the user who made the change.
+	UserId string
+	Time   time.Time
+}
+
+// IsChange reports whether a successful request for the action a changes a
+// resource.
+func IsChange(a action.Type) bool {
+	switch a {
+	case action.Create, action.Update, action.Delete, action.Cancel:
+		return true
+	}
+	s := a.String()
+	for _, p := range []string{"add-", "set-", "remove-", "change-", "rotate-"} {
+		if strings.HasPrefix(s, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// A Notification is delivered to the user of a subscription when a matching
+// change is made.
+type Notification struct {
+	SubscriptionId string    `json:"subscription_id"`
+	UserId         string    `json:"user_id"`
+	ScopeId        string    `json:"scope_id"`
+	ResourceId     string    `json:"resource_id"`
+	ResourceType   string    `json:"resource_type"`
+	Action         string    `json:"action"`
+	ChangedBy      string    `json:"changed_by,omitempty"`
+	Time           time.Time `json:"time"`
+}
+
+// A Notifier delivers notifications of changes to the users subscribed to
+// them. Changes are queued by Notify and delivered by Run.
+type Notifier struct {
+	repoFn         RepoFactory
+	iamRepoFn      iam.IamRepoFactory
+	webhooks       bool
+	webhookTimeout time.Duration
+	client         *http.Client
+	changes        chan *Change
+}
+
+// NewNotifier creates a new Notifier. Supported options are WithWebhooks,
+// WithWebhookTimeout and WithQueueSize.
+func NewNotifier(ctx context.Context, repoFn RepoFactory, iamRepoFn iam.IamRepoFactory, opt ...Option) (*Notifier, error) {
+	const op = "subscription.NewNotifier"
+	switch {
+	case repoFn == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing repo factory")
+	case iamRepoFn == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing iam repo factory")
+	}
+	opts := getOpts(opt...)
+	if opts.withQueueSize <= 0 {
+		opts.withQueueSize = DefaultQueueSize
+	}
+	if opts.withWebhookTimeout <= 0 {
+		opts.withWebhookTimeout = DefaultWebhookTimeout
+	}
+	client := opts.withHttpClient
+	if client == nil {
+		client = &http.Client{
+			// Redirects are not followed so a webhook cannot send
+			// notifications to another address.
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+	}
+	return &Notifier{
+		repoFn:         repoFn,
+		iamRepoFn:      iamRepoFn,
+		webhooks:       opts.withWebhooks,
+		webhookTimeout: opts.withWebhookTimeout,
+		client:         client,
+		changes:        make(chan *Change, opts.withQueueSize),
+	}, nil
+}
+
+// WebhooksEnabled reports whether notifications are posted to the webhooks
+// of subscriptions. It is safe to call on a nil Notifier.
+func (n *Notifier) WebhooksEnabled() bool {
+	return n != nil && n.webhooks
+}
+
+// Notify queues the change c for delivery. It does not block: if the queue is
+// full the change is dropped and an error event is written.
+func (n *Notifier) Notify(ctx context.Context, c *Change) {
+	const op = "subscription.(Notifier).Notify"
+	if c == nil {
+		return
+	}
+	if c.Time.IsZero() {
+		c.Time = time.Now()
+	}
+	select {
+	case n.changes <- c:
+	default:
+		event.WriteError(ctx, op, fmt.Errorf("notification queue is full"), event.WithInfoMsg("dropped change", "resource_id", c.ResourceId, "action", c.Action.String()))
+	}
+}
+
+// Run delivers the queued changes until ctx is done.
+func (n *Notifier) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case c := <-n.changes:
+			n.deliver(ctx, c)
+		}
+	}
+}
+
+// deliver delivers the notifications for c to the users of the matching
+// subscriptions who are allowed to read the changed resource.
+func (n *Notifier) deliver(ctx context.Context, c *Change) {
+	const op = "subscription.(Notifier).deliver"
+	repo, err := n.repoFn()
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("unable to get subscription repository"))
+		return
+	}
+	subs, err := repo.ListMatchingSubscriptions(ctx, c)
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("unable to list matching subscriptions", "resource_id", c.ResourceId))
+		return
+	}
+	if len(subs) == 0 {
+		return
+	}
+
+	res := perms.Resource{
+		ScopeId: c.ScopeId,
+		Id:      c.ResourceId,
+		Type:    c.ResourceType,
+		Pin:     c.Pin,
+	}
+	acls := make(map[string]bool)
+	for _, s := range subs {
+		if !s.matches(c) {
+			continue
+		}
+		allowed, ok := acls[s.UserId]
+		if !ok {
+			acl, err := n.aclForUser(ctx, s.UserId)
+			if err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("unable to compute acl of subscriber", "user_id", s.UserId))
+				continue
+			}
+			allowed = acl.Allowed(res, action.Read, s.UserId).Authorized
+			acls[s.UserId] = allowed
+		}
+		if !allowed {
+			continue
+		}
+		n.send(ctx, s, &Notification{
+			SubscriptionId: s.PublicId,
+			UserId:         s.UserId,
+			ScopeId:        c.ScopeId,
+			ResourceId:     c.ResourceId,
+			ResourceType:   c.ResourceType.String(),
+			Action:         c.Action.String(),
+			ChangedBy:      c.UserId,
+			Time:           c.Time,
+		})
+	}
+}
+
+// send writes the notification to the event stream and posts it to the
+// webhook of s if webhooks are enabled.
+func (n *Notifier) send(ctx context.Context, s *Subscription, no *Notification) {
+	const op = "subscription.(Notifier).send"
+	if err := event.WriteObservation(ctx, op, event.WithHeader(NotificationEventHeader, no)); err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("unable to write notification event", "subscription_id", s.PublicId))
+	}
+	if !n.webhooks || s.WebhookUrl == "" {
+		return
+	}
+	if err := n.post(ctx, s.WebhookUrl, no); err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("unable to post notification to webhook", "subscription_id", s.PublicId))
+	}
+}
+
+func (n *Notifier) post(ctx context.Context, u string, no *Notification) error {
+	const op = "subscription.(Notifier).post"
+	body, err := json.Marshal(no)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	ctx, cancel := context.WithTimeout(ctx, n.webhookTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errors.New(ctx, errors.Unknown, op, fmt.Sprintf("webhook returned status %d", resp.StatusCode))
+	}
+	return nil
+}
+
+// aclForUser returns the ACL made of the user's current grants.
+func (n *Notifier) aclForUser(ctx context.Context, userId string) (perms.ACL, error) {
+	const op = "subscription.(Notifier).aclForUser"
+	iamRepo, err := n.iamRepoFn()
+	if err != nil {
+		return perms.ACL{}, errors.Wrap(ctx, err, op)
+	}
+	grantTuples, err := iamRepo.GrantsForUser(ctx, userId)
+	if err != nil {
+		return perms.ACL{}, errors.Wrap(ctx, err, op)
+	}
+	parsedGrants := make([]perms.Grant, 0, len(grantTuples))
+	for _, pair := range grantTuples {
+		parsed, err := perms.Parse(
+			pair.ScopeId,
+			pair.Grant,
+			perms.WithUserId(userId),
+			perms.WithSkipFinalValidation(true))
+		if err != nil {
+			return perms.ACL{}, errors.Wrap(ctx, err, op)
+		}
+		parsedGrants = append(parsedGrants, parsed)
+	}
+	return perms.NewACL(parsedGrants...), nil
+}
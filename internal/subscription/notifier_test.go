@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package subscription
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsChange(t *testing.T) {
+	t.Parallel()
+	for _, a := range []action.Type{
+		action.Create, action.Update, action.Delete, action.Cancel,
+		action.AddGrants, action.SetPrincipals, action.RemoveHostSources,
+		action.ChangePassword, action.RotateScopeKeys,
+	} {
+		assert.True(t, IsChange(a), a.String())
+	}
+	for _, a := range []action.Type{
+		action.Read, action.List, action.NoOp, action.AuthorizeSession, action.Authenticate,
+	} {
+		assert.False(t, IsChange(a), a.String())
+	}
+}
+
+func testNotifier(t *testing.T, opt ...Option) *Notifier {
+	t.Helper()
+	repoFn := func() (*Repository, error) { return nil, nil }
+	iamRepoFn := func() (*iam.Repository, error) { return nil, nil }
+	n, err := NewNotifier(context.Background(), repoFn, iamRepoFn, opt...)
+	require.NoError(t, err)
+	return n
+}
+
+func TestNewNotifier(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	repoFn := func() (*Repository, error) { return nil, nil }
+	iamRepoFn := func() (*iam.Repository, error) { return nil, nil }
+
+	_, err := NewNotifier(ctx, nil, iamRepoFn)
+	assert.ErrorContains(t, err, "missing repo factory")
+	_, err = NewNotifier(ctx, repoFn, nil)
+	assert.ErrorContains(t, err, "missing iam repo factory")
+
+	n := testNotifier(t)
+	assert.False(t, n.WebhooksEnabled())
+	assert.Equal(t, DefaultWebhookTimeout, n.webhookTimeout)
+	assert.Equal(t, DefaultQueueSize, cap(n.changes))
+
+	n = testNotifier(t, WithWebhooks(true), WithWebhookTimeout(time.Second), WithQueueSize(3))
+	assert.True(t, n.WebhooksEnabled())
+	assert.Equal(t, time.Second, n.webhookTimeout)
+	assert.Equal(t, 3, cap(n.changes))
+}
+
+func TestNotifier_Notify(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	n := testNotifier(t, WithQueueSize(1))
+
+	n.Notify(ctx, &Change{ResourceId: "ttcp_1"})
+	// The queue is full so the second change is dropped without blocking.
+	n.Notify(ctx, &Change{ResourceId: "ttcp_2"})
+	n.Notify(ctx, nil)
+
+	require.Len(t, n.changes, 1)
+	c := <-n.changes
+	assert.Equal(t, "ttcp_1", c.ResourceId)
+	assert.False(t, c.Time.IsZero())
+}
+
+func TestNotifier_post(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	no := &Notification{
+		SubscriptionId: "evsub_1234567890",
+		UserId:         "u_1234567890",
+		ScopeId:        "p_1234567890",
+		ResourceId:     "ttcp_1234567890",
+		ResourceType:   "target",
+		Action:         "update",
+		ChangedBy:      "u_0987654321",
+		Time:           time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	var got Notification
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if r.URL.Path == "/redirect" {
+			http.Redirect(w, r, "/ok", http.StatusTemporaryRedirect)
+			return
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+	}))
+	defer srv.Close()
+
+	n := testNotifier(t, WithWebhooks(true))
+	require.NoError(t, n.post(ctx, srv.URL+"/ok", no))
+	assert.Equal(t, *no, got)
+
+	assert.ErrorContains(t, n.post(ctx, srv.URL+"/fail", no), "webhook returned status 500")
+	assert.ErrorContains(t, n.post(ctx, srv.URL+"/redirect", no), "webhook returned status 307")
+}
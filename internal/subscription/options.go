@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package subscription
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/types/resource"
+)
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withResourceId     string
+	withResourceType   resource.Type
+	withWebhookUrl     string
+	withLimit          int
+	withWebhooks       bool
+	withWebhookTimeout time.Duration
+	withQueueSize      int
+	withHttpClient     *http.Client
+}
+
+func getDefaultOptions() options {
+	return options{}
+}
+
+// WithResourceId provides an option to subscribe to the changes of the
+// resource with the given id.
+func WithResourceId(id string) Option {
+	return func(o *options) {
+		o.withResourceId = id
+	}
+}
+
+// WithResourceType provides an option to subscribe to the changes of all the
+// resources of the given type in the scope of the subscription.
+func WithResourceType(t resource.Type) Option {
+	return func(o *options) {
+		o.withResourceType = t
+	}
+}
+
+// WithWebhookUrl provides an option to post the notifications of a
+// subscription to the given url.
+func WithWebhookUrl(u string) Option {
+	return func(o *options) {
+		o.withWebhookUrl = u
+	}
+}
+
+// WithLimit provides an option to provide a limit. Intentionally allowing
+// negative integers. If WithLimit < 0, then unlimited results are returned.
+// If WithLimit == 0, then default limits are used for results.
+func WithLimit(limit int) Option {
+	return func(o *options) {
+		o.withLimit = limit
+	}
+}
+
+// WithWebhooks provides an option to enable posting notifications to the
+// webhooks of subscriptions. Webhooks are disabled by default.
+func WithWebhooks(enabled bool) Option {
+	return func(o *options) {
+		o.withWebhooks = enabled
+	}
+}
+
+// WithWebhookTimeout provides an option to specify how long posting a
+// notification to a webhook can take. Defaults to DefaultWebhookTimeout.
+func WithWebhookTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.withWebhookTimeout = timeout
+	}
+}
+
+// WithQueueSize provides an option to specify how many changes can be waiting
+// to be delivered before new changes are dropped. Defaults to
+// DefaultQueueSize.
+func WithQueueSize(size int) Option {
+	return func(o *options) {
+		o.withQueueSize = size
+	}
+}
+
+// withHttpClient provides an option to specify the client posting to
+// webhooks, for tests.
+func withHttpClient(c *http.Client) Option {
+	return func(o *options) {
+		o.withHttpClient = c
+	}
+}
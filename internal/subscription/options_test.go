@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package subscription
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetOpts(t *testing.T) {
+	t.Parallel()
+	t.Run("WithResourceId", func(t *testing.T) {
+		opts := getOpts(WithResourceId("ttcp_1234567890"))
+		testOpts := getDefaultOptions()
+		testOpts.withResourceId = "ttcp_1234567890"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithResourceType", func(t *testing.T) {
+		opts := getOpts(WithResourceType(resource.Target))
+		testOpts := getDefaultOptions()
+		testOpts.withResourceType = resource.Target
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithWebhookUrl", func(t *testing.T) {
+		opts := getOpts(WithWebhookUrl("https://example.com/hook"))
+		testOpts := getDefaultOptions()
+		testOpts.withWebhookUrl = "https://example.com/hook"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithLimit", func(t *testing.T) {
+		opts := getOpts(WithLimit(5))
+		testOpts := getDefaultOptions()
+		testOpts.withLimit = 5
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithWebhooks", func(t *testing.T) {
+		opts := getOpts(WithWebhooks(true))
+		testOpts := getDefaultOptions()
+		testOpts.withWebhooks = true
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithWebhookTimeout", func(t *testing.T) {
+		opts := getOpts(WithWebhookTimeout(time.Second))
+		testOpts := getDefaultOptions()
+		testOpts.withWebhookTimeout = time.Second
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithQueueSize", func(t *testing.T) {
+		opts := getOpts(WithQueueSize(10))
+		testOpts := getDefaultOptions()
+		testOpts.withQueueSize = 10
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("withHttpClient", func(t *testing.T) {
+		c := &http.Client{}
+		opts := getOpts(withHttpClient(c))
+		testOpts := getDefaultOptions()
+		testOpts.withHttpClient = c
+		assert.Equal(t, opts, testOpts)
+	})
+}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package subscription
+
+const (
+	insertSubscriptionQuery = `
+insert into event_subscription
+  (public_id, user_id, scope_id, resource_id, resource_type, webhook_url)
+values
+  (@public_id, @user_id, @scope_id, @resource_id, @resource_type, @webhook_url)
+returning create_time;
+`
+
+	countUserSubscriptionsQuery = `
+select count(*)
+  from event_subscription
+ where user_id = @user_id;
+`
+
+	subscriptionColumns = `
+public_id, user_id, scope_id, resource_id, resource_type, webhook_url, create_time
+`
+
+	lookupSubscriptionQuery = `
+select ` + subscriptionColumns + `
+  from event_subscription
+ where public_id = @public_id;
+`
+
+	listUserSubscriptionsQuery = `
+select ` + subscriptionColumns + `
+  from event_subscription
+ where user_id = @user_id
+   and scope_id = @scope_id
+ order by create_time, public_id
+ limit @limit;
+`
+
+	listMatchingSubscriptionsQuery = `
+select ` + subscriptionColumns + `
+  from event_subscription
+ where resource_id = @resource_id
+    or (scope_id = @scope_id and resource_type = @resource_type);
+`
+
+	deleteSubscriptionQuery = `
+delete from event_subscription
+ where public_id = @public_id;
+`
+)
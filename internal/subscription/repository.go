@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package subscription
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/types/resource"
+)
+
+// MaxSubscriptionsPerUser is the number of subscriptions a user can have.
+const MaxSubscriptionsPerUser = 100
+
+// RepoFactory returns a Repository.
+type RepoFactory func() (*Repository, error)
+
+// A Repository stores and retrieves subscriptions. It is not safe to use a
+// repository concurrently.
+type Repository struct {
+	reader db.Reader
+	writer db.Writer
+	// defaultLimit provides a default for limiting the number of results
+	// returned from the repo
+	defaultLimit int
+}
+
+// NewRepository creates a new Repository. The returned repository should
+// only be used for one transaction and it is not safe for concurrent go
+// routines to access it. WithLimit option is used as a repo wide default
+// limit applied to all ListX methods.
+func NewRepository(ctx context.Context, r db.Reader, w db.Writer, opt ...Option) (*Repository, error) {
+	const op = "subscription.NewRepository"
+	switch {
+	case r == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "db.Reader")
+	case w == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "db.Writer")
+	}
+
+	opts := getOpts(opt...)
+	if opts.withLimit == 0 {
+		// zero signals the boundary defaults should be used.
+		opts.withLimit = db.DefaultLimit
+	}
+	return &Repository{
+		reader:       r,
+		writer:       w,
+		defaultLimit: opts.withLimit,
+	}, nil
+}
+
+// CreateSubscription inserts s into the repository and returns a new
+// Subscription containing its PublicId and CreateTime. s is not changed. s
+// must not contain a PublicId. An error is returned if the user of s already
+// has MaxSubscriptionsPerUser subscriptions.
+func (r *Repository) CreateSubscription(ctx context.Context, s *Subscription) (*Subscription, error) {
+	const op = "subscription.(Repository).CreateSubscription"
+	if s == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing subscription")
+	}
+	if s.PublicId != "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id not empty")
+	}
+	if err := s.validate(ctx, op); err != nil {
+		return nil, err
+	}
+
+	id, err := db.NewPublicId(Prefix)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	ret := *s
+	ret.PublicId = id
+
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			rows, err := reader.Query(ctx, countUserSubscriptionsQuery, []any{sql.Named("user_id", s.UserId)})
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to count subscriptions"))
+			}
+			var count int
+			for rows.Next() {
+				if err := rows.Scan(&count); err != nil {
+					rows.Close()
+					return errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+				}
+			}
+			rows.Close()
+			if count >= MaxSubscriptionsPerUser {
+				return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("user already has %d subscriptions", MaxSubscriptionsPerUser))
+			}
+
+			rows, err = w.Query(ctx, insertSubscriptionQuery, []any{
+				sql.Named("public_id", ret.PublicId),
+				sql.Named("user_id", ret.UserId),
+				sql.Named("scope_id", ret.ScopeId),
+				sql.Named("resource_id", nullString(ret.ResourceId)),
+				sql.Named("resource_type", nullResourceType(ret.ResourceType)),
+				sql.Named("webhook_url", nullString(ret.WebhookUrl)),
+			})
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to insert subscription"))
+			}
+			defer rows.Close()
+			for rows.Next() {
+				if err := rows.Scan(&ret.CreateTime); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+				}
+			}
+			return rows.Err()
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &ret, nil
+}
+
+// LookupSubscription returns the Subscription for publicId. Returns nil, nil
+// if no Subscription is found for publicId.
+func (r *Repository) LookupSubscription(ctx context.Context, publicId string) (*Subscription, error) {
+	const op = "subscription.(Repository).LookupSubscription"
+	if publicId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	subs, err := r.query(ctx, lookupSubscriptionQuery, []any{sql.Named("public_id", publicId)})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(subs) == 0 {
+		return nil, nil
+	}
+	return subs[0], nil
+}
+
+// ListSubscriptions returns the subscriptions of the user in the scope,
+// oldest first. Supports the WithLimit option.
+func (r *Repository) ListSubscriptions(ctx context.Context, userId, scopeId string, opt ...Option) ([]*Subscription, error) {
+	const op = "subscription.(Repository).ListSubscriptions"
+	switch {
+	case userId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing user id")
+	case scopeId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	var limitArg any = limit
+	if limit < 0 {
+		limitArg = nil
+	}
+	subs, err := r.query(ctx, listUserSubscriptionsQuery, []any{
+		sql.Named("user_id", userId),
+		sql.Named("scope_id", scopeId),
+		sql.Named("limit", limitArg),
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return subs, nil
+}
+
+// ListMatchingSubscriptions returns the subscriptions matching the change c:
+// the subscriptions to the changed resource, and the subscriptions to the
+// resources of its type in its scope.
+func (r *Repository) ListMatchingSubscriptions(ctx context.Context, c *Change) ([]*Subscription, error) {
+	const op = "subscription.(Repository).ListMatchingSubscriptions"
+	if c == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing change")
+	}
+	subs, err := r.query(ctx, listMatchingSubscriptionsQuery, []any{
+		sql.Named("resource_id", c.ResourceId),
+		sql.Named("scope_id", c.ScopeId),
+		sql.Named("resource_type", c.ResourceType.String()),
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return subs, nil
+}
+
+// DeleteSubscription deletes the subscription for publicId from the
+// repository and returns the number of records deleted.
+func (r *Repository) DeleteSubscription(ctx context.Context, publicId string) (int, error) {
+	const op = "subscription.(Repository).DeleteSubscription"
+	if publicId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	n, err := r.writer.Exec(ctx, deleteSubscriptionQuery, []any{sql.Named("public_id", publicId)})
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	return n, nil
+}
+
+func (r *Repository) query(ctx context.Context, query string, args []any) ([]*Subscription, error) {
+	const op = "subscription.(Repository).query"
+	rows, err := r.reader.Query(ctx, query, args)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var subs []*Subscription
+	for rows.Next() {
+		var s Subscription
+		var resourceId, resourceType, webhookUrl sql.NullString
+		if err := rows.Scan(&s.PublicId, &s.UserId, &s.ScopeId, &resourceId, &resourceType, &webhookUrl, &s.CreateTime); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+		}
+		s.ResourceId = resourceId.String
+		if resourceType.Valid {
+			s.ResourceType = resource.Map[resourceType.String]
+		}
+		s.WebhookUrl = webhookUrl.String
+		subs = append(subs, &s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return subs, nil
+}
+
+func nullString(s string) any {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+func nullResourceType(t resource.Type) any {
+	if t == resource.Unknown {
+		return nil
+	}
+	return t.String()
+}
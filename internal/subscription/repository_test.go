@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package subscription
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_New(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+
+	got, err := NewRepository(ctx, rw, rw)
+	require.NoError(t, err)
+	assert.Equal(t, &Repository{reader: rw, writer: rw, defaultLimit: db.DefaultLimit}, got)
+
+	got, err = NewRepository(ctx, rw, rw, WithLimit(5))
+	require.NoError(t, err)
+	assert.Equal(t, 5, got.defaultLimit)
+
+	_, err = NewRepository(ctx, nil, rw)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	_, err = NewRepository(ctx, rw, nil)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+}
+
+func TestRepository_Subscriptions(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, prj := iam.TestScopes(t, iamRepo)
+	user := iam.TestUser(t, iamRepo, org.GetPublicId())
+
+	repo, err := NewRepository(ctx, rw, rw)
+	require.NoError(t, err)
+
+	byId, err := NewSubscription(ctx, user.GetPublicId(), prj.GetPublicId(), WithResourceId("ttcp_1234567890"))
+	require.NoError(t, err)
+	byId, err = repo.CreateSubscription(ctx, byId)
+	require.NoError(t, err)
+	assert.NotEmpty(t, byId.PublicId)
+	assert.False(t, byId.CreateTime.IsZero())
+
+	byType, err := NewSubscription(ctx, user.GetPublicId(), prj.GetPublicId(), WithResourceType(resource.Target), WithWebhookUrl("https://example.com/hook"))
+	require.NoError(t, err)
+	byType, err = repo.CreateSubscription(ctx, byType)
+	require.NoError(t, err)
+
+	_, err = repo.CreateSubscription(ctx, byType)
+	assert.ErrorContains(t, err, "public id not empty")
+
+	got, err := repo.LookupSubscription(ctx, byType.PublicId)
+	require.NoError(t, err)
+	assert.Equal(t, byType.ResourceType, got.ResourceType)
+	assert.Equal(t, byType.WebhookUrl, got.WebhookUrl)
+
+	list, err := repo.ListSubscriptions(ctx, user.GetPublicId(), prj.GetPublicId())
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, byId.PublicId, list[0].PublicId)
+
+	list, err = repo.ListSubscriptions(ctx, user.GetPublicId(), prj.GetPublicId(), WithLimit(1))
+	require.NoError(t, err)
+	assert.Len(t, list, 1)
+
+	list, err = repo.ListSubscriptions(ctx, user.GetPublicId(), org.GetPublicId())
+	require.NoError(t, err)
+	assert.Empty(t, list)
+
+	matching, err := repo.ListMatchingSubscriptions(ctx, &Change{
+		ScopeId:      prj.GetPublicId(),
+		ResourceId:   "ttcp_1234567890",
+		ResourceType: resource.Target,
+	})
+	require.NoError(t, err)
+	assert.Len(t, matching, 2)
+
+	matching, err = repo.ListMatchingSubscriptions(ctx, &Change{
+		ScopeId:      prj.GetPublicId(),
+		ResourceId:   "hsst_1234567890",
+		ResourceType: resource.HostSet,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, matching)
+
+	n, err := repo.DeleteSubscription(ctx, byId.PublicId)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	got, err = repo.LookupSubscription(ctx, byId.PublicId)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	// Subscriptions are deleted with their user.
+	_, err = iamRepo.DeleteUser(ctx, user.GetPublicId())
+	require.NoError(t, err)
+	got, err = repo.LookupSubscription(ctx, byType.PublicId)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestRepository_CreateSubscription_Limit(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+	user := iam.TestUser(t, iamRepo, org.GetPublicId())
+
+	repo, err := NewRepository(ctx, rw, rw)
+	require.NoError(t, err)
+	s, err := NewSubscription(ctx, user.GetPublicId(), org.GetPublicId(), WithResourceType(resource.User))
+	require.NoError(t, err)
+	for i := 0; i < MaxSubscriptionsPerUser; i++ {
+		_, err := repo.CreateSubscription(ctx, s)
+		require.NoError(t, err)
+	}
+	_, err = repo.CreateSubscription(ctx, s)
+	assert.ErrorContains(t, err, "user already has 100 subscriptions")
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package subscription
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/types/resource"
+)
+
+// Prefix is the prefix of the public ids of subscriptions.
+const Prefix = "evsub"
+
+// A Subscription is the subscription of a user to the changes of a resource,
+// or of all the resources of a type in a scope.
+type Subscription struct {
+	PublicId     string
+	UserId       string
+	ScopeId      string
+	ResourceId   string
+	ResourceType resource.Type
+	WebhookUrl   string
+	CreateTime   time.Time
+}
+
+// NewSubscription creates a new in memory Subscription of the user in the
+// scope. Exactly one of the WithResourceId and WithResourceType options must
+// be given. WithWebhookUrl is also supported.
+func NewSubscription(ctx context.Context, userId, scopeId string, opt ...Option) (*Subscription, error) {
+	const op = "subscription.NewSubscription"
+	opts := getOpts(opt...)
+	s := &Subscription{
+		UserId:       userId,
+		ScopeId:      scopeId,
+		ResourceId:   opts.withResourceId,
+		ResourceType: opts.withResourceType,
+		WebhookUrl:   opts.withWebhookUrl,
+	}
+	if err := s.validate(ctx, op); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Subscription) validate(ctx context.Context, op errors.Op) error {
+	switch {
+	case s.UserId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing user id")
+	case s.ScopeId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	case s.ResourceId == "" && s.ResourceType == resource.Unknown:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing resource id or resource type")
+	case s.ResourceId != "" && s.ResourceType != resource.Unknown:
+		return errors.New(ctx, errors.InvalidParameter, op, "only one of resource id and resource type can be set")
+	case s.ResourceType == resource.All:
+		return errors.New(ctx, errors.InvalidParameter, op, "resource type must not be wildcard")
+	}
+	if s.WebhookUrl != "" {
+		if err := ValidateWebhookUrl(s.WebhookUrl); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter))
+		}
+	}
+	return nil
+}
+
+// ValidateWebhookUrl returns an error if u is not an absolute http or https
+// url.
+func ValidateWebhookUrl(u string) error {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return fmt.Errorf("invalid webhook url: %w", err)
+	}
+	switch {
+	case parsed.Scheme != "http" && parsed.Scheme != "https":
+		return fmt.Errorf("webhook url scheme must be http or https")
+	case parsed.Host == "":
+		return fmt.Errorf("webhook url must have a host")
+	case parsed.User != nil:
+		return fmt.Errorf("webhook url must not contain user information")
+	}
+	return nil
+}
+
+// matches reports whether the change c matches the subscription.
+func (s *Subscription) matches(c *Change) bool {
+	if s.ResourceId != "" {
+		return s.ResourceId == c.ResourceId
+	}
+	return s.ScopeId == c.ScopeId && s.ResourceType == c.ResourceType
+}
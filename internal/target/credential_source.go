@@ -27,13 +27,21 @@ type CredentialSource interface {
 	CredentialPurpose() credential.Purpose
 	TargetId() string
 	Type() CredentialSourceType
+	Exclusive() bool
+	Priority() uint32
 }
 
 // CredentialSources contains slices of credential publicIds
-// per purpose to be attached to the target.
+// per purpose to be attached to the target. ExclusiveCredentialIds are the
+// ids of the static credentials, attached for any purpose, which can only be
+// checked out by one session at a time. FallbackCredentialIds are the ids of
+// the credential sources, in order of priority, which are fallbacks for the
+// other fallback credential sources with the same purpose.
 type CredentialSources struct {
 	BrokeredCredentialIds            []string
 	InjectedApplicationCredentialIds []string
+	ExclusiveCredentialIds           []string
+	FallbackCredentialIds            []string
 }
 
 // A TargetCredentialSource represents the relationship between a target and a
@@ -76,6 +84,19 @@ func (ts *TargetCredentialSource) Type() CredentialSourceType {
 	return CredentialSourceType(ts.GetType())
 }
 
+// Exclusive returns true if the credential source is a static credential
+// which can only be checked out by one session at a time
+func (ts *TargetCredentialSource) Exclusive() bool {
+	return ts.GetExclusive()
+}
+
+// Priority returns the order in which the credential source is tried among
+// the fallback credential sources of the target with the same purpose, or
+// zero if it is not a fallback credential source
+func (ts *TargetCredentialSource) Priority() uint32 {
+	return ts.GetPriority()
+}
+
 // credentialSourceView provides a common way to return credential sources regardless of their
 // underlying type (library or static).
 type credentialSourceView struct {
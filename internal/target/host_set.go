@@ -97,6 +97,10 @@ func (t *TargetHostSet) oplog(op oplog.OpType) oplog.Metadata {
 // TargetSet is returned from most repo operations as the target's host set.
 type TargetSet struct {
 	*hostStore.Set
+	// SetPriority is the order in which the host set is preferred among the
+	// fallback host sources of the target, or zero if it is not a fallback
+	// host source.
+	SetPriority uint32 `gorm:"column:priority;default:null"`
 }
 
 // TableName returns the tablename to override the default gorm table name
@@ -113,3 +117,10 @@ func (ts *TargetSet) Id() string {
 func (ts *TargetSet) HostCatalogId() string {
 	return ts.CatalogId
 }
+
+// Priority returns the order in which the host set is preferred among the
+// fallback host sources of the target, or zero if it is not a fallback host
+// source
+func (ts *TargetSet) Priority() uint32 {
+	return ts.SetPriority
+}
@@ -8,4 +8,5 @@ package target
 type HostSource interface {
 	HostCatalogId() string
 	Id() string
+	Priority() uint32
 }
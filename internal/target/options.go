@@ -34,6 +34,7 @@ type options struct {
 	WithUserId                 string
 	WithType                   subtypes.Subtype
 	WithHostSources            []string
+	WithFallbackHostSources    []string
 	WithCredentialLibraries    []*CredentialLibrary
 	WithStaticCredentials      []*StaticCredential
 	WithSessionMaxSeconds      uint32
@@ -45,6 +46,13 @@ type options struct {
 	WithIngressWorkerFilter    string
 	WithTargetIds              []string
 	WithAddress                string
+	WithMaxAuthAgeSeconds      uint32
+	WithBanner                 string
+	WithAnnotationOwner        string
+	WithAnnotationCostCenter   string
+	WithAnnotationTicketUrl    string
+	WithDeletionProtected      bool
+	WithTags                   map[string][]string
 }
 
 func getDefaultOptions() options {
@@ -59,6 +67,7 @@ func getDefaultOptions() options {
 		WithUserId:                 "",
 		WithType:                   "",
 		WithHostSources:            nil,
+		WithFallbackHostSources:    nil,
 		WithCredentialLibraries:    nil,
 		WithStaticCredentials:      nil,
 		WithSessionMaxSeconds:      uint32((8 * time.Hour).Seconds()),
@@ -69,6 +78,12 @@ func getDefaultOptions() options {
 		WithEgressWorkerFilter:     "",
 		WithIngressWorkerFilter:    "",
 		WithAddress:                "",
+		WithMaxAuthAgeSeconds:      0,
+		WithBanner:                 "",
+		WithAnnotationOwner:        "",
+		WithAnnotationCostCenter:   "",
+		WithAnnotationTicketUrl:    "",
+		WithDeletionProtected:      false,
 	}
 }
 
@@ -144,6 +159,14 @@ func WithHostSources(hs []string) Option {
 	}
 }
 
+// WithFallbackHostSources provides an option for providing the ids of the
+// host sources, in order of priority, which are fallbacks for each other.
+func WithFallbackHostSources(hs []string) Option {
+	return func(o *options) {
+		o.WithFallbackHostSources = hs
+	}
+}
+
 // WithCredentialLibraries provides an option for providing a list of credential libraries.
 func WithCredentialLibraries(cl []*CredentialLibrary) Option {
 	return func(o *options) {
@@ -170,6 +193,61 @@ func WithSessionConnectionLimit(limit int32) Option {
 	}
 }
 
+// WithMaxAuthAgeSeconds provides an optional maximum age, in seconds, of the
+// auth token used to authorize a session to the target. Zero means no maximum.
+func WithMaxAuthAgeSeconds(s uint32) Option {
+	return func(o *options) {
+		o.WithMaxAuthAgeSeconds = s
+	}
+}
+
+// WithBanner provides an optional banner which must be acknowledged by the
+// user before a session to the target is activated.
+func WithBanner(b string) Option {
+	return func(o *options) {
+		o.WithBanner = b
+	}
+}
+
+// WithAnnotationOwner provides an optional person or team which owns the
+// target.
+func WithAnnotationOwner(owner string) Option {
+	return func(o *options) {
+		o.WithAnnotationOwner = owner
+	}
+}
+
+// WithAnnotationCostCenter provides an optional cost center the target is
+// charged to.
+func WithAnnotationCostCenter(costCenter string) Option {
+	return func(o *options) {
+		o.WithAnnotationCostCenter = costCenter
+	}
+}
+
+// WithAnnotationTicketUrl provides an optional link to the ticket which tracks
+// the target.
+func WithAnnotationTicketUrl(url string) Option {
+	return func(o *options) {
+		o.WithAnnotationTicketUrl = url
+	}
+}
+
+// WithDeletionProtected provides an option to protect the target from being
+// deleted.
+func WithDeletionProtected(protected bool) Option {
+	return func(o *options) {
+		o.WithDeletionProtected = protected
+	}
+}
+
+// WithTags provides an optional map of tag keys to values for a target.
+func WithTags(tags map[string][]string) Option {
+	return func(o *options) {
+		o.WithTags = tags
+	}
+}
+
 // WithPublicId provides an optional public id
 func WithPublicId(id string) Option {
 	return func(o *options) {
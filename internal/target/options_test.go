@@ -105,6 +105,13 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.WithHostSources = []string{"alice", "bob"}
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithFallbackHostSources", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithFallbackHostSources([]string{"bob", "alice"}))
+		testOpts := getDefaultOptions()
+		testOpts.WithFallbackHostSources = []string{"bob", "alice"}
+		assert.Equal(opts, testOpts)
+	})
 	t.Run("WithWorkerFilter", func(t *testing.T) {
 		assert := assert.New(t)
 		opts := GetOpts(WithWorkerFilter(`"/foo" == "bar"`))
@@ -126,6 +133,47 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.WithIngressWorkerFilter = `"/foo" == "bar"`
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithMaxAuthAgeSeconds", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithMaxAuthAgeSeconds(300))
+		testOpts := getDefaultOptions()
+		testOpts.WithMaxAuthAgeSeconds = 300
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithBanner", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithBanner("Authorized use only."))
+		testOpts := getDefaultOptions()
+		testOpts.WithBanner = "Authorized use only."
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithAnnotations", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(
+			WithAnnotationOwner("platform-team"),
+			WithAnnotationCostCenter("CC-1234"),
+			WithAnnotationTicketUrl("https://tickets.example.com/OPS-1"),
+		)
+		testOpts := getDefaultOptions()
+		testOpts.WithAnnotationOwner = "platform-team"
+		testOpts.WithAnnotationCostCenter = "CC-1234"
+		testOpts.WithAnnotationTicketUrl = "https://tickets.example.com/OPS-1"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithDeletionProtected", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithDeletionProtected(true))
+		testOpts := getDefaultOptions()
+		testOpts.WithDeletionProtected = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithTags", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithTags(map[string][]string{"env": {"prod"}}))
+		testOpts := getDefaultOptions()
+		testOpts.WithTags = map[string][]string{"env": {"prod"}}
+		assert.Equal(opts, testOpts)
+	})
 	t.Run("WithPermissions", func(t *testing.T) {
 		assert := assert.New(t)
 		opts := GetOpts(WithPermissions([]perms.Permission{{ScopeId: "test1"}, {ScopeId: "test2"}}))
@@ -59,3 +59,54 @@ select public_id, project_id from target
 ;
 `
 )
+
+const (
+	addFavoriteTargetQuery = `
+insert into target_user_favorite
+  (user_id, target_id)
+values
+  (@user_id, @target_id)
+on conflict do nothing;
+`
+
+	removeFavoriteTargetQuery = `
+delete from target_user_favorite
+ where user_id   = @user_id
+   and target_id = @target_id;
+`
+
+	listFavoriteTargetIdsQuery = `
+  select target_id
+    from target_user_favorite
+   where user_id = @user_id
+order by create_time desc;
+`
+
+	recordRecentTargetQuery = `
+insert into target_user_recent
+  (user_id, target_id)
+values
+  (@user_id, @target_id)
+on conflict (user_id, target_id) do update
+  set last_connect_time = current_timestamp;
+`
+
+	pruneRecentTargetsQuery = `
+delete from target_user_recent
+ where user_id = @user_id
+   and target_id not in (
+       select target_id
+         from target_user_recent
+        where user_id = @user_id
+     order by last_connect_time desc
+        limit @max_recent
+   );
+`
+
+	listRecentTargetIdsQuery = `
+  select target_id
+    from target_user_recent
+   where user_id = @user_id
+order by last_connect_time desc;
+`
+)
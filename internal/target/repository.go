@@ -7,6 +7,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/boundary/internal/boundary"
@@ -129,6 +130,7 @@ func (r *Repository) LookupTarget(ctx context.Context, publicIdOrName string, op
 	target := allocTargetView()
 	target.PublicId = publicIdOrName
 	var address string
+	var tags map[string][]string
 	var hostSources []HostSource
 	var credSources []CredentialSource
 	_, err := r.writer.DoTx(
@@ -161,6 +163,11 @@ func (r *Repository) LookupTarget(ctx context.Context, publicIdOrName string, op
 			if targetAddress != nil {
 				address = targetAddress.GetAddress()
 			}
+			targetTags, err := fetchTags(ctx, read, target.PublicId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			tags = targetTags[target.PublicId]
 			return nil
 		},
 	)
@@ -174,6 +181,7 @@ func (r *Repository) LookupTarget(ctx context.Context, publicIdOrName string, op
 	if err != nil {
 		return nil, nil, nil, errors.Wrap(ctx, err, op)
 	}
+	subtype.SetTags(tags)
 	return subtype, hostSources, credSources, nil
 }
 
@@ -227,7 +235,8 @@ func (r *Repository) FetchAuthzProtectedEntitiesByScope(ctx context.Context, pro
 // ListTargets lists targets in a project based on the data in the WithPermissions option
 // provided to the Repository constructor. If no permissions are available, this function
 // is a no-op.
-// Supports WithLimit which overrides the limit set in the Repository object.
+// Supports WithLimit which overrides the limit set in the Repository object, and
+// WithTargetIds which restricts the targets listed to the given ones.
 func (r *Repository) ListTargets(ctx context.Context, opt ...Option) ([]Target, error) {
 	const op = "target.(Repository).ListTargets"
 
@@ -246,8 +255,17 @@ func (r *Repository) ListTargets(ctx context.Context, opt ...Option) ([]Target,
 		limit = opts.WithLimit
 	}
 
+	whereClause := strings.Join(where, " or ")
+	if opts.WithTargetIds != nil {
+		if len(opts.WithTargetIds) == 0 {
+			return []Target{}, nil
+		}
+		whereClause = fmt.Sprintf("(%s) and public_id = any(@target_ids)", whereClause)
+		args = append(args, sql.Named("target_ids", "{"+strings.Join(opts.WithTargetIds, ",")+"}"))
+	}
+
 	var foundTargets []*targetView
-	err := r.reader.SearchWhere(ctx, &foundTargets, strings.Join(where, " or "), args,
+	err := r.reader.SearchWhere(ctx, &foundTargets, whereClause, args,
 		db.WithLimit(limit))
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
@@ -267,6 +285,10 @@ func (r *Repository) ListTargets(ctx context.Context, opt ...Option) ([]Target,
 	for _, addr := range foundAddresses {
 		addresses[addr.TargetId()] = addr.Address()
 	}
+	tags, err := fetchTags(ctx, r.reader, targetIds...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
 
 	targets := make([]Target, 0, len(foundTargets))
 	for _, t := range foundTargets {
@@ -284,6 +306,7 @@ func (r *Repository) ListTargets(ctx context.Context, opt ...Option) ([]Target,
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
+		subtype.SetTags(tags[t.GetPublicId()])
 		targets = append(targets, subtype)
 	}
 
@@ -305,10 +328,35 @@ func (r *Repository) listPermissionWhereClauses() ([]string, []any) {
 		clauses = append(clauses, fmt.Sprintf("project_id = @project_id_%d", inClauseCnt))
 		args = append(args, sql.Named(fmt.Sprintf("project_id_%d", inClauseCnt), p.ScopeId))
 
+		// Targets are listed when granted by id or when having all of the
+		// tags of any of the tag selectors.
+		var idClauses []string
 		if len(p.ResourceIds) > 0 {
-			clauses = append(clauses, fmt.Sprintf("public_id = any(@public_id_%d)", inClauseCnt))
+			idClauses = append(idClauses, fmt.Sprintf("public_id = any(@public_id_%d)", inClauseCnt))
 			args = append(args, sql.Named(fmt.Sprintf("public_id_%d", inClauseCnt), "{"+strings.Join(p.ResourceIds, ",")+"}"))
 		}
+		for i, tags := range p.Tags {
+			keys := make([]string, 0, len(tags))
+			for k := range tags {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			var tagClauses []string
+			for j, k := range keys {
+				keyArg := fmt.Sprintf("tag_key_%d_%d_%d", inClauseCnt, i+1, j+1)
+				valueArg := fmt.Sprintf("tag_value_%d_%d_%d", inClauseCnt, i+1, j+1)
+				tagClauses = append(tagClauses, fmt.Sprintf("public_id in (select target_id from target_tag where key = @%s and value = @%s)", keyArg, valueArg))
+				args = append(args, sql.Named(keyArg, k), sql.Named(valueArg, tags[k]))
+			}
+			idClauses = append(idClauses, fmt.Sprintf("(%s)", strings.Join(tagClauses, " and ")))
+		}
+		switch len(idClauses) {
+		case 0:
+		case 1:
+			clauses = append(clauses, idClauses[0])
+		default:
+			clauses = append(clauses, fmt.Sprintf("(%s)", strings.Join(idClauses, " or ")))
+		}
 
 		where = append(where, fmt.Sprintf("(%s)", strings.Join(clauses, " and ")))
 	}
@@ -373,8 +421,8 @@ func (r *Repository) DeleteTarget(ctx context.Context, publicId string, _ ...Opt
 }
 
 // CreateTarget inserts into the repository and returns the new Target with
-// its list of host sets and credential libraries.
-// WithPublicId is the only supported option.
+// its list of host sets and credential libraries. The tags of the target are
+// created with it. WithPublicId is the only supported option.
 func (r *Repository) CreateTarget(ctx context.Context, target Target, opt ...Option) (Target, []HostSource, []CredentialSource, error) {
 	const op = "target.(Repository).CreateTarget"
 	opts := GetOpts(opt...)
@@ -426,6 +474,10 @@ func (r *Repository) CreateTarget(ctx context.Context, target Target, opt ...Opt
 			return nil, nil, nil, errors.Wrap(ctx, err, op)
 		}
 	}
+	tags, err := newTags(ctx, t.GetPublicId(), t.GetTags())
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	oplogWrapper, err := r.kms.GetWrapper(ctx, target.GetProjectId(), kms.KeyPurposeOplog)
 	if err != nil {
@@ -445,7 +497,7 @@ func (r *Repository) CreateTarget(ctx context.Context, target Target, opt ...Opt
 			if err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
 			}
-			msgs := make([]*oplog.Message, 0, 2)
+			msgs := make([]*oplog.Message, 0, 2+len(tags))
 			var targetOplogMsg oplog.Message
 			returnedTarget = t.Clone()
 			if err := w.Create(ctx, returnedTarget, db.NewOplogMsg(&targetOplogMsg)); err != nil {
@@ -461,6 +513,19 @@ func (r *Repository) CreateTarget(ctx context.Context, target Target, opt ...Opt
 				msgs = append(msgs, &targetAddressOplogMsg)
 			}
 
+			if len(tags) > 0 {
+				tagOplogMsgs := make([]*oplog.Message, 0, len(tags))
+				if err := w.CreateItems(ctx, tags, db.NewOplogMsgs(&tagOplogMsgs)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create target tags"))
+				}
+				msgs = append(msgs, tagOplogMsgs...)
+			}
+			targetTags, err := fetchTags(ctx, read, t.GetPublicId())
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			returnedTarget.(Target).SetTags(targetTags[t.GetPublicId()])
+
 			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, targetTicket, metadata, msgs); err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
 			}
@@ -478,8 +543,9 @@ func (r *Repository) CreateTarget(ctx context.Context, target Target, opt ...Opt
 // target. fieldMaskPaths provides field_mask.proto paths for fields that should
 // be updated.  Fields will be set to NULL if the field is a zero value and
 // included in fieldMask. Name, Description, and WorkerFilter are the only
-// updatable fields. If no updatable fields are included in the fieldMaskPaths,
-// then an error is returned.
+// updatable fields. The Tags path replaces the tags of the target with the tags
+// of the given target. If no updatable fields are included in the
+// fieldMaskPaths, then an error is returned.
 func (r *Repository) UpdateTarget(ctx context.Context, target Target, version uint32, fieldMaskPaths []string, _ ...Option) (Target, []HostSource, []CredentialSource, int, error) {
 	const op = "target.(Repository).UpdateTarget"
 	if target == nil {
@@ -503,6 +569,7 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 	}
 
 	var addressEndpoint string
+	var updateTags bool
 	for _, f := range fieldMaskPaths {
 		switch {
 		case strings.EqualFold("name", f):
@@ -513,9 +580,17 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 		case strings.EqualFold("workerfilter", f):
 		case strings.EqualFold("egressworkerfilter", f):
 		case strings.EqualFold("ingressworkerfilter", f):
+		case strings.EqualFold("maxauthageseconds", f):
+		case strings.EqualFold("banner", f):
+		case strings.EqualFold("annotationowner", f):
+		case strings.EqualFold("annotationcostcenter", f):
+		case strings.EqualFold("annotationticketurl", f):
+		case strings.EqualFold("deletionprotected", f):
 		case strings.EqualFold("address", f):
 			target.SetAddress(strings.TrimSpace(target.GetAddress()))
 			addressEndpoint = target.GetAddress()
+		case strings.EqualFold("tags", f):
+			updateTags = true
 		default:
 			return nil, nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, fmt.Sprintf("invalid field mask: %s", f))
 		}
@@ -532,14 +607,27 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 			"WorkerFilter":           target.GetWorkerFilter(),
 			"EgressWorkerFilter":     target.GetEgressWorkerFilter(),
 			"IngressWorkerFilter":    target.GetIngressWorkerFilter(),
+			"MaxAuthAgeSeconds":      target.GetMaxAuthAgeSeconds(),
+			"Banner":                 target.GetBanner(),
+			"AnnotationOwner":        target.GetAnnotationOwner(),
+			"AnnotationCostCenter":   target.GetAnnotationCostCenter(),
+			"AnnotationTicketUrl":    target.GetAnnotationTicketUrl(),
+			"DeletionProtected":      target.GetDeletionProtected(),
 			"Address":                target.GetAddress(),
 		},
 		fieldMaskPaths,
-		[]string{"SessionMaxSeconds", "SessionConnectionLimit"},
+		[]string{"SessionMaxSeconds", "SessionConnectionLimit", "DeletionProtected"},
 	)
-	if len(dbMask) == 0 && len(nullFields) == 0 {
+	if len(dbMask) == 0 && len(nullFields) == 0 && !updateTags {
 		return nil, nil, nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
 	}
+	var tags []any
+	if updateTags {
+		var err error
+		if tags, err = newTags(ctx, target.GetPublicId(), target.GetTags()); err != nil {
+			return nil, nil, nil, db.NoRowsAffected, err
+		}
+	}
 
 	// The Address field is not apart of the target schema in the database.
 	// It is apart of a different table called target_address, which is why
@@ -563,10 +651,10 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 		}
 	}
 
-	// If the Address field is the only present change, then we must still
-	// update the target's version because a target address is a child object
-	// of the target.
-	if (len(filteredDbMask) == 0 && len(filteredNullFields) == 0) && (updateAddress || deleteAddress) {
+	// If the Address field or the tags are the only present change, then we
+	// must still update the target's version because target addresses and
+	// tags are child objects of the target.
+	if (len(filteredDbMask) == 0 && len(filteredNullFields) == 0) && (updateAddress || deleteAddress || updateTags) {
 		target.SetVersion(version + 1)
 		filteredDbMask = append(filteredDbMask, "Version")
 	}
@@ -646,6 +734,32 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 			if address != nil {
 				t.SetAddress(address.GetAddress())
 			}
+
+			if updateTags {
+				var existing []*Tag
+				if err := read.SearchWhere(ctx, &existing, "target_id = ?", []any{t.GetPublicId()}); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to fetch target tags"))
+				}
+				if len(existing) > 0 {
+					deleteTags := make([]any, 0, len(existing))
+					for _, tag := range existing {
+						deleteTags = append(deleteTags, tag)
+					}
+					if _, err := w.DeleteItems(ctx, deleteTags, db.WithOplog(oplogWrapper, tagOplog(t.GetPublicId(), oplog.OpType_OP_TYPE_DELETE))); err != nil {
+						return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete target tags"))
+					}
+				}
+				if len(tags) > 0 {
+					if err := w.CreateItems(ctx, tags, db.WithOplog(oplogWrapper, tagOplog(t.GetPublicId(), oplog.OpType_OP_TYPE_CREATE))); err != nil {
+						return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create target tags"))
+					}
+				}
+			}
+			targetTags, err := fetchTags(ctx, read, t.GetPublicId())
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			t.SetTags(targetTags[t.GetPublicId()])
 			returnedTarget = t.Clone()
 
 			return nil
@@ -47,6 +47,30 @@ func (r *Repository) AddTargetCredentialSources(ctx context.Context, targetId st
 	if err != nil {
 		return nil, nil, nil, errors.Wrap(ctx, err, op)
 	}
+	if len(idsByPurpose.FallbackCredentialIds) > 0 {
+		// Fallback credential sources added to a target are tried after the
+		// fallback credential sources already attached to it.
+		current, err := fetchCredentialSources(ctx, r.reader, targetId)
+		if err != nil {
+			return nil, nil, nil, errors.Wrap(ctx, err, op)
+		}
+		var maxPriority uint32
+		for _, cs := range current {
+			if cs.Priority() > maxPriority {
+				maxPriority = cs.Priority()
+			}
+		}
+		for _, cl := range addCredLibs {
+			if cl.Priority > 0 {
+				cl.Priority += maxPriority
+			}
+		}
+		for _, c := range addStaticCreds {
+			if c.Priority > 0 {
+				c.Priority += maxPriority
+			}
+		}
+	}
 
 	target := alloc()
 	if err := target.SetPublicId(ctx, t.PublicId); err != nil {
@@ -131,6 +155,11 @@ func (r *Repository) AddTargetCredentialSources(ctx context.Context, targetId st
 			if address != nil {
 				updatedTarget.SetAddress(address.GetAddress())
 			}
+			tags, err := fetchTags(ctx, reader, targetId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to retrieve target tags after adding"))
+			}
+			updatedTarget.SetTags(tags[targetId])
 			return nil
 		},
 	)
@@ -287,7 +316,19 @@ func (r *Repository) SetTargetCredentialSources(ctx context.Context, targetId st
 		delStaticCred = append(delStaticCred, delS...)
 	}
 
-	if len(addCredLibs)+len(delCredLibs)+len(addStaticCred)+len(delStaticCred) == 0 {
+	exclusive, priority, updCredLibs, updStaticCred, err := r.attributeChanges(ctx, targetId, ids, delCredLibs, delStaticCred)
+	if err != nil {
+		return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	for _, cl := range addCredLibs {
+		cl.Priority = priority[cl.CredentialLibraryId]
+	}
+	for _, c := range addStaticCred {
+		c.Exclusive = exclusive[c.CredentialId]
+		c.Priority = priority[c.CredentialId]
+	}
+
+	if len(addCredLibs)+len(delCredLibs)+len(updCredLibs)+len(addStaticCred)+len(delStaticCred)+len(updStaticCred) == 0 {
 		// Nothing needs to be changed, return early
 		hostSets, err := fetchHostSources(ctx, r.reader, targetId)
 		if err != nil {
@@ -375,6 +416,22 @@ func (r *Repository) SetTargetCredentialSources(ctx context.Context, targetId st
 				metadata["op-type"] = append(metadata["op-type"], oplog.OpType_OP_TYPE_CREATE.String())
 			}
 
+			// update the priority of existing credential libraries
+			for _, cl := range updCredLibs {
+				var updMsg oplog.Message
+				fieldMask, nullFields := priorityMasks(cl.Priority)
+				rowsUpdated, err := w.Update(ctx, cl, fieldMask, nullFields, db.NewOplogMsg(&updMsg))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update target credential library"))
+				}
+				if rowsUpdated != 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated target credential library and %d rows updated", rowsUpdated))
+				}
+				rowsAffected += rowsUpdated
+				msgs = append(msgs, &updMsg)
+				metadata["op-type"] = append(metadata["op-type"], oplog.OpType_OP_TYPE_UPDATE.String())
+			}
+
 			// delete existing credential libraries not part of set
 			if len(delCredLibs) > 0 {
 				i := make([]any, 0, len(delCredLibs))
@@ -409,6 +466,23 @@ func (r *Repository) SetTargetCredentialSources(ctx context.Context, targetId st
 				metadata["op-type"] = append(metadata["op-type"], oplog.OpType_OP_TYPE_CREATE.String())
 			}
 
+			// update the exclusivity and priority of existing static credentials
+			for _, c := range updStaticCred {
+				var updMsg oplog.Message
+				fieldMask, nullFields := priorityMasks(c.Priority)
+				fieldMask = append(fieldMask, "Exclusive")
+				rowsUpdated, err := w.Update(ctx, c, fieldMask, nullFields, db.NewOplogMsg(&updMsg))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update target static credential"))
+				}
+				if rowsUpdated != 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated target static credential and %d rows updated", rowsUpdated))
+				}
+				rowsAffected += rowsUpdated
+				msgs = append(msgs, &updMsg)
+				metadata["op-type"] = append(metadata["op-type"], oplog.OpType_OP_TYPE_UPDATE.String())
+			}
+
 			// delete existing static credentials not part of set
 			if len(delStaticCred) > 0 {
 				i := make([]any, 0, len(delStaticCred))
@@ -516,6 +590,85 @@ func (r *Repository) changes(ctx context.Context, targetId string, ids []string,
 	return addCredLib, delCredLib, addStaticCred, delStaticCred, nil
 }
 
+// attributeChanges returns the set of exclusive credential ids in ids, the
+// priority of the fallback credential ids in ids, and the credential
+// libraries and static credentials currently attached to targetId, and not
+// in delCredLib or delStaticCred, whose exclusivity or priority needs to be
+// updated.
+func (r *Repository) attributeChanges(ctx context.Context, targetId string, ids CredentialSources, delCredLib []*CredentialLibrary, delStaticCred []*StaticCredential) (
+	exclusive map[string]bool,
+	priority map[string]uint32,
+	updCredLib []*CredentialLibrary,
+	updStaticCred []*StaticCredential,
+	err error,
+) {
+	const op = "target.(Repository).attributeChanges"
+	credTypeById := make(map[string]CredentialSourceType, len(ids.ExclusiveCredentialIds))
+	if len(ids.ExclusiveCredentialIds) > 0 {
+		var credView []*credentialSourceView
+		if err := r.reader.SearchWhere(ctx, &credView, "public_id in (?)", []any{ids.ExclusiveCredentialIds}); err != nil {
+			return nil, nil, nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("can't retrieve credentials"))
+		}
+		for _, cv := range credView {
+			credTypeById[cv.GetPublicId()] = CredentialSourceType(cv.GetType())
+		}
+	}
+	exclusive, err = exclusiveCredentials(ctx, ids, credTypeById)
+	if err != nil {
+		return nil, nil, nil, nil, errors.Wrap(ctx, err, op)
+	}
+	priority, err = credentialPriorities(ctx, ids)
+	if err != nil {
+		return nil, nil, nil, nil, errors.Wrap(ctx, err, op)
+	}
+
+	var currentLibs []*CredentialLibrary
+	if err := r.reader.SearchWhere(ctx, &currentLibs, "target_id = ?", []any{targetId}); err != nil {
+		return nil, nil, nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("can't retrieve target credential libraries"))
+	}
+	deleted := make(map[string]bool, len(delCredLib)+len(delStaticCred))
+	for _, cl := range delCredLib {
+		deleted[cl.CredentialLibraryId+cl.CredentialPurpose] = true
+	}
+	for _, cl := range currentLibs {
+		if deleted[cl.CredentialLibraryId+cl.CredentialPurpose] || cl.Priority == priority[cl.CredentialLibraryId] {
+			continue
+		}
+		upd := cl.clone()
+		upd.Priority = priority[cl.CredentialLibraryId]
+		updCredLib = append(updCredLib, upd)
+	}
+
+	var current []*StaticCredential
+	if err := r.reader.SearchWhere(ctx, &current, "target_id = ?", []any{targetId}); err != nil {
+		return nil, nil, nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("can't retrieve target static credentials"))
+	}
+	for _, c := range delStaticCred {
+		deleted[c.CredentialId+c.CredentialPurpose] = true
+	}
+	for _, c := range current {
+		if deleted[c.CredentialId+c.CredentialPurpose] ||
+			(c.Exclusive == exclusive[c.CredentialId] && c.Priority == priority[c.CredentialId]) {
+			continue
+		}
+		upd := c.clone()
+		upd.Exclusive = exclusive[c.CredentialId]
+		upd.Priority = priority[c.CredentialId]
+		updStaticCred = append(updStaticCred, upd)
+	}
+	return exclusive, priority, updCredLib, updStaticCred, nil
+}
+
+// priorityMasks returns the field mask and null fields to update the
+// priority of a target credential source to p. A priority of zero is stored
+// as null.
+func priorityMasks(p uint32) (fieldMask, nullFields []string) {
+	if p == 0 {
+		return nil, []string{"Priority"}
+	}
+	return []string{"Priority"}, nil
+}
+
 func fetchCredentialSources(ctx context.Context, r db.Reader, targetId string) ([]CredentialSource, error) {
 	const op = "target.fetchCredentialSources"
 	var sources []*TargetCredentialSource
@@ -560,6 +713,15 @@ func (r *Repository) createSources(ctx context.Context, tId string, tSubtype sub
 		credTypeById[cv.GetPublicId()] = CredentialSourceType(cv.GetType())
 	}
 
+	exclusive, err := exclusiveCredentials(ctx, credSources, credTypeById)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	priority, err := credentialPriorities(ctx, credSources)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+
 	credLibs := make([]*CredentialLibrary, 0, totalCreds)
 	staticCred := make([]*StaticCredential, 0, totalCreds)
 	byPurpose := map[credential.Purpose][]string{
@@ -574,12 +736,15 @@ func (r *Repository) createSources(ctx context.Context, tId string, tSubtype sub
 				if err != nil {
 					return nil, nil, errors.Wrap(ctx, err, op)
 				}
+				lib.Priority = priority[id]
 				credLibs = append(credLibs, lib)
 			case StaticCredentialSourceType:
 				cred, err := NewStaticCredential(tId, id, purpose)
 				if err != nil {
 					return nil, nil, errors.Wrap(ctx, err, op)
 				}
+				cred.Exclusive = exclusive[id]
+				cred.Priority = priority[id]
 				staticCred = append(staticCred, cred)
 			}
 		}
@@ -595,3 +760,43 @@ func (r *Repository) createSources(ctx context.Context, tId string, tSubtype sub
 
 	return credLibs, staticCred, nil
 }
+
+// exclusiveCredentials returns the set of ids in credSources which are
+// exclusive. Each exclusive id must be the id of a static credential in the
+// brokered or injected application credential ids of credSources.
+// credTypeById maps the ids of credSources to their type.
+func exclusiveCredentials(ctx context.Context, credSources CredentialSources, credTypeById map[string]CredentialSourceType) (map[string]bool, error) {
+	const op = "target.exclusiveCredentials"
+	ids := strutil.MergeSlices(credSources.BrokeredCredentialIds, credSources.InjectedApplicationCredentialIds)
+	exclusive := make(map[string]bool, len(credSources.ExclusiveCredentialIds))
+	for _, id := range credSources.ExclusiveCredentialIds {
+		if !strutil.StrListContains(ids, id) {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("exclusive credential %s is not a credential source of the request", id))
+		}
+		if credTypeById[id] != StaticCredentialSourceType {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("credential source %s is not a static credential and cannot be exclusive", id))
+		}
+		exclusive[id] = true
+	}
+	return exclusive, nil
+}
+
+// credentialPriorities returns the priority of the fallback credential ids
+// of credSources, which is their position in the fallback credential ids
+// starting at one. Each fallback id must be in the brokered or injected
+// application credential ids of credSources.
+func credentialPriorities(ctx context.Context, credSources CredentialSources) (map[string]uint32, error) {
+	const op = "target.credentialPriorities"
+	ids := strutil.MergeSlices(credSources.BrokeredCredentialIds, credSources.InjectedApplicationCredentialIds)
+	priority := make(map[string]uint32, len(credSources.FallbackCredentialIds))
+	for i, id := range credSources.FallbackCredentialIds {
+		if !strutil.StrListContains(ids, id) {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("fallback credential source %s is not a credential source of the request", id))
+		}
+		if _, ok := priority[id]; ok {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("fallback credential source %s is listed more than once", id))
+		}
+		priority[id] = uint32(i + 1)
+	}
+	return priority, nil
+}
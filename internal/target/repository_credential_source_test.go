@@ -316,3 +316,141 @@ func TestRepository_SetTargetCredentialSources(t *testing.T) {
 		assert.Truef(errors.Match(errors.T(errors.RecordNotFound), err), "unexpected error %s", err.Error())
 	})
 }
+
+func TestRepository_ExclusiveCredentialSources(t *testing.T) {
+	target.Register(targettest.Subtype, hooks{}, globals.TcpTargetPrefix)
+
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	repo, err := target.NewRepository(ctx, rw, rw, testKms)
+	require.NoError(t, err)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, proj := iam.TestScopes(t, iamRepo)
+
+	storeVault := vault.TestCredentialStores(t, conn, wrapper, proj.GetPublicId(), 1)[0]
+	lib := vault.TestCredentialLibraries(t, conn, wrapper, storeVault.GetPublicId(), 1)[0]
+	storeStatic := static.TestCredentialStores(t, conn, wrapper, proj.GetPublicId(), 1)[0]
+	creds := static.TestUsernamePasswordCredentials(t, conn, wrapper, "u", "p", storeStatic.GetPublicId(), proj.GetPublicId(), 2)
+	cred1, cred2 := creds[0], creds[1]
+
+	exclusiveIds := func(sources []target.CredentialSource) []string {
+		var ids []string
+		for _, cs := range sources {
+			if cs.Exclusive() {
+				ids = append(ids, cs.Id())
+			}
+		}
+		return ids
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		tar := targettest.TestNewTestTarget(ctx, t, conn, proj.PublicId, "invalid")
+		_, _, _, err := repo.AddTargetCredentialSources(ctx, tar.GetPublicId(), tar.GetVersion(), target.CredentialSources{
+			BrokeredCredentialIds:  []string{lib.GetPublicId()},
+			ExclusiveCredentialIds: []string{lib.GetPublicId()},
+		})
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+		_, _, _, err = repo.AddTargetCredentialSources(ctx, tar.GetPublicId(), tar.GetVersion(), target.CredentialSources{
+			BrokeredCredentialIds:  []string{cred1.GetPublicId()},
+			ExclusiveCredentialIds: []string{cred2.GetPublicId()},
+		})
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+	})
+
+	t.Run("add-and-set", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tar := targettest.TestNewTestTarget(ctx, t, conn, proj.PublicId, "add-and-set")
+		_, _, sources, err := repo.AddTargetCredentialSources(ctx, tar.GetPublicId(), tar.GetVersion(), target.CredentialSources{
+			BrokeredCredentialIds:  []string{lib.GetPublicId(), cred1.GetPublicId(), cred2.GetPublicId()},
+			ExclusiveCredentialIds: []string{cred1.GetPublicId()},
+		})
+		require.NoError(err)
+		assert.Equal([]string{cred1.GetPublicId()}, exclusiveIds(sources))
+
+		_, sources, affected, err := repo.SetTargetCredentialSources(ctx, tar.GetPublicId(), tar.GetVersion()+1, target.CredentialSources{
+			BrokeredCredentialIds:  []string{lib.GetPublicId(), cred1.GetPublicId(), cred2.GetPublicId()},
+			ExclusiveCredentialIds: []string{cred2.GetPublicId()},
+		})
+		require.NoError(err)
+		assert.Equal(2, affected)
+		assert.Equal([]string{cred2.GetPublicId()}, exclusiveIds(sources))
+	})
+}
+
+func TestRepository_FallbackCredentialSources(t *testing.T) {
+	target.Register(targettest.Subtype, hooks{}, globals.TcpTargetPrefix)
+
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	repo, err := target.NewRepository(ctx, rw, rw, testKms)
+	require.NoError(t, err)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, proj := iam.TestScopes(t, iamRepo)
+
+	storeVault := vault.TestCredentialStores(t, conn, wrapper, proj.GetPublicId(), 1)[0]
+	libs := vault.TestCredentialLibraries(t, conn, wrapper, storeVault.GetPublicId(), 2)
+	lib1, lib2 := libs[0], libs[1]
+	storeStatic := static.TestCredentialStores(t, conn, wrapper, proj.GetPublicId(), 1)[0]
+	cred := static.TestUsernamePasswordCredentials(t, conn, wrapper, "u", "p", storeStatic.GetPublicId(), proj.GetPublicId(), 1)[0]
+
+	priorities := func(sources []target.CredentialSource) map[string]uint32 {
+		p := make(map[string]uint32)
+		for _, cs := range sources {
+			if cs.Priority() > 0 {
+				p[cs.Id()] = cs.Priority()
+			}
+		}
+		return p
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		tar := targettest.TestNewTestTarget(ctx, t, conn, proj.PublicId, "invalid")
+		_, _, _, err := repo.AddTargetCredentialSources(ctx, tar.GetPublicId(), tar.GetVersion(), target.CredentialSources{
+			BrokeredCredentialIds: []string{lib1.GetPublicId()},
+			FallbackCredentialIds: []string{lib2.GetPublicId()},
+		})
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+		_, _, _, err = repo.AddTargetCredentialSources(ctx, tar.GetPublicId(), tar.GetVersion(), target.CredentialSources{
+			BrokeredCredentialIds: []string{lib1.GetPublicId()},
+			FallbackCredentialIds: []string{lib1.GetPublicId(), lib1.GetPublicId()},
+		})
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+	})
+
+	t.Run("add-and-set", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tar := targettest.TestNewTestTarget(ctx, t, conn, proj.PublicId, "add-and-set")
+		_, _, sources, err := repo.AddTargetCredentialSources(ctx, tar.GetPublicId(), tar.GetVersion(), target.CredentialSources{
+			BrokeredCredentialIds: []string{lib1.GetPublicId(), lib2.GetPublicId()},
+			FallbackCredentialIds: []string{lib2.GetPublicId(), lib1.GetPublicId()},
+		})
+		require.NoError(err)
+		assert.Equal(map[string]uint32{lib2.GetPublicId(): 1, lib1.GetPublicId(): 2}, priorities(sources))
+
+		// added fallback credential sources are tried after the existing ones
+		_, _, sources, err = repo.AddTargetCredentialSources(ctx, tar.GetPublicId(), tar.GetVersion()+1, target.CredentialSources{
+			BrokeredCredentialIds: []string{cred.GetPublicId()},
+			FallbackCredentialIds: []string{cred.GetPublicId()},
+		})
+		require.NoError(err)
+		assert.Equal(map[string]uint32{lib2.GetPublicId(): 1, lib1.GetPublicId(): 2, cred.GetPublicId(): 3}, priorities(sources))
+
+		_, sources, affected, err := repo.SetTargetCredentialSources(ctx, tar.GetPublicId(), tar.GetVersion()+2, target.CredentialSources{
+			BrokeredCredentialIds: []string{lib1.GetPublicId(), lib2.GetPublicId(), cred.GetPublicId()},
+			FallbackCredentialIds: []string{cred.GetPublicId(), lib1.GetPublicId()},
+		})
+		require.NoError(err)
+		assert.Equal(3, affected)
+		assert.Equal(map[string]uint32{cred.GetPublicId(): 1, lib1.GetPublicId(): 2}, priorities(sources))
+	})
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package target
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// MaxRecentTargets is the number of recently connected targets kept for each
+// user.
+const MaxRecentTargets = 25
+
+// AddFavoriteTarget adds the target to the favorites of the user. Adding a
+// target that is already a favorite is not an error.
+func (r *Repository) AddFavoriteTarget(ctx context.Context, userId, targetId string) error {
+	const op = "target.(Repository).AddFavoriteTarget"
+	switch {
+	case userId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing user id")
+	case targetId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+	}
+	_, err := r.writer.Exec(ctx, addFavoriteTargetQuery, []any{
+		sql.Named("user_id", userId),
+		sql.Named("target_id", targetId),
+	})
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// RemoveFavoriteTarget removes the target from the favorites of the user.
+// Removing a target that is not a favorite is not an error.
+func (r *Repository) RemoveFavoriteTarget(ctx context.Context, userId, targetId string) error {
+	const op = "target.(Repository).RemoveFavoriteTarget"
+	switch {
+	case userId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing user id")
+	case targetId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+	}
+	_, err := r.writer.Exec(ctx, removeFavoriteTargetQuery, []any{
+		sql.Named("user_id", userId),
+		sql.Named("target_id", targetId),
+	})
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// ListFavoriteTargetIds returns the ids of the favorite targets of the user.
+func (r *Repository) ListFavoriteTargetIds(ctx context.Context, userId string) ([]string, error) {
+	const op = "target.(Repository).ListFavoriteTargetIds"
+	if userId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing user id")
+	}
+	return r.listUserTargetIds(ctx, op, listFavoriteTargetIdsQuery, userId)
+}
+
+// RecordRecentTarget records that the user authorized a session against the
+// target, keeping only the MaxRecentTargets most recent targets of the user.
+func (r *Repository) RecordRecentTarget(ctx context.Context, userId, targetId string) error {
+	const op = "target.(Repository).RecordRecentTarget"
+	switch {
+	case userId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing user id")
+	case targetId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+	}
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			if _, err := w.Exec(ctx, recordRecentTargetQuery, []any{
+				sql.Named("user_id", userId),
+				sql.Named("target_id", targetId),
+			}); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to record recent target"))
+			}
+			if _, err := w.Exec(ctx, pruneRecentTargetsQuery, []any{
+				sql.Named("user_id", userId),
+				sql.Named("max_recent", MaxRecentTargets),
+			}); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to prune recent targets"))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// ListRecentTargetIds returns the ids of the targets the user most recently
+// authorized sessions against, most recent first.
+func (r *Repository) ListRecentTargetIds(ctx context.Context, userId string) ([]string, error) {
+	const op = "target.(Repository).ListRecentTargetIds"
+	if userId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing user id")
+	}
+	return r.listUserTargetIds(ctx, op, listRecentTargetIdsQuery, userId)
+}
+
+func (r *Repository) listUserTargetIds(ctx context.Context, op errors.Op, query, userId string) ([]string, error) {
+	rows, err := r.reader.Query(ctx, query, []any{sql.Named("user_id", userId)})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	ids := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return ids, nil
+}
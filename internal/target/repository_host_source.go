@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/go-secure-stdlib/strutil"
 )
 
 // AddTargetHostSources provides the ability to add host sources (hostSourceIds)
@@ -18,7 +19,11 @@ import (
 // targetVersion or an error will be returned.  The target and a list of current
 // host source ids will be returned on success. Zero is not a valid value for the
 // WithVersion option and will return an error.
-func (r *Repository) AddTargetHostSources(ctx context.Context, targetId string, targetVersion uint32, hostSourceIds []string, _ ...Option) (Target, []HostSource, []CredentialSource, error) {
+//
+// The WithFallbackHostSources option marks host sources of hostSourceIds as
+// fallbacks, in order of priority. They are preferred after the fallback host
+// sources already attached to the target.
+func (r *Repository) AddTargetHostSources(ctx context.Context, targetId string, targetVersion uint32, hostSourceIds []string, opt ...Option) (Target, []HostSource, []CredentialSource, error) {
 	const op = "target.(Repository).AddTargetHostSources"
 	if targetId == "" {
 		return nil, nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing target id")
@@ -29,12 +34,34 @@ func (r *Repository) AddTargetHostSources(ctx context.Context, targetId string,
 	if len(hostSourceIds) == 0 {
 		return nil, nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing host source ids")
 	}
+	opts := GetOpts(opt...)
+	priority, err := hostSourcePriorities(ctx, hostSourceIds, opts.WithFallbackHostSources)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(ctx, err, op)
+	}
+	var maxPriority uint32
+	if len(priority) > 0 {
+		// Fallback host sources added to a target are preferred after the
+		// fallback host sources already attached to it.
+		current, err := fetchHostSources(ctx, r.reader, targetId)
+		if err != nil {
+			return nil, nil, nil, errors.Wrap(ctx, err, op)
+		}
+		for _, hs := range current {
+			if hs.Priority() > maxPriority {
+				maxPriority = hs.Priority()
+			}
+		}
+	}
 	newHostSources := make([]any, 0, len(hostSourceIds))
 	for _, id := range hostSourceIds {
 		ths, err := NewTargetHostSet(targetId, id)
 		if err != nil {
 			return nil, nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory target host set"))
 		}
+		if p := priority[id]; p > 0 {
+			ths.Priority = p + maxPriority
+		}
 		newHostSources = append(newHostSources, ths)
 	}
 	t := allocTargetView()
@@ -109,6 +136,11 @@ func (r *Repository) AddTargetHostSources(ctx context.Context, targetId string,
 			if err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to retrieve current credential sources after adds"))
 			}
+			tags, err := fetchTags(ctx, reader, targetId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to retrieve target tags after adds"))
+			}
+			updatedTarget.SetTags(tags[targetId])
 			return nil
 		},
 	)
@@ -216,7 +248,11 @@ func (r *Repository) DeleteTargetHostSources(ctx context.Context, targetId strin
 // target host sources as need to reconcile the existing sets with the sets
 // requested. If hostSourceIds is empty, the target host sources will be cleared. Zero
 // is not a valid value for the WithVersion option and will return an error.
-func (r *Repository) SetTargetHostSources(ctx context.Context, targetId string, targetVersion uint32, hostSourceIds []string, _ ...Option) ([]HostSource, []CredentialSource, int, error) {
+//
+// The WithFallbackHostSources option sets the fallback host sources of the
+// target, in order of priority. Host sources of hostSourceIds which are not
+// in the option are not fallback host sources.
+func (r *Repository) SetTargetHostSources(ctx context.Context, targetId string, targetVersion uint32, hostSourceIds []string, opt ...Option) ([]HostSource, []CredentialSource, int, error) {
 	const op = "target.(Repository).SetTargetHostSources"
 	if targetId == "" {
 		return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing target id")
@@ -224,6 +260,11 @@ func (r *Repository) SetTargetHostSources(ctx context.Context, targetId string,
 	if targetVersion == 0 {
 		return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing version")
 	}
+	opts := GetOpts(opt...)
+	priority, err := hostSourcePriorities(ctx, hostSourceIds, opts.WithFallbackHostSources)
+	if err != nil {
+		return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
 	t := allocTargetView()
 	t.PublicId = targetId
 	if err := r.reader.LookupByPublicId(ctx, &t); err != nil {
@@ -245,17 +286,27 @@ func (r *Repository) SetTargetHostSources(ctx context.Context, targetId string,
 		found[s.Id()] = s
 	}
 	addHostSources := make([]any, 0, len(hostSourceIds))
+	var updateHostSources []*TargetHostSet
 	for _, id := range hostSourceIds {
-		if _, ok := found[id]; ok {
-			// found a match, so do nothing (we want to keep it), but remove it
-			// from found
+		if s, ok := found[id]; ok {
+			// found a match, so keep it, updating its priority if needed, but
+			// remove it from found
 			delete(found, id)
+			if s.Priority() != priority[id] {
+				hs, err := NewTargetHostSet(targetId, id)
+				if err != nil {
+					return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory target host set"))
+				}
+				hs.Priority = priority[id]
+				updateHostSources = append(updateHostSources, hs)
+			}
 			continue
 		}
 		hs, err := NewTargetHostSet(targetId, id)
 		if err != nil {
 			return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory target host set"))
 		}
+		hs.Priority = priority[id]
 		addHostSources = append(addHostSources, hs)
 	}
 	deleteHostSources := make([]any, 0, len(hostSourceIds))
@@ -268,7 +319,7 @@ func (r *Repository) SetTargetHostSources(ctx context.Context, targetId string,
 			deleteHostSources = append(deleteHostSources, hs)
 		}
 	}
-	if len(addHostSources) == 0 && len(deleteHostSources) == 0 {
+	if len(addHostSources) == 0 && len(deleteHostSources) == 0 && len(updateHostSources) == 0 {
 		return foundThs, nil, db.NoRowsAffected, nil
 	}
 
@@ -332,6 +383,22 @@ func (r *Repository) SetTargetHostSources(ctx context.Context, targetId string,
 				metadata["op-type"] = append(metadata["op-type"], oplog.OpType_OP_TYPE_CREATE.String())
 			}
 
+			// update the priority of existing host sources
+			for _, hs := range updateHostSources {
+				var updMsg oplog.Message
+				fieldMask, nullFields := priorityMasks(hs.Priority)
+				rowsUpdated, err := w.Update(ctx, hs, fieldMask, nullFields, db.NewOplogMsg(&updMsg))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update target host source"))
+				}
+				if rowsUpdated != 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated target host source and %d rows updated", rowsUpdated))
+				}
+				totalRowsAffected += rowsUpdated
+				msgs = append(msgs, &updMsg)
+				metadata["op-type"] = append(metadata["op-type"], oplog.OpType_OP_TYPE_UPDATE.String())
+			}
+
 			// Anything we didn't take out of found needs to be removed
 			if len(deleteHostSources) > 0 {
 				hostSourceOplogMsgs := make([]*oplog.Message, 0, len(deleteHostSources))
@@ -386,3 +453,21 @@ func fetchHostSources(ctx context.Context, r db.Reader, targetId string) ([]Host
 	}
 	return ret, nil
 }
+
+// hostSourcePriorities returns the priority of the fallbackIds, which is
+// their position in fallbackIds starting at one. Each fallback id must be in
+// hostSourceIds.
+func hostSourcePriorities(ctx context.Context, hostSourceIds, fallbackIds []string) (map[string]uint32, error) {
+	const op = "target.hostSourcePriorities"
+	priority := make(map[string]uint32, len(fallbackIds))
+	for i, id := range fallbackIds {
+		if !strutil.StrListContains(hostSourceIds, id) {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("fallback host source %s is not a host source of the request", id))
+		}
+		if _, ok := priority[id]; ok {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("fallback host source %s is listed more than once", id))
+		}
+		priority[id] = uint32(i + 1)
+	}
+	return priority, nil
+}
@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package target
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/target/store"
+)
+
+// EnableSshCertificateAuthority enables the SSH certificate authority of the
+// target, generating its key if the target does not have one yet, and sets
+// the username of the client certificates it issues. The target must
+// support injected application credentials. The certificate authority is
+// returned with its private key cleared.
+func (r *Repository) EnableSshCertificateAuthority(ctx context.Context, targetId, username string, _ ...Option) (*SshCertificateAuthority, error) {
+	const op = "target.(Repository).EnableSshCertificateAuthority"
+	switch {
+	case targetId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+	case username == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing username")
+	}
+
+	t := allocTargetView()
+	t.PublicId = targetId
+	if err := r.reader.LookupByPublicId(ctx, &t); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", targetId)))
+	}
+	vetCredentialSources, ok := subtypeRegistry.vetCredentialSourcesFunc(t.Subtype())
+	if !ok {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%s is an unsupported target type %s", t.PublicId, t.Type))
+	}
+	// The client certificates are injected by the worker, so the target must
+	// accept injected application credentials.
+	injected := &CredentialLibrary{
+		CredentialLibrary: &store.CredentialLibrary{
+			TargetId:          targetId,
+			CredentialPurpose: string(credential.InjectedApplicationPurpose),
+		},
+	}
+	if err := vetCredentialSources(ctx, []*CredentialLibrary{injected}, nil); err != nil {
+		return nil, err
+	}
+
+	ca, err := newSshCertificateAuthority(ctx, targetId, username)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	oplogWrapper, err := r.kms.GetWrapper(ctx, t.GetProjectId(), kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+	databaseWrapper, err := r.kms.GetWrapper(ctx, t.GetProjectId(), kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := ca.encrypt(ctx, databaseWrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	var returnedCa *SshCertificateAuthority
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			current := allocSshCertificateAuthority()
+			err := reader.LookupWhere(ctx, current, "target_id = ?", []any{targetId})
+			switch {
+			case errors.IsNotFoundError(err):
+				returnedCa = ca.clone()
+				if err := w.Create(ctx, returnedCa, db.WithOplog(oplogWrapper, ca.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create ssh certificate authority"))
+				}
+			case err != nil:
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to look up ssh certificate authority"))
+			default:
+				// Keep the key of an enabled certificate authority so the
+				// host certificates it signed remain trusted.
+				current.Username = ca.GetUsername()
+				returnedCa = current
+				rowsUpdated, err := w.Update(ctx, returnedCa, []string{"Username"}, nil, db.WithOplog(oplogWrapper, current.oplog(oplog.OpType_OP_TYPE_UPDATE)))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update ssh certificate authority"))
+				}
+				if rowsUpdated != 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated ssh certificate authority and %d rows updated", rowsUpdated))
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	returnedCa.CtPrivateKey = nil
+	return returnedCa, nil
+}
+
+// DisableSshCertificateAuthority deletes the SSH certificate authority of
+// the target. Sessions authorized after it is deleted are not issued client
+// certificates, and enabling it again generates a new key. Disabling the
+// certificate authority of a target which does not have one is not an
+// error.
+func (r *Repository) DisableSshCertificateAuthority(ctx context.Context, targetId string, _ ...Option) (int, error) {
+	const op = "target.(Repository).DisableSshCertificateAuthority"
+	if targetId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+	}
+
+	t := allocTargetView()
+	t.PublicId = targetId
+	if err := r.reader.LookupByPublicId(ctx, &t); err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", targetId)))
+	}
+	oplogWrapper, err := r.kms.GetWrapper(ctx, t.GetProjectId(), kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsDeleted int
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			ca := allocSshCertificateAuthority()
+			if err := reader.LookupWhere(ctx, ca, "target_id = ?", []any{targetId}); err != nil {
+				if errors.IsNotFoundError(err) {
+					return nil
+				}
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to look up ssh certificate authority"))
+			}
+			rowsDeleted, err = w.Delete(ctx, ca, db.WithOplog(oplogWrapper, ca.oplog(oplog.OpType_OP_TYPE_DELETE)))
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete ssh certificate authority"))
+			}
+			if rowsDeleted > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 ssh certificate authority would have been deleted")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	return rowsDeleted, nil
+}
+
+// LookupSshCertificateAuthority returns the SSH certificate authority of the
+// target with its private key decrypted. If the target does not have a
+// certificate authority, it will return nil, nil.
+func (r *Repository) LookupSshCertificateAuthority(ctx context.Context, targetId string, _ ...Option) (*SshCertificateAuthority, error) {
+	const op = "target.(Repository).LookupSshCertificateAuthority"
+	if targetId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+	}
+
+	t := allocTargetView()
+	t.PublicId = targetId
+	if err := r.reader.LookupByPublicId(ctx, &t); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", targetId)))
+	}
+	ca := allocSshCertificateAuthority()
+	if err := r.reader.LookupWhere(ctx, ca, "target_id = ?", []any{targetId}); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	databaseWrapper, err := r.kms.GetWrapper(ctx, t.GetProjectId(), kms.KeyPurposeDatabase, kms.WithKeyId(ca.GetKeyId()))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := ca.decrypt(ctx, databaseWrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return ca, nil
+}
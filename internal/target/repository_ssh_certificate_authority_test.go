@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package target_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/targettest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestRepository_SshCertificateAuthority(t *testing.T) {
+	target.Register(targettest.Subtype, hooks{}, globals.TcpTargetPrefix)
+
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	repo, err := target.NewRepository(ctx, rw, rw, testKms)
+	require.NoError(t, err)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, proj := iam.TestScopes(t, iamRepo)
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := repo.EnableSshCertificateAuthority(ctx, "", "ubuntu")
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+		_, err = repo.EnableSshCertificateAuthority(ctx, "ttcp_1234567890", "")
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+		_, err = repo.EnableSshCertificateAuthority(ctx, "ttcp_1234567890", "ubuntu")
+		assert.Truef(t, errors.Match(errors.T(errors.RecordNotFound), err), "unexpected error: %v", err)
+		_, err = repo.DisableSshCertificateAuthority(ctx, "")
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+		_, err = repo.LookupSshCertificateAuthority(ctx, "")
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+	})
+
+	t.Run("lifecycle", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tar := targettest.TestNewTestTarget(ctx, t, conn, proj.PublicId, "ssh-ca")
+
+		got, err := repo.LookupSshCertificateAuthority(ctx, tar.GetPublicId())
+		require.NoError(err)
+		assert.Nil(got)
+
+		ca, err := repo.EnableSshCertificateAuthority(ctx, tar.GetPublicId(), "ubuntu")
+		require.NoError(err)
+		assert.Equal(tar.GetPublicId(), ca.GetTargetId())
+		assert.Equal("ubuntu", ca.GetUsername())
+		assert.NotEmpty(ca.GetPublicKey())
+		assert.NotEmpty(ca.GetKeyId())
+		assert.Empty(ca.GetPrivateKey())
+		assert.Empty(ca.GetCtPrivateKey())
+
+		got, err = repo.LookupSshCertificateAuthority(ctx, tar.GetPublicId())
+		require.NoError(err)
+		require.NotNil(got)
+		assert.Equal(ca.GetPublicKey(), got.GetPublicKey())
+		_, certificate, err := got.IssueClientCertificate(ctx, "s_1234567890", time.Now().Add(time.Hour))
+		require.NoError(err)
+		pub, _, _, _, err := ssh.ParseAuthorizedKey(certificate)
+		require.NoError(err)
+		cert, ok := pub.(*ssh.Certificate)
+		require.True(ok)
+		caKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(ca.GetPublicKey()))
+		require.NoError(err)
+		assert.Equal(caKey.Marshal(), cert.SignatureKey.Marshal())
+
+		// enabling it again keeps the key and updates the username
+		updated, err := repo.EnableSshCertificateAuthority(ctx, tar.GetPublicId(), "admin")
+		require.NoError(err)
+		assert.Equal(ca.GetPublicKey(), updated.GetPublicKey())
+		assert.Equal("admin", updated.GetUsername())
+
+		deleted, err := repo.DisableSshCertificateAuthority(ctx, tar.GetPublicId())
+		require.NoError(err)
+		assert.Equal(1, deleted)
+		got, err = repo.LookupSshCertificateAuthority(ctx, tar.GetPublicId())
+		require.NoError(err)
+		assert.Nil(got)
+
+		deleted, err = repo.DisableSshCertificateAuthority(ctx, tar.GetPublicId())
+		require.NoError(err)
+		assert.Equal(0, deleted)
+
+		// enabling it after it was disabled generates a new key
+		ca2, err := repo.EnableSshCertificateAuthority(ctx, tar.GetPublicId(), "ubuntu")
+		require.NoError(err)
+		assert.NotEqual(ca.GetPublicKey(), ca2.GetPublicKey())
+	})
+}
@@ -299,6 +299,36 @@ func TestRepositoryListPermissionWhereClauses(t *testing.T) {
 				sql.Named("project_id_4", "scope_d"),
 			},
 		},
+		{
+			name: "onePermissionTags",
+			perms: []perms.Permission{
+				{
+					ScopeId:     "scope_a",
+					Action:      action.List,
+					ResourceIds: []string{"resourceid1"},
+					Tags: []map[string]string{
+						{"env": "prod"},
+						{"team": "red", "env": "dev"},
+					},
+				},
+			},
+			expWhere: []string{
+				"(project_id = @project_id_1 and (public_id = any(@public_id_1) or " +
+					"(public_id in (select target_id from target_tag where key = @tag_key_1_1_1 and value = @tag_value_1_1_1)) or " +
+					"(public_id in (select target_id from target_tag where key = @tag_key_1_2_1 and value = @tag_value_1_2_1) and " +
+					"public_id in (select target_id from target_tag where key = @tag_key_1_2_2 and value = @tag_value_1_2_2))))",
+			},
+			expArgs: []any{
+				sql.Named("project_id_1", "scope_a"),
+				sql.Named("public_id_1", "{resourceid1}"),
+				sql.Named("tag_key_1_1_1", "env"),
+				sql.Named("tag_value_1_1_1", "prod"),
+				sql.Named("tag_key_1_2_1", "env"),
+				sql.Named("tag_value_1_2_1", "dev"),
+				sql.Named("tag_key_1_2_2", "team"),
+				sql.Named("tag_value_1_2_2", "red"),
+			},
+		},
 	}
 
 	for _, tt := range tests {
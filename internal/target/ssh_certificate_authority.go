@@ -0,0 +1,287 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package target
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/pem"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/target/store"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/hashicorp/go-kms-wrapping/v2/extras/structwrapping"
+	"golang.org/x/crypto/ssh"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	DefaultSshCertificateAuthorityTableName = "target_ssh_certificate_authority"
+)
+
+// clientCertificateExtensions are the extensions of the client certificates
+// issued for sessions. They match the default extensions of ssh-keygen.
+var clientCertificateExtensions = map[string]string{
+	"permit-X11-forwarding":   "",
+	"permit-agent-forwarding": "",
+	"permit-port-forwarding":  "",
+	"permit-pty":              "",
+	"permit-user-rc":          "",
+}
+
+// An SshCertificateAuthority is an SSH certificate authority managed by
+// Boundary for a target. It issues a client certificate for each session
+// authorized against the target, which the worker injects into the session,
+// and signs the host keys of the endpoints of the target so clients can
+// trust them. Its private key is encrypted with a database key of the
+// project of the target.
+type SshCertificateAuthority struct {
+	*store.SshCertificateAuthority
+	tableName string `gorm:"-"`
+}
+
+// Ensure SshCertificateAuthority implements interfaces
+var (
+	_ db.VetForWriter         = (*SshCertificateAuthority)(nil)
+	_ oplog.ReplayableMessage = (*SshCertificateAuthority)(nil)
+)
+
+// newSshCertificateAuthority creates a new in memory certificate authority
+// for targetId with a newly generated ed25519 key. The client certificates
+// it issues have username as their only principal.
+func newSshCertificateAuthority(ctx context.Context, targetId, username string) (*SshCertificateAuthority, error) {
+	const op = "target.newSshCertificateAuthority"
+	username = strings.TrimSpace(username)
+	switch {
+	case targetId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+	case username == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing username")
+	}
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to generate certificate authority key"))
+	}
+	sshPub, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	pemKey, err := encodePrivateKey(priv)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &SshCertificateAuthority{
+		SshCertificateAuthority: &store.SshCertificateAuthority{
+			TargetId:   targetId,
+			Username:   username,
+			PublicKey:  strings.TrimSpace(string(ssh.MarshalAuthorizedKey(sshPub))),
+			PrivateKey: pemKey,
+		},
+	}, nil
+}
+
+func allocSshCertificateAuthority() *SshCertificateAuthority {
+	return &SshCertificateAuthority{
+		SshCertificateAuthority: &store.SshCertificateAuthority{},
+	}
+}
+
+// clone creates a clone of the certificate authority
+func (ca *SshCertificateAuthority) clone() *SshCertificateAuthority {
+	cp := proto.Clone(ca.SshCertificateAuthority)
+	return &SshCertificateAuthority{
+		SshCertificateAuthority: cp.(*store.SshCertificateAuthority),
+	}
+}
+
+// VetForWrite implements db.VetForWrite() interface and validates the
+// certificate authority before it's written.
+func (ca *SshCertificateAuthority) VetForWrite(ctx context.Context, _ db.Reader, opType db.OpType, _ ...db.Option) error {
+	const op = "target.(SshCertificateAuthority).VetForWrite"
+	if opType == db.CreateOp {
+		switch {
+		case ca.GetTargetId() == "":
+			return errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+		case ca.GetPublicKey() == "":
+			return errors.New(ctx, errors.InvalidParameter, op, "missing public key")
+		case len(ca.GetCtPrivateKey()) == 0:
+			return errors.New(ctx, errors.InvalidParameter, op, "missing encrypted private key")
+		}
+	}
+	return nil
+}
+
+// TableName returns the tablename to override the default gorm table name
+func (ca *SshCertificateAuthority) TableName() string {
+	if ca.tableName != "" {
+		return ca.tableName
+	}
+	return DefaultSshCertificateAuthorityTableName
+}
+
+// SetTableName sets the tablename and satisfies the ReplayableMessage
+// interface. If the caller attempts to set the name to "" the name will be
+// reset to the default name.
+func (ca *SshCertificateAuthority) SetTableName(n string) {
+	ca.tableName = n
+}
+
+func (ca *SshCertificateAuthority) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{ca.GetTargetId()},
+		"resource-type":      []string{"target ssh certificate authority"},
+		"op-type":            []string{op.String()},
+	}
+	return metadata
+}
+
+func (ca *SshCertificateAuthority) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "target.(SshCertificateAuthority).encrypt"
+	if len(ca.PrivateKey) == 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, "no private key defined")
+	}
+	if err := structwrapping.WrapStruct(ctx, cipher, ca.SshCertificateAuthority, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	var err error
+	ca.KeyId, err = cipher.KeyId(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to discover wrapper key id"))
+	}
+	ca.PrivateKey = nil
+	return nil
+}
+
+func (ca *SshCertificateAuthority) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "target.(SshCertificateAuthority).decrypt"
+	if err := structwrapping.UnwrapStruct(ctx, cipher, ca.SshCertificateAuthority, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	ca.CtPrivateKey = nil
+	return nil
+}
+
+// IssueClientCertificate generates a new ed25519 key and issues a user
+// certificate for it which is valid for the username of the certificate
+// authority until validBefore. The key id of the certificate is sessionId so
+// it can be traced back to the session in the logs of the endpoint. The
+// private key is returned in the PEM format and the certificate in the
+// authorized_keys format. The private key of the certificate authority must
+// be decrypted.
+func (ca *SshCertificateAuthority) IssueClientCertificate(ctx context.Context, sessionId string, validBefore time.Time) (privateKey []byte, certificate []byte, _ error) {
+	const op = "target.(SshCertificateAuthority).IssueClientCertificate"
+	switch {
+	case sessionId == "":
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	case validBefore.IsZero():
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing valid before")
+	}
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to generate client key"))
+	}
+	sshPub, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	cert := &ssh.Certificate{
+		Key:             sshPub,
+		CertType:        ssh.UserCert,
+		KeyId:           sessionId,
+		ValidPrincipals: []string{ca.GetUsername()},
+		// Allow for clock skew between the controller and the endpoint.
+		ValidAfter:  uint64(time.Now().Add(-time.Minute).Unix()),
+		ValidBefore: uint64(validBefore.Unix()),
+		Permissions: ssh.Permissions{
+			Extensions: clientCertificateExtensions,
+		},
+	}
+	if err := ca.sign(ctx, cert); err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	pemKey, err := encodePrivateKey(priv)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	return pemKey, ssh.MarshalAuthorizedKey(cert), nil
+}
+
+// SignHostKey signs the host key publicKey, in the authorized_keys format,
+// for the principals, which are the names the endpoint is reached by. The
+// certificate is valid for validFor, or never expires if validFor is zero.
+// The certificate is returned in the authorized_keys format. The private key
+// of the certificate authority must be decrypted.
+func (ca *SshCertificateAuthority) SignHostKey(ctx context.Context, publicKey string, principals []string, validFor time.Duration) ([]byte, error) {
+	const op = "target.(SshCertificateAuthority).SignHostKey"
+	switch {
+	case strings.TrimSpace(publicKey) == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing public key")
+	case len(principals) == 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing principals")
+	case validFor < 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "negative validity")
+	}
+	hostKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter), errors.WithMsg("unable to parse public key"))
+	}
+	if _, ok := hostKey.(*ssh.Certificate); ok {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public key is a certificate")
+	}
+	now := time.Now()
+	cert := &ssh.Certificate{
+		Key:             hostKey,
+		CertType:        ssh.HostCert,
+		KeyId:           ca.GetTargetId(),
+		ValidPrincipals: principals,
+		ValidAfter:      uint64(now.Add(-time.Minute).Unix()),
+		ValidBefore:     ssh.CertTimeInfinity,
+	}
+	if validFor > 0 {
+		cert.ValidBefore = uint64(now.Add(validFor).Unix())
+	}
+	if err := ca.sign(ctx, cert); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return ssh.MarshalAuthorizedKey(cert), nil
+}
+
+// sign sets the serial of cert to a random number and signs it with the
+// private key of the certificate authority.
+func (ca *SshCertificateAuthority) sign(ctx context.Context, cert *ssh.Certificate) error {
+	const op = "target.(SshCertificateAuthority).sign"
+	if len(ca.GetPrivateKey()) == 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, "private key is not decrypted")
+	}
+	signer, err := ssh.ParsePrivateKey(ca.GetPrivateKey())
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to parse certificate authority private key"))
+	}
+	var serial [8]byte
+	if _, err := rand.Read(serial[:]); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to generate certificate serial"))
+	}
+	cert.Serial = binary.BigEndian.Uint64(serial[:])
+	if err := cert.SignCert(rand.Reader, signer); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to sign certificate"))
+	}
+	return nil
+}
+
+// encodePrivateKey encodes key as a PKCS #8 PEM block, which OpenSSH and
+// the worker both accept.
+func encodePrivateKey(key ed25519.PrivateKey) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
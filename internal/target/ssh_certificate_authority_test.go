@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package target
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestSshCertificateAuthority_New(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := newSshCertificateAuthority(ctx, "", "ubuntu")
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+	_, err = newSshCertificateAuthority(ctx, "ttcp_1234567890", " ")
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+
+	ca, err := newSshCertificateAuthority(ctx, "ttcp_1234567890", " ubuntu ")
+	require.NoError(t, err)
+	assert.Equal(t, "ubuntu", ca.GetUsername())
+	pub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(ca.GetPublicKey()))
+	require.NoError(t, err)
+	assert.Equal(t, ssh.KeyAlgoED25519, pub.Type())
+	signer, err := ssh.ParsePrivateKey(ca.GetPrivateKey())
+	require.NoError(t, err)
+	assert.Equal(t, pub.Marshal(), signer.PublicKey().Marshal())
+
+	other, err := newSshCertificateAuthority(ctx, "ttcp_1234567890", "ubuntu")
+	require.NoError(t, err)
+	assert.NotEqual(t, ca.GetPublicKey(), other.GetPublicKey())
+}
+
+func TestSshCertificateAuthority_IssueClientCertificate(t *testing.T) {
+	ctx := context.Background()
+	ca, err := newSshCertificateAuthority(ctx, "ttcp_1234567890", "ubuntu")
+	require.NoError(t, err)
+	caKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(ca.GetPublicKey()))
+	require.NoError(t, err)
+	validBefore := time.Now().Add(time.Hour)
+
+	t.Run("invalid", func(t *testing.T) {
+		_, _, err := ca.IssueClientCertificate(ctx, "", validBefore)
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+		_, _, err = ca.IssueClientCertificate(ctx, "s_1234567890", time.Time{})
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+
+		encrypted := ca.clone()
+		encrypted.PrivateKey = nil
+		_, _, err = encrypted.IssueClientCertificate(ctx, "s_1234567890", validBefore)
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		privateKey, certificate, err := ca.IssueClientCertificate(ctx, "s_1234567890", validBefore)
+		require.NoError(err)
+		signer, err := ssh.ParsePrivateKey(privateKey)
+		require.NoError(err)
+		pub, _, _, _, err := ssh.ParseAuthorizedKey(certificate)
+		require.NoError(err)
+		cert, ok := pub.(*ssh.Certificate)
+		require.True(ok)
+
+		assert.Equal(signer.PublicKey().Marshal(), cert.Key.Marshal())
+		assert.Equal(uint32(ssh.UserCert), cert.CertType)
+		assert.Equal("s_1234567890", cert.KeyId)
+		assert.Equal([]string{"ubuntu"}, cert.ValidPrincipals)
+		assert.Equal(uint64(validBefore.Unix()), cert.ValidBefore)
+		assert.Contains(cert.Extensions, "permit-pty")
+
+		checker := &ssh.CertChecker{
+			IsUserAuthority: func(auth ssh.PublicKey) bool {
+				return string(auth.Marshal()) == string(caKey.Marshal())
+			},
+		}
+		_, err = checker.Authenticate(testConnMetadata("ubuntu"), cert)
+		assert.NoError(err)
+		_, err = checker.Authenticate(testConnMetadata("root"), cert)
+		assert.Error(err)
+	})
+}
+
+func TestSshCertificateAuthority_SignHostKey(t *testing.T) {
+	ctx := context.Background()
+	ca, err := newSshCertificateAuthority(ctx, "ttcp_1234567890", "ubuntu")
+	require.NoError(t, err)
+	caKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(ca.GetPublicKey()))
+	require.NoError(t, err)
+
+	hostPub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	hostKey, err := ssh.NewPublicKey(hostPub)
+	require.NoError(t, err)
+	hostAuthorizedKey := string(ssh.MarshalAuthorizedKey(hostKey))
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := ca.SignHostKey(ctx, "", []string{"db.example.com"}, 0)
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+		_, err = ca.SignHostKey(ctx, hostAuthorizedKey, nil, 0)
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+		_, err = ca.SignHostKey(ctx, hostAuthorizedKey, []string{"db.example.com"}, -time.Hour)
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+		_, err = ca.SignHostKey(ctx, "not a key", []string{"db.example.com"}, 0)
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+
+		_, certificate, err := ca.IssueClientCertificate(ctx, "s_1234567890", time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		_, err = ca.SignHostKey(ctx, string(certificate), []string{"db.example.com"}, 0)
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+	})
+
+	tests := []struct {
+		name            string
+		validFor        time.Duration
+		wantValidBefore func(now time.Time) uint64
+	}{
+		{
+			name:            "no-expiration",
+			wantValidBefore: func(time.Time) uint64 { return ssh.CertTimeInfinity },
+		},
+		{
+			name:            "expiration",
+			validFor:        24 * time.Hour,
+			wantValidBefore: func(now time.Time) uint64 { return uint64(now.Add(24 * time.Hour).Unix()) },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			now := time.Now()
+			certificate, err := ca.SignHostKey(ctx, hostAuthorizedKey, []string{"db.example.com", "10.0.0.1"}, tt.validFor)
+			require.NoError(err)
+			pub, _, _, _, err := ssh.ParseAuthorizedKey(certificate)
+			require.NoError(err)
+			cert, ok := pub.(*ssh.Certificate)
+			require.True(ok)
+
+			assert.Equal(uint32(ssh.HostCert), cert.CertType)
+			assert.Equal("ttcp_1234567890", cert.KeyId)
+			assert.Equal(hostKey.Marshal(), cert.Key.Marshal())
+			assert.InDelta(tt.wantValidBefore(now), cert.ValidBefore, 1)
+
+			checker := &ssh.CertChecker{
+				IsHostAuthority: func(auth ssh.PublicKey, _ string) bool {
+					return string(auth.Marshal()) == string(caKey.Marshal())
+				},
+			}
+			assert.NoError(checker.CheckHostKey("db.example.com:22", &net.TCPAddr{}, cert))
+			assert.Error(checker.CheckHostKey("other.example.com:22", &net.TCPAddr{}, cert))
+		})
+	}
+}
+
+type testConnMetadata string
+
+func (m testConnMetadata) User() string        { return string(m) }
+func (testConnMetadata) SessionID() []byte     { return nil }
+func (testConnMetadata) ClientVersion() []byte { return nil }
+func (testConnMetadata) ServerVersion() []byte { return nil }
+func (testConnMetadata) RemoteAddr() net.Addr  { return &net.TCPAddr{} }
+func (testConnMetadata) LocalAddr() net.Addr   { return &net.TCPAddr{} }
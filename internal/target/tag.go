@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package target
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/target/store"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	DefaultTargetTagTableName = "target_tag"
+)
+
+// A Tag is a key and value applied to a target. Grants can select targets by
+// their tags, such as id=*;type=target;tags=env:prod;actions=read.
+type Tag struct {
+	*store.TargetTag
+	tableName string `gorm:"-"`
+}
+
+// Ensure Tag implements interfaces
+var (
+	_ db.VetForWriter         = (*Tag)(nil)
+	_ oplog.ReplayableMessage = (*Tag)(nil)
+)
+
+// NewTag creates a new in memory tag of a target. No options are currently
+// supported.
+func NewTag(targetId, key, value string, _ ...Option) (*Tag, error) {
+	const op = "target.NewTag"
+	switch {
+	case targetId == "":
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing target id")
+	case key == "":
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing key")
+	case value == "":
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("missing value for key %q", key))
+	}
+	return &Tag{
+		TargetTag: &store.TargetTag{
+			TargetId: targetId,
+			Key:      key,
+			Value:    value,
+		},
+	}, nil
+}
+
+// Clone creates a clone of the target tag
+func (t *Tag) Clone() any {
+	cp := proto.Clone(t.TargetTag)
+	return &Tag{
+		TargetTag: cp.(*store.TargetTag),
+	}
+}
+
+// VetForWrite implements db.VetForWrite() interface and validates the target
+// tag before it's written.
+func (t *Tag) VetForWrite(ctx context.Context, _ db.Reader, opType db.OpType, _ ...db.Option) error {
+	const op = "target.(Tag).VetForWrite"
+	if opType == db.CreateOp {
+		switch {
+		case t.GetTargetId() == "":
+			return errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+		case t.GetKey() == "":
+			return errors.New(ctx, errors.InvalidParameter, op, "missing key")
+		case t.GetValue() == "":
+			return errors.New(ctx, errors.InvalidParameter, op, "missing value")
+		}
+	}
+	return nil
+}
+
+// TableName returns the tablename to override the default gorm table name
+func (t *Tag) TableName() string {
+	if t.tableName != "" {
+		return t.tableName
+	}
+	return DefaultTargetTagTableName
+}
+
+// SetTableName sets the tablename and satisfies the ReplayableMessage
+// interface. If the caller attempts to set the name to "" the name will be
+// reset to the default name.
+func (t *Tag) SetTableName(n string) {
+	t.tableName = n
+}
+
+func tagOplog(targetId string, op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{targetId},
+		"resource-type":      []string{"target tag"},
+		"op-type":            []string{op.String()},
+	}
+	return metadata
+}
+
+// CloneTags returns a copy of the tags of a target.
+func CloneTags(tags map[string][]string) map[string][]string {
+	if tags == nil {
+		return nil
+	}
+	ret := make(map[string][]string, len(tags))
+	for k, v := range tags {
+		ret[k] = append([]string(nil), v...)
+	}
+	return ret
+}
+
+// newTags returns the tags of the target for the map of keys to values, ready
+// to be written. Keys and values are trimmed of whitespace and duplicate values
+// are ignored.
+func newTags(ctx context.Context, targetId string, tags map[string][]string) ([]any, error) {
+	const op = "target.newTags"
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var ret []any
+	seen := make(map[[2]string]bool)
+	for _, k := range keys {
+		key := strings.TrimSpace(k)
+		for _, v := range tags[k] {
+			value := strings.TrimSpace(v)
+			if seen[[2]string{key, value}] {
+				continue
+			}
+			seen[[2]string{key, value}] = true
+			t, err := NewTag(targetId, key, value)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			ret = append(ret, t)
+		}
+	}
+	return ret, nil
+}
+
+// fetchTags returns the tags of the targets, keyed by target id, as maps of
+// each key to its values.
+func fetchTags(ctx context.Context, r db.Reader, targetIds ...string) (map[string]map[string][]string, error) {
+	const op = "target.fetchTags"
+	ret := make(map[string]map[string][]string, len(targetIds))
+	if len(targetIds) == 0 {
+		return ret, nil
+	}
+	var tags []*Tag
+	if err := r.SearchWhere(ctx, &tags, "target_id in (?)", []any{targetIds}); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	for _, t := range tags {
+		if ret[t.GetTargetId()] == nil {
+			ret[t.GetTargetId()] = make(map[string][]string)
+		}
+		ret[t.GetTargetId()][t.GetKey()] = append(ret[t.GetTargetId()][t.GetKey()], t.GetValue())
+	}
+	for _, tags := range ret {
+		for _, values := range tags {
+			sort.Strings(values)
+		}
+	}
+	return ret, nil
+}
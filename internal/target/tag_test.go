@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package target
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/target/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTag_New(t *testing.T) {
+	type args struct {
+		targetId string
+		key      string
+		value    string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *Tag
+		wantErr errors.Code
+	}{
+		{
+			name:    "no-target_id",
+			args:    args{key: "env", value: "prod"},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name:    "no-key",
+			args:    args{targetId: "targ_0000000", value: "prod"},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name:    "no-value",
+			args:    args{targetId: "targ_0000000", key: "env"},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name: "valid",
+			args: args{targetId: "targ_0000000", key: "env", value: "prod"},
+			want: &Tag{
+				TargetTag: &store.TargetTag{
+					TargetId: "targ_0000000",
+					Key:      "env",
+					Value:    "prod",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewTag(tt.args.targetId, tt.args.key, tt.args.value)
+			if tt.wantErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantErr), err), "want err: %q got: %q", tt.wantErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.EqualValues(tt.want, got)
+		})
+	}
+}
+
+func Test_newTags(t *testing.T) {
+	ctx := context.Background()
+
+	got, err := newTags(ctx, "targ_0000000", map[string][]string{
+		"team": {"red"},
+		" env": {"prod", "prod ", "dev"},
+	})
+	require.NoError(t, err)
+	var pairs [][2]string
+	for _, v := range got {
+		tag := v.(*Tag)
+		assert.Equal(t, "targ_0000000", tag.GetTargetId())
+		pairs = append(pairs, [2]string{tag.GetKey(), tag.GetValue()})
+	}
+	assert.Equal(t, [][2]string{{"env", "prod"}, {"env", "dev"}, {"team", "red"}}, pairs)
+
+	_, err = newTags(ctx, "targ_0000000", map[string][]string{"env": {" "}})
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err: %q got: %q", errors.InvalidParameter, err)
+}
@@ -33,6 +33,13 @@ type Target interface {
 	GetEgressWorkerFilter() string
 	GetIngressWorkerFilter() string
 	GetAddress() string
+	GetMaxAuthAgeSeconds() uint32
+	GetBanner() string
+	GetAnnotationOwner() string
+	GetAnnotationCostCenter() string
+	GetAnnotationTicketUrl() string
+	GetDeletionProtected() bool
+	GetTags() map[string][]string
 	Clone() Target
 	SetPublicId(context.Context, string) error
 	SetProjectId(string)
@@ -48,6 +55,13 @@ type Target interface {
 	SetEgressWorkerFilter(string)
 	SetIngressWorkerFilter(string)
 	SetAddress(string)
+	SetMaxAuthAgeSeconds(uint32)
+	SetBanner(string)
+	SetAnnotationOwner(string)
+	SetAnnotationCostCenter(string)
+	SetAnnotationTicketUrl(string)
+	SetDeletionProtected(bool)
+	SetTags(map[string][]string)
 	Oplog(op oplog.OpType) oplog.Metadata
 }
 
@@ -146,6 +160,12 @@ func (t *targetView) targetSubtype(ctx context.Context, address string) (Target,
 	tt.SetWorkerFilter(t.WorkerFilter)
 	tt.SetEgressWorkerFilter(t.EgressWorkerFilter)
 	tt.SetIngressWorkerFilter(t.IngressWorkerFilter)
+	tt.SetMaxAuthAgeSeconds(t.MaxAuthAgeSeconds)
+	tt.SetBanner(t.Banner)
+	tt.SetAnnotationOwner(t.AnnotationOwner)
+	tt.SetAnnotationCostCenter(t.AnnotationCostCenter)
+	tt.SetAnnotationTicketUrl(t.AnnotationTicketUrl)
+	tt.SetDeletionProtected(t.DeletionProtected)
 	tt.SetAddress(address)
 	return tt, nil
 }
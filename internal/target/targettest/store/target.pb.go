@@ -71,6 +71,27 @@ type Target struct {
 	// A boolean expression that allows filtering the ingress workers that can handle a session
 	// @inject_tag: `gorm:"default:null"`
 	IngressWorkerFilter string `protobuf:"bytes,140,opt,name=ingress_worker_filter,json=ingressWorkerFilter,proto3" json:"ingress_worker_filter,omitempty" gorm:"default:null"`
+	// Maximum age of a user's authentication in seconds when authorizing a session
+	// @inject_tag: `gorm:"default:null"`
+	MaxAuthAgeSeconds uint32 `protobuf:"varint,150,opt,name=max_auth_age_seconds,json=maxAuthAgeSeconds,proto3" json:"max_auth_age_seconds,omitempty" gorm:"default:null"`
+	// Banner the user must acknowledge before a session is activated
+	// @inject_tag: `gorm:"default:null"`
+	Banner string `protobuf:"bytes,160,opt,name=banner,proto3" json:"banner,omitempty" gorm:"default:null"`
+	// annotation_owner is the optional person or team which owns the target
+	// @inject_tag: `gorm:"default:null"`
+	AnnotationOwner string `protobuf:"bytes,170,opt,name=annotation_owner,json=annotationOwner,proto3" json:"annotation_owner,omitempty" gorm:"default:null"`
+	// annotation_cost_center is the optional cost center the target is charged
+	// to
+	// @inject_tag: `gorm:"default:null"`
+	AnnotationCostCenter string `protobuf:"bytes,180,opt,name=annotation_cost_center,json=annotationCostCenter,proto3" json:"annotation_cost_center,omitempty" gorm:"default:null"`
+	// annotation_ticket_url is an optional link to the ticket which tracks the
+	// target
+	// @inject_tag: `gorm:"default:null"`
+	AnnotationTicketUrl string `protobuf:"bytes,190,opt,name=annotation_ticket_url,json=annotationTicketUrl,proto3" json:"annotation_ticket_url,omitempty" gorm:"default:null"`
+	// deletion_protected prevents the target from being deleted until it is
+	// cleared by a user granted the clear-deletion-protection action.
+	// @inject_tag: `gorm:"default:false"`
+	DeletionProtected bool `protobuf:"varint,200,opt,name=deletion_protected,json=deletionProtected,proto3" json:"deletion_protected,omitempty" gorm:"default:false"`
 }
 
 func (x *Target) Reset() {
@@ -196,6 +217,48 @@ func (x *Target) GetIngressWorkerFilter() string {
 	return ""
 }
 
+func (x *Target) GetMaxAuthAgeSeconds() uint32 {
+	if x != nil {
+		return x.MaxAuthAgeSeconds
+	}
+	return 0
+}
+
+func (x *Target) GetBanner() string {
+	if x != nil {
+		return x.Banner
+	}
+	return ""
+}
+
+func (x *Target) GetAnnotationOwner() string {
+	if x != nil {
+		return x.AnnotationOwner
+	}
+	return ""
+}
+
+func (x *Target) GetAnnotationCostCenter() string {
+	if x != nil {
+		return x.AnnotationCostCenter
+	}
+	return ""
+}
+
+func (x *Target) GetAnnotationTicketUrl() string {
+	if x != nil {
+		return x.AnnotationTicketUrl
+	}
+	return ""
+}
+
+func (x *Target) GetDeletionProtected() bool {
+	if x != nil {
+		return x.DeletionProtected
+	}
+	return false
+}
+
 var File_controller_storage_target_targettest_store_v1_target_proto protoreflect.FileDescriptor
 
 var file_controller_storage_target_targettest_store_v1_target_proto_rawDesc = []byte{
@@ -211,7 +274,7 @@ var file_controller_storage_target_targettest_store_v1_target_proto_rawDesc = []
 	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
 	0x6c, 0x65, 0x72, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x74, 0x69, 0x6d, 0x65,
 	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x91, 0x07, 0x0a, 0x06, 0x54, 0x61, 0x72,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa8, 0x0b, 0x0a, 0x06, 0x54, 0x61, 0x72,
 	0x67, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64,
 	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64,
 	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x14,
@@ -268,12 +331,46 @@ var file_controller_storage_target_targettest_store_v1_target_proto_rawDesc = []
 	0x72, 0x65, 0x73, 0x73, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
 	0x12, 0x15, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72,
 	0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x13, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73,
-	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x46, 0x5a, 0x44,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x73, 0x68, 0x69,
-	0x63, 0x6f, 0x72, 0x70, 0x2f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x2f, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x2f, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x3b, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x5f, 0x0a, 0x14,
+	0x6d, 0x61, 0x78, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x96, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x2d, 0xc2, 0xdd, 0x29,
+	0x29, 0x0a, 0x11, 0x4d, 0x61, 0x78, 0x41, 0x75, 0x74, 0x68, 0x41, 0x67, 0x65, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x61,
+	0x67, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x41,
+	0x75, 0x74, 0x68, 0x41, 0x67, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2d, 0x0a,
+	0x06, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0xa0, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14,
+	0xc2, 0xdd, 0x29, 0x10, 0x0a, 0x06, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x06, 0x62, 0x61,
+	0x6e, 0x6e, 0x65, 0x72, 0x52, 0x06, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x54, 0x0a, 0x10,
+	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x18, 0xaa, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc2, 0xdd, 0x29, 0x24, 0x0a, 0x0f, 0x41,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x11,
+	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x52, 0x0f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x12, 0x6a, 0x0a, 0x16, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x18, 0xb4, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x33, 0xc2, 0xdd, 0x29, 0x2f, 0x0a, 0x14, 0x41, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x73, 0x74, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12,
+	0x17, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x63, 0x6f, 0x73,
+	0x74, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x14, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x73, 0x74, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x66,
+	0x0a, 0x15, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0xbe, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31,
+	0xc2, 0xdd, 0x29, 0x2d, 0x0a, 0x13, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x75, 0x72,
+	0x6c, 0x52, 0x13, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x5b, 0x0a, 0x12, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0xc8, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x42, 0x2b, 0xc2, 0xdd, 0x29, 0x27, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x12, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x52, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x42, 0x46, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2f, 0x62, 0x6f, 0x75, 0x6e,
+	0x64, 0x61, 0x72, 0x79, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x2f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2f,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x3b, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -30,8 +30,9 @@ const (
 // Target is a target.Target used for tests.
 type Target struct {
 	*store.Target
-	Address   string `gorm:"-"`
-	tableName string `gorm:"-"`
+	Address   string              `gorm:"-"`
+	Tags      map[string][]string `gorm:"-"`
+	tableName string              `gorm:"-"`
 }
 
 var (
@@ -133,10 +134,39 @@ func (t *Target) GetAddress() string {
 	return t.Address
 }
 
+func (t *Target) GetTags() map[string][]string {
+	return t.Tags
+}
+
+func (t *Target) GetMaxAuthAgeSeconds() uint32 {
+	return t.MaxAuthAgeSeconds
+}
+
+func (t *Target) GetBanner() string {
+	return t.Banner
+}
+
+func (t *Target) GetAnnotationOwner() string {
+	return t.AnnotationOwner
+}
+
+func (t *Target) GetAnnotationCostCenter() string {
+	return t.AnnotationCostCenter
+}
+
+func (t *Target) GetAnnotationTicketUrl() string {
+	return t.AnnotationTicketUrl
+}
+
+func (t *Target) GetDeletionProtected() bool {
+	return t.DeletionProtected
+}
+
 func (t *Target) Clone() target.Target {
 	cp := proto.Clone(t.Target)
 	return &Target{
 		Address: t.Address,
+		Tags:    target.CloneTags(t.Tags),
 		Target:  cp.(*store.Target),
 	}
 }
@@ -198,6 +228,34 @@ func (t *Target) SetAddress(a string) {
 	t.Address = a
 }
 
+func (t *Target) SetTags(tags map[string][]string) {
+	t.Tags = tags
+}
+
+func (t *Target) SetMaxAuthAgeSeconds(s uint32) {
+	t.MaxAuthAgeSeconds = s
+}
+
+func (t *Target) SetBanner(b string) {
+	t.Banner = b
+}
+
+func (t *Target) SetAnnotationOwner(o string) {
+	t.AnnotationOwner = o
+}
+
+func (t *Target) SetAnnotationCostCenter(c string) {
+	t.AnnotationCostCenter = c
+}
+
+func (t *Target) SetAnnotationTicketUrl(u string) {
+	t.AnnotationTicketUrl = u
+}
+
+func (t *Target) SetDeletionProtected(p bool) {
+	t.DeletionProtected = p
+}
+
 func (t *Target) Oplog(op oplog.OpType) oplog.Metadata {
 	return oplog.Metadata{
 		"resource-public-id": []string{t.PublicId},
@@ -282,7 +340,14 @@ func New(projectId string, opt ...target.Option) (target.Target, error) {
 			WorkerFilter:           opts.WithWorkerFilter,
 			EgressWorkerFilter:     opts.WithEgressWorkerFilter,
 			IngressWorkerFilter:    opts.WithIngressWorkerFilter,
+			MaxAuthAgeSeconds:      opts.WithMaxAuthAgeSeconds,
+			Banner:                 opts.WithBanner,
+			AnnotationOwner:        opts.WithAnnotationOwner,
+			AnnotationCostCenter:   opts.WithAnnotationCostCenter,
+			AnnotationTicketUrl:    opts.WithAnnotationTicketUrl,
+			DeletionProtected:      opts.WithDeletionProtected,
 		},
+		Tags: opts.WithTags,
 	}
 	return t, nil
 }
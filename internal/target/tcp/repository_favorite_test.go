@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tcp_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/tcp"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_FavoriteTargets(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+
+	ctx := context.Background()
+	repo, err := target.NewRepository(ctx, rw, rw, testKms)
+	require.NoError(t, err)
+	org, proj := iam.TestScopes(t, iamRepo)
+	u1 := iam.TestUser(t, iamRepo, org.GetPublicId())
+	u2 := iam.TestUser(t, iamRepo, org.GetPublicId())
+	tar1 := tcp.TestTarget(ctx, t, conn, proj.GetPublicId(), "target1")
+	tar2 := tcp.TestTarget(ctx, t, conn, proj.GetPublicId(), "target2")
+
+	t.Run("missing-params", func(t *testing.T) {
+		assert := assert.New(t)
+		err := repo.AddFavoriteTarget(ctx, "", tar1.GetPublicId())
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+		err = repo.AddFavoriteTarget(ctx, u1.GetPublicId(), "")
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+		err = repo.RemoveFavoriteTarget(ctx, "", tar1.GetPublicId())
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+		_, err = repo.ListFavoriteTargetIds(ctx, "")
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+
+	t.Run("add-list-remove", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ids, err := repo.ListFavoriteTargetIds(ctx, u1.GetPublicId())
+		require.NoError(err)
+		assert.NotNil(ids)
+		assert.Empty(ids)
+
+		require.NoError(repo.AddFavoriteTarget(ctx, u1.GetPublicId(), tar1.GetPublicId()))
+		require.NoError(repo.AddFavoriteTarget(ctx, u1.GetPublicId(), tar2.GetPublicId()))
+		// Adding a favorite again is a no-op
+		require.NoError(repo.AddFavoriteTarget(ctx, u1.GetPublicId(), tar1.GetPublicId()))
+
+		ids, err = repo.ListFavoriteTargetIds(ctx, u1.GetPublicId())
+		require.NoError(err)
+		assert.ElementsMatch([]string{tar1.GetPublicId(), tar2.GetPublicId()}, ids)
+
+		// Favorites are per user
+		ids, err = repo.ListFavoriteTargetIds(ctx, u2.GetPublicId())
+		require.NoError(err)
+		assert.Empty(ids)
+
+		require.NoError(repo.RemoveFavoriteTarget(ctx, u1.GetPublicId(), tar1.GetPublicId()))
+		// Removing a target that isn't a favorite is a no-op
+		require.NoError(repo.RemoveFavoriteTarget(ctx, u1.GetPublicId(), tar1.GetPublicId()))
+
+		ids, err = repo.ListFavoriteTargetIds(ctx, u1.GetPublicId())
+		require.NoError(err)
+		assert.Equal([]string{tar2.GetPublicId()}, ids)
+	})
+
+	t.Run("unknown-target", func(t *testing.T) {
+		err := repo.AddFavoriteTarget(ctx, u1.GetPublicId(), "ttcp_doesntexist")
+		assert.Error(t, err)
+	})
+
+	t.Run("list-targets", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ids, err := repo.ListFavoriteTargetIds(ctx, u1.GetPublicId())
+		require.NoError(err)
+		listRepo, err := target.NewRepository(ctx, rw, rw, testKms, target.WithPermissions([]perms.Permission{{
+			ScopeId:  proj.GetPublicId(),
+			Resource: resource.Target,
+			Action:   action.List,
+			All:      true,
+		}}))
+		require.NoError(err)
+		got, err := listRepo.ListTargets(ctx, target.WithTargetIds(ids))
+		require.NoError(err)
+		require.Len(got, 1)
+		assert.Equal(tar2.GetPublicId(), got[0].GetPublicId())
+
+		got, err = listRepo.ListTargets(ctx, target.WithTargetIds([]string{}))
+		require.NoError(err)
+		assert.Empty(got)
+	})
+
+	t.Run("target-deleted", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		_, err := repo.DeleteTarget(ctx, tar2.GetPublicId())
+		require.NoError(err)
+		ids, err := repo.ListFavoriteTargetIds(ctx, u1.GetPublicId())
+		require.NoError(err)
+		assert.Empty(ids)
+	})
+}
+
+func TestRepository_RecentTargets(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+
+	ctx := context.Background()
+	repo, err := target.NewRepository(ctx, rw, rw, testKms)
+	require.NoError(t, err)
+	org, proj := iam.TestScopes(t, iamRepo)
+	u := iam.TestUser(t, iamRepo, org.GetPublicId())
+
+	t.Run("missing-params", func(t *testing.T) {
+		assert := assert.New(t)
+		err := repo.RecordRecentTarget(ctx, "", "ttcp_1234567890")
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+		err = repo.RecordRecentTarget(ctx, u.GetPublicId(), "")
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+		_, err = repo.ListRecentTargetIds(ctx, "")
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+
+	t.Run("order-and-prune", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		var targetIds []string
+		for i := 0; i < target.MaxRecentTargets+1; i++ {
+			tar := tcp.TestTarget(ctx, t, conn, proj.GetPublicId(), fmt.Sprintf("target%d", i))
+			targetIds = append(targetIds, tar.GetPublicId())
+			require.NoError(repo.RecordRecentTarget(ctx, u.GetPublicId(), tar.GetPublicId()))
+		}
+
+		ids, err := repo.ListRecentTargetIds(ctx, u.GetPublicId())
+		require.NoError(err)
+		require.Len(ids, target.MaxRecentTargets)
+		// Most recent first, and the oldest one was pruned
+		assert.Equal(targetIds[len(targetIds)-1], ids[0])
+		assert.NotContains(ids, targetIds[0])
+
+		// Connecting again moves the target to the front
+		require.NoError(repo.RecordRecentTarget(ctx, u.GetPublicId(), targetIds[1]))
+		ids, err = repo.ListRecentTargetIds(ctx, u.GetPublicId())
+		require.NoError(err)
+		require.Len(ids, target.MaxRecentTargets)
+		assert.Equal(targetIds[1], ids[0])
+	})
+}
@@ -601,3 +601,61 @@ func TestRepository_SetTargetHostSets(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_FallbackHostSources(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, proj := iam.TestScopes(t, iamRepo)
+	repo, err := target.NewRepository(ctx, rw, rw, testKms)
+	require.NoError(t, err)
+
+	cat := static.TestCatalogs(t, conn, proj.PublicId, 1)[0]
+	sets := static.TestSets(t, conn, cat.GetPublicId(), 3)
+	set1, set2, set3 := sets[0].PublicId, sets[1].PublicId, sets[2].PublicId
+
+	priorities := func(sources []target.HostSource) map[string]uint32 {
+		p := make(map[string]uint32)
+		for _, hs := range sources {
+			if hs.Priority() > 0 {
+				p[hs.Id()] = hs.Priority()
+			}
+		}
+		return p
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		tar := tcp.TestTarget(ctx, t, conn, proj.PublicId, "invalid")
+		_, _, _, err := repo.AddTargetHostSources(ctx, tar.GetPublicId(), tar.GetVersion(), []string{set1}, target.WithFallbackHostSources([]string{set2}))
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+		_, _, _, err = repo.AddTargetHostSources(ctx, tar.GetPublicId(), tar.GetVersion(), []string{set1}, target.WithFallbackHostSources([]string{set1, set1}))
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+	})
+
+	t.Run("add-and-set", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tar := tcp.TestTarget(ctx, t, conn, proj.PublicId, "add-and-set")
+		_, sources, _, err := repo.AddTargetHostSources(ctx, tar.GetPublicId(), tar.GetVersion(), []string{set1, set2}, target.WithFallbackHostSources([]string{set2, set1}))
+		require.NoError(err)
+		assert.Equal(map[string]uint32{set2: 1, set1: 2}, priorities(sources))
+
+		// added fallback host sources are preferred after the existing ones
+		_, sources, _, err = repo.AddTargetHostSources(ctx, tar.GetPublicId(), tar.GetVersion()+1, []string{set3}, target.WithFallbackHostSources([]string{set3}))
+		require.NoError(err)
+		assert.Equal(map[string]uint32{set2: 1, set1: 2, set3: 3}, priorities(sources))
+
+		sources, _, affected, err := repo.SetTargetHostSources(ctx, tar.GetPublicId(), tar.GetVersion()+2, []string{set1, set2, set3}, target.WithFallbackHostSources([]string{set3, set1}))
+		require.NoError(err)
+		assert.Equal(2, affected)
+		assert.Equal(map[string]uint32{set3: 1, set1: 2}, priorities(sources))
+
+		// setting the same fallback host sources changes nothing
+		_, _, affected, err = repo.SetTargetHostSources(ctx, tar.GetPublicId(), tar.GetVersion()+3, []string{set1, set2, set3}, target.WithFallbackHostSources([]string{set3, set1}))
+		require.NoError(err)
+		assert.Equal(db.NoRowsAffected, affected)
+	})
+}
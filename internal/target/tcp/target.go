@@ -31,8 +31,10 @@ const (
 type Target struct {
 	*store.Target
 	// Network address assigned to the Target.
-	Address   string `json:"address,omitempty" gorm:"-"`
-	tableName string `gorm:"-"`
+	Address string `json:"address,omitempty" gorm:"-"`
+	// Tags of the Target, a map of keys to their values.
+	Tags      map[string][]string `json:"tags,omitempty" gorm:"-"`
+	tableName string              `gorm:"-"`
 }
 
 // Ensure Target implements interfaces
@@ -61,8 +63,15 @@ func (h targetHooks) NewTarget(projectId string, opt ...target.Option) (target.T
 			WorkerFilter:           opts.WithWorkerFilter,
 			EgressWorkerFilter:     opts.WithEgressWorkerFilter,
 			IngressWorkerFilter:    opts.WithIngressWorkerFilter,
+			MaxAuthAgeSeconds:      opts.WithMaxAuthAgeSeconds,
+			Banner:                 opts.WithBanner,
+			AnnotationOwner:        opts.WithAnnotationOwner,
+			AnnotationCostCenter:   opts.WithAnnotationCostCenter,
+			AnnotationTicketUrl:    opts.WithAnnotationTicketUrl,
+			DeletionProtected:      opts.WithDeletionProtected,
 		},
 		Address: opts.WithAddress,
+		Tags:    opts.WithTags,
 	}
 	return t, nil
 }
@@ -80,6 +89,7 @@ func (t *Target) Clone() target.Target {
 	return &Target{
 		Target:  cp.(*store.Target),
 		Address: t.Address,
+		Tags:    target.CloneTags(t.Tags),
 	}
 }
 
@@ -135,6 +145,10 @@ func (t *Target) GetAddress() string {
 	return t.Address
 }
 
+func (t *Target) GetTags() map[string][]string {
+	return t.Tags
+}
+
 func (t *Target) SetPublicId(ctx context.Context, publicId string) error {
 	const op = "tcp.(Target).SetPublicId"
 	if !strings.HasPrefix(publicId, TargetPrefix+"_") {
@@ -196,3 +210,31 @@ func (t *Target) SetIngressWorkerFilter(filter string) {
 func (t *Target) SetAddress(address string) {
 	t.Address = address
 }
+
+func (t *Target) SetTags(tags map[string][]string) {
+	t.Tags = tags
+}
+
+func (t *Target) SetMaxAuthAgeSeconds(s uint32) {
+	t.MaxAuthAgeSeconds = s
+}
+
+func (t *Target) SetBanner(b string) {
+	t.Banner = b
+}
+
+func (t *Target) SetAnnotationOwner(o string) {
+	t.AnnotationOwner = o
+}
+
+func (t *Target) SetAnnotationCostCenter(c string) {
+	t.AnnotationCostCenter = c
+}
+
+func (t *Target) SetAnnotationTicketUrl(u string) {
+	t.AnnotationTicketUrl = u
+}
+
+func (t *Target) SetDeletionProtected(p bool) {
+	t.DeletionProtected = p
+}
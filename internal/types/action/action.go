@@ -67,6 +67,19 @@ const (
 	RotateScopeKeys                    Type = 53
 	ListScopeKeyVersionDestructionJobs Type = 54
 	DestroyScopeKeyVersion             Type = 55
+	ReadSecret                         Type = 56
+	Simulate                           Type = 57
+	ListAvailableHosts                 Type = 58
+	TestWorkerFilter                   Type = 59
+	ListAuthorizedActions              Type = 60
+	ReadLoginMetadata                  Type = 61
+	SubscribeEvents                    Type = 62
+	ExceedConnectionLimit              Type = 63
+	ClearDeletionProtection            Type = 64
+	EnableSshCertificateAuthority      Type = 65
+	DisableSshCertificateAuthority     Type = 66
+	SignSshHostKey                     Type = 67
+	BatchCreate                        Type = 68
 
 	// When adding new actions, be sure to update:
 	//
@@ -130,6 +143,19 @@ var Map = map[string]Type{
 	RotateScopeKeys.String():                    RotateScopeKeys,
 	ListScopeKeyVersionDestructionJobs.String(): ListScopeKeyVersionDestructionJobs,
 	DestroyScopeKeyVersion.String():             DestroyScopeKeyVersion,
+	ReadSecret.String():                         ReadSecret,
+	Simulate.String():                           Simulate,
+	ListAvailableHosts.String():                 ListAvailableHosts,
+	TestWorkerFilter.String():                   TestWorkerFilter,
+	ListAuthorizedActions.String():              ListAuthorizedActions,
+	ReadLoginMetadata.String():                  ReadLoginMetadata,
+	SubscribeEvents.String():                    SubscribeEvents,
+	ExceedConnectionLimit.String():              ExceedConnectionLimit,
+	ClearDeletionProtection.String():            ClearDeletionProtection,
+	EnableSshCertificateAuthority.String():      EnableSshCertificateAuthority,
+	DisableSshCertificateAuthority.String():     DisableSshCertificateAuthority,
+	SignSshHostKey.String():                     SignSshHostKey,
+	BatchCreate.String():                        BatchCreate,
 }
 
 var DeprecatedMap = map[string]Type{
@@ -199,6 +225,19 @@ func (a Type) String() string {
 		"rotate-keys",
 		"list-key-version-destruction-jobs",
 		"destroy-key-version",
+		"read:secret",
+		"simulate",
+		"list-available-hosts",
+		"test-worker-filter",
+		"list-authorized-actions",
+		"read-login-metadata",
+		"subscribe-events",
+		"exceed-connection-limit",
+		"clear-deletion-protection",
+		"enable-ssh-certificate-authority",
+		"disable-ssh-certificate-authority",
+		"sign-ssh-host-key",
+		"batch-create",
 	}[a]
 }
 
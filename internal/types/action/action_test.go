@@ -134,6 +134,58 @@ func TestAction(t *testing.T) {
 			action: DestroyScopeKeyVersion,
 			want:   "destroy-key-version",
 		},
+		{
+			action: ReadSecret,
+			want:   "read:secret",
+		},
+		{
+			action: Simulate,
+			want:   "simulate",
+		},
+		{
+			action: ListAvailableHosts,
+			want:   "list-available-hosts",
+		},
+		{
+			action: TestWorkerFilter,
+			want:   "test-worker-filter",
+		},
+		{
+			action: ListAuthorizedActions,
+			want:   "list-authorized-actions",
+		},
+		{
+			action: ReadLoginMetadata,
+			want:   "read-login-metadata",
+		},
+		{
+			action: SubscribeEvents,
+			want:   "subscribe-events",
+		},
+		{
+			action: ExceedConnectionLimit,
+			want:   "exceed-connection-limit",
+		},
+		{
+			action: ClearDeletionProtection,
+			want:   "clear-deletion-protection",
+		},
+		{
+			action: EnableSshCertificateAuthority,
+			want:   "enable-ssh-certificate-authority",
+		},
+		{
+			action: DisableSshCertificateAuthority,
+			want:   "disable-ssh-certificate-authority",
+		},
+		{
+			action: SignSshHostKey,
+			want:   "sign-ssh-host-key",
+		},
+		{
+			action: BatchCreate,
+			want:   "batch-create",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.want, func(t *testing.T) {
@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import (
+	"errors"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/boundary/globals"
+)
+
+// ErrMissingPort is returned by SplitHostPort when the address has no port.
+var ErrMissingPort = errors.New(globals.MissingPortErrStr)
+
+// SplitHostPort splits an address of the form "host:port" or "[host]:port"
+// into its host and port, like net.SplitHostPort. Unlike net.SplitHostPort it
+// also understands addresses without a port, including IPv6 addresses both
+// bare and enclosed in square brackets; for those the host, without brackets,
+// is returned along with ErrMissingPort.
+func SplitHostPort(hostport string) (host, port string, err error) {
+	host, port, err = net.SplitHostPort(hostport)
+	switch {
+	case err == nil:
+		return host, port, nil
+	case strings.Contains(err.Error(), globals.MissingPortErrStr):
+		if strings.HasPrefix(hostport, "[") && strings.HasSuffix(hostport, "]") {
+			hostport = hostport[1 : len(hostport)-1]
+		}
+		return hostport, "", ErrMissingPort
+	}
+	if ip := net.ParseIP(hostport); ip != nil && ip.To4() == nil {
+		return hostport, "", ErrMissingPort
+	}
+	return "", "", err
+}
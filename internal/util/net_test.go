@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SplitHostPort(t *testing.T) {
+	t.Parallel()
+
+	tc := []struct {
+		in         string
+		wantHost   string
+		wantPort   string
+		wantErr    error
+		wantAnyErr bool
+	}{
+		{in: "127.0.0.1:9200", wantHost: "127.0.0.1", wantPort: "9200"},
+		{in: "127.0.0.1", wantHost: "127.0.0.1", wantErr: util.ErrMissingPort},
+		{in: "example.com:22", wantHost: "example.com", wantPort: "22"},
+		{in: "example.com", wantHost: "example.com", wantErr: util.ErrMissingPort},
+		{in: "[2001:db8::1]:22", wantHost: "2001:db8::1", wantPort: "22"},
+		{in: "[2001:db8::1]", wantHost: "2001:db8::1", wantErr: util.ErrMissingPort},
+		{in: "2001:db8::1", wantHost: "2001:db8::1", wantErr: util.ErrMissingPort},
+		{in: "::", wantHost: "::", wantErr: util.ErrMissingPort},
+		{in: "[2001:db8::1", wantAnyErr: true},
+		{in: "example.com:22:33", wantAnyErr: true},
+	}
+	for _, tt := range tc {
+		host, port, err := util.SplitHostPort(tt.in)
+		switch {
+		case tt.wantAnyErr:
+			assert.Error(t, err, tt.in)
+			assert.NotErrorIs(t, err, util.ErrMissingPort, tt.in)
+			continue
+		case tt.wantErr != nil:
+			assert.ErrorIs(t, err, tt.wantErr, tt.in)
+		default:
+			assert.NoError(t, err, tt.in)
+		}
+		assert.Equal(t, tt.wantHost, host, tt.in)
+		assert.Equal(t, tt.wantPort, port, tt.in)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strings"
 	"text/template"
+	"text/template/parse"
 
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/util"
@@ -36,8 +37,11 @@ func New(ctx context.Context, raw string) (*Parsed, error) {
 		},
 	}
 
+	// Referencing a missing key of a map (e.g. an account attribute that isn't
+	// set) is an error rather than a "<no value>" string
 	tmpl, err := template.New("template").
 		Funcs(ret.funcMap).
+		Option("missingkey=error").
 		Parse(ret.raw)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("error parsing template"))
@@ -70,3 +74,72 @@ func (p *Parsed) Generate(ctx context.Context, data any) (string, error) {
 
 	return out, nil
 }
+
+// Fields returns the fields of the data referenced by the template, such as
+// "User.Id", in the order they first appear. Fields referenced relative to a
+// dot changed by a with or range action are returned relative to that dot.
+func (p *Parsed) Fields() []string {
+	if p.tmpl == nil || p.tmpl.Tree == nil {
+		return nil
+	}
+	var fields []string
+	seen := map[string]bool{}
+	add := func(ident []string) {
+		f := strings.Join(ident, ".")
+		if f != "" && !seen[f] {
+			seen[f] = true
+			fields = append(fields, f)
+		}
+	}
+	var walk func(parse.Node)
+	walk = func(n parse.Node) {
+		switch n := n.(type) {
+		case *parse.ListNode:
+			if n == nil {
+				return
+			}
+			for _, c := range n.Nodes {
+				walk(c)
+			}
+		case *parse.ActionNode:
+			walk(n.Pipe)
+		case *parse.PipeNode:
+			if n == nil {
+				return
+			}
+			for _, c := range n.Cmds {
+				walk(c)
+			}
+		case *parse.CommandNode:
+			for _, a := range n.Args {
+				walk(a)
+			}
+		case *parse.FieldNode:
+			add(n.Ident)
+		case *parse.VariableNode:
+			// Only fields of the data itself, referenced through $, are
+			// returned.
+			if len(n.Ident) > 1 && n.Ident[0] == "$" {
+				add(n.Ident[1:])
+			}
+		case *parse.ChainNode:
+			walk(n.Node)
+		case *parse.IfNode:
+			walk(n.Pipe)
+			walk(n.List)
+			walk(n.ElseList)
+		case *parse.RangeNode:
+			walk(n.Pipe)
+			walk(n.List)
+			walk(n.ElseList)
+		case *parse.WithNode:
+			walk(n.Pipe)
+			walk(n.List)
+			walk(n.ElseList)
+		case *parse.TemplateNode:
+			walk(n.Pipe)
+		}
+	}
+	walk(p.tmpl.Tree.Root)
+	return fields
+}
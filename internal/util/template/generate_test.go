@@ -89,6 +89,10 @@ func TestGenerate(t *testing.T) {
 			Subject:   util.Pointer("accountSubject"),
 			Email:     util.Pointer("account@email.com"),
 		},
+		Target: Target{
+			Id:   util.Pointer("targetId"),
+			Name: util.Pointer("targetName"),
+		},
 	}
 	raw := strings.TrimSpace(`
 {{ .User.Id }}
@@ -102,6 +106,8 @@ func TestGenerate(t *testing.T) {
 {{ .Account.Subject }}
 {{ .Account.Email }}
 {{ truncateFrom .Account.Email "@" }}
+{{ .Target.Id }}
+{{ .Target.Name }}
 `)
 
 	parsed, err := New(ctx, raw)
@@ -128,7 +134,50 @@ accountLoginName
 accountSubject
 account@email.com
 account
+targetId
+targetName
 `)
 
 	assert.Equal(exp, out)
 }
+
+func TestGenerate_AccountAttributes(t *testing.T) {
+	require, assert := require.New(t), assert.New(t)
+	ctx := context.Background()
+
+	parsed, err := New(ctx, "{{ .Account.Attributes.department }}")
+	require.NoError(err)
+	require.NotNil(parsed)
+
+	// Ensure we error when the attribute is not set
+	_, err = parsed.Generate(ctx, Data{})
+	require.Error(err)
+	_, err = parsed.Generate(ctx, Data{Account: Account{Attributes: map[string]any{"employeeId": "1234"}}})
+	require.Error(err)
+
+	out, err := parsed.Generate(ctx, Data{Account: Account{Attributes: map[string]any{"department": "engineering"}}})
+	require.NoError(err)
+	assert.Equal("engineering", out)
+}
+
+func TestParsed_Fields(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		raw  string
+		want []string
+	}{
+		{raw: "no fields"},
+		{raw: "{{ .User.Id }}-{{ .User.Id }}", want: []string{"User.Id"}},
+		{raw: `{{ truncateFrom .Account.Email "@" }} {{ .Target.Name }}`, want: []string{"Account.Email", "Target.Name"}},
+		{raw: "{{ .Account.Attributes.department }}", want: []string{"Account.Attributes.department"}},
+		{raw: "{{ if .User.Name }}{{ $.User.Name }}{{ else }}{{ .User.Id }}{{ end }}", want: []string{"User.Name", "User.Id"}},
+		{raw: "{{ with .User }}{{ .Email }}{{ end }}", want: []string{"User", "Email"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			parsed, err := New(ctx, tt.raw)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, parsed.Fields())
+		})
+	}
+}
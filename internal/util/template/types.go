@@ -9,6 +9,7 @@ package template
 type Data struct {
 	User    User
 	Account Account
+	Target  Target
 }
 
 // User contains user information. FullName and Email are not always populated
@@ -26,11 +27,21 @@ type User struct {
 }
 
 // Account contains account information. Not all fields will always be
-// populated; it depends on the type of account.
+// populated; it depends on the type of account. Attributes contains the custom
+// entry attributes of an LDAP account, as mapped by the auth method's account
+// attribute maps.
 type Account struct {
-	Id        *string
-	Name      *string
-	LoginName *string
-	Subject   *string
-	Email     *string
+	Id         *string
+	Name       *string
+	LoginName  *string
+	Subject    *string
+	Email      *string
+	Attributes map[string]any
+}
+
+// Target contains information about the target a session is being authorized
+// to. It is only populated when templating data for a session.
+type Target struct {
+	Id   *string
+	Name *string
 }
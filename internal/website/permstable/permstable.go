@@ -382,6 +382,13 @@ var authMethod = &Resource{
 						"id=<id>;actions=authenticate",
 					},
 				},
+				&Action{
+					Name:        "clear-deletion-protection",
+					Description: "Remove the deletion protection of an auth method so that it can be deleted, in addition to the update action",
+					Examples: []string{
+						"id=<id>;actions=update,clear-deletion-protection",
+					},
+				},
 			),
 		},
 	},
@@ -677,7 +684,16 @@ var scope = &Resource{
 				"ID":   "<id>",
 				"Type": "scope",
 			},
-			Actions: rudActions("a scope", false),
+			Actions: append(
+				rudActions("a scope", false),
+				&Action{
+					Name:        "clear-deletion-protection",
+					Description: "Remove the deletion protection of a scope so that it can be deleted, in addition to the update action",
+					Examples: []string{
+						"id=<id>;actions=update,clear-deletion-protection",
+					},
+				},
+			),
 		},
 	},
 }
@@ -694,7 +710,7 @@ var session = &Resource{
 			Actions: []*Action{
 				{
 					Name:        "list",
-					Description: "List sessions",
+					Description: "List sessions, and the history of the terminated sessions of the calling user",
 					Examples: []string{
 						"type=<type>;actions=list",
 					},
@@ -710,7 +726,7 @@ var session = &Resource{
 			Actions: []*Action{
 				{
 					Name:        "read",
-					Description: "Read a session",
+					Description: "Read a session, and the transcript uploaded by its client",
 					Examples: []string{
 						"id=<id>;actions=read",
 					},
@@ -724,7 +740,7 @@ var session = &Resource{
 				},
 				{
 					Name:        "read:self",
-					Description: "Read a session, which must be associated with the calling user",
+					Description: "Read a session, or upload the transcript captured by its client, which must be associated with the calling user",
 					Examples: []string{
 						"id=*;type=session;actions=read:self",
 					},
@@ -809,6 +825,48 @@ var target = &Resource{
 						"id=<id>;actions=authorize-session",
 					},
 				},
+				&Action{
+					Name:        "list-available-hosts",
+					Description: "List the hosts a session can currently be authorized against via the target",
+					Examples: []string{
+						"id=<id>;actions=list-available-hosts",
+					},
+				},
+				&Action{
+					Name:        "exceed-connection-limit",
+					Description: "Authorize sessions via the target which are not limited by the session connection limit of the target",
+					Examples: []string{
+						"id=<id>;actions=authorize-session,exceed-connection-limit",
+					},
+				},
+				&Action{
+					Name:        "clear-deletion-protection",
+					Description: "Remove the deletion protection of a target so that it can be deleted, in addition to the update action",
+					Examples: []string{
+						"id=<id>;actions=update,clear-deletion-protection",
+					},
+				},
+				&Action{
+					Name:        "enable-ssh-certificate-authority",
+					Description: "Enable the SSH certificate authority of a target, which issues a client certificate for each session",
+					Examples: []string{
+						"id=<id>;actions=enable-ssh-certificate-authority",
+					},
+				},
+				&Action{
+					Name:        "disable-ssh-certificate-authority",
+					Description: "Disable the SSH certificate authority of a target",
+					Examples: []string{
+						"id=<id>;actions=disable-ssh-certificate-authority",
+					},
+				},
+				&Action{
+					Name:        "sign-ssh-host-key",
+					Description: "Sign the host key of an endpoint of a target with the SSH certificate authority of the target",
+					Examples: []string{
+						"id=<id>;actions=sign-ssh-host-key",
+					},
+				},
 			),
 		},
 	},
@@ -886,6 +944,13 @@ var worker = &Resource{
 						"type=<type>;actions=create:worker-led",
 					},
 				},
+				&Action{
+					Name:        "test-worker-filter",
+					Description: "List the connected workers matching a worker filter",
+					Examples: []string{
+						"type=<type>;actions=test-worker-filter",
+					},
+				},
 			),
 		},
 		{
@@ -59,9 +59,9 @@ type HostSet struct {
 	// Output only. A list of Hosts in this Host Set.
 	HostIds []string `protobuf:"bytes,100,rep,name=host_ids,proto3" json:"host_ids,omitempty" class:"public"` // @gotags: `class:"public"`
 	// multiple possible endpoints for a host. Preferences are specified by
-	// "cidr:<valid IPv4/6 CIDR>" or "dns:<globbed name>", specifying which IP
-	// address or DNS name out of a host's available possibilities should be
-	// preferred. If no preferences are specified, a value will be chosen from
+	// "cidr:<valid IPv4/6 CIDR>", "dns:<globbed name>" or "family:<ipv4|ipv6>",
+	// specifying which IP address or DNS name out of a host's available
+	// possibilities should be preferred. If no preferences are specified, a value will be chosen from
 	// among all avialable values using a built-in priority order. May not be
 	// valid for all plugin types.
 	PreferredEndpoints []string `protobuf:"bytes,101,rep,name=preferred_endpoints,proto3" json:"preferred_endpoints,omitempty" class:"public"` // @gotags: `class:"public"`
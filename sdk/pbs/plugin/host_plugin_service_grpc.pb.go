@@ -51,6 +51,12 @@ type HostPluginServiceClient interface {
 	OnDeleteSet(ctx context.Context, in *OnDeleteSetRequest, opts ...grpc.CallOption) (*OnDeleteSetResponse, error)
 	// ListHosts looks up all the hosts in the provided host sets.
 	ListHosts(ctx context.Context, in *ListHostsRequest, opts ...grpc.CallOption) (*ListHostsResponse, error)
+	// ResolveHostPort is called when a session is authorized to a host of
+	// the plugin, and allows the plugin to look up the port to connect to on
+	// platforms where it is assigned dynamically. The port is fixed for the
+	// lifetime of the session. Plugins that do not implement it, or return a
+	// port of 0, use the default port of the target.
+	ResolveHostPort(ctx context.Context, in *ResolveHostPortRequest, opts ...grpc.CallOption) (*ResolveHostPortResponse, error)
 }
 
 type hostPluginServiceClient struct {
@@ -142,6 +148,15 @@ func (c *hostPluginServiceClient) ListHosts(ctx context.Context, in *ListHostsRe
 	return out, nil
 }
 
+func (c *hostPluginServiceClient) ResolveHostPort(ctx context.Context, in *ResolveHostPortRequest, opts ...grpc.CallOption) (*ResolveHostPortResponse, error) {
+	out := new(ResolveHostPortResponse)
+	err := c.cc.Invoke(ctx, "/plugin.v1.HostPluginService/ResolveHostPort", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HostPluginServiceServer is the server API for HostPluginService service.
 // All implementations must embed UnimplementedHostPluginServiceServer
 // for forward compatibility
@@ -179,6 +194,12 @@ type HostPluginServiceServer interface {
 	OnDeleteSet(context.Context, *OnDeleteSetRequest) (*OnDeleteSetResponse, error)
 	// ListHosts looks up all the hosts in the provided host sets.
 	ListHosts(context.Context, *ListHostsRequest) (*ListHostsResponse, error)
+	// ResolveHostPort is called when a session is authorized to a host of
+	// the plugin, and allows the plugin to look up the port to connect to on
+	// platforms where it is assigned dynamically. The port is fixed for the
+	// lifetime of the session. Plugins that do not implement it, or return a
+	// port of 0, use the default port of the target.
+	ResolveHostPort(context.Context, *ResolveHostPortRequest) (*ResolveHostPortResponse, error)
 	mustEmbedUnimplementedHostPluginServiceServer()
 }
 
@@ -213,6 +234,9 @@ func (UnimplementedHostPluginServiceServer) OnDeleteSet(context.Context, *OnDele
 func (UnimplementedHostPluginServiceServer) ListHosts(context.Context, *ListHostsRequest) (*ListHostsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListHosts not implemented")
 }
+func (UnimplementedHostPluginServiceServer) ResolveHostPort(context.Context, *ResolveHostPortRequest) (*ResolveHostPortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveHostPort not implemented")
+}
 func (UnimplementedHostPluginServiceServer) mustEmbedUnimplementedHostPluginServiceServer() {}
 
 // UnsafeHostPluginServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -388,6 +412,24 @@ func _HostPluginService_ListHosts_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HostPluginService_ResolveHostPort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveHostPortRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostPluginServiceServer).ResolveHostPort(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/plugin.v1.HostPluginService/ResolveHostPort",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostPluginServiceServer).ResolveHostPort(ctx, req.(*ResolveHostPortRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HostPluginService_ServiceDesc is the grpc.ServiceDesc for HostPluginService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -431,6 +473,10 @@ var HostPluginService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListHosts",
 			Handler:    _HostPluginService_ListHosts_Handler,
 		},
+		{
+			MethodName: "ResolveHostPort",
+			Handler:    _HostPluginService_ResolveHostPort_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "plugin/v1/host_plugin_service.proto",
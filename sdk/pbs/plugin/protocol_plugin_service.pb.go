@@ -0,0 +1,659 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: plugin/v1/protocol_plugin_service.proto
+
+package plugin
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetProtocolsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetProtocolsRequest) Reset() {
+	*x = GetProtocolsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_v1_protocol_plugin_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProtocolsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProtocolsRequest) ProtoMessage() {}
+
+func (x *GetProtocolsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_v1_protocol_plugin_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProtocolsRequest.ProtoReflect.Descriptor instead.
+func (*GetProtocolsRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_v1_protocol_plugin_service_proto_rawDescGZIP(), []int{0}
+}
+
+type GetProtocolsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The protocols handled by the plugin.
+	Protocols []string `protobuf:"bytes,10,rep,name=protocols,proto3" json:"protocols,omitempty"`
+}
+
+func (x *GetProtocolsResponse) Reset() {
+	*x = GetProtocolsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_v1_protocol_plugin_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProtocolsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProtocolsResponse) ProtoMessage() {}
+
+func (x *GetProtocolsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_v1_protocol_plugin_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProtocolsResponse.ProtoReflect.Descriptor instead.
+func (*GetProtocolsResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_v1_protocol_plugin_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetProtocolsResponse) GetProtocols() []string {
+	if x != nil {
+		return x.Protocols
+	}
+	return nil
+}
+
+type ProxyStart struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the session the connection belongs to.
+	SessionId string `protobuf:"bytes,10,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// The id of the connection being proxied.
+	ConnectionId string `protobuf:"bytes,20,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// The endpoint of the session.
+	Endpoint string `protobuf:"bytes,30,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// The protocol context returned by the controller when authorizing the
+	// connection, passed through unchanged.
+	ProtocolContext *anypb.Any `protobuf:"bytes,40,opt,name=protocol_context,json=protocolContext,proto3" json:"protocol_context,omitempty"`
+}
+
+func (x *ProxyStart) Reset() {
+	*x = ProxyStart{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_v1_protocol_plugin_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProxyStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyStart) ProtoMessage() {}
+
+func (x *ProxyStart) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_v1_protocol_plugin_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyStart.ProtoReflect.Descriptor instead.
+func (*ProxyStart) Descriptor() ([]byte, []int) {
+	return file_plugin_v1_protocol_plugin_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProxyStart) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ProxyStart) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *ProxyStart) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *ProxyStart) GetProtocolContext() *anypb.Any {
+	if x != nil {
+		return x.ProtocolContext
+	}
+	return nil
+}
+
+type ProxyClose struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// An optional reason the stream is being closed.
+	Reason string `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ProxyClose) Reset() {
+	*x = ProxyClose{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_v1_protocol_plugin_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProxyClose) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyClose) ProtoMessage() {}
+
+func (x *ProxyClose) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_v1_protocol_plugin_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyClose.ProtoReflect.Descriptor instead.
+func (*ProxyClose) Descriptor() ([]byte, []int) {
+	return file_plugin_v1_protocol_plugin_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProxyClose) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ProxyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Request:
+	//
+	//	*ProxyRequest_Start
+	//	*ProxyRequest_ClientData
+	//	*ProxyRequest_EndpointData
+	//	*ProxyRequest_Close
+	Request isProxyRequest_Request `protobuf_oneof:"request"`
+}
+
+func (x *ProxyRequest) Reset() {
+	*x = ProxyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_v1_protocol_plugin_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProxyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyRequest) ProtoMessage() {}
+
+func (x *ProxyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_v1_protocol_plugin_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyRequest.ProtoReflect.Descriptor instead.
+func (*ProxyRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_v1_protocol_plugin_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (m *ProxyRequest) GetRequest() isProxyRequest_Request {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (x *ProxyRequest) GetStart() *ProxyStart {
+	if x, ok := x.GetRequest().(*ProxyRequest_Start); ok {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *ProxyRequest) GetClientData() []byte {
+	if x, ok := x.GetRequest().(*ProxyRequest_ClientData); ok {
+		return x.ClientData
+	}
+	return nil
+}
+
+func (x *ProxyRequest) GetEndpointData() []byte {
+	if x, ok := x.GetRequest().(*ProxyRequest_EndpointData); ok {
+		return x.EndpointData
+	}
+	return nil
+}
+
+func (x *ProxyRequest) GetClose() *ProxyClose {
+	if x, ok := x.GetRequest().(*ProxyRequest_Close); ok {
+		return x.Close
+	}
+	return nil
+}
+
+type isProxyRequest_Request interface {
+	isProxyRequest_Request()
+}
+
+type ProxyRequest_Start struct {
+	// Starts proxying a connection.
+	Start *ProxyStart `protobuf:"bytes,10,opt,name=start,proto3,oneof"`
+}
+
+type ProxyRequest_ClientData struct {
+	// Data read from the client connection.
+	ClientData []byte `protobuf:"bytes,20,opt,name=client_data,json=clientData,proto3,oneof"`
+}
+
+type ProxyRequest_EndpointData struct {
+	// Data read from the endpoint connection.
+	EndpointData []byte `protobuf:"bytes,30,opt,name=endpoint_data,json=endpointData,proto3,oneof"`
+}
+
+type ProxyRequest_Close struct {
+	// Sent when either connection is closed by the peer.
+	Close *ProxyClose `protobuf:"bytes,40,opt,name=close,proto3,oneof"`
+}
+
+func (*ProxyRequest_Start) isProxyRequest_Request() {}
+
+func (*ProxyRequest_ClientData) isProxyRequest_Request() {}
+
+func (*ProxyRequest_EndpointData) isProxyRequest_Request() {}
+
+func (*ProxyRequest_Close) isProxyRequest_Request() {}
+
+type ProxyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*ProxyResponse_ClientData
+	//	*ProxyResponse_EndpointData
+	//	*ProxyResponse_Close
+	Response isProxyResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *ProxyResponse) Reset() {
+	*x = ProxyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_v1_protocol_plugin_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProxyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyResponse) ProtoMessage() {}
+
+func (x *ProxyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_v1_protocol_plugin_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyResponse.ProtoReflect.Descriptor instead.
+func (*ProxyResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_v1_protocol_plugin_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (m *ProxyResponse) GetResponse() isProxyResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *ProxyResponse) GetClientData() []byte {
+	if x, ok := x.GetResponse().(*ProxyResponse_ClientData); ok {
+		return x.ClientData
+	}
+	return nil
+}
+
+func (x *ProxyResponse) GetEndpointData() []byte {
+	if x, ok := x.GetResponse().(*ProxyResponse_EndpointData); ok {
+		return x.EndpointData
+	}
+	return nil
+}
+
+func (x *ProxyResponse) GetClose() *ProxyClose {
+	if x, ok := x.GetResponse().(*ProxyResponse_Close); ok {
+		return x.Close
+	}
+	return nil
+}
+
+type isProxyResponse_Response interface {
+	isProxyResponse_Response()
+}
+
+type ProxyResponse_ClientData struct {
+	// Data to write to the client connection.
+	ClientData []byte `protobuf:"bytes,10,opt,name=client_data,json=clientData,proto3,oneof"`
+}
+
+type ProxyResponse_EndpointData struct {
+	// Data to write to the endpoint connection.
+	EndpointData []byte `protobuf:"bytes,20,opt,name=endpoint_data,json=endpointData,proto3,oneof"`
+}
+
+type ProxyResponse_Close struct {
+	// Closes both connections.
+	Close *ProxyClose `protobuf:"bytes,30,opt,name=close,proto3,oneof"`
+}
+
+func (*ProxyResponse_ClientData) isProxyResponse_Response() {}
+
+func (*ProxyResponse_EndpointData) isProxyResponse_Response() {}
+
+func (*ProxyResponse_Close) isProxyResponse_Response() {}
+
+var File_plugin_v1_protocol_plugin_service_proto protoreflect.FileDescriptor
+
+var file_plugin_v1_protocol_plugin_service_proto_rawDesc = []byte{
+	0x0a, 0x27, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x34, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x22, 0xad, 0x01, 0x0a,
+	0x0a, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x10, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x28, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x24, 0x0a, 0x0a,
+	0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x22, 0xc1, 0x01, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x6f, 0x78, 0x79, 0x53, 0x74, 0x61, 0x72, 0x74, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x21, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x0d, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0c,
+	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2d, 0x0a, 0x05,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x94, 0x01, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x78, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52,
+	0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x0d, 0x65,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x0c, 0x48, 0x00, 0x52, 0x0c, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x2d, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x78, 0x79, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x48, 0x00, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x73,
+	0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xa8, 0x01,
+	0x0a, 0x15, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4f, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x05, 0x50, 0x72, 0x6f, 0x78,
+	0x79, 0x12, 0x17, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x78, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
+	0x2f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x2f, 0x73, 0x64, 0x6b, 0x2f, 0x70, 0x62,
+	0x73, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x3b, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_plugin_v1_protocol_plugin_service_proto_rawDescOnce sync.Once
+	file_plugin_v1_protocol_plugin_service_proto_rawDescData = file_plugin_v1_protocol_plugin_service_proto_rawDesc
+)
+
+func file_plugin_v1_protocol_plugin_service_proto_rawDescGZIP() []byte {
+	file_plugin_v1_protocol_plugin_service_proto_rawDescOnce.Do(func() {
+		file_plugin_v1_protocol_plugin_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_plugin_v1_protocol_plugin_service_proto_rawDescData)
+	})
+	return file_plugin_v1_protocol_plugin_service_proto_rawDescData
+}
+
+var file_plugin_v1_protocol_plugin_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_plugin_v1_protocol_plugin_service_proto_goTypes = []interface{}{
+	(*GetProtocolsRequest)(nil),  // 0: plugin.v1.GetProtocolsRequest
+	(*GetProtocolsResponse)(nil), // 1: plugin.v1.GetProtocolsResponse
+	(*ProxyStart)(nil),           // 2: plugin.v1.ProxyStart
+	(*ProxyClose)(nil),           // 3: plugin.v1.ProxyClose
+	(*ProxyRequest)(nil),         // 4: plugin.v1.ProxyRequest
+	(*ProxyResponse)(nil),        // 5: plugin.v1.ProxyResponse
+	(*anypb.Any)(nil),            // 6: google.protobuf.Any
+}
+var file_plugin_v1_protocol_plugin_service_proto_depIdxs = []int32{
+	6, // 0: plugin.v1.ProxyStart.protocol_context:type_name -> google.protobuf.Any
+	2, // 1: plugin.v1.ProxyRequest.start:type_name -> plugin.v1.ProxyStart
+	3, // 2: plugin.v1.ProxyRequest.close:type_name -> plugin.v1.ProxyClose
+	3, // 3: plugin.v1.ProxyResponse.close:type_name -> plugin.v1.ProxyClose
+	0, // 4: plugin.v1.ProtocolPluginService.GetProtocols:input_type -> plugin.v1.GetProtocolsRequest
+	4, // 5: plugin.v1.ProtocolPluginService.Proxy:input_type -> plugin.v1.ProxyRequest
+	1, // 6: plugin.v1.ProtocolPluginService.GetProtocols:output_type -> plugin.v1.GetProtocolsResponse
+	5, // 7: plugin.v1.ProtocolPluginService.Proxy:output_type -> plugin.v1.ProxyResponse
+	6, // [6:8] is the sub-list for method output_type
+	4, // [4:6] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_plugin_v1_protocol_plugin_service_proto_init() }
+func file_plugin_v1_protocol_plugin_service_proto_init() {
+	if File_plugin_v1_protocol_plugin_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_plugin_v1_protocol_plugin_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProtocolsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_v1_protocol_plugin_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProtocolsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_v1_protocol_plugin_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProxyStart); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_v1_protocol_plugin_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProxyClose); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_v1_protocol_plugin_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProxyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_v1_protocol_plugin_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProxyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_plugin_v1_protocol_plugin_service_proto_msgTypes[4].OneofWrappers = []interface{}{
+		(*ProxyRequest_Start)(nil),
+		(*ProxyRequest_ClientData)(nil),
+		(*ProxyRequest_EndpointData)(nil),
+		(*ProxyRequest_Close)(nil),
+	}
+	file_plugin_v1_protocol_plugin_service_proto_msgTypes[5].OneofWrappers = []interface{}{
+		(*ProxyResponse_ClientData)(nil),
+		(*ProxyResponse_EndpointData)(nil),
+		(*ProxyResponse_Close)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_plugin_v1_protocol_plugin_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_plugin_v1_protocol_plugin_service_proto_goTypes,
+		DependencyIndexes: file_plugin_v1_protocol_plugin_service_proto_depIdxs,
+		MessageInfos:      file_plugin_v1_protocol_plugin_service_proto_msgTypes,
+	}.Build()
+	File_plugin_v1_protocol_plugin_service_proto = out.File
+	file_plugin_v1_protocol_plugin_service_proto_rawDesc = nil
+	file_plugin_v1_protocol_plugin_service_proto_goTypes = nil
+	file_plugin_v1_protocol_plugin_service_proto_depIdxs = nil
+}
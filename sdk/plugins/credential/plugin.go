@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package external_credential_plugins
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"syscall"
+
+	pb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+)
+
+const (
+	credentialServicePluginSetName = "credential-plugin"
+
+	// CredentialPluginPrefix is the file name prefix of credential plugin
+	// binaries; the remainder of the file name is the plugin's name.
+	CredentialPluginPrefix = "boundary-plugin-credential-"
+)
+
+// HandshakeConfig is a shared config that can be used regardless of plugin, to
+// avoid having to know type-specific things about each plugin
+var HandshakeConfig = plugin.HandshakeConfig{
+	MagicCookieKey:   "HASHICORP_BOUNDARY_CREDENTIAL_PLUGIN",
+	MagicCookieValue: credentialServicePluginSetName,
+}
+
+// ServeCredentialPlugin is a generic function to start serving a credential plugin
+// service as a plugin
+func ServeCredentialPlugin(svc pb.CredentialPluginServiceServer, opt ...Option) error {
+	opts, err := getOpts(opt...)
+	if err != nil {
+		return err
+	}
+
+	signalCh := make(chan os.Signal, 1)
+	signal.Notify(signalCh, syscall.SIGHUP)
+	go func() {
+		for {
+			<-signalCh
+		}
+	}()
+
+	credentialServiceServer, err := NewCredentialPluginServiceServer(svc)
+	if err != nil {
+		return err
+	}
+	plugin.Serve(&plugin.ServeConfig{
+		HandshakeConfig: HandshakeConfig,
+		VersionedPlugins: map[int]plugin.PluginSet{
+			1: {credentialServicePluginSetName: credentialServiceServer},
+		},
+		Logger:     opts.withLogger,
+		GRPCServer: plugin.DefaultGRPCServer,
+	})
+	return nil
+}
+
+type credentialPlugin struct {
+	plugin.Plugin
+
+	impl pb.CredentialPluginServiceServer
+}
+
+func NewCredentialPluginServiceServer(impl pb.CredentialPluginServiceServer) (*credentialPlugin, error) {
+	if impl == nil {
+		return nil, fmt.Errorf("empty underlying credential plugin passed in")
+	}
+	return &credentialPlugin{
+		impl: impl,
+	}, nil
+}
+
+func NewCredentialPluginClient(pluginPath string, opt ...Option) (*plugin.Client, error) {
+	opts, err := getOpts(opt...)
+	if err != nil {
+		return nil, err
+	}
+	credentialServiceClient := &credentialPlugin{}
+
+	return plugin.NewClient(&plugin.ClientConfig{
+		HandshakeConfig: HandshakeConfig,
+		VersionedPlugins: map[int]plugin.PluginSet{
+			1: {credentialServicePluginSetName: credentialServiceClient},
+		},
+		Cmd: exec.Command(pluginPath),
+		AllowedProtocols: []plugin.Protocol{
+			plugin.ProtocolGRPC,
+		},
+		Logger:   opts.withLogger,
+		AutoMTLS: true,
+	}), nil
+}
+
+func (h *credentialPlugin) GRPCServer(broker *plugin.GRPCBroker, s *grpc.Server) error {
+	pb.RegisterCredentialPluginServiceServer(s, h.impl)
+	return nil
+}
+
+func (h *credentialPlugin) GRPCClient(ctx context.Context, broker *plugin.GRPCBroker, c *grpc.ClientConn) (any, error) {
+	return pb.NewCredentialPluginServiceClient(c), nil
+}
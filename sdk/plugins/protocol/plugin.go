@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package external_protocol_plugins
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"syscall"
+
+	pb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+)
+
+const (
+	protocolServicePluginSetName = "protocol-plugin"
+
+	// ProtocolPluginPrefix is the file name prefix of protocol plugin
+	// binaries; the remainder of the file name is the plugin's name.
+	ProtocolPluginPrefix = "boundary-plugin-protocol-"
+)
+
+// HandshakeConfig is a shared config that can be used regardless of plugin, to
+// avoid having to know type-specific things about each plugin
+var HandshakeConfig = plugin.HandshakeConfig{
+	MagicCookieKey:   "HASHICORP_BOUNDARY_PROTOCOL_PLUGIN",
+	MagicCookieValue: protocolServicePluginSetName,
+}
+
+// ServeProtocolPlugin is a generic function to start serving a protocol plugin
+// service as a plugin
+func ServeProtocolPlugin(svc pb.ProtocolPluginServiceServer, opt ...Option) error {
+	opts, err := getOpts(opt...)
+	if err != nil {
+		return err
+	}
+
+	signalCh := make(chan os.Signal, 1)
+	signal.Notify(signalCh, syscall.SIGHUP)
+	go func() {
+		for {
+			<-signalCh
+		}
+	}()
+
+	protocolServiceServer, err := NewProtocolPluginServiceServer(svc)
+	if err != nil {
+		return err
+	}
+	plugin.Serve(&plugin.ServeConfig{
+		HandshakeConfig: HandshakeConfig,
+		VersionedPlugins: map[int]plugin.PluginSet{
+			1: {protocolServicePluginSetName: protocolServiceServer},
+		},
+		Logger:     opts.withLogger,
+		GRPCServer: plugin.DefaultGRPCServer,
+	})
+	return nil
+}
+
+type protocolPlugin struct {
+	plugin.Plugin
+
+	impl pb.ProtocolPluginServiceServer
+}
+
+func NewProtocolPluginServiceServer(impl pb.ProtocolPluginServiceServer) (*protocolPlugin, error) {
+	if impl == nil {
+		return nil, fmt.Errorf("empty underlying protocol plugin passed in")
+	}
+	return &protocolPlugin{
+		impl: impl,
+	}, nil
+}
+
+func NewProtocolPluginClient(pluginPath string, opt ...Option) (*plugin.Client, error) {
+	opts, err := getOpts(opt...)
+	if err != nil {
+		return nil, err
+	}
+	protocolServiceClient := &protocolPlugin{}
+
+	return plugin.NewClient(&plugin.ClientConfig{
+		HandshakeConfig: HandshakeConfig,
+		VersionedPlugins: map[int]plugin.PluginSet{
+			1: {protocolServicePluginSetName: protocolServiceClient},
+		},
+		Cmd: exec.Command(pluginPath),
+		AllowedProtocols: []plugin.Protocol{
+			plugin.ProtocolGRPC,
+		},
+		Logger:   opts.withLogger,
+		AutoMTLS: true,
+	}), nil
+}
+
+func (h *protocolPlugin) GRPCServer(broker *plugin.GRPCBroker, s *grpc.Server) error {
+	pb.RegisterProtocolPluginServiceServer(s, h.impl)
+	return nil
+}
+
+func (h *protocolPlugin) GRPCClient(ctx context.Context, broker *plugin.GRPCBroker, c *grpc.ClientConn) (any, error) {
+	return pb.NewProtocolPluginServiceClient(c), nil
+}
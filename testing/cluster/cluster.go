@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cluster
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/internal/daemon/worker"
+	"github.com/hashicorp/boundary/testing/controller"
+	"github.com/hashicorp/go-hclog"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+)
+
+type option struct {
+	controllerOpts       []controller.Option
+	workerCount          int
+	workerAuthStorageKms wrapping.Wrapper
+	logger               hclog.Logger
+}
+
+type Option func(*option) error
+
+func getOpts(opt ...Option) (*option, error) {
+	opts := &option{
+		workerCount: 1,
+	}
+	for _, o := range opt {
+		if err := o(opts); err != nil {
+			return nil, err
+		}
+	}
+	return opts, nil
+}
+
+// WithControllerOptions provides options to the TestController of the
+// cluster, for example to configure its root, worker auth and recovery KMS or
+// the database it uses. The worker auth KMS of the controller is also used by
+// the workers of the cluster.
+func WithControllerOptions(opt ...controller.Option) Option {
+	return func(c *option) error {
+		c.controllerOpts = append(c.controllerOpts, opt...)
+		return nil
+	}
+}
+
+// WithWorkerCount sets the number of workers started with the cluster. It
+// defaults to 1; 0 starts a cluster without workers.
+func WithWorkerCount(n int) Option {
+	return func(c *option) error {
+		if n < 0 {
+			return fmt.Errorf("Worker count cannot be negative.")
+		}
+		c.workerCount = n
+		return nil
+	}
+}
+
+// WithWorkerAuthStorageKms sets the KMS encrypting the worker auth storage of
+// the workers of the cluster.
+func WithWorkerAuthStorageKms(wrapper wrapping.Wrapper) Option {
+	return func(c *option) error {
+		c.workerAuthStorageKms = wrapper
+		return nil
+	}
+}
+
+// WithLogger sets the logger of the workers of the cluster. Each worker logs
+// with a named sub-logger.
+func WithLogger(logger hclog.Logger) Option {
+	return func(c *option) error {
+		c.logger = logger
+		return nil
+	}
+}
+
+// TestCluster is a controller, its database and workers running in the test
+// process, usable to test session proxying end to end.
+type TestCluster struct {
+	Controller *controller.TestController
+	Workers    []*worker.TestWorker
+
+	opts *option
+}
+
+// NewTestCluster blocks until a new TestCluster is created and all its workers
+// have reported their status to the controller. The controller and workers
+// are shut down when the test completes.
+func NewTestCluster(t *testing.T, opt ...Option) *TestCluster {
+	opts, err := getOpts(opt...)
+	if err != nil {
+		t.Fatalf("Couldn't create TestCluster: %v", err)
+	}
+	if opts.logger == nil {
+		opts.logger = hclog.New(&hclog.LoggerOptions{
+			Name:  t.Name(),
+			Level: hclog.Error,
+		})
+	}
+	tc := controller.NewTestController(t, opts.controllerOpts...)
+	t.Cleanup(tc.Shutdown)
+
+	c := &TestCluster{
+		Controller: tc,
+		opts:       opts,
+	}
+	for i := 0; i < opts.workerCount; i++ {
+		c.AddWorker(t)
+	}
+	return c
+}
+
+// AddWorker starts a new worker connected to the controller of the cluster,
+// and blocks until it has reported its status to the controller.
+func (c *TestCluster) AddWorker(t *testing.T) *worker.TestWorker {
+	w := worker.NewTestWorker(t, &worker.TestWorkerOpts{
+		WorkerAuthKms:        c.Controller.Config().WorkerAuthKms,
+		WorkerAuthStorageKms: c.opts.workerAuthStorageKms,
+		InitialUpstreams:     c.Controller.ClusterAddrs(),
+		Logger:               c.opts.logger.Named(fmt.Sprintf("w%d", len(c.Workers)+1)),
+	})
+	t.Cleanup(w.Shutdown)
+
+	if err := w.Worker().WaitForNextSuccessfulStatusUpdate(); err != nil {
+		t.Fatalf("Worker %s couldn't report its status: %v", w.Name(), err)
+	}
+	if err := c.Controller.WaitForNextWorkerStatusUpdate(w.Name()); err != nil {
+		t.Fatalf("Controller didn't receive the status of worker %s: %v", w.Name(), err)
+	}
+	c.Workers = append(c.Workers, w)
+	return w
+}
+
+// Client returns an api client for the controller of the cluster,
+// authenticated as the admin user.
+func (c *TestCluster) Client() *api.Client {
+	client := c.Controller.Client()
+	client.SetToken(c.Controller.Token().Token)
+	return client
+}
@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/boundary/internal/tests/helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTestCluster(t *testing.T) {
+	require, assert := require.New(t), assert.New(t)
+	ctx := context.Background()
+
+	c := NewTestCluster(t, WithWorkerCount(2))
+	require.Len(c.Workers, 2)
+	assert.NotEqual(c.Workers[0].Name(), c.Workers[1].Name())
+
+	tcl := targets.NewClient(c.Client())
+	tgts, err := tcl.List(ctx, "global", targets.WithRecursive(true))
+	require.NoError(err)
+	require.NotEmpty(tgts.Items)
+	tgt := tgts.Items[0]
+
+	ts := helper.NewTestTcpServer(t)
+	defer ts.Close()
+	_, err = tcl.Update(ctx, tgt.Id, tgt.Version, targets.WithTcpTargetDefaultPort(ts.Port()))
+	require.NoError(err)
+
+	sess := helper.NewTestSession(ctx, t, tcl, tgt.Id)
+	sConn := sess.Connect(ctx, t)
+	sConn.TestSendRecvAll(t)
+}
@@ -0,0 +1,5 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package cluster is a package meant for internal testing only.  The interfaces may change or be removed at any time without warning.
+package cluster
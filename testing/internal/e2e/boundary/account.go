@@ -46,7 +46,7 @@ func CreateNewAccountCli(t testing.TB, ctx context.Context, authMethodId string,
 			"-auth-method-id", authMethodId,
 			"-login-name", loginName,
 			"-password", "env://E2E_TEST_ACCOUNT_PASSWORD",
-			"-name", "e2e Account "+loginName,
+			"-name", ResourceName(t, "Account "+loginName),
 			"-description", "e2e Account",
 			"-format", "json",
 		),
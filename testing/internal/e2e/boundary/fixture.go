@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package boundary
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/authmethods"
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/stretchr/testify/require"
+)
+
+// The functions in this file create resources using the Go api and register a
+// cleanup function deleting them when the test completes, so tests can set up
+// their fixtures without parsing the output of `boundary` cli commands.
+
+// CreateOrg uses the Go api to create a new org in boundary. The org is deleted
+// when the test completes.
+// Returns the id of the new org.
+func CreateOrg(t testing.TB, ctx context.Context, client *api.Client) string {
+	scopeClient := scopes.NewClient(client)
+	newOrgId := CreateNewOrgApi(t, ctx, client)
+	cleanupDelete(t, "org", newOrgId, func(ctx context.Context) error {
+		_, err := scopeClient.Delete(ctx, newOrgId)
+		return err
+	})
+	return newOrgId
+}
+
+// CreateProject uses the Go api to create a new project under the provided org.
+// The project is deleted when the test completes.
+// Returns the id of the new project.
+func CreateProject(t testing.TB, ctx context.Context, client *api.Client, orgId string) string {
+	scopeClient := scopes.NewClient(client)
+	newProjectId := CreateNewProjectApi(t, ctx, client, orgId)
+	cleanupDelete(t, "project", newProjectId, func(ctx context.Context) error {
+		_, err := scopeClient.Delete(ctx, newProjectId)
+		return err
+	})
+	return newProjectId
+}
+
+// CreateTargetWithCreds uses the Go api to create a new tcp target in the
+// provided project, with the provided host source and brokered credential
+// sources. The target is deleted when the test completes.
+// Returns the id of the new target.
+func CreateTargetWithCreds(t testing.TB, ctx context.Context, client *api.Client, projectId string, defaultPort string, hostSourceId string, credentialSourceIds ...string) string {
+	tClient := targets.NewClient(client)
+	targetPort, err := strconv.ParseInt(defaultPort, 10, 32)
+	require.NoError(t, err)
+	newTargetResult, err := tClient.Create(ctx, "tcp", projectId,
+		targets.WithName(ResourceName(t, "Target")),
+		targets.WithTcpTargetDefaultPort(uint32(targetPort)),
+	)
+	require.NoError(t, err)
+	newTargetId := newTargetResult.Item.Id
+	t.Logf("Created Target: %s", newTargetId)
+	cleanupDelete(t, "target", newTargetId, func(ctx context.Context) error {
+		_, err := tClient.Delete(ctx, newTargetId)
+		return err
+	})
+
+	if hostSourceId != "" {
+		_, err = tClient.AddHostSources(ctx, newTargetId, 0,
+			[]string{hostSourceId},
+			targets.WithAutomaticVersioning(true),
+		)
+		require.NoError(t, err)
+	}
+	if len(credentialSourceIds) > 0 {
+		_, err = tClient.AddCredentialSources(ctx, newTargetId, 0,
+			targets.WithBrokeredCredentialSourceIds(credentialSourceIds),
+			targets.WithAutomaticVersioning(true),
+		)
+		require.NoError(t, err)
+	}
+
+	return newTargetId
+}
+
+// CreateLdapAuthMethod uses the Go api to create a new ldap auth method in the
+// provided scope, connecting to the provided ldap urls. Additional attributes
+// of the auth method, such as the user and group dns, can be set with opt. The
+// auth method is deleted when the test completes.
+// Returns the id of the new auth method.
+func CreateLdapAuthMethod(t testing.TB, ctx context.Context, client *api.Client, scopeId string, urls []string, opt ...authmethods.Option) string {
+	amClient := authmethods.NewClient(client)
+	opts := append([]authmethods.Option{
+		authmethods.WithName(ResourceName(t, "Auth Method")),
+		authmethods.WithLdapAuthMethodUrls(urls),
+	}, opt...)
+	newAuthMethodResult, err := amClient.Create(ctx, "ldap", scopeId, opts...)
+	require.NoError(t, err)
+	newAuthMethodId := newAuthMethodResult.Item.Id
+	t.Logf("Created Auth Method: %s", newAuthMethodId)
+	cleanupDelete(t, "auth method", newAuthMethodId, func(ctx context.Context) error {
+		_, err := amClient.Delete(ctx, newAuthMethodId)
+		return err
+	})
+
+	return newAuthMethodId
+}
+
+// cleanupDelete registers a cleanup function deleting a resource. A resource
+// that was already deleted, by the test or along with its parent scope, is
+// not an error.
+func cleanupDelete(t testing.TB, resource, id string, deleteFn func(context.Context) error) {
+	t.Cleanup(func() {
+		err := deleteFn(context.Background())
+		if errors.Is(err, api.ErrNotFound) {
+			return
+		}
+		require.NoError(t, err, "failed to delete %s %s", resource, id)
+	})
+}
@@ -23,7 +23,7 @@ import (
 // Returns the id of the new host catalog.
 func CreateNewHostCatalogApi(t testing.TB, ctx context.Context, client *api.Client, projectId string) string {
 	hcClient := hostcatalogs.NewClient(client)
-	newHostCatalogResult, err := hcClient.Create(ctx, "static", projectId, hostcatalogs.WithName("e2e Host Catalog"))
+	newHostCatalogResult, err := hcClient.Create(ctx, "static", projectId, hostcatalogs.WithName(ResourceName(t, "Host Catalog")))
 	require.NoError(t, err)
 	newHostCatalogId := newHostCatalogResult.Item.Id
 	t.Logf("Created Host Catalog: %s", newHostCatalogId)
@@ -35,7 +35,7 @@ func CreateNewHostCatalogApi(t testing.TB, ctx context.Context, client *api.Clie
 // Returns the id of the new host set.
 func CreateNewHostSetApi(t testing.TB, ctx context.Context, client *api.Client, hostCatalogId string) string {
 	hsClient := hostsets.NewClient(client)
-	newHostSetResult, err := hsClient.Create(ctx, hostCatalogId, hostsets.WithName("e2e Host"))
+	newHostSetResult, err := hsClient.Create(ctx, hostCatalogId, hostsets.WithName(ResourceName(t, "Host")))
 	require.NoError(t, err)
 	newHostSetId := newHostSetResult.Item.Id
 	t.Logf("Created Host Set: %s", newHostSetId)
@@ -72,7 +72,7 @@ func CreateNewHostCatalogCli(t testing.TB, ctx context.Context, projectId string
 		e2e.WithArgs(
 			"host-catalogs", "create", "static",
 			"-scope-id", projectId,
-			"-name", "e2e Host Catalog",
+			"-name", ResourceName(t, "Host Catalog"),
 			"-format", "json",
 		),
 	)
@@ -93,7 +93,7 @@ func CreateNewHostSetCli(t testing.TB, ctx context.Context, hostCatalogId string
 		e2e.WithArgs(
 			"host-sets", "create", "static",
 			"-host-catalog-id", hostCatalogId,
-			"-name", "e2e Host Set",
+			"-name", ResourceName(t, "Host Set"),
 			"-format", "json",
 		),
 	)
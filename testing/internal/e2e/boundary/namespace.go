@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package boundary
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var namespaces sync.Map
+
+// Namespace returns a prefix unique to the test, made of the test name and a
+// random suffix. Resources created by the functions in this package are named
+// within the namespace of the test creating them, so tests creating resources
+// with the same parent can run in parallel on the same cluster.
+func Namespace(t testing.TB) string {
+	if ns, ok := namespaces.Load(t); ok {
+		return ns.(string)
+	}
+	b := make([]byte, 4)
+	_, err := rand.Read(b)
+	require.NoError(t, err)
+	ns := fmt.Sprintf("%s-%s", strings.ReplaceAll(t.Name(), "/", "-"), hex.EncodeToString(b))
+	if actual, loaded := namespaces.LoadOrStore(t, ns); loaded {
+		return actual.(string)
+	}
+	t.Cleanup(func() { namespaces.Delete(t) })
+	return ns
+}
+
+// ResourceName returns the name of a resource created by the test, within the
+// test's namespace
+func ResourceName(t testing.TB, resource string) string {
+	return fmt.Sprintf("e2e %s %s", resource, Namespace(t))
+}
@@ -20,7 +20,7 @@ func CreateNewRoleCli(t testing.TB, ctx context.Context, scopeId string) string
 		e2e.WithArgs(
 			"roles", "create",
 			"-scope-id", scopeId,
-			"-name", "e2e Role",
+			"-name", ResourceName(t, "Role"),
 			"-format", "json",
 		),
 	)
@@ -18,7 +18,7 @@ import (
 // Returns the id of the new org.
 func CreateNewOrgApi(t testing.TB, ctx context.Context, client *api.Client) string {
 	scopeClient := scopes.NewClient(client)
-	newOrgResult, err := scopeClient.Create(ctx, "global", scopes.WithName("e2e Org"))
+	newOrgResult, err := scopeClient.Create(ctx, "global", scopes.WithName(ResourceName(t, "Org")))
 	require.NoError(t, err)
 
 	newOrgId := newOrgResult.Item.Id
@@ -31,7 +31,7 @@ func CreateNewOrgApi(t testing.TB, ctx context.Context, client *api.Client) stri
 // Returns the id of the new project.
 func CreateNewProjectApi(t testing.TB, ctx context.Context, client *api.Client, orgId string) string {
 	scopeClient := scopes.NewClient(client)
-	newProjResult, err := scopeClient.Create(ctx, orgId, scopes.WithName("e2e Project"))
+	newProjResult, err := scopeClient.Create(ctx, orgId, scopes.WithName(ResourceName(t, "Project")))
 	require.NoError(t, err)
 
 	newProjectId := newProjResult.Item.Id
@@ -45,7 +45,7 @@ func CreateNewOrgCli(t testing.TB, ctx context.Context) string {
 	output := e2e.RunCommand(ctx, "boundary",
 		e2e.WithArgs(
 			"scopes", "create",
-			"-name", "e2e Org",
+			"-name", ResourceName(t, "Org"),
 			"-scope-id", "global",
 			"-format", "json",
 		),
@@ -68,7 +68,7 @@ func CreateNewProjectCli(t testing.TB, ctx context.Context, orgId string) string
 	output := e2e.RunCommand(ctx, "boundary",
 		e2e.WithArgs(
 			"scopes", "create",
-			"-name", "e2e Project",
+			"-name", ResourceName(t, "Project"),
 			"-scope-id", orgId,
 			"-format", "json",
 		),
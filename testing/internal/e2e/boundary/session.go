@@ -12,16 +12,106 @@ import (
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/sessions"
 	"github.com/hashicorp/boundary/testing/internal/e2e"
 	"github.com/stretchr/testify/require"
 )
 
+// SessionOption is a func that sets optional attributes for the functions
+// waiting for sessions
+type SessionOption func(*sessionOptions)
+
+type sessionOptions struct {
+	withCount    int
+	withTargetId string
+	withUserId   string
+	withMatch    func(*sessions.Session) bool
+}
+
+func getSessionOpts(opt ...SessionOption) sessionOptions {
+	opts := sessionOptions{}
+	for _, o := range opt {
+		if o != nil {
+			o(&opts)
+		}
+	}
+	return opts
+}
+
+// WithSessionCount waits for exactly count matching sessions. Finding more
+// matching sessions is an error. By default, the wait ends when at least one
+// matching session is found.
+func WithSessionCount(count int) SessionOption {
+	return func(o *sessionOptions) {
+		o.withCount = count
+	}
+}
+
+// WithSessionTargetId only matches the sessions of the specified target
+func WithSessionTargetId(targetId string) SessionOption {
+	return func(o *sessionOptions) {
+		o.withTargetId = targetId
+	}
+}
+
+// WithSessionUserId only matches the sessions of the specified user
+func WithSessionUserId(userId string) SessionOption {
+	return func(o *sessionOptions) {
+		o.withUserId = userId
+	}
+}
+
+// WithSessionMatch only matches the sessions for which match returns true
+func WithSessionMatch(match func(*sessions.Session) bool) SessionOption {
+	return func(o *sessionOptions) {
+		o.withMatch = match
+	}
+}
+
+// matchSessions returns the sessions matching the options, or an error if
+// the sessions aren't all there yet
+func (o sessionOptions) matchSessions(t testing.TB, items []*sessions.Session) ([]*sessions.Session, error) {
+	var matched []*sessions.Session
+	for _, s := range items {
+		switch {
+		case o.withTargetId != "" && s.TargetId != o.withTargetId:
+		case o.withUserId != "" && s.UserId != o.withUserId:
+		case o.withMatch != nil && !o.withMatch(s):
+		default:
+			matched = append(matched, s)
+		}
+	}
+
+	sessionCount := len(matched)
+	if sessionCount == 0 {
+		return nil, errors.New("No matching items are appearing in the session list")
+	}
+	t.Logf("Found %d matching session(s)", sessionCount)
+	switch {
+	case o.withCount == 0:
+	case sessionCount < o.withCount:
+		return nil, fmt.Errorf("Waiting for sessions... Expected: %d, Actual: %d", o.withCount, sessionCount)
+	case sessionCount > o.withCount:
+		return nil, backoff.Permanent(fmt.Errorf("Expected %d session(s) to be found, but found %d", o.withCount, sessionCount))
+	}
+	return matched, nil
+}
+
 // WaitForSessionCli waits for a session to appear in the session list and returns the session
-// information
-func WaitForSessionCli(t testing.TB, ctx context.Context, projectId string) *sessions.Session {
-	t.Log("Waiting for session to appear...")
-	var session *sessions.Session
+// information. Exactly one session is expected to match; see WaitForSessionsCli.
+func WaitForSessionCli(t testing.TB, ctx context.Context, projectId string, opt ...SessionOption) *sessions.Session {
+	opt = append([]SessionOption{WithSessionCount(1)}, opt...)
+	return WaitForSessionsCli(t, ctx, projectId, opt...)[0]
+}
+
+// WaitForSessionsCli uses the cli to wait for the sessions matching the options
+// to appear in the session list of the project, and returns the sessions.
+// Terminated sessions are included.
+func WaitForSessionsCli(t testing.TB, ctx context.Context, projectId string, opt ...SessionOption) []*sessions.Session {
+	t.Log("Waiting for sessions to appear...")
+	opts := getSessionOpts(opt...)
+	var matched []*sessions.Session
 	err := backoff.RetryNotify(
 		func() error {
 			// List sessions
@@ -37,19 +127,36 @@ func WaitForSessionCli(t testing.TB, ctx context.Context, projectId string) *ses
 				return backoff.Permanent(err)
 			}
 
-			// Check if there is one session
-			sessionCount := len(sessionListResult.Items)
-			if sessionCount == 0 {
-				return errors.New("No items are appearing in the session list")
-			}
+			matched, err = opts.matchSessions(t, sessionListResult.Items)
+			return err
+		},
+		backoff.WithMaxRetries(backoff.NewConstantBackOff(3*time.Second), 5),
+		func(err error, td time.Duration) {
+			t.Logf("%s. Retrying...", err.Error())
+		},
+	)
+	require.NoError(t, err)
+
+	return matched
+}
 
-			t.Logf("Found %d session(s)", sessionCount)
-			if sessionCount != 1 {
-				return backoff.Permanent(errors.New("Only one session was expected to be found"))
+// WaitForSessionsApi uses the Go api to wait for the sessions matching the
+// options to appear in the session list of the project, and returns the
+// sessions. Terminated sessions are included.
+func WaitForSessionsApi(t testing.TB, ctx context.Context, client *api.Client, projectId string, opt ...SessionOption) []*sessions.Session {
+	t.Log("Waiting for sessions to appear...")
+	opts := getSessionOpts(opt...)
+	sClient := sessions.NewClient(client)
+	var matched []*sessions.Session
+	err := backoff.RetryNotify(
+		func() error {
+			sessionListResult, err := sClient.List(ctx, projectId, sessions.WithIncludeTerminated(true))
+			if err != nil {
+				return backoff.Permanent(err)
 			}
 
-			session = sessionListResult.Items[0]
-			return nil
+			matched, err = opts.matchSessions(t, sessionListResult.Items)
+			return err
 		},
 		backoff.WithMaxRetries(backoff.NewConstantBackOff(3*time.Second), 5),
 		func(err error, td time.Duration) {
@@ -58,7 +165,7 @@ func WaitForSessionCli(t testing.TB, ctx context.Context, projectId string) *ses
 	)
 	require.NoError(t, err)
 
-	return session
+	return matched
 }
 
 // WaitForSessionStatusCli reads the specified session and waits for its status to match the
@@ -22,7 +22,7 @@ func CreateNewTargetApi(t testing.TB, ctx context.Context, client *api.Client, p
 	targetPort, err := strconv.ParseInt(defaultPort, 10, 32)
 	require.NoError(t, err)
 	newTargetResult, err := tClient.Create(ctx, "tcp", projectId,
-		targets.WithName("e2e Target"),
+		targets.WithName(ResourceName(t, "Target")),
 		targets.WithTcpTargetDefaultPort(uint32(targetPort)),
 	)
 	require.NoError(t, err)
@@ -50,7 +50,7 @@ func CreateNewTargetCli(t testing.TB, ctx context.Context, projectId string, def
 			"targets", "create", "tcp",
 			"-scope-id", projectId,
 			"-default-port", defaultPort,
-			"-name", "e2e Target",
+			"-name", ResourceName(t, "Target"),
 			"-format", "json",
 		),
 	)
@@ -73,7 +73,7 @@ func CreateNewAddressTargetCli(t testing.TB, ctx context.Context, projectId stri
 			"targets", "create", "tcp",
 			"-scope-id", projectId,
 			"-default-port", defaultPort,
-			"-name", "e2e Target",
+			"-name", ResourceName(t, "Target"),
 			"-address", address,
 			"-format", "json",
 		),
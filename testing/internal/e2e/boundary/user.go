@@ -33,7 +33,7 @@ func CreateNewUserCli(t testing.TB, ctx context.Context, scopeId string) string
 		e2e.WithArgs(
 			"users", "create",
 			"-scope-id", scopeId,
-			"-name", "e2e User",
+			"-name", ResourceName(t, "User"),
 			"-description", "e2e User",
 			"-format", "json",
 		),
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package infra
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/jimlambrt/gldap"
+	"github.com/jimlambrt/gldap/testdirectory"
+)
+
+// LdapPassword is the password of every user of an LdapServer
+const LdapPassword = "password"
+
+// LdapUser describes a user of an LdapServer and the groups it is a member of
+type LdapUser struct {
+	Name   string
+	Groups []string
+}
+
+// LdapServer stores information about an in-process ldap server
+type LdapServer struct {
+	Directory *testdirectory.Directory
+	// Url is the ldaps url of the server
+	Url string
+	// Certificate is the PEM encoded certificate of the server
+	Certificate string
+	UserDn      string
+	GroupDn     string
+}
+
+// StartLdapServer starts an in-process ldap server with the provided users.
+// The server listens on localhost, so it can only be used by a controller
+// running on the same host as the test, and is stopped when the test
+// completes. Users authenticate with LdapPassword.
+// Returns information about the server
+func StartLdapServer(t testing.TB, users ...LdapUser) *LdapServer {
+	t.Log("Starting ldap server...")
+	logger := hclog.New(&hclog.LoggerOptions{
+		Name:  "e2e-ldap",
+		Level: hclog.Error,
+	})
+	td := testdirectory.Start(t,
+		testdirectory.WithDefaults(t, &testdirectory.Defaults{AllowAnonymousBind: true}),
+		testdirectory.WithLogger(t, logger),
+	)
+	s := &LdapServer{
+		Directory:   td,
+		Url:         fmt.Sprintf("ldaps://127.0.0.1:%d", td.Port()),
+		Certificate: td.Cert(),
+		UserDn:      testdirectory.DefaultUserDN,
+		GroupDn:     testdirectory.DefaultGroupDN,
+	}
+	s.SetUsers(t, users...)
+	t.Logf("Started ldap server: %s", s.Url)
+	return s
+}
+
+// SetUsers replaces the users of the ldap server and their group memberships.
+// Groups are created for every group a user is a member of.
+func (s *LdapServer) SetUsers(t testing.TB, users ...LdapUser) {
+	members := make(map[string][]string)
+	entries := make([]*gldap.Entry, 0, len(users))
+	for _, u := range users {
+		var opt []testdirectory.Option
+		if len(u.Groups) > 0 {
+			opt = append(opt, testdirectory.WithMembersOf(t, testdirectory.NewMemberOf(t, u.Groups)...))
+		}
+		entries = append(entries, testdirectory.NewUsers(t, []string{u.Name}, opt...)...)
+		for _, g := range u.Groups {
+			members[g] = append(members[g], u.Name)
+		}
+	}
+
+	groupNames := make([]string, 0, len(members))
+	for g := range members {
+		groupNames = append(groupNames, g)
+	}
+	sort.Strings(groupNames)
+	groups := make([]*gldap.Entry, 0, len(groupNames))
+	for _, g := range groupNames {
+		groups = append(groups, testdirectory.NewGroup(t, g, members[g]))
+	}
+
+	s.Directory.SetUsers(entries...)
+	s.Directory.SetGroups(groups...)
+}
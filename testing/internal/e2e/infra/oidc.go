@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package infra
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	capoidc "github.com/hashicorp/cap/oidc"
+	"github.com/stretchr/testify/require"
+)
+
+// OidcSubject describes a subject that can log in to an OidcProvider. Groups
+// are issued in the "groups" claim of the subject's tokens, along with the
+// custom Claims.
+type OidcSubject struct {
+	Name     string
+	Password string
+	Email    string
+	Groups   []string
+	Claims   map[string]any
+}
+
+// OidcProvider stores information about an in-process oidc provider
+type OidcProvider struct {
+	Provider     *capoidc.TestProvider
+	Issuer       string
+	ClientId     string
+	ClientSecret string
+	// CaCert is the PEM encoded certificate of the provider's CA
+	CaCert string
+}
+
+// StartOidcProvider starts an in-process oidc provider which lets the provided
+// subjects log in with their password, and which redirects to the callback of
+// the controller at apiUrl. The provider listens on localhost, so it can only
+// be used by a controller running on the same host as the test, and is stopped
+// when the test completes.
+// Returns information about the provider
+func StartOidcProvider(t testing.TB, apiUrl string, subjects ...OidcSubject) *OidcProvider {
+	t.Log("Starting oidc provider...")
+	clientId, err := capoidc.NewID()
+	require.NoError(t, err)
+	clientSecret, err := capoidc.NewID()
+	require.NoError(t, err)
+
+	tp := capoidc.StartTestProvider(t,
+		capoidc.WithTestDefaults(&capoidc.TestProviderDefaults{
+			AllowedRedirectURIs: []string{fmt.Sprintf("%s/v1/auth-methods/oidc:authenticate:callback", strings.TrimSuffix(apiUrl, "/"))},
+			ClientID:            &clientId,
+			ClientSecret:        &clientSecret,
+		}),
+	)
+	p := &OidcProvider{
+		Provider:     tp,
+		Issuer:       tp.Addr(),
+		ClientId:     clientId,
+		ClientSecret: clientSecret,
+		CaCert:       tp.CACert(),
+	}
+	p.SetSubjects(subjects...)
+	t.Logf("Started oidc provider: %s", p.Issuer)
+	return p
+}
+
+// SetSubjects replaces the subjects which can log in to the oidc provider
+func (p *OidcProvider) SetSubjects(subjects ...OidcSubject) {
+	info := make(map[string]*capoidc.TestSubject, len(subjects))
+	for _, s := range subjects {
+		email := s.Email
+		if email == "" {
+			email = fmt.Sprintf("%s@example.com", s.Name)
+		}
+		claims := make(map[string]any, len(s.Claims)+1)
+		for k, v := range s.Claims {
+			claims[k] = v
+		}
+		if len(s.Groups) > 0 {
+			claims["groups"] = s.Groups
+		}
+		info[s.Name] = &capoidc.TestSubject{
+			Password: s.Password,
+			UserInfo: map[string]any{
+				"email": email,
+				"name":  s.Name,
+			},
+			CustomClaims: claims,
+		}
+	}
+	p.Provider.SetSubjectInfo(info)
+}
+
+// SetClaims sets custom claims issued in the tokens of every subject, in
+// addition to the claims of the subject
+func (p *OidcProvider) SetClaims(claims map[string]any) {
+	p.Provider.SetCustomClaims(claims)
+}
@@ -13,7 +13,6 @@ import (
 
 	"github.com/cenkalti/backoff/v4"
 	"github.com/hashicorp/boundary/api/credentials"
-	"github.com/hashicorp/boundary/api/scopes"
 	"github.com/hashicorp/boundary/api/targets"
 	"github.com/hashicorp/boundary/testing/internal/e2e"
 	"github.com/hashicorp/boundary/testing/internal/e2e/boundary"
@@ -122,19 +121,12 @@ func TestApiStaticCredentialStore(t *testing.T) {
 	require.NoError(t, err)
 	ctx := context.Background()
 
-	newOrgId := boundary.CreateNewOrgApi(t, ctx, client)
-	t.Cleanup(func() {
-		scopeClient := scopes.NewClient(client)
-		_, err := scopeClient.Delete(ctx, newOrgId)
-		require.NoError(t, err)
-	})
+	newOrgId := boundary.CreateOrg(t, ctx, client)
 	newProjectId := boundary.CreateNewProjectApi(t, ctx, client, newOrgId)
 	newHostCatalogId := boundary.CreateNewHostCatalogApi(t, ctx, client, newProjectId)
 	newHostSetId := boundary.CreateNewHostSetApi(t, ctx, client, newHostCatalogId)
 	newHostId := boundary.CreateNewHostApi(t, ctx, client, newHostCatalogId, c.TargetIp)
 	boundary.AddHostToHostSetApi(t, ctx, client, newHostSetId, newHostId)
-	newTargetId := boundary.CreateNewTargetApi(t, ctx, client, newProjectId, c.TargetPort)
-	boundary.AddHostSourceToTargetApi(t, ctx, client, newTargetId, newHostSetId)
 	newCredentialStoreId := boundary.CreateNewCredentialStoreStaticApi(t, ctx, client, newProjectId)
 
 	// Create credentials
@@ -149,15 +141,10 @@ func TestApiStaticCredentialStore(t *testing.T) {
 	newCredentialsId := newCredentialsResult.Item.Id
 	t.Logf("Created Credentials: %s", newCredentialsId)
 
-	// Add credentials to target
-	tClient := targets.NewClient(client)
-	_, err = tClient.AddCredentialSources(ctx, newTargetId, 0,
-		targets.WithAutomaticVersioning(true),
-		targets.WithBrokeredCredentialSourceIds([]string{newCredentialsId}),
-	)
-	require.NoError(t, err)
+	newTargetId := boundary.CreateTargetWithCreds(t, ctx, client, newProjectId, c.TargetPort, newHostSetId, newCredentialsId)
 
 	// Authorize Session
+	tClient := targets.NewClient(client)
 	newSessionAuthorizationResult, err := tClient.AuthorizeSession(ctx, newTargetId)
 	require.NoError(t, err)
 	newSessionAuthorization := newSessionAuthorizationResult.Item
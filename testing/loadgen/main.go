@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Command loadgen measures the performance of session authorization. It
+// creates synthetic users and targets in a running Boundary cluster, such as
+// one started with `boundary dev`, then calls authorize-session at a constant
+// rate and reports a latency histogram. The synthetic resources are deleted
+// when it completes.
+//
+//	go run ./testing/loadgen -auth-method-id ampw_1234567890 -login-name admin -rps 50 -duration 1m
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/authmethods"
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/go-uuid"
+)
+
+// errSaturated is recorded when a request is due but the maximum number of
+// requests is already in flight.
+var errSaturated = errors.New("concurrency limit reached, request not sent")
+
+type config struct {
+	addr          string
+	authMethodId  string
+	loginName     string
+	password      string
+	users         int
+	targets       int
+	targetAddress string
+	targetPort    int
+	rps           float64
+	duration      time.Duration
+	concurrency   int
+	keep          bool
+	runId         string
+}
+
+func main() {
+	c := &config{}
+	flag.StringVar(&c.addr, "addr", "", "The address of the controller api. Defaults to BOUNDARY_ADDR, or http://127.0.0.1:9200.")
+	flag.StringVar(&c.authMethodId, "auth-method-id", "", "The id of the password auth method to authenticate with. Not needed when BOUNDARY_TOKEN is set.")
+	flag.StringVar(&c.loginName, "login-name", "admin", "The login name to authenticate with.")
+	flag.StringVar(&c.password, "password", "", "The password to authenticate with. Defaults to BOUNDARY_LOADGEN_PASSWORD.")
+	flag.IntVar(&c.users, "users", 10, "The number of synthetic users authorizing sessions.")
+	flag.IntVar(&c.targets, "targets", 10, "The number of synthetic targets sessions are authorized to.")
+	flag.StringVar(&c.targetAddress, "target-address", "127.0.0.1", "The address of the synthetic targets.")
+	flag.IntVar(&c.targetPort, "target-port", 22, "The default port of the synthetic targets.")
+	flag.Float64Var(&c.rps, "rps", 10, "The number of authorize-session requests sent per second.")
+	flag.DurationVar(&c.duration, "duration", 30*time.Second, "How long to send requests for.")
+	flag.IntVar(&c.concurrency, "concurrency", 100, "The maximum number of requests in flight.")
+	flag.BoolVar(&c.keep, "keep", false, "Keep the synthetic resources when done.")
+	flag.Parse()
+
+	if err := run(c); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(c *config) error {
+	switch {
+	case c.users < 1:
+		return errors.New("-users must be at least 1")
+	case c.targets < 1:
+		return errors.New("-targets must be at least 1")
+	case c.rps <= 0:
+		return errors.New("-rps must be positive")
+	case c.concurrency < 1:
+		return errors.New("-concurrency must be at least 1")
+	case c.targetPort < 1 || c.targetPort > 65535:
+		return errors.New("-target-port must be a valid port")
+	}
+	var err error
+	if c.runId, err = uuid.GenerateUUID(); err != nil {
+		return err
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	client, err := newClient(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Creating %d users and %d targets...\n", c.users, c.targets)
+	f, err := setup(ctx, client, c)
+	if !c.keep {
+		defer func() {
+			// The run context may be canceled by now
+			if err := teardown(context.Background(), client, f); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+			}
+		}()
+	}
+	if err != nil {
+		return err
+	}
+	if c.keep {
+		fmt.Printf("Synthetic resources are in org %s\n", f.orgId)
+	}
+
+	fmt.Printf("Authorizing sessions at %g requests per second for %s...\n", c.rps, c.duration)
+	s, elapsed := drive(ctx, c, f)
+	s.report(os.Stdout, elapsed)
+	return nil
+}
+
+// newClient returns a client authenticated to the controller
+func newClient(ctx context.Context, c *config) (*api.Client, error) {
+	apiConf, err := api.DefaultConfig()
+	if err != nil {
+		return nil, err
+	}
+	if c.addr != "" {
+		apiConf.Addr = c.addr
+	}
+	client, err := api.NewClient(apiConf)
+	if err != nil {
+		return nil, err
+	}
+	// Retries would hide the latency of failed requests
+	client.SetMaxRetries(0)
+	if client.Token() != "" {
+		return client, nil
+	}
+
+	if c.authMethodId == "" {
+		return nil, errors.New("-auth-method-id is required when BOUNDARY_TOKEN is not set")
+	}
+	password := c.password
+	if password == "" {
+		password = os.Getenv("BOUNDARY_LOADGEN_PASSWORD")
+	}
+	if password == "" {
+		return nil, errors.New("no password provided")
+	}
+	result, err := authmethods.NewClient(client).Authenticate(ctx, c.authMethodId, "login", map[string]any{
+		"login_name": c.loginName,
+		"password":   password,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error authenticating: %w", err)
+	}
+	token, ok := result.Attributes["token"].(string)
+	if !ok {
+		return nil, errors.New("no token in authentication result")
+	}
+	client.SetToken(token)
+	return client, nil
+}
+
+// drive sends authorize-session requests at the configured rate, cycling
+// through the synthetic users and targets, until the duration has elapsed or
+// the context is canceled. Returns the stats of the requests and how long
+// they were sent for.
+func drive(ctx context.Context, c *config, f *fixture) (*stats, time.Duration) {
+	s := newStats()
+	ctx, cancel := context.WithTimeout(ctx, c.duration)
+	defer cancel()
+
+	tClients := make([]*targets.Client, 0, len(f.clients))
+	for _, client := range f.clients {
+		tClients = append(tClients, targets.NewClient(client))
+	}
+	sem := make(chan struct{}, c.concurrency)
+	var wg sync.WaitGroup
+	ticker := time.NewTicker(time.Duration(float64(time.Second) / c.rps))
+	defer ticker.Stop()
+
+	start := time.Now()
+	for i := 0; ; i++ {
+		select {
+		case <-ctx.Done():
+			elapsed := time.Since(start)
+			wg.Wait()
+			return s, elapsed
+		case <-ticker.C:
+		}
+		select {
+		case sem <- struct{}{}:
+		default:
+			s.record(0, errSaturated)
+			continue
+		}
+		tClient := tClients[i%len(tClients)]
+		// Cycle through every user and target pair
+		targetId := f.targetIds[(i/len(tClients))%len(f.targetIds)]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			// Requests in flight when the duration elapses are allowed to
+			// complete, so they aren't recorded as errors
+			reqStart := time.Now()
+			_, err := tClient.AuthorizeSession(context.Background(), targetId)
+			s.record(time.Since(reqStart), err)
+		}()
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/accounts"
+	"github.com/hashicorp/boundary/api/authmethods"
+	"github.com/hashicorp/boundary/api/roles"
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/boundary/api/users"
+)
+
+const syntheticPassword = "loadgen-password"
+
+// fixture holds the synthetic resources created for a load test
+type fixture struct {
+	orgId     string
+	targetIds []string
+	// clients holds a client authenticated as each synthetic user
+	clients []*api.Client
+}
+
+// setup creates an org and project holding the synthetic targets, and a
+// password auth method in the org with the synthetic users, who are granted
+// authorize-session on the targets. Deleting the org deletes all of them.
+func setup(ctx context.Context, client *api.Client, c *config) (*fixture, error) {
+	name := fmt.Sprintf("loadgen %s", c.runId)
+	org, err := scopes.NewClient(client).Create(ctx, "global", scopes.WithName(name))
+	if err != nil {
+		return nil, fmt.Errorf("error creating org: %w", err)
+	}
+	f := &fixture{orgId: org.Item.Id}
+	proj, err := scopes.NewClient(client).Create(ctx, f.orgId, scopes.WithName(name))
+	if err != nil {
+		return f, fmt.Errorf("error creating project: %w", err)
+	}
+
+	tClient := targets.NewClient(client)
+	for i := 0; i < c.targets; i++ {
+		t, err := tClient.Create(ctx, "tcp", proj.Item.Id,
+			targets.WithName(fmt.Sprintf("target-%d", i)),
+			targets.WithAddress(c.targetAddress),
+			targets.WithTcpTargetDefaultPort(uint32(c.targetPort)),
+			targets.WithSessionConnectionLimit(-1),
+		)
+		if err != nil {
+			return f, fmt.Errorf("error creating target: %w", err)
+		}
+		f.targetIds = append(f.targetIds, t.Item.Id)
+	}
+
+	am, err := authmethods.NewClient(client).Create(ctx, "password", f.orgId, authmethods.WithName(name))
+	if err != nil {
+		return f, fmt.Errorf("error creating auth method: %w", err)
+	}
+	role, err := roles.NewClient(client).Create(ctx, f.orgId,
+		roles.WithName(name),
+		roles.WithGrantScopeId(proj.Item.Id),
+	)
+	if err != nil {
+		return f, fmt.Errorf("error creating role: %w", err)
+	}
+	role, err = roles.NewClient(client).AddGrants(ctx, role.Item.Id, role.Item.Version,
+		[]string{"id=*;type=target;actions=authorize-session"})
+	if err != nil {
+		return f, fmt.Errorf("error adding grants to role: %w", err)
+	}
+
+	aClient := accounts.NewClient(client)
+	uClient := users.NewClient(client)
+	amClient := authmethods.NewClient(client)
+	userIds := make([]string, 0, c.users)
+	for i := 0; i < c.users; i++ {
+		loginName := fmt.Sprintf("user-%d", i)
+		acct, err := aClient.Create(ctx, am.Item.Id,
+			accounts.WithPasswordAccountLoginName(loginName),
+			accounts.WithPasswordAccountPassword(syntheticPassword),
+		)
+		if err != nil {
+			return f, fmt.Errorf("error creating account: %w", err)
+		}
+		u, err := uClient.Create(ctx, f.orgId, users.WithName(loginName))
+		if err != nil {
+			return f, fmt.Errorf("error creating user: %w", err)
+		}
+		if _, err := uClient.AddAccounts(ctx, u.Item.Id, u.Item.Version, []string{acct.Item.Id}); err != nil {
+			return f, fmt.Errorf("error adding account to user: %w", err)
+		}
+		userIds = append(userIds, u.Item.Id)
+
+		result, err := amClient.Authenticate(ctx, am.Item.Id, "login", map[string]any{
+			"login_name": loginName,
+			"password":   syntheticPassword,
+		})
+		if err != nil {
+			return f, fmt.Errorf("error authenticating user: %w", err)
+		}
+		token, ok := result.Attributes["token"].(string)
+		if !ok {
+			return f, fmt.Errorf("no token in authentication result")
+		}
+		userClient := client.Clone()
+		userClient.SetToken(token)
+		f.clients = append(f.clients, userClient)
+	}
+	if _, err := roles.NewClient(client).AddPrincipals(ctx, role.Item.Id, role.Item.Version, userIds); err != nil {
+		return f, fmt.Errorf("error adding principals to role: %w", err)
+	}
+	return f, nil
+}
+
+// teardown deletes the synthetic resources
+func teardown(ctx context.Context, client *api.Client, f *fixture) error {
+	if f == nil || f.orgId == "" {
+		return nil
+	}
+	if _, err := scopes.NewClient(client).Delete(ctx, f.orgId); err != nil {
+		return fmt.Errorf("error deleting org %s: %w", f.orgId, err)
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+// histogramBuckets are the upper bounds of the latency histogram buckets.
+// Latencies above the last bound are counted in an overflow bucket.
+var histogramBuckets = []time.Duration{
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+}
+
+// stats records the latencies and errors of requests; it is safe for
+// concurrent use.
+type stats struct {
+	mu        sync.Mutex
+	latencies []time.Duration
+	errors    map[string]int
+}
+
+func newStats() *stats {
+	return &stats{
+		errors: make(map[string]int),
+	}
+}
+
+// record records the latency of a successful request, or the error of a
+// failed one.
+func (s *stats) record(latency time.Duration, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err != nil {
+		s.errors[errorKey(err)]++
+		return
+	}
+	s.latencies = append(s.latencies, latency)
+}
+
+// errorKey returns the key errors are grouped by in the report. Api errors
+// are grouped by status, kind and message, leaving out details that vary
+// between requests.
+func errorKey(err error) string {
+	if apiErr := api.AsServerError(err); apiErr != nil {
+		return fmt.Sprintf("%d %s: %s", apiErr.Response().StatusCode(), apiErr.Kind, apiErr.Message)
+	}
+	return err.Error()
+}
+
+// percentile returns the latency below which p percent of the successful
+// requests completed. The latencies must be sorted.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	i := int(float64(len(sorted))*p/100+0.5) - 1
+	switch {
+	case i < 0:
+		i = 0
+	case i >= len(sorted):
+		i = len(sorted) - 1
+	}
+	return sorted[i]
+}
+
+// histogram returns the number of latencies in each of histogramBuckets,
+// followed by the number of latencies above the last bucket.
+func histogram(latencies []time.Duration) []int {
+	counts := make([]int, len(histogramBuckets)+1)
+	for _, l := range latencies {
+		i := sort.Search(len(histogramBuckets), func(i int) bool { return l <= histogramBuckets[i] })
+		counts[i]++
+	}
+	return counts
+}
+
+// report writes a summary of the recorded requests made over elapsed.
+func (s *stats) report(w io.Writer, elapsed time.Duration) {
+	s.mu.Lock()
+	sorted := append([]time.Duration{}, s.latencies...)
+	errs := make(map[string]int, len(s.errors))
+	var errCount int
+	for k, v := range s.errors {
+		errs[k] = v
+		errCount += v
+	}
+	s.mu.Unlock()
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	total := len(sorted) + errCount
+	fmt.Fprintf(w, "Requests:      %d\n", total)
+	fmt.Fprintf(w, "Errors:        %d\n", errCount)
+	if elapsed > 0 {
+		fmt.Fprintf(w, "Achieved RPS:  %.2f\n", float64(total)/elapsed.Seconds())
+	}
+	if len(sorted) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "Latency:\n")
+	fmt.Fprintf(w, "  min:         %s\n", sorted[0])
+	for _, p := range []float64{50, 90, 95, 99} {
+		fmt.Fprintf(w, "  p%-11s%s\n", fmt.Sprintf("%g:", p), percentile(sorted, p))
+	}
+	fmt.Fprintf(w, "  max:         %s\n", sorted[len(sorted)-1])
+
+	fmt.Fprintf(w, "Histogram:\n")
+	counts := histogram(sorted)
+	max := 0
+	for _, c := range counts {
+		if c > max {
+			max = c
+		}
+	}
+	for i, c := range counts {
+		label := fmt.Sprintf(" > %s", histogramBuckets[len(histogramBuckets)-1])
+		if i < len(histogramBuckets) {
+			label = fmt.Sprintf("<= %s", histogramBuckets[i])
+		}
+		bar := 0
+		if max > 0 {
+			bar = c * 40 / max
+		}
+		fmt.Fprintf(w, "  %-10s %8d %s\n", label, c, strings.Repeat("#", bar))
+	}
+
+	if len(errs) > 0 {
+		fmt.Fprintf(w, "Errors by message:\n")
+		msgs := make([]string, 0, len(errs))
+		for k := range errs {
+			msgs = append(msgs, k)
+		}
+		sort.Strings(msgs)
+		for _, m := range msgs {
+			fmt.Fprintf(w, "  %8d %s\n", errs[m], m)
+		}
+	}
+}
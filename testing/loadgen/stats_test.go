@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPercentile(t *testing.T) {
+	var sorted []time.Duration
+	for i := 1; i <= 100; i++ {
+		sorted = append(sorted, time.Duration(i)*time.Millisecond)
+	}
+	assert.Equal(t, time.Duration(0), percentile(nil, 50))
+	assert.Equal(t, 50*time.Millisecond, percentile(sorted, 50))
+	assert.Equal(t, 99*time.Millisecond, percentile(sorted, 99))
+	assert.Equal(t, 100*time.Millisecond, percentile(sorted, 100))
+	assert.Equal(t, time.Millisecond, percentile(sorted, 0))
+}
+
+func TestHistogram(t *testing.T) {
+	counts := histogram([]time.Duration{
+		time.Millisecond,
+		5 * time.Millisecond,
+		6 * time.Millisecond,
+		time.Second,
+		time.Minute,
+	})
+	assert.Len(t, counts, len(histogramBuckets)+1)
+	assert.Equal(t, 2, counts[0])
+	assert.Equal(t, 1, counts[1])
+	assert.Equal(t, 1, counts[7])
+	assert.Equal(t, 1, counts[len(counts)-1])
+}
+
+func TestStatsReport(t *testing.T) {
+	s := newStats()
+	s.record(10*time.Millisecond, nil)
+	s.record(20*time.Millisecond, nil)
+	s.record(0, errors.New("boom"))
+	s.record(0, errSaturated)
+
+	var buf bytes.Buffer
+	s.report(&buf, 2*time.Second)
+	out := buf.String()
+	assert.Contains(t, out, "Requests:      4\n")
+	assert.Contains(t, out, "Errors:        2\n")
+	assert.Contains(t, out, "Achieved RPS:  2.00\n")
+	assert.Contains(t, out, "min:         10ms")
+	assert.Contains(t, out, "max:         20ms")
+	assert.Contains(t, out, "1 boom")
+	assert.Contains(t, out, errSaturated.Error())
+}